@@ -137,14 +137,41 @@ const (
 	// ConfigKeycloakServiceAccountEnabled is a feature flag for service account support
 	ConfigKeycloakServiceAccountEnabled = "keycloak.serviceAccount"
 
-	// ConfigRateLimiterEnabled is a feature flag for rate limiter
+	// ConfigRateLimiterEnabled is a feature flag for the client IP rate limiter
 	ConfigRateLimiterEnabled = "rateLimiter.enabled"
-	// ConfigRateLimiterRate specifies the rate limit (requests per second)
+	// ConfigRateLimiterRate specifies the rate limit per client IP (requests per second), applied before authentication
 	ConfigRateLimiterRate = "rateLimiter.rate"
-	// ConfigRateLimiterBurst specifies the burst size
+	// ConfigRateLimiterBurst specifies the burst size per client IP
 	ConfigRateLimiterBurst = "rateLimiter.burst"
-	// ConfigRateLimiterExpiresIn specifies the expiration time in seconds
+	// ConfigRateLimiterExpiresIn specifies the expiration time in seconds of client IP rate limiting state
 	ConfigRateLimiterExpiresIn = "rateLimiter.expiresIn"
+	// ConfigRateLimiterOrgUserEnabled is a feature flag for the org and user rate limiter
+	ConfigRateLimiterOrgUserEnabled = "rateLimiter.orgUserEnabled"
+	// ConfigRateLimiterStore specifies where request counts are kept, shared between replicas in postgres or per replica in memory
+	ConfigRateLimiterStore = "rateLimiter.store"
+	// ConfigRateLimiterWindow specifies the length of the counting window in seconds
+	ConfigRateLimiterWindow = "rateLimiter.window"
+	// ConfigRateLimiterReadOrg specifies the read requests allowed per org in a window
+	ConfigRateLimiterReadOrg = "rateLimiter.read.org"
+	// ConfigRateLimiterReadUser specifies the read requests allowed per user or service account in a window
+	ConfigRateLimiterReadUser = "rateLimiter.read.user"
+	// ConfigRateLimiterWriteOrg specifies the mutating requests allowed per org in a window
+	ConfigRateLimiterWriteOrg = "rateLimiter.write.org"
+	// ConfigRateLimiterWriteUser specifies the mutating requests allowed per user or service account in a window
+	ConfigRateLimiterWriteUser = "rateLimiter.write.user"
+	// ConfigRateLimiterExpensiveOrg specifies the requests to expensive endpoints allowed per org in a window
+	ConfigRateLimiterExpensiveOrg = "rateLimiter.expensive.org"
+	// ConfigRateLimiterExpensiveUser specifies the requests to expensive endpoints allowed per user or service account in a window
+	ConfigRateLimiterExpensiveUser = "rateLimiter.expensive.user"
+	// ConfigRateLimiterExpensivePaths specifies the route path fragments identifying expensive endpoints
+	ConfigRateLimiterExpensivePaths = "rateLimiter.expensivePaths"
+)
+
+const (
+	// RateLimiterStorePostgres keeps rate limiting counts in Postgres, shared between API replicas
+	RateLimiterStorePostgres = "postgres"
+	// RateLimiterStoreMemory keeps rate limiting counts in memory, separately for each API replica
+	RateLimiterStoreMemory = "memory"
 )
 
 // IssuerConfig represents a single issuer configuration entry
@@ -195,10 +222,23 @@ func ParseOriginString(origin string) (string, error) {
 
 // RateLimiterConfig holds configuration for rate limiting
 type RateLimiterConfig struct {
-	Enabled   bool
-	Rate      float64 // requests per second
-	Burst     int     // maximum burst size
-	ExpiresIn int     // expiration time in seconds
+	Enabled        bool    // enables the client IP rate limiter
+	Rate           float64 // requests per second per client IP
+	Burst          int     // maximum burst size per client IP
+	ExpiresIn      int     // expiration time in seconds of client IP state
+	OrgUserEnabled bool    // enables the org and user rate limiter
+	Store          string  // postgres or memory
+	Window         int     // counting window in seconds
+	Read           RateLimitBudget
+	Write          RateLimitBudget
+	Expensive      RateLimitBudget
+	ExpensivePaths []string
+}
+
+// RateLimitBudget holds the requests allowed in a window, a limit of 0 disables the corresponding check
+type RateLimitBudget struct {
+	Org  int // requests per org
+	User int // requests per user or service account
 }
 
 // Maintain a global config object
@@ -268,6 +308,16 @@ func NewConfig() *Config {
 	c.v.SetDefault(ConfigRateLimiterRate, 10.0)     // 10 requests per second
 	c.v.SetDefault(ConfigRateLimiterBurst, 30)      // burst of 30 requests
 	c.v.SetDefault(ConfigRateLimiterExpiresIn, 180) // 180 seconds (3 minutes)
+	c.v.SetDefault(ConfigRateLimiterOrgUserEnabled, false)
+	c.v.SetDefault(ConfigRateLimiterStore, RateLimiterStorePostgres)
+	c.v.SetDefault(ConfigRateLimiterWindow, 60) // 1 minute
+	c.v.SetDefault(ConfigRateLimiterReadOrg, 6000)
+	c.v.SetDefault(ConfigRateLimiterReadUser, 1200)
+	c.v.SetDefault(ConfigRateLimiterWriteOrg, 1200)
+	c.v.SetDefault(ConfigRateLimiterWriteUser, 300)
+	c.v.SetDefault(ConfigRateLimiterExpensiveOrg, 120)
+	c.v.SetDefault(ConfigRateLimiterExpensiveUser, 30)
+	c.v.SetDefault(ConfigRateLimiterExpensivePaths, []string{"/instance/batch", "/stats"})
 
 	c.v.AutomaticEnv()
 	c.v.SetConfigFile(c.GetPathToConfig())
@@ -530,16 +580,27 @@ func (c *Config) GetMetricsConfig() *MetricsConfig {
 
 // GetRateLimiterConfig returns the rate limiter config
 func (c *Config) GetRateLimiterConfig() *RateLimiterConfig {
-	return NewRateLimiterConfig(c.GetRateLimiterEnabled(), c.GetRateLimiterRate(), c.GetRateLimiterBurst(), c.GetRateLimiterExpiresIn())
-}
-
-// NewRateLimiterConfig initializes and returns a configuration object for rate limiting
-func NewRateLimiterConfig(enabled bool, rate float64, burst int, expiresIn int) *RateLimiterConfig {
 	return &RateLimiterConfig{
-		Enabled:   enabled,
-		Rate:      rate,
-		Burst:     burst,
-		ExpiresIn: expiresIn,
+		Enabled:        c.GetRateLimiterEnabled(),
+		Rate:           c.GetRateLimiterRate(),
+		Burst:          c.GetRateLimiterBurst(),
+		ExpiresIn:      c.GetRateLimiterExpiresIn(),
+		OrgUserEnabled: c.GetRateLimiterOrgUserEnabled(),
+		Store:          c.GetRateLimiterStore(),
+		Window:         c.GetRateLimiterWindow(),
+		Read: RateLimitBudget{
+			Org:  c.v.GetInt(ConfigRateLimiterReadOrg),
+			User: c.v.GetInt(ConfigRateLimiterReadUser),
+		},
+		Write: RateLimitBudget{
+			Org:  c.v.GetInt(ConfigRateLimiterWriteOrg),
+			User: c.v.GetInt(ConfigRateLimiterWriteUser),
+		},
+		Expensive: RateLimitBudget{
+			Org:  c.v.GetInt(ConfigRateLimiterExpensiveOrg),
+			User: c.v.GetInt(ConfigRateLimiterExpensiveUser),
+		},
+		ExpensivePaths: c.v.GetStringSlice(ConfigRateLimiterExpensivePaths),
 	}
 }
 
@@ -1123,42 +1184,72 @@ func (c *Config) Close() {
 
 // Rate limiter configuration methods
 
-// GetRateLimiterEnabled gets the enabled field for rate limiter
+// GetRateLimiterEnabled gets the enabled field for the client IP rate limiter
 func (c *Config) GetRateLimiterEnabled() bool {
 	return c.v.GetBool(ConfigRateLimiterEnabled)
 }
 
-// SetRateLimiterEnabled sets the enabled field for rate limiter
+// SetRateLimiterEnabled sets the enabled field for the client IP rate limiter
 func (c *Config) SetRateLimiterEnabled(value bool) {
 	c.v.Set(ConfigRateLimiterEnabled, value)
 }
 
-// GetRateLimiterRate gets the rate limit (requests per second)
+// GetRateLimiterOrgUserEnabled gets the enabled field for the org and user rate limiter
+func (c *Config) GetRateLimiterOrgUserEnabled() bool {
+	return c.v.GetBool(ConfigRateLimiterOrgUserEnabled)
+}
+
+// SetRateLimiterOrgUserEnabled sets the enabled field for the org and user rate limiter
+func (c *Config) SetRateLimiterOrgUserEnabled(value bool) {
+	c.v.Set(ConfigRateLimiterOrgUserEnabled, value)
+}
+
+// GetRateLimiterRate gets the rate limit per client IP (requests per second)
 func (c *Config) GetRateLimiterRate() float64 {
 	return c.v.GetFloat64(ConfigRateLimiterRate)
 }
 
-// SetRateLimiterRate sets the rate limit (requests per second)
+// SetRateLimiterRate sets the rate limit per client IP (requests per second)
 func (c *Config) SetRateLimiterRate(value float64) {
 	c.v.Set(ConfigRateLimiterRate, value)
 }
 
-// GetRateLimiterBurst gets the burst size
+// GetRateLimiterBurst gets the burst size per client IP
 func (c *Config) GetRateLimiterBurst() int {
 	return c.v.GetInt(ConfigRateLimiterBurst)
 }
 
-// SetRateLimiterBurst sets the burst size
+// SetRateLimiterBurst sets the burst size per client IP
 func (c *Config) SetRateLimiterBurst(value int) {
 	c.v.Set(ConfigRateLimiterBurst, value)
 }
 
-// GetRateLimiterExpiresIn gets the expiration time in seconds
+// GetRateLimiterExpiresIn gets the expiration time in seconds of client IP rate limiting state
 func (c *Config) GetRateLimiterExpiresIn() int {
 	return c.v.GetInt(ConfigRateLimiterExpiresIn)
 }
 
-// SetRateLimiterExpiresIn sets the expiration time in seconds
+// SetRateLimiterExpiresIn sets the expiration time in seconds of client IP rate limiting state
 func (c *Config) SetRateLimiterExpiresIn(value int) {
 	c.v.Set(ConfigRateLimiterExpiresIn, value)
 }
+
+// GetRateLimiterStore gets the store for rate limiting counts
+func (c *Config) GetRateLimiterStore() string {
+	return c.v.GetString(ConfigRateLimiterStore)
+}
+
+// SetRateLimiterStore sets the store for rate limiting counts
+func (c *Config) SetRateLimiterStore(value string) {
+	c.v.Set(ConfigRateLimiterStore, value)
+}
+
+// GetRateLimiterWindow gets the length of the counting window in seconds
+func (c *Config) GetRateLimiterWindow() int {
+	return c.v.GetInt(ConfigRateLimiterWindow)
+}
+
+// SetRateLimiterWindow sets the length of the counting window in seconds
+func (c *Config) SetRateLimiterWindow(value int) {
+	c.v.Set(ConfigRateLimiterWindow, value)
+}
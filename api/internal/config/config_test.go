@@ -43,3 +43,37 @@ func TestNewConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_GetRateLimiterConfig(t *testing.T) {
+	cfg := NewConfig()
+
+	got := cfg.GetRateLimiterConfig()
+	assert.False(t, got.Enabled)
+	assert.False(t, got.OrgUserEnabled)
+	assert.Equal(t, 10.0, got.Rate)
+	assert.Equal(t, 30, got.Burst)
+	assert.Equal(t, 180, got.ExpiresIn)
+	assert.Equal(t, RateLimiterStorePostgres, got.Store)
+	assert.Equal(t, 60, got.Window)
+	assert.Equal(t, RateLimitBudget{Org: 6000, User: 1200}, got.Read)
+	assert.Equal(t, RateLimitBudget{Org: 1200, User: 300}, got.Write)
+	assert.Equal(t, RateLimitBudget{Org: 120, User: 30}, got.Expensive)
+	assert.Equal(t, []string{"/instance/batch", "/stats"}, got.ExpensivePaths)
+
+	cfg.SetRateLimiterEnabled(true)
+	cfg.SetRateLimiterOrgUserEnabled(true)
+	cfg.SetRateLimiterStore(RateLimiterStoreMemory)
+	cfg.SetRateLimiterWindow(10)
+	cfg.SetRateLimiterRate(5.0)
+	cfg.SetRateLimiterBurst(15)
+	cfg.SetRateLimiterExpiresIn(60)
+
+	got = cfg.GetRateLimiterConfig()
+	assert.True(t, got.Enabled)
+	assert.True(t, got.OrgUserEnabled)
+	assert.Equal(t, RateLimiterStoreMemory, got.Store)
+	assert.Equal(t, 10, got.Window)
+	assert.Equal(t, 5.0, got.Rate)
+	assert.Equal(t, 15, got.Burst)
+	assert.Equal(t, 60, got.ExpiresIn)
+}
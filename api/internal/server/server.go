@@ -135,14 +135,14 @@ func InitAPIServer(cfg *config.Config, dbSession *cdb.Session, tc tsdkClient.Cli
 	// Secure middleware configures echo with secure headers
 	e.Use(middleware.Secure())
 
-	// Rate limiter middleware (if enabled)
+	// Client IP rate limiter middleware (if enabled), applied ahead of auth so unauthenticated requests are also limited
 	rateLimiterConfig := cfg.GetRateLimiterConfig()
 	if rateLimiterConfig.Enabled {
 		log.Info().
 			Float64("Rate", rateLimiterConfig.Rate).
 			Int("Burst", rateLimiterConfig.Burst).
 			Int("Expires In", rateLimiterConfig.ExpiresIn).
-			Msg("Rate limiter enabled")
+			Msg("Client IP rate limiter enabled")
 
 		rateLimiterMiddlewareConfig := echoMiddleware.RateLimiterConfig{
 			Skipper: echoMiddleware.DefaultSkipper,
@@ -176,7 +176,7 @@ func InitAPIServer(cfg *config.Config, dbSession *cdb.Session, tc tsdkClient.Cli
 
 		e.Use(echoMiddleware.RateLimiterWithConfig(rateLimiterMiddlewareConfig))
 	} else {
-		log.Info().Msg("Rate limiter disabled")
+		log.Info().Msg("Client IP rate limiter disabled")
 	}
 
 	if cfg.GetTracingEnabled() {
@@ -264,6 +264,28 @@ func InitAPIServer(cfg *config.Config, dbSession *cdb.Session, tc tsdkClient.Cli
 	authMiddleware := authn.Auth(dbSession, tc, jwtOriginConfig, payloadEncryptionConfig, keycloakConfig)
 	routeGroup.Use(authMiddleware)
 
+	// Org and user rate limiter middleware (if enabled), keyed by the org and user from the auth context
+	if rateLimiterConfig.OrgUserEnabled {
+		log.Info().
+			Str("Store", rateLimiterConfig.Store).
+			Int("Window", rateLimiterConfig.Window).
+			Interface("Read", rateLimiterConfig.Read).
+			Interface("Write", rateLimiterConfig.Write).
+			Interface("Expensive", rateLimiterConfig.Expensive).
+			Strs("Expensive Paths", rateLimiterConfig.ExpensivePaths).
+			Msg("Org and user rate limiter enabled")
+
+		if rateLimiterConfig.Window <= 0 {
+			log.Panic().Int("Window", rateLimiterConfig.Window).Msg("rate limiter window must be positive")
+		}
+
+		rateLimitStore, err := middleware.NewRateLimitStore(rateLimiterConfig, dbSession)
+		if err != nil {
+			log.Panic().Err(err).Msg("failed to initialize rate limiter store")
+		}
+		routeGroup.Use(middleware.RateLimiter(rateLimiterConfig, rateLimitStore))
+	}
+
 	apiRoutes := api.NewAPIRoutes(dbSession, tc, tnc, scp, cfg)
 	for _, apiRoute := range apiRoutes {
 		routeGroup.Add(apiRoute.Method, apiRoute.Path, apiRoute.Handler.Handle)
@@ -0,0 +1,637 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	common "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/pagination"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+
+	networkSecurityGroupWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/networksecuritygroup"
+)
+
+// validateAddressSetRequest validates the org membership and role of the User managing Address Sets
+func validateAddressSetRequest(dbUser *cdbm.User, org string) *cutil.APIError {
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			return cutil.NewAPIError(http.StatusInternalServerError, "Error validating org membership for User", nil)
+		}
+		return cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to manage Address Sets
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		return cutil.NewAPIError(http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	return nil
+}
+
+// getTenantForAddressSetRequest retrieves the Tenant of the org that owns the Address Sets being managed
+func getTenantForAddressSetRequest(ctx context.Context, dbSession *cdb.Session, org string) (*cdbm.Tenant, *cutil.APIError) {
+	tenant, err := common.GetTenantForOrg(ctx, nil, dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgTenantNotFound) {
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Org does not have a Tenant associated", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	return tenant, nil
+}
+
+// getAddressSetForTenant retrieves the Address Set specified in the URL and checks that it belongs to the Tenant
+func getAddressSetForTenant(ctx context.Context, c echo.Context, dbSession *cdb.Session, tenant *cdbm.Tenant, includeRelations []string) (*cdbm.AddressSet, *cutil.APIError) {
+	asID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Address Set ID in URL", nil)
+	}
+
+	asDAO := cdbm.NewAddressSetDAO(dbSession)
+	das, err := asDAO.GetByID(ctx, nil, asID, includeRelations)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Address Set with specified ID", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Address Set due to DB error", nil)
+	}
+
+	// Address Sets of other Tenants are reported as missing rather than forbidden
+	if das.TenantID != tenant.ID {
+		return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Address Set with specified ID", nil)
+	}
+
+	return das, nil
+}
+
+// getNetworkSecurityGroupsReferencingAddressSet retrieves the Network Security Groups with rules referencing the Address Set
+func getNetworkSecurityGroupsReferencingAddressSet(ctx context.Context, dbSession *cdb.Session, asID uuid.UUID) ([]cdbm.NetworkSecurityGroup, error) {
+	nsgDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+	nsgs, _, err := nsgDAO.GetAll(ctx, nil, cdbm.NetworkSecurityGroupFilterInput{ReferencedAddressSetIDs: []uuid.UUID{asID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	return nsgs, err
+}
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateAddressSetHandler is the API Handler for creating new Address Set
+type CreateAddressSetHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateAddressSetHandler initializes and returns a new handler for creating Address Set
+func NewCreateAddressSetHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) CreateAddressSetHandler {
+	return CreateAddressSetHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create an Address Set
+// @Description Create a named list of prefixes that Network Security Group rules can reference
+// @Tags addressset
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APIAddressSetCreateRequest true "Address Set create request"
+// @Success 201 {object} model.APIAddressSet
+// @Router /v2/org/{org}/carbide/address-set [post]
+func (cash CreateAddressSetHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressSet", "Create", c, cash.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateAddressSetRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Address Sets, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForAddressSetRequest(ctx, cash.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIAddressSetCreateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Address Set creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Address Set creation request data", verr)
+	}
+
+	cash.tracerSpan.SetAttribute(handlerSpan, attribute.String("name", apiRequest.Name), logger)
+
+	// Check for name uniqueness within the Tenant
+	asDAO := cdbm.NewAddressSetDAO(cash.dbSession)
+	dass, tot, err := asDAO.GetAll(ctx, nil, cdbm.AddressSetFilterInput{
+		Names:     []string{apiRequest.Name},
+		TenantIDs: []uuid.UUID{tenant.ID},
+	}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("db error checking for name uniqueness of Address Set")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Address Set due to DB error", nil)
+	}
+	if tot > 0 {
+		logger.Warn().Str("name", apiRequest.Name).Msg("Address Set with same name already exists for Tenant")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "An Address Set with specified name already exists for Tenant", validation.Errors{
+			"id": errors.New(dass[0].ID.String()),
+		})
+	}
+
+	das, err := asDAO.Create(ctx, nil, cdbm.AddressSetCreateInput{
+		Name:        apiRequest.Name,
+		Description: apiRequest.Description,
+		TenantOrg:   org,
+		TenantID:    tenant.ID,
+		Prefixes:    apiRequest.Prefixes,
+		CreatedBy:   dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create Address Set record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Address Set due to DB error", nil)
+	}
+
+	logger.Info().Str("Address Set ID", das.ID.String()).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, model.NewAPIAddressSet(das))
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllAddressSetHandler is the API Handler for retrieving all Address Sets of the org
+type GetAllAddressSetHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllAddressSetHandler initializes and returns a new handler for retrieving all Address Sets
+func NewGetAllAddressSetHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllAddressSetHandler {
+	return GetAllAddressSetHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all Address Sets
+// @Description Get all Address Sets of the org's Tenant
+// @Tags addressset
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param query query string false "Query input for full text search"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant'"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {array} []model.APIAddressSet
+// @Router /v2/org/{org}/carbide/address-set [get]
+func (gaash GetAllAddressSetHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressSet", "GetAll", c, gaash.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateAddressSetRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Address Sets, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForAddressSetRequest(ctx, gaash.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err := c.Bind(&pageRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	// Validate pagination request attributes
+	err = pageRequest.Validate(cdbm.AddressSetOrderByFields)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest,
+			"Failed to validate pagination request data", err)
+	}
+
+	// Get query text for full text search from query param
+	var searchQuery *string
+	searchQueryStr := c.QueryParam("query")
+	if searchQueryStr != "" {
+		searchQuery = &searchQueryStr
+		gaash.tracerSpan.SetAttribute(handlerSpan, attribute.String("query", searchQueryStr), logger)
+	}
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.AddressSetRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	asDAO := cdbm.NewAddressSetDAO(gaash.dbSession)
+	dass, total, err := asDAO.GetAll(ctx, nil, cdbm.AddressSetFilterInput{
+		TenantIDs:   []uuid.UUID{tenant.ID},
+		SearchQuery: searchQuery,
+	}, cdbp.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}, qIncludeRelations)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Address Sets from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Address Sets due to DB error", nil)
+	}
+
+	// Create response
+	apiAddressSets := []model.APIAddressSet{}
+	for i := range dass {
+		apiAddressSets = append(apiAddressSets, *model.NewAPIAddressSet(&dass[i]))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiAddressSets)
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetAddressSetHandler is the API Handler for retrieving an Address Set
+type GetAddressSetHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAddressSetHandler initializes and returns a new handler for retrieving an Address Set
+func NewGetAddressSetHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAddressSetHandler {
+	return GetAddressSetHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get an Address Set
+// @Description Get an Address Set of the org's Tenant
+// @Tags addressset
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Address Set"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant'"
+// @Success 200 {object} model.APIAddressSet
+// @Router /v2/org/{org}/carbide/address-set/{id} [get]
+func (gash GetAddressSetHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressSet", "Get", c, gash.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateAddressSetRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Address Sets, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForAddressSetRequest(ctx, gash.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gash.tracerSpan.SetAttribute(handlerSpan, attribute.String("address_set_id", c.Param("id")), logger)
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.AddressSetRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	das, apiErr := getAddressSetForTenant(ctx, c, gash.dbSession, tenant, qIncludeRelations)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Address Set specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIAddressSet(das))
+}
+
+// ~~~~~ Update Handler ~~~~~ //
+
+// UpdateAddressSetHandler is the API Handler for updating an Address Set
+type UpdateAddressSetHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateAddressSetHandler initializes and returns a new handler for updating an Address Set
+func NewUpdateAddressSetHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) UpdateAddressSetHandler {
+	return UpdateAddressSetHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Update an Address Set
+// @Description Update an Address Set of the org's Tenant. Network Security Groups referencing the Address Set are re-synced with their Sites when prefixes change.
+// @Tags addressset
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Address Set"
+// @Param message body model.APIAddressSetUpdateRequest true "Address Set update request"
+// @Success 200 {object} model.APIAddressSet
+// @Router /v2/org/{org}/carbide/address-set/{id} [patch]
+func (uash UpdateAddressSetHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressSet", "Update", c, uash.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateAddressSetRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Address Sets, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForAddressSetRequest(ctx, uash.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	uash.tracerSpan.SetAttribute(handlerSpan, attribute.String("address_set_id", c.Param("id")), logger)
+
+	das, apiErr := getAddressSetForTenant(ctx, c, uash.dbSession, tenant, nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Address Set specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIAddressSetUpdateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Address Set update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Address Set update request data", verr)
+	}
+
+	asDAO := cdbm.NewAddressSetDAO(uash.dbSession)
+
+	// Check for name uniqueness within the Tenant
+	if apiRequest.Name != nil && *apiRequest.Name != das.Name {
+		dass, tot, serr := asDAO.GetAll(ctx, nil, cdbm.AddressSetFilterInput{
+			Names:     []string{*apiRequest.Name},
+			TenantIDs: []uuid.UUID{tenant.ID},
+		}, cdbp.PageInput{}, nil)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("db error checking for name uniqueness of Address Set")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Set due to DB error", nil)
+		}
+		if tot > 0 {
+			logger.Warn().Str("name", *apiRequest.Name).Msg("Address Set with same name already exists for Tenant")
+			return cutil.NewAPIErrorResponse(c, http.StatusConflict, "An Address Set with specified name already exists for Tenant", validation.Errors{
+				"id": errors.New(dass[0].ID.String()),
+			})
+		}
+	}
+
+	prefixesChanged := apiRequest.Prefixes != nil && !slices.Equal(apiRequest.Prefixes, das.Prefixes)
+
+	// start a transaction
+	tx, err := cdb.BeginTx(ctx, uash.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Set due to DB error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	uas, err := asDAO.Update(ctx, tx, cdbm.AddressSetUpdateInput{
+		AddressSetID: das.ID,
+		Name:         apiRequest.Name,
+		Description:  apiRequest.Description,
+		Prefixes:     apiRequest.Prefixes,
+		UpdatedBy:    dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating Address Set in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Set due to DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing Address Set update transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Address Set due to DB error", nil)
+	}
+	txCommitted = true
+
+	// Re-sync the rules of Network Security Groups referencing this Address Set with their Sites
+	if prefixesChanged {
+		nsgs, serr := getNetworkSecurityGroupsReferencingAddressSet(ctx, uash.dbSession, das.ID)
+		if serr != nil {
+			// Log error but continue, stale rules will be detected by inventory
+			logger.Error().Err(serr).Msg("failed to retrieve Network Security Groups referencing Address Set")
+		}
+
+		for _, nsg := range nsgs {
+			wid, serr := networkSecurityGroupWorkflow.ExecuteSyncNetworkSecurityGroupWorkflow(ctx, uash.tc, nsg.SiteID, nsg.ID)
+			if serr != nil {
+				// Log error but continue, stale rules will be detected by inventory
+				logger.Error().Err(serr).Str("Network Security Group ID", nsg.ID).Msg("failed to execute sync Network Security Group workflow")
+				continue
+			}
+
+			logger.Info().Str("Workflow ID", *wid).Str("Network Security Group ID", nsg.ID).Msg("triggered Network Security Group sync workflow")
+		}
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIAddressSet(uas))
+}
+
+// ~~~~~ Delete Handler ~~~~~ //
+
+// DeleteAddressSetHandler is the API Handler for deleting an Address Set
+type DeleteAddressSetHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteAddressSetHandler initializes and returns a new handler for deleting an Address Set
+func NewDeleteAddressSetHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) DeleteAddressSetHandler {
+	return DeleteAddressSetHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Delete an Address Set
+// @Description Delete an Address Set of the org's Tenant. Address Sets referenced by Network Security Group rules cannot be deleted.
+// @Tags addressset
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Address Set"
+// @Success 204
+// @Router /v2/org/{org}/carbide/address-set/{id} [delete]
+func (dash DeleteAddressSetHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AddressSet", "Delete", c, dash.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateAddressSetRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Address Sets, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForAddressSetRequest(ctx, dash.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	dash.tracerSpan.SetAttribute(handlerSpan, attribute.String("address_set_id", c.Param("id")), logger)
+
+	das, apiErr := getAddressSetForTenant(ctx, c, dash.dbSession, tenant, nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Address Set specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	nsgs, err := getNetworkSecurityGroupsReferencingAddressSet(ctx, dash.dbSession, das.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Network Security Groups referencing Address Set")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Groups referencing Address Set", nil)
+	}
+	if len(nsgs) > 0 {
+		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Cannot delete Address Set, rules of one or more Network Security Groups reference this Address Set", nil)
+	}
+
+	asDAO := cdbm.NewAddressSetDAO(dash.dbSession)
+	err = asDAO.Delete(ctx, nil, das.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error deleting Address Set from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Address Set due to DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.NoContent(http.StatusNoContent)
+}
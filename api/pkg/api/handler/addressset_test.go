@@ -0,0 +1,259 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func TestValidateAddressSetRequest(t *testing.T) {
+	org := "test-org"
+	newUser := func(roles ...string) *cdbm.User {
+		return &cdbm.User{
+			StarfleetID: cdb.GetStrPtr("test-user"),
+			OrgData: cdbm.OrgData{
+				org: cdbm.Org{
+					Name:  org,
+					Roles: roles,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		user         *cdbm.User
+		org          string
+		expectedCode int
+	}{
+		{
+			name: "test Tenant Admin is allowed to manage Address Sets",
+			user: newUser("FORGE_TENANT_ADMIN"),
+			org:  org,
+		},
+		{
+			name:         "test Provider Admin is not allowed to manage Address Sets",
+			user:         newUser("FORGE_PROVIDER_ADMIN"),
+			org:          org,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "test User of other org is not allowed to manage Address Sets",
+			user:         newUser("FORGE_TENANT_ADMIN"),
+			org:          "other-org",
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			apiErr := validateAddressSetRequest(tc.user, tc.org)
+			if tc.expectedCode == 0 {
+				assert.Nil(t, apiErr)
+				return
+			}
+			require.NotNil(t, apiErr)
+			assert.Equal(t, tc.expectedCode, apiErr.Code)
+		})
+	}
+}
+
+func TestAddressSetHandler_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.AddressSet)(nil))
+	require.NoError(t, err)
+
+	cfg := common.GetTestConfig()
+
+	org := "test-org"
+	user := common.TestBuildUser(t, dbSession, uuid.NewString(), org, []string{"FORGE_TENANT_ADMIN"})
+	ip := common.TestBuildInfrastructureProvider(t, dbSession, "test-provider", "test-provider-org", user)
+	tn := common.TestBuildTenant(t, dbSession, "test-tenant", org, user)
+	site := common.TestBuildSite(t, dbSession, ip, "test-site", user)
+	_ = common.TestBuildTenantSite(t, dbSession, tn, site, user)
+
+	otherOrg := "other-org"
+	otherUser := common.TestBuildUser(t, dbSession, uuid.NewString(), otherOrg, []string{"FORGE_TENANT_ADMIN"})
+	otherTn := common.TestBuildTenant(t, dbSession, "other-tenant", otherOrg, otherUser)
+
+	asDAO := cdbm.NewAddressSetDAO(dbSession)
+	otherAS, err := asDAO.Create(ctx, nil, cdbm.AddressSetCreateInput{Name: "other", TenantOrg: otherOrg, TenantID: otherTn.ID, Prefixes: []string{"10.0.0.0/8"}, CreatedBy: otherUser.ID})
+	require.NoError(t, err)
+
+	tmc := &tmocks.Client{}
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	tmc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.AnythingOfType("func(internal.Context, uuid.UUID, string) error"), mock.AnythingOfType("uuid.UUID"),
+		mock.AnythingOfType("string")).Return(wrun, nil)
+
+	newContext := func(method string, path string, body string, paramNames []string, paramValues []string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		ec := e.NewContext(req, rec)
+		ec.SetParamNames(append([]string{"orgName"}, paramNames...)...)
+		ec.SetParamValues(append([]string{org}, paramValues...)...)
+		ec.Set("user", user)
+		ec.SetRequest(ec.Request().WithContext(ctx))
+		return ec, rec
+	}
+
+	// Create
+	createTests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name:           "test create Address Set success",
+			body:           `{"name": "corp", "prefixes": ["10.0.0.0/8", "192.168.0.0/16"]}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "test create Address Set with duplicate name fails",
+			body:           `{"name": "corp", "prefixes": ["172.16.0.0/12"]}`,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "test create Address Set with invalid prefix fails",
+			body:           `{"name": "bad", "prefixes": ["10.0.0.0/33"]}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create Address Set with same name as Address Set of other Tenant success",
+			body:           `{"name": "other"}`,
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	var created *model.APIAddressSet
+	for _, tc := range createTests {
+		t.Run(tc.name, func(t *testing.T) {
+			ec, rec := newContext(http.MethodPost, "/address-set", tc.body, nil, nil)
+
+			err := NewCreateAddressSetHandler(dbSession, tmc, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus == http.StatusCreated && created == nil {
+				created = &model.APIAddressSet{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), created))
+				assert.Equal(t, []string{"10.0.0.0/8", "192.168.0.0/16"}, created.Prefixes)
+				assert.Equal(t, tn.ID.String(), created.TenantID)
+			}
+		})
+	}
+	require.NotNil(t, created)
+
+	// GetAll only returns Address Sets of the Tenant
+	ec, rec := newContext(http.MethodGet, "/address-set?query=corp", "", nil, nil)
+	require.NoError(t, NewGetAllAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code)
+	all := []model.APIAddressSet{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	require.Len(t, all, 1)
+	assert.Equal(t, created.ID, all[0].ID)
+	assert.NotEmpty(t, rec.Header().Get("X-Pagination"))
+
+	// Get
+	ec, rec = newContext(http.MethodGet, "/address-set/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewGetAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Address Sets of other Tenants are not found
+	ec, rec = newContext(http.MethodGet, "/address-set/"+otherAS.ID.String(), "", []string{"id"}, []string{otherAS.ID.String()})
+	require.NoError(t, NewGetAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Reference the Address Set from a Network Security Group rule
+	nsgDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+	nsg, err := nsgDAO.Create(ctx, nil, cdbm.NetworkSecurityGroupCreateInput{
+		Name: "referencing", SiteID: site.ID, TenantOrg: org, TenantID: tn.ID, Status: cdbm.NetworkSecurityGroupStatusReady, CreatedByID: user.ID,
+		Rules: []*cdbm.NetworkSecurityGroupRule{
+			{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+					Id:             cdb.GetStrPtr("from-corp"),
+					DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+				},
+				SourceAddressSetID: cdb.GetStrPtr(created.ID),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	// Updating the prefixes re-syncs the referencing Network Security Group
+	ec, rec = newContext(http.MethodPatch, "/address-set/"+created.ID, `{"prefixes": ["10.0.0.0/8"]}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated := &model.APIAddressSet{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), updated))
+	assert.Equal(t, []string{"10.0.0.0/8"}, updated.Prefixes)
+	tmc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+
+	// Updating only the description does not
+	ec, rec = newContext(http.MethodPatch, "/address-set/"+created.ID, `{"description": "Corporate networks"}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	tmc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+
+	// Renaming to the name of another Address Set of the Tenant fails
+	ec, rec = newContext(http.MethodPatch, "/address-set/"+created.ID, `{"name": "other"}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Referenced Address Sets cannot be deleted
+	ec, rec = newContext(http.MethodDelete, "/address-set/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewDeleteAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusPreconditionFailed, rec.Code)
+
+	require.NoError(t, nsgDAO.Delete(ctx, nil, cdbm.NetworkSecurityGroupDeleteInput{NetworkSecurityGroupID: nsg.ID, UpdatedByID: user.ID}))
+
+	ec, rec = newContext(http.MethodDelete, "/address-set/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewDeleteAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	ec, rec = newContext(http.MethodGet, "/address-set/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewGetAddressSetHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
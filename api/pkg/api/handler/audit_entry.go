@@ -18,11 +18,14 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	goset "github.com/deckarep/golang-set/v2"
 	"github.com/google/uuid"
@@ -35,6 +38,7 @@ import (
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	wutil "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
 	"go.opentelemetry.io/otel/attribute"
 )
 
@@ -59,6 +63,14 @@ func NewGetAllAuditEntryHandler(dbSession *cdb.Session) GetAllAuditEntryHandler
 // @Accept json
 // @Produce json
 // @Security ApiKeyAuth
+// @Param failedOnly query boolean false "Only return AuditEntries for failed requests"
+// @Param userId query string false "Filter by User ID, can be specified multiple times"
+// @Param endpoint query string false "Filter by endpoint, '*' matches any sequence of characters"
+// @Param method query string false "Filter by HTTP method, can be specified multiple times"
+// @Param statusCodeMin query integer false "Filter by minimum response status code"
+// @Param statusCodeMax query integer false "Filter by maximum response status code"
+// @Param startTime query string false "Filter by request time, inclusive, in RFC 3339 format"
+// @Param endTime query string false "Filter by request time, exclusive, in RFC 3339 format"
 // @Success 200 {array} []model.APIAuditEntry
 // @Router /v2/org/{org}/carbide/audit [get]
 func (gaaeh GetAllAuditEntryHandler) Handle(c echo.Context) error {
@@ -104,17 +116,10 @@ func (gaaeh GetAllAuditEntryHandler) Handle(c echo.Context) error {
 	}
 
 	// build filter
-	filter := cdbm.AuditEntryFilterInput{OrgName: &orgName}
-
-	// Check `failedOnly` in query
-	failedOnly := false
-	qpf := c.QueryParam("failedOnly")
-	if qpf != "" {
-		failedOnly, err = strconv.ParseBool(qpf)
-		if err != nil {
-			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid value specified for `failedOnly` query param", nil)
-		}
-		filter.FailedOnly = &failedOnly
+	filter, err := getAuditEntryFilterFromQuery(c, orgName)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating query params")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
 	}
 
 	aeDAO := cdbm.NewAuditEntryDAO(gaaeh.dbSession)
@@ -125,26 +130,11 @@ func (gaaeh GetAllAuditEntryHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve AuditEntries", nil)
 	}
 
-	// build set of user IDs
-	userIDs := goset.NewSet[uuid.UUID]()
-	for _, dbae := range dbAuditEntries {
-		if dbae.UserID != nil {
-			userIDs.Add(*dbae.UserID)
-		}
-	}
-
-	// build map of users
-	dbUsersMap := make(map[uuid.UUID]*cdbm.User)
-	userDAO := cdbm.NewUserDAO(gaaeh.dbSession)
-	dbUsers, _, err := userDAO.GetAll(ctx, nil, cdbm.UserFilterInput{UserIDs: userIDs.ToSlice()},
-		paginator.PageInput{Limit: cdb.GetIntPtr(paginator.TotalLimit)}, nil)
+	dbUsersMap, err := getAuditEntryUsers(ctx, gaaeh.dbSession, dbAuditEntries)
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving Users from DB")
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Users", nil)
 	}
-	for i := 0; i < len(dbUsers); i++ {
-		dbUsersMap[dbUsers[i].ID] = &dbUsers[i]
-	}
 
 	// Create response
 	apiAuditEntries := []model.APIAuditEntry{}
@@ -260,3 +250,261 @@ func (gaeh GetAuditEntryHandler) Handle(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, apiAuditEntry)
 }
+
+const (
+	// AuditEntryExportFormatJSONLines exports one JSON encoded AuditEntry per line
+	AuditEntryExportFormatJSONLines = "jsonl"
+	// AuditEntryExportFormatCEF exports one ArcSight Common Event Format record per line
+	AuditEntryExportFormatCEF = "cef"
+
+	// auditEntryExportPageSize is the number of AuditEntries read from DB per page while exporting
+	auditEntryExportPageSize = 1000
+)
+
+// GetAuditEntryExportHandler is the API Handler for exporting AuditEntries
+type GetAuditEntryExportHandler struct {
+	dbSession  *cdb.Session
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAuditEntryExportHandler initializes and returns a new handler for exporting AuditEntries
+func NewGetAuditEntryExportHandler(dbSession *cdb.Session) GetAuditEntryExportHandler {
+	return GetAuditEntryExportHandler{
+		dbSession:  dbSession,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Export AuditEntries
+// @Description Stream all AuditEntries in the org matching the filters, oldest first
+// @Tags audit
+// @Produce plain
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param format query string false "Export format, 'jsonl' (default) or 'cef'"
+// @Param failedOnly query boolean false "Only return AuditEntries for failed requests"
+// @Param userId query string false "Filter by User ID, can be specified multiple times"
+// @Param endpoint query string false "Filter by endpoint, '*' matches any sequence of characters"
+// @Param method query string false "Filter by HTTP method, can be specified multiple times"
+// @Param statusCodeMin query integer false "Filter by minimum response status code"
+// @Param statusCodeMax query integer false "Filter by maximum response status code"
+// @Param startTime query string false "Filter by request time, inclusive, in RFC 3339 format"
+// @Param endTime query string false "Filter by request time, exclusive, in RFC 3339 format"
+// @Success 200 {string} string
+// @Router /v2/org/{org}/carbide/audit/export [get]
+func (gaeeh GetAuditEntryExportHandler) Handle(c echo.Context) error {
+	orgName, dbUser, ctx, logger, handlerSpan := common.SetupHandler("AuditEntry", "Export", c, gaeeh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, orgName)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", orgName), nil)
+	}
+
+	// Validate role, only Provider or Tenant Admins are allowed to export audit log
+	ok = auth.ValidateUserRoles(dbUser, orgName, nil, auth.ProviderAdminRole, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Provider/Tenant Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Admin role with org", nil)
+	}
+
+	format := AuditEntryExportFormatJSONLines
+	if qf := c.QueryParam("format"); qf != "" {
+		format = strings.ToLower(qf)
+	}
+
+	var contentType string
+	switch format {
+	case AuditEntryExportFormatJSONLines:
+		contentType = "application/x-ndjson"
+	case AuditEntryExportFormatCEF:
+		contentType = echo.MIMETextPlainCharsetUTF8
+	default:
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Invalid value specified for `format` query param, must be one of: %s, %s", AuditEntryExportFormatJSONLines, AuditEntryExportFormatCEF), nil)
+	}
+
+	gaeeh.tracerSpan.SetAttribute(handlerSpan, attribute.String("format", format), logger)
+
+	// build filter
+	filter, err := getAuditEntryFilterFromQuery(c, orgName)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating query params")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+
+	aeDAO := cdbm.NewAuditEntryDAO(gaeeh.dbSession)
+	orderBy := &paginator.OrderBy{Field: cdbm.AuditEntryOrderByDefault, Order: paginator.OrderAscending}
+
+	// Read the first page before writing the response so DB errors can still be reported
+	page := paginator.PageInput{Offset: cdb.GetIntPtr(0), Limit: cdb.GetIntPtr(auditEntryExportPageSize), OrderBy: orderBy}
+	dbAuditEntries, _, err := aeDAO.GetAll(ctx, nil, filter, page)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving AuditEntries from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve AuditEntries", nil)
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, contentType)
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"audit-%s.%s\"", orgName, format))
+	resp.WriteHeader(http.StatusOK)
+
+	exported := 0
+	for {
+		dbUsersMap, err := getAuditEntryUsers(ctx, gaeeh.dbSession, dbAuditEntries)
+		if err != nil {
+			// Response has already been started, nothing else can be reported to the client
+			logger.Error().Err(err).Msg("error retrieving Users from DB, aborting export")
+			return nil
+		}
+
+		for _, dbae := range dbAuditEntries {
+			var dbu *cdbm.User
+			if dbae.UserID != nil {
+				dbu = dbUsersMap[*dbae.UserID]
+			}
+
+			var line []byte
+			if format == AuditEntryExportFormatCEF {
+				line = []byte(wutil.FormatAuditEntryCEF(dbae, dbu))
+			} else {
+				line, err = json.Marshal(model.NewAPIAuditEntry(dbae, dbu))
+				if err != nil {
+					logger.Error().Err(err).Str("AuditEntryID", dbae.ID.String()).Msg("error marshaling AuditEntry, aborting export")
+					return nil
+				}
+			}
+
+			if _, err = resp.Write(append(line, '\n')); err != nil {
+				logger.Warn().Err(err).Msg("error writing export response, client may have disconnected")
+				return nil
+			}
+			exported++
+		}
+		resp.Flush()
+
+		if len(dbAuditEntries) < auditEntryExportPageSize {
+			break
+		}
+
+		page.Offset = cdb.GetIntPtr(*page.Offset + auditEntryExportPageSize)
+		dbAuditEntries, _, err = aeDAO.GetAll(ctx, nil, filter, page)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving AuditEntries from DB, aborting export")
+			return nil
+		}
+	}
+
+	logger.Info().Int("Exported", exported).Msg("finishing API handler")
+
+	return nil
+}
+
+// getAuditEntryFilterFromQuery builds an AuditEntry filter for the org from the request query params
+func getAuditEntryFilterFromQuery(c echo.Context, orgName string) (cdbm.AuditEntryFilterInput, error) {
+	filter := cdbm.AuditEntryFilterInput{OrgName: &orgName}
+	qParams := c.QueryParams()
+
+	// Check `failedOnly` in query
+	if qpf := c.QueryParam("failedOnly"); qpf != "" {
+		failedOnly, err := strconv.ParseBool(qpf)
+		if err != nil {
+			return filter, errors.New("Invalid value specified for `failedOnly` query param")
+		}
+		filter.FailedOnly = &failedOnly
+	}
+
+	for _, qpu := range qParams["userId"] {
+		userID, err := uuid.Parse(qpu)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid value specified for `userId` query param: %s", qpu)
+		}
+		filter.UserIDs = append(filter.UserIDs, userID)
+	}
+
+	if qpe := c.QueryParam("endpoint"); qpe != "" {
+		filter.EndpointPattern = &qpe
+	}
+
+	for _, qpm := range qParams["method"] {
+		method := strings.ToUpper(qpm)
+		switch method {
+		case http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			filter.Methods = append(filter.Methods, method)
+		default:
+			return filter, fmt.Errorf("Invalid value specified for `method` query param: %s", qpm)
+		}
+	}
+
+	for name, target := range map[string]**int{"statusCodeMin": &filter.StatusCodeMin, "statusCodeMax": &filter.StatusCodeMax} {
+		qps := c.QueryParam(name)
+		if qps == "" {
+			continue
+		}
+		statusCode, err := strconv.Atoi(qps)
+		if err != nil || statusCode < 100 || statusCode > 599 {
+			return filter, fmt.Errorf("Invalid value specified for `%s` query param, must be a status code between 100 and 599", name)
+		}
+		*target = &statusCode
+	}
+	if filter.StatusCodeMin != nil && filter.StatusCodeMax != nil && *filter.StatusCodeMin > *filter.StatusCodeMax {
+		return filter, errors.New("`statusCodeMin` query param must not be greater than `statusCodeMax`")
+	}
+
+	for name, target := range map[string]**time.Time{"startTime": &filter.StartTime, "endTime": &filter.EndTime} {
+		qpt := c.QueryParam(name)
+		if qpt == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, qpt)
+		if err != nil {
+			return filter, fmt.Errorf("Invalid value specified for `%s` query param, must be in RFC 3339 format", name)
+		}
+		*target = &t
+	}
+	if filter.StartTime != nil && filter.EndTime != nil && !filter.StartTime.Before(*filter.EndTime) {
+		return filter, errors.New("`startTime` query param must be before `endTime`")
+	}
+
+	return filter, nil
+}
+
+// getAuditEntryUsers retrieves the Users who made the requests recorded in the given AuditEntries, keyed by ID
+func getAuditEntryUsers(ctx context.Context, dbSession *cdb.Session, dbAuditEntries []cdbm.AuditEntry) (map[uuid.UUID]*cdbm.User, error) {
+	// build set of user IDs
+	userIDs := goset.NewSet[uuid.UUID]()
+	for _, dbae := range dbAuditEntries {
+		if dbae.UserID != nil {
+			userIDs.Add(*dbae.UserID)
+		}
+	}
+
+	// build map of users
+	dbUsersMap := make(map[uuid.UUID]*cdbm.User)
+	if userIDs.Cardinality() == 0 {
+		return dbUsersMap, nil
+	}
+
+	userDAO := cdbm.NewUserDAO(dbSession)
+	dbUsers, _, err := userDAO.GetAll(ctx, nil, cdbm.UserFilterInput{UserIDs: userIDs.ToSlice()},
+		paginator.PageInput{Limit: cdb.GetIntPtr(paginator.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(dbUsers); i++ {
+		dbUsersMap[dbUsers[i].ID] = &dbUsers[i]
+	}
+
+	return dbUsersMap, nil
+}
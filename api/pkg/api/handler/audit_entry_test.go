@@ -21,6 +21,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
@@ -33,7 +34,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestGetAllAuditEntryHandler_Handle(t *testing.T) {
@@ -139,6 +142,88 @@ func TestGetAllAuditEntryHandler_Handle(t *testing.T) {
 			wantTotalCount: 12,
 			wantRespCode:   http.StatusOK,
 		},
+		{
+			name: "get all for provider org filtered by user, method and status code range",
+			fields: fields{
+				dbSession: dbSession,
+			},
+			args: args{
+				org:  org1,
+				user: adminUser1,
+				query: url.Values{
+					"userId":        {org1user.ID.String()},
+					"method":        {"post", "PATCH"},
+					"statusCodeMin": {"400"},
+					"statusCodeMax": {"499"},
+				},
+			},
+			wantCount:      12,
+			wantTotalCount: 12,
+			wantRespCode:   http.StatusOK,
+		},
+		{
+			name: "get all for provider org filtered by endpoint and time window",
+			fields: fields{
+				dbSession: dbSession,
+			},
+			args: args{
+				org:  org1,
+				user: adminUser1,
+				query: url.Values{
+					"endpoint":  {"/v2/org/*/carbide/ep"},
+					"startTime": {time.Now().Add(-time.Hour).Format(time.RFC3339)},
+					"endTime":   {time.Now().Add(time.Hour).Format(time.RFC3339)},
+				},
+			},
+			wantCount:      paginator.DefaultLimit,
+			wantTotalCount: 25,
+			wantRespCode:   http.StatusOK,
+		},
+		{
+			name: "get all for provider org with no entries in time window",
+			fields: fields{
+				dbSession: dbSession,
+			},
+			args: args{
+				org:  org1,
+				user: adminUser1,
+				query: url.Values{
+					"endTime": {time.Now().Add(-time.Hour).Format(time.RFC3339)},
+				},
+			},
+			wantCount:      0,
+			wantTotalCount: 0,
+			wantRespCode:   http.StatusOK,
+		},
+		{
+			name: "get all for provider org with invalid status code range",
+			fields: fields{
+				dbSession: dbSession,
+			},
+			args: args{
+				org:  org1,
+				user: adminUser1,
+				query: url.Values{
+					"statusCodeMin": {"500"},
+					"statusCodeMax": {"400"},
+				},
+			},
+			wantRespCode: http.StatusBadRequest,
+		},
+		{
+			name: "get all for provider org with invalid start time",
+			fields: fields{
+				dbSession: dbSession,
+			},
+			args: args{
+				org:  org1,
+				user: adminUser1,
+				query: url.Values{
+					"startTime": {"yesterday"},
+				},
+			},
+			wantRespCode: http.StatusBadRequest,
+		},
 		{
 			name: "get all for provider org using wrong admin user",
 			fields: fields{
@@ -406,3 +491,206 @@ func TestGetAuditEntryHandler_Handle(t *testing.T) {
 		})
 	}
 }
+
+func TestGetAuditEntryExportHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+	common.TestSetupSchema(t, dbSession)
+
+	// orgs
+	org1 := "test-org-1"
+	org2 := "test-org-2"
+
+	// build users to execute export
+	adminUser1 := common.TestBuildUser(t, dbSession, "admin-1", org1, []string{"FORGE_PROVIDER_ADMIN"})
+	nonAdminUser1 := common.TestBuildUser(t, dbSession, "view-1", org1, []string{"FORGE_PROVIDER_VIEWER"})
+
+	// build users for audit entries
+	org1user := common.TestBuildUser(t, dbSession, "org-1-user", org1, []string{"FORGE_PROVIDER_ADMIN"})
+	org2user := common.TestBuildUser(t, dbSession, "org-2-user", org2, []string{"FORGE_TENANT_ADMIN"})
+
+	// build audit entries
+	for i := 0; i < 30; i++ {
+		if i%3 == 0 {
+			common.TestBuildAuditEntry(t, dbSession, org1, &org1user.ID, http.StatusInternalServerError)
+		} else if i%3 == 1 {
+			common.TestBuildAuditEntry(t, dbSession, org1, &org1user.ID, http.StatusCreated)
+		} else {
+			common.TestBuildAuditEntry(t, dbSession, org2, &org2user.ID, http.StatusCreated)
+		}
+	}
+
+	// Setup echo server/context
+	e := echo.New()
+
+	// OTEL Spanner configuration
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	tests := []struct {
+		name            string
+		query           url.Values
+		user            *cdbm.User
+		wantRespCode    int
+		wantContentType string
+		wantLines       int
+	}{
+		{
+			name:            "export as JSON lines",
+			user:            adminUser1,
+			wantRespCode:    http.StatusOK,
+			wantContentType: "application/x-ndjson",
+			wantLines:       20,
+		},
+		{
+			name:            "export failed requests as CEF",
+			query:           url.Values{"format": {"cef"}, "failedOnly": {"true"}},
+			user:            adminUser1,
+			wantRespCode:    http.StatusOK,
+			wantContentType: echo.MIMETextPlainCharsetUTF8,
+			wantLines:       10,
+		},
+		{
+			name:         "export with invalid format",
+			query:        url.Values{"format": {"xml"}},
+			user:         adminUser1,
+			wantRespCode: http.StatusBadRequest,
+		},
+		{
+			name:         "export with invalid method",
+			query:        url.Values{"method": {"TRACE"}},
+			user:         adminUser1,
+			wantRespCode: http.StatusBadRequest,
+		},
+		{
+			name:         "export using non-admin user",
+			user:         nonAdminUser1,
+			wantRespCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := GetAuditEntryExportHandler{
+				dbSession: dbSession,
+			}
+
+			path := fmt.Sprintf("/v2/org/%s/carbide/audit/export?%s", org1, tt.query.Encode())
+
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(org1)
+			ec.Set("user", tt.user)
+
+			ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := handler.Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantRespCode, rec.Code)
+
+			if tt.wantRespCode != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tt.wantContentType, rec.Header().Get(echo.HeaderContentType))
+
+			lines := strings.Split(strings.TrimSuffix(rec.Body.String(), "\n"), "\n")
+			require.Equal(t, tt.wantLines, len(lines))
+
+			for _, line := range lines {
+				if tt.query.Get("format") == "cef" {
+					assert.True(t, strings.HasPrefix(line, "CEF:0|"))
+					assert.Contains(t, line, "outcome=failure")
+					continue
+				}
+
+				var ae model.APIAuditEntry
+				require.NoError(t, json.Unmarshal([]byte(line), &ae))
+				assert.Equal(t, org1, ae.OrgName)
+				require.NotNil(t, ae.User)
+			}
+		})
+	}
+}
+
+func TestGetAuditEntryFilterFromQuery(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name    string
+		query   url.Values
+		want    cdbm.AuditEntryFilterInput
+		wantErr bool
+	}{
+		{
+			name:  "no filters",
+			query: url.Values{},
+			want:  cdbm.AuditEntryFilterInput{},
+		},
+		{
+			name: "all filters",
+			query: url.Values{
+				"failedOnly":    {"true"},
+				"userId":        {userID.String()},
+				"endpoint":      {"/v2/org/*/carbide/instance*"},
+				"method":        {"post", "DELETE"},
+				"statusCodeMin": {"400"},
+				"statusCodeMax": {"499"},
+				"startTime":     {"2026-01-01T00:00:00Z"},
+				"endTime":       {"2026-01-02T00:00:00Z"},
+			},
+			want: cdbm.AuditEntryFilterInput{
+				FailedOnly:      cdb.GetBoolPtr(true),
+				UserIDs:         []uuid.UUID{userID},
+				EndpointPattern: cdb.GetStrPtr("/v2/org/*/carbide/instance*"),
+				Methods:         []string{http.MethodPost, http.MethodDelete},
+				StatusCodeMin:   cdb.GetIntPtr(400),
+				StatusCodeMax:   cdb.GetIntPtr(499),
+				StartTime:       cdb.GetTimePtr(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)),
+				EndTime:         cdb.GetTimePtr(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:    "invalid user ID",
+			query:   url.Values{"userId": {"not-a-uuid"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid method",
+			query:   url.Values{"method": {"TRACE"}},
+			wantErr: true,
+		},
+		{
+			name:    "status code out of range",
+			query:   url.Values{"statusCodeMax": {"600"}},
+			wantErr: true,
+		},
+		{
+			name:    "inverted time window",
+			query:   url.Values{"startTime": {"2026-01-02T00:00:00Z"}, "endTime": {"2026-01-01T00:00:00Z"}},
+			wantErr: true,
+		},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/v2/org/test-org/carbide/audit?"+tt.query.Encode(), nil)
+			ec := e.NewContext(req, httptest.NewRecorder())
+
+			got, err := getAuditEntryFilterFromQuery(ec, "test-org")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			tt.want.OrgName = cdb.GetStrPtr("test-org")
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
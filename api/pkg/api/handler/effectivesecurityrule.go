@@ -0,0 +1,271 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	common "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	cwu "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
+)
+
+// validateEffectiveSecurityRuleAccess validates that the user is a Tenant Admin of the org
+func validateEffectiveSecurityRuleAccess(logger zerolog.Logger, dbUser *cdbm.User, org string) *cutil.APIError {
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Only Tenant Admins are allowed to interact with NetworkSecurityGroup endpoints
+	if !auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole) {
+		logger.Warn().Msg("user does not have Tenant Admin role, access denied")
+		return cutil.NewAPIError(http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	return nil
+}
+
+// getInstanceEffectiveSecurityRules retrieves an Instance of the org's Tenant along with the Network Security Group rules that apply to it
+func getInstanceEffectiveSecurityRules(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, org string, instanceStrID string) (*cdbm.Instance, *model.APIInstanceEffectiveSecurityRules, *cutil.APIError) {
+	instanceID, err := uuid.Parse(instanceStrID)
+	if err != nil {
+		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Instance ID specified in request", nil)
+	}
+
+	instanceDAO := cdbm.NewInstanceDAO(dbSession)
+	instance, err := instanceDAO.GetByID(ctx, nil, instanceID, []string{cdbm.VpcRelationName, cdbm.NetworkSecurityGroupRelationName})
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Instance with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Instance from DB")
+		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Instance", nil)
+	}
+
+	// Get Tenant for this org
+	tnDAO := cdbm.NewTenantDAO(dbSession)
+	tenants, err := tnDAO.GetAllByOrg(ctx, nil, org, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Tenant for this org")
+		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Tenant", nil)
+	}
+	if len(tenants) == 0 {
+		return nil, nil, cutil.NewAPIError(http.StatusForbidden, "Org does not have a Tenant associated", nil)
+	}
+
+	// Check if Instance belongs to Tenant
+	if instance.TenantID != tenants[0].ID {
+		return nil, nil, cutil.NewAPIError(http.StatusForbidden, "Instance does not belong to current Tenant", nil)
+	}
+
+	// The Instance's own Network Security Group is loaded as a relation, the VPC's has to be retrieved separately
+	var vpcNSG *cdbm.NetworkSecurityGroup
+	if instance.Vpc != nil && instance.Vpc.NetworkSecurityGroupID != nil {
+		nsgDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+		vpcNSG, err = nsgDAO.GetByID(ctx, nil, *instance.Vpc.NetworkSecurityGroupID, nil)
+		if err != nil {
+			if !errors.Is(err, cdb.ErrDoesNotExist) {
+				logger.Error().Err(err).Msg("error retrieving Network Security Group for VPC from DB")
+				return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Network Security Group for Instance's VPC", nil)
+			}
+			logger.Warn().Str("NetworkSecurityGroupID", *instance.Vpc.NetworkSecurityGroupID).Msg("Network Security Group attached to VPC does not exist")
+			vpcNSG = nil
+		}
+	}
+
+	// Resolve Address Set and Network Security Group references in the rules
+	referencingNSGs := []*cdbm.NetworkSecurityGroup{}
+	for _, nsg := range []*cdbm.NetworkSecurityGroup{instance.NetworkSecurityGroup, vpcNSG} {
+		if nsg != nil {
+			referencingNSGs = append(referencingNSGs, nsg)
+		}
+	}
+
+	prefixesByReferenceID, err := cwu.GetNetworkSecurityGroupReferencePrefixes(ctx, nil, dbSession, referencingNSGs...)
+	if err != nil {
+		logger.Error().Err(err).Msg("error resolving references in Network Security Group rules for Instance")
+		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to resolve references in Network Security Group rules for Instance", nil)
+	}
+
+	aesr, err := model.NewAPIInstanceEffectiveSecurityRules(instance, instance.NetworkSecurityGroup, vpcNSG, prefixesByReferenceID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error converting Network Security Group rules for Instance")
+		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to prepare Network Security Group rules for Instance", nil)
+	}
+
+	return instance, aesr, nil
+}
+
+// ~~~~~ Get Instance Effective Security Rules Handler ~~~~~ //
+
+// GetInstanceEffectiveSecurityRulesHandler is the API Handler for getting the Network Security Group rules that apply to an Instance
+type GetInstanceEffectiveSecurityRulesHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetInstanceEffectiveSecurityRulesHandler initializes and returns a new handler for getting the Network Security Group rules that apply to an Instance
+func NewGetInstanceEffectiveSecurityRulesHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetInstanceEffectiveSecurityRulesHandler {
+	return GetInstanceEffectiveSecurityRulesHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get the effective Network Security Group rules of an Instance
+// @Description Get the Network Security Group rules that apply to an Instance in evaluation order. A Network Security Group attached to the Instance takes precedence over the one attached to its VPC.
+// @Tags Instance
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance"
+// @Success 200 {object} model.APIInstanceEffectiveSecurityRules
+// @Router /v2/org/{org}/carbide/instance/{id}/effective-security-rules [get]
+func (giesrh GetInstanceEffectiveSecurityRulesHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("Instance", "GetEffectiveSecurityRules", c, giesrh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateEffectiveSecurityRuleAccess(logger, dbUser, org); apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	instanceStrID := c.Param("id")
+	giesrh.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_id", instanceStrID), logger)
+
+	_, aesr, apiErr := getInstanceEffectiveSecurityRules(ctx, logger, giesrh.dbSession, org, instanceStrID)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+	return c.JSON(http.StatusOK, aesr)
+}
+
+// ~~~~~ Evaluate Network Security Group Handler ~~~~~ //
+
+// EvaluateNetworkSecurityGroupHandler is the API Handler for evaluating traffic against the Network Security Group rules that apply to an Instance
+type EvaluateNetworkSecurityGroupHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewEvaluateNetworkSecurityGroupHandler initializes and returns a new handler for evaluating traffic against Network Security Group rules
+func NewEvaluateNetworkSecurityGroupHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) EvaluateNetworkSecurityGroupHandler {
+	return EvaluateNetworkSecurityGroupHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Evaluate traffic against Network Security Group rules
+// @Description Decide whether traffic to or from an Instance is allowed by the Network Security Group rules that apply to it, and by which rule
+// @Tags networksecuritygroup
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APINetworkSecurityGroupEvaluateRequest true "Network Security Group evaluation request"
+// @Success 200 {object} model.APINetworkSecurityGroupEvaluation
+// @Router /v2/org/{org}/carbide/network-security-group/evaluate [post]
+func (ensgh EvaluateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("NetworkSecurityGroup", "Evaluate", c, ensgh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateEffectiveSecurityRuleAccess(logger, dbUser, org); apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APINetworkSecurityGroupEvaluateRequest{}
+	if err := c.Bind(&apiRequest); err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	if err := apiRequest.Validate(); err != nil {
+		logger.Warn().Err(err).Msg("error validating Network Security Group evaluation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Network Security Group evaluation request data", err)
+	}
+
+	ensgh.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_id", apiRequest.InstanceID), logger)
+
+	instance, aesr, apiErr := getInstanceEffectiveSecurityRules(ctx, logger, ensgh.dbSession, org, apiRequest.InstanceID)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// The Instance's addresses are used for its side of the traffic unless the request specifies it
+	ifcDAO := cdbm.NewInterfaceDAO(ensgh.dbSession)
+	ifcs, _, err := ifcDAO.GetAll(ctx, nil, cdbm.InterfaceFilterInput{InstanceIDs: []uuid.UUID{instance.ID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Interfaces for Instance from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Interfaces for Instance", nil)
+	}
+
+	instanceAddresses := []string{}
+	for _, ifc := range ifcs {
+		instanceAddresses = append(instanceAddresses, ifc.IPAddresses...)
+	}
+
+	evaluation := aesr.Evaluate(&apiRequest, instanceAddresses)
+
+	logger.Info().Bool("Allowed", evaluation.Allowed).Msg("finishing API handler")
+	return c.JSON(http.StatusOK, evaluation)
+}
@@ -0,0 +1,316 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/otelecho"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func testEffectiveSecurityRuleBuildRule(direction cwssaws.NetworkSecurityGroupRuleDirection, action cwssaws.NetworkSecurityGroupRuleAction, priority uint32, srcPrefix string, dstPort int) *cdbm.NetworkSecurityGroupRule {
+	return &cdbm.NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             cdb.GetStrPtr(uuid.NewString()),
+			Direction:      direction,
+			Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP,
+			Action:         action,
+			Priority:       priority,
+			DstPortStart:   getIntPtrToUint32Ptr(cdb.GetIntPtr(dstPort)),
+			DstPortEnd:     getIntPtrToUint32Ptr(cdb.GetIntPtr(dstPort)),
+			SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: srcPrefix},
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+	}
+}
+
+func TestEffectiveSecurityRuleHandlers(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org"
+	ipOrgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+	tnOrgRoles := []string{"FORGE_TENANT_ADMIN"}
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.New().String(), ipOrg, ipOrgRoles)
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+
+	st1 := testInstanceBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu1 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-2", tnOrg1, tnOrgRoles)
+	tn1 := testInstanceBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+	testBuildTenantSiteAssociation(t, dbSession, tnOrg1, tn1.ID, st1.ID, tnu1.ID)
+
+	tnu2 := testInstanceBuildUser(t, dbSession, "test-starfleet-id-3", tnOrg2, tnOrgRoles)
+	tn2 := testInstanceBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+	assert.NotNil(t, tn2)
+
+	// The VPC allows HTTPS from anywhere, the Instance only from 10.1.0.0/16 after denying 10.1.2.0/24
+	vpcNSG := testBuildNetworkSecurityGroup(t, dbSession, "vpc-nsg", tn1, st1, cdbm.NetworkSecurityGroupStatusReady)
+	vpcNSG.Rules = []*cdbm.NetworkSecurityGroupRule{
+		testEffectiveSecurityRuleBuildRule(cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS, cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT, 100, "0.0.0.0/0", 443),
+	}
+	testUpdateNetworkSecurityGroup(t, dbSession, vpcNSG)
+
+	instNSG := testBuildNetworkSecurityGroup(t, dbSession, "instance-nsg", tn1, st1, cdbm.NetworkSecurityGroupStatusReady)
+	instNSG.Rules = []*cdbm.NetworkSecurityGroupRule{
+		testEffectiveSecurityRuleBuildRule(cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS, cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT, 200, "10.1.0.0/16", 443),
+		testEffectiveSecurityRuleBuildRule(cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS, cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY, 100, "10.1.2.0/24", 443),
+	}
+	testUpdateNetworkSecurityGroup(t, dbSession, instNSG)
+
+	vpc1 := testVPCBuildVPC(t, dbSession, "test-vpc-1", ip, tn1, st1, nil, nil, nil, cdbm.VpcStatusReady, tnu1)
+	vpc1.NetworkSecurityGroupID = cdb.GetStrPtr(vpcNSG.ID)
+	testUpdateVPC(t, dbSession, vpc1)
+
+	al1 := testInstanceSiteBuildAllocation(t, dbSession, st1, tn1, "test-allocation-1", ipu)
+	ist1 := testInstanceBuildInstanceType(t, dbSession, ip, "test-instance-type-1", st1, cdbm.InstanceStatusReady)
+	alc1 := testInstanceSiteBuildAllocationContraints(t, dbSession, al1, cdbm.AllocationResourceTypeInstanceType, ist1.ID, cdbm.AllocationConstraintTypeReserved, 5, ipu)
+
+	mc1 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(false), nil)
+	testInstanceBuildMachineInstanceType(t, dbSession, mc1, ist1)
+	mc2 := testInstanceBuildMachine(t, dbSession, ip.ID, st1.ID, cdb.GetBoolPtr(false), nil)
+	testInstanceBuildMachineInstanceType(t, dbSession, mc2, ist1)
+
+	subnet1 := testInstanceBuildSubnet(t, dbSession, "test-subnet-1", tn1, vpc1, cdb.GetUUIDPtr(uuid.New()), cdbm.SubnetStatusReady, tnu1)
+
+	// Instance with its own Network Security Group
+	inst1 := testInstanceBuildInstance(t, dbSession, "test-instance-1", al1.ID, alc1.ID, tn1.ID, ip.ID, st1.ID, &ist1.ID, vpc1.ID, cdb.GetStrPtr(mc1.ID), nil, nil, cdbm.InstanceStatusReady)
+	inst1.NetworkSecurityGroupID = cdb.GetStrPtr(instNSG.ID)
+	testUpdateInstance(t, dbSession, inst1)
+	ifc1 := testInstanceBuildInterface(t, dbSession, inst1.ID, &subnet1.ID, nil, nil, nil, nil, true, cdbm.InterfaceStatusReady, tnu1)
+	testUpdateInterfaceWithIPs(t, dbSession, ifc1, []string{"192.168.0.10"})
+
+	// Instance that inherits the VPC's Network Security Group
+	inst2 := testInstanceBuildInstance(t, dbSession, "test-instance-2", al1.ID, alc1.ID, tn1.ID, ip.ID, st1.ID, &ist1.ID, vpc1.ID, cdb.GetStrPtr(mc2.ID), nil, nil, cdbm.InstanceStatusReady)
+
+	e := echo.New()
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+	ctx = context.WithValue(ctx, otelecho.TracerKey, tracer)
+
+	t.Run("get effective rules", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			org              string
+			user             *cdbm.User
+			instanceID       string
+			wantResponseCode int
+			wantSource       string
+			wantNSGID        string
+			wantRuleCount    int
+			wantOverridden   int
+		}{
+			{
+				name:             "instance NSG overrides VPC NSG",
+				org:              tnOrg1,
+				user:             tnu1,
+				instanceID:       inst1.ID.String(),
+				wantResponseCode: http.StatusOK,
+				wantSource:       model.APINetworkSecurityGroupSourceInstance,
+				wantNSGID:        instNSG.ID,
+				wantRuleCount:    2,
+				wantOverridden:   1,
+			},
+			{
+				name:             "VPC NSG applies",
+				org:              tnOrg1,
+				user:             tnu1,
+				instanceID:       inst2.ID.String(),
+				wantResponseCode: http.StatusOK,
+				wantSource:       model.APINetworkSecurityGroupSourceVpc,
+				wantNSGID:        vpcNSG.ID,
+				wantRuleCount:    1,
+			},
+			{
+				name:             "unknown instance",
+				org:              tnOrg1,
+				user:             tnu1,
+				instanceID:       uuid.NewString(),
+				wantResponseCode: http.StatusNotFound,
+			},
+			{
+				name:             "instance of another tenant",
+				org:              tnOrg2,
+				user:             tnu2,
+				instanceID:       inst1.ID.String(),
+				wantResponseCode: http.StatusForbidden,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				h := NewGetInstanceEffectiveSecurityRulesHandler(dbSession, tc, cfg)
+
+				path := fmt.Sprintf("/v2/org/%s/carbide/instance/%s/effective-security-rules", tt.org, tt.instanceID)
+				req := httptest.NewRequest(http.MethodGet, path, nil)
+				rec := httptest.NewRecorder()
+
+				ec := e.NewContext(req, rec)
+				ec.SetParamNames("orgName", "id")
+				ec.SetParamValues(tt.org, tt.instanceID)
+				ec.Set("user", tt.user)
+				ec.SetRequest(ec.Request().WithContext(ctx))
+
+				require.NoError(t, h.Handle(ec))
+				require.Equal(t, tt.wantResponseCode, rec.Code, rec.Body.String())
+
+				if rec.Code != http.StatusOK {
+					return
+				}
+
+				rst := &model.APIInstanceEffectiveSecurityRules{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rst))
+
+				assert.Equal(t, tt.instanceID, rst.InstanceID)
+				assert.Equal(t, tt.wantSource, rst.Source)
+				require.NotNil(t, rst.NetworkSecurityGroup)
+				assert.Equal(t, tt.wantNSGID, rst.NetworkSecurityGroup.ID)
+				require.Len(t, rst.Rules, tt.wantRuleCount)
+				assert.Len(t, rst.OverriddenRules, tt.wantOverridden)
+
+				// Rules are returned in evaluation order
+				for i := 1; i < len(rst.Rules); i++ {
+					assert.LessOrEqual(t, rst.Rules[i-1].Priority, rst.Rules[i].Priority)
+				}
+			})
+		}
+	})
+
+	t.Run("evaluate", func(t *testing.T) {
+		tests := []struct {
+			name             string
+			org              string
+			user             *cdbm.User
+			body             string
+			wantResponseCode int
+			wantAllowed      bool
+			wantMatchedNSGID string
+		}{
+			{
+				name:             "permitted by instance rule",
+				org:              tnOrg1,
+				user:             tnu1,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "tcp", "sourcePrefix": "10.1.3.0/24", "destinationPort": 443}`, inst1.ID),
+				wantResponseCode: http.StatusOK,
+				wantAllowed:      true,
+				wantMatchedNSGID: instNSG.ID,
+			},
+			{
+				name:             "denied by higher priority instance rule",
+				org:              tnOrg1,
+				user:             tnu1,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "TCP", "sourcePrefix": "10.1.2.5", "destinationPort": 443}`, inst1.ID),
+				wantResponseCode: http.StatusOK,
+				wantAllowed:      false,
+				wantMatchedNSGID: instNSG.ID,
+			},
+			{
+				name:             "denied by default, VPC rule is overridden",
+				org:              tnOrg1,
+				user:             tnu1,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "TCP", "sourcePrefix": "172.16.0.1", "destinationPort": 443}`, inst1.ID),
+				wantResponseCode: http.StatusOK,
+				wantAllowed:      false,
+			},
+			{
+				name:             "permitted by VPC rule",
+				org:              tnOrg1,
+				user:             tnu1,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "TCP", "sourcePrefix": "10.0.0.0/8", "destinationPort": 443}`, inst2.ID),
+				wantResponseCode: http.StatusOK,
+				wantAllowed:      true,
+				wantMatchedNSGID: vpcNSG.ID,
+			},
+			{
+				name:             "invalid request",
+				org:              tnOrg1,
+				user:             tnu1,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "ICMP", "destinationPort": 443}`, inst1.ID),
+				wantResponseCode: http.StatusBadRequest,
+			},
+			{
+				name:             "instance of another tenant",
+				org:              tnOrg2,
+				user:             tnu2,
+				body:             fmt.Sprintf(`{"instanceId": "%s", "protocol": "TCP", "destinationPort": 443}`, inst1.ID),
+				wantResponseCode: http.StatusForbidden,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				h := NewEvaluateNetworkSecurityGroupHandler(dbSession, tc, cfg)
+
+				path := fmt.Sprintf("/v2/org/%s/carbide/network-security-group/evaluate", tt.org)
+				req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(tt.body))
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+				rec := httptest.NewRecorder()
+
+				ec := e.NewContext(req, rec)
+				ec.SetParamNames("orgName")
+				ec.SetParamValues(tt.org)
+				ec.Set("user", tt.user)
+				ec.SetRequest(ec.Request().WithContext(ctx))
+
+				require.NoError(t, h.Handle(ec))
+				require.Equal(t, tt.wantResponseCode, rec.Code, rec.Body.String())
+
+				if rec.Code != http.StatusOK {
+					return
+				}
+
+				rst := &model.APINetworkSecurityGroupEvaluation{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rst))
+
+				assert.Equal(t, tt.wantAllowed, rst.Allowed, rst.Reason)
+				if tt.wantMatchedNSGID == "" {
+					assert.Nil(t, rst.MatchedRule)
+					return
+				}
+				require.NotNil(t, rst.MatchedRule)
+				assert.Equal(t, tt.wantMatchedNSGID, rst.MatchedRule.NetworkSecurityGroupID)
+			})
+		}
+	})
+}
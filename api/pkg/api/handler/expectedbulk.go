@@ -0,0 +1,699 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	tclient "go.temporal.io/sdk/client"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	sc "github.com/nvidia/bare-metal-manager-rest/api/pkg/client/site"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+const (
+	// ExpectedDeviceExportFormatJSON is the default format of Expected Machine, Switch and Power Shelf listings
+	ExpectedDeviceExportFormatJSON = "json"
+	// ExpectedDeviceExportFormatCSV exports all matching Expected Machines, Switches or Power Shelves in the bulk import CSV format
+	ExpectedDeviceExportFormatCSV = "csv"
+
+	// expectedDeviceCSVMediaType is the media type of bulk import request bodies and exports in CSV format
+	expectedDeviceCSVMediaType = "text/csv"
+)
+
+// isExpectedDeviceCSVRequest returns true if the request body is a bulk import CSV file
+func isExpectedDeviceCSVRequest(c echo.Context) bool {
+	mediaType, _, err := mime.ParseMediaType(c.Request().Header.Get(echo.HeaderContentType))
+	return err == nil && mediaType == expectedDeviceCSVMediaType
+}
+
+// isExpectedDeviceCSVExport returns true if the `format` query param requests a CSV export
+func isExpectedDeviceCSVExport(c echo.Context) (bool, *cutil.APIError) {
+	switch c.QueryParam("format") {
+	case "", ExpectedDeviceExportFormatJSON:
+		return false, nil
+	case ExpectedDeviceExportFormatCSV:
+		return true, nil
+	}
+	return false, cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Invalid value specified for `format` query param, must be one of: %s, %s", ExpectedDeviceExportFormatJSON, ExpectedDeviceExportFormatCSV), nil)
+}
+
+// writeExpectedDeviceCSV writes an export in the bulk import CSV format
+func writeExpectedDeviceCSV(c echo.Context, logger zerolog.Logger, filename string, header []string, records [][]string) error {
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, expectedDeviceCSVMediaType+"; charset=UTF-8")
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+	resp.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(resp)
+	if err := w.Write(header); err == nil {
+		err = w.WriteAll(records)
+	}
+	if err := w.Error(); err != nil {
+		// Response has already been started, nothing else can be reported to the client
+		logger.Warn().Err(err).Msg("error writing CSV export response, client may have disconnected")
+		return nil
+	}
+
+	logger.Info().Int("Rows", len(records)).Msg("finishing API handler")
+
+	return nil
+}
+
+// newExpectedDeviceBulkParseError returns the API error for a bulk import request body that could not be parsed
+func newExpectedDeviceBulkParseError(err error) *cutil.APIError {
+	var rowErrors validation.Errors
+	if errors.As(err, &rowErrors) {
+		return cutil.NewAPIError(http.StatusBadRequest, "Failed to parse bulk import data", rowErrors)
+	}
+	return cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Failed to parse bulk import data: %s", err), nil)
+}
+
+// expectedDeviceBulkTracker detects duplicate entries in a bulk import, both within the request and against the entries already on the Site
+type expectedDeviceBulkTracker struct {
+	// name of the serial number field, used in validation errors
+	serialField string
+	// Site ID of the first entry, all entries must belong to the same Site
+	siteID *uuid.UUID
+	// indices of the request entries by lowercase BMC MAC address and serial number
+	macs    map[string]int
+	serials map[string]int
+	// lowercase BMC MAC addresses and serial numbers already on the Site
+	existingMacs    map[string]bool
+	existingSerials map[string]bool
+}
+
+// newExpectedDeviceBulkTracker returns a new tracker for a bulk import
+func newExpectedDeviceBulkTracker(serialField string) *expectedDeviceBulkTracker {
+	return &expectedDeviceBulkTracker{
+		serialField:     serialField,
+		macs:            map[string]int{},
+		serials:         map[string]int{},
+		existingMacs:    map[string]bool{},
+		existingSerials: map[string]bool{},
+	}
+}
+
+// checkRequest validates the entry at index i against the previous entries of the request
+func (t *expectedDeviceBulkTracker) checkRequest(i int, siteIDStr string, mac string, serial string, itemErrors validation.Errors) {
+	if siteID, err := uuid.Parse(siteIDStr); err == nil {
+		if t.siteID == nil {
+			t.siteID = &siteID
+		} else if siteID != *t.siteID {
+			common.AddToValidationErrors(itemErrors, "siteId", fmt.Errorf("entry does not belong to the same Site (%s) as other entries in request", t.siteID))
+		}
+	}
+
+	lowerMac := strings.ToLower(mac)
+	if prev, ok := t.macs[lowerMac]; ok {
+		common.AddToValidationErrors(itemErrors, "bmcMacAddress", fmt.Errorf("duplicate BMC MAC address '%s' found at indices %d and %d", mac, prev, i))
+	} else {
+		t.macs[lowerMac] = i
+	}
+
+	lowerSerial := strings.ToLower(serial)
+	if prev, ok := t.serials[lowerSerial]; ok {
+		common.AddToValidationErrors(itemErrors, t.serialField, fmt.Errorf("duplicate serial number '%s' found at indices %d and %d", serial, prev, i))
+	} else {
+		t.serials[lowerSerial] = i
+	}
+}
+
+// addExisting records an entry already on the Site
+func (t *expectedDeviceBulkTracker) addExisting(mac string, serial string) {
+	t.existingMacs[strings.ToLower(mac)] = true
+	t.existingSerials[strings.ToLower(serial)] = true
+}
+
+// checkExisting validates an entry of the request against the entries already on the Site
+func (t *expectedDeviceBulkTracker) checkExisting(mac string, serial string, itemErrors validation.Errors) {
+	if t.existingMacs[strings.ToLower(mac)] {
+		common.AddToValidationErrors(itemErrors, "bmcMacAddress", fmt.Errorf("entry with BMC MAC address '%s' already exists on Site", mac))
+	}
+	if t.existingSerials[strings.ToLower(serial)] {
+		common.AddToValidationErrors(itemErrors, t.serialField, fmt.Errorf("entry with serial number '%s' already exists on Site", serial))
+	}
+}
+
+// getExpectedDeviceBulkSite retrieves the Site of a bulk import and validates that the org can create entries on it
+func getExpectedDeviceBulkSite(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, siteID uuid.UUID, infrastructureProvider *cdbm.InfrastructureProvider, tenant *cdbm.Tenant) (*cdbm.Site, *cutil.APIError) {
+	site, err := common.GetSiteFromIDString(ctx, nil, siteID.String(), dbSession)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Site specified in request data does not exist", nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Site from DB")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Site specified in request data due to DB error", nil)
+	}
+
+	hasAccess, apiError := ValidateProviderOrTenantSiteAccess(ctx, logger, dbSession, site, infrastructureProvider, tenant)
+	if apiError != nil {
+		return nil, apiError
+	}
+	if !hasAccess {
+		return nil, cutil.NewAPIError(http.StatusForbidden, "Current org is not associated with the Site", nil)
+	}
+
+	if site.Status != cdbm.SiteStatusRegistered {
+		logger.Warn().Msg("Site is not in Registered state")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Site is not in Registered state, cannot perform operation", nil)
+	}
+
+	return site, nil
+}
+
+// validateExpectedDeviceBulkCount validates the number of entries in a bulk import
+func validateExpectedDeviceBulkCount(count int, entity string) *cutil.APIError {
+	if count == 0 {
+		return cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Bulk import data must contain at least 1 %s entry", entity), nil)
+	}
+	if count > model.ExpectedDeviceMaxBulkItems {
+		return cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("At most %d %s entries can be imported in a single request", model.ExpectedDeviceMaxBulkItems, entity), nil)
+	}
+	return nil
+}
+
+// bindExpectedDeviceBulkRequest binds a bulk import request body, either a CSV file read by parseCSV or a JSON array
+// Entries that do not specify a Site are assigned the Site of the `siteId` query param
+func bindExpectedDeviceBulkRequest[T any](c echo.Context, logger zerolog.Logger, entity string, parseCSV func(io.Reader) ([]T, error), siteID func(*T) *string) ([]T, *cutil.APIError) {
+	apiRequests := []T{}
+	if isExpectedDeviceCSVRequest(c) {
+		var err error
+		apiRequests, err = parseCSV(c.Request().Body)
+		if err != nil {
+			logger.Warn().Err(err).Msg("error parsing bulk import CSV")
+			return nil, newExpectedDeviceBulkParseError(err)
+		}
+	} else if err := c.Bind(&apiRequests); err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	if apiErr := validateExpectedDeviceBulkCount(len(apiRequests), entity); apiErr != nil {
+		return nil, apiErr
+	}
+
+	if defaultSiteID := c.QueryParam("siteId"); defaultSiteID != "" {
+		for i := range apiRequests {
+			if entrySiteID := siteID(&apiRequests[i]); *entrySiteID == "" {
+				*entrySiteID = defaultSiteID
+			}
+		}
+	}
+
+	return apiRequests, nil
+}
+
+// ~~~~~ Expected Machine Bulk Create Handler ~~~~~ //
+
+// BulkCreateExpectedMachineHandler is the API Handler for importing Expected Machines in bulk
+type BulkCreateExpectedMachineHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewBulkCreateExpectedMachineHandler initializes and returns a new handler for importing Expected Machines in bulk
+func NewBulkCreateExpectedMachineHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) BulkCreateExpectedMachineHandler {
+	return BulkCreateExpectedMachineHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Import Expected Machines in bulk
+// @Description Import Expected Machines from a JSON array or a CSV file. All entries are validated before any is created, then created in a single transaction and synced to the Site at once.
+// @Tags ExpectedMachine
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteId query string false "ID of Site for entries that do not specify one"
+// @Param message body []model.APIExpectedMachineCreateRequest true "Expected Machine bulk import request"
+// @Success 201 {object} []model.APIExpectedMachine
+// @Router /v2/org/{org}/carbide/expected-machine/bulk [post]
+func (bcemh BulkCreateExpectedMachineHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ExpectedMachine", "BulkCreate", c, bcemh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	// Is DB user missing?
+	if dbUser == nil {
+		logger.Error().Msg("invalid User object found in request context")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// ensure our user is a provider or tenant for the org
+	infrastructureProvider, tenant, apiError := common.IsProviderOrTenant(ctx, logger, bcemh.dbSession, org, dbUser, false, true)
+	if apiError != nil {
+		return cutil.NewAPIErrorResponse(c, apiError.Code, apiError.Message, apiError.Data)
+	}
+
+	// Bind request data to API model, either a CSV file or a JSON array
+	apiRequests, apiErr := bindExpectedDeviceBulkRequest(c, logger, "Expected Machine", model.ParseExpectedMachineCSV, func(req *model.APIExpectedMachineCreateRequest) *string {
+		return &req.SiteID
+	})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Expected Machines are imported the same way they are created in a batch
+	createdMachines, apiErr := createExpectedMachines(ctx, logger, bcemh.dbSession, bcemh.scp, dbUser, infrastructureProvider, tenant, apiRequests)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+	if len(createdMachines) > 0 {
+		bcemh.tracerSpan.SetAttribute(handlerSpan, attribute.String("site_id", createdMachines[0].SiteID.String()), logger)
+	}
+
+	// Create response
+	apiExpectedMachines := make([]*model.APIExpectedMachine, 0, len(createdMachines))
+	for i := range createdMachines {
+		apiExpectedMachines = append(apiExpectedMachines, model.NewAPIExpectedMachine(&createdMachines[i]))
+	}
+
+	logger.Info().Int("Count", len(apiExpectedMachines)).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiExpectedMachines)
+}
+
+// ~~~~~ Expected Switch Bulk Create Handler ~~~~~ //
+
+// BulkCreateExpectedSwitchHandler is the API Handler for importing Expected Switches in bulk
+type BulkCreateExpectedSwitchHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewBulkCreateExpectedSwitchHandler initializes and returns a new handler for importing Expected Switches in bulk
+func NewBulkCreateExpectedSwitchHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) BulkCreateExpectedSwitchHandler {
+	return BulkCreateExpectedSwitchHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Import Expected Switches in bulk
+// @Description Import Expected Switches from a JSON array or a CSV file. All entries are validated before any is created, then created in a single transaction and synced to the Site at once.
+// @Tags ExpectedSwitch
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteId query string false "ID of Site for entries that do not specify one"
+// @Param message body []model.APIExpectedSwitchCreateRequest true "Expected Switch bulk import request"
+// @Success 201 {object} []model.APIExpectedSwitch
+// @Router /v2/org/{org}/carbide/expected-switch/bulk [post]
+func (bcesh BulkCreateExpectedSwitchHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ExpectedSwitch", "BulkCreate", c, bcesh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	// Is DB user missing?
+	if dbUser == nil {
+		logger.Error().Msg("invalid User object found in request context")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// ensure our user is a provider or tenant for the org
+	infrastructureProvider, tenant, apiError := common.IsProviderOrTenant(ctx, logger, bcesh.dbSession, org, dbUser, false, true)
+	if apiError != nil {
+		return cutil.NewAPIErrorResponse(c, apiError.Code, apiError.Message, apiError.Data)
+	}
+
+	// Bind request data to API model, either a CSV file or a JSON array
+	apiRequests, apiErr := bindExpectedDeviceBulkRequest(c, logger, "Expected Switch", model.ParseExpectedSwitchCSV, func(req *model.APIExpectedSwitchCreateRequest) *string {
+		return &req.SiteID
+	})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate all entries before touching the DB
+	tracker := newExpectedDeviceBulkTracker("switchSerialNumber")
+	validationErrors := validation.Errors{}
+	for i := range apiRequests {
+		req := &apiRequests[i]
+		itemErrors := validation.Errors{}
+		if verr := req.Validate(); verr != nil {
+			var ok bool
+			itemErrors, ok = verr.(validation.Errors)
+			if !ok {
+				itemErrors = validation.Errors{}
+				common.AddToValidationErrors(itemErrors, "validation", verr)
+			}
+		}
+		tracker.checkRequest(i, req.SiteID, req.BmcMacAddress, req.SwitchSerialNumber, itemErrors)
+
+		if len(itemErrors) > 0 {
+			validationErrors[strconv.Itoa(i)] = itemErrors
+		}
+	}
+	if len(validationErrors) > 0 {
+		logger.Warn().Int("Count", len(validationErrors)).Msg("error validating Expected Switch bulk import data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Switch bulk import data", validationErrors)
+	}
+
+	site, apiErr := getExpectedDeviceBulkSite(ctx, logger, bcesh.dbSession, *tracker.siteID, infrastructureProvider, tenant)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+	bcesh.tracerSpan.SetAttribute(handlerSpan, attribute.String("site_id", site.ID.String()), logger)
+
+	// Start a db transaction
+	tx, err := cdb.BeginTx(ctx, bcesh.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Switches due to DB transaction error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	// Retrieve all Expected Switches on Site to check for duplicates
+	esDAO := cdbm.NewExpectedSwitchDAO(bcesh.dbSession)
+	existingSwitches, _, err := esDAO.GetAll(ctx, tx, cdbm.ExpectedSwitchFilterInput{
+		SiteIDs: []uuid.UUID{site.ID},
+	}, paginator.PageInput{
+		Limit: cdb.GetIntPtr(paginator.TotalLimit),
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Expected Switches from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Expected Switches on Site due to DB error", nil)
+	}
+	for _, es := range existingSwitches {
+		tracker.addExisting(es.BmcMacAddress, es.SwitchSerialNumber)
+	}
+
+	for i, req := range apiRequests {
+		itemErrors := validation.Errors{}
+		tracker.checkExisting(req.BmcMacAddress, req.SwitchSerialNumber, itemErrors)
+		if len(itemErrors) > 0 {
+			validationErrors[strconv.Itoa(i)] = itemErrors
+		}
+	}
+	if len(validationErrors) > 0 {
+		logger.Warn().Int("Count", len(validationErrors)).Msg("Expected Switch bulk import data conflicts with Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Switch bulk import data", validationErrors)
+	}
+
+	// Create the Expected Switches in DB and build the workflow request
+	createdSwitches := make([]*cdbm.ExpectedSwitch, 0, len(apiRequests))
+	workflowSwitches := make([]*cwssaws.ExpectedSwitch, 0, len(apiRequests))
+	for _, req := range apiRequests {
+		es, serr := esDAO.Create(ctx, tx, cdbm.ExpectedSwitchCreateInput{
+			ExpectedSwitchID:   uuid.New(),
+			SiteID:             site.ID,
+			BmcMacAddress:      req.BmcMacAddress,
+			SwitchSerialNumber: req.SwitchSerialNumber,
+			Labels:             req.Labels,
+			CreatedBy:          dbUser.ID,
+		})
+		if serr != nil {
+			logger.Error().Err(serr).Msg("error creating Expected Switch record in DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Switches due to DB error", nil)
+		}
+		createdSwitches = append(createdSwitches, es)
+
+		workflowSwitches = append(workflowSwitches, newExpectedSwitchCreateWorkflowRequest(es, &req))
+	}
+
+	workflowOptions := tclient.StartWorkflowOptions{
+		ID:                       "expected-switch-bulk-create-" + uuid.NewString(),
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+		TaskQueue:                queue.SiteTaskQueue,
+	}
+
+	// Get the temporal client for the site we are working with
+	stc, err := bcesh.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	logger.Info().Int("Count", len(workflowSwitches)).Msg("triggering CreateExpectedSwitches workflow on Site")
+
+	apiErr = common.ExecuteSyncWorkflow(ctx, logger, stc, "CreateExpectedSwitches", workflowOptions, &cwssaws.ExpectedSwitchList{ExpectedSwitches: workflowSwitches})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Commit transaction
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing Expected Switch bulk import transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Switches due to DB transaction error", nil)
+	}
+	txCommitted = true
+
+	// Create response
+	apiExpectedSwitches := make([]*model.APIExpectedSwitch, 0, len(createdSwitches))
+	for _, es := range createdSwitches {
+		apiExpectedSwitches = append(apiExpectedSwitches, model.NewAPIExpectedSwitch(es))
+	}
+
+	logger.Info().Int("Count", len(apiExpectedSwitches)).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiExpectedSwitches)
+}
+
+// ~~~~~ Expected Power Shelf Bulk Create Handler ~~~~~ //
+
+// BulkCreateExpectedPowerShelfHandler is the API Handler for importing Expected Power Shelves in bulk
+type BulkCreateExpectedPowerShelfHandler struct {
+	dbSession  *cdb.Session
+	tc         tclient.Client
+	scp        *sc.ClientPool
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewBulkCreateExpectedPowerShelfHandler initializes and returns a new handler for importing Expected Power Shelves in bulk
+func NewBulkCreateExpectedPowerShelfHandler(dbSession *cdb.Session, tc tclient.Client, scp *sc.ClientPool, cfg *config.Config) BulkCreateExpectedPowerShelfHandler {
+	return BulkCreateExpectedPowerShelfHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		scp:        scp,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Import Expected Power Shelves in bulk
+// @Description Import Expected Power Shelves from a JSON array or a CSV file. All entries are validated before any is created, then created in a single transaction and synced to the Site at once.
+// @Tags ExpectedPowerShelf
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param siteId query string false "ID of Site for entries that do not specify one"
+// @Param message body []model.APIExpectedPowerShelfCreateRequest true "Expected Power Shelf bulk import request"
+// @Success 201 {object} []model.APIExpectedPowerShelf
+// @Router /v2/org/{org}/carbide/expected-power-shelf/bulk [post]
+func (bceph BulkCreateExpectedPowerShelfHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ExpectedPowerShelf", "BulkCreate", c, bceph.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	// Is DB user missing?
+	if dbUser == nil {
+		logger.Error().Msg("invalid User object found in request context")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// ensure our user is a provider or tenant for the org
+	infrastructureProvider, tenant, apiError := common.IsProviderOrTenant(ctx, logger, bceph.dbSession, org, dbUser, false, true)
+	if apiError != nil {
+		return cutil.NewAPIErrorResponse(c, apiError.Code, apiError.Message, apiError.Data)
+	}
+
+	// Bind request data to API model, either a CSV file or a JSON array
+	apiRequests, apiErr := bindExpectedDeviceBulkRequest(c, logger, "Expected Power Shelf", model.ParseExpectedPowerShelfCSV, func(req *model.APIExpectedPowerShelfCreateRequest) *string {
+		return &req.SiteID
+	})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate all entries before touching the DB
+	tracker := newExpectedDeviceBulkTracker("shelfSerialNumber")
+	validationErrors := validation.Errors{}
+	for i := range apiRequests {
+		req := &apiRequests[i]
+		itemErrors := validation.Errors{}
+		if verr := req.Validate(); verr != nil {
+			var ok bool
+			itemErrors, ok = verr.(validation.Errors)
+			if !ok {
+				itemErrors = validation.Errors{}
+				common.AddToValidationErrors(itemErrors, "validation", verr)
+			}
+		}
+		tracker.checkRequest(i, req.SiteID, req.BmcMacAddress, req.ShelfSerialNumber, itemErrors)
+
+		if len(itemErrors) > 0 {
+			validationErrors[strconv.Itoa(i)] = itemErrors
+		}
+	}
+	if len(validationErrors) > 0 {
+		logger.Warn().Int("Count", len(validationErrors)).Msg("error validating Expected Power Shelf bulk import data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Power Shelf bulk import data", validationErrors)
+	}
+
+	site, apiErr := getExpectedDeviceBulkSite(ctx, logger, bceph.dbSession, *tracker.siteID, infrastructureProvider, tenant)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+	bceph.tracerSpan.SetAttribute(handlerSpan, attribute.String("site_id", site.ID.String()), logger)
+
+	// Start a db transaction
+	tx, err := cdb.BeginTx(ctx, bceph.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Power Shelves due to DB transaction error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	// Retrieve all Expected Power Shelves on Site to check for duplicates
+	epsDAO := cdbm.NewExpectedPowerShelfDAO(bceph.dbSession)
+	existingShelves, _, err := epsDAO.GetAll(ctx, tx, cdbm.ExpectedPowerShelfFilterInput{
+		SiteIDs: []uuid.UUID{site.ID},
+	}, paginator.PageInput{
+		Limit: cdb.GetIntPtr(paginator.TotalLimit),
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Expected Power Shelves from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Expected Power Shelves on Site due to DB error", nil)
+	}
+	for _, eps := range existingShelves {
+		tracker.addExisting(eps.BmcMacAddress, eps.ShelfSerialNumber)
+	}
+
+	for i, req := range apiRequests {
+		itemErrors := validation.Errors{}
+		tracker.checkExisting(req.BmcMacAddress, req.ShelfSerialNumber, itemErrors)
+		if len(itemErrors) > 0 {
+			validationErrors[strconv.Itoa(i)] = itemErrors
+		}
+	}
+	if len(validationErrors) > 0 {
+		logger.Warn().Int("Count", len(validationErrors)).Msg("Expected Power Shelf bulk import data conflicts with Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Power Shelf bulk import data", validationErrors)
+	}
+
+	// Create the Expected Power Shelves in DB and build the workflow request
+	createdShelves := make([]*cdbm.ExpectedPowerShelf, 0, len(apiRequests))
+	workflowShelves := make([]*cwssaws.ExpectedPowerShelf, 0, len(apiRequests))
+	for _, req := range apiRequests {
+		eps, serr := epsDAO.Create(ctx, tx, cdbm.ExpectedPowerShelfCreateInput{
+			ExpectedPowerShelfID: uuid.New(),
+			SiteID:               site.ID,
+			BmcMacAddress:        req.BmcMacAddress,
+			ShelfSerialNumber:    req.ShelfSerialNumber,
+			IpAddress:            req.IpAddress,
+			Labels:               req.Labels,
+			CreatedBy:            dbUser.ID,
+		})
+		if serr != nil {
+			logger.Error().Err(serr).Msg("error creating Expected Power Shelf record in DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Power Shelves due to DB error", nil)
+		}
+		createdShelves = append(createdShelves, eps)
+
+		workflowShelves = append(workflowShelves, newExpectedPowerShelfCreateWorkflowRequest(eps, &req))
+	}
+
+	workflowOptions := tclient.StartWorkflowOptions{
+		ID:                       "expected-power-shelf-bulk-create-" + uuid.NewString(),
+		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
+		TaskQueue:                queue.SiteTaskQueue,
+	}
+
+	// Get the temporal client for the site we are working with
+	stc, err := bceph.scp.GetClientByID(site.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+	}
+
+	logger.Info().Int("Count", len(workflowShelves)).Msg("triggering CreateExpectedPowerShelves workflow on Site")
+
+	apiErr = common.ExecuteSyncWorkflow(ctx, logger, stc, "CreateExpectedPowerShelves", workflowOptions, &cwssaws.ExpectedPowerShelfList{ExpectedPowerShelves: workflowShelves})
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Commit transaction
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing Expected Power Shelf bulk import transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to import Expected Power Shelves due to DB transaction error", nil)
+	}
+	txCommitted = true
+
+	// Create response
+	apiExpectedPowerShelves := make([]*model.APIExpectedPowerShelf, 0, len(createdShelves))
+	for _, eps := range createdShelves {
+		apiExpectedPowerShelves = append(apiExpectedPowerShelves, model.NewAPIExpectedPowerShelf(eps))
+	}
+
+	logger.Info().Int("Count", len(apiExpectedPowerShelves)).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiExpectedPowerShelves)
+}
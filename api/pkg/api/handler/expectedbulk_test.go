@@ -0,0 +1,587 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	sc "github.com/nvidia/bare-metal-manager-rest/api/pkg/client/site"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func TestIsExpectedDeviceCSVRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		want        bool
+	}{
+		{name: "test CSV content type is detected", contentType: "text/csv", want: true},
+		{name: "test CSV content type with charset is detected", contentType: "text/csv; charset=UTF-8", want: true},
+		{name: "test JSON content type is not CSV", contentType: echo.MIMEApplicationJSON, want: false},
+		{name: "test missing content type is not CSV", contentType: "", want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tc.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, tc.contentType)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			assert.Equal(t, tc.want, isExpectedDeviceCSVRequest(c))
+		})
+	}
+}
+
+func TestIsExpectedDeviceCSVExport(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		want      bool
+		expectErr bool
+	}{
+		{name: "test default format is JSON", query: "", want: false},
+		{name: "test JSON format", query: "?format=json", want: false},
+		{name: "test CSV format", query: "?format=csv", want: true},
+		{name: "test unknown format is rejected", query: "?format=xml", expectErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/"+tc.query, nil)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+			got, apiErr := isExpectedDeviceCSVExport(c)
+			if tc.expectErr {
+				require.NotNil(t, apiErr)
+				assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+				return
+			}
+			require.Nil(t, apiErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestExpectedDeviceBulkTracker(t *testing.T) {
+	siteID := uuid.NewString()
+	otherSiteID := uuid.NewString()
+
+	tracker := newExpectedDeviceBulkTracker("chassisSerialNumber")
+	tracker.addExisting("00:11:22:33:44:ff", "EXISTING-SN")
+
+	check := func(i int, site, mac, serial string) validation.Errors {
+		itemErrors := validation.Errors{}
+		tracker.checkRequest(i, site, mac, serial, itemErrors)
+		tracker.checkExisting(mac, serial, itemErrors)
+		return itemErrors
+	}
+
+	assert.Empty(t, check(0, siteID, "00:11:22:33:44:01", "SN-1"))
+
+	// MAC address comparison is case-insensitive
+	errs := check(1, siteID, "00:11:22:33:44:01", "SN-2")
+	assert.Contains(t, errs, "bmcMacAddress")
+	assert.NotContains(t, errs, "chassisSerialNumber")
+
+	errs = check(2, siteID, "00:11:22:33:44:02", "sn-1")
+	assert.Contains(t, errs, "chassisSerialNumber")
+
+	errs = check(3, otherSiteID, "00:11:22:33:44:03", "SN-3")
+	assert.Contains(t, errs, "siteId")
+
+	errs = check(4, siteID, "00:11:22:33:44:FF", "existing-sn")
+	assert.Contains(t, errs, "bmcMacAddress")
+	assert.Contains(t, errs, "chassisSerialNumber")
+
+	require.NotNil(t, tracker.siteID)
+	assert.Equal(t, siteID, tracker.siteID.String())
+}
+
+func TestWriteExpectedDeviceCSV(t *testing.T) {
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?format=csv", nil), rec)
+
+	records := [][]string{
+		{uuid.NewString(), "00:11:22:33:44:01", "SN-1", "", "", "", "", "env=prod"},
+	}
+	err := writeExpectedDeviceCSV(c, zerolog.Nop(), "expected-machines.csv", model.ExpectedMachineCSVHeader, records)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.True(t, strings.HasPrefix(rec.Header().Get(echo.HeaderContentType), "text/csv"))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "expected-machines.csv")
+
+	parsed, err := model.ParseExpectedMachineCSV(strings.NewReader(rec.Body.String()))
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+	assert.Equal(t, "SN-1", parsed[0].ChassisSerialNumber)
+	assert.Equal(t, "prod", parsed[0].Labels["env"])
+}
+
+// testExpectedDeviceBulkInitDB initializes a test database session including Expected Switch and Power Shelf tables
+func testExpectedDeviceBulkInitDB(t *testing.T) *cdb.Session {
+	dbSession := testExpectedMachineInitDB(t)
+
+	ctx := context.Background()
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.ExpectedSwitch)(nil))
+	assert.Nil(t, err)
+	err = dbSession.DB.ResetModel(ctx, (*cdbm.ExpectedPowerShelf)(nil))
+	assert.Nil(t, err)
+
+	return dbSession
+}
+
+// testExpectedDeviceBulkMockUser returns a provider admin User for the specified org
+func testExpectedDeviceBulkMockUser(org string) *cdbm.User {
+	return &cdbm.User{
+		StarfleetID: cdb.GetStrPtr("test-user"),
+		OrgData: cdbm.OrgData{
+			org: cdbm.Org{
+				ID:          123,
+				Name:        org,
+				DisplayName: org,
+				OrgType:     "ENTERPRISE",
+				Roles:       []string{"FORGE_PROVIDER_ADMIN"},
+			},
+		},
+	}
+}
+
+// testExpectedDeviceBulkSyncClient returns a mock Temporal client for a sync workflow that completes with the specified error
+func testExpectedDeviceBulkSyncClient(workflowName string, workflowErr error) *tmocks.Client {
+	mockWorkflowRun := &tmocks.WorkflowRun{}
+	mockWorkflowRun.On("GetID").Return("test-workflow-id")
+	mockWorkflowRun.On("Get", mock.Anything, mock.Anything).Return(workflowErr)
+
+	mockTemporalClient := &tmocks.Client{}
+	mockTemporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, workflowName, mock.Anything).Return(mockWorkflowRun, nil)
+
+	return mockTemporalClient
+}
+
+// testExpectedDeviceBulkRequest executes a bulk import handler and returns the recorded response
+func testExpectedDeviceBulkRequest(t *testing.T, handle func(c echo.Context) error, org string, query string, contentType string, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/v2/org/"+org+"/carbide/expected-device/bulk"+query, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, contentType)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user", testExpectedDeviceBulkMockUser(org))
+	c.SetParamNames("orgName")
+	c.SetParamValues(org)
+
+	err := handle(c)
+	require.NoError(t, err)
+
+	return rec
+}
+
+func TestBulkCreateExpectedMachineHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testExpectedDeviceBulkInitDB(t)
+	defer dbSession.Close()
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+	scp := sc.NewClientPool(tcfg)
+
+	org := "test-org"
+	_, site := testExpectedMachineSetupTestData(t, dbSession, org)
+
+	existing := &cdbm.ExpectedMachine{
+		ID:                  uuid.New(),
+		SiteID:              site.ID,
+		BmcMacAddress:       "00:11:22:33:55:ff",
+		ChassisSerialNumber: "BULK-EXISTING",
+	}
+	_, err := dbSession.DB.NewInsert().Model(existing).Exec(ctx)
+	require.NoError(t, err)
+
+	// newClient returns a mock Temporal client for which the Site rejects the entries at the specified indexes
+	newClient := func(rejected map[int]string, workflowErr error) *tmocks.Client {
+		var captured *cwssaws.BatchExpectedMachineOperationRequest
+
+		mockWorkflowRun := &tmocks.WorkflowRun{}
+		mockWorkflowRun.On("GetID").Return("test-workflow-id")
+		mockWorkflowRun.On("Get", mock.Anything, mock.Anything).
+			Run(func(args mock.Arguments) {
+				resp, ok := args.Get(1).(*cwssaws.BatchExpectedMachineOperationResponse)
+				if !ok || captured == nil {
+					return
+				}
+				for i, em := range captured.GetExpectedMachines().GetExpectedMachines() {
+					result := &cwssaws.ExpectedMachineOperationResult{Id: em.GetId(), Success: true}
+					if msg, ok := rejected[i]; ok {
+						result.Success = false
+						result.ErrorMessage = cdb.GetStrPtr(msg)
+					}
+					resp.Results = append(resp.Results, result)
+				}
+			}).
+			Return(workflowErr)
+
+		mockTemporalClient := &tmocks.Client{}
+		mockTemporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, "CreateExpectedMachines", mock.Anything).
+			Run(func(args mock.Arguments) {
+				captured, _ = args.Get(3).(*cwssaws.BatchExpectedMachineOperationRequest)
+			}).
+			Return(mockWorkflowRun, nil)
+
+		return mockTemporalClient
+	}
+
+	tests := []struct {
+		name             string
+		query            string
+		contentType      string
+		body             string
+		rejected         map[int]string
+		workflowErr      error
+		expectedStatus   int
+		expectedCreated  int
+		expectedErrorKey string
+	}{
+		{
+			name:        "test Expected Machine bulk import from JSON succeeds",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:01","chassisSerialNumber":"BULK-001","skuId":"test-sku-uuid-1"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:02","chassisSerialNumber":"BULK-002","labels":{"env":"test"}}]`, site.ID, site.ID),
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 2,
+		},
+		{
+			name:        "test Expected Machine bulk import from CSV with default Site succeeds",
+			query:       "?siteId=" + site.ID.String(),
+			contentType: "text/csv",
+			body: strings.Join(model.ExpectedMachineCSVHeader, ",") + "\n" +
+				",00:11:22:33:55:03,BULK-003,,DPU-003,admin,password,env=test\n",
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 1,
+		},
+		{
+			name:        "test Expected Machine bulk import with duplicate MAC in request fails",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:04","chassisSerialNumber":"BULK-004"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:04","chassisSerialNumber":"BULK-005"}]`, site.ID, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "1",
+		},
+		{
+			name:             "test Expected Machine bulk import conflicting with existing entry fails",
+			contentType:      echo.MIMEApplicationJSON,
+			body:             fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:ff","chassisSerialNumber":"BULK-006"}]`, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "0",
+		},
+		{
+			name:             "test Expected Machine bulk import with unknown SKU fails",
+			contentType:      echo.MIMEApplicationJSON,
+			body:             fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:07","chassisSerialNumber":"BULK-007","skuId":"unknown-sku"}]`, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "0",
+		},
+		{
+			name:        "test Expected Machine bulk import rejected by Site persists nothing",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:08","chassisSerialNumber":"BULK-008"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:09","chassisSerialNumber":"BULK-009"}]`, site.ID, site.ID),
+			rejected:         map[int]string{1: "duplicate BMC MAC address on Site"},
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "1",
+		},
+		{
+			name:           "test Expected Machine bulk import with failed Site workflow persists nothing",
+			contentType:    echo.MIMEApplicationJSON,
+			body:           fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:55:10","chassisSerialNumber":"BULK-010"}]`, site.ID),
+			workflowErr:    errors.New("site unreachable"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			before, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedMachine)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+
+			scp.IDClientMap[site.ID.String()] = newClient(tc.rejected, tc.workflowErr)
+			handler := NewBulkCreateExpectedMachineHandler(dbSession, nil, scp, cfg)
+
+			rec := testExpectedDeviceBulkRequest(t, handler.Handle, org, tc.query, tc.contentType, tc.body)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			after, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedMachine)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, before+tc.expectedCreated, after)
+
+			if tc.expectedStatus == http.StatusCreated {
+				response := []model.APIExpectedMachine{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Len(t, response, tc.expectedCreated)
+				return
+			}
+
+			if tc.expectedErrorKey != "" {
+				apiErr := struct {
+					Data map[string]interface{} `json:"data"`
+				}{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+				assert.Contains(t, apiErr.Data, tc.expectedErrorKey)
+			}
+		})
+	}
+}
+
+func TestBulkCreateExpectedSwitchHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testExpectedDeviceBulkInitDB(t)
+	defer dbSession.Close()
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+	scp := sc.NewClientPool(tcfg)
+
+	org := "test-org"
+	_, site := testExpectedMachineSetupTestData(t, dbSession, org)
+
+	existing := &cdbm.ExpectedSwitch{
+		ID:                 uuid.New(),
+		SiteID:             site.ID,
+		BmcMacAddress:      "00:11:22:33:66:ff",
+		SwitchSerialNumber: "SWITCH-EXISTING",
+	}
+	_, err := dbSession.DB.NewInsert().Model(existing).Exec(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		query            string
+		contentType      string
+		body             string
+		workflowErr      error
+		expectedStatus   int
+		expectedCreated  int
+		expectedErrorKey string
+	}{
+		{
+			name:        "test Expected Switch bulk import from JSON succeeds",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:01","switchSerialNumber":"SWITCH-001"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:02","switchSerialNumber":"SWITCH-002","labels":{"env":"test"}}]`, site.ID, site.ID),
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 2,
+		},
+		{
+			name:        "test Expected Switch bulk import from CSV with default Site succeeds",
+			query:       "?siteId=" + site.ID.String(),
+			contentType: "text/csv",
+			body: strings.Join(model.ExpectedSwitchCSVHeader, ",") + "\n" +
+				",00:11:22:33:66:03,SWITCH-003,admin,password,nvos,nvos-password,env=test\n",
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 1,
+		},
+		{
+			name:        "test Expected Switch bulk import with duplicate serial number in request fails",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:04","switchSerialNumber":"SWITCH-004"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:05","switchSerialNumber":"SWITCH-004"}]`, site.ID, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "1",
+		},
+		{
+			name:             "test Expected Switch bulk import conflicting with existing entry fails",
+			contentType:      echo.MIMEApplicationJSON,
+			body:             fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:FF","switchSerialNumber":"SWITCH-006"}]`, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "0",
+		},
+		{
+			name:             "test Expected Switch bulk import with missing Site ID fails",
+			contentType:      echo.MIMEApplicationJSON,
+			body:             `[{"bmcMacAddress":"00:11:22:33:66:07","switchSerialNumber":"SWITCH-007"}]`,
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "0",
+		},
+		{
+			name:           "test Expected Switch bulk import with failed Site workflow persists nothing",
+			contentType:    echo.MIMEApplicationJSON,
+			body:           fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:66:08","switchSerialNumber":"SWITCH-008"}]`, site.ID),
+			workflowErr:    errors.New("site unreachable"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			before, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedSwitch)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+
+			scp.IDClientMap[site.ID.String()] = testExpectedDeviceBulkSyncClient("CreateExpectedSwitches", tc.workflowErr)
+			handler := NewBulkCreateExpectedSwitchHandler(dbSession, nil, scp, cfg)
+
+			rec := testExpectedDeviceBulkRequest(t, handler.Handle, org, tc.query, tc.contentType, tc.body)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			after, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedSwitch)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, before+tc.expectedCreated, after)
+
+			if tc.expectedStatus == http.StatusCreated {
+				response := []model.APIExpectedSwitch{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Len(t, response, tc.expectedCreated)
+				return
+			}
+
+			if tc.expectedErrorKey != "" {
+				apiErr := struct {
+					Data map[string]interface{} `json:"data"`
+				}{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+				assert.Contains(t, apiErr.Data, tc.expectedErrorKey)
+			}
+		})
+	}
+}
+
+func TestBulkCreateExpectedPowerShelfHandler_Handle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testExpectedDeviceBulkInitDB(t)
+	defer dbSession.Close()
+
+	cfg := common.GetTestConfig()
+	tcfg, _ := cfg.GetTemporalConfig()
+	scp := sc.NewClientPool(tcfg)
+
+	org := "test-org"
+	_, site := testExpectedMachineSetupTestData(t, dbSession, org)
+
+	existing := &cdbm.ExpectedPowerShelf{
+		ID:                uuid.New(),
+		SiteID:            site.ID,
+		BmcMacAddress:     "00:11:22:33:77:ff",
+		ShelfSerialNumber: "SHELF-EXISTING",
+	}
+	_, err := dbSession.DB.NewInsert().Model(existing).Exec(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		query            string
+		contentType      string
+		body             string
+		workflowErr      error
+		expectedStatus   int
+		expectedCreated  int
+		expectedErrorKey string
+	}{
+		{
+			name:        "test Expected Power Shelf bulk import from JSON succeeds",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:01","shelfSerialNumber":"SHELF-001","ipAddress":"10.0.0.1"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:02","shelfSerialNumber":"SHELF-002","labels":{"env":"test"}}]`, site.ID, site.ID),
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 2,
+		},
+		{
+			name:        "test Expected Power Shelf bulk import from CSV with default Site succeeds",
+			query:       "?siteId=" + site.ID.String(),
+			contentType: "text/csv",
+			body: strings.Join(model.ExpectedPowerShelfCSVHeader, ",") + "\n" +
+				",00:11:22:33:77:03,SHELF-003,10.0.0.3,admin,password,env=test\n",
+			expectedStatus:  http.StatusCreated,
+			expectedCreated: 1,
+		},
+		{
+			name:        "test Expected Power Shelf bulk import with duplicate MAC in request fails",
+			contentType: echo.MIMEApplicationJSON,
+			body: fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:04","shelfSerialNumber":"SHELF-004"},`+
+				`{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:04","shelfSerialNumber":"SHELF-005"}]`, site.ID, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "1",
+		},
+		{
+			name:             "test Expected Power Shelf bulk import conflicting with existing entry fails",
+			contentType:      echo.MIMEApplicationJSON,
+			body:             fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:06","shelfSerialNumber":"shelf-existing"}]`, site.ID),
+			expectedStatus:   http.StatusBadRequest,
+			expectedErrorKey: "0",
+		},
+		{
+			name:           "test Expected Power Shelf bulk import with failed Site workflow persists nothing",
+			contentType:    echo.MIMEApplicationJSON,
+			body:           fmt.Sprintf(`[{"siteId":"%s","bmcMacAddress":"00:11:22:33:77:07","shelfSerialNumber":"SHELF-007"}]`, site.ID),
+			workflowErr:    errors.New("site unreachable"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			before, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedPowerShelf)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+
+			scp.IDClientMap[site.ID.String()] = testExpectedDeviceBulkSyncClient("CreateExpectedPowerShelves", tc.workflowErr)
+			handler := NewBulkCreateExpectedPowerShelfHandler(dbSession, nil, scp, cfg)
+
+			rec := testExpectedDeviceBulkRequest(t, handler.Handle, org, tc.query, tc.contentType, tc.body)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			after, err := dbSession.DB.NewSelect().Model((*cdbm.ExpectedPowerShelf)(nil)).Where("site_id = ?", site.ID).Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, before+tc.expectedCreated, after)
+
+			if tc.expectedStatus == http.StatusCreated {
+				response := []model.APIExpectedPowerShelf{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Len(t, response, tc.expectedCreated)
+				return
+			}
+
+			if tc.expectedErrorKey != "" {
+				apiErr := struct {
+					Data map[string]interface{} `json:"data"`
+				}{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &apiErr))
+				assert.Contains(t, apiErr.Data, tc.expectedErrorKey)
+			}
+		})
+	}
+}
@@ -254,29 +254,7 @@ func (cemh CreateExpectedMachineHandler) Handle(c echo.Context) error {
 	}
 
 	// Build the create request for workflow
-	// BMC credentials come from API request since they're not stored in DB
-	createExpectedMachineRequest := &cwssaws.ExpectedMachine{
-		Id:                       &cwssaws.UUID{Value: expectedMachine.ID.String()},
-		BmcMacAddress:            expectedMachine.BmcMacAddress,
-		ChassisSerialNumber:      expectedMachine.ChassisSerialNumber,
-		FallbackDpuSerialNumbers: expectedMachine.FallbackDpuSerialNumbers,
-		SkuId:                    expectedMachine.SkuID,
-	}
-
-	if apiRequest.DefaultBmcUsername != nil {
-		createExpectedMachineRequest.BmcUsername = *apiRequest.DefaultBmcUsername
-	}
-
-	if apiRequest.DefaultBmcPassword != nil {
-		createExpectedMachineRequest.BmcPassword = *apiRequest.DefaultBmcPassword
-	}
-
-	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
-	if protoLabels != nil {
-		createExpectedMachineRequest.Metadata = &cwssaws.Metadata{
-			Labels: protoLabels,
-		}
-	}
+	createExpectedMachineRequest := newExpectedMachineCreateWorkflowRequest(expectedMachine, &apiRequest)
 
 	logger.Info().Msg("triggering Expected Machine create workflow on Site")
 
@@ -349,6 +327,7 @@ func NewGetAllExpectedMachineHandler(dbSession *cdb.Session, tc tclient.Client,
 // @Param includeRelation query string false "Related entities to include in response e.g. 'Site', 'SKU'"
 // @Param pageSize query integer false "Number of results per page"
 // @Param orderBy query string false "Order by field"
+// @Param format query string false "Response format, 'json' (default) or 'csv' to export all matching entries in the bulk import CSV format"
 // @Success 200 {object} []model.APIExpectedMachine
 // @Router /v2/org/{org}/carbide/expected-machine [get]
 func (gaemh GetAllExpectedMachineHandler) Handle(c echo.Context) error {
@@ -439,17 +418,29 @@ func (gaemh GetAllExpectedMachineHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate pagination request data", err)
 	}
 
+	// CSV exports include all matching entries rather than a single page
+	isCSVExport, apiErr := isExpectedDeviceCSVExport(c)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	pageInput := paginator.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}
+	if isCSVExport {
+		pageInput.Offset = nil
+		pageInput.Limit = cdb.GetIntPtr(paginator.TotalLimit)
+	}
+
 	// Get Expected Machines from DB
 	emDAO := cdbm.NewExpectedMachineDAO(gaemh.dbSession)
 	expectedMachines, total, err := emDAO.GetAll(
 		ctx,
 		nil,
 		filterInput,
-		paginator.PageInput{
-			Offset:  pageRequest.Offset,
-			Limit:   pageRequest.Limit,
-			OrderBy: pageRequest.OrderBy,
-		}, qIncludeRelations,
+		pageInput, qIncludeRelations,
 	)
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving Expected Machines from db")
@@ -463,6 +454,14 @@ func (gaemh GetAllExpectedMachineHandler) Handle(c echo.Context) error {
 		apiExpectedMachines = append(apiExpectedMachines, apiExpectedMachine)
 	}
 
+	if isCSVExport {
+		records := make([][]string, 0, len(apiExpectedMachines))
+		for _, apiObj := range apiExpectedMachines {
+			records = append(records, model.ExpectedMachineCSVRecord(apiObj))
+		}
+		return writeExpectedDeviceCSV(c, logger, "expected-machines.csv", model.ExpectedMachineCSVHeader, records)
+	}
+
 	// Create pagination response header
 	pageResponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
 	pageHeader, err := json.Marshal(pageResponse)
@@ -1016,275 +1015,231 @@ func (cemh CreateExpectedMachinesHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("At most %d Expected Machine entries can be created in a batch request", model.ExpectedMachineMaxBatchItems), nil)
 	}
 
-	// Validate each item, also collect requested sku IDs
-	// - SiteID is required, a valid UUID and unique across all items
+	createdExpectedMachines, apiErr := createExpectedMachines(ctx, logger, cemh.dbSession, cemh.scp, dbUser, infrastructureProvider, tenant, apiRequests)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().
+		Int("SuccessCount", len(createdExpectedMachines)).
+		Msg("finishing CreateExpectedMachines API handler")
+
+	// Return only successful machines
+	return c.JSON(http.StatusCreated, createdExpectedMachines)
+}
+
+// createExpectedMachines creates Expected Machines for batch create and bulk import requests
+// All entries must belong to the same Site. Entries are validated before any is created, then created in a single
+// transaction and synced to the Site at once. Nothing is created if any entry is invalid or rejected by the Site.
+func createExpectedMachines(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, scp *sc.ClientPool, dbUser *cdbm.User, infrastructureProvider *cdbm.InfrastructureProvider, tenant *cdbm.Tenant, apiRequests []model.APIExpectedMachineCreateRequest) ([]cdbm.ExpectedMachine, *cutil.APIError) {
+	// Validate each item before touching the DB
+	// - SiteID is required, a valid UUID and the same across all items
 	// - BMC address is required and must be unique
 	// - Serial Number is required and must be unique
-	// Note: this is early partial validation before we try to call the DB.
-	validationErrors := validation.Errors{} //
-	var foundSiteID *uuid.UUID
-	bmcMacMap := make(map[string]int)
-	serialMap := make(map[string]int)
-	requestedSkuIDs := make(map[string]bool)
+	tracker := newExpectedDeviceBulkTracker("chassisSerialNumber")
+	validationErrors := validation.Errors{}
 	for i, req := range apiRequests {
-		strIndex := strconv.Itoa(i) // index/key as string for validation errors map
 		itemErrors := validation.Errors{}
-
-		verr := req.Validate()
-		if verr != nil {
+		if verr := req.Validate(); verr != nil {
 			var ok bool
 			itemErrors, ok = verr.(validation.Errors)
 			if !ok {
+				itemErrors = validation.Errors{}
 				common.AddToValidationErrors(itemErrors, "validation", verr)
 			}
 		}
-
-		// SiteID is mandatory for batch create and must be the same for all items
 		if req.SiteID == "" {
-			common.AddToValidationErrors(itemErrors, "siteID", fmt.Errorf("Site ID is required"))
-		}
-		siteID, _ := uuid.Parse(req.SiteID) // already validated
-		if foundSiteID == nil {
-			foundSiteID = &siteID
-		} else {
-			if siteID != *foundSiteID {
-				common.AddToValidationErrors(itemErrors, "siteID", fmt.Errorf(
-					"Expected Machine does not belong to the same Site (%s) as other Expected Machines in request", req.SiteID))
-			}
-		}
-
-		lowerMac := strings.ToLower(req.BmcMacAddress)
-		if prev, ok := bmcMacMap[lowerMac]; ok {
-			common.AddToValidationErrors(itemErrors, "bmcMacAddress", fmt.Errorf(
-				"duplicate BMC MAC address '%s' found at indices %d and %d", req.BmcMacAddress, prev, i))
-		}
-		bmcMacMap[lowerMac] = i
-
-		lowerSerial := strings.ToLower(req.ChassisSerialNumber)
-		if prev, ok := serialMap[lowerSerial]; ok {
-			common.AddToValidationErrors(itemErrors, "chassisSerialNumber", fmt.Errorf(
-				"duplicate chassis serial number '%s' found at indices %d and %d", req.ChassisSerialNumber, prev, i))
-		}
-		serialMap[lowerSerial] = i
-
-		if req.SkuID != nil {
-			requestedSkuIDs[*req.SkuID] = true
+			common.AddToValidationErrors(itemErrors, "siteId", errors.New("Site ID is required"))
 		}
+		tracker.checkRequest(i, req.SiteID, req.BmcMacAddress, req.ChassisSerialNumber, itemErrors)
 
 		if len(itemErrors) > 0 {
-			validationErrors[strIndex] = itemErrors
+			validationErrors[strconv.Itoa(i)] = itemErrors
 		}
 	}
 	if len(validationErrors) > 0 {
-		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Machine create data", validationErrors)
+		logger.Warn().Int("Count", len(validationErrors)).Msg("error validating Expected Machine create data")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate Expected Machine create data", validationErrors)
 	}
-	siteID := *foundSiteID
 
-	// Retrieve the Site from the DB
-	site, err := common.GetSiteFromIDString(ctx, nil, siteID.String(), cemh.dbSession)
-	if err != nil {
-		if errors.Is(err, cdb.ErrDoesNotExist) {
-			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Site specified in request data does not exist", nil)
-		}
-		logger.Error().Err(err).Msg("error retrieving Site from DB")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Site specified in request data due to DB error", nil)
-	}
-
-	// Validate access to Site
-	hasAccess, apiError := ValidateProviderOrTenantSiteAccess(ctx, logger, cemh.dbSession, site, infrastructureProvider, tenant)
-	if apiError != nil {
-		return cutil.NewAPIErrorResponse(c, apiError.Code, apiError.Message, apiError.Data)
-	}
-	if !hasAccess {
-		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Current org is not associated with the Site", nil)
+	site, apiErr := getExpectedDeviceBulkSite(ctx, logger, dbSession, *tracker.siteID, infrastructureProvider, tenant)
+	if apiErr != nil {
+		return nil, apiErr
 	}
 
 	// Start a db transaction
-	tx, err := cdb.BeginTx(ctx, cemh.dbSession, &sql.TxOptions{})
+	tx, err := cdb.BeginTx(ctx, dbSession, &sql.TxOptions{})
 	if err != nil {
 		logger.Error().Err(err).Msg("unable to start transaction")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Expected Machines due to DB transaction error", nil)
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to create Expected Machines due to DB transaction error", nil)
 	}
 	// this variable is used in cleanup actions to indicate if this transaction committed
 	txCommitted := false
 	defer common.RollbackTx(ctx, tx, &txCommitted)
 
-	// Retrieve all ExpectedMachines on Site from DB to allow unicity checks at Site level
-	emDAO := cdbm.NewExpectedMachineDAO(cemh.dbSession)
-	existingMachinesOnSite, _, err := emDAO.GetAll(ctx, tx, cdbm.ExpectedMachineFilterInput{
-		SiteIDs: []uuid.UUID{siteID},
+	// Retrieve all Expected Machines and SKUs on Site to check for duplicates and unknown SKUs
+	emDAO := cdbm.NewExpectedMachineDAO(dbSession)
+	existingMachines, _, err := emDAO.GetAll(ctx, tx, cdbm.ExpectedMachineFilterInput{
+		SiteIDs: []uuid.UUID{site.ID},
 	}, paginator.PageInput{
 		Limit: cdb.GetIntPtr(paginator.TotalLimit), // we want ALL records on site
-	}, []string{})
+	}, nil)
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving Expected Machines from DB")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Expected Machines due to DB error", nil)
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Expected Machines on Site due to DB error", nil)
 	}
-	existingMacAddressMap := make(map[string]bool)
-	existingSerialMap := make(map[string]bool)
-	for _, machine := range existingMachinesOnSite {
-		existingMacAddressMap[machine.BmcMacAddress] = true
-		existingSerialMap[machine.ChassisSerialNumber] = true
+	for _, em := range existingMachines {
+		tracker.addExisting(em.BmcMacAddress, em.ChassisSerialNumber)
 	}
 
-	// Retrieve all SKUs on Site to validate existence of SKU IDs in request
-	skuDAO := cdbm.NewSkuDAO(cemh.dbSession)
+	skuDAO := cdbm.NewSkuDAO(dbSession)
 	existingSkus, _, err := skuDAO.GetAll(ctx, tx, cdbm.SkuFilterInput{
-		SiteIDs: []uuid.UUID{siteID},
+		SiteIDs: []uuid.UUID{site.ID},
 	}, paginator.PageInput{
-		Limit: cdb.GetIntPtr(len(requestedSkuIDs)),
+		Limit: cdb.GetIntPtr(paginator.TotalLimit),
 	})
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving SKUs from DB")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve SKUs due to DB error", nil)
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve SKUs on Site due to DB error", nil)
 	}
-	existingSkuIDsMap := make(map[string]bool)
+	existingSkuIDs := map[string]bool{}
 	for _, sku := range existingSkus {
-		existingSkuIDsMap[sku.ID] = true
+		existingSkuIDs[sku.ID] = true
 	}
 
-	// Final checks: unicity of MAC, Serial, and existence of SKUs
-	validationErrors = validation.Errors{}
 	for i, req := range apiRequests {
 		itemErrors := validation.Errors{}
-		strIndex := strconv.Itoa(i) // index/key as string for validation errors map
-
-		// Check MAC unicity
-		if existingMacAddressMap[req.BmcMacAddress] {
-			common.AddToValidationErrors(itemErrors, "bmcMacAddress", fmt.Errorf(
-				"Expected Machine with BMC MAC Address: %s already exist", req.BmcMacAddress))
-		}
-		// Check Serial unicity
-		if existingSerialMap[req.ChassisSerialNumber] {
-			common.AddToValidationErrors(itemErrors, "chassisSerialNumber", fmt.Errorf(
-				"Expected Machine with Chassis Serial Number: %s already exists", req.ChassisSerialNumber))
+		tracker.checkExisting(req.BmcMacAddress, req.ChassisSerialNumber, itemErrors)
+		if req.SkuID != nil && !existingSkuIDs[*req.SkuID] {
+			common.AddToValidationErrors(itemErrors, "skuId", fmt.Errorf("SKU '%s' does not exist on Site", *req.SkuID))
 		}
-		// Check SKU existence
-		if req.SkuID != nil && !existingSkuIDsMap[*req.SkuID] {
-			common.AddToValidationErrors(itemErrors, "skuID", fmt.Errorf(
-				"the SkuID specified for Expected Machine does not exist in DB: %s", *req.SkuID))
-		}
-
-		// Collect errors
 		if len(itemErrors) > 0 {
-			validationErrors[strIndex] = itemErrors
+			validationErrors[strconv.Itoa(i)] = itemErrors
 		}
 	}
 	if len(validationErrors) > 0 {
-		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate Expected Machine update data", validationErrors)
+		logger.Warn().Int("Count", len(validationErrors)).Msg("Expected Machine create data conflicts with Site")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate Expected Machine create data", validationErrors)
 	}
 
 	createInputs := make([]cdbm.ExpectedMachineCreateInput, 0, len(apiRequests))
-	for _, machineReq := range apiRequests {
+	for _, req := range apiRequests {
 		createInputs = append(createInputs, cdbm.ExpectedMachineCreateInput{
 			ExpectedMachineID:        uuid.New(),
 			SiteID:                   site.ID,
-			BmcMacAddress:            machineReq.BmcMacAddress,
-			ChassisSerialNumber:      machineReq.ChassisSerialNumber,
-			SkuID:                    machineReq.SkuID,
-			FallbackDpuSerialNumbers: machineReq.FallbackDPUSerialNumbers,
-			Labels:                   machineReq.Labels,
+			BmcMacAddress:            req.BmcMacAddress,
+			ChassisSerialNumber:      req.ChassisSerialNumber,
+			SkuID:                    req.SkuID,
+			FallbackDpuSerialNumbers: req.FallbackDPUSerialNumbers,
+			Labels:                   req.Labels,
 			CreatedBy:                dbUser.ID,
 		})
 	}
 
-	createdExpectedMachines, err := emDAO.CreateMultiple(ctx, tx, createInputs)
+	createdMachines, err := emDAO.CreateMultiple(ctx, tx, createInputs)
 	if err != nil {
-		logger.Error().Err(err).Msg("error creating ExpectedMachine records in DB")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Expected Machine due to DB error", nil)
+		logger.Error().Err(err).Msg("error creating Expected Machine records in DB")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to create Expected Machines due to DB error", nil)
 	}
 
-	workflowMachines := make([]*cwssaws.ExpectedMachine, 0, len(createdExpectedMachines))
-	for i, createdMachine := range createdExpectedMachines {
-		workflowMachine := &cwssaws.ExpectedMachine{
-			Id:                       &cwssaws.UUID{Value: createdMachine.ID.String()},
-			BmcMacAddress:            createdMachine.BmcMacAddress,
-			ChassisSerialNumber:      createdMachine.ChassisSerialNumber,
-			FallbackDpuSerialNumbers: createdMachine.FallbackDpuSerialNumbers,
-			SkuId:                    createdMachine.SkuID,
-		}
-
-		if apiRequests[i].DefaultBmcUsername != nil {
-			workflowMachine.BmcUsername = *apiRequests[i].DefaultBmcUsername
-		}
-
-		if apiRequests[i].DefaultBmcPassword != nil {
-			workflowMachine.BmcPassword = *apiRequests[i].DefaultBmcPassword
-		}
-
-		protoLabels := util.ProtobufLabelsFromAPILabels(apiRequests[i].Labels)
-		if protoLabels != nil {
-			workflowMachine.Metadata = &cwssaws.Metadata{
-				Labels: protoLabels,
-			}
-		}
-
-		workflowMachines = append(workflowMachines, workflowMachine)
+	indexByID := map[string]int{}
+	workflowMachines := make([]*cwssaws.ExpectedMachine, 0, len(createdMachines))
+	for i := range createdMachines {
+		indexByID[createdMachines[i].ID.String()] = i
+		workflowMachines = append(workflowMachines, newExpectedMachineCreateWorkflowRequest(&createdMachines[i], &apiRequests[i]))
 	}
 
-	logger.Info().Int("Count", len(workflowMachines)).Msg("triggering CreateExpectedMachines workflow on Site")
-
-	// Create workflow request
 	workflowRequest := &cwssaws.BatchExpectedMachineOperationRequest{
 		ExpectedMachines:     &cwssaws.ExpectedMachineList{ExpectedMachines: workflowMachines},
 		AcceptPartialResults: false,
 	}
 
-	// Create workflow options
-	workflowID := fmt.Sprintf("create-expected-machines-%s-%d", site.ID.String(), len(workflowMachines))
 	workflowOptions := tclient.StartWorkflowOptions{
-		ID:                       workflowID,
+		ID:                       "expected-machine-batch-create-" + uuid.NewString(),
 		WorkflowExecutionTimeout: cutil.WorkflowExecutionTimeout,
 		TaskQueue:                queue.SiteTaskQueue,
 	}
 
 	// Get the temporal client for the site we are working with
-	stc, err := cemh.scp.GetClientByID(site.ID)
+	stc, err := scp.GetClientByID(site.ID)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to retrieve Temporal client for Site")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
 	}
 
-	// Execute workflow and get results
-	workflowRun, err := stc.ExecuteWorkflow(ctx, workflowOptions, "CreateExpectedMachines", workflowRequest)
+	logger.Info().Int("Count", len(workflowMachines)).Msg("triggering CreateExpectedMachines workflow on Site")
+
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, cutil.WorkflowContextTimeout)
+	defer cancel()
+
+	workflowRun, err := stc.ExecuteWorkflow(ctxWithTimeout, workflowOptions, "CreateExpectedMachines", workflowRequest)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to schedule CreateExpectedMachines workflow on Site")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, fmt.Sprintf("Failed to schedule batch Expected Machine creation workflow on Site: %v", err), nil)
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, fmt.Sprintf("Failed to schedule Expected Machine creation workflow on Site: %v", err), nil)
 	}
 
-	workflowRunID := workflowRun.GetID()
-	logger = logger.With().Str("WorkflowID", workflowRunID).Logger()
-	logger.Info().Msg("executing CreateExpectedMachines workflow on Site")
-
-	// Get workflow results
 	var workflowResult cwssaws.BatchExpectedMachineOperationResponse
-
-	err = workflowRun.Get(ctx, &workflowResult)
+	err = workflowRun.Get(ctxWithTimeout, &workflowResult)
 	if err != nil {
-		logger.Error().Err(err).Msg("error executing CreateExpectedMachines workflow on Site")
-		// Workflow failed entirely - don't commit transaction
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, fmt.Sprintf("Failed to execute batch Expected Machine creation workflow on Site: %v", err), nil)
+		code, uwerr := common.UnwrapWorkflowError(err)
+		logger.Error().Err(uwerr).Msg("error executing CreateExpectedMachines workflow on Site")
+		return nil, cutil.NewAPIError(code, fmt.Sprintf("Failed to execute Expected Machine creation workflow on Site: %s", uwerr), nil)
 	}
 
-	// sanity checks since this is all-or-nothing
-	if len(workflowResult.GetResults()) != len(createdExpectedMachines) {
-		logger.Warn().Msgf("Workflow returned a different number of Expected Machines (expected %d but got %d)", len(createdExpectedMachines), len(workflowResult.GetResults()))
+	// Creation is all-or-nothing, entries rejected by the Site are reported per index and nothing is committed
+	for _, result := range workflowResult.GetResults() {
+		if result.GetSuccess() {
+			continue
+		}
+		key := result.GetId().GetValue()
+		if i, ok := indexByID[key]; ok {
+			key = strconv.Itoa(i)
+		}
+		validationErrors[key] = errors.New(result.GetErrorMessage())
+	}
+	if len(validationErrors) > 0 {
+		logger.Warn().Int("Count", len(validationErrors)).Msg("Site rejected Expected Machine entries")
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Site rejected one or more Expected Machines, none were created", validationErrors)
 	}
 
 	// Commit transaction
 	err = tx.Commit()
 	if err != nil {
-		logger.Error().Err(err).Msg("error committing ExpectedMachine CreateExpectedMachines transaction to DB")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Expected Machines due to DB transaction error", nil)
+		logger.Error().Err(err).Msg("error committing Expected Machine create transaction to DB")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to create Expected Machines due to DB transaction error", nil)
 	}
 	txCommitted = true
 
-	logger.Info().
-		Int("SuccessCount", len(createdExpectedMachines)).
-		Msg("finishing CreateExpectedMachines API handler")
+	return createdMachines, nil
+}
 
-	// Return only successful machines
-	return c.JSON(http.StatusCreated, createdExpectedMachines)
+// newExpectedMachineCreateWorkflowRequest builds the Site workflow request for a newly created Expected Machine
+// BMC credentials come from API request since they're not stored in DB
+func newExpectedMachineCreateWorkflowRequest(expectedMachine *cdbm.ExpectedMachine, apiRequest *model.APIExpectedMachineCreateRequest) *cwssaws.ExpectedMachine {
+	workflowMachine := &cwssaws.ExpectedMachine{
+		Id:                       &cwssaws.UUID{Value: expectedMachine.ID.String()},
+		BmcMacAddress:            expectedMachine.BmcMacAddress,
+		ChassisSerialNumber:      expectedMachine.ChassisSerialNumber,
+		FallbackDpuSerialNumbers: expectedMachine.FallbackDpuSerialNumbers,
+		SkuId:                    expectedMachine.SkuID,
+	}
+
+	if apiRequest.DefaultBmcUsername != nil {
+		workflowMachine.BmcUsername = *apiRequest.DefaultBmcUsername
+	}
+
+	if apiRequest.DefaultBmcPassword != nil {
+		workflowMachine.BmcPassword = *apiRequest.DefaultBmcPassword
+	}
+
+	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
+	if protoLabels != nil {
+		workflowMachine.Metadata = &cwssaws.Metadata{
+			Labels: protoLabels,
+		}
+	}
+
+	return workflowMachine
 }
 
 // ~~~~~ Batch Update Handler ~~~~~ //
@@ -190,31 +190,7 @@ func (cepsh CreateExpectedPowerShelfHandler) Handle(c echo.Context) error {
 	}
 
 	// Build the create request for workflow
-	// BMC credentials come from API request since they're not stored in DB
-	createExpectedPowerShelfRequest := &cwssaws.ExpectedPowerShelf{
-		Id:                &cwssaws.UUID{Value: expectedPowerShelf.ID.String()},
-		BmcMacAddress:     expectedPowerShelf.BmcMacAddress,
-		ShelfSerialNumber: expectedPowerShelf.ShelfSerialNumber,
-	}
-
-	if expectedPowerShelf.IpAddress != nil {
-		createExpectedPowerShelfRequest.IpAddress = *expectedPowerShelf.IpAddress
-	}
-
-	if apiRequest.DefaultBmcUsername != nil {
-		createExpectedPowerShelfRequest.BmcUsername = *apiRequest.DefaultBmcUsername
-	}
-
-	if apiRequest.DefaultBmcPassword != nil {
-		createExpectedPowerShelfRequest.BmcPassword = *apiRequest.DefaultBmcPassword
-	}
-
-	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
-	if protoLabels != nil {
-		createExpectedPowerShelfRequest.Metadata = &cwssaws.Metadata{
-			Labels: protoLabels,
-		}
-	}
+	createExpectedPowerShelfRequest := newExpectedPowerShelfCreateWorkflowRequest(expectedPowerShelf, &apiRequest)
 
 	logger.Info().Msg("triggering Expected Power Shelf create workflow on Site")
 
@@ -254,6 +230,37 @@ func (cepsh CreateExpectedPowerShelfHandler) Handle(c echo.Context) error {
 	return c.JSON(http.StatusCreated, apiExpectedPowerShelf)
 }
 
+// newExpectedPowerShelfCreateWorkflowRequest builds the Site workflow request for a newly created Expected Power Shelf
+// BMC credentials come from API request since they're not stored in DB
+func newExpectedPowerShelfCreateWorkflowRequest(expectedPowerShelf *cdbm.ExpectedPowerShelf, apiRequest *model.APIExpectedPowerShelfCreateRequest) *cwssaws.ExpectedPowerShelf {
+	workflowShelf := &cwssaws.ExpectedPowerShelf{
+		Id:                &cwssaws.UUID{Value: expectedPowerShelf.ID.String()},
+		BmcMacAddress:     expectedPowerShelf.BmcMacAddress,
+		ShelfSerialNumber: expectedPowerShelf.ShelfSerialNumber,
+	}
+
+	if expectedPowerShelf.IpAddress != nil {
+		workflowShelf.IpAddress = *expectedPowerShelf.IpAddress
+	}
+
+	if apiRequest.DefaultBmcUsername != nil {
+		workflowShelf.BmcUsername = *apiRequest.DefaultBmcUsername
+	}
+
+	if apiRequest.DefaultBmcPassword != nil {
+		workflowShelf.BmcPassword = *apiRequest.DefaultBmcPassword
+	}
+
+	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
+	if protoLabels != nil {
+		workflowShelf.Metadata = &cwssaws.Metadata{
+			Labels: protoLabels,
+		}
+	}
+
+	return workflowShelf
+}
+
 // ~~~~~ GetAll Handler ~~~~~ //
 
 // GetAllExpectedPowerShelfHandler is the API Handler for getting all ExpectedPowerShelves
@@ -287,6 +294,7 @@ func NewGetAllExpectedPowerShelfHandler(dbSession *cdb.Session, tc tclient.Clien
 // @Param includeRelation query string false "Related entities to include in response e.g. 'Site'"
 // @Param pageSize query integer false "Number of results per page"
 // @Param orderBy query string false "Order by field"
+// @Param format query string false "Response format, 'json' (default) or 'csv' to export all matching entries in the bulk import CSV format"
 // @Success 200 {object} []model.APIExpectedPowerShelf
 // @Router /v2/org/{org}/carbide/expected-power-shelf [get]
 func (gaepsh GetAllExpectedPowerShelfHandler) Handle(c echo.Context) error {
@@ -377,17 +385,29 @@ func (gaepsh GetAllExpectedPowerShelfHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate pagination request data", err)
 	}
 
+	// CSV exports include all matching entries rather than a single page
+	isCSVExport, apiErr := isExpectedDeviceCSVExport(c)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	pageInput := paginator.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}
+	if isCSVExport {
+		pageInput.Offset = nil
+		pageInput.Limit = cdb.GetIntPtr(paginator.TotalLimit)
+	}
+
 	// Get Expected Power Shelves from DB
 	epsDAO := cdbm.NewExpectedPowerShelfDAO(gaepsh.dbSession)
 	expectedPowerShelves, total, err := epsDAO.GetAll(
 		ctx,
 		nil,
 		filterInput,
-		paginator.PageInput{
-			Offset:  pageRequest.Offset,
-			Limit:   pageRequest.Limit,
-			OrderBy: pageRequest.OrderBy,
-		}, qIncludeRelations,
+		pageInput, qIncludeRelations,
 	)
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving Expected Power Shelves from db")
@@ -401,6 +421,14 @@ func (gaepsh GetAllExpectedPowerShelfHandler) Handle(c echo.Context) error {
 		apiExpectedPowerShelves = append(apiExpectedPowerShelves, apiExpectedPowerShelf)
 	}
 
+	if isCSVExport {
+		records := make([][]string, 0, len(apiExpectedPowerShelves))
+		for _, apiObj := range apiExpectedPowerShelves {
+			records = append(records, model.ExpectedPowerShelfCSVRecord(apiObj))
+		}
+		return writeExpectedDeviceCSV(c, logger, "expected-power-shelves.csv", model.ExpectedPowerShelfCSVHeader, records)
+	}
+
 	// Create pagination response header
 	pageResponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
 	pageHeader, err := json.Marshal(pageResponse)
@@ -189,35 +189,7 @@ func (cesh CreateExpectedSwitchHandler) Handle(c echo.Context) error {
 	}
 
 	// Build the create request for workflow
-	// NVOS credentials come from API request since they're not stored in DB
-	createExpectedSwitchRequest := &cwssaws.ExpectedSwitch{
-		Id:                 &cwssaws.UUID{Value: expectedSwitch.ID.String()},
-		BmcMacAddress:      expectedSwitch.BmcMacAddress,
-		SwitchSerialNumber: expectedSwitch.SwitchSerialNumber,
-	}
-
-	if apiRequest.DefaultBmcUsername != nil {
-		createExpectedSwitchRequest.BmcUsername = *apiRequest.DefaultBmcUsername
-	}
-
-	if apiRequest.DefaultBmcPassword != nil {
-		createExpectedSwitchRequest.BmcPassword = *apiRequest.DefaultBmcPassword
-	}
-
-	if apiRequest.NvOsUsername != nil {
-		createExpectedSwitchRequest.NvosUsername = apiRequest.NvOsUsername
-	}
-
-	if apiRequest.NvOsPassword != nil {
-		createExpectedSwitchRequest.NvosPassword = apiRequest.NvOsPassword
-	}
-
-	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
-	if protoLabels != nil {
-		createExpectedSwitchRequest.Metadata = &cwssaws.Metadata{
-			Labels: protoLabels,
-		}
-	}
+	createExpectedSwitchRequest := newExpectedSwitchCreateWorkflowRequest(expectedSwitch, &apiRequest)
 
 	logger.Info().Msg("triggering Expected Switch create workflow on Site")
 
@@ -257,6 +229,35 @@ func (cesh CreateExpectedSwitchHandler) Handle(c echo.Context) error {
 	return c.JSON(http.StatusCreated, apiExpectedSwitch)
 }
 
+// newExpectedSwitchCreateWorkflowRequest builds the Site workflow request for a newly created Expected Switch
+// NVOS and BMC credentials come from API request since they're not stored in DB
+func newExpectedSwitchCreateWorkflowRequest(expectedSwitch *cdbm.ExpectedSwitch, apiRequest *model.APIExpectedSwitchCreateRequest) *cwssaws.ExpectedSwitch {
+	workflowSwitch := &cwssaws.ExpectedSwitch{
+		Id:                 &cwssaws.UUID{Value: expectedSwitch.ID.String()},
+		BmcMacAddress:      expectedSwitch.BmcMacAddress,
+		SwitchSerialNumber: expectedSwitch.SwitchSerialNumber,
+		NvosUsername:       apiRequest.NvOsUsername,
+		NvosPassword:       apiRequest.NvOsPassword,
+	}
+
+	if apiRequest.DefaultBmcUsername != nil {
+		workflowSwitch.BmcUsername = *apiRequest.DefaultBmcUsername
+	}
+
+	if apiRequest.DefaultBmcPassword != nil {
+		workflowSwitch.BmcPassword = *apiRequest.DefaultBmcPassword
+	}
+
+	protoLabels := util.ProtobufLabelsFromAPILabels(apiRequest.Labels)
+	if protoLabels != nil {
+		workflowSwitch.Metadata = &cwssaws.Metadata{
+			Labels: protoLabels,
+		}
+	}
+
+	return workflowSwitch
+}
+
 // ~~~~~ GetAll Handler ~~~~~ //
 
 // GetAllExpectedSwitchHandler is the API Handler for getting all ExpectedSwitches
@@ -290,6 +291,7 @@ func NewGetAllExpectedSwitchHandler(dbSession *cdb.Session, tc tclient.Client, c
 // @Param includeRelation query string false "Related entities to include in response e.g. 'Site'"
 // @Param pageSize query integer false "Number of results per page"
 // @Param orderBy query string false "Order by field"
+// @Param format query string false "Response format, 'json' (default) or 'csv' to export all matching entries in the bulk import CSV format"
 // @Success 200 {object} []model.APIExpectedSwitch
 // @Router /v2/org/{org}/carbide/expected-switch [get]
 func (gaesh GetAllExpectedSwitchHandler) Handle(c echo.Context) error {
@@ -380,17 +382,29 @@ func (gaesh GetAllExpectedSwitchHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to validate pagination request data", err)
 	}
 
+	// CSV exports include all matching entries rather than a single page
+	isCSVExport, apiErr := isExpectedDeviceCSVExport(c)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	pageInput := paginator.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}
+	if isCSVExport {
+		pageInput.Offset = nil
+		pageInput.Limit = cdb.GetIntPtr(paginator.TotalLimit)
+	}
+
 	// Get Expected Switches from DB
 	esDAO := cdbm.NewExpectedSwitchDAO(gaesh.dbSession)
 	expectedSwitches, total, err := esDAO.GetAll(
 		ctx,
 		nil,
 		filterInput,
-		paginator.PageInput{
-			Offset:  pageRequest.Offset,
-			Limit:   pageRequest.Limit,
-			OrderBy: pageRequest.OrderBy,
-		}, qIncludeRelations,
+		pageInput, qIncludeRelations,
 	)
 	if err != nil {
 		logger.Error().Err(err).Msg("error retrieving Expected Switches from db")
@@ -404,6 +418,14 @@ func (gaesh GetAllExpectedSwitchHandler) Handle(c echo.Context) error {
 		apiExpectedSwitches = append(apiExpectedSwitches, apiExpectedSwitch)
 	}
 
+	if isCSVExport {
+		records := make([][]string, 0, len(apiExpectedSwitches))
+		for _, apiObj := range apiExpectedSwitches {
+			records = append(records, model.ExpectedSwitchCSVRecord(apiObj))
+		}
+		return writeExpectedDeviceCSV(c, logger, "expected-switches.csv", model.ExpectedSwitchCSVHeader, records)
+	}
+
 	// Create pagination response header
 	pageResponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
 	pageHeader, err := json.Marshal(pageResponse)
@@ -79,7 +79,7 @@ func NewCreateInstanceHandler(dbSession *cdb.Session, tc temporalClient.Client,
 // apiRequest will be mutated for use in createFromParams.
 // osConfig will hold the struct/data for use with Temporal/Carbide calls.
 // Errors should be returned in the form of cutil.NewAPIErrorResponse
-func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIInstanceCreateRequest, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *cutil.APIError) {
+func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIInstanceCreateRequest, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *uuid.UUID, *cutil.APIError) {
 
 	ctx := c.Request().Context()
 
@@ -88,7 +88,7 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 
 		if err := apiRequest.ValidateAndSetOperatingSystemData(cih.cfg, nil); err != nil {
 			logger.Error().Err(err).Msg("failed to validate OperatingSystem")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
 		}
 
 		return &cwssaws.OperatingSystem{
@@ -100,7 +100,7 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, nil, nil
+		}, nil, nil, nil
 	}
 
 	// Otherwise, we'll use the OS sent by the caller
@@ -110,7 +110,7 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 
 	if id, err = uuid.Parse(*apiRequest.OperatingSystemID); err != nil {
 		logger.Error().Err(err).Msg("failed to parse OperatingSystemID")
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
 			"operatingSystemId": errors.New(*apiRequest.OperatingSystemID),
 		})
 	}
@@ -122,12 +122,12 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 	os, serr := osDAO.GetByID(ctx, nil, *osID, nil)
 	if serr != nil {
 		if serr == cdb.ErrDoesNotExist {
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
 				"id": errors.New(osID.String()),
 			})
 		}
 		logger.Error().Err(serr).Msg("error retrieving OperatingSystem from DB by ID")
-		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
+		return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
 			"id": errors.New(osID.String()),
 		})
 	}
@@ -140,38 +140,53 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 	// Confirm ownership between tenant and OS.
 	if os.TenantID.String() != apiRequest.TenantID {
 		logger.Error().Msg("OperatingSystem in request is not owned by tenant")
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not owned by Tenant", nil)
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not owned by Tenant", nil)
 	}
 
 	if os.Type == cdbm.OperatingSystemTypeImage {
 		if site.Config == nil || !site.Config.ImageBasedOperatingSystem {
 			logger.Warn().Str("operatingSystemId", os.ID.String()).Str("siteId", site.ID.String()).Msg("Creation of Instance with Image based Operating System is not supported for Site, ImageBasedOperatingSystem capability is not enabled")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Creation of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Creation of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
 		}
 	}
 
-	// Confirm match between site and OS (only for Image type).
-	if os.Type == cdbm.OperatingSystemTypeImage {
+	// Resolve the Operating System Version to provision the Instance with
+	osv, apiErr := getOperatingSystemVersionForInstance(ctx, logger, cih.dbSession, os, apiRequest.OperatingSystemVersionID, site)
+	if apiErr != nil {
+		return nil, nil, nil, apiErr
+	}
+
+	var osvID *uuid.UUID
+	imageID := os.ID
+	if osv != nil {
+		osvID = &osv.ID
+		imageID = osv.ID
+		os = applyOperatingSystemVersion(os, osv)
+	}
+
+	// Confirm match between site and OS (only for Image type), versions are already confirmed to be in the site
+	if osv == nil && os.Type == cdbm.OperatingSystemTypeImage {
 		ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(cih.dbSession)
 		_, ossaCount, err := ossaDAO.GetAll(
 			ctx,
 			nil,
 			cdbm.OperatingSystemSiteAssociationFilterInput{
-				OperatingSystemIDs: []uuid.UUID{id},
-				SiteIDs:            []uuid.UUID{site.ID},
+				OperatingSystemIDs:        []uuid.UUID{id},
+				SiteIDs:                   []uuid.UUID{site.ID},
+				HasOperatingSystemVersion: cdb.GetBoolPtr(false),
 			},
 			cdbp.PageInput{Limit: cdb.GetIntPtr(1)},
 			nil,
 		)
 		if err != nil {
 			logger.Error().Msgf("Error retrieving OperatingSystemAssociations for OS: %s", err)
-			return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
+			return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
 				"id": errors.New(osID.String()),
 			})
 		}
 		if ossaCount == 0 {
 			logger.Error().Msg("OperatingSystem does not belong to VPC site")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
 		}
 	}
 
@@ -182,7 +197,7 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 	err = apiRequest.ValidateAndSetOperatingSystemData(cih.cfg, os)
 	if err != nil {
 		logger.Error().Msgf("OperatingSystem options validation failed: %s", err)
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
 	}
 
 	// Options below should all have been set by the
@@ -198,17 +213,17 @@ func (cih CreateInstanceHandler) buildInstanceCreateRequestOsConfig(c echo.Conte
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, osID, nil
+		}, osID, osvID, nil
 	} else {
 		return &cwssaws.OperatingSystem{
 			PhoneHomeEnabled: *apiRequest.PhoneHomeEnabled,
 			Variant: &cwssaws.OperatingSystem_OsImageId{
 				OsImageId: &cwssaws.UUID{
-					Value: os.ID.String(),
+					Value: imageID.String(),
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, osID, nil
+		}, osID, osvID, nil
 	}
 }
 
@@ -230,7 +245,8 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 	//    - Validate org membership
 	//    - Validate Tenant Admin role
 	// 2. Request Validation
-	//    - Bind and validate request data
+	//    - Bind request data and apply Instance Template attributes
+	//    - Validate request data
 	//    - Validate tenant, VPC, site
 	//    - Load and validate Interfaces (Subnets, VPC Prefixes)
 	//    - Load and validate DPU Extension Service Deployments
@@ -306,13 +322,6 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
 	}
 
-	// Validate request attributes
-	verr := apiRequest.Validate()
-	if verr != nil {
-		logger.Warn().Err(verr).Msg("error validating Instance creation request data")
-		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Instance creation request data", verr)
-	}
-
 	// Validate the tenant for which this Instance is being created
 	tenant, err := common.GetTenantForOrg(ctx, nil, cih.dbSession, org)
 	if err != nil {
@@ -324,6 +333,26 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
 	}
 
+	// Fill in attributes not specified in request from the Instance Template, if one was specified
+	var instanceTemplateVersion *cdbm.InstanceTemplateVersion
+	if apiRequest.TemplateID != nil {
+		var itc *model.APIInstanceTemplateConfig
+		var apiErr *cutil.APIError
+		instanceTemplateVersion, itc, apiErr = getInstanceTemplateConfigForInstanceRequest(ctx, cih.dbSession, tenant, *apiRequest.TemplateID, apiRequest.TemplateVersion)
+		if apiErr != nil {
+			logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+			return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+		}
+		itc.ApplyToInstanceCreateRequest(&apiRequest)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Instance creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Instance creation request data", verr)
+	}
+
 	// verify tenant-id in request, the api validation ensures non-nil tenantID in request
 	apiTenant, err := common.GetTenantFromIDString(ctx, nil, apiRequest.TenantID, cih.dbSession)
 	if err != nil {
@@ -656,7 +685,7 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 	// apiRequest will be mutated for use in CreateFromParams.
 	// osConfig will hold the struct/data for use with Temporal/Carbide calls.
 	// Errors will be returned already in the form of cutil.NewAPIErrorResponse
-	osConfig, osID, oserr := cih.buildInstanceCreateRequestOsConfig(c, &logger, &apiRequest, site)
+	osConfig, osID, osvID, oserr := cih.buildInstanceCreateRequestOsConfig(c, &logger, &apiRequest, site)
 	if oserr != nil {
 		// buildInstanceCreateRequestOsConfig already handles logging,
 		// so this is a bit redundant, but this log brings you to the
@@ -1150,6 +1179,7 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		VpcID:                    vpc.ID,
 		MachineID:                cdb.GetStrPtr(machine.ID),
 		OperatingSystemID:        osID,
+		OperatingSystemVersionID: osvID,
 		IpxeScript:               apiRequest.IpxeScript,
 		AlwaysBootWithCustomIpxe: *apiRequest.AlwaysBootWithCustomIpxe,
 		PhoneHomeEnabled:         *apiRequest.PhoneHomeEnabled,
@@ -1170,6 +1200,12 @@ func (cih CreateInstanceHandler) Handle(c echo.Context) error {
 		instanceCreateInput.AllocationConstraintID = &selectedAllocationConstraint.ID
 	}
 
+	// Record the Instance Template version the Instance was created from
+	if instanceTemplateVersion != nil {
+		instanceCreateInput.InstanceTemplateID = &instanceTemplateVersion.InstanceTemplateID
+		instanceCreateInput.InstanceTemplateVersion = &instanceTemplateVersion.Version
+	}
+
 	instance, err := instanceDAO.Create(ctx, tx, instanceCreateInput)
 	if err != nil {
 		logger.Error().Err(err).Msg("unable to create Instance record in DB")
@@ -1731,10 +1767,12 @@ func (uih UpdateInstanceHandler) handleReboot(c echo.Context, logger *zerolog.Lo
 // apiRequest will be mutated for use in UpdateFromParams.
 // osConfig will hold the struct/data for use with Temporal/Carbide calls.
 // Errors should be returned in the form of cutil.NewAPIErrorResponse
-func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIInstanceUpdateRequest, instance *cdbm.Instance, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *cutil.APIError) {
+func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIInstanceUpdateRequest, instance *cdbm.Instance, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *uuid.UUID, *cutil.APIError) {
 
 	var os *cdbm.OperatingSystem
 	var osID *uuid.UUID
+	var osv *cdbm.OperatingSystemVersion
+	var osvID *uuid.UUID
 
 	ctx := c.Request().Context()
 
@@ -1743,7 +1781,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 
 		if err := apiRequest.ValidateAndSetOperatingSystemData(uih.cfg, instance, nil); err != nil {
 			logger.Error().Err(err).Msg("failed to validate OperatingSystem")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
 		}
 
 		return &cwssaws.OperatingSystem{
@@ -1755,7 +1793,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, nil, nil
+		}, nil, nil, nil
 	}
 
 	// If the base OS is either not changing OR the base is changing to another OS and NOT simply being cleared,
@@ -1771,7 +1809,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 
 		if id, err = uuid.Parse(*apiRequest.OperatingSystemID); err != nil {
 			logger.Error().Err(err).Msg("failed to parse OperatingSystemID")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
 				"operatingSystemId": errors.New(*apiRequest.OperatingSystemID),
 			})
 		}
@@ -1779,6 +1817,11 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 		osID = &id
 	}
 
+	if osID == nil && apiRequest.OperatingSystemVersionID != nil {
+		logger.Warn().Msg("OperatingSystemVersionID specified for Instance without Operating System")
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "`operatingSystemVersionId` can only be specified when Instance has an Operating System or `operatingSystemId` is specified", nil)
+	}
+
 	if osID != nil {
 		var serr error
 
@@ -1787,12 +1830,12 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 		os, serr = osDAO.GetByID(ctx, nil, *osID, nil)
 		if serr != nil {
 			if serr == cdb.ErrDoesNotExist {
-				return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
+				return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
 					"id": errors.New(osID.String()),
 				})
 			}
 			logger.Error().Err(serr).Msg("error retrieving OperatingSystem from DB by ID")
-			return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
+			return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
 				"id": errors.New(osID.String()),
 			})
 		}
@@ -1805,39 +1848,65 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 		// Confirm ownership between tenant and OS.
 		if os.TenantID.String() != instance.Tenant.ID.String() {
 			logger.Error().Msg("OperatingSystem in request is not owned by tenant")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Operating system specified in request is not owned by Tenant", nil)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Operating system specified in request is not owned by Tenant", nil)
 		}
 
 		// Validate the Site has the ImageBasedOperatingSystem capability enabled for Image based Operating Systems
 		if os.Type == cdbm.OperatingSystemTypeImage {
 			if site.Config == nil || !site.Config.ImageBasedOperatingSystem {
 				logger.Warn().Str("operatingSystemId", os.ID.String()).Str("siteId", site.ID.String()).Msg("Instance update with Image based Operating System is not supported for Site, ImageBasedOperatingSystem capability is not enabled")
-				return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Update of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
+				return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Update of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
 			}
 		}
 
-		// Confirm match between site and OS (only for Image type).
-		if os.Type == cdbm.OperatingSystemTypeImage {
+		// Resolve the Operating System Version, Instances keep their current version unless a version
+		// is requested or the Operating System is changing
+		isSameOs := instance.OperatingSystemID != nil && *instance.OperatingSystemID == *osID
+		if apiRequest.OperatingSystemVersionID == nil && isSameOs {
+			if instance.OperatingSystemVersionID != nil {
+				osvDAO := cdbm.NewOperatingSystemVersionDAO(uih.dbSession)
+				osv, serr = osvDAO.GetByID(ctx, nil, *instance.OperatingSystemVersionID, nil)
+				if serr != nil && serr != cdb.ErrDoesNotExist {
+					logger.Error().Err(serr).Msg("error retrieving Operating System Version of Instance from DB by ID")
+					return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Operating System Version of Instance, DB error", nil)
+				}
+			}
+		} else {
+			var apiErr *cutil.APIError
+			osv, apiErr = getOperatingSystemVersionForInstance(ctx, logger, uih.dbSession, os, apiRequest.OperatingSystemVersionID, site)
+			if apiErr != nil {
+				return nil, nil, nil, apiErr
+			}
+		}
+
+		if osv != nil {
+			osvID = &osv.ID
+			os = applyOperatingSystemVersion(os, osv)
+		}
+
+		// Confirm match between site and OS (only for Image type), versions are already confirmed to be in the site
+		if osv == nil && os.Type == cdbm.OperatingSystemTypeImage {
 			ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(uih.dbSession)
 			_, ossaCount, err := ossaDAO.GetAll(
 				ctx,
 				nil,
 				cdbm.OperatingSystemSiteAssociationFilterInput{
-					OperatingSystemIDs: []uuid.UUID{*osID},
-					SiteIDs:            []uuid.UUID{site.ID},
+					OperatingSystemIDs:        []uuid.UUID{*osID},
+					SiteIDs:                   []uuid.UUID{site.ID},
+					HasOperatingSystemVersion: cdb.GetBoolPtr(false),
 				},
 				cdbp.PageInput{Limit: cdb.GetIntPtr(1)},
 				nil,
 			)
 			if err != nil {
 				logger.Error().Msgf("Error retrieving OperatingSystemAssociations for OS: %s", err)
-				return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
+				return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
 					"id": errors.New(osID.String()),
 				})
 			}
 			if ossaCount == 0 {
 				logger.Error().Msg("OperatingSystem does not belong to VPC site")
-				return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
+				return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
 			}
 		}
 	}
@@ -1845,7 +1914,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 	// reject deactivated OS except if OS stays the same:
 	if os != nil && !os.IsActive {
 		if apiRequest.OperatingSystemID != nil && instance.OperatingSystemID != nil && *apiRequest.OperatingSystemID != instance.OperatingSystemID.String() {
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Operating System specified in request has been deactivated and cannot be used to update an instance", nil)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Operating System specified in request has been deactivated and cannot be used to update an instance", nil)
 		}
 	}
 
@@ -1858,7 +1927,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 	err := apiRequest.ValidateAndSetOperatingSystemData(uih.cfg, instance, os)
 	if err != nil {
 		logger.Error().Msgf("OperatingSystem options validation failed: %s", err)
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
 	}
 
 	// Here, we'll default to whatever the instance already had set,
@@ -1887,6 +1956,11 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 	}
 
 	if os != nil {
+		osImageID := os.ID
+		if osv != nil {
+			osImageID = osv.ID
+		}
+
 		if os.Type == cdbm.OperatingSystemTypeIPXE {
 			return &cwssaws.OperatingSystem{
 				RunProvisioningInstructionsOnEveryBoot: alwaysBootWithCustomIpxe,
@@ -1897,17 +1971,17 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 					},
 				},
 				UserData: userData,
-			}, osID, nil
+			}, osID, osvID, nil
 		} else if os.Type == cdbm.OperatingSystemTypeImage {
 			return &cwssaws.OperatingSystem{
 				PhoneHomeEnabled: phoneHomeEnabled,
 				Variant: &cwssaws.OperatingSystem_OsImageId{
 					OsImageId: &cwssaws.UUID{
-						Value: os.ID.String(),
+						Value: osImageID.String(),
 					},
 				},
 				UserData: userData,
-			}, osID, nil
+			}, osID, osvID, nil
 		}
 	}
 
@@ -1920,7 +1994,7 @@ func (uih UpdateInstanceHandler) buildInstanceUpdateRequestOsConfig(c echo.Conte
 			},
 		},
 		UserData: userData,
-	}, osID, nil
+	}, osID, osvID, nil
 }
 
 // Handle godoc
@@ -2555,7 +2629,7 @@ func (uih UpdateInstanceHandler) Handle(c echo.Context) error {
 	// apiRequest will be mutated for use in UpdateFromParams.
 	// osConfig will hold the struct/data for use with Temporal/Carbide calls.
 	// Errors will be returned already in the form of cutil.NewAPIError
-	osConfig, osID, oserr := uih.buildInstanceUpdateRequestOsConfig(c, &logger, &apiRequest, instance, site)
+	osConfig, osID, osvID, oserr := uih.buildInstanceUpdateRequestOsConfig(c, &logger, &apiRequest, instance, site)
 	if oserr != nil {
 		// buildInstanceUpdateRequestOsConfig already handles logging,
 		// so this is a bit redundant, but this log brings you to the
@@ -2578,6 +2652,7 @@ func (uih UpdateInstanceHandler) Handle(c echo.Context) error {
 			Name:                     apiRequest.Name,
 			Description:              apiRequest.Description,
 			OperatingSystemID:        osID,
+			OperatingSystemVersionID: osvID,
 			IpxeScript:               apiRequest.IpxeScript,
 			AlwaysBootWithCustomIpxe: apiRequest.AlwaysBootWithCustomIpxe,
 			NetworkSecurityGroupID:   nsgID,
@@ -2600,6 +2675,12 @@ func (uih UpdateInstanceHandler) Handle(c echo.Context) error {
 		shouldClear = true
 	}
 
+	// Clear the Operating System Version if the Instance is no longer provisioned with one
+	if osvID == nil && instance.OperatingSystemVersionID != nil {
+		clearInput.OperatingSystemVersionID = true
+		shouldClear = true
+	}
+
 	// If this request is attempting to clear the NSG for the instance, set it.
 	if apiRequest.NetworkSecurityGroupID != nil {
 		if *apiRequest.NetworkSecurityGroupID == "" {
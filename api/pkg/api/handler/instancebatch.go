@@ -77,8 +77,8 @@ func NewBatchCreateInstanceHandler(dbSession *cdb.Session, tc temporalClient.Cli
 
 // buildBatchInstanceCreateRequestOsConfig validates and retrieves OS configuration for batch instance creation.
 // This mirrors the behavior of CreateInstanceHandler.buildInstanceCreateRequestOsConfig.
-// Returns: osConfig, osID, and error (matching single API pattern)
-func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIBatchInstanceCreateRequest, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *cutil.APIError) {
+// Returns: osConfig, osID, osvID, and error (matching single API pattern)
+func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c echo.Context, logger *zerolog.Logger, apiRequest *model.APIBatchInstanceCreateRequest, site *cdbm.Site) (*cwssaws.OperatingSystem, *uuid.UUID, *uuid.UUID, *cutil.APIError) {
 
 	ctx := c.Request().Context()
 
@@ -87,7 +87,7 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 
 		if err := apiRequest.ValidateAndSetOperatingSystemData(bcih.cfg, nil); err != nil {
 			logger.Error().Err(err).Msg("failed to validate OperatingSystem")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Failed to validate OperatingSystem data", err)
 		}
 
 		return &cwssaws.OperatingSystem{
@@ -99,7 +99,7 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, nil, nil
+		}, nil, nil, nil
 	}
 
 	// Otherwise, we'll use the OS sent by the caller
@@ -109,7 +109,7 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 
 	if id, err = uuid.Parse(*apiRequest.OperatingSystemID); err != nil {
 		logger.Error().Err(err).Msg("failed to parse OperatingSystemID")
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemId` specified", validation.Errors{
 			"operatingSystemId": errors.New(*apiRequest.OperatingSystemID),
 		})
 	}
@@ -121,12 +121,12 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 	os, serr := osDAO.GetByID(ctx, nil, *osID, nil)
 	if serr != nil {
 		if serr == cdb.ErrDoesNotExist {
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find OperatingSystem with ID specified in request data", validation.Errors{
 				"id": errors.New(osID.String()),
 			})
 		}
 		logger.Error().Err(serr).Msg("error retrieving OperatingSystem from DB by ID")
-		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
+		return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystem with ID specified in request data, DB error", validation.Errors{
 			"id": errors.New(osID.String()),
 		})
 	}
@@ -139,16 +139,30 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 	// Confirm ownership between tenant and OS.
 	if os.TenantID.String() != apiRequest.TenantID {
 		logger.Error().Msg("OperatingSystem in request is not owned by tenant")
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not owned by Tenant", nil)
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not owned by Tenant", nil)
 	}
 
 	if os.Type == cdbm.OperatingSystemTypeImage {
 		if site.Config == nil || !site.Config.ImageBasedOperatingSystem {
 			logger.Warn().Str("operatingSystemId", os.ID.String()).Str("siteId", site.ID.String()).Msg("Creation of Instance with Image based Operating System is not supported for Site, ImageBasedOperatingSystem capability is not enabled")
-			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Creation of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
+			return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Creation of Instance with Image based Operating System is not supported. Site must have ImageBasedOperatingSystem capability enabled.", nil)
 		}
 	}
 
+	// Resolve the Operating System Version to provision the Instances with
+	osv, apiErr := getOperatingSystemVersionForInstance(ctx, logger, bcih.dbSession, os, apiRequest.OperatingSystemVersionID, site)
+	if apiErr != nil {
+		return nil, nil, nil, apiErr
+	}
+
+	var osvID *uuid.UUID
+	imageID := os.ID
+	if osv != nil {
+		osvID = &osv.ID
+		imageID = osv.ID
+		os = applyOperatingSystemVersion(os, osv)
+	}
+
 	// Confirm match between site and OS (only for Image type).
 	/*
 		if os.Type == cdbm.OperatingSystemTypeImage {
@@ -165,13 +179,13 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 			)
 			if err != nil {
 				logger.Error().Msgf("Error retrieving OperatingSystemAssociations for OS: %s", err)
-				return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
+				return nil, nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve OperatingSystemAssociations for OS with ID specified in request data, DB error", validation.Errors{
 					"id": errors.New(osID.String()),
 				})
 			}
 			if ossaCount == 0 {
 				logger.Error().Msg("OperatingSystem does not belong to VPC site")
-				return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
+				return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem specified in request is not in VPC site", nil)
 			}
 		}*/
 
@@ -182,7 +196,7 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 	err = apiRequest.ValidateAndSetOperatingSystemData(bcih.cfg, os)
 	if err != nil {
 		logger.Error().Msgf("OperatingSystem options validation failed: %s", err)
-		return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
+		return nil, nil, nil, cutil.NewAPIError(http.StatusBadRequest, "OperatingSystem options validation failed", err)
 	}
 
 	// Options below should all have been set by the
@@ -198,17 +212,17 @@ func (bcih BatchCreateInstanceHandler) buildBatchInstanceCreateRequestOsConfig(c
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, osID, nil
+		}, osID, osvID, nil
 	} else {
 		return &cwssaws.OperatingSystem{
 			PhoneHomeEnabled: *apiRequest.PhoneHomeEnabled,
 			Variant: &cwssaws.OperatingSystem_OsImageId{
 				OsImageId: &cwssaws.UUID{
-					Value: os.ID.String(),
+					Value: imageID.String(),
 				},
 			},
 			UserData: apiRequest.UserData,
-		}, osID, nil
+		}, osID, osvID, nil
 	}
 }
 
@@ -336,6 +350,30 @@ func (bcih BatchCreateInstanceHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
 	}
 
+	// Validate the tenant for which these Instances are being created
+	tenant, err := common.GetTenantForOrg(ctx, nil, bcih.dbSession, org)
+	if err != nil {
+		if err == common.ErrOrgTenantNotFound {
+			logger.Warn().Err(err).Msg("Org does not have a Tenant associated")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Org does not have a Tenant associated", nil)
+		}
+		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	// Fill in attributes not specified in request from the Instance Template, if one was specified
+	var instanceTemplateVersion *cdbm.InstanceTemplateVersion
+	if apiRequest.TemplateID != nil {
+		var itc *model.APIInstanceTemplateConfig
+		var apiErr *cutil.APIError
+		instanceTemplateVersion, itc, apiErr = getInstanceTemplateConfigForInstanceRequest(ctx, bcih.dbSession, tenant, *apiRequest.TemplateID, apiRequest.TemplateVersion)
+		if apiErr != nil {
+			logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+			return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+		}
+		itc.ApplyToBatchInstanceCreateRequest(&apiRequest)
+	}
+
 	// Validate request attributes
 	verr := apiRequest.Validate()
 	if verr != nil {
@@ -352,17 +390,6 @@ func (bcih BatchCreateInstanceHandler) Handle(c echo.Context) error {
 
 	logger.Info().Int("Count", apiRequest.Count).Bool("TopologyOptimized", topologyOptimized).Msg("Input validation completed for batch Instance creation request")
 
-	// Validate the tenant for which these Instances are being created
-	tenant, err := common.GetTenantForOrg(ctx, nil, bcih.dbSession, org)
-	if err != nil {
-		if err == common.ErrOrgTenantNotFound {
-			logger.Warn().Err(err).Msg("Org does not have a Tenant associated")
-			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Org does not have a Tenant associated", nil)
-		}
-		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
-		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
-	}
-
 	// Verify tenant-id in request matches tenant from org
 	apiTenant, err := common.GetTenantFromIDString(ctx, nil, apiRequest.TenantID, bcih.dbSession)
 	if err != nil {
@@ -753,7 +780,7 @@ func (bcih BatchCreateInstanceHandler) Handle(c echo.Context) error {
 	// apiRequest will be mutated for use in CreateFromParams.
 	// osConfig will hold the struct/data for use with Temporal/Carbide calls.
 	// Errors will be returned already in the form of cutil.NewAPIErrorResponse
-	osConfig, osID, oserr := bcih.buildBatchInstanceCreateRequestOsConfig(c, &logger, &apiRequest, site)
+	osConfig, osID, osvID, oserr := bcih.buildBatchInstanceCreateRequestOsConfig(c, &logger, &apiRequest, site)
 	if oserr != nil {
 		// buildBatchInstanceCreateRequestOsConfig already handles logging,
 		// so this is a bit redundant, but this log brings you to the
@@ -1193,6 +1220,7 @@ func (bcih BatchCreateInstanceHandler) Handle(c echo.Context) error {
 			VpcID:                    vpc.ID,
 			MachineID:                cdb.GetStrPtr(machine.ID),
 			OperatingSystemID:        osID,
+			OperatingSystemVersionID: osvID,
 			IpxeScript:               apiRequest.IpxeScript,
 			AlwaysBootWithCustomIpxe: *apiRequest.AlwaysBootWithCustomIpxe,
 			PhoneHomeEnabled:         *apiRequest.PhoneHomeEnabled,
@@ -1207,6 +1235,12 @@ func (bcih BatchCreateInstanceHandler) Handle(c echo.Context) error {
 			PowerStatus:              cdb.GetStrPtr(cdbm.InstancePowerStatusRebooting),
 			CreatedBy:                dbUser.ID,
 		})
+
+		// Record the Instance Template version the Instances were created from
+		if instanceTemplateVersion != nil {
+			instanceCreateInputs[len(instanceCreateInputs)-1].InstanceTemplateID = &instanceTemplateVersion.InstanceTemplateID
+			instanceCreateInputs[len(instanceCreateInputs)-1].InstanceTemplateVersion = &instanceTemplateVersion.Version
+		}
 	}
 
 	// --- Batch create all instances ---
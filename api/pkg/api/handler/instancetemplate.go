@@ -0,0 +1,939 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	common "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/pagination"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+)
+
+// validateInstanceTemplateRequest validates the org membership and role of the User managing Instance Templates
+func validateInstanceTemplateRequest(dbUser *cdbm.User, org string) *cutil.APIError {
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			return cutil.NewAPIError(http.StatusInternalServerError, "Error validating org membership for User", nil)
+		}
+		return cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to manage Instance Templates
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		return cutil.NewAPIError(http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	return nil
+}
+
+// getTenantForInstanceTemplateRequest retrieves the Tenant of the org that owns the Instance Templates being managed
+func getTenantForInstanceTemplateRequest(ctx context.Context, dbSession *cdb.Session, org string) (*cdbm.Tenant, *cutil.APIError) {
+	tenant, err := common.GetTenantForOrg(ctx, nil, dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgTenantNotFound) {
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Org does not have a Tenant associated", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	return tenant, nil
+}
+
+// getInstanceTemplateByIDForTenant retrieves an Instance Template and checks that it belongs to the Tenant
+func getInstanceTemplateByIDForTenant(ctx context.Context, dbSession *cdb.Session, tenant *cdbm.Tenant, itIDStr string, includeRelations []string) (*cdbm.InstanceTemplate, *cutil.APIError) {
+	itID, err := uuid.Parse(itIDStr)
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Instance Template ID", nil)
+	}
+
+	itDAO := cdbm.NewInstanceTemplateDAO(dbSession)
+	dit, err := itDAO.GetByID(ctx, nil, itID, includeRelations, false)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Instance Template with specified ID", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Instance Template due to DB error", nil)
+	}
+
+	// Instance Templates of other Tenants are reported as missing rather than forbidden
+	if dit.TenantID != tenant.ID {
+		return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Instance Template with specified ID", nil)
+	}
+
+	return dit, nil
+}
+
+// getInstanceTemplateVersion retrieves the specified version of an Instance Template, latest version if not specified
+func getInstanceTemplateVersion(ctx context.Context, dbSession *cdb.Session, dit *cdbm.InstanceTemplate, version *int) (*cdbm.InstanceTemplateVersion, *cutil.APIError) {
+	v := dit.Version
+	if version != nil {
+		v = *version
+	}
+
+	itvDAO := cdbm.NewInstanceTemplateVersionDAO(dbSession)
+	ditv, err := itvDAO.GetByInstanceTemplateIDAndVersion(ctx, nil, dit.ID, v, nil)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, fmt.Sprintf("Could not find version %d of Instance Template", v), nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Instance Template Version due to DB error", nil)
+	}
+
+	return ditv, nil
+}
+
+// getInstanceTemplateConfigForInstanceRequest retrieves the Instance Template version specified in an Instance create request
+// and returns the attributes it holds. Templates of other Tenants or unknown versions are reported as bad request.
+func getInstanceTemplateConfigForInstanceRequest(ctx context.Context, dbSession *cdb.Session, tenant *cdbm.Tenant, itIDStr string, version *int) (*cdbm.InstanceTemplateVersion, *model.APIInstanceTemplateConfig, *cutil.APIError) {
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, dbSession, tenant, itIDStr, nil)
+	if apiErr != nil {
+		if apiErr.Code == http.StatusNotFound {
+			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find Instance Template specified in request", nil)
+		}
+		return nil, nil, apiErr
+	}
+
+	ditv, apiErr := getInstanceTemplateVersion(ctx, dbSession, dit, version)
+	if apiErr != nil {
+		if apiErr.Code == http.StatusNotFound {
+			return nil, nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find Instance Template version specified in request", nil)
+		}
+		return nil, nil, apiErr
+	}
+
+	itc, err := model.NewAPIInstanceTemplateConfig(ditv.Config)
+	if err != nil {
+		return nil, nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to read attributes of Instance Template", nil)
+	}
+
+	return ditv, itc, nil
+}
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateInstanceTemplateHandler is the API Handler for creating new Instance Template
+type CreateInstanceTemplateHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateInstanceTemplateHandler initializes and returns a new handler for creating Instance Template
+func NewCreateInstanceTemplateHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) CreateInstanceTemplateHandler {
+	return CreateInstanceTemplateHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create an Instance Template
+// @Description Create a named set of Instance attributes that Instance create requests can reference. The attributes are stored as version 1 of the template.
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APIInstanceTemplateCreateRequest true "Instance Template create request"
+// @Success 201 {object} model.APIInstanceTemplate
+// @Router /v2/org/{org}/carbide/instance-template [post]
+func (cith CreateInstanceTemplateHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplate", "Create", c, cith.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, cith.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIInstanceTemplateCreateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Instance Template creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Instance Template creation request data", verr)
+	}
+
+	cith.tracerSpan.SetAttribute(handlerSpan, attribute.String("name", apiRequest.Name), logger)
+
+	config, err := apiRequest.Config.ToMap()
+	if err != nil {
+		logger.Error().Err(err).Msg("error converting Instance Template config")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to process Instance Template config", nil)
+	}
+
+	// Check for name uniqueness within the Tenant
+	itDAO := cdbm.NewInstanceTemplateDAO(cith.dbSession)
+	dits, tot, err := itDAO.GetAll(ctx, nil, cdbm.InstanceTemplateFilterInput{
+		Names:     []string{apiRequest.Name},
+		TenantIDs: []uuid.UUID{tenant.ID},
+	}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("db error checking for name uniqueness of Instance Template")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Instance Template due to DB error", nil)
+	}
+	if tot > 0 {
+		logger.Warn().Str("name", apiRequest.Name).Msg("Instance Template with same name already exists for Tenant")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "An Instance Template with specified name already exists for Tenant", validation.Errors{
+			"id": errors.New(dits[0].ID.String()),
+		})
+	}
+
+	// start a transaction
+	tx, err := cdb.BeginTx(ctx, cith.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Instance Template due to DB error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	dit, err := itDAO.Create(ctx, tx, cdbm.InstanceTemplateCreateInput{
+		Name:        apiRequest.Name,
+		Description: apiRequest.Description,
+		TenantOrg:   org,
+		TenantID:    tenant.ID,
+		Version:     1,
+		CreatedBy:   dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create Instance Template record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Instance Template due to DB error", nil)
+	}
+
+	itvDAO := cdbm.NewInstanceTemplateVersionDAO(cith.dbSession)
+	ditv, err := itvDAO.Create(ctx, tx, cdbm.InstanceTemplateVersionCreateInput{
+		InstanceTemplateID: dit.ID,
+		Version:            dit.Version,
+		Config:             config,
+		CreatedBy:          dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create Instance Template Version record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Instance Template due to DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing Instance Template create transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Instance Template due to DB error", nil)
+	}
+	txCommitted = true
+
+	logger.Info().Str("Instance Template ID", dit.ID.String()).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, model.NewAPIInstanceTemplate(dit, ditv))
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllInstanceTemplateHandler is the API Handler for retrieving all Instance Templates of the org
+type GetAllInstanceTemplateHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllInstanceTemplateHandler initializes and returns a new handler for retrieving all Instance Templates
+func NewGetAllInstanceTemplateHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllInstanceTemplateHandler {
+	return GetAllInstanceTemplateHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all Instance Templates
+// @Description Get all Instance Templates of the org's Tenant along with the attributes of their latest version
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param query query string false "Query input for full text search"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant'"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {array} []model.APIInstanceTemplate
+// @Router /v2/org/{org}/carbide/instance-template [get]
+func (gaith GetAllInstanceTemplateHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplate", "GetAll", c, gaith.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, gaith.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err := c.Bind(&pageRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	// Validate pagination request attributes
+	err = pageRequest.Validate(cdbm.InstanceTemplateOrderByFields)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest,
+			"Failed to validate pagination request data", err)
+	}
+
+	// Get query text for full text search from query param
+	var searchQuery *string
+	searchQueryStr := c.QueryParam("query")
+	if searchQueryStr != "" {
+		searchQuery = &searchQueryStr
+		gaith.tracerSpan.SetAttribute(handlerSpan, attribute.String("query", searchQueryStr), logger)
+	}
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.InstanceTemplateRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	itDAO := cdbm.NewInstanceTemplateDAO(gaith.dbSession)
+	dits, total, err := itDAO.GetAll(ctx, nil, cdbm.InstanceTemplateFilterInput{
+		TenantIDs:   []uuid.UUID{tenant.ID},
+		SearchQuery: searchQuery,
+	}, cdbp.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}, qIncludeRelations)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Instance Templates from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance Templates due to DB error", nil)
+	}
+
+	// Retrieve the latest version of each Instance Template
+	itIDs := []uuid.UUID{}
+	versions := []int{}
+	for _, dit := range dits {
+		itIDs = append(itIDs, dit.ID)
+		versions = append(versions, dit.Version)
+	}
+
+	ditvMap := map[string]*cdbm.InstanceTemplateVersion{}
+	if len(dits) > 0 {
+		itvDAO := cdbm.NewInstanceTemplateVersionDAO(gaith.dbSession)
+		ditvs, _, serr := itvDAO.GetAll(ctx, nil, cdbm.InstanceTemplateVersionFilterInput{
+			InstanceTemplateIDs: itIDs,
+			Versions:            versions,
+		}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("error retrieving Instance Template Versions from DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance Template Versions due to DB error", nil)
+		}
+
+		for i := range ditvs {
+			ditvMap[fmt.Sprintf("%s:%d", ditvs[i].InstanceTemplateID, ditvs[i].Version)] = &ditvs[i]
+		}
+	}
+
+	// Create response
+	apiInstanceTemplates := []model.APIInstanceTemplate{}
+	for i := range dits {
+		ditv := ditvMap[fmt.Sprintf("%s:%d", dits[i].ID, dits[i].Version)]
+		apiInstanceTemplates = append(apiInstanceTemplates, *model.NewAPIInstanceTemplate(&dits[i], ditv))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiInstanceTemplates)
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetInstanceTemplateHandler is the API Handler for retrieving an Instance Template
+type GetInstanceTemplateHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetInstanceTemplateHandler initializes and returns a new handler for retrieving an Instance Template
+func NewGetInstanceTemplateHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetInstanceTemplateHandler {
+	return GetInstanceTemplateHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get an Instance Template
+// @Description Get an Instance Template of the org's Tenant along with the attributes of its latest version
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance Template"
+// @Param includeRelation query string false "Related entities to include in response e.g. 'Tenant'"
+// @Success 200 {object} model.APIInstanceTemplate
+// @Router /v2/org/{org}/carbide/instance-template/{id} [get]
+func (gith GetInstanceTemplateHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplate", "Get", c, gith.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, gith.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gith.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_template_id", c.Param("id")), logger)
+
+	// Get and validate includeRelation params
+	qIncludeRelations, errMsg := common.GetAndValidateQueryRelations(c.QueryParams(), cdbm.InstanceTemplateRelatedEntities)
+	if errMsg != "" {
+		logger.Warn().Msg(errMsg)
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, errMsg, nil)
+	}
+
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, gith.dbSession, tenant, c.Param("id"), qIncludeRelations)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	ditv, apiErr := getInstanceTemplateVersion(ctx, gith.dbSession, dit, nil)
+	if apiErr != nil {
+		logger.Error().Str("reason", apiErr.Message).Msg("error retrieving latest version of Instance Template")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve latest version of Instance Template", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIInstanceTemplate(dit, ditv))
+}
+
+// ~~~~~ Update Handler ~~~~~ //
+
+// UpdateInstanceTemplateHandler is the API Handler for updating an Instance Template
+type UpdateInstanceTemplateHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateInstanceTemplateHandler initializes and returns a new handler for updating an Instance Template
+func NewUpdateInstanceTemplateHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) UpdateInstanceTemplateHandler {
+	return UpdateInstanceTemplateHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Update an Instance Template
+// @Description Update an Instance Template of the org's Tenant. Specifying config creates a new version of the template, existing versions are not modified.
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance Template"
+// @Param message body model.APIInstanceTemplateUpdateRequest true "Instance Template update request"
+// @Success 200 {object} model.APIInstanceTemplate
+// @Router /v2/org/{org}/carbide/instance-template/{id} [patch]
+func (uith UpdateInstanceTemplateHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplate", "Update", c, uith.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, uith.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	uith.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_template_id", c.Param("id")), logger)
+
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, uith.dbSession, tenant, c.Param("id"), nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIInstanceTemplateUpdateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Instance Template update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Instance Template update request data", verr)
+	}
+
+	itDAO := cdbm.NewInstanceTemplateDAO(uith.dbSession)
+
+	// Check for name uniqueness within the Tenant
+	if apiRequest.Name != nil && *apiRequest.Name != dit.Name {
+		dits, tot, serr := itDAO.GetAll(ctx, nil, cdbm.InstanceTemplateFilterInput{
+			Names:     []string{*apiRequest.Name},
+			TenantIDs: []uuid.UUID{tenant.ID},
+		}, cdbp.PageInput{}, nil)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("db error checking for name uniqueness of Instance Template")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+		}
+		if tot > 0 {
+			logger.Warn().Str("name", *apiRequest.Name).Msg("Instance Template with same name already exists for Tenant")
+			return cutil.NewAPIErrorResponse(c, http.StatusConflict, "An Instance Template with specified name already exists for Tenant", validation.Errors{
+				"id": errors.New(dits[0].ID.String()),
+			})
+		}
+	}
+
+	var config map[string]interface{}
+	if apiRequest.Config != nil {
+		config, err = apiRequest.Config.ToMap()
+		if err != nil {
+			logger.Error().Err(err).Msg("error converting Instance Template config")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to process Instance Template config", nil)
+		}
+	}
+
+	// start a transaction
+	tx, err := cdb.BeginTx(ctx, uith.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	// Lock the Instance Template row so concurrent updates create consecutive versions
+	dit, err = itDAO.GetByID(ctx, tx, dit.ID, nil, true)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Could not find Instance Template with specified ID", nil)
+		}
+		logger.Error().Err(err).Msg("error locking Instance Template in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+	}
+
+	updateInput := cdbm.InstanceTemplateUpdateInput{
+		InstanceTemplateID: dit.ID,
+		Name:               apiRequest.Name,
+		Description:        apiRequest.Description,
+		UpdatedBy:          dbUser.ID,
+	}
+
+	// New attributes are stored as a new version, Instances keep referencing the version they were created from
+	if config != nil {
+		itvDAO := cdbm.NewInstanceTemplateVersionDAO(uith.dbSession)
+		_, err = itvDAO.Create(ctx, tx, cdbm.InstanceTemplateVersionCreateInput{
+			InstanceTemplateID: dit.ID,
+			Version:            dit.Version + 1,
+			Config:             config,
+			CreatedBy:          dbUser.ID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("error creating Instance Template Version in DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+		}
+		updateInput.Version = cdb.GetIntPtr(dit.Version + 1)
+	}
+
+	uit, err := itDAO.Update(ctx, tx, updateInput)
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating Instance Template in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing Instance Template update transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Template due to DB error", nil)
+	}
+	txCommitted = true
+
+	ditv, apiErr := getInstanceTemplateVersion(ctx, uith.dbSession, uit, nil)
+	if apiErr != nil {
+		logger.Error().Str("reason", apiErr.Message).Msg("error retrieving latest version of Instance Template")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve latest version of Instance Template", nil)
+	}
+
+	logger.Info().Int("Version", uit.Version).Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIInstanceTemplate(uit, ditv))
+}
+
+// ~~~~~ Delete Handler ~~~~~ //
+
+// DeleteInstanceTemplateHandler is the API Handler for deleting an Instance Template
+type DeleteInstanceTemplateHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteInstanceTemplateHandler initializes and returns a new handler for deleting an Instance Template
+func NewDeleteInstanceTemplateHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) DeleteInstanceTemplateHandler {
+	return DeleteInstanceTemplateHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Delete an Instance Template
+// @Description Delete an Instance Template of the org's Tenant. Instances created from the template are not affected.
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance Template"
+// @Success 204
+// @Router /v2/org/{org}/carbide/instance-template/{id} [delete]
+func (dith DeleteInstanceTemplateHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplate", "Delete", c, dith.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, dith.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	dith.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_template_id", c.Param("id")), logger)
+
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, dith.dbSession, tenant, c.Param("id"), nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	itDAO := cdbm.NewInstanceTemplateDAO(dith.dbSession)
+	err := itDAO.Delete(ctx, nil, dit.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error deleting Instance Template from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to delete Instance Template due to DB error", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ~~~~~ GetAll Version Handler ~~~~~ //
+
+// GetAllInstanceTemplateVersionHandler is the API Handler for retrieving all versions of an Instance Template
+type GetAllInstanceTemplateVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllInstanceTemplateVersionHandler initializes and returns a new handler for retrieving all Instance Template Versions
+func NewGetAllInstanceTemplateVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllInstanceTemplateVersionHandler {
+	return GetAllInstanceTemplateVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all Instance Template Versions
+// @Description Get all versions of an Instance Template
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance Template"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {array} []model.APIInstanceTemplateVersion
+// @Router /v2/org/{org}/carbide/instance-template/{id}/version [get]
+func (gaitvh GetAllInstanceTemplateVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplateVersion", "GetAll", c, gaitvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, gaitvh.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gaitvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_template_id", c.Param("id")), logger)
+
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, gaitvh.dbSession, tenant, c.Param("id"), nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err := c.Bind(&pageRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	// Validate pagination request attributes
+	err = pageRequest.Validate(cdbm.InstanceTemplateVersionOrderByFields)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest,
+			"Failed to validate pagination request data", err)
+	}
+
+	itvDAO := cdbm.NewInstanceTemplateVersionDAO(gaitvh.dbSession)
+	ditvs, total, err := itvDAO.GetAll(ctx, nil, cdbm.InstanceTemplateVersionFilterInput{
+		InstanceTemplateIDs: []uuid.UUID{dit.ID},
+	}, cdbp.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Instance Template Versions from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance Template Versions due to DB error", nil)
+	}
+
+	// Create response
+	apiVersions := []model.APIInstanceTemplateVersion{}
+	for i := range ditvs {
+		apiVersions = append(apiVersions, *model.NewAPIInstanceTemplateVersion(&ditvs[i]))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiVersions)
+}
+
+// ~~~~~ Get Version Handler ~~~~~ //
+
+// GetInstanceTemplateVersionHandler is the API Handler for retrieving a version of an Instance Template
+type GetInstanceTemplateVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetInstanceTemplateVersionHandler initializes and returns a new handler for retrieving an Instance Template Version
+func NewGetInstanceTemplateVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetInstanceTemplateVersionHandler {
+	return GetInstanceTemplateVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get an Instance Template Version
+// @Description Get a version of an Instance Template by version number
+// @Tags instancetemplate
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Instance Template"
+// @Param version path integer true "Version number of Instance Template"
+// @Success 200 {object} model.APIInstanceTemplateVersion
+// @Router /v2/org/{org}/carbide/instance-template/{id}/version/{version} [get]
+func (gitvh GetInstanceTemplateVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InstanceTemplateVersion", "Get", c, gitvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateInstanceTemplateRequest(dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage Instance Templates, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, apiErr := getTenantForInstanceTemplateRequest(ctx, gitvh.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Tenant for org")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gitvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("instance_template_id", c.Param("id")), logger)
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Instance Template version in URL", nil)
+	}
+
+	dit, apiErr := getInstanceTemplateByIDForTenant(ctx, gitvh.dbSession, tenant, c.Param("id"), nil)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	ditv, apiErr := getInstanceTemplateVersion(ctx, gitvh.dbSession, dit, &version)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving Instance Template Version specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIInstanceTemplateVersion(ditv))
+}
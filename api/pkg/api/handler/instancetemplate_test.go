@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestInstanceTemplateHandler_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	org := "test-org"
+	user := common.TestBuildUser(t, dbSession, uuid.NewString(), org, []string{"FORGE_TENANT_ADMIN"})
+	tn := common.TestBuildTenant(t, dbSession, "test-tenant", org, user)
+
+	otherOrg := "other-org"
+	otherUser := common.TestBuildUser(t, dbSession, uuid.NewString(), otherOrg, []string{"FORGE_TENANT_ADMIN"})
+	otherTn := common.TestBuildTenant(t, dbSession, "other-tenant", otherOrg, otherUser)
+
+	itDAO := cdbm.NewInstanceTemplateDAO(dbSession)
+	otherIT, err := itDAO.Create(ctx, nil, cdbm.InstanceTemplateCreateInput{Name: "other", TenantOrg: otherOrg, TenantID: otherTn.ID, Version: 1, CreatedBy: otherUser.ID})
+	require.NoError(t, err)
+
+	tmc := &tmocks.Client{}
+
+	newContext := func(method string, path string, body string, paramNames []string, paramValues []string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		ec := e.NewContext(req, rec)
+		ec.SetParamNames(append([]string{"orgName"}, paramNames...)...)
+		ec.SetParamValues(append([]string{org}, paramValues...)...)
+		ec.Set("user", user)
+		ec.SetRequest(ec.Request().WithContext(ctx))
+		return ec, rec
+	}
+
+	vpcID := uuid.NewString()
+
+	// Create
+	createTests := []struct {
+		name           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name:           "test create Instance Template success",
+			body:           `{"name": "gpu-worker", "config": {"vpcId": "` + vpcID + `", "userData": "#cloud-config", "labels": {"role": "worker"}}}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "test create Instance Template with duplicate name fails",
+			body:           `{"name": "gpu-worker"}`,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "test create Instance Template with invalid config fails",
+			body:           `{"name": "bad", "config": {"vpcId": "vpc"}}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create Instance Template with same name as Instance Template of other Tenant success",
+			body:           `{"name": "other"}`,
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	var created *model.APIInstanceTemplate
+	for _, tc := range createTests {
+		t.Run(tc.name, func(t *testing.T) {
+			ec, rec := newContext(http.MethodPost, "/instance-template", tc.body, nil, nil)
+
+			err := NewCreateInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus == http.StatusCreated && created == nil {
+				created = &model.APIInstanceTemplate{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), created))
+				assert.Equal(t, 1, created.Version)
+				assert.Equal(t, vpcID, created.Config["vpcId"])
+				assert.Equal(t, tn.ID.String(), created.TenantID)
+			}
+		})
+	}
+	require.NotNil(t, created)
+
+	// GetAll only returns Instance Templates of the Tenant
+	ec, rec := newContext(http.MethodGet, "/instance-template?query=gpu", "", nil, nil)
+	require.NoError(t, NewGetAllInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code)
+	all := []model.APIInstanceTemplate{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	require.Len(t, all, 1)
+	assert.Equal(t, created.ID, all[0].ID)
+	assert.Equal(t, "#cloud-config", all[0].Config["userData"])
+	assert.NotEmpty(t, rec.Header().Get("X-Pagination"))
+
+	// Instance Templates of other Tenants are not found
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+otherIT.ID.String(), "", []string{"id"}, []string{otherIT.ID.String()})
+	require.NoError(t, NewGetInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	// Updating only the description does not create a new version
+	ec, rec = newContext(http.MethodPatch, "/instance-template/"+created.ID, `{"description": "GPU worker nodes"}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated := &model.APIInstanceTemplate{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), updated))
+	assert.Equal(t, 1, updated.Version)
+
+	// Updating the config creates a new version
+	ec, rec = newContext(http.MethodPatch, "/instance-template/"+created.ID, `{"config": {"vpcId": "`+vpcID+`", "phoneHomeEnabled": true}}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated = &model.APIInstanceTemplate{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), updated))
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, true, updated.Config["phoneHomeEnabled"])
+	assert.Nil(t, updated.Config["userData"])
+
+	// Renaming to the name of another Instance Template of the Tenant fails
+	ec, rec = newContext(http.MethodPatch, "/instance-template/"+created.ID, `{"name": "other"}`, []string{"id"}, []string{created.ID})
+	require.NoError(t, NewUpdateInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	// Versions
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+created.ID+"/version", "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewGetAllInstanceTemplateVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	versions := []model.APIInstanceTemplateVersion{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &versions))
+	require.Len(t, versions, 2)
+	assert.Equal(t, 1, versions[0].Version)
+	assert.Equal(t, "#cloud-config", versions[0].Config["userData"])
+
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+created.ID+"/version/1", "", []string{"id", "version"}, []string{created.ID, "1"})
+	require.NoError(t, NewGetInstanceTemplateVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+created.ID+"/version/3", "", []string{"id", "version"}, []string{created.ID, "3"})
+	require.NoError(t, NewGetInstanceTemplateVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+created.ID+"/version/latest", "", []string{"id", "version"}, []string{created.ID, "latest"})
+	require.NoError(t, NewGetInstanceTemplateVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Instance create requests resolve the latest or the requested version
+	ditv, itc, apiErr := getInstanceTemplateConfigForInstanceRequest(ctx, dbSession, tn, created.ID, nil)
+	require.Nil(t, apiErr)
+	assert.Equal(t, 2, ditv.Version)
+	assert.True(t, *itc.PhoneHomeEnabled)
+
+	ditv, itc, apiErr = getInstanceTemplateConfigForInstanceRequest(ctx, dbSession, tn, created.ID, cdb.GetIntPtr(1))
+	require.Nil(t, apiErr)
+	assert.Equal(t, 1, ditv.Version)
+	assert.Equal(t, "#cloud-config", *itc.UserData)
+
+	_, _, apiErr = getInstanceTemplateConfigForInstanceRequest(ctx, dbSession, tn, created.ID, cdb.GetIntPtr(5))
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	_, _, apiErr = getInstanceTemplateConfigForInstanceRequest(ctx, dbSession, tn, otherIT.ID.String(), nil)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	// Delete
+	ec, rec = newContext(http.MethodDelete, "/instance-template/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewDeleteInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	ec, rec = newContext(http.MethodGet, "/instance-template/"+created.ID, "", []string{"id"}, []string{created.ID})
+	require.NoError(t, NewGetInstanceTemplateHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
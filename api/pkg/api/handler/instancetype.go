@@ -190,6 +190,7 @@ func (cith CreateInstanceTypeHandler) Handle(c echo.Context) error {
 		SiteID:                   &site.ID,
 		Labels:                   labels,
 		Status:                   cdbm.InstanceTypeStatusReady,
+		AutoAssign:               apiRequest.AutoAssign != nil && *apiRequest.AutoAssign,
 		CreatedBy:                dbUser.ID,
 	})
 	if err != nil {
@@ -1246,7 +1247,7 @@ func (uith UpdateInstanceTypeHandler) Handle(c echo.Context) error {
 	}
 
 	// Update Instance Type
-	it, err = itDAO.Update(ctx, tx, cdbm.InstanceTypeUpdateInput{ID: itID, Name: apiRequest.Name, Description: apiRequest.Description, Labels: apiRequest.Labels})
+	it, err = itDAO.Update(ctx, tx, cdbm.InstanceTypeUpdateInput{ID: itID, Name: apiRequest.Name, Description: apiRequest.Description, Labels: apiRequest.Labels, AutoAssign: apiRequest.AutoAssign})
 	if err != nil {
 		logger.Error().Err(err).Msg("error updating Instance Type in DB")
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Instance Type", nil)
@@ -1259,6 +1260,13 @@ func (uith UpdateInstanceTypeHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Machine capabilities for Instance Type", nil)
 	}
 
+	// Auto assignment would match every Machine without Capabilities to match against
+	if it.AutoAssign && len(mcs) == 0 {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Instance Type update request data", validation.Errors{
+			"autoAssign": model.ErrValidationAutoAssignWithoutCapabilities,
+		})
+	}
+
 	// Return API response
 
 	// Get Instance Type status details
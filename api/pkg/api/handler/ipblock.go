@@ -18,6 +18,7 @@
 package handler
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -31,6 +32,7 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog"
 
 	"github.com/labstack/echo/v4"
 
@@ -248,6 +250,15 @@ func (cipbh CreateIPBlockHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to get new Status Detail for IPBlock", nil)
 	}
 
+	// Reserve the requested ranges in IPAM
+	if len(apiRequest.ReservedRanges) > 0 {
+		prefix, err = ipam.UpdateIpamReservedRangesForCidr(ctx, ipamStorage, ipb, prefix.Cidr, model.GetIPRangesFromAPIReservedIPRanges(apiRequest.ReservedRanges))
+		if err != nil {
+			logger.Warn().Err(err).Msg("error reserving ranges in ipam prefix")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not reserve ranges in IPAM entry for IPBlock. Details: %s", err.Error()), nil)
+		}
+	}
+
 	// commit transaction
 	err = tx.Commit()
 	if err != nil {
@@ -259,6 +270,7 @@ func (cipbh CreateIPBlockHandler) Handle(c echo.Context) error {
 
 	// Create response
 	apiInstance := model.NewAPIIPBlock(ipb, []cdbm.StatusDetail{*ssd}, nil)
+	apiInstance.ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
 	logger.Info().Msg("finishing API handler")
 	return c.JSON(http.StatusCreated, apiInstance)
 }
@@ -513,6 +525,9 @@ func (gaipbh GetAllIPBlockHandler) Handle(c echo.Context) error {
 		apiIpbs = append(apiIpbs, apiIpb)
 	}
 
+	// Get reserved ranges from IPAM for the page of IPBlocks
+	setAPIIPBlocksReservedRanges(ctx, logger, ipamStorage, apiIpbs, ipbs)
+
 	// Create pagination response header
 	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
 	pageHeader, err := json.Marshal(pageReponse)
@@ -736,12 +751,16 @@ func (gadipbh GetAllDerivedIPBlockHandler) Handle(c echo.Context) error {
 	}
 
 	// Prepare derived blocks details
+	ipamStorage := ipam.NewIpamStorage(gadipbh.dbSession.DB, nil)
 	for _, ipb := range dipbs {
 		cipb := ipb
 		apiIpb := model.NewAPIIPBlock(&cipb, dssdMap[cipb.ID.String()], nil)
 		apiIpbs = append(apiIpbs, apiIpb)
 	}
 
+	// Get reserved ranges from IPAM for the page of derived IPBlocks
+	setAPIIPBlocksReservedRanges(ctx, logger, ipamStorage, apiIpbs, dipbs)
+
 	// Create pagination response header
 	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
 	pageHeader, err := json.Marshal(pageReponse)
@@ -885,10 +904,10 @@ func (gipbh GetIPBlockHandler) Handle(c echo.Context) error {
 	}
 
 	// Get IPAM usage stats
+	ipamStorage := ipam.NewIpamStorage(gipbh.dbSession.DB, nil)
 	var puipb *cipam.Usage
 	if includeUsageStats {
 		// Get Usage stats from IPAM for the IPBlock
-		ipamStorage := ipam.NewIpamStorage(gipbh.dbSession.DB, nil)
 		puipb, err = ipam.GetIpamUsageForIPBlock(ctx, ipamStorage, ipb)
 		if err != nil {
 			logger.Error().Err(err).Msg("error retrieving ipam usage stats details for IPBlock")
@@ -899,11 +918,46 @@ func (gipbh GetIPBlockHandler) Handle(c echo.Context) error {
 	// Create API response
 	apiIPBlock := model.NewAPIIPBlock(ipb, ssds, puipb)
 
+	// Get reserved ranges from IPAM for the IPBlock
+	setAPIIPBlockReservedRanges(ctx, logger, ipamStorage, apiIPBlock, ipb)
+
 	logger.Info().Msg("finishing API handler")
 
 	return c.JSON(http.StatusOK, apiIPBlock)
 }
 
+// setAPIIPBlockReservedRanges populates the reserved ranges of the API IPBlock from its IPAM entry.
+// IPBlocks without an IPAM entry, e.g. full grants, are returned without reserved ranges
+func setAPIIPBlockReservedRanges(ctx context.Context, logger zerolog.Logger, ipamStorage cipam.Storage, apiIpb *model.APIIPBlock, ipb *cdbm.IPBlock) {
+	prefix, err := ipam.GetIpamPrefixForIPBlock(ctx, ipamStorage, ipb)
+	if err != nil {
+		logger.Warn().Err(err).Str("IPBlock ID", ipb.ID.String()).Msg("error retrieving ipam prefix for IPBlock, reserved ranges will not be included")
+		return
+	}
+	apiIpb.ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
+}
+
+// setAPIIPBlocksReservedRanges populates the reserved ranges of a page of API IPBlocks from their IPAM entries
+// with a single IPAM query. apiIpbs must be in the same order as ipbs
+func setAPIIPBlocksReservedRanges(ctx context.Context, logger zerolog.Logger, ipamStorage cipam.Storage, apiIpbs []*model.APIIPBlock, ipbs []cdbm.IPBlock) {
+	keys := make([]cipam.PrefixKey, 0, len(ipbs))
+	for i := range ipbs {
+		keys = append(keys, ipam.GetIpamPrefixKeyForCidr(ctx, &ipbs[i], ipam.GetCidrForIPBlock(ctx, ipbs[i].Prefix, ipbs[i].PrefixLength)))
+	}
+
+	prefixes, err := ipam.GetIpamPrefixes(ctx, ipamStorage, keys)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error retrieving ipam prefixes for IPBlocks, reserved ranges will not be included")
+		return
+	}
+
+	for i, key := range keys {
+		if prefix, ok := prefixes[key]; ok {
+			apiIpbs[i].ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
+		}
+	}
+}
+
 // ~~~~~ Update Handler ~~~~~ //
 
 // UpdateIPBlockHandler is the API Handler for updating a IPBlock
@@ -1069,6 +1123,22 @@ func (uipbh UpdateIPBlockHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update IPBlock", nil)
 	}
 
+	// Update the reserved ranges in IPAM
+	var prefix *cipam.Prefix
+	ipamStorage := ipam.NewIpamStorage(uipbh.dbSession.DB, tx.GetBunTx())
+	if apiRequest.ReservedRanges != nil {
+		prefix, err = ipam.UpdateIpamReservedRangesForCidr(ctx, ipamStorage, ipb, ipam.GetCidrForIPBlock(ctx, ipb.Prefix, ipb.PrefixLength), model.GetIPRangesFromAPIReservedIPRanges(*apiRequest.ReservedRanges))
+		if err != nil {
+			logger.Warn().Err(err).Msg("error updating reserved ranges in ipam prefix")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not update reserved ranges in IPAM entry for IPBlock. Details: %s", err.Error()), nil)
+		}
+	} else {
+		prefix, err = ipam.GetIpamPrefixForIPBlock(ctx, ipamStorage, ipb)
+		if err != nil {
+			logger.Warn().Err(err).Msg("error retrieving ipam prefix for IPBlock, reserved ranges will not be included")
+		}
+	}
+
 	sdDAO := cdbm.NewStatusDetailDAO(uipbh.dbSession)
 	ssds, _, err := sdDAO.GetAllByEntityID(ctx, tx, ipb.ID.String(), nil, cdb.GetIntPtr(pagination.MaxPageSize), nil)
 	if err != nil {
@@ -1086,6 +1156,9 @@ func (uipbh UpdateIPBlockHandler) Handle(c echo.Context) error {
 
 	// Create response
 	apiInstance := model.NewAPIIPBlock(ipb, ssds, nil)
+	if prefix != nil {
+		apiInstance.ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
+	}
 
 	logger.Info().Msg("finishing API handler")
 
@@ -797,6 +797,137 @@ func TestIPBlockHandler_Update(t *testing.T) {
 	}
 }
 
+func TestIPBlockHandler_ReservedRanges(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testIPBlockInitDB(t)
+	defer dbSession.Close()
+
+	testIPBlockSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	orgRoles := []string{"FORGE_PROVIDER_ADMIN"}
+	user := testIPBlockBuildUser(t, dbSession, "TestIPBlockHandler_ReservedRanges", []string{ipOrg}, orgRoles)
+	ip := testIPBlockBuildInfrastructureProvider(t, dbSession, "TestIp", ipOrg, user)
+	site := testIPBlockBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, true, user)
+
+	cfg := common.GetTestConfig()
+	tempClient := &tmocks.Client{}
+
+	// OTEL Spanner configuration
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	newContext := func(method string, query url.Values, body interface{}, id string) (echo.Context, *httptest.ResponseRecorder) {
+		reqBody := ""
+		if body != nil {
+			b, err := json.Marshal(body)
+			require.Nil(t, err)
+			reqBody = string(b)
+		}
+		req := httptest.NewRequest(method, fmt.Sprintf("/v2/org/%s/carbide/ipblock?%s", ipOrg, query.Encode()), strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		ec := echo.New().NewContext(req, rec)
+		if id != "" {
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(ipOrg, id)
+		} else {
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(ipOrg)
+		}
+		ec.Set("user", user)
+		ec.SetRequest(ec.Request().WithContext(context.WithValue(ctx, otelecho.TracerKey, tracer)))
+		return ec, rec
+	}
+
+	create := func(name, prefix string, reservedRanges []model.APIReservedIPRange) *httptest.ResponseRecorder {
+		ec, rec := newContext(http.MethodPost, url.Values{}, &model.APIIPBlockCreateRequest{
+			Name:            name,
+			SiteID:          site.ID.String(),
+			RoutingType:     cdbm.IPBlockRoutingTypeDatacenterOnly,
+			Prefix:          prefix,
+			PrefixLength:    24,
+			ProtocolVersion: cdbm.IPBlockProtocolVersionV4,
+			ReservedRanges:  reservedRanges,
+		}, "")
+		cipbh := CreateIPBlockHandler{dbSession: dbSession, tc: tempClient, cfg: cfg}
+		assert.Nil(t, cipbh.Handle(ec))
+		return rec
+	}
+
+	update := func(id string, reservedRanges []model.APIReservedIPRange) *httptest.ResponseRecorder {
+		ec, rec := newContext(http.MethodPatch, url.Values{}, &model.APIIPBlockUpdateRequest{ReservedRanges: &reservedRanges}, id)
+		uipbh := UpdateIPBlockHandler{dbSession: dbSession, tc: tempClient, cfg: cfg}
+		assert.Nil(t, uipbh.Handle(ec))
+		return rec
+	}
+
+	getAll := func() map[string]model.APIIPBlock {
+		ec, rec := newContext(http.MethodGet, url.Values{"includeUsageStats": []string{"true"}}, nil, "")
+		gaipbh := GetAllIPBlockHandler{dbSession: dbSession, tc: tempClient, cfg: cfg}
+		assert.Nil(t, gaipbh.Handle(ec))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rsp := []model.APIIPBlock{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &rsp))
+		ipbs := map[string]model.APIIPBlock{}
+		for _, ipb := range rsp {
+			ipbs[ipb.Name] = ipb
+		}
+		return ipbs
+	}
+
+	gateway := model.APIReservedIPRange{Start: "10.10.0.1"}
+	vips := model.APIReservedIPRange{Start: "10.10.0.10", End: "10.10.0.19"}
+	infra := model.APIReservedIPRange{Start: "10.10.0.250", End: "10.10.0.254"}
+	outside := model.APIReservedIPRange{Start: "10.20.0.5", End: "10.20.0.9"}
+
+	// Create with reserved ranges
+	rec := create("reserved", "10.10.0.0", []model.APIReservedIPRange{gateway, vips})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	rsp := &model.APIIPBlock{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+	assert.ElementsMatch(t, []model.APIReservedIPRange{gateway, vips}, rsp.ReservedRanges)
+	reservedID := rsp.ID
+
+	rec = create("unreserved", "10.10.1.0", nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	// Create with a range outside of the CIDR
+	rec = create("outside", "10.10.2.0", []model.APIReservedIPRange{outside})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Could not reserve ranges in IPAM entry for IPBlock")
+
+	// Update replaces the reserved ranges
+	rec = update(reservedID, []model.APIReservedIPRange{infra})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rsp = &model.APIIPBlock{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+	assert.Equal(t, []model.APIReservedIPRange{infra}, rsp.ReservedRanges)
+
+	// Update with a range outside of the CIDR is rejected and keeps the ranges
+	rec = update(reservedID, []model.APIReservedIPRange{infra, outside})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Could not update reserved ranges in IPAM entry for IPBlock")
+
+	// List includes the reserved ranges, and the available IPs exclude them
+	ipbs := getAll()
+	require.Len(t, ipbs, 2)
+	assert.Equal(t, []model.APIReservedIPRange{infra}, ipbs["reserved"].ReservedRanges)
+	assert.Empty(t, ipbs["unreserved"].ReservedRanges)
+	require.NotNil(t, ipbs["reserved"].UsageStats)
+	require.NotNil(t, ipbs["unreserved"].UsageStats)
+	assert.Equal(t, uint64(5), ipbs["reserved"].UsageStats.ReservedIPs)
+	assert.Equal(t, ipbs["unreserved"].UsageStats.AvailableIPs-5, ipbs["reserved"].UsageStats.AvailableIPs)
+
+	// Clearing the reserved ranges makes the IPs available again
+	rec = update(reservedID, []model.APIReservedIPRange{})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	ipbs = getAll()
+	assert.Empty(t, ipbs["reserved"].ReservedRanges)
+	assert.Equal(t, ipbs["unreserved"].UsageStats.AvailableIPs, ipbs["reserved"].UsageStats.AvailableIPs)
+}
+
 func TestIPBlockHandler_Get(t *testing.T) {
 	ctx := context.Background()
 	dbSession := testIPBlockInitDB(t)
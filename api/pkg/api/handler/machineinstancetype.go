@@ -38,6 +38,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 	swe "github.com/nvidia/bare-metal-manager-rest/site-workflow/pkg/error"
 	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+	cwu "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
 
 	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
@@ -474,6 +475,140 @@ func (gamith GetAllMachineInstanceTypeHandler) Handle(c echo.Context) error {
 	return c.JSON(http.StatusOK, amits)
 }
 
+// ~~~~~ Match Handler ~~~~~ //
+
+// GetInstanceTypeMatchHandler is the API Handler for previewing which Machines match an Instance Type
+type GetInstanceTypeMatchHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetInstanceTypeMatchHandler initializes and returns a new handler for previewing which Machines match an Instance Type
+func NewGetInstanceTypeMatchHandler(dbSession *cdb.Session, cfg *config.Config) GetInstanceTypeMatchHandler {
+	return GetInstanceTypeMatchHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Preview Machines matching an Instance Type
+// @Description Evaluate the unassigned Machines of the Instance Type's Site against its Machine Capabilities. Returns the Machines that match exactly, i.e. those auto assignment would assign, and the near-misses with the Capabilities they fail on.
+// @Tags machineinstancetype
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param instance_type_id path string true "ID of Instance Type"
+// @Success 200 {object} model.APIInstanceTypeMatch
+// @Router /v2/org/{org}/carbide/instance/type/{instance_type_id}/match [get]
+func (gitmh GetInstanceTypeMatchHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("MachineInstanceType", "Match", c, gitmh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Provider Admins are allowed to preview Machine/InstanceType matches
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.ProviderAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Provider Admin role, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Provider Admin role with org", nil)
+	}
+
+	// Get Instance Type ID
+	itStrID := c.Param("instanceTypeId")
+
+	gitmh.tracerSpan.SetAttribute(handlerSpan, attribute.String("instancetype_id", itStrID), logger)
+
+	itID, err := uuid.Parse(itStrID)
+	if err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid Instance Type ID in URL", nil)
+	}
+
+	// Check if org has an Infrastructure Provider
+	ipDAO := cdbm.NewInfrastructureProviderDAO(gitmh.dbSession)
+
+	ips, serr := ipDAO.GetAllByOrg(ctx, nil, org, nil)
+	if serr != nil {
+		logger.Error().Err(serr).Msg("error retrieving Infrastructure Provider for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to to retrieve Org entities to check Instance Type association", nil)
+	}
+
+	if len(ips) == 0 {
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Org does not have an Infrastructure Provider", nil)
+	}
+
+	orgIP := &ips[0]
+
+	// Get Instance Type
+	itDAO := cdbm.NewInstanceTypeDAO(gitmh.dbSession)
+
+	it, err := itDAO.GetByID(ctx, nil, itID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, "Instance Type not found", nil)
+		}
+
+		logger.Error().Err(err).Msg("error retrieving Instance Type from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Instance Type", nil)
+	}
+
+	// Check if Instance Type is associated with the Org's Provider
+	if orgIP.ID != it.InfrastructureProviderID {
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "Instance Type is not associated with org's Infrastructure Provider", nil)
+	}
+
+	if it.SiteID == nil {
+		logger.Error().Msg("InstanceType is not associated with a site")
+		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Failed to match Machines with Instance Type because Instance Type is not associated with a Site.", nil)
+	}
+
+	// Get Machine Capabilities of the Instance Type
+	mcDAO := cdbm.NewMachineCapabilityDAO(gitmh.dbSession)
+
+	itmcs, _, err := mcDAO.GetAll(ctx, nil, nil, []uuid.UUID{it.ID}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cdb.GetIntPtr(paginator.TotalLimit), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Machine Capabilities for Instance Type from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Machine Capabilities for Instance Type", nil)
+	}
+
+	if len(itmcs) == 0 {
+		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Instance Type does not have any Machine Capabilities to match against", nil)
+	}
+
+	// Get Machine Capabilities of the Machines that could be assigned
+	mcsByMachineID, err := cwu.GetUnassignedMachineCapabilities(ctx, nil, gitmh.dbSession, it.InfrastructureProviderID, *it.SiteID)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving unassigned Machines for Site from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve unassigned Machines for Instance Type's Site", nil)
+	}
+
+	mismatchesByMachineID := make(map[string][]string, len(mcsByMachineID))
+	for machineID, mcs := range mcsByMachineID {
+		mismatchesByMachineID[machineID] = cdbm.MatchMachineCapabilities(itmcs, mcs, cdbm.MachineCapabilityMatchExact)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIInstanceTypeMatch(it, mismatchesByMachineID))
+}
+
 // ~~~~~ Delete Handler ~~~~~ //
 
 // DeleteMachineInstanceTypeHandler is the API Handler for deleting a Machine/InstanceType association
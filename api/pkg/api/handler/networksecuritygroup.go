@@ -29,6 +29,7 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
 
 	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
 	common "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
@@ -43,11 +44,78 @@ import (
 	swe "github.com/nvidia/bare-metal-manager-rest/site-workflow/pkg/error"
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+	cwu "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
 	"go.opentelemetry.io/otel/attribute"
 	temporalClient "go.temporal.io/sdk/client"
 	tp "go.temporal.io/sdk/temporal"
 )
 
+// validateNetworkSecurityGroupRuleReferences ensures that the Address Sets and Network Security Groups referenced by rules exist and belong to the Tenant.
+// Referenced Network Security Groups must also be on the same Site. selfID is the ID of the Network Security Group being updated, if any.
+func validateNetworkSecurityGroupRuleReferences(ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, tenantID uuid.UUID, siteID uuid.UUID, selfID *string, rules []*cdbm.NetworkSecurityGroupRule) *cutil.APIError {
+	addressSetIDs, nsgIDs := (&cdbm.NetworkSecurityGroup{Rules: rules}).GetReferencedIDs()
+
+	if len(addressSetIDs) > 0 {
+		asDAO := cdbm.NewAddressSetDAO(dbSession)
+		addressSets, _, err := asDAO.GetAll(ctx, nil, cdbm.AddressSetFilterInput{AddressSetIDs: addressSetIDs, TenantIDs: []uuid.UUID{tenantID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving Address Sets referenced by rules from DB")
+			return cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Address Sets referenced by rules", nil)
+		}
+
+		found := map[uuid.UUID]bool{}
+		for _, as := range addressSets {
+			found[as.ID] = true
+		}
+		for _, id := range addressSetIDs {
+			if !found[id] {
+				return cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Address Set `%s` referenced by rules could not be found", id), nil)
+			}
+		}
+	}
+
+	if len(nsgIDs) > 0 {
+		nsgDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+		nsgs, _, err := nsgDAO.GetAll(ctx, nil, cdbm.NetworkSecurityGroupFilterInput{NetworkSecurityGroupIDs: nsgIDs, TenantIDs: []uuid.UUID{tenantID}, SiteIDs: []uuid.UUID{siteID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving Network Security Groups referenced by rules from DB")
+			return cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Network Security Groups referenced by rules", nil)
+		}
+
+		found := map[string]bool{}
+		if selfID != nil {
+			found[*selfID] = true
+		}
+		for _, nsg := range nsgs {
+			found[nsg.ID] = true
+		}
+		for _, id := range nsgIDs {
+			if !found[id] {
+				return cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Network Security Group `%s` referenced by rules could not be found on the same Site", id), nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+// getNetworkSecurityGroupSiteRules returns the rules of a Network Security Group as they are sent to the Site, with Address Set and
+// Network Security Group references resolved to the prefixes they currently contain
+func getNetworkSecurityGroupSiteRules(ctx context.Context, logger zerolog.Logger, tx *cdb.Tx, dbSession *cdb.Session, nsg *cdbm.NetworkSecurityGroup, siteConfig *cdbm.SiteConfig) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, *cutil.APIError) {
+	rules, err := cwu.GetResolvedNetworkSecurityGroupRules(ctx, tx, dbSession, nsg)
+	if err != nil {
+		logger.Error().Err(err).Msg("error resolving references in NetworkSecurityGroup rules")
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to resolve Address Sets and Network Security Groups referenced by rules", nil)
+	}
+
+	maxRules := model.GetMaxNetworkSecurityGroupRules(siteConfig)
+	if len(rules) > maxRules {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Rules expand to %d rules when references are resolved, number of rules cannot exceed %d", len(rules), maxRules), nil)
+	}
+
+	return rules, nil
+}
+
 // ~~~~~ Create Handler ~~~~~ //
 
 // CreateNetworkSecurityGroupHandler is the API Handler for creating a new NetworkSecurityGroup
@@ -215,6 +283,11 @@ func (cnsgh CreateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		rules[i] = newRule
 	}
 
+	apiErr := validateNetworkSecurityGroupRuleReferences(ctx, logger, cnsgh.dbSession, tenant.ID, site.ID, nil, rules)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
 	// Start a db tx
 	tx, err := cdb.BeginTx(ctx, cnsgh.dbSession, &sql.TxOptions{})
 	if err != nil {
@@ -287,10 +360,9 @@ func (cnsgh CreateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 
 	// Convert the DB rule wrappers into rules
 	// we can send to Carbide.
-	carbideRules := make([]*cwssaws.NetworkSecurityGroupRuleAttributes, len(rules))
-
-	for i, rule := range rules {
-		carbideRules[i] = rule.NetworkSecurityGroupRuleAttributes
+	carbideRules, apiErr := getNetworkSecurityGroupSiteRules(ctx, logger, tx, cnsgh.dbSession, networkSecurityGroup, siteConfig)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
 	}
 
 	// Prepare the create request workflow object
@@ -920,6 +992,17 @@ func (dnsgh DeleteNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Cannot delete NetworkSecurityGroup, one or more VPCs have attached this Network Security Group", nil)
 	}
 
+	referencingNSGs, _, err := nsgDAO.GetAll(ctx, nil, cdbm.NetworkSecurityGroupFilterInput{ReferencedNetworkSecurityGroupIDs: []string{nsgID}}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Network Security Groups referencing Network Security Group", nil)
+	}
+
+	for _, referencingNSG := range referencingNSGs {
+		if referencingNSG.ID != nsgID {
+			return cutil.NewAPIErrorResponse(c, http.StatusPreconditionFailed, "Cannot delete NetworkSecurityGroup, rules of one or more other Network Security Groups reference this Network Security Group", nil)
+		}
+	}
+
 	// Start a DB transaction
 	tx, err := cdb.BeginTx(ctx, dnsgh.dbSession, &sql.TxOptions{})
 	if err != nil {
@@ -1208,6 +1291,11 @@ func (dnsgh UpdateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 
 			rules[i] = newRule
 		}
+
+		apiErr := validateNetworkSecurityGroupRuleReferences(ctx, logger, dnsgh.dbSession, nsg.TenantID, nsg.SiteID, &nsg.ID, rules)
+		if apiErr != nil {
+			return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+		}
 	}
 
 	// Start a DB transaction
@@ -1269,10 +1357,9 @@ func (dnsgh UpdateNetworkSecurityGroupHandler) Handle(c echo.Context) error {
 
 	// Convert the DB rule wrappers into rules
 	// we can send to Carbide.
-	carbideRules := make([]*cwssaws.NetworkSecurityGroupRuleAttributes, len(nsg.Rules))
-
-	for i, rule := range nsg.Rules {
-		carbideRules[i] = rule.NetworkSecurityGroupRuleAttributes
+	carbideRules, apiErr := getNetworkSecurityGroupSiteRules(ctx, logger, tx, dnsgh.dbSession, nsg, siteConfig)
+	if apiErr != nil {
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
 	}
 
 	// Prepare the create request workflow object
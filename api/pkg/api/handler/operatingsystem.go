@@ -674,8 +674,9 @@ func (gash GetAllOperatingSystemHandler) Handle(c echo.Context) error {
 		ctx,
 		nil,
 		cdbm.OperatingSystemSiteAssociationFilterInput{
-			OperatingSystemIDs: osIDs,
-			SiteIDs:            siteIDs,
+			OperatingSystemIDs:        osIDs,
+			SiteIDs:                   siteIDs,
+			HasOperatingSystemVersion: cdb.GetBoolPtr(false),
 		},
 		cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)},
 		[]string{cdbm.SiteRelationName},
@@ -871,7 +872,8 @@ func (gsh GetOperatingSystemHandler) Handle(c echo.Context) error {
 			ctx,
 			nil,
 			cdbm.OperatingSystemSiteAssociationFilterInput{
-				OperatingSystemIDs: []uuid.UUID{os.ID},
+				OperatingSystemIDs:        []uuid.UUID{os.ID},
+				HasOperatingSystemVersion: cdb.GetBoolPtr(false),
 			},
 			cdbp.PageInput{
 				Limit: cdb.GetIntPtr(cdbp.TotalLimit),
@@ -1072,7 +1074,8 @@ func (ush UpdateOperatingSystemHandler) Handle(c echo.Context) error {
 			ctx,
 			nil,
 			cdbm.OperatingSystemSiteAssociationFilterInput{
-				OperatingSystemIDs: []uuid.UUID{os.ID},
+				OperatingSystemIDs:        []uuid.UUID{os.ID},
+				HasOperatingSystemVersion: cdb.GetBoolPtr(false),
 			},
 			cdbp.PageInput{},
 			[]string{cdbm.SiteRelationName},
@@ -1528,14 +1531,20 @@ func (dsh DeleteOperatingSystemHandler) Handle(c echo.Context) error {
 					return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve client for Site", nil)
 				}
 
+				// Operating System Versions are known on Site by their own ID
+				siteImageID := *common.GetSiteOperatingSystemtID(os)
+				if ossa.OperatingSystemVersionID != nil {
+					siteImageID = *ossa.OperatingSystemVersionID
+				}
+
 				// Prepare the delete/release request workflow object
 				deleteOsRequest := &cwssaws.DeleteOsImageRequest{
-					Id:                   &cwssaws.UUID{Value: common.GetSiteOperatingSystemtID(os).String()},
+					Id:                   &cwssaws.UUID{Value: siteImageID.String()},
 					TenantOrganizationId: tenant.Org,
 				}
 
 				workflowOptions := temporalClient.StartWorkflowOptions{
-					ID:        "image-os-delete-" + ossa.SiteID.String() + "-" + os.ID.String() + "-" + *ossa.Version,
+					ID:        "image-os-delete-" + ossa.SiteID.String() + "-" + ossa.GetSiteImageID().String() + "-" + *ossa.Version,
 					TaskQueue: queue.SiteTaskQueue,
 				}
 
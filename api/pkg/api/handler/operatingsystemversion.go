@@ -0,0 +1,806 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/attribute"
+	temporalClient "go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	common "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/pagination"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+
+	osImageWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/operatingsystem"
+)
+
+// getOperatingSystemForVersionRequest validates the User managing Operating System Versions and retrieves the
+// Operating System specified in the URL, which must belong to the Tenant of the org
+func getOperatingSystemForVersionRequest(ctx context.Context, c echo.Context, dbSession *cdb.Session, dbUser *cdbm.User, org string) (*cdbm.OperatingSystem, *cutil.APIError) {
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			return nil, cutil.NewAPIError(http.StatusInternalServerError, "Error validating org membership for User", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to manage Operating System Versions
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		return nil, cutil.NewAPIError(http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgTenantNotFound) {
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Org does not have a Tenant associated", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	osID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Operating System ID in URL", nil)
+	}
+
+	osDAO := cdbm.NewOperatingSystemDAO(dbSession)
+	os, err := osDAO.GetByID(ctx, nil, osID, nil)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Operating System with specified ID", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Operating System due to DB error", nil)
+	}
+
+	if os.TenantID == nil || *os.TenantID != tenant.ID {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Tenant for Operating System in request does not match tenant in org", nil)
+	}
+
+	return os, nil
+}
+
+// getOperatingSystemVersionForOperatingSystem retrieves the Operating System Version specified in the URL and checks
+// that it belongs to the Operating System
+func getOperatingSystemVersionForOperatingSystem(ctx context.Context, c echo.Context, dbSession *cdb.Session, os *cdbm.OperatingSystem) (*cdbm.OperatingSystemVersion, *cutil.APIError) {
+	osvID, err := uuid.Parse(c.Param("versionId"))
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid Operating System Version ID in URL", nil)
+	}
+
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(dbSession)
+	osv, err := osvDAO.GetByID(ctx, nil, osvID, nil)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Operating System Version with specified ID", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Operating System Version due to DB error", nil)
+	}
+
+	if osv.OperatingSystemID != os.ID {
+		return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find Operating System Version with specified ID", nil)
+	}
+
+	return osv, nil
+}
+
+// getAPIOperatingSystemVersions retrieves the status history and Site associations of Operating System Versions
+// and returns their API representation
+func getAPIOperatingSystemVersions(ctx context.Context, dbSession *cdb.Session, osvs []cdbm.OperatingSystemVersion) ([]model.APIOperatingSystemVersion, error) {
+	apiosvs := []model.APIOperatingSystemVersion{}
+	if len(osvs) == 0 {
+		return apiosvs, nil
+	}
+
+	osvIDs := []uuid.UUID{}
+	osvStrIDs := []string{}
+	for _, osv := range osvs {
+		osvIDs = append(osvIDs, osv.ID)
+		osvStrIDs = append(osvStrIDs, osv.ID.String())
+	}
+
+	sdDAO := cdbm.NewStatusDetailDAO(dbSession)
+	sds, err := sdDAO.GetRecentByEntityIDs(ctx, nil, osvStrIDs, common.RECENT_STATUS_DETAIL_COUNT)
+	if err != nil {
+		return nil, err
+	}
+
+	sdMap := map[string][]cdbm.StatusDetail{}
+	for _, sd := range sds {
+		sdMap[sd.EntityID] = append(sdMap[sd.EntityID], sd)
+	}
+
+	ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(dbSession)
+	ossas, _, err := ossaDAO.GetAll(ctx, nil, cdbm.OperatingSystemSiteAssociationFilterInput{
+		OperatingSystemVersionIDs: osvIDs,
+	}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, []string{cdbm.SiteRelationName})
+	if err != nil {
+		return nil, err
+	}
+
+	ossaMap := map[uuid.UUID][]cdbm.OperatingSystemSiteAssociation{}
+	for _, ossa := range ossas {
+		ossaMap[*ossa.OperatingSystemVersionID] = append(ossaMap[*ossa.OperatingSystemVersionID], ossa)
+	}
+
+	for i := range osvs {
+		apiosvs = append(apiosvs, *model.NewAPIOperatingSystemVersion(&osvs[i], sdMap[osvs[i].ID.String()], ossaMap[osvs[i].ID]))
+	}
+
+	return apiosvs, nil
+}
+
+// getOperatingSystemVersionForInstance resolves the Operating System Version an Instance should be provisioned with.
+// If no version is requested, the latest usable version of the Operating System is selected, for Image based Operating
+// Systems among the versions synced to the Site. Nil is returned if there is no such version, in which case the
+// Operating System's own image or iPXE script is used.
+func getOperatingSystemVersionForInstance(ctx context.Context, logger *zerolog.Logger, dbSession *cdb.Session, os *cdbm.OperatingSystem, osvIDStr *string, site *cdbm.Site) (*cdbm.OperatingSystemVersion, *cutil.APIError) {
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(dbSession)
+
+	var osv *cdbm.OperatingSystemVersion
+
+	if osvIDStr != nil && *osvIDStr != "" {
+		osvID, err := uuid.Parse(*osvIDStr)
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to parse OperatingSystemVersionID")
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Unable to parse `operatingSystemVersionId` specified", validation.Errors{
+				"operatingSystemVersionId": errors.New(*osvIDStr),
+			})
+		}
+
+		osv, err = osvDAO.GetByID(ctx, nil, osvID, nil)
+		if err != nil {
+			if errors.Is(err, cdb.ErrDoesNotExist) {
+				return nil, cutil.NewAPIError(http.StatusBadRequest, "Could not find Operating System Version with ID specified in request data", validation.Errors{
+					"operatingSystemVersionId": errors.New(osvID.String()),
+				})
+			}
+			logger.Error().Err(err).Msg("error retrieving Operating System Version from DB by ID")
+			return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Operating System Version with ID specified in request data, DB error", nil)
+		}
+
+		if osv.OperatingSystemID != os.ID {
+			logger.Warn().Str("operatingSystemVersionId", osv.ID.String()).Msg("Operating System Version in request does not belong to Operating System")
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Operating System Version specified in request does not belong to Operating System", nil)
+		}
+
+		if osv.IsDeprecated() {
+			logger.Warn().Str("operatingSystemVersionId", osv.ID.String()).Msg("Operating System Version in request is deprecated")
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Operating System Version specified in request has been deprecated and cannot be used for new Instances", nil)
+		}
+
+		if !osv.IsUsable() {
+			logger.Warn().Str("operatingSystemVersionId", osv.ID.String()).Str("status", osv.Status).Msg("Operating System Version in request is not ready")
+			return nil, cutil.NewAPIError(http.StatusBadRequest, fmt.Sprintf("Operating System Version specified in request is not ready, current status: %s", osv.Status), nil)
+		}
+	} else {
+		// Image based versions must have been synced to the Site
+		var siteID *uuid.UUID
+		if os.Type == cdbm.OperatingSystemTypeImage {
+			siteID = &site.ID
+		}
+
+		var err error
+		osv, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, siteID, nil)
+		if err != nil {
+			if errors.Is(err, cdb.ErrDoesNotExist) {
+				return nil, nil
+			}
+			logger.Error().Err(err).Msg("error retrieving latest usable Operating System Version from DB")
+			return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Operating System Version for Operating System, DB error", nil)
+		}
+
+		return osv, nil
+	}
+
+	// Image based versions must have been synced to the Site
+	if os.Type == cdbm.OperatingSystemTypeImage {
+		ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(dbSession)
+		_, ossaCount, err := ossaDAO.GetAll(ctx, nil, cdbm.OperatingSystemSiteAssociationFilterInput{
+			OperatingSystemVersionIDs: []uuid.UUID{osv.ID},
+			SiteIDs:                   []uuid.UUID{site.ID},
+			Statuses:                  []string{cdbm.OperatingSystemSiteAssociationStatusSynced},
+		}, cdbp.PageInput{Limit: cdb.GetIntPtr(1)}, nil)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving Site associations for Operating System Version from DB")
+			return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve Site associations for Operating System Version, DB error", nil)
+		}
+		if ossaCount == 0 {
+			logger.Warn().Str("operatingSystemVersionId", osv.ID.String()).Msg("Operating System Version has not been synced to VPC site")
+			return nil, cutil.NewAPIError(http.StatusBadRequest, "Operating System Version is not available in VPC site, it has not been synced to the Site", nil)
+		}
+	}
+
+	return osv, nil
+}
+
+// applyOperatingSystemVersion returns a copy of the Operating System with the image or iPXE script of the version
+func applyOperatingSystemVersion(os *cdbm.OperatingSystem, osv *cdbm.OperatingSystemVersion) *cdbm.OperatingSystem {
+	if osv == nil {
+		return os
+	}
+
+	vos := *os
+	vos.ImageURL = osv.ImageURL
+	vos.ImageSHA = osv.ImageSHA
+	vos.ImageAuthType = osv.ImageAuthType
+	vos.ImageAuthToken = osv.ImageAuthToken
+	vos.ImageDisk = osv.ImageDisk
+	vos.RootFsID = osv.RootFsID
+	vos.RootFsLabel = osv.RootFsLabel
+	vos.IpxeScript = osv.IpxeScript
+
+	return &vos
+}
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateOperatingSystemVersionHandler is the API Handler for creating a new version of an Operating System
+type CreateOperatingSystemVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateOperatingSystemVersionHandler initializes and returns a new handler for creating Operating System Version
+func NewCreateOperatingSystemVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) CreateOperatingSystemVersionHandler {
+	return CreateOperatingSystemVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create an Operating System Version
+// @Description Create an immutable version of an Operating System. Image based versions are verified before they can be used.
+// @Tags OperatingSystem
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Operating System"
+// @Param message body model.APIOperatingSystemVersionCreateRequest true "Operating System Version create request"
+// @Success 201 {object} model.APIOperatingSystemVersion
+// @Router /v2/org/{org}/carbide/operating-system/{id}/version [post]
+func (cosvh CreateOperatingSystemVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("OperatingSystemVersion", "Create", c, cosvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	os, apiErr := getOperatingSystemForVersionRequest(ctx, c, cosvh.dbSession, dbUser, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	cosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("operatingsystem_id", os.ID.String()), logger)
+
+	if os.Status == cdbm.OperatingSystemStatusDeleting {
+		logger.Warn().Msg("Operating System is being deleted, cannot create version")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Operating System is being deleted, versions can no longer be created", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIOperatingSystemVersionCreateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate(os)
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Operating System Version creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Operating System Version creation request data", verr)
+	}
+
+	cosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("version", apiRequest.Version), logger)
+
+	// Check for version uniqueness within the Operating System
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(cosvh.dbSession)
+	dosvs, tot, err := osvDAO.GetAll(ctx, nil, cdbm.OperatingSystemVersionFilterInput{
+		OperatingSystemIDs: []uuid.UUID{os.ID},
+		Versions:           []string{apiRequest.Version},
+	}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("db error checking for uniqueness of Operating System Version")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Operating System Version due to DB error", nil)
+	}
+	if tot > 0 {
+		logger.Warn().Str("version", apiRequest.Version).Msg("Operating System Version with same version already exists")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "A version with specified name already exists for Operating System", validation.Errors{
+			"id": errors.New(dosvs[0].ID.String()),
+		})
+	}
+
+	// iPXE scripts are served as is, only images need to be verified
+	status := cdbm.OperatingSystemVersionStatusReady
+	statusMessage := "Operating System Version is ready to use"
+	if os.Type == cdbm.OperatingSystemTypeImage {
+		status = cdbm.OperatingSystemVersionStatusPending
+		statusMessage = "received request for creation, pending image verification"
+	}
+
+	// Start a db tx
+	tx, err := cdb.BeginTx(ctx, cosvh.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Operating System Version", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	osv, err := osvDAO.Create(ctx, tx, cdbm.OperatingSystemVersionCreateInput{
+		OperatingSystemID: os.ID,
+		Version:           apiRequest.Version,
+		ImageURL:          apiRequest.ImageURL,
+		ImageSHA:          apiRequest.ImageSHA,
+		ImageAuthType:     apiRequest.ImageAuthType,
+		ImageAuthToken:    apiRequest.ImageAuthToken,
+		ImageDisk:         apiRequest.ImageDisk,
+		RootFsID:          apiRequest.RootFsID,
+		RootFsLabel:       apiRequest.RootFsLabel,
+		IpxeScript:        apiRequest.IpxeScript,
+		Status:            status,
+		CreatedBy:         dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create Operating System Version record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Operating System Version due to DB error", nil)
+	}
+
+	sdDAO := cdbm.NewStatusDetailDAO(cosvh.dbSession)
+	_, err = sdDAO.CreateFromParams(ctx, tx, osv.ID.String(), status, &statusMessage)
+	if err != nil {
+		logger.Error().Err(err).Msg("error creating Status Detail DB entry")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Status Detail for Operating System Version", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create Operating System Version", nil)
+	}
+	txCommitted = true
+
+	if os.Type == cdbm.OperatingSystemTypeImage {
+		wid, serr := osImageWorkflow.ExecuteVerifyOperatingSystemVersionWorkflow(ctx, cosvh.tc, osv.ID)
+		if serr != nil {
+			// Log error but continue, verification can be retried by the user
+			logger.Error().Err(serr).Msg("failed to execute verify Operating System Version workflow")
+		} else {
+			logger.Info().Str("Workflow ID", *wid).Msg("triggered Operating System Version verification workflow")
+		}
+	}
+
+	apiosvs, err := getAPIOperatingSystemVersions(ctx, cosvh.dbSession, []cdbm.OperatingSystemVersion{*osv})
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving details for Operating System Version from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve details for Operating System Version", nil)
+	}
+
+	logger.Info().Str("Operating System Version ID", osv.ID.String()).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiosvs[0])
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllOperatingSystemVersionHandler is the API Handler for retrieving all versions of an Operating System
+type GetAllOperatingSystemVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllOperatingSystemVersionHandler initializes and returns a new handler for retrieving all Operating System Versions
+func NewGetAllOperatingSystemVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetAllOperatingSystemVersionHandler {
+	return GetAllOperatingSystemVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all Operating System Versions
+// @Description Get all versions of an Operating System
+// @Tags OperatingSystem
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Operating System"
+// @Param status query string false "Filter by status e.g. 'Ready', 'Error'"
+// @Param isDeprecated query boolean false "Filter by deprecation"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {array} []model.APIOperatingSystemVersion
+// @Router /v2/org/{org}/carbide/operating-system/{id}/version [get]
+func (gaosvh GetAllOperatingSystemVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("OperatingSystemVersion", "GetAll", c, gaosvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	os, apiErr := getOperatingSystemForVersionRequest(ctx, c, gaosvh.dbSession, dbUser, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err := c.Bind(&pageRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	// Validate pagination request attributes
+	err = pageRequest.Validate(cdbm.OperatingSystemVersionOrderByFields)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest,
+			"Failed to validate pagination request data", err)
+	}
+
+	filter := cdbm.OperatingSystemVersionFilterInput{
+		OperatingSystemIDs: []uuid.UUID{os.ID},
+	}
+
+	// Get status from query param
+	if statusQuery := c.QueryParam("status"); statusQuery != "" {
+		if _, ok := cdbm.OperatingSystemVersionStatusMap[statusQuery]; !ok {
+			logger.Warn().Str("status", statusQuery).Msg("invalid value in status query")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Invalid Status value in query: %s", statusQuery), nil)
+		}
+		filter.Statuses = []string{statusQuery}
+		gaosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("status", statusQuery), logger)
+	}
+
+	// Get deprecation from query param
+	if isDeprecatedQuery := c.QueryParam("isDeprecated"); isDeprecatedQuery != "" {
+		isDeprecated, serr := strconv.ParseBool(isDeprecatedQuery)
+		if serr != nil {
+			logger.Warn().Str("isDeprecated", isDeprecatedQuery).Msg("invalid value in isDeprecated query")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Invalid isDeprecated value in query: %s", isDeprecatedQuery), nil)
+		}
+		filter.IsDeprecated = &isDeprecated
+	}
+
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(gaosvh.dbSession)
+	osvs, total, err := osvDAO.GetAll(ctx, nil, filter, cdbp.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving Operating System Versions from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Operating System Versions due to DB error", nil)
+	}
+
+	// Create response
+	apiosvs, err := getAPIOperatingSystemVersions(ctx, gaosvh.dbSession, osvs)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving details for Operating System Versions from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve details for Operating System Versions", nil)
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiosvs)
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetOperatingSystemVersionHandler is the API Handler for retrieving an Operating System Version
+type GetOperatingSystemVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetOperatingSystemVersionHandler initializes and returns a new handler for retrieving an Operating System Version
+func NewGetOperatingSystemVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) GetOperatingSystemVersionHandler {
+	return GetOperatingSystemVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Retrieve an Operating System Version
+// @Description Retrieve a version of an Operating System
+// @Tags OperatingSystem
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Operating System"
+// @Param versionId path string true "ID of Operating System Version"
+// @Success 200 {object} model.APIOperatingSystemVersion
+// @Router /v2/org/{org}/carbide/operating-system/{id}/version/{versionId} [get]
+func (gosvh GetOperatingSystemVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("OperatingSystemVersion", "Get", c, gosvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	os, apiErr := getOperatingSystemForVersionRequest(ctx, c, gosvh.dbSession, dbUser, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	osv, apiErr := getOperatingSystemVersionForOperatingSystem(ctx, c, gosvh.dbSession, os)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System Version for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("operatingsystemversion_id", osv.ID.String()), logger)
+
+	apiosvs, err := getAPIOperatingSystemVersions(ctx, gosvh.dbSession, []cdbm.OperatingSystemVersion{*osv})
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving details for Operating System Version from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve details for Operating System Version", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiosvs[0])
+}
+
+// ~~~~~ Update Handler ~~~~~ //
+
+// UpdateOperatingSystemVersionHandler is the API Handler for deprecating or undeprecating an Operating System Version
+type UpdateOperatingSystemVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewUpdateOperatingSystemVersionHandler initializes and returns a new handler for updating an Operating System Version
+func NewUpdateOperatingSystemVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) UpdateOperatingSystemVersionHandler {
+	return UpdateOperatingSystemVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Update an Operating System Version
+// @Description Deprecate or undeprecate a version of an Operating System. Deprecated versions can not be used for new Instances, existing Instances are not affected.
+// @Tags OperatingSystem
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Operating System"
+// @Param versionId path string true "ID of Operating System Version"
+// @Param message body model.APIOperatingSystemVersionUpdateRequest true "Operating System Version update request"
+// @Success 200 {object} model.APIOperatingSystemVersion
+// @Router /v2/org/{org}/carbide/operating-system/{id}/version/{versionId} [patch]
+func (uosvh UpdateOperatingSystemVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("OperatingSystemVersion", "Update", c, uosvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	os, apiErr := getOperatingSystemForVersionRequest(ctx, c, uosvh.dbSession, dbUser, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	osv, apiErr := getOperatingSystemVersionForOperatingSystem(ctx, c, uosvh.dbSession, os)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System Version for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	uosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("operatingsystemversion_id", osv.ID.String()), logger)
+
+	// Bind request data to API model
+	apiRequest := model.APIOperatingSystemVersionUpdateRequest{}
+	err := c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate(osv)
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating Operating System Version update request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating Operating System Version update request data", verr)
+	}
+
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(uosvh.dbSession)
+
+	if apiRequest.IsDeprecated != nil && !*apiRequest.IsDeprecated {
+		osv, err = osvDAO.Clear(ctx, nil, cdbm.OperatingSystemVersionClearInput{
+			OperatingSystemVersionID: osv.ID,
+			Deprecated:               true,
+			DeprecationNote:          true,
+		})
+	} else {
+		input := cdbm.OperatingSystemVersionUpdateInput{
+			OperatingSystemVersionID: osv.ID,
+			DeprecationNote:          apiRequest.DeprecationNote,
+		}
+		if apiRequest.IsDeprecated != nil {
+			input.Deprecated = cdb.GetTimePtr(cdb.GetCurTime())
+		}
+		osv, err = osvDAO.Update(ctx, nil, input)
+	}
+	if err != nil {
+		logger.Error().Err(err).Msg("error updating Operating System Version in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update Operating System Version due to DB error", nil)
+	}
+
+	apiosvs, err := getAPIOperatingSystemVersions(ctx, uosvh.dbSession, []cdbm.OperatingSystemVersion{*osv})
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving details for Operating System Version from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve details for Operating System Version", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiosvs[0])
+}
+
+// ~~~~~ Verify Handler ~~~~~ //
+
+// VerifyOperatingSystemVersionHandler is the API Handler for re-running verification of an Operating System Version
+type VerifyOperatingSystemVersionHandler struct {
+	dbSession  *cdb.Session
+	tc         temporalClient.Client
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewVerifyOperatingSystemVersionHandler initializes and returns a new handler for verifying an Operating System Version
+func NewVerifyOperatingSystemVersionHandler(dbSession *cdb.Session, tc temporalClient.Client, cfg *config.Config) VerifyOperatingSystemVersionHandler {
+	return VerifyOperatingSystemVersionHandler{
+		dbSession:  dbSession,
+		tc:         tc,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Verify an Operating System Version
+// @Description Re-run verification of an image based Operating System Version, e.g. after the image has been fixed. Verified versions are re-synced to Sites where sync failed.
+// @Tags OperatingSystem
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of Operating System"
+// @Param versionId path string true "ID of Operating System Version"
+// @Success 202 {object} model.APIOperatingSystemVersion
+// @Router /v2/org/{org}/carbide/operating-system/{id}/version/{versionId}/verify [post]
+func (vosvh VerifyOperatingSystemVersionHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("OperatingSystemVersion", "Verify", c, vosvh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	os, apiErr := getOperatingSystemForVersionRequest(ctx, c, vosvh.dbSession, dbUser, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	osv, apiErr := getOperatingSystemVersionForOperatingSystem(ctx, c, vosvh.dbSession, os)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("unable to retrieve Operating System Version for request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	vosvh.tracerSpan.SetAttribute(handlerSpan, attribute.String("operatingsystemversion_id", osv.ID.String()), logger)
+
+	if os.Type != cdbm.OperatingSystemTypeImage {
+		logger.Warn().Msg("verification requested for iPXE based Operating System Version")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Only image based Operating System Versions can be verified", nil)
+	}
+
+	if osv.Status == cdbm.OperatingSystemVersionStatusVerifying {
+		logger.Warn().Msg("Operating System Version is already being verified")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "Operating System Version is already being verified", nil)
+	}
+
+	wid, err := osImageWorkflow.ExecuteVerifyOperatingSystemVersionWorkflow(ctx, vosvh.tc, osv.ID)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to execute verify Operating System Version workflow")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to start Operating System Version verification", nil)
+	}
+
+	logger.Info().Str("Workflow ID", *wid).Msg("triggered Operating System Version verification workflow")
+
+	apiosvs, err := getAPIOperatingSystemVersions(ctx, vosvh.dbSession, []cdbm.OperatingSystemVersion{*osv})
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving details for Operating System Version from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve details for Operating System Version", nil)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusAccepted, apiosvs[0])
+}
@@ -0,0 +1,279 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestOperatingSystemVersionHandler_Lifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	org := "test-org"
+	user := common.TestBuildUser(t, dbSession, uuid.NewString(), org, []string{"FORGE_TENANT_ADMIN"})
+	ip := common.TestBuildInfrastructureProvider(t, dbSession, "test-provider", "test-provider-org", user)
+	tn := common.TestBuildTenant(t, dbSession, "test-tenant", org, user)
+	site := common.TestBuildSite(t, dbSession, ip, "test-site", user)
+
+	os := common.TestBuildOperatingSystem(t, dbSession, "debian-12", tn, cdbm.OperatingSystemStatusReady, user)
+	os.Type = cdbm.OperatingSystemTypeImage
+	_, err := dbSession.DB.NewUpdate().Model(os).Column("type").WherePK().Exec(ctx)
+	require.NoError(t, err)
+
+	otherOrg := "other-org"
+	otherUser := common.TestBuildUser(t, dbSession, uuid.NewString(), otherOrg, []string{"FORGE_TENANT_ADMIN"})
+	otherTn := common.TestBuildTenant(t, dbSession, "other-tenant", otherOrg, otherUser)
+	otherOS := common.TestBuildOperatingSystem(t, dbSession, "other-os", otherTn, cdbm.OperatingSystemStatusReady, otherUser)
+
+	tmc := &tmocks.Client{}
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	tmc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.AnythingOfType("func(internal.Context, uuid.UUID) error"), mock.AnythingOfType("uuid.UUID")).Return(wrun, nil)
+
+	newContext := func(method string, path string, body string, paramNames []string, paramValues []string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		ec := e.NewContext(req, rec)
+		ec.SetParamNames(append([]string{"orgName"}, paramNames...)...)
+		ec.SetParamValues(append([]string{org}, paramValues...)...)
+		ec.Set("user", user)
+		ec.SetRequest(ec.Request().WithContext(ctx))
+		return ec, rec
+	}
+
+	// Create
+	createTests := []struct {
+		name           string
+		osID           string
+		body           string
+		expectedStatus int
+	}{
+		{
+			name:           "test create Operating System Version success",
+			osID:           os.ID.String(),
+			body:           `{"version": "12.5", "imageUrl": "https://example.com/debian-12.5.qcow2", "imageSha": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "test create Operating System Version with duplicate version fails",
+			osID:           os.ID.String(),
+			body:           `{"version": "12.5", "imageUrl": "https://example.com/debian-12.5.qcow2", "imageSha": "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}`,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "test create Operating System Version without image fails",
+			osID:           os.ID.String(),
+			body:           `{"version": "12.6"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create Operating System Version for Operating System of other Tenant fails",
+			osID:           otherOS.ID.String(),
+			body:           `{"version": "1.0", "ipxeScript": "#!ipxe"}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create Operating System Version for non-existent Operating System fails",
+			osID:           uuid.NewString(),
+			body:           `{"version": "1.0", "ipxeScript": "#!ipxe"}`,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	var created *model.APIOperatingSystemVersion
+	for _, tc := range createTests {
+		t.Run(tc.name, func(t *testing.T) {
+			ec, rec := newContext(http.MethodPost, "/operating-system/"+tc.osID+"/version", tc.body, []string{"id"}, []string{tc.osID})
+
+			err := NewCreateOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus == http.StatusCreated {
+				created = &model.APIOperatingSystemVersion{}
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), created))
+				assert.Equal(t, cdbm.OperatingSystemVersionStatusPending, created.Status)
+				assert.Len(t, created.StatusHistory, 1)
+			}
+		})
+	}
+	require.NotNil(t, created)
+	tmc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+
+	osPath := "/operating-system/" + os.ID.String() + "/version"
+	osvPath := osPath + "/" + created.ID
+	osvParamNames := []string{"id", "versionId"}
+	osvParamValues := []string{os.ID.String(), created.ID}
+
+	// Pending versions are not used for Instances
+	logger := log.With().Logger()
+	osv, apiErr := getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, nil, site)
+	assert.Nil(t, apiErr)
+	assert.Nil(t, osv)
+
+	_, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, &created.ID, site)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	// Verified versions must be synced to the Site before they are used
+	osvID := uuid.MustParse(created.ID)
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(dbSession)
+	_, err = osvDAO.Update(ctx, nil, cdbm.OperatingSystemVersionUpdateInput{OperatingSystemVersionID: osvID, Status: cdb.GetStrPtr(cdbm.OperatingSystemVersionStatusReady)})
+	require.NoError(t, err)
+
+	osv, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, nil, site)
+	assert.Nil(t, apiErr)
+	assert.Nil(t, osv)
+
+	ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(dbSession)
+	ossa, err := ossaDAO.Create(ctx, nil, cdbm.OperatingSystemSiteAssociationCreateInput{
+		OperatingSystemID:        os.ID,
+		OperatingSystemVersionID: &osvID,
+		SiteID:                   site.ID,
+		Status:                   cdbm.OperatingSystemSiteAssociationStatusSyncing,
+		CreatedBy:                user.ID,
+	})
+	require.NoError(t, err)
+
+	// Versions still syncing to the Site are not used
+	osv, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, nil, site)
+	assert.Nil(t, apiErr)
+	assert.Nil(t, osv)
+
+	_, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, &created.ID, site)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	_, err = ossaDAO.Update(ctx, nil, cdbm.OperatingSystemSiteAssociationUpdateInput{
+		OperatingSystemSiteAssociationID: ossa.ID,
+		Status:                           cdb.GetStrPtr(cdbm.OperatingSystemSiteAssociationStatusSynced),
+	})
+	require.NoError(t, err)
+
+	osv, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, nil, site)
+	require.Nil(t, apiErr)
+	require.NotNil(t, osv)
+	assert.Equal(t, osvID, osv.ID)
+
+	osv, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, &created.ID, site)
+	require.Nil(t, apiErr)
+	require.NotNil(t, osv)
+	assert.Equal(t, osvID, osv.ID)
+
+	vos := applyOperatingSystemVersion(os, osv)
+	assert.Equal(t, osv.ImageURL, vos.ImageURL)
+	assert.Nil(t, os.ImageURL)
+
+	// GetAll
+	ec, rec := newContext(http.MethodGet, osPath+"?status=Ready", "", []string{"id"}, []string{os.ID.String()})
+	require.NoError(t, NewGetAllOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	all := []model.APIOperatingSystemVersion{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &all))
+	require.Len(t, all, 1)
+	assert.Len(t, all[0].SiteAssociations, 1)
+	assert.NotEmpty(t, rec.Header().Get("X-Pagination"))
+
+	ec, rec = newContext(http.MethodGet, osPath+"?status=Bad", "", []string{"id"}, []string{os.ID.String()})
+	require.NoError(t, NewGetAllOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Get
+	ec, rec = newContext(http.MethodGet, osvPath, "", osvParamNames, osvParamValues)
+	require.NoError(t, NewGetOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	// Versions are only found under their Operating System
+	ec, rec = newContext(http.MethodGet, osvPath, "", osvParamNames, []string{otherOS.ID.String(), created.ID})
+	require.NoError(t, NewGetOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Deprecate
+	ec, rec = newContext(http.MethodPatch, osvPath, `{"isDeprecated": true, "deprecationNote": "kernel CVE"}`, osvParamNames, osvParamValues)
+	require.NoError(t, NewUpdateOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated := &model.APIOperatingSystemVersion{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), updated))
+	assert.True(t, updated.IsDeprecated)
+	assert.Equal(t, "kernel CVE", *updated.DeprecationNote)
+
+	// Deprecated versions are no longer used for new Instances
+	osv, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, nil, site)
+	assert.Nil(t, apiErr)
+	assert.Nil(t, osv)
+
+	_, apiErr = getOperatingSystemVersionForInstance(ctx, &logger, dbSession, os, &created.ID, site)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, http.StatusBadRequest, apiErr.Code)
+
+	ec, rec = newContext(http.MethodPatch, osvPath, `{"isDeprecated": true}`, osvParamNames, osvParamValues)
+	require.NoError(t, NewUpdateOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Undeprecate
+	ec, rec = newContext(http.MethodPatch, osvPath, `{"isDeprecated": false}`, osvParamNames, osvParamValues)
+	require.NoError(t, NewUpdateOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	updated = &model.APIOperatingSystemVersion{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), updated))
+	assert.False(t, updated.IsDeprecated)
+	assert.Nil(t, updated.DeprecationNote)
+
+	// Verify
+	ec, rec = newContext(http.MethodPost, osvPath+"/verify", "", osvParamNames, osvParamValues)
+	require.NoError(t, NewVerifyOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+	tmc.AssertNumberOfCalls(t, "ExecuteWorkflow", 2)
+
+	_, err = osvDAO.Update(ctx, nil, cdbm.OperatingSystemVersionUpdateInput{OperatingSystemVersionID: osvID, Status: cdb.GetStrPtr(cdbm.OperatingSystemVersionStatusVerifying)})
+	require.NoError(t, err)
+
+	ec, rec = newContext(http.MethodPost, osvPath+"/verify", "", osvParamNames, osvParamValues)
+	require.NoError(t, NewVerifyOperatingSystemVersionHandler(dbSession, tmc, cfg).Handle(ec))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	tmc.AssertNumberOfCalls(t, "ExecuteWorkflow", 2)
+}
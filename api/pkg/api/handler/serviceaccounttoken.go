@@ -0,0 +1,504 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/attribute"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/pagination"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cauth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/config"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+)
+
+const (
+	// serviceAccountTokenUserPrefix prefixes the auxiliary ID of the User each API token acts as
+	serviceAccountTokenUserPrefix = "api-token:"
+)
+
+// validateServiceAccountTokenRequest validates the org membership and role of the User managing API tokens.
+// API tokens cannot be used to manage API tokens, so a leaked token cannot be used to mint new ones.
+func validateServiceAccountTokenRequest(c echo.Context, dbUser *cdbm.User, org string) *cutil.APIError {
+	if cauth.GetAPITokenIDFromContext(c) != nil {
+		return cutil.NewAPIError(http.StatusForbidden, "API tokens cannot be used to manage API tokens", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			return cutil.NewAPIError(http.StatusInternalServerError, "Error validating org membership for User", nil)
+		}
+		return cutil.NewAPIError(http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to manage API tokens
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		return cutil.NewAPIError(http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	return nil
+}
+
+// ~~~~~ Create Handler ~~~~~ //
+
+// CreateServiceAccountTokenHandler is the API Handler for issuing a new API token
+type CreateServiceAccountTokenHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewCreateServiceAccountTokenHandler initializes and returns a new handler for issuing API tokens
+func NewCreateServiceAccountTokenHandler(dbSession *cdb.Session, cfg *config.Config) CreateServiceAccountTokenHandler {
+	return CreateServiceAccountTokenHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Create an API token
+// @Description Issue an API token scoped to the org's Tenant, a set of resources and verbs, and optionally a Site. The token is only returned in this response.
+// @Tags serviceaccount
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param message body model.APIServiceAccountTokenCreateRequest true "API token create request"
+// @Success 201 {object} model.APIServiceAccountToken
+// @Router /v2/org/{org}/carbide/service-account/token [post]
+func (csath CreateServiceAccountTokenHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ServiceAccountToken", "Create", c, csath.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateServiceAccountTokenRequest(c, dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage API tokens, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, csath.dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgTenantNotFound) {
+			logger.Warn().Err(err).Msg("Org does not have a Tenant associated")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Org does not have a Tenant associated", nil)
+		}
+		logger.Error().Err(err).Msg("unable to retrieve tenant for org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve tenant for org", nil)
+	}
+
+	// Bind request data to API model
+	apiRequest := model.APIServiceAccountTokenCreateRequest{}
+	err = c.Bind(&apiRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request data, potentially invalid structure", nil)
+	}
+
+	// Validate request attributes
+	verr := apiRequest.Validate()
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("error validating API token creation request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Error validating API token creation request data", verr)
+	}
+
+	csath.tracerSpan.SetAttribute(handlerSpan, attribute.String("name", apiRequest.Name), logger)
+
+	// Check for name uniqueness among the Tenant's unrevoked tokens
+	satDAO := cdbm.NewServiceAccountTokenDAO(csath.dbSession)
+	sats, tot, err := satDAO.GetAll(ctx, nil, cdbm.ServiceAccountTokenFilterInput{
+		Names:          []string{apiRequest.Name},
+		TenantIDs:      []uuid.UUID{tenant.ID},
+		ExcludeRevoked: true,
+	}, cdbp.PageInput{}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("db error checking for name uniqueness of API token")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create API token due to DB error", nil)
+	}
+	if tot > 0 {
+		logger.Warn().Str("name", apiRequest.Name).Msg("API token with same name already exists for Tenant")
+		return cutil.NewAPIErrorResponse(c, http.StatusConflict, "An API token with specified name already exists for Tenant", validation.Errors{
+			"id": errors.New(sats[0].ID.String()),
+		})
+	}
+
+	// Validate that the Tenant has access to the Site the token is restricted to
+	var siteID *uuid.UUID
+	if apiRequest.SiteID != nil {
+		site, serr := common.GetSiteFromIDString(ctx, nil, *apiRequest.SiteID, csath.dbSession)
+		if serr != nil {
+			if errors.Is(serr, cdb.ErrDoesNotExist) {
+				return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not find Site with ID: %s", *apiRequest.SiteID), nil)
+			}
+			logger.Error().Err(serr).Msg("error retrieving Site from DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Site specified in request due to DB error", nil)
+		}
+
+		tsDAO := cdbm.NewTenantSiteDAO(csath.dbSession)
+		_, serr = tsDAO.GetByTenantIDAndSiteID(ctx, nil, tenant.ID, site.ID, nil)
+		if serr != nil {
+			if errors.Is(serr, cdb.ErrDoesNotExist) {
+				return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Tenant does not have access to Site specified in request", nil)
+			}
+			logger.Error().Err(serr).Msg("error retrieving Tenant Site association")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Tenant Site association", nil)
+		}
+		siteID = &site.ID
+	}
+
+	tokenStr, err := core.GenerateAPIToken()
+	if err != nil {
+		logger.Error().Err(err).Msg("error generating API token")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate API token", nil)
+	}
+
+	// start a transaction
+	tx, err := cdb.BeginTx(ctx, csath.dbSession, &sql.TxOptions{})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to start transaction")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create API token due to DB error", nil)
+	}
+	// this variable is used in cleanup actions to indicate if this transaction committed
+	txCommitted := false
+	defer common.RollbackTx(ctx, tx, &txCommitted)
+
+	// Each token acts as its own User so that its actions are audited separately from its creator
+	satID := uuid.New()
+	userDAO := cdbm.NewUserDAO(csath.dbSession)
+	tokenUser, _, err := userDAO.GetOrCreate(ctx, tx, cdbm.UserGetOrCreateInput{
+		AuxiliaryID: cdb.GetStrPtr(serviceAccountTokenUserPrefix + satID.String()),
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create User for API token in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create API token due to DB error", nil)
+	}
+
+	dbsat, err := satDAO.Create(ctx, tx, cdbm.ServiceAccountTokenCreateInput{
+		ServiceAccountTokenID: &satID,
+		Name:                  apiRequest.Name,
+		Description:           apiRequest.Description,
+		Org:                   org,
+		TenantID:              tenant.ID,
+		SiteID:                siteID,
+		Scopes:                apiRequest.Scopes,
+		TokenPrefix:           core.GetAPITokenDisplayPrefix(tokenStr),
+		TokenHash:             core.HashAPIToken(tokenStr),
+		UserID:                tokenUser.ID,
+		Expires:               apiRequest.Expires,
+		CreatedBy:             dbUser.ID,
+	})
+	if err != nil {
+		logger.Error().Err(err).Msg("unable to create API token record in DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create API token due to DB error", nil)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("error committing API token transaction to DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to create API token due to DB error", nil)
+	}
+	txCommitted = true
+
+	// Create response, the token is only ever returned here
+	apiToken := model.NewAPIServiceAccountToken(dbsat)
+	apiToken.Token = &tokenStr
+
+	logger.Info().Str("API Token ID", dbsat.ID.String()).Msg("finishing API handler")
+
+	return c.JSON(http.StatusCreated, apiToken)
+}
+
+// ~~~~~ GetAll Handler ~~~~~ //
+
+// GetAllServiceAccountTokenHandler is the API Handler for retrieving all API tokens of the org
+type GetAllServiceAccountTokenHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetAllServiceAccountTokenHandler initializes and returns a new handler for retrieving all API tokens
+func NewGetAllServiceAccountTokenHandler(dbSession *cdb.Session, cfg *config.Config) GetAllServiceAccountTokenHandler {
+	return GetAllServiceAccountTokenHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get all API tokens
+// @Description Get all API tokens of the org. Tokens themselves are never returned, only their prefix.
+// @Tags serviceaccount
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param includeRevoked query boolean false "Include revoked tokens"
+// @Param pageNumber query integer false "Page number of results returned"
+// @Param pageSize query integer false "Number of results per page"
+// @Param orderBy query string false "Order by field"
+// @Success 200 {array} []model.APIServiceAccountToken
+// @Router /v2/org/{org}/carbide/service-account/token [get]
+func (gasath GetAllServiceAccountTokenHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ServiceAccountToken", "GetAll", c, gasath.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateServiceAccountTokenRequest(c, dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage API tokens, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Validate pagination request
+	pageRequest := pagination.PageRequest{}
+	err := c.Bind(&pageRequest)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error binding pagination request data into API model")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Failed to parse request pagination data", nil)
+	}
+
+	// Validate pagination request attributes
+	err = pageRequest.Validate(cdbm.ServiceAccountTokenOrderByFields)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating pagination request data")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest,
+			"Failed to validate pagination request data", err)
+	}
+
+	includeRevoked := false
+	if qir := c.QueryParam("includeRevoked"); qir != "" {
+		includeRevoked, err = strconv.ParseBool(qir)
+		if err != nil {
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, "Invalid value specified for `includeRevoked` query param", nil)
+		}
+	}
+
+	satDAO := cdbm.NewServiceAccountTokenDAO(gasath.dbSession)
+	dbsats, total, err := satDAO.GetAll(ctx, nil, cdbm.ServiceAccountTokenFilterInput{
+		Orgs:           []string{org},
+		ExcludeRevoked: !includeRevoked,
+	}, cdbp.PageInput{
+		Offset:  pageRequest.Offset,
+		Limit:   pageRequest.Limit,
+		OrderBy: pageRequest.OrderBy,
+	}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("error retrieving API tokens from DB")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve API tokens due to DB error", nil)
+	}
+
+	// Create response
+	apiTokens := []model.APIServiceAccountToken{}
+	for i := range dbsats {
+		apiTokens = append(apiTokens, *model.NewAPIServiceAccountToken(&dbsats[i]))
+	}
+
+	// Create pagination response header
+	pageReponse := pagination.NewPageResponse(*pageRequest.PageNumber, *pageRequest.PageSize, total, pageRequest.OrderByStr)
+	pageHeader, err := json.Marshal(pageReponse)
+	if err != nil {
+		logger.Error().Err(err).Msg("error marshaling pagination response")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to generate pagination response header", nil)
+	}
+	c.Response().Header().Set(pagination.ResponseHeaderName, string(pageHeader))
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, apiTokens)
+}
+
+// getServiceAccountTokenForOrg retrieves the API token specified in the URL and checks that it belongs to the org
+func getServiceAccountTokenForOrg(ctx context.Context, c echo.Context, dbSession *cdb.Session, org string) (*cdbm.ServiceAccountToken, *cutil.APIError) {
+	satID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return nil, cutil.NewAPIError(http.StatusBadRequest, "Invalid API token ID in URL", nil)
+	}
+
+	satDAO := cdbm.NewServiceAccountTokenDAO(dbSession)
+	dbsat, err := satDAO.GetByID(ctx, nil, satID, nil)
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find API token with specified ID", nil)
+		}
+		return nil, cutil.NewAPIError(http.StatusInternalServerError, "Failed to retrieve API token due to DB error", nil)
+	}
+
+	// Tokens of other orgs are reported as missing rather than forbidden
+	if dbsat.Org != org {
+		return nil, cutil.NewAPIError(http.StatusNotFound, "Could not find API token with specified ID", nil)
+	}
+
+	return dbsat, nil
+}
+
+// ~~~~~ Get Handler ~~~~~ //
+
+// GetServiceAccountTokenHandler is the API Handler for retrieving an API token
+type GetServiceAccountTokenHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetServiceAccountTokenHandler initializes and returns a new handler for retrieving an API token
+func NewGetServiceAccountTokenHandler(dbSession *cdb.Session, cfg *config.Config) GetServiceAccountTokenHandler {
+	return GetServiceAccountTokenHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Get an API token
+// @Description Get an API token of the org. The token itself is never returned, only its prefix.
+// @Tags serviceaccount
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of API token"
+// @Success 200 {object} model.APIServiceAccountToken
+// @Router /v2/org/{org}/carbide/service-account/token/{id} [get]
+func (gsath GetServiceAccountTokenHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ServiceAccountToken", "Get", c, gsath.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateServiceAccountTokenRequest(c, dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage API tokens, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	gsath.tracerSpan.SetAttribute(handlerSpan, attribute.String("api_token_id", c.Param("id")), logger)
+
+	dbsat, apiErr := getServiceAccountTokenForOrg(ctx, c, gsath.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving API token specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.JSON(http.StatusOK, model.NewAPIServiceAccountToken(dbsat))
+}
+
+// ~~~~~ Delete Handler ~~~~~ //
+
+// DeleteServiceAccountTokenHandler is the API Handler for revoking an API token
+type DeleteServiceAccountTokenHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewDeleteServiceAccountTokenHandler initializes and returns a new handler for revoking an API token
+func NewDeleteServiceAccountTokenHandler(dbSession *cdb.Session, cfg *config.Config) DeleteServiceAccountTokenHandler {
+	return DeleteServiceAccountTokenHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Revoke an API token
+// @Description Revoke an API token of the org. Revoked tokens are rejected immediately and kept for auditing.
+// @Tags serviceaccount
+// @Accept json
+// @Produce json
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param id path string true "ID of API token"
+// @Success 204
+// @Router /v2/org/{org}/carbide/service-account/token/{id} [delete]
+func (dsath DeleteServiceAccountTokenHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("ServiceAccountToken", "Delete", c, dsath.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	if apiErr := validateServiceAccountTokenRequest(c, dbUser, org); apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("user is not allowed to manage API tokens, access denied")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	dsath.tracerSpan.SetAttribute(handlerSpan, attribute.String("api_token_id", c.Param("id")), logger)
+
+	dbsat, apiErr := getServiceAccountTokenForOrg(ctx, c, dsath.dbSession, org)
+	if apiErr != nil {
+		logger.Warn().Str("reason", apiErr.Message).Msg("error retrieving API token specified in request")
+		return cutil.NewAPIErrorResponse(c, apiErr.Code, apiErr.Message, apiErr.Data)
+	}
+
+	// Revoking is idempotent, the original revocation time is kept
+	if dbsat.Revoked == nil {
+		satDAO := cdbm.NewServiceAccountTokenDAO(dsath.dbSession)
+		_, err := satDAO.Update(ctx, nil, cdbm.ServiceAccountTokenUpdateInput{
+			ServiceAccountTokenID: dbsat.ID,
+			Revoked:               cdb.GetTimePtr(cdb.GetCurTime()),
+			RevokedBy:             &dbUser.ID,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("error revoking API token in DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to revoke API token due to DB error", nil)
+		}
+	}
+
+	logger.Info().Msg("finishing API handler")
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,717 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/pagination"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/authentication"
+	cauth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/config"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/processors"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestValidateServiceAccountTokenRequest(t *testing.T) {
+	org := "test-org"
+	newUser := func(roles ...string) *cdbm.User {
+		return &cdbm.User{
+			StarfleetID: cdb.GetStrPtr("test-user"),
+			OrgData: cdbm.OrgData{
+				org: cdbm.Org{
+					Name:  org,
+					Roles: roles,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		user         *cdbm.User
+		org          string
+		withAPIToken bool
+		expectedCode int
+	}{
+		{
+			name: "test Tenant Admin is allowed to manage API tokens",
+			user: newUser("FORGE_TENANT_ADMIN"),
+			org:  org,
+		},
+		{
+			name:         "test Provider Admin is not allowed to manage API tokens",
+			user:         newUser("FORGE_PROVIDER_ADMIN"),
+			org:          org,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "test User of other org is not allowed to manage API tokens",
+			user:         newUser("FORGE_TENANT_ADMIN"),
+			org:          "other-org",
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "test API token is not allowed to manage API tokens",
+			user:         newUser("FORGE_TENANT_ADMIN"),
+			org:          org,
+			withAPIToken: true,
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			ec := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+			if tc.withAPIToken {
+				cauth.SetAPITokenIDInContext(ec, uuid.New())
+			}
+
+			apiErr := validateServiceAccountTokenRequest(ec, tc.user, tc.org)
+			if tc.expectedCode == 0 {
+				assert.Nil(t, apiErr)
+				return
+			}
+			require.NotNil(t, apiErr)
+			assert.Equal(t, tc.expectedCode, apiErr.Code)
+		})
+	}
+}
+
+func testServiceAccountTokenSetupSchema(t *testing.T, dbSession *cdb.Session) {
+	common.TestSetupSchema(t, dbSession)
+
+	// create ServiceAccountToken table
+	err := dbSession.DB.ResetModel(context.Background(), (*cdbm.ServiceAccountToken)(nil))
+	require.NoError(t, err)
+}
+
+func testBuildServiceAccountToken(t *testing.T, dbSession *cdb.Session, name string, tn *cdbm.Tenant, siteID *uuid.UUID, scopes []string, revoked bool, user *cdbm.User) (*cdbm.ServiceAccountToken, string) {
+	ctx := context.Background()
+
+	tokenStr, err := core.GenerateAPIToken()
+	require.NoError(t, err)
+
+	satID := uuid.New()
+	tokenUser, _, err := cdbm.NewUserDAO(dbSession).GetOrCreate(ctx, nil, cdbm.UserGetOrCreateInput{
+		AuxiliaryID: cdb.GetStrPtr(serviceAccountTokenUserPrefix + satID.String()),
+	})
+	require.NoError(t, err)
+
+	satDAO := cdbm.NewServiceAccountTokenDAO(dbSession)
+	sat, err := satDAO.Create(ctx, nil, cdbm.ServiceAccountTokenCreateInput{
+		ServiceAccountTokenID: &satID,
+		Name:                  name,
+		Org:                   tn.Org,
+		TenantID:              tn.ID,
+		SiteID:                siteID,
+		Scopes:                scopes,
+		TokenPrefix:           core.GetAPITokenDisplayPrefix(tokenStr),
+		TokenHash:             core.HashAPIToken(tokenStr),
+		UserID:                tokenUser.ID,
+		CreatedBy:             user.ID,
+	})
+	require.NoError(t, err)
+
+	if revoked {
+		sat, err = satDAO.Update(ctx, nil, cdbm.ServiceAccountTokenUpdateInput{ServiceAccountTokenID: sat.ID, Revoked: cdb.GetTimePtr(cdb.GetCurTime())})
+		require.NoError(t, err)
+	}
+
+	return sat, tokenStr
+}
+
+func TestServiceAccountTokenHandler_Create(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	testServiceAccountTokenSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	tnOrg := "test-tenant-org"
+	ipOrg := "test-provider-org"
+
+	tnu := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg, []string{"FORGE_TENANT_ADMIN"})
+	ipu := common.TestBuildUser(t, dbSession, uuid.NewString(), ipOrg, []string{"FORGE_PROVIDER_ADMIN"})
+	ip := common.TestBuildInfrastructureProvider(t, dbSession, "test-provider", ipOrg, ipu)
+	tn := common.TestBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu)
+	st1 := common.TestBuildSite(t, dbSession, ip, "test-site-1", ipu)
+	_ = common.TestBuildTenantSite(t, dbSession, tn, st1, tnu)
+	st2 := common.TestBuildSite(t, dbSession, ip, "test-site-2", ipu)
+
+	_, _ = testBuildServiceAccountToken(t, dbSession, "existing", tn, nil, []string{"instance:read"}, false, tnu)
+	_, _ = testBuildServiceAccountToken(t, dbSession, "revoked", tn, nil, []string{"instance:read"}, true, tnu)
+
+	tests := []struct {
+		name           string
+		reqOrg         string
+		reqBody        string
+		user           *cdbm.User
+		expectedStatus int
+		expectedSiteID *string
+	}{
+		{
+			name:           "test create API token success",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "ci", "scopes": ["instance:write", "site:read"]}`,
+			user:           tnu,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "test create Site scoped API token success",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "ci-site", "scopes": ["instance:write"], "siteId": "` + st1.ID.String() + `"}`,
+			user:           tnu,
+			expectedStatus: http.StatusCreated,
+			expectedSiteID: cdb.GetStrPtr(st1.ID.String()),
+		},
+		{
+			name:           "test create API token with name of revoked token success",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "revoked", "scopes": ["instance:read"]}`,
+			user:           tnu,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "test create API token with duplicate name fails",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "existing", "scopes": ["instance:read"]}`,
+			user:           tnu,
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "test create API token with invalid scope fails",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "bad-scope", "scopes": ["instance:delete"]}`,
+			user:           tnu,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create API token for Site not accessible to Tenant fails",
+			reqOrg:         tnOrg,
+			reqBody:        `{"name": "other-site", "scopes": ["instance:read"], "siteId": "` + st2.ID.String() + `"}`,
+			user:           tnu,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test create API token by Provider Admin fails",
+			reqOrg:         ipOrg,
+			reqBody:        `{"name": "provider", "scopes": ["instance:read"]}`,
+			user:           ipu,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(tc.reqOrg)
+			ec.Set("user", tc.user)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := NewCreateServiceAccountTokenHandler(dbSession, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus != http.StatusCreated {
+				return
+			}
+
+			rsp := &model.APIServiceAccountToken{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+			require.NotNil(t, rsp.Token)
+			assert.True(t, core.IsAPIToken(*rsp.Token))
+			assert.True(t, strings.HasPrefix(*rsp.Token, rsp.TokenPrefix))
+			assert.Equal(t, model.ServiceAccountTokenStatusActive, rsp.Status)
+			assert.Equal(t, tc.expectedSiteID, rsp.SiteID)
+
+			// Only the hash of the token is stored
+			sat, err := cdbm.NewServiceAccountTokenDAO(dbSession).GetByTokenHash(ctx, nil, core.HashAPIToken(*rsp.Token))
+			require.NoError(t, err)
+			assert.Equal(t, rsp.ID, sat.ID.String())
+		})
+	}
+}
+
+func TestServiceAccountTokenHandler_GetAll(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	testServiceAccountTokenSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+
+	tnu1 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg1, []string{"FORGE_TENANT_ADMIN"})
+	tnu2 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg2, []string{"FORGE_TENANT_ADMIN"})
+	tn1 := common.TestBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+	tn2 := common.TestBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	for i := 0; i < 3; i++ {
+		_, _ = testBuildServiceAccountToken(t, dbSession, fmt.Sprintf("active-%d", i), tn1, nil, []string{"instance:read"}, false, tnu1)
+	}
+	_, _ = testBuildServiceAccountToken(t, dbSession, "revoked", tn1, nil, []string{"instance:read"}, true, tnu1)
+	_, _ = testBuildServiceAccountToken(t, dbSession, "other-tenant", tn2, nil, []string{"instance:read"}, false, tnu2)
+
+	tests := []struct {
+		name           string
+		reqOrg         string
+		reqQuery       string
+		user           *cdbm.User
+		expectedStatus int
+		expectedCount  int
+		expectedTotal  int
+	}{
+		{
+			name:           "test get all API tokens excludes revoked tokens",
+			reqOrg:         tnOrg1,
+			user:           tnu1,
+			expectedStatus: http.StatusOK,
+			expectedCount:  3,
+			expectedTotal:  3,
+		},
+		{
+			name:           "test get all API tokens including revoked tokens",
+			reqOrg:         tnOrg1,
+			reqQuery:       "includeRevoked=true",
+			user:           tnu1,
+			expectedStatus: http.StatusOK,
+			expectedCount:  4,
+			expectedTotal:  4,
+		},
+		{
+			name:           "test get all API tokens with pagination",
+			reqOrg:         tnOrg1,
+			reqQuery:       "pageSize=2",
+			user:           tnu1,
+			expectedStatus: http.StatusOK,
+			expectedCount:  2,
+			expectedTotal:  3,
+		},
+		{
+			name:           "test get all API tokens of other Tenant",
+			reqOrg:         tnOrg2,
+			user:           tnu2,
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			expectedTotal:  1,
+		},
+		{
+			name:           "test get all API tokens of org user is not a member of fails",
+			reqOrg:         tnOrg2,
+			user:           tnu1,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/?"+tc.reqQuery, nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(tc.reqOrg)
+			ec.Set("user", tc.user)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := NewGetAllServiceAccountTokenHandler(dbSession, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			rsp := []model.APIServiceAccountToken{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rsp))
+			assert.Len(t, rsp, tc.expectedCount)
+			for _, token := range rsp {
+				assert.Nil(t, token.Token)
+			}
+
+			pr := &pagination.PageResponse{}
+			require.NoError(t, json.Unmarshal([]byte(rec.Header().Get(pagination.ResponseHeaderName)), pr))
+			assert.Equal(t, tc.expectedTotal, pr.Total)
+		})
+	}
+}
+
+func TestServiceAccountTokenHandler_GetByID(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	testServiceAccountTokenSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+
+	tnu1 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg1, []string{"FORGE_TENANT_ADMIN"})
+	tnu2 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg2, []string{"FORGE_TENANT_ADMIN"})
+	tn1 := common.TestBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+	_ = common.TestBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	sat1, _ := testBuildServiceAccountToken(t, dbSession, "ci", tn1, nil, []string{"instance:read"}, false, tnu1)
+	sat2, _ := testBuildServiceAccountToken(t, dbSession, "revoked", tn1, nil, []string{"instance:read"}, true, tnu1)
+
+	tests := []struct {
+		name           string
+		reqOrg         string
+		reqID          string
+		user           *cdbm.User
+		expectedStatus int
+		expectedState  string
+	}{
+		{
+			name:           "test get API token success",
+			reqOrg:         tnOrg1,
+			reqID:          sat1.ID.String(),
+			user:           tnu1,
+			expectedStatus: http.StatusOK,
+			expectedState:  model.ServiceAccountTokenStatusActive,
+		},
+		{
+			name:           "test get revoked API token success",
+			reqOrg:         tnOrg1,
+			reqID:          sat2.ID.String(),
+			user:           tnu1,
+			expectedStatus: http.StatusOK,
+			expectedState:  model.ServiceAccountTokenStatusRevoked,
+		},
+		{
+			name:           "test get API token with invalid ID fails",
+			reqOrg:         tnOrg1,
+			reqID:          "bad-id",
+			user:           tnu1,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "test get unknown API token fails",
+			reqOrg:         tnOrg1,
+			reqID:          uuid.NewString(),
+			user:           tnu1,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "test get API token of other Tenant fails",
+			reqOrg:         tnOrg2,
+			reqID:          sat1.ID.String(),
+			user:           tnu2,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tc.reqOrg, tc.reqID)
+			ec.Set("user", tc.user)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := NewGetServiceAccountTokenHandler(dbSession, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus != http.StatusOK {
+				return
+			}
+
+			rsp := &model.APIServiceAccountToken{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+			assert.Equal(t, tc.reqID, rsp.ID)
+			assert.Equal(t, tc.expectedState, rsp.Status)
+			// Token is only returned on creation
+			assert.Nil(t, rsp.Token)
+		})
+	}
+}
+
+func TestServiceAccountTokenHandler_Delete(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := common.TestInitDB(t)
+	defer dbSession.Close()
+
+	testServiceAccountTokenSetupSchema(t, dbSession)
+
+	cfg := common.GetTestConfig()
+
+	tnOrg1 := "test-tenant-org-1"
+	tnOrg2 := "test-tenant-org-2"
+
+	tnu1 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg1, []string{"FORGE_TENANT_ADMIN"})
+	tnu2 := common.TestBuildUser(t, dbSession, uuid.NewString(), tnOrg2, []string{"FORGE_TENANT_ADMIN"})
+	tn1 := common.TestBuildTenant(t, dbSession, "test-tenant-1", tnOrg1, tnu1)
+	_ = common.TestBuildTenant(t, dbSession, "test-tenant-2", tnOrg2, tnu2)
+
+	sat1, _ := testBuildServiceAccountToken(t, dbSession, "ci", tn1, nil, []string{"instance:read"}, false, tnu1)
+	sat2, _ := testBuildServiceAccountToken(t, dbSession, "revoked", tn1, nil, []string{"instance:read"}, true, tnu1)
+
+	tests := []struct {
+		name           string
+		reqOrg         string
+		reqID          string
+		user           *cdbm.User
+		expectedStatus int
+	}{
+		{
+			name:           "test delete API token of other Tenant fails",
+			reqOrg:         tnOrg2,
+			reqID:          sat1.ID.String(),
+			user:           tnu2,
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "test delete API token revokes token",
+			reqOrg:         tnOrg1,
+			reqID:          sat1.ID.String(),
+			user:           tnu1,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "test delete revoked API token succeeds",
+			reqOrg:         tnOrg1,
+			reqID:          sat2.ID.String(),
+			user:           tnu1,
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "test delete unknown API token fails",
+			reqOrg:         tnOrg1,
+			reqID:          uuid.NewString(),
+			user:           tnu1,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodDelete, "/", nil)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tc.reqOrg, tc.reqID)
+			ec.Set("user", tc.user)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			err := NewDeleteServiceAccountTokenHandler(dbSession, cfg).Handle(ec)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedStatus, rec.Code, rec.Body.String())
+
+			if tc.expectedStatus != http.StatusNoContent {
+				return
+			}
+
+			// Tokens are revoked rather than deleted
+			sat, err := cdbm.NewServiceAccountTokenDAO(dbSession).GetByID(ctx, nil, uuid.MustParse(tc.reqID), nil)
+			require.NoError(t, err)
+			assert.NotNil(t, sat.Revoked)
+		})
+	}
+}
+
+func TestServiceAccountTokenHandler_SiteScope(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+
+	testInstanceSetupSchema(t, dbSession)
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.ServiceAccountToken)(nil))
+	require.NoError(t, err)
+	err = dbSession.DB.ResetModel(ctx, (*cdbm.TenantSite)(nil))
+	require.NoError(t, err)
+
+	cfg := common.GetTestConfig()
+	tc := &tmocks.Client{}
+
+	ipOrg := "test-provider-org"
+	tnOrg := "test-tenant-org"
+
+	ipu := testInstanceBuildUser(t, dbSession, uuid.NewString(), ipOrg, []string{"FORGE_PROVIDER_ADMIN"})
+	ip := testInstanceSiteBuildInfrastructureProvider(t, dbSession, "test-infrastructure-provider", ipOrg, ipu)
+	stA := testInstanceBuildSite(t, dbSession, ip, "test-site-a", cdbm.SiteStatusRegistered, true, ipu)
+	stB := testInstanceBuildSite(t, dbSession, ip, "test-site-b", cdbm.SiteStatusRegistered, true, ipu)
+
+	tnu := testInstanceBuildUser(t, dbSession, uuid.NewString(), tnOrg, []string{"FORGE_TENANT_ADMIN"})
+	tn := testInstanceBuildTenant(t, dbSession, "test-tenant", tnOrg, tnu)
+	_ = common.TestBuildTenantSite(t, dbSession, tn, stA, tnu)
+	_ = common.TestBuildTenantSite(t, dbSession, tn, stB, tnu)
+
+	os1 := testInstanceBuildOperatingSystem(t, dbSession, "test-os", tn, cdbm.OperatingSystemTypeImage, false, nil, false, cdbm.OperatingSystemStatusReady, tnu)
+
+	buildInstance := func(st *cdbm.Site, name string) *cdbm.Instance {
+		al := testInstanceSiteBuildAllocation(t, dbSession, st, tn, name+"-allocation", ipu)
+		ist := testInstanceBuildInstanceType(t, dbSession, ip, name+"-instance-type", st, cdbm.InstanceStatusReady)
+		alc := testInstanceSiteBuildAllocationContraints(t, dbSession, al, cdbm.AllocationResourceTypeInstanceType, ist.ID, cdbm.AllocationConstraintTypeReserved, 1, ipu)
+		mc := testInstanceBuildMachine(t, dbSession, ip.ID, st.ID, cdb.GetBoolPtr(true), nil)
+		vpc := testInstanceBuildVPC(t, dbSession, name+"-vpc", ip, tn, st, nil, nil, cdb.GetStrPtr(cdbm.VpcEthernetVirtualizer), nil, cdbm.VpcStatusReady, tnu)
+		return testInstanceBuildInstance(t, dbSession, name, al.ID, alc.ID, tn.ID, ip.ID, st.ID, &ist.ID, vpc.ID, &mc.ID, &os1.ID, nil, cdbm.InstanceStatusReady)
+	}
+	insA := buildInstance(stA, "test-instance-a")
+	insB := buildInstance(stB, "test-instance-b")
+
+	_, tokenA := testBuildServiceAccountToken(t, dbSession, "site-a", tn, &stA.ID, []string{"instance:write"}, false, tnu)
+
+	joCfg := cauth.NewJWTOriginConfig()
+	joCfg.SetProcessorForOrigin(cauth.TokenOriginAPIToken, processors.NewAPITokenProcessor(dbSession))
+
+	tests := []struct {
+		name              string
+		method            string
+		route             string
+		target            string
+		paramNames        []string
+		paramValues       []string
+		handler           echo.HandlerFunc
+		expectedStatus    int
+		expectedInstances []string
+	}{
+		{
+			name:           "test Site scoped token can get Instance of its Site",
+			method:         http.MethodGet,
+			route:          "/v2/org/:orgName/carbide/instance/:id",
+			target:         "/v2/org/" + tnOrg + "/carbide/instance/" + insA.ID.String(),
+			paramNames:     []string{"orgName", "id"},
+			paramValues:    []string{tnOrg, insA.ID.String()},
+			handler:        NewGetInstanceHandler(dbSession, tc, cfg).Handle,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "test Site scoped token can't get Instance of other Site",
+			method:         http.MethodGet,
+			route:          "/v2/org/:orgName/carbide/instance/:id",
+			target:         "/v2/org/" + tnOrg + "/carbide/instance/" + insB.ID.String(),
+			paramNames:     []string{"orgName", "id"},
+			paramValues:    []string{tnOrg, insB.ID.String()},
+			handler:        NewGetInstanceHandler(dbSession, tc, cfg).Handle,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "test Site scoped token can't delete Instance of other Site",
+			method:         http.MethodDelete,
+			route:          "/v2/org/:orgName/carbide/instance/:id",
+			target:         "/v2/org/" + tnOrg + "/carbide/instance/" + insB.ID.String(),
+			paramNames:     []string{"orgName", "id"},
+			paramValues:    []string{tnOrg, insB.ID.String()},
+			handler:        NewDeleteInstanceHandler(dbSession, tc, nil, cfg).Handle,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:              "test Site scoped token only lists Instances of its Site",
+			method:            http.MethodGet,
+			route:             "/v2/org/:orgName/carbide/instance",
+			target:            "/v2/org/" + tnOrg + "/carbide/instance",
+			paramNames:        []string{"orgName"},
+			paramValues:       []string{tnOrg},
+			handler:           NewGetAllInstanceHandler(dbSession, tc, cfg).Handle,
+			expectedStatus:    http.StatusOK,
+			expectedInstances: []string{insA.ID.String()},
+		},
+		{
+			name:           "test Site scoped token can't list Instances of other Site",
+			method:         http.MethodGet,
+			route:          "/v2/org/:orgName/carbide/instance",
+			target:         "/v2/org/" + tnOrg + "/carbide/instance?siteId=" + stB.ID.String(),
+			paramNames:     []string{"orgName"},
+			paramValues:    []string{tnOrg},
+			handler:        NewGetAllInstanceHandler(dbSession, tc, cfg).Handle,
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := echo.New()
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+tokenA)
+			rec := httptest.NewRecorder()
+
+			ec := e.NewContext(req, rec)
+			ec.SetPath(tt.route)
+			ec.SetParamNames(tt.paramNames...)
+			ec.SetParamValues(tt.paramValues...)
+			ec.SetRequest(ec.Request().WithContext(ctx))
+
+			apiErr := authentication.AuthProcessor(ec, joCfg)
+			if tt.expectedStatus == http.StatusForbidden {
+				require.NotNil(t, apiErr)
+				assert.Equal(t, http.StatusForbidden, apiErr.Code)
+				return
+			}
+			require.Nil(t, apiErr)
+
+			err := tt.handler(ec)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedStatus, rec.Code, rec.Body.String())
+
+			if tt.expectedInstances == nil {
+				return
+			}
+
+			rsp := []model.APIInstance{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rsp))
+			ids := []string{}
+			for _, ins := range rsp {
+				ids = append(ids, ins.ID)
+			}
+			assert.Equal(t, tt.expectedInstances, ids)
+		})
+	}
+}
@@ -0,0 +1,371 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+	auth "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	cutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+)
+
+const (
+	// TenantUsageExportFormatJSON returns the usage report as a JSON object
+	TenantUsageExportFormatJSON = "json"
+	// TenantUsageExportFormatCSV returns the usage report as CSV with a header row
+	TenantUsageExportFormatCSV = "csv"
+
+	// TenantUsageDefaultDays is the number of days reported when no date range is specified
+	TenantUsageDefaultDays = 30
+	// TenantUsageMaxDays is the maximum number of days that can be reported at once
+	TenantUsageMaxDays = 366
+
+	// tenantUsagePageSize is the number of usage rows read from DB per page
+	tenantUsagePageSize = 1000
+)
+
+// tenantUsageQuery is a parsed usage report request
+type tenantUsageQuery struct {
+	filter      cdbm.TenantUsageFilterInput
+	startDate   time.Time
+	endDate     time.Time
+	granularity string
+	format      string
+}
+
+// ~~~~~ Get Current Tenant Usage Handler ~~~~~ //
+
+// GetCurrentTenantUsageHandler is the API Handler for retrieving usage of the Tenant associated with the org
+type GetCurrentTenantUsageHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetCurrentTenantUsageHandler initializes and returns a new handler to retrieve usage of the Tenant associated with the org
+func NewGetCurrentTenantUsageHandler(dbSession *cdb.Session, cfg *config.Config) GetCurrentTenantUsageHandler {
+	return GetCurrentTenantUsageHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Retrieve the usage of the Tenant associated with the org
+// @Description Retrieve instance-hours and allocated Machine hours of the Tenant per Site and Instance Type, rolled up daily
+// @Tags tenant
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param startDate query string false "First UTC day of the report, inclusive, in YYYY-MM-DD format. Defaults to 30 days before endDate"
+// @Param endDate query string false "Last UTC day of the report, inclusive, in YYYY-MM-DD format. Defaults to yesterday"
+// @Param siteId query string false "Filter by Site ID, can be specified multiple times"
+// @Param instanceTypeId query string false "Filter by Instance Type ID, can be specified multiple times"
+// @Param granularity query string false "'total' (default) to aggregate over the period or 'daily'"
+// @Param format query string false "'json' (default) or 'csv'"
+// @Success 200 {object} model.APITenantUsageReport
+// @Router /v2/org/{org}/carbide/tenant/current/usage [get]
+func (gctuh GetCurrentTenantUsageHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("Tenant", "GetCurrentUsage", c, gctuh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Tenant Admins are allowed to retrieve Tenant usage
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.TenantAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Tenant Admin role with org, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Tenant Admin role with org", nil)
+	}
+
+	tenant, err := common.GetTenantForOrg(ctx, nil, gctuh.dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgTenantNotFound) {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, fmt.Sprintf("Org '%v' does not have a Tenant", org), nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Tenant for this org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Tenant", nil)
+	}
+
+	query, err := getTenantUsageQuery(c, false)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating query params")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+	query.filter.TenantIDs = []uuid.UUID{tenant.ID}
+
+	return writeTenantUsageReport(c, ctx, logger, gctuh.dbSession, query, map[uuid.UUID]string{tenant.ID: tenant.Org}, org)
+}
+
+// ~~~~~ Get Current Infrastructure Provider Usage Handler ~~~~~ //
+
+// GetCurrentInfrastructureProviderUsageHandler is the API Handler for retrieving usage of all Tenants
+// of the Infrastructure Provider associated with the org
+type GetCurrentInfrastructureProviderUsageHandler struct {
+	dbSession  *cdb.Session
+	cfg        *config.Config
+	tracerSpan *cutil.TracerSpan
+}
+
+// NewGetCurrentInfrastructureProviderUsageHandler initializes and returns a new handler to retrieve usage of all Tenants
+// of the Infrastructure Provider associated with the org
+func NewGetCurrentInfrastructureProviderUsageHandler(dbSession *cdb.Session, cfg *config.Config) GetCurrentInfrastructureProviderUsageHandler {
+	return GetCurrentInfrastructureProviderUsageHandler{
+		dbSession:  dbSession,
+		cfg:        cfg,
+		tracerSpan: cutil.NewTracerSpan(),
+	}
+}
+
+// Handle godoc
+// @Summary Retrieve the usage of all Tenants of the Infrastructure Provider associated with the org
+// @Description Retrieve instance-hours and allocated Machine hours per Tenant, Site and Instance Type, rolled up daily
+// @Tags infrastructureprovider
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Security ApiKeyAuth
+// @Param org path string true "Name of NGC organization"
+// @Param startDate query string false "First UTC day of the report, inclusive, in YYYY-MM-DD format. Defaults to 30 days before endDate"
+// @Param endDate query string false "Last UTC day of the report, inclusive, in YYYY-MM-DD format. Defaults to yesterday"
+// @Param tenantId query string false "Filter by Tenant ID, can be specified multiple times"
+// @Param siteId query string false "Filter by Site ID, can be specified multiple times"
+// @Param instanceTypeId query string false "Filter by Instance Type ID, can be specified multiple times"
+// @Param granularity query string false "'total' (default) to aggregate over the period or 'daily'"
+// @Param format query string false "'json' (default) or 'csv'"
+// @Success 200 {object} model.APITenantUsageReport
+// @Router /v2/org/{org}/carbide/infrastructure-provider/current/usage [get]
+func (gcipuh GetCurrentInfrastructureProviderUsageHandler) Handle(c echo.Context) error {
+	org, dbUser, ctx, logger, handlerSpan := common.SetupHandler("InfrastructureProvider", "GetCurrentUsage", c, gcipuh.tracerSpan)
+	if handlerSpan != nil {
+		defer handlerSpan.End()
+	}
+	if dbUser == nil {
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve current user", nil)
+	}
+
+	// Validate org
+	ok, err := auth.ValidateOrgMembership(dbUser, org)
+	if !ok {
+		if err != nil {
+			logger.Error().Err(err).Msg("error validating org membership for User in request")
+		} else {
+			logger.Warn().Msg("could not validate org membership for user, access denied")
+		}
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, fmt.Sprintf("Failed to validate membership for org: %s", org), nil)
+	}
+
+	// Validate role, only Provider Admins are allowed to retrieve Provider usage
+	ok = auth.ValidateUserRoles(dbUser, org, nil, auth.ProviderAdminRole)
+	if !ok {
+		logger.Warn().Msg("user does not have Provider Admin role with org, access denied")
+		return cutil.NewAPIErrorResponse(c, http.StatusForbidden, "User does not have Provider Admin role with org", nil)
+	}
+
+	ip, err := common.GetInfrastructureProviderForOrg(ctx, nil, gcipuh.dbSession, org)
+	if err != nil {
+		if errors.Is(err, common.ErrOrgInstrastructureProviderNotFound) {
+			return cutil.NewAPIErrorResponse(c, http.StatusNotFound, fmt.Sprintf("Org '%v' does not have an Infrastructure Provider", org), nil)
+		}
+		logger.Error().Err(err).Msg("error retrieving Infrastructure Provider for this org")
+		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Infrastructure Provider", nil)
+	}
+
+	query, err := getTenantUsageQuery(c, true)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error validating query params")
+		return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, err.Error(), nil)
+	}
+	query.filter.InfrastructureProviderIDs = []uuid.UUID{ip.ID}
+
+	return writeTenantUsageReport(c, ctx, logger, gcipuh.dbSession, query, nil, org)
+}
+
+// getTenantUsageQuery parses and validates the query params of a usage report request
+func getTenantUsageQuery(c echo.Context, allowTenantFilter bool) (*tenantUsageQuery, error) {
+	now := time.Now().UTC()
+	query := &tenantUsageQuery{
+		endDate:     time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1),
+		granularity: model.TenantUsageGranularityTotal,
+		format:      TenantUsageExportFormatJSON,
+	}
+
+	if qed := c.QueryParam("endDate"); qed != "" {
+		endDate, err := time.Parse(time.DateOnly, qed)
+		if err != nil {
+			return nil, errors.New("Invalid value specified for `endDate` query param, must be in YYYY-MM-DD format")
+		}
+		query.endDate = endDate
+	}
+
+	query.startDate = query.endDate.AddDate(0, 0, 1-TenantUsageDefaultDays)
+	if qsd := c.QueryParam("startDate"); qsd != "" {
+		startDate, err := time.Parse(time.DateOnly, qsd)
+		if err != nil {
+			return nil, errors.New("Invalid value specified for `startDate` query param, must be in YYYY-MM-DD format")
+		}
+		query.startDate = startDate
+	}
+
+	if query.startDate.After(query.endDate) {
+		return nil, errors.New("`startDate` query param must not be after `endDate`")
+	}
+	if query.endDate.Sub(query.startDate) >= TenantUsageMaxDays*24*time.Hour {
+		return nil, fmt.Errorf("Usage can be retrieved for at most %d days at a time", TenantUsageMaxDays)
+	}
+
+	query.filter.StartDate = &query.startDate
+	query.filter.EndDate = &query.endDate
+
+	if qg := c.QueryParam("granularity"); qg != "" {
+		query.granularity = strings.ToLower(qg)
+		if query.granularity != model.TenantUsageGranularityTotal && query.granularity != model.TenantUsageGranularityDaily {
+			return nil, fmt.Errorf("Invalid value specified for `granularity` query param, must be one of: %s, %s", model.TenantUsageGranularityTotal, model.TenantUsageGranularityDaily)
+		}
+	}
+
+	if qf := c.QueryParam("format"); qf != "" {
+		query.format = strings.ToLower(qf)
+		if query.format != TenantUsageExportFormatJSON && query.format != TenantUsageExportFormatCSV {
+			return nil, fmt.Errorf("Invalid value specified for `format` query param, must be one of: %s, %s", TenantUsageExportFormatJSON, TenantUsageExportFormatCSV)
+		}
+	}
+
+	qParams := c.QueryParams()
+
+	if len(qParams["tenantId"]) > 0 && !allowTenantFilter {
+		return nil, errors.New("`tenantId` query param is not supported for Tenant usage")
+	}
+
+	for name, target := range map[string]*[]uuid.UUID{"tenantId": &query.filter.TenantIDs, "siteId": &query.filter.SiteIDs, "instanceTypeId": &query.filter.InstanceTypeIDs} {
+		for _, qp := range qParams[name] {
+			id, err := uuid.Parse(qp)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value specified for `%s` query param: %s", name, qp)
+			}
+			*target = append(*target, id)
+		}
+	}
+
+	return query, nil
+}
+
+// writeTenantUsageReport retrieves the usage rows matching the query and writes the report in the requested format.
+// Orgs of Tenants missing from tenantOrgs are retrieved from DB.
+func writeTenantUsageReport(c echo.Context, ctx context.Context, logger zerolog.Logger, dbSession *cdb.Session, query *tenantUsageQuery, tenantOrgs map[uuid.UUID]string, org string) error {
+	tuDAO := cdbm.NewTenantUsageDAO(dbSession)
+
+	dbtus := []cdbm.TenantUsage{}
+	page := paginator.PageInput{Offset: cdb.GetIntPtr(0), Limit: cdb.GetIntPtr(tenantUsagePageSize)}
+	for {
+		pageTus, _, err := tuDAO.GetAll(ctx, nil, query.filter, page)
+		if err != nil {
+			logger.Error().Err(err).Msg("error retrieving Tenant usage from DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve usage", nil)
+		}
+		dbtus = append(dbtus, pageTus...)
+
+		if len(pageTus) < tenantUsagePageSize {
+			break
+		}
+		page.Offset = cdb.GetIntPtr(*page.Offset + tenantUsagePageSize)
+	}
+
+	if tenantOrgs == nil {
+		tenantOrgs = map[uuid.UUID]string{}
+	}
+
+	tnDAO := cdbm.NewTenantDAO(dbSession)
+	for _, dbtu := range dbtus {
+		if _, ok := tenantOrgs[dbtu.TenantID]; ok {
+			continue
+		}
+
+		tenant, err := tnDAO.GetByID(ctx, nil, dbtu.TenantID, nil)
+		if err != nil && !errors.Is(err, cdb.ErrDoesNotExist) {
+			logger.Error().Err(err).Str("Tenant ID", dbtu.TenantID.String()).Msg("error retrieving Tenant from DB")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve Tenants for usage", nil)
+		}
+
+		// Usage of deleted Tenants is still reported
+		tenantOrgs[dbtu.TenantID] = ""
+		if tenant != nil {
+			tenantOrgs[dbtu.TenantID] = tenant.Org
+		}
+	}
+
+	report := model.NewAPITenantUsageReport(query.startDate, query.endDate, query.granularity, dbtus, tenantOrgs)
+
+	if query.format == TenantUsageExportFormatJSON {
+		logger.Info().Int("Rows", len(report.Usage)).Msg("finishing API handler")
+		return c.JSON(http.StatusOK, report)
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, "text/csv; charset=UTF-8")
+	resp.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=\"usage-%s-%s-%s.csv\"", org, report.StartDate, report.EndDate))
+	resp.WriteHeader(http.StatusOK)
+
+	w := csv.NewWriter(resp)
+	if err := w.Write(report.CSVHeader()); err == nil {
+		err = w.WriteAll(report.CSVRecords())
+	}
+	if err := w.Error(); err != nil {
+		// Response has already been started, nothing else can be reported to the client
+		logger.Warn().Err(err).Msg("error writing usage export response, client may have disconnected")
+		return nil
+	}
+
+	logger.Info().Int("Rows", len(report.Usage)).Msg("finishing API handler")
+
+	return nil
+}
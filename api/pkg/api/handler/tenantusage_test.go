@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model"
+)
+
+func TestGetTenantUsageQuery(t *testing.T) {
+	e := echo.New()
+
+	now := time.Now().UTC()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	stID := uuid.New()
+	tnID := uuid.New()
+
+	tests := []struct {
+		name              string
+		query             string
+		allowTenantFilter bool
+		wantErr           bool
+		wantStart         time.Time
+		wantEnd           time.Time
+		wantGranularity   string
+		wantFormat        string
+		wantSiteIDs       []uuid.UUID
+		wantTenantIDs     []uuid.UUID
+	}{
+		{
+			name:            "test defaults to the last 30 complete days",
+			query:           "",
+			wantStart:       yesterday.AddDate(0, 0, -29),
+			wantEnd:         yesterday,
+			wantGranularity: model.TenantUsageGranularityTotal,
+			wantFormat:      TenantUsageExportFormatJSON,
+		},
+		{
+			name:              "test explicit range, filters, granularity and format",
+			query:             "?startDate=2026-03-01&endDate=2026-03-31&siteId=" + stID.String() + "&tenantId=" + tnID.String() + "&granularity=daily&format=CSV",
+			allowTenantFilter: true,
+			wantStart:         time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:           time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			wantGranularity:   model.TenantUsageGranularityDaily,
+			wantFormat:        TenantUsageExportFormatCSV,
+			wantSiteIDs:       []uuid.UUID{stID},
+			wantTenantIDs:     []uuid.UUID{tnID},
+		},
+		{
+			name:    "test invalid date format",
+			query:   "?startDate=03/01/2026",
+			wantErr: true,
+		},
+		{
+			name:    "test start after end",
+			query:   "?startDate=2026-04-02&endDate=2026-04-01",
+			wantErr: true,
+		},
+		{
+			name:    "test range too long",
+			query:   "?startDate=2025-01-01&endDate=2026-04-01",
+			wantErr: true,
+		},
+		{
+			name:    "test invalid granularity",
+			query:   "?granularity=hourly",
+			wantErr: true,
+		},
+		{
+			name:    "test invalid format",
+			query:   "?format=xml",
+			wantErr: true,
+		},
+		{
+			name:    "test invalid site ID",
+			query:   "?siteId=bad-id",
+			wantErr: true,
+		},
+		{
+			name:    "test Tenant filter not allowed",
+			query:   "?tenantId=" + tnID.String(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/usage"+tt.query, nil)
+			ec := e.NewContext(req, httptest.NewRecorder())
+
+			got, err := getTenantUsageQuery(ec, tt.allowTenantFilter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantStart, got.startDate)
+			assert.Equal(t, tt.wantEnd, got.endDate)
+			assert.Equal(t, tt.wantStart, *got.filter.StartDate)
+			assert.Equal(t, tt.wantEnd, *got.filter.EndDate)
+			assert.Equal(t, tt.wantGranularity, got.granularity)
+			assert.Equal(t, tt.wantFormat, got.format)
+			assert.Equal(t, tt.wantSiteIDs, got.filter.SiteIDs)
+			assert.Equal(t, tt.wantTenantIDs, got.filter.TenantIDs)
+		})
+	}
+}
@@ -859,13 +859,6 @@ func MatchInstanceTypeCapabilitiesForMachines(ctx context.Context, logger zerolo
 		return true, nil, nil
 	}
 
-	// Build a map of capability type to capability object for instancetype
-	itmcCapMap := make(map[string]*cdbm.MachineCapability)
-	for _, imc := range instmcs {
-		cimc := imc
-		itmcCapMap[imc.Name] = &cimc
-	}
-
 	// Get Machine Capabilities for Machines
 	mmcs, mtotal, serr := mcDAO.GetAll(ctx, nil, machineIds, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cdb.GetIntPtr(cdbp.TotalLimit), nil)
 	if serr != nil {
@@ -879,73 +872,20 @@ func MatchInstanceTypeCapabilitiesForMachines(ctx context.Context, logger zerolo
 	}
 
 	// Build a map of Machine ID to Machine Capabilities
-	mmcCapMapByMachinId := make(map[string]map[string]*cdbm.MachineCapability)
+	mmcsByMachineID := make(map[string][]cdbm.MachineCapability)
 	for _, mmc := range mmcs {
-		cmmc := mmc
-		if mmcCapMapByMachinId[*mmc.MachineID] == nil {
-			mmcCapMapByMachinId[*mmc.MachineID] = make(map[string]*cdbm.MachineCapability)
-		}
-
-		// It's possible for two capabilities to have the same name but different types:
-		//
-		// name            |    type    | frequency | capacity | count |        vendor         |            created
-		// ----------------------------+------------+-----------+----------+-------+-----------------------+-------------------------------
-		// MT2910 Family [ConnectX-7] | Network    |           |          |     2 | Mellanox Technologies | 2025-03-27 02:40:43.50987+00
-		// MT2910 Family [ConnectX-7] | InfiniBand |           |          |     8 | Mellanox Technologies | 2024-02-02 21:41:13.149839+00
-		//
-		// If we can assume that name+type can never have a duplicate,
-		// we can rely on prefixing the map entries with type.
-		mmcCapMapByMachinId[*mmc.MachineID][mmc.Type+"-"+mmc.Name] = &cmmc
-	}
-
-	// Loop through Capabilities of Instance Type with Machines
-	for _, imc := range instmcs {
-		// Compare each Capabilities of Instance Type with Machine's Capabilities
-		for mID, mCapMap := range mmcCapMapByMachinId {
-
-			// See earlier comments above about prefixing with type.
-			mmc, found := mCapMap[imc.Type+"-"+imc.Name]
-			if !found {
-				return false, &mID, nil
-			}
-
-			if imc.Frequency != nil {
-				if mmc.Frequency == nil || (*imc.Frequency != *mmc.Frequency) {
-					return false, &mID, nil
-				}
-			}
-
-			if imc.Capacity != nil {
-				if mmc.Capacity == nil || (*imc.Capacity != *mmc.Capacity) {
-					return false, &mID, nil
-				}
-			}
-
-			if imc.Vendor != nil {
-				if mmc.Vendor == nil || (*imc.Vendor != *mmc.Vendor) {
-					return false, &mID, nil
-				}
-			}
-
-			if imc.DeviceType != nil {
-				if mmc.DeviceType == nil || (*imc.DeviceType != *mmc.DeviceType) {
-					return false, &mID, nil
-				}
-			}
-
-			if imc.InactiveDevices != nil {
-				if !slices.Equal(imc.InactiveDevices, mmc.InactiveDevices) {
-					return false, &mID, nil
-				}
-			}
+		mmcsByMachineID[*mmc.MachineID] = append(mmcsByMachineID[*mmc.MachineID], mmc)
+	}
 
-			if imc.Count != nil {
-				if mmc.Count == nil || (*imc.Count != *mmc.Count) {
-					return false, &mID, nil
-				}
-			}
+	// Compare Capabilities of Instance Type with each Machine's Capabilities. Manual assignment only requires the
+	// Instance Type's Capabilities to be present, unlike auto assignment which requires an exact match
+	for mID, mCaps := range mmcsByMachineID {
+		if mismatches := cdbm.MatchMachineCapabilities(instmcs, mCaps, cdbm.MachineCapabilityMatchSubset); len(mismatches) > 0 {
+			logger.Info().Str("Machine ID", mID).Strs("Mismatches", mismatches).Msg("Machine Capabilities do not match Instance Type")
+			return false, &mID, nil
 		}
 	}
+
 	return true, nil, nil
 }
 
@@ -117,9 +117,18 @@ func TestSetupSchema(t *testing.T, dbSession *cdb.Session) {
 	// create Operating System table
 	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.OperatingSystem)(nil))
 	assert.Nil(t, err)
+	// create OperatingSystemVersion table
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.OperatingSystemVersion)(nil))
+	assert.Nil(t, err)
 	// create Operating System Site Association table
 	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.OperatingSystemSiteAssociation)(nil))
 	assert.Nil(t, err)
+	// create InstanceTemplate table
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.InstanceTemplate)(nil))
+	assert.Nil(t, err)
+	// create InstanceTemplateVersion table
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.InstanceTemplateVersion)(nil))
+	assert.Nil(t, err)
 	// create Machine table
 	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.Machine)(nil))
 	assert.Nil(t, err)
@@ -45,6 +45,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/ipam"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	cipam "github.com/nvidia/bare-metal-manager-rest/ipam"
 	swe "github.com/nvidia/bare-metal-manager-rest/site-workflow/pkg/error"
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
@@ -237,6 +238,15 @@ func (csh CreateVpcPrefixHandler) Handle(c echo.Context) error {
 	}
 	logger.Info().Str("childCidr", childPrefix.Cidr).Msg("created child cidr for VPC prefix")
 
+	// Reserve the requested ranges in IPAM
+	if len(apiRequest.ReservedRanges) > 0 {
+		childPrefix, err = ipam.UpdateIpamReservedRangesForCidr(ctx, ipamStorage, ipBlock, childPrefix.Cidr, model.GetIPRangesFromAPIReservedIPRanges(apiRequest.ReservedRanges))
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to reserve ranges in IPAM entry for VPC prefix")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not reserve ranges in IPAM entry for VPC prefix. Details: %s", err.Error()), nil)
+		}
+	}
+
 	// Create VPC prefix in DB
 	vpcPrefix, err := vpcPrefixDAO.Create(ctx, tx, cdbm.VpcPrefixCreateInput{Name: apiRequest.Name, TenantOrg: org, SiteID: site.ID, VpcID: vpc.ID, TenantID: tenant.ID, IpBlockID: &ipBlock.ID, Prefix: childPrefix.Cidr, PrefixLength: apiRequest.PrefixLength, Status: cdbm.VpcPrefixStatusReady, CreatedBy: dbUser.ID})
 	if err != nil {
@@ -337,6 +347,7 @@ func (csh CreateVpcPrefixHandler) Handle(c echo.Context) error {
 
 	// create response
 	apiVpcPrefix := model.NewAPIVpcPrefix(vpcPrefix, []cdbm.StatusDetail{*ssd})
+	apiVpcPrefix.ReservedRanges = model.NewAPIReservedIPRanges(childPrefix.ReservedRanges())
 	logger.Info().Msg("finishing API handler")
 	return c.JSON(http.StatusCreated, apiVpcPrefix)
 }
@@ -527,10 +538,50 @@ func (gash GetAllVpcPrefixHandler) Handle(c echo.Context) error {
 	// Create response
 	apiVpcPrefixes := []*model.APIVpcPrefix{}
 
+	// Get IP Blocks of the VPC prefixes to retrieve their IPAM entries
+	ipBlockIDs := []uuid.UUID{}
+	for _, vp := range vpcPrefixes {
+		if vp.IPBlockID != nil {
+			ipBlockIDs = append(ipBlockIDs, *vp.IPBlockID)
+		}
+	}
+	ipBlockMap := map[uuid.UUID]*cdbm.IPBlock{}
+	if len(ipBlockIDs) > 0 {
+		ipBlocks, _, serr := cdbm.NewIPBlockDAO(gash.dbSession).GetAll(ctx, nil, cdbm.IPBlockFilterInput{IPBlockIDs: ipBlockIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if serr != nil {
+			logger.Warn().Err(serr).Msg("error retrieving IP Blocks for VPC Prefixes from DB, reserved ranges will not be included")
+		}
+		for i := range ipBlocks {
+			ipBlockMap[ipBlocks[i].ID] = &ipBlocks[i]
+		}
+	}
+
+	// Get IPAM entries of the VPC prefixes with a single query to retrieve their reserved ranges
+	prefixKeys := map[uuid.UUID]cipam.PrefixKey{}
+	for _, vp := range vpcPrefixes {
+		if vp.IPBlockID != nil && ipBlockMap[*vp.IPBlockID] != nil {
+			prefixKeys[vp.ID] = ipam.GetIpamPrefixKeyForCidr(ctx, ipBlockMap[*vp.IPBlockID], vp.Prefix)
+		}
+	}
+	keys := make([]cipam.PrefixKey, 0, len(prefixKeys))
+	for _, key := range prefixKeys {
+		keys = append(keys, key)
+	}
+	prefixes, serr := ipam.GetIpamPrefixes(ctx, ipam.NewIpamStorage(gash.dbSession.DB, nil), keys)
+	if serr != nil {
+		logger.Warn().Err(serr).Msg("error retrieving IPAM entries for VPC prefixes, reserved ranges will not be included")
+	}
+
 	// get status details
 	for _, sn := range vpcPrefixes {
 		cursn := sn
 		apiVpcPrefix := model.NewAPIVpcPrefix(&cursn, ssdMap[sn.ID.String()])
+
+		// set reserved ranges from IPAM
+		if key, ok := prefixKeys[sn.ID]; ok && prefixes[key] != nil {
+			apiVpcPrefix.ReservedRanges = model.NewAPIReservedIPRanges(prefixes[key].ReservedRanges())
+		}
+
 		apiVpcPrefixes = append(apiVpcPrefixes, apiVpcPrefix)
 	}
 
@@ -662,10 +713,36 @@ func (gsh GetVpcPrefixHandler) Handle(c echo.Context) error {
 
 	// Send response
 	apiVpcPrefix := model.NewAPIVpcPrefix(vpcPrefix, ssds)
+
+	// get reserved ranges from IPAM for the response
+	ipamStorage := ipam.NewIpamStorage(gsh.dbSession.DB, nil)
+	prefix, _, err := getIpamPrefixForVpcPrefix(ctx, nil, gsh.dbSession, ipamStorage, vpcPrefix)
+	if err != nil {
+		logger.Warn().Err(err).Msg("error retrieving IPAM entry for VPC prefix, reserved ranges will not be included")
+	} else {
+		apiVpcPrefix.ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
+	}
+
 	logger.Info().Msg("finishing API handler")
 	return c.JSON(http.StatusOK, apiVpcPrefix)
 }
 
+// getIpamPrefixForVpcPrefix returns the IPAM entry allocated for the VPC prefix along with the IP Block it was allocated from
+func getIpamPrefixForVpcPrefix(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, ipamStorage cipam.Storage, vpcPrefix *cdbm.VpcPrefix) (*cipam.Prefix, *cdbm.IPBlock, error) {
+	if vpcPrefix.IPBlockID == nil {
+		return nil, nil, fmt.Errorf("VPC prefix: %s is not associated with an IP Block", vpcPrefix.ID.String())
+	}
+	ipBlock, err := cdbm.NewIPBlockDAO(dbSession).GetByID(ctx, tx, *vpcPrefix.IPBlockID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix, err := ipam.GetIpamPrefixForCidr(ctx, ipamStorage, ipBlock, vpcPrefix.Prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	return prefix, ipBlock, nil
+}
+
 // ~~~~~ Update Handler ~~~~~ //
 
 // UpdateVpcPrefixHandler is the API Handler for updating a VPC prefix
@@ -806,6 +883,23 @@ func (ush UpdateVpcPrefixHandler) Handle(c echo.Context) error {
 		return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to update VPC prefix", nil)
 	}
 
+	// update the reserved ranges in IPAM
+	ipamStorage := ipam.NewIpamStorage(ush.dbSession.DB, tx.GetBunTx())
+	prefix, ipBlock, err := getIpamPrefixForVpcPrefix(ctx, tx, ush.dbSession, ipamStorage, vpcPrefix)
+	if err != nil {
+		if apiRequest.ReservedRanges != nil {
+			logger.Error().Err(err).Msg("error retrieving IPAM entry for VPC prefix")
+			return cutil.NewAPIErrorResponse(c, http.StatusInternalServerError, "Failed to retrieve IPAM entry for VPC prefix", nil)
+		}
+		logger.Warn().Err(err).Msg("error retrieving IPAM entry for VPC prefix, reserved ranges will not be included")
+	} else if apiRequest.ReservedRanges != nil {
+		prefix, err = ipam.UpdateIpamReservedRangesForCidr(ctx, ipamStorage, ipBlock, prefix.Cidr, model.GetIPRangesFromAPIReservedIPRanges(*apiRequest.ReservedRanges))
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to update reserved ranges in IPAM entry for VPC prefix")
+			return cutil.NewAPIErrorResponse(c, http.StatusBadRequest, fmt.Sprintf("Could not update reserved ranges in IPAM entry for VPC prefix. Details: %s", err.Error()), nil)
+		}
+	}
+
 	// get status details for the response
 	sdDAO := cdbm.NewStatusDetailDAO(ush.dbSession)
 	ssds, _, err := sdDAO.GetAllByEntityID(ctx, tx, vpcPrefix.ID.String(), nil, cdb.GetIntPtr(pagination.MaxPageSize), nil)
@@ -890,6 +984,9 @@ func (ush UpdateVpcPrefixHandler) Handle(c echo.Context) error {
 
 	// Send response
 	apiVpcPrefix := model.NewAPIVpcPrefix(vpcPrefix, ssds)
+	if prefix != nil {
+		apiVpcPrefix.ReservedRanges = model.NewAPIReservedIPRanges(prefix.ReservedRanges())
+	}
 	logger.Info().Msg("finishing API handler")
 	return c.JSON(http.StatusOK, apiVpcPrefix)
 }
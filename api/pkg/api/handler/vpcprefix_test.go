@@ -1244,6 +1244,164 @@ func TestVpcPrefixHandler_Get(t *testing.T) {
 	}
 }
 
+func TestVpcPrefixHandler_ReservedRanges(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+
+	common.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-ip-org-1"
+	ipu := testMachineBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, []string{"FORGE_PROVIDER_ADMIN"})
+	tnOrg := "test-tn-org-1"
+	tnu := testMachineBuildUser(t, dbSession, uuid.New().String(), []string{tnOrg}, []string{"FORGE_TENANT_ADMIN"})
+
+	ip := testIPBlockBuildInfrastructureProvider(t, dbSession, "TestIp", ipOrg, ipu)
+	site := testIPBlockBuildSite(t, dbSession, ip, "testSite", cdbm.SiteStatusRegistered, true, ipu)
+	tenant := testMachineBuildTenant(t, dbSession, tnOrg, "t1")
+	vpc := testVpcPrefixBuildVpc(t, dbSession, ip, tenant, site, tnOrg, "testVPC", cdb.GetStrPtr(cdbm.VpcFNN), cdbm.VpcStatusReady, cdb.GetUUIDPtr(uuid.New()))
+
+	cfg := common.GetTestConfig()
+	tempClient := &tmocks.Client{}
+	ipamStorage := ipam.NewIpamStorage(dbSession.DB, nil)
+
+	ipb := testIPBlockBuildIPBlock(t, dbSession, "testipb", site, ip, &tenant.ID, cdbm.IPBlockRoutingTypeDatacenterOnly, "192.168.0.0", 16, cdbm.IPBlockProtocolVersionV4, false, cdbm.IPBlockStatusReady, ipu)
+	_, err := ipam.CreateIpamEntryForIPBlock(ctx, ipamStorage, ipb.Prefix, ipb.PrefixLength, ipb.RoutingType, ipb.InfrastructureProviderID.String(), ipb.SiteID.String())
+	require.Nil(t, err)
+
+	tsc := &tmocks.Client{}
+	tcfg, _ := cfg.GetTemporalConfig()
+	scp := sc.NewClientPool(tcfg)
+	scp.IDClientMap[site.ID.String()] = tsc
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	wrun.Mock.On("Get", mock.Anything, mock.Anything).Return(nil)
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"CreateVpcPrefix", mock.Anything).Return(wrun, nil)
+	tsc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		"UpdateVpcPrefix", mock.Anything).Return(wrun, nil)
+
+	// OTEL Spanner configuration
+	tracer, _, ctx := common.TestCommonTraceProviderSetup(t, ctx)
+
+	newContext := func(method string, body interface{}, id string) (echo.Context, *httptest.ResponseRecorder) {
+		reqBody := ""
+		if body != nil {
+			b, err := json.Marshal(body)
+			require.Nil(t, err)
+			reqBody = string(b)
+		}
+		req := httptest.NewRequest(method, fmt.Sprintf("/v2/org/%s/carbide/vpcprefix", tnOrg), strings.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+
+		ec := echo.New().NewContext(req, rec)
+		if id != "" {
+			ec.SetParamNames("orgName", "id")
+			ec.SetParamValues(tnOrg, id)
+		} else {
+			ec.SetParamNames("orgName")
+			ec.SetParamValues(tnOrg)
+		}
+		ec.Set("user", tnu)
+		ec.SetRequest(ec.Request().WithContext(context.WithValue(ctx, otelecho.TracerKey, tracer)))
+		return ec, rec
+	}
+
+	create := func(name string, reservedRanges []model.APIReservedIPRange) *httptest.ResponseRecorder {
+		ec, rec := newContext(http.MethodPost, &model.APIVpcPrefixCreateRequest{
+			Name:           name,
+			VpcID:          vpc.ID.String(),
+			IPBlockID:      cdb.GetStrPtr(ipb.ID.String()),
+			PrefixLength:   24,
+			ReservedRanges: reservedRanges,
+		}, "")
+		cvph := CreateVpcPrefixHandler{dbSession: dbSession, tc: tempClient, cfg: cfg, scp: scp}
+		assert.Nil(t, cvph.Handle(ec))
+		return rec
+	}
+
+	update := func(id string, reservedRanges []model.APIReservedIPRange) *httptest.ResponseRecorder {
+		ec, rec := newContext(http.MethodPatch, &model.APIVpcPrefixUpdateRequest{ReservedRanges: &reservedRanges}, id)
+		uvph := UpdateVpcPrefixHandler{dbSession: dbSession, tc: tempClient, scp: scp, cfg: cfg}
+		assert.Nil(t, uvph.Handle(ec))
+		return rec
+	}
+
+	getAll := func() map[string]model.APIVpcPrefix {
+		ec, rec := newContext(http.MethodGet, nil, "")
+		gavph := GetAllVpcPrefixHandler{dbSession: dbSession, tc: tempClient, cfg: cfg}
+		assert.Nil(t, gavph.Handle(ec))
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		rsp := []model.APIVpcPrefix{}
+		require.Nil(t, json.Unmarshal(rec.Body.Bytes(), &rsp))
+		vpcPrefixes := map[string]model.APIVpcPrefix{}
+		for _, vpcPrefix := range rsp {
+			vpcPrefixes[vpcPrefix.Name] = vpcPrefix
+		}
+		return vpcPrefixes
+	}
+
+	availableIPs := func(cidr *string) uint64 {
+		require.NotNil(t, cidr)
+		prefix, err := ipam.GetIpamPrefixForCidr(ctx, ipamStorage, ipb, *cidr)
+		require.Nil(t, err)
+		return prefix.Usage().AvailableIPs
+	}
+
+	// The first child prefix acquired from the IP Block is 192.168.0.0/24
+	gateway := model.APIReservedIPRange{Start: "192.168.0.1"}
+	vips := model.APIReservedIPRange{Start: "192.168.0.10", End: "192.168.0.19"}
+	infra := model.APIReservedIPRange{Start: "192.168.0.250", End: "192.168.0.254"}
+	outside := model.APIReservedIPRange{Start: "10.20.0.5", End: "10.20.0.9"}
+
+	// Create with reserved ranges
+	rec := create("reserved", []model.APIReservedIPRange{gateway, vips})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+	rsp := &model.APIVpcPrefix{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+	require.NotNil(t, rsp.Prefix)
+	require.Equal(t, "192.168.0.0/24", *rsp.Prefix)
+	assert.ElementsMatch(t, []model.APIReservedIPRange{gateway, vips}, rsp.ReservedRanges)
+	reservedID := rsp.ID
+
+	rec = create("unreserved", nil)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	// Create with a range outside of the CIDR
+	rec = create("outside", []model.APIReservedIPRange{outside})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Could not reserve ranges in IPAM entry for VPC prefix")
+
+	// Update replaces the reserved ranges
+	rec = update(reservedID, []model.APIReservedIPRange{infra})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rsp = &model.APIVpcPrefix{}
+	require.Nil(t, json.Unmarshal(rec.Body.Bytes(), rsp))
+	assert.Equal(t, []model.APIReservedIPRange{infra}, rsp.ReservedRanges)
+
+	// Update with a range outside of the CIDR is rejected and keeps the ranges
+	rec = update(reservedID, []model.APIReservedIPRange{infra, outside})
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Could not update reserved ranges in IPAM entry for VPC prefix")
+
+	// List includes the reserved ranges, and the available IPs exclude them
+	vpcPrefixes := getAll()
+	require.Len(t, vpcPrefixes, 2)
+	assert.Equal(t, []model.APIReservedIPRange{infra}, vpcPrefixes["reserved"].ReservedRanges)
+	assert.Empty(t, vpcPrefixes["unreserved"].ReservedRanges)
+	assert.Equal(t, availableIPs(vpcPrefixes["unreserved"].Prefix)-5, availableIPs(vpcPrefixes["reserved"].Prefix))
+
+	// Clearing the reserved ranges makes the IPs available again
+	rec = update(reservedID, []model.APIReservedIPRange{})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	vpcPrefixes = getAll()
+	assert.Empty(t, vpcPrefixes["reserved"].ReservedRanges)
+	assert.Equal(t, availableIPs(vpcPrefixes["unreserved"].Prefix), availableIPs(vpcPrefixes["reserved"].Prefix))
+}
+
 func TestVpcPrefixHandler_Update(t *testing.T) {
 	ctx := context.Background()
 	dbSession := testMachineInitDB(t)
@@ -0,0 +1,152 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+const (
+	// AddressSetMaxPrefixes is the maximum number of prefixes an Address Set can hold
+	AddressSetMaxPrefixes = 100
+)
+
+// validateAddressSetPrefixes ensures that prefixes are valid, unique CIDR prefixes within the allowed count
+func validateAddressSetPrefixes(value interface{}) error {
+	prefixes, _ := value.([]string)
+	if len(prefixes) > AddressSetMaxPrefixes {
+		return fmt.Errorf("number of prefixes cannot exceed %d", AddressSetMaxPrefixes)
+	}
+
+	seen := map[string]bool{}
+	for _, prefix := range prefixes {
+		if _, _, err := net.ParseCIDR(prefix); err != nil {
+			return fmt.Errorf("prefix `%s` is not a valid CIDR prefix", prefix)
+		}
+		if seen[prefix] {
+			return fmt.Errorf("prefix `%s` is specified more than once", prefix)
+		}
+		seen[prefix] = true
+	}
+
+	return nil
+}
+
+// APIAddressSetCreateRequest is the data structure to capture user request to create a new AddressSet
+type APIAddressSetCreateRequest struct {
+	// Name is the name of the AddressSet
+	Name string `json:"name"`
+	// Description is the description of the AddressSet
+	Description *string `json:"description"`
+	// Prefixes is the list of CIDR prefixes in the AddressSet
+	Prefixes []string `json:"prefixes"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (ascr APIAddressSetCreateRequest) Validate() error {
+	return validation.ValidateStruct(&ascr,
+		validation.Field(&ascr.Name,
+			validation.Required.Error(validationErrorStringLength),
+			validation.By(util.ValidateNameCharacters),
+			validation.Length(2, 256).Error(validationErrorStringLength)),
+		validation.Field(&ascr.Description,
+			validation.When(ascr.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+		validation.Field(&ascr.Prefixes,
+			validation.By(validateAddressSetPrefixes)),
+	)
+}
+
+// APIAddressSetUpdateRequest is the data structure to capture user request to update an AddressSet
+type APIAddressSetUpdateRequest struct {
+	// Name is the name of the AddressSet
+	Name *string `json:"name"`
+	// Description is the description of the AddressSet
+	Description *string `json:"description"`
+	// Prefixes replaces the list of CIDR prefixes in the AddressSet
+	Prefixes []string `json:"prefixes"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (asur APIAddressSetUpdateRequest) Validate() error {
+	if asur.Name == nil && asur.Description == nil && asur.Prefixes == nil {
+		return validation.Errors{
+			validationCommonErrorField: errors.New("at least one of name, description or prefixes must be specified"),
+		}
+	}
+
+	return validation.ValidateStruct(&asur,
+		validation.Field(&asur.Name,
+			validation.When(asur.Name != nil, validation.Required.Error(validationErrorStringLength)),
+			validation.When(asur.Name != nil, validation.By(util.ValidateNameCharacters)),
+			validation.When(asur.Name != nil, validation.Length(2, 256).Error(validationErrorStringLength))),
+		validation.Field(&asur.Description,
+			validation.When(asur.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+		validation.Field(&asur.Prefixes,
+			validation.By(validateAddressSetPrefixes)),
+	)
+}
+
+// APIAddressSet is the data structure to capture API representation of an AddressSet
+type APIAddressSet struct {
+	// ID is the unique UUID v4 identifier for the AddressSet
+	ID string `json:"id"`
+	// Name is the name of the AddressSet
+	Name string `json:"name"`
+	// Description is the description of the AddressSet
+	Description *string `json:"description"`
+	// TenantID is the ID of the Tenant
+	TenantID string `json:"tenantId"`
+	// Tenant is the summary of the tenant
+	Tenant *APITenantSummary `json:"tenant,omitempty"`
+	// Prefixes is the list of CIDR prefixes in the AddressSet
+	Prefixes []string `json:"prefixes"`
+	// Created indicates the ISO datetime string for when the AddressSet was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the AddressSet was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIAddressSet accepts a DB layer AddressSet object and returns an API object
+func NewAPIAddressSet(das *cdbm.AddressSet) *APIAddressSet {
+	apias := &APIAddressSet{
+		ID:          das.ID.String(),
+		Name:        das.Name,
+		Description: das.Description,
+		TenantID:    das.TenantID.String(),
+		Prefixes:    das.Prefixes,
+		Created:     das.Created,
+		Updated:     das.Updated,
+	}
+
+	if apias.Prefixes == nil {
+		apias.Prefixes = []string{}
+	}
+
+	if das.Tenant != nil {
+		apias.Tenant = NewAPITenantSummary(das.Tenant)
+	}
+
+	return apias
+}
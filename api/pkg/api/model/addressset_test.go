@@ -0,0 +1,146 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIAddressSetCreateRequest_Validate(t *testing.T) {
+	tooMany := []string{}
+	for i := 0; i <= AddressSetMaxPrefixes; i++ {
+		tooMany = append(tooMany, fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))
+	}
+
+	tests := []struct {
+		desc      string
+		obj       APIAddressSetCreateRequest
+		expectErr bool
+	}{
+		{
+			desc:      "ok when only required fields are provided",
+			obj:       APIAddressSetCreateRequest{Name: "corp"},
+			expectErr: false,
+		},
+		{
+			desc:      "ok when all fields are provided",
+			obj:       APIAddressSetCreateRequest{Name: "corp", Description: cdb.GetStrPtr("Corporate networks"), Prefixes: []string{"10.0.0.0/8", "fd00::/8"}},
+			expectErr: false,
+		},
+		{
+			desc:      "error when name is not provided",
+			obj:       APIAddressSetCreateRequest{Prefixes: []string{"10.0.0.0/8"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefix is not a CIDR",
+			obj:       APIAddressSetCreateRequest{Name: "corp", Prefixes: []string{"10.0.0.1"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefix is duplicated",
+			obj:       APIAddressSetCreateRequest{Name: "corp", Prefixes: []string{"10.0.0.0/8", "10.0.0.0/8"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when there are too many prefixes",
+			obj:       APIAddressSetCreateRequest{Name: "corp", Prefixes: tooMany},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIAddressSetUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIAddressSetUpdateRequest
+		expectErr bool
+	}{
+		{
+			desc:      "ok when name is updated",
+			obj:       APIAddressSetUpdateRequest{Name: cdb.GetStrPtr("updated")},
+			expectErr: false,
+		},
+		{
+			desc:      "ok when prefixes are cleared",
+			obj:       APIAddressSetUpdateRequest{Prefixes: []string{}},
+			expectErr: false,
+		},
+		{
+			desc:      "error when nothing is updated",
+			obj:       APIAddressSetUpdateRequest{},
+			expectErr: true,
+		},
+		{
+			desc:      "error when name is too short",
+			obj:       APIAddressSetUpdateRequest{Name: cdb.GetStrPtr("a")},
+			expectErr: true,
+		},
+		{
+			desc:      "error when prefix is invalid",
+			obj:       APIAddressSetUpdateRequest{Prefixes: []string{"10.0.0.0/33"}},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIAddressSetNew(t *testing.T) {
+	dbAddressSet := &cdbm.AddressSet{
+		ID:          uuid.New(),
+		Name:        "corp",
+		Description: cdb.GetStrPtr("Corporate networks"),
+		TenantOrg:   "test-org",
+		TenantID:    uuid.New(),
+		Tenant:      &cdbm.Tenant{ID: uuid.New(), Org: "test-org"},
+		Prefixes:    []string{"10.0.0.0/8"},
+		Created:     cdb.GetCurTime(),
+		Updated:     cdb.GetCurTime(),
+	}
+
+	apias := NewAPIAddressSet(dbAddressSet)
+	assert.Equal(t, dbAddressSet.ID.String(), apias.ID)
+	assert.Equal(t, dbAddressSet.Name, apias.Name)
+	assert.Equal(t, dbAddressSet.Description, apias.Description)
+	assert.Equal(t, dbAddressSet.TenantID.String(), apias.TenantID)
+	assert.Equal(t, dbAddressSet.Prefixes, apias.Prefixes)
+	assert.NotNil(t, apias.Tenant)
+
+	dbAddressSet.Prefixes = nil
+	dbAddressSet.Tenant = nil
+	apias = NewAPIAddressSet(dbAddressSet)
+	assert.Equal(t, []string{}, apias.Prefixes)
+	assert.Nil(t, apias.Tenant)
+}
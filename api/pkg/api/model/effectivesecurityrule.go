@@ -0,0 +1,470 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	hutil "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+const (
+	// APINetworkSecurityGroupSourceInstance indicates the Network Security Group attached to the Instance applies
+	APINetworkSecurityGroupSourceInstance = "Instance"
+	// APINetworkSecurityGroupSourceVpc indicates the Network Security Group attached to the Instance's VPC applies
+	APINetworkSecurityGroupSourceVpc = "Vpc"
+	// APINetworkSecurityGroupSourceNone indicates no Network Security Group applies and traffic is not filtered
+	APINetworkSecurityGroupSourceNone = "None"
+
+	// NetworkSecurityGroupPortMax is the highest port a rule or evaluation request can specify
+	NetworkSecurityGroupPortMax = 65535
+)
+
+// APIEffectiveSecurityRule is a Network Security Group rule along with the Network Security Group it comes from
+type APIEffectiveSecurityRule struct {
+	APINetworkSecurityGroupRule
+	// NetworkSecurityGroupID is the ID of the Network Security Group the rule belongs to
+	NetworkSecurityGroupID string `json:"networkSecurityGroupId"`
+	// NetworkSecurityGroupName is the name of the Network Security Group the rule belongs to
+	NetworkSecurityGroupName string `json:"networkSecurityGroupName"`
+	// Source is what the Network Security Group is attached to, either Instance or Vpc
+	Source string `json:"source"`
+	// ResolvedSourcePrefixes are the prefixes the source Address Set or Network Security Group reference currently resolves to
+	ResolvedSourcePrefixes []string `json:"resolvedSourcePrefixes,omitempty"`
+	// ResolvedDestinationPrefixes are the prefixes the destination Address Set or Network Security Group reference currently resolves to
+	ResolvedDestinationPrefixes []string `json:"resolvedDestinationPrefixes,omitempty"`
+}
+
+// resolveReference returns the prefixes an Address Set or Network Security Group reference resolves to, nil if there is no reference
+func resolveReference(addressSetID *string, networkSecurityGroupID *string, prefixesByReferenceID map[string][]string) []string {
+	var id *string
+	if addressSetID != nil {
+		id = addressSetID
+	} else if networkSecurityGroupID != nil {
+		id = networkSecurityGroupID
+	}
+	if id == nil {
+		return nil
+	}
+
+	prefixes := prefixesByReferenceID[*id]
+	if prefixes == nil {
+		// Reference that resolves to nothing matches no traffic
+		prefixes = []string{}
+	}
+	return prefixes
+}
+
+// APIInstanceEffectiveSecurityRules is the data structure to capture the Network Security Group rules that apply to an Instance
+type APIInstanceEffectiveSecurityRules struct {
+	// InstanceID is the ID of the Instance
+	InstanceID string `json:"instanceId"`
+	// VpcID is the ID of the VPC the Instance belongs to
+	VpcID string `json:"vpcId"`
+	// Source is what the applied Network Security Group is attached to: Instance, Vpc or None
+	Source string `json:"source"`
+	// NetworkSecurityGroup is the summary of the applied Network Security Group
+	NetworkSecurityGroup *APINetworkSecurityGroupSummary `json:"networkSecurityGroup"`
+	// Rules are the applied rules in evaluation order
+	Rules []*APIEffectiveSecurityRule `json:"rules"`
+	// OverriddenNetworkSecurityGroup is the summary of the VPC's Network Security Group when the Instance's own Network Security Group takes precedence
+	OverriddenNetworkSecurityGroup *APINetworkSecurityGroupSummary `json:"overriddenNetworkSecurityGroup"`
+	// OverriddenRules are the rules of the overridden Network Security Group, these are not applied to the Instance
+	OverriddenRules []*APIEffectiveSecurityRule `json:"overriddenRules"`
+}
+
+// newAPIEffectiveSecurityRules converts the rules of a Network Security Group and sorts them in evaluation order
+func newAPIEffectiveSecurityRules(nsg *cdbm.NetworkSecurityGroup, source string, prefixesByReferenceID map[string][]string) ([]*APIEffectiveSecurityRule, error) {
+	rules := make([]*APIEffectiveSecurityRule, 0, len(nsg.Rules))
+	for _, rule := range nsg.Rules {
+		if rule == nil || rule.NetworkSecurityGroupRuleAttributes == nil {
+			continue
+		}
+
+		apiRule, err := APINetworkSecurityGroupRuleFromProtobufRule(rule)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, &APIEffectiveSecurityRule{
+			APINetworkSecurityGroupRule: *apiRule,
+			NetworkSecurityGroupID:      nsg.ID,
+			NetworkSecurityGroupName:    nsg.Name,
+			Source:                      source,
+			ResolvedSourcePrefixes:      resolveReference(rule.SourceAddressSetID, rule.SourceNetworkSecurityGroupID, prefixesByReferenceID),
+			ResolvedDestinationPrefixes: resolveReference(rule.DestinationAddressSetID, rule.DestinationNetworkSecurityGroupID, prefixesByReferenceID),
+		})
+	}
+
+	// Rules with lower priority values are evaluated first, rules with the same priority keep their order in the Network Security Group
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Priority < rules[j].Priority
+	})
+
+	return rules, nil
+}
+
+// NewAPIInstanceEffectiveSecurityRules accepts the Network Security Groups attached to an Instance and its VPC and returns the rules that apply to the Instance.
+// A Network Security Group attached to the Instance takes precedence over the one attached to its VPC.
+// prefixesByReferenceID holds the prefixes that Address Set and Network Security Group references in the rules resolve to.
+func NewAPIInstanceEffectiveSecurityRules(instance *cdbm.Instance, instanceNSG *cdbm.NetworkSecurityGroup, vpcNSG *cdbm.NetworkSecurityGroup, prefixesByReferenceID map[string][]string) (*APIInstanceEffectiveSecurityRules, error) {
+	aesr := &APIInstanceEffectiveSecurityRules{
+		InstanceID:      instance.ID.String(),
+		VpcID:           instance.VpcID.String(),
+		Source:          APINetworkSecurityGroupSourceNone,
+		Rules:           []*APIEffectiveSecurityRule{},
+		OverriddenRules: []*APIEffectiveSecurityRule{},
+	}
+
+	var err error
+
+	if instanceNSG != nil {
+		aesr.Source = APINetworkSecurityGroupSourceInstance
+		aesr.NetworkSecurityGroup = NewAPINetworkSecurityGroupSummary(instanceNSG)
+		aesr.Rules, err = newAPIEffectiveSecurityRules(instanceNSG, APINetworkSecurityGroupSourceInstance, prefixesByReferenceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if vpcNSG != nil {
+			aesr.OverriddenNetworkSecurityGroup = NewAPINetworkSecurityGroupSummary(vpcNSG)
+			aesr.OverriddenRules, err = newAPIEffectiveSecurityRules(vpcNSG, APINetworkSecurityGroupSourceVpc, prefixesByReferenceID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else if vpcNSG != nil {
+		aesr.Source = APINetworkSecurityGroupSourceVpc
+		aesr.NetworkSecurityGroup = NewAPINetworkSecurityGroupSummary(vpcNSG)
+		aesr.Rules, err = newAPIEffectiveSecurityRules(vpcNSG, APINetworkSecurityGroupSourceVpc, prefixesByReferenceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return aesr, nil
+}
+
+// APINetworkSecurityGroupEvaluateRequest is the data structure to capture user request to evaluate traffic against the rules that apply to an Instance
+type APINetworkSecurityGroupEvaluateRequest struct {
+	// InstanceID is the ID of the Instance receiving or sending the traffic
+	InstanceID string `json:"instanceId"`
+	// Direction is INGRESS for traffic to the Instance or EGRESS for traffic from the Instance, defaults to INGRESS
+	Direction string `json:"direction"`
+	// Protocol is the protocol of the traffic
+	Protocol string `json:"protocol"`
+	// SourcePrefix is the source address or prefix of the traffic, defaults to any address for ingress and the Instance's addresses for egress
+	SourcePrefix *string `json:"sourcePrefix"`
+	// SourcePort is the source port of the traffic, defaults to any port
+	SourcePort *int `json:"sourcePort"`
+	// DestinationPrefix is the destination address or prefix of the traffic, defaults to the Instance's addresses for ingress and any address for egress
+	DestinationPrefix *string `json:"destinationPrefix"`
+	// DestinationPort is the destination port of the traffic, defaults to any port
+	DestinationPort *int `json:"destinationPort"`
+}
+
+// validateAddressOrPrefix validates that a value is an IP address or a CIDR prefix
+func validateAddressOrPrefix(value interface{}) error {
+	s, _ := value.(*string)
+	if s == nil {
+		return nil
+	}
+	if _, err := parseAddressOrPrefix(*s); err != nil {
+		return errors.New("must be a valid IP address or CIDR prefix")
+	}
+	return nil
+}
+
+// parseAddressOrPrefix parses a CIDR prefix, or an IP address as a single address prefix
+func parseAddressOrPrefix(s string) (*net.IPNet, error) {
+	if _, ipNet, err := net.ParseCIDR(s); err == nil {
+		return ipNet, nil
+	}
+
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address or CIDR prefix: %s", s)
+	}
+	if ip4 := ip.To4(); ip4 != nil {
+		return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+}
+
+// Validate ensures the values in the request are acceptable, Direction and Protocol are normalized to upper case
+func (nsger *APINetworkSecurityGroupEvaluateRequest) Validate() error {
+	nsger.Direction = strings.ToUpper(nsger.Direction)
+	if nsger.Direction == "" {
+		nsger.Direction = APINetworkSecurityGroupRuleDirectionIngress
+	}
+	nsger.Protocol = strings.ToUpper(nsger.Protocol)
+
+	portsAllowed := nsger.Protocol == APINetworkSecurityGroupRuleProtocolTcp || nsger.Protocol == APINetworkSecurityGroupRuleProtocolUdp
+
+	return validation.ValidateStruct(nsger,
+		validation.Field(&nsger.InstanceID,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&nsger.Direction,
+			validation.In(APINetworkSecurityGroupRuleDirectionIngress, APINetworkSecurityGroupRuleActionEgress).Error(
+				fmt.Sprintf("must be one of: %s, %s", APINetworkSecurityGroupRuleDirectionIngress, APINetworkSecurityGroupRuleActionEgress))),
+		validation.Field(&nsger.Protocol,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.By(func(value interface{}) error {
+				if _, ok := NetworkSecurityGroupRuleProtobufProtocolFromAPIProtocol[nsger.Protocol]; !ok {
+					return fmt.Errorf("unknown protocol `%s`", nsger.Protocol)
+				}
+				return nil
+			})),
+		validation.Field(&nsger.SourcePrefix,
+			validation.By(validateAddressOrPrefix)),
+		validation.Field(&nsger.DestinationPrefix,
+			validation.By(validateAddressOrPrefix)),
+		validation.Field(&nsger.SourcePort,
+			validation.When(nsger.SourcePort != nil && !portsAllowed, validation.Nil.Error(fmt.Sprintf("ports cannot be specified with protocol `%s`", nsger.Protocol))),
+			validation.When(nsger.SourcePort != nil, validation.Min(0), validation.Max(NetworkSecurityGroupPortMax))),
+		validation.Field(&nsger.DestinationPort,
+			validation.When(nsger.DestinationPort != nil && !portsAllowed, validation.Nil.Error(fmt.Sprintf("ports cannot be specified with protocol `%s`", nsger.Protocol))),
+			validation.When(nsger.DestinationPort != nil, validation.Min(0), validation.Max(NetworkSecurityGroupPortMax))),
+	)
+}
+
+// APINetworkSecurityGroupEvaluation is the data structure to capture the result of evaluating traffic against the rules that apply to an Instance
+type APINetworkSecurityGroupEvaluation struct {
+	// InstanceID is the ID of the Instance
+	InstanceID string `json:"instanceId"`
+	// Source is what the applied Network Security Group is attached to: Instance, Vpc or None
+	Source string `json:"source"`
+	// NetworkSecurityGroupID is the ID of the applied Network Security Group
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupId"`
+	// Allowed is true if the traffic is permitted
+	Allowed bool `json:"allowed"`
+	// Action is PERMIT or DENY
+	Action string `json:"action"`
+	// Reason explains how the decision was reached
+	Reason string `json:"reason"`
+	// MatchedRule is the rule that decided the traffic, nil if no rule matched
+	MatchedRule *APIEffectiveSecurityRule `json:"matchedRule"`
+	// PartiallyMatchedRules are rules evaluated before the decision that match only part of the specified traffic,
+	// e.g. a subset of the source prefix or of the ports. Traffic within them is decided by these rules instead.
+	PartiallyMatchedRules []*APIEffectiveSecurityRule `json:"partiallyMatchedRules"`
+}
+
+// ruleMatch describes how much of the specified traffic a rule matches
+type ruleMatch int
+
+const (
+	ruleMatchNone ruleMatch = iota
+	ruleMatchPartial
+	ruleMatchFull
+)
+
+// combine returns the match of two conditions that must both hold
+func (rm ruleMatch) combine(other ruleMatch) ruleMatch {
+	if other < rm {
+		return other
+	}
+	return rm
+}
+
+// matchPrefix returns how much of the traffic between the specified prefixes falls within the rule prefix,
+// or within any of the resolved prefixes when the rule references an Address Set or Network Security Group
+func matchPrefix(rulePrefix *string, resolvedPrefixes []string, prefixes []*net.IPNet) ruleMatch {
+	rulePrefixes := resolvedPrefixes
+	if rulePrefixes == nil {
+		if rulePrefix == nil {
+			return ruleMatchFull
+		}
+		rulePrefixes = []string{*rulePrefix}
+	}
+
+	ruleNets := make([]*net.IPNet, 0, len(rulePrefixes))
+	for _, rp := range rulePrefixes {
+		if ruleNet, err := parseAddressOrPrefix(rp); err == nil {
+			ruleNets = append(ruleNets, ruleNet)
+		}
+	}
+
+	covered := 0
+	overlapped := 0
+	for _, prefix := range prefixes {
+		ones, bits := prefix.Mask.Size()
+		isCovered, isOverlapped := false, false
+		for _, ruleNet := range ruleNets {
+			ruleOnes, ruleBits := ruleNet.Mask.Size()
+			if bits != ruleBits {
+				continue
+			}
+			if ruleNet.Contains(prefix.IP) && ruleOnes <= ones {
+				isCovered = true
+				isOverlapped = true
+			} else if prefix.Contains(ruleNet.IP) {
+				isOverlapped = true
+			}
+		}
+		if isCovered {
+			covered++
+		}
+		if isOverlapped {
+			overlapped++
+		}
+	}
+
+	switch {
+	case len(prefixes) > 0 && covered == len(prefixes):
+		return ruleMatchFull
+	case overlapped > 0:
+		return ruleMatchPartial
+	}
+	return ruleMatchNone
+}
+
+// matchPort returns whether the specified port falls within the rule port range
+func matchPort(rulePortRange *string, port *int) ruleMatch {
+	start, end, err := hutil.StringPtrToPortRangeUint32PtrPair(rulePortRange)
+	if err != nil {
+		return ruleMatchNone
+	}
+	if start == nil || end == nil || (*start == 0 && *end == NetworkSecurityGroupPortMax) {
+		return ruleMatchFull
+	}
+	if port == nil {
+		return ruleMatchPartial
+	}
+	if uint32(*port) >= *start && uint32(*port) <= *end {
+		return ruleMatchFull
+	}
+	return ruleMatchNone
+}
+
+// matchProtocol returns whether the specified protocol is matched by the rule protocol
+func matchProtocol(ruleProtocol string, protocol string) ruleMatch {
+	switch {
+	case ruleProtocol == APINetworkSecurityGroupRuleProtocolAny || ruleProtocol == protocol:
+		return ruleMatchFull
+	case protocol == APINetworkSecurityGroupRuleProtocolAny:
+		return ruleMatchPartial
+	}
+	return ruleMatchNone
+}
+
+// describeRule returns a short human readable reference to a rule
+func describeRule(rule *APIEffectiveSecurityRule) string {
+	name := "unnamed rule"
+	if rule.Name != nil && *rule.Name != "" {
+		name = fmt.Sprintf("rule `%s`", *rule.Name)
+	}
+	return fmt.Sprintf("%s (priority %d) of Network Security Group `%s`", name, rule.Priority, rule.NetworkSecurityGroupName)
+}
+
+// Evaluate decides whether the traffic described by a validated request is allowed by the rules that apply to the Instance.
+// Rules are evaluated in order and the first rule matching all of the traffic decides; when an applied Network Security Group
+// has no matching rule the traffic is denied. instanceAddresses are used for the Instance side of the traffic when the request omits it.
+func (aesr *APIInstanceEffectiveSecurityRules) Evaluate(req *APINetworkSecurityGroupEvaluateRequest, instanceAddresses []string) *APINetworkSecurityGroupEvaluation {
+	result := &APINetworkSecurityGroupEvaluation{
+		InstanceID:            aesr.InstanceID,
+		Source:                aesr.Source,
+		PartiallyMatchedRules: []*APIEffectiveSecurityRule{},
+	}
+	if aesr.NetworkSecurityGroup != nil {
+		result.NetworkSecurityGroupID = &aesr.NetworkSecurityGroup.ID
+	}
+
+	if aesr.Source == APINetworkSecurityGroupSourceNone {
+		result.Allowed = true
+		result.Action = APINetworkSecurityGroupRuleActionPermit
+		result.Reason = "No Network Security Group is attached to the Instance or its VPC, traffic is not filtered"
+		return result
+	}
+
+	// Build the prefixes of each side of the traffic
+	anyAddress := []*net.IPNet{{IP: net.IPv4zero.To4(), Mask: net.CIDRMask(0, 32)}}
+	instancePrefixes := []*net.IPNet{}
+	for _, address := range instanceAddresses {
+		if prefix, err := parseAddressOrPrefix(address); err == nil {
+			instancePrefixes = append(instancePrefixes, prefix)
+		}
+	}
+	if len(instancePrefixes) == 0 {
+		instancePrefixes = anyAddress
+	}
+
+	sourcePrefixes, destinationPrefixes := anyAddress, instancePrefixes
+	if req.Direction == APINetworkSecurityGroupRuleActionEgress {
+		sourcePrefixes, destinationPrefixes = instancePrefixes, anyAddress
+	}
+	if req.SourcePrefix != nil {
+		if prefix, err := parseAddressOrPrefix(*req.SourcePrefix); err == nil {
+			sourcePrefixes = []*net.IPNet{prefix}
+		}
+	}
+	if req.DestinationPrefix != nil {
+		if prefix, err := parseAddressOrPrefix(*req.DestinationPrefix); err == nil {
+			destinationPrefixes = []*net.IPNet{prefix}
+		}
+	}
+
+	for _, rule := range aesr.Rules {
+		if rule.Direction != req.Direction {
+			continue
+		}
+
+		match := matchProtocol(rule.Protocol, req.Protocol).
+			combine(matchPrefix(rule.SourcePrefix, rule.ResolvedSourcePrefixes, sourcePrefixes)).
+			combine(matchPrefix(rule.DestinationPrefix, rule.ResolvedDestinationPrefixes, destinationPrefixes)).
+			combine(matchPort(rule.SourcePortRange, req.SourcePort)).
+			combine(matchPort(rule.DestinationPortRange, req.DestinationPort))
+
+		switch match {
+		case ruleMatchPartial:
+			result.PartiallyMatchedRules = append(result.PartiallyMatchedRules, rule)
+		case ruleMatchFull:
+			result.MatchedRule = rule
+			result.Action = rule.Action
+			result.Allowed = rule.Action == APINetworkSecurityGroupRuleActionPermit
+			verb := "denied"
+			if result.Allowed {
+				verb = "permitted"
+			}
+			result.Reason = fmt.Sprintf("Traffic is %s by %s", verb, describeRule(rule))
+		}
+
+		if result.MatchedRule != nil {
+			break
+		}
+	}
+
+	if result.MatchedRule == nil {
+		result.Allowed = false
+		result.Action = APINetworkSecurityGroupRuleActionDeny
+		result.Reason = fmt.Sprintf("No %s rule of Network Security Group `%s` matches the traffic, traffic is denied by default", req.Direction, aesr.NetworkSecurityGroup.Name)
+	}
+
+	if len(result.PartiallyMatchedRules) > 0 {
+		result.Reason += fmt.Sprintf(". %d earlier rule(s) match only part of the specified traffic and decide that part instead", len(result.PartiallyMatchedRules))
+	}
+
+	return result
+}
@@ -0,0 +1,380 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEffectiveNSGRule(name string, priority uint32, direction cwssaws.NetworkSecurityGroupRuleDirection, protocol cwssaws.NetworkSecurityGroupRuleProtocol, action cwssaws.NetworkSecurityGroupRuleAction, src string, dst string, dstPortStart *uint32, dstPortEnd *uint32) *cdbm.NetworkSecurityGroupRule {
+	return &cdbm.NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             cdb.GetStrPtr(name),
+			Direction:      direction,
+			Protocol:       protocol,
+			Action:         action,
+			Priority:       priority,
+			DstPortStart:   dstPortStart,
+			DstPortEnd:     dstPortEnd,
+			SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: src},
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: dst},
+		},
+	}
+}
+
+func uint32Ptr(i uint32) *uint32 {
+	return &i
+}
+
+func TestNewAPIInstanceEffectiveSecurityRules(t *testing.T) {
+	ingress := cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS
+	tcp := cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP
+	permit := cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT
+
+	instance := &cdbm.Instance{ID: uuid.New(), VpcID: uuid.New()}
+	instanceNSG := &cdbm.NetworkSecurityGroup{
+		ID:   uuid.NewString(),
+		Name: "instance-nsg",
+		Rules: []*cdbm.NetworkSecurityGroupRule{
+			newTestEffectiveNSGRule("second", 200, ingress, tcp, permit, "0.0.0.0/0", "0.0.0.0/0", nil, nil),
+			newTestEffectiveNSGRule("first", 100, ingress, tcp, permit, "0.0.0.0/0", "0.0.0.0/0", nil, nil),
+			newTestEffectiveNSGRule("second-tie", 200, ingress, tcp, permit, "0.0.0.0/0", "0.0.0.0/0", nil, nil),
+		},
+	}
+	vpcNSG := &cdbm.NetworkSecurityGroup{
+		ID:   uuid.NewString(),
+		Name: "vpc-nsg",
+		Rules: []*cdbm.NetworkSecurityGroupRule{
+			newTestEffectiveNSGRule("vpc", 10, ingress, tcp, permit, "0.0.0.0/0", "0.0.0.0/0", nil, nil),
+		},
+	}
+
+	tests := []struct {
+		name              string
+		instanceNSG       *cdbm.NetworkSecurityGroup
+		vpcNSG            *cdbm.NetworkSecurityGroup
+		wantSource        string
+		wantRules         []string
+		wantOverridden    []string
+		wantOverriddenNSG bool
+	}{
+		{
+			name:       "test no Network Security Group applies",
+			wantSource: APINetworkSecurityGroupSourceNone,
+		},
+		{
+			name:       "test VPC Network Security Group applies",
+			vpcNSG:     vpcNSG,
+			wantSource: APINetworkSecurityGroupSourceVpc,
+			wantRules:  []string{"vpc"},
+		},
+		{
+			name:        "test Instance Network Security Group applies, rules in evaluation order",
+			instanceNSG: instanceNSG,
+			wantSource:  APINetworkSecurityGroupSourceInstance,
+			wantRules:   []string{"first", "second", "second-tie"},
+		},
+		{
+			name:              "test Instance Network Security Group overrides VPC Network Security Group",
+			instanceNSG:       instanceNSG,
+			vpcNSG:            vpcNSG,
+			wantSource:        APINetworkSecurityGroupSourceInstance,
+			wantRules:         []string{"first", "second", "second-tie"},
+			wantOverridden:    []string{"vpc"},
+			wantOverriddenNSG: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := NewAPIInstanceEffectiveSecurityRules(instance, tc.instanceNSG, tc.vpcNSG, nil)
+			require.NoError(t, err)
+
+			assert.Equal(t, instance.ID.String(), got.InstanceID)
+			assert.Equal(t, instance.VpcID.String(), got.VpcID)
+			assert.Equal(t, tc.wantSource, got.Source)
+			assert.Equal(t, tc.wantSource != APINetworkSecurityGroupSourceNone, got.NetworkSecurityGroup != nil)
+			assert.Equal(t, tc.wantOverriddenNSG, got.OverriddenNetworkSecurityGroup != nil)
+
+			names := []string{}
+			for _, rule := range got.Rules {
+				names = append(names, *rule.Name)
+				assert.Equal(t, tc.wantSource, rule.Source)
+			}
+			assert.ElementsMatch(t, tc.wantRules, names)
+			if len(tc.wantRules) > 0 {
+				assert.Equal(t, tc.wantRules, names)
+			}
+
+			overridden := []string{}
+			for _, rule := range got.OverriddenRules {
+				overridden = append(overridden, *rule.Name)
+				assert.Equal(t, APINetworkSecurityGroupSourceVpc, rule.Source)
+			}
+			assert.ElementsMatch(t, tc.wantOverridden, overridden)
+		})
+	}
+}
+
+func TestAPINetworkSecurityGroupEvaluateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       APINetworkSecurityGroupEvaluateRequest
+		wantDir   string
+		expectErr bool
+	}{
+		{
+			name:    "test valid request defaults direction to ingress",
+			req:     APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Protocol: "tcp", SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationPort: cdb.GetIntPtr(443)},
+			wantDir: APINetworkSecurityGroupRuleDirectionIngress,
+		},
+		{
+			name:    "test valid egress request with single address",
+			req:     APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Direction: "egress", Protocol: "ANY", DestinationPrefix: cdb.GetStrPtr("8.8.8.8")},
+			wantDir: APINetworkSecurityGroupRuleActionEgress,
+		},
+		{
+			name:      "test missing instance ID",
+			req:       APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP"},
+			expectErr: true,
+		},
+		{
+			name:      "test unknown protocol",
+			req:       APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Protocol: "SCTP"},
+			expectErr: true,
+		},
+		{
+			name:      "test unknown direction",
+			req:       APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Direction: "SIDEWAYS", Protocol: "TCP"},
+			expectErr: true,
+		},
+		{
+			name:      "test invalid prefix",
+			req:       APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.0.0.0/33")},
+			expectErr: true,
+		},
+		{
+			name:      "test port out of range",
+			req:       APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Protocol: "TCP", DestinationPort: cdb.GetIntPtr(70000)},
+			expectErr: true,
+		},
+		{
+			name:      "test port with ICMP",
+			req:       APINetworkSecurityGroupEvaluateRequest{InstanceID: uuid.NewString(), Protocol: "ICMP", DestinationPort: cdb.GetIntPtr(80)},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDir, tc.req.Direction)
+		})
+	}
+}
+
+func TestAPIInstanceEffectiveSecurityRules_Evaluate(t *testing.T) {
+	ingress := cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS
+	egress := cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_EGRESS
+	tcp := cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP
+	anyProto := cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY
+	permit := cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT
+	deny := cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY
+
+	instance := &cdbm.Instance{ID: uuid.New(), VpcID: uuid.New()}
+	nsg := &cdbm.NetworkSecurityGroup{
+		ID:   uuid.NewString(),
+		Name: "web",
+		Rules: []*cdbm.NetworkSecurityGroupRule{
+			newTestEffectiveNSGRule("deny-bad-host", 10, ingress, anyProto, deny, "10.9.9.9/32", "0.0.0.0/0", nil, nil),
+			newTestEffectiveNSGRule("allow-https-internal", 100, ingress, tcp, permit, "10.0.0.0/8", "192.168.1.0/24", uint32Ptr(443), uint32Ptr(443)),
+			newTestEffectiveNSGRule("allow-egress", 100, egress, anyProto, permit, "0.0.0.0/0", "0.0.0.0/0", nil, nil),
+		},
+	}
+	instanceAddresses := []string{"192.168.1.10"}
+
+	aesr, err := NewAPIInstanceEffectiveSecurityRules(instance, nsg, nil, nil)
+	require.NoError(t, err)
+
+	noNSG, err := NewAPIInstanceEffectiveSecurityRules(instance, nil, nil, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		rules           *APIInstanceEffectiveSecurityRules
+		req             APINetworkSecurityGroupEvaluateRequest
+		addresses       []string
+		wantAllowed     bool
+		wantRule        *string
+		wantPartialRule []string
+	}{
+		{
+			name:        "test traffic is not filtered without Network Security Group",
+			rules:       noNSG,
+			req:         APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationPort: cdb.GetIntPtr(443)},
+			wantAllowed: true,
+		},
+		{
+			name:            "test TCP 443 from 10.0.0.0/8 is permitted, bad host rule decides part of the traffic",
+			rules:           aesr,
+			req:             APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationPort: cdb.GetIntPtr(443)},
+			addresses:       instanceAddresses,
+			wantAllowed:     true,
+			wantRule:        cdb.GetStrPtr("allow-https-internal"),
+			wantPartialRule: []string{"deny-bad-host"},
+		},
+		{
+			name:        "test TCP 443 from denied host is denied",
+			rules:       aesr,
+			req:         APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.9.9.9"), DestinationPort: cdb.GetIntPtr(443)},
+			addresses:   instanceAddresses,
+			wantAllowed: false,
+			wantRule:    cdb.GetStrPtr("deny-bad-host"),
+		},
+		{
+			name:        "test TCP 22 from 10.1.0.0/16 is denied by default",
+			rules:       aesr,
+			req:         APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.1.0.0/16"), DestinationPort: cdb.GetIntPtr(22)},
+			addresses:   instanceAddresses,
+			wantAllowed: false,
+		},
+		{
+			name:            "test TCP 443 from public source is denied by default",
+			rules:           aesr,
+			req:             APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("0.0.0.0/0"), DestinationPort: cdb.GetIntPtr(443)},
+			addresses:       instanceAddresses,
+			wantAllowed:     false,
+			wantPartialRule: []string{"deny-bad-host", "allow-https-internal"},
+		},
+		{
+			name:            "test TCP without port only partially matches port specific rule",
+			rules:           aesr,
+			req:             APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.1.1.1")},
+			addresses:       instanceAddresses,
+			wantAllowed:     false,
+			wantPartialRule: []string{"allow-https-internal"},
+		},
+		{
+			name:        "test explicit destination outside rule prefix is denied",
+			rules:       aesr,
+			req:         APINetworkSecurityGroupEvaluateRequest{Protocol: "TCP", SourcePrefix: cdb.GetStrPtr("10.1.1.1"), DestinationPrefix: cdb.GetStrPtr("172.16.0.1"), DestinationPort: cdb.GetIntPtr(443)},
+			addresses:   instanceAddresses,
+			wantAllowed: false,
+		},
+		{
+			name:        "test egress is permitted",
+			rules:       aesr,
+			req:         APINetworkSecurityGroupEvaluateRequest{Direction: "EGRESS", Protocol: "UDP", DestinationPrefix: cdb.GetStrPtr("8.8.8.8"), DestinationPort: cdb.GetIntPtr(53)},
+			addresses:   instanceAddresses,
+			wantAllowed: true,
+			wantRule:    cdb.GetStrPtr("allow-egress"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.req.InstanceID = instance.ID.String()
+			require.NoError(t, tc.req.Validate())
+
+			got := tc.rules.Evaluate(&tc.req, tc.addresses)
+			assert.Equal(t, tc.wantAllowed, got.Allowed)
+			assert.NotEmpty(t, got.Reason)
+
+			if tc.wantRule == nil {
+				assert.Nil(t, got.MatchedRule)
+			} else {
+				require.NotNil(t, got.MatchedRule)
+				assert.Equal(t, *tc.wantRule, *got.MatchedRule.Name)
+			}
+
+			partial := []string{}
+			for _, rule := range got.PartiallyMatchedRules {
+				partial = append(partial, *rule.Name)
+			}
+			assert.Equal(t, len(tc.wantPartialRule), len(partial))
+			assert.ElementsMatch(t, tc.wantPartialRule, partial)
+		})
+	}
+}
+
+func TestAPIInstanceEffectiveSecurityRules_EvaluateReferences(t *testing.T) {
+	ingress := cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_INGRESS
+	tcp := cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_TCP
+	permit := cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_PERMIT
+
+	corpID := uuid.NewString()
+	emptyID := uuid.NewString()
+
+	fromCorp := newTestEffectiveNSGRule("from-corp", 100, ingress, tcp, permit, "", "0.0.0.0/0", nil, nil)
+	fromCorp.SourceNet = nil
+	fromCorp.SourceAddressSetID = cdb.GetStrPtr(corpID)
+
+	fromEmpty := newTestEffectiveNSGRule("from-empty", 10, ingress, tcp, permit, "", "0.0.0.0/0", nil, nil)
+	fromEmpty.SourceNet = nil
+	fromEmpty.SourceAddressSetID = cdb.GetStrPtr(emptyID)
+
+	instance := &cdbm.Instance{ID: uuid.New(), VpcID: uuid.New()}
+	nsg := &cdbm.NetworkSecurityGroup{
+		ID:    uuid.NewString(),
+		Name:  "web",
+		Rules: []*cdbm.NetworkSecurityGroupRule{fromCorp, fromEmpty},
+	}
+
+	aesr, err := NewAPIInstanceEffectiveSecurityRules(instance, nsg, nil, map[string][]string{corpID: {"10.0.0.0/8", "172.16.0.0/12"}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(aesr.Rules))
+	assert.Equal(t, []string{}, aesr.Rules[0].ResolvedSourcePrefixes)
+	assert.Equal(t, []string{"10.0.0.0/8", "172.16.0.0/12"}, aesr.Rules[1].ResolvedSourcePrefixes)
+	assert.Nil(t, aesr.Rules[1].ResolvedDestinationPrefixes)
+	assert.Equal(t, corpID, *aesr.Rules[1].SourceAddressSetID)
+
+	tests := []struct {
+		name        string
+		source      string
+		wantAllowed bool
+		wantPartial bool
+	}{
+		{name: "test traffic from first prefix of the set is permitted", source: "10.1.2.3", wantAllowed: true},
+		{name: "test traffic from second prefix of the set is permitted", source: "172.16.5.0/24", wantAllowed: true},
+		{name: "test traffic from outside the set is denied", source: "8.8.8.8", wantAllowed: false},
+		{name: "test traffic overlapping the set is partially matched", source: "0.0.0.0/0", wantAllowed: false, wantPartial: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := APINetworkSecurityGroupEvaluateRequest{InstanceID: instance.ID.String(), Protocol: "TCP", SourcePrefix: cdb.GetStrPtr(tc.source)}
+			require.NoError(t, req.Validate())
+
+			got := aesr.Evaluate(&req, []string{"192.168.1.10"})
+			assert.Equal(t, tc.wantAllowed, got.Allowed)
+			assert.Equal(t, tc.wantPartial, len(got.PartiallyMatchedRules) > 0)
+		})
+	}
+}
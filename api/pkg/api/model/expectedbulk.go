@@ -0,0 +1,317 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// ExpectedDeviceMaxBulkItems is the maximum number of Expected Machines, Switches or Power Shelves allowed in a single bulk import
+	ExpectedDeviceMaxBulkItems = 1000
+
+	// expectedDeviceCSVListSeparator separates values in list columns of bulk CSV files e.g. fallbackDPUSerialNumbers and labels
+	expectedDeviceCSVListSeparator = ";"
+	// expectedDeviceCSVLabelSeparator separates key and value of a label in bulk CSV files
+	expectedDeviceCSVLabelSeparator = "="
+
+	// utf8BOM is prepended to CSV files exported by some spreadsheet applications
+	utf8BOM = "\ufeff"
+)
+
+var (
+	// ExpectedMachineCSVHeader is the header of Expected Machine bulk CSV files
+	ExpectedMachineCSVHeader = []string{"siteId", "bmcMacAddress", "chassisSerialNumber", "skuId", "fallbackDPUSerialNumbers", "defaultBmcUsername", "defaultBmcPassword", "labels"}
+	// ExpectedSwitchCSVHeader is the header of Expected Switch bulk CSV files
+	ExpectedSwitchCSVHeader = []string{"siteId", "bmcMacAddress", "switchSerialNumber", "defaultBmcUsername", "defaultBmcPassword", "nvOsUsername", "nvOsPassword", "labels"}
+	// ExpectedPowerShelfCSVHeader is the header of Expected Power Shelf bulk CSV files
+	ExpectedPowerShelfCSVHeader = []string{"siteId", "bmcMacAddress", "shelfSerialNumber", "ipAddress", "defaultBmcUsername", "defaultBmcPassword", "labels"}
+)
+
+// expectedDeviceCSVRow holds the values of a bulk CSV row by column name
+type expectedDeviceCSVRow map[string]string
+
+// optional returns a pointer to the value of the column, or nil if the value is empty
+func (r expectedDeviceCSVRow) optional(column string) *string {
+	if v := r[column]; v != "" {
+		return &v
+	}
+	return nil
+}
+
+// list returns the values of a list column, or nil if the value is empty
+func (r expectedDeviceCSVRow) list(column string) []string {
+	var values []string
+	for _, v := range strings.Split(r[column], expectedDeviceCSVListSeparator) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// labels returns the labels specified as key=value pairs, or nil if the value is empty
+func (r expectedDeviceCSVRow) labels() (map[string]string, error) {
+	entries := r.list("labels")
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	labels := map[string]string{}
+	for _, entry := range entries {
+		key, value, ok := strings.Cut(entry, expectedDeviceCSVLabelSeparator)
+		if !ok {
+			return nil, fmt.Errorf("label %q must be of the form key%svalue", entry, expectedDeviceCSVLabelSeparator)
+		}
+		labels[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return labels, nil
+}
+
+// readExpectedDeviceCSV reads the rows of a bulk CSV file. The first row must be a header naming the columns,
+// columns can be in any order and are matched case-insensitively against the supported header.
+func readExpectedDeviceCSV(r io.Reader, header []string) ([]expectedDeviceCSVRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, errors.New("CSV must contain a header row")
+	}
+
+	supported := map[string]string{}
+	for _, column := range header {
+		supported[strings.ToLower(column)] = column
+	}
+
+	columns := make([]string, len(records[0]))
+	seen := map[string]bool{}
+	for i, name := range records[0] {
+		name = strings.TrimSpace(strings.TrimPrefix(name, utf8BOM))
+		column, ok := supported[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("CSV header contains unsupported column %q, supported columns are: %s", name, strings.Join(header, ", "))
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("CSV header contains duplicate column %q", name)
+		}
+		seen[column] = true
+		columns[i] = column
+	}
+
+	rows := []expectedDeviceCSVRow{}
+	for _, record := range records[1:] {
+		row := expectedDeviceCSVRow{}
+		empty := true
+		for i, value := range record {
+			value = strings.TrimSpace(value)
+			if value != "" {
+				empty = false
+			}
+			row[columns[i]] = value
+		}
+		// Spreadsheets often export trailing empty rows
+		if empty {
+			continue
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// formatExpectedDeviceCSVLabels formats labels as key=value pairs sorted by key
+func formatExpectedDeviceCSVLabels(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, key+expectedDeviceCSVLabelSeparator+labels[key])
+	}
+	return strings.Join(entries, expectedDeviceCSVListSeparator)
+}
+
+// ParseExpectedMachineCSV parses a bulk CSV file into Expected Machine create requests.
+// Rows that cannot be parsed are reported as validation errors keyed by the index of the row, excluding the header.
+func ParseExpectedMachineCSV(r io.Reader) ([]APIExpectedMachineCreateRequest, error) {
+	rows, err := readExpectedDeviceCSV(r, ExpectedMachineCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]APIExpectedMachineCreateRequest, 0, len(rows))
+	rowErrors := validation.Errors{}
+	for i, row := range rows {
+		labels, lerr := row.labels()
+		if lerr != nil {
+			rowErrors[strconv.Itoa(i)] = validation.Errors{"labels": lerr}
+		}
+
+		requests = append(requests, APIExpectedMachineCreateRequest{
+			SiteID:                   row["siteId"],
+			BmcMacAddress:            row["bmcMacAddress"],
+			ChassisSerialNumber:      row["chassisSerialNumber"],
+			SkuID:                    row.optional("skuId"),
+			FallbackDPUSerialNumbers: row.list("fallbackDPUSerialNumbers"),
+			DefaultBmcUsername:       row.optional("defaultBmcUsername"),
+			DefaultBmcPassword:       row.optional("defaultBmcPassword"),
+			Labels:                   labels,
+		})
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	return requests, nil
+}
+
+// ParseExpectedSwitchCSV parses a bulk CSV file into Expected Switch create requests.
+// Rows that cannot be parsed are reported as validation errors keyed by the index of the row, excluding the header.
+func ParseExpectedSwitchCSV(r io.Reader) ([]APIExpectedSwitchCreateRequest, error) {
+	rows, err := readExpectedDeviceCSV(r, ExpectedSwitchCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]APIExpectedSwitchCreateRequest, 0, len(rows))
+	rowErrors := validation.Errors{}
+	for i, row := range rows {
+		labels, lerr := row.labels()
+		if lerr != nil {
+			rowErrors[strconv.Itoa(i)] = validation.Errors{"labels": lerr}
+		}
+
+		requests = append(requests, APIExpectedSwitchCreateRequest{
+			SiteID:             row["siteId"],
+			BmcMacAddress:      row["bmcMacAddress"],
+			SwitchSerialNumber: row["switchSerialNumber"],
+			DefaultBmcUsername: row.optional("defaultBmcUsername"),
+			DefaultBmcPassword: row.optional("defaultBmcPassword"),
+			NvOsUsername:       row.optional("nvOsUsername"),
+			NvOsPassword:       row.optional("nvOsPassword"),
+			Labels:             labels,
+		})
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	return requests, nil
+}
+
+// ParseExpectedPowerShelfCSV parses a bulk CSV file into Expected Power Shelf create requests.
+// Rows that cannot be parsed are reported as validation errors keyed by the index of the row, excluding the header.
+func ParseExpectedPowerShelfCSV(r io.Reader) ([]APIExpectedPowerShelfCreateRequest, error) {
+	rows, err := readExpectedDeviceCSV(r, ExpectedPowerShelfCSVHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	requests := make([]APIExpectedPowerShelfCreateRequest, 0, len(rows))
+	rowErrors := validation.Errors{}
+	for i, row := range rows {
+		labels, lerr := row.labels()
+		if lerr != nil {
+			rowErrors[strconv.Itoa(i)] = validation.Errors{"labels": lerr}
+		}
+
+		requests = append(requests, APIExpectedPowerShelfCreateRequest{
+			SiteID:             row["siteId"],
+			BmcMacAddress:      row["bmcMacAddress"],
+			ShelfSerialNumber:  row["shelfSerialNumber"],
+			IpAddress:          row.optional("ipAddress"),
+			DefaultBmcUsername: row.optional("defaultBmcUsername"),
+			DefaultBmcPassword: row.optional("defaultBmcPassword"),
+			Labels:             labels,
+		})
+	}
+	if len(rowErrors) > 0 {
+		return nil, rowErrors
+	}
+
+	return requests, nil
+}
+
+// ExpectedMachineCSVRecord returns the Expected Machine as a row of a bulk CSV file.
+// Credentials are not stored so their columns are always empty.
+func ExpectedMachineCSVRecord(em *APIExpectedMachine) []string {
+	skuID := ""
+	if em.SkuID != nil {
+		skuID = *em.SkuID
+	}
+
+	return []string{
+		em.SiteID.String(),
+		em.BmcMacAddress,
+		em.ChassisSerialNumber,
+		skuID,
+		strings.Join(em.FallbackDPUSerialNumbers, expectedDeviceCSVListSeparator),
+		"",
+		"",
+		formatExpectedDeviceCSVLabels(em.Labels),
+	}
+}
+
+// ExpectedSwitchCSVRecord returns the Expected Switch as a row of a bulk CSV file.
+// Credentials are not stored so their columns are always empty.
+func ExpectedSwitchCSVRecord(es *APIExpectedSwitch) []string {
+	return []string{
+		es.SiteID.String(),
+		es.BmcMacAddress,
+		es.SwitchSerialNumber,
+		"",
+		"",
+		"",
+		"",
+		formatExpectedDeviceCSVLabels(es.Labels),
+	}
+}
+
+// ExpectedPowerShelfCSVRecord returns the Expected Power Shelf as a row of a bulk CSV file.
+// Credentials are not stored so their columns are always empty.
+func ExpectedPowerShelfCSVRecord(eps *APIExpectedPowerShelf) []string {
+	ipAddress := ""
+	if eps.IpAddress != nil {
+		ipAddress = *eps.IpAddress
+	}
+
+	return []string{
+		eps.SiteID.String(),
+		eps.BmcMacAddress,
+		eps.ShelfSerialNumber,
+		ipAddress,
+		"",
+		"",
+		formatExpectedDeviceCSVLabels(eps.Labels),
+	}
+}
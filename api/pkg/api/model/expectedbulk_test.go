@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+)
+
+func TestParseExpectedMachineCSV(t *testing.T) {
+	siteID := uuid.NewString()
+
+	tests := []struct {
+		name        string
+		data        string
+		want        []APIExpectedMachineCreateRequest
+		wantErr     bool
+		wantRowErrs []string
+	}{
+		{
+			name: "test parse with columns in any order, mixed case header and BOM",
+			data: "\ufeffBmcMacAddress,chassisSerialNumber,SITEID,fallbackDPUSerialNumbers,labels,defaultBmcUsername,defaultBmcPassword\n" +
+				"00:1A:2B:3C:4D:5E,CHASSIS-1," + siteID + ",DPU-1; DPU-2,rack=A1;env=prod,admin,secret\n" +
+				",,,,,,\n" +
+				"00:1A:2B:3C:4D:5F,CHASSIS-2," + siteID + ",,,,\n",
+			want: []APIExpectedMachineCreateRequest{
+				{
+					SiteID:                   siteID,
+					BmcMacAddress:            "00:1A:2B:3C:4D:5E",
+					ChassisSerialNumber:      "CHASSIS-1",
+					FallbackDPUSerialNumbers: []string{"DPU-1", "DPU-2"},
+					DefaultBmcUsername:       cdb.GetStrPtr("admin"),
+					DefaultBmcPassword:       cdb.GetStrPtr("secret"),
+					Labels:                   map[string]string{"rack": "A1", "env": "prod"},
+				},
+				{
+					SiteID:              siteID,
+					BmcMacAddress:       "00:1A:2B:3C:4D:5F",
+					ChassisSerialNumber: "CHASSIS-2",
+				},
+			},
+		},
+		{
+			name: "test parse with only required columns",
+			data: "bmcMacAddress,chassisSerialNumber\n00:1A:2B:3C:4D:5E,CHASSIS-1\n",
+			want: []APIExpectedMachineCreateRequest{
+				{
+					BmcMacAddress:       "00:1A:2B:3C:4D:5E",
+					ChassisSerialNumber: "CHASSIS-1",
+				},
+			},
+		},
+		{
+			name:    "test parse fails on unsupported column",
+			data:    "bmcMacAddress,chassisSerialNumber,rack\n00:1A:2B:3C:4D:5E,CHASSIS-1,A1\n",
+			wantErr: true,
+		},
+		{
+			name:    "test parse fails on duplicate column",
+			data:    "bmcMacAddress,BMCMACADDRESS\n00:1A:2B:3C:4D:5E,00:1A:2B:3C:4D:5E\n",
+			wantErr: true,
+		},
+		{
+			name:    "test parse fails on row with wrong number of fields",
+			data:    "bmcMacAddress,chassisSerialNumber\n00:1A:2B:3C:4D:5E\n",
+			wantErr: true,
+		},
+		{
+			name:    "test parse fails on empty file",
+			data:    "",
+			wantErr: true,
+		},
+		{
+			name:        "test parse reports invalid labels per row",
+			data:        "bmcMacAddress,chassisSerialNumber,labels\n00:1A:2B:3C:4D:5E,CHASSIS-1,rack=A1\n00:1A:2B:3C:4D:5F,CHASSIS-2,rack\n",
+			wantErr:     true,
+			wantRowErrs: []string{"1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseExpectedMachineCSV(strings.NewReader(tc.data))
+			if tc.wantErr {
+				require.Error(t, err)
+				if tc.wantRowErrs != nil {
+					rowErrs, ok := err.(validation.Errors)
+					require.True(t, ok)
+					for _, key := range tc.wantRowErrs {
+						assert.Contains(t, rowErrs, key)
+					}
+					assert.Len(t, rowErrs, len(tc.wantRowErrs))
+				}
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParseExpectedSwitchCSV(t *testing.T) {
+	data := "siteId,bmcMacAddress,switchSerialNumber,nvOsUsername,nvOsPassword\n" +
+		"f97df110-f4de-492e-8849-4a6af68026b0,00:1A:2B:3C:4D:5E,SWITCH-1,nvos,secret\n"
+
+	got, err := ParseExpectedSwitchCSV(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "SWITCH-1", got[0].SwitchSerialNumber)
+	assert.Equal(t, cdb.GetStrPtr("nvos"), got[0].NvOsUsername)
+	assert.Equal(t, cdb.GetStrPtr("secret"), got[0].NvOsPassword)
+	assert.Nil(t, got[0].DefaultBmcUsername)
+	assert.NoError(t, got[0].Validate())
+}
+
+func TestParseExpectedPowerShelfCSV(t *testing.T) {
+	data := "siteId,bmcMacAddress,shelfSerialNumber,ipAddress\n" +
+		"f97df110-f4de-492e-8849-4a6af68026b0,00:1A:2B:3C:4D:5E,SHELF-1,10.0.0.10\n"
+
+	got, err := ParseExpectedPowerShelfCSV(strings.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "SHELF-1", got[0].ShelfSerialNumber)
+	assert.Equal(t, cdb.GetStrPtr("10.0.0.10"), got[0].IpAddress)
+	assert.NoError(t, got[0].Validate())
+}
+
+func TestExpectedDeviceCSVRecord_RoundTrip(t *testing.T) {
+	em := &APIExpectedMachine{
+		SiteID:                   uuid.New(),
+		BmcMacAddress:            "00:1A:2B:3C:4D:5E",
+		ChassisSerialNumber:      "CHASSIS-1",
+		SkuID:                    cdb.GetStrPtr("sku-1"),
+		FallbackDPUSerialNumbers: []string{"DPU-1", "DPU-2"},
+		Labels:                   map[string]string{"rack": "A1", "env": "prod"},
+	}
+
+	record := ExpectedMachineCSVRecord(em)
+	require.Len(t, record, len(ExpectedMachineCSVHeader))
+	assert.Equal(t, "env=prod;rack=A1", record[len(record)-1])
+
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	require.NoError(t, w.Write(ExpectedMachineCSVHeader))
+	require.NoError(t, w.Write(record))
+	w.Flush()
+
+	got, err := ParseExpectedMachineCSV(buf)
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, em.SiteID.String(), got[0].SiteID)
+	assert.Equal(t, em.BmcMacAddress, got[0].BmcMacAddress)
+	assert.Equal(t, em.ChassisSerialNumber, got[0].ChassisSerialNumber)
+	assert.Equal(t, em.SkuID, got[0].SkuID)
+	assert.Equal(t, em.FallbackDPUSerialNumbers, got[0].FallbackDPUSerialNumbers)
+	assert.Equal(t, em.Labels, got[0].Labels)
+	assert.Nil(t, got[0].DefaultBmcPassword)
+
+	assert.Len(t, ExpectedSwitchCSVRecord(&APIExpectedSwitch{}), len(ExpectedSwitchCSVHeader))
+	assert.Len(t, ExpectedPowerShelfCSVRecord(&APIExpectedPowerShelf{}), len(ExpectedPowerShelfCSVHeader))
+}
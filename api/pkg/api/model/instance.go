@@ -349,6 +349,8 @@ type APIInstanceCreateRequest struct {
 	VpcID string `json:"vpcId"`
 	// OperatingSystemID is the ID of the Operating System
 	OperatingSystemID *string `json:"operatingSystemId"`
+	// OperatingSystemVersionID is the ID of the Operating System Version, latest usable version is used if not specified
+	OperatingSystemVersionID *string `json:"operatingSystemVersionId"`
 	// IpxeScript is the iPXE script for the Operating System
 	IpxeScript *string `json:"ipxeScript"`
 	// AlwaysBootWithCustomIpxe is the flag to allow always boot with ipxe
@@ -376,6 +378,10 @@ type APIInstanceCreateRequest struct {
 	MachineID *string `json:"machineId"`
 	// AllowUnhealthyMachine is the flag to allow unhealthy machine when requesting s specific Machine ID
 	AllowUnhealthyMachine *bool `json:"allowUnhealthyMachine"`
+	// TemplateID is the ID of the Instance Template providing attributes not specified in the request
+	TemplateID *string `json:"templateId"`
+	// TemplateVersion is the version of the Instance Template to use, latest version is used if not specified
+	TemplateVersion *int `json:"templateVersion"`
 }
 
 // APIBatchInstanceCreateRequest is the data structure to capture request to create multiple instances in a single request
@@ -395,6 +401,8 @@ type APIBatchInstanceCreateRequest struct {
 	VpcID string `json:"vpcId"`
 	// OperatingSystemID is the ID of the Operating System
 	OperatingSystemID *string `json:"operatingSystemId"`
+	// OperatingSystemVersionID is the ID of the Operating System Version, latest usable version is used if not specified
+	OperatingSystemVersionID *string `json:"operatingSystemVersionId"`
 	// IpxeScript is the iPXE script for the Operating System
 	IpxeScript *string `json:"ipxeScript"`
 	// AlwaysBootWithCustomIpxe is the flag to allow always boot with ipxe
@@ -420,6 +428,10 @@ type APIBatchInstanceCreateRequest struct {
 	// TopologyOptimized indicates whether to enforce rack-aware placement
 	// If true, all instances must be allocated on machines within the same rack or the request will fail
 	TopologyOptimized *bool `json:"topologyOptimized"`
+	// TemplateID is the ID of the Instance Template providing attributes not specified in the request
+	TemplateID *string `json:"templateId"`
+	// TemplateVersion is the version of the Instance Template to use, latest version is used if not specified
+	TemplateVersion *int `json:"templateVersion"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -443,6 +455,12 @@ func (icr APIInstanceCreateRequest) Validate() error {
 			validationis.UUID.Error(validationErrorInvalidUUID)),
 		validation.Field(&icr.OperatingSystemID,
 			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&icr.OperatingSystemVersionID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&icr.TemplateID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&icr.TemplateVersion,
+			validation.When(icr.TemplateVersion != nil, validation.Min(1).Error("must be at least 1"))),
 		validation.Field(&icr.Interfaces,
 			validation.Required.Error("at least one Interface must be specified"),
 			validation.Length(1, MaxInterfaceCount).Error(fmt.Sprintf("at most %v Interfaces can be specified", MaxInterfaceCount))),
@@ -472,6 +490,18 @@ func (icr APIInstanceCreateRequest) Validate() error {
 		}
 	}
 
+	if icr.OperatingSystemVersionID != nil && (icr.OperatingSystemID == nil || *icr.OperatingSystemID == "") {
+		return validation.Errors{
+			"operatingSystemVersionId": errors.New("can only be specified when `operatingSystemId` is specified"),
+		}
+	}
+
+	if icr.TemplateVersion != nil && icr.TemplateID == nil {
+		return validation.Errors{
+			"templateVersion": errors.New("can only be specified when `templateId` is specified"),
+		}
+	}
+
 	// Validate Interfaces
 	err = ValidateInterfaces(&icr.Interfaces)
 	if err != nil {
@@ -801,6 +831,12 @@ func (bicr APIBatchInstanceCreateRequest) Validate() error {
 			validationis.UUID.Error(validationErrorInvalidUUID)),
 		validation.Field(&bicr.OperatingSystemID,
 			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&bicr.OperatingSystemVersionID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&bicr.TemplateID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&bicr.TemplateVersion,
+			validation.When(bicr.TemplateVersion != nil, validation.Min(1).Error("must be at least 1"))),
 		validation.Field(&bicr.Interfaces,
 			validation.Required.Error("at least one Interface must be specified"),
 			validation.Length(1, MaxInterfaceCount).Error(fmt.Sprintf("at most %v Interfaces can be specified", MaxInterfaceCount))),
@@ -823,6 +859,18 @@ func (bicr APIBatchInstanceCreateRequest) Validate() error {
 		}
 	}
 
+	if bicr.OperatingSystemVersionID != nil && (bicr.OperatingSystemID == nil || *bicr.OperatingSystemID == "") {
+		return validation.Errors{
+			"operatingSystemVersionId": errors.New("can only be specified when `operatingSystemId` is specified"),
+		}
+	}
+
+	if bicr.TemplateVersion != nil && bicr.TemplateID == nil {
+		return validation.Errors{
+			"templateVersion": errors.New("can only be specified when `templateId` is specified"),
+		}
+	}
+
 	// Validate Interfaces
 	err = ValidateInterfaces(&bicr.Interfaces)
 	if err != nil {
@@ -1090,6 +1138,8 @@ type APIInstanceUpdateRequest struct {
 	ApplyUpdatesOnReboot *bool `json:"applyUpdatesOnReboot"`
 	// OperatingSystemID is the ID of the Operating System
 	OperatingSystemID *string `json:"operatingSystemId"`
+	// OperatingSystemVersionID is the ID of the Operating System Version, latest usable version is used if not specified
+	OperatingSystemVersionID *string `json:"operatingSystemVersionId"`
 	// IpxeScript is the iPXE script for the Operating System
 	IpxeScript *string `json:"ipxeScript"`
 	// UserData is the user-date to be used when booting; e.g., a cloud-init config
@@ -1382,6 +1432,7 @@ func (iur *APIInstanceUpdateRequest) IsUpdateRequest() bool {
 		iur.Description != nil ||
 		iur.Labels != nil ||
 		iur.OperatingSystemID != nil ||
+		iur.OperatingSystemVersionID != nil ||
 		iur.IpxeScript != nil ||
 		iur.UserData != nil ||
 		iur.PhoneHomeEnabled != nil ||
@@ -1416,6 +1467,9 @@ func (iur APIInstanceUpdateRequest) Validate() error {
 		validation.Field(&iur.OperatingSystemID,
 			validationis.UUID.Error(validationErrorInvalidUUID),
 		),
+		validation.Field(&iur.OperatingSystemVersionID,
+			validationis.UUID.Error(validationErrorInvalidUUID),
+		),
 		validation.Field(&iur.Interfaces,
 			validation.When(len(iur.Interfaces) > 0, validation.Length(1, MaxInterfaceCount).Error(fmt.Sprintf("at most %v Interfaces can be specified", MaxInterfaceCount))),
 		),
@@ -1431,6 +1485,12 @@ func (iur APIInstanceUpdateRequest) Validate() error {
 		}
 	}
 
+	if iur.OperatingSystemVersionID != nil && iur.OperatingSystemID != nil && *iur.OperatingSystemID == "" {
+		return validation.Errors{
+			"operatingSystemVersionId": errors.New("cannot be specified when `operatingSystemId` is being cleared"),
+		}
+	}
+
 	// NOTE: Deeper validation takes place in ValidateAndSetOperatingSystemData and in ValidateInfiniBandInterfaces
 	if !iur.IsRebootRequest() {
 		if iur.RebootWithCustomIpxe != nil && *iur.RebootWithCustomIpxe {
@@ -1622,6 +1682,12 @@ type APIInstance struct {
 	Machine *APIMachineSummary `json:"machine,omitempty"`
 	// OperatingSystemID is the ID of the OperatingSystem
 	OperatingSystemID *string `json:"operatingSystemId"`
+	// OperatingSystemVersionID is the ID of the OperatingSystem Version the Instance was provisioned with
+	OperatingSystemVersionID *string `json:"operatingSystemVersionId"`
+	// InstanceTemplateID is the ID of the Instance Template the Instance was created from
+	InstanceTemplateID *string `json:"instanceTemplateId"`
+	// InstanceTemplateVersion is the version of the Instance Template the Instance was created from
+	InstanceTemplateVersion *int `json:"instanceTemplateVersion"`
 	// OperatingSystem is the summary of the OperatingSystem
 	OperatingSystem *APIOperatingSystemSummary `json:"operatingSystem,omitempty"`
 	// ipxeScript is an attribute which is inherited from Operating System
@@ -1704,6 +1770,15 @@ func NewAPIInstance(dbinst *cdbm.Instance, dbSite *cdbm.Site, dbiss []cdbm.Inter
 		apiInstance.OperatingSystemID = cdb.GetStrPtr(dbinst.OperatingSystemID.String())
 	}
 
+	if dbinst.OperatingSystemVersionID != nil {
+		apiInstance.OperatingSystemVersionID = cdb.GetStrPtr(dbinst.OperatingSystemVersionID.String())
+	}
+
+	if dbinst.InstanceTemplateID != nil {
+		apiInstance.InstanceTemplateID = cdb.GetStrPtr(dbinst.InstanceTemplateID.String())
+		apiInstance.InstanceTemplateVersion = dbinst.InstanceTemplateVersion
+	}
+
 	if dbinst.ControllerInstanceID != nil {
 		apiInstance.ControllerInstanceID = dbinst.ControllerInstanceID.String()
 	}
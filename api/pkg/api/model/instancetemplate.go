@@ -0,0 +1,454 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+// validateInstanceTemplateLabels ensures that labels are within the allowed count and length limits
+func validateInstanceTemplateLabels(value interface{}) error {
+	labels, _ := value.(map[string]string)
+	if len(labels) > util.LabelCountMax {
+		return util.ErrValidationLabelCount
+	}
+
+	for key, value := range labels {
+		if key == "" {
+			return util.ErrValidationLabelKeyEmpty
+		}
+		if len(key) > util.LabelKeyMaxLength {
+			return util.ErrValidationLabelKeyLength
+		}
+		if len(value) > util.LabelValueMaxLength {
+			return util.ErrValidationLabelValueLength
+		}
+	}
+
+	return nil
+}
+
+// APIInstanceTemplateConfig is the set of Instance attributes held by an InstanceTemplate version.
+// Any subset of the attributes can be specified, field names match the Instance create request.
+type APIInstanceTemplateConfig struct {
+	// Description is the description of the Instances
+	Description *string `json:"description,omitempty"`
+	// InstanceTypeID is the ID of the Instance Type
+	InstanceTypeID *string `json:"instanceTypeId,omitempty"`
+	// VpcID is the ID of the VPC containing the Instances
+	VpcID *string `json:"vpcId,omitempty"`
+	// OperatingSystemID is the ID of the Operating System
+	OperatingSystemID *string `json:"operatingSystemId,omitempty"`
+	// OperatingSystemVersionID is the ID of the Operating System Version
+	OperatingSystemVersionID *string `json:"operatingSystemVersionId,omitempty"`
+	// IpxeScript is the iPXE script for the Operating System
+	IpxeScript *string `json:"ipxeScript,omitempty"`
+	// AlwaysBootWithCustomIpxe is the flag to allow always boot with ipxe
+	AlwaysBootWithCustomIpxe *bool `json:"alwaysBootWithCustomIpxe,omitempty"`
+	// PhoneHomeEnabled is the flag to enable phone home for the Instances
+	PhoneHomeEnabled *bool `json:"phoneHomeEnabled,omitempty"`
+	// UserData is the user data for the Instances
+	UserData *string `json:"userData,omitempty"`
+	// Interfaces is the list of Interfaces to create for the Instances
+	Interfaces []APIInterfaceCreateOrUpdateRequest `json:"interfaces,omitempty"`
+	// InfiniBandInterfaces is the list of InfiniBandInterface to create for the Instances
+	InfiniBandInterfaces []APIInfiniBandInterfaceCreateOrUpdateRequest `json:"infinibandInterfaces,omitempty"`
+	// NVLinkInterfaces is the list of NVLinkInterface to create for the Instances
+	NVLinkInterfaces []APINVLinkInterfaceCreateOrUpdateRequest `json:"nvLinkInterfaces,omitempty"`
+	// DpuExtensionServiceDeployments is the list of DpuExtensionServiceDeployments to create for the Instances
+	DpuExtensionServiceDeployments []APIDpuExtensionServiceDeploymentRequest `json:"dpuExtensionServiceDeployments,omitempty"`
+	// SSHKeyGroupIDs is a list of SSHKeyGroup IDs
+	SSHKeyGroupIDs []string `json:"sshKeyGroupIds,omitempty"`
+	// Labels is a key value objects applied to the Instances
+	Labels map[string]string `json:"labels,omitempty"`
+	// NetworkSecurityGroupID is the ID of a desired NSG to attach to the Instances
+	NetworkSecurityGroupID *string `json:"networkSecurityGroupId,omitempty"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+// Attributes are checked individually, combined validation happens when the template is used to create Instances
+func (itc APIInstanceTemplateConfig) Validate() error {
+	err := validation.ValidateStruct(&itc,
+		validation.Field(&itc.Description,
+			validation.When(itc.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+		validation.Field(&itc.InstanceTypeID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&itc.VpcID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&itc.OperatingSystemID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&itc.OperatingSystemVersionID,
+			validationis.UUID.Error(validationErrorInvalidUUID)),
+		validation.Field(&itc.NetworkSecurityGroupID,
+			validation.When(itc.NetworkSecurityGroupID != nil, validation.Required.Error(validationErrorValueRequired))),
+		validation.Field(&itc.Interfaces,
+			validation.Length(0, MaxInterfaceCount).Error(fmt.Sprintf("at most %v Interfaces can be specified", MaxInterfaceCount))),
+		validation.Field(&itc.Labels,
+			validation.By(validateInstanceTemplateLabels)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if itc.OperatingSystemID != nil && itc.IpxeScript != nil {
+		return validation.Errors{
+			"ipxeScript": errors.New("only one of `operatingSystemId` or `ipxeScript` can be specified"),
+		}
+	}
+
+	if itc.OperatingSystemVersionID != nil && itc.OperatingSystemID == nil {
+		return validation.Errors{
+			"operatingSystemVersionId": errors.New("can only be specified when `operatingSystemId` is specified"),
+		}
+	}
+
+	// Validate Interfaces, a copy is used as validation sets defaults on the Interfaces
+	if len(itc.Interfaces) > 0 {
+		ifcs := append([]APIInterfaceCreateOrUpdateRequest{}, itc.Interfaces...)
+		err = ValidateInterfaces(&ifcs)
+		if err != nil {
+			return validation.Errors{"interfaces": err}
+		}
+	}
+
+	for _, ibic := range itc.InfiniBandInterfaces {
+		err = ibic.Validate()
+		if err != nil {
+			return validation.Errors{"infinibandInterfaces": err}
+		}
+	}
+
+	for _, nvlifc := range itc.NVLinkInterfaces {
+		err = nvlifc.Validate()
+		if err != nil {
+			return validation.Errors{"nvLinkInterfaces": err}
+		}
+	}
+
+	err = ValidateDpuExtensionServiceDeployments(itc.DpuExtensionServiceDeployments)
+	if err != nil {
+		return err
+	}
+
+	for _, skgID := range itc.SSHKeyGroupIDs {
+		err = validationis.UUID.Validate(skgID)
+		if err != nil {
+			return validation.Errors{"sshKeyGroupIds": errors.New(validationErrorInvalidUUID)}
+		}
+	}
+
+	return nil
+}
+
+// ToMap converts the config to its stored representation
+func (itc APIInstanceTemplateConfig) ToMap() (map[string]interface{}, error) {
+	bytes, err := json.Marshal(itc)
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]interface{}{}
+	err = json.Unmarshal(bytes, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// mergeLabels returns template labels overlaid with request labels
+func (itc APIInstanceTemplateConfig) mergeLabels(labels map[string]string) map[string]string {
+	if len(itc.Labels) == 0 {
+		return labels
+	}
+
+	merged := map[string]string{}
+	for key, value := range itc.Labels {
+		merged[key] = value
+	}
+	for key, value := range labels {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+// ApplyToInstanceCreateRequest sets attributes from the template that are not specified in the request.
+// Operating System attributes are applied together and only when the request specifies neither
+// `operatingSystemId` nor `ipxeScript`. Instance Type is not applied when the request specifies a Machine.
+// An empty list in the request overrides the template list, labels are merged with request labels taking precedence.
+func (itc APIInstanceTemplateConfig) ApplyToInstanceCreateRequest(icr *APIInstanceCreateRequest) {
+	if icr.Description == nil {
+		icr.Description = itc.Description
+	}
+	if icr.InstanceTypeID == nil && icr.MachineID == nil {
+		icr.InstanceTypeID = itc.InstanceTypeID
+	}
+	if icr.VpcID == "" && itc.VpcID != nil {
+		icr.VpcID = *itc.VpcID
+	}
+	if icr.OperatingSystemID == nil && icr.IpxeScript == nil {
+		icr.OperatingSystemID = itc.OperatingSystemID
+		icr.IpxeScript = itc.IpxeScript
+		if icr.OperatingSystemVersionID == nil {
+			icr.OperatingSystemVersionID = itc.OperatingSystemVersionID
+		}
+	}
+	if icr.AlwaysBootWithCustomIpxe == nil {
+		icr.AlwaysBootWithCustomIpxe = itc.AlwaysBootWithCustomIpxe
+	}
+	if icr.PhoneHomeEnabled == nil {
+		icr.PhoneHomeEnabled = itc.PhoneHomeEnabled
+	}
+	if icr.UserData == nil {
+		icr.UserData = itc.UserData
+	}
+	if icr.Interfaces == nil {
+		icr.Interfaces = append([]APIInterfaceCreateOrUpdateRequest{}, itc.Interfaces...)
+	}
+	if icr.InfiniBandInterfaces == nil {
+		icr.InfiniBandInterfaces = itc.InfiniBandInterfaces
+	}
+	if icr.NVLinkInterfaces == nil {
+		icr.NVLinkInterfaces = itc.NVLinkInterfaces
+	}
+	if icr.DpuExtensionServiceDeployments == nil {
+		icr.DpuExtensionServiceDeployments = itc.DpuExtensionServiceDeployments
+	}
+	if icr.SSHKeyGroupIDs == nil {
+		icr.SSHKeyGroupIDs = itc.SSHKeyGroupIDs
+	}
+	if icr.NetworkSecurityGroupID == nil {
+		icr.NetworkSecurityGroupID = itc.NetworkSecurityGroupID
+	}
+	icr.Labels = itc.mergeLabels(icr.Labels)
+}
+
+// ApplyToBatchInstanceCreateRequest sets attributes from the template that are not specified in the batch request.
+// Attributes are applied the same way as for a single Instance create request.
+func (itc APIInstanceTemplateConfig) ApplyToBatchInstanceCreateRequest(bicr *APIBatchInstanceCreateRequest) {
+	if bicr.Description == nil {
+		bicr.Description = itc.Description
+	}
+	if bicr.InstanceTypeID == "" && itc.InstanceTypeID != nil {
+		bicr.InstanceTypeID = *itc.InstanceTypeID
+	}
+	if bicr.VpcID == "" && itc.VpcID != nil {
+		bicr.VpcID = *itc.VpcID
+	}
+	if bicr.OperatingSystemID == nil && bicr.IpxeScript == nil {
+		bicr.OperatingSystemID = itc.OperatingSystemID
+		bicr.IpxeScript = itc.IpxeScript
+		if bicr.OperatingSystemVersionID == nil {
+			bicr.OperatingSystemVersionID = itc.OperatingSystemVersionID
+		}
+	}
+	if bicr.AlwaysBootWithCustomIpxe == nil {
+		bicr.AlwaysBootWithCustomIpxe = itc.AlwaysBootWithCustomIpxe
+	}
+	if bicr.PhoneHomeEnabled == nil {
+		bicr.PhoneHomeEnabled = itc.PhoneHomeEnabled
+	}
+	if bicr.UserData == nil {
+		bicr.UserData = itc.UserData
+	}
+	if bicr.Interfaces == nil {
+		bicr.Interfaces = append([]APIInterfaceCreateOrUpdateRequest{}, itc.Interfaces...)
+	}
+	if bicr.InfiniBandInterfaces == nil {
+		bicr.InfiniBandInterfaces = itc.InfiniBandInterfaces
+	}
+	if bicr.NVLinkInterfaces == nil {
+		bicr.NVLinkInterfaces = itc.NVLinkInterfaces
+	}
+	if bicr.DpuExtensionServiceDeployments == nil {
+		bicr.DpuExtensionServiceDeployments = itc.DpuExtensionServiceDeployments
+	}
+	if bicr.SSHKeyGroupIDs == nil {
+		bicr.SSHKeyGroupIDs = itc.SSHKeyGroupIDs
+	}
+	if bicr.NetworkSecurityGroupID == nil {
+		bicr.NetworkSecurityGroupID = itc.NetworkSecurityGroupID
+	}
+	bicr.Labels = itc.mergeLabels(bicr.Labels)
+}
+
+// NewAPIInstanceTemplateConfig accepts the stored config of a DB layer InstanceTemplateVersion and returns an API object
+func NewAPIInstanceTemplateConfig(config map[string]interface{}) (*APIInstanceTemplateConfig, error) {
+	bytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	itc := &APIInstanceTemplateConfig{}
+	err = json.Unmarshal(bytes, itc)
+	if err != nil {
+		return nil, err
+	}
+
+	return itc, nil
+}
+
+// APIInstanceTemplateCreateRequest is the data structure to capture user request to create a new InstanceTemplate
+type APIInstanceTemplateCreateRequest struct {
+	// Name is the name of the InstanceTemplate
+	Name string `json:"name"`
+	// Description is the description of the InstanceTemplate
+	Description *string `json:"description"`
+	// Config is the set of Instance attributes for the first version of the InstanceTemplate
+	Config APIInstanceTemplateConfig `json:"config"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (itcr APIInstanceTemplateCreateRequest) Validate() error {
+	err := validation.ValidateStruct(&itcr,
+		validation.Field(&itcr.Name,
+			validation.Required.Error(validationErrorStringLength),
+			validation.By(util.ValidateNameCharacters),
+			validation.Length(2, 256).Error(validationErrorStringLength)),
+		validation.Field(&itcr.Description,
+			validation.When(itcr.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+	)
+	if err != nil {
+		return err
+	}
+
+	return itcr.Config.Validate()
+}
+
+// APIInstanceTemplateUpdateRequest is the data structure to capture user request to update an InstanceTemplate
+type APIInstanceTemplateUpdateRequest struct {
+	// Name is the name of the InstanceTemplate
+	Name *string `json:"name"`
+	// Description is the description of the InstanceTemplate
+	Description *string `json:"description"`
+	// Config replaces the set of Instance attributes, a new version of the InstanceTemplate is created
+	Config *APIInstanceTemplateConfig `json:"config"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (itur APIInstanceTemplateUpdateRequest) Validate() error {
+	if itur.Name == nil && itur.Description == nil && itur.Config == nil {
+		return validation.Errors{
+			validationCommonErrorField: errors.New("at least one of name, description or config must be specified"),
+		}
+	}
+
+	err := validation.ValidateStruct(&itur,
+		validation.Field(&itur.Name,
+			validation.When(itur.Name != nil, validation.Required.Error(validationErrorStringLength)),
+			validation.When(itur.Name != nil, validation.By(util.ValidateNameCharacters)),
+			validation.When(itur.Name != nil, validation.Length(2, 256).Error(validationErrorStringLength))),
+		validation.Field(&itur.Description,
+			validation.When(itur.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+	)
+	if err != nil {
+		return err
+	}
+
+	if itur.Config != nil {
+		return itur.Config.Validate()
+	}
+
+	return nil
+}
+
+// APIInstanceTemplate is the data structure to capture API representation of an InstanceTemplate
+type APIInstanceTemplate struct {
+	// ID is the unique UUID v4 identifier for the InstanceTemplate
+	ID string `json:"id"`
+	// Name is the name of the InstanceTemplate
+	Name string `json:"name"`
+	// Description is the description of the InstanceTemplate
+	Description *string `json:"description"`
+	// TenantID is the ID of the Tenant
+	TenantID string `json:"tenantId"`
+	// Tenant is the summary of the tenant
+	Tenant *APITenantSummary `json:"tenant,omitempty"`
+	// Version is the latest version of the InstanceTemplate
+	Version int `json:"version"`
+	// Config is the set of Instance attributes of the latest version
+	Config map[string]interface{} `json:"config"`
+	// Created indicates the ISO datetime string for when the InstanceTemplate was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the InstanceTemplate was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIInstanceTemplate accepts a DB layer InstanceTemplate object and its latest version and returns an API object
+func NewAPIInstanceTemplate(dit *cdbm.InstanceTemplate, ditv *cdbm.InstanceTemplateVersion) *APIInstanceTemplate {
+	apiit := &APIInstanceTemplate{
+		ID:          dit.ID.String(),
+		Name:        dit.Name,
+		Description: dit.Description,
+		TenantID:    dit.TenantID.String(),
+		Version:     dit.Version,
+		Config:      map[string]interface{}{},
+		Created:     dit.Created,
+		Updated:     dit.Updated,
+	}
+
+	if ditv != nil && ditv.Config != nil {
+		apiit.Config = ditv.Config
+	}
+
+	if dit.Tenant != nil {
+		apiit.Tenant = NewAPITenantSummary(dit.Tenant)
+	}
+
+	return apiit
+}
+
+// APIInstanceTemplateVersion is the data structure to capture API representation of an InstanceTemplateVersion
+type APIInstanceTemplateVersion struct {
+	// ID is the unique UUID v4 identifier for the InstanceTemplateVersion
+	ID string `json:"id"`
+	// InstanceTemplateID is the ID of the InstanceTemplate
+	InstanceTemplateID string `json:"instanceTemplateId"`
+	// Version is the version number, starting at 1
+	Version int `json:"version"`
+	// Config is the set of Instance attributes of the version
+	Config map[string]interface{} `json:"config"`
+	// Created indicates the ISO datetime string for when the InstanceTemplateVersion was created
+	Created time.Time `json:"created"`
+}
+
+// NewAPIInstanceTemplateVersion accepts a DB layer InstanceTemplateVersion object and returns an API object
+func NewAPIInstanceTemplateVersion(ditv *cdbm.InstanceTemplateVersion) *APIInstanceTemplateVersion {
+	apiitv := &APIInstanceTemplateVersion{
+		ID:                 ditv.ID.String(),
+		InstanceTemplateID: ditv.InstanceTemplateID.String(),
+		Version:            ditv.Version,
+		Config:             ditv.Config,
+		Created:            ditv.Created,
+	}
+
+	if apiitv.Config == nil {
+		apiitv.Config = map[string]interface{}{}
+	}
+
+	return apiitv
+}
@@ -0,0 +1,248 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIInstanceTemplateCreateRequest_Validate(t *testing.T) {
+	subnetID := uuid.NewString()
+
+	tests := []struct {
+		desc      string
+		obj       APIInstanceTemplateCreateRequest
+		expectErr bool
+	}{
+		{
+			desc:      "ok when only name is provided",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker"},
+			expectErr: false,
+		},
+		{
+			desc: "ok when config is provided",
+			obj: APIInstanceTemplateCreateRequest{
+				Name: "gpu-worker",
+				Config: APIInstanceTemplateConfig{
+					InstanceTypeID:    cdb.GetStrPtr(uuid.NewString()),
+					VpcID:             cdb.GetStrPtr(uuid.NewString()),
+					OperatingSystemID: cdb.GetStrPtr(uuid.NewString()),
+					Interfaces:        []APIInterfaceCreateOrUpdateRequest{{SubnetID: &subnetID}},
+					SSHKeyGroupIDs:    []string{uuid.NewString()},
+					Labels:            map[string]string{"role": "worker"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			desc:      "error when name is not provided",
+			obj:       APIInstanceTemplateCreateRequest{Config: APIInstanceTemplateConfig{VpcID: cdb.GetStrPtr(uuid.NewString())}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when VPC ID is not a UUID",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker", Config: APIInstanceTemplateConfig{VpcID: cdb.GetStrPtr("vpc")}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when both operating system and iPXE script are provided",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker", Config: APIInstanceTemplateConfig{OperatingSystemID: cdb.GetStrPtr(uuid.NewString()), IpxeScript: cdb.GetStrPtr("#ipxe")}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when operating system version is provided without operating system",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker", Config: APIInstanceTemplateConfig{OperatingSystemVersionID: cdb.GetStrPtr(uuid.NewString())}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when SSH key group ID is not a UUID",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker", Config: APIInstanceTemplateConfig{SSHKeyGroupIDs: []string{"keys"}}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when label key is empty",
+			obj:       APIInstanceTemplateCreateRequest{Name: "gpu-worker", Config: APIInstanceTemplateConfig{Labels: map[string]string{"": "worker"}}},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIInstanceTemplateUpdateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIInstanceTemplateUpdateRequest
+		expectErr bool
+	}{
+		{
+			desc:      "ok when only name is provided",
+			obj:       APIInstanceTemplateUpdateRequest{Name: cdb.GetStrPtr("gpu-worker")},
+			expectErr: false,
+		},
+		{
+			desc:      "ok when only config is provided",
+			obj:       APIInstanceTemplateUpdateRequest{Config: &APIInstanceTemplateConfig{UserData: cdb.GetStrPtr("#cloud-config")}},
+			expectErr: false,
+		},
+		{
+			desc:      "error when nothing is provided",
+			obj:       APIInstanceTemplateUpdateRequest{},
+			expectErr: true,
+		},
+		{
+			desc:      "error when config is invalid",
+			obj:       APIInstanceTemplateUpdateRequest{Config: &APIInstanceTemplateConfig{InstanceTypeID: cdb.GetStrPtr("type")}},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIInstanceTemplateConfig_ApplyToInstanceCreateRequest(t *testing.T) {
+	subnetID := uuid.NewString()
+	itc := APIInstanceTemplateConfig{
+		Description:       cdb.GetStrPtr("from template"),
+		InstanceTypeID:    cdb.GetStrPtr(uuid.NewString()),
+		VpcID:             cdb.GetStrPtr(uuid.NewString()),
+		OperatingSystemID: cdb.GetStrPtr(uuid.NewString()),
+		UserData:          cdb.GetStrPtr("#cloud-config"),
+		Interfaces:        []APIInterfaceCreateOrUpdateRequest{{SubnetID: &subnetID}},
+		Labels:            map[string]string{"role": "worker", "team": "infra"},
+	}
+
+	// Template attributes fill in the request
+	icr := APIInstanceCreateRequest{
+		Name:     "worker-1",
+		TenantID: uuid.NewString(),
+		Labels:   map[string]string{"team": "ml"},
+	}
+	itc.ApplyToInstanceCreateRequest(&icr)
+	assert.Equal(t, itc.Description, icr.Description)
+	assert.Equal(t, itc.InstanceTypeID, icr.InstanceTypeID)
+	assert.Equal(t, *itc.VpcID, icr.VpcID)
+	assert.Equal(t, itc.OperatingSystemID, icr.OperatingSystemID)
+	assert.Equal(t, itc.UserData, icr.UserData)
+	assert.Equal(t, itc.Interfaces, icr.Interfaces)
+	assert.Equal(t, map[string]string{"role": "worker", "team": "ml"}, icr.Labels)
+	assert.NoError(t, icr.Validate())
+
+	// Validation of the merged request must not change the template
+	assert.False(t, itc.Interfaces[0].IsPhysical)
+
+	// Request attributes override template attributes
+	machineID := "fm100ht038bg3qsho433vkg684heguv282qaggmrsh2ugn1qk096n2c6hcg"
+	icr = APIInstanceCreateRequest{
+		Name:       "worker-2",
+		TenantID:   uuid.NewString(),
+		VpcID:      uuid.NewString(),
+		MachineID:  &machineID,
+		IpxeScript: cdb.GetStrPtr("#ipxe"),
+		Interfaces: []APIInterfaceCreateOrUpdateRequest{},
+	}
+	vpcID := icr.VpcID
+	itc.ApplyToInstanceCreateRequest(&icr)
+	assert.Equal(t, vpcID, icr.VpcID)
+	assert.Nil(t, icr.InstanceTypeID)
+	assert.Nil(t, icr.OperatingSystemID)
+	assert.Equal(t, "#ipxe", *icr.IpxeScript)
+	assert.Len(t, icr.Interfaces, 0)
+}
+
+func TestAPIInstanceTemplateConfig_ApplyToBatchInstanceCreateRequest(t *testing.T) {
+	itc := APIInstanceTemplateConfig{
+		InstanceTypeID:   cdb.GetStrPtr(uuid.NewString()),
+		IpxeScript:       cdb.GetStrPtr("#ipxe"),
+		PhoneHomeEnabled: cdb.GetBoolPtr(true),
+	}
+
+	bicr := APIBatchInstanceCreateRequest{
+		NamePrefix:       "worker",
+		Count:            2,
+		PhoneHomeEnabled: cdb.GetBoolPtr(false),
+	}
+	itc.ApplyToBatchInstanceCreateRequest(&bicr)
+	assert.Equal(t, *itc.InstanceTypeID, bicr.InstanceTypeID)
+	assert.Equal(t, itc.IpxeScript, bicr.IpxeScript)
+	assert.False(t, *bicr.PhoneHomeEnabled)
+	assert.Nil(t, bicr.Labels)
+}
+
+func TestAPIInstanceTemplateConfig_ToMap(t *testing.T) {
+	itc := APIInstanceTemplateConfig{
+		VpcID:          cdb.GetStrPtr(uuid.NewString()),
+		SSHKeyGroupIDs: []string{uuid.NewString()},
+	}
+
+	config, err := itc.ToMap()
+	require.NoError(t, err)
+	assert.Len(t, config, 2)
+	assert.Equal(t, *itc.VpcID, config["vpcId"])
+
+	got, err := NewAPIInstanceTemplateConfig(config)
+	require.NoError(t, err)
+	assert.Equal(t, itc, *got)
+}
+
+func TestNewAPIInstanceTemplate(t *testing.T) {
+	dit := &cdbm.InstanceTemplate{
+		ID:          uuid.New(),
+		Name:        "gpu-worker",
+		Description: cdb.GetStrPtr("GPU worker nodes"),
+		TenantID:    uuid.New(),
+		Version:     2,
+		Created:     time.Now(),
+		Updated:     time.Now(),
+	}
+	ditv := &cdbm.InstanceTemplateVersion{
+		ID:                 uuid.New(),
+		InstanceTemplateID: dit.ID,
+		Version:            2,
+		Config:             map[string]interface{}{"userData": "#cloud-config"},
+		Created:            time.Now(),
+	}
+
+	apiit := NewAPIInstanceTemplate(dit, ditv)
+	assert.Equal(t, dit.ID.String(), apiit.ID)
+	assert.Equal(t, 2, apiit.Version)
+	assert.Equal(t, ditv.Config, apiit.Config)
+	assert.Nil(t, apiit.Tenant)
+
+	apiit = NewAPIInstanceTemplate(dit, nil)
+	assert.Equal(t, map[string]interface{}{}, apiit.Config)
+
+	apiitv := NewAPIInstanceTemplateVersion(ditv)
+	assert.Equal(t, dit.ID.String(), apiitv.InstanceTemplateID)
+	assert.Equal(t, 2, apiitv.Version)
+}
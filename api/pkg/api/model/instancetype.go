@@ -20,6 +20,7 @@ package model
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -29,6 +30,11 @@ import (
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 )
 
+var (
+	// ErrValidationAutoAssignWithoutCapabilities is the error when auto assignment is enabled for an Instance Type without Machine Capabilities
+	ErrValidationAutoAssignWithoutCapabilities = errors.New("auto assignment requires at least one Machine Capability")
+)
+
 // APIInstanceTypeCreateRequest is the data structure to capture user request to create a new InstanceType
 type APIInstanceTypeCreateRequest struct {
 	// Name is the name of the InstanceType
@@ -43,6 +49,8 @@ type APIInstanceTypeCreateRequest struct {
 	ControllerMachineType *string `json:"controllerMachineType"`
 	// MachineCapabilities is the list of Machine Capabilities to match
 	MachineCapabilities []APIMachineCapability `json:"machineCapabilities"`
+	// AutoAssign specifies whether Machines matching the Machine Capabilities are assigned to the Instance Type automatically
+	AutoAssign *bool `json:"autoAssign"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -141,6 +149,13 @@ func (itcr APIInstanceTypeCreateRequest) Validate() error {
 
 	}
 
+	// Auto assignment would match every Machine without Capabilities to match against
+	if itcr.AutoAssign != nil && *itcr.AutoAssign && len(itcr.MachineCapabilities) == 0 {
+		return validation.Errors{
+			"autoAssign": ErrValidationAutoAssignWithoutCapabilities,
+		}
+	}
+
 	return nil
 }
 
@@ -154,6 +169,8 @@ type APIInstanceTypeUpdateRequest struct {
 	Labels map[string]string `json:"labels"`
 	// MachineCapabilities is the list of Machine Capabilities to match
 	MachineCapabilities []APIMachineCapability `json:"machineCapabilities"`
+	// AutoAssign specifies whether Machines matching the Machine Capabilities are assigned to the Instance Type automatically
+	AutoAssign *bool `json:"autoAssign"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -282,6 +299,8 @@ type APIInstanceType struct {
 	Status string `json:"status"`
 	// StatusHistory is the history of statuses for the Instance Type
 	StatusHistory []APIStatusDetail `json:"statusHistory"`
+	// AutoAssign specifies whether matching Machines are assigned to the Instance Type automatically
+	AutoAssign bool `json:"autoAssign"`
 	// Created is the date and time the entity was created
 	Created time.Time `json:"created"`
 	// Updated is the date and time the entity was last updated
@@ -303,6 +322,7 @@ func NewAPIInstanceType(dbit *cdbm.InstanceType, dbsds []cdbm.StatusDetail, mcs
 		SiteID:                   dbit.SiteID.String(),
 		Labels:                   dbit.Labels,
 		Status:                   dbit.Status,
+		AutoAssign:               dbit.AutoAssign,
 		Created:                  dbit.Created,
 		Updated:                  dbit.Updated,
 	}
@@ -380,3 +400,51 @@ type APIAllocationStats struct {
 	// MaxAllocatable is the maximum number of Machines of this Instance Type that can be allocated to a Tenant
 	MaxAllocatable *int `json:"maxAllocatable,omitempty"`
 }
+
+// APIMachineNearMiss is the data structure to capture a Machine that almost matches an Instance Type
+type APIMachineNearMiss struct {
+	// MachineID is the ID of the Machine
+	MachineID string `json:"machineId"`
+	// Reasons is the list of Capability mismatches that prevent the Machine from matching
+	Reasons []string `json:"reasons"`
+}
+
+// APIInstanceTypeMatch is the data structure to capture Machines that match the Capabilities of an Instance Type
+type APIInstanceTypeMatch struct {
+	// InstanceTypeID is the ID of the Instance Type
+	InstanceTypeID string `json:"instanceTypeId"`
+	// AutoAssign specifies whether matching Machines are assigned to the Instance Type automatically
+	AutoAssign bool `json:"autoAssign"`
+	// MatchedMachineIDs is the list of unassigned Machines whose Capabilities match the Instance Type
+	MatchedMachineIDs []string `json:"matchedMachineIds"`
+	// NearMisses is the list of unassigned Machines that fail to match on a few Capabilities
+	NearMisses []APIMachineNearMiss `json:"nearMisses"`
+}
+
+// NewAPIInstanceTypeMatch accepts an Instance Type and the Capability mismatches of each candidate Machine
+// and returns an API layer object
+func NewAPIInstanceTypeMatch(dbit *cdbm.InstanceType, mismatchesByMachineID map[string][]string) *APIInstanceTypeMatch {
+	apiitm := &APIInstanceTypeMatch{
+		InstanceTypeID:    dbit.ID.String(),
+		AutoAssign:        dbit.AutoAssign,
+		MatchedMachineIDs: []string{},
+		NearMisses:        []APIMachineNearMiss{},
+	}
+
+	machineIDs := make([]string, 0, len(mismatchesByMachineID))
+	for machineID := range mismatchesByMachineID {
+		machineIDs = append(machineIDs, machineID)
+	}
+	sort.Strings(machineIDs)
+
+	for _, machineID := range machineIDs {
+		mismatches := mismatchesByMachineID[machineID]
+		if len(mismatches) == 0 {
+			apiitm.MatchedMachineIDs = append(apiitm.MatchedMachineIDs, machineID)
+		} else if len(mismatches) <= cdbm.MachineCapabilityNearMissMismatchMax {
+			apiitm.NearMisses = append(apiitm.NearMisses, APIMachineNearMiss{MachineID: machineID, Reasons: mismatches})
+		}
+	}
+
+	return apiitm
+}
@@ -177,6 +177,7 @@ func TestAPIInstanceTypeCreateRequest_Validate(t *testing.T) {
 		Labels                map[string]string
 		ControllerMachineType *string
 		MachineCapabilities   []APIMachineCapability
+		AutoAssign            *bool
 	}
 	tests := []struct {
 		name    string
@@ -303,6 +304,31 @@ func TestAPIInstanceTypeCreateRequest_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "test valid Instance Type create request with auto assignment",
+			fields: fields{
+				Name:   "test-name",
+				SiteID: uuid.New().String(),
+				MachineCapabilities: []APIMachineCapability{
+					{
+						Type:  cdbm.MachineCapabilityTypeGPU,
+						Name:  "NVIDIA H100",
+						Count: cdb.GetIntPtr(8),
+					},
+				},
+				AutoAssign: cdb.GetBoolPtr(true),
+			},
+			wantErr: false,
+		},
+		{
+			name: "test invalid Instance Type create request - auto assignment without Machine Capabilities",
+			fields: fields{
+				Name:       "test-name",
+				SiteID:     uuid.New().String(),
+				AutoAssign: cdb.GetBoolPtr(true),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -313,6 +339,7 @@ func TestAPIInstanceTypeCreateRequest_Validate(t *testing.T) {
 				Labels:                tt.fields.Labels,
 				ControllerMachineType: tt.fields.ControllerMachineType,
 				MachineCapabilities:   tt.fields.MachineCapabilities,
+				AutoAssign:            tt.fields.AutoAssign,
 			}
 			err := itcr.Validate()
 			if tt.wantErr {
@@ -365,3 +392,32 @@ func TestAPIInstanceTypeUpdateRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestNewAPIInstanceTypeMatch(t *testing.T) {
+	dbit := &cdbm.InstanceType{
+		ID:         uuid.New(),
+		Name:       "test-name",
+		AutoAssign: true,
+	}
+
+	mismatchesByMachineID := map[string][]string{
+		"machine-c": {},
+		"machine-a": {},
+		"machine-b": {"GPU Capability NVIDIA H100: count is 4, expected 8"},
+		"machine-d": {"CPU Capability AMD Opteron Series x10 not found", "GPU Capability NVIDIA H100 not found", "InfiniBand Capability MT2910 Family [ConnectX-7] not found"},
+	}
+
+	got := NewAPIInstanceTypeMatch(dbit, mismatchesByMachineID)
+
+	assert.Equal(t, dbit.ID.String(), got.InstanceTypeID)
+	assert.True(t, got.AutoAssign)
+	assert.Equal(t, []string{"machine-a", "machine-c"}, got.MatchedMachineIDs)
+	assert.Equal(t, []APIMachineNearMiss{
+		{MachineID: "machine-b", Reasons: mismatchesByMachineID["machine-b"]},
+	}, got.NearMisses)
+
+	// Empty results serialize as empty lists
+	got = NewAPIInstanceTypeMatch(dbit, nil)
+	assert.NotNil(t, got.MatchedMachineIDs)
+	assert.NotNil(t, got.NearMisses)
+}
@@ -18,12 +18,14 @@
 package model
 
 import (
+	"errors"
 	"fmt"
 	"net/netip"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	"go4.org/netipx"
 
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
@@ -46,6 +48,8 @@ const (
 	validationErrorIPv4BlockSizeMax       = "prefixLength must be at most 32"
 	validationErrorIPv6BlockSizeMin       = "prefixLength must be at least 1"
 	validationErrorIPv6BlockSizeMax       = "prefixLength must be at most 128"
+	validationErrorReservedIPRangeOrder   = "end must not be lower than start"
+	validationErrorReservedIPRangeFamily  = "start and end must be of the same IP protocol version"
 )
 
 // APIIPBlockCreateRequest is the data structure to capture user request to create a new IPBlock
@@ -67,6 +71,8 @@ type APIIPBlockCreateRequest struct {
 	PrefixLength int `json:"prefixLength"`
 	// ProtocolVersion is the version of the ip network ipv4 or ipv6
 	ProtocolVersion string `json:"protocolVersion"`
+	// ReservedRanges are the ranges of the IPBlock which are excluded from allocation
+	ReservedRanges []APIReservedIPRange `json:"reservedRanges"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -90,6 +96,7 @@ func (ipbcr APIIPBlockCreateRequest) Validate() error {
 		validation.Field(&ipbcr.ProtocolVersion,
 			validation.Required.Error(validationErrorValueRequired),
 			validation.In(cdbm.IPBlockProtocolVersionV4, cdbm.IPBlockProtocolVersionV6).Error(validationErrorIPBlockProtocolVersion)),
+		validation.Field(&ipbcr.ReservedRanges),
 	)
 	if err != nil {
 		return err
@@ -154,6 +161,8 @@ type APIIPBlockUpdateRequest struct {
 	Name *string `json:"name"`
 	// Description is the description of the IPBlock
 	Description *string `json:"description"`
+	// ReservedRanges replaces the ranges of the IPBlock which are excluded from allocation, an empty list clears them
+	ReservedRanges *[]APIReservedIPRange `json:"reservedRanges"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -165,9 +174,84 @@ func (ipbur APIIPBlockUpdateRequest) Validate() error {
 			validation.When(ipbur.Name != nil, validation.Required.Error(validationErrorStringLength)),
 			validation.When(ipbur.Name != nil, validation.By(util.ValidateNameCharacters)),
 			validation.When(ipbur.Name != nil, validation.Length(2, 256).Error(validationErrorStringLength))),
+		validation.Field(&ipbur.ReservedRanges),
 	)
 }
 
+// APIReservedIPRange is the data structure to capture a range of IP addresses which is excluded from allocation
+type APIReservedIPRange struct {
+	// Start is the first IP address of the range
+	Start string `json:"start"`
+	// End is the last IP address of the range, the range only contains Start if empty
+	End string `json:"end,omitempty"`
+}
+
+// Validate ensure the values passed in request are acceptable
+func (rr APIReservedIPRange) Validate() error {
+	err := validation.ValidateStruct(&rr,
+		validation.Field(&rr.Start,
+			validation.Required.Error(validationErrorValueRequired),
+			validationis.IP.Error(validationErrorInvalidIPAddress)),
+		validation.Field(&rr.End,
+			validationis.IP.Error(validationErrorInvalidIPAddress)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if rr.End == "" {
+		return nil
+	}
+	start := netip.MustParseAddr(rr.Start)
+	end := netip.MustParseAddr(rr.End)
+	if start.Is4() != end.Is4() {
+		return validation.Errors{
+			"end": errors.New(validationErrorReservedIPRangeFamily),
+		}
+	}
+	if end.Less(start) {
+		return validation.Errors{
+			"end": errors.New(validationErrorReservedIPRangeOrder),
+		}
+	}
+	return nil
+}
+
+// IPRange returns the IP range described by the request, Validate must have succeeded before
+func (rr APIReservedIPRange) IPRange() netipx.IPRange {
+	start := netip.MustParseAddr(rr.Start)
+	if rr.End == "" {
+		return netipx.IPRangeFrom(start, start)
+	}
+	return netipx.IPRangeFrom(start, netip.MustParseAddr(rr.End))
+}
+
+// GetIPRangesFromAPIReservedIPRanges returns the IP ranges described by the requested reserved ranges
+func GetIPRangesFromAPIReservedIPRanges(apiRanges []APIReservedIPRange) []netipx.IPRange {
+	ranges := []netipx.IPRange{}
+	for _, apiRange := range apiRanges {
+		ranges = append(ranges, apiRange.IPRange())
+	}
+	return ranges
+}
+
+// NewAPIReservedIPRanges accepts reserved ranges of an IPAM prefix in from-to notation and returns API layer objects
+func NewAPIReservedIPRanges(reservedRanges []string) []APIReservedIPRange {
+	apiRanges := []APIReservedIPRange{}
+	for _, rr := range reservedRanges {
+		r, err := netipx.ParseIPRange(rr)
+		if err != nil {
+			continue
+		}
+		apiRange := APIReservedIPRange{Start: r.From().String()}
+		if r.To() != r.From() {
+			apiRange.End = r.To().String()
+		}
+		apiRanges = append(apiRanges, apiRange)
+	}
+	return apiRanges
+}
+
 // APIIPBlock is the data structure to capture API representation of an IPBlock
 type APIIPBlock struct {
 	// ID is the unique UUID v4 identifier for the IPBlock
@@ -202,6 +286,8 @@ type APIIPBlock struct {
 	StatusHistory []APIStatusDetail `json:"statusHistory"`
 	// UsageStats is the usage summary from IPAM for the IPBlock
 	UsageStats *APIIPBlockUsageStats `json:"usageStats,omitempty"`
+	// ReservedRanges are the ranges of the IPBlock which are excluded from allocation
+	ReservedRanges []APIReservedIPRange `json:"reservedRanges,omitempty"`
 	// CreatedAt indicates the ISO datetime string for when the entity was created
 	Created time.Time `json:"created"`
 	// UpdatedAt indicates the ISO datetime string for when the entity was last updated
@@ -245,6 +331,7 @@ func NewAPIIPBlock(dbipb *cdbm.IPBlock, dbsds []cdbm.StatusDetail, dbpu *ipam.Us
 			AvailablePrefixes:         dbpu.AvailablePrefixes,
 			AcquiredPrefixes:          dbpu.AcquiredPrefixes,
 			AvailableSmallestPrefixes: dbpu.AvailableSmallestPrefixes,
+			ReservedIPs:               dbpu.ReservedIPs,
 		}
 	}
 
@@ -304,7 +391,7 @@ type APIIPBlockStats struct {
 
 // APIIPBlockUsageStats is a data structure to capture information about IPBlock usage statsfrom IPAM at the API layer
 type APIIPBlockUsageStats struct {
-	// AvailableIPs is the total number of available IPs in the IPBlock
+	// AvailableIPs is the total number of available IPs in the IPBlock, excluding IPs in reserved ranges
 	AvailableIPs uint64 `json:"availableIPs"`
 	// AcquiredIPs the number of acquired IPs from the IPBlock
 	AcquiredIPs uint64 `json:"acquiredIPs"`
@@ -314,4 +401,6 @@ type APIIPBlockUsageStats struct {
 	AvailableSmallestPrefixes uint64 `json:"availableSmallestPrefixes"`
 	// AcquiredPrefixes the number of acquired prefixes from the IPBlock
 	AcquiredPrefixes uint64 `json:"acquiredPrefixes"`
+	// ReservedIPs the number of IPs of the IPBlock covered by reserved ranges
+	ReservedIPs uint64 `json:"reservedIPs"`
 }
@@ -127,6 +127,16 @@ func TestAPIIPBlockCreateRequest_Validate(t *testing.T) {
 			obj:       APIIPBlockCreateRequest{Name: "ab", SiteID: uuid.New().String(), RoutingType: cdbm.IPBlockRoutingTypePublic, Prefix: "192.164.10.0", PrefixLength: prefLen, ProtocolVersion: cdbm.IPBlockProtocolVersionV4},
 			expectErr: false,
 		},
+		{
+			desc:      "ok when reserved ranges are valid",
+			obj:       APIIPBlockCreateRequest{Name: "ab", SiteID: uuid.New().String(), RoutingType: cdbm.IPBlockRoutingTypePublic, Prefix: "192.164.10.0", PrefixLength: prefLen, ProtocolVersion: cdbm.IPBlockProtocolVersionV4, ReservedRanges: []APIReservedIPRange{{Start: "192.164.10.1"}, {Start: "192.164.10.10", End: "192.164.10.20"}}},
+			expectErr: false,
+		},
+		{
+			desc:      "error when reserved range is not valid",
+			obj:       APIIPBlockCreateRequest{Name: "ab", SiteID: uuid.New().String(), RoutingType: cdbm.IPBlockRoutingTypePublic, Prefix: "192.164.10.0", PrefixLength: prefLen, ProtocolVersion: cdbm.IPBlockProtocolVersionV4, ReservedRanges: []APIReservedIPRange{{Start: "192.164.10.20", End: "192.164.10.10"}}},
+			expectErr: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -170,6 +180,16 @@ func TestAPIIPBlockUpdateRequest_Validate(t *testing.T) {
 			obj:       APIIPBlockUpdateRequest{Name: cdb.GetStrPtr("ab"), Description: cdb.GetStrPtr("")},
 			expectErr: false,
 		},
+		{
+			desc:      "ok when reserved ranges are cleared",
+			obj:       APIIPBlockUpdateRequest{ReservedRanges: &[]APIReservedIPRange{}},
+			expectErr: false,
+		},
+		{
+			desc:      "error when reserved range is not valid",
+			obj:       APIIPBlockUpdateRequest{ReservedRanges: &[]APIReservedIPRange{{Start: "bad-ip-address"}}},
+			expectErr: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -182,6 +202,61 @@ func TestAPIIPBlockUpdateRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestAPIReservedIPRange_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIReservedIPRange
+		expectErr bool
+	}{
+		{
+			desc:      "ok when only Start is provided",
+			obj:       APIReservedIPRange{Start: "192.168.0.1"},
+			expectErr: false,
+		},
+		{
+			desc:      "ok when Start and End are provided",
+			obj:       APIReservedIPRange{Start: "2001:db8::1", End: "2001:db8::ff"},
+			expectErr: false,
+		},
+		{
+			desc:      "error when Start is not provided",
+			obj:       APIReservedIPRange{End: "192.168.0.1"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when End is not valid ip address",
+			obj:       APIReservedIPRange{Start: "192.168.0.1", End: "bad-ip-address"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when Start and End are of different protocol versions",
+			obj:       APIReservedIPRange{Start: "192.168.0.1", End: "2001:db8::1"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when End is lower than Start",
+			obj:       APIReservedIPRange{Start: "192.168.0.10", End: "192.168.0.1"},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestAPIReservedIPRangeConversion(t *testing.T) {
+	apiRanges := []APIReservedIPRange{{Start: "192.168.0.1"}, {Start: "192.168.0.10", End: "192.168.0.20"}}
+
+	ranges := GetIPRangesFromAPIReservedIPRanges(apiRanges)
+	assert.Equal(t, []string{"192.168.0.1-192.168.0.1", "192.168.0.10-192.168.0.20"}, []string{ranges[0].String(), ranges[1].String()})
+
+	assert.Equal(t, apiRanges, NewAPIReservedIPRanges([]string{ranges[0].String(), ranges[1].String()}))
+	assert.Equal(t, []APIReservedIPRange{}, NewAPIReservedIPRanges(nil))
+}
+
 func TestAPIIPBlockNew(t *testing.T) {
 	dbObj := &cdbm.IPBlock{
 		ID:                       uuid.New(),
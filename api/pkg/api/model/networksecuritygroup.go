@@ -26,6 +26,7 @@ import (
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/google/uuid"
 	hutil "github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util"
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
@@ -120,6 +121,14 @@ var NetworkSecurityGroupRuleAPIPropagationStatusFromProtobufPropagationStatus =
 	cwssaws.NetworkSecurityGroupPropagationStatus_NSG_PROP_STATUS_ERROR:   APINetworkSecurityGroupPropagationStatusError,
 }
 
+// GetMaxNetworkSecurityGroupRules returns the maximum number of rules a NetworkSecurityGroup can have on a Site
+func GetMaxNetworkSecurityGroupRules(siteConfig *cdbm.SiteConfig) int {
+	if siteConfig != nil && siteConfig.MaxNetworkSecurityGroupRuleCount != nil {
+		return *siteConfig.MaxNetworkSecurityGroupRuleCount
+	}
+	return MaxNetworkSecurityGroupRules
+}
+
 // APINetworkSecurityGroupCreateRequest is the data structure to capture instance request to create a new NetworkSecurityGroup
 type APINetworkSecurityGroupCreateRequest struct {
 	// Name is the name of the NetworkSecurityGroup
@@ -154,10 +163,7 @@ func (req APINetworkSecurityGroupCreateRequest) Validate(siteConfig *cdbm.SiteCo
 		return err
 	}
 
-	maxRules := MaxNetworkSecurityGroupRules
-	if siteConfig != nil && siteConfig.MaxNetworkSecurityGroupRuleCount != nil {
-		maxRules = *siteConfig.MaxNetworkSecurityGroupRuleCount
-	}
+	maxRules := GetMaxNetworkSecurityGroupRules(siteConfig)
 
 	if len(req.Rules) > maxRules {
 		return validation.Errors{
@@ -243,10 +249,7 @@ func (req APINetworkSecurityGroupUpdateRequest) Validate(siteConfig *cdbm.SiteCo
 		return err
 	}
 
-	maxRules := MaxNetworkSecurityGroupRules
-	if siteConfig != nil && siteConfig.MaxNetworkSecurityGroupRuleCount != nil {
-		maxRules = *siteConfig.MaxNetworkSecurityGroupRuleCount
-	}
+	maxRules := GetMaxNetworkSecurityGroupRules(siteConfig)
 
 	if len(req.Rules) > maxRules {
 		return validation.Errors{
@@ -407,8 +410,8 @@ func ProtobufRuleFromAPINetworkSecurityGroupRule(rule *APINetworkSecurityGroupRu
 
 	// Process src/dst prefixes
 
-	// We currently only have a single source network
-	// option supported.
+	// A network can be given as a literal prefix or as a
+	// reference to an Address Set or to a Network Security Group.
 	// As/If we add more, add more if-blocks
 	// and checking the final count will be a
 	// pretty cheap way to make sure we have only
@@ -428,6 +431,24 @@ func ProtobufRuleFromAPINetworkSecurityGroupRule(rule *APINetworkSecurityGroupRu
 		sourceNetOptionCount++
 	}
 
+	if rule.SourceAddressSetID != nil {
+		if _, err := uuid.Parse(*rule.SourceAddressSetID); err != nil {
+			return nil, validation.Errors{"rules": fmt.Errorf("source Address Set ID `%s` is not valid", *rule.SourceAddressSetID)}
+		}
+
+		newRule.SourceAddressSetID = rule.SourceAddressSetID
+		sourceNetOptionCount++
+	}
+
+	if rule.SourceNetworkSecurityGroupID != nil {
+		if *rule.SourceNetworkSecurityGroupID == "" {
+			return nil, validation.Errors{"rules": fmt.Errorf("source Network Security Group ID cannot be empty")}
+		}
+
+		newRule.SourceNetworkSecurityGroupID = rule.SourceNetworkSecurityGroupID
+		sourceNetOptionCount++
+	}
+
 	if sourceNetOptionCount > 1 {
 		return nil, validation.Errors{"rules": fmt.Errorf("too many source network options found in API request")}
 	}
@@ -450,6 +471,24 @@ func ProtobufRuleFromAPINetworkSecurityGroupRule(rule *APINetworkSecurityGroupRu
 		destinationNetOptionCount++
 	}
 
+	if rule.DestinationAddressSetID != nil {
+		if _, err := uuid.Parse(*rule.DestinationAddressSetID); err != nil {
+			return nil, validation.Errors{"rules": fmt.Errorf("destination Address Set ID `%s` is not valid", *rule.DestinationAddressSetID)}
+		}
+
+		newRule.DestinationAddressSetID = rule.DestinationAddressSetID
+		destinationNetOptionCount++
+	}
+
+	if rule.DestinationNetworkSecurityGroupID != nil {
+		if *rule.DestinationNetworkSecurityGroupID == "" {
+			return nil, validation.Errors{"rules": fmt.Errorf("destination Network Security Group ID cannot be empty")}
+		}
+
+		newRule.DestinationNetworkSecurityGroupID = rule.DestinationNetworkSecurityGroupID
+		destinationNetOptionCount++
+	}
+
 	if destinationNetOptionCount > 1 {
 		return nil, validation.Errors{"rules": fmt.Errorf("too many destination network options found in API request")}
 	}
@@ -501,24 +540,30 @@ func APINetworkSecurityGroupRuleFromProtobufRule(rule *cdbm.NetworkSecurityGroup
 	var srcPrefix *string
 	var dstPrefix *string
 
-	switch srcNet := rule.GetSourceNet().(type) {
-	case *cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix:
-		if _, _, err := net.ParseCIDR(srcNet.SrcPrefix); err != nil {
-			return nil, validation.Errors{"rules": fmt.Errorf("found invalid source prefix `%s` in database record", srcNet.SrcPrefix)}
+	// References to Address Sets and Network Security Groups are only
+	// known to carbide-rest-api and take the place of the prefix.
+	if rule.SourceAddressSetID == nil && rule.SourceNetworkSecurityGroupID == nil {
+		switch srcNet := rule.GetSourceNet().(type) {
+		case *cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix:
+			if _, _, err := net.ParseCIDR(srcNet.SrcPrefix); err != nil {
+				return nil, validation.Errors{"rules": fmt.Errorf("found invalid source prefix `%s` in database record", srcNet.SrcPrefix)}
+			}
+			srcPrefix = &srcNet.SrcPrefix
+		default:
+			return nil, validation.Errors{"rules": fmt.Errorf("encountered unknown source network option in database record")}
 		}
-		srcPrefix = &srcNet.SrcPrefix
-	default:
-		return nil, validation.Errors{"rules": fmt.Errorf("encountered unknown source network option in database record")}
 	}
 
-	switch dstNet := rule.GetDestinationNet().(type) {
-	case *cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix:
-		if _, _, err := net.ParseCIDR(dstNet.DstPrefix); err != nil {
-			return nil, validation.Errors{"rules": fmt.Errorf("found invalid destination prefix `%s` in database record", dstNet.DstPrefix)}
+	if rule.DestinationAddressSetID == nil && rule.DestinationNetworkSecurityGroupID == nil {
+		switch dstNet := rule.GetDestinationNet().(type) {
+		case *cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix:
+			if _, _, err := net.ParseCIDR(dstNet.DstPrefix); err != nil {
+				return nil, validation.Errors{"rules": fmt.Errorf("found invalid destination prefix `%s` in database record", dstNet.DstPrefix)}
+			}
+			dstPrefix = &dstNet.DstPrefix
+		default:
+			return nil, fmt.Errorf("encountered unknown source network option in database record")
 		}
-		dstPrefix = &dstNet.DstPrefix
-	default:
-		return nil, fmt.Errorf("encountered unknown source network option in database record")
 	}
 
 	// Process rule port ranges
@@ -555,6 +600,11 @@ func APINetworkSecurityGroupRuleFromProtobufRule(rule *cdbm.NetworkSecurityGroup
 		Priority:             int(rule.Priority),
 		SourcePrefix:         srcPrefix,
 		DestinationPrefix:    dstPrefix,
+
+		SourceAddressSetID:                rule.SourceAddressSetID,
+		DestinationAddressSetID:           rule.DestinationAddressSetID,
+		SourceNetworkSecurityGroupID:      rule.SourceNetworkSecurityGroupID,
+		DestinationNetworkSecurityGroupID: rule.DestinationNetworkSecurityGroupID,
 	}, nil
 }
 
@@ -613,6 +663,15 @@ type APINetworkSecurityGroupRule struct {
 	Priority             int     `json:"priority"`
 	SourcePrefix         *string `json:"sourcePrefix"`
 	DestinationPrefix    *string `json:"destinationPrefix"`
+
+	// SourceAddressSetID references an Address Set whose prefixes are used as the source network
+	SourceAddressSetID *string `json:"sourceAddressSetId,omitempty"`
+	// DestinationAddressSetID references an Address Set whose prefixes are used as the destination network
+	DestinationAddressSetID *string `json:"destinationAddressSetId,omitempty"`
+	// SourceNetworkSecurityGroupID references a Network Security Group whose Instances are used as the source network
+	SourceNetworkSecurityGroupID *string `json:"sourceNetworkSecurityGroupId,omitempty"`
+	// DestinationNetworkSecurityGroupID references a Network Security Group whose Instances are used as the destination network
+	DestinationNetworkSecurityGroupID *string `json:"destinationNetworkSecurityGroupId,omitempty"`
 }
 
 // APINetworkSecurityGroupStats holds detailed usage stats for an NSG
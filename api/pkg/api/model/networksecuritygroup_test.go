@@ -249,6 +249,70 @@ func TestAPINetworkSecurityGroupRuleConversions(t *testing.T) {
 	}
 }
 
+func TestAPINetworkSecurityGroupRuleReferenceConversions(t *testing.T) {
+	addressSetID := uuid.NewString()
+	nsgID := uuid.NewString()
+
+	tests := []struct {
+		name      string
+		rule      APINetworkSecurityGroupRule
+		expectErr bool
+	}{
+		{
+			name: "test source Address Set and destination prefix",
+			rule: APINetworkSecurityGroupRule{SourceAddressSetID: cdb.GetStrPtr(addressSetID), DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0")},
+		},
+		{
+			name: "test source prefix and destination Network Security Group",
+			rule: APINetworkSecurityGroupRule{SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationNetworkSecurityGroupID: cdb.GetStrPtr(nsgID)},
+		},
+		{
+			name: "test source Network Security Group and destination Address Set",
+			rule: APINetworkSecurityGroupRule{SourceNetworkSecurityGroupID: cdb.GetStrPtr(nsgID), DestinationAddressSetID: cdb.GetStrPtr(addressSetID)},
+		},
+		{
+			name:      "test prefix and Address Set for the same side",
+			rule:      APINetworkSecurityGroupRule{SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), SourceAddressSetID: cdb.GetStrPtr(addressSetID), DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0")},
+			expectErr: true,
+		},
+		{
+			name:      "test Address Set and Network Security Group for the same side",
+			rule:      APINetworkSecurityGroupRule{SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationAddressSetID: cdb.GetStrPtr(addressSetID), DestinationNetworkSecurityGroupID: cdb.GetStrPtr(nsgID)},
+			expectErr: true,
+		},
+		{
+			name:      "test invalid Address Set ID",
+			rule:      APINetworkSecurityGroupRule{SourceAddressSetID: cdb.GetStrPtr("not-a-uuid"), DestinationPrefix: cdb.GetStrPtr("0.0.0.0/0")},
+			expectErr: true,
+		},
+		{
+			name:      "test empty Network Security Group ID",
+			rule:      APINetworkSecurityGroupRule{SourcePrefix: cdb.GetStrPtr("10.0.0.0/8"), DestinationNetworkSecurityGroupID: cdb.GetStrPtr("")},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.rule.Direction = APINetworkSecurityGroupRuleDirectionIngress
+			tc.rule.Protocol = APINetworkSecurityGroupRuleProtocolTcp
+			tc.rule.Action = APINetworkSecurityGroupRuleActionPermit
+
+			dbRule, err := ProtobufRuleFromAPINetworkSecurityGroupRule(&tc.rule)
+			if tc.expectErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.True(t, dbRule.HasReferences())
+
+			apiRule, err := APINetworkSecurityGroupRuleFromProtobufRule(dbRule)
+			assert.Nil(t, err)
+			assert.Equal(t, &tc.rule, apiRule)
+		})
+	}
+}
+
 func TestAPINetworkSecurityGroupCreateRequest_Validate(t *testing.T) {
 
 	rules := []APINetworkSecurityGroupRule{
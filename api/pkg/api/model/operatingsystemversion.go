@@ -0,0 +1,237 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+// APIOperatingSystemVersionCreateRequest is the data structure to capture user request to create a new version of an OperatingSystem
+type APIOperatingSystemVersionCreateRequest struct {
+	// Version is the name of the version, unique within the Operating System
+	Version string `json:"version"`
+	// ImageURL is the image path for the Operating System Version
+	ImageURL *string `json:"imageUrl"`
+	// ImageSHA is SHA for the Operating System Version image
+	ImageSHA *string `json:"imageSha"`
+	// ImageAuthType is auth type for the Operating System Version image
+	ImageAuthType *string `json:"imageAuthType"`
+	// ImageAuthToken is auth token for the Operating System Version image
+	ImageAuthToken *string `json:"imageAuthToken"`
+	// ImageDisk is disk for the Operating System Version image
+	ImageDisk *string `json:"imageDisk"`
+	// RootFsID is root fs id for the Operating System Version image
+	RootFsID *string `json:"rootFsId"`
+	// RootFsLabel is root fs label for the Operating System Version image
+	RootFsLabel *string `json:"rootFsLabel"`
+	// IpxeScript is the iPXE script for the Operating System Version
+	IpxeScript *string `json:"ipxeScript"`
+}
+
+// Validate ensure the values passed in request are acceptable for the type of the Operating System
+func (osvcr APIOperatingSystemVersionCreateRequest) Validate(os *cdbm.OperatingSystem) error {
+	err := validation.ValidateStruct(&osvcr,
+		validation.Field(&osvcr.Version,
+			validation.Required.Error(validationErrorStringLength64),
+			validation.Length(2, 64).Error(validationErrorStringLength64)),
+	)
+	if err != nil {
+		return err
+	}
+
+	if os.Type == cdbm.OperatingSystemTypeIPXE {
+		return validation.ValidateStruct(&osvcr,
+			validation.Field(&osvcr.IpxeScript,
+				validation.Required.Error(validationErrorValueRequired)),
+			validation.Field(&osvcr.ImageURL,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.ImageSHA,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.ImageAuthType,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.ImageAuthToken,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.ImageDisk,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.RootFsID,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+			validation.Field(&osvcr.RootFsLabel,
+				validation.Nil.Error("cannot be specified for iPXE based Operating Systems")),
+		)
+	}
+
+	if osvcr.IpxeScript != nil {
+		return validation.Errors{
+			"ipxeScript": errors.New("cannot be specified for image based Operating Systems"),
+		}
+	}
+
+	return validation.ValidateStruct(&osvcr,
+		validation.Field(&osvcr.ImageURL,
+			validation.Required.Error(validationErrorValueRequired),
+			is.URL),
+		validation.Field(&osvcr.ImageSHA,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.When(osvcr.ImageSHA != nil, validation.Match(util.ShaHashRegex).Error(errMsgInvalidImageSHA))),
+		validation.Field(&osvcr.ImageAuthType,
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.ImageAuthType)) && util.IsNilOrEmptyStrPtr(osvcr.ImageAuthToken),
+				validation.Required.Error("imageAuthType cannot be specified if imageAuthToken is not specified")),
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.ImageAuthType)),
+				validation.In(cdbm.OperatingSystemAuthTypeBasic, cdbm.OperatingSystemAuthTypeBearer).Error("imageAuthType must be Basic or Bearer")),
+		),
+		validation.Field(&osvcr.ImageAuthToken,
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.ImageAuthToken)) && util.IsNilOrEmptyStrPtr(osvcr.ImageAuthType), validation.Required.Error("imageAuthType must be specified when imageAuthToken is specified"))),
+		validation.Field(&osvcr.ImageDisk,
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.ImageDisk)), validation.Match(util.DiskImagePathRegex).Error(errMsgInvalidImageDiskPath))),
+		validation.Field(&osvcr.RootFsID,
+			validation.When(util.IsNilOrEmptyStrPtr(osvcr.RootFsLabel), validation.Required.Error(errMsgExactlyOneRootFsField)),
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.RootFsLabel)), validation.Empty.Error(errMsgExactlyOneRootFsField))),
+		validation.Field(&osvcr.RootFsLabel,
+			validation.When(util.IsNilOrEmptyStrPtr(osvcr.RootFsID), validation.Required.Error(errMsgExactlyOneRootFsField)),
+			validation.When(!(util.IsNilOrEmptyStrPtr(osvcr.RootFsID)), validation.Empty.Error(errMsgExactlyOneRootFsField))),
+	)
+}
+
+// APIOperatingSystemVersionUpdateRequest is the data structure to capture user request to update an OperatingSystemVersion
+// Versions are immutable, only their deprecation can be changed
+type APIOperatingSystemVersionUpdateRequest struct {
+	// IsDeprecated indicates if the version should be deprecated
+	IsDeprecated *bool `json:"isDeprecated"`
+	// DeprecationNote is the reason for deprecating the version
+	DeprecationNote *string `json:"deprecationNote"`
+}
+
+// Validate ensure the values passed in request are acceptable
+func (osvur APIOperatingSystemVersionUpdateRequest) Validate(existingOSV *cdbm.OperatingSystemVersion) error {
+	if osvur.IsDeprecated == nil && osvur.DeprecationNote == nil {
+		return validation.Errors{
+			validationCommonErrorField: errors.New("at least one of isDeprecated or deprecationNote must be specified"),
+		}
+	}
+
+	// reject attempts to change deprecation if already in desired state
+	if osvur.IsDeprecated != nil {
+		if *osvur.IsDeprecated && existingOSV.IsDeprecated() {
+			return validation.Errors{
+				"isDeprecated": errors.New("Operating System Version is already deprecated"),
+			}
+		} else if !*osvur.IsDeprecated && !existingOSV.IsDeprecated() {
+			return validation.Errors{
+				"isDeprecated": errors.New("Operating System Version is not deprecated"),
+			}
+		} else if !*osvur.IsDeprecated && osvur.DeprecationNote != nil {
+			return validation.Errors{
+				"deprecationNote": errors.New("cannot provide Deprecation Note when undeprecating Operating System Version"),
+			}
+		}
+	} else if !existingOSV.IsDeprecated() {
+		return validation.Errors{
+			"deprecationNote": errors.New("cannot set Deprecation Note on an Operating System Version that is not deprecated"),
+		}
+	}
+
+	return nil
+}
+
+// APIOperatingSystemVersion is the data structure to capture API representation of an OperatingSystemVersion
+type APIOperatingSystemVersion struct {
+	// ID is the unique UUID v4 identifier for the Operating System Version
+	ID string `json:"id"`
+	// OperatingSystemID is the ID of the Operating System the version belongs to
+	OperatingSystemID string `json:"operatingSystemId"`
+	// Version is the name of the version
+	Version string `json:"version"`
+	// ImageURL is url path for the Operating System Version image
+	ImageURL *string `json:"imageUrl"`
+	// ImageSHA is SHA for the Operating System Version image
+	ImageSHA *string `json:"imageSha"`
+	// ImageAuthType is auth type for the Operating System Version image
+	ImageAuthType *string `json:"imageAuthType"`
+	// ImageAuthToken is auth token for the Operating System Version image
+	ImageAuthToken *string `json:"imageAuthToken"`
+	// ImageDisk is disk for the Operating System Version image
+	ImageDisk *string `json:"imageDisk"`
+	// RootFsID is root fs id for the Operating System Version image
+	RootFsID *string `json:"rootFsId"`
+	// RootFsLabel is root fs label for the Operating System Version image
+	RootFsLabel *string `json:"rootFsLabel"`
+	// IpxeScript is the iPXE script for the Operating System Version
+	IpxeScript *string `json:"ipxeScript"`
+	// IsDeprecated indicates if the version is deprecated, deprecated versions can not be used for new Instances
+	IsDeprecated bool `json:"isDeprecated"`
+	// Deprecated indicates the ISO datetime string for when the version was deprecated
+	Deprecated *time.Time `json:"deprecated"`
+	// DeprecationNote is the reason for deprecating the version
+	DeprecationNote *string `json:"deprecationNote"`
+	// Verified indicates the ISO datetime string for when the version image was verified
+	Verified *time.Time `json:"verified"`
+	// Status is the status of the Operating System Version
+	Status string `json:"status"`
+	// StatusHistory is the history of statuses for the Operating System Version
+	StatusHistory []APIStatusDetail `json:"statusHistory"`
+	// SiteAssociations is the list of Sites the version is synced to
+	SiteAssociations []APIOperatingSystemSiteAssociation `json:"siteAssociations"`
+	// Created indicates the ISO datetime string for when the entity was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the entity was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIOperatingSystemVersion accepts DB layer objects and returns an API layer object
+func NewAPIOperatingSystemVersion(dbosv *cdbm.OperatingSystemVersion, dbsds []cdbm.StatusDetail, ossas []cdbm.OperatingSystemSiteAssociation) *APIOperatingSystemVersion {
+	apiosv := &APIOperatingSystemVersion{
+		ID:                dbosv.ID.String(),
+		OperatingSystemID: dbosv.OperatingSystemID.String(),
+		Version:           dbosv.Version,
+		ImageURL:          dbosv.ImageURL,
+		ImageSHA:          dbosv.ImageSHA,
+		ImageAuthType:     dbosv.ImageAuthType,
+		ImageAuthToken:    dbosv.ImageAuthToken,
+		ImageDisk:         dbosv.ImageDisk,
+		RootFsID:          dbosv.RootFsID,
+		RootFsLabel:       dbosv.RootFsLabel,
+		IpxeScript:        dbosv.IpxeScript,
+		IsDeprecated:      dbosv.IsDeprecated(),
+		Deprecated:        dbosv.Deprecated,
+		DeprecationNote:   dbosv.DeprecationNote,
+		Verified:          dbosv.Verified,
+		Status:            dbosv.Status,
+		Created:           dbosv.Created,
+		Updated:           dbosv.Updated,
+	}
+
+	apiosv.StatusHistory = []APIStatusDetail{}
+	for _, dbsd := range dbsds {
+		apiosv.StatusHistory = append(apiosv.StatusHistory, NewAPIStatusDetail(dbsd))
+	}
+
+	apiosv.SiteAssociations = []APIOperatingSystemSiteAssociation{}
+	for _, ossa := range ossas {
+		curVal := ossa
+		apiosv.SiteAssociations = append(apiosv.SiteAssociations, *NewAPIOperatingSystemSiteAssociation(&curVal, nil))
+	}
+
+	return apiosv
+}
@@ -0,0 +1,194 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestAPIOperatingSystemVersionCreateRequest_Validate(t *testing.T) {
+	imageOS := &cdbm.OperatingSystem{Type: cdbm.OperatingSystemTypeImage}
+	ipxeOS := &cdbm.OperatingSystem{Type: cdbm.OperatingSystemTypeIPXE}
+
+	tests := []struct {
+		desc      string
+		obj       APIOperatingSystemVersionCreateRequest
+		os        *cdbm.OperatingSystem
+		expectErr bool
+	}{
+		{
+			desc:      "ok when image version is valid",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("a0b1c2"), RootFsID: cdb.GetStrPtr("abc")},
+			os:        imageOS,
+			expectErr: false,
+		},
+		{
+			desc:      "ok when image version uses auth",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("a0b1c2"), ImageAuthType: cdb.GetStrPtr(cdbm.OperatingSystemAuthTypeBearer), ImageAuthToken: cdb.GetStrPtr("token"), RootFsLabel: cdb.GetStrPtr("root")},
+			os:        imageOS,
+			expectErr: false,
+		},
+		{
+			desc:      "error when version is not provided",
+			obj:       APIOperatingSystemVersionCreateRequest{ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("a0b1c2"), RootFsID: cdb.GetStrPtr("abc")},
+			os:        imageOS,
+			expectErr: true,
+		},
+		{
+			desc:      "error when image version has no SHA",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), RootFsID: cdb.GetStrPtr("abc")},
+			os:        imageOS,
+			expectErr: true,
+		},
+		{
+			desc:      "error when image version has an invalid SHA",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("xyz"), RootFsID: cdb.GetStrPtr("abc")},
+			os:        imageOS,
+			expectErr: true,
+		},
+		{
+			desc:      "error when image version specifies both root fs fields",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("a0b1c2"), RootFsID: cdb.GetStrPtr("abc"), RootFsLabel: cdb.GetStrPtr("root")},
+			os:        imageOS,
+			expectErr: true,
+		},
+		{
+			desc:      "error when image version specifies iPXE script",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "22.04.1", ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"), ImageSHA: cdb.GetStrPtr("a0b1c2"), RootFsID: cdb.GetStrPtr("abc"), IpxeScript: cdb.GetStrPtr("#ipxe")},
+			os:        imageOS,
+			expectErr: true,
+		},
+		{
+			desc:      "ok when iPXE version is valid",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "v2", IpxeScript: cdb.GetStrPtr("#ipxe")},
+			os:        ipxeOS,
+			expectErr: false,
+		},
+		{
+			desc:      "error when iPXE version specifies image URL",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "v2", IpxeScript: cdb.GetStrPtr("#ipxe"), ImageURL: cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2")},
+			os:        ipxeOS,
+			expectErr: true,
+		},
+		{
+			desc:      "error when iPXE version has no script",
+			obj:       APIOperatingSystemVersionCreateRequest{Version: "v2"},
+			os:        ipxeOS,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate(tc.os)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
+
+func TestAPIOperatingSystemVersionUpdateRequest_Validate(t *testing.T) {
+	active := &cdbm.OperatingSystemVersion{}
+	deprecated := &cdbm.OperatingSystemVersion{Deprecated: cdb.GetTimePtr(cdb.GetCurTime())}
+
+	tests := []struct {
+		desc      string
+		obj       APIOperatingSystemVersionUpdateRequest
+		existing  *cdbm.OperatingSystemVersion
+		expectErr bool
+	}{
+		{
+			desc:      "ok when deprecating with a note",
+			obj:       APIOperatingSystemVersionUpdateRequest{IsDeprecated: cdb.GetBoolPtr(true), DeprecationNote: cdb.GetStrPtr("CVE fixed in next version")},
+			existing:  active,
+			expectErr: false,
+		},
+		{
+			desc:      "ok when undeprecating",
+			obj:       APIOperatingSystemVersionUpdateRequest{IsDeprecated: cdb.GetBoolPtr(false)},
+			existing:  deprecated,
+			expectErr: false,
+		},
+		{
+			desc:      "ok when changing the note of a deprecated version",
+			obj:       APIOperatingSystemVersionUpdateRequest{DeprecationNote: cdb.GetStrPtr("superseded")},
+			existing:  deprecated,
+			expectErr: false,
+		},
+		{
+			desc:      "error when nothing is specified",
+			obj:       APIOperatingSystemVersionUpdateRequest{},
+			existing:  active,
+			expectErr: true,
+		},
+		{
+			desc:      "error when deprecating a deprecated version",
+			obj:       APIOperatingSystemVersionUpdateRequest{IsDeprecated: cdb.GetBoolPtr(true)},
+			existing:  deprecated,
+			expectErr: true,
+		},
+		{
+			desc:      "error when undeprecating with a note",
+			obj:       APIOperatingSystemVersionUpdateRequest{IsDeprecated: cdb.GetBoolPtr(false), DeprecationNote: cdb.GetStrPtr("note")},
+			existing:  deprecated,
+			expectErr: true,
+		},
+		{
+			desc:      "error when setting a note on a version that is not deprecated",
+			obj:       APIOperatingSystemVersionUpdateRequest{DeprecationNote: cdb.GetStrPtr("note")},
+			existing:  active,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate(tc.existing)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
+
+func TestNewAPIOperatingSystemVersion(t *testing.T) {
+	osv := &cdbm.OperatingSystemVersion{
+		ID:                uuid.New(),
+		OperatingSystemID: uuid.New(),
+		Version:           "22.04.1",
+		ImageURL:          cdb.GetStrPtr("https://images.test.com/ubuntu.qcow2"),
+		Status:            cdbm.OperatingSystemVersionStatusReady,
+		Deprecated:        cdb.GetTimePtr(cdb.GetCurTime()),
+		DeprecationNote:   cdb.GetStrPtr("superseded"),
+	}
+	sds := []cdbm.StatusDetail{{ID: uuid.New(), EntityID: osv.ID.String(), Status: cdbm.OperatingSystemVersionStatusReady}}
+	ossas := []cdbm.OperatingSystemSiteAssociation{{ID: uuid.New(), OperatingSystemID: osv.OperatingSystemID, OperatingSystemVersionID: &osv.ID, Status: cdbm.OperatingSystemSiteAssociationStatusSynced}}
+
+	apiosv := NewAPIOperatingSystemVersion(osv, sds, ossas)
+	assert.Equal(t, osv.ID.String(), apiosv.ID)
+	assert.Equal(t, osv.OperatingSystemID.String(), apiosv.OperatingSystemID)
+	assert.Equal(t, "22.04.1", apiosv.Version)
+	assert.True(t, apiosv.IsDeprecated)
+	assert.Equal(t, "superseded", *apiosv.DeprecationNote)
+	assert.Len(t, apiosv.StatusHistory, 1)
+	assert.Len(t, apiosv.SiteAssociations, 1)
+	assert.Equal(t, cdbm.OperatingSystemSiteAssociationStatusSynced, apiosv.SiteAssociations[0].Status)
+}
@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	validationis "github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/model/util"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+const (
+	// ServiceAccountTokenStatusActive indicates the token can be used
+	ServiceAccountTokenStatusActive = "Active"
+	// ServiceAccountTokenStatusExpired indicates the token expiry has passed
+	ServiceAccountTokenStatusExpired = "Expired"
+	// ServiceAccountTokenStatusRevoked indicates the token has been revoked
+	ServiceAccountTokenStatusRevoked = "Revoked"
+
+	// ServiceAccountTokenMaxScopes is the maximum number of scopes a token can have
+	ServiceAccountTokenMaxScopes = 64
+)
+
+// APIServiceAccountTokenCreateRequest is the data structure to capture user request to create an API token
+type APIServiceAccountTokenCreateRequest struct {
+	// Name is the name of the token, unique among the org's unrevoked tokens
+	Name string `json:"name"`
+	// Description is an optional description of the token
+	Description *string `json:"description"`
+	// Scopes are the resources and verbs the token allows, e.g. instance:write or *:read
+	Scopes []string `json:"scopes"`
+	// SiteID optionally restricts the token to a Site
+	SiteID *string `json:"siteId"`
+	// Expires is the optional time after which the token can no longer be used
+	Expires *time.Time `json:"expires"`
+}
+
+// Validate ensures that the values passed in request are acceptable
+func (satcr APIServiceAccountTokenCreateRequest) Validate() error {
+	err := validation.ValidateStruct(&satcr,
+		validation.Field(&satcr.Name,
+			validation.Required.Error(validationErrorStringLength),
+			validation.By(util.ValidateNameCharacters),
+			validation.Length(2, 256).Error(validationErrorStringLength)),
+		validation.Field(&satcr.Description,
+			validation.When(satcr.Description != nil, validation.Length(0, 1024).Error(validationErrorDescriptionStringLength))),
+		validation.Field(&satcr.Scopes,
+			validation.Required.Error(validationErrorValueRequired),
+			validation.Length(1, ServiceAccountTokenMaxScopes).Error(fmt.Sprintf("at most %d scopes can be specified", ServiceAccountTokenMaxScopes))),
+		validation.Field(&satcr.SiteID,
+			validation.When(satcr.SiteID != nil, validationis.UUID.Error(validationErrorInvalidUUID))),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range satcr.Scopes {
+		if serr := core.ValidateAPITokenScope(scope); serr != nil {
+			return validation.Errors{
+				"scopes": serr,
+			}
+		}
+	}
+
+	if satcr.Expires != nil && !satcr.Expires.After(time.Now()) {
+		return validation.Errors{
+			"expires": fmt.Errorf("must be in the future"),
+		}
+	}
+
+	return nil
+}
+
+// APIServiceAccountToken is the data structure to capture API representation of an API token
+type APIServiceAccountToken struct {
+	// ID is the unique UUID v4 identifier of the token
+	ID string `json:"id"`
+	// Name is the name of the token
+	Name string `json:"name"`
+	// Description is the description of the token
+	Description *string `json:"description"`
+	// Org is the org the token is valid for
+	Org string `json:"org"`
+	// TenantID is the ID of the Tenant the token acts as
+	TenantID string `json:"tenantId"`
+	// SiteID is the ID of the Site the token is restricted to, if any
+	SiteID *string `json:"siteId"`
+	// Scopes are the resources and verbs the token allows
+	Scopes []string `json:"scopes"`
+	// TokenPrefix is the first characters of the token, used to identify it
+	TokenPrefix string `json:"tokenPrefix"`
+	// Token is the secret token, only returned when the token is created
+	Token *string `json:"token,omitempty"`
+	// Status is one of Active, Expired or Revoked
+	Status string `json:"status"`
+	// Expires is the time after which the token can no longer be used
+	Expires *time.Time `json:"expires"`
+	// LastUsed is the approximate time the token was last used
+	LastUsed *time.Time `json:"lastUsed"`
+	// Revoked is the time the token was revoked
+	Revoked *time.Time `json:"revoked"`
+	// Created indicates the ISO datetime string for when the token was created
+	Created time.Time `json:"created"`
+	// Updated indicates the ISO datetime string for when the token was last updated
+	Updated time.Time `json:"updated"`
+}
+
+// NewAPIServiceAccountToken accepts a DB layer ServiceAccountToken object and returns an API object
+func NewAPIServiceAccountToken(dbsat *cdbm.ServiceAccountToken) *APIServiceAccountToken {
+	apisat := &APIServiceAccountToken{
+		ID:          dbsat.ID.String(),
+		Name:        dbsat.Name,
+		Description: dbsat.Description,
+		Org:         dbsat.Org,
+		TenantID:    dbsat.TenantID.String(),
+		Scopes:      dbsat.Scopes,
+		TokenPrefix: dbsat.TokenPrefix,
+		Status:      ServiceAccountTokenStatusActive,
+		Expires:     dbsat.Expires,
+		LastUsed:    dbsat.LastUsed,
+		Revoked:     dbsat.Revoked,
+		Created:     dbsat.Created,
+		Updated:     dbsat.Updated,
+	}
+
+	if dbsat.SiteID != nil {
+		apisat.SiteID = cdb.GetStrPtr(dbsat.SiteID.String())
+	}
+
+	if dbsat.Revoked != nil {
+		apisat.Status = ServiceAccountTokenStatusRevoked
+	} else if dbsat.IsExpired(time.Now()) {
+		apisat.Status = ServiceAccountTokenStatusExpired
+	}
+
+	return apisat
+}
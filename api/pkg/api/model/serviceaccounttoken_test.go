@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIServiceAccountTokenCreateRequest_Validate(t *testing.T) {
+	tests := []struct {
+		desc      string
+		obj       APIServiceAccountTokenCreateRequest
+		expectErr bool
+	}{
+		{
+			desc: "ok when only required fields are provided",
+			obj:  APIServiceAccountTokenCreateRequest{Name: "ci-token", Scopes: []string{"instance:write"}},
+		},
+		{
+			desc: "ok when all fields are provided",
+			obj: APIServiceAccountTokenCreateRequest{
+				Name:        "ci-token",
+				Description: cdb.GetStrPtr("CI pipeline"),
+				Scopes:      []string{"instance:write", "*:read"},
+				SiteID:      cdb.GetStrPtr(uuid.NewString()),
+				Expires:     cdb.GetTimePtr(time.Now().Add(24 * time.Hour)),
+			},
+		},
+		{
+			desc:      "error when name is missing",
+			obj:       APIServiceAccountTokenCreateRequest{Scopes: []string{"instance:write"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when scopes are missing",
+			obj:       APIServiceAccountTokenCreateRequest{Name: "ci-token"},
+			expectErr: true,
+		},
+		{
+			desc:      "error when scope is invalid",
+			obj:       APIServiceAccountTokenCreateRequest{Name: "ci-token", Scopes: []string{"instance:admin"}},
+			expectErr: true,
+		},
+		{
+			desc:      "error when site ID is invalid",
+			obj:       APIServiceAccountTokenCreateRequest{Name: "ci-token", Scopes: []string{"instance:write"}, SiteID: cdb.GetStrPtr("bad-id")},
+			expectErr: true,
+		},
+		{
+			desc:      "error when expiry is in the past",
+			obj:       APIServiceAccountTokenCreateRequest{Name: "ci-token", Scopes: []string{"instance:write"}, Expires: cdb.GetTimePtr(time.Now().Add(-time.Hour))},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.obj.Validate()
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestNewAPIServiceAccountToken(t *testing.T) {
+	siteID := uuid.New()
+	dbsat := &cdbm.ServiceAccountToken{
+		ID:          uuid.New(),
+		Name:        "ci-token",
+		Org:         "test-org",
+		TenantID:    uuid.New(),
+		SiteID:      &siteID,
+		Scopes:      []string{"instance:write"},
+		TokenPrefix: "cbt_abcdefgh",
+		TokenHash:   "hash",
+		Created:     time.Now(),
+		Updated:     time.Now(),
+	}
+
+	apisat := NewAPIServiceAccountToken(dbsat)
+	assert.Equal(t, dbsat.ID.String(), apisat.ID)
+	assert.Equal(t, siteID.String(), *apisat.SiteID)
+	assert.Equal(t, dbsat.Scopes, apisat.Scopes)
+	assert.Equal(t, dbsat.TokenPrefix, apisat.TokenPrefix)
+	assert.Nil(t, apisat.Token)
+	assert.Equal(t, ServiceAccountTokenStatusActive, apisat.Status)
+
+	dbsat.Expires = cdb.GetTimePtr(time.Now().Add(-time.Minute))
+	assert.Equal(t, ServiceAccountTokenStatusExpired, NewAPIServiceAccountToken(dbsat).Status)
+
+	dbsat.Revoked = cdb.GetTimePtr(time.Now())
+	assert.Equal(t, ServiceAccountTokenStatusRevoked, NewAPIServiceAccountToken(dbsat).Status)
+}
@@ -200,6 +200,8 @@ type APISite struct {
 	// Capabilities holds the capabilities, currently for use
 	// as site-level feature flagging.
 	Capabilities *APISiteCapabilities `json:"capabilities"`
+	// BootstrapStatus is the bootstrap lifecycle status of the site as tracked by Site Manager, only visible to Provider
+	BootstrapStatus *APISiteBootstrapStatus `json:"bootstrapStatus,omitempty"`
 }
 
 // APISiteLocation information about site address
@@ -253,6 +255,7 @@ func NewAPISite(dbs cdbm.Site, dbsds []cdbm.StatusDetail, ts *cdbm.TenantSite) A
 		// Return Provider specific information
 		apiSite.RegistrationToken = dbs.RegistrationToken
 		apiSite.RegistrationTokenExpiration = dbs.RegistrationTokenExpiration
+		apiSite.BootstrapStatus = NewAPISiteBootstrapStatus(dbs.BootstrapStatus)
 	} else {
 		// Return Tenant specific information
 		apiSite.IsSerialConsoleSSHKeysEnabled = cdb.GetBoolPtr(ts.EnableSerialConsole)
@@ -271,6 +274,67 @@ func NewAPISite(dbs cdbm.Site, dbsds []cdbm.StatusDetail, ts *cdbm.TenantSite) A
 	return apiSite
 }
 
+// APISiteBootstrapStatus is the bootstrap lifecycle status of a site
+type APISiteBootstrapStatus struct {
+	// State is the bootstrap state of the site agent
+	State string `json:"state"`
+	// OTPExpiry is the ISO datetime string for when the current one time passcode expires
+	OTPExpiry *time.Time `json:"otpExpiry,omitempty"`
+	// LastCredentialFetch is the ISO datetime string for when the site agent last fetched credentials
+	LastCredentialFetch *time.Time `json:"lastCredentialFetch,omitempty"`
+	// LastCredentialFetchSourceIP is the source IP of the last credential fetch
+	LastCredentialFetchSourceIP string `json:"lastCredentialFetchSourceIp,omitempty"`
+	// LastCredentialFetchMethod is how credentials were last fetched, either with the OTP or by renewal
+	LastCredentialFetchMethod string `json:"lastCredentialFetchMethod,omitempty"`
+	// CertificateSerial is the serial number of the site agent client certificate
+	CertificateSerial string `json:"certificateSerial,omitempty"`
+	// CertificateExpiry is the ISO datetime string for when the site agent client certificate expires
+	CertificateExpiry *time.Time `json:"certificateExpiry,omitempty"`
+	// AgentVersion is the version of the site agent that last fetched credentials
+	AgentVersion string `json:"agentVersion,omitempty"`
+	// Rolls is the history of OTP rolls, oldest first
+	Rolls []APISiteBootstrapRoll `json:"rolls"`
+	// Synced is the ISO datetime string for when the status was last synced from Site Manager
+	Synced time.Time `json:"synced"`
+}
+
+// APISiteBootstrapRoll is an OTP roll of a site
+type APISiteBootstrapRoll struct {
+	// Time is the ISO datetime string for when the OTP was rolled
+	Time time.Time `json:"time"`
+	// PreviousState is the bootstrap state before the roll
+	PreviousState string `json:"previousState"`
+}
+
+// NewAPISiteBootstrapStatus creates and returns a new APISiteBootstrapStatus object, nil if the status has not been synced
+func NewAPISiteBootstrapStatus(dbbs *cdbm.SiteBootstrapStatus) *APISiteBootstrapStatus {
+	if dbbs == nil {
+		return nil
+	}
+
+	apiStatus := &APISiteBootstrapStatus{
+		State:                       dbbs.State,
+		OTPExpiry:                   dbbs.OTPExpiry,
+		LastCredentialFetch:         dbbs.LastCredsFetch,
+		LastCredentialFetchSourceIP: dbbs.LastCredsFetchSourceIP,
+		LastCredentialFetchMethod:   dbbs.LastCredsFetchMethod,
+		CertificateSerial:           dbbs.CertSerial,
+		CertificateExpiry:           dbbs.CertExpiry,
+		AgentVersion:                dbbs.AgentVersion,
+		Rolls:                       []APISiteBootstrapRoll{},
+		Synced:                      dbbs.Synced,
+	}
+
+	for _, roll := range dbbs.Rolls {
+		apiStatus.Rolls = append(apiStatus.Rolls, APISiteBootstrapRoll{
+			Time:          roll.Time,
+			PreviousState: roll.PreviousState,
+		})
+	}
+
+	return apiStatus
+}
+
 // APISiteCapabilities holds the model of site capabilities
 type APISiteCapabilities struct {
 	NativeNetworking          bool `json:"nativeNetworking"`
@@ -71,6 +71,17 @@ func TestNewAPISite(t *testing.T) {
 		Contact: &cdbm.SiteContact{
 			Email: "johndoe@nvidia.com",
 		},
+		BootstrapStatus: &cdbm.SiteBootstrapStatus{
+			State:                  "RegistrationComplete",
+			LastCredsFetch:         cdb.GetTimePtr(time.Now()),
+			LastCredsFetchSourceIP: "10.0.0.1",
+			LastCredsFetchMethod:   "otp",
+			CertSerial:             "1f",
+			CertExpiry:             cdb.GetTimePtr(time.Now().Add(90 * 24 * time.Hour)),
+			AgentVersion:           "v1.2.3",
+			Rolls:                  []cdbm.SiteBootstrapRoll{{Time: time.Now(), PreviousState: "AwaitHandshake"}},
+			Synced:                 time.Now(),
+		},
 	}
 
 	dbsds := []cdbm.StatusDetail{
@@ -140,6 +151,7 @@ func TestNewAPISite(t *testing.T) {
 					State:   "CA",
 					Country: "USA",
 				},
+				BootstrapStatus: NewAPISiteBootstrapStatus(dbs.BootstrapStatus),
 			},
 		},
 		{
@@ -202,6 +214,7 @@ func TestNewAPISite(t *testing.T) {
 				assert.Equal(t, tt.want.RegistrationToken, got.RegistrationToken)
 				assert.Equal(t, tt.want.RegistrationTokenExpiration, got.RegistrationTokenExpiration)
 			}
+			assert.Equal(t, tt.want.BootstrapStatus, got.BootstrapStatus)
 			assert.Equal(t, *tt.want.SerialConsoleHostname, *got.SerialConsoleHostname)
 			assert.Equal(t, tt.want.IsSerialConsoleEnabled, got.IsSerialConsoleEnabled)
 			assert.Equal(t, *tt.want.SerialConsoleIdleTimeout, *got.SerialConsoleIdleTimeout)
@@ -230,6 +243,36 @@ func TestNewAPISite(t *testing.T) {
 	}
 }
 
+func TestNewAPISiteBootstrapStatus(t *testing.T) {
+	rolled := time.Now().Add(-time.Hour)
+	dbbs := &cdbm.SiteBootstrapStatus{
+		State:                  "RegistrationComplete",
+		OTPExpiry:              cdb.GetTimePtr(time.Now().Add(time.Hour)),
+		LastCredsFetch:         cdb.GetTimePtr(time.Now()),
+		LastCredsFetchSourceIP: "10.0.0.1",
+		LastCredsFetchMethod:   "renew",
+		CertSerial:             "1f",
+		CertExpiry:             cdb.GetTimePtr(time.Now().Add(90 * 24 * time.Hour)),
+		AgentVersion:           "v1.2.3",
+		Rolls:                  []cdbm.SiteBootstrapRoll{{Time: rolled, PreviousState: "AwaitHandshake"}},
+		Synced:                 time.Now(),
+	}
+
+	assert.Nil(t, NewAPISiteBootstrapStatus(nil))
+
+	got := NewAPISiteBootstrapStatus(dbbs)
+	assert.Equal(t, dbbs.State, got.State)
+	assert.Equal(t, dbbs.OTPExpiry, got.OTPExpiry)
+	assert.Equal(t, dbbs.LastCredsFetch, got.LastCredentialFetch)
+	assert.Equal(t, dbbs.LastCredsFetchSourceIP, got.LastCredentialFetchSourceIP)
+	assert.Equal(t, dbbs.LastCredsFetchMethod, got.LastCredentialFetchMethod)
+	assert.Equal(t, dbbs.CertSerial, got.CertificateSerial)
+	assert.Equal(t, dbbs.CertExpiry, got.CertificateExpiry)
+	assert.Equal(t, dbbs.AgentVersion, got.AgentVersion)
+	assert.Equal(t, []APISiteBootstrapRoll{{Time: rolled, PreviousState: "AwaitHandshake"}}, got.Rolls)
+	assert.Equal(t, dbbs.Synced, got.Synced)
+}
+
 func TestAPISiteCreateRequest_Validate(t *testing.T) {
 	type fields struct {
 		Name                  string
@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+const (
+	// TenantUsageGranularityTotal aggregates usage over the whole report period
+	TenantUsageGranularityTotal = "total"
+	// TenantUsageGranularityDaily reports usage for each day of the report period
+	TenantUsageGranularityDaily = "daily"
+)
+
+// APITenantUsage is the data structure to capture the usage of an Instance Type by a Tenant at a Site
+type APITenantUsage struct {
+	// Date is the UTC day of the usage, set only for daily granularity
+	Date *string `json:"date,omitempty"`
+	// TenantID is the ID of the Tenant
+	TenantID string `json:"tenantId"`
+	// TenantOrg is the org of the Tenant
+	TenantOrg string `json:"tenantOrg"`
+	// SiteID is the ID of the Site
+	SiteID string `json:"siteId"`
+	// InstanceTypeID is the ID of the Instance Type, empty for Instances without an Instance Type
+	InstanceTypeID *string `json:"instanceTypeId"`
+	// InstanceCount is the number of Instances that accrued usage, the daily peak for total granularity
+	InstanceCount int `json:"instanceCount"`
+	// InstanceHours is the number of hours Instances spent in a billable status
+	InstanceHours float64 `json:"instanceHours"`
+	// AllocatedCount is the number of Machines allocated at the end of the day, the daily peak for total granularity
+	AllocatedCount int `json:"allocatedCount"`
+	// AllocatedHours is the number of Machine hours allocated to the Tenant
+	AllocatedHours float64 `json:"allocatedHours"`
+	// Utilization is the percentage of allocated Machine hours used by Instances, nil when nothing was allocated
+	Utilization *float64 `json:"utilization"`
+}
+
+// APITenantUsageTotal is the data structure to capture the usage summed over all rows of a report
+type APITenantUsageTotal struct {
+	// InstanceHours is the number of hours Instances spent in a billable status
+	InstanceHours float64 `json:"instanceHours"`
+	// AllocatedHours is the number of Machine hours allocated
+	AllocatedHours float64 `json:"allocatedHours"`
+	// Utilization is the percentage of allocated Machine hours used by Instances, nil when nothing was allocated
+	Utilization *float64 `json:"utilization"`
+}
+
+// APITenantUsageReport is the data structure to capture Tenant usage over a period of UTC days
+type APITenantUsageReport struct {
+	// StartDate is the first day of the report, inclusive
+	StartDate string `json:"startDate"`
+	// EndDate is the last day of the report, inclusive
+	EndDate string `json:"endDate"`
+	// Granularity is either total or daily
+	Granularity string `json:"granularity"`
+	// Usage is the list of usage rows
+	Usage []APITenantUsage `json:"usage"`
+	// Total is the usage summed over all rows
+	Total APITenantUsageTotal `json:"total"`
+}
+
+// tenantUsageKey identifies an aggregated usage row
+type tenantUsageKey struct {
+	date           string
+	tenantID       uuid.UUID
+	siteID         uuid.UUID
+	instanceTypeID uuid.UUID
+}
+
+// NewAPITenantUsageReport accepts daily DB usage rows and the orgs of their Tenants and returns an API layer object.
+// Rows are aggregated per Tenant, Site and Instance Type, and per day for daily granularity.
+func NewAPITenantUsageReport(startDate time.Time, endDate time.Time, granularity string, dbtus []cdbm.TenantUsage, tenantOrgs map[uuid.UUID]string) *APITenantUsageReport {
+	report := &APITenantUsageReport{
+		StartDate:   startDate.Format(time.DateOnly),
+		EndDate:     endDate.Format(time.DateOnly),
+		Granularity: granularity,
+		Usage:       []APITenantUsage{},
+	}
+
+	rows := map[tenantUsageKey]*APITenantUsage{}
+	keys := []tenantUsageKey{}
+
+	for _, dbtu := range dbtus {
+		key := tenantUsageKey{tenantID: dbtu.TenantID, siteID: dbtu.SiteID}
+		if dbtu.InstanceTypeID != nil {
+			key.instanceTypeID = *dbtu.InstanceTypeID
+		}
+		if granularity == TenantUsageGranularityDaily {
+			key.date = dbtu.UsageDate.Format(time.DateOnly)
+		}
+
+		row, ok := rows[key]
+		if !ok {
+			row = &APITenantUsage{
+				TenantID:  dbtu.TenantID.String(),
+				TenantOrg: tenantOrgs[dbtu.TenantID],
+				SiteID:    dbtu.SiteID.String(),
+			}
+			if dbtu.InstanceTypeID != nil {
+				row.InstanceTypeID = cdb.GetStrPtr(dbtu.InstanceTypeID.String())
+			}
+			if key.date != "" {
+				row.Date = cdb.GetStrPtr(key.date)
+			}
+			rows[key] = row
+			keys = append(keys, key)
+		}
+
+		row.InstanceCount = max(row.InstanceCount, dbtu.InstanceCount)
+		row.InstanceHours += dbtu.InstanceHours
+		row.AllocatedCount = max(row.AllocatedCount, dbtu.AllocatedCount)
+		row.AllocatedHours += dbtu.AllocatedHours
+
+		report.Total.InstanceHours += dbtu.InstanceHours
+		report.Total.AllocatedHours += dbtu.AllocatedHours
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		ri, rj := rows[keys[i]], rows[keys[j]]
+		if keys[i].date != keys[j].date {
+			return keys[i].date < keys[j].date
+		}
+		if ri.TenantOrg != rj.TenantOrg {
+			return ri.TenantOrg < rj.TenantOrg
+		}
+		if ri.TenantID != rj.TenantID {
+			return ri.TenantID < rj.TenantID
+		}
+		if ri.SiteID != rj.SiteID {
+			return ri.SiteID < rj.SiteID
+		}
+		return keys[i].instanceTypeID.String() < keys[j].instanceTypeID.String()
+	})
+
+	for _, key := range keys {
+		row := rows[key]
+		row.InstanceHours = roundUsageHours(row.InstanceHours)
+		row.AllocatedHours = roundUsageHours(row.AllocatedHours)
+		row.Utilization = usageUtilization(row.InstanceHours, row.AllocatedHours)
+		report.Usage = append(report.Usage, *row)
+	}
+
+	report.Total.InstanceHours = roundUsageHours(report.Total.InstanceHours)
+	report.Total.AllocatedHours = roundUsageHours(report.Total.AllocatedHours)
+	report.Total.Utilization = usageUtilization(report.Total.InstanceHours, report.Total.AllocatedHours)
+
+	return report
+}
+
+// CSVHeader returns the column names of the CSV export of the report
+func (r *APITenantUsageReport) CSVHeader() []string {
+	header := []string{"tenantId", "tenantOrg", "siteId", "instanceTypeId", "instanceCount", "instanceHours", "allocatedCount", "allocatedHours", "utilization"}
+	if r.Granularity == TenantUsageGranularityDaily {
+		header = append([]string{"date"}, header...)
+	}
+	return header
+}
+
+// CSVRecords returns one CSV record per usage row of the report
+func (r *APITenantUsageReport) CSVRecords() [][]string {
+	records := make([][]string, 0, len(r.Usage))
+	for _, u := range r.Usage {
+		record := []string{
+			u.TenantID,
+			u.TenantOrg,
+			u.SiteID,
+			derefString(u.InstanceTypeID),
+			strconv.Itoa(u.InstanceCount),
+			strconv.FormatFloat(u.InstanceHours, 'f', -1, 64),
+			strconv.Itoa(u.AllocatedCount),
+			strconv.FormatFloat(u.AllocatedHours, 'f', -1, 64),
+			"",
+		}
+		if u.Utilization != nil {
+			record[8] = strconv.FormatFloat(*u.Utilization, 'f', -1, 64)
+		}
+		if r.Granularity == TenantUsageGranularityDaily {
+			record = append([]string{derefString(u.Date)}, record...)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// roundUsageHours rounds hours to four decimal places
+func roundUsageHours(hours float64) float64 {
+	return math.Round(hours*1e4) / 1e4
+}
+
+// usageUtilization returns used hours as a percentage of allocated hours, rounded to two decimal places
+func usageUtilization(usedHours float64, allocatedHours float64) *float64 {
+	if allocatedHours == 0 {
+		return nil
+	}
+	utilization := math.Round(usedHours/allocatedHours*1e4) / 1e2
+	return &utilization
+}
+
+// derefString returns the string pointed to, or an empty string for nil
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
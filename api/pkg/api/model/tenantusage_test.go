@@ -0,0 +1,105 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAPITenantUsageReport(t *testing.T) {
+	tnID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	stID := uuid.MustParse("00000000-0000-0000-0000-000000000002")
+	itID := uuid.MustParse("00000000-0000-0000-0000-000000000003")
+
+	day1 := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+
+	dbtus := []cdbm.TenantUsage{
+		{TenantID: tnID, SiteID: stID, InstanceTypeID: &itID, UsageDate: day1, InstanceCount: 2, InstanceHours: 36, AllocatedCount: 4, AllocatedHours: 96},
+		{TenantID: tnID, SiteID: stID, InstanceTypeID: &itID, UsageDate: day2, InstanceCount: 3, InstanceHours: 60, AllocatedCount: 3, AllocatedHours: 72},
+		{TenantID: tnID, SiteID: stID, UsageDate: day1, InstanceCount: 1, InstanceHours: 1.5},
+	}
+	tenantOrgs := map[uuid.UUID]string{tnID: "test-org"}
+
+	tests := []struct {
+		name        string
+		granularity string
+		want        *APITenantUsageReport
+		wantHeader  []string
+		wantRecords [][]string
+	}{
+		{
+			name:        "test total granularity aggregates days",
+			granularity: TenantUsageGranularityTotal,
+			want: &APITenantUsageReport{
+				StartDate:   "2026-04-01",
+				EndDate:     "2026-04-02",
+				Granularity: TenantUsageGranularityTotal,
+				Usage: []APITenantUsage{
+					{TenantID: tnID.String(), TenantOrg: "test-org", SiteID: stID.String(), InstanceCount: 1, InstanceHours: 1.5},
+					{TenantID: tnID.String(), TenantOrg: "test-org", SiteID: stID.String(), InstanceTypeID: cdb.GetStrPtr(itID.String()), InstanceCount: 3, InstanceHours: 96, AllocatedCount: 4, AllocatedHours: 168, Utilization: floatPtr(57.14)},
+				},
+				Total: APITenantUsageTotal{InstanceHours: 97.5, AllocatedHours: 168, Utilization: floatPtr(58.04)},
+			},
+			wantHeader: []string{"tenantId", "tenantOrg", "siteId", "instanceTypeId", "instanceCount", "instanceHours", "allocatedCount", "allocatedHours", "utilization"},
+			wantRecords: [][]string{
+				{tnID.String(), "test-org", stID.String(), "", "1", "1.5", "0", "0", ""},
+				{tnID.String(), "test-org", stID.String(), itID.String(), "3", "96", "4", "168", "57.14"},
+			},
+		},
+		{
+			name:        "test daily granularity keeps days apart",
+			granularity: TenantUsageGranularityDaily,
+			want: &APITenantUsageReport{
+				StartDate:   "2026-04-01",
+				EndDate:     "2026-04-02",
+				Granularity: TenantUsageGranularityDaily,
+				Usage: []APITenantUsage{
+					{Date: cdb.GetStrPtr("2026-04-01"), TenantID: tnID.String(), TenantOrg: "test-org", SiteID: stID.String(), InstanceCount: 1, InstanceHours: 1.5},
+					{Date: cdb.GetStrPtr("2026-04-01"), TenantID: tnID.String(), TenantOrg: "test-org", SiteID: stID.String(), InstanceTypeID: cdb.GetStrPtr(itID.String()), InstanceCount: 2, InstanceHours: 36, AllocatedCount: 4, AllocatedHours: 96, Utilization: floatPtr(37.5)},
+					{Date: cdb.GetStrPtr("2026-04-02"), TenantID: tnID.String(), TenantOrg: "test-org", SiteID: stID.String(), InstanceTypeID: cdb.GetStrPtr(itID.String()), InstanceCount: 3, InstanceHours: 60, AllocatedCount: 3, AllocatedHours: 72, Utilization: floatPtr(83.33)},
+				},
+				Total: APITenantUsageTotal{InstanceHours: 97.5, AllocatedHours: 168, Utilization: floatPtr(58.04)},
+			},
+			wantHeader: []string{"date", "tenantId", "tenantOrg", "siteId", "instanceTypeId", "instanceCount", "instanceHours", "allocatedCount", "allocatedHours", "utilization"},
+			wantRecords: [][]string{
+				{"2026-04-01", tnID.String(), "test-org", stID.String(), "", "1", "1.5", "0", "0", ""},
+				{"2026-04-01", tnID.String(), "test-org", stID.String(), itID.String(), "2", "36", "4", "96", "37.5"},
+				{"2026-04-02", tnID.String(), "test-org", stID.String(), itID.String(), "3", "60", "3", "72", "83.33"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewAPITenantUsageReport(day1, day2, tt.granularity, dbtus, tenantOrgs)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantHeader, got.CSVHeader())
+			assert.Equal(t, tt.wantRecords, got.CSVRecords())
+		})
+	}
+}
+
+func floatPtr(f float64) *float64 {
+	return &f
+}
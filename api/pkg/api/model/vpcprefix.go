@@ -49,6 +49,8 @@ type APIVpcPrefixCreateRequest struct {
 	IPBlockID *string `json:"ipBlockId"`
 	// PrefixLength is the length of the prefix
 	PrefixLength int `json:"prefixLength"`
+	// ReservedRanges are the ranges of the VpcPrefix which are excluded from allocation
+	ReservedRanges []APIReservedIPRange `json:"reservedRanges"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -67,6 +69,7 @@ func (vpcr APIVpcPrefixCreateRequest) Validate() error {
 			validation.Required.Error(validationErrorValueRequired),
 			validation.Min(VpcPrefixBlockSizeMin).Error(validationErrorVpcPrefixBlockSizeMin),
 			validation.Max(VpcPrefixBlockSizeMax).Error(validationErrorVpcPrefixBlockSizeMax)),
+		validation.Field(&vpcr.ReservedRanges),
 	)
 
 	if err != nil {
@@ -84,6 +87,8 @@ type APIVpcPrefixUpdateRequest struct {
 	IPBlockID *string `json:"ipBlockId"`
 	// PrefixLength is the length of the prefix
 	PrefixLength *int `json:"prefixLength"`
+	// ReservedRanges replaces the ranges of the VpcPrefix which are excluded from allocation, an empty list clears them
+	ReservedRanges *[]APIReservedIPRange `json:"reservedRanges"`
 }
 
 // Validate ensure the values passed in request are acceptable
@@ -97,7 +102,7 @@ func (vpur APIVpcPrefixUpdateRequest) Validate() error {
 
 	if vpur.IPBlockID != nil || vpur.PrefixLength != nil {
 		return validation.Errors{
-			"prefix": errors.New("update has not been supported yet, only name allowed"),
+			"prefix": errors.New("update has not been supported yet, only name and reservedRanges allowed"),
 		}
 	}
 
@@ -132,6 +137,8 @@ type APIVpcPrefix struct {
 	PrefixLength int `json:"prefixLength"`
 	// Status is the status of the VpcPrefix
 	Status string `json:"status"`
+	// ReservedRanges are the ranges of the VpcPrefix which are excluded from allocation
+	ReservedRanges []APIReservedIPRange `json:"reservedRanges,omitempty"`
 	// StatusHistory is the history of statuses for the VpcPrefix
 	StatusHistory []APIStatusDetail `json:"statusHistory"`
 	// CreatedAt indicates the ISO datetime string for when the entity was created
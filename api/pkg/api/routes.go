@@ -54,6 +54,27 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetCurrentServiceAccountHandler(dbSession, cfg),
 		},
+		// Service Account API token endpoints
+		{
+			Path:    apiPathPrefix + "/service-account/token",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateServiceAccountTokenHandler(dbSession, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/service-account/token",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllServiceAccountTokenHandler(dbSession, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/service-account/token/:id",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetServiceAccountTokenHandler(dbSession, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/service-account/token/:id",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteServiceAccountTokenHandler(dbSession, cfg),
+		},
 		// Infrastructure Provider endpoints
 		{
 			Path:    apiPathPrefix + "/infrastructure-provider",
@@ -75,6 +96,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetCurrentInfrastructureProviderStatsHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/infrastructure-provider/current/usage",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetCurrentInfrastructureProviderUsageHandler(dbSession, cfg),
+		},
 		// Tenant endpoints
 		{
 			Path:    apiPathPrefix + "/tenant",
@@ -96,6 +122,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetCurrentTenantStatsHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/tenant/current/usage",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetCurrentTenantUsageHandler(dbSession, cfg),
+		},
 		// Tenant Instance Type Stats endpoint
 		{
 			Path:    apiPathPrefix + "/tenant/instance-type/stats",
@@ -285,6 +316,48 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetInstanceStatusDetailsHandler(dbSession),
 		},
+		{
+			Path:    apiPathPrefix + "/instance/:id/effective-security-rules",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetInstanceEffectiveSecurityRulesHandler(dbSession, tc, cfg),
+		},
+		// InstanceTemplate endpoints
+		{
+			Path:    apiPathPrefix + "/instance-template",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateInstanceTemplateHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/instance-template",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllInstanceTemplateHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/instance-template/:id",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetInstanceTemplateHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/instance-template/:id",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateInstanceTemplateHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/instance-template/:id",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteInstanceTemplateHandler(dbSession, tc, cfg),
+		},
+		// InstanceTemplateVersion endpoints
+		{
+			Path:    apiPathPrefix + "/instance-template/:id/version",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllInstanceTemplateVersionHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/instance-template/:id/version/:version",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetInstanceTemplateVersionHandler(dbSession, tc, cfg),
+		},
 		// Instance Type endpoints
 		{
 			Path:    apiPathPrefix + "/instance/type",
@@ -386,6 +459,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetAllExpectedMachineHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/expected-machine/bulk",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewBulkCreateExpectedMachineHandler(dbSession, tc, scp, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/expected-machine/:id",
 			Method:  http.MethodGet,
@@ -412,6 +490,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetAllExpectedPowerShelfHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/expected-power-shelf/bulk",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewBulkCreateExpectedPowerShelfHandler(dbSession, tc, scp, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/expected-power-shelf/:id",
 			Method:  http.MethodGet,
@@ -438,6 +521,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetAllExpectedSwitchHandler(dbSession, tc, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/expected-switch/bulk",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewBulkCreateExpectedSwitchHandler(dbSession, tc, scp, cfg),
+		},
 		{
 			Path:    apiPathPrefix + "/expected-switch/:id",
 			Method:  http.MethodGet,
@@ -514,6 +602,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodDelete,
 			Handler: apiHandler.NewDeleteMachineInstanceTypeHandler(dbSession, tc, scp, cfg),
 		},
+		{
+			Path:    apiPathPrefix + "/instance/type/:instanceTypeId/match",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetInstanceTypeMatchHandler(dbSession, cfg),
+		},
 		// Allocation endpoints
 		{
 			Path:    apiPathPrefix + "/allocation",
@@ -598,6 +691,32 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodDelete,
 			Handler: apiHandler.NewDeleteOperatingSystemHandler(dbSession, tc, scp, cfg),
 		},
+		// OperatingSystemVersion endpoints
+		{
+			Path:    apiPathPrefix + "/operating-system/:id/version",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateOperatingSystemVersionHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/operating-system/:id/version",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllOperatingSystemVersionHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/operating-system/:id/version/:versionId",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetOperatingSystemVersionHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/operating-system/:id/version/:versionId",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateOperatingSystemVersionHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/operating-system/:id/version/:versionId/verify",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewVerifyOperatingSystemVersionHandler(dbSession, tc, cfg),
+		},
 		// NetworkSecurityGroup endpoints
 		{
 			Path:    apiPathPrefix + "/network-security-group",
@@ -605,6 +724,12 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Handler: apiHandler.NewCreateNetworkSecurityGroupHandler(dbSession, tc, scp, cfg),
 		},
 
+		{
+			Path:    apiPathPrefix + "/network-security-group/evaluate",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewEvaluateNetworkSecurityGroupHandler(dbSession, tc, cfg),
+		},
+
 		{
 			Path:    apiPathPrefix + "/network-security-group",
 			Method:  http.MethodGet,
@@ -629,6 +754,33 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Handler: apiHandler.NewDeleteNetworkSecurityGroupHandler(dbSession, tc, scp, cfg),
 		},
 
+		// AddressSet endpoints
+		{
+			Path:    apiPathPrefix + "/address-set",
+			Method:  http.MethodPost,
+			Handler: apiHandler.NewCreateAddressSetHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-set",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAllAddressSetHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-set/:id",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAddressSetHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-set/:id",
+			Method:  http.MethodPatch,
+			Handler: apiHandler.NewUpdateAddressSetHandler(dbSession, tc, cfg),
+		},
+		{
+			Path:    apiPathPrefix + "/address-set/:id",
+			Method:  http.MethodDelete,
+			Handler: apiHandler.NewDeleteAddressSetHandler(dbSession, tc, cfg),
+		},
+
 		// SSHKey endpoints
 		{
 			Path:    apiPathPrefix + "/sshkey",
@@ -693,6 +845,11 @@ func NewAPIRoutes(dbSession *cdb.Session, tc tClient.Client, tnc tClient.Namespa
 			Method:  http.MethodGet,
 			Handler: apiHandler.NewGetAllAuditEntryHandler(dbSession),
 		},
+		{
+			Path:    apiPathPrefix + "/audit/export",
+			Method:  http.MethodGet,
+			Handler: apiHandler.NewGetAuditEntryExportHandler(dbSession),
+		},
 		{
 			Path:    apiPathPrefix + "/audit/:id",
 			Method:  http.MethodGet,
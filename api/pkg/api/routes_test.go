@@ -23,6 +23,8 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
 	"github.com/nvidia/bare-metal-manager-rest/api/pkg/api/handler/util/common"
 	sc "github.com/nvidia/bare-metal-manager-rest/api/pkg/client/site"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/processors"
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	"github.com/stretchr/testify/assert"
 
@@ -47,40 +49,44 @@ func TestNewAPIRoutes(t *testing.T) {
 	scp := sc.NewClientPool(tcfg)
 
 	routeCount := map[string]int{
-		"metadata":                1,
-		"service-account":         1,
-		"infrastructure-provider": 4,
-		"tenant":                  4,
-		"tenant-account":          5,
-		"site":                    6,
-		"vpc":                     6,
-		"vpcprefix":               5,
-		"ip-block":                6,
-		"instance":                8,
-		"interface":               1,
-		"infiniband-partition":    5,
-		"nvlink-interface":        5,
-		"expected-machine":        5,
-		"expected-power-shelf":    5,
-		"expected-switch":         5,
-		"instance-type":           5,
-		"machine":                 5,
-		"allocation":              6,
-		"subnet":                  5,
-		"machine-instance-type":   3,
-		"user":                    1,
-		"operating-system":        5,
-		"sshkey":                  5,
-		"sshkeygroup":             5,
-		"machine-capability":      1,
-		"audit":                   2,
-		"network-security-group":  5,
-		"machine-validation":      11,
-		"dpu-extension-service":   7,
-		"sku":                     2,
-		"rack":                    10,
-		"tray":                    8,
-		"stats":                   4,
+		"metadata":                  1,
+		"service-account":           5,
+		"infrastructure-provider":   5,
+		"tenant":                    5,
+		"tenant-account":            5,
+		"site":                      6,
+		"vpc":                       6,
+		"vpcprefix":                 5,
+		"ip-block":                  6,
+		"instance":                  9,
+		"interface":                 1,
+		"infiniband-partition":      5,
+		"nvlink-interface":          5,
+		"expected-machine":          6,
+		"expected-power-shelf":      6,
+		"expected-switch":           6,
+		"instance-template":         5,
+		"instance-template-version": 2,
+		"instance-type":             5,
+		"machine":                   5,
+		"allocation":                6,
+		"subnet":                    5,
+		"machine-instance-type":     4,
+		"user":                      1,
+		"operating-system":          5,
+		"operating-system-version":  5,
+		"sshkey":                    5,
+		"sshkeygroup":               5,
+		"machine-capability":        1,
+		"audit":                     3,
+		"network-security-group":    6,
+		"address-set":               5,
+		"machine-validation":        11,
+		"dpu-extension-service":     7,
+		"sku":                       2,
+		"rack":                      10,
+		"tray":                      8,
+		"stats":                     4,
 	}
 
 	totalRouteCount := 0
@@ -109,8 +115,19 @@ func TestNewAPIRoutes(t *testing.T) {
 
 			assert.Equal(t, totalRouteCount, len(got))
 
+			resources := map[string]bool{}
+			for _, route := range got {
+				resources[core.GetAPITokenResource(route.Path)] = true
+			}
+			// Resources API tokens can be scoped to must exist
+			for resource := range core.APITokenResources {
+				assert.True(t, resources[resource], "API token resource %s has no routes", resource)
+			}
+
 			for _, route := range got {
 				assert.Contains(t, route.Path, "/org/:orgName/"+cfg.GetAPIName())
+				// New routes must decide how Site scoped API tokens are restricted, or explicitly deny them
+				assert.True(t, processors.HasAPITokenSitePolicy(route.Path), "route %s has no Site policy for API tokens in auth/pkg/processors/apitokensite.go", route.Path)
 			}
 		})
 	}
@@ -0,0 +1,346 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	ccu "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+// Rate limiting response headers, see https://datatracker.ietf.org/doc/draft-ietf-httpapi-ratelimit-headers/
+const (
+	HeaderRateLimitLimit     = "RateLimit-Limit"
+	HeaderRateLimitRemaining = "RateLimit-Remaining"
+	HeaderRateLimitReset     = "RateLimit-Reset"
+	HeaderRateLimitPolicy    = "RateLimit-Policy"
+)
+
+// Rate limiting classes, each with a separate budget
+const (
+	RateLimitClassRead      = "read"
+	RateLimitClassWrite     = "write"
+	RateLimitClassExpensive = "expensive"
+)
+
+// RateLimitStore counts requests against rate limiting keys in fixed windows
+type RateLimitStore interface {
+	// Increment counts a request against the key in the window starting at windowStart and returns the
+	// number of requests counted in that window so far
+	Increment(ctx context.Context, key string, windowStart time.Time, window time.Duration) (int, error)
+}
+
+// NewRateLimitStore returns the store configured for rate limiting
+func NewRateLimitStore(cfg *config.RateLimiterConfig, dbSession *cdb.Session) (RateLimitStore, error) {
+	switch cfg.Store {
+	case config.RateLimiterStorePostgres:
+		return NewDBRateLimitStore(dbSession), nil
+	case config.RateLimiterStoreMemory:
+		return NewMemoryRateLimitStore(), nil
+	default:
+		return nil, fmt.Errorf("unknown rate limiter store: %s", cfg.Store)
+	}
+}
+
+// MemoryRateLimitStore keeps request counts in memory, it is not shared between API replicas
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	counts  map[string]int
+	current time.Time
+}
+
+// NewMemoryRateLimitStore returns a new MemoryRateLimitStore
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		counts: map[string]int{},
+	}
+}
+
+// Increment implements RateLimitStore
+func (s *MemoryRateLimitStore) Increment(_ context.Context, key string, windowStart time.Time, _ time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Only the current window is kept, counts of previous windows are dropped when a new one starts
+	if windowStart.After(s.current) {
+		s.current = windowStart
+		s.counts = map[string]int{}
+	} else if windowStart.Before(s.current) {
+		// The window of the request already ended, it is no longer counted
+		return 1, nil
+	}
+
+	s.counts[key]++
+	return s.counts[key], nil
+}
+
+// DBRateLimitSyncInterval is how often a DBRateLimitStore adds the requests it counted to Postgres
+const DBRateLimitSyncInterval = time.Second
+
+// DBRateLimitStore keeps request counts in Postgres, shared between API replicas. Requests are counted in memory
+// and added to Postgres in the background at most once per sync interval, so requests neither wait on Postgres
+// nor queue on the row lock of a busy key. Requests admitted by other replicas are seen after the next sync, so a
+// key can exceed its limit by what the other replicas admit in one sync interval.
+type DBRateLimitStore struct {
+	dao          cdbm.RateLimitCounterDAO
+	syncInterval time.Duration
+
+	mu         sync.Mutex
+	counters   map[string]*dbRateLimitCounter
+	syncing    bool
+	lastSynced time.Time
+	lastPurged time.Time
+}
+
+// dbRateLimitCounter is the count of a key in the current window as known to this replica
+type dbRateLimitCounter struct {
+	windowStart time.Time
+	expires     time.Time
+	// synced is the count in Postgres as of the last sync, including the requests this replica added
+	synced int
+	// pending is the requests counted by this replica that are not added to Postgres yet
+	pending int
+}
+
+// NewDBRateLimitStore returns a new DBRateLimitStore
+func NewDBRateLimitStore(dbSession *cdb.Session) *DBRateLimitStore {
+	return newDBRateLimitStore(cdbm.NewRateLimitCounterDAO(dbSession), DBRateLimitSyncInterval)
+}
+
+func newDBRateLimitStore(dao cdbm.RateLimitCounterDAO, syncInterval time.Duration) *DBRateLimitStore {
+	return &DBRateLimitStore{
+		dao:          dao,
+		syncInterval: syncInterval,
+		counters:     map[string]*dbRateLimitCounter{},
+	}
+}
+
+// Increment implements RateLimitStore
+func (s *DBRateLimitStore) Increment(_ context.Context, key string, windowStart time.Time, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.purgeExpired(windowStart, window)
+
+	counter := s.counters[key]
+	if counter == nil || windowStart.After(counter.windowStart) {
+		counter = &dbRateLimitCounter{windowStart: windowStart, expires: windowStart.Add(window)}
+		s.counters[key] = counter
+	} else if windowStart.Before(counter.windowStart) {
+		// The window of the request already ended, it is no longer counted
+		return 1, nil
+	}
+	counter.pending++
+
+	if now := time.Now(); !s.syncing && now.Sub(s.lastSynced) >= s.syncInterval {
+		s.syncing = true
+		s.lastSynced = now
+		go s.sync(window)
+	}
+
+	return counter.synced + counter.pending, nil
+}
+
+// sync adds the pending counts to Postgres and picks up the requests counted by other replicas
+func (s *DBRateLimitStore) sync(window time.Duration) {
+	defer func() {
+		s.mu.Lock()
+		s.syncing = false
+		s.mu.Unlock()
+	}()
+
+	type delta struct {
+		key     string
+		counter dbRateLimitCounter
+	}
+
+	s.mu.Lock()
+	now := time.Now()
+	var deltas []delta
+	for key, counter := range s.counters {
+		if counter.pending == 0 {
+			// Counters of ended windows are dropped once they have nothing left to sync
+			if !counter.expires.After(now) {
+				delete(s.counters, key)
+			}
+			continue
+		}
+		deltas = append(deltas, delta{key: key, counter: *counter})
+		counter.pending = 0
+	}
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), window)
+	defer cancel()
+
+	for _, d := range deltas {
+		count, err := s.dao.Increment(ctx, nil, d.key, d.counter.windowStart, d.counter.expires, d.counter.pending)
+
+		s.mu.Lock()
+		counter := s.counters[d.key]
+		if counter != nil && counter.windowStart.Equal(d.counter.windowStart) {
+			if err != nil {
+				// Keep the requests to add them on the next sync
+				counter.pending += d.counter.pending
+			} else {
+				counter.synced = count
+			}
+		}
+		s.mu.Unlock()
+
+		if err != nil {
+			log.Warn().Err(err).Str("key", d.key).Msg("failed to sync rate limit counter")
+		}
+	}
+}
+
+// purgeExpired deletes expired counters in the background, at most once per window, it must be called with the
+// lock held
+func (s *DBRateLimitStore) purgeExpired(windowStart time.Time, window time.Duration) {
+	if windowStart.Sub(s.lastPurged) < window {
+		return
+	}
+	s.lastPurged = windowStart
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), window)
+		defer cancel()
+
+		if _, err := s.dao.DeleteExpired(ctx, nil, windowStart); err != nil {
+			log.Warn().Err(err).Msg("failed to purge expired rate limit counters")
+		}
+	}()
+}
+
+// rateLimitCheck is a limit applied to a rate limiting key
+type rateLimitCheck struct {
+	key   string
+	limit int
+}
+
+// RateLimiter returns a middleware that limits requests per org and per user or service account, with separate
+// budgets for read, mutating and expensive requests. It must be added after the auth middleware, requests that
+// fail authentication are limited per client IP ahead of it.
+func RateLimiter(cfg *config.RateLimiterConfig, store RateLimitStore) echo.MiddlewareFunc {
+	window := time.Duration(cfg.Window) * time.Second
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			class := rateLimitClass(c, cfg.ExpensivePaths)
+			budget := cfg.Read
+			switch class {
+			case RateLimitClassWrite:
+				budget = cfg.Write
+			case RateLimitClassExpensive:
+				budget = cfg.Expensive
+			}
+
+			// The user is checked first and the org is only charged for requests admitted by the user limit,
+			// so that a throttled user does not consume the budget of the rest of the org
+			var checks []rateLimitCheck
+			if dbUser, ok := c.Get("user").(*cdbm.User); ok && dbUser != nil && budget.User > 0 {
+				checks = append(checks, rateLimitCheck{key: "user:" + dbUser.ID.String() + ":" + class, limit: budget.User})
+			}
+			// Org names are case insensitive, all spellings of an org share its budget
+			orgName := strings.ToLower(c.Param("orgName"))
+			if orgName != "" && budget.Org > 0 {
+				checks = append(checks, rateLimitCheck{key: "org:" + orgName + ":" + class, limit: budget.Org})
+			}
+			if len(checks) == 0 {
+				return next(c)
+			}
+
+			now := time.Now()
+			windowStart := now.Truncate(window)
+
+			// Report the check closest to its limit
+			var (
+				tightest  *rateLimitCheck
+				remaining = math.MaxInt
+				exceeded  bool
+			)
+			for i := range checks {
+				count, err := store.Increment(c.Request().Context(), checks[i].key, windowStart, window)
+				if err != nil {
+					// Do not turn a store outage into an API outage
+					log.Error().Err(err).Str("key", checks[i].key).Msg("failed to count request for rate limiting")
+					continue
+				}
+
+				if left := checks[i].limit - count; left < remaining {
+					tightest = &checks[i]
+					remaining = left
+				}
+				if count > checks[i].limit {
+					exceeded = true
+					break
+				}
+			}
+			if tightest == nil {
+				return next(c)
+			}
+
+			reset := int(math.Ceil(windowStart.Add(window).Sub(now).Seconds()))
+			header := c.Response().Header()
+			header.Set(HeaderRateLimitLimit, strconv.Itoa(tightest.limit))
+			header.Set(HeaderRateLimitRemaining, strconv.Itoa(max(remaining, 0)))
+			header.Set(HeaderRateLimitReset, strconv.Itoa(reset))
+			header.Set(HeaderRateLimitPolicy, fmt.Sprintf("%d;w=%d", tightest.limit, cfg.Window))
+
+			if exceeded {
+				header.Set(echo.HeaderRetryAfter, strconv.Itoa(reset))
+				log.Warn().
+					Str("key", tightest.key).
+					Str("path", c.Request().URL.Path).
+					Msg("Rate limit exceeded")
+				return ccu.NewAPIErrorResponse(c, http.StatusTooManyRequests, "Request rate limit exceeded, please re-evaluate your request patterns", nil)
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// rateLimitClass returns the rate limiting class of the request
+func rateLimitClass(c echo.Context, expensivePaths []string) string {
+	for _, path := range expensivePaths {
+		if path != "" && strings.Contains(c.Path(), path) {
+			return RateLimitClassExpensive
+		}
+	}
+
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return RateLimitClassRead
+	default:
+		return RateLimitClassWrite
+	}
+}
@@ -0,0 +1,347 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/api/internal/config"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+type failingRateLimitStore struct{}
+
+func (failingRateLimitStore) Increment(context.Context, string, time.Time, time.Duration) (int, error) {
+	return 0, errors.New("store unavailable")
+}
+
+// fakeRateLimitCounterDAO keeps counters in memory in place of Postgres
+type fakeRateLimitCounterDAO struct {
+	cdbm.RateLimitCounterDAO
+
+	mu     sync.Mutex
+	counts map[string]int
+	calls  int
+}
+
+func newFakeRateLimitCounterDAO() *fakeRateLimitCounterDAO {
+	return &fakeRateLimitCounterDAO{counts: map[string]int{}}
+}
+
+func (d *fakeRateLimitCounterDAO) Increment(_ context.Context, _ *cdb.Tx, key string, windowStart time.Time, _ time.Time, count int) (int, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls++
+	d.counts[key+"@"+windowStart.UTC().String()] += count
+	return d.counts[key+"@"+windowStart.UTC().String()], nil
+}
+
+func (d *fakeRateLimitCounterDAO) DeleteExpired(context.Context, *cdb.Tx, time.Time) (int, error) {
+	return 0, nil
+}
+
+func (d *fakeRateLimitCounterDAO) count(key string, windowStart time.Time) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.counts[key+"@"+windowStart.UTC().String()]
+}
+
+// waitForSync waits until the store has added all the requests it counted to the DAO
+func waitForSync(t *testing.T, s *DBRateLimitStore) {
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if s.syncing {
+			return false
+		}
+		for _, counter := range s.counters {
+			if counter.pending > 0 {
+				// Start the sync that a request would have started
+				s.syncing = true
+				go s.sync(time.Minute)
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func testRateLimiterConfig() *config.RateLimiterConfig {
+	return &config.RateLimiterConfig{
+		OrgUserEnabled: true,
+		Store:          config.RateLimiterStoreMemory,
+		Window:         60,
+		Read:           config.RateLimitBudget{Org: 5, User: 3},
+		Write:          config.RateLimitBudget{Org: 2, User: 0},
+		Expensive:      config.RateLimitBudget{Org: 1, User: 1},
+		ExpensivePaths: []string{"/instance/batch", "/stats"},
+	}
+}
+
+func serveRateLimited(mw echo.MiddlewareFunc, method, path string, orgName string, user *cdbm.User) *httptest.ResponseRecorder {
+	e := echo.New()
+	req := httptest.NewRequest(method, "/v2/org/"+orgName+"/carbide/x", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath(path)
+	c.SetParamNames("orgName")
+	c.SetParamValues(orgName)
+	if user != nil {
+		c.Set("user", user)
+	}
+
+	h := mw(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	if err := h(c); err != nil {
+		e.HTTPErrorHandler(err, c)
+	}
+	return rec
+}
+
+func TestRateLimiter(t *testing.T) {
+	instancePath := "/v2/org/:orgName/carbide/instance"
+	batchPath := "/v2/org/:orgName/carbide/instance/batch"
+
+	t.Run("user budget is tighter than org budget", func(t *testing.T) {
+		mw := RateLimiter(testRateLimiterConfig(), NewMemoryRateLimitStore())
+		user := &cdbm.User{ID: uuid.New()}
+
+		for i := 1; i <= 3; i++ {
+			rec := serveRateLimited(mw, http.MethodGet, instancePath, "org-a", user)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "3", rec.Header().Get(HeaderRateLimitLimit))
+			assert.Equal(t, []string{"2", "1", "0"}[i-1], rec.Header().Get(HeaderRateLimitRemaining))
+			assert.NotEmpty(t, rec.Header().Get(HeaderRateLimitReset))
+			assert.Equal(t, "3;w=60", rec.Header().Get(HeaderRateLimitPolicy))
+		}
+
+		rec := serveRateLimited(mw, http.MethodGet, instancePath, "org-a", user)
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "0", rec.Header().Get(HeaderRateLimitRemaining))
+		assert.NotEmpty(t, rec.Header().Get(echo.HeaderRetryAfter))
+
+		// Requests rejected by the user limit are not charged to the org
+		for i := 0; i < 3; i++ {
+			rec = serveRateLimited(mw, http.MethodGet, instancePath, "org-a", user)
+			assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+			assert.Equal(t, "3", rec.Header().Get(HeaderRateLimitLimit))
+		}
+
+		// Another user of the same org has the rest of the org budget
+		other := &cdbm.User{ID: uuid.New()}
+		for i := 0; i < 2; i++ {
+			rec = serveRateLimited(mw, http.MethodGet, instancePath, "org-a", other)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		rec = serveRateLimited(mw, http.MethodGet, instancePath, "org-a", other)
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "5", rec.Header().Get(HeaderRateLimitLimit))
+
+		// Other orgs are not affected
+		rec = serveRateLimited(mw, http.MethodGet, instancePath, "org-b", &cdbm.User{ID: uuid.New()})
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("read, write and expensive requests have separate budgets", func(t *testing.T) {
+		mw := RateLimiter(testRateLimiterConfig(), NewMemoryRateLimitStore())
+		user := &cdbm.User{ID: uuid.New()}
+
+		assert.Equal(t, http.StatusOK, serveRateLimited(mw, http.MethodPost, batchPath, "org-a", user).Code)
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(mw, http.MethodPost, batchPath, "org-a", user).Code)
+
+		// The write budget has no user limit, the org limit applies
+		for i := 0; i < 2; i++ {
+			rec := serveRateLimited(mw, http.MethodPatch, instancePath, "org-a", user)
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "2", rec.Header().Get(HeaderRateLimitLimit))
+		}
+		assert.Equal(t, http.StatusTooManyRequests, serveRateLimited(mw, http.MethodDelete, instancePath, "org-a", user).Code)
+
+		assert.Equal(t, http.StatusOK, serveRateLimited(mw, http.MethodGet, instancePath, "org-a", user).Code)
+	})
+
+	t.Run("org names are case insensitive", func(t *testing.T) {
+		mw := RateLimiter(testRateLimiterConfig(), NewMemoryRateLimitStore())
+
+		for _, orgName := range []string{"org-a", "Org-A", "ORG-A", "org-A", "oRg-a"} {
+			assert.Equal(t, http.StatusOK, serveRateLimited(mw, http.MethodGet, instancePath, orgName, &cdbm.User{ID: uuid.New()}).Code)
+		}
+		rec := serveRateLimited(mw, http.MethodGet, instancePath, "ORG-a", &cdbm.User{ID: uuid.New()})
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+		assert.Equal(t, "5", rec.Header().Get(HeaderRateLimitLimit))
+	})
+
+	t.Run("store failures do not reject requests", func(t *testing.T) {
+		mw := RateLimiter(testRateLimiterConfig(), failingRateLimitStore{})
+
+		rec := serveRateLimited(mw, http.MethodGet, instancePath, "org-a", &cdbm.User{ID: uuid.New()})
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get(HeaderRateLimitLimit))
+	})
+}
+
+func TestRateLimiter_ConcurrentRequests(t *testing.T) {
+	instancePath := "/v2/org/:orgName/carbide/instance"
+	cfg := testRateLimiterConfig()
+	cfg.Read = config.RateLimitBudget{Org: 20, User: 0}
+
+	requests := 100
+
+	serveConcurrently := func(mw echo.MiddlewareFunc) (admitted int, rejected int) {
+		var (
+			mu sync.Mutex
+			wg sync.WaitGroup
+		)
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				rec := serveRateLimited(mw, http.MethodGet, instancePath, "org-a", &cdbm.User{ID: uuid.New()})
+
+				mu.Lock()
+				defer mu.Unlock()
+				switch rec.Code {
+				case http.StatusOK:
+					admitted++
+				case http.StatusTooManyRequests:
+					rejected++
+				}
+			}()
+		}
+		wg.Wait()
+		return admitted, rejected
+	}
+
+	t.Run("memory store admits exactly the limit", func(t *testing.T) {
+		admitted, rejected := serveConcurrently(RateLimiter(cfg, NewMemoryRateLimitStore()))
+		assert.Equal(t, 20, admitted)
+		assert.Equal(t, requests-20, rejected)
+	})
+
+	t.Run("postgres store admits exactly the limit and syncs the requests in batches", func(t *testing.T) {
+		dao := newFakeRateLimitCounterDAO()
+		store := newDBRateLimitStore(dao, 50*time.Millisecond)
+
+		windowStart := time.Now().Truncate(time.Duration(cfg.Window) * time.Second)
+		admitted, rejected := serveConcurrently(RateLimiter(cfg, store))
+		assert.Equal(t, 20, admitted)
+		assert.Equal(t, requests-20, rejected)
+
+		waitForSync(t, store)
+		assert.Equal(t, requests, dao.count("org:org-a:read", windowStart))
+
+		dao.mu.Lock()
+		defer dao.mu.Unlock()
+		assert.Less(t, dao.calls, requests)
+	})
+}
+
+func TestDBRateLimitStore(t *testing.T) {
+	ctx := context.Background()
+	window := time.Minute
+	start := time.Now().Truncate(window)
+
+	dao := newFakeRateLimitCounterDAO()
+	replica1 := newDBRateLimitStore(dao, 0)
+	replica2 := newDBRateLimitStore(dao, 0)
+
+	for i := 1; i <= 3; i++ {
+		count, err := replica1.Increment(ctx, "k", start, window)
+		assert.NoError(t, err)
+		assert.Equal(t, i, count)
+	}
+	waitForSync(t, replica1)
+	assert.Equal(t, 3, dao.count("k", start))
+
+	// A replica sees the requests counted by other replicas after it syncs
+	count, _ := replica2.Increment(ctx, "k", start, window)
+	assert.Equal(t, 1, count)
+	waitForSync(t, replica2)
+	count, _ = replica2.Increment(ctx, "k", start, window)
+	assert.Equal(t, 5, count)
+
+	// Other keys are counted separately
+	count, _ = replica2.Increment(ctx, "other", start, window)
+	assert.Equal(t, 1, count)
+
+	// A new window starts from zero, and requests of an ended window are not counted
+	count, _ = replica1.Increment(ctx, "k", start.Add(window), window)
+	assert.Equal(t, 1, count)
+	count, _ = replica1.Increment(ctx, "k", start, window)
+	assert.Equal(t, 1, count)
+	waitForSync(t, replica1)
+	assert.Equal(t, 1, dao.count("k", start.Add(window)))
+}
+
+func TestRateLimitClass(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{http.MethodGet, "/v2/org/:orgName/carbide/instance", RateLimitClassRead},
+		{http.MethodHead, "/v2/org/:orgName/carbide/instance", RateLimitClassRead},
+		{http.MethodPost, "/v2/org/:orgName/carbide/instance", RateLimitClassWrite},
+		{http.MethodDelete, "/v2/org/:orgName/carbide/instance/:id", RateLimitClassWrite},
+		{http.MethodPost, "/v2/org/:orgName/carbide/instance/batch", RateLimitClassExpensive},
+		{http.MethodGet, "/v2/org/:orgName/carbide/machine/gpu/stats", RateLimitClassExpensive},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			c := e.NewContext(httptest.NewRequest(tt.method, "/", nil), httptest.NewRecorder())
+			c.SetPath(tt.path)
+			assert.Equal(t, tt.want, rateLimitClass(c, []string{"/instance/batch", "/stats"}))
+		})
+	}
+}
+
+func TestMemoryRateLimitStore(t *testing.T) {
+	s := NewMemoryRateLimitStore()
+	ctx := context.Background()
+	window := time.Minute
+	start := time.Now().Truncate(window)
+
+	count, _ := s.Increment(ctx, "k", start, window)
+	assert.Equal(t, 1, count)
+	count, _ = s.Increment(ctx, "k", start, window)
+	assert.Equal(t, 2, count)
+	count, _ = s.Increment(ctx, "other", start, window)
+	assert.Equal(t, 1, count)
+
+	// A new window starts from zero
+	count, _ = s.Increment(ctx, "k", start.Add(window), window)
+	assert.Equal(t, 1, count)
+}
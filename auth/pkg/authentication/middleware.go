@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/config"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
 	commonConfig "github.com/nvidia/bare-metal-manager-rest/common/pkg/config"
 	"github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
 
@@ -85,6 +86,18 @@ func AuthProcessor(c echo.Context, joCfg *config.JWTOriginConfig) *util.APIError
 
 	tokenStr := parts[1]
 
+	// API tokens issued by the service are opaque and have no issuer, route them to their processor directly
+	if core.IsAPIToken(tokenStr) {
+		processor := joCfg.GetProcessorByOrigin(config.TokenOriginAPIToken)
+		if processor == nil {
+			logger.Error().Msg("No processor found for API tokens")
+			return util.NewAPIError(http.StatusUnauthorized, "Invalid authorization token in request", nil)
+		}
+
+		_, apiErr := processor.ProcessToken(c, tokenStr, nil, logger)
+		return apiErr
+	}
+
 	// Parse the token without validating it yet to get the issuer
 	unverifiedToken, _, uErr := new(jwt.Parser).ParseUnverified(tokenStr, jwt.MapClaims{})
 	if uErr != nil {
@@ -27,6 +27,7 @@ import (
 	mapset "github.com/deckarep/golang-set/v2"
 	"github.com/go-jose/go-jose/v4"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
@@ -47,6 +48,7 @@ const (
 
 var (
 	isServiceAccountContextKey = AuthContextKey("isServiceAccount")
+	apiTokenIDContextKey       = AuthContextKey("apiTokenID")
 )
 
 // =============================================================================
@@ -72,6 +74,22 @@ func GetIsServiceAccountFromContext(c echo.Context) bool {
 	return ok && b
 }
 
+// SetAPITokenIDInContext stores the ID of the API token used to authenticate the request
+func SetAPITokenIDInContext(c echo.Context, tokenID uuid.UUID) {
+	ctx := context.WithValue(c.Request().Context(), apiTokenIDContextKey, tokenID)
+	c.SetRequest(c.Request().WithContext(ctx))
+}
+
+// GetAPITokenIDFromContext returns the ID of the API token used to authenticate the request,
+// or nil if the request was authenticated with a JWT
+func GetAPITokenIDFromContext(c echo.Context) *uuid.UUID {
+	v, ok := c.Request().Context().Value(apiTokenIDContextKey).(uuid.UUID)
+	if !ok {
+		return nil
+	}
+	return &v
+}
+
 // =============================================================================
 // ClaimMapping Struct and Methods
 // =============================================================================
@@ -34,6 +34,7 @@ const (
 	TokenOriginKasSsa    = "kas-ssa"    // KAS SSA tokens
 	TokenOriginKeycloak  = "keycloak"   // Keycloak tokens
 	TokenOriginCustom    = "custom"     // Custom/third-party tokens (default if not specified)
+	TokenOriginAPIToken  = "api-token"  // Opaque API tokens issued by the service, never configured as an issuer
 )
 
 // AllowedOrigins is the list of valid token origins for the service
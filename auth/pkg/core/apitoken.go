@@ -0,0 +1,182 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package core
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// =============================================================================
+// Constants
+// =============================================================================
+
+const (
+	// APITokenPrefix identifies opaque API tokens issued by the service, as opposed to JWTs
+	APITokenPrefix = "cbt_"
+	// APITokenDisplayLength is the number of leading token characters stored and shown to identify a token
+	APITokenDisplayLength = 12
+
+	// APITokenVerbRead allows safe (GET and HEAD) requests
+	APITokenVerbRead = "read"
+	// APITokenVerbWrite allows all requests, including reads
+	APITokenVerbWrite = "write"
+	// APITokenResourceAll matches every resource
+	APITokenResourceAll = "*"
+
+	// apiTokenSecretBytes is the number of random bytes in a token
+	apiTokenSecretBytes = 32
+)
+
+var (
+	// APITokenResources are the resources API tokens can be scoped to, i.e. the first path segment of org routes
+	// e.g. instance, ipblock. Tokens act as Tenant Admin, so routes of any other resource are denied to every
+	// token, including tokens scoped to all resources, until the resource is reviewed and added here.
+	APITokenResources = map[string]bool{
+		"address-set":              true,
+		"allocation":               true,
+		"audit":                    true,
+		"dpu-extension-service":    true,
+		"expected-machine":         true,
+		"expected-power-shelf":     true,
+		"expected-switch":          true,
+		"infiniband-partition":     true,
+		"infrastructure-provider":  true,
+		"instance":                 true,
+		"instance-template":        true,
+		"ipblock":                  true,
+		"machine":                  true,
+		"machine-capability":       true,
+		"metadata":                 true,
+		"network-security-group":   true,
+		"nvlink-interface":         true,
+		"nvlink-logical-partition": true,
+		"operating-system":         true,
+		"rack":                     true,
+		"service-account":          true,
+		"site":                     true,
+		"sku":                      true,
+		"sshkey":                   true,
+		"sshkeygroup":              true,
+		"subnet":                   true,
+		"tenant":                   true,
+		"tray":                     true,
+		"user":                     true,
+		"vpc":                      true,
+		"vpc-prefix":               true,
+	}
+)
+
+// =============================================================================
+// Token Functions
+// =============================================================================
+
+// GenerateAPIToken returns a new random API token
+func GenerateAPIToken() (string, error) {
+	secret := make([]byte, apiTokenSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// IsAPIToken returns true if the bearer token is an API token issued by the service
+func IsAPIToken(tokenStr string) bool {
+	return strings.HasPrefix(tokenStr, APITokenPrefix)
+}
+
+// HashAPIToken returns the hex encoded SHA-256 hash of an API token, which is what gets stored.
+// Tokens carry 256 bits of randomness so a salted or slow hash is not needed.
+func HashAPIToken(tokenStr string) string {
+	hash := sha256.Sum256([]byte(tokenStr))
+	return hex.EncodeToString(hash[:])
+}
+
+// GetAPITokenDisplayPrefix returns the leading characters of a token used to identify it in listings
+func GetAPITokenDisplayPrefix(tokenStr string) string {
+	if len(tokenStr) <= APITokenDisplayLength {
+		return tokenStr
+	}
+	return tokenStr[:APITokenDisplayLength]
+}
+
+// =============================================================================
+// Scope Functions
+// =============================================================================
+
+// ValidateAPITokenScope checks that a scope is of the form <resource>:<verb>,
+// where resource is one of APITokenResources or * and verb is read or write
+func ValidateAPITokenScope(scope string) error {
+	resource, verb, ok := strings.Cut(scope, ":")
+	if !ok {
+		return fmt.Errorf("scope %q must be of the form <resource>:<verb>", scope)
+	}
+	if resource != APITokenResourceAll && !APITokenResources[resource] {
+		return fmt.Errorf("scope %q has unknown resource, must be an API resource name e.g. instance, or %s", scope, APITokenResourceAll)
+	}
+	if verb != APITokenVerbRead && verb != APITokenVerbWrite {
+		return fmt.Errorf("scope %q has invalid verb, must be %s or %s", scope, APITokenVerbRead, APITokenVerbWrite)
+	}
+	return nil
+}
+
+// GetAPITokenVerb returns the verb required for a request method
+func GetAPITokenVerb(method string) string {
+	if method == http.MethodGet || method == http.MethodHead {
+		return APITokenVerbRead
+	}
+	return APITokenVerbWrite
+}
+
+// GetAPITokenResource returns the resource of a route path, i.e. the first segment after the API name
+// e.g. "instance" for /v2/org/:orgName/carbide/instance/:id. Returns empty string if path is not an org route.
+func GetAPITokenResource(routePath string) string {
+	segments := strings.Split(strings.Trim(routePath, "/"), "/")
+	for i, segment := range segments {
+		if segment == ":orgName" && i+2 < len(segments) {
+			return segments[i+2]
+		}
+	}
+	return ""
+}
+
+// APITokenScopesAllow returns true if any scope grants the verb on the resource. Write implies read.
+// Resources that are not in APITokenResources are never allowed.
+func APITokenScopesAllow(scopes []string, resource string, verb string) bool {
+	if !APITokenResources[resource] {
+		return false
+	}
+	for _, scope := range scopes {
+		scopeResource, scopeVerb, ok := strings.Cut(scope, ":")
+		if !ok {
+			continue
+		}
+		if scopeResource != APITokenResourceAll && scopeResource != resource {
+			continue
+		}
+		if scopeVerb == verb || scopeVerb == APITokenVerbWrite {
+			return true
+		}
+	}
+	return false
+}
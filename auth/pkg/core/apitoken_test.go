@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package core
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAPIToken(t *testing.T) {
+	token, err := GenerateAPIToken()
+	require.NoError(t, err)
+
+	assert.True(t, IsAPIToken(token))
+	assert.Len(t, token, len(APITokenPrefix)+43)
+	assert.Equal(t, token[:APITokenDisplayLength], GetAPITokenDisplayPrefix(token))
+
+	other, err := GenerateAPIToken()
+	require.NoError(t, err)
+	assert.NotEqual(t, token, other)
+	assert.NotEqual(t, HashAPIToken(token), HashAPIToken(other))
+	assert.Equal(t, HashAPIToken(token), HashAPIToken(token))
+	assert.Len(t, HashAPIToken(token), 64)
+
+	assert.False(t, IsAPIToken("eyJhbGciOiJSUzI1NiJ9.e30.sig"))
+}
+
+func TestValidateAPITokenScope(t *testing.T) {
+	tests := []struct {
+		scope   string
+		wantErr bool
+	}{
+		{scope: "instance:read"},
+		{scope: "ipblock:write"},
+		{scope: "*:read"},
+		{scope: "instance", wantErr: true},
+		{scope: "instance:delete", wantErr: true},
+		{scope: "Instance:read", wantErr: true},
+		{scope: "ip-block:read", wantErr: true},
+		{scope: ":read", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.scope, func(t *testing.T) {
+			err := ValidateAPITokenScope(tt.scope)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGetAPITokenResource(t *testing.T) {
+	assert.Equal(t, "instance", GetAPITokenResource("/v2/org/:orgName/carbide/instance/:id"))
+	assert.Equal(t, "ipblock", GetAPITokenResource("/v2/org/:orgName/carbide/ipblock"))
+	assert.Equal(t, "", GetAPITokenResource("/v2/org/:orgName/carbide"))
+	assert.Equal(t, "", GetAPITokenResource("/healthz"))
+}
+
+func TestAPITokenScopesAllow(t *testing.T) {
+	scopes := []string{"instance:write", "vpc:read"}
+
+	assert.True(t, APITokenScopesAllow(scopes, "instance", GetAPITokenVerb(http.MethodPost)))
+	assert.True(t, APITokenScopesAllow(scopes, "instance", GetAPITokenVerb(http.MethodGet)))
+	assert.True(t, APITokenScopesAllow(scopes, "vpc", GetAPITokenVerb(http.MethodGet)))
+	assert.False(t, APITokenScopesAllow(scopes, "vpc", GetAPITokenVerb(http.MethodDelete)))
+	assert.False(t, APITokenScopesAllow(scopes, "subnet", GetAPITokenVerb(http.MethodGet)))
+	assert.False(t, APITokenScopesAllow(scopes, "", GetAPITokenVerb(http.MethodGet)))
+
+	assert.True(t, APITokenScopesAllow([]string{"*:read"}, "subnet", APITokenVerbRead))
+	assert.False(t, APITokenScopesAllow([]string{"*:read"}, "subnet", APITokenVerbWrite))
+
+	// Routes of resources that are not known to the scope check are denied, even for all resources
+	assert.False(t, APITokenScopesAllow([]string{"*:write"}, "new-resource", APITokenVerbRead))
+	assert.False(t, APITokenScopesAllow([]string{"new-resource:write"}, "new-resource", APITokenVerbWrite))
+}
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processors
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	authz "github.com/nvidia/bare-metal-manager-rest/auth/pkg/authorization"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/config"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/rs/zerolog"
+)
+
+const (
+	// APITokenLastUsedUpdatePeriod is the minimum duration between updates of a token's last used timestamp
+	APITokenLastUsedUpdatePeriod = time.Minute
+)
+
+var (
+	// APITokenRoles are the roles assigned to the User of an API token in the token's org
+	APITokenRoles = []string{authz.TenantAdminRole}
+)
+
+// Ensure APITokenProcessor implements config.TokenProcessor interface
+var _ config.TokenProcessor = (*APITokenProcessor)(nil)
+
+// APITokenProcessor processes opaque API tokens issued by the service.
+// Tokens are looked up by hash and restricted to their org, scopes and optionally a Site.
+type APITokenProcessor struct {
+	dbSession *cdb.Session
+}
+
+// ProcessToken processes API tokens, the JWKS config is not used as API tokens are not JWTs
+func (h *APITokenProcessor) ProcessToken(c echo.Context, tokenStr string, _ *config.JwksConfig, logger zerolog.Logger) (*cdbm.User, *util.APIError) {
+	ctx := c.Request().Context()
+
+	satDAO := cdbm.NewServiceAccountTokenDAO(h.dbSession)
+	sat, err := satDAO.GetByTokenHash(ctx, nil, core.HashAPIToken(tokenStr))
+	if err != nil {
+		if errors.Is(err, cdb.ErrDoesNotExist) {
+			logger.Warn().Str("Token Prefix", core.GetAPITokenDisplayPrefix(tokenStr)).Msg("API token not found")
+			return nil, util.NewAPIError(http.StatusUnauthorized, "Invalid authorization token in request", nil)
+		}
+		logger.Error().Err(err).Msg("failed to retrieve API token from DB")
+		return nil, util.NewAPIError(http.StatusUnauthorized, "Failed to retrieve authorization token, DB error", nil)
+	}
+
+	logger = logger.With().Str("API Token ID", sat.ID.String()).Logger()
+
+	now := cdb.GetCurTime()
+
+	if sat.Revoked != nil {
+		logger.Warn().Msg("API token has been revoked")
+		return nil, util.NewAPIError(http.StatusUnauthorized, "Authorization token in request has been revoked", nil)
+	}
+	if sat.IsExpired(now) {
+		logger.Warn().Msg("API token expired")
+		return nil, util.NewAPIError(http.StatusUnauthorized, "Authorization token in request has expired", nil)
+	}
+
+	// Tokens are only valid for the org they were issued in
+	reqOrg := strings.ToLower(c.Param("orgName"))
+	if reqOrg != strings.ToLower(sat.Org) {
+		logger.Warn().Str("requested_org", reqOrg).Msg("API token was not issued for organization specified in URL")
+		return nil, util.NewAPIError(http.StatusForbidden, "Authorization token is not valid for organization specified in URL", nil)
+	}
+
+	resource := core.GetAPITokenResource(c.Path())
+	verb := core.GetAPITokenVerb(c.Request().Method)
+	if !core.APITokenScopesAllow(sat.Scopes, resource, verb) {
+		logger.Warn().Str("resource", resource).Str("verb", verb).Strs("scopes", sat.Scopes).Msg("API token scopes do not allow request")
+		return nil, util.NewAPIError(http.StatusForbidden, "Authorization token scopes do not allow this request", nil)
+	}
+
+	// Site scoped tokens may only access resources of their Site
+	if sat.SiteID != nil {
+		reqSiteID, err := enforceAPITokenSite(ctx, h.dbSession, c, *sat.SiteID)
+		if err != nil {
+			if errors.Is(err, errAPITokenSiteNotDetermined) {
+				logger.Warn().Str("route", c.Path()).Msg("Site accessed by request cannot be restricted for Site scoped API token")
+				return nil, util.NewAPIError(http.StatusForbidden, "Site scoped authorization tokens are not supported for this request, specify a Site or use a token without Site scope", nil)
+			}
+			logger.Error().Err(err).Msg("failed to determine Site accessed by request for API token")
+			return nil, util.NewAPIError(http.StatusInternalServerError, "Failed to validate authorization token Site", nil)
+		}
+		if reqSiteID != nil {
+			logger.Warn().Str("requested_site", reqSiteID.String()).Msg("API token is not valid for Site accessed by request")
+			return nil, util.NewAPIError(http.StatusForbidden, "Authorization token is not valid for Site specified in request", nil)
+		}
+	}
+
+	userDAO := cdbm.NewUserDAO(h.dbSession)
+	dbUser, err := userDAO.Get(ctx, nil, sat.UserID, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve user for API token from DB")
+		return nil, util.NewAPIError(http.StatusUnauthorized, "Failed to retrieve user record, DB error", nil)
+	}
+
+	// The token's User only ever has roles in the token's org
+	tokenOrgData := cdbm.OrgData{
+		sat.Org: cdbm.Org{
+			Name:        sat.Org,
+			DisplayName: sat.Org,
+			Roles:       APITokenRoles,
+			Teams:       []cdbm.Team{},
+		},
+	}
+	updatedUser, apiErr := GetUserWithUpdatedOrgData(*dbUser, tokenOrgData, sat.Org, logger)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+
+	if updatedUser != nil {
+		dbUser, err = userDAO.Update(ctx, nil, cdbm.UserUpdateInput{
+			UserID:  dbUser.ID,
+			OrgData: updatedUser.OrgData,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to update user in DB")
+			return nil, util.NewAPIError(http.StatusUnauthorized, "Failed to update user record, DB error", nil)
+		}
+	}
+
+	// Track last use, at most once per update period to avoid a DB write on every request
+	if sat.LastUsed == nil || now.Sub(*sat.LastUsed) > APITokenLastUsedUpdatePeriod {
+		_, err = satDAO.Update(context.Background(), nil, cdbm.ServiceAccountTokenUpdateInput{
+			ServiceAccountTokenID: sat.ID,
+			LastUsed:              &now,
+		})
+		if err != nil {
+			// Not fatal, the request is authenticated regardless
+			logger.Warn().Err(err).Msg("failed to update last used time of API token")
+		}
+	}
+
+	config.SetIsServiceAccountInContext(c, false)
+	config.SetAPITokenIDInContext(c, sat.ID)
+
+	// Set user in context
+	c.Set("user", dbUser)
+	return dbUser, nil
+}
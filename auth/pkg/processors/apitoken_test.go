@@ -0,0 +1,476 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processors
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/config"
+	"github.com/nvidia/bare-metal-manager-rest/auth/pkg/core"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbu "github.com/nvidia/bare-metal-manager-rest/db/pkg/util"
+)
+
+// newAPITokenTestContext returns an echo context for a request matched to the given route
+func newAPITokenTestContext(method string, route string, target string, body string, paramNames []string, paramValues []string) echo.Context {
+	e := echo.New()
+
+	var reqBody io.Reader
+	if body != "" {
+		reqBody = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reqBody)
+	if body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+
+	c := e.NewContext(req, httptest.NewRecorder())
+	c.SetPath(route)
+	c.SetParamNames(paramNames...)
+	c.SetParamValues(paramValues...)
+	return c
+}
+
+func TestGetAPITokenRoute(t *testing.T) {
+	tests := []struct {
+		name      string
+		routePath string
+		want      string
+	}{
+		{name: "collection route", routePath: "/v2/org/:orgName/carbide/instance", want: "/instance"},
+		{name: "nested route", routePath: "/v2/org/:orgName/carbide/instance/type/:instanceTypeId/machine", want: "/instance/type/:instanceTypeId/machine"},
+		{name: "API root", routePath: "/v2/org/:orgName/carbide", want: ""},
+		{name: "non org route", routePath: "/healthz", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getAPITokenRoute(tt.routePath))
+		})
+	}
+}
+
+func TestGetRequestSiteIDs(t *testing.T) {
+	siteID1 := uuid.New()
+	siteID2 := uuid.New()
+
+	tests := []struct {
+		name        string
+		method      string
+		route       string
+		target      string
+		body        string
+		contentType string
+		paramNames  []string
+		paramValues []string
+		want        []uuid.UUID
+	}{
+		{
+			name:        "no Site in request",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/instance",
+			target:      "/v2/org/test-org/carbide/instance",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{},
+		},
+		{
+			name:        "Site in query params",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/instance",
+			target:      "/v2/org/test-org/carbide/instance?siteId=" + siteID1.String() + "&siteId=" + siteID2.String(),
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{siteID1, siteID2},
+		},
+		{
+			name:        "Site resource ID",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/site/:id",
+			target:      "/v2/org/test-org/carbide/site/" + siteID1.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{"test-org", siteID1.String()},
+			want:        []uuid.UUID{siteID1},
+		},
+		{
+			name:        "Site path param of nested route",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/site/:siteID/machine-validation/test",
+			target:      "/v2/org/test-org/carbide/site/" + siteID2.String() + "/machine-validation/test",
+			paramNames:  []string{"orgName", "siteID"},
+			paramValues: []string{"test-org", siteID2.String()},
+			want:        []uuid.UUID{siteID2},
+		},
+		{
+			name:        "Site in JSON body",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/vpc",
+			target:      "/v2/org/test-org/carbide/vpc",
+			body:        `{"name": "test-vpc", "siteId": "` + siteID2.String() + `"}`,
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{siteID2},
+		},
+		{
+			name:        "Sites of JSON body entries",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/expected-machine/bulk",
+			target:      "/v2/org/test-org/carbide/expected-machine/bulk?siteId=" + siteID1.String(),
+			body:        `[{"siteId": "` + siteID2.String() + `"}, {"bmcMacAddress": "00:11:22:33:44:55"}]`,
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{siteID1, siteID2},
+		},
+		{
+			name:        "Sites of CSV body entries",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/expected-switch/bulk",
+			target:      "/v2/org/test-org/carbide/expected-switch/bulk",
+			body:        "bmcMacAddress,siteId\n00:11:22:33:44:55," + siteID2.String() + "\n00:11:22:33:44:66,\n",
+			contentType: "text/csv",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{siteID2},
+		},
+		{
+			name:        "invalid Site IDs are ignored",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/vpc",
+			target:      "/v2/org/test-org/carbide/vpc?siteId=bad",
+			body:        `[1, 2]`,
+			paramNames:  []string{"orgName"},
+			paramValues: []string{"test-org"},
+			want:        []uuid.UUID{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newAPITokenTestContext(tt.method, tt.route, tt.target, tt.body, tt.paramNames, tt.paramValues)
+			if tt.contentType != "" {
+				c.Request().Header.Set(echo.HeaderContentType, tt.contentType)
+			}
+
+			policy, ok := apiTokenSitePolicies[getAPITokenRoute(tt.route)]
+			require.True(t, ok)
+
+			got, err := getRequestSiteIDs(context.Background(), nil, c, policy)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// Body must remain readable by the handler
+			if tt.body != "" {
+				body, err := io.ReadAll(c.Request().Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.body, string(body))
+			}
+		})
+	}
+}
+
+func TestEnforceAPITokenSite(t *testing.T) {
+	dbSession := cdbu.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	cdbm.TestSetupSchema(t, dbSession)
+
+	org := "test-org"
+
+	adminUser := cdbm.TestBuildUser(t, dbSession, uuid.NewString(), org, []string{"FORGE_PROVIDER_ADMIN", "FORGE_TENANT_ADMIN"})
+	ip := cdbm.TestBuildInfrastructureProvider(t, dbSession, "test-ip", org, adminUser)
+	site1 := cdbm.TestBuildSite(t, dbSession, ip, "test-site-1", adminUser)
+	site2 := cdbm.TestBuildSite(t, dbSession, ip, "test-site-2", adminUser)
+	tenant := cdbm.TestBuildTenant(t, dbSession, "test-tenant", org, adminUser)
+
+	vpc1 := cdbm.TestBuildVPC(t, dbSession, "test-vpc-1", ip, tenant, site1, nil, nil, nil, cdbm.VpcStatusReady, adminUser, nil)
+	vpc2 := cdbm.TestBuildVPC(t, dbSession, "test-vpc-2", ip, tenant, site2, nil, nil, nil, cdbm.VpcStatusReady, adminUser, nil)
+
+	// os1 is only on the token's Site, os2 is shared with another Site
+	os1 := cdbm.TestBuildOperatingSystem(t, dbSession, "test-os-1", tenant, cdbm.OperatingSystemStatusReady, adminUser)
+	os2 := cdbm.TestBuildOperatingSystem(t, dbSession, "test-os-2", tenant, cdbm.OperatingSystemStatusReady, adminUser)
+	ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(dbSession)
+	for _, assoc := range []struct {
+		os   *cdbm.OperatingSystem
+		site *cdbm.Site
+	}{{os1, site1}, {os2, site1}, {os2, site2}} {
+		_, err := ossaDAO.Create(context.Background(), nil, cdbm.OperatingSystemSiteAssociationCreateInput{
+			OperatingSystemID: assoc.os.ID,
+			SiteID:            assoc.site.ID,
+			Status:            cdbm.OperatingSystemSiteAssociationStatusSynced,
+			CreatedBy:         adminUser.ID,
+		})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		route       string
+		target      string
+		body        string
+		paramNames  []string
+		paramValues []string
+		wantSiteID  *uuid.UUID
+		wantErr     error
+		wantFilter  bool
+	}{
+		{
+			name:        "resource of token Site is allowed",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/vpc/:id",
+			target:      "/v2/org/test-org/carbide/vpc/" + vpc1.ID.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, vpc1.ID.String()},
+		},
+		{
+			name:        "resource of other Site is denied",
+			method:      http.MethodDelete,
+			route:       "/v2/org/:orgName/carbide/vpc/:id",
+			target:      "/v2/org/test-org/carbide/vpc/" + vpc2.ID.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, vpc2.ID.String()},
+			wantSiteID:  &site2.ID,
+		},
+		{
+			name:        "unknown resource is left for handler",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/vpc/:id",
+			target:      "/v2/org/test-org/carbide/vpc/" + uuid.NewString(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, uuid.NewString()},
+		},
+		{
+			name:        "list without Site is restricted to token Site",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/vpc",
+			target:      "/v2/org/test-org/carbide/vpc",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+			wantFilter:  true,
+		},
+		{
+			name:        "create referencing resource of other Site is denied",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/subnet",
+			target:      "/v2/org/test-org/carbide/subnet",
+			body:        `{"name": "test-subnet", "vpcId": "` + vpc2.ID.String() + `"}`,
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+			wantSiteID:  &site2.ID,
+		},
+		{
+			name:        "create without Site is denied",
+			method:      http.MethodPost,
+			route:       "/v2/org/:orgName/carbide/vpc",
+			target:      "/v2/org/test-org/carbide/vpc",
+			body:        `{"name": "test-vpc"}`,
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+			wantErr:     errAPITokenSiteNotDetermined,
+		},
+		{
+			name:        "list that can't be restricted to a Site is denied",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/site",
+			target:      "/v2/org/test-org/carbide/site",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+			wantErr:     errAPITokenSiteNotDetermined,
+		},
+		{
+			name:        "shared resource of token Site can be read",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/operating-system/:id",
+			target:      "/v2/org/test-org/carbide/operating-system/" + os2.ID.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, os2.ID.String()},
+		},
+		{
+			name:        "shared resource of other Sites can't be changed",
+			method:      http.MethodPatch,
+			route:       "/v2/org/:orgName/carbide/operating-system/:id",
+			target:      "/v2/org/test-org/carbide/operating-system/" + os2.ID.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, os2.ID.String()},
+			wantSiteID:  &site2.ID,
+		},
+		{
+			name:        "shared resource only on token Site can be changed",
+			method:      http.MethodPatch,
+			route:       "/v2/org/:orgName/carbide/operating-system/:id",
+			target:      "/v2/org/test-org/carbide/operating-system/" + os1.ID.String(),
+			paramNames:  []string{"orgName", "id"},
+			paramValues: []string{org, os1.ID.String()},
+		},
+		{
+			name:        "route explicitly unsupported for Site tokens is denied",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/audit",
+			target:      "/v2/org/test-org/carbide/audit",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+			wantErr:     errAPITokenSiteNotDetermined,
+		},
+		{
+			name:        "Site independent route is allowed",
+			method:      http.MethodGet,
+			route:       "/v2/org/:orgName/carbide/user/current",
+			target:      "/v2/org/test-org/carbide/user/current",
+			paramNames:  []string{"orgName"},
+			paramValues: []string{org},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newAPITokenTestContext(tt.method, tt.route, tt.target, tt.body, tt.paramNames, tt.paramValues)
+
+			gotSiteID, err := enforceAPITokenSite(context.Background(), dbSession, c, site1.ID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSiteID, gotSiteID)
+
+			if tt.wantFilter {
+				assert.Equal(t, site1.ID.String(), c.QueryParam("siteId"))
+				assert.Equal(t, site1.ID.String(), c.Request().URL.Query().Get("siteId"))
+			}
+		})
+	}
+}
+
+func TestAPITokenProcessor_ProcessToken(t *testing.T) {
+	dbSession := cdbu.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	cdbm.TestSetupSchema(t, dbSession)
+
+	ctx := context.Background()
+	org := "test-org"
+
+	adminUser := cdbm.TestBuildUser(t, dbSession, uuid.NewString(), org, []string{"FORGE_PROVIDER_ADMIN", "FORGE_TENANT_ADMIN"})
+	ip := cdbm.TestBuildInfrastructureProvider(t, dbSession, "test-ip", org, adminUser)
+	site := cdbm.TestBuildSite(t, dbSession, ip, "test-site", adminUser)
+	tenant := cdbm.TestBuildTenant(t, dbSession, "test-tenant", org, adminUser)
+
+	satDAO := cdbm.NewServiceAccountTokenDAO(dbSession)
+	userDAO := cdbm.NewUserDAO(dbSession)
+
+	buildToken := func(name string, scopes []string, siteID *uuid.UUID, expires *time.Time, revoked bool) string {
+		tokenStr, err := core.GenerateAPIToken()
+		require.NoError(t, err)
+
+		tokenUser, _, err := userDAO.GetOrCreate(ctx, nil, cdbm.UserGetOrCreateInput{AuxiliaryID: cdb.GetStrPtr("api-token:" + uuid.NewString())})
+		require.NoError(t, err)
+
+		sat, err := satDAO.Create(ctx, nil, cdbm.ServiceAccountTokenCreateInput{
+			Name:        name,
+			Org:         org,
+			TenantID:    tenant.ID,
+			SiteID:      siteID,
+			Scopes:      scopes,
+			TokenPrefix: core.GetAPITokenDisplayPrefix(tokenStr),
+			TokenHash:   core.HashAPIToken(tokenStr),
+			UserID:      tokenUser.ID,
+			Expires:     expires,
+			CreatedBy:   adminUser.ID,
+		})
+		require.NoError(t, err)
+
+		if revoked {
+			_, err = satDAO.Update(ctx, nil, cdbm.ServiceAccountTokenUpdateInput{ServiceAccountTokenID: sat.ID, Revoked: cdb.GetTimePtr(time.Now())})
+			require.NoError(t, err)
+		}
+		return tokenStr
+	}
+
+	scopes := []string{"instance:write", "vpc:read"}
+	validToken := buildToken("valid", scopes, nil, cdb.GetTimePtr(time.Now().Add(time.Hour)), false)
+	allToken := buildToken("all", []string{"*:write"}, nil, nil, false)
+	siteToken := buildToken("site", scopes, &site.ID, nil, false)
+	expiredToken := buildToken("expired", scopes, nil, cdb.GetTimePtr(time.Now().Add(-time.Hour)), false)
+	revokedToken := buildToken("revoked", scopes, nil, nil, true)
+
+	otherSiteID := uuid.New()
+
+	tests := []struct {
+		name     string
+		token    string
+		method   string
+		route    string
+		target   string
+		org      string
+		wantCode int
+	}{
+		{name: "write scope allows create", token: validToken, method: http.MethodPost, route: "/v2/org/:orgName/carbide/instance", target: "/v2/org/test-org/carbide/instance", org: org},
+		{name: "read scope allows get", token: validToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/test-org/carbide/vpc", org: org},
+		{name: "read scope denies delete", token: validToken, method: http.MethodDelete, route: "/v2/org/:orgName/carbide/vpc/:id", target: "/v2/org/test-org/carbide/vpc/" + uuid.NewString(), org: org, wantCode: http.StatusForbidden},
+		{name: "resource outside scopes is denied", token: validToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/subnet", target: "/v2/org/test-org/carbide/subnet", org: org, wantCode: http.StatusForbidden},
+		{name: "all resources scope allows known resource", token: allToken, method: http.MethodDelete, route: "/v2/org/:orgName/carbide/subnet/:id", target: "/v2/org/test-org/carbide/subnet/" + uuid.NewString(), org: org},
+		{name: "all resources scope denies unknown resource", token: allToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/new-resource", target: "/v2/org/test-org/carbide/new-resource", org: org, wantCode: http.StatusForbidden},
+		{name: "other org is denied", token: validToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/other-org/carbide/vpc", org: "other-org", wantCode: http.StatusForbidden},
+		{name: "token Site is allowed", token: siteToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/instance", target: "/v2/org/test-org/carbide/instance?siteId=" + site.ID.String(), org: org},
+		{name: "other Site is denied", token: siteToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/instance", target: "/v2/org/test-org/carbide/instance?siteId=" + otherSiteID.String(), org: org, wantCode: http.StatusForbidden},
+		{name: "token Site is set on list without Site", token: siteToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/test-org/carbide/vpc", org: org},
+		{name: "create without Site is denied for Site scoped token", token: siteToken, method: http.MethodPost, route: "/v2/org/:orgName/carbide/instance", target: "/v2/org/test-org/carbide/instance", org: org, wantCode: http.StatusForbidden},
+		{name: "expired token is rejected", token: expiredToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/test-org/carbide/vpc", org: org, wantCode: http.StatusUnauthorized},
+		{name: "revoked token is rejected", token: revokedToken, method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/test-org/carbide/vpc", org: org, wantCode: http.StatusUnauthorized},
+		{name: "unknown token is rejected", token: core.APITokenPrefix + "unknown", method: http.MethodGet, route: "/v2/org/:orgName/carbide/vpc", target: "/v2/org/test-org/carbide/vpc", org: org, wantCode: http.StatusUnauthorized},
+	}
+
+	processor := NewAPITokenProcessor(dbSession)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newAPITokenTestContext(tt.method, tt.route, tt.target, "", []string{"orgName"}, []string{tt.org})
+
+			dbUser, apiErr := processor.ProcessToken(c, tt.token, nil, zerolog.Nop())
+			if tt.wantCode != 0 {
+				require.NotNil(t, apiErr)
+				assert.Equal(t, tt.wantCode, apiErr.Code)
+				assert.Nil(t, config.GetAPITokenIDFromContext(c))
+				return
+			}
+
+			require.Nil(t, apiErr)
+			require.NotNil(t, dbUser)
+			assert.NotNil(t, config.GetAPITokenIDFromContext(c))
+
+			tokenOrg, err := dbUser.OrgData.GetOrgByName(org)
+			require.NoError(t, err)
+			assert.Equal(t, APITokenRoles, tokenOrg.Roles)
+		})
+	}
+
+	// Last use is tracked
+	sat, err := satDAO.GetByTokenHash(ctx, nil, core.HashAPIToken(validToken))
+	require.NoError(t, err)
+	assert.NotNil(t, sat.LastUsed)
+}
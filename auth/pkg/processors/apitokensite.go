@@ -0,0 +1,448 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processors
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/uptrace/bun"
+)
+
+var (
+	// errAPITokenSiteNotDetermined is returned when the Site accessed by a request cannot be determined
+	errAPITokenSiteNotDetermined = errors.New("site accessed by request cannot be determined")
+)
+
+// apiTokenSiteTarget identifies the Site bound resource addressed by a route
+type apiTokenSiteTarget struct {
+	// model is the DB model of the resource, used to look up its Site
+	model interface{}
+	// param is the path param holding the ID of the resource
+	param string
+	// assocColumn is set for resources shared by Sites, model is then the Site association model and
+	// assocColumn the column holding the ID of the resource
+	assocColumn string
+}
+
+// apiTokenSitePolicy describes how the Site accessed by a route is determined for Site scoped API tokens
+type apiTokenSitePolicy struct {
+	// siteIndependent indicates the route does not access Site bound resources
+	siteIndependent bool
+	// siteUnsupported indicates the route can't be restricted to a Site and is denied for Site scoped tokens
+	siteUnsupported bool
+	// siteParam is the path param holding the ID of the Site addressed by the route
+	siteParam string
+	// target is the Site bound resource addressed by the route
+	target *apiTokenSiteTarget
+	// siteFilter indicates GET requests accept a siteId query param. It is set to the token's Site when not specified.
+	siteFilter bool
+	// siteInRequest indicates write requests must specify their Site through the siteId query param,
+	// the siteId body attribute or one of the bodyRefs
+	siteInRequest bool
+	// bodyRefs maps top level body attributes to the Site bound models they reference
+	bodyRefs map[string]interface{}
+	// bodyEntries indicates the body is a JSON array or CSV file of entries that may each specify a siteId
+	bodyEntries bool
+}
+
+func newAPITokenSiteTarget(model interface{}, param string) *apiTokenSiteTarget {
+	return &apiTokenSiteTarget{model: model, param: param}
+}
+
+// newAPITokenSiteAssociationTarget returns the target of a resource shared by Sites through an association model.
+// The resource can be read by tokens of any of its Sites but only changed when the token's Site is its only Site.
+func newAPITokenSiteAssociationTarget(assocModel interface{}, assocColumn string, param string) *apiTokenSiteTarget {
+	return &apiTokenSiteTarget{model: assocModel, param: param, assocColumn: assocColumn}
+}
+
+// HasAPITokenSitePolicy returns true if a route either has a Site policy or is explicitly denied for Site scoped API tokens
+func HasAPITokenSitePolicy(routePath string) bool {
+	_, ok := apiTokenSitePolicies[getAPITokenRoute(routePath)]
+	return ok
+}
+
+// apiTokenSitePolicies are keyed by route path relative to the API, e.g. /instance/:id.
+// Every org route must have a policy, routes without one are denied for Site scoped API tokens.
+var apiTokenSitePolicies = map[string]apiTokenSitePolicy{
+	"/metadata":                        {siteIndependent: true},
+	"/user/current":                    {siteIndependent: true},
+	"/tenant/current":                  {siteIndependent: true},
+	"/infrastructure-provider/current": {siteIndependent: true},
+	"/service-account/current":         {siteIndependent: true},
+	// Address Sets and Instance Templates belong to the Tenant rather than a Site
+	"/address-set":                            {siteIndependent: true},
+	"/address-set/:id":                        {siteIndependent: true},
+	"/instance-template":                      {siteIndependent: true},
+	"/instance-template/:id":                  {siteIndependent: true},
+	"/instance-template/:id/version":          {siteIndependent: true},
+	"/instance-template/:id/version/:version": {siteIndependent: true},
+
+	"/site/:id":                {siteParam: "id"},
+	"/site/:id/status-history": {siteParam: "id"},
+	"/site/:siteID/machine-validation/external-config":            {siteParam: "siteID"},
+	"/site/:siteID/machine-validation/external-config/:cfgName":   {siteParam: "siteID"},
+	"/site/:siteID/machine-validation/machine/:machineID/results": {siteParam: "siteID"},
+	"/site/:siteID/machine-validation/machine/:machineID/runs":    {siteParam: "siteID"},
+	"/site/:siteID/machine-validation/test":                       {siteParam: "siteID"},
+	"/site/:siteID/machine-validation/test/:id/version/:version":  {siteParam: "siteID"},
+	"/tenant/current/usage":                                       {siteFilter: true},
+	"/infrastructure-provider/current/usage":                      {siteFilter: true},
+	"/tenant/instance-type/stats":                                 {siteFilter: true},
+	"/machine/gpu/stats":                                          {siteFilter: true},
+	"/machine/instance-type/stats":                                {siteFilter: true},
+	"/machine/instance-type/stats/summary":                        {siteFilter: true},
+	"/machine-capability":                                         {siteFilter: true},
+	"/nvlink-interface":                                           {siteFilter: true},
+	"/operating-system":                                           {siteFilter: true},
+	"/operating-system/:id":                                       {target: newAPITokenSiteAssociationTarget((*cdbm.OperatingSystemSiteAssociation)(nil), "operating_system_id", "id")},
+	"/operating-system/:id/version":                               {target: newAPITokenSiteAssociationTarget((*cdbm.OperatingSystemSiteAssociation)(nil), "operating_system_id", "id")},
+	"/operating-system/:id/version/:versionId":                    {target: newAPITokenSiteAssociationTarget((*cdbm.OperatingSystemSiteAssociation)(nil), "operating_system_id", "id")},
+	"/operating-system/:id/version/:versionId/verify":             {target: newAPITokenSiteAssociationTarget((*cdbm.OperatingSystemSiteAssociation)(nil), "operating_system_id", "id")},
+	"/sshkeygroup":                                                {siteFilter: true},
+	"/sshkeygroup/:id":                                            {target: newAPITokenSiteAssociationTarget((*cdbm.SSHKeyGroupSiteAssociation)(nil), "sshkey_group_id", "id")},
+	"/sku":                                                        {siteFilter: true},
+	"/sku/:id":                                                    {target: newAPITokenSiteTarget((*cdbm.SKU)(nil), "id")},
+	"/machine":                                                    {siteFilter: true},
+	"/machine/:id":                                                {target: newAPITokenSiteTarget((*cdbm.Machine)(nil), "id")},
+	"/machine/:id/status-history":                                 {target: newAPITokenSiteTarget((*cdbm.Machine)(nil), "id")},
+	"/allocation":                                                 {siteFilter: true, siteInRequest: true},
+	"/allocation/:id":                                             {target: newAPITokenSiteTarget((*cdbm.Allocation)(nil), "id")},
+	"/allocation/:allocationId/constraint/:id":                    {target: newAPITokenSiteTarget((*cdbm.Allocation)(nil), "allocationId")},
+	"/ipblock":                                    {siteFilter: true, siteInRequest: true},
+	"/ipblock/:id":                                {target: newAPITokenSiteTarget((*cdbm.IPBlock)(nil), "id")},
+	"/ipblock/:id/derived":                        {target: newAPITokenSiteTarget((*cdbm.IPBlock)(nil), "id")},
+	"/instance/type":                              {siteFilter: true, siteInRequest: true},
+	"/instance/type/:id":                          {target: newAPITokenSiteTarget((*cdbm.InstanceType)(nil), "id")},
+	"/instance/type/:instanceTypeId/machine":      {target: newAPITokenSiteTarget((*cdbm.InstanceType)(nil), "instanceTypeId")},
+	"/instance/type/:instanceTypeId/machine/:id":  {target: newAPITokenSiteTarget((*cdbm.InstanceType)(nil), "instanceTypeId")},
+	"/instance/type/:instanceTypeId/match":        {target: newAPITokenSiteTarget((*cdbm.InstanceType)(nil), "instanceTypeId")},
+	"/vpc":                                        {siteFilter: true, siteInRequest: true},
+	"/vpc/:id":                                    {target: newAPITokenSiteTarget((*cdbm.Vpc)(nil), "id")},
+	"/vpc/:id/virtualization":                     {target: newAPITokenSiteTarget((*cdbm.Vpc)(nil), "id")},
+	"/subnet":                                     {siteFilter: true, siteInRequest: true, bodyRefs: map[string]interface{}{"vpcId": (*cdbm.Vpc)(nil)}},
+	"/subnet/:id":                                 {target: newAPITokenSiteTarget((*cdbm.Subnet)(nil), "id")},
+	"/vpc-prefix":                                 {siteFilter: true, siteInRequest: true, bodyRefs: map[string]interface{}{"vpcId": (*cdbm.Vpc)(nil)}},
+	"/vpc-prefix/:id":                             {target: newAPITokenSiteTarget((*cdbm.VpcPrefix)(nil), "id")},
+	"/instance":                                   {siteFilter: true, siteInRequest: true, bodyRefs: map[string]interface{}{"vpcId": (*cdbm.Vpc)(nil), "instanceTypeId": (*cdbm.InstanceType)(nil), "machineId": (*cdbm.Machine)(nil)}},
+	"/instance/batch":                             {siteInRequest: true, bodyRefs: map[string]interface{}{"vpcId": (*cdbm.Vpc)(nil), "instanceTypeId": (*cdbm.InstanceType)(nil)}},
+	"/instance/:id":                               {target: newAPITokenSiteTarget((*cdbm.Instance)(nil), "id")},
+	"/instance/:id/effective-security-rules":      {target: newAPITokenSiteTarget((*cdbm.Instance)(nil), "id")},
+	"/instance/:id/status-history":                {target: newAPITokenSiteTarget((*cdbm.Instance)(nil), "id")},
+	"/instance/:instanceId/interface":             {target: newAPITokenSiteTarget((*cdbm.Instance)(nil), "instanceId")},
+	"/network-security-group":                     {siteFilter: true, siteInRequest: true},
+	"/network-security-group/:id":                 {target: newAPITokenSiteTarget((*cdbm.NetworkSecurityGroup)(nil), "id")},
+	"/network-security-group/evaluate":            {siteInRequest: true, bodyRefs: map[string]interface{}{"instanceId": (*cdbm.Instance)(nil)}},
+	"/infiniband-partition":                       {siteFilter: true, siteInRequest: true},
+	"/infiniband-partition/:id":                   {target: newAPITokenSiteTarget((*cdbm.InfiniBandPartition)(nil), "id")},
+	"/nvlink-logical-partition":                   {siteFilter: true, siteInRequest: true},
+	"/nvlink-logical-partition/:id":               {target: newAPITokenSiteTarget((*cdbm.NVLinkLogicalPartition)(nil), "id")},
+	"/dpu-extension-service":                      {siteFilter: true, siteInRequest: true},
+	"/dpu-extension-service/:id":                  {target: newAPITokenSiteTarget((*cdbm.DpuExtensionService)(nil), "id")},
+	"/dpu-extension-service/:id/version/:version": {target: newAPITokenSiteTarget((*cdbm.DpuExtensionService)(nil), "id")},
+	"/expected-machine":                           {siteFilter: true, siteInRequest: true},
+	"/expected-machine/:id":                       {target: newAPITokenSiteTarget((*cdbm.ExpectedMachine)(nil), "id")},
+	"/expected-machine/bulk":                      {siteInRequest: true, bodyEntries: true},
+	"/expected-switch":                            {siteFilter: true, siteInRequest: true},
+	"/expected-switch/:id":                        {target: newAPITokenSiteTarget((*cdbm.ExpectedSwitch)(nil), "id")},
+	"/expected-switch/bulk":                       {siteInRequest: true, bodyEntries: true},
+	"/expected-power-shelf":                       {siteFilter: true, siteInRequest: true},
+	"/expected-power-shelf/:id":                   {target: newAPITokenSiteTarget((*cdbm.ExpectedPowerShelf)(nil), "id")},
+	"/expected-power-shelf/bulk":                  {siteInRequest: true, bodyEntries: true},
+	// Rack and Tray routes are served by the Site specified in the siteId query param
+	"/rack":                {siteFilter: true, siteInRequest: true},
+	"/rack/:id":            {siteFilter: true, siteInRequest: true},
+	"/rack/:id/bringup":    {siteFilter: true, siteInRequest: true},
+	"/rack/:id/firmware":   {siteFilter: true, siteInRequest: true},
+	"/rack/:id/power":      {siteFilter: true, siteInRequest: true},
+	"/rack/:id/validation": {siteFilter: true, siteInRequest: true},
+	"/rack/bringup":        {siteFilter: true, siteInRequest: true},
+	"/rack/firmware":       {siteFilter: true, siteInRequest: true},
+	"/rack/power":          {siteFilter: true, siteInRequest: true},
+	"/rack/validation":     {siteFilter: true, siteInRequest: true},
+	"/tray":                {siteFilter: true, siteInRequest: true},
+	"/tray/:id":            {siteFilter: true, siteInRequest: true},
+	"/tray/:id/firmware":   {siteFilter: true, siteInRequest: true},
+	"/tray/:id/power":      {siteFilter: true, siteInRequest: true},
+	"/tray/:id/validation": {siteFilter: true, siteInRequest: true},
+	"/tray/firmware":       {siteFilter: true, siteInRequest: true},
+	"/tray/power":          {siteFilter: true, siteInRequest: true},
+	"/tray/validation":     {siteFilter: true, siteInRequest: true},
+	// Routes that span Sites or manage the org itself
+	"/audit":                                 {siteUnsupported: true},
+	"/audit/:id":                             {siteUnsupported: true},
+	"/audit/export":                          {siteUnsupported: true},
+	"/infrastructure-provider":               {siteUnsupported: true},
+	"/infrastructure-provider/current/stats": {siteUnsupported: true},
+	"/service-account/token":                 {siteUnsupported: true},
+	"/service-account/token/:id":             {siteUnsupported: true},
+	"/site":                                  {siteUnsupported: true},
+	"/sshkey":                                {siteUnsupported: true},
+	"/sshkey/:id":                            {siteUnsupported: true},
+	"/tenant":                                {siteUnsupported: true},
+	"/tenant/account":                        {siteUnsupported: true},
+	"/tenant/account/:id":                    {siteUnsupported: true},
+	"/tenant/current/stats":                  {siteUnsupported: true},
+}
+
+// getAPITokenRoute returns the route path relative to the API e.g. /instance/:id for /v2/org/:orgName/carbide/instance/:id.
+// Returns empty string if path is not an org route.
+func getAPITokenRoute(routePath string) string {
+	segments := strings.Split(strings.Trim(routePath, "/"), "/")
+	for i, segment := range segments {
+		if segment == ":orgName" && i+2 < len(segments) {
+			return "/" + strings.Join(segments[i+2:], "/")
+		}
+	}
+	return ""
+}
+
+// enforceAPITokenSite checks that a request only accesses the given Site. GET requests to routes accepting a
+// Site filter are restricted to the Site when they don't specify one. Returns errAPITokenSiteNotDetermined if
+// the route can't be restricted to a Site.
+func enforceAPITokenSite(ctx context.Context, dbSession *cdb.Session, c echo.Context, siteID uuid.UUID) (*uuid.UUID, error) {
+	policy, ok := apiTokenSitePolicies[getAPITokenRoute(c.Path())]
+	if !ok || policy.siteUnsupported {
+		return nil, errAPITokenSiteNotDetermined
+	}
+	if policy.siteIndependent {
+		return nil, nil
+	}
+
+	reqSiteIDs, err := getRequestSiteIDs(ctx, dbSession, c, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.target != nil && policy.target.assocColumn != "" {
+		assocSiteIDs, err := getResourceAssociatedSiteIDs(ctx, dbSession, policy.target, c.Param(policy.target.param))
+		if err != nil {
+			return nil, err
+		}
+		// Shared resources can be read through any of their Sites, resources of no Site can only be read
+		if c.Request().Method != http.MethodGet {
+			if len(assocSiteIDs) == 0 {
+				return nil, errAPITokenSiteNotDetermined
+			}
+			reqSiteIDs = append(reqSiteIDs, assocSiteIDs...)
+		} else if !slices.Contains(assocSiteIDs, siteID) {
+			reqSiteIDs = append(reqSiteIDs, assocSiteIDs...)
+		}
+	}
+
+	for _, reqSiteID := range reqSiteIDs {
+		if reqSiteID != siteID {
+			return &reqSiteID, nil
+		}
+	}
+
+	if policy.siteParam != "" || policy.target != nil {
+		return nil, nil
+	}
+
+	if c.Request().Method == http.MethodGet {
+		if !policy.siteFilter {
+			return nil, errAPITokenSiteNotDetermined
+		}
+		if len(reqSiteIDs) == 0 {
+			setRequestSiteFilter(c, siteID)
+		}
+		return nil, nil
+	}
+
+	if !policy.siteInRequest || len(reqSiteIDs) == 0 {
+		return nil, errAPITokenSiteNotDetermined
+	}
+
+	return nil, nil
+}
+
+// setRequestSiteFilter sets the siteId query param of a request
+func setRequestSiteFilter(c echo.Context, siteID uuid.UUID) {
+	// Query params are cached by the context once read, update both
+	c.QueryParams().Set("siteId", siteID.String())
+
+	req := c.Request()
+	query := req.URL.Query()
+	query.Set("siteId", siteID.String())
+	req.URL.RawQuery = query.Encode()
+}
+
+// getRequestSiteIDs returns the Site IDs accessed by a request: the siteId query param, the Site path param
+// or the Site of the resource addressed by the route, and the top level siteId attribute of a JSON body, the
+// siteId of each body entry or the Sites of resources referenced by body attributes. The Sites of shared
+// resources are checked by enforceAPITokenSite. Unparseable IDs and unknown resources are ignored
+// and left for the handler to reject.
+func getRequestSiteIDs(ctx context.Context, dbSession *cdb.Session, c echo.Context, policy apiTokenSitePolicy) ([]uuid.UUID, error) {
+	siteIDStrs := append([]string{}, c.QueryParams()["siteId"]...)
+
+	if policy.siteParam != "" {
+		siteIDStrs = append(siteIDStrs, c.Param(policy.siteParam))
+	}
+
+	siteIDs := []uuid.UUID{}
+
+	if policy.target != nil && policy.target.assocColumn == "" {
+		siteID, err := getResourceSiteID(ctx, dbSession, policy.target.model, c.Param(policy.target.param))
+		if err != nil {
+			return nil, err
+		}
+		if siteID != nil {
+			siteIDs = append(siteIDs, *siteID)
+		}
+	}
+
+	req := c.Request()
+	contentType := req.Header.Get(echo.HeaderContentType)
+	isJSON := strings.HasPrefix(contentType, echo.MIMEApplicationJSON)
+	isCSV := policy.bodyEntries && strings.HasPrefix(contentType, "text/csv")
+	if req.Body != nil && req.Method != http.MethodGet && (isJSON || isCSV) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		// Restore the body for the handler
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if policy.bodyEntries {
+			siteIDStrs = append(siteIDStrs, getBodyEntrySiteIDs(body, isCSV)...)
+		}
+
+		attrs := map[string]interface{}{}
+		// Non-object or malformed bodies are left for the handler to reject
+		if json.Unmarshal(body, &attrs) == nil {
+			if siteID, ok := attrs["siteId"].(string); ok {
+				siteIDStrs = append(siteIDStrs, siteID)
+			}
+			for attr, model := range policy.bodyRefs {
+				id, ok := attrs[attr].(string)
+				if !ok {
+					continue
+				}
+				siteID, err := getResourceSiteID(ctx, dbSession, model, id)
+				if err != nil {
+					return nil, err
+				}
+				if siteID != nil {
+					siteIDs = append(siteIDs, *siteID)
+				}
+			}
+		}
+	}
+
+	for _, siteIDStr := range siteIDStrs {
+		siteID, err := uuid.Parse(siteIDStr)
+		if err != nil {
+			continue
+		}
+		siteIDs = append(siteIDs, siteID)
+	}
+
+	return siteIDs, nil
+}
+
+// getBodyEntrySiteIDs returns the siteId of each entry of a JSON array or CSV body. Malformed bodies are left
+// for the handler to reject.
+func getBodyEntrySiteIDs(body []byte, isCSV bool) []string {
+	siteIDStrs := []string{}
+
+	if !isCSV {
+		entries := []map[string]interface{}{}
+		if json.Unmarshal(body, &entries) == nil {
+			for _, entry := range entries {
+				if siteID, ok := entry["siteId"].(string); ok {
+					siteIDStrs = append(siteIDStrs, siteID)
+				}
+			}
+		}
+		return siteIDStrs
+	}
+
+	records, err := csv.NewReader(bytes.NewReader(body)).ReadAll()
+	if err != nil || len(records) == 0 {
+		return siteIDStrs
+	}
+	col := slices.Index(records[0], "siteId")
+	if col < 0 {
+		return siteIDStrs
+	}
+	for _, record := range records[1:] {
+		if col < len(record) {
+			siteIDStrs = append(siteIDStrs, record[col])
+		}
+	}
+	return siteIDStrs
+}
+
+// getResourceAssociatedSiteIDs returns the Sites a shared resource is associated with
+func getResourceAssociatedSiteIDs(ctx context.Context, dbSession *cdb.Session, target *apiTokenSiteTarget, id string) ([]uuid.UUID, error) {
+	// Invalid IDs are left for the handler to reject
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, nil
+	}
+
+	siteIDs := []uuid.UUID{}
+	err := dbSession.DB.NewSelect().Model(target.model).Column("site_id").Where("? = ?", bun.Ident(target.assocColumn), id).Scan(ctx, &siteIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return siteIDs, nil
+}
+
+// getResourceSiteID returns the Site of a Site bound resource. Returns nil if the resource does not exist.
+// Resources that exist without a Site can't be accessed by Site scoped tokens.
+func getResourceSiteID(ctx context.Context, dbSession *cdb.Session, model interface{}, id string) (*uuid.UUID, error) {
+	switch model.(type) {
+	case *cdbm.Machine, *cdbm.SKU:
+		if id == "" {
+			return nil, nil
+		}
+	default:
+		// Invalid IDs are left for the handler to reject
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, nil
+		}
+	}
+
+	var siteID uuid.NullUUID
+	err := dbSession.DB.NewSelect().Model(model).Column("site_id").Where("id = ?", id).Limit(1).Scan(ctx, &siteID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !siteID.Valid {
+		return nil, errAPITokenSiteNotDetermined
+	}
+
+	return &siteID.UUID, nil
+}
@@ -55,9 +55,16 @@ func NewCustomProcessor(dbSession *cdb.Session) config.TokenProcessor {
 	}
 }
 
+// NewAPITokenProcessor creates a new API token processor
+func NewAPITokenProcessor(dbSession *cdb.Session) config.TokenProcessor {
+	return &APITokenProcessor{
+		dbSession: dbSession,
+	}
+}
+
 // InitializeProcessors sets up all token processors in the JWTOriginConfig
 func InitializeProcessors(joCfg *config.JWTOriginConfig, dbSession *cdb.Session, tc temporalClient.Client, encCfg *commonConfig.PayloadEncryptionConfig, kcfg *config.KeycloakConfig) {
-	for _, origin := range []string{config.TokenOriginKeycloak, config.TokenOriginKasSsa, config.TokenOriginKasLegacy, config.TokenOriginCustom} {
+	for _, origin := range []string{config.TokenOriginKeycloak, config.TokenOriginKasSsa, config.TokenOriginKasLegacy, config.TokenOriginCustom, config.TokenOriginAPIToken} {
 		switch origin {
 		case config.TokenOriginKeycloak:
 			processor := NewKeycloakProcessor(dbSession, kcfg)
@@ -71,6 +78,9 @@ func InitializeProcessors(joCfg *config.JWTOriginConfig, dbSession *cdb.Session,
 		case config.TokenOriginCustom:
 			processor := NewCustomProcessor(dbSession)
 			joCfg.SetProcessorForOrigin(origin, processor)
+		case config.TokenOriginAPIToken:
+			processor := NewAPITokenProcessor(dbSession)
+			joCfg.SetProcessorForOrigin(origin, processor)
 		}
 	}
 }
@@ -18,10 +18,13 @@
 package certs
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	cli "github.com/urfave/cli/v2"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/core"
 	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/pki"
@@ -104,6 +107,66 @@ func NewCommand() *cli.Command {
 				EnvVars: []string{"ALT_CA_KEY_FILE"},
 				Usage:   "Alternate path to CA private key file",
 			},
+			&cli.StringFlag{
+				Name:    "next-ca-cert-file",
+				Value:   "/etc/pki/ca-next/tls.crt",
+				EnvVars: []string{"NEXT_CA_CERT_FILE"},
+				Usage:   "Path to the certificate of the CA to rotate to, rotation is disabled when it does not exist",
+			},
+			&cli.StringFlag{
+				Name:    "next-ca-key-file",
+				Value:   "/etc/pki/ca-next/tls.key",
+				EnvVars: []string{"NEXT_CA_KEY_FILE"},
+				Usage:   "Path to the private key of the CA to rotate to",
+			},
+			&cli.TimestampFlag{
+				Name:    "ca-rotation-start",
+				Layout:  time.RFC3339,
+				EnvVars: []string{"CA_ROTATION_START"},
+				Usage:   "When the CA rotation starts (RFC3339), defaults to the NotBefore of the next CA",
+			},
+			&cli.DurationFlag{
+				Name:    "ca-rotation-propagation",
+				Value:   pki.DefaultCARotationPropagation,
+				EnvVars: []string{"CA_ROTATION_PROPAGATION"},
+				Usage:   "How long both CAs are published before the next CA starts signing",
+			},
+			&cli.DurationFlag{
+				Name:    "ca-rotation-overlap",
+				Value:   pki.DefaultCARotationOverlap,
+				EnvVars: []string{"CA_ROTATION_OVERLAP"},
+				Usage:   "How long after the rotation starts the current CA stays trusted, must cover the propagation plus the maximum certificate TTL",
+			},
+			&cli.DurationFlag{
+				Name:    "max-certificate-ttl",
+				Value:   pki.DefaultCertificateTTL,
+				EnvVars: []string{"MAX_CERTIFICATE_TTL"},
+				Usage:   "Maximum TTL of the certificates issued to clients, longer requested TTLs are capped",
+			},
+			&cli.StringFlag{
+				Name:    "admin-token",
+				Value:   "",
+				EnvVars: []string{"CERT_MANAGER_ADMIN_TOKEN"},
+				Usage:   "Bearer token for the certificate inventory and revocation API, disabled when empty",
+			},
+			&cli.StringFlag{
+				Name:    "inventory-secret",
+				Value:   "",
+				EnvVars: []string{"CERT_INVENTORY_SECRET"},
+				Usage:   "Kubernetes Secret persisting the inventory of issued certificates",
+			},
+			&cli.StringFlag{
+				Name:    "inventory-file",
+				Value:   "",
+				EnvVars: []string{"CERT_INVENTORY_FILE"},
+				Usage:   "File persisting the inventory of issued certificates, used when no secret is set",
+			},
+			&cli.StringFlag{
+				Name:    "namespace",
+				Value:   "default",
+				EnvVars: []string{"POD_NAMESPACE"},
+				Usage:   "Namespace of the inventory secret",
+			},
 		},
 		Before: func(c *cli.Context) error {
 			if c.Bool("debug") {
@@ -120,8 +183,18 @@ func NewCommand() *cli.Command {
 				InsecureAddr: ":" + c.String("insecure-port"),
 				DNSName:      c.String("dns-name"),
 				CABaseDNS:    c.String("ca-base-dns"),
+				AdminToken:   c.String("admin-token"),
 				sentryDSN:    c.String("sentry-dsn"),
 			}
+			if o.AdminToken == "" {
+				log.Warn("No admin token configured, certificate inventory and revocation API disabled")
+			}
+
+			store, err := newCertificateStore(c)
+			if err != nil {
+				log.Errorf("Failed to set up certificate inventory store: %v", err)
+				return err
+			}
 
 			// Use native Go PKI for certificate generation
 			log.Info("Using native Go PKI for certificate generation")
@@ -139,6 +212,14 @@ func NewCommand() *cli.Command {
 				CAKeyFile:      caKeyFile,
 				AltCACertFile:  altCACertFile,
 				AltCAKeyFile:   altCAKeyFile,
+				Store:          store,
+
+				NextCACertFile:        c.String("next-ca-cert-file"),
+				NextCAKeyFile:         c.String("next-ca-key-file"),
+				CARotationStart:       caRotationStart(c),
+				CARotationPropagation: c.Duration("ca-rotation-propagation"),
+				CARotationOverlap:     c.Duration("ca-rotation-overlap"),
+				MaxCertificateTTL:     c.Duration("max-certificate-ttl"),
 			})
 			if err != nil {
 				log.Errorf("Failed to create native PKI issuer: %v", err)
@@ -164,3 +245,42 @@ func NewCommand() *cli.Command {
 		},
 	}
 }
+
+// newCertificateStore returns the store for the certificate inventory
+func newCertificateStore(c *cli.Context) (pki.CertificateStore, error) {
+	log := core.GetLogger(c.Context)
+
+	if name := c.String("inventory-secret"); name != "" {
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get in-cluster config: %w", err)
+		}
+		client, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+
+		log.Infof("Persisting certificate inventory in secret %s/%s", c.String("namespace"), name)
+		return &pki.SecretCertificateStore{
+			Client:    client,
+			Namespace: c.String("namespace"),
+			Name:      name,
+		}, nil
+	}
+
+	if path := c.String("inventory-file"); path != "" {
+		log.Infof("Persisting certificate inventory in %s", path)
+		return &pki.FileCertificateStore{Path: path}, nil
+	}
+
+	log.Warn("No certificate inventory store configured, revocations are lost on restart")
+	return nil, nil
+}
+
+// caRotationStart returns the configured CA rotation start, zero if unset
+func caRotationStart(c *cli.Context) time.Time {
+	if t := c.Timestamp("ca-rotation-start"); t != nil {
+		return *t
+	}
+	return time.Time{}
+}
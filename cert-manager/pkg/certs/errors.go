@@ -36,6 +36,8 @@ const (
 	ErrorGetCertificate
 	ErrorEncryptCertificatePrivateKey
 	ErrorMarshalJSON
+	ErrorInvalidNonce
+	ErrorExpiredNonce
 
 	ErrorRequestCACertificate
 	ErrorDecodeCACertificate
@@ -43,6 +45,11 @@ const (
 
 	ErrorEventLogParse
 	ErrorEventLogVerification
+
+	ErrorUnauthorized
+	ErrorRequestCRL
+	ErrorListCertificates
+	ErrorRevokeCertificate
 )
 
 type errorInfo struct {
@@ -66,6 +73,10 @@ var errorInfoTable = [...]errorInfo{
 	{"ErrorBadPKIRequest", http.StatusBadRequest},
 	{"ErrorEventLogParse", http.StatusBadRequest},
 	{"ErrorEventLogVerification", http.StatusBadRequest},
+	{"ErrorUnauthorized", http.StatusUnauthorized},
+	{"ErrorRequestCRL", http.StatusInternalServerError},
+	{"ErrorListCertificates", http.StatusInternalServerError},
+	{"ErrorRevokeCertificate", http.StatusInternalServerError},
 }
 
 // Error returns a go error
@@ -30,3 +30,9 @@ type CertificateRequest = types.CertificateRequest
 
 // CertificateResponse is an alias for types.CertificateResponse for backward compatibility
 type CertificateResponse = types.CertificateResponse
+
+// RevokeRequest is an alias for types.RevokeRequest
+type RevokeRequest = types.RevokeRequest
+
+// RevokeResponse is an alias for types.RevokeResponse
+type RevokeResponse = types.RevokeResponse
@@ -50,7 +50,7 @@ func (h *pkiCACertificateHandler) reply(ctx context.Context, cert string, err Er
 	}
 }
 
-// ServeHTTP implements /v1/pki/ca/*
+// ServeHTTP implements /v1/pki/ca/* and /v1/pki/crl
 func (h *pkiCACertificateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := core.GetLogger(ctx)
@@ -81,6 +81,13 @@ func (h *pkiCACertificateHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 			h.reply(ctx, "", ErrorRequestCACertificate, w)
 			return
 		}
+	case "/v1/pki/crl":
+		cert, err = h.certificateIssuer.GetCRL(ctx)
+		if err != nil {
+			log.WithField("err", ErrorRequestCRL.String()).Errorf("failed to request PKI CRL: %s", err.Error())
+			h.reply(ctx, "", ErrorRequestCRL, w)
+			return
+		}
 	default:
 		log.WithField("err", ErrorBadPKIRequest.String()).Errorf("invalid path")
 		h.reply(ctx, "", ErrorBadPKIRequest, w)
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package certs
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/core"
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
+)
+
+// adminAuth only lets requests carrying the admin bearer token through.
+// Without a configured token the admin API is disabled.
+type adminAuth struct {
+	token string
+	h     http.Handler
+}
+
+// ServeHTTP implements http.Handler
+func (a *adminAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := core.GetLogger(r.Context())
+
+	if a.token == "" {
+		log.WithField("err", ErrorUnauthorized.String()).Errorf("admin API disabled, no admin token configured")
+		http.Error(w, ErrorUnauthorized.Error(), http.StatusForbidden)
+		return
+	}
+
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		log.WithField("err", ErrorUnauthorized.String()).Errorf("invalid admin token")
+		http.Error(w, ErrorUnauthorized.Error(), ErrorUnauthorized.Code())
+		return
+	}
+
+	a.h.ServeHTTP(w, r)
+}
+
+type pkiCertificatesHandler struct {
+	certificateIssuer CertificateIssuer
+}
+
+func (h *pkiCertificatesHandler) reply(ctx context.Context, resp interface{}, err Error, w http.ResponseWriter) {
+	log := core.GetLogger(ctx)
+
+	if err != ErrorNone {
+		http.Error(w, err.Error(), err.Code())
+		return
+	}
+
+	respBytes, marshalErr := json.Marshal(resp)
+	if marshalErr != nil {
+		log.WithField("err", ErrorMarshalJSON.String()).Errorf("Failed to json.Marshal %T, err: %s", resp, marshalErr.Error())
+		http.Error(w, ErrorMarshalJSON.Error(), ErrorMarshalJSON.Code())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(err.Code())
+	_, errWrite := w.Write(respBytes)
+	if errWrite != nil {
+		log.Error(errWrite)
+	}
+}
+
+// ServeHTTP implements /v1/pki/certificates and /v1/pki/revoke
+func (h *pkiCertificatesHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := core.GetLogger(ctx)
+
+	switch r.URL.Path {
+	case "/v1/pki/certificates":
+		filter := &types.CertificateFilter{
+			CommonName: r.URL.Query().Get("common_name"),
+		}
+		if v := r.URL.Query().Get("include_expired"); v != "" {
+			includeExpired, err := strconv.ParseBool(v)
+			if err != nil {
+				log.WithField("err", ErrorParseRequest.String()).Errorf("invalid include_expired: %s", err.Error())
+				h.reply(ctx, nil, ErrorParseRequest, w)
+				return
+			}
+			filter.IncludeExpired = includeExpired
+		}
+
+		certs, err := h.certificateIssuer.ListCertificates(ctx, filter)
+		if err != nil {
+			log.WithField("err", ErrorListCertificates.String()).Errorf("failed certificateIssuer.ListCertificates, err: %s", err.Error())
+			h.reply(ctx, nil, ErrorListCertificates, w)
+			return
+		}
+
+		h.reply(ctx, &types.ListCertificatesResponse{Certificates: certs}, ErrorNone, w)
+	case "/v1/pki/revoke":
+		req := &types.RevokeRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			log.WithField("err", ErrorParseRequest.String()).Errorf("failed to parse request body as RevokeRequest: %s", err.Error())
+			h.reply(ctx, nil, ErrorParseRequest, w)
+			return
+		}
+		if err := req.Validate(); err != nil {
+			log.WithField("err", ErrorParseRequest.String()).Errorf("invalid RevokeRequest: %s", err.Error())
+			h.reply(ctx, nil, ErrorParseRequest, w)
+			return
+		}
+
+		revoked, err := h.certificateIssuer.RevokeCertificates(ctx, req)
+		if err != nil {
+			log.WithField("err", ErrorRevokeCertificate.String()).Errorf("failed certificateIssuer.RevokeCertificates, err: %s", err.Error())
+			h.reply(ctx, nil, ErrorRevokeCertificate, w)
+			return
+		}
+
+		for _, c := range revoked {
+			log.Infof("revoked certificate %s (%s), reason: %q", c.SerialNumber, c.CommonName, req.Reason)
+		}
+		if revoked == nil {
+			revoked = []types.IssuedCertificate{}
+		}
+
+		h.reply(ctx, &types.RevokeResponse{Revoked: revoked}, ErrorNone, w)
+	default:
+		log.WithField("err", ErrorBadPKIRequest.String()).Errorf("invalid path")
+		h.reply(ctx, nil, ErrorBadPKIRequest, w)
+	}
+}
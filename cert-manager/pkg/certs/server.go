@@ -21,6 +21,7 @@ import (
 	"context"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/getsentry/sentry-go"
 	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/core"
@@ -31,6 +32,9 @@ const (
 	svcKeyFile  = "/tmp/svc.key"
 	svcCertFile = "/tmp/svc.cert"
 	svcTTL      = 10 * 365 * 24
+	// svcRenewInterval is how often the service certificate is checked
+	// against the signing CA
+	svcRenewInterval = 10 * time.Minute
 )
 
 // Options defines options for the server
@@ -39,7 +43,10 @@ type Options struct {
 	InsecureAddr string
 	DNSName      string
 	CABaseDNS    string
-	sentryDSN    string
+	// AdminToken is the bearer token required by the certificate inventory
+	// and revocation endpoints. They are disabled when empty.
+	AdminToken string
+	sentryDSN  string
 }
 
 // Server defines a server
@@ -71,12 +78,16 @@ func NewServerWithIssuer(ctx context.Context, o Options, certIssuer CertificateI
 	appService.Use(core.NewHTTPMiddleware(ctx, core.WithRequestMetrics("cloud_cert_manager"))...)
 	appService.Path("/v1/pki/ca").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
 	appService.Path("/v1/pki/ca/pem").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
+	appService.Path("/v1/pki/crl").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
 	appService.Path("/v1/pki/cloud-cert").Handler(s.PKICloudCertificateHandler(ctx)).Methods("POST")
+	appService.Path("/v1/pki/certificates").Handler(s.PKICertificatesHandler(ctx)).Methods("GET")
+	appService.Path("/v1/pki/revoke").Handler(s.PKICertificatesHandler(ctx)).Methods("POST")
 	s.appService = appService
 	insec := core.NewHTTPService(s.InsecureAddr)
 	insec.AddHealthRoute(ctx)
 	insec.Path("/v1/pki/ca").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
 	insec.Path("/v1/pki/ca/pem").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
+	insec.Path("/v1/pki/crl").Handler(s.PKICACertificateHandler(ctx)).Methods("GET")
 	s.insecService = insec
 
 	if o.sentryDSN != "" {
@@ -103,6 +114,8 @@ func (s *Server) Start(ctx context.Context) {
 		log.Fatalf("failed to start healt ep: %v", err)
 		return
 	}
+
+	go s.renewServiceCert(ctx)
 }
 
 // PKICACertificateHandler returns pkiCACertificateHandler
@@ -123,6 +136,18 @@ func (s *Server) PKICloudCertificateHandler(_ context.Context) http.Handler {
 	return s.withWraps(h, "ccm-get-cert")
 }
 
+// PKICertificatesHandler returns pkiCertificatesHandler behind the admin token
+func (s *Server) PKICertificatesHandler(_ context.Context) http.Handler {
+	h := &adminAuth{
+		token: s.AdminToken,
+		h: &pkiCertificatesHandler{
+			certificateIssuer: s.certificateIssuer,
+		},
+	}
+
+	return s.withWraps(h, "ccm-certificates")
+}
+
 func (s *Server) tlsSetup(ctx context.Context) error {
 	i := s.certificateIssuer
 	cert, key, err := i.RawCertificate(ctx, s.DNSName, svcTTL)
@@ -130,17 +155,54 @@ func (s *Server) tlsSetup(ctx context.Context) error {
 		return err
 	}
 
-	err = os.WriteFile(svcCertFile, []byte(cert), 0644)
+	// the key goes first, the TLS service reloads the pair when the
+	// certificate changes
+	err = os.WriteFile(svcKeyFile, []byte(key), 0644)
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(svcKeyFile, []byte(key), 0644)
+	return os.WriteFile(svcCertFile, []byte(cert), 0644)
+}
+
+// renewServiceCert reissues the service certificate once the next CA of a
+// rotation starts signing, before the current CA it was issued by retires
+func (s *Server) renewServiceCert(ctx context.Context) {
+	log := core.GetLogger(ctx)
+	ticker := time.NewTicker(svcRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.checkServiceCert(ctx, time.Now()); err != nil {
+			log.Errorf("checkServiceCert: %v", err)
+		}
+	}
+}
+
+// checkServiceCert reissues the service certificate if it needs to be
+func (s *Server) checkServiceCert(ctx context.Context, now time.Time) error {
+	cert, err := os.ReadFile(svcCertFile)
 	if err != nil {
 		return err
 	}
+	ca, err := s.certificateIssuer.GetCACertificate(ctx)
+	if err != nil {
+		return err
+	}
+
+	reissue, err := core.NeedsReissue(cert, []byte(ca), now)
+	if err != nil || !reissue {
+		return err
+	}
 
-	return nil
+	core.GetLogger(ctx).Infof("Reissuing service certificate for %s", s.DNSName)
+	return s.tlsSetup(ctx)
 }
 
 // withWraps applies the required wrappers to the handlers
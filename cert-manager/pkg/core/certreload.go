@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package core
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// certReloader serves the certificate in certFile/keyFile and reloads it
+// when the files change, so that a reissued certificate is picked up
+// without restarting the service
+type certReloader struct {
+	certFile, keyFile string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// getCertificate implements tls.Config.GetCertificate. A pair that fails
+// to load, e.g. because the key is still being written, keeps the previous
+// certificate until the next handshake.
+func (r *certReloader) getCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	fi, err := os.Stat(r.certFile)
+	if err == nil && r.cert != nil && fi.ModTime().Equal(r.modTime) {
+		return r.cert, nil
+	}
+
+	cert, lerr := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if lerr != nil {
+		if r.cert != nil {
+			return r.cert, nil
+		}
+		return nil, lerr
+	}
+	r.cert = &cert
+	if err == nil {
+		r.modTime = fi.ModTime()
+	}
+	return r.cert, nil
+}
+
+// NeedsReissue reports whether the first certificate in certPEM must be
+// reissued: it was not signed by the signing CA, the first one in
+// caBundlePEM, e.g. after a CA rotation, or less than a third of its
+// lifetime is left at now.
+func NeedsReissue(certPEM, caBundlePEM []byte, now time.Time) (bool, error) {
+	cert, err := parseFirstCertificate(certPEM)
+	if err != nil {
+		return false, fmt.Errorf("certificate: %w", err)
+	}
+	ca, err := parseFirstCertificate(caBundlePEM)
+	if err != nil {
+		return false, fmt.Errorf("CA bundle: %w", err)
+	}
+
+	if cert.CheckSignatureFrom(ca) != nil {
+		return true, nil
+	}
+
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return cert.NotAfter.Sub(now) < lifetime/3, nil
+}
+
+func parseFirstCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
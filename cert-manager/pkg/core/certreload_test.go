@@ -0,0 +1,126 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCert returns a PEM certificate and key, signed by parent or self-signed
+func testCert(t *testing.T, name string, isCA bool, notBefore, notAfter time.Time, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) ([]byte, []byte, *x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		DNSNames:              []string{name},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert, key
+}
+
+func TestNeedsReissue(t *testing.T) {
+	now := time.Now()
+	oldCAPEM, _, oldCA, oldCAKey := testCert(t, "old-ca", true, now.Add(-time.Hour), now.Add(24*time.Hour), nil, nil)
+	newCAPEM, _, _, _ := testCert(t, "new-ca", true, now.Add(-time.Hour), now.Add(24*time.Hour), nil, nil)
+	leaf, _, _, _ := testCert(t, "svc.local", false, now.Add(-time.Hour), now.Add(5*time.Hour), oldCA, oldCAKey)
+
+	tests := []struct {
+		name     string
+		bundle   []byte
+		at       time.Time
+		expected bool
+	}{
+		{"signed by signing CA", append(append([]byte{}, oldCAPEM...), newCAPEM...), now, false},
+		{"next CA signs", append(append([]byte{}, newCAPEM...), oldCAPEM...), now, true},
+		{"near expiry", oldCAPEM, now.Add(4 * time.Hour), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reissue, err := NeedsReissue(leaf, tt.bundle, tt.at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, reissue)
+		})
+	}
+
+	_, err := NeedsReissue([]byte("garbage"), oldCAPEM, now)
+	assert.Error(t, err)
+}
+
+func TestCertReloader(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	now := time.Now()
+
+	firstCert, firstKey, first, _ := testCert(t, "first.local", false, now.Add(-time.Hour), now.Add(time.Hour), nil, nil)
+	require.NoError(t, os.WriteFile(keyFile, firstKey, 0600))
+	require.NoError(t, os.WriteFile(certFile, firstCert, 0600))
+
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	cert, err := r.getCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, first.Raw, cert.Certificate[0])
+
+	// a half written pair keeps the previous certificate
+	secondCert, secondKey, second, _ := testCert(t, "second.local", false, now.Add(-time.Hour), now.Add(time.Hour), nil, nil)
+	require.NoError(t, os.WriteFile(certFile, secondCert, 0600))
+	require.NoError(t, os.Chtimes(certFile, now, now.Add(time.Minute)))
+	cert, err = r.getCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, first.Raw, cert.Certificate[0])
+
+	// the complete pair is picked up
+	require.NoError(t, os.WriteFile(keyFile, secondKey, 0600))
+	cert, err = r.getCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, second.Raw, cert.Certificate[0])
+}
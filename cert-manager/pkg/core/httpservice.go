@@ -19,6 +19,7 @@ package core
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -82,7 +83,8 @@ type HTTPService struct {
 	ShutDownGracePeriod time.Duration
 
 	// CertFile, KeyFile are used if TLS is required.
-	// They point to the corresponding files.
+	// They point to the corresponding files, which are reloaded when
+	// they change.
 	CertFile, KeyFile string
 
 	// for internal use
@@ -173,9 +175,15 @@ func (s *HTTPService) Start(ctx context.Context) (net.Listener, error) {
 	}
 
 	if s.isTLS {
+		reloader := &certReloader{certFile: s.CertFile, keyFile: s.KeyFile}
+		if _, err := reloader.getCertificate(nil); err != nil {
+			listener.Close()
+			return nil, err
+		}
+		server.TLSConfig = &tls.Config{GetCertificate: reloader.getCertificate}
 		go func() {
 			log.Infof("Serving HTTPS at: %v", listener.Addr())
-			if err := server.ServeTLS(listener, s.CertFile, s.KeyFile); err != nil {
+			if err := server.ServeTLS(listener, "", ""); err != nil {
 				log.Error(err)
 			}
 		}()
@@ -0,0 +1,221 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
+)
+
+// Inventory tracks the certificates issued by the CA so that they can be
+// listed and revoked. Every change is written through to the store; expired
+// certificates are dropped since they no longer need to appear in the CRL.
+type Inventory struct {
+	mu    sync.Mutex
+	store CertificateStore
+	certs map[string]*types.IssuedCertificate
+	now   func() time.Time
+}
+
+// NewInventory loads the inventory from the store. A nil store keeps the
+// inventory in memory only.
+func NewInventory(ctx context.Context, store CertificateStore) (*Inventory, error) {
+	if store == nil {
+		store = &memoryCertificateStore{}
+	}
+
+	records, err := store.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate inventory: %w", err)
+	}
+
+	inv := &Inventory{
+		store: store,
+		certs: make(map[string]*types.IssuedCertificate, len(records)),
+		now:   time.Now,
+	}
+	for i := range records {
+		inv.certs[records[i].SerialNumber] = &records[i]
+	}
+
+	return inv, nil
+}
+
+// Record adds a newly issued certificate to the inventory
+func (inv *Inventory) Record(ctx context.Context, cert *x509.Certificate) error {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	record := &types.IssuedCertificate{
+		SerialNumber: serialString(cert.SerialNumber),
+		CommonName:   cert.Subject.CommonName,
+		DNSNames:     cert.DNSNames,
+		NotBefore:    cert.NotBefore,
+		NotAfter:     cert.NotAfter,
+
+		AuthorityKeyID: hex.EncodeToString(cert.AuthorityKeyId),
+	}
+	inv.certs[record.SerialNumber] = record
+
+	return inv.saveLocked(ctx)
+}
+
+// List returns the certificates matching the filter, ordered by expiry
+func (inv *Inventory) List(filter *types.CertificateFilter) []types.IssuedCertificate {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	if filter == nil {
+		filter = &types.CertificateFilter{}
+	}
+
+	now := inv.now()
+	result := make([]types.IssuedCertificate, 0, len(inv.certs))
+	for _, c := range inv.certs {
+		if filter.CommonName != "" && c.CommonName != filter.CommonName {
+			continue
+		}
+		if !filter.IncludeExpired && now.After(c.NotAfter) {
+			continue
+		}
+		result = append(result, *c)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].NotAfter.Before(result[j].NotAfter)
+	})
+
+	return result
+}
+
+// Revoke marks the certificates with the given serial number or common name
+// as revoked and returns them. Certificates that are already revoked are
+// returned unchanged so that revocation is idempotent.
+func (inv *Inventory) Revoke(ctx context.Context, serialNumber, commonName, reason string) ([]types.IssuedCertificate, error) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	serialNumber = normalizeSerial(serialNumber)
+	now := inv.now()
+
+	var revoked []types.IssuedCertificate
+	changed := false
+	for _, c := range inv.certs {
+		if serialNumber != "" && c.SerialNumber != serialNumber {
+			continue
+		}
+		if commonName != "" && c.CommonName != commonName {
+			continue
+		}
+
+		if !c.IsRevoked() {
+			revokedAt := now
+			c.RevokedAt = &revokedAt
+			c.RevocationReason = reason
+			changed = true
+		}
+		revoked = append(revoked, *c)
+	}
+
+	if !changed {
+		return revoked, nil
+	}
+
+	return revoked, inv.saveLocked(ctx)
+}
+
+// RevocationEntries returns the CRL entries for revoked certificates that
+// have not expired yet
+func (inv *Inventory) RevocationEntries() []x509.RevocationListEntry {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+
+	now := inv.now()
+	var entries []x509.RevocationListEntry
+	for _, c := range inv.certs {
+		if !c.IsRevoked() || now.After(c.NotAfter) {
+			continue
+		}
+
+		serial, ok := new(big.Int).SetString(c.SerialNumber, 16)
+		if !ok {
+			continue
+		}
+
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: *c.RevokedAt,
+		})
+	}
+
+	return entries
+}
+
+// saveLocked drops expired certificates and writes the inventory to the store
+func (inv *Inventory) saveLocked(ctx context.Context) error {
+	now := inv.now()
+	records := make([]types.IssuedCertificate, 0, len(inv.certs))
+	for serial, c := range inv.certs {
+		if now.After(c.NotAfter) {
+			delete(inv.certs, serial)
+			continue
+		}
+		records = append(records, *c)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].SerialNumber < records[j].SerialNumber
+	})
+
+	if err := inv.store.Save(ctx, records); err != nil {
+		return fmt.Errorf("failed to save certificate inventory: %w", err)
+	}
+
+	return nil
+}
+
+// serialString formats a serial number the way it is stored in the inventory
+func serialString(serial *big.Int) string {
+	return serial.Text(16)
+}
+
+// normalizeSerial accepts serial numbers in hex with optional colons, a 0x
+// prefix, leading zeros or upper case, as printed by common tools
+func normalizeSerial(serial string) string {
+	if serial == "" {
+		return ""
+	}
+
+	s := strings.ToLower(strings.ReplaceAll(serial, ":", ""))
+	s = strings.TrimPrefix(s, "0x")
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		return s
+	}
+
+	return serialString(n)
+}
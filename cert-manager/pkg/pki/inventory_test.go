@@ -0,0 +1,238 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
+)
+
+func newTestIssuer(t *testing.T, store CertificateStore) types.CertificateIssuer {
+	t.Helper()
+
+	certPath, keyPath, _ := createTestCA(t)
+	issuer, err := NewNativeCertificateIssuer(NativeCertificateIssuerOptions{
+		BaseDNS:    "test.local",
+		CACertFile: certPath,
+		CAKeyFile:  keyPath,
+		Store:      store,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+	return issuer
+}
+
+func parseCRL(t *testing.T, crlPEM string) *x509.RevocationList {
+	t.Helper()
+
+	block, _ := pem.Decode([]byte(crlPEM))
+	if block == nil || block.Type != "X509 CRL" {
+		t.Fatalf("Failed to decode CRL PEM")
+	}
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse CRL: %v", err)
+	}
+	return crl
+}
+
+func TestNativeCertificateIssuer_RevokeCertificates(t *testing.T) {
+	ctx := context.Background()
+	issuer := newTestIssuer(t, nil)
+
+	if _, _, err := issuer.NewCertificate(ctx, &types.CertificateRequest{Name: "client", App: "site-1"}); err != nil {
+		t.Fatalf("NewCertificate failed: %v", err)
+	}
+	if _, _, err := issuer.NewCertificate(ctx, &types.CertificateRequest{Name: "client", App: "site-2"}); err != nil {
+		t.Fatalf("NewCertificate failed: %v", err)
+	}
+
+	certs, err := issuer.ListCertificates(ctx, nil)
+	if err != nil {
+		t.Fatalf("ListCertificates failed: %v", err)
+	}
+	if len(certs) != 2 {
+		t.Fatalf("Expected 2 certificates, got %d", len(certs))
+	}
+
+	revoked, err := issuer.RevokeCertificates(ctx, &types.RevokeRequest{Name: "client", App: "site-1", Reason: "site deleted"})
+	if err != nil {
+		t.Fatalf("RevokeCertificates failed: %v", err)
+	}
+	if len(revoked) != 1 || revoked[0].CommonName != "site-1.client.test.local" || !revoked[0].IsRevoked() {
+		t.Fatalf("Unexpected revoked certificates: %+v", revoked)
+	}
+
+	crlPEM, err := issuer.GetCRL(ctx)
+	if err != nil {
+		t.Fatalf("GetCRL failed: %v", err)
+	}
+	crl := parseCRL(t, crlPEM)
+	if len(crl.RevokedCertificateEntries) != 1 {
+		t.Fatalf("Expected 1 CRL entry, got %d", len(crl.RevokedCertificateEntries))
+	}
+	if got := serialString(crl.RevokedCertificateEntries[0].SerialNumber); got != revoked[0].SerialNumber {
+		t.Errorf("CRL entry serial %s, expected %s", got, revoked[0].SerialNumber)
+	}
+
+	// Revoking again is a no-op
+	again, err := issuer.RevokeCertificates(ctx, &types.RevokeRequest{SerialNumber: revoked[0].SerialNumber})
+	if err != nil {
+		t.Fatalf("RevokeCertificates failed: %v", err)
+	}
+	if len(again) != 1 || !again[0].RevokedAt.Equal(*revoked[0].RevokedAt) {
+		t.Errorf("Expected unchanged revocation, got %+v", again)
+	}
+
+	none, err := issuer.RevokeCertificates(ctx, &types.RevokeRequest{CommonName: "unknown.test.local"})
+	if err != nil {
+		t.Fatalf("RevokeCertificates failed: %v", err)
+	}
+	if len(none) != 0 {
+		t.Errorf("Expected no revoked certificates, got %d", len(none))
+	}
+
+	if _, err := issuer.RevokeCertificates(ctx, &types.RevokeRequest{}); err == nil {
+		t.Error("Expected error for empty revoke request")
+	}
+}
+
+func TestNativeCertificateIssuer_InventoryPersisted(t *testing.T) {
+	ctx := context.Background()
+	store := &FileCertificateStore{Path: filepath.Join(t.TempDir(), "issued.json")}
+
+	issuer := newTestIssuer(t, store)
+	certPEM, _, err := issuer.RawCertificate(ctx, "node.test.local", 1)
+	if err != nil {
+		t.Fatalf("RawCertificate failed: %v", err)
+	}
+	block, _ := pem.Decode([]byte(certPEM))
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatalf("Failed to parse certificate: %v", err)
+	}
+
+	// Serial numbers are accepted the way common tools print them
+	serial := "0x" + strings.ToUpper(cert.SerialNumber.Text(16))
+	if _, err := issuer.RevokeCertificates(ctx, &types.RevokeRequest{SerialNumber: serial}); err != nil {
+		t.Fatalf("RevokeCertificates failed: %v", err)
+	}
+
+	// A restarted issuer sees the revocation
+	restarted := newTestIssuer(t, store)
+	certs, err := restarted.ListCertificates(ctx, &types.CertificateFilter{CommonName: "node.test.local"})
+	if err != nil {
+		t.Fatalf("ListCertificates failed: %v", err)
+	}
+	if len(certs) != 1 || !certs[0].IsRevoked() {
+		t.Fatalf("Expected revoked certificate after restart, got %+v", certs)
+	}
+
+	crlPEM, err := restarted.GetCRL(ctx)
+	if err != nil {
+		t.Fatalf("GetCRL failed: %v", err)
+	}
+	if crl := parseCRL(t, crlPEM); len(crl.RevokedCertificateEntries) != 1 {
+		t.Errorf("Expected 1 CRL entry after restart, got %d", len(crl.RevokedCertificateEntries))
+	}
+}
+
+func TestInventory_PrunesExpired(t *testing.T) {
+	ctx := context.Background()
+	inv, err := NewInventory(ctx, nil)
+	if err != nil {
+		t.Fatalf("NewInventory failed: %v", err)
+	}
+
+	now := time.Now()
+	inv.now = func() time.Time { return now }
+
+	ca, err := NewTestCA(CAOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create test CA: %v", err)
+	}
+	cert, _, _, err := ca.issue("short.test.local", 1)
+	if err != nil {
+		t.Fatalf("issue failed: %v", err)
+	}
+	if err := inv.Record(ctx, cert); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if _, err := inv.Revoke(ctx, "", "short.test.local", ""); err != nil {
+		t.Fatalf("Revoke failed: %v", err)
+	}
+	if len(inv.RevocationEntries()) != 1 {
+		t.Fatalf("Expected 1 revocation entry")
+	}
+
+	inv.now = func() time.Time { return now.Add(2 * time.Hour) }
+	if len(inv.RevocationEntries()) != 0 {
+		t.Errorf("Expected expired certificate to be left out of the CRL")
+	}
+	if len(inv.List(&types.CertificateFilter{IncludeExpired: true})) != 1 {
+		t.Errorf("Expected expired certificate to be listed")
+	}
+	if len(inv.List(nil)) != 0 {
+		t.Errorf("Expected expired certificate to be filtered")
+	}
+}
+
+func TestSecretCertificateStore(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	store := &SecretCertificateStore{Client: client, Namespace: "carbide", Name: "cert-inventory"}
+
+	certs, err := store.Load(ctx)
+	if err != nil || len(certs) != 0 {
+		t.Fatalf("Expected empty inventory, got %v, %v", certs, err)
+	}
+
+	record := types.IssuedCertificate{SerialNumber: "abc", CommonName: "a.test.local", NotAfter: time.Now().Add(time.Hour)}
+	for i := 0; i < 2; i++ {
+		if err := store.Save(ctx, []types.IssuedCertificate{record}); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	secret, err := client.CoreV1().Secrets("carbide").Get(ctx, "cert-inventory", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get secret: %v", err)
+	}
+	if len(secret.Data[inventorySecretKey]) == 0 {
+		t.Fatalf("Expected inventory in secret")
+	}
+
+	certs, err = store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(certs) != 1 || certs[0].SerialNumber != "abc" {
+		t.Errorf("Unexpected inventory %+v", certs)
+	}
+}
@@ -19,15 +19,23 @@ package pki
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
 )
 
 // NativeCertificateIssuer implements types.CertificateIssuer using native Go crypto
 type NativeCertificateIssuer struct {
-	ca      *CA
-	baseDNS string
+	ca        *CA
+	rotation  *caRotation
+	baseDNS   string
+	inventory *Inventory
+	maxTTL    time.Duration
+	now       func() time.Time
 }
 
 // NativeCertificateIssuerOptions defines options for the native issuer
@@ -40,6 +48,26 @@ type NativeCertificateIssuerOptions struct {
 	CAKeyFile      string
 	AltCACertFile  string
 	AltCAKeyFile   string
+	// Store persists the inventory of issued certificates. When nil the
+	// inventory is kept in memory and lost on restart.
+	Store CertificateStore
+
+	// NextCACertFile and NextCAKeyFile hold the CA to rotate to. Rotation
+	// is disabled when they are unset or the files do not exist.
+	NextCACertFile string
+	NextCAKeyFile  string
+	// CARotationStart is when the rotation starts, defaults to the NotBefore
+	// of the next CA
+	CARotationStart time.Time
+	// CARotationPropagation is how long the bundle with both CAs is
+	// published before the next CA starts signing
+	CARotationPropagation time.Duration
+	// CARotationOverlap is how long after the start the current CA stays
+	// trusted, it must cover the propagation plus MaxCertificateTTL
+	CARotationOverlap time.Duration
+	// MaxCertificateTTL caps the TTL clients may request, defaults to
+	// DefaultCertificateTTL
+	MaxCertificateTTL time.Duration
 }
 
 // NewNativeCertificateIssuer creates a new native Go certificate issuer.
@@ -53,10 +81,7 @@ func NewNativeCertificateIssuer(opts NativeCertificateIssuerOptions) (types.Cert
 		ca, err = LoadCA(opts.CACertFile, opts.CAKeyFile)
 		if err == nil {
 			fmt.Printf("Loaded CA from primary path: %s\n", opts.CACertFile)
-			return &NativeCertificateIssuer{
-				ca:      ca,
-				baseDNS: opts.BaseDNS,
-			}, nil
+			return newNativeCertificateIssuer(ca, opts)
 		}
 		loadErr = fmt.Errorf("primary path (%s): %w", opts.CACertFile, err)
 	}
@@ -66,10 +91,7 @@ func NewNativeCertificateIssuer(opts NativeCertificateIssuerOptions) (types.Cert
 		ca, err = LoadCA(opts.AltCACertFile, opts.AltCAKeyFile)
 		if err == nil {
 			fmt.Printf("Loaded CA from alternate path: %s\n", opts.AltCACertFile)
-			return &NativeCertificateIssuer{
-				ca:      ca,
-				baseDNS: opts.BaseDNS,
-			}, nil
+			return newNativeCertificateIssuer(ca, opts)
 		}
 		if loadErr != nil {
 			loadErr = fmt.Errorf("%w; alternate path (%s): %w", loadErr, opts.AltCACertFile, err)
@@ -85,27 +107,191 @@ func NewNativeCertificateIssuer(opts NativeCertificateIssuerOptions) (types.Cert
 	return nil, fmt.Errorf("CA certificate required: no paths configured")
 }
 
-// NewCertificate implements types.CertificateIssuer
+// newNativeCertificateIssuer sets up CA rotation, loads the inventory and
+// publishes CRLs that include the certificates revoked before the last restart
+func newNativeCertificateIssuer(ca *CA, opts NativeCertificateIssuerOptions) (types.CertificateIssuer, error) {
+	if opts.MaxCertificateTTL == 0 {
+		opts.MaxCertificateTTL = DefaultCertificateTTL
+	}
+	if opts.MaxCertificateTTL < time.Hour {
+		return nil, fmt.Errorf("maximum certificate TTL (%v) must be at least an hour", opts.MaxCertificateTTL)
+	}
+
+	rotation, err := loadCARotation(ca, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := NewInventory(context.Background(), opts.Store)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &NativeCertificateIssuer{
+		ca:        ca,
+		rotation:  rotation,
+		baseDNS:   opts.BaseDNS,
+		inventory: inventory,
+		maxTTL:    opts.MaxCertificateTTL,
+		now:       time.Now,
+	}
+
+	if rotation != nil {
+		fmt.Printf("%s\n", rotation.describe(i.now()))
+	}
+
+	if len(inventory.RevocationEntries()) > 0 {
+		if err := i.updateCRLs(true); err != nil {
+			return nil, err
+		}
+	}
+
+	return i, nil
+}
+
+// loadCARotation loads the next CA, if any, and schedules the rotation to it
+func loadCARotation(ca *CA, opts NativeCertificateIssuerOptions) (*caRotation, error) {
+	if opts.NextCACertFile == "" || opts.NextCAKeyFile == "" {
+		return nil, nil
+	}
+
+	// The next CA is typically mounted from an optional secret that only
+	// exists while a rotation is in progress
+	if _, err := os.Stat(opts.NextCACertFile); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	next, err := LoadCA(opts.NextCACertFile, opts.NextCAKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load next CA (%s): %w", opts.NextCACertFile, err)
+	}
+	fmt.Printf("Loaded next CA from path: %s\n", opts.NextCACertFile)
+
+	propagation := opts.CARotationPropagation
+	if propagation == 0 {
+		propagation = DefaultCARotationPropagation
+	}
+	overlap := opts.CARotationOverlap
+	if overlap == 0 {
+		overlap = DefaultCARotationOverlap
+	}
+
+	return newCARotation(ca, next, opts.CARotationStart, propagation, overlap, opts.MaxCertificateTTL)
+}
+
+// trustedCAs returns the CAs to publish, the signing CA first
+func (i *NativeCertificateIssuer) trustedCAs() []*CA {
+	if i.rotation == nil {
+		return []*CA{i.ca}
+	}
+	return i.rotation.trusted(i.now())
+}
+
+// signer returns the CA that signs new certificates
+func (i *NativeCertificateIssuer) signer() *CA {
+	return i.trustedCAs()[0]
+}
+
+// updateCRLs regenerates the CRL of every trusted CA, or only the stale ones
+// unless forced. Serial numbers are random, so every CRL lists all revoked
+// certificates regardless of which CA issued them.
+func (i *NativeCertificateIssuer) updateCRLs(force bool) error {
+	now := i.now()
+	entries := i.inventory.RevocationEntries()
+	for _, ca := range i.trustedCAs() {
+		if !force && !ca.crl.stale(now) {
+			continue
+		}
+		if err := ca.updateCRL(entries...); err != nil {
+			return fmt.Errorf("failed to update CRL: %w", err)
+		}
+	}
+	return nil
+}
+
+// NewCertificate implements types.CertificateIssuer. The TTL is capped at
+// the maximum certificate TTL, which the CA rotation overlap is validated
+// against, so that no certificate outlives the CA that issued it.
 func (i *NativeCertificateIssuer) NewCertificate(ctx context.Context, req *types.CertificateRequest) (string, string, error) {
 	sans := req.UniqueName(i.baseDNS)
 	ttl := req.TTL
-	if ttl == 0 {
-		ttl = 24 * 90 // 90 days default
+	if ttl <= 0 {
+		ttl = int(DefaultCertificateTTL / time.Hour)
 	}
-	return i.ca.IssueCertificate(sans, ttl)
+	ttl = min(ttl, int(i.maxTTL/time.Hour))
+	return i.issue(ctx, sans, ttl)
 }
 
-// RawCertificate implements types.CertificateIssuer
+// RawCertificate implements types.CertificateIssuer. The TTL is not capped,
+// it is only used for the service certificate of the cert-manager itself,
+// which is reissued once the next CA starts signing.
 func (i *NativeCertificateIssuer) RawCertificate(ctx context.Context, sans string, ttl int) (string, string, error) {
-	return i.ca.IssueCertificate(sans, ttl)
+	return i.issue(ctx, sans, ttl)
 }
 
-// GetCACertificate implements types.CertificateIssuer
+// issue issues a certificate and records it in the inventory. A certificate
+// that cannot be recorded could never be revoked, so it is not handed out.
+func (i *NativeCertificateIssuer) issue(ctx context.Context, sans string, ttl int) (string, string, error) {
+	cert, certPEM, keyPEM, err := i.signer().issue(sans, ttl)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := i.inventory.Record(ctx, cert); err != nil {
+		return "", "", err
+	}
+
+	return certPEM, keyPEM, nil
+}
+
+// GetCACertificate implements types.CertificateIssuer. During a CA rotation
+// this is a bundle of both CAs, the signing CA first.
 func (i *NativeCertificateIssuer) GetCACertificate(ctx context.Context) (string, error) {
-	return i.ca.GetCACertificatePEM(), nil
+	return caBundle(i.trustedCAs()), nil
 }
 
-// GetCRL implements types.CertificateIssuer
+// GetCRL implements types.CertificateIssuer. During a CA rotation this holds
+// the CRL of each trusted CA.
 func (i *NativeCertificateIssuer) GetCRL(ctx context.Context) (string, error) {
-	return i.ca.GetCRL(), nil
+	if err := i.updateCRLs(false); err != nil {
+		return "", err
+	}
+
+	var crls []string
+	for _, ca := range i.trustedCAs() {
+		if crl := ca.GetCRL(); crl != "" {
+			crls = append(crls, ensureNewline(crl))
+		}
+	}
+	return strings.Join(crls, ""), nil
+}
+
+// ListCertificates implements types.CertificateIssuer
+func (i *NativeCertificateIssuer) ListCertificates(ctx context.Context, filter *types.CertificateFilter) ([]types.IssuedCertificate, error) {
+	return i.inventory.List(filter), nil
+}
+
+// RevokeCertificates implements types.CertificateIssuer
+func (i *NativeCertificateIssuer) RevokeCertificates(ctx context.Context, req *types.RevokeRequest) ([]types.IssuedCertificate, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	commonName := req.CommonName
+	if req.Name != "" || req.App != "" {
+		commonName = (&types.CertificateRequest{Name: req.Name, App: req.App}).UniqueName(i.baseDNS)
+	}
+
+	revoked, err := i.inventory.Revoke(ctx, req.SerialNumber, commonName, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(revoked) > 0 {
+		if err := i.updateCRLs(true); err != nil {
+			return nil, err
+		}
+	}
+
+	return revoked, nil
 }
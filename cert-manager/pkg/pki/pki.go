@@ -38,6 +38,11 @@ const (
 	RSAKeySize = 2048
 	// DefaultCATTL is the default TTL for CA certificates (10 years)
 	DefaultCATTL = 10 * 365 * 24 * time.Hour
+	// CRLValidity is how long a generated CRL is valid for
+	CRLValidity = 24 * time.Hour
+	// crlRefreshAfter is the age after which the CRL is regenerated so that
+	// clients never see one past its NextUpdate
+	crlRefreshAfter = CRLValidity / 2
 )
 
 // CA represents a Certificate Authority
@@ -56,6 +61,13 @@ type CRL struct {
 	mu      sync.RWMutex
 }
 
+// stale returns true if the CRL should be regenerated
+func (c *CRL) stale(now time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.list == nil || now.Sub(c.list.ThisUpdate) > crlRefreshAfter
+}
+
 // GetCACertificatePEM returns the CA certificate in PEM format
 func (ca *CA) GetCACertificatePEM() string {
 	ca.mu.RLock()
@@ -72,19 +84,26 @@ func (ca *CA) GetCRL() string {
 
 // IssueCertificate issues a new certificate signed by this CA
 func (ca *CA) IssueCertificate(commonName string, ttlHours int) (certPEM, keyPEM string, err error) {
+	_, certPEM, keyPEM, err = ca.issue(commonName, ttlHours)
+	return certPEM, keyPEM, err
+}
+
+// issue issues a new certificate and also returns it parsed so that callers
+// can record it
+func (ca *CA) issue(commonName string, ttlHours int) (*x509.Certificate, string, string, error) {
 	ca.mu.RLock()
 	defer ca.mu.RUnlock()
 
 	// Generate private key for the certificate
 	key, err := rsa.GenerateKey(rand.Reader, RSAKeySize)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to generate key: %w", err)
+		return nil, "", "", fmt.Errorf("failed to generate key: %w", err)
 	}
 
 	// Generate serial number
 	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
 	if err != nil {
-		return "", "", fmt.Errorf("failed to generate serial number: %w", err)
+		return nil, "", "", fmt.Errorf("failed to generate serial number: %w", err)
 	}
 
 	now := time.Now()
@@ -107,7 +126,12 @@ func (ca *CA) IssueCertificate(commonName string, ttlHours int) (certPEM, keyPEM
 	// Sign the certificate with CA
 	certDER, err := x509.CreateCertificate(rand.Reader, template, ca.cert, key.Public(), ca.key)
 	if err != nil {
-		return "", "", fmt.Errorf("failed to create certificate: %w", err)
+		return nil, "", "", fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("failed to parse certificate: %w", err)
 	}
 
 	// Encode certificate to PEM
@@ -122,19 +146,23 @@ func (ca *CA) IssueCertificate(commonName string, ttlHours int) (certPEM, keyPEM
 		Bytes: x509.MarshalPKCS1PrivateKey(key),
 	})
 
-	return string(certPEMBytes), string(keyPEMBytes), nil
+	return cert, string(certPEMBytes), string(keyPEMBytes), nil
 }
 
-// updateCRL updates the Certificate Revocation List
-func (ca *CA) updateCRL() error {
+// updateCRL regenerates the Certificate Revocation List with the given
+// revoked certificates
+func (ca *CA) updateCRL(revoked ...x509.RevocationListEntry) error {
 	ca.crl.mu.Lock()
 	defer ca.crl.mu.Unlock()
 
 	now := time.Now()
 	template := &x509.RevocationList{
-		Number:     big.NewInt(1),
-		ThisUpdate: now,
-		NextUpdate: now.Add(24 * time.Hour),
+		// The CRL number must increase with every CRL issued, including
+		// across restarts, so it is derived from the clock.
+		Number:                    big.NewInt(now.UnixNano()),
+		ThisUpdate:                now,
+		NextUpdate:                now.Add(CRLValidity),
+		RevokedCertificateEntries: revoked,
 	}
 
 	crlDER, err := x509.CreateRevocationList(rand.Reader, template, ca.cert, ca.key)
@@ -147,6 +175,7 @@ func (ca *CA) updateCRL() error {
 		Bytes: crlDER,
 	})
 
+	ca.crl.list = template
 	ca.crl.listPEM = string(crlPEM)
 	return nil
 }
@@ -215,6 +244,12 @@ func LoadCAFromPEM(certPEM, keyPEM []byte) (*CA, error) {
 		return nil, fmt.Errorf("failed to parse CA private key: %w", err)
 	}
 
+	// A mismatched pair would sign certificates nothing can verify, which
+	// is easy to end up with when staging a new CA for rotation
+	if pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool }); !ok || !pub.Equal(cert.PublicKey) {
+		return nil, fmt.Errorf("CA private key does not match certificate")
+	}
+
 	ca := &CA{
 		cert:    cert,
 		key:     key,
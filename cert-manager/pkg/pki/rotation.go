@@ -0,0 +1,124 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pki
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultCertificateTTL is the TTL of certificates issued without one,
+	// and the default maximum TTL a client may request
+	DefaultCertificateTTL = 90 * 24 * time.Hour
+	// DefaultCARotationPropagation is how long the CA bundle is published
+	// before the new CA starts signing
+	DefaultCARotationPropagation = time.Hour
+	// DefaultCARotationOverlap is how long the old CA stays trusted after the
+	// rotation starts. A certificate the old CA issued just before the next
+	// CA starts signing must keep verifying until it expires, so the overlap
+	// covers the propagation plus the maximum certificate TTL, with a day to
+	// spare for clock skew and renewal lag.
+	DefaultCARotationOverlap = DefaultCARotationPropagation + DefaultCertificateTTL + 24*time.Hour
+)
+
+// caRotation is a staged rotation from the current CA to the next one:
+// -- before start: only the current CA is trusted and signs.
+// -- from start: both CAs are published, the current CA still signs, so
+// that clients pick up the bundle before they see leaves of the next CA.
+// -- from signFrom: the next CA signs, both CAs stay trusted.
+// -- from retireAt: the current CA is retired, only the next CA is trusted.
+type caRotation struct {
+	current  *CA
+	next     *CA
+	start    time.Time
+	signFrom time.Time
+	retireAt time.Time
+}
+
+// newCARotation schedules a rotation from current to next. A zero start
+// begins the rotation at the NotBefore of the next CA. The overlap must
+// cover the propagation plus maxTTL, the longest TTL of the certificates
+// the current CA issues before the next CA starts signing.
+func newCARotation(current, next *CA, start time.Time, propagation, overlap, maxTTL time.Duration) (*caRotation, error) {
+	if start.IsZero() {
+		start = next.cert.NotBefore
+	}
+	if propagation < 0 {
+		return nil, fmt.Errorf("CA rotation propagation must not be negative")
+	}
+	if overlap < propagation+maxTTL {
+		return nil, fmt.Errorf("CA rotation overlap (%v) must cover propagation (%v) plus the maximum certificate TTL (%v)", overlap, propagation, maxTTL)
+	}
+	if next.cert.Equal(current.cert) {
+		return nil, fmt.Errorf("next CA is the same as the current CA")
+	}
+
+	return &caRotation{
+		current:  current,
+		next:     next,
+		start:    start,
+		signFrom: start.Add(propagation),
+		retireAt: start.Add(overlap),
+	}, nil
+}
+
+// trusted returns the CAs to publish at t, the signing CA first
+func (r *caRotation) trusted(t time.Time) []*CA {
+	switch {
+	case t.Before(r.start):
+		return []*CA{r.current}
+	case t.Before(r.signFrom):
+		return []*CA{r.current, r.next}
+	case t.Before(r.retireAt):
+		return []*CA{r.next, r.current}
+	default:
+		return []*CA{r.next}
+	}
+}
+
+// describe returns a short summary of the rotation stage at t for logging
+func (r *caRotation) describe(t time.Time) string {
+	switch {
+	case t.Before(r.start):
+		return fmt.Sprintf("CA rotation scheduled to start at %s", r.start.Format(time.RFC3339))
+	case t.Before(r.signFrom):
+		return fmt.Sprintf("CA rotation publishing bundle, next CA signs from %s", r.signFrom.Format(time.RFC3339))
+	case t.Before(r.retireAt):
+		return fmt.Sprintf("CA rotation signing with next CA, current CA retires at %s", r.retireAt.Format(time.RFC3339))
+	default:
+		return "CA rotation complete, current CA retired"
+	}
+}
+
+// caBundle concatenates the PEM certificates of the CAs
+func caBundle(cas []*CA) string {
+	var b strings.Builder
+	for _, ca := range cas {
+		b.WriteString(ensureNewline(ca.GetCACertificatePEM()))
+	}
+	return b.String()
+}
+
+func ensureNewline(s string) string {
+	if s == "" || strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
@@ -0,0 +1,220 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
+)
+
+// parseBundle returns the certificates in a PEM bundle
+func parseBundle(t *testing.T, bundle string) []*x509.Certificate {
+	t.Helper()
+
+	var certs []*x509.Certificate
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return certs
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatalf("Failed to parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func TestNativeCertificateIssuer_CARotation(t *testing.T) {
+	ctx := context.Background()
+	certPath, keyPath, _ := createTestCA(t)
+	nextCertPath, nextKeyPath, _ := createTestCA(t)
+
+	start := time.Now().Add(time.Hour)
+	iss, err := NewNativeCertificateIssuer(NativeCertificateIssuerOptions{
+		BaseDNS:               "test.local",
+		CACertFile:            certPath,
+		CAKeyFile:             keyPath,
+		NextCACertFile:        nextCertPath,
+		NextCAKeyFile:         nextKeyPath,
+		CARotationStart:       start,
+		CARotationPropagation: time.Hour,
+		CARotationOverlap:     48 * time.Hour,
+		MaxCertificateTTL:     24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+	issuer := iss.(*NativeCertificateIssuer)
+	current, next := issuer.ca.cert, issuer.rotation.next.cert
+
+	tests := []struct {
+		name    string
+		at      time.Time
+		trusted []*x509.Certificate
+	}{
+		{"before start", start.Add(-time.Minute), []*x509.Certificate{current}},
+		{"propagating", start.Add(time.Minute), []*x509.Certificate{current, next}},
+		{"signing with next", start.Add(2 * time.Hour), []*x509.Certificate{next, current}},
+		{"retired", start.Add(49 * time.Hour), []*x509.Certificate{next}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issuer.now = func() time.Time { return tt.at }
+
+			bundlePEM, err := issuer.GetCACertificate(ctx)
+			if err != nil {
+				t.Fatalf("GetCACertificate failed: %v", err)
+			}
+			bundle := parseBundle(t, bundlePEM)
+			if len(bundle) != len(tt.trusted) {
+				t.Fatalf("Expected %d CAs in bundle, got %d", len(tt.trusted), len(bundle))
+			}
+			for j := range bundle {
+				if !bundle[j].Equal(tt.trusted[j]) {
+					t.Errorf("Unexpected CA at position %d: %s", j, bundle[j].Subject)
+				}
+			}
+
+			certPEM, _, err := issuer.NewCertificate(ctx, &types.CertificateRequest{Name: "client", App: "site"})
+			if err != nil {
+				t.Fatalf("NewCertificate failed: %v", err)
+			}
+			leaf := parseBundle(t, certPEM)[0]
+			if err := leaf.CheckSignatureFrom(tt.trusted[0]); err != nil {
+				t.Errorf("Certificate not signed by the signing CA: %v", err)
+			}
+
+			crlPEM, err := issuer.GetCRL(ctx)
+			if err != nil {
+				t.Fatalf("GetCRL failed: %v", err)
+			}
+			rest, crls := []byte(crlPEM), 0
+			for {
+				var block *pem.Block
+				if block, rest = pem.Decode(rest); block == nil {
+					break
+				}
+				crls++
+			}
+			if crls != len(tt.trusted) {
+				t.Errorf("Expected %d CRLs, got %d", len(tt.trusted), crls)
+			}
+		})
+	}
+}
+
+func TestNativeCertificateIssuer_NoNextCA(t *testing.T) {
+	certPath, keyPath, _ := createTestCA(t)
+	dir := t.TempDir()
+
+	iss, err := NewNativeCertificateIssuer(NativeCertificateIssuerOptions{
+		CACertFile:     certPath,
+		CAKeyFile:      keyPath,
+		NextCACertFile: filepath.Join(dir, "tls.crt"),
+		NextCAKeyFile:  filepath.Join(dir, "tls.key"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+	if iss.(*NativeCertificateIssuer).rotation != nil {
+		t.Error("Expected no rotation without next CA files")
+	}
+}
+
+func TestNativeCertificateIssuer_InvalidRotation(t *testing.T) {
+	certPath, keyPath, _ := createTestCA(t)
+	nextCertPath, nextKeyPath, _ := createTestCA(t)
+
+	tests := []struct {
+		name                         string
+		nextCert, nextKey            string
+		propagation, overlap, maxTTL time.Duration
+	}{
+		{"same CA", certPath, keyPath, 0, 0, 0},
+		{"overlap shorter than propagation", nextCertPath, nextKeyPath, 2 * time.Hour, time.Hour, 0},
+		{"overlap shorter than certificate TTL", nextCertPath, nextKeyPath, time.Hour, 48 * time.Hour, 72 * time.Hour},
+		{"default overlap shorter than certificate TTL", nextCertPath, nextKeyPath, 0, 0, 180 * 24 * time.Hour},
+		{"certificate TTL under an hour", nextCertPath, nextKeyPath, 0, 0, time.Minute},
+		{"mismatched key", nextCertPath, keyPath, 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewNativeCertificateIssuer(NativeCertificateIssuerOptions{
+				CACertFile:            certPath,
+				CAKeyFile:             keyPath,
+				NextCACertFile:        tt.nextCert,
+				NextCAKeyFile:         tt.nextKey,
+				CARotationPropagation: tt.propagation,
+				CARotationOverlap:     tt.overlap,
+				MaxCertificateTTL:     tt.maxTTL,
+			})
+			if err == nil {
+				t.Error("Expected error")
+			}
+		})
+	}
+}
+
+func TestNativeCertificateIssuer_MaxCertificateTTL(t *testing.T) {
+	ctx := context.Background()
+	certPath, keyPath, _ := createTestCA(t)
+
+	iss, err := NewNativeCertificateIssuer(NativeCertificateIssuerOptions{
+		BaseDNS:           "test.local",
+		CACertFile:        certPath,
+		CAKeyFile:         keyPath,
+		MaxCertificateTTL: 30 * 24 * time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("Failed to create issuer: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		ttl      int
+		expected time.Duration
+	}{
+		{"requested", 24, 24 * time.Hour},
+		{"default capped", 0, 30 * 24 * time.Hour},
+		{"requested capped", 365 * 24, 30 * 24 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certPEM, _, err := iss.NewCertificate(ctx, &types.CertificateRequest{Name: "client", App: "site", TTL: tt.ttl})
+			if err != nil {
+				t.Fatalf("NewCertificate failed: %v", err)
+			}
+			leaf := parseBundle(t, certPEM)[0]
+			if ttl := leaf.NotAfter.Sub(leaf.NotBefore); ttl > tt.expected || ttl < tt.expected-time.Hour {
+				t.Errorf("Expected TTL %v, got %v", tt.expected, ttl)
+			}
+		})
+	}
+}
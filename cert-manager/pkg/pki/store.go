@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package pki
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/types"
+)
+
+// inventorySecretKey is the key holding the inventory in the Secret
+const inventorySecretKey = "issued.json"
+
+// CertificateStore persists the certificate inventory
+type CertificateStore interface {
+	Load(ctx context.Context) ([]types.IssuedCertificate, error)
+	Save(ctx context.Context, certs []types.IssuedCertificate) error
+}
+
+// memoryCertificateStore keeps the inventory for the lifetime of the process
+type memoryCertificateStore struct {
+	mu    sync.Mutex
+	certs []types.IssuedCertificate
+}
+
+// Load implements CertificateStore
+func (s *memoryCertificateStore) Load(_ context.Context) ([]types.IssuedCertificate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]types.IssuedCertificate(nil), s.certs...), nil
+}
+
+// Save implements CertificateStore
+func (s *memoryCertificateStore) Save(_ context.Context, certs []types.IssuedCertificate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.certs = append([]types.IssuedCertificate(nil), certs...)
+	return nil
+}
+
+// FileCertificateStore persists the inventory as a JSON file
+type FileCertificateStore struct {
+	Path string
+}
+
+// Load implements CertificateStore
+func (s *FileCertificateStore) Load(_ context.Context) ([]types.IssuedCertificate, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshalInventory(data)
+}
+
+// Save implements CertificateStore. The file is replaced atomically.
+func (s *FileCertificateStore) Save(_ context.Context, certs []types.IssuedCertificate) error {
+	data, err := json.Marshal(certs)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// SecretCertificateStore persists the inventory in a Kubernetes Secret
+type SecretCertificateStore struct {
+	Client    kubernetes.Interface
+	Namespace string
+	Name      string
+}
+
+// Load implements CertificateStore
+func (s *SecretCertificateStore) Load(ctx context.Context) ([]types.IssuedCertificate, error) {
+	secret, err := s.Client.CoreV1().Secrets(s.Namespace).Get(ctx, s.Name, metav1.GetOptions{})
+	if k8serr.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret %s/%s: %w", s.Namespace, s.Name, err)
+	}
+
+	return unmarshalInventory(secret.Data[inventorySecretKey])
+}
+
+// Save implements CertificateStore
+func (s *SecretCertificateStore) Save(ctx context.Context, certs []types.IssuedCertificate) error {
+	data, err := json.Marshal(certs)
+	if err != nil {
+		return err
+	}
+
+	secrets := s.Client.CoreV1().Secrets(s.Namespace)
+	secret, err := secrets.Get(ctx, s.Name, metav1.GetOptions{})
+	if k8serr.IsNotFound(err) {
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.Name,
+				Namespace: s.Namespace,
+				Labels:    map[string]string{"app": "carbide-rest-cert-manager"},
+			},
+			Data: map[string][]byte{inventorySecretKey: data},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get secret %s/%s: %w", s.Namespace, s.Name, err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[inventorySecretKey] = data
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+func unmarshalInventory(data []byte) ([]types.IssuedCertificate, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var certs []types.IssuedCertificate
+	if err := json.Unmarshal(data, &certs); err != nil {
+		return nil, fmt.Errorf("failed to parse certificate inventory: %w", err)
+	}
+
+	return certs, nil
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 )
 
 // CertificateIssuer defines a certificate issuer interface
@@ -30,6 +31,8 @@ type CertificateIssuer interface {
 	GetCACertificate(ctx context.Context) (string, error)
 	GetCRL(ctx context.Context) (string, error)
 	RawCertificate(ctx context.Context, sans string, ttl int) (string, string, error)
+	ListCertificates(ctx context.Context, filter *CertificateFilter) ([]IssuedCertificate, error)
+	RevokeCertificates(ctx context.Context, req *RevokeRequest) ([]IssuedCertificate, error)
 }
 
 // CertificateRequest defines a request
@@ -66,3 +69,73 @@ func (r *CertificateRequest) UniqueName(baseDNS string) string {
 	// add baseDNS
 	return fmt.Sprintf("%s.%s", sans, baseDNS)
 }
+
+// IssuedCertificate records a certificate signed by the CA
+type IssuedCertificate struct {
+	// SerialNumber is the certificate serial in lower-case hex
+	SerialNumber string    `json:"serialNumber"`
+	CommonName   string    `json:"commonName"`
+	DNSNames     []string  `json:"dnsNames,omitempty"`
+	NotBefore    time.Time `json:"notBefore"`
+	NotAfter     time.Time `json:"notAfter"`
+	// AuthorityKeyID identifies the CA that signed the certificate, in hex
+	AuthorityKeyID string `json:"authorityKeyId,omitempty"`
+
+	RevokedAt        *time.Time `json:"revokedAt,omitempty"`
+	RevocationReason string     `json:"revocationReason,omitempty"`
+}
+
+// IsRevoked returns true if the certificate has been revoked
+func (c *IssuedCertificate) IsRevoked() bool {
+	return c.RevokedAt != nil
+}
+
+// CertificateFilter narrows a certificate listing
+type CertificateFilter struct {
+	// CommonName matches certificates with this exact CN
+	CommonName string `json:"commonName,omitempty"`
+
+	// IncludeExpired includes certificates past their NotAfter
+	IncludeExpired bool `json:"includeExpired,omitempty"`
+}
+
+// RevokeRequest selects the certificates to revoke. Exactly one of
+// SerialNumber, CommonName or Name/App must be set; Name/App are resolved to a
+// common name the same way as CertificateRequest.
+type RevokeRequest struct {
+	SerialNumber string `json:"serialNumber,omitempty"`
+	CommonName   string `json:"commonName,omitempty"`
+	Name         string `json:"name,omitempty"`
+	App          string `json:"app,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// Validate checks that the request selects certificates in exactly one way
+func (r *RevokeRequest) Validate() error {
+	selectors := 0
+	if r.SerialNumber != "" {
+		selectors++
+	}
+	if r.CommonName != "" {
+		selectors++
+	}
+	if r.Name != "" || r.App != "" {
+		selectors++
+	}
+
+	if selectors != 1 {
+		return fmt.Errorf("exactly one of serialNumber, commonName or name/app is required")
+	}
+
+	return nil
+}
+
+// RevokeResponse lists the certificates that were revoked
+type RevokeResponse struct {
+	Revoked []IssuedCertificate `json:"revoked"`
+}
+
+// ListCertificatesResponse lists issued certificates
+type ListCertificatesResponse struct {
+	Certificates []IssuedCertificate `json:"certificates"`
+}
@@ -19,8 +19,11 @@ package main
 
 import (
 	"fmt"
+	"net/http"
 	"os"
+	"time"
 
+	"github.com/nvidia/bare-metal-manager-rest/cli/mockserver"
 	carbidecli "github.com/nvidia/bare-metal-manager-rest/cli/pkg"
 	"github.com/nvidia/bare-metal-manager-rest/cli/tui"
 	"github.com/nvidia/bare-metal-manager-rest/openapi"
@@ -41,6 +44,41 @@ func main() {
 			return tui.RunTUI(c.String("config"))
 		},
 	})
+	app.Commands = append(app.Commands, &cli.Command{
+		Name:  "mock-server",
+		Usage: "Serve the API from an in-memory store for SDK and CLI testing",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "listen",
+				Usage: "Address to listen on",
+				Value: "127.0.0.1:8388",
+			},
+			&cli.DurationFlag{
+				Name:  "ready-after",
+				Usage: "How long created objects stay Pending (or Syncing) before becoming Ready (or Synced)",
+				Value: 5 * time.Second,
+			},
+			&cli.StringFlag{
+				Name:  "seed",
+				Usage: "YAML or JSON file mapping collection paths to objects to preload",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			spec, err := carbidecli.ParseSpec(openapi.Spec)
+			if err != nil {
+				return err
+			}
+			srv := mockserver.New(spec)
+			srv.ReadyAfter = c.Duration("ready-after")
+			if seed := c.String("seed"); seed != "" {
+				if err := srv.LoadSeedFile(seed); err != nil {
+					return err
+				}
+			}
+			fmt.Fprintf(os.Stderr, "Mock API server listening on http://%s\n", c.String("listen"))
+			return http.ListenAndServe(c.String("listen"), srv)
+		},
+	})
 	if err := app.Run(os.Args); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
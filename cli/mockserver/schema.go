@@ -0,0 +1,175 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockserver
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	carbidecli "github.com/nvidia/bare-metal-manager-rest/cli/pkg"
+)
+
+// maxSampleDepth bounds recursion when generating sample objects from schemas.
+const maxSampleDepth = 6
+
+// validateValue checks a decoded JSON value against a schema and records
+// violations in errs keyed by field path. Null is accepted for any field;
+// presence is enforced only through "required".
+func validateValue(spec *carbidecli.Spec, schema *carbidecli.Schema, value interface{}, path string, errs map[string]string) {
+	schema = spec.ResolveSchema(schema)
+	if schema == nil || value == nil {
+		return
+	}
+	field := path
+	if field == "" {
+		field = "body"
+	}
+
+	switch schema.Type {
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			errs[field] = "must be an object"
+			return
+		}
+		for _, name := range schema.Required {
+			if _, ok := obj[name]; !ok {
+				errs[joinPath(path, name)] = "cannot be blank"
+			}
+		}
+		for name, prop := range schema.Properties {
+			if v, ok := obj[name]; ok {
+				validateValue(spec, prop, v, joinPath(path, name), errs)
+			}
+		}
+	case "array":
+		list, ok := value.([]interface{})
+		if !ok {
+			errs[field] = "must be an array"
+			return
+		}
+		for i, v := range list {
+			validateValue(spec, schema.Items, v, fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			errs[field] = "must be a string"
+			return
+		}
+		if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, s) {
+			errs[field] = "must be a valid value"
+			return
+		}
+		n := utf8.RuneCountInString(s)
+		if schema.MinLength != nil && n < *schema.MinLength || schema.MaxLength != nil && n > *schema.MaxLength {
+			errs[field] = "the length is not valid"
+			return
+		}
+		if schema.Format == "uuid" {
+			if _, err := uuid.Parse(s); err != nil {
+				errs[field] = "must be a valid UUID"
+			}
+		}
+	case "integer", "number":
+		f, ok := value.(float64)
+		if !ok {
+			errs[field] = "must be a number"
+			return
+		}
+		if schema.Type == "integer" && f != math.Trunc(f) {
+			errs[field] = "must be an integer"
+			return
+		}
+		if schema.Minimum != nil && f < float64(*schema.Minimum) {
+			errs[field] = fmt.Sprintf("must be no less than %d", *schema.Minimum)
+		}
+		if schema.Maximum != nil && f > float64(*schema.Maximum) {
+			errs[field] = fmt.Sprintf("must be no greater than %d", *schema.Maximum)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errs[field] = "must be a boolean"
+		}
+	}
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+// sampleValue builds a representative value for a schema, preferring the
+// schema's own examples.
+func sampleValue(spec *carbidecli.Spec, schema *carbidecli.Schema, now time.Time, depth int) interface{} {
+	schema = spec.ResolveSchema(schema)
+	if schema == nil || depth > maxSampleDepth {
+		return nil
+	}
+	if len(schema.Examples) > 0 {
+		return schema.Examples[0]
+	}
+	switch schema.Type {
+	case "object":
+		obj := make(map[string]interface{}, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			if v := sampleValue(spec, prop, now, depth+1); v != nil {
+				obj[name] = v
+			}
+		}
+		return obj
+	case "array":
+		return []interface{}{}
+	case "string":
+		switch {
+		case len(schema.Enum) > 0:
+			return schema.Enum[0]
+		case schema.Format == "date-time":
+			return now.Format(time.RFC3339Nano)
+		case schema.Format == "uuid":
+			return uuid.Nil.String()
+		}
+		return ""
+	case "integer", "number":
+		return 0
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// statusEnum returns the status values of a resource schema, or nil if the
+// resource has no enumerated status.
+func statusEnum(spec *carbidecli.Spec, schema *carbidecli.Schema) []string {
+	schema = spec.ResolveSchema(schema)
+	if schema == nil {
+		return nil
+	}
+	status := spec.ResolveSchema(schema.Properties["status"])
+	if status == nil {
+		return nil
+	}
+	return status.Enum
+}
@@ -0,0 +1,685 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package mockserver serves the paths of the embedded OpenAPI spec from an
+// in-memory store so that the SDKs and CLI can be exercised without a full
+// deployment.
+package mockserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v3"
+
+	carbidecli "github.com/nvidia/bare-metal-manager-rest/cli/pkg"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+
+	paginationHeader = "X-Pagination"
+	errorSource      = "forge"
+
+	statusPending = "Pending"
+	statusReady   = "Ready"
+)
+
+// statusTransitions lists the initial and final status a new object moves
+// through, for each pair of values a status enum may contain.
+var statusTransitions = [][2]string{
+	{statusPending, statusReady},
+	{"Syncing", "Synced"},
+}
+
+type routeKind int
+
+const (
+	// routeStatic answers with the example or a sample of the response schema.
+	routeStatic routeKind = iota
+	// routeCollection lists and creates objects.
+	routeCollection
+	// routeItem gets, updates and deletes a single object of a collection.
+	routeItem
+	// routeStatusHistory returns the statusHistory of the parent item.
+	routeStatusHistory
+)
+
+type route struct {
+	template string
+	segments []string
+	literals int
+	item     carbidecli.PathItem
+	kind     routeKind
+	// parentItem is set for collections nested under an item, e.g. allocation constraints.
+	parentItem bool
+}
+
+// record is a stored object, the status it moves to and when.
+type record struct {
+	obj     map[string]interface{}
+	target  string
+	readyAt time.Time
+}
+
+// Server is an http.Handler that serves every path in an OpenAPI spec.
+// Collections (a path with a sibling "/{id}" path) are stateful: objects are
+// validated against the request schema, assigned IDs and listed with
+// pagination. Objects with a Pending (or Syncing) status become Ready (or
+// Synced) after ReadyAfter.
+// All other paths return the response example from the spec.
+type Server struct {
+	// ReadyAfter is how long a newly created object stays in its initial status.
+	ReadyAfter time.Duration
+
+	spec   *carbidecli.Spec
+	routes []*route
+	now    func() time.Time
+
+	mu          sync.Mutex
+	collections map[string][]*record
+}
+
+// New returns a Server for the given spec.
+func New(spec *carbidecli.Spec) *Server {
+	s := &Server{
+		ReadyAfter:  5 * time.Second,
+		spec:        spec,
+		now:         time.Now,
+		collections: make(map[string][]*record),
+	}
+
+	templates := make(map[string]bool, len(spec.Paths))
+	for t := range spec.Paths {
+		templates[t] = true
+	}
+	isCollection := func(t string) bool {
+		for other := range templates {
+			if parent, last := splitLast(other); parent == t && isParam(last) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for t, item := range spec.Paths {
+		r := &route{template: t, segments: strings.Split(strings.Trim(t, "/"), "/"), item: item}
+		for _, seg := range r.segments {
+			if !isParam(seg) {
+				r.literals++
+			}
+		}
+		parent, last := splitLast(t)
+		switch {
+		case isCollection(t):
+			r.kind = routeCollection
+			grandparent, _ := splitLast(parent)
+			r.parentItem = isParam(lastSegment(parent)) && isCollection(grandparent)
+		case isParam(last) && isCollection(parent):
+			r.kind = routeItem
+		case last == "status-history" && isParam(lastSegment(parent)) && isCollection(parentPath(parent)):
+			r.kind = routeStatusHistory
+		}
+		s.routes = append(s.routes, r)
+	}
+	// Prefer literal segments over parameters, e.g. /instance/type over /instance/{instanceId}.
+	sort.Slice(s.routes, func(i, j int) bool {
+		if s.routes[i].literals != s.routes[j].literals {
+			return s.routes[i].literals > s.routes[j].literals
+		}
+		return s.routes[i].template < s.routes[j].template
+	})
+	return s
+}
+
+// Seed adds objects to a collection. The path uses "{org}" for the org segment,
+// e.g. "/v2/org/{org}/carbide/machine". Objects without an ID are assigned one.
+func (s *Server) Seed(collectionPath string, objs ...map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	for _, o := range objs {
+		if _, ok := o["id"]; !ok {
+			o["id"] = uuid.NewString()
+		}
+		s.collections[collectionPath] = append(s.collections[collectionPath], &record{obj: o, readyAt: now})
+	}
+}
+
+// LoadSeedFile seeds collections from a YAML or JSON file mapping collection
+// paths to lists of objects.
+func (s *Server) LoadSeedFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading seed file: %w", err)
+	}
+	var seed map[string][]map[string]interface{}
+	if err := yaml.Unmarshal(data, &seed); err != nil {
+		return fmt.Errorf("parsing seed file: %w", err)
+	}
+	for p, objs := range seed {
+		// Round-trip through JSON so values match decoded request bodies.
+		b, err := json.Marshal(objs)
+		if err != nil {
+			return fmt.Errorf("seed %s: %w", p, err)
+		}
+		var normalized []map[string]interface{}
+		if err := json.Unmarshal(b, &normalized); err != nil {
+			return fmt.Errorf("seed %s: %w", p, err)
+		}
+		s.Seed(p, normalized...)
+	}
+	return nil
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rt, params := s.match(r.URL.Path)
+	if rt == nil {
+		writeError(w, http.StatusNotFound, "The requested path was not found", nil)
+		return
+	}
+	op := operation(rt.item, r.Method)
+	if op == nil {
+		writeError(w, http.StatusMethodNotAllowed, "Method not allowed", nil)
+		return
+	}
+
+	var body map[string]interface{}
+	if schema := s.spec.RequestBodySchema(op); schema != nil {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "Failed to read request body", nil)
+			return
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			writeError(w, http.StatusBadRequest, "Request body is not valid JSON", nil)
+			return
+		}
+		errs := make(map[string]string)
+		validateValue(s.spec, schema, decoded, "", errs)
+		if len(errs) > 0 {
+			writeError(w, http.StatusBadRequest, "Error validating request data", errs)
+			return
+		}
+		body, _ = decoded.(map[string]interface{})
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Objects are shared across orgs; the org segment is normalized away.
+	key := normalizeOrg(r.URL.Path)
+	switch {
+	case rt.kind == routeCollection && r.Method == http.MethodGet:
+		s.list(w, r, key)
+	case rt.kind == routeCollection && r.Method == http.MethodPost:
+		if rt.parentItem {
+			parent, id := splitLast(parentPath(key))
+			if s.find(parent, id) == nil {
+				writeError(w, http.StatusNotFound, "Parent object not found", nil)
+				return
+			}
+		}
+		s.create(w, op, key, params, body)
+	case rt.kind == routeItem:
+		collection, id := splitLast(key)
+		rec := s.find(collection, id)
+		if rec == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Object with ID %s not found", id), nil)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, rec.obj)
+		case http.MethodPatch, http.MethodPut:
+			s.update(w, op, rec, body)
+		case http.MethodDelete:
+			s.remove(collection, id)
+			code, _ := op.SuccessResponse()
+			w.WriteHeader(code)
+		default:
+			s.static(w, op)
+		}
+	case rt.kind == routeStatusHistory && r.Method == http.MethodGet:
+		itemPath, _ := splitLast(key)
+		collection, id := splitLast(itemPath)
+		rec := s.find(collection, id)
+		if rec == nil {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("Object with ID %s not found", id), nil)
+			return
+		}
+		history, _ := rec.obj["statusHistory"].([]interface{})
+		if history == nil {
+			history = []interface{}{}
+		}
+		writeJSON(w, http.StatusOK, history)
+	default:
+		s.static(w, op)
+	}
+}
+
+// match returns the route for a request path and the path parameters it binds.
+func (s *Server) match(path string) (*route, map[string]string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, r := range s.routes {
+		if len(r.segments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		ok := true
+		for i, seg := range r.segments {
+			if isParam(seg) {
+				params[seg[1:len(seg)-1]] = segments[i]
+				continue
+			}
+			if seg != segments[i] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return r, params
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, collection string) {
+	q := r.URL.Query()
+	pageNumber, pageSize := 1, defaultPageSize
+	if v := q.Get("pageNumber"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "Invalid pageNumber", nil)
+			return
+		}
+		pageNumber = n
+	}
+	if v := q.Get("pageSize"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxPageSize {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("pageSize must be between 1 and %d", maxPageSize), nil)
+			return
+		}
+		pageSize = n
+	}
+
+	var items []map[string]interface{}
+	for _, rec := range s.collections[collection] {
+		s.advance(rec)
+		if matchesQuery(rec.obj, q) {
+			items = append(items, rec.obj)
+		}
+	}
+	orderBy := q.Get("orderBy")
+	if orderBy != "" {
+		sortObjects(items, orderBy)
+	}
+
+	total := len(items)
+	start := (pageNumber - 1) * pageSize
+	end := start + pageSize
+	if start > total {
+		start = total
+	}
+	if end > total {
+		end = total
+	}
+	page := items[start:end]
+	if page == nil {
+		page = []map[string]interface{}{}
+	}
+
+	header := map[string]interface{}{"pageNumber": pageNumber, "pageSize": pageSize, "total": total, "orderBy": nil}
+	if orderBy != "" {
+		header["orderBy"] = orderBy
+	}
+	hb, _ := json.Marshal(header)
+	w.Header().Set(paginationHeader, string(hb))
+	writeJSON(w, http.StatusOK, page)
+}
+
+func (s *Server) create(w http.ResponseWriter, op *carbidecli.Operation, collection string, params map[string]string, body map[string]interface{}) {
+	code, schema := s.responseSchema(op)
+	now := s.now()
+	obj := make(map[string]interface{})
+	s.merge(schema, obj, body)
+	// Path parameters such as org or allocationId identify the owner of the object.
+	for name, value := range params {
+		if _, ok := schema.Properties[name]; ok {
+			obj[name] = value
+		}
+	}
+	obj["id"] = uuid.NewString()
+	stamp := now.Format(time.RFC3339Nano)
+	if _, ok := schema.Properties["created"]; ok {
+		obj["created"] = stamp
+	}
+	if _, ok := schema.Properties["updated"]; ok {
+		obj["updated"] = stamp
+	}
+
+	rec := &record{obj: obj, readyAt: now.Add(s.ReadyAfter)}
+	statuses := statusEnum(s.spec, schema)
+	for _, t := range statusTransitions {
+		if containsAll(statuses, t[0], t[1]) {
+			obj["status"] = t[0]
+			obj["statusHistory"] = []interface{}{statusDetail(t[0], "Request received, pending processing", stamp)}
+			rec.target = t[1]
+			break
+		}
+	}
+	s.collections[collection] = append(s.collections[collection], rec)
+	writeJSON(w, code, obj)
+}
+
+func (s *Server) update(w http.ResponseWriter, op *carbidecli.Operation, rec *record, body map[string]interface{}) {
+	code, schema := s.responseSchema(op)
+	s.advance(rec)
+	s.merge(schema, rec.obj, body)
+	if _, ok := rec.obj["updated"]; ok {
+		rec.obj["updated"] = s.now().Format(time.RFC3339Nano)
+	}
+	writeJSON(w, code, rec.obj)
+}
+
+// merge copies request fields that the response schema exposes and that are
+// not read-only. Fields of schemas without properties are copied as-is.
+func (s *Server) merge(schema *carbidecli.Schema, dst, src map[string]interface{}) {
+	for k, v := range src {
+		if schema.Properties == nil {
+			dst[k] = v
+			continue
+		}
+		prop := s.spec.ResolveSchema(schema.Properties[k])
+		if prop == nil || prop.ReadOnly || k == "id" || k == "status" {
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// advance moves a new object to its final status once its ready time has passed.
+func (s *Server) advance(rec *record) {
+	if rec.target == "" || rec.obj["status"] == rec.target || s.now().Before(rec.readyAt) {
+		return
+	}
+	stamp := s.now().Format(time.RFC3339Nano)
+	rec.obj["status"] = rec.target
+	history, _ := rec.obj["statusHistory"].([]interface{})
+	rec.obj["statusHistory"] = append(history, statusDetail(rec.target, "Request completed successfully", stamp))
+	if _, ok := rec.obj["updated"]; ok {
+		rec.obj["updated"] = stamp
+	}
+}
+
+func (s *Server) find(collection, id string) *record {
+	for _, rec := range s.collections[collection] {
+		if rec.obj["id"] == id {
+			s.advance(rec)
+			return rec
+		}
+	}
+	return nil
+}
+
+func (s *Server) remove(collection, id string) {
+	recs := s.collections[collection]
+	for i, rec := range recs {
+		if rec.obj["id"] == id {
+			s.collections[collection] = append(recs[:i], recs[i+1:]...)
+			break
+		}
+	}
+	// Nested collections of the removed object go with it.
+	prefix := collection + "/" + id + "/"
+	for k := range s.collections {
+		if strings.HasPrefix(k, prefix) {
+			delete(s.collections, k)
+		}
+	}
+}
+
+// static answers with the operation's response example, or a sample built
+// from the response schema when the spec has no example.
+func (s *Server) static(w http.ResponseWriter, op *carbidecli.Operation) {
+	code, resp := op.SuccessResponse()
+	if resp == nil {
+		w.WriteHeader(code)
+		return
+	}
+	mt, ok := resp.Content["application/json"]
+	if !ok {
+		w.WriteHeader(code)
+		return
+	}
+	if len(mt.Examples) > 0 {
+		names := make([]string, 0, len(mt.Examples))
+		for name := range mt.Examples {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		writeJSON(w, code, jsonCompatible(mt.Examples[names[0]].Value))
+		return
+	}
+	writeJSON(w, code, jsonCompatible(sampleValue(s.spec, mt.Schema, s.now(), 0)))
+}
+
+// responseSchema returns the success code and resolved response schema of an
+// operation. An empty object schema is returned when none is declared.
+func (s *Server) responseSchema(op *carbidecli.Operation) (int, *carbidecli.Schema) {
+	code, resp := op.SuccessResponse()
+	if resp != nil {
+		if mt, ok := resp.Content["application/json"]; ok {
+			if schema := s.spec.ResolveSchema(mt.Schema); schema != nil {
+				return code, schema
+			}
+		}
+	}
+	return code, &carbidecli.Schema{Type: "object"}
+}
+
+// listControlParams are query parameters that shape a list rather than filter it.
+var listControlParams = map[string]bool{
+	"pageNumber":      true,
+	"pageSize":        true,
+	"orderBy":         true,
+	"includeRelation": true,
+}
+
+// matchesQuery applies list filters. A filter on a field the object does not
+// have is ignored; "query" matches the name as a case-insensitive substring.
+func matchesQuery(obj map[string]interface{}, q map[string][]string) bool {
+	for name, values := range q {
+		if listControlParams[name] || strings.HasPrefix(name, "include") || len(values) == 0 {
+			continue
+		}
+		if name == "query" {
+			objName, _ := obj["name"].(string)
+			if !strings.Contains(strings.ToLower(objName), strings.ToLower(values[0])) {
+				return false
+			}
+			continue
+		}
+		v, ok := obj[name]
+		if !ok || v == nil {
+			continue
+		}
+		matched := false
+		for _, want := range values {
+			if fmt.Sprint(v) == want {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// sortObjects orders items by an orderBy value such as NAME_ASC or CREATED_DESC.
+func sortObjects(items []map[string]interface{}, orderBy string) {
+	i := strings.LastIndex(orderBy, "_")
+	if i < 0 {
+		return
+	}
+	field := toCamel(orderBy[:i])
+	desc := orderBy[i+1:] == "DESC"
+	sort.SliceStable(items, func(a, b int) bool {
+		va, vb := fmt.Sprint(items[a][field]), fmt.Sprint(items[b][field])
+		if desc {
+			return va > vb
+		}
+		return va < vb
+	})
+}
+
+// toCamel converts an orderBy field such as IP_ADDRESS to ipAddress.
+func toCamel(s string) string {
+	parts := strings.Split(strings.ToLower(s), "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
+
+func statusDetail(status, message, stamp string) map[string]interface{} {
+	return map[string]interface{}{"status": status, "message": message, "created": stamp, "updated": stamp}
+}
+
+func operation(item carbidecli.PathItem, method string) *carbidecli.Operation {
+	switch method {
+	case http.MethodGet:
+		return item.Get
+	case http.MethodPost:
+		return item.Post
+	case http.MethodPatch:
+		return item.Patch
+	case http.MethodPut:
+		return item.Put
+	case http.MethodDelete:
+		return item.Delete
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, message string, data map[string]string) {
+	resp := map[string]interface{}{"source": errorSource, "message": message, "data": nil}
+	if len(data) > 0 {
+		resp["data"] = data
+	}
+	writeJSON(w, code, resp)
+}
+
+// jsonCompatible converts values decoded from YAML (which may have
+// map[string]interface{} keys of other types) into JSON-encodable values.
+func jsonCompatible(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[k] = jsonCompatible(val)
+		}
+		return out
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			out[fmt.Sprint(k)] = jsonCompatible(val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, val := range t {
+			out[i] = jsonCompatible(val)
+		}
+		return out
+	case time.Time:
+		return t.UTC().Format(time.RFC3339)
+	}
+	return v
+}
+
+func containsAll(values []string, want ...string) bool {
+	for _, w := range want {
+		found := false
+		for _, v := range values {
+			if v == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func isParam(seg string) bool {
+	return strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}")
+}
+
+func splitLast(p string) (string, string) {
+	i := strings.LastIndex(p, "/")
+	if i < 0 {
+		return "", p
+	}
+	return p[:i], p[i+1:]
+}
+
+func lastSegment(p string) string {
+	_, last := splitLast(p)
+	return last
+}
+
+func parentPath(p string) string {
+	parent, _ := splitLast(p)
+	return parent
+}
+
+// normalizeOrg replaces the org segment of a request path with "{org}".
+func normalizeOrg(p string) string {
+	segments := strings.Split(p, "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] == "org" {
+			segments[i+1] = "{org}"
+			break
+		}
+	}
+	return strings.Join(segments, "/")
+}
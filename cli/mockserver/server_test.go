@@ -0,0 +1,277 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package mockserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	carbidecli "github.com/nvidia/bare-metal-manager-rest/cli/pkg"
+	"github.com/nvidia/bare-metal-manager-rest/openapi"
+)
+
+const (
+	vpcPath  = "/v2/org/{org}/carbide/vpc"
+	siteUUID = "72771e6a-6f5e-4de4-a5b9-1266c4197811"
+)
+
+func newTestServer(t *testing.T) (*Server, *carbidecli.Client, *time.Time) {
+	t.Helper()
+	spec, err := carbidecli.ParseSpec(openapi.Spec)
+	if err != nil {
+		t.Fatalf("ParseSpec: %v", err)
+	}
+	srv := New(spec)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	srv.now = func() time.Time { return now }
+
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	client := carbidecli.NewClient(ts.URL, "test-org", "token", logrus.NewEntry(logrus.New()), false)
+	return srv, client, &now
+}
+
+func decode(t *testing.T, data []byte) map[string]interface{} {
+	t.Helper()
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		t.Fatalf("decoding %s: %v", data, err)
+	}
+	return obj
+}
+
+func TestCreateGetUpdateDelete(t *testing.T) {
+	_, client, now := newTestServer(t)
+
+	resp, _, err := client.Do("POST", vpcPath, nil, nil, []byte(`{"name":"prod","siteId":"`+siteUUID+`","labels":{"env":"prod"}}`))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	vpc := decode(t, resp)
+	id, _ := vpc["id"].(string)
+	if id == "" || vpc["name"] != "prod" || vpc["org"] != "test-org" || vpc["status"] != "Pending" {
+		t.Fatalf("unexpected created object: %v", vpc)
+	}
+
+	resp, _, err = client.Do("GET", vpcPath+"/{vpcId}", map[string]string{"vpcId": id}, nil, nil)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got := decode(t, resp); got["status"] != "Pending" {
+		t.Errorf("status before ReadyAfter = %v, want Pending", got["status"])
+	}
+
+	*now = now.Add(10 * time.Second)
+	resp, _, err = client.Do("PATCH", vpcPath+"/{vpcId}", map[string]string{"vpcId": id}, nil, []byte(`{"description":"updated"}`))
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+	updated := decode(t, resp)
+	if updated["status"] != "Ready" || updated["description"] != "updated" || updated["name"] != "prod" {
+		t.Errorf("unexpected updated object: %v", updated)
+	}
+	if history := updated["statusHistory"].([]interface{}); len(history) != 2 {
+		t.Errorf("statusHistory length = %d, want 2", len(history))
+	}
+
+	if _, _, err := client.Do("DELETE", vpcPath+"/{vpcId}", map[string]string{"vpcId": id}, nil, nil); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	_, _, err = client.Do("GET", vpcPath+"/{vpcId}", map[string]string{"vpcId": id}, nil, nil)
+	var apiErr *carbidecli.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("get after delete: err = %v, want 404", err)
+	}
+}
+
+func TestCreateValidatesRequestBody(t *testing.T) {
+	_, client, _ := newTestServer(t)
+
+	tests := []struct {
+		name  string
+		body  string
+		field string
+	}{
+		{"missing required", `{"name":"prod"}`, "siteId"},
+		{"wrong type", `{"name":1,"siteId":"` + siteUUID + `"}`, "name"},
+		{"invalid uuid", `{"name":"prod","siteId":"not-a-uuid"}`, "siteId"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := client.Do("POST", vpcPath, nil, nil, []byte(tt.body))
+			var apiErr *carbidecli.APIError
+			if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadRequest {
+				t.Fatalf("err = %v, want 400", err)
+			}
+			data, _ := apiErr.Data.(map[string]interface{})
+			if _, ok := data[tt.field]; !ok {
+				t.Errorf("validation data %v does not mention %q", apiErr.Data, tt.field)
+			}
+		})
+	}
+}
+
+func TestListPaginationAndFilters(t *testing.T) {
+	_, client, _ := newTestServer(t)
+
+	for i := 0; i < 25; i++ {
+		body := fmt.Sprintf(`{"name":"vpc-%02d","siteId":"%s"}`, i, siteUUID)
+		if _, _, err := client.Do("POST", vpcPath, nil, nil, []byte(body)); err != nil {
+			t.Fatalf("create: %v", err)
+		}
+	}
+
+	resp, headers, err := client.Do("GET", vpcPath, nil, map[string]string{"pageSize": "10", "pageNumber": "3", "orderBy": "NAME_DESC"}, nil)
+	if err != nil {
+		t.Fatalf("list: %v", err)
+	}
+	var page []map[string]interface{}
+	if err := json.Unmarshal(resp, &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 5 || page[0]["name"] != "vpc-04" {
+		t.Errorf("page 3 = %d items starting at %v, want 5 starting at vpc-04", len(page), page[0]["name"])
+	}
+	var ph struct {
+		PageNumber int `json:"pageNumber"`
+		PageSize   int `json:"pageSize"`
+		Total      int `json:"total"`
+	}
+	if err := json.Unmarshal([]byte(headers.Get("X-Pagination")), &ph); err != nil {
+		t.Fatalf("X-Pagination header: %v", err)
+	}
+	if ph.PageNumber != 3 || ph.PageSize != 10 || ph.Total != 25 {
+		t.Errorf("X-Pagination = %+v", ph)
+	}
+
+	resp, _, err = client.Do("GET", vpcPath, nil, map[string]string{"query": "vpc-1"}, nil)
+	if err != nil {
+		t.Fatalf("list with query: %v", err)
+	}
+	if err := json.Unmarshal(resp, &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 10 {
+		t.Errorf("query vpc-1 matched %d items, want 10", len(page))
+	}
+
+	_, _, err = client.Do("GET", vpcPath, nil, map[string]string{"pageSize": "500"}, nil)
+	if err == nil {
+		t.Error("expected error for pageSize above maximum")
+	}
+}
+
+func TestNestedCollectionRequiresParent(t *testing.T) {
+	srv, client, _ := newTestServer(t)
+	constraintPath := "/v2/org/{org}/carbide/allocation/{allocationId}/constraint"
+
+	missing := map[string]string{"allocationId": "5f2cc306-76e9-4fca-9186-950c9ef9a74e"}
+	body := []byte(`{"resourceType":"InstanceType","resourceTypeId":"5f2cc306-76e9-4fca-9186-950c9ef9a74e","constraintType":"Reserved","constraintValue":1}`)
+	_, _, err := client.Do("POST", constraintPath, missing, nil, body)
+	var apiErr *carbidecli.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("err = %v, want 404 for missing parent", err)
+	}
+
+	srv.Seed("/v2/org/{org}/carbide/allocation", map[string]interface{}{"id": missing["allocationId"], "name": "a"})
+	resp, _, err := client.Do("POST", constraintPath, missing, nil, body)
+	if err != nil {
+		t.Fatalf("create constraint: %v", err)
+	}
+	if got := decode(t, resp); got["allocationId"] != missing["allocationId"] {
+		t.Errorf("allocationId = %v, want it taken from the path", got["allocationId"])
+	}
+}
+
+func TestStaticAndStatusHistory(t *testing.T) {
+	srv, client, _ := newTestServer(t)
+
+	resp, _, err := client.Do("GET", "/v2/org/{org}/carbide/tenant/current", nil, nil, nil)
+	if err != nil {
+		t.Fatalf("tenant/current: %v", err)
+	}
+	if got := decode(t, resp); got["id"] == nil {
+		t.Errorf("expected example tenant, got %v", got)
+	}
+
+	dir := t.TempDir()
+	seed := filepath.Join(dir, "seed.yaml")
+	os.WriteFile(seed, []byte(`/v2/org/{org}/carbide/site:
+  - id: `+siteUUID+`
+    name: sjc4
+    status: Registered
+    statusHistory:
+      - status: Registered
+        message: Site registered
+`), 0600)
+	if err := srv.LoadSeedFile(seed); err != nil {
+		t.Fatalf("LoadSeedFile: %v", err)
+	}
+	resp, _, err = client.Do("GET", "/v2/org/{org}/carbide/site/{siteId}/status-history", map[string]string{"siteId": siteUUID}, nil, nil)
+	if err != nil {
+		t.Fatalf("status-history: %v", err)
+	}
+	var history []map[string]interface{}
+	if err := json.Unmarshal(resp, &history); err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 1 || history[0]["status"] != "Registered" {
+		t.Errorf("status history = %v", history)
+	}
+}
+
+func TestUnknownPath(t *testing.T) {
+	_, client, _ := newTestServer(t)
+	_, _, err := client.Do("GET", "/v2/org/{org}/carbide/does-not-exist", nil, nil, nil)
+	var apiErr *carbidecli.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("err = %v, want 404", err)
+	}
+}
+
+func TestSyncingBecomesSynced(t *testing.T) {
+	_, client, now := newTestServer(t)
+	groupPath := "/v2/org/{org}/carbide/sshkeygroup"
+
+	resp, _, err := client.Do("POST", groupPath, nil, nil, []byte(`{"name":"admins"}`))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	group := decode(t, resp)
+	if group["status"] != "Syncing" {
+		t.Fatalf("status after create = %v, want Syncing", group["status"])
+	}
+
+	*now = now.Add(10 * time.Second)
+	resp, _, err = client.Do("GET", groupPath+"/{sshKeyGroupId}", map[string]string{"sshKeyGroupId": group["id"].(string)}, nil, nil)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got := decode(t, resp); got["status"] != "Synced" {
+		t.Errorf("status after ReadyAfter = %v, want Synced", got["status"])
+	}
+}
@@ -38,6 +38,7 @@ func NewApp(specData []byte) (*cli.App, error) {
 	commands := BuildCommands(spec)
 	commands = append(commands, LoginCommand())
 	commands = append(commands, InitCommand())
+	commands = append(commands, PlanCommand(), ApplyCommand(), ExportCommand())
 	commands = append(commands, completionCommand())
 
 	app := &cli.App{
@@ -0,0 +1,456 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package carbidecli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	cli "github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+func environmentFileFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     "file",
+		Aliases:  []string{"f"},
+		Usage:    "Environment YAML file (use - for stdin)",
+		Required: true,
+	}
+}
+
+func pruneFlag() cli.Flag {
+	return &cli.BoolFlag{
+		Name:  "prune",
+		Usage: "Delete resources on the site that are not declared in the file",
+	}
+}
+
+// PlanCommand returns the 'plan' CLI command that shows the changes 'apply' would make.
+func PlanCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "plan",
+		Usage:     "Show the changes needed to make a site match an environment file",
+		ArgsUsage: " ",
+		Flags:     []cli.Flag{environmentFileFlag(), pruneFlag()},
+		Action: func(c *cli.Context) error {
+			env, err := LoadEnvironment(c.String("file"))
+			if err != nil {
+				return err
+			}
+			client, err := clientFromContext(c)
+			if err != nil {
+				return err
+			}
+			plan, err := BuildPlan(client, env, c.Bool("prune"))
+			if err != nil {
+				return err
+			}
+			if plan.Empty() {
+				fmt.Println("No changes. Site matches the environment file.")
+				return nil
+			}
+			plan.Print(os.Stdout)
+			return nil
+		},
+	}
+}
+
+// ApplyCommand returns the 'apply' CLI command that converges a site on an environment file.
+func ApplyCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "apply",
+		Usage:     "Create, update and delete resources so a site matches an environment file",
+		ArgsUsage: " ",
+		Flags: []cli.Flag{
+			environmentFileFlag(),
+			pruneFlag(),
+			&cli.BoolFlag{
+				Name:    "yes",
+				Aliases: []string{"y"},
+				Usage:   "Apply without asking for confirmation",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			env, err := LoadEnvironment(c.String("file"))
+			if err != nil {
+				return err
+			}
+			client, err := clientFromContext(c)
+			if err != nil {
+				return err
+			}
+			plan, err := BuildPlan(client, env, c.Bool("prune"))
+			if err != nil {
+				return err
+			}
+			if plan.Empty() {
+				fmt.Println("No changes. Site matches the environment file.")
+				return nil
+			}
+			plan.Print(os.Stdout)
+			if !c.Bool("yes") {
+				fmt.Fprint(os.Stderr, "Apply these changes? [y/N]: ")
+				var answer string
+				fmt.Scanln(&answer)
+				if a := strings.ToLower(strings.TrimSpace(answer)); a != "y" && a != "yes" {
+					return fmt.Errorf("apply cancelled")
+				}
+			}
+			return plan.Apply(os.Stderr)
+		},
+	}
+}
+
+// ExportCommand returns the 'export' CLI command that writes live site state as an environment file.
+func ExportCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "export",
+		Usage:     "Write the resources on a site as an environment file",
+		ArgsUsage: " ",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "site",
+				Usage:    "Site name or ID",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:    "file",
+				Aliases: []string{"f"},
+				Usage:   "Output file (default stdout)",
+			},
+		},
+		Action: func(c *cli.Context) error {
+			client, err := clientFromContext(c)
+			if err != nil {
+				return err
+			}
+			env, err := ExportEnvironment(client, c.String("site"))
+			if err != nil {
+				return err
+			}
+			out, err := MarshalEnvironment(env)
+			if err != nil {
+				return err
+			}
+			if path := c.String("file"); path != "" && path != "-" {
+				if err := os.WriteFile(path, out, 0644); err != nil {
+					return fmt.Errorf("writing environment file: %w", err)
+				}
+				fmt.Fprintf(os.Stderr, "Environment written to %s\n", path)
+				return nil
+			}
+			_, err = os.Stdout.Write(out)
+			return err
+		},
+	}
+}
+
+const (
+	defaultReadyPollInterval = 5 * time.Second
+	defaultReadyTimeout      = 30 * time.Minute
+)
+
+var (
+	// readyStatuses are the statuses in which a created resource can be referenced.
+	readyStatuses = map[string]bool{"Ready": true, "Synced": true}
+	// goneStatuses are the statuses of deleted resources that are still returned by the API.
+	goneStatuses = map[string]bool{"Deleted": true, "Terminated": true}
+)
+
+// Apply executes the plan in order, stopping at the first failure. Steps that
+// already succeeded are not rolled back; running apply again picks up from
+// the current state.
+//
+// Resources of one kind are created or deleted together. Before moving on to
+// the next kind, Apply waits until the created resources are ready and the
+// deleted ones are gone, as the API rejects references to resources that are
+// still provisioning and deletes of resources that are still in use.
+func (p *Plan) Apply(w io.Writer) error {
+	var pending []planAction
+	for _, a := range p.Actions {
+		if len(pending) > 0 && a.Kind != pending[0].Kind {
+			// Deletes only remove undeclared resources, which nothing created by
+			// this plan references, so pending creates do not hold them back.
+			if a.Op != "delete" || pending[0].Op == "delete" {
+				if err := p.waitFor(w, pending); err != nil {
+					return err
+				}
+			}
+			pending = nil
+		}
+
+		switch a.Op {
+		case "create":
+			body, err := a.body()
+			if err != nil {
+				return fmt.Errorf("creating %s %q: %w", a.Kind, a.Name, err)
+			}
+			data, err := json.Marshal(body)
+			if err != nil {
+				return fmt.Errorf("creating %s %q: %w", a.Kind, a.Name, err)
+			}
+			resp, _, err := p.resolver.client.Do("POST", a.Kind.path(), nil, nil, data)
+			if err != nil {
+				return fmt.Errorf("creating %s %q: %w", a.Kind, a.Name, err)
+			}
+			var obj liveObject
+			if err := json.Unmarshal(resp, &obj); err != nil {
+				return fmt.Errorf("decoding created %s %q: %w", a.Kind, a.Name, err)
+			}
+			p.resolver.Register(a.Kind, obj)
+			fmt.Fprintf(w, "Created %s %q (%s)\n", a.Kind, a.Name, obj.str("id"))
+			if status := obj.str("status"); status != "" && !readyStatuses[status] {
+				pending = append(pending, planAction{Op: a.Op, Kind: a.Kind, Name: a.Name, ID: obj.str("id")})
+			}
+		case "update":
+			body, err := a.body()
+			if err != nil {
+				return fmt.Errorf("updating %s %q: %w", a.Kind, a.Name, err)
+			}
+			data, err := json.Marshal(body)
+			if err != nil {
+				return fmt.Errorf("updating %s %q: %w", a.Kind, a.Name, err)
+			}
+			if _, _, err := p.resolver.client.Do("PATCH", a.Kind.itemPath(), map[string]string{"id": a.ID}, nil, data); err != nil {
+				return fmt.Errorf("updating %s %q: %w", a.Kind, a.Name, err)
+			}
+			fmt.Fprintf(w, "Updated %s %q (%s)\n", a.Kind, a.Name, strings.Join(a.Changes, ", "))
+		case "delete":
+			if _, _, err := p.resolver.client.Do("DELETE", a.Kind.itemPath(), map[string]string{"id": a.ID}, nil, nil); err != nil {
+				return fmt.Errorf("deleting %s %q: %w", a.Kind, a.Name, err)
+			}
+			fmt.Fprintf(w, "Deleted %s %q\n", a.Kind, a.Name)
+			pending = append(pending, a)
+		}
+	}
+	c, u, d := p.counts()
+	fmt.Fprintf(w, "Apply complete: %d created, %d updated, %d deleted.\n", c, u, d)
+	return nil
+}
+
+// waitFor polls each created resource until it is ready and each deleted
+// resource until it is gone.
+func (p *Plan) waitFor(w io.Writer, actions []planAction) error {
+	interval, timeout := p.pollInterval, p.readyTimeout
+	if interval == 0 {
+		interval = defaultReadyPollInterval
+	}
+	if timeout == 0 {
+		timeout = defaultReadyTimeout
+	}
+
+	for _, a := range actions {
+		if a.Op == "create" {
+			fmt.Fprintf(w, "Waiting for %s %q to be ready\n", a.Kind, a.Name)
+		} else {
+			fmt.Fprintf(w, "Waiting for %s %q to be deleted\n", a.Kind, a.Name)
+		}
+		deadline := time.Now().Add(timeout)
+		for {
+			done, err := p.checkDone(a)
+			if err != nil {
+				return err
+			}
+			if done {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("timed out after %s waiting for %s %q", timeout, a.Kind, a.Name)
+			}
+			time.Sleep(interval)
+		}
+	}
+	return nil
+}
+
+// checkDone reports whether a created resource is ready or a deleted resource is gone.
+func (p *Plan) checkDone(a planAction) (bool, error) {
+	resp, _, err := p.resolver.client.Do("GET", a.Kind.itemPath(), map[string]string{"id": a.ID}, nil, nil)
+	if err != nil {
+		var apiErr *APIError
+		if a.Op == "delete" && errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+			return true, nil
+		}
+		return false, fmt.Errorf("fetching %s %q: %w", a.Kind, a.Name, err)
+	}
+	var obj liveObject
+	if err := json.Unmarshal(resp, &obj); err != nil {
+		return false, fmt.Errorf("decoding %s %q: %w", a.Kind, a.Name, err)
+	}
+	status := obj.str("status")
+	if status == "Error" {
+		return false, fmt.Errorf("%s %q is in status %s", a.Kind, a.Name, status)
+	}
+	if a.Op == "delete" {
+		return goneStatuses[status], nil
+	}
+	return status == "" || readyStatuses[status], nil
+}
+
+// MarshalEnvironment encodes an environment as YAML.
+func MarshalEnvironment(env *Environment) ([]byte, error) {
+	var sb strings.Builder
+	enc := yaml.NewEncoder(&sb)
+	enc.SetIndent(2)
+	if err := enc.Encode(env); err != nil {
+		return nil, fmt.Errorf("encoding environment: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encoding environment: %w", err)
+	}
+	return []byte(sb.String()), nil
+}
+
+// ExportEnvironment reads the managed resources on a site and describes them
+// as an environment, replacing IDs with names.
+func ExportEnvironment(client *Client, site string) (*Environment, error) {
+	r := newEnvResolver(client)
+	siteID, err := r.Resolve(kindSite, site)
+	if err != nil {
+		return nil, err
+	}
+	r.siteID = siteID
+	env := &Environment{Site: r.Name(kindSite, siteID)}
+
+	for _, kind := range managedKinds {
+		objs, err := r.fetch(kind)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range objs {
+			switch kind {
+			case kindNetworkSecurityGroup:
+				n := EnvNetworkSecurityGroup{Name: o.str("name"), Description: o.str("description")}
+				if v, ok := o["statefulEgress"].(bool); ok {
+					n.StatefulEgress = &v
+				}
+				decodeField(o, "rules", &n.Rules)
+				decodeField(o, "labels", &n.Labels)
+				env.NetworkSecurityGroups = append(env.NetworkSecurityGroups, n)
+			case kindSSHKeyGroup:
+				g := EnvSSHKeyGroup{Name: o.str("name"), Description: o.str("description")}
+				var keys []namedItem
+				decodeField(o, "sshKeys", &keys)
+				for _, k := range keys {
+					g.SSHKeys = append(g.SSHKeys, k.Name)
+				}
+				env.SSHKeyGroups = append(env.SSHKeyGroups, g)
+			case kindVPC:
+				v := EnvVPC{
+					Name:                      o.str("name"),
+					Description:               o.str("description"),
+					NetworkVirtualizationType: o.str("networkVirtualizationType"),
+					NetworkSecurityGroup:      r.Name(kindNetworkSecurityGroup, o.str("networkSecurityGroupId")),
+				}
+				decodeField(o, "labels", &v.Labels)
+				env.VPCs = append(env.VPCs, v)
+			case kindVPCPrefix:
+				// Reserved ranges are only returned when fetching a single prefix.
+				resp, _, err := client.Do("GET", kind.itemPath(), map[string]string{"id": o.str("id")}, nil, nil)
+				if err != nil {
+					return nil, fmt.Errorf("fetching %s %q: %w", kind, o.str("name"), err)
+				}
+				var full liveObject
+				if err := json.Unmarshal(resp, &full); err != nil {
+					return nil, fmt.Errorf("decoding %s %q: %w", kind, o.str("name"), err)
+				}
+				p := EnvVPCPrefix{
+					Name:    full.str("name"),
+					VPC:     r.Name(kindVPC, full.str("vpcId")),
+					IPBlock: r.Name(kindIPBlock, full.str("ipBlockId")),
+				}
+				decodeField(full, "prefixLength", &p.PrefixLength)
+				decodeField(full, "reservedRanges", &p.ReservedRanges)
+				env.VPCPrefixes = append(env.VPCPrefixes, p)
+			case kindSubnet:
+				s := EnvSubnet{
+					Name:        o.str("name"),
+					Description: o.str("description"),
+					VPC:         r.Name(kindVPC, o.str("vpcId")),
+					IPv4Block:   r.Name(kindIPBlock, o.str("ipv4BlockId")),
+					IPv6Block:   r.Name(kindIPBlock, o.str("ipv6BlockId")),
+				}
+				decodeField(o, "prefixLength", &s.PrefixLength)
+				env.Subnets = append(env.Subnets, s)
+			case kindInstance:
+				env.Instances = append(env.Instances, exportInstance(r, o))
+			}
+		}
+	}
+	return env, nil
+}
+
+func exportInstance(r *envResolver, o liveObject) EnvInstance {
+	inst := EnvInstance{
+		Name:            o.str("name"),
+		Description:     o.str("description"),
+		VPC:             r.Name(kindVPC, o.str("vpcId")),
+		InstanceType:    r.Name(kindInstanceType, o.str("instanceTypeId")),
+		OperatingSystem: r.Name(kindOperatingSystem, o.str("operatingSystemId")),
+		UserData:        o.str("userData"),
+	}
+	if inherited, _ := o["networkSecurityGroupInherited"].(bool); !inherited {
+		inst.NetworkSecurityGroup = r.Name(kindNetworkSecurityGroup, o.str("networkSecurityGroupId"))
+	}
+	var groupIDs []string
+	decodeField(o, "sshKeyGroupIds", &groupIDs)
+	for _, id := range groupIDs {
+		inst.SSHKeyGroups = append(inst.SSHKeyGroups, r.Name(kindSSHKeyGroup, id))
+	}
+	decodeField(o, "labels", &inst.Labels)
+
+	var ifaces []struct {
+		SubnetID       string `json:"subnetId"`
+		VPCPrefixID    string `json:"vpcPrefixId"`
+		IsPhysical     bool   `json:"isPhysical"`
+		Device         string `json:"device"`
+		DeviceInstance *int   `json:"deviceInstance"`
+	}
+	decodeField(o, "interfaces", &ifaces)
+	for _, ifc := range ifaces {
+		inst.Interfaces = append(inst.Interfaces, EnvInterface{
+			Subnet:         r.Name(kindSubnet, ifc.SubnetID),
+			VPCPrefix:      r.Name(kindVPCPrefix, ifc.VPCPrefixID),
+			IsPhysical:     ifc.IsPhysical,
+			Device:         ifc.Device,
+			DeviceInstance: ifc.DeviceInstance,
+		})
+	}
+	return inst
+}
+
+// decodeField converts a field of a live object into a typed value, leaving
+// out untouched if the field is absent or has a different shape.
+func decodeField(o liveObject, key string, out interface{}) {
+	v, ok := o[key]
+	if !ok || v == nil {
+		return
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(b, out)
+}
@@ -221,7 +221,7 @@ func detectPrimaryResource(ops []resolvedOp) string {
 
 func extractResourceSuffix(opID string) string {
 	prefixes := []string{
-		"batch-create-", "batch-update-",
+		"batch-create-", "batch-update-", "bulk-create-", "evaluate-", "verify-",
 		"get-all-", "get-current-",
 		"create-", "update-", "delete-", "get-",
 	}
@@ -680,6 +680,9 @@ func operationAction(opID string) string {
 	}{
 		{"batch-create-", "batch-create"},
 		{"batch-update-", "batch-update"},
+		{"bulk-create-", "bulk-create"},
+		{"evaluate-", "evaluate"},
+		{"verify-", "verify"},
 		{"get-all-", "list"},
 		{"get-current-", "get"},
 		{"create-", "create"},
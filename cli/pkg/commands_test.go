@@ -93,6 +93,9 @@ func TestOperationAction(t *testing.T) {
 		{"batch-create-instance", "batch-create"},
 		{"batch-create-expected-machines", "batch-create"},
 		{"batch-update-expected-machines", "batch-update"},
+		{"bulk-create-expected-switch", "bulk-create"},
+		{"evaluate-network-security-group", "evaluate"},
+		{"verify-operating-system-version", "verify"},
 		{"get-metadata", "get"},
 		{"get-user", "get"},
 	}
@@ -121,6 +124,9 @@ func TestExtractResourceSuffix(t *testing.T) {
 		{"get-current-infrastructure-provider", "infrastructure-provider"},
 		{"batch-create-expected-machines", "expected-machines"},
 		{"batch-update-expected-machines", "expected-machines"},
+		{"bulk-create-expected-switch", "expected-switch"},
+		{"evaluate-network-security-group", "network-security-group"},
+		{"verify-operating-system-version", "operating-system-version"},
 		{"get-site-status-history", "site-status-history"},
 		{"get-instance-status-history", "instance-status-history"},
 	}
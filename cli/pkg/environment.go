@@ -0,0 +1,1027 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package carbidecli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment is the declarative document consumed by 'plan' and 'apply' and
+// produced by 'export'. All references between resources are by name.
+// Fields omitted from the document are left untouched on the server.
+type Environment struct {
+	Site                  string                    `yaml:"site"`
+	NetworkSecurityGroups []EnvNetworkSecurityGroup `yaml:"networkSecurityGroups,omitempty"`
+	SSHKeyGroups          []EnvSSHKeyGroup          `yaml:"sshKeyGroups,omitempty"`
+	VPCs                  []EnvVPC                  `yaml:"vpcs,omitempty"`
+	VPCPrefixes           []EnvVPCPrefix            `yaml:"vpcPrefixes,omitempty"`
+	Subnets               []EnvSubnet               `yaml:"subnets,omitempty"`
+	Instances             []EnvInstance             `yaml:"instances,omitempty"`
+}
+
+type EnvNetworkSecurityGroup struct {
+	Name           string            `yaml:"name"`
+	Description    string            `yaml:"description,omitempty"`
+	StatefulEgress *bool             `yaml:"statefulEgress,omitempty"`
+	Rules          []EnvNSGRule      `yaml:"rules,omitempty"`
+	Labels         map[string]string `yaml:"labels,omitempty"`
+}
+
+// EnvNSGRule mirrors the API NetworkSecurityGroupRule and is sent as-is.
+type EnvNSGRule struct {
+	Name                 string `yaml:"name,omitempty" json:"name,omitempty"`
+	Direction            string `yaml:"direction" json:"direction"`
+	SourcePortRange      string `yaml:"sourcePortRange,omitempty" json:"sourcePortRange,omitempty"`
+	DestinationPortRange string `yaml:"destinationPortRange,omitempty" json:"destinationPortRange,omitempty"`
+	Protocol             string `yaml:"protocol" json:"protocol"`
+	Action               string `yaml:"action" json:"action"`
+	Priority             int    `yaml:"priority,omitempty" json:"priority,omitempty"`
+	SourcePrefix         string `yaml:"sourcePrefix" json:"sourcePrefix"`
+	DestinationPrefix    string `yaml:"destinationPrefix" json:"destinationPrefix"`
+}
+
+type EnvSSHKeyGroup struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description,omitempty"`
+	SSHKeys     []string `yaml:"sshKeys,omitempty"`
+}
+
+type EnvVPC struct {
+	Name                      string            `yaml:"name"`
+	Description               string            `yaml:"description,omitempty"`
+	NetworkVirtualizationType string            `yaml:"networkVirtualizationType,omitempty"`
+	NetworkSecurityGroup      string            `yaml:"networkSecurityGroup,omitempty"`
+	Labels                    map[string]string `yaml:"labels,omitempty"`
+}
+
+type EnvVPCPrefix struct {
+	Name           string             `yaml:"name"`
+	VPC            string             `yaml:"vpc"`
+	IPBlock        string             `yaml:"ipBlock,omitempty"`
+	PrefixLength   int                `yaml:"prefixLength"`
+	ReservedRanges []EnvReservedRange `yaml:"reservedRanges,omitempty"`
+}
+
+type EnvReservedRange struct {
+	Start string `yaml:"start" json:"start"`
+	End   string `yaml:"end,omitempty" json:"end,omitempty"`
+}
+
+type EnvSubnet struct {
+	Name         string `yaml:"name"`
+	Description  string `yaml:"description,omitempty"`
+	VPC          string `yaml:"vpc"`
+	IPv4Block    string `yaml:"ipv4Block,omitempty"`
+	IPv6Block    string `yaml:"ipv6Block,omitempty"`
+	PrefixLength int    `yaml:"prefixLength"`
+}
+
+type EnvInstance struct {
+	Name                 string            `yaml:"name"`
+	Description          string            `yaml:"description,omitempty"`
+	VPC                  string            `yaml:"vpc"`
+	InstanceType         string            `yaml:"instanceType,omitempty"`
+	Machine              string            `yaml:"machine,omitempty"`
+	OperatingSystem      string            `yaml:"operatingSystem,omitempty"`
+	NetworkSecurityGroup string            `yaml:"networkSecurityGroup,omitempty"`
+	SSHKeyGroups         []string          `yaml:"sshKeyGroups,omitempty"`
+	UserData             string            `yaml:"userData,omitempty"`
+	Labels               map[string]string `yaml:"labels,omitempty"`
+	Interfaces           []EnvInterface    `yaml:"interfaces"`
+}
+
+type EnvInterface struct {
+	Subnet         string `yaml:"subnet,omitempty"`
+	VPCPrefix      string `yaml:"vpcPrefix,omitempty"`
+	IsPhysical     bool   `yaml:"isPhysical,omitempty"`
+	Device         string `yaml:"device,omitempty"`
+	DeviceInstance *int   `yaml:"deviceInstance,omitempty"`
+}
+
+// LoadEnvironment reads and validates an environment document. Use "-" to read from stdin.
+func LoadEnvironment(path string) (*Environment, error) {
+	data, err := ReadBodyInput("", path)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, fmt.Errorf("-f is required")
+	}
+	return ParseEnvironment(data)
+}
+
+// ParseEnvironment parses and validates an environment document.
+func ParseEnvironment(data []byte) (*Environment, error) {
+	var env Environment
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&env); err != nil {
+		return nil, fmt.Errorf("parsing environment: %w", err)
+	}
+	if err := env.Validate(); err != nil {
+		return nil, err
+	}
+	return &env, nil
+}
+
+// Validate checks that the document is self-consistent: names are present and
+// unique per resource kind and every resource has its required references.
+func (e *Environment) Validate() error {
+	if e.Site == "" {
+		return fmt.Errorf("environment: site is required")
+	}
+	seen := make(map[resourceKind]map[string]bool)
+	check := func(kind resourceKind, name string) error {
+		if name == "" {
+			return fmt.Errorf("environment: %s without a name", kind)
+		}
+		if seen[kind] == nil {
+			seen[kind] = make(map[string]bool)
+		}
+		if seen[kind][name] {
+			return fmt.Errorf("environment: duplicate %s %q", kind, name)
+		}
+		seen[kind][name] = true
+		return nil
+	}
+	for _, r := range e.NetworkSecurityGroups {
+		if err := check(kindNetworkSecurityGroup, r.Name); err != nil {
+			return err
+		}
+	}
+	for _, r := range e.SSHKeyGroups {
+		if err := check(kindSSHKeyGroup, r.Name); err != nil {
+			return err
+		}
+	}
+	for _, r := range e.VPCs {
+		if err := check(kindVPC, r.Name); err != nil {
+			return err
+		}
+	}
+	for _, r := range e.VPCPrefixes {
+		if err := check(kindVPCPrefix, r.Name); err != nil {
+			return err
+		}
+		if r.VPC == "" || r.PrefixLength == 0 {
+			return fmt.Errorf("environment: %s %q requires vpc and prefixLength", kindVPCPrefix, r.Name)
+		}
+	}
+	for _, r := range e.Subnets {
+		if err := check(kindSubnet, r.Name); err != nil {
+			return err
+		}
+		if r.VPC == "" || r.PrefixLength == 0 {
+			return fmt.Errorf("environment: %s %q requires vpc and prefixLength", kindSubnet, r.Name)
+		}
+		if r.IPv4Block == "" && r.IPv6Block == "" {
+			return fmt.Errorf("environment: %s %q requires ipv4Block or ipv6Block", kindSubnet, r.Name)
+		}
+	}
+	for _, r := range e.Instances {
+		if err := check(kindInstance, r.Name); err != nil {
+			return err
+		}
+		if r.VPC == "" || len(r.Interfaces) == 0 {
+			return fmt.Errorf("environment: %s %q requires vpc and at least one interface", kindInstance, r.Name)
+		}
+		for i, ifc := range r.Interfaces {
+			if (ifc.Subnet == "") == (ifc.VPCPrefix == "") {
+				return fmt.Errorf("environment: %s %q interface %d must set exactly one of subnet or vpcPrefix", kindInstance, r.Name, i)
+			}
+		}
+	}
+	return nil
+}
+
+// resourceKind identifies a resource type by its API path segment.
+type resourceKind string
+
+const (
+	kindSite                 resourceKind = "site"
+	kindIPBlock              resourceKind = "ipblock"
+	kindInstanceType         resourceKind = "instance/type"
+	kindOperatingSystem      resourceKind = "operating-system"
+	kindSSHKey               resourceKind = "sshkey"
+	kindNetworkSecurityGroup resourceKind = "network-security-group"
+	kindSSHKeyGroup          resourceKind = "sshkeygroup"
+	kindVPC                  resourceKind = "vpc"
+	kindVPCPrefix            resourceKind = "vpc-prefix"
+	kindSubnet               resourceKind = "subnet"
+	kindInstance             resourceKind = "instance"
+)
+
+// managedKinds lists the kinds an environment manages, in creation order.
+// Deletion runs in the reverse order.
+var managedKinds = []resourceKind{
+	kindNetworkSecurityGroup,
+	kindSSHKeyGroup,
+	kindVPC,
+	kindVPCPrefix,
+	kindSubnet,
+	kindInstance,
+}
+
+func (k resourceKind) path() string {
+	return "/v2/org/{org}/carbide/" + string(k)
+}
+
+func (k resourceKind) itemPath() string {
+	return k.path() + "/{id}"
+}
+
+// updatableFields lists the request fields that can be changed after creation.
+// All other fields are create-only.
+var updatableFields = map[resourceKind]map[string]bool{
+	kindNetworkSecurityGroup: {"description": true, "statefulEgress": true, "rules": true, "labels": true},
+	kindSSHKeyGroup:          {"description": true, "sshKeyIds": true, "siteIds": true},
+	kindVPC:                  {"description": true, "networkSecurityGroupId": true, "labels": true},
+	kindVPCPrefix:            {"reservedRanges": true},
+	kindSubnet:               {"description": true},
+	kindInstance: {
+		"description": true, "operatingSystemId": true, "networkSecurityGroupId": true,
+		"sshKeyGroupIds": true, "userData": true, "labels": true, "interfaces": true,
+	},
+}
+
+// unorderedFields are list fields compared without regard to element order.
+var unorderedFields = map[string]bool{
+	"rules":          true,
+	"sshKeyIds":      true,
+	"siteIds":        true,
+	"sshKeyGroupIds": true,
+	"reservedRanges": true,
+}
+
+// liveObject is a resource as returned by the API, keyed by its JSON fields.
+type liveObject map[string]interface{}
+
+func (o liveObject) str(key string) string {
+	if s, ok := o[key].(string); ok {
+		return s
+	}
+	return ""
+}
+
+// namedItem is a resource reduced to what name resolution needs.
+type namedItem struct {
+	Name string
+	ID   string
+}
+
+// envResolver resolves resource names to IDs, fetching each resource type at
+// most once. Resources created during apply are registered as they appear.
+type envResolver struct {
+	client   *Client
+	siteID   string
+	items    map[resourceKind][]namedItem
+	live     map[resourceKind][]liveObject
+	declared map[resourceKind]map[string]bool
+}
+
+func newEnvResolver(client *Client) *envResolver {
+	return &envResolver{
+		client:   client,
+		items:    make(map[resourceKind][]namedItem),
+		live:     make(map[resourceKind][]liveObject),
+		declared: make(map[resourceKind]map[string]bool),
+	}
+}
+
+// pendingID is the placeholder used during planning for a resource that the
+// plan will create.
+func pendingID(kind resourceKind, name string) string {
+	return fmt.Sprintf("(%s %s)", kind, name)
+}
+
+func (r *envResolver) fetch(kind resourceKind) ([]liveObject, error) {
+	if objs, ok := r.live[kind]; ok {
+		return objs, nil
+	}
+	query := map[string]string{}
+	switch kind {
+	case kindSite, kindSSHKey:
+	default:
+		if r.siteID != "" {
+			query["siteId"] = r.siteID
+		}
+	}
+	objs, err := fetchAllObjects(r.client, kind.path(), query)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", kind, err)
+	}
+	items := make([]namedItem, 0, len(objs))
+	for _, o := range objs {
+		items = append(items, namedItem{Name: o.str("name"), ID: o.str("id")})
+	}
+	r.live[kind] = objs
+	r.items[kind] = items
+	return objs, nil
+}
+
+// Resolve returns the ID for a resource name or ID. Matching follows the TUI:
+// case-insensitive on name, exact on ID.
+func (r *envResolver) Resolve(kind resourceKind, ref string) (string, error) {
+	if ref == "" {
+		return "", nil
+	}
+	if _, err := r.fetch(kind); err != nil {
+		return "", err
+	}
+	var matches []namedItem
+	for _, it := range r.items[kind] {
+		if it.ID == ref {
+			return it.ID, nil
+		}
+		if strings.EqualFold(it.Name, ref) {
+			matches = append(matches, it)
+		}
+	}
+	switch len(matches) {
+	case 1:
+		return matches[0].ID, nil
+	case 0:
+		if r.declared[kind][strings.ToLower(ref)] {
+			return pendingID(kind, ref), nil
+		}
+		return "", fmt.Errorf("no %s matching %q found", kind, ref)
+	default:
+		return "", fmt.Errorf("%s name %q is ambiguous (%d matches); use the ID instead", kind, ref, len(matches))
+	}
+}
+
+// Name returns the name for a resource ID, or the ID itself if it is unknown.
+func (r *envResolver) Name(kind resourceKind, id string) string {
+	if id == "" {
+		return ""
+	}
+	if _, err := r.fetch(kind); err != nil {
+		return id
+	}
+	for _, it := range r.items[kind] {
+		if it.ID == id {
+			if it.Name == "" {
+				return id
+			}
+			return it.Name
+		}
+	}
+	return id
+}
+
+// Register records a newly created resource so later references resolve to it.
+func (r *envResolver) Register(kind resourceKind, obj liveObject) {
+	r.items[kind] = append(r.items[kind], namedItem{Name: obj.str("name"), ID: obj.str("id")})
+	r.live[kind] = append(r.live[kind], obj)
+}
+
+// fetchAllObjects pages through a list endpoint and returns every item.
+func fetchAllObjects(client *Client, path string, query map[string]string) ([]liveObject, error) {
+	const pageSize = 100
+	const maxPages = 1000
+
+	params := make(map[string]string, len(query)+2)
+	for k, v := range query {
+		params[k] = v
+	}
+	params["pageSize"] = strconv.Itoa(pageSize)
+
+	var all []liveObject
+	for page := 1; page <= maxPages; page++ {
+		params["pageNumber"] = strconv.Itoa(page)
+		body, headers, err := client.Do("GET", path, nil, params, nil)
+		if err != nil {
+			return nil, err
+		}
+		var items []liveObject
+		if len(body) > 0 {
+			if err := json.Unmarshal(body, &items); err != nil {
+				return nil, fmt.Errorf("decoding %s: %w", path, err)
+			}
+		}
+		all = append(all, items...)
+		h := parsePaginationHeader(headers)
+		if h != nil && h.Total > 0 && len(all) >= h.Total {
+			break
+		}
+		if len(items) < pageSize {
+			break
+		}
+	}
+	return all, nil
+}
+
+// planAction is a single step of a plan.
+type planAction struct {
+	Op      string
+	Kind    resourceKind
+	Name    string
+	ID      string
+	Changes []string
+
+	// body builds the request body; it is evaluated at apply time so that
+	// references to resources created earlier in the same apply resolve.
+	body func() (map[string]interface{}, error)
+}
+
+// Plan is the ordered list of actions needed to converge live state on an environment.
+type Plan struct {
+	SiteID    string
+	Actions   []planAction
+	Unmanaged int
+
+	resolver *envResolver
+	// pollInterval and readyTimeout control how Apply waits for resources,
+	// zero selects the defaults
+	pollInterval time.Duration
+	readyTimeout time.Duration
+}
+
+func (p *Plan) counts() (create, update, del int) {
+	for _, a := range p.Actions {
+		switch a.Op {
+		case "create":
+			create++
+		case "update":
+			update++
+		case "delete":
+			del++
+		}
+	}
+	return
+}
+
+// Empty reports whether the plan has nothing to do.
+func (p *Plan) Empty() bool {
+	return len(p.Actions) == 0
+}
+
+// Print writes a human-readable summary of the plan.
+func (p *Plan) Print(w io.Writer) {
+	for _, a := range p.Actions {
+		switch a.Op {
+		case "create":
+			fmt.Fprintf(w, "  + %s %q\n", a.Kind, a.Name)
+		case "update":
+			fmt.Fprintf(w, "  ~ %s %q (%s)\n", a.Kind, a.Name, strings.Join(a.Changes, ", "))
+		case "delete":
+			fmt.Fprintf(w, "  - %s %q\n", a.Kind, a.Name)
+		}
+	}
+	c, u, d := p.counts()
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete.\n", c, u, d)
+	if p.Unmanaged > 0 {
+		fmt.Fprintf(w, "%d resources on the site are not in the document; use --prune to delete them.\n", p.Unmanaged)
+	}
+}
+
+// desiredResource is a declared resource with its create body builder.
+type desiredResource struct {
+	name string
+	body func() (map[string]interface{}, error)
+}
+
+// BuildPlan compares the environment against live state and returns the
+// actions needed to converge. With prune, resources on the site that are not
+// declared are deleted.
+func BuildPlan(client *Client, env *Environment, prune bool) (*Plan, error) {
+	r := newEnvResolver(client)
+	siteID, err := r.Resolve(kindSite, env.Site)
+	if err != nil {
+		return nil, err
+	}
+	r.siteID = siteID
+	return buildPlan(r, env, prune)
+}
+
+func buildPlan(r *envResolver, env *Environment, prune bool) (*Plan, error) {
+	tenantID := ""
+	if len(env.Instances) > 0 {
+		body, _, err := r.client.Do("GET", "/v2/org/{org}/carbide/tenant/current", nil, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("fetching current tenant: %w", err)
+		}
+		var tenant liveObject
+		if err := json.Unmarshal(body, &tenant); err != nil {
+			return nil, fmt.Errorf("decoding current tenant: %w", err)
+		}
+		tenantID = tenant.str("id")
+	}
+
+	desired := env.desired(r, tenantID)
+	for kind, resources := range desired {
+		r.declared[kind] = make(map[string]bool, len(resources))
+		for _, d := range resources {
+			r.declared[kind][strings.ToLower(d.name)] = true
+		}
+	}
+
+	plan := &Plan{SiteID: r.siteID, resolver: r}
+	var deletes []planAction
+	for _, kind := range managedKinds {
+		live, err := r.fetch(kind)
+		if err != nil {
+			return nil, err
+		}
+		byName := make(map[string]liveObject, len(live))
+		for _, o := range live {
+			byName[strings.ToLower(o.str("name"))] = o
+		}
+
+		declared := make(map[string]bool)
+		for _, d := range desired[kind] {
+			declared[strings.ToLower(d.name)] = true
+			obj, exists := byName[strings.ToLower(d.name)]
+			if !exists {
+				plan.Actions = append(plan.Actions, planAction{Op: "create", Kind: kind, Name: d.name, body: d.body})
+				continue
+			}
+			if kind == kindVPCPrefix {
+				// Reserved ranges are only returned when fetching a single prefix.
+				full, _, err := r.client.Do("GET", kind.itemPath(), map[string]string{"id": obj.str("id")}, nil, nil)
+				if err != nil {
+					return nil, fmt.Errorf("fetching %s %q: %w", kind, d.name, err)
+				}
+				if err := json.Unmarshal(full, &obj); err != nil {
+					return nil, fmt.Errorf("decoding %s %q: %w", kind, d.name, err)
+				}
+			}
+			action, err := diffResource(kind, d, obj)
+			if err != nil {
+				return nil, err
+			}
+			if action != nil {
+				plan.Actions = append(plan.Actions, *action)
+			}
+		}
+
+		for _, o := range live {
+			if declared[strings.ToLower(o.str("name"))] {
+				continue
+			}
+			if !prune {
+				plan.Unmanaged++
+				continue
+			}
+			// SSH key groups shared with other sites are never pruned.
+			if kind == kindSSHKeyGroup && len(nestedIDs(o["siteAssociations"], "site")) > 1 {
+				continue
+			}
+			deletes = append(deletes, planAction{Op: "delete", Kind: kind, Name: o.str("name"), ID: o.str("id")})
+		}
+	}
+
+	// Delete dependents before the resources they reference.
+	sort.SliceStable(deletes, func(i, j int) bool {
+		return kindOrder(deletes[i].Kind) > kindOrder(deletes[j].Kind)
+	})
+	plan.Actions = append(plan.Actions, deletes...)
+	return plan, nil
+}
+
+func kindOrder(kind resourceKind) int {
+	for i, k := range managedKinds {
+		if k == kind {
+			return i
+		}
+	}
+	return -1
+}
+
+// diffResource returns an update action when declared fields differ from
+// live state, nil when they match, and an error when a create-only field differs.
+func diffResource(kind resourceKind, d desiredResource, live liveObject) (*planAction, error) {
+	desired, err := d.body()
+	if err != nil {
+		return nil, fmt.Errorf("%s %q: %w", kind, d.name, err)
+	}
+	desired = normalizeJSON(desired)
+
+	var changes []string
+	overrides := map[string]interface{}{}
+	for _, key := range sortedFieldKeys(desired) {
+		if key == "name" {
+			continue
+		}
+		want := desired[key]
+		have := liveFieldValue(kind, key, live)
+		if kind == kindSSHKeyGroup && key == "siteIds" {
+			// Only add the environment's site; associations with other sites are left alone.
+			union, added := unionIDs(have, want)
+			if added {
+				overrides[key] = union
+				changes = append(changes, key)
+			}
+			continue
+		}
+		if fieldEqual(key, want, have) {
+			continue
+		}
+		if !updatableFields[kind][key] {
+			return nil, fmt.Errorf("%s %q: %s cannot be changed in place (have %v, want %v); delete and re-create it",
+				kind, d.name, key, displayValue(have), displayValue(want))
+		}
+		changes = append(changes, key)
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	name := live.str("name")
+	version := live.str("version")
+	return &planAction{
+		Op:      "update",
+		Kind:    kind,
+		Name:    d.name,
+		ID:      live.str("id"),
+		Changes: changes,
+		body: func() (map[string]interface{}, error) {
+			full, err := d.body()
+			if err != nil {
+				return nil, err
+			}
+			update := map[string]interface{}{}
+			for _, key := range changes {
+				if v, ok := overrides[key]; ok {
+					update[key] = v
+					continue
+				}
+				update[key] = full[key]
+			}
+			switch kind {
+			case kindSubnet:
+				update["name"] = name
+			case kindSSHKeyGroup:
+				update["version"] = version
+			}
+			return update, nil
+		},
+	}, nil
+}
+
+// ruleFields and interfaceFields are the request keys compared for list elements;
+// other keys on live elements are populated by the server.
+var (
+	ruleFields = []string{"name", "direction", "sourcePortRange", "destinationPortRange", "protocol",
+		"action", "priority", "sourcePrefix", "destinationPrefix"}
+	interfaceFields = []string{"subnetId", "vpcPrefixId", "isPhysical", "device", "deviceInstance"}
+)
+
+// liveFieldValue extracts the live value comparable to a request field. Most
+// request fields share the response field name; the rest are derived.
+func liveFieldValue(kind resourceKind, key string, live liveObject) interface{} {
+	switch {
+	case kind == kindSSHKeyGroup && key == "sshKeyIds":
+		return nestedIDs(live["sshKeys"], "")
+	case kind == kindSSHKeyGroup && key == "siteIds":
+		return nestedIDs(live["siteAssociations"], "site")
+	}
+	return live[key]
+}
+
+// nestedIDs collects the "id" of each element of a list, optionally from a nested object.
+func nestedIDs(v interface{}, nested string) []interface{} {
+	list, _ := v.([]interface{})
+	ids := make([]interface{}, 0, len(list))
+	for _, e := range list {
+		m, _ := e.(map[string]interface{})
+		if nested != "" {
+			m, _ = m[nested].(map[string]interface{})
+		}
+		if id, ok := m["id"].(string); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// unionIDs returns have plus any IDs in want it lacks, and whether any were added.
+func unionIDs(have, want interface{}) ([]interface{}, bool) {
+	union, _ := have.([]interface{})
+	union = append([]interface{}{}, union...)
+	added := false
+	wl, _ := want.([]interface{})
+	for _, w := range wl {
+		found := false
+		for _, h := range union {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			union = append(union, w)
+			added = true
+		}
+	}
+	return union, added
+}
+
+// compactList reduces each element of a list of objects to the given keys,
+// dropping empty values so that null, zero and absent compare equal.
+func compactList(v interface{}, keys []string) interface{} {
+	list, ok := v.([]interface{})
+	if !ok {
+		return v
+	}
+	out := make([]interface{}, 0, len(list))
+	for _, e := range list {
+		m, _ := e.(map[string]interface{})
+		p := make(map[string]interface{})
+		for _, k := range keys {
+			switch val := m[k].(type) {
+			case nil:
+			case string:
+				if val != "" {
+					p[k] = val
+				}
+			case bool:
+				if val {
+					p[k] = val
+				}
+			case float64:
+				if val != 0 {
+					p[k] = val
+				}
+			default:
+				p[k] = val
+			}
+		}
+		out = append(out, p)
+	}
+	return out
+}
+
+func fieldEqual(key string, want, have interface{}) bool {
+	switch key {
+	case "rules":
+		want, have = compactList(want, ruleFields), compactList(have, ruleFields)
+	case "interfaces":
+		want, have = compactList(want, interfaceFields), compactList(have, interfaceFields)
+	}
+	if unorderedFields[key] {
+		return reflect.DeepEqual(sortedJSONList(want), sortedJSONList(have))
+	}
+	if wl, ok := want.([]interface{}); ok && len(wl) == 0 && have == nil {
+		return true
+	}
+	if wm, ok := want.(map[string]interface{}); ok && len(wm) == 0 && have == nil {
+		return true
+	}
+	return reflect.DeepEqual(want, have)
+}
+
+// sortedJSONList returns the JSON encoding of each list element, sorted.
+func sortedJSONList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	out := make([]string, 0, len(list))
+	for _, e := range list {
+		b, _ := json.Marshal(e)
+		out = append(out, string(b))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// normalizeJSON round-trips a value through JSON so it compares equal to
+// decoded API responses.
+func normalizeJSON(m map[string]interface{}) map[string]interface{} {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return m
+	}
+	var out map[string]interface{}
+	if json.Unmarshal(b, &out) != nil {
+		return m
+	}
+	return out
+}
+
+func displayValue(v interface{}) string {
+	if v == nil {
+		return "<none>"
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func sortedFieldKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// desired returns the create body builders for every declared resource, by kind.
+func (e *Environment) desired(r *envResolver, tenantID string) map[resourceKind][]desiredResource {
+	out := make(map[resourceKind][]desiredResource)
+
+	for _, n := range e.NetworkSecurityGroups {
+		n := n
+		out[kindNetworkSecurityGroup] = append(out[kindNetworkSecurityGroup], desiredResource{name: n.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": n.Name, "siteId": r.siteID}
+			setString(b, "description", n.Description)
+			if n.StatefulEgress != nil {
+				b["statefulEgress"] = *n.StatefulEgress
+			}
+			if n.Rules != nil {
+				b["rules"] = n.Rules
+			}
+			if n.Labels != nil {
+				b["labels"] = n.Labels
+			}
+			return b, nil
+		}})
+	}
+
+	for _, g := range e.SSHKeyGroups {
+		g := g
+		out[kindSSHKeyGroup] = append(out[kindSSHKeyGroup], desiredResource{name: g.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": g.Name, "siteIds": []string{r.siteID}}
+			setString(b, "description", g.Description)
+			if g.SSHKeys != nil {
+				ids, err := resolveAll(r, kindSSHKey, g.SSHKeys)
+				if err != nil {
+					return nil, err
+				}
+				b["sshKeyIds"] = ids
+			}
+			return b, nil
+		}})
+	}
+
+	for _, v := range e.VPCs {
+		v := v
+		out[kindVPC] = append(out[kindVPC], desiredResource{name: v.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": v.Name, "siteId": r.siteID}
+			setString(b, "description", v.Description)
+			setString(b, "networkVirtualizationType", v.NetworkVirtualizationType)
+			if err := setRef(b, r, "networkSecurityGroupId", kindNetworkSecurityGroup, v.NetworkSecurityGroup); err != nil {
+				return nil, err
+			}
+			if v.Labels != nil {
+				b["labels"] = v.Labels
+			}
+			return b, nil
+		}})
+	}
+
+	for _, p := range e.VPCPrefixes {
+		p := p
+		out[kindVPCPrefix] = append(out[kindVPCPrefix], desiredResource{name: p.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": p.Name, "prefixLength": p.PrefixLength}
+			if err := setRef(b, r, "vpcId", kindVPC, p.VPC); err != nil {
+				return nil, err
+			}
+			if err := setRef(b, r, "ipBlockId", kindIPBlock, p.IPBlock); err != nil {
+				return nil, err
+			}
+			if p.ReservedRanges != nil {
+				ranges := make([]EnvReservedRange, 0, len(p.ReservedRanges))
+				for _, rr := range p.ReservedRanges {
+					if rr.End == rr.Start {
+						rr.End = ""
+					}
+					ranges = append(ranges, rr)
+				}
+				b["reservedRanges"] = ranges
+			}
+			return b, nil
+		}})
+	}
+
+	for _, s := range e.Subnets {
+		s := s
+		out[kindSubnet] = append(out[kindSubnet], desiredResource{name: s.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": s.Name, "prefixLength": s.PrefixLength}
+			setString(b, "description", s.Description)
+			if err := setRef(b, r, "vpcId", kindVPC, s.VPC); err != nil {
+				return nil, err
+			}
+			if err := setRef(b, r, "ipv4BlockId", kindIPBlock, s.IPv4Block); err != nil {
+				return nil, err
+			}
+			if err := setRef(b, r, "ipv6BlockId", kindIPBlock, s.IPv6Block); err != nil {
+				return nil, err
+			}
+			return b, nil
+		}})
+	}
+
+	for _, inst := range e.Instances {
+		inst := inst
+		out[kindInstance] = append(out[kindInstance], desiredResource{name: inst.Name, body: func() (map[string]interface{}, error) {
+			b := map[string]interface{}{"name": inst.Name, "tenantId": tenantID}
+			setString(b, "description", inst.Description)
+			setString(b, "machineId", inst.Machine)
+			setString(b, "userData", inst.UserData)
+			refs := []struct {
+				key  string
+				kind resourceKind
+				ref  string
+			}{
+				{"vpcId", kindVPC, inst.VPC},
+				{"instanceTypeId", kindInstanceType, inst.InstanceType},
+				{"operatingSystemId", kindOperatingSystem, inst.OperatingSystem},
+				{"networkSecurityGroupId", kindNetworkSecurityGroup, inst.NetworkSecurityGroup},
+			}
+			for _, ref := range refs {
+				if err := setRef(b, r, ref.key, ref.kind, ref.ref); err != nil {
+					return nil, err
+				}
+			}
+			if inst.SSHKeyGroups != nil {
+				ids, err := resolveAll(r, kindSSHKeyGroup, inst.SSHKeyGroups)
+				if err != nil {
+					return nil, err
+				}
+				b["sshKeyGroupIds"] = ids
+			}
+			if inst.Labels != nil {
+				b["labels"] = inst.Labels
+			}
+			ifaces := make([]map[string]interface{}, 0, len(inst.Interfaces))
+			for _, ifc := range inst.Interfaces {
+				m := map[string]interface{}{}
+				if err := setRef(m, r, "subnetId", kindSubnet, ifc.Subnet); err != nil {
+					return nil, err
+				}
+				if err := setRef(m, r, "vpcPrefixId", kindVPCPrefix, ifc.VPCPrefix); err != nil {
+					return nil, err
+				}
+				if ifc.IsPhysical {
+					m["isPhysical"] = true
+				}
+				setString(m, "device", ifc.Device)
+				if ifc.DeviceInstance != nil {
+					m["deviceInstance"] = *ifc.DeviceInstance
+				}
+				ifaces = append(ifaces, m)
+			}
+			b["interfaces"] = ifaces
+			return b, nil
+		}})
+	}
+
+	return out
+}
+
+func setString(b map[string]interface{}, key, value string) {
+	if value != "" {
+		b[key] = value
+	}
+}
+
+func setRef(b map[string]interface{}, r *envResolver, key string, kind resourceKind, ref string) error {
+	if ref == "" {
+		return nil
+	}
+	id, err := r.Resolve(kind, ref)
+	if err != nil {
+		return err
+	}
+	b[key] = id
+	return nil
+}
+
+func resolveAll(r *envResolver, kind resourceKind, refs []string) ([]string, error) {
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		id, err := r.Resolve(kind, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
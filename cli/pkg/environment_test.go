@@ -0,0 +1,496 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package carbidecli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// fakeAPI is a minimal in-memory stand-in for the resource endpoints used by plan/apply/export.
+type fakeAPI struct {
+	mu      sync.Mutex
+	objects map[string][]map[string]interface{}
+	nextID  int
+	calls   []string
+
+	// polls is the number of GETs a created object stays Pending and a deleted
+	// object stays Deleting; zero creates and deletes at once
+	polls     int
+	remaining map[string]int
+}
+
+func newFakeAPI() *fakeAPI {
+	return &fakeAPI{
+		objects: map[string][]map[string]interface{}{
+			"site":          {{"id": "site-1", "name": "dev"}},
+			"ipblock":       {{"id": "block-1", "name": "tenant-v4", "siteId": "site-1"}},
+			"instance/type": {{"id": "it-1", "name": "gpu-large", "siteId": "site-1"}},
+		},
+		remaining: map[string]int{},
+	}
+}
+
+// find returns the object with the given ID of any kind.
+func (f *fakeAPI) find(id string) map[string]interface{} {
+	for _, objs := range f.objects {
+		for _, o := range objs {
+			if o["id"] == id {
+				return o
+			}
+		}
+	}
+	return nil
+}
+
+// referenced reports whether another object refers to the given ID.
+func (f *fakeAPI) referenced(id string) bool {
+	for _, objs := range f.objects {
+		for _, o := range objs {
+			for k, v := range o {
+				if k != "id" && v == id {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (f *fakeAPI) remove(kind, id string) {
+	objs := f.objects[kind]
+	for i, o := range objs {
+		if o["id"] == id {
+			f.objects[kind] = append(objs[:i], objs[i+1:]...)
+			return
+		}
+	}
+}
+
+func writeFakeError(w http.ResponseWriter, code int, msg string) {
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{"message": msg})
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v2/org/test-org/carbide/")
+	if path == "tenant/current" {
+		json.NewEncoder(w).Encode(map[string]interface{}{"id": "tenant-1"})
+		return
+	}
+	if r.Method != http.MethodGet {
+		f.calls = append(f.calls, r.Method+" "+path)
+	}
+
+	kind, id := path, ""
+	if _, ok := f.objects[path]; !ok && r.Method != http.MethodPost {
+		if i := strings.LastIndex(path, "/"); i >= 0 {
+			kind, id = path[:i], path[i+1:]
+		}
+	}
+
+	switch {
+	case r.Method == http.MethodGet && id == "":
+		items := []map[string]interface{}{}
+		for _, o := range f.objects[kind] {
+			if siteID := r.URL.Query().Get("siteId"); siteID != "" && o["siteId"] != nil && o["siteId"] != siteID {
+				continue
+			}
+			items = append(items, o)
+		}
+		w.Header().Set("X-Pagination", fmt.Sprintf(`{"pageNumber":1,"pageSize":100,"total":%d}`, len(items)))
+		json.NewEncoder(w).Encode(items)
+	case r.Method == http.MethodGet:
+		for _, o := range f.objects[kind] {
+			if o["id"] != id {
+				continue
+			}
+			if n, ok := f.remaining[id]; ok {
+				if n > 1 {
+					f.remaining[id] = n - 1
+				} else {
+					delete(f.remaining, id)
+					if o["status"] == "Deleting" {
+						f.remove(kind, id)
+						break
+					}
+					o["status"] = "Ready"
+				}
+			}
+			json.NewEncoder(w).Encode(o)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodPost:
+		var o map[string]interface{}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &o)
+		// Like the API, references to resources that are not ready are rejected.
+		for k, v := range o {
+			ref, _ := v.(string)
+			if ro := f.find(ref); strings.HasSuffix(k, "Id") && ro != nil && ro["status"] != nil && ro["status"] != "Ready" {
+				writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("%s %s is not Ready", k, ref))
+				return
+			}
+		}
+		f.nextID++
+		o["id"] = fmt.Sprintf("%s-%d", kind, f.nextID)
+		if _, ok := o["siteId"]; !ok {
+			o["siteId"] = "site-1"
+		}
+		if f.polls > 0 {
+			o["status"] = "Pending"
+			f.remaining[o["id"].(string)] = f.polls
+		}
+		f.objects[kind] = append(f.objects[kind], o)
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(o)
+	case r.Method == http.MethodPatch:
+		var patch map[string]interface{}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &patch)
+		for _, o := range f.objects[kind] {
+			if o["id"] == id {
+				for k, v := range patch {
+					o[k] = v
+				}
+				json.NewEncoder(w).Encode(o)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodDelete:
+		for _, o := range f.objects[kind] {
+			if o["id"] != id {
+				continue
+			}
+			if f.referenced(id) {
+				writeFakeError(w, http.StatusBadRequest, fmt.Sprintf("%s %s is in use", kind, id))
+				return
+			}
+			if f.polls > 0 {
+				o["status"] = "Deleting"
+				f.remaining[id] = f.polls
+			} else {
+				f.remove(kind, id)
+			}
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeClient(t *testing.T, api *fakeAPI) *Client {
+	t.Helper()
+	srv := httptest.NewServer(api)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL, "test-org", "token", logrus.NewEntry(logrus.New()), false)
+}
+
+const testEnvironment = `
+site: dev
+networkSecurityGroups:
+  - name: web
+    rules:
+      - direction: INGRESS
+        protocol: TCP
+        action: PERMIT
+        sourcePrefix: 0.0.0.0/0
+        destinationPrefix: 0.0.0.0/0
+        destinationPortRange: "443"
+vpcs:
+  - name: prod
+    networkSecurityGroup: web
+    labels:
+      env: prod
+subnets:
+  - name: frontend
+    vpc: prod
+    ipv4Block: tenant-v4
+    prefixLength: 24
+instances:
+  - name: web-1
+    vpc: prod
+    instanceType: gpu-large
+    interfaces:
+      - subnet: frontend
+        isPhysical: true
+`
+
+func TestParseEnvironment(t *testing.T) {
+	env, err := ParseEnvironment([]byte(testEnvironment))
+	if err != nil {
+		t.Fatalf("ParseEnvironment: %v", err)
+	}
+	if env.Site != "dev" || len(env.VPCs) != 1 || len(env.Instances) != 1 {
+		t.Fatalf("unexpected environment: %+v", env)
+	}
+
+	invalid := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"missing site", "vpcs: [{name: a}]", "site is required"},
+		{"duplicate", "site: dev\nvpcs: [{name: a}, {name: a}]", "duplicate vpc"},
+		{"unknown field", "site: dev\nvpc: []", "field vpc not found"},
+		{"subnet without block", "site: dev\nsubnets: [{name: s, vpc: a, prefixLength: 24}]", "ipv4Block or ipv6Block"},
+		{"interface with both refs", "site: dev\ninstances: [{name: i, vpc: a, interfaces: [{subnet: s, vpcPrefix: p}]}]", "exactly one of subnet or vpcPrefix"},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseEnvironment([]byte(tt.doc))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanApplyCreatesInDependencyOrder(t *testing.T) {
+	api := newFakeAPI()
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte(testEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	var got []string
+	for _, a := range plan.Actions {
+		got = append(got, a.Op+" "+string(a.Kind)+" "+a.Name)
+	}
+	want := []string{
+		"create network-security-group web",
+		"create vpc prod",
+		"create subnet frontend",
+		"create instance web-1",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Fatalf("plan actions:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+
+	vpc := api.objects["vpc"][0]
+	if vpc["networkSecurityGroupId"] != api.objects["network-security-group"][0]["id"] {
+		t.Errorf("vpc networkSecurityGroupId = %v, want created NSG ID", vpc["networkSecurityGroupId"])
+	}
+	inst := api.objects["instance"][0]
+	if inst["tenantId"] != "tenant-1" || inst["vpcId"] != vpc["id"] || inst["instanceTypeId"] != "it-1" {
+		t.Errorf("instance references not resolved: %v", inst)
+	}
+	ifaces := inst["interfaces"].([]interface{})
+	if ifaces[0].(map[string]interface{})["subnetId"] != api.objects["subnet"][0]["id"] {
+		t.Errorf("interface subnetId not resolved: %v", ifaces)
+	}
+
+	plan, err = BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatalf("BuildPlan after apply: %v", err)
+	}
+	if !plan.Empty() {
+		t.Errorf("expected empty plan after apply, got %+v", plan.Actions)
+	}
+}
+
+func TestApplyWaitsForReadiness(t *testing.T) {
+	api := newFakeAPI()
+	api.polls = 2
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte(testEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	plan.pollInterval = time.Millisecond
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	if api.objects["vpc"][0]["status"] != "Ready" || api.objects["subnet"][0]["status"] != "Ready" {
+		t.Errorf("expected VPC and subnet to be Ready before their dependents were created")
+	}
+	// Nothing depends on the instance, so apply does not wait for it.
+	if api.objects["instance"][0]["status"] != "Pending" {
+		t.Errorf("instance status = %v, want Pending", api.objects["instance"][0]["status"])
+	}
+
+	env, err = ParseEnvironment([]byte("site: dev"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err = BuildPlan(client, env, true)
+	if err != nil {
+		t.Fatalf("BuildPlan with prune: %v", err)
+	}
+	plan.pollInterval = time.Millisecond
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("Apply with prune: %v", err)
+	}
+	for _, kind := range []string{"instance", "subnet", "vpc"} {
+		if len(api.objects[kind]) != 0 {
+			t.Errorf("%s not deleted: %v", kind, api.objects[kind])
+		}
+	}
+}
+
+func TestApplyFailsOnErrorStatus(t *testing.T) {
+	api := newFakeAPI()
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte(testEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan.pollInterval = time.Millisecond
+	plan.Actions = plan.Actions[:2]
+
+	// The fake returns the posted status, so the NSG is created in Error and
+	// the VPC referencing it must not be created.
+	plan.Actions[0].body = func() (map[string]interface{}, error) {
+		return map[string]interface{}{"name": "web", "status": "Error"}, nil
+	}
+	err = plan.Apply(io.Discard)
+	if err == nil || !strings.Contains(err.Error(), "in status Error") {
+		t.Errorf("expected error status to fail apply, got %v", err)
+	}
+	if len(api.objects["vpc"]) != 0 {
+		t.Errorf("vpc created despite failed NSG")
+	}
+}
+
+func TestPlanUpdatesAndPrunes(t *testing.T) {
+	api := newFakeAPI()
+	api.objects["vpc"] = []map[string]interface{}{
+		{"id": "vpc-a", "name": "prod", "siteId": "site-1", "description": "old"},
+		{"id": "vpc-b", "name": "stale", "siteId": "site-1"},
+	}
+	api.objects["subnet"] = []map[string]interface{}{
+		{"id": "subnet-b", "name": "stale-subnet", "siteId": "site-1", "vpcId": "vpc-b"},
+	}
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte("site: dev\nvpcs: [{name: prod, description: new}]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatalf("BuildPlan: %v", err)
+	}
+	if len(plan.Actions) != 1 || plan.Actions[0].Op != "update" || plan.Actions[0].Changes[0] != "description" {
+		t.Fatalf("expected one description update, got %+v", plan.Actions)
+	}
+	if plan.Unmanaged != 2 {
+		t.Errorf("Unmanaged = %d, want 2", plan.Unmanaged)
+	}
+
+	plan, err = BuildPlan(client, env, true)
+	if err != nil {
+		t.Fatalf("BuildPlan with prune: %v", err)
+	}
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	want := []string{"PATCH vpc/vpc-a", "DELETE subnet/subnet-b", "DELETE vpc/vpc-b"}
+	if strings.Join(api.calls, ",") != strings.Join(want, ",") {
+		t.Errorf("calls = %v, want %v", api.calls, want)
+	}
+}
+
+func TestPlanRejectsCreateOnlyChange(t *testing.T) {
+	api := newFakeAPI()
+	api.objects["vpc"] = []map[string]interface{}{{"id": "vpc-a", "name": "prod", "siteId": "site-1"}}
+	api.objects["subnet"] = []map[string]interface{}{
+		{"id": "subnet-a", "name": "frontend", "siteId": "site-1", "vpcId": "vpc-a", "ipv4BlockId": "block-1", "prefixLength": 26},
+	}
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte("site: dev\nsubnets: [{name: frontend, vpc: prod, ipv4Block: tenant-v4, prefixLength: 24}]"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = BuildPlan(client, env, false)
+	if err == nil || !strings.Contains(err.Error(), "prefixLength cannot be changed in place") {
+		t.Errorf("expected create-only error, got %v", err)
+	}
+}
+
+func TestExportEnvironmentRoundTrip(t *testing.T) {
+	api := newFakeAPI()
+	client := newFakeClient(t, api)
+	env, err := ParseEnvironment([]byte(testEnvironment))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := BuildPlan(client, env, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := plan.Apply(io.Discard); err != nil {
+		t.Fatal(err)
+	}
+
+	exported, err := ExportEnvironment(client, "dev")
+	if err != nil {
+		t.Fatalf("ExportEnvironment: %v", err)
+	}
+	data, err := MarshalEnvironment(exported)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reparsed, err := ParseEnvironment(data)
+	if err != nil {
+		t.Fatalf("exported document does not parse: %v\n%s", err, data)
+	}
+	if reparsed.VPCs[0].NetworkSecurityGroup != "web" || reparsed.Instances[0].Interfaces[0].Subnet != "frontend" {
+		t.Errorf("references not exported by name:\n%s", data)
+	}
+
+	plan, err = BuildPlan(client, reparsed, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("expected exported environment to match live state, got %+v", plan.Actions)
+	}
+}
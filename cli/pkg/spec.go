@@ -19,6 +19,8 @@ package carbidecli
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"gopkg.in/yaml.v3"
@@ -57,12 +59,13 @@ type PathItem struct {
 }
 
 type Operation struct {
-	OperationID string       `yaml:"operationId"`
-	Summary     string       `yaml:"summary"`
-	Description string       `yaml:"description"`
-	Tags        []string     `yaml:"tags"`
-	Parameters  []Parameter  `yaml:"parameters"`
-	RequestBody *RequestBody `yaml:"requestBody"`
+	OperationID string              `yaml:"operationId"`
+	Summary     string              `yaml:"summary"`
+	Description string              `yaml:"description"`
+	Tags        []string            `yaml:"tags"`
+	Parameters  []Parameter         `yaml:"parameters"`
+	RequestBody *RequestBody        `yaml:"requestBody"`
+	Responses   map[string]Response `yaml:"responses"`
 }
 
 type Parameter struct {
@@ -77,8 +80,18 @@ type RequestBody struct {
 	Content map[string]MediaType `yaml:"content"`
 }
 
+type Response struct {
+	Description string               `yaml:"description"`
+	Content     map[string]MediaType `yaml:"content"`
+}
+
 type MediaType struct {
-	Schema *Schema `yaml:"schema"`
+	Schema   *Schema            `yaml:"schema"`
+	Examples map[string]Example `yaml:"examples"`
+}
+
+type Example struct {
+	Value interface{} `yaml:"value"`
 }
 
 // SchemaType handles OpenAPI 3.1 type fields that can be a string or a list of strings.
@@ -115,6 +128,8 @@ type Schema struct {
 	Minimum    *int               `yaml:"minimum"`
 	Maximum    *int               `yaml:"maximum"`
 	Default    interface{}        `yaml:"default"`
+	Examples   []interface{}      `yaml:"examples"`
+	ReadOnly   bool               `yaml:"readOnly"`
 }
 
 type Components struct {
@@ -159,3 +174,24 @@ func (s *Spec) RequestBodySchema(op *Operation) *Schema {
 	}
 	return s.ResolveSchema(mt.Schema)
 }
+
+// SuccessResponse returns the first 2xx status code declared for an operation
+// and its response, or 200 and nil if none is declared.
+func (op *Operation) SuccessResponse() (int, *Response) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return 200, nil
+	}
+	sort.Strings(codes)
+	resp := op.Responses[codes[0]]
+	code, err := strconv.Atoi(codes[0])
+	if err != nil {
+		return 200, &resp
+	}
+	return code, &resp
+}
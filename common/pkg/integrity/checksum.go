@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package integrity verifies downloaded or packaged artifacts, such as firmware images, against
+// declared checksums and detached signatures before they are used.
+package integrity
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+const (
+	// ChecksumAlgorithmSHA256 is the SHA-256 checksum algorithm
+	ChecksumAlgorithmSHA256 = "sha256"
+	// ChecksumAlgorithmSHA512 is the SHA-512 checksum algorithm
+	ChecksumAlgorithmSHA512 = "sha512"
+
+	// ChecksumManifestFile is the conventional name of a manifest listing artifact checksums in `sha256sum` format
+	ChecksumManifestFile = "SHA256SUMS"
+)
+
+var (
+	// ErrMissingChecksum is returned when an artifact does not declare a checksum
+	ErrMissingChecksum = errors.New("no checksum declared")
+	// ErrInvalidChecksum is returned when a declared checksum cannot be parsed
+	ErrInvalidChecksum = errors.New("invalid checksum")
+	// ErrChecksumMismatch is returned when an artifact does not match its declared checksum
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+)
+
+// Checksum is a parsed "<algorithm>:<hex digest>" checksum, e.g. "sha256:9f86d0...".
+type Checksum struct {
+	Algorithm string
+	Digest    []byte
+}
+
+// ParseChecksum parses a checksum in "<algorithm>:<hex digest>" format.
+func ParseChecksum(s string) (Checksum, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Checksum{}, ErrMissingChecksum
+	}
+
+	algorithm, digestHex, ok := strings.Cut(s, ":")
+	if !ok {
+		return Checksum{}, fmt.Errorf("%w %q: expected <algorithm>:<hex digest>", ErrInvalidChecksum, s)
+	}
+
+	algorithm = strings.ToLower(algorithm)
+	size, err := digestSize(algorithm)
+	if err != nil {
+		return Checksum{}, err
+	}
+
+	digest, err := hex.DecodeString(digestHex)
+	if err != nil || len(digest) != size {
+		return Checksum{}, fmt.Errorf("%w %q: expected %d hex encoded bytes", ErrInvalidChecksum, s, size)
+	}
+
+	return Checksum{Algorithm: algorithm, Digest: digest}, nil
+}
+
+// ParseChecksumManifest parses a manifest in `sha256sum` output format, such as a SHA256SUMS file, into a
+// map of file name to "sha256:<hex digest>". Blank lines and lines starting with '#' are ignored.
+func ParseChecksumManifest(data []byte) (map[string]string, error) {
+	checksums := make(map[string]string)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed checksum manifest line: %q", line)
+		}
+
+		// sha256sum marks binary mode with a leading '*'
+		checksums[strings.TrimPrefix(fields[1], "*")] = ChecksumAlgorithmSHA256 + ":" + fields[0]
+	}
+
+	return checksums, scanner.Err()
+}
+
+// String returns the checksum in "<algorithm>:<hex digest>" format.
+func (c Checksum) String() string {
+	return c.Algorithm + ":" + hex.EncodeToString(c.Digest)
+}
+
+// newHash returns a new hash for the checksum's algorithm.
+func (c Checksum) newHash() hash.Hash {
+	if c.Algorithm == ChecksumAlgorithmSHA512 {
+		return sha512.New()
+	}
+	return sha256.New()
+}
+
+// matches compares the checksum against a computed digest.
+func (c Checksum) matches(digest []byte) error {
+	if !bytes.Equal(c.Digest, digest) {
+		return fmt.Errorf("%w: expected %s, got %s:%s", ErrChecksumMismatch, c, c.Algorithm, hex.EncodeToString(digest))
+	}
+	return nil
+}
+
+func digestSize(algorithm string) (int, error) {
+	switch algorithm {
+	case ChecksumAlgorithmSHA256:
+		return sha256.Size, nil
+	case ChecksumAlgorithmSHA512:
+		return sha512.Size, nil
+	default:
+		return 0, fmt.Errorf("%w: unsupported algorithm %q, must be %s or %s", ErrInvalidChecksum, algorithm, ChecksumAlgorithmSHA256, ChecksumAlgorithmSHA512)
+	}
+}
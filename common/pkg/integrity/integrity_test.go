@@ -0,0 +1,306 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integrity
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+)
+
+var testContent = []byte("firmware image contents")
+
+func sha256Checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func pemPublicKey(t *testing.T, pub crypto.PublicKey) []byte {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+// testMinisignKey builds a minisign key pair and signs content the way `minisign -S` does
+type testMinisignKey struct {
+	keyID []byte
+	pub   ed25519.PublicKey
+	priv  ed25519.PrivateKey
+}
+
+func newTestMinisignKey(t *testing.T) *testMinisignKey {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return &testMinisignKey{keyID: []byte{1, 2, 3, 4, 5, 6, 7, 8}, pub: pub, priv: priv}
+}
+
+func (k *testMinisignKey) publicKey() []byte {
+	raw := append([]byte(minisignAlgorithmLegacy), k.keyID...)
+	raw = append(raw, k.pub...)
+	return []byte("untrusted comment: minisign public key\n" + base64.StdEncoding.EncodeToString(raw) + "\n")
+}
+
+func (k *testMinisignKey) sign(content []byte, algorithm string, trustedComment string) []byte {
+	digest := blake2b.Sum512(content)
+	sig := ed25519.Sign(k.priv, digest[:])
+	if algorithm == minisignAlgorithmLegacy {
+		sig = ed25519.Sign(k.priv, content)
+	}
+	raw := append([]byte(algorithm), k.keyID...)
+	raw = append(raw, sig...)
+	globalSig := ed25519.Sign(k.priv, append(bytes.Clone(sig), trustedComment...))
+	return []byte(fmt.Sprintf("untrusted comment: signature from minisign secret key\n%s\ntrusted comment: %s\n%s\n",
+		base64.StdEncoding.EncodeToString(raw), trustedComment, base64.StdEncoding.EncodeToString(globalSig)))
+}
+
+func TestParseChecksum(t *testing.T) {
+	sum512 := sha512.Sum512(testContent)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr error
+	}{
+		{name: "sha256", input: sha256Checksum(testContent), want: sha256Checksum(testContent)},
+		{name: "sha512 upper case algorithm", input: "SHA512:" + hex.EncodeToString(sum512[:]), want: "sha512:" + hex.EncodeToString(sum512[:])},
+		{name: "empty", input: " ", wantErr: ErrMissingChecksum},
+		{name: "no algorithm", input: "abcdef", wantErr: ErrInvalidChecksum},
+		{name: "unsupported algorithm", input: "md5:d41d8cd98f00b204e9800998ecf8427e", wantErr: ErrInvalidChecksum},
+		{name: "short digest", input: "sha256:abcdef", wantErr: ErrInvalidChecksum},
+		{name: "not hex", input: "sha256:" + string(bytes.Repeat([]byte("z"), 64)), wantErr: ErrInvalidChecksum},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseChecksum(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ecPub, err := ParsePublicKey(pemPublicKey(t, &ecKey.PublicKey))
+	require.NoError(t, err)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	rsaPub, err := ParsePublicKey(pemPublicKey(t, &rsaKey.PublicKey))
+	require.NoError(t, err)
+
+	msKey := newTestMinisignKey(t)
+	msPub, err := ParsePublicKey(msKey.publicKey())
+	require.NoError(t, err)
+	assert.Equal(t, "0807060504030201", msPub.ID())
+
+	otherMsKey := newTestMinisignKey(t)
+	otherMsKey.keyID = []byte{8, 7, 6, 5, 4, 3, 2, 1}
+
+	digest := sha256.Sum256(testContent)
+	ecSig, err := ecdsa.SignASN1(rand.Reader, ecKey, digest[:])
+	require.NoError(t, err)
+	rsaSig, err := rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	msSig := msKey.sign(testContent, minisignAlgorithmHashed, "file:fw.bin")
+	tamperedComment := bytes.Replace(msSig, []byte("file:fw.bin"), []byte("file:xx.bin"), 1)
+
+	tests := []struct {
+		name             string
+		keys             []PublicKey
+		requireSignature bool
+		content          []byte
+		checksum         string
+		signature        []byte
+		wantErr          error
+	}{
+		{
+			name:     "checksum only",
+			content:  testContent,
+			checksum: sha256Checksum(testContent),
+		},
+		{
+			name:     "missing checksum",
+			content:  testContent,
+			checksum: "",
+			wantErr:  ErrMissingChecksum,
+		},
+		{
+			name:     "corrupted content",
+			content:  append(bytes.Clone(testContent), 0),
+			checksum: sha256Checksum(testContent),
+			wantErr:  ErrChecksumMismatch,
+		},
+		{
+			name:      "signature rejected without trusted keys",
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: []byte("garbage"),
+			wantErr:   ErrUntrustedSignature,
+		},
+		{
+			name:      "cosign ECDSA signature, base64 encoded",
+			keys:      []PublicKey{ecPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: []byte(base64.StdEncoding.EncodeToString(ecSig) + "\n"),
+		},
+		{
+			name:      "RSA signature, raw bytes, second key matches",
+			keys:      []PublicKey{ecPub, rsaPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: rsaSig,
+		},
+		{
+			name:      "signature from untrusted key",
+			keys:      []PublicKey{rsaPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: ecSig,
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "minisign signature",
+			keys:      []PublicKey{ecPub, msPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: msSig,
+		},
+		{
+			name:      "minisign signature with tampered trusted comment",
+			keys:      []PublicKey{msPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: tamperedComment,
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "minisign signature from another key",
+			keys:      []PublicKey{msPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: otherMsKey.sign(testContent, minisignAlgorithmHashed, "file:fw.bin"),
+			wantErr:   ErrSignatureMismatch,
+		},
+		{
+			name:      "legacy minisign signature",
+			keys:      []PublicKey{msPub},
+			content:   testContent,
+			checksum:  sha256Checksum(testContent),
+			signature: msKey.sign(testContent, minisignAlgorithmLegacy, "file:fw.bin"),
+			wantErr:   ErrInvalidSignature,
+		},
+		{
+			name:             "signature required but missing",
+			keys:             []PublicKey{ecPub},
+			requireSignature: true,
+			content:          testContent,
+			checksum:         sha256Checksum(testContent),
+			wantErr:          ErrMissingSignature,
+		},
+		{
+			name:             "signature optional and missing",
+			keys:             []PublicKey{ecPub},
+			requireSignature: false,
+			content:          testContent,
+			checksum:         sha256Checksum(testContent),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := NewVerifier(tt.keys, tt.requireSignature)
+			require.NoError(t, err)
+
+			err = v.Verify(bytes.NewReader(tt.content), tt.checksum, tt.signature)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestNewVerifier_RequireSignatureWithoutKeys(t *testing.T) {
+	_, err := NewVerifier(nil, true)
+	assert.Error(t, err)
+}
+
+func TestParsePublicKey_Invalid(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = ParsePublicKey(pemPublicKey(t, edPub))
+	assert.ErrorIs(t, err, ErrInvalidPublicKey)
+
+	_, err = ParsePublicKey([]byte("not a key"))
+	assert.ErrorIs(t, err, ErrInvalidPublicKey)
+}
+
+func TestVerifier_VerifyFile(t *testing.T) {
+	dir := t.TempDir()
+
+	msKey := newTestMinisignKey(t)
+	keyPath := filepath.Join(dir, "fw.pub")
+	require.NoError(t, os.WriteFile(keyPath, msKey.publicKey(), 0o600))
+
+	keys, err := LoadPublicKeys(keyPath)
+	require.NoError(t, err)
+
+	v, err := NewVerifier(keys, true)
+	require.NoError(t, err)
+
+	fwPath := filepath.Join(dir, "fw.bin")
+	require.NoError(t, os.WriteFile(fwPath, testContent, 0o600))
+	sigPath := fwPath + ".minisig"
+	require.NoError(t, os.WriteFile(sigPath, msKey.sign(testContent, minisignAlgorithmHashed, "file:fw.bin"), 0o600))
+
+	assert.NoError(t, v.VerifyFile(fwPath, sha256Checksum(testContent), sigPath))
+	assert.ErrorIs(t, v.VerifyFile(fwPath, sha256Checksum(testContent), ""), ErrMissingSignature)
+	assert.Error(t, v.VerifyFile(fwPath, sha256Checksum(testContent), filepath.Join(dir, "missing.minisig")))
+
+	_, err = LoadPublicKeys(filepath.Join(dir, "missing.pub"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,300 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integrity
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	minisignUntrustedCommentPrefix = "untrusted comment:"
+	minisignTrustedCommentPrefix   = "trusted comment: "
+
+	// minisignAlgorithmHashed marks signatures made over the BLAKE2b-512 digest of the content
+	minisignAlgorithmHashed = "ED"
+	// minisignAlgorithmLegacy marks signatures made over the content itself
+	minisignAlgorithmLegacy = "Ed"
+
+	minisignKeyIDSize     = 8
+	minisignPublicKeySize = 2 + minisignKeyIDSize + ed25519.PublicKeySize
+	minisignSignatureSize = 2 + minisignKeyIDSize + ed25519.SignatureSize
+)
+
+var (
+	// ErrMissingSignature is returned when signatures are required and an artifact has none
+	ErrMissingSignature = errors.New("no signature provided")
+	// ErrInvalidSignature is returned when a signature cannot be parsed
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrSignatureMismatch is returned when a signature does not verify against any trusted key
+	ErrSignatureMismatch = errors.New("signature does not match any trusted key")
+	// ErrUntrustedSignature is returned when an artifact is signed but no trusted keys are configured to check it
+	ErrUntrustedSignature = errors.New("signature provided but no trusted public keys are configured")
+	// ErrInvalidPublicKey is returned when a public key cannot be parsed
+	ErrInvalidPublicKey = errors.New("invalid public key")
+)
+
+// PublicKey is a trusted key used to verify detached signatures.
+//
+// Two key formats are supported:
+//   - PEM encoded PKIX ECDSA or RSA keys, as used by `cosign sign-blob`. Signatures are base64 or raw
+//     ASN.1 signatures over the SHA-256 digest of the content.
+//   - minisign public keys. Signatures are minisign signature files made with a prehashed (default) key.
+type PublicKey interface {
+	// ID returns a short identifier for the key
+	ID() string
+
+	verify(sig *signature, d *digests) error
+}
+
+// signature is a parsed detached signature.
+type signature struct {
+	// raw holds a cosign style signature
+	raw []byte
+
+	// minisign holds a minisign signature
+	minisign *minisignSignature
+}
+
+type minisignSignature struct {
+	algorithm      string
+	keyID          []byte
+	signature      []byte
+	trustedComment string
+	globalSig      []byte
+}
+
+// digests holds the digests of an artifact computed in a single pass.
+type digests struct {
+	sha256     []byte
+	blake2b512 []byte
+}
+
+// LoadPublicKey reads a trusted public key from a PEM or minisign public key file.
+func LoadPublicKey(path string) (PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key %s: %w", path, err)
+	}
+
+	key, err := ParsePublicKey(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return key, nil
+}
+
+// LoadPublicKeys reads trusted public keys from each of the given files.
+func LoadPublicKeys(paths ...string) ([]PublicKey, error) {
+	keys := make([]PublicKey, 0, len(paths))
+	for _, path := range paths {
+		key, err := LoadPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// ParsePublicKey parses a PEM encoded PKIX public key or a minisign public key.
+func ParsePublicKey(data []byte) (PublicKey, error) {
+	if block, _ := pem.Decode(data); block != nil {
+		return parsePEMPublicKey(block)
+	}
+	return parseMinisignPublicKey(data)
+}
+
+func parsePEMPublicKey(block *pem.Block) (PublicKey, error) {
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("%w: unexpected PEM block type %q", ErrInvalidPublicKey, block.Type)
+	}
+
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+
+	sum := sha256.Sum256(block.Bytes)
+	id := hex.EncodeToString(sum[:8])
+
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey, *rsa.PublicKey:
+		return &pkixPublicKey{id: id, key: pub}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported key type %T, must be ECDSA or RSA", ErrInvalidPublicKey, pub)
+	}
+}
+
+func parseMinisignPublicKey(data []byte) (PublicKey, error) {
+	var encoded string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, minisignUntrustedCommentPrefix) {
+			continue
+		}
+		encoded = line
+		break
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != minisignPublicKeySize {
+		return nil, fmt.Errorf("%w: expected PEM or minisign public key", ErrInvalidPublicKey)
+	}
+	if string(raw[:2]) != minisignAlgorithmLegacy {
+		return nil, fmt.Errorf("%w: unsupported minisign key algorithm %q", ErrInvalidPublicKey, raw[:2])
+	}
+
+	return &minisignPublicKey{
+		keyID: raw[2 : 2+minisignKeyIDSize],
+		key:   ed25519.PublicKey(raw[2+minisignKeyIDSize:]),
+	}, nil
+}
+
+// parseSignature parses a minisign signature file, or a base64 or raw encoded cosign style signature.
+func parseSignature(data []byte) (*signature, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		return nil, fmt.Errorf("%w: signature is empty", ErrInvalidSignature)
+	}
+
+	if bytes.HasPrefix(trimmed, []byte(minisignUntrustedCommentPrefix)) {
+		sig, err := parseMinisignSignature(string(trimmed))
+		if err != nil {
+			return nil, err
+		}
+		return &signature{minisign: sig}, nil
+	}
+
+	if raw, err := base64.StdEncoding.DecodeString(string(trimmed)); err == nil {
+		return &signature{raw: raw}, nil
+	}
+	return &signature{raw: data}, nil
+}
+
+func parseMinisignSignature(data string) (*minisignSignature, error) {
+	lines := strings.Split(data, "\n")
+	if len(lines) < 4 {
+		return nil, fmt.Errorf("%w: minisign signature must have 4 lines", ErrInvalidSignature)
+	}
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], "\r")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(lines[1])
+	if err != nil || len(raw) != minisignSignatureSize {
+		return nil, fmt.Errorf("%w: malformed minisign signature", ErrInvalidSignature)
+	}
+
+	if !strings.HasPrefix(lines[2], minisignTrustedCommentPrefix) {
+		return nil, fmt.Errorf("%w: minisign signature is missing trusted comment", ErrInvalidSignature)
+	}
+
+	globalSig, err := base64.StdEncoding.DecodeString(lines[3])
+	if err != nil || len(globalSig) != ed25519.SignatureSize {
+		return nil, fmt.Errorf("%w: malformed minisign global signature", ErrInvalidSignature)
+	}
+
+	return &minisignSignature{
+		algorithm:      string(raw[:2]),
+		keyID:          raw[2 : 2+minisignKeyIDSize],
+		signature:      raw[2+minisignKeyIDSize:],
+		trustedComment: strings.TrimPrefix(lines[2], minisignTrustedCommentPrefix),
+		globalSig:      globalSig,
+	}, nil
+}
+
+// pkixPublicKey verifies cosign style signatures over the SHA-256 digest of the content.
+type pkixPublicKey struct {
+	id  string
+	key crypto.PublicKey
+}
+
+func (k *pkixPublicKey) ID() string {
+	return k.id
+}
+
+func (k *pkixPublicKey) verify(sig *signature, d *digests) error {
+	if sig.raw == nil {
+		return ErrSignatureMismatch
+	}
+
+	switch key := k.key.(type) {
+	case *ecdsa.PublicKey:
+		if ecdsa.VerifyASN1(key, d.sha256, sig.raw) {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if rsa.VerifyPKCS1v15(key, crypto.SHA256, d.sha256, sig.raw) == nil {
+			return nil
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+// minisignPublicKey verifies minisign signatures.
+type minisignPublicKey struct {
+	keyID []byte
+	key   ed25519.PublicKey
+}
+
+func (k *minisignPublicKey) ID() string {
+	return strings.ToUpper(hex.EncodeToString(reverse(k.keyID)))
+}
+
+func (k *minisignPublicKey) verify(sig *signature, d *digests) error {
+	ms := sig.minisign
+	if ms == nil || !bytes.Equal(ms.keyID, k.keyID) {
+		return ErrSignatureMismatch
+	}
+
+	if ms.algorithm != minisignAlgorithmHashed {
+		return fmt.Errorf("%w: unsupported minisign algorithm %q, sign with a prehashed key", ErrInvalidSignature, ms.algorithm)
+	}
+
+	if !ed25519.Verify(k.key, d.blake2b512, ms.signature) {
+		return ErrSignatureMismatch
+	}
+
+	// The global signature covers the trusted comment, which must not be tampered with either
+	if !ed25519.Verify(k.key, append(bytes.Clone(ms.signature), ms.trustedComment...), ms.globalSig) {
+		return fmt.Errorf("%w: trusted comment signature does not verify", ErrSignatureMismatch)
+	}
+	return nil
+}
+
+// reverse returns a reversed copy of b; minisign displays little-endian key IDs most significant byte first.
+func reverse(b []byte) []byte {
+	r := make([]byte, len(b))
+	for i := range b {
+		r[len(b)-1-i] = b[i]
+	}
+	return r
+}
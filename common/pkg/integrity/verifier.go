@@ -0,0 +1,135 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integrity
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Verifier checks artifacts against their declared checksum and, when trusted keys are configured, a
+// detached signature. Every artifact must declare a checksum.
+type Verifier struct {
+	keys             []PublicKey
+	requireSignature bool
+}
+
+// NewVerifier creates a Verifier trusting the given keys. If requireSignature is set, artifacts without a
+// signature are rejected, so at least one key must be given.
+func NewVerifier(keys []PublicKey, requireSignature bool) (*Verifier, error) {
+	if requireSignature && len(keys) == 0 {
+		return nil, errors.New("signatures are required but no trusted public keys are configured")
+	}
+	return &Verifier{keys: keys, requireSignature: requireSignature}, nil
+}
+
+// NewChecksumVerifier creates a Verifier that only checks checksums.
+func NewChecksumVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// RequiresSignature returns true if artifacts must be signed.
+func (v *Verifier) RequiresSignature() bool {
+	return v.requireSignature
+}
+
+// Verify reads content and checks it against the checksum and optional detached signature.
+// A signature can only be checked when trusted keys are configured, so signed content is rejected
+// rather than silently accepted as verified when there are none.
+func (v *Verifier) Verify(content io.Reader, checksum string, sigData []byte) error {
+	sum, err := ParseChecksum(checksum)
+	if err != nil {
+		return err
+	}
+
+	var sig *signature
+	if len(sigData) > 0 {
+		if len(v.keys) == 0 {
+			return ErrUntrustedSignature
+		}
+		if sig, err = parseSignature(sigData); err != nil {
+			return err
+		}
+	} else if v.requireSignature {
+		return ErrMissingSignature
+	}
+
+	// Compute every digest that is needed in a single pass over the content
+	checksumHash := sum.newHash()
+	writers := []io.Writer{checksumHash}
+
+	sha256Hash := sha256.New()
+	if sig != nil && sig.raw != nil {
+		writers = append(writers, sha256Hash)
+	}
+
+	blake2bHash, _ := blake2b.New512(nil)
+	if sig != nil && sig.minisign != nil {
+		writers = append(writers, blake2bHash)
+	}
+
+	if _, err := io.Copy(io.MultiWriter(writers...), content); err != nil {
+		return fmt.Errorf("failed to read content: %w", err)
+	}
+
+	if err := sum.matches(checksumHash.Sum(nil)); err != nil {
+		return err
+	}
+
+	if sig == nil {
+		return nil
+	}
+
+	d := &digests{sha256: sha256Hash.Sum(nil), blake2b512: blake2bHash.Sum(nil)}
+	for _, key := range v.keys {
+		err = key.verify(sig, d)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrSignatureMismatch) {
+			return err
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+// VerifyFile checks the file at path against the checksum and, if signaturePath is set, the detached
+// signature stored in that file.
+func (v *Verifier) VerifyFile(path string, checksum string, signaturePath string) error {
+	var sigData []byte
+	if signaturePath != "" {
+		data, err := os.ReadFile(signaturePath)
+		if err != nil {
+			return fmt.Errorf("failed to read signature: %w", err)
+		}
+		sigData = data
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return v.Verify(f, checksum, sigData)
+}
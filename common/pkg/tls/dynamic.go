@@ -107,8 +107,9 @@ func (d *DynTLSCfg) WithTLSCfg(cfg *tls.Config) *DynTLSCfg {
 	return d
 }
 
-// ClientCfg returns tls config that can be used for a tls client
-// CA cannot be refreshed for clients. Instead a warning is logged.
+// ClientCfg returns tls config that can be used for a tls client. The server
+// certificate is verified against the CA as currently on disk, so that a CA
+// bundle published during CA rotation is picked up without a restart.
 func (d *DynTLSCfg) ClientCfg() *tls.Config {
 	d.Lock()
 	defer d.Unlock()
@@ -116,6 +117,21 @@ func (d *DynTLSCfg) ClientCfg() *tls.Config {
 
 	d.tlsCfg.RootCAs = d.caCertPool
 	d.tlsCfg.Certificates = []tls.Certificate(nil)
+	if !d.tlsCfg.InsecureSkipVerify {
+		// RootCAs is only consulted once per config, so take over
+		// verification to use the refreshed pool
+		d.tlsCfg.InsecureSkipVerify = true
+		verify := d.tlsCfg.VerifyConnection
+		d.tlsCfg.VerifyConnection = func(cs tls.ConnectionState) error {
+			if err := d.verifyServer(cs); err != nil {
+				return err
+			}
+			if verify != nil {
+				return verify(cs)
+			}
+			return nil
+		}
+	}
 	d.tlsCfg.GetClientCertificate = func(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
 		d.Lock()
 		defer d.Unlock()
@@ -146,6 +162,7 @@ func (d *DynTLSCfg) ServerCfg() *tls.Config {
 			d.cachedCfg = d.tlsCfg.Clone()
 			d.cachedCfg.Certificates = []tls.Certificate{*d.cachedCert}
 			d.cachedCfg.RootCAs = d.caCertPool
+			d.cachedCfg.ClientCAs = d.caCertPool
 			d.cacheUpdated = false
 		}
 
@@ -155,6 +172,36 @@ func (d *DynTLSCfg) ServerCfg() *tls.Config {
 	return d.tlsCfg
 }
 
+// verifyServer verifies the server certificate chain and name against the
+// current CA pool, as crypto/tls does when InsecureSkipVerify is false. The
+// name check is skipped by x509 for an empty name, so a connection without
+// a server name is rejected rather than accepting any certificate the CA
+// issued.
+func (d *DynTLSCfg) verifyServer(cs tls.ConnectionState) error {
+	if len(cs.PeerCertificates) == 0 {
+		return fmt.Errorf("tls: server presented no certificates")
+	}
+	if cs.ServerName == "" {
+		return fmt.Errorf("tls: server name is required to verify the server certificate")
+	}
+
+	d.Lock()
+	roots := d.caCertPool
+	d.Unlock()
+
+	opts := x509.VerifyOptions{
+		Roots:         roots,
+		DNSName:       cs.ServerName,
+		Intermediates: x509.NewCertPool(),
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
+
 func (d *DynTLSCfg) pollCerts() {
 	for {
 		select {
@@ -179,17 +226,20 @@ func (d *DynTLSCfg) refresh() {
 	}
 
 	if !reflect.DeepEqual(caCert, d.cachedCa) {
-		if d.isClient {
-			// for client config, we don't have a way to update CA
-			// just log a warning
-			d.logger.Warn("CA has changed, clients will likely not work without restart")
+		caCertPool := x509.NewCertPool()
+		if !caCertPool.AppendCertsFromPEM(caCert) {
+			// keep trusting the previous CA rather than none, the file
+			// may be in the middle of being rewritten
+			d.logger.Errorf("No CA certificates found in %s, keeping previous CA", d.cacertPath)
 		} else {
-			caCertPool := x509.NewCertPool()
-			caCertPool.AppendCertsFromPEM(caCert)
 			d.caCertPool = caCertPool
 			d.cachedCa = caCert
 			d.cacheUpdated = true
-			d.logger.Info("Updated server CA certificate")
+			if d.isClient {
+				d.logger.Info("Updated client CA certificate")
+			} else {
+				d.logger.Info("Updated server CA certificate")
+			}
 		}
 	}
 
@@ -18,8 +18,15 @@
 package tls
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -250,3 +257,129 @@ func TestDynTLSCfg(t *testing.T) {
 	caCertPool.AppendCertsFromPEM([]byte(test2Cert))
 	assert.True(t, caCertPool.Equal(sCfg.RootCAs))
 }
+
+// genCert returns a PEM certificate and key signed by parent, self-signed
+// when parent is nil
+func genCert(t *testing.T, cn string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) ([]byte, []byte, *x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if isCA {
+		tmpl.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		tmpl.DNSNames = []string{cn}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert, key
+}
+
+func handshake(clientCfg, serverCfg *tls.Config) error {
+	c, s := net.Pipe()
+	defer c.Close()
+	defer s.Close()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- tls.Server(s, serverCfg).Handshake()
+	}()
+
+	err := tls.Client(c, clientCfg).Handshake()
+	// with TLS 1.3 the client is done before the server has verified the
+	// client certificate, unblock the server if it still sends an alert
+	c.Close()
+	serverErr := <-errCh
+	if err != nil {
+		return err
+	}
+	return serverErr
+}
+
+func TestDynTLSCfgCARotation(t *testing.T) {
+	refreshPeriod = testRefreshPeriod
+	dir := t.TempDir()
+
+	oldCAPEM, _, oldCA, oldCAKey := genCert(t, "old-ca", true, nil, nil)
+	newCAPEM, _, newCA, newCAKey := genCert(t, "new-ca", true, nil, nil)
+	oldLeaf, oldLeafKey, _, _ := genCert(t, "server.local", false, oldCA, oldCAKey)
+	newLeaf, newLeafKey, _, _ := genCert(t, "server.local", false, newCA, newCAKey)
+
+	write := func(name string, data ...[]byte) string {
+		path := filepath.Join(dir, name)
+		var b []byte
+		for _, d := range data {
+			b = append(b, d...)
+		}
+		require.NoError(t, os.WriteFile(path, b, 0600))
+		return path
+	}
+
+	clientKey := write("client.key", oldLeafKey)
+	clientCert := write("client.crt", oldLeaf)
+	clientCA := write("client-ca.pem", oldCAPEM)
+	client, err := NewDynTLSCfg(clientKey, clientCert, clientCA)
+	require.NoError(t, err)
+	defer client.Close()
+	clientCfg := client.WithTLSCfg(&tls.Config{ServerName: "server.local"}).ClientCfg()
+
+	serverKey := write("server.key", newLeafKey)
+	serverCert := write("server.crt", newLeaf)
+	serverCA := write("server-ca.pem", oldCAPEM, newCAPEM)
+	server, err := NewDynTLSCfg(serverKey, serverCert, serverCA)
+	require.NoError(t, err)
+	defer server.Close()
+	serverCfg := server.WithTLSCfg(&tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		// tickets are written after the handshake, which would block on the pipe
+		SessionTicketsDisabled: true,
+	}).ServerCfg()
+
+	// the client does not trust the new CA yet
+	assert.Error(t, handshake(clientCfg, serverCfg))
+
+	// publish the bundle to the client
+	write("client-ca.pem", newCAPEM, oldCAPEM)
+	time.Sleep(100 * time.Millisecond)
+	assert.NoError(t, handshake(clientCfg, serverCfg))
+
+	// the server name is still verified
+	otherName := clientCfg.Clone()
+	otherName.ServerName = "other.local"
+	assert.Error(t, handshake(otherName, serverCfg))
+
+	// a missing server name is not a wildcard
+	noName := clientCfg.Clone()
+	noName.ServerName = ""
+	assert.Error(t, handshake(noName, serverCfg))
+
+	// retire the old CA on the server, the old client cert is rejected
+	write("server-ca.pem", newCAPEM)
+	time.Sleep(100 * time.Millisecond)
+	assert.Error(t, handshake(clientCfg, serverCfg))
+}
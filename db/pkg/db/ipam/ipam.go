@@ -26,6 +26,7 @@ import (
 
 	cipam "github.com/nvidia/bare-metal-manager-rest/ipam"
 	"github.com/uptrace/bun"
+	"go4.org/netipx"
 
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
@@ -141,10 +142,122 @@ func GetIpamUsageForIPBlock(ctx context.Context, ipamDB cipam.Storage, ipBlock *
 			AvailableSmallestPrefixes: ipamPrefix.Usage().AvailableSmallestPrefixes,
 			AvailablePrefixes:         ipamPrefix.Usage().AvailablePrefixes,
 			AcquiredPrefixes:          ipamPrefix.Usage().AcquiredPrefixes,
+			ReservedIPs:               ipamPrefix.Usage().ReservedIPs,
 		}, nil
 	}
 }
 
+// GetIpamPrefixForIPBlock will get the ipam prefix for the IPBlock
+func GetIpamPrefixForIPBlock(ctx context.Context, ipamDB cipam.Storage, ipBlock *cdbm.IPBlock) (*cipam.Prefix, error) {
+	if ipBlock == nil {
+		return nil, ErrNilIPBlock
+	}
+	return GetIpamPrefixForCidr(ctx, ipamDB, ipBlock, GetCidrForIPBlock(ctx, ipBlock.Prefix, ipBlock.PrefixLength))
+}
+
+// GetIpamPrefixForCidr will get the ipam prefix for a cidr in the namespace of the given IPBlock
+// this is used to retrieve child prefixes of an IPBlock, e.g. VPC prefixes
+func GetIpamPrefixForCidr(ctx context.Context, ipamDB cipam.Storage, ipBlock *cdbm.IPBlock, cidr string) (*cipam.Prefix, error) {
+	if ipBlock == nil {
+		return nil, ErrNilIPBlock
+	}
+	ipamer := cipam.NewWithStorage(ipamDB)
+	namespace := GetIpamNamespaceForIPBlock(ctx, ipBlock.RoutingType, ipBlock.InfrastructureProviderID.String(), ipBlock.SiteID.String())
+	ipamer.SetNamespace(namespace)
+	ipamPrefix := ipamer.PrefixFrom(ctx, cidr)
+	if ipamPrefix == nil {
+		return nil, ErrPrefixDoesNotExistForIPBlock
+	}
+	return ipamPrefix, nil
+}
+
+// GetIpamPrefixKeyForCidr will return the key of the ipam prefix for a cidr in the namespace of the given IPBlock
+func GetIpamPrefixKeyForCidr(ctx context.Context, ipBlock *cdbm.IPBlock, cidr string) cipam.PrefixKey {
+	if ipPref, err := netip.ParsePrefix(cidr); err == nil {
+		cidr = ipPref.Masked().String()
+	}
+	return cipam.PrefixKey{
+		Cidr:      cidr,
+		Namespace: GetIpamNamespaceForIPBlock(ctx, ipBlock.RoutingType, ipBlock.InfrastructureProviderID.String(), ipBlock.SiteID.String()),
+	}
+}
+
+// GetIpamPrefixes will get the ipam prefixes for the given keys
+// this is used to populate a page of API objects with a single ipam query
+// keys without an ipam entry are omitted from the result
+func GetIpamPrefixes(ctx context.Context, ipamDB cipam.Storage, keys []cipam.PrefixKey) (map[cipam.PrefixKey]*cipam.Prefix, error) {
+	prefixes := map[cipam.PrefixKey]*cipam.Prefix{}
+
+	bunDB, ok := ipamDB.(*cipam.Bundb)
+	if !ok {
+		// other storages can only read prefixes one at a time
+		for _, key := range keys {
+			prefix, err := ipamDB.ReadPrefix(ctx, key.Cidr, key.Namespace)
+			if err == nil {
+				prefixes[key] = &prefix
+			}
+		}
+		return prefixes, nil
+	}
+
+	read, err := bunDB.ReadPrefixes(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	for key := range read {
+		prefix := read[key]
+		prefixes[key] = &prefix
+	}
+	return prefixes, nil
+}
+
+// UpdateIpamReservedRangesForCidr will reconcile the reserved ranges of the ipam prefix for a cidr in the namespace of the given IPBlock
+// reserved ranges which are not in the given list are released, new ranges are reserved
+func UpdateIpamReservedRangesForCidr(ctx context.Context, ipamDB cipam.Storage, ipBlock *cdbm.IPBlock, cidr string, reservedRanges []netipx.IPRange) (*cipam.Prefix, error) {
+	if ipBlock == nil {
+		return nil, ErrNilIPBlock
+	}
+	ipamer := cipam.NewWithStorage(ipamDB)
+	namespace := GetIpamNamespaceForIPBlock(ctx, ipBlock.RoutingType, ipBlock.InfrastructureProviderID.String(), ipBlock.SiteID.String())
+	ipamer.SetNamespace(namespace)
+	ipamPrefix := ipamer.PrefixFrom(ctx, cidr)
+	if ipamPrefix == nil {
+		return nil, ErrPrefixDoesNotExistForIPBlock
+	}
+
+	desired := map[string]bool{}
+	for _, r := range reservedRanges {
+		desired[r.String()] = true
+	}
+	existing := map[string]bool{}
+	var err error
+	for _, rr := range ipamPrefix.ReservedRanges() {
+		existing[rr] = true
+		if desired[rr] {
+			continue
+		}
+		r, perr := netipx.ParseIPRange(rr)
+		if perr != nil {
+			return nil, perr
+		}
+		ipamPrefix, err = ipamer.ReleaseRange(ctx, cidr, r.From().String(), r.To().String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range reservedRanges {
+		if existing[r.String()] {
+			continue
+		}
+		ipamPrefix, err = ipamer.ReserveRange(ctx, cidr, r.From().String(), r.To().String())
+		if err != nil {
+			return nil, err
+		}
+		existing[r.String()] = true
+	}
+	return ipamPrefix, nil
+}
+
 // CreateChildIpamEntryForIPBlock will create an child ipam entry in the ipam DB for the given parent IP Block, with a given child block size
 // Note: FullGrant is a special case when the childBlockSize matches the parentIPBlock, and the parentIPBlock has no
 // child prefixes, then, the parentIPBlock is updated as a full grant in db, and its prefix is
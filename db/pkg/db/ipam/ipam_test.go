@@ -30,6 +30,7 @@ import (
 	cipam "github.com/nvidia/bare-metal-manager-rest/ipam"
 	"github.com/stretchr/testify/assert"
 	"github.com/uptrace/bun/extra/bundebug"
+	"go4.org/netipx"
 )
 
 // ~~~~~ For Testing IPAM ~~~~~ //
@@ -372,6 +373,143 @@ func TestGetIpamUsageForIPBlock(t *testing.T) {
 	}
 }
 
+func TestUpdateIpamReservedRangesForCidr(t *testing.T) {
+	dbSession := cdbutil.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	ipamDB := getTestIpamDB(t, dbSession, true)
+	ctx := context.Background()
+
+	testIpamSetupSchema(t, dbSession)
+
+	ip := testIpamBuildInfrastructureProvider(t, dbSession, "testip")
+	site := testIpamBuildSite(t, dbSession, ip, "testsite")
+
+	ipBlock := &cdbm.IPBlock{
+		ID:                       uuid.New(),
+		RoutingType:              cdbm.IPBlockRoutingTypeDatacenterOnly,
+		InfrastructureProviderID: ip.ID,
+		SiteID:                   site.ID,
+		Prefix:                   "192.168.0.0",
+		PrefixLength:             24,
+		ProtocolVersion:          cdbm.IPBlockProtocolVersionV4,
+	}
+	cidr := GetCidrForIPBlock(ctx, ipBlock.Prefix, ipBlock.PrefixLength)
+
+	_, err := CreateIpamEntryForIPBlock(ctx, ipamDB, ipBlock.Prefix, ipBlock.PrefixLength, ipBlock.RoutingType, ip.ID.String(), site.ID.String())
+	assert.Nil(t, err)
+
+	gateway := netipx.MustParseIPRange("192.168.0.1-192.168.0.1")
+	vips := netipx.MustParseIPRange("192.168.0.10-192.168.0.19")
+	infra := netipx.MustParseIPRange("192.168.0.250-192.168.0.254")
+
+	tests := []struct {
+		name           string
+		inputIPB       *cdbm.IPBlock
+		inputCidr      string
+		inputRanges    []netipx.IPRange
+		expectedRanges []string
+		expectedErr    bool
+	}{
+		{
+			name:        "error nil IPBlock",
+			inputIPB:    nil,
+			inputCidr:   cidr,
+			expectedErr: true,
+		},
+		{
+			name:        "error no prefix found for cidr",
+			inputIPB:    ipBlock,
+			inputCidr:   "192.170.0.0/24",
+			expectedErr: true,
+		},
+		{
+			name:           "success reserve ranges",
+			inputIPB:       ipBlock,
+			inputCidr:      cidr,
+			inputRanges:    []netipx.IPRange{vips, gateway},
+			expectedRanges: []string{gateway.String(), vips.String()},
+		},
+		{
+			name:           "success replace ranges",
+			inputIPB:       ipBlock,
+			inputCidr:      cidr,
+			inputRanges:    []netipx.IPRange{gateway, infra},
+			expectedRanges: []string{gateway.String(), infra.String()},
+		},
+		{
+			name:        "error overlapping ranges",
+			inputIPB:    ipBlock,
+			inputCidr:   cidr,
+			inputRanges: []netipx.IPRange{gateway, infra, netipx.MustParseIPRange("192.168.0.200-192.168.0.250")},
+			expectedErr: true,
+		},
+		{
+			name:           "success clear ranges",
+			inputIPB:       ipBlock,
+			inputCidr:      cidr,
+			inputRanges:    []netipx.IPRange{},
+			expectedRanges: []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prefix, err := UpdateIpamReservedRangesForCidr(ctx, ipamDB, tc.inputIPB, tc.inputCidr, tc.inputRanges)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if !tc.expectedErr {
+				assert.ElementsMatch(t, tc.expectedRanges, prefix.ReservedRanges())
+
+				prefix, err = GetIpamPrefixForIPBlock(ctx, ipamDB, tc.inputIPB)
+				assert.Nil(t, err)
+				assert.ElementsMatch(t, tc.expectedRanges, prefix.ReservedRanges())
+			}
+		})
+	}
+}
+
+func TestGetIpamPrefixes(t *testing.T) {
+	dbSession := cdbutil.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	ipamDB := getTestIpamDB(t, dbSession, true)
+	ctx := context.Background()
+
+	testIpamSetupSchema(t, dbSession)
+
+	ip := testIpamBuildInfrastructureProvider(t, dbSession, "testip")
+	site1 := testIpamBuildSite(t, dbSession, ip, "testsite1")
+	site2 := testIpamBuildSite(t, dbSession, ip, "testsite2")
+
+	// the same prefix at two sites is kept apart by the ipam namespace
+	ipBlock1 := &cdbm.IPBlock{ID: uuid.New(), RoutingType: cdbm.IPBlockRoutingTypeDatacenterOnly, InfrastructureProviderID: ip.ID, SiteID: site1.ID, Prefix: "192.168.0.0", PrefixLength: 24}
+	ipBlock2 := &cdbm.IPBlock{ID: uuid.New(), RoutingType: cdbm.IPBlockRoutingTypeDatacenterOnly, InfrastructureProviderID: ip.ID, SiteID: site2.ID, Prefix: "192.168.0.0", PrefixLength: 24}
+	ipBlock3 := &cdbm.IPBlock{ID: uuid.New(), RoutingType: cdbm.IPBlockRoutingTypeDatacenterOnly, InfrastructureProviderID: ip.ID, SiteID: site2.ID, Prefix: "10.0.0.0", PrefixLength: 16}
+
+	for _, ipb := range []*cdbm.IPBlock{ipBlock1, ipBlock2} {
+		_, err := CreateIpamEntryForIPBlock(ctx, ipamDB, ipb.Prefix, ipb.PrefixLength, ipb.RoutingType, ip.ID.String(), ipb.SiteID.String())
+		assert.Nil(t, err)
+	}
+	vips := netipx.MustParseIPRange("192.168.0.10-192.168.0.19")
+	_, err := UpdateIpamReservedRangesForCidr(ctx, ipamDB, ipBlock2, GetCidrForIPBlock(ctx, ipBlock2.Prefix, ipBlock2.PrefixLength), []netipx.IPRange{vips})
+	assert.Nil(t, err)
+
+	key1 := GetIpamPrefixKeyForCidr(ctx, ipBlock1, "192.168.0.0/24")
+	key2 := GetIpamPrefixKeyForCidr(ctx, ipBlock2, "192.168.0.5/24")
+	key3 := GetIpamPrefixKeyForCidr(ctx, ipBlock3, "10.0.0.0/16")
+	assert.Equal(t, "192.168.0.0/24", key2.Cidr)
+
+	prefixes, err := GetIpamPrefixes(ctx, ipamDB, []cipam.PrefixKey{key1, key2, key3})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(prefixes))
+	assert.Empty(t, prefixes[key1].ReservedRanges())
+	assert.Equal(t, []string{vips.String()}, prefixes[key2].ReservedRanges())
+	assert.Nil(t, prefixes[key3])
+
+	prefixes, err = GetIpamPrefixes(ctx, ipamDB, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(prefixes))
+}
+
 func TestCreateChildIpamEntryForIPBlock(t *testing.T) {
 	dbSession := cdbutil.GetTestDBSession(t, false)
 	defer dbSession.Close()
@@ -0,0 +1,336 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// AddressSetOrderByDefault default field to be used for ordering when none specified
+	AddressSetOrderByDefault = "created"
+)
+
+var (
+	// AddressSetOrderByFields is a list of valid order by fields for the AddressSet model
+	AddressSetOrderByFields = []string{"name", "created", "updated"}
+	// AddressSetRelatedEntities is a list of valid relation by fields for the AddressSet model
+	AddressSetRelatedEntities = map[string]bool{
+		TenantRelationName: true,
+	}
+)
+
+// AddressSet is a named list of prefixes owned by a Tenant.
+// Network Security Group rules can reference an AddressSet instead of a literal prefix.
+type AddressSet struct {
+	bun.BaseModel `bun:"table:address_set,alias:ads"`
+
+	ID          uuid.UUID  `bun:"type:uuid,pk"`
+	Name        string     `bun:"name,notnull"`
+	Description *string    `bun:"description"`
+	TenantOrg   string     `bun:"tenant_org,notnull"`
+	TenantID    uuid.UUID  `bun:"tenant_id,type:uuid,notnull"`
+	Tenant      *Tenant    `bun:"rel:belongs-to,join:tenant_id=id"`
+	Prefixes    []string   `bun:"prefixes,notnull,array"`
+	Created     time.Time  `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated     time.Time  `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted     *time.Time `bun:"deleted,soft_delete"`
+	CreatedBy   uuid.UUID  `bun:"created_by,type:uuid,notnull"`
+	UpdatedBy   uuid.UUID  `bun:"updated_by,type:uuid,notnull"`
+}
+
+// AddressSetCreateInput input parameters for Create method
+type AddressSetCreateInput struct {
+	AddressSetID *uuid.UUID
+	Name         string
+	Description  *string
+	TenantOrg    string
+	TenantID     uuid.UUID
+	Prefixes     []string
+	CreatedBy    uuid.UUID
+}
+
+// AddressSetUpdateInput input parameters for Update method
+type AddressSetUpdateInput struct {
+	AddressSetID uuid.UUID
+	Name         *string
+	Description  *string
+	Prefixes     []string
+	UpdatedBy    uuid.UUID
+}
+
+// AddressSetFilterInput input parameters for GetAll method
+type AddressSetFilterInput struct {
+	AddressSetIDs []uuid.UUID
+	Names         []string
+	TenantIDs     []uuid.UUID
+	SearchQuery   *string
+}
+
+var _ bun.BeforeAppendModelHook = (*AddressSet)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (ads *AddressSet) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		ads.Created = db.GetCurTime()
+		ads.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		ads.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*AddressSet)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (ads *AddressSet) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("tenant_id") REFERENCES "tenant" ("id")`)
+	return nil
+}
+
+// AddressSetDAO is an interface for interacting with the AddressSet model
+type AddressSetDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input AddressSetCreateInput) (*AddressSet, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*AddressSet, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter AddressSetFilterInput, page paginator.PageInput, includeRelations []string) ([]AddressSet, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input AddressSetUpdateInput) (*AddressSet, error)
+	//
+	Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error
+}
+
+// AddressSetSQLDAO is an implementation of the AddressSetDAO interface
+type AddressSetSQLDAO struct {
+	dbSession *db.Session
+	AddressSetDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new AddressSet from the given parameters
+func (adssd AddressSetSQLDAO) Create(ctx context.Context, tx *db.Tx, input AddressSetCreateInput) (*AddressSet, error) {
+	// Create a child span and set the attributes for current request
+	ctx, adsDAOSpan := adssd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressSetDAO.Create")
+	if adsDAOSpan != nil {
+		defer adsDAOSpan.End()
+
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "name", input.Name)
+	}
+
+	id := uuid.New()
+	if input.AddressSetID != nil {
+		id = *input.AddressSetID
+	}
+
+	prefixes := input.Prefixes
+	if prefixes == nil {
+		prefixes = []string{}
+	}
+
+	ads := &AddressSet{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		TenantOrg:   input.TenantOrg,
+		TenantID:    input.TenantID,
+		Prefixes:    prefixes,
+		CreatedBy:   input.CreatedBy,
+		UpdatedBy:   input.CreatedBy,
+	}
+
+	_, err := db.GetIDB(tx, adssd.dbSession).NewInsert().Model(ads).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return adssd.GetByID(ctx, tx, ads.ID, nil)
+}
+
+// GetByID returns an AddressSet by ID
+// returns db.ErrDoesNotExist error if the record is not found
+func (adssd AddressSetSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*AddressSet, error) {
+	// Create a child span and set the attributes for current request
+	ctx, adsDAOSpan := adssd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressSetDAO.GetByID")
+	if adsDAOSpan != nil {
+		defer adsDAOSpan.End()
+
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "id", id.String())
+	}
+
+	ads := &AddressSet{}
+
+	query := db.GetIDB(tx, adssd.dbSession).NewSelect().Model(ads).Where("ads.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return ads, nil
+}
+
+// GetAll returns all AddressSets with various optional filters
+// errors are returned only when there is a db related error
+// if records not found, then error is nil, but length of returned slice is 0
+// if orderBy is nil, then records are ordered by column specified in AddressSetOrderByDefault in ascending order
+func (adssd AddressSetSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter AddressSetFilterInput, page paginator.PageInput, includeRelations []string) ([]AddressSet, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, adsDAOSpan := adssd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressSetDAO.GetAll")
+	if adsDAOSpan != nil {
+		defer adsDAOSpan.End()
+	}
+
+	adss := []AddressSet{}
+
+	query := db.GetIDB(tx, adssd.dbSession).NewSelect().Model(&adss)
+
+	if filter.AddressSetIDs != nil {
+		query = query.Where("ads.id IN (?)", bun.In(filter.AddressSetIDs))
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "id", filter.AddressSetIDs)
+	}
+	if filter.Names != nil {
+		query = query.Where("ads.name IN (?)", bun.In(filter.Names))
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "name", filter.Names)
+	}
+	if filter.TenantIDs != nil {
+		query = query.Where("ads.tenant_id IN (?)", bun.In(filter.TenantIDs))
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "tenant_id", filter.TenantIDs)
+	}
+	if filter.SearchQuery != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("ads.name ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("ads.description ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("array_to_string(ads.prefixes, ' ') ILIKE ?", "%"+*filter.SearchQuery+"%")
+		})
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "search_query", *filter.SearchQuery)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(AddressSetOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, AddressSetOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return adss, paginator.Total, nil
+}
+
+// Update updates specified fields of an existing AddressSet
+// The updated fields are assumed to be set to non-null values
+func (adssd AddressSetSQLDAO) Update(ctx context.Context, tx *db.Tx, input AddressSetUpdateInput) (*AddressSet, error) {
+	// Create a child span and set the attributes for current request
+	ctx, adsDAOSpan := adssd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressSetDAO.Update")
+	if adsDAOSpan != nil {
+		defer adsDAOSpan.End()
+
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "id", input.AddressSetID.String())
+	}
+
+	ads := &AddressSet{
+		ID:        input.AddressSetID,
+		UpdatedBy: input.UpdatedBy,
+	}
+
+	updatedFields := []string{"updated_by"}
+
+	if input.Name != nil {
+		ads.Name = *input.Name
+		updatedFields = append(updatedFields, "name")
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "name", *input.Name)
+	}
+	if input.Description != nil {
+		ads.Description = input.Description
+		updatedFields = append(updatedFields, "description")
+	}
+	if input.Prefixes != nil {
+		ads.Prefixes = input.Prefixes
+		updatedFields = append(updatedFields, "prefixes")
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "prefixes", input.Prefixes)
+	}
+
+	updatedFields = append(updatedFields, "updated")
+
+	_, err := db.GetIDB(tx, adssd.dbSession).NewUpdate().Model(ads).Column(updatedFields...).Where("ads.id = ?", input.AddressSetID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return adssd.GetByID(ctx, tx, ads.ID, nil)
+}
+
+// Delete deletes an AddressSet by ID
+// If the object being deleted doesnt exist, error is not returned (idempotent delete)
+func (adssd AddressSetSQLDAO) Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error {
+	// Create a child span and set the attributes for current request
+	ctx, adsDAOSpan := adssd.tracerSpan.CreateChildInCurrentContext(ctx, "AddressSetDAO.Delete")
+	if adsDAOSpan != nil {
+		defer adsDAOSpan.End()
+
+		adssd.tracerSpan.SetAttribute(adsDAOSpan, "id", id.String())
+	}
+
+	ads := &AddressSet{
+		ID: id,
+	}
+
+	_, err := db.GetIDB(tx, adssd.dbSession).NewDelete().Model(ads).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewAddressSetDAO returns a new AddressSetDAO
+func NewAddressSetDAO(dbSession *db.Session) AddressSetDAO {
+	return &AddressSetSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+)
+
+func TestAddressSetSQLDAO(t *testing.T) {
+	dbSession := testInitDB(t)
+	defer dbSession.Close()
+
+	TestSetupSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	tnu := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("jdoe@test.com"), db.GetStrPtr("Jane"), db.GetStrPtr("Doe"))
+	tn1 := testBuildTenant(t, dbSession, nil, "test-tenant-1", "test-tenant-org-1", tnu.ID)
+	tn2 := testBuildTenant(t, dbSession, nil, "test-tenant-2", "test-tenant-org-2", tnu.ID)
+
+	adsDAO := NewAddressSetDAO(dbSession)
+
+	ads1, err := adsDAO.Create(ctx, nil, AddressSetCreateInput{
+		Name:        "corp",
+		Description: db.GetStrPtr("Corporate networks"),
+		TenantOrg:   tn1.Org,
+		TenantID:    tn1.ID,
+		Prefixes:    []string{"10.0.0.0/8", "192.168.0.0/16"},
+		CreatedBy:   tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.0/8", "192.168.0.0/16"}, ads1.Prefixes)
+	assert.Equal(t, tnu.ID, ads1.UpdatedBy)
+
+	ads2, err := adsDAO.Create(ctx, nil, AddressSetCreateInput{
+		Name:      "empty",
+		TenantOrg: tn2.Org,
+		TenantID:  tn2.ID,
+		CreatedBy: tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{}, ads2.Prefixes)
+
+	got, err := adsDAO.GetByID(ctx, nil, ads1.ID, []string{TenantRelationName})
+	require.NoError(t, err)
+	assert.Equal(t, "corp", got.Name)
+	require.NotNil(t, got.Tenant)
+	assert.Equal(t, tn1.ID, got.Tenant.ID)
+
+	_, err = adsDAO.GetByID(ctx, nil, uuid.New(), nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	adss, total, err := adsDAO.GetAll(ctx, nil, AddressSetFilterInput{TenantIDs: []uuid.UUID{tn1.ID}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, ads1.ID, adss[0].ID)
+
+	_, total, err = adsDAO.GetAll(ctx, nil, AddressSetFilterInput{SearchQuery: db.GetStrPtr("192.168")}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+
+	_, total, err = adsDAO.GetAll(ctx, nil, AddressSetFilterInput{Names: []string{"corp", "empty"}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	updated, err := adsDAO.Update(ctx, nil, AddressSetUpdateInput{
+		AddressSetID: ads1.ID,
+		Name:         db.GetStrPtr("corporate"),
+		Prefixes:     []string{"172.16.0.0/12"},
+		UpdatedBy:    tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "corporate", updated.Name)
+	assert.Equal(t, []string{"172.16.0.0/12"}, updated.Prefixes)
+	assert.Equal(t, "Corporate networks", *updated.Description)
+
+	err = adsDAO.Delete(ctx, nil, ads1.ID)
+	require.NoError(t, err)
+
+	_, err = adsDAO.GetByID(ctx, nil, ads1.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	// Deletion is idempotent
+	err = adsDAO.Delete(ctx, nil, ads1.ID)
+	assert.NoError(t, err)
+}
@@ -146,6 +146,9 @@ type AllocationConstraintDAO interface {
 		derivedResourceID bool) (*AllocationConstraint, error)
 	//
 	DeleteByID(ctx context.Context, tx *db.Tx, id uuid.UUID) error
+	// GetAllExistingBetween returns all AllocationConstraints of a resource type, including deleted ones,
+	// that existed at any time in [start, end), with their Allocation
+	GetAllExistingBetween(ctx context.Context, tx *db.Tx, resourceType string, start time.Time, end time.Time) ([]AllocationConstraint, error)
 }
 
 // AllocationConstraintSQLDAO is an implementation of the AllocationConstraintDAO interface
@@ -450,6 +453,38 @@ func (acd AllocationConstraintSQLDAO) ClearFromParams(ctx context.Context, tx *d
 	return nv, nil
 }
 
+// GetAllExistingBetween returns all AllocationConstraints of a resource type, including deleted ones,
+// that were created before end and were not deleted before start. Deleted Allocations are included in the relation.
+func (acd AllocationConstraintSQLDAO) GetAllExistingBetween(ctx context.Context, tx *db.Tx, resourceType string, start time.Time, end time.Time) ([]AllocationConstraint, error) {
+	// Create a child span and set the attributes for current request
+	ctx, aDAOSpan := acd.tracerSpan.CreateChildInCurrentContext(ctx, "AllocationConstraintDAO.GetAllExistingBetween")
+	if aDAOSpan != nil {
+		defer aDAOSpan.End()
+
+		acd.tracerSpan.SetAttribute(aDAOSpan, "resource_type", resourceType)
+		acd.tracerSpan.SetAttribute(aDAOSpan, "start", start.String())
+		acd.tracerSpan.SetAttribute(aDAOSpan, "end", end.String())
+	}
+
+	acs := []AllocationConstraint{}
+
+	err := db.GetIDB(tx, acd.dbSession).NewSelect().Model(&acs).
+		WhereAllWithDeleted().
+		Relation(AllocationRelationName).
+		Where("ac.resource_type = ?", resourceType).
+		Where("ac.created < ?", end).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("ac.deleted IS NULL").WhereOr("ac.deleted >= ?", start)
+		}).
+		Order("ac.created ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return acs, nil
+}
+
 // DeleteByID deletes an AllocationConstraint by ID
 // error is returned only if there is a db error
 // if the object being deleted doesnt exist, error is not returned (idempotent delete)
@@ -20,6 +20,7 @@ package model
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	otrace "go.opentelemetry.io/otel/trace"
@@ -931,3 +932,48 @@ func TestAllocationConstraintSQLDAO_DeleteByID(t *testing.T) {
 		})
 	}
 }
+
+func TestAllocationConstraintSQLDAO_GetAllExistingBetween(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testAllocationConstraintInitDB(t)
+	defer dbSession.Close()
+	testAllocationConstraintSetupSchema(t, dbSession)
+	ip := testAllocationConstraintBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testAllocationConstraintBuildSite(t, dbSession, ip, "testSite")
+	tenant := testAllocationConstraintBuildTenant(t, dbSession, "testTenant")
+	user := testAllocationConstraintBuildUser(t, dbSession, "testUser")
+	insType := testAllocationConstraintBuildInstanceType(t, dbSession,
+		ip.ID, site.ID, user.ID, "instance-type-1")
+	alloc := testAllocationConstraintBuildAllocation(t, dbSession, ip.ID,
+		tenant.ID, site.ID, user.ID)
+
+	asd := NewAllocationConstraintDAO(dbSession)
+
+	now := time.Now().UTC()
+
+	active, err := asd.CreateFromParams(ctx, nil, alloc.ID, AllocationResourceTypeInstanceType,
+		insType.ID, AllocationConstraintTypeReserved, 10, nil, user.ID)
+	assert.NoError(t, err)
+
+	deleted, err := asd.CreateFromParams(ctx, nil, alloc.ID, AllocationResourceTypeInstanceType,
+		insType.ID, AllocationConstraintTypeOnDemand, 5, nil, user.ID)
+	assert.NoError(t, err)
+	_, err = dbSession.DB.NewUpdate().Model((*AllocationConstraint)(nil)).Set("deleted = ?", now.Add(-36*time.Hour)).Where("id = ?", deleted.ID).Exec(ctx)
+	assert.NoError(t, err)
+
+	// Constraints of other resource types are excluded
+	_, err = asd.CreateFromParams(ctx, nil, alloc.ID, AllocationResourceTypeIPBlock,
+		uuid.New(), AllocationConstraintTypeReserved, 1, nil, user.ID)
+	assert.NoError(t, err)
+
+	// Deleted Allocations are still returned with their constraints
+	_, err = dbSession.DB.NewUpdate().Model((*Allocation)(nil)).Set("deleted = ?", now.Add(-time.Hour)).Where("id = ?", alloc.ID).Exec(ctx)
+	assert.NoError(t, err)
+
+	acs, err := asd.GetAllExistingBetween(ctx, nil, AllocationResourceTypeInstanceType, now.Add(-24*time.Hour), now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, acs, 1)
+	assert.Equal(t, active.ID, acs[0].ID)
+	assert.NotNil(t, acs[0].Allocation)
+	assert.Equal(t, tenant.ID, acs[0].Allocation.TenantID)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/uptrace/bun"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -83,8 +84,15 @@ type AuditEntryUpdateInput struct {
 }
 
 type AuditEntryFilterInput struct {
-	OrgName    *string
-	FailedOnly *bool // returns only entries with status_code >= 400
+	OrgName         *string
+	FailedOnly      *bool // returns only entries with status_code >= 400
+	UserIDs         []uuid.UUID
+	EndpointPattern *string // '*' matches any sequence of characters, e.g. /v2/org/*/carbide/instance*
+	Methods         []string
+	StatusCodeMin   *int
+	StatusCodeMax   *int
+	StartTime       *time.Time // inclusive
+	EndTime         *time.Time // exclusive
 }
 
 type AuditEntryDAO interface {
@@ -92,6 +100,7 @@ type AuditEntryDAO interface {
 	Update(ctx context.Context, tx *db.Tx, input AuditEntryUpdateInput) (*AuditEntry, error)
 	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID) (*AuditEntry, error)
 	GetAll(ctx context.Context, tx *db.Tx, filter AuditEntryFilterInput, page paginator.PageInput) ([]AuditEntry, int, error)
+	DeleteOlderThan(ctx context.Context, tx *db.Tx, before time.Time, limit int) (int, error)
 }
 
 // AuditEntrySQLDAO is the SQL data access object for AuditEntry
@@ -224,6 +233,28 @@ func (aed AuditEntrySQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter AuditE
 			aed.tracerSpan.SetAttribute(daoSpan, "failedOnly", *filter.FailedOnly)
 		}
 	}
+	if len(filter.UserIDs) > 0 {
+		query = query.Where("ae.user_id IN (?)", bun.In(filter.UserIDs))
+	}
+	if filter.EndpointPattern != nil {
+		query = query.Where("ae.endpoint LIKE ?", EndpointPatternToLike(*filter.EndpointPattern))
+		aed.tracerSpan.SetAttribute(daoSpan, "endpoint_pattern", *filter.EndpointPattern)
+	}
+	if len(filter.Methods) > 0 {
+		query = query.Where("ae.method IN (?)", bun.In(filter.Methods))
+	}
+	if filter.StatusCodeMin != nil {
+		query = query.Where("ae.status_code >= ?", *filter.StatusCodeMin)
+	}
+	if filter.StatusCodeMax != nil {
+		query = query.Where("ae.status_code <= ?", *filter.StatusCodeMax)
+	}
+	if filter.StartTime != nil {
+		query = query.Where("ae.timestamp >= ?", *filter.StartTime)
+	}
+	if filter.EndTime != nil {
+		query = query.Where("ae.timestamp < ?", *filter.EndTime)
+	}
 
 	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
 	var multiOrderBy []*paginator.OrderBy
@@ -249,6 +280,35 @@ func (aed AuditEntrySQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter AuditE
 	return entries, dbPaginator.Total, nil
 }
 
+// DeleteOlderThan deletes up to limit AuditEntries recorded before the given time and returns the number deleted.
+// Callers enforcing retention should call it repeatedly until it returns fewer than limit.
+func (aed AuditEntrySQLDAO) DeleteOlderThan(ctx context.Context, tx *db.Tx, before time.Time, limit int) (int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, daoSpan := aed.tracerSpan.CreateChildInCurrentContext(ctx, "AuditEntryDAO.DeleteOlderThan")
+	if daoSpan != nil {
+		defer daoSpan.End()
+		aed.tracerSpan.SetAttribute(daoSpan, "before", before.String())
+	}
+
+	idb := db.GetIDB(tx, aed.dbSession)
+	expired := idb.NewSelect().Model((*AuditEntry)(nil)).Column("ae.id").Where("ae.timestamp < ?", before).Limit(limit)
+	res, err := idb.NewDelete().Model((*AuditEntry)(nil)).Where("id IN (?)", expired).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(deleted), nil
+}
+
+// EndpointPatternToLike converts an endpoint pattern using '*' wildcards into a SQL LIKE pattern
+func EndpointPatternToLike(pattern string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+	return strings.ReplaceAll(escaped, "*", "%")
+}
+
 func NewAuditEntryDAO(dbSession *db.Session) AuditEntryDAO {
 	return &AuditEntrySQLDAO{
 		dbSession:  dbSession,
@@ -274,3 +274,108 @@ func TestAuditEntrySQLDAO_GetAll(t *testing.T) {
 		assert.Equal(t, entry.StatusCode, http.StatusForbidden)
 	}
 }
+
+func TestAuditEntrySQLDAO_GetAllWithSearchFilters(t *testing.T) {
+	dbSession := util.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	setupAuditSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := AuditEntrySQLDAO{
+		dbSession: dbSession,
+	}
+
+	orgName := "aoij2l0al10s"
+
+	userID, err := createAuditUser(dbSession)
+	assert.NoError(t, err)
+	otherUserID := uuid.New()
+
+	start := time.Now().Add(-time.Hour).UTC()
+	for i := 0; i < 12; i++ {
+		createInput, err := makeAuditEntryCreateInput(orgName, userID, http.StatusCreated)
+		assert.NoError(t, err)
+		createInput.Timestamp = start.Add(time.Duration(i) * time.Minute)
+		switch i % 3 {
+		case 1:
+			createInput.Endpoint = fmt.Sprintf("/v2/org/%s/carbide/instance/%s", orgName, uuid.NewString())
+			createInput.Method = "DELETE"
+			createInput.StatusCode = http.StatusAccepted
+		case 2:
+			createInput.Endpoint = fmt.Sprintf("/v2/org/%s/carbide/vpc", orgName)
+			createInput.StatusCode = http.StatusConflict
+			createInput.UserID = &otherUserID
+		}
+		_, err = dao.Create(ctx, nil, createInput)
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		name   string
+		filter AuditEntryFilterInput
+		want   int
+	}{
+		{name: "user", filter: AuditEntryFilterInput{UserIDs: []uuid.UUID{otherUserID}}, want: 4},
+		{name: "endpoint pattern", filter: AuditEntryFilterInput{EndpointPattern: db.GetStrPtr("/v2/org/*/carbide/instance/*")}, want: 4},
+		{name: "endpoint pattern is anchored", filter: AuditEntryFilterInput{EndpointPattern: db.GetStrPtr("/carbide/vpc")}, want: 0},
+		{name: "methods", filter: AuditEntryFilterInput{Methods: []string{"POST"}}, want: 8},
+		{name: "status range", filter: AuditEntryFilterInput{StatusCodeMin: db.GetIntPtr(200), StatusCodeMax: db.GetIntPtr(299)}, want: 8},
+		{name: "time window", filter: AuditEntryFilterInput{StartTime: db.GetTimePtr(start.Add(3 * time.Minute)), EndTime: db.GetTimePtr(start.Add(6 * time.Minute))}, want: 3},
+		{name: "combined", filter: AuditEntryFilterInput{Methods: []string{"POST"}, StatusCodeMin: db.GetIntPtr(400)}, want: 4},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, total, err := dao.GetAll(ctx, nil, tc.filter, paginator.PageInput{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, total)
+		})
+	}
+}
+
+func TestAuditEntrySQLDAO_DeleteOlderThan(t *testing.T) {
+	dbSession := util.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	setupAuditSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := AuditEntrySQLDAO{
+		dbSession: dbSession,
+	}
+
+	userID, err := createAuditUser(dbSession)
+	assert.NoError(t, err)
+
+	now := time.Now().UTC()
+	for i := 0; i < 10; i++ {
+		createInput, err := makeAuditEntryCreateInput("aoij2l0al10s", userID, http.StatusCreated)
+		assert.NoError(t, err)
+		createInput.Timestamp = now.Add(-time.Duration(i) * 24 * time.Hour)
+		_, err = dao.Create(ctx, nil, createInput)
+		assert.NoError(t, err)
+	}
+
+	// entries 7, 8 and 9 days old are past a 6.5 day retention
+	cutoff := now.Add(-156 * time.Hour)
+	deleted, err := dao.DeleteOlderThan(ctx, nil, cutoff, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	deleted, err = dao.DeleteOlderThan(ctx, nil, cutoff, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	_, total, err := dao.GetAll(ctx, nil, AuditEntryFilterInput{}, paginator.PageInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, 7, total)
+}
+
+func TestEndpointPatternToLike(t *testing.T) {
+	assert.Equal(t, "/v2/org/%/carbide/instance%", EndpointPatternToLike("/v2/org/*/carbide/instance*"))
+	assert.Equal(t, `/v2/org/my\_org/carbide/100\%`, EndpointPatternToLike("/v2/org/my_org/carbide/100%"))
+}
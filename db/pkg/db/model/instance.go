@@ -174,6 +174,9 @@ type Instance struct {
 	Hostname                               *string                                 `bun:"hostname"`
 	OperatingSystemID                      *uuid.UUID                              `bun:"operating_system_id,type:uuid"`
 	OperatingSystem                        *OperatingSystem                        `bun:"rel:belongs-to,join:operating_system_id=id"`
+	OperatingSystemVersionID               *uuid.UUID                              `bun:"operating_system_version_id,type:uuid"`
+	InstanceTemplateID                     *uuid.UUID                              `bun:"instance_template_id,type:uuid"`
+	InstanceTemplateVersion                *int                                    `bun:"instance_template_version"`
 	IpxeScript                             *string                                 `bun:"ipxe_script"`
 	AlwaysBootWithCustomIpxe               bool                                    `bun:"always_boot_with_custom_ipxe,notnull"`
 	PhoneHomeEnabled                       bool                                    `bun:"phone_home_enabled,notnull"`
@@ -210,6 +213,9 @@ type InstanceCreateInput struct {
 	ControllerInstanceID                   *uuid.UUID
 	Hostname                               *string
 	OperatingSystemID                      *uuid.UUID
+	OperatingSystemVersionID               *uuid.UUID
+	InstanceTemplateID                     *uuid.UUID
+	InstanceTemplateVersion                *int
 	IpxeScript                             *string
 	AlwaysBootWithCustomIpxe               bool
 	PhoneHomeEnabled                       bool
@@ -241,6 +247,7 @@ type InstanceUpdateInput struct {
 	ControllerInstanceID                   *uuid.UUID
 	Hostname                               *string
 	OperatingSystemID                      *uuid.UUID
+	OperatingSystemVersionID               *uuid.UUID
 	IpxeScript                             *string
 	AlwaysBootWithCustomIpxe               *bool
 	PhoneHomeEnabled                       *bool
@@ -264,6 +271,7 @@ type InstanceClearInput struct {
 	NetworkSecurityGroupPropagationDetails bool
 	Hostname                               bool
 	OperatingSystemID                      bool
+	OperatingSystemVersionID               bool
 	IpxeScript                             bool
 	UserData                               bool
 	Labels                                 bool
@@ -285,6 +293,8 @@ type InstanceFilterInput struct {
 	MachineIDs                []string
 	ControllerInstanceIDs     []uuid.UUID
 	OperatingSystemIDs        []uuid.UUID
+	OperatingSystemVersionIDs []uuid.UUID
+	InstanceTemplateIDs       []uuid.UUID
 	Statuses                  []string
 	SearchQuery               *string
 }
@@ -342,6 +352,8 @@ type InstanceDAO interface {
 	Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error
 	// GetCount returns total count of rows for specified filter
 	GetCount(ctx context.Context, tx *db.Tx, filter InstanceFilterInput) (count int, err error)
+	// GetAllExistingBetween returns all Instances, including deleted ones, that existed at any time in [start, end)
+	GetAllExistingBetween(ctx context.Context, tx *db.Tx, start time.Time, end time.Time) ([]Instance, error)
 }
 
 // InstanceSQLDAO is an implementation of the InstanceDAO interface
@@ -547,6 +559,20 @@ func (isd InstanceSQLDAO) setQueryWithFilter(filter InstanceFilterInput, query *
 		}
 	}
 
+	if filter.OperatingSystemVersionIDs != nil {
+		query = query.Where("i.operating_system_version_id IN (?)", bun.In(filter.OperatingSystemVersionIDs))
+		if instanceDAOSpan != nil {
+			isd.tracerSpan.SetAttribute(instanceDAOSpan, "operating_system_version_ids", filter.OperatingSystemVersionIDs)
+		}
+	}
+
+	if filter.InstanceTemplateIDs != nil {
+		query = query.Where("i.instance_template_id IN (?)", bun.In(filter.InstanceTemplateIDs))
+		if instanceDAOSpan != nil {
+			isd.tracerSpan.SetAttribute(instanceDAOSpan, "instance_template_ids", filter.InstanceTemplateIDs)
+		}
+	}
+
 	if filter.Statuses != nil {
 		query = query.Where("i.status IN (?)", bun.In(filter.Statuses))
 		if instanceDAOSpan != nil {
@@ -655,6 +681,35 @@ func (isd InstanceSQLDAO) GetCount(ctx context.Context, tx *db.Tx, filter Instan
 	return query.Count(ctx)
 }
 
+// GetAllExistingBetween returns all Instances, including deleted ones, that were created before end
+// and were not deleted before start
+func (isd InstanceSQLDAO) GetAllExistingBetween(ctx context.Context, tx *db.Tx, start time.Time, end time.Time) ([]Instance, error) {
+	// Create a child span and set the attributes for current request
+	ctx, instanceDAOSpan := isd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceDAO.GetAllExistingBetween")
+	if instanceDAOSpan != nil {
+		defer instanceDAOSpan.End()
+
+		isd.tracerSpan.SetAttribute(instanceDAOSpan, "start", start.String())
+		isd.tracerSpan.SetAttribute(instanceDAOSpan, "end", end.String())
+	}
+
+	instances := []Instance{}
+
+	err := db.GetIDB(tx, isd.dbSession).NewSelect().Model(&instances).
+		WhereAllWithDeleted().
+		Where("i.created < ?", end).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("i.deleted IS NULL").WhereOr("i.deleted >= ?", start)
+		}).
+		Order("i.created ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return instances, nil
+}
+
 // Update updates specified fields of an existing Instance
 // The updated fields are assumed to be set to non-null values
 // For setting to null values, use: Clear
@@ -712,6 +767,10 @@ func (isd InstanceSQLDAO) Clear(ctx context.Context, tx *db.Tx, input InstanceCl
 		i.OperatingSystemID = nil
 		updatedFields = append(updatedFields, "operating_system_id")
 	}
+	if input.OperatingSystemVersionID {
+		i.OperatingSystemVersionID = nil
+		updatedFields = append(updatedFields, "operating_system_version_id")
+	}
 	if input.IpxeScript {
 		i.IpxeScript = nil
 		updatedFields = append(updatedFields, "ipxe_script")
@@ -818,6 +877,9 @@ func (isd InstanceSQLDAO) CreateMultiple(ctx context.Context, tx *db.Tx, inputs
 			ControllerInstanceID:                   input.ControllerInstanceID,
 			Hostname:                               input.Hostname,
 			OperatingSystemID:                      input.OperatingSystemID,
+			OperatingSystemVersionID:               input.OperatingSystemVersionID,
+			InstanceTemplateID:                     input.InstanceTemplateID,
+			InstanceTemplateVersion:                input.InstanceTemplateVersion,
 			IpxeScript:                             input.IpxeScript,
 			AlwaysBootWithCustomIpxe:               input.AlwaysBootWithCustomIpxe,
 			PhoneHomeEnabled:                       input.PhoneHomeEnabled,
@@ -1006,6 +1068,13 @@ func (isd InstanceSQLDAO) UpdateMultiple(ctx context.Context, tx *db.Tx, inputs
 				isd.tracerSpan.SetAttribute(instanceDAOSpan, prefix+"operating_system_id", input.OperatingSystemID.String())
 			}
 		}
+		if input.OperatingSystemVersionID != nil {
+			i.OperatingSystemVersionID = input.OperatingSystemVersionID
+			columns = append(columns, "operating_system_version_id")
+			if addTrace {
+				isd.tracerSpan.SetAttribute(instanceDAOSpan, prefix+"operating_system_version_id", input.OperatingSystemVersionID.String())
+			}
+		}
 		if input.IpxeScript != nil {
 			i.IpxeScript = input.IpxeScript
 			columns = append(columns, "ipxe_script")
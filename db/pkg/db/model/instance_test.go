@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	otrace "go.opentelemetry.io/otel/trace"
@@ -189,7 +190,7 @@ func testInstanceBuildNetworkSecurityGroup(t *testing.T, dbSession *db.Session,
 		Status:    InstanceTypeStatusReady,
 		Rules: []*NetworkSecurityGroupRule{
 			&NetworkSecurityGroupRule{
-				&cwssaws.NetworkSecurityGroupRuleAttributes{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
 					Id:     db.GetStrPtr(uuid.NewString()),
 					Action: cwssaws.NetworkSecurityGroupRuleAction_NSG_RULE_ACTION_DENY,
 				},
@@ -3239,3 +3240,60 @@ func TestInstanceSQLDAO_UpdateMultiple_AllFields(t *testing.T) {
 	assert.Equal(t, "on", *updated.PowerStatus, "PowerStatus not updated")
 	assert.True(t, updated.IsMissingOnSite, "IsMissingOnSite not updated")
 }
+
+func TestInstanceSQLDAO_GetAllExistingBetween(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testInstanceSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	vpc := testInstanceBuildVpc(t, dbSession, ip, site, tenant, "testVpc")
+	instanceType := testInstanceBuildInstanceType(t, dbSession, ip, "testInstanceType")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+	isd := NewInstanceDAO(dbSession)
+
+	now := time.Now().UTC()
+
+	// Instances are created with the given lifetimes, a nil deletion time leaves the Instance active
+	lifetimes := map[string][2]*time.Time{
+		"active":         {db.GetTimePtr(now.Add(-48 * time.Hour)), nil},
+		"deleted-before": {db.GetTimePtr(now.Add(-48 * time.Hour)), db.GetTimePtr(now.Add(-36 * time.Hour))},
+		"deleted-during": {db.GetTimePtr(now.Add(-48 * time.Hour)), db.GetTimePtr(now.Add(-time.Hour))},
+		"created-after":  {db.GetTimePtr(now.Add(2 * time.Hour)), nil},
+		"created-during": {db.GetTimePtr(now.Add(-time.Hour)), nil},
+	}
+
+	for name, lifetime := range lifetimes {
+		i, err := isd.Create(ctx, nil, InstanceCreateInput{
+			Name:                     name,
+			TenantID:                 tenant.ID,
+			InfrastructureProviderID: ip.ID,
+			SiteID:                   site.ID,
+			InstanceTypeID:           &instanceType.ID,
+			VpcID:                    vpc.ID,
+			Labels:                   map[string]string{},
+			Status:                   InstanceStatusReady,
+			CreatedBy:                user.ID,
+		})
+		assert.NoError(t, err)
+
+		_, err = dbSession.DB.NewUpdate().Model((*Instance)(nil)).Set("created = ?", *lifetime[0]).Where("id = ?", i.ID).Exec(ctx)
+		assert.NoError(t, err)
+
+		if lifetime[1] != nil {
+			_, err = dbSession.DB.NewUpdate().Model((*Instance)(nil)).Set("deleted = ?", *lifetime[1]).Where("id = ?", i.ID).Exec(ctx)
+			assert.NoError(t, err)
+		}
+	}
+
+	instances, err := isd.GetAllExistingBetween(ctx, nil, now.Add(-24*time.Hour), now.Add(time.Hour))
+	assert.NoError(t, err)
+
+	names := []string{}
+	for _, i := range instances {
+		names = append(names, i.Name)
+	}
+	assert.ElementsMatch(t, []string{"active", "deleted-during", "created-during"}, names)
+}
@@ -0,0 +1,338 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// InstanceTemplateRelationName is the relation name for the InstanceTemplate model
+	InstanceTemplateRelationName = "InstanceTemplate"
+
+	// InstanceTemplateOrderByDefault default field to be used for ordering when none specified
+	InstanceTemplateOrderByDefault = "created"
+)
+
+var (
+	// InstanceTemplateOrderByFields is a list of valid order by fields for the InstanceTemplate model
+	InstanceTemplateOrderByFields = []string{"name", "version", "created", "updated"}
+	// InstanceTemplateRelatedEntities is a list of valid relation by fields for the InstanceTemplate model
+	InstanceTemplateRelatedEntities = map[string]bool{
+		TenantRelationName: true,
+	}
+)
+
+// InstanceTemplate is a named, versioned set of Instance attributes owned by a Tenant.
+// Version is the number of the latest InstanceTemplateVersion, which holds the attributes.
+type InstanceTemplate struct {
+	bun.BaseModel `bun:"table:instance_template,alias:itp"`
+
+	ID          uuid.UUID  `bun:"type:uuid,pk"`
+	Name        string     `bun:"name,notnull"`
+	Description *string    `bun:"description"`
+	TenantOrg   string     `bun:"tenant_org,notnull"`
+	TenantID    uuid.UUID  `bun:"tenant_id,type:uuid,notnull"`
+	Tenant      *Tenant    `bun:"rel:belongs-to,join:tenant_id=id"`
+	Version     int        `bun:"version,notnull"`
+	Created     time.Time  `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated     time.Time  `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted     *time.Time `bun:"deleted,soft_delete"`
+	CreatedBy   uuid.UUID  `bun:"created_by,type:uuid,notnull"`
+	UpdatedBy   uuid.UUID  `bun:"updated_by,type:uuid,notnull"`
+}
+
+// InstanceTemplateCreateInput input parameters for Create method
+type InstanceTemplateCreateInput struct {
+	InstanceTemplateID *uuid.UUID
+	Name               string
+	Description        *string
+	TenantOrg          string
+	TenantID           uuid.UUID
+	Version            int
+	CreatedBy          uuid.UUID
+}
+
+// InstanceTemplateUpdateInput input parameters for Update method
+type InstanceTemplateUpdateInput struct {
+	InstanceTemplateID uuid.UUID
+	Name               *string
+	Description        *string
+	Version            *int
+	UpdatedBy          uuid.UUID
+}
+
+// InstanceTemplateFilterInput input parameters for GetAll method
+type InstanceTemplateFilterInput struct {
+	InstanceTemplateIDs []uuid.UUID
+	Names               []string
+	TenantIDs           []uuid.UUID
+	SearchQuery         *string
+}
+
+var _ bun.BeforeAppendModelHook = (*InstanceTemplate)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (it *InstanceTemplate) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		it.Created = db.GetCurTime()
+		it.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		it.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*InstanceTemplate)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (it *InstanceTemplate) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("tenant_id") REFERENCES "tenant" ("id")`)
+	return nil
+}
+
+// InstanceTemplateDAO is an interface for interacting with the InstanceTemplate model
+type InstanceTemplateDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input InstanceTemplateCreateInput) (*InstanceTemplate, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string, forUpdate bool) (*InstanceTemplate, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter InstanceTemplateFilterInput, page paginator.PageInput, includeRelations []string) ([]InstanceTemplate, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input InstanceTemplateUpdateInput) (*InstanceTemplate, error)
+	//
+	Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error
+}
+
+// InstanceTemplateSQLDAO is an implementation of the InstanceTemplateDAO interface
+type InstanceTemplateSQLDAO struct {
+	dbSession *db.Session
+	InstanceTemplateDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new InstanceTemplate from the given parameters
+func (itsd InstanceTemplateSQLDAO) Create(ctx context.Context, tx *db.Tx, input InstanceTemplateCreateInput) (*InstanceTemplate, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itDAOSpan := itsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateDAO.Create")
+	if itDAOSpan != nil {
+		defer itDAOSpan.End()
+
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "name", input.Name)
+	}
+
+	id := uuid.New()
+	if input.InstanceTemplateID != nil {
+		id = *input.InstanceTemplateID
+	}
+
+	it := &InstanceTemplate{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		TenantOrg:   input.TenantOrg,
+		TenantID:    input.TenantID,
+		Version:     input.Version,
+		CreatedBy:   input.CreatedBy,
+		UpdatedBy:   input.CreatedBy,
+	}
+
+	_, err := db.GetIDB(tx, itsd.dbSession).NewInsert().Model(it).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return itsd.GetByID(ctx, tx, it.ID, nil, false)
+}
+
+// GetByID returns an InstanceTemplate by ID
+// returns db.ErrDoesNotExist error if the record is not found
+func (itsd InstanceTemplateSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string, forUpdate bool) (*InstanceTemplate, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itDAOSpan := itsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateDAO.GetByID")
+	if itDAOSpan != nil {
+		defer itDAOSpan.End()
+
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "id", id.String())
+	}
+
+	it := &InstanceTemplate{}
+
+	query := db.GetIDB(tx, itsd.dbSession).NewSelect().Model(it).Where("itp.id = ?", id)
+
+	if forUpdate {
+		query = query.For("UPDATE")
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return it, nil
+}
+
+// GetAll returns all InstanceTemplates with various optional filters
+// errors are returned only when there is a db related error
+// if records not found, then error is nil, but length of returned slice is 0
+// if orderBy is nil, then records are ordered by column specified in InstanceTemplateOrderByDefault in ascending order
+func (itsd InstanceTemplateSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter InstanceTemplateFilterInput, page paginator.PageInput, includeRelations []string) ([]InstanceTemplate, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itDAOSpan := itsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateDAO.GetAll")
+	if itDAOSpan != nil {
+		defer itDAOSpan.End()
+	}
+
+	its := []InstanceTemplate{}
+
+	query := db.GetIDB(tx, itsd.dbSession).NewSelect().Model(&its)
+
+	if filter.InstanceTemplateIDs != nil {
+		query = query.Where("itp.id IN (?)", bun.In(filter.InstanceTemplateIDs))
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "id", filter.InstanceTemplateIDs)
+	}
+	if filter.Names != nil {
+		query = query.Where("itp.name IN (?)", bun.In(filter.Names))
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "name", filter.Names)
+	}
+	if filter.TenantIDs != nil {
+		query = query.Where("itp.tenant_id IN (?)", bun.In(filter.TenantIDs))
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "tenant_id", filter.TenantIDs)
+	}
+	if filter.SearchQuery != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("itp.name ILIKE ?", "%"+*filter.SearchQuery+"%").
+				WhereOr("itp.description ILIKE ?", "%"+*filter.SearchQuery+"%")
+		})
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "search_query", *filter.SearchQuery)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(InstanceTemplateOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, InstanceTemplateOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return its, paginator.Total, nil
+}
+
+// Update updates specified fields of an existing InstanceTemplate
+// The updated fields are assumed to be set to non-null values
+func (itsd InstanceTemplateSQLDAO) Update(ctx context.Context, tx *db.Tx, input InstanceTemplateUpdateInput) (*InstanceTemplate, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itDAOSpan := itsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateDAO.Update")
+	if itDAOSpan != nil {
+		defer itDAOSpan.End()
+
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "id", input.InstanceTemplateID.String())
+	}
+
+	it := &InstanceTemplate{
+		ID:        input.InstanceTemplateID,
+		UpdatedBy: input.UpdatedBy,
+	}
+
+	updatedFields := []string{"updated_by"}
+
+	if input.Name != nil {
+		it.Name = *input.Name
+		updatedFields = append(updatedFields, "name")
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "name", *input.Name)
+	}
+	if input.Description != nil {
+		it.Description = input.Description
+		updatedFields = append(updatedFields, "description")
+	}
+	if input.Version != nil {
+		it.Version = *input.Version
+		updatedFields = append(updatedFields, "version")
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "version", *input.Version)
+	}
+
+	updatedFields = append(updatedFields, "updated")
+
+	_, err := db.GetIDB(tx, itsd.dbSession).NewUpdate().Model(it).Column(updatedFields...).Where("itp.id = ?", input.InstanceTemplateID).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return itsd.GetByID(ctx, tx, it.ID, nil, false)
+}
+
+// Delete deletes an InstanceTemplate by ID
+// Versions are retained as Instances may reference them
+// If the object being deleted doesnt exist, error is not returned (idempotent delete)
+func (itsd InstanceTemplateSQLDAO) Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error {
+	// Create a child span and set the attributes for current request
+	ctx, itDAOSpan := itsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateDAO.Delete")
+	if itDAOSpan != nil {
+		defer itDAOSpan.End()
+
+		itsd.tracerSpan.SetAttribute(itDAOSpan, "id", id.String())
+	}
+
+	it := &InstanceTemplate{
+		ID: id,
+	}
+
+	_, err := db.GetIDB(tx, itsd.dbSession).NewDelete().Model(it).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewInstanceTemplateDAO returns a new InstanceTemplateDAO
+func NewInstanceTemplateDAO(dbSession *db.Session) InstanceTemplateDAO {
+	return &InstanceTemplateSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+)
+
+func TestInstanceTemplateSQLDAO(t *testing.T) {
+	dbSession := testInitDB(t)
+	defer dbSession.Close()
+
+	TestSetupSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	tnu := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("jdoe@test.com"), db.GetStrPtr("Jane"), db.GetStrPtr("Doe"))
+	tn1 := testBuildTenant(t, dbSession, nil, "test-tenant-1", "test-tenant-org-1", tnu.ID)
+	tn2 := testBuildTenant(t, dbSession, nil, "test-tenant-2", "test-tenant-org-2", tnu.ID)
+
+	itDAO := NewInstanceTemplateDAO(dbSession)
+	itvDAO := NewInstanceTemplateVersionDAO(dbSession)
+
+	it1, err := itDAO.Create(ctx, nil, InstanceTemplateCreateInput{
+		Name:        "gpu-worker",
+		Description: db.GetStrPtr("GPU worker nodes"),
+		TenantOrg:   tn1.Org,
+		TenantID:    tn1.ID,
+		Version:     1,
+		CreatedBy:   tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, it1.Version)
+	assert.Equal(t, tnu.ID, it1.UpdatedBy)
+
+	it2, err := itDAO.Create(ctx, nil, InstanceTemplateCreateInput{
+		Name:      "cpu-worker",
+		TenantOrg: tn2.Org,
+		TenantID:  tn2.ID,
+		Version:   1,
+		CreatedBy: tnu.ID,
+	})
+	require.NoError(t, err)
+
+	got, err := itDAO.GetByID(ctx, nil, it1.ID, []string{TenantRelationName}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "gpu-worker", got.Name)
+	require.NotNil(t, got.Tenant)
+	assert.Equal(t, tn1.ID, got.Tenant.ID)
+
+	_, err = itDAO.GetByID(ctx, nil, uuid.New(), nil, false)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	its, total, err := itDAO.GetAll(ctx, nil, InstanceTemplateFilterInput{TenantIDs: []uuid.UUID{tn1.ID}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, it1.ID, its[0].ID)
+
+	_, total, err = itDAO.GetAll(ctx, nil, InstanceTemplateFilterInput{SearchQuery: db.GetStrPtr("GPU")}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+
+	_, total, err = itDAO.GetAll(ctx, nil, InstanceTemplateFilterInput{Names: []string{"gpu-worker", "cpu-worker"}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	// Versions
+	itv1, err := itvDAO.Create(ctx, nil, InstanceTemplateVersionCreateInput{
+		InstanceTemplateID: it1.ID,
+		Version:            1,
+		Config:             map[string]interface{}{"userData": "#cloud-config"},
+		CreatedBy:          tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "#cloud-config", itv1.Config["userData"])
+
+	itv2, err := itvDAO.Create(ctx, nil, InstanceTemplateVersionCreateInput{
+		InstanceTemplateID: it1.ID,
+		Version:            2,
+		CreatedBy:          tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{}, itv2.Config)
+
+	// Version numbers are unique within a template
+	_, err = itvDAO.Create(ctx, nil, InstanceTemplateVersionCreateInput{
+		InstanceTemplateID: it1.ID,
+		Version:            2,
+		CreatedBy:          tnu.ID,
+	})
+	assert.Error(t, err)
+
+	gotv, err := itvDAO.GetByInstanceTemplateIDAndVersion(ctx, nil, it1.ID, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, itv1.ID, gotv.ID)
+
+	_, err = itvDAO.GetByInstanceTemplateIDAndVersion(ctx, nil, it2.ID, 1, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	itvs, total, err := itvDAO.GetAll(ctx, nil, InstanceTemplateVersionFilterInput{InstanceTemplateIDs: []uuid.UUID{it1.ID}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Equal(t, 1, itvs[0].Version)
+	assert.Equal(t, 2, itvs[1].Version)
+
+	updated, err := itDAO.Update(ctx, nil, InstanceTemplateUpdateInput{
+		InstanceTemplateID: it1.ID,
+		Name:               db.GetStrPtr("gpu-worker-v2"),
+		Version:            db.GetIntPtr(2),
+		UpdatedBy:          tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "gpu-worker-v2", updated.Name)
+	assert.Equal(t, 2, updated.Version)
+	assert.Equal(t, "GPU worker nodes", *updated.Description)
+
+	err = itDAO.Delete(ctx, nil, it1.ID)
+	require.NoError(t, err)
+
+	_, err = itDAO.GetByID(ctx, nil, it1.ID, nil, false)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	// Versions are retained for Instances that reference them
+	_, err = itvDAO.GetByID(ctx, nil, itv1.ID, nil)
+	assert.NoError(t, err)
+
+	// Deletion is idempotent
+	err = itDAO.Delete(ctx, nil, it1.ID)
+	assert.NoError(t, err)
+}
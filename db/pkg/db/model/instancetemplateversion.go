@@ -0,0 +1,273 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// InstanceTemplateVersionRelationName is the relation name for the InstanceTemplateVersion model
+	InstanceTemplateVersionRelationName = "InstanceTemplateVersion"
+
+	// InstanceTemplateVersionOrderByDefault default field to be used for ordering when none specified
+	InstanceTemplateVersionOrderByDefault = "version"
+)
+
+var (
+	// InstanceTemplateVersionOrderByFields is a list of valid order by fields for the InstanceTemplateVersion model
+	InstanceTemplateVersionOrderByFields = []string{"version", "created"}
+	// InstanceTemplateVersionRelatedEntities is a list of valid relation by fields for the InstanceTemplateVersion model
+	InstanceTemplateVersionRelatedEntities = map[string]bool{
+		InstanceTemplateRelationName: true,
+	}
+)
+
+// InstanceTemplateVersion is an immutable revision of the Instance attributes of an InstanceTemplate.
+// Config holds the attributes in the same JSON form accepted by the Instance create request.
+type InstanceTemplateVersion struct {
+	bun.BaseModel `bun:"table:instance_template_version,alias:itv"`
+
+	ID                 uuid.UUID              `bun:"type:uuid,pk"`
+	InstanceTemplateID uuid.UUID              `bun:"instance_template_id,type:uuid,notnull"`
+	InstanceTemplate   *InstanceTemplate      `bun:"rel:belongs-to,join:instance_template_id=id"`
+	Version            int                    `bun:"version,notnull"`
+	Config             map[string]interface{} `bun:"config,type:jsonb,notnull,default:'{}'"`
+	Created            time.Time              `bun:"created,nullzero,notnull,default:current_timestamp"`
+	CreatedBy          uuid.UUID              `bun:"created_by,type:uuid,notnull"`
+}
+
+// InstanceTemplateVersionCreateInput input parameters for Create method
+type InstanceTemplateVersionCreateInput struct {
+	InstanceTemplateVersionID *uuid.UUID
+	InstanceTemplateID        uuid.UUID
+	Version                   int
+	Config                    map[string]interface{}
+	CreatedBy                 uuid.UUID
+}
+
+// InstanceTemplateVersionFilterInput input parameters for GetAll method
+type InstanceTemplateVersionFilterInput struct {
+	InstanceTemplateVersionIDs []uuid.UUID
+	InstanceTemplateIDs        []uuid.UUID
+	Versions                   []int
+}
+
+var _ bun.BeforeAppendModelHook = (*InstanceTemplateVersion)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (itv *InstanceTemplateVersion) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		itv.Created = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*InstanceTemplateVersion)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (itv *InstanceTemplateVersion) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("instance_template_id") REFERENCES "instance_template" ("id")`)
+	return nil
+}
+
+// InstanceTemplateVersionDAO is an interface for interacting with the InstanceTemplateVersion model
+type InstanceTemplateVersionDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input InstanceTemplateVersionCreateInput) (*InstanceTemplateVersion, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*InstanceTemplateVersion, error)
+	//
+	GetByInstanceTemplateIDAndVersion(ctx context.Context, tx *db.Tx, instanceTemplateID uuid.UUID, version int, includeRelations []string) (*InstanceTemplateVersion, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter InstanceTemplateVersionFilterInput, page paginator.PageInput, includeRelations []string) ([]InstanceTemplateVersion, int, error)
+}
+
+// InstanceTemplateVersionSQLDAO is an implementation of the InstanceTemplateVersionDAO interface
+type InstanceTemplateVersionSQLDAO struct {
+	dbSession *db.Session
+	InstanceTemplateVersionDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new InstanceTemplateVersion from the given parameters
+func (itvsd InstanceTemplateVersionSQLDAO) Create(ctx context.Context, tx *db.Tx, input InstanceTemplateVersionCreateInput) (*InstanceTemplateVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itvDAOSpan := itvsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateVersionDAO.Create")
+	if itvDAOSpan != nil {
+		defer itvDAOSpan.End()
+
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "instance_template_id", input.InstanceTemplateID.String())
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "version", input.Version)
+	}
+
+	id := uuid.New()
+	if input.InstanceTemplateVersionID != nil {
+		id = *input.InstanceTemplateVersionID
+	}
+
+	config := input.Config
+	if config == nil {
+		config = map[string]interface{}{}
+	}
+
+	itv := &InstanceTemplateVersion{
+		ID:                 id,
+		InstanceTemplateID: input.InstanceTemplateID,
+		Version:            input.Version,
+		Config:             config,
+		CreatedBy:          input.CreatedBy,
+	}
+
+	_, err := db.GetIDB(tx, itvsd.dbSession).NewInsert().Model(itv).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return itvsd.GetByID(ctx, tx, itv.ID, nil)
+}
+
+// GetByID returns an InstanceTemplateVersion by ID
+// returns db.ErrDoesNotExist error if the record is not found
+func (itvsd InstanceTemplateVersionSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*InstanceTemplateVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itvDAOSpan := itvsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateVersionDAO.GetByID")
+	if itvDAOSpan != nil {
+		defer itvDAOSpan.End()
+
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "id", id.String())
+	}
+
+	itv := &InstanceTemplateVersion{}
+
+	query := db.GetIDB(tx, itvsd.dbSession).NewSelect().Model(itv).Where("itv.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return itv, nil
+}
+
+// GetByInstanceTemplateIDAndVersion returns a specific version of an InstanceTemplate
+// returns db.ErrDoesNotExist error if the record is not found
+func (itvsd InstanceTemplateVersionSQLDAO) GetByInstanceTemplateIDAndVersion(ctx context.Context, tx *db.Tx, instanceTemplateID uuid.UUID, version int, includeRelations []string) (*InstanceTemplateVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itvDAOSpan := itvsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateVersionDAO.GetByInstanceTemplateIDAndVersion")
+	if itvDAOSpan != nil {
+		defer itvDAOSpan.End()
+
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "instance_template_id", instanceTemplateID.String())
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "version", version)
+	}
+
+	itv := &InstanceTemplateVersion{}
+
+	query := db.GetIDB(tx, itvsd.dbSession).NewSelect().Model(itv).
+		Where("itv.instance_template_id = ?", instanceTemplateID).
+		Where("itv.version = ?", version)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return itv, nil
+}
+
+// GetAll returns all InstanceTemplateVersions with various optional filters
+// errors are returned only when there is a db related error
+// if records not found, then error is nil, but length of returned slice is 0
+// if orderBy is nil, then records are ordered by column specified in InstanceTemplateVersionOrderByDefault in ascending order
+func (itvsd InstanceTemplateVersionSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter InstanceTemplateVersionFilterInput, page paginator.PageInput, includeRelations []string) ([]InstanceTemplateVersion, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, itvDAOSpan := itvsd.tracerSpan.CreateChildInCurrentContext(ctx, "InstanceTemplateVersionDAO.GetAll")
+	if itvDAOSpan != nil {
+		defer itvDAOSpan.End()
+	}
+
+	itvs := []InstanceTemplateVersion{}
+
+	query := db.GetIDB(tx, itvsd.dbSession).NewSelect().Model(&itvs)
+
+	if filter.InstanceTemplateVersionIDs != nil {
+		query = query.Where("itv.id IN (?)", bun.In(filter.InstanceTemplateVersionIDs))
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "id", filter.InstanceTemplateVersionIDs)
+	}
+	if filter.InstanceTemplateIDs != nil {
+		query = query.Where("itv.instance_template_id IN (?)", bun.In(filter.InstanceTemplateIDs))
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "instance_template_id", filter.InstanceTemplateIDs)
+	}
+	if filter.Versions != nil {
+		query = query.Where("itv.version IN (?)", bun.In(filter.Versions))
+		itvsd.tracerSpan.SetAttribute(itvDAOSpan, "version", filter.Versions)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(InstanceTemplateVersionOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, InstanceTemplateVersionOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return itvs, paginator.Total, nil
+}
+
+// NewInstanceTemplateVersionDAO returns a new InstanceTemplateVersionDAO
+func NewInstanceTemplateVersionDAO(dbSession *db.Session) InstanceTemplateVersionDAO {
+	return &InstanceTemplateVersionSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -90,6 +90,7 @@ type InstanceType struct {
 	Site                     *Site                   `bun:"rel:belongs-to,join:site_id=id"`
 	Labels                   map[string]string       `bun:"labels,type:jsonb"`
 	Status                   string                  `bun:"status,notnull"`
+	AutoAssign               bool                    `bun:"auto_assign,notnull,default:false"`
 	Created                  time.Time               `bun:"created,nullzero,notnull,default:current_timestamp"`
 	Updated                  time.Time               `bun:"updated,nullzero,notnull,default:current_timestamp"`
 	Deleted                  *time.Time              `bun:"deleted,soft_delete"`
@@ -133,6 +134,7 @@ type InstanceTypeCreateInput struct {
 	SiteID                   *uuid.UUID
 	Labels                   map[string]string
 	Status                   string
+	AutoAssign               bool
 	CreatedBy                uuid.UUID
 	Version                  string
 }
@@ -148,6 +150,7 @@ type InstanceTypeUpdateInput struct {
 	Labels                 map[string]string
 	SiteID                 *uuid.UUID
 	Status                 *string
+	AutoAssign             *bool
 	Version                *string
 }
 
@@ -161,6 +164,7 @@ type InstanceTypeFilterInput struct {
 	SearchQuery              *string
 	InstanceTypeIDs          []uuid.UUID
 	TenantIDs                []uuid.UUID // This implies filtering out any instance types with no allocations for the listed tenants.
+	AutoAssign               *bool
 }
 
 // InstanceTypeFilterInput input parameters for Clear method
@@ -227,6 +231,7 @@ func (itsd InstanceTypeSQLDAO) Create(ctx context.Context, tx *db.Tx, input Inst
 		SiteID:                   input.SiteID,
 		Labels:                   input.Labels,
 		Status:                   input.Status,
+		AutoAssign:               input.AutoAssign,
 		CreatedBy:                input.CreatedBy,
 		Version:                  input.Version,
 	}
@@ -342,6 +347,14 @@ func (itsd InstanceTypeSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter Ins
 		}
 	}
 
+	if filter.AutoAssign != nil {
+		query = query.Where("it.auto_assign = ?", *filter.AutoAssign)
+
+		if instanceTypeDAOSpan != nil {
+			itsd.tracerSpan.SetAttribute(instanceTypeDAOSpan, "auto_assign", *filter.AutoAssign)
+		}
+	}
+
 	if filter.TenantIDs != nil {
 		// Attach the allocation_constraint table with an innner join
 		// since that will naturally filter out any instance type
@@ -492,6 +505,15 @@ func (itsd InstanceTypeSQLDAO) Update(ctx context.Context, tx *db.Tx, input Inst
 		}
 	}
 
+	if input.AutoAssign != nil {
+		it.AutoAssign = *input.AutoAssign
+		updatedFields = append(updatedFields, "auto_assign")
+
+		if instanceTypeDAOSpan != nil {
+			itsd.tracerSpan.SetAttribute(instanceTypeDAOSpan, "auto_assign", *input.AutoAssign)
+		}
+	}
+
 	if input.SiteID != nil {
 		it.SiteID = input.SiteID
 
@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+)
+
+// InventorySyncState records the checksum of the inventory last applied from a Site for an inventory type,
+// used to verify that delta inventory publications apply on top of the state known to Cloud
+type InventorySyncState struct {
+	bun.BaseModel `bun:"table:inventory_sync_state,alias:iss"`
+
+	SiteID   uuid.UUID `bun:"site_id,type:uuid,pk"`
+	ItemType string    `bun:"item_type,pk"`
+	Checksum string    `bun:"checksum,notnull"`
+	Updated  time.Time `bun:"updated,nullzero,notnull,default:current_timestamp"`
+}
+
+// InventorySyncStateDAO is an interface for interacting with the InventorySyncState model
+type InventorySyncStateDAO interface {
+	Get(ctx context.Context, tx *db.Tx, siteID uuid.UUID, itemType string) (*InventorySyncState, error)
+	Upsert(ctx context.Context, tx *db.Tx, siteID uuid.UUID, itemType string, checksum string) (*InventorySyncState, error)
+}
+
+// InventorySyncStateSQLDAO is the SQL data access object for InventorySyncState
+type InventorySyncStateSQLDAO struct {
+	dbSession *db.Session
+	InventorySyncStateDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Get returns the sync state for the Site and inventory type, db.ErrDoesNotExist if none was recorded yet
+func (issd InventorySyncStateSQLDAO) Get(ctx context.Context, tx *db.Tx, siteID uuid.UUID, itemType string) (*InventorySyncState, error) {
+	// Create a child span and set the attributes for current request
+	ctx, daoSpan := issd.tracerSpan.CreateChildInCurrentContext(ctx, "InventorySyncStateDAO.Get")
+	if daoSpan != nil {
+		defer daoSpan.End()
+		issd.tracerSpan.SetAttribute(daoSpan, "site_id", siteID.String())
+		issd.tracerSpan.SetAttribute(daoSpan, "item_type", itemType)
+	}
+
+	state := &InventorySyncState{}
+
+	err := db.GetIDB(tx, issd.dbSession).NewSelect().Model(state).
+		Where("iss.site_id = ?", siteID).
+		Where("iss.item_type = ?", itemType).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// Upsert records the checksum for the Site and inventory type
+func (issd InventorySyncStateSQLDAO) Upsert(ctx context.Context, tx *db.Tx, siteID uuid.UUID, itemType string, checksum string) (*InventorySyncState, error) {
+	// Create a child span and set the attributes for current request
+	ctx, daoSpan := issd.tracerSpan.CreateChildInCurrentContext(ctx, "InventorySyncStateDAO.Upsert")
+	if daoSpan != nil {
+		defer daoSpan.End()
+		issd.tracerSpan.SetAttribute(daoSpan, "site_id", siteID.String())
+		issd.tracerSpan.SetAttribute(daoSpan, "item_type", itemType)
+	}
+
+	state := &InventorySyncState{
+		SiteID:   siteID,
+		ItemType: itemType,
+		Checksum: checksum,
+		Updated:  db.GetCurTime(),
+	}
+
+	_, err := db.GetIDB(tx, issd.dbSession).NewInsert().Model(state).
+		On("CONFLICT (site_id, item_type) DO UPDATE").
+		Set("checksum = EXCLUDED.checksum").
+		Set("updated = EXCLUDED.updated").
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// NewInventorySyncStateDAO returns a new InventorySyncStateDAO
+func NewInventorySyncStateDAO(dbSession *db.Session) InventorySyncStateDAO {
+	return &InventorySyncStateSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
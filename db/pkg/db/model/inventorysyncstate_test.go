@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/util"
+)
+
+func TestInventorySyncStateSQLDAO_Upsert(t *testing.T) {
+	dbSession := util.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	if err := dbSession.DB.ResetModel(context.Background(), (*InventorySyncState)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := NewInventorySyncStateDAO(dbSession)
+
+	siteID := uuid.New()
+
+	_, err := dao.Get(ctx, nil, siteID, "Machine")
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	_, err = dao.Upsert(ctx, nil, siteID, "Machine", "checksum-1")
+	assert.NoError(t, err)
+
+	state, err := dao.Get(ctx, nil, siteID, "Machine")
+	assert.NoError(t, err)
+	assert.Equal(t, "checksum-1", state.Checksum)
+
+	_, err = dao.Upsert(ctx, nil, siteID, "Machine", "checksum-2")
+	assert.NoError(t, err)
+
+	state, err = dao.Get(ctx, nil, siteID, "Machine")
+	assert.NoError(t, err)
+	assert.Equal(t, "checksum-2", state.Checksum)
+
+	// Inventory types are tracked separately
+	_, err = dao.Get(ctx, nil, siteID, "Subnet")
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+}
@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -53,6 +54,16 @@ const (
 
 	// MachineCapabilityOrderByDefault default field to be used for ordering when none specified
 	MachineCapabilityOrderByDefault = "created"
+
+	// MachineCapabilityNearMissMismatchMax is the maximum number of mismatches for a Machine to be considered a near-miss for an Instance Type
+	MachineCapabilityNearMissMismatchMax = 2
+
+	// MachineCapabilityMatchExact requires a Machine to have exactly the Capabilities of the Instance Type, a Machine
+	// Capability the Instance Type does not list is reported as a mismatch
+	MachineCapabilityMatchExact = "Exact"
+	// MachineCapabilityMatchSubset only requires a Machine to have the Capabilities of the Instance Type, additional
+	// Machine Capabilities are ignored
+	MachineCapabilityMatchSubset = "Subset"
 )
 
 var (
@@ -198,6 +209,75 @@ func (mc *MachineCapability) GetIntInfo(name string) *int {
 	return &intInfo
 }
 
+// MatchMachineCapabilities compares the Capabilities of a Machine against the Capabilities required by an Instance Type.
+// Capabilities are matched by type and name, then every attribute set on the required Capability must be equal on the
+// Machine's. With MachineCapabilityMatchExact, a Machine Capability the Instance Type does not list is also a mismatch;
+// with MachineCapabilityMatchSubset it is ignored. It returns a reason for each mismatch, so an empty result means the
+// Machine matches.
+func MatchMachineCapabilities(required []MachineCapability, actual []MachineCapability, mode string) []string {
+	// It's possible for two capabilities to have the same name but different types, so they are keyed by both
+	actualMap := make(map[string]*MachineCapability, len(actual))
+	for i := range actual {
+		actualMap[actual[i].Type+"-"+actual[i].Name] = &actual[i]
+	}
+
+	requiredMap := make(map[string]bool, len(required))
+	for i := range required {
+		requiredMap[required[i].Type+"-"+required[i].Name] = true
+	}
+
+	mismatches := []string{}
+
+	strMismatch := func(rmc *MachineCapability, attr string, want *string, got *string) {
+		if want == nil {
+			return
+		}
+		if got == nil {
+			mismatches = append(mismatches, fmt.Sprintf("%s Capability %s: %s is not set, expected %s", rmc.Type, rmc.Name, attr, *want))
+		} else if *want != *got {
+			mismatches = append(mismatches, fmt.Sprintf("%s Capability %s: %s is %s, expected %s", rmc.Type, rmc.Name, attr, *got, *want))
+		}
+	}
+
+	for i := range required {
+		rmc := &required[i]
+
+		amc, found := actualMap[rmc.Type+"-"+rmc.Name]
+		if !found {
+			mismatches = append(mismatches, fmt.Sprintf("%s Capability %s not found", rmc.Type, rmc.Name))
+			continue
+		}
+
+		strMismatch(rmc, "frequency", rmc.Frequency, amc.Frequency)
+		strMismatch(rmc, "capacity", rmc.Capacity, amc.Capacity)
+		strMismatch(rmc, "vendor", rmc.Vendor, amc.Vendor)
+		strMismatch(rmc, "device type", rmc.DeviceType, amc.DeviceType)
+
+		if rmc.InactiveDevices != nil && !slices.Equal(rmc.InactiveDevices, amc.InactiveDevices) {
+			mismatches = append(mismatches, fmt.Sprintf("%s Capability %s: inactive devices are %v, expected %v", rmc.Type, rmc.Name, amc.InactiveDevices, rmc.InactiveDevices))
+		}
+
+		if rmc.Count != nil {
+			if amc.Count == nil {
+				mismatches = append(mismatches, fmt.Sprintf("%s Capability %s: count is not set, expected %d", rmc.Type, rmc.Name, *rmc.Count))
+			} else if *rmc.Count != *amc.Count {
+				mismatches = append(mismatches, fmt.Sprintf("%s Capability %s: count is %d, expected %d", rmc.Type, rmc.Name, *amc.Count, *rmc.Count))
+			}
+		}
+	}
+
+	if mode == MachineCapabilityMatchExact {
+		for i := range actual {
+			amc := &actual[i]
+			if !requiredMap[amc.Type+"-"+amc.Name] {
+				mismatches = append(mismatches, fmt.Sprintf("%s Capability %s is not part of Instance Type", amc.Type, amc.Name))
+			}
+		}
+	}
+
+	return mismatches
+}
+
 // TODO: Add follow up migration to remove description, value_str and value_int
 
 // GetIndentedJSON returns formatted json of MachineCapability
@@ -1506,3 +1506,87 @@ func TestMachineCapability_GetIntInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchMachineCapabilities(t *testing.T) {
+	required := []MachineCapability{
+		{Type: MachineCapabilityTypeCPU, Name: "AMD Opteron Series x10", Frequency: db.GetStrPtr("3.0GHz"), Count: db.GetIntPtr(2)},
+		{Type: MachineCapabilityTypeGPU, Name: "NVIDIA H100", Vendor: db.GetStrPtr("NVIDIA"), Count: db.GetIntPtr(8)},
+		{Type: MachineCapabilityTypeInfiniBand, Name: "MT2910 Family [ConnectX-7]", Count: db.GetIntPtr(8), InactiveDevices: []int{}},
+	}
+
+	matching := func() []MachineCapability {
+		return []MachineCapability{
+			{Type: MachineCapabilityTypeCPU, Name: "AMD Opteron Series x10", Frequency: db.GetStrPtr("3.0GHz"), Cores: db.GetIntPtr(32), Count: db.GetIntPtr(2)},
+			{Type: MachineCapabilityTypeGPU, Name: "NVIDIA H100", Vendor: db.GetStrPtr("NVIDIA"), Count: db.GetIntPtr(8)},
+			{Type: MachineCapabilityTypeInfiniBand, Name: "MT2910 Family [ConnectX-7]", Count: db.GetIntPtr(8), InactiveDevices: []int{}},
+			{Type: MachineCapabilityTypeNetwork, Name: "MT2910 Family [ConnectX-7]", Count: db.GetIntPtr(2)},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		actual func() []MachineCapability
+		mode   string
+		want   []string
+	}{
+		{
+			name: "test exact match returns no mismatches",
+			actual: func() []MachineCapability {
+				return matching()[:3]
+			},
+			mode: MachineCapabilityMatchExact,
+			want: []string{},
+		},
+		{
+			name:   "test additional Machine Capability is reported in exact mode",
+			actual: matching,
+			mode:   MachineCapabilityMatchExact,
+			want:   []string{"Network Capability MT2910 Family [ConnectX-7] is not part of Instance Type"},
+		},
+		{
+			name:   "test additional Machine Capability is ignored in subset mode",
+			actual: matching,
+			mode:   MachineCapabilityMatchSubset,
+			want:   []string{},
+		},
+		{
+			name: "test missing Capability is reported",
+			actual: func() []MachineCapability {
+				return matching()[:2]
+			},
+			mode: MachineCapabilityMatchSubset,
+			want: []string{"InfiniBand Capability MT2910 Family [ConnectX-7] not found"},
+		},
+		{
+			name: "test Capability with same name but different type is not matched",
+			actual: func() []MachineCapability {
+				mcs := matching()
+				mcs[2].Type = MachineCapabilityTypeStorage
+				return mcs
+			},
+			mode: MachineCapabilityMatchSubset,
+			want: []string{"InfiniBand Capability MT2910 Family [ConnectX-7] not found"},
+		},
+		{
+			name: "test attribute mismatches are reported",
+			actual: func() []MachineCapability {
+				mcs := matching()
+				mcs[0].Frequency = nil
+				mcs[1].Count = db.GetIntPtr(4)
+				mcs[2].InactiveDevices = []int{3}
+				return mcs
+			},
+			mode: MachineCapabilityMatchSubset,
+			want: []string{
+				"CPU Capability AMD Opteron Series x10: frequency is not set, expected 3.0GHz",
+				"GPU Capability NVIDIA H100: count is 4, expected 8",
+				"InfiniBand Capability MT2910 Family [ConnectX-7]: inactive devices are [3], expected []",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MatchMachineCapabilities(required, tt.actual(), tt.mode))
+		})
+	}
+}
@@ -20,7 +20,10 @@ package model
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,6 +33,7 @@ import (
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 	"github.com/uptrace/bun"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 
 	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
 )
@@ -114,11 +118,159 @@ func (s *NetworkSecurityGroup) GetRulesAsProtoRefs() []*cwssaws.NetworkSecurityG
 	return rules
 }
 
+// HasReferences returns whether any rule of the NetworkSecurityGroup references an AddressSet or another NetworkSecurityGroup
+func (s *NetworkSecurityGroup) HasReferences() bool {
+	for _, rule := range s.Rules {
+		if rule != nil && rule.HasReferences() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReferencedIDs returns the IDs of the AddressSets and the Network Security Groups referenced by the rules
+func (s *NetworkSecurityGroup) GetReferencedIDs() (addressSetIDs []uuid.UUID, networkSecurityGroupIDs []string) {
+	seen := map[string]bool{}
+
+	for _, rule := range s.Rules {
+		if rule == nil {
+			continue
+		}
+
+		for _, id := range []*string{rule.SourceAddressSetID, rule.DestinationAddressSetID} {
+			if id == nil || seen[*id] {
+				continue
+			}
+			seen[*id] = true
+			if parsed, err := uuid.Parse(*id); err == nil {
+				addressSetIDs = append(addressSetIDs, parsed)
+			}
+		}
+
+		for _, id := range []*string{rule.SourceNetworkSecurityGroupID, rule.DestinationNetworkSecurityGroupID} {
+			if id == nil || seen[*id] {
+				continue
+			}
+			seen[*id] = true
+			networkSecurityGroupIDs = append(networkSecurityGroupIDs, *id)
+		}
+	}
+
+	return addressSetIDs, networkSecurityGroupIDs
+}
+
+// GetRulesAsResolvedProtoRefs returns the rules as they must be sent to the Site. Rules that reference
+// an AddressSet or a Network Security Group are expanded into one rule per referenced prefix, with the
+// prefixes of each reference taken from prefixesByReferenceID. A reference that resolves to no prefixes
+// results in no rules.
+func (s *NetworkSecurityGroup) GetRulesAsResolvedProtoRefs(prefixesByReferenceID map[string][]string) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, error) {
+	if s.Rules == nil {
+		return nil, nil
+	}
+
+	rules := []*cwssaws.NetworkSecurityGroupRuleAttributes{}
+
+	for _, rule := range s.Rules {
+		if rule == nil || rule.NetworkSecurityGroupRuleAttributes == nil {
+			continue
+		}
+
+		if !rule.HasReferences() {
+			rules = append(rules, rule.NetworkSecurityGroupRuleAttributes)
+			continue
+		}
+
+		srcPrefixes, err := rule.resolvePrefixes(rule.SourceAddressSetID, rule.SourceNetworkSecurityGroupID, prefixesByReferenceID)
+		if err != nil {
+			return nil, err
+		}
+		if srcPrefixes == nil {
+			if srcNet, ok := rule.GetSourceNet().(*cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix); ok {
+				srcPrefixes = []string{srcNet.SrcPrefix}
+			}
+		}
+
+		dstPrefixes, err := rule.resolvePrefixes(rule.DestinationAddressSetID, rule.DestinationNetworkSecurityGroupID, prefixesByReferenceID)
+		if err != nil {
+			return nil, err
+		}
+		if dstPrefixes == nil {
+			if dstNet, ok := rule.GetDestinationNet().(*cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix); ok {
+				dstPrefixes = []string{dstNet.DstPrefix}
+			}
+		}
+
+		count := len(srcPrefixes) * len(dstPrefixes)
+		for i, srcPrefix := range srcPrefixes {
+			for j, dstPrefix := range dstPrefixes {
+				expanded := proto.Clone(rule.NetworkSecurityGroupRuleAttributes).(*cwssaws.NetworkSecurityGroupRuleAttributes)
+				expanded.SourceNet = &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: srcPrefix}
+				expanded.DestinationNet = &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: dstPrefix}
+
+				// Rule IDs must stay unique on Site
+				if expanded.Id != nil && count > 1 {
+					expanded.Id = db.GetStrPtr(fmt.Sprintf("%s-%d", *expanded.Id, i*len(dstPrefixes)+j+1))
+				}
+
+				rules = append(rules, expanded)
+			}
+		}
+	}
+
+	return rules, nil
+}
+
 // A light wrapper around the protobuf so
 // that we can implement our own marshal/unmarshal
-// that understands how to work with protobuf messages
+// that understands how to work with protobuf messages.
+// Sites only understand literal prefixes, so references to
+// AddressSets and Network Security Groups are kept alongside
+// the protobuf and expanded before rules are sent to Site.
 type NetworkSecurityGroupRule struct {
 	*cwssaws.NetworkSecurityGroupRuleAttributes
+	// SourceAddressSetID is the ID of the AddressSet whose prefixes are used as the source
+	SourceAddressSetID *string
+	// DestinationAddressSetID is the ID of the AddressSet whose prefixes are used as the destination
+	DestinationAddressSetID *string
+	// SourceNetworkSecurityGroupID is the ID of the Network Security Group whose Instances' addresses are used as the source
+	SourceNetworkSecurityGroupID *string
+	// DestinationNetworkSecurityGroupID is the ID of the Network Security Group whose Instances' addresses are used as the destination
+	DestinationNetworkSecurityGroupID *string
+}
+
+// networkSecurityGroupRuleReferences holds the JSON representation of the references of a NetworkSecurityGroupRule
+type networkSecurityGroupRuleReferences struct {
+	SourceAddressSetID                *string `json:"srcAddressSetId,omitempty"`
+	DestinationAddressSetID           *string `json:"dstAddressSetId,omitempty"`
+	SourceNetworkSecurityGroupID      *string `json:"srcNetworkSecurityGroupId,omitempty"`
+	DestinationNetworkSecurityGroupID *string `json:"dstNetworkSecurityGroupId,omitempty"`
+}
+
+// HasReferences returns true if the rule references an AddressSet or a Network Security Group
+func (s *NetworkSecurityGroupRule) HasReferences() bool {
+	return s.SourceAddressSetID != nil || s.DestinationAddressSetID != nil ||
+		s.SourceNetworkSecurityGroupID != nil || s.DestinationNetworkSecurityGroupID != nil
+}
+
+// resolvePrefixes returns the prefixes of whichever of the given references is set, or nil if neither is
+func (s *NetworkSecurityGroupRule) resolvePrefixes(addressSetID *string, networkSecurityGroupID *string, prefixesByReferenceID map[string][]string) ([]string, error) {
+	for _, id := range []*string{addressSetID, networkSecurityGroupID} {
+		if id == nil {
+			continue
+		}
+
+		prefixes, found := prefixesByReferenceID[*id]
+		if !found {
+			return nil, fmt.Errorf("prefixes for rule reference %s were not resolved", *id)
+		}
+
+		if prefixes == nil {
+			prefixes = []string{}
+		}
+		return prefixes, nil
+	}
+
+	return nil, nil
 }
 
 func (s *NetworkSecurityGroupRule) UnmarshalJSON(b []byte) error {
@@ -135,11 +287,59 @@ func (s *NetworkSecurityGroupRule) UnmarshalJSON(b []byte) error {
 	// If they then save the change, the record on site would lose the detail.
 	_ = protoJsonUnmarshalOptions.Unmarshal(b, s)
 
+	refs := networkSecurityGroupRuleReferences{}
+	if err := json.Unmarshal(b, &refs); err == nil {
+		s.SourceAddressSetID = refs.SourceAddressSetID
+		s.DestinationAddressSetID = refs.DestinationAddressSetID
+		s.SourceNetworkSecurityGroupID = refs.SourceNetworkSecurityGroupID
+		s.DestinationNetworkSecurityGroupID = refs.DestinationNetworkSecurityGroupID
+	}
+
 	return nil
 }
 
 func (s *NetworkSecurityGroupRule) MarshalJSON() ([]byte, error) {
-	return protojson.Marshal(s)
+	b, err := protojson.Marshal(s)
+	if err != nil || !s.HasReferences() {
+		return b, err
+	}
+
+	// Merge the references into the protobuf JSON
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+
+	refs, err := json.Marshal(networkSecurityGroupRuleReferences{
+		SourceAddressSetID:                s.SourceAddressSetID,
+		DestinationAddressSetID:           s.DestinationAddressSetID,
+		SourceNetworkSecurityGroupID:      s.SourceNetworkSecurityGroupID,
+		DestinationNetworkSecurityGroupID: s.DestinationNetworkSecurityGroupID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(refs, &fields); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
+
+// NetworkSecurityGroupAddressPrefix returns an IP address or prefix as a prefix, single addresses are returned as a host prefix
+func NetworkSecurityGroupAddressPrefix(address string) (string, error) {
+	if _, ipNet, err := net.ParseCIDR(address); err == nil {
+		return ipNet.String(), nil
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return "", fmt.Errorf("`%s` is not a valid IP address or prefix", address)
+	}
+	if ip.To4() != nil {
+		return ip.String() + "/32", nil
+	}
+	return ip.String() + "/128", nil
 }
 
 // A light wrapper around the protobuf so
@@ -209,6 +409,10 @@ type NetworkSecurityGroupFilterInput struct {
 	SiteIDs                 []uuid.UUID
 	Statuses                []string
 	SearchQuery             *string
+	// ReferencedAddressSetIDs filters to Network Security Groups with rules referencing any of the AddressSets
+	ReferencedAddressSetIDs []uuid.UUID
+	// ReferencedNetworkSecurityGroupIDs filters to Network Security Groups with rules referencing any of the Network Security Groups
+	ReferencedNetworkSecurityGroupIDs []string
 }
 
 // NetworkSecurityGroupDeleteInput input parameters for Delete method
@@ -400,6 +604,24 @@ func (sgsd NetworkSecurityGroupSQLDAO) GetAll(ctx context.Context, tx *db.Tx, fi
 		sgsd.tracerSpan.SetAttribute(networkSecurityGroupDAOSpan, "tenant_organization_ids", filter.Statuses)
 	}
 
+	if filter.ReferencedAddressSetIDs != nil {
+		ids := make([]string, 0, len(filter.ReferencedAddressSetIDs))
+		for _, id := range filter.ReferencedAddressSetIDs {
+			ids = append(ids, id.String())
+		}
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return whereNetworkSecurityGroupRulesReference(q, "srcAddressSetId", "dstAddressSetId", ids)
+		})
+		sgsd.tracerSpan.SetAttribute(networkSecurityGroupDAOSpan, "referenced_address_set_ids", ids)
+	}
+
+	if filter.ReferencedNetworkSecurityGroupIDs != nil {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return whereNetworkSecurityGroupRulesReference(q, "srcNetworkSecurityGroupId", "dstNetworkSecurityGroupId", filter.ReferencedNetworkSecurityGroupIDs)
+		})
+		sgsd.tracerSpan.SetAttribute(networkSecurityGroupDAOSpan, "referenced_network_security_group_ids", filter.ReferencedNetworkSecurityGroupIDs)
+	}
+
 	if filter.SearchQuery != nil {
 		normalizedTokens := db.GetStrPtr(db.GetStringToTsQuery(*filter.SearchQuery))
 		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
@@ -435,6 +657,21 @@ func (sgsd NetworkSecurityGroupSQLDAO) GetAll(ctx context.Context, tx *db.Tx, fi
 	return sgs, paginator.Total, nil
 }
 
+// whereNetworkSecurityGroupRulesReference matches Network Security Groups with a rule whose source or destination reference is one of the IDs
+func whereNetworkSecurityGroupRulesReference(q *bun.SelectQuery, srcKey string, dstKey string, ids []string) *bun.SelectQuery {
+	// An empty list must not match anything
+	q = q.Where("FALSE")
+
+	for _, id := range ids {
+		for _, key := range []string{srcKey, dstKey} {
+			contains, _ := json.Marshal([]map[string]string{{key: id}})
+			q = q.WhereOr("nsg.rules @> ?::jsonb", string(contains))
+		}
+	}
+
+	return q
+}
+
 // Update updates specified fields of an existing NetworkSecurityGroup
 // The updated fields are assumed to be set to non-null values
 // For setting to null values, use: Clear
@@ -71,7 +71,7 @@ func TestNetworkSecurityGroupSQLDAO_Create(t *testing.T) {
 	labels["key"] = "value"
 
 	rule := &NetworkSecurityGroupRule{
-		&cwssaws.NetworkSecurityGroupRuleAttributes{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
 			Id:             db.GetStrPtr(uuid.NewString()),
 			Direction:      cwssaws.NetworkSecurityGroupRuleDirection_NSG_RULE_DIRECTION_EGRESS,
 			Protocol:       cwssaws.NetworkSecurityGroupRuleProtocol_NSG_RULE_PROTO_ANY,
@@ -514,11 +514,11 @@ func TestNetworkSecurityGroupSQLDAO_Update(t *testing.T) {
 	labels["key"] = "value"
 
 	rules := []*NetworkSecurityGroupRule{
-		&NetworkSecurityGroupRule{&cwssaws.NetworkSecurityGroupRuleAttributes{}},
+		&NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{}},
 	}
 
 	badRules := []*NetworkSecurityGroupRule{
-		&NetworkSecurityGroupRule{&cwssaws.NetworkSecurityGroupRuleAttributes{}},
+		&NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{}},
 		nil,
 	}
 
@@ -702,3 +702,201 @@ func TestNetworkSecurityGroupSQLDAO_DeleteByID(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkSecurityGroupSQLDAO_GetAllByReference(t *testing.T) {
+	ctx := context.Background()
+	dbSession := testInstanceInitDB(t)
+	defer dbSession.Close()
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+	ip := testInstanceBuildInfrastructureProvider(t, dbSession, "testIP")
+	site := testInstanceBuildSite(t, dbSession, ip, "testSite")
+	tenant := testInstanceBuildTenant(t, dbSession, "testTenant")
+	user := testInstanceBuildUser(t, dbSession, "testUser")
+
+	sgsd := NewNetworkSecurityGroupDAO(dbSession)
+
+	addressSetID := uuid.New()
+
+	referenced, err := sgsd.Create(ctx, nil, NetworkSecurityGroupCreateInput{Name: "referenced", SiteID: site.ID, TenantOrg: tenant.Org, TenantID: tenant.ID, Status: NetworkSecurityGroupStatusReady, CreatedByID: user.ID})
+	assert.Nil(t, err)
+
+	referencing, err := sgsd.Create(ctx, nil, NetworkSecurityGroupCreateInput{
+		Name: "referencing", SiteID: site.ID, TenantOrg: tenant.Org, TenantID: tenant.ID, Status: NetworkSecurityGroupStatusReady, CreatedByID: user.ID,
+		Rules: []*NetworkSecurityGroupRule{
+			{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+					Id:             db.GetStrPtr("from-corp"),
+					DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+				},
+				SourceAddressSetID: db.GetStrPtr(addressSetID.String()),
+			},
+			{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+					Id:        db.GetStrPtr("to-peers"),
+					SourceNet: &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "0.0.0.0/0"},
+				},
+				DestinationNetworkSecurityGroupID: db.GetStrPtr(referenced.ID),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// References survive the round trip through the DB
+	got, err := sgsd.GetByID(ctx, nil, referencing.ID, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, addressSetID.String(), *got.Rules[0].SourceAddressSetID)
+	assert.Equal(t, referenced.ID, *got.Rules[1].DestinationNetworkSecurityGroupID)
+
+	nsgs, total, err := sgsd.GetAll(ctx, nil, NetworkSecurityGroupFilterInput{ReferencedAddressSetIDs: []uuid.UUID{addressSetID}}, paginator.PageInput{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, referencing.ID, nsgs[0].ID)
+
+	nsgs, total, err = sgsd.GetAll(ctx, nil, NetworkSecurityGroupFilterInput{ReferencedNetworkSecurityGroupIDs: []string{referenced.ID}}, paginator.PageInput{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, referencing.ID, nsgs[0].ID)
+
+	_, total, err = sgsd.GetAll(ctx, nil, NetworkSecurityGroupFilterInput{ReferencedAddressSetIDs: []uuid.UUID{uuid.New()}}, paginator.PageInput{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+
+	_, total, err = sgsd.GetAll(ctx, nil, NetworkSecurityGroupFilterInput{ReferencedNetworkSecurityGroupIDs: []string{}}, paginator.PageInput{}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, total)
+}
+
+func TestNetworkSecurityGroupRule_JSON(t *testing.T) {
+	addressSetID := uuid.NewString()
+
+	rule := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             db.GetStrPtr("from-corp"),
+			Priority:       10,
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+		SourceAddressSetID: db.GetStrPtr(addressSetID),
+	}
+
+	b, err := rule.MarshalJSON()
+	assert.Nil(t, err)
+	assert.Contains(t, string(b), `"srcAddressSetId":"`+addressSetID+`"`)
+
+	got := &NetworkSecurityGroupRule{}
+	assert.Nil(t, got.UnmarshalJSON(b))
+	assert.True(t, proto.Equal(rule.NetworkSecurityGroupRuleAttributes, got.NetworkSecurityGroupRuleAttributes))
+	assert.Equal(t, addressSetID, *got.SourceAddressSetID)
+	assert.Nil(t, got.DestinationAddressSetID)
+	assert.True(t, got.HasReferences())
+
+	// Rules without references are plain protobuf JSON
+	plain := &NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule.NetworkSecurityGroupRuleAttributes}
+	b, err = plain.MarshalJSON()
+	assert.Nil(t, err)
+	assert.NotContains(t, string(b), "AddressSetId")
+
+	got = &NetworkSecurityGroupRule{}
+	assert.Nil(t, got.UnmarshalJSON(b))
+	assert.False(t, got.HasReferences())
+}
+
+func TestNetworkSecurityGroup_GetRulesAsResolvedProtoRefs(t *testing.T) {
+	corpID := uuid.NewString()
+	emptyID := uuid.NewString()
+	peerNSGID := uuid.NewString()
+
+	literal := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             db.GetStrPtr("literal"),
+			SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: "1.1.1.0/24"},
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+	}
+
+	fromCorp := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             db.GetStrPtr("from-corp"),
+			Priority:       20,
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+		SourceAddressSetID: db.GetStrPtr(corpID),
+	}
+
+	corpToPeers := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Priority: 30,
+		},
+		SourceAddressSetID:                db.GetStrPtr(corpID),
+		DestinationNetworkSecurityGroupID: db.GetStrPtr(peerNSGID),
+	}
+
+	fromEmpty := &NetworkSecurityGroupRule{
+		NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             db.GetStrPtr("from-empty"),
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		},
+		SourceAddressSetID: db.GetStrPtr(emptyID),
+	}
+
+	nsg := &NetworkSecurityGroup{Rules: []*NetworkSecurityGroupRule{literal, fromCorp, corpToPeers, fromEmpty}}
+
+	addressSetIDs, nsgIDs := nsg.GetReferencedIDs()
+	assert.ElementsMatch(t, []uuid.UUID{uuid.MustParse(corpID), uuid.MustParse(emptyID)}, addressSetIDs)
+	assert.Equal(t, []string{peerNSGID}, nsgIDs)
+
+	prefixes := map[string][]string{
+		corpID:    {"10.0.0.0/8", "172.16.0.0/12"},
+		emptyID:   {},
+		peerNSGID: {"192.168.0.10/32"},
+	}
+
+	rules, err := nsg.GetRulesAsResolvedProtoRefs(prefixes)
+	assert.Nil(t, err)
+	assert.Equal(t, 5, len(rules))
+
+	// Literal rules are passed through as they are
+	assert.Equal(t, literal.NetworkSecurityGroupRuleAttributes, rules[0])
+
+	// Expanded rules get unique IDs
+	assert.Equal(t, "from-corp-1", rules[1].GetId())
+	assert.Equal(t, "10.0.0.0/8", rules[1].GetSrcPrefix())
+	assert.Equal(t, "from-corp-2", rules[2].GetId())
+	assert.Equal(t, "172.16.0.0/12", rules[2].GetSrcPrefix())
+	assert.Equal(t, uint32(20), rules[2].Priority)
+	assert.Equal(t, "0.0.0.0/0", rules[2].GetDstPrefix())
+
+	// Unnamed rules stay unnamed
+	assert.Nil(t, rules[3].Id)
+	assert.Equal(t, "10.0.0.0/8", rules[3].GetSrcPrefix())
+	assert.Equal(t, "192.168.0.10/32", rules[3].GetDstPrefix())
+	assert.Equal(t, "172.16.0.0/12", rules[4].GetSrcPrefix())
+
+	// The stored rule is not modified
+	assert.Nil(t, fromCorp.SourceNet)
+
+	// Every reference must be resolved
+	delete(prefixes, peerNSGID)
+	_, err = nsg.GetRulesAsResolvedProtoRefs(prefixes)
+	assert.NotNil(t, err)
+}
+
+func TestNetworkSecurityGroupAddressPrefix(t *testing.T) {
+	tests := []struct {
+		address string
+		want    string
+		wantErr bool
+	}{
+		{address: "10.0.0.5", want: "10.0.0.5/32"},
+		{address: "10.0.0.5/24", want: "10.0.0.0/24"},
+		{address: "fd00::1", want: "fd00::1/128"},
+		{address: "not-an-address", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			got, err := NetworkSecurityGroupAddressPrefix(tt.address)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -59,6 +59,9 @@ func testOperatingSystemSetupSchema(t *testing.T, dbSession *db.Session) {
 	// create OperatingSystem table
 	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystem)(nil))
 	assert.Nil(t, err)
+	// create OperatingSystemVersion table
+	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystemVersion)(nil))
+	assert.Nil(t, err)
 	// create OperatingSystemSiteAssociation table
 	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystemSiteAssociation)(nil))
 	assert.Nil(t, err)
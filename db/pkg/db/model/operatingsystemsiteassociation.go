@@ -38,7 +38,8 @@ var (
 
 	// OperatingSystemSiteAssociationRelatedEntities is a list of valid relation by fields for the OperatingSystemSiteAssociation model
 	OperatingSystemSiteAssociationRelatedEntities = map[string]bool{
-		OperatingSystemRelationName: true,
+		OperatingSystemRelationName:        true,
+		OperatingSystemVersionRelationName: true,
 	}
 
 	// OperatingSystemSiteAssociationEntityTypes is a list of valid choices for the EntityType field
@@ -73,30 +74,44 @@ var (
 )
 
 // OperatingSystemSiteAssociation associates an OperatingSystem with different Sites
+// An association with an OperatingSystemVersionID tracks the sync of that specific version to the Site,
+// otherwise it tracks the sync of the OperatingSystem itself
 type OperatingSystemSiteAssociation struct {
 	bun.BaseModel `bun:"table:operating_system_site_association,alias:ossa"`
 
-	ID                uuid.UUID        `bun:"type:uuid,pk"`
-	OperatingSystemID uuid.UUID        `bun:"operating_system_id,type:uuid,notnull"`
-	OperatingSystem   *OperatingSystem `bun:"rel:belongs-to,join:operating_system_id=id"`
-	SiteID            uuid.UUID        `bun:"site_id,type:uuid,notnull"`
-	Site              *Site            `bun:"rel:belongs-to,join:site_id=id"`
-	Version           *string          `bun:"version"`
-	Status            string           `bun:"status,notnull"`
-	IsMissingOnSite   bool             `bun:"is_missing_on_site,notnull"`
-	Created           time.Time        `bun:"created,nullzero,notnull,default:current_timestamp"`
-	Updated           time.Time        `bun:"updated,nullzero,notnull,default:current_timestamp"`
-	Deleted           *time.Time       `bun:"deleted,soft_delete"`
-	CreatedBy         uuid.UUID        `bun:"created_by,type:uuid,notnull"`
+	ID                       uuid.UUID               `bun:"type:uuid,pk"`
+	OperatingSystemID        uuid.UUID               `bun:"operating_system_id,type:uuid,notnull"`
+	OperatingSystem          *OperatingSystem        `bun:"rel:belongs-to,join:operating_system_id=id"`
+	OperatingSystemVersionID *uuid.UUID              `bun:"operating_system_version_id,type:uuid"`
+	OperatingSystemVersion   *OperatingSystemVersion `bun:"rel:belongs-to,join:operating_system_version_id=id"`
+	SiteID                   uuid.UUID               `bun:"site_id,type:uuid,notnull"`
+	Site                     *Site                   `bun:"rel:belongs-to,join:site_id=id"`
+	Version                  *string                 `bun:"version"`
+	Status                   string                  `bun:"status,notnull"`
+	IsMissingOnSite          bool                    `bun:"is_missing_on_site,notnull"`
+	Created                  time.Time               `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated                  time.Time               `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted                  *time.Time              `bun:"deleted,soft_delete"`
+	CreatedBy                uuid.UUID               `bun:"created_by,type:uuid,notnull"`
+}
+
+// GetSiteImageID returns the ID the image is known by on Site
+// Version associations use the OperatingSystemVersion ID, otherwise the OperatingSystem ID is used
+func (ossa *OperatingSystemSiteAssociation) GetSiteImageID() uuid.UUID {
+	if ossa.OperatingSystemVersionID != nil {
+		return *ossa.OperatingSystemVersionID
+	}
+	return ossa.OperatingSystemID
 }
 
 // OperatingSystemSiteAssociationCreateInput input parameters for Create method
 type OperatingSystemSiteAssociationCreateInput struct {
-	OperatingSystemID uuid.UUID
-	SiteID            uuid.UUID
-	Version           *string
-	Status            string
-	CreatedBy         uuid.UUID
+	OperatingSystemID        uuid.UUID
+	OperatingSystemVersionID *uuid.UUID
+	SiteID                   uuid.UUID
+	Version                  *string
+	Status                   string
+	CreatedBy                uuid.UUID
 }
 
 // OperatingSystemSiteAssociationUpdateInput input parameters for Update method
@@ -110,10 +125,12 @@ type OperatingSystemSiteAssociationUpdateInput struct {
 }
 
 type OperatingSystemSiteAssociationFilterInput struct {
-	OperatingSystemIDs []uuid.UUID
-	SiteIDs            []uuid.UUID
-	Versions           []string
-	Statuses           []string
+	OperatingSystemIDs        []uuid.UUID
+	OperatingSystemVersionIDs []uuid.UUID
+	HasOperatingSystemVersion *bool
+	SiteIDs                   []uuid.UUID
+	Versions                  []string
+	Statuses                  []string
 }
 
 var _ bun.BeforeAppendModelHook = (*OperatingSystemSiteAssociation)(nil)
@@ -176,12 +193,13 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) Create(
 	}
 
 	ossa := &OperatingSystemSiteAssociation{
-		ID:                uuid.New(),
-		OperatingSystemID: input.OperatingSystemID,
-		SiteID:            input.SiteID,
-		Version:           input.Version,
-		Status:            input.Status,
-		CreatedBy:         input.CreatedBy,
+		ID:                       uuid.New(),
+		OperatingSystemID:        input.OperatingSystemID,
+		OperatingSystemVersionID: input.OperatingSystemVersionID,
+		SiteID:                   input.SiteID,
+		Version:                  input.Version,
+		Status:                   input.Status,
+		CreatedBy:                input.CreatedBy,
 	}
 
 	_, err := db.GetIDB(tx, ossasd.dbSession).NewInsert().Model(ossa).Exec(ctx)
@@ -228,6 +246,7 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) GetByID(ctx context.Context,
 }
 
 // GetByOperatingSystemIDAndSiteID returns an OperatingSystemSiteAssociation by OperatingSystemID and SiteID
+// Only the association of the OperatingSystem itself is returned, version associations are excluded
 // returns db.ErrDoesNotExist error if the record is not found
 func (ossasd OperatingSystemSiteAssociationSQLDAO) GetByOperatingSystemIDAndSiteID(ctx context.Context, tx *db.Tx, OperatingSystemID uuid.UUID, siteID uuid.UUID, includeRelations []string) (*OperatingSystemSiteAssociation, error) {
 	// Create a child span and set the attributes for current request
@@ -241,7 +260,7 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) GetByOperatingSystemIDAndSite
 
 	ossa := &OperatingSystemSiteAssociation{}
 
-	query := db.GetIDB(tx, ossasd.dbSession).NewSelect().Model(ossa).Where("ossa.operating_system_id = ?", OperatingSystemID.String()).Where("ossa.site_id = ?", siteID.String())
+	query := db.GetIDB(tx, ossasd.dbSession).NewSelect().Model(ossa).Where("ossa.operating_system_id = ?", OperatingSystemID.String()).Where("ossa.site_id = ?", siteID.String()).Where("ossa.operating_system_version_id IS NULL")
 
 	for _, relation := range includeRelations {
 		query = query.Relation(relation)
@@ -276,6 +295,18 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) GetAll(ctx context.Context, t
 		query = query.Where("ossa.operating_system_id IN (?)", bun.In(filter.OperatingSystemIDs))
 		ossasd.tracerSpan.SetAttribute(OperatingSystemSiteAssociationDAOSpan, "operating_system_id", filter.OperatingSystemIDs)
 	}
+	if filter.OperatingSystemVersionIDs != nil {
+		query = query.Where("ossa.operating_system_version_id IN (?)", bun.In(filter.OperatingSystemVersionIDs))
+		ossasd.tracerSpan.SetAttribute(OperatingSystemSiteAssociationDAOSpan, "operating_system_version_id", filter.OperatingSystemVersionIDs)
+	}
+	if filter.HasOperatingSystemVersion != nil {
+		if *filter.HasOperatingSystemVersion {
+			query = query.Where("ossa.operating_system_version_id IS NOT NULL")
+		} else {
+			query = query.Where("ossa.operating_system_version_id IS NULL")
+		}
+		ossasd.tracerSpan.SetAttribute(OperatingSystemSiteAssociationDAOSpan, "has_operating_system_version", *filter.HasOperatingSystemVersion)
+	}
 	if filter.SiteIDs != nil {
 		query = query.Where("ossa.site_id IN (?)", bun.In(filter.SiteIDs))
 		ossasd.tracerSpan.SetAttribute(OperatingSystemSiteAssociationDAOSpan, "site_id", filter.SiteIDs)
@@ -314,7 +345,7 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) GetAll(ctx context.Context, t
 // GenerateAndUpdateVersion is a utility function to generate latest version and update the OperatingSystemSiteAssociation
 func (ossasd OperatingSystemSiteAssociationSQLDAO) GenerateAndUpdateVersion(ctx context.Context, tx *db.Tx, id uuid.UUID) (*OperatingSystemSiteAssociation, error) {
 	// Retrieve Operating Systemp Association details for calculating hash version based on OperatingSystemSiteAssociation ID
-	dbossa, err := ossasd.GetByID(ctx, tx, id, []string{OperatingSystemRelationName})
+	dbossa, err := ossasd.GetByID(ctx, tx, id, []string{OperatingSystemRelationName, OperatingSystemVersionRelationName})
 	if err != nil {
 		return nil, err
 	}
@@ -323,8 +354,19 @@ func (ossasd OperatingSystemSiteAssociationSQLDAO) GenerateAndUpdateVersion(ctx
 	hash := sha1.New()
 	hash.Write([]byte(dbossa.OperatingSystemID.String()))
 
+	// Version associations are hashed on the immutable image parameters of the version
+	if dbossa.OperatingSystemVersion != nil {
+		osv := dbossa.OperatingSystemVersion
+		hash.Write([]byte(osv.ID.String()))
+		for _, param := range []*string{osv.ImageURL, osv.ImageSHA, osv.ImageAuthType, osv.ImageAuthToken, osv.ImageDisk, osv.RootFsID, osv.RootFsLabel} {
+			if param != nil {
+				hash.Write([]byte(*param))
+			}
+		}
+	}
+
 	// Update hash based on Operating System parameter (Image based OS)
-	if dbossa.OperatingSystem != nil {
+	if dbossa.OperatingSystem != nil && dbossa.OperatingSystemVersion == nil {
 		if dbossa.OperatingSystem.ImageURL != nil {
 			hash.Write([]byte(*dbossa.OperatingSystem.ImageURL))
 		}
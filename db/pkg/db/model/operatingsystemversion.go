@@ -0,0 +1,488 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// OperatingSystemVersionStatusPending status is pending, the version has not been verified yet
+	OperatingSystemVersionStatusPending = "Pending"
+	// OperatingSystemVersionStatusVerifying status is verifying, the image is being fetched and checked
+	OperatingSystemVersionStatusVerifying = "Verifying"
+	// OperatingSystemVersionStatusReady status is ready, the version can be used for new Instances
+	OperatingSystemVersionStatusReady = "Ready"
+	// OperatingSystemVersionStatusError status is error, verification failed
+	OperatingSystemVersionStatusError = "Error"
+
+	// OperatingSystemVersionRelationName is the relation name for the OperatingSystemVersion model
+	OperatingSystemVersionRelationName = "OperatingSystemVersion"
+
+	// OperatingSystemVersionOrderByDefault default field to be used for ordering when none specified
+	OperatingSystemVersionOrderByDefault = "created"
+)
+
+var (
+	// OperatingSystemVersionOrderByFields is a list of valid order by fields for the OperatingSystemVersion model
+	OperatingSystemVersionOrderByFields = []string{"version", "status", "created", "updated"}
+	// OperatingSystemVersionRelatedEntities is a list of valid relation by fields for the OperatingSystemVersion model
+	OperatingSystemVersionRelatedEntities = map[string]bool{
+		OperatingSystemRelationName: true,
+	}
+	// OperatingSystemVersionStatusMap is a list of valid status for the OperatingSystemVersion model
+	OperatingSystemVersionStatusMap = map[string]bool{
+		OperatingSystemVersionStatusPending:   true,
+		OperatingSystemVersionStatusVerifying: true,
+		OperatingSystemVersionStatusReady:     true,
+		OperatingSystemVersionStatusError:     true,
+	}
+)
+
+// OperatingSystemVersion is an immutable revision of the image or iPXE script of an OperatingSystem.
+// Only the status, verification and deprecation attributes of a version can change after creation.
+type OperatingSystemVersion struct {
+	bun.BaseModel `bun:"table:operating_system_version,alias:osv"`
+
+	ID                uuid.UUID        `bun:"type:uuid,pk"`
+	OperatingSystemID uuid.UUID        `bun:"operating_system_id,type:uuid,notnull"`
+	OperatingSystem   *OperatingSystem `bun:"rel:belongs-to,join:operating_system_id=id"`
+	Version           string           `bun:"version,notnull"`
+	ImageURL          *string          `bun:"image_url"`
+	ImageSHA          *string          `bun:"image_sha"`
+	ImageAuthType     *string          `bun:"image_auth_type"`
+	ImageAuthToken    *string          `bun:"image_auth_token"`
+	ImageDisk         *string          `bun:"image_disk"`
+	RootFsID          *string          `bun:"root_fs_id"`
+	RootFsLabel       *string          `bun:"root_fs_label"`
+	IpxeScript        *string          `bun:"ipxe_script"`
+	Status            string           `bun:"status,notnull"`
+	Verified          *time.Time       `bun:"verified"`
+	Deprecated        *time.Time       `bun:"deprecated"`
+	DeprecationNote   *string          `bun:"deprecation_note"`
+	Created           time.Time        `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated           time.Time        `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted           *time.Time       `bun:"deleted,soft_delete"`
+	CreatedBy         uuid.UUID        `bun:"created_by,type:uuid,notnull"`
+}
+
+// IsDeprecated returns true if the version has been deprecated and must not be used for new Instances
+func (osv *OperatingSystemVersion) IsDeprecated() bool {
+	return osv.Deprecated != nil
+}
+
+// IsUsable returns true if the version can be used for new Instances
+func (osv *OperatingSystemVersion) IsUsable() bool {
+	return osv.Status == OperatingSystemVersionStatusReady && !osv.IsDeprecated()
+}
+
+// OperatingSystemVersionCreateInput input parameters for Create method
+type OperatingSystemVersionCreateInput struct {
+	OperatingSystemVersionID *uuid.UUID
+	OperatingSystemID        uuid.UUID
+	Version                  string
+	ImageURL                 *string
+	ImageSHA                 *string
+	ImageAuthType            *string
+	ImageAuthToken           *string
+	ImageDisk                *string
+	RootFsID                 *string
+	RootFsLabel              *string
+	IpxeScript               *string
+	Status                   string
+	CreatedBy                uuid.UUID
+}
+
+// OperatingSystemVersionUpdateInput input parameters for Update method
+// Image attributes are immutable and can not be updated
+type OperatingSystemVersionUpdateInput struct {
+	OperatingSystemVersionID uuid.UUID
+	Status                   *string
+	Verified                 *time.Time
+	Deprecated               *time.Time
+	DeprecationNote          *string
+}
+
+// OperatingSystemVersionClearInput input parameters for Clear method
+type OperatingSystemVersionClearInput struct {
+	OperatingSystemVersionID uuid.UUID
+	Verified                 bool
+	Deprecated               bool
+	DeprecationNote          bool
+}
+
+// OperatingSystemVersionFilterInput input parameters for GetAll method
+type OperatingSystemVersionFilterInput struct {
+	OperatingSystemVersionIDs []uuid.UUID
+	OperatingSystemIDs        []uuid.UUID
+	Versions                  []string
+	Statuses                  []string
+	IsDeprecated              *bool
+}
+
+var _ bun.BeforeAppendModelHook = (*OperatingSystemVersion)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (osv *OperatingSystemVersion) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		osv.Created = db.GetCurTime()
+		osv.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		osv.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*OperatingSystemVersion)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (osv *OperatingSystemVersion) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("operating_system_id") REFERENCES "operating_system" ("id")`)
+	return nil
+}
+
+// OperatingSystemVersionDAO is an interface for interacting with the OperatingSystemVersion model
+type OperatingSystemVersionDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input OperatingSystemVersionCreateInput) (*OperatingSystemVersion, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*OperatingSystemVersion, error)
+	//
+	GetLatestUsable(ctx context.Context, tx *db.Tx, operatingSystemID uuid.UUID, siteID *uuid.UUID, includeRelations []string) (*OperatingSystemVersion, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter OperatingSystemVersionFilterInput, page paginator.PageInput, includeRelations []string) ([]OperatingSystemVersion, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input OperatingSystemVersionUpdateInput) (*OperatingSystemVersion, error)
+	//
+	Clear(ctx context.Context, tx *db.Tx, input OperatingSystemVersionClearInput) (*OperatingSystemVersion, error)
+	//
+	Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error
+}
+
+// OperatingSystemVersionSQLDAO is an implementation of the OperatingSystemVersionDAO interface
+type OperatingSystemVersionSQLDAO struct {
+	dbSession *db.Session
+	OperatingSystemVersionDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new OperatingSystemVersion from the given parameters
+func (osvsd OperatingSystemVersionSQLDAO) Create(ctx context.Context, tx *db.Tx, input OperatingSystemVersionCreateInput) (*OperatingSystemVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.Create")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "operating_system_id", input.OperatingSystemID.String())
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "version", input.Version)
+	}
+
+	id := uuid.New()
+	if input.OperatingSystemVersionID != nil {
+		id = *input.OperatingSystemVersionID
+	}
+
+	osv := &OperatingSystemVersion{
+		ID:                id,
+		OperatingSystemID: input.OperatingSystemID,
+		Version:           input.Version,
+		ImageURL:          input.ImageURL,
+		ImageSHA:          input.ImageSHA,
+		ImageAuthType:     input.ImageAuthType,
+		ImageAuthToken:    input.ImageAuthToken,
+		ImageDisk:         input.ImageDisk,
+		RootFsID:          input.RootFsID,
+		RootFsLabel:       input.RootFsLabel,
+		IpxeScript:        input.IpxeScript,
+		Status:            input.Status,
+		CreatedBy:         input.CreatedBy,
+	}
+
+	_, err := db.GetIDB(tx, osvsd.dbSession).NewInsert().Model(osv).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return osvsd.GetByID(ctx, tx, osv.ID, nil)
+}
+
+// GetByID returns an OperatingSystemVersion by ID
+// returns db.ErrDoesNotExist error if the record is not found
+func (osvsd OperatingSystemVersionSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*OperatingSystemVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.GetByID")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "id", id.String())
+	}
+
+	osv := &OperatingSystemVersion{}
+
+	query := db.GetIDB(tx, osvsd.dbSession).NewSelect().Model(osv).Where("osv.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return osv, nil
+}
+
+// GetLatestUsable returns the most recently created OperatingSystemVersion of an OperatingSystem that is
+// Ready and not deprecated. If siteID is specified, only versions that have been synced to the Site are considered.
+// returns db.ErrDoesNotExist error if the OperatingSystem has no usable version
+func (osvsd OperatingSystemVersionSQLDAO) GetLatestUsable(ctx context.Context, tx *db.Tx, operatingSystemID uuid.UUID, siteID *uuid.UUID, includeRelations []string) (*OperatingSystemVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.GetLatestUsable")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "operating_system_id", operatingSystemID.String())
+	}
+
+	osv := &OperatingSystemVersion{}
+
+	query := db.GetIDB(tx, osvsd.dbSession).NewSelect().Model(osv).
+		Where("osv.operating_system_id = ?", operatingSystemID).
+		Where("osv.status = ?", OperatingSystemVersionStatusReady).
+		Where("osv.deprecated IS NULL").
+		Order("osv.created DESC").
+		Limit(1)
+
+	if siteID != nil {
+		query = query.Where("EXISTS (?)", db.GetIDB(tx, osvsd.dbSession).NewSelect().
+			Model((*OperatingSystemSiteAssociation)(nil)).
+			ColumnExpr("1").
+			Where("ossa.operating_system_version_id = osv.id").
+			Where("ossa.site_id = ?", *siteID).
+			Where("ossa.status = ?", OperatingSystemSiteAssociationStatusSynced))
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "site_id", siteID.String())
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return osv, nil
+}
+
+// GetAll returns all OperatingSystemVersions with various optional filters
+// errors are returned only when there is a db related error
+// if records not found, then error is nil, but length of returned slice is 0
+// if orderBy is nil, then records are ordered by column specified in OperatingSystemVersionOrderByDefault in ascending order
+func (osvsd OperatingSystemVersionSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter OperatingSystemVersionFilterInput, page paginator.PageInput, includeRelations []string) ([]OperatingSystemVersion, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.GetAll")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+	}
+
+	osvs := []OperatingSystemVersion{}
+
+	query := db.GetIDB(tx, osvsd.dbSession).NewSelect().Model(&osvs)
+
+	if filter.OperatingSystemVersionIDs != nil {
+		query = query.Where("osv.id IN (?)", bun.In(filter.OperatingSystemVersionIDs))
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "id", filter.OperatingSystemVersionIDs)
+	}
+	if filter.OperatingSystemIDs != nil {
+		query = query.Where("osv.operating_system_id IN (?)", bun.In(filter.OperatingSystemIDs))
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "operating_system_id", filter.OperatingSystemIDs)
+	}
+	if filter.Versions != nil {
+		query = query.Where("osv.version IN (?)", bun.In(filter.Versions))
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "version", filter.Versions)
+	}
+	if filter.Statuses != nil {
+		query = query.Where("osv.status IN (?)", bun.In(filter.Statuses))
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "status", filter.Statuses)
+	}
+	if filter.IsDeprecated != nil {
+		if *filter.IsDeprecated {
+			query = query.Where("osv.deprecated IS NOT NULL")
+		} else {
+			query = query.Where("osv.deprecated IS NULL")
+		}
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "is_deprecated", *filter.IsDeprecated)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(OperatingSystemVersionOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, OperatingSystemVersionOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return osvs, paginator.Total, nil
+}
+
+// Update updates the status, verification and deprecation attributes of an existing OperatingSystemVersion
+// The updated fields are assumed to be set to non-null values
+func (osvsd OperatingSystemVersionSQLDAO) Update(ctx context.Context, tx *db.Tx, input OperatingSystemVersionUpdateInput) (*OperatingSystemVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.Update")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "id", input.OperatingSystemVersionID.String())
+	}
+
+	osv := &OperatingSystemVersion{
+		ID: input.OperatingSystemVersionID,
+	}
+
+	updatedFields := []string{}
+
+	if input.Status != nil {
+		osv.Status = *input.Status
+		updatedFields = append(updatedFields, "status")
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "status", *input.Status)
+	}
+	if input.Verified != nil {
+		osv.Verified = input.Verified
+		updatedFields = append(updatedFields, "verified")
+	}
+	if input.Deprecated != nil {
+		osv.Deprecated = input.Deprecated
+		updatedFields = append(updatedFields, "deprecated")
+	}
+	if input.DeprecationNote != nil {
+		osv.DeprecationNote = input.DeprecationNote
+		updatedFields = append(updatedFields, "deprecation_note")
+	}
+
+	if len(updatedFields) > 0 {
+		updatedFields = append(updatedFields, "updated")
+
+		_, err := db.GetIDB(tx, osvsd.dbSession).NewUpdate().Model(osv).Column(updatedFields...).Where("osv.id = ?", input.OperatingSystemVersionID).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return osvsd.GetByID(ctx, tx, osv.ID, nil)
+}
+
+// Clear sets parameters of an existing OperatingSystemVersion to null values in db
+func (osvsd OperatingSystemVersionSQLDAO) Clear(ctx context.Context, tx *db.Tx, input OperatingSystemVersionClearInput) (*OperatingSystemVersion, error) {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.Clear")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "id", input.OperatingSystemVersionID.String())
+	}
+
+	osv := &OperatingSystemVersion{
+		ID: input.OperatingSystemVersionID,
+	}
+
+	updatedFields := []string{}
+
+	if input.Verified {
+		osv.Verified = nil
+		updatedFields = append(updatedFields, "verified")
+	}
+	if input.Deprecated {
+		osv.Deprecated = nil
+		updatedFields = append(updatedFields, "deprecated")
+	}
+	if input.DeprecationNote {
+		osv.DeprecationNote = nil
+		updatedFields = append(updatedFields, "deprecation_note")
+	}
+
+	if len(updatedFields) > 0 {
+		updatedFields = append(updatedFields, "updated")
+
+		_, err := db.GetIDB(tx, osvsd.dbSession).NewUpdate().Model(osv).Column(updatedFields...).Where("osv.id = ?", input.OperatingSystemVersionID).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return osvsd.GetByID(ctx, tx, osv.ID, nil)
+}
+
+// Delete deletes an OperatingSystemVersion by ID
+// If the object being deleted doesnt exist, error is not returned (idempotent delete)
+func (osvsd OperatingSystemVersionSQLDAO) Delete(ctx context.Context, tx *db.Tx, id uuid.UUID) error {
+	// Create a child span and set the attributes for current request
+	ctx, osvDAOSpan := osvsd.tracerSpan.CreateChildInCurrentContext(ctx, "OperatingSystemVersionDAO.Delete")
+	if osvDAOSpan != nil {
+		defer osvDAOSpan.End()
+
+		osvsd.tracerSpan.SetAttribute(osvDAOSpan, "id", id.String())
+	}
+
+	osv := &OperatingSystemVersion{
+		ID: id,
+	}
+
+	_, err := db.GetIDB(tx, osvsd.dbSession).NewDelete().Model(osv).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewOperatingSystemVersionDAO returns a new OperatingSystemVersionDAO
+func NewOperatingSystemVersionDAO(dbSession *db.Session) OperatingSystemVersionDAO {
+	return &OperatingSystemVersionSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+)
+
+func TestOperatingSystemVersionSQLDAO(t *testing.T) {
+	dbSession := testInitDB(t)
+	defer dbSession.Close()
+
+	TestSetupSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	user := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("jdoe@test.com"), db.GetStrPtr("Jane"), db.GetStrPtr("Doe"))
+	tenant := testBuildTenant(t, dbSession, nil, "test-tenant", "test-tenant-org", user.ID)
+	os := testBuildImageOperatingSystem(t, dbSession, "ubuntu", nil, tenant.Org, nil, &tenant.ID, nil, false, OperatingSystemStatusReady, user.ID)
+
+	osvDAO := NewOperatingSystemVersionDAO(dbSession)
+
+	osv1, err := osvDAO.Create(ctx, nil, OperatingSystemVersionCreateInput{
+		OperatingSystemID: os.ID,
+		Version:           "22.04.1",
+		ImageURL:          db.GetStrPtr("https://images.test.com/ubuntu-22.04.1.qcow2"),
+		ImageSHA:          db.GetStrPtr("a0b1c2"),
+		Status:            OperatingSystemVersionStatusPending,
+		CreatedBy:         user.ID,
+	})
+	require.NoError(t, err)
+	assert.False(t, osv1.IsUsable())
+
+	// No usable version until one is verified
+	_, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, nil, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	osv1, err = osvDAO.Update(ctx, nil, OperatingSystemVersionUpdateInput{
+		OperatingSystemVersionID: osv1.ID,
+		Status:                   db.GetStrPtr(OperatingSystemVersionStatusReady),
+		Verified:                 db.GetTimePtr(db.GetCurTime()),
+	})
+	require.NoError(t, err)
+	assert.True(t, osv1.IsUsable())
+	assert.NotNil(t, osv1.Verified)
+	assert.Equal(t, "https://images.test.com/ubuntu-22.04.1.qcow2", *osv1.ImageURL)
+
+	osv2, err := osvDAO.Create(ctx, nil, OperatingSystemVersionCreateInput{
+		OperatingSystemID: os.ID,
+		Version:           "22.04.2",
+		ImageURL:          db.GetStrPtr("https://images.test.com/ubuntu-22.04.2.qcow2"),
+		Status:            OperatingSystemVersionStatusReady,
+		CreatedBy:         user.ID,
+	})
+	require.NoError(t, err)
+
+	latest, err := osvDAO.GetLatestUsable(ctx, nil, os.ID, nil, []string{OperatingSystemRelationName})
+	require.NoError(t, err)
+	assert.Equal(t, osv2.ID, latest.ID)
+	require.NotNil(t, latest.OperatingSystem)
+	assert.Equal(t, os.ID, latest.OperatingSystem.ID)
+
+	// Deprecated versions are no longer returned as the latest usable version
+	osv2, err = osvDAO.Update(ctx, nil, OperatingSystemVersionUpdateInput{
+		OperatingSystemVersionID: osv2.ID,
+		Deprecated:               db.GetTimePtr(db.GetCurTime()),
+		DeprecationNote:          db.GetStrPtr("kernel regression"),
+	})
+	require.NoError(t, err)
+	assert.True(t, osv2.IsDeprecated())
+	assert.False(t, osv2.IsUsable())
+
+	latest, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, osv1.ID, latest.ID)
+
+	osvs, total, err := osvDAO.GetAll(ctx, nil, OperatingSystemVersionFilterInput{OperatingSystemIDs: []uuid.UUID{os.ID}, IsDeprecated: db.GetBoolPtr(true)}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, osv2.ID, osvs[0].ID)
+
+	_, total, err = osvDAO.GetAll(ctx, nil, OperatingSystemVersionFilterInput{Versions: []string{"22.04.1"}, Statuses: []string{OperatingSystemVersionStatusReady}}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+
+	// Undeprecate the version
+	osv2, err = osvDAO.Clear(ctx, nil, OperatingSystemVersionClearInput{OperatingSystemVersionID: osv2.ID, Deprecated: true, DeprecationNote: true})
+	require.NoError(t, err)
+	assert.Nil(t, osv2.Deprecated)
+	assert.Nil(t, osv2.DeprecationNote)
+
+	// Version associations are kept apart from the Operating System association
+	ip := testBuildInfrastructureProvider(t, dbSession, nil, "test-provider", "test-provider-org", user.ID)
+	site := TestBuildSite(t, dbSession, ip, "test-site", user)
+	ossaDAO := NewOperatingSystemSiteAssociationDAO(dbSession)
+	baseOssa, err := ossaDAO.Create(ctx, nil, OperatingSystemSiteAssociationCreateInput{OperatingSystemID: os.ID, SiteID: site.ID, Status: OperatingSystemSiteAssociationStatusSynced, CreatedBy: user.ID})
+	require.NoError(t, err)
+	assert.Equal(t, os.ID, baseOssa.GetSiteImageID())
+	versionOssa, err := ossaDAO.Create(ctx, nil, OperatingSystemSiteAssociationCreateInput{OperatingSystemID: os.ID, OperatingSystemVersionID: &osv1.ID, SiteID: site.ID, Status: OperatingSystemSiteAssociationStatusSyncing, CreatedBy: user.ID})
+	require.NoError(t, err)
+	assert.Equal(t, osv1.ID, versionOssa.GetSiteImageID())
+
+	got, err := ossaDAO.GetByOperatingSystemIDAndSiteID(ctx, nil, os.ID, site.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, baseOssa.ID, got.ID)
+
+	ossas, total, err := ossaDAO.GetAll(ctx, nil, OperatingSystemSiteAssociationFilterInput{OperatingSystemIDs: []uuid.UUID{os.ID}, HasOperatingSystemVersion: db.GetBoolPtr(true)}, paginator.PageInput{}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, versionOssa.ID, ossas[0].ID)
+
+	// Only versions synced to the Site are considered when a Site is specified
+	latest, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, osv2.ID, latest.ID)
+
+	_, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, &site.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	_, err = ossaDAO.Update(ctx, nil, OperatingSystemSiteAssociationUpdateInput{OperatingSystemSiteAssociationID: versionOssa.ID, Status: db.GetStrPtr(OperatingSystemSiteAssociationStatusSynced)})
+	require.NoError(t, err)
+
+	latest, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, &site.ID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, osv1.ID, latest.ID)
+
+	otherSite := TestBuildSite(t, dbSession, ip, "test-site-2", user)
+	_, err = osvDAO.GetLatestUsable(ctx, nil, os.ID, &otherSite.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+
+	versionOssa, err = ossaDAO.GenerateAndUpdateVersion(ctx, nil, versionOssa.ID)
+	require.NoError(t, err)
+	baseOssa, err = ossaDAO.GenerateAndUpdateVersion(ctx, nil, baseOssa.ID)
+	require.NoError(t, err)
+	assert.NotEqual(t, *baseOssa.Version, *versionOssa.Version)
+
+	err = osvDAO.Delete(ctx, nil, osv2.ID)
+	require.NoError(t, err)
+	_, err = osvDAO.GetByID(ctx, nil, osv2.ID, nil)
+	assert.ErrorIs(t, err, db.ErrDoesNotExist)
+}
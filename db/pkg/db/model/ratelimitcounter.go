@@ -0,0 +1,111 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+)
+
+// RateLimitCounter counts the API requests made against a rate limiting key in a fixed window,
+// shared between API replicas
+type RateLimitCounter struct {
+	bun.BaseModel `bun:"table:rate_limit_counter,alias:rlc"`
+
+	Key         string    `bun:"key,pk"`
+	WindowStart time.Time `bun:"window_start,pk"`
+	Count       int       `bun:"count,notnull"`
+	Expires     time.Time `bun:"expires,notnull"`
+}
+
+// RateLimitCounterDAO is an interface for interacting with the RateLimitCounter model
+type RateLimitCounterDAO interface {
+	Increment(ctx context.Context, tx *db.Tx, key string, windowStart time.Time, expires time.Time, count int) (int, error)
+	DeleteExpired(ctx context.Context, tx *db.Tx, before time.Time) (int, error)
+}
+
+// RateLimitCounterSQLDAO is the SQL data access object for RateLimitCounter
+type RateLimitCounterSQLDAO struct {
+	dbSession *db.Session
+	RateLimitCounterDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Increment atomically adds count requests to the key in the window starting at windowStart
+// and returns the number of requests counted in that window so far
+func (rlcd RateLimitCounterSQLDAO) Increment(ctx context.Context, tx *db.Tx, key string, windowStart time.Time, expires time.Time, count int) (int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, daoSpan := rlcd.tracerSpan.CreateChildInCurrentContext(ctx, "RateLimitCounterDAO.Increment")
+	if daoSpan != nil {
+		defer daoSpan.End()
+		rlcd.tracerSpan.SetAttribute(daoSpan, "key", key)
+	}
+
+	counter := &RateLimitCounter{
+		Key:         key,
+		WindowStart: windowStart.UTC(),
+		Count:       count,
+		Expires:     expires.UTC(),
+	}
+
+	_, err := db.GetIDB(tx, rlcd.dbSession).NewInsert().Model(counter).
+		On("CONFLICT (key, window_start) DO UPDATE").
+		Set("count = rlc.count + EXCLUDED.count").
+		Returning("count").
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Count, nil
+}
+
+// DeleteExpired deletes the counters that expired before the given time and returns the number deleted
+func (rlcd RateLimitCounterSQLDAO) DeleteExpired(ctx context.Context, tx *db.Tx, before time.Time) (int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, daoSpan := rlcd.tracerSpan.CreateChildInCurrentContext(ctx, "RateLimitCounterDAO.DeleteExpired")
+	if daoSpan != nil {
+		defer daoSpan.End()
+		rlcd.tracerSpan.SetAttribute(daoSpan, "before", before.String())
+	}
+
+	res, err := db.GetIDB(tx, rlcd.dbSession).NewDelete().Model((*RateLimitCounter)(nil)).Where("expires < ?", before.UTC()).Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(deleted), nil
+}
+
+// NewRateLimitCounterDAO returns a new RateLimitCounterDAO
+func NewRateLimitCounterDAO(dbSession *db.Session) RateLimitCounterDAO {
+	return &RateLimitCounterSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/util"
+)
+
+func TestRateLimitCounterSQLDAO_Increment(t *testing.T) {
+	dbSession := util.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	if err := dbSession.DB.ResetModel(context.Background(), (*RateLimitCounter)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := NewRateLimitCounterDAO(dbSession)
+
+	windowStart := time.Now().Truncate(time.Minute)
+	expires := windowStart.Add(time.Minute)
+
+	for i := 1; i <= 3; i++ {
+		count, err := dao.Increment(ctx, nil, "org:test-org:read", windowStart, expires, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, i, count)
+	}
+
+	// Other keys and windows are counted separately
+	count, err := dao.Increment(ctx, nil, "org:test-org:write", windowStart, expires, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count, err = dao.Increment(ctx, nil, "org:test-org:read", expires, expires.Add(time.Minute), 1)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Requests counted elsewhere are added in one go
+	count, err = dao.Increment(ctx, nil, "org:test-org:read", windowStart, expires, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, count)
+}
+
+func TestRateLimitCounterSQLDAO_DeleteExpired(t *testing.T) {
+	dbSession := util.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	if err := dbSession.DB.ResetModel(context.Background(), (*RateLimitCounter)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := NewRateLimitCounterDAO(dbSession)
+
+	now := time.Now()
+	_, err := dao.Increment(ctx, nil, "user:expired", now.Add(-2*time.Minute), now.Add(-time.Minute), 1)
+	assert.NoError(t, err)
+	_, err = dao.Increment(ctx, nil, "user:current", now.Truncate(time.Minute), now.Add(time.Minute), 1)
+	assert.NoError(t, err)
+
+	deleted, err := dao.DeleteExpired(ctx, nil, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	var remaining []RateLimitCounter
+	err = dbSession.DB.NewSelect().Model(&remaining).Scan(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, remaining, 1)
+	assert.Equal(t, "user:current", remaining[0].Key)
+}
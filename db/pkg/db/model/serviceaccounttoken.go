@@ -0,0 +1,354 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+	"github.com/uptrace/bun"
+)
+
+const (
+	// ServiceAccountTokenOrderByDefault default field to be used for ordering when none specified
+	ServiceAccountTokenOrderByDefault = "created"
+)
+
+var (
+	// ServiceAccountTokenOrderByFields is a list of valid order by fields for the ServiceAccountToken model
+	ServiceAccountTokenOrderByFields = []string{"name", "expires", "last_used", "created", "updated"}
+	// ServiceAccountTokenRelatedEntities is a list of valid relation by fields for the ServiceAccountToken model
+	ServiceAccountTokenRelatedEntities = map[string]bool{
+		TenantRelationName: true,
+		SiteRelationName:   true,
+	}
+)
+
+// ServiceAccountToken is an API token issued by the service. Only the hash of the token is stored.
+// The token acts as its own User, which is limited to the token's org, Tenant, scopes and optionally Site.
+type ServiceAccountToken struct {
+	bun.BaseModel `bun:"table:service_account_token,alias:sat"`
+
+	ID          uuid.UUID  `bun:"type:uuid,pk"`
+	Name        string     `bun:"name,notnull"`
+	Description *string    `bun:"description"`
+	Org         string     `bun:"org,notnull"`
+	TenantID    uuid.UUID  `bun:"tenant_id,type:uuid,notnull"`
+	Tenant      *Tenant    `bun:"rel:belongs-to,join:tenant_id=id"`
+	SiteID      *uuid.UUID `bun:"site_id,type:uuid"`
+	Site        *Site      `bun:"rel:belongs-to,join:site_id=id"`
+	Scopes      []string   `bun:"scopes,notnull,array"`
+	TokenPrefix string     `bun:"token_prefix,notnull"`
+	TokenHash   string     `bun:"token_hash,notnull,unique"`
+	UserID      uuid.UUID  `bun:"user_id,type:uuid,notnull"`
+	Expires     *time.Time `bun:"expires"`
+	LastUsed    *time.Time `bun:"last_used"`
+	Revoked     *time.Time `bun:"revoked"`
+	RevokedBy   *uuid.UUID `bun:"revoked_by,type:uuid"`
+	Created     time.Time  `bun:"created,nullzero,notnull,default:current_timestamp"`
+	Updated     time.Time  `bun:"updated,nullzero,notnull,default:current_timestamp"`
+	Deleted     *time.Time `bun:"deleted,soft_delete"`
+	CreatedBy   uuid.UUID  `bun:"created_by,type:uuid,notnull"`
+}
+
+// IsExpired returns true if the token has an expiry that has passed
+func (sat *ServiceAccountToken) IsExpired(now time.Time) bool {
+	return sat.Expires != nil && !now.Before(*sat.Expires)
+}
+
+// ServiceAccountTokenCreateInput input parameters for Create method
+type ServiceAccountTokenCreateInput struct {
+	ServiceAccountTokenID *uuid.UUID
+	Name                  string
+	Description           *string
+	Org                   string
+	TenantID              uuid.UUID
+	SiteID                *uuid.UUID
+	Scopes                []string
+	TokenPrefix           string
+	TokenHash             string
+	UserID                uuid.UUID
+	Expires               *time.Time
+	CreatedBy             uuid.UUID
+}
+
+// ServiceAccountTokenUpdateInput input parameters for Update method
+type ServiceAccountTokenUpdateInput struct {
+	ServiceAccountTokenID uuid.UUID
+	LastUsed              *time.Time
+	Revoked               *time.Time
+	RevokedBy             *uuid.UUID
+}
+
+// ServiceAccountTokenFilterInput input parameters for GetAll method
+type ServiceAccountTokenFilterInput struct {
+	ServiceAccountTokenIDs []uuid.UUID
+	Names                  []string
+	Orgs                   []string
+	TenantIDs              []uuid.UUID
+	ExcludeRevoked         bool
+}
+
+var _ bun.BeforeAppendModelHook = (*ServiceAccountToken)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (sat *ServiceAccountToken) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		sat.Created = db.GetCurTime()
+		sat.Updated = db.GetCurTime()
+	case *bun.UpdateQuery:
+		sat.Updated = db.GetCurTime()
+	}
+	return nil
+}
+
+var _ bun.BeforeCreateTableHook = (*ServiceAccountToken)(nil)
+
+// BeforeCreateTable is a hook that is called before the table is created
+func (sat *ServiceAccountToken) BeforeCreateTable(ctx context.Context, query *bun.CreateTableQuery) error {
+	query.ForeignKey(`("tenant_id") REFERENCES "tenant" ("id")`).
+		ForeignKey(`("site_id") REFERENCES "site" ("id")`).
+		ForeignKey(`("user_id") REFERENCES "user" ("id")`)
+	return nil
+}
+
+// ServiceAccountTokenDAO is an interface for interacting with the ServiceAccountToken model
+type ServiceAccountTokenDAO interface {
+	//
+	Create(ctx context.Context, tx *db.Tx, input ServiceAccountTokenCreateInput) (*ServiceAccountToken, error)
+	//
+	GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*ServiceAccountToken, error)
+	//
+	GetByTokenHash(ctx context.Context, tx *db.Tx, tokenHash string) (*ServiceAccountToken, error)
+	//
+	GetAll(ctx context.Context, tx *db.Tx, filter ServiceAccountTokenFilterInput, page paginator.PageInput, includeRelations []string) ([]ServiceAccountToken, int, error)
+	//
+	Update(ctx context.Context, tx *db.Tx, input ServiceAccountTokenUpdateInput) (*ServiceAccountToken, error)
+}
+
+// ServiceAccountTokenSQLDAO is an implementation of the ServiceAccountTokenDAO interface
+type ServiceAccountTokenSQLDAO struct {
+	dbSession *db.Session
+	ServiceAccountTokenDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// Create creates a new ServiceAccountToken from the given parameters
+func (satsd ServiceAccountTokenSQLDAO) Create(ctx context.Context, tx *db.Tx, input ServiceAccountTokenCreateInput) (*ServiceAccountToken, error) {
+	// Create a child span and set the attributes for current request
+	ctx, satDAOSpan := satsd.tracerSpan.CreateChildInCurrentContext(ctx, "ServiceAccountTokenDAO.Create")
+	if satDAOSpan != nil {
+		defer satDAOSpan.End()
+
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "name", input.Name)
+	}
+
+	id := uuid.New()
+	if input.ServiceAccountTokenID != nil {
+		id = *input.ServiceAccountTokenID
+	}
+
+	sat := &ServiceAccountToken{
+		ID:          id,
+		Name:        input.Name,
+		Description: input.Description,
+		Org:         input.Org,
+		TenantID:    input.TenantID,
+		SiteID:      input.SiteID,
+		Scopes:      input.Scopes,
+		TokenPrefix: input.TokenPrefix,
+		TokenHash:   input.TokenHash,
+		UserID:      input.UserID,
+		Expires:     input.Expires,
+		CreatedBy:   input.CreatedBy,
+	}
+
+	_, err := db.GetIDB(tx, satsd.dbSession).NewInsert().Model(sat).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return satsd.GetByID(ctx, tx, sat.ID, nil)
+}
+
+// GetByID returns a ServiceAccountToken by ID
+// returns db.ErrDoesNotExist error if the record is not found
+func (satsd ServiceAccountTokenSQLDAO) GetByID(ctx context.Context, tx *db.Tx, id uuid.UUID, includeRelations []string) (*ServiceAccountToken, error) {
+	// Create a child span and set the attributes for current request
+	ctx, satDAOSpan := satsd.tracerSpan.CreateChildInCurrentContext(ctx, "ServiceAccountTokenDAO.GetByID")
+	if satDAOSpan != nil {
+		defer satDAOSpan.End()
+
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "id", id.String())
+	}
+
+	sat := &ServiceAccountToken{}
+
+	query := db.GetIDB(tx, satsd.dbSession).NewSelect().Model(sat).Where("sat.id = ?", id)
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return sat, nil
+}
+
+// GetByTokenHash returns the ServiceAccountToken with the given token hash, including revoked and expired tokens
+// returns db.ErrDoesNotExist error if the record is not found
+func (satsd ServiceAccountTokenSQLDAO) GetByTokenHash(ctx context.Context, tx *db.Tx, tokenHash string) (*ServiceAccountToken, error) {
+	// Create a child span and set the attributes for current request
+	ctx, satDAOSpan := satsd.tracerSpan.CreateChildInCurrentContext(ctx, "ServiceAccountTokenDAO.GetByTokenHash")
+	if satDAOSpan != nil {
+		defer satDAOSpan.End()
+	}
+
+	sat := &ServiceAccountToken{}
+
+	err := db.GetIDB(tx, satsd.dbSession).NewSelect().Model(sat).Where("sat.token_hash = ?", tokenHash).Scan(ctx)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, db.ErrDoesNotExist
+		}
+		return nil, err
+	}
+
+	return sat, nil
+}
+
+// GetAll returns all ServiceAccountTokens with various optional filters
+// errors are returned only when there is a db related error
+// if records not found, then error is nil, but length of returned slice is 0
+// if orderBy is nil, then records are ordered by column specified in ServiceAccountTokenOrderByDefault in ascending order
+func (satsd ServiceAccountTokenSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter ServiceAccountTokenFilterInput, page paginator.PageInput, includeRelations []string) ([]ServiceAccountToken, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, satDAOSpan := satsd.tracerSpan.CreateChildInCurrentContext(ctx, "ServiceAccountTokenDAO.GetAll")
+	if satDAOSpan != nil {
+		defer satDAOSpan.End()
+	}
+
+	sats := []ServiceAccountToken{}
+
+	query := db.GetIDB(tx, satsd.dbSession).NewSelect().Model(&sats)
+
+	if filter.ServiceAccountTokenIDs != nil {
+		query = query.Where("sat.id IN (?)", bun.In(filter.ServiceAccountTokenIDs))
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "id", filter.ServiceAccountTokenIDs)
+	}
+	if filter.Names != nil {
+		query = query.Where("sat.name IN (?)", bun.In(filter.Names))
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "name", filter.Names)
+	}
+	if filter.Orgs != nil {
+		query = query.Where("sat.org IN (?)", bun.In(filter.Orgs))
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "org", filter.Orgs)
+	}
+	if filter.TenantIDs != nil {
+		query = query.Where("sat.tenant_id IN (?)", bun.In(filter.TenantIDs))
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "tenant_id", filter.TenantIDs)
+	}
+	if filter.ExcludeRevoked {
+		query = query.Where("sat.revoked IS NULL")
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "exclude_revoked", true)
+	}
+
+	for _, relation := range includeRelations {
+		query = query.Relation(relation)
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	if page.OrderBy == nil {
+		page.OrderBy = paginator.NewDefaultOrderBy(ServiceAccountTokenOrderByDefault)
+	}
+
+	paginator, err := paginator.NewPaginator(ctx, query, page.Offset, page.Limit, page.OrderBy, ServiceAccountTokenOrderByFields)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = paginator.Query.Limit(paginator.Limit).Offset(paginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sats, paginator.Total, nil
+}
+
+// Update updates specified fields of an existing ServiceAccountToken
+// The updated fields are assumed to be set to non-null values
+func (satsd ServiceAccountTokenSQLDAO) Update(ctx context.Context, tx *db.Tx, input ServiceAccountTokenUpdateInput) (*ServiceAccountToken, error) {
+	// Create a child span and set the attributes for current request
+	ctx, satDAOSpan := satsd.tracerSpan.CreateChildInCurrentContext(ctx, "ServiceAccountTokenDAO.Update")
+	if satDAOSpan != nil {
+		defer satDAOSpan.End()
+
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "id", input.ServiceAccountTokenID.String())
+	}
+
+	sat := &ServiceAccountToken{
+		ID: input.ServiceAccountTokenID,
+	}
+
+	updatedFields := []string{}
+
+	if input.LastUsed != nil {
+		sat.LastUsed = input.LastUsed
+		updatedFields = append(updatedFields, "last_used")
+	}
+	if input.Revoked != nil {
+		sat.Revoked = input.Revoked
+		updatedFields = append(updatedFields, "revoked")
+		satsd.tracerSpan.SetAttribute(satDAOSpan, "revoked", *input.Revoked)
+	}
+	if input.RevokedBy != nil {
+		sat.RevokedBy = input.RevokedBy
+		updatedFields = append(updatedFields, "revoked_by")
+	}
+
+	if len(updatedFields) > 0 {
+		updatedFields = append(updatedFields, "updated")
+
+		_, err := db.GetIDB(tx, satsd.dbSession).NewUpdate().Model(sat).Column(updatedFields...).Where("sat.id = ?", input.ServiceAccountTokenID).Exec(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return satsd.GetByID(ctx, tx, sat.ID, nil)
+}
+
+// NewServiceAccountTokenDAO returns a new ServiceAccountTokenDAO
+func NewServiceAccountTokenDAO(dbSession *db.Session) ServiceAccountTokenDAO {
+	return &ServiceAccountTokenSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
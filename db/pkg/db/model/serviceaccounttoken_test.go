@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+)
+
+func TestServiceAccountTokenSQLDAO_CreateAndGet(t *testing.T) {
+	dbSession := testInitDB(t)
+	defer dbSession.Close()
+
+	TestSetupSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	ipu := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("johnd@test.com"), db.GetStrPtr("John"), db.GetStrPtr("Doe"))
+	ip := testBuildInfrastructureProvider(t, dbSession, nil, "test-ip", "Test Provider", ipu.ID)
+	st := testBuildSite(t, dbSession, nil, ip.ID, "test-site", "Test Site", ip.Org, ipu.ID)
+
+	tnu := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("jdoe@test.com"), db.GetStrPtr("Jane"), db.GetStrPtr("Doe"))
+	tn := testBuildTenant(t, dbSession, nil, "test-tenant", "test-tenant-org", tnu.ID)
+	tku := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), nil, nil, nil)
+
+	satDAO := NewServiceAccountTokenDAO(dbSession)
+
+	sat, err := satDAO.Create(ctx, nil, ServiceAccountTokenCreateInput{
+		Name:        "ci",
+		Org:         tn.Org,
+		TenantID:    tn.ID,
+		SiteID:      &st.ID,
+		Scopes:      []string{"instance:write", "vpc:read"},
+		TokenPrefix: "cbt_abcdefgh",
+		TokenHash:   "hash-1",
+		UserID:      tku.ID,
+		Expires:     db.GetTimePtr(time.Now().Add(time.Hour)),
+		CreatedBy:   tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"instance:write", "vpc:read"}, sat.Scopes)
+	assert.Equal(t, st.ID, *sat.SiteID)
+	assert.False(t, sat.IsExpired(time.Now()))
+	assert.True(t, sat.IsExpired(time.Now().Add(2*time.Hour)))
+
+	// Token hash must be unique
+	_, err = satDAO.Create(ctx, nil, ServiceAccountTokenCreateInput{
+		Name: "dup", Org: tn.Org, TenantID: tn.ID, Scopes: []string{"*:read"}, TokenPrefix: "cbt_abcdefgh", TokenHash: "hash-1", UserID: tku.ID, CreatedBy: tnu.ID,
+	})
+	assert.Error(t, err)
+
+	got, err := satDAO.GetByTokenHash(ctx, nil, "hash-1")
+	require.NoError(t, err)
+	assert.Equal(t, sat.ID, got.ID)
+
+	_, err = satDAO.GetByTokenHash(ctx, nil, "hash-2")
+	assert.Equal(t, db.ErrDoesNotExist, err)
+
+	_, err = satDAO.GetByID(ctx, nil, uuid.New(), nil)
+	assert.Equal(t, db.ErrDoesNotExist, err)
+
+	got, err = satDAO.GetByID(ctx, nil, sat.ID, []string{TenantRelationName, SiteRelationName})
+	require.NoError(t, err)
+	assert.NotNil(t, got.Tenant)
+	assert.NotNil(t, got.Site)
+}
+
+func TestServiceAccountTokenSQLDAO_GetAllAndUpdate(t *testing.T) {
+	dbSession := testInitDB(t)
+	defer dbSession.Close()
+
+	TestSetupSchema(t, dbSession)
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	tnu := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), db.GetStrPtr("jdoe@test.com"), db.GetStrPtr("Jane"), db.GetStrPtr("Doe"))
+	tn := testBuildTenant(t, dbSession, nil, "test-tenant", "test-tenant-org", tnu.ID)
+	tku := testBuildUser(t, dbSession, nil, testGenerateStarfleetID(), nil, nil, nil)
+
+	satDAO := NewServiceAccountTokenDAO(dbSession)
+
+	sats := []*ServiceAccountToken{}
+	for _, name := range []string{"ci-1", "ci-2", "ci-3"} {
+		sat, err := satDAO.Create(ctx, nil, ServiceAccountTokenCreateInput{
+			Name: name, Org: tn.Org, TenantID: tn.ID, Scopes: []string{"*:read"}, TokenPrefix: "cbt_" + name, TokenHash: "hash-" + name, UserID: tku.ID, CreatedBy: tnu.ID,
+		})
+		require.NoError(t, err)
+		sats = append(sats, sat)
+	}
+
+	now := db.GetCurTime()
+	updated, err := satDAO.Update(ctx, nil, ServiceAccountTokenUpdateInput{
+		ServiceAccountTokenID: sats[0].ID,
+		LastUsed:              &now,
+		Revoked:               &now,
+		RevokedBy:             &tnu.ID,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, updated.LastUsed)
+	assert.NotNil(t, updated.Revoked)
+	assert.Equal(t, tnu.ID, *updated.RevokedBy)
+
+	tests := []struct {
+		desc      string
+		filter    ServiceAccountTokenFilterInput
+		wantCount int
+	}{
+		{desc: "all tokens of org", filter: ServiceAccountTokenFilterInput{Orgs: []string{tn.Org}}, wantCount: 3},
+		{desc: "exclude revoked", filter: ServiceAccountTokenFilterInput{TenantIDs: []uuid.UUID{tn.ID}, ExcludeRevoked: true}, wantCount: 2},
+		{desc: "by name", filter: ServiceAccountTokenFilterInput{Names: []string{"ci-2"}}, wantCount: 1},
+		{desc: "by ID", filter: ServiceAccountTokenFilterInput{ServiceAccountTokenIDs: []uuid.UUID{sats[2].ID}}, wantCount: 1},
+		{desc: "other org", filter: ServiceAccountTokenFilterInput{Orgs: []string{"other-org"}}, wantCount: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, total, err := satDAO.GetAll(ctx, nil, tc.filter, paginator.PageInput{}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantCount, total)
+			assert.Len(t, got, tc.wantCount)
+		})
+	}
+}
@@ -109,6 +109,7 @@ type Site struct {
 	Contact                       *SiteContact            `bun:"contact"`  // since this is a json object, type of the column will be JSONB automatically
 	AgentCertExpiry               *time.Time              `bun:"agent_cert_expiry"`
 	Config                        *SiteConfig             `bun:"config,type:jsonb"`
+	BootstrapStatus               *SiteBootstrapStatus    `bun:"bootstrap_status,type:jsonb"`
 	// DEPRECATED - We're moving to SiteConfig
 	Capabilities *SiteCapabilities `bun:"capabilities,type:jsonb"`
 }
@@ -123,6 +124,26 @@ type SiteContact struct {
 	Email string `json:"email"`
 }
 
+// SiteBootstrapStatus mirrors the bootstrap lifecycle status kept by Site Manager
+type SiteBootstrapStatus struct {
+	State                  string              `json:"state"`
+	OTPExpiry              *time.Time          `json:"otp_expiry,omitempty"`
+	LastCredsFetch         *time.Time          `json:"last_creds_fetch,omitempty"`
+	LastCredsFetchSourceIP string              `json:"last_creds_fetch_source_ip,omitempty"`
+	LastCredsFetchMethod   string              `json:"last_creds_fetch_method,omitempty"`
+	CertSerial             string              `json:"cert_serial,omitempty"`
+	CertExpiry             *time.Time          `json:"cert_expiry,omitempty"`
+	AgentVersion           string              `json:"agent_version,omitempty"`
+	Rolls                  []SiteBootstrapRoll `json:"rolls,omitempty"`
+	Synced                 time.Time           `json:"synced"`
+}
+
+// SiteBootstrapRoll is an OTP roll of a Site
+type SiteBootstrapRoll struct {
+	Time          time.Time `json:"time"`
+	PreviousState string    `json:"previous_state,omitempty"`
+}
+
 type SiteCreateInput struct {
 	Name                          string
 	DisplayName                   *string
@@ -175,6 +196,7 @@ type SiteUpdateInput struct {
 	Location                      *SiteLocation
 	Contact                       *SiteContact
 	AgentCertExpiry               *time.Time
+	BootstrapStatus               *SiteBootstrapStatus
 	Config                        *SiteConfigUpdateInput
 }
 
@@ -592,6 +614,13 @@ func (ssd SiteSQLDAO) Update(ctx context.Context, tx *db.Tx, input SiteUpdateInp
 		ssd.tracerSpan.SetAttribute(stDAOSpan, "agent_cert_expiry", input.AgentCertExpiry.String())
 	}
 
+	// BootstrapStatus only handled on update, it is mirrored from Site Manager
+	if input.BootstrapStatus != nil {
+		st.BootstrapStatus = input.BootstrapStatus
+		updatedFields = append(updatedFields, "bootstrap_status")
+		ssd.tracerSpan.SetAttribute(stDAOSpan, "bootstrap_status", input.BootstrapStatus.State)
+	}
+
 	if len(updatedFields) > 0 {
 		updatedFields = append(updatedFields, "updated")
 
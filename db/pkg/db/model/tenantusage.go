@@ -0,0 +1,221 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	stracer "github.com/nvidia/bare-metal-manager-rest/db/pkg/tracer"
+)
+
+const (
+	// TenantUsageOrderByDefault default field to be used for ordering when none specified
+	TenantUsageOrderByDefault = "usage_date"
+)
+
+var (
+	// TenantUsageOrderByFields is a list of valid order by fields for the TenantUsage model
+	TenantUsageOrderByFields = []string{"usage_date", "instance_hours", "allocated_hours", "created"}
+)
+
+// TenantUsage is the usage of an Instance Type by a Tenant at a Site over one UTC day
+type TenantUsage struct {
+	bun.BaseModel `bun:"table:tenant_usage,alias:tu"`
+
+	ID                       uuid.UUID  `bun:"type:uuid,pk"`
+	TenantID                 uuid.UUID  `bun:"tenant_id,type:uuid,notnull"`
+	InfrastructureProviderID uuid.UUID  `bun:"infrastructure_provider_id,type:uuid,notnull"`
+	SiteID                   uuid.UUID  `bun:"site_id,type:uuid,notnull"`
+	InstanceTypeID           *uuid.UUID `bun:"instance_type_id,type:uuid"`
+	UsageDate                time.Time  `bun:"usage_date,type:date,notnull"`
+	InstanceCount            int        `bun:"instance_count,notnull"`  // Instances that accrued usage during the day
+	InstanceHours            float64    `bun:"instance_hours,notnull"`  // Hours Instances spent in a billable status
+	AllocatedCount           int        `bun:"allocated_count,notnull"` // Machines allocated to the Tenant at the end of the day
+	AllocatedHours           float64    `bun:"allocated_hours,notnull"` // Machine hours allocated to the Tenant
+	Created                  time.Time  `bun:"created,nullzero,notnull,default:current_timestamp"`
+}
+
+// TenantUsageCreateInput input parameters for creating a TenantUsage
+type TenantUsageCreateInput struct {
+	TenantID                 uuid.UUID
+	InfrastructureProviderID uuid.UUID
+	SiteID                   uuid.UUID
+	InstanceTypeID           *uuid.UUID
+	InstanceCount            int
+	InstanceHours            float64
+	AllocatedCount           int
+	AllocatedHours           float64
+}
+
+// TenantUsageFilterInput filtering options for TenantUsage GetAll
+type TenantUsageFilterInput struct {
+	TenantIDs                 []uuid.UUID
+	InfrastructureProviderIDs []uuid.UUID
+	SiteIDs                   []uuid.UUID
+	InstanceTypeIDs           []uuid.UUID
+	// StartDate and EndDate are inclusive UTC dates
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+var _ bun.BeforeAppendModelHook = (*TenantUsage)(nil)
+
+// BeforeAppendModel is a hook that is called before the model is appended to the query
+func (tu *TenantUsage) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	switch query.(type) {
+	case *bun.InsertQuery:
+		tu.Created = db.GetCurTime()
+	}
+	return nil
+}
+
+// TenantUsageDAO is an interface for interacting with the TenantUsage model
+type TenantUsageDAO interface {
+	// ReplaceForDate replaces all TenantUsage rows for a day, making the daily rollup idempotent
+	ReplaceForDate(ctx context.Context, tx *db.Tx, usageDate time.Time, inputs []TenantUsageCreateInput) ([]TenantUsage, error)
+	// GetAll returns TenantUsage rows matching the filter
+	GetAll(ctx context.Context, tx *db.Tx, filter TenantUsageFilterInput, page paginator.PageInput) ([]TenantUsage, int, error)
+}
+
+// TenantUsageSQLDAO is an implementation of the TenantUsageDAO interface
+type TenantUsageSQLDAO struct {
+	dbSession *db.Session
+	TenantUsageDAO
+	tracerSpan *stracer.TracerSpan
+}
+
+// ReplaceForDate deletes the TenantUsage rows of the given UTC day and creates new ones from the inputs.
+// Since there are 2 operations (DELETE, INSERT), it is required that this library call happens within a transaction
+func (tud TenantUsageSQLDAO) ReplaceForDate(ctx context.Context, tx *db.Tx, usageDate time.Time, inputs []TenantUsageCreateInput) ([]TenantUsage, error) {
+	// Create a child span and set the attributes for current request
+	ctx, tuDAOSpan := tud.tracerSpan.CreateChildInCurrentContext(ctx, "TenantUsageDAO.ReplaceForDate")
+	if tuDAOSpan != nil {
+		defer tuDAOSpan.End()
+
+		tud.tracerSpan.SetAttribute(tuDAOSpan, "usage_date", usageDate.Format(time.DateOnly))
+		tud.tracerSpan.SetAttribute(tuDAOSpan, "count", len(inputs))
+	}
+
+	idb := db.GetIDB(tx, tud.dbSession)
+
+	_, err := idb.NewDelete().Model((*TenantUsage)(nil)).Where("usage_date = ?", usageDate.Format(time.DateOnly)).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tus := make([]TenantUsage, 0, len(inputs))
+	if len(inputs) == 0 {
+		return tus, nil
+	}
+
+	day := time.Date(usageDate.Year(), usageDate.Month(), usageDate.Day(), 0, 0, 0, 0, time.UTC)
+	for _, input := range inputs {
+		tus = append(tus, TenantUsage{
+			ID:                       uuid.New(),
+			TenantID:                 input.TenantID,
+			InfrastructureProviderID: input.InfrastructureProviderID,
+			SiteID:                   input.SiteID,
+			InstanceTypeID:           input.InstanceTypeID,
+			UsageDate:                day,
+			InstanceCount:            input.InstanceCount,
+			InstanceHours:            input.InstanceHours,
+			AllocatedCount:           input.AllocatedCount,
+			AllocatedHours:           input.AllocatedHours,
+		})
+	}
+
+	_, err = idb.NewInsert().Model(&tus).Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tus, nil
+}
+
+// GetAll returns all TenantUsage rows matching the filter along with the total count
+func (tud TenantUsageSQLDAO) GetAll(ctx context.Context, tx *db.Tx, filter TenantUsageFilterInput, page paginator.PageInput) ([]TenantUsage, int, error) {
+	// Create a child span and set the attributes for current request
+	ctx, tuDAOSpan := tud.tracerSpan.CreateChildInCurrentContext(ctx, "TenantUsageDAO.GetAll")
+	if tuDAOSpan != nil {
+		defer tuDAOSpan.End()
+	}
+
+	tus := []TenantUsage{}
+
+	query := db.GetIDB(tx, tud.dbSession).NewSelect().Model(&tus)
+
+	if len(filter.TenantIDs) > 0 {
+		query = query.Where("tu.tenant_id IN (?)", bun.In(filter.TenantIDs))
+	}
+	if len(filter.InfrastructureProviderIDs) > 0 {
+		query = query.Where("tu.infrastructure_provider_id IN (?)", bun.In(filter.InfrastructureProviderIDs))
+	}
+	if len(filter.SiteIDs) > 0 {
+		query = query.Where("tu.site_id IN (?)", bun.In(filter.SiteIDs))
+	}
+	if len(filter.InstanceTypeIDs) > 0 {
+		query = query.Where("tu.instance_type_id IN (?)", bun.In(filter.InstanceTypeIDs))
+	}
+	if filter.StartDate != nil {
+		query = query.Where("tu.usage_date >= ?", filter.StartDate.Format(time.DateOnly))
+		tud.tracerSpan.SetAttribute(tuDAOSpan, "start_date", filter.StartDate.Format(time.DateOnly))
+	}
+	if filter.EndDate != nil {
+		query = query.Where("tu.usage_date <= ?", filter.EndDate.Format(time.DateOnly))
+		tud.tracerSpan.SetAttribute(tuDAOSpan, "end_date", filter.EndDate.Format(time.DateOnly))
+	}
+
+	// if no order is passed, set default to make sure objects return always in the same order and pagination works properly
+	var multiOrderBy []*paginator.OrderBy
+	if page.OrderBy == nil {
+		multiOrderBy = append(multiOrderBy, paginator.NewDefaultOrderBy(TenantUsageOrderByDefault))
+	} else {
+		multiOrderBy = append(multiOrderBy, page.OrderBy)
+		if page.OrderBy.Field != TenantUsageOrderByDefault {
+			multiOrderBy = append(multiOrderBy, paginator.NewDefaultOrderBy(TenantUsageOrderByDefault))
+		}
+	}
+	// Rows of the same day are ordered by ID so pages are stable
+	multiOrderBy = append(multiOrderBy, paginator.NewDefaultOrderBy("id"))
+
+	dbPaginator, err := paginator.NewPaginatorMultiOrderBy(ctx, query, page.Offset, page.Limit, multiOrderBy, append(TenantUsageOrderByFields, "id"))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = dbPaginator.Query.Limit(dbPaginator.Limit).Offset(dbPaginator.Offset).Scan(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return tus, dbPaginator.Total, nil
+}
+
+// NewTenantUsageDAO returns a new TenantUsageDAO
+func NewTenantUsageDAO(dbSession *db.Session) TenantUsageDAO {
+	return &TenantUsageSQLDAO{
+		dbSession:  dbSession,
+		tracerSpan: stracer.NewTracerSpan(),
+	}
+}
@@ -0,0 +1,163 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/util"
+)
+
+func testTenantUsageInitDB(t *testing.T) *db.Session {
+	dbSession := util.GetTestDBSession(t, false)
+
+	if err := dbSession.DB.ResetModel(context.Background(), (*TenantUsage)(nil)); err != nil {
+		t.Fatal(err)
+	}
+
+	return dbSession
+}
+
+func TestTenantUsageSQLDAO_ReplaceForDate(t *testing.T) {
+	dbSession := testTenantUsageInitDB(t)
+	defer dbSession.Close()
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := NewTenantUsageDAO(dbSession)
+
+	day := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	input := TenantUsageCreateInput{
+		TenantID:                 uuid.New(),
+		InfrastructureProviderID: uuid.New(),
+		SiteID:                   uuid.New(),
+		InstanceTypeID:           db.GetUUIDPtr(uuid.New()),
+		InstanceCount:            2,
+		InstanceHours:            36,
+		AllocatedCount:           4,
+		AllocatedHours:           96,
+	}
+
+	tus, err := dao.ReplaceForDate(ctx, nil, day, []TenantUsageCreateInput{input, input})
+	assert.NoError(t, err)
+	assert.Len(t, tus, 2)
+
+	// Rolling up the same day again replaces its rows, other days are kept
+	_, err = dao.ReplaceForDate(ctx, nil, day.AddDate(0, 0, 1), []TenantUsageCreateInput{input})
+	assert.NoError(t, err)
+
+	input.InstanceHours = 40
+	tus, err = dao.ReplaceForDate(ctx, nil, day, []TenantUsageCreateInput{input})
+	assert.NoError(t, err)
+	assert.Len(t, tus, 1)
+
+	all, total, err := dao.GetAll(ctx, nil, TenantUsageFilterInput{}, paginator.PageInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.True(t, all[0].UsageDate.Equal(day))
+	assert.Equal(t, 40.0, all[0].InstanceHours)
+
+	// Replacing with no inputs clears the day
+	tus, err = dao.ReplaceForDate(ctx, nil, day, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, tus)
+
+	_, total, err = dao.GetAll(ctx, nil, TenantUsageFilterInput{}, paginator.PageInput{})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+}
+
+func TestTenantUsageSQLDAO_GetAll(t *testing.T) {
+	dbSession := testTenantUsageInitDB(t)
+	defer dbSession.Close()
+
+	// OTEL Spanner configuration
+	_, _, ctx := testCommonTraceProviderSetup(t, context.Background())
+
+	dao := NewTenantUsageDAO(dbSession)
+
+	tenant1 := uuid.New()
+	tenant2 := uuid.New()
+	provider := uuid.New()
+	site1 := uuid.New()
+	site2 := uuid.New()
+	instanceType := uuid.New()
+
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		_, err := dao.ReplaceForDate(ctx, nil, start.AddDate(0, 0, i), []TenantUsageCreateInput{
+			{TenantID: tenant1, InfrastructureProviderID: provider, SiteID: site1, InstanceTypeID: &instanceType, InstanceHours: 24},
+			{TenantID: tenant2, InfrastructureProviderID: provider, SiteID: site2, InstanceHours: 12},
+		})
+		assert.NoError(t, err)
+	}
+
+	tests := []struct {
+		desc          string
+		filter        TenantUsageFilterInput
+		expectedTotal int
+	}{
+		{
+			desc:          "no filter returns all rows",
+			filter:        TenantUsageFilterInput{},
+			expectedTotal: 6,
+		},
+		{
+			desc:          "filter by Tenant",
+			filter:        TenantUsageFilterInput{TenantIDs: []uuid.UUID{tenant1}},
+			expectedTotal: 3,
+		},
+		{
+			desc:          "filter by Provider",
+			filter:        TenantUsageFilterInput{InfrastructureProviderIDs: []uuid.UUID{provider}},
+			expectedTotal: 6,
+		},
+		{
+			desc:          "filter by Site",
+			filter:        TenantUsageFilterInput{SiteIDs: []uuid.UUID{site2}},
+			expectedTotal: 3,
+		},
+		{
+			desc:          "filter by Instance Type",
+			filter:        TenantUsageFilterInput{InstanceTypeIDs: []uuid.UUID{instanceType}},
+			expectedTotal: 3,
+		},
+		{
+			desc:          "filter by date range, inclusive",
+			filter:        TenantUsageFilterInput{StartDate: db.GetTimePtr(start.AddDate(0, 0, 1)), EndDate: db.GetTimePtr(start.AddDate(0, 0, 2))},
+			expectedTotal: 4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			got, total, err := dao.GetAll(ctx, nil, tc.filter, paginator.PageInput{Limit: db.GetIntPtr(paginator.TotalLimit)})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedTotal, total)
+			assert.Len(t, got, tc.expectedTotal)
+		})
+	}
+}
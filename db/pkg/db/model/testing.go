@@ -80,9 +80,18 @@ func TestSetupSchema(t *testing.T, dbSession *db.Session) {
 	// create Operating System table
 	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystem)(nil))
 	assert.Nil(t, err)
+	// create OperatingSystemVersion table
+	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystemVersion)(nil))
+	assert.Nil(t, err)
 	// create Operating System Site Association table
 	err = dbSession.DB.ResetModel(context.Background(), (*OperatingSystemSiteAssociation)(nil))
 	assert.Nil(t, err)
+	// create InstanceTemplate table
+	err = dbSession.DB.ResetModel(context.Background(), (*InstanceTemplate)(nil))
+	assert.Nil(t, err)
+	// create InstanceTemplateVersion table
+	err = dbSession.DB.ResetModel(context.Background(), (*InstanceTemplateVersion)(nil))
+	assert.Nil(t, err)
 	// create Machine table
 	err = dbSession.DB.ResetModel(context.Background(), (*Machine)(nil))
 	assert.Nil(t, err)
@@ -143,6 +152,15 @@ func TestSetupSchema(t *testing.T, dbSession *db.Session) {
 	// create dpu extension service deployment table
 	err = dbSession.DB.ResetModel(context.Background(), (*DpuExtensionServiceDeployment)(nil))
 	assert.Nil(t, err)
+	// create tenant usage table
+	err = dbSession.DB.ResetModel(context.Background(), (*TenantUsage)(nil))
+	assert.Nil(t, err)
+	// create service account token table
+	err = dbSession.DB.ResetModel(context.Background(), (*ServiceAccountToken)(nil))
+	assert.Nil(t, err)
+	// create address set table
+	err = dbSession.DB.ResetModel(context.Background(), (*AddressSet)(nil))
+	assert.Nil(t, err)
 }
 
 // TestBuildUser creates a test User
@@ -0,0 +1,74 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Start transactions
+		tx, terr := db.BeginTx(ctx, &sql.TxOptions{})
+		if terr != nil {
+			handlePanic(terr, "failed to begin transaction")
+		}
+
+		// Create TenantUsage table
+		_, err := tx.NewCreateTable().Model((*model.TenantUsage)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop indices if they exist
+		_, err = tx.Exec("DROP INDEX IF EXISTS tenant_usage_usage_date_idx")
+		handleError(tx, err)
+
+		_, err = tx.Exec("DROP INDEX IF EXISTS tenant_usage_tenant_id_usage_date_idx")
+		handleError(tx, err)
+
+		_, err = tx.Exec("DROP INDEX IF EXISTS tenant_usage_infrastructure_provider_id_usage_date_idx")
+		handleError(tx, err)
+
+		// Add index for usage_date, used by the daily rollup to replace a day
+		_, err = tx.Exec("CREATE INDEX tenant_usage_usage_date_idx ON tenant_usage(usage_date)")
+		handleError(tx, err)
+
+		// Add indices for Tenant and Provider usage reports
+		_, err = tx.Exec("CREATE INDEX tenant_usage_tenant_id_usage_date_idx ON tenant_usage(tenant_id, usage_date)")
+		handleError(tx, err)
+
+		_, err = tx.Exec("CREATE INDEX tenant_usage_infrastructure_provider_id_usage_date_idx ON tenant_usage(infrastructure_provider_id, usage_date)")
+		handleError(tx, err)
+
+		terr = tx.Commit()
+		if terr != nil {
+			handlePanic(terr, "failed to commit transaction")
+		}
+
+		fmt.Print(" [up migration] Created 'tenant_usage' table and created indices successfully. ")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] No action taken")
+		return nil
+	})
+}
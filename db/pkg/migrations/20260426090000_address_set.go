@@ -0,0 +1,69 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Start transactions
+		tx, terr := db.BeginTx(ctx, &sql.TxOptions{})
+		if terr != nil {
+			handlePanic(terr, "failed to begin transaction")
+		}
+
+		// Create AddressSet table
+		_, err := tx.NewCreateTable().Model((*model.AddressSet)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS address_set_tenant_id_idx")
+		handleError(tx, err)
+
+		// Add index for tenant_id, used to list the AddressSets of a Tenant
+		_, err = tx.Exec("CREATE INDEX address_set_tenant_id_idx ON address_set(tenant_id) WHERE deleted IS NULL")
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS network_security_group_rules_gin_idx")
+		handleError(tx, err)
+
+		// Add GIN index for rules, used to find the Network Security Groups referencing an AddressSet or another Network Security Group
+		_, err = tx.Exec("CREATE INDEX network_security_group_rules_gin_idx ON network_security_group USING GIN (rules jsonb_path_ops)")
+		handleError(tx, err)
+
+		terr = tx.Commit()
+		if terr != nil {
+			handlePanic(terr, "failed to commit transaction")
+		}
+
+		fmt.Print(" [up migration] Created 'address_set' table and created indexes successfully. ")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] No action taken")
+		return nil
+	})
+}
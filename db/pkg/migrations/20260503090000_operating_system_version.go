@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Start transactions
+		tx, terr := db.BeginTx(ctx, &sql.TxOptions{})
+		if terr != nil {
+			handlePanic(terr, "failed to begin transaction")
+		}
+
+		// Create OperatingSystemVersion table
+		_, err := tx.NewCreateTable().Model((*model.OperatingSystemVersion)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS operating_system_version_operating_system_id_version_idx")
+		handleError(tx, err)
+
+		// Version names are unique within an Operating System
+		_, err = tx.Exec("CREATE UNIQUE INDEX operating_system_version_operating_system_id_version_idx ON operating_system_version(operating_system_id, version) WHERE deleted IS NULL")
+		handleError(tx, err)
+
+		// Add operating_system_version_id column to operating_system_site_association table
+		_, err = tx.NewAddColumn().Model((*model.OperatingSystemSiteAssociation)(nil)).IfNotExists().ColumnExpr("operating_system_version_id UUID NULL").Exec(ctx)
+		handleError(tx, err)
+
+		// Drop if one exists (won't occur/harmless in dev/stage/prod but helps with test)
+		_, err = tx.Exec("ALTER TABLE operating_system_site_association DROP CONSTRAINT IF EXISTS operating_system_site_association_operating_system_version_id_fkey")
+		handleError(tx, err)
+
+		_, err = tx.Exec("ALTER TABLE operating_system_site_association ADD CONSTRAINT operating_system_site_association_operating_system_version_id_fkey FOREIGN KEY (operating_system_version_id) REFERENCES public.operating_system_version(id)")
+		handleError(tx, err)
+
+		// Add operating_system_version_id column to instance table
+		_, err = tx.NewAddColumn().Model((*model.Instance)(nil)).IfNotExists().ColumnExpr("operating_system_version_id UUID NULL").Exec(ctx)
+		handleError(tx, err)
+
+		// Drop if one exists (won't occur/harmless in dev/stage/prod but helps with test)
+		_, err = tx.Exec("ALTER TABLE instance DROP CONSTRAINT IF EXISTS instance_operating_system_version_id_fkey")
+		handleError(tx, err)
+
+		_, err = tx.Exec("ALTER TABLE instance ADD CONSTRAINT instance_operating_system_version_id_fkey FOREIGN KEY (operating_system_version_id) REFERENCES public.operating_system_version(id)")
+		handleError(tx, err)
+
+		terr = tx.Commit()
+		if terr != nil {
+			handlePanic(terr, "failed to commit transaction")
+		}
+
+		fmt.Print(" [up migration] Created 'operating_system_version' table and added 'operating_system_version_id' columns successfully. ")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] No action taken")
+		return nil
+	})
+}
@@ -0,0 +1,86 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/uptrace/bun"
+
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Start transactions
+		tx, terr := db.BeginTx(ctx, &sql.TxOptions{})
+		if terr != nil {
+			handlePanic(terr, "failed to begin transaction")
+		}
+
+		// Create InstanceTemplate table
+		_, err := tx.NewCreateTable().Model((*model.InstanceTemplate)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS instance_template_tenant_id_idx")
+		handleError(tx, err)
+
+		_, err = tx.Exec("CREATE INDEX instance_template_tenant_id_idx ON instance_template(tenant_id)")
+		handleError(tx, err)
+
+		// Create InstanceTemplateVersion table
+		_, err = tx.NewCreateTable().Model((*model.InstanceTemplateVersion)(nil)).IfNotExists().Exec(ctx)
+		handleError(tx, err)
+
+		// Drop index if it exists
+		_, err = tx.Exec("DROP INDEX IF EXISTS instance_template_version_instance_template_id_version_idx")
+		handleError(tx, err)
+
+		// Version numbers are unique within an Instance Template
+		_, err = tx.Exec("CREATE UNIQUE INDEX instance_template_version_instance_template_id_version_idx ON instance_template_version(instance_template_id, version)")
+		handleError(tx, err)
+
+		// Add instance_template_id and instance_template_version columns to instance table
+		_, err = tx.NewAddColumn().Model((*model.Instance)(nil)).IfNotExists().ColumnExpr("instance_template_id UUID NULL").Exec(ctx)
+		handleError(tx, err)
+
+		_, err = tx.NewAddColumn().Model((*model.Instance)(nil)).IfNotExists().ColumnExpr("instance_template_version INTEGER NULL").Exec(ctx)
+		handleError(tx, err)
+
+		// Drop if one exists (won't occur/harmless in dev/stage/prod but helps with test)
+		_, err = tx.Exec("ALTER TABLE instance DROP CONSTRAINT IF EXISTS instance_instance_template_id_fkey")
+		handleError(tx, err)
+
+		_, err = tx.Exec("ALTER TABLE instance ADD CONSTRAINT instance_instance_template_id_fkey FOREIGN KEY (instance_template_id) REFERENCES public.instance_template(id)")
+		handleError(tx, err)
+
+		terr = tx.Commit()
+		if terr != nil {
+			handlePanic(terr, "failed to commit transaction")
+		}
+
+		fmt.Print(" [up migration] Created 'instance_template' and 'instance_template_version' tables and added 'instance_template_id' columns successfully. ")
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		fmt.Print(" [down migration] No action taken")
+		return nil
+	})
+}
@@ -149,6 +149,39 @@ func (s *Bundb) ReadPrefix(ctx context.Context, prefix, namespace string) (Prefi
 	return fromJSON(p.Prefix)
 }
 
+// PrefixKey identifies a prefix by its cidr and namespace
+type PrefixKey struct {
+	Cidr      string
+	Namespace string
+}
+
+// ReadPrefixes - read the given prefixes with a single query
+// prefixes which do not exist are omitted from the result
+func (s *Bundb) ReadPrefixes(ctx context.Context, keys []PrefixKey) (map[PrefixKey]Prefix, error) {
+	prefixes := map[PrefixKey]Prefix{}
+	if len(keys) == 0 {
+		return prefixes, nil
+	}
+	tuples := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		tuples = append(tuples, []string{k.Cidr, k.Namespace})
+	}
+	ps := []BunPrefix{}
+	query := s.getIDB().NewSelect().Model(&ps).Where("(cidr, namespace) IN (?)", bun.In(tuples))
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read prefixes:%w", err)
+	}
+	for _, p := range ps {
+		prefix, err := fromJSON(p.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		prefixes[PrefixKey{Cidr: p.Cidr, Namespace: p.Namespace}] = prefix
+	}
+	return prefixes, nil
+}
+
 // ReadPrefixes - read all the prefixes for the namespace
 func (s *Bundb) ReadAllPrefixes(ctx context.Context, namespace string) (Prefixes, error) {
 	var prefixes [][]byte
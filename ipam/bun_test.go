@@ -312,6 +312,43 @@ func TestBundbReadPrefix(t *testing.T) {
 	dumpConnectionCount(t, dbbun)
 }
 
+func TestBundbReadPrefixes(t *testing.T) {
+	ctx := context.Background()
+	dbSession, err := getTestBundbSession(t)
+	dbbun := NewBunStorage(dbSession, nil)
+	assert.Nil(t, err)
+	defer dbbun.close()
+	testSetupSchema(t, dbbun)
+	dbbun.DeleteAllPrefixesFromAllNamespaces(ctx)
+	db := dbbun.db
+	tx, err := db.BeginTx(ctx, &dbsql.TxOptions{})
+	assert.Nil(t, err)
+	apiDbSession := NewBunStorage(db, &tx)
+
+	// no keys
+	ps, err := apiDbSession.ReadPrefixes(ctx, nil)
+	require.Nil(t, err)
+	require.Equal(t, 0, len(ps))
+
+	// same cidr in two namespaces, only the requested ones are returned
+	for _, prefix := range []Prefix{{Cidr: "12.0.0.0/16", Namespace: "a"}, {Cidr: "12.0.0.0/16", Namespace: "b"}, {Cidr: "13.0.0.0/16", Namespace: "a"}} {
+		_, err = apiDbSession.CreatePrefix(ctx, prefix, prefix.Namespace)
+		require.Nil(t, err)
+	}
+	ps, err = apiDbSession.ReadPrefixes(ctx, []PrefixKey{
+		{Cidr: "12.0.0.0/16", Namespace: "b"},
+		{Cidr: "13.0.0.0/16", Namespace: "a"},
+		{Cidr: "14.0.0.0/16", Namespace: "a"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 2, len(ps))
+	assert.Equal(t, "b", ps[PrefixKey{Cidr: "12.0.0.0/16", Namespace: "b"}].Namespace)
+	assert.Equal(t, "13.0.0.0/16", ps[PrefixKey{Cidr: "13.0.0.0/16", Namespace: "a"}].Cidr)
+
+	err = tx.Rollback()
+	assert.Nil(t, err)
+}
+
 func TestBundbReadAllPrefixes(t *testing.T) {
 	ctx := context.Background()
 	dbSession, err := getTestBundbSession(t)
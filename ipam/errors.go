@@ -9,6 +9,8 @@ var (
 	ErrNoIPAvailable = errors.New("NoIPAvailableError")
 	// ErrAlreadyAllocated is returned if the requested address is not available
 	ErrAlreadyAllocated = errors.New("AlreadyAllocatedError")
+	// ErrReserved is returned if the requested address or prefix lies within a reserved range
+	ErrReserved = errors.New("ReservedError")
 	// ErrOptimisticLockError is returned if insert or update conflicts with the existing data
 	ErrOptimisticLockError = errors.New("OptimisticLockError")
 	// ErrNamespaceDoesNotExist is returned when an operation is perfomed in a namespace that does not exist.
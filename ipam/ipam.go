@@ -52,6 +52,16 @@ type Ipamer interface {
 	// If the Prefix or the IP is not found an NotFoundError is returned.
 	// This operation is scoped to the root namespace unless a different namespace is provided in the context.
 	ReleaseIPFromPrefix(ctx context.Context, prefixCidr, ip string) error
+	// ReserveRange excludes the range of ips between from and to (inclusive) from allocation and returns the updated Prefix.
+	// If to is empty, only from is reserved.
+	// The range must not overlap acquired ips, acquired child prefixes or other reserved ranges.
+	// This operation is scoped to the root namespace unless a different namespace is provided in the context.
+	ReserveRange(ctx context.Context, prefixCidr, from, to string) (*Prefix, error)
+	// ReleaseRange makes a range previously reserved with ReserveRange available for allocation again
+	// and returns the updated Prefix.
+	// If the range is not reserved an NotFoundError is returned.
+	// This operation is scoped to the root namespace unless a different namespace is provided in the context.
+	ReleaseRange(ctx context.Context, prefixCidr, from, to string) (*Prefix, error)
 	// Dump all stored prefixes as json formatted string
 	// This operation is scoped to the root namespace unless a different namespace is provided in the context.
 	Dump(ctx context.Context) (string, error)
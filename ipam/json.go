@@ -12,6 +12,7 @@ type prefixJSON struct {
 	ChildPrefixLength int             // the length of the child prefixes. Legacy to migrate existing prefixes stored in the db to set the IsParent on reads.
 	IsParent          bool            // set to true if there are child prefixes
 	IPs               map[string]bool // The ips contained in this prefix
+	ReservedRanges    []string        // ip ranges in from-to notation which are excluded from allocation
 	Version           int64           // Version is used for optimistic locking
 }
 
@@ -28,6 +29,7 @@ func (p prefixJSON) toPrefix() Prefix {
 		childPrefixLength:      p.ChildPrefixLength,
 		isParent:               p.IsParent,
 		ips:                    p.IPs,
+		reservedRanges:         p.ReservedRanges,
 		version:                p.Version,
 		Namespace:              p.Namespace,
 	}
@@ -45,6 +47,7 @@ func (p Prefix) toPrefixJSON() prefixJSON {
 		// TODO remove this in the next release
 		ChildPrefixLength: p.childPrefixLength,
 		IPs:               p.ips,
+		ReservedRanges:    p.reservedRanges,
 		Version:           p.version,
 	}
 }
@@ -6,8 +6,10 @@ import (
 	"encoding/gob"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"net/netip"
+	"slices"
 	"strings"
 
 	"github.com/avast/retry-go/v4"
@@ -24,6 +26,7 @@ type Prefix struct {
 	// TODO remove this in the next release
 	childPrefixLength int             // the length of the child prefixes
 	ips               map[string]bool // The ips contained in this prefix
+	reservedRanges    []string        // ip ranges in from-to notation which are excluded from allocation
 	version           int64           // version is used for optimistic locking
 }
 
@@ -40,6 +43,7 @@ func (p Prefix) deepCopy() *Prefix {
 		childPrefixLength:      p.childPrefixLength,
 		availableChildPrefixes: copyMap(p.availableChildPrefixes),
 		ips:                    copyMap(p.ips),
+		reservedRanges:         slices.Clone(p.reservedRanges),
 		version:                p.version,
 	}
 }
@@ -69,6 +73,9 @@ func (p *Prefix) GobEncode() ([]byte, error) {
 	if err := encoder.Encode(p.ParentCidr); err != nil {
 		return nil, err
 	}
+	if err := encoder.Encode(p.reservedRanges); err != nil {
+		return nil, err
+	}
 	return w.Bytes(), nil
 }
 
@@ -94,7 +101,14 @@ func (p *Prefix) GobDecode(buf []byte) error {
 	if err := decoder.Decode(&p.Cidr); err != nil {
 		return err
 	}
-	return decoder.Decode(&p.ParentCidr)
+	if err := decoder.Decode(&p.ParentCidr); err != nil {
+		return err
+	}
+	// reserved ranges are absent in prefixes encoded by older releases
+	if err := decoder.Decode(&p.reservedRanges); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
 }
 
 func copyMap(m map[string]bool) map[string]bool {
@@ -107,7 +121,7 @@ func copyMap(m map[string]bool) map[string]bool {
 
 // Usage of ips and child Prefixes of a Prefix
 type Usage struct {
-	// AvailableIPs the number of available IPs if this is not a parent prefix
+	// AvailableIPs the number of available IPs if this is not a parent prefix, IPs in reserved ranges are excluded
 	// No more than 2^31 available IPs are reported
 	AvailableIPs uint64
 	// AcquiredIPs the number of acquired IPs if this is not a parent prefix
@@ -119,6 +133,9 @@ type Usage struct {
 	AvailablePrefixes []string
 	// AcquiredPrefixes the number of acquired prefixes if this is a parent prefix
 	AcquiredPrefixes uint64
+	// ReservedIPs the number of IPs covered by reserved ranges
+	// No more than 2^31 reserved IPs are reported
+	ReservedIPs uint64
 }
 
 func (i *ipamer) NewPrefix(ctx context.Context, cidr string) (*Prefix, error) {
@@ -196,6 +213,9 @@ func (i *ipamer) acquireChildPrefixInternal(ctx context.Context, parentCidr, chi
 			return nil, err
 		}
 		length = childprefix.Bits()
+		if r, reserved := parent.reservedRangeOverlapping(netipx.RangeOfPrefix(childprefix.Masked())); reserved {
+			return nil, fmt.Errorf("%w: specific prefix %s overlaps reserved range %s in prefix %s", ErrReserved, childCidr, r, parentCidr)
+		}
 	}
 	if ipprefix.Bits() >= length {
 		return nil, fmt.Errorf("given length:%d must be greater than prefix length:%d", length, ipprefix.Bits())
@@ -216,6 +236,9 @@ func (i *ipamer) acquireChildPrefixInternal(ctx context.Context, parentCidr, chi
 		}
 		ipsetBuilder.RemovePrefix(cpipprefix)
 	}
+	for _, r := range parent.reservedIPRanges() {
+		ipsetBuilder.RemoveRange(r)
+	}
 
 	ipset, err := ipsetBuilder.IPSet()
 	if err != nil {
@@ -396,8 +419,12 @@ func (i *ipamer) acquireSpecificIPInternal(ctx context.Context, prefixCidr, spec
 		if ok {
 			return nil, fmt.Errorf("%w: given ip:%s is already allocated", ErrAlreadyAllocated, specificIPnet)
 		}
+		if r, reserved := prefix.reservedRangeOverlapping(netipx.IPRangeFrom(specificIPnet, specificIPnet)); reserved {
+			return nil, fmt.Errorf("%w: given ip:%s is in reserved range %s", ErrReserved, specificIPnet, r)
+		}
 	}
 
+	reserved := prefix.reservedIPRanges()
 	iprange := netipx.RangeOfPrefix(ipnet)
 	for ip := iprange.From(); ipnet.Contains(ip); ip = ip.Next() {
 		ipstring := ip.String()
@@ -405,6 +432,11 @@ func (i *ipamer) acquireSpecificIPInternal(ctx context.Context, prefixCidr, spec
 		if ok {
 			continue
 		}
+		if r, ok := rangeContaining(reserved, ip); ok {
+			// skip the whole reserved range at once instead of walking it ip by ip
+			ip = r.To()
+			continue
+		}
 		if specificIP == "" || specificIPnet.Compare(ip) == 0 {
 			acquired := &IP{
 				IP:           ip,
@@ -457,6 +489,105 @@ func (i *ipamer) releaseIPFromPrefixInternal(ctx context.Context, prefixCidr, ip
 	return nil
 }
 
+func (i *ipamer) ReserveRange(ctx context.Context, prefixCidr, from, to string) (*Prefix, error) {
+	var prefix *Prefix
+	return prefix, retryOnOptimisticLock(func() error {
+		var err error
+		prefix, err = i.reserveRangeInternal(ctx, prefixCidr, from, to)
+		return err
+	})
+}
+
+// reserveRangeInternal will exclude the given range of ips from further allocation.
+// The range must be inside the prefix, and must not overlap acquired ips, acquired child prefixes
+// or other reserved ranges.
+func (i *ipamer) reserveRangeInternal(ctx context.Context, prefixCidr, from, to string) (*Prefix, error) {
+	prefix := i.PrefixFrom(ctx, prefixCidr)
+	if prefix == nil {
+		return nil, fmt.Errorf("%w: unable to find prefix for cidr:%s", ErrNotFound, prefixCidr)
+	}
+	r, err := prefix.parseRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if existing, ok := prefix.reservedRangeOverlapping(r); ok {
+		return nil, fmt.Errorf("%w: range %s overlaps reserved range %s", ErrReserved, r, existing)
+	}
+
+	ipnet, err := netip.ParsePrefix(prefix.Cidr)
+	if err != nil {
+		return nil, err
+	}
+	// network and broadcast address are blocked on creation and may be part of a reservation
+	blocked := netipx.RangeOfPrefix(ipnet)
+	for ipstring := range prefix.ips {
+		ip, err := netip.ParseAddr(ipstring)
+		if err != nil {
+			continue
+		}
+		if ip == blocked.From() || (ip.Is4() && ip == blocked.To()) {
+			continue
+		}
+		if r.Contains(ip) {
+			return nil, fmt.Errorf("%w: range %s contains acquired ip:%s", ErrAlreadyAllocated, r, ip)
+		}
+	}
+	for cp, available := range prefix.availableChildPrefixes {
+		if available {
+			continue
+		}
+		cpipprefix, err := netip.ParsePrefix(cp)
+		if err != nil {
+			return nil, err
+		}
+		if r.Overlaps(netipx.RangeOfPrefix(cpipprefix)) {
+			return nil, fmt.Errorf("%w: range %s overlaps acquired child prefix:%s", ErrAlreadyAllocated, r, cp)
+		}
+	}
+
+	prefix.reservedRanges = append(prefix.reservedRanges, r.String())
+	slices.SortFunc(prefix.reservedRanges, compareRanges)
+	_, err = i.storage.UpdatePrefix(ctx, *prefix, i.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to persist reserved range:%s error:%w", r, err)
+	}
+	return prefix, nil
+}
+
+func (i *ipamer) ReleaseRange(ctx context.Context, prefixCidr, from, to string) (*Prefix, error) {
+	var prefix *Prefix
+	return prefix, retryOnOptimisticLock(func() error {
+		var err error
+		prefix, err = i.releaseRangeInternal(ctx, prefixCidr, from, to)
+		return err
+	})
+}
+
+// releaseRangeInternal will make a previously reserved range available for allocation again.
+func (i *ipamer) releaseRangeInternal(ctx context.Context, prefixCidr, from, to string) (*Prefix, error) {
+	prefix := i.PrefixFrom(ctx, prefixCidr)
+	if prefix == nil {
+		return nil, fmt.Errorf("%w: unable to find prefix for cidr:%s", ErrNotFound, prefixCidr)
+	}
+	r, err := prefix.parseRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+	idx := slices.Index(prefix.reservedRanges, r.String())
+	if idx < 0 {
+		return nil, fmt.Errorf("%w: range %s is not reserved in prefix:%s", ErrNotFound, r, prefixCidr)
+	}
+	prefix.reservedRanges = slices.Delete(prefix.reservedRanges, idx, idx+1)
+	if len(prefix.reservedRanges) == 0 {
+		prefix.reservedRanges = nil
+	}
+	_, err = i.storage.UpdatePrefix(ctx, *prefix, i.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("unable to release reserved range:%s error:%w", r, err)
+	}
+	return prefix, nil
+}
+
 // PrefixesOverlapping will check if one ore more prefix of newPrefixes is overlapping
 // with one of existingPrefixes
 func PrefixesOverlapping(existingPrefixes []string, newPrefixes []string) error {
@@ -560,6 +691,9 @@ func (p *Prefix) String() string {
 
 func (u *Usage) String() string {
 	if u.AcquiredPrefixes == 0 {
+		if u.ReservedIPs > 0 {
+			return fmt.Sprintf("ip:%d/%d reserved:%d", u.AcquiredIPs, u.AvailableIPs, u.ReservedIPs)
+		}
 		return fmt.Sprintf("ip:%d/%d", u.AcquiredIPs, u.AvailableIPs)
 	}
 	return fmt.Sprintf("ip:%d/%d prefixes alloc:%d avail:%d", u.AcquiredIPs, u.AvailableIPs, u.AcquiredPrefixes, u.AvailableSmallestPrefixes)
@@ -589,17 +723,122 @@ func (p *Prefix) hasIPs() bool {
 	return false
 }
 
+// ReservedRanges returns the ranges of this Prefix which are excluded from allocation, in from-to notation
+func (p *Prefix) ReservedRanges() []string {
+	return slices.Clone(p.reservedRanges)
+}
+
+// parseRange parses the given boundaries into a range and ensures it is inside this Prefix.
+// If to is empty, the range only contains from.
+func (p *Prefix) parseRange(from, to string) (netipx.IPRange, error) {
+	if to == "" {
+		to = from
+	}
+	fromIP, err := netip.ParseAddr(from)
+	if err != nil {
+		return netipx.IPRange{}, fmt.Errorf("given ip:%s in not valid", from)
+	}
+	toIP, err := netip.ParseAddr(to)
+	if err != nil {
+		return netipx.IPRange{}, fmt.Errorf("given ip:%s in not valid", to)
+	}
+	r := netipx.IPRangeFrom(fromIP, toIP)
+	if !r.IsValid() {
+		return netipx.IPRange{}, fmt.Errorf("given range %s-%s is not valid", from, to)
+	}
+	ipnet, err := netip.ParsePrefix(p.Cidr)
+	if err != nil {
+		return netipx.IPRange{}, err
+	}
+	if !ipnet.Contains(fromIP) || !ipnet.Contains(toIP) {
+		return netipx.IPRange{}, fmt.Errorf("given range %s is not in %s", r, p.Cidr)
+	}
+	return r, nil
+}
+
+// reservedIPRanges returns the parsed reserved ranges of this Prefix
+func (p *Prefix) reservedIPRanges() []netipx.IPRange {
+	ranges := make([]netipx.IPRange, 0, len(p.reservedRanges))
+	for _, rr := range p.reservedRanges {
+		r, err := netipx.ParseIPRange(rr)
+		if err != nil {
+			continue
+		}
+		ranges = append(ranges, r)
+	}
+	return ranges
+}
+
+// reservedRangeOverlapping returns the first reserved range which overlaps the given range
+func (p *Prefix) reservedRangeOverlapping(r netipx.IPRange) (netipx.IPRange, bool) {
+	for _, rr := range p.reservedIPRanges() {
+		if rr.Overlaps(r) {
+			return rr, true
+		}
+	}
+	return netipx.IPRange{}, false
+}
+
+// rangeContaining returns the range of the given ranges which contains ip
+func rangeContaining(ranges []netipx.IPRange, ip netip.Addr) (netipx.IPRange, bool) {
+	for _, r := range ranges {
+		if r.Contains(ip) {
+			return r, true
+		}
+	}
+	return netipx.IPRange{}, false
+}
+
+// compareRanges orders ranges in from-to notation by their first ip
+func compareRanges(a, b string) int {
+	ra, erra := netipx.ParseIPRange(a)
+	rb, errb := netipx.ParseIPRange(b)
+	if erra != nil || errb != nil {
+		return strings.Compare(a, b)
+	}
+	return ra.From().Compare(rb.From())
+}
+
 // availableips return the number of ips available in this Prefix
 func (p *Prefix) availableips() uint64 {
 	ipprefix, err := netip.ParsePrefix(p.Cidr)
 	if err != nil {
 		return 0
 	}
-	// We don't report more than 2^31 available IPs by design
-	if (ipprefix.Addr().BitLen() - ipprefix.Bits()) > 31 {
-		return math.MaxInt32
+	if len(p.reservedRanges) == 0 {
+		// We don't report more than 2^31 available IPs by design
+		if (ipprefix.Addr().BitLen() - ipprefix.Bits()) > 31 {
+			return math.MaxInt32
+		}
+		return 1 << (ipprefix.Addr().BitLen() - ipprefix.Bits())
+	}
+
+	// Reserved ranges are never allocated, so they are not available
+	var ipsetBuilder netipx.IPSetBuilder
+	ipsetBuilder.AddPrefix(ipprefix)
+	for _, r := range p.reservedIPRanges() {
+		ipsetBuilder.RemoveRange(r)
 	}
-	return 1 << (ipprefix.Addr().BitLen() - ipprefix.Bits())
+	ipset, err := ipsetBuilder.IPSet()
+	if err != nil {
+		return 0
+	}
+	return countips(ipset.Prefixes())
+}
+
+// countips return the number of ips in the given prefixes, no more than 2^31 by design
+func countips(pfxs []netip.Prefix) uint64 {
+	var count uint64
+	for _, pfx := range pfxs {
+		if (pfx.Addr().BitLen() - pfx.Bits()) > 31 {
+			return math.MaxInt32
+		}
+		count += 1 << (pfx.Addr().BitLen() - pfx.Bits())
+		if count > math.MaxInt32 {
+			return math.MaxInt32
+		}
+	}
+	return count
 }
 
 // acquiredips return the number of ips acquired in this Prefix
@@ -625,6 +864,9 @@ func (p *Prefix) availablePrefixes() (uint64, []string) {
 		}
 		ipsetBuilder.RemovePrefix(ipprefix)
 	}
+	for _, r := range p.reservedIPRanges() {
+		ipsetBuilder.RemoveRange(r)
+	}
 
 	ipset, err := ipsetBuilder.IPSet()
 	if err != nil {
@@ -663,6 +905,16 @@ func (p *Prefix) acquiredPrefixes() uint64 {
 	return count
 }
 
+// reservedips return the number of ips covered by the reserved ranges of this Prefix
+func (p *Prefix) reservedips() uint64 {
+	var pfxs []netip.Prefix
+	for _, r := range p.reservedIPRanges() {
+		pfxs = append(pfxs, r.Prefixes()...)
+	}
+	// We don't report more than 2^31 reserved IPs by design
+	return countips(pfxs)
+}
+
 // Usage report Prefix usage.
 func (p *Prefix) Usage() Usage {
 	sp, ap := p.availablePrefixes()
@@ -672,6 +924,7 @@ func (p *Prefix) Usage() Usage {
 		AcquiredPrefixes:          p.acquiredPrefixes(),
 		AvailableSmallestPrefixes: sp,
 		AvailablePrefixes:         ap,
+		ReservedIPs:               p.reservedips(),
 	}
 }
 
@@ -1409,6 +1409,7 @@ func TestPrefixDeepCopy(t *testing.T) {
 	p1.availableChildPrefixes["4.1.2.0/24"] = true
 	p1.ips["4.1.1.1"] = true
 	p1.ips["4.1.1.2"] = true
+	p1.reservedRanges = []string{"4.1.1.10-4.1.1.20"}
 
 	p2 := p1.deepCopy()
 
@@ -1416,6 +1417,7 @@ func TestPrefixDeepCopy(t *testing.T) {
 	require.Equal(t, p1, p2)
 	require.False(t, &(p1.availableChildPrefixes) == &(p2.availableChildPrefixes))
 	require.False(t, &(p1.ips) == &(p2.ips))
+	require.False(t, &(p1.reservedRanges[0]) == &(p2.reservedRanges[0]))
 }
 
 func TestGob(t *testing.T) {
@@ -1500,6 +1502,97 @@ func TestPrefix_availablePrefixes(t *testing.T) {
 	}
 }
 
+func TestIpamer_ReserveRange(t *testing.T) {
+	ctx := context.Background()
+
+	testWithBackends(t, func(t *testing.T, ipam *ipamer) {
+		p, err := ipam.NewPrefix(ctx, "192.168.0.0/29")
+		require.NoError(t, err)
+
+		p, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.0.1", "192.168.0.3")
+		require.NoError(t, err)
+		require.Equal(t, []string{"192.168.0.1-192.168.0.3"}, p.ReservedRanges())
+		require.Equal(t, uint64(3), p.Usage().ReservedIPs)
+		require.Equal(t, uint64(2), p.Usage().AcquiredIPs)
+		// reserved ips are not available
+		require.Equal(t, uint64(5), p.Usage().AvailableIPs)
+
+		// reservations are persisted
+		p = ipam.PrefixFrom(ctx, p.Cidr)
+		require.NotNil(t, p)
+		require.Equal(t, []string{"192.168.0.1-192.168.0.3"}, p.ReservedRanges())
+
+		_, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.0.3", "192.168.0.4")
+		require.ErrorIs(t, err, ErrReserved)
+		_, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.1.1", "")
+		require.Error(t, err)
+		_, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.0.5", "192.168.0.4")
+		require.Error(t, err)
+
+		_, err = ipam.AcquireSpecificIP(ctx, p.Cidr, "192.168.0.2")
+		require.ErrorIs(t, err, ErrReserved)
+
+		ip, err := ipam.AcquireIP(ctx, p.Cidr)
+		require.NoError(t, err)
+		require.Equal(t, "192.168.0.4", ip.IP.String())
+
+		_, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.0.4", "192.168.0.5")
+		require.ErrorIs(t, err, ErrAlreadyAllocated)
+
+		// single ip reservation
+		p, err = ipam.ReserveRange(ctx, p.Cidr, "192.168.0.6", "")
+		require.NoError(t, err)
+		require.Equal(t, []string{"192.168.0.1-192.168.0.3", "192.168.0.6-192.168.0.6"}, p.ReservedRanges())
+
+		ip, err = ipam.AcquireIP(ctx, p.Cidr)
+		require.NoError(t, err)
+		require.Equal(t, "192.168.0.5", ip.IP.String())
+		_, err = ipam.AcquireIP(ctx, p.Cidr)
+		require.ErrorIs(t, err, ErrNoIPAvailable)
+
+		_, err = ipam.ReleaseRange(ctx, p.Cidr, "192.168.0.1", "192.168.0.2")
+		require.ErrorIs(t, err, ErrNotFound)
+		p, err = ipam.ReleaseRange(ctx, p.Cidr, "192.168.0.1", "192.168.0.3")
+		require.NoError(t, err)
+		require.Equal(t, []string{"192.168.0.6-192.168.0.6"}, p.ReservedRanges())
+		require.Equal(t, uint64(7), p.Usage().AvailableIPs)
+
+		ip, err = ipam.AcquireIP(ctx, p.Cidr)
+		require.NoError(t, err)
+		require.Equal(t, "192.168.0.1", ip.IP.String())
+	})
+}
+
+func TestIpamer_ReserveRangeChildPrefix(t *testing.T) {
+	ctx := context.Background()
+
+	testWithBackends(t, func(t *testing.T, ipam *ipamer) {
+		p, err := ipam.NewPrefix(ctx, "10.0.0.0/24")
+		require.NoError(t, err)
+
+		p, err = ipam.ReserveRange(ctx, p.Cidr, "10.0.0.0", "10.0.0.63")
+		require.NoError(t, err)
+		require.Equal(t, uint64(64), p.Usage().ReservedIPs)
+		require.Equal(t, uint64(192), p.Usage().AvailableIPs)
+		require.Equal(t, []string{"10.0.0.64/26", "10.0.0.128/25"}, p.Usage().AvailablePrefixes)
+
+		_, err = ipam.AcquireSpecificChildPrefix(ctx, p.Cidr, "10.0.0.32/27")
+		require.ErrorIs(t, err, ErrReserved)
+
+		c1, err := ipam.AcquireChildPrefix(ctx, p.Cidr, 26)
+		require.NoError(t, err)
+		require.Equal(t, "10.0.0.64/26", c1.Cidr)
+
+		_, err = ipam.ReserveRange(ctx, p.Cidr, "10.0.0.100", "10.0.0.130")
+		require.ErrorIs(t, err, ErrAlreadyAllocated)
+
+		_, err = ipam.AcquireChildPrefix(ctx, p.Cidr, 25)
+		require.NoError(t, err)
+		_, err = ipam.AcquireChildPrefix(ctx, p.Cidr, 26)
+		require.Error(t, err)
+	})
+}
+
 func TestAcquireIPParallel(t *testing.T) {
 	ctx := context.Background()
 	ipsCount := 50
@@ -18,6 +18,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/firmwaremanager/packages"
 
 	log "github.com/sirupsen/logrus"
@@ -28,8 +29,29 @@ var (
 	fwPackagesDir   string
 	fwFirmwareDir   string
 	fwBundleVersion string
+	fwTrustedKeys   []string
+	fwRequireSig    bool
 )
 
+// loadRegistry loads the firmware bundles, verifying firmware files with the configured trusted keys.
+func loadRegistry() *packages.Registry {
+	keys, err := integrity.LoadPublicKeys(fwTrustedKeys...)
+	if err != nil {
+		log.Fatalf("Failed to load trusted keys: %v", err)
+	}
+
+	verifier, err := integrity.NewVerifier(keys, fwRequireSig)
+	if err != nil {
+		log.Fatalf("Failed to create verifier: %v", err)
+	}
+
+	registry := packages.NewRegistry(fwFirmwareDir, verifier)
+	if err := registry.LoadFromDirectory(fwPackagesDir); err != nil {
+		log.Fatalf("Failed to load packages: %v", err)
+	}
+	return registry
+}
+
 // firmwareCmd represents the firmware command group
 var firmwareCmd = &cobra.Command{
 	Use:   "firmware",
@@ -49,12 +71,12 @@ var firmwareListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List available firmware bundles",
 	Run: func(cmd *cobra.Command, args []string) {
-		registry := packages.NewRegistry(fwFirmwareDir)
-		if err := registry.LoadFromDirectory(fwPackagesDir); err != nil {
-			log.Fatalf("Failed to load packages: %v", err)
-		}
+		registry := loadRegistry()
 
 		pkgs := registry.ListPackages()
+		rejected := registry.ListRejected()
+		defer printRejected(rejected)
+
 		if len(pkgs) == 0 {
 			fmt.Println("No firmware bundles found.")
 			fmt.Printf("\nSearched in: %s\n", fwPackagesDir)
@@ -82,6 +104,27 @@ var firmwareListCmd = &cobra.Command{
 	},
 }
 
+// printRejected prints the bundles that failed validation or integrity checks.
+func printRejected(rejected []packages.RejectedPackage) {
+	if len(rejected) == 0 {
+		return
+	}
+
+	fmt.Printf("\nRejected Firmware Bundles (%d):\n\n", len(rejected))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "FILE\tVERSION\tREASON")
+	fmt.Fprintln(w, "----\t-------\t------")
+	for _, r := range rejected {
+		version := r.Version
+		if version == "" {
+			version = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", filepath.Base(r.File), version, r.Reason)
+	}
+	w.Flush()
+}
+
 // firmwareShowCmd shows details of a specific bundle
 var firmwareShowCmd = &cobra.Command{
 	Use:   "show",
@@ -91,10 +134,7 @@ var firmwareShowCmd = &cobra.Command{
 			log.Fatal("Bundle version is required (--version)")
 		}
 
-		registry := packages.NewRegistry(fwFirmwareDir)
-		if err := registry.LoadFromDirectory(fwPackagesDir); err != nil {
-			log.Fatalf("Failed to load packages: %v", err)
-		}
+		registry := loadRegistry()
 
 		pkg, err := registry.Get(fwBundleVersion)
 		if err != nil {
@@ -160,19 +200,16 @@ var firmwareShowCmd = &cobra.Command{
 	},
 }
 
-// firmwareValidateCmd validates a bundle's files exist
+// firmwareValidateCmd validates a bundle's files exist and pass integrity checks
 var firmwareValidateCmd = &cobra.Command{
 	Use:   "validate",
-	Short: "Validate firmware bundle files exist",
+	Short: "Validate firmware bundle files exist and match their checksums",
 	Run: func(cmd *cobra.Command, args []string) {
 		if fwBundleVersion == "" {
 			log.Fatal("Bundle version is required (--version)")
 		}
 
-		registry := packages.NewRegistry(fwFirmwareDir)
-		if err := registry.LoadFromDirectory(fwPackagesDir); err != nil {
-			log.Fatalf("Failed to load packages: %v", err)
-		}
+		registry := loadRegistry()
 
 		pkg, err := registry.Get(fwBundleVersion)
 		if err != nil {
@@ -193,7 +230,13 @@ var firmwareValidateCmd = &cobra.Command{
 				continue
 			}
 
-			fmt.Printf("  [OK]   %s: %s (%d bytes)\n", strings.ToUpper(compName), filepath.Base(comp.File), info.Size())
+			if err := registry.VerifyComponent(pkg, compName); err != nil {
+				fmt.Printf("  [FAIL] %s: %v\n", strings.ToUpper(compName), err)
+				allValid = false
+				continue
+			}
+
+			fmt.Printf("  [OK]   %s: %s (%d bytes, %s)\n", strings.ToUpper(compName), filepath.Base(comp.File), info.Size(), comp.Checksum)
 		}
 
 		fmt.Println()
@@ -215,6 +258,8 @@ func init() {
 
 	firmwareCmd.PersistentFlags().StringVar(&fwPackagesDir, "bundles-dir", defaultBundlesDir, "Directory containing bundle YAML files")
 	firmwareCmd.PersistentFlags().StringVar(&fwFirmwareDir, "firmware-dir", defaultFirmwareDir, "Base directory for firmware files")
+	firmwareCmd.PersistentFlags().StringSliceVar(&fwTrustedKeys, "trusted-key", nil, "Public key (PEM or minisign) trusted to sign firmware, repeatable")
+	firmwareCmd.PersistentFlags().BoolVar(&fwRequireSig, "require-signature", false, "Reject firmware without a valid signature from a trusted key")
 
 	firmwareCmd.AddCommand(firmwareListCmd)
 	firmwareCmd.AddCommand(firmwareShowCmd)
@@ -214,6 +214,13 @@ var registerBundlesCmd = &cobra.Command{
 			log.Fatalf("Failed to list bundles: %v", err)
 		}
 
+		for _, r := range resp.Rejected {
+			fmt.Printf("Rejected bundle %s (version %q): %s\n", r.File, r.Version, r.Reason)
+		}
+		if len(resp.Rejected) > 0 {
+			fmt.Println()
+		}
+
 		if len(resp.Bundles) == 0 {
 			fmt.Println("No firmware bundles available")
 			return
@@ -49,6 +49,24 @@ func getEnvIntOrDefault(envVar string, defaultVal int) int {
 	return defaultVal
 }
 
+// getEnvBoolOrDefault returns the bool value of an environment variable or a default value.
+func getEnvBoolOrDefault(envVar string, defaultVal bool) bool {
+	if val := os.Getenv(envVar); val != "" {
+		if boolVal, err := strconv.ParseBool(val); err == nil {
+			return boolVal
+		}
+	}
+	return defaultVal
+}
+
+// getEnvListOrDefault returns the comma-separated values of an environment variable or a default value.
+func getEnvListOrDefault(envVar string, defaultVal []string) []string {
+	if val := os.Getenv(envVar); val != "" {
+		return strings.Split(val, ",")
+	}
+	return defaultVal
+}
+
 const (
 	// default service config
 	defaultServicePort   = 50051
@@ -71,6 +89,17 @@ const (
 	defaultFirmwareFirmwareDir = ""
 	defaultFirmwareNumWorkers  = 10
 	defaultFirmwarePollSeconds = 5
+	defaultFirmwareLeaseSecs   = 120
+
+	// default health collector config
+	defaultHealthIntervalSecs = 60
+	defaultHealthRetentionHrs = 168
+	defaultHealthConcurrency  = 8
+	defaultMetricsPort        = 9090
+
+	// default RLA config (empty host disables maintenance window checks)
+	defaultRLAHost = ""
+	defaultRLAPort = 50051
 )
 
 var (
@@ -95,6 +124,20 @@ var (
 	firmwareFirmwareDir string
 	firmwareNumWorkers  int
 	firmwarePollSeconds int
+	firmwareTrustedKeys []string
+	firmwareRequireSig  bool
+	firmwareInstanceID  string
+	firmwareLeaseSecs   int
+
+	// Health collector config
+	healthIntervalSecs int
+	healthRetentionHrs int
+	healthConcurrency  int
+	metricsPort        int
+
+	// RLA config
+	rlaHost string
+	rlaPort int
 )
 
 // serveCmd represents the serve command
@@ -130,6 +173,20 @@ func init() {
 	serveCmd.Flags().StringVar(&firmwareFirmwareDir, "fw_firmware_dir", getEnvOrDefault("FW_FIRMWARE_DIR", defaultFirmwareFirmwareDir), "Firmware files directory (env: FW_FIRMWARE_DIR)")
 	serveCmd.Flags().IntVar(&firmwareNumWorkers, "fw_workers", getEnvIntOrDefault("FW_WORKERS", defaultFirmwareNumWorkers), "Number of firmware update workers (env: FW_WORKERS)")
 	serveCmd.Flags().IntVar(&firmwarePollSeconds, "fw_poll_seconds", getEnvIntOrDefault("FW_POLL_SECONDS", defaultFirmwarePollSeconds), "Worker poll interval in seconds (env: FW_POLL_SECONDS)")
+	serveCmd.Flags().StringSliceVar(&firmwareTrustedKeys, "fw_trusted_keys", getEnvListOrDefault("FW_TRUSTED_KEYS", nil), "Public keys (PEM or minisign) trusted to sign firmware, comma-separated (env: FW_TRUSTED_KEYS)")
+	serveCmd.Flags().BoolVar(&firmwareRequireSig, "fw_require_signature", getEnvBoolOrDefault("FW_REQUIRE_SIGNATURE", false), "Reject firmware without a valid signature from a trusted key (env: FW_REQUIRE_SIGNATURE)")
+	serveCmd.Flags().StringVar(&firmwareInstanceID, "fw_instance_id", getEnvOrDefault("FW_INSTANCE_ID", ""), "Lease owner ID of this replica for firmware updates and health collection, defaults to hostname plus random suffix (env: FW_INSTANCE_ID)")
+	serveCmd.Flags().IntVar(&firmwareLeaseSecs, "fw_lease_seconds", getEnvIntOrDefault("FW_LEASE_SECONDS", defaultFirmwareLeaseSecs), "Seconds a claimed firmware update stays leased without a heartbeat (env: FW_LEASE_SECONDS)")
+
+	// Health collector flags
+	serveCmd.Flags().IntVar(&healthIntervalSecs, "health_interval_seconds", getEnvIntOrDefault("HEALTH_INTERVAL_SECONDS", defaultHealthIntervalSecs), "Switch health poll interval in seconds, 0 disables the collector (env: HEALTH_INTERVAL_SECONDS)")
+	serveCmd.Flags().IntVar(&healthRetentionHrs, "health_retention_hours", getEnvIntOrDefault("HEALTH_RETENTION_HOURS", defaultHealthRetentionHrs), "Hours health and port counter samples are kept (env: HEALTH_RETENTION_HOURS)")
+	serveCmd.Flags().IntVar(&healthConcurrency, "health_concurrency", getEnvIntOrDefault("HEALTH_CONCURRENCY", defaultHealthConcurrency), "Number of switches polled in parallel (env: HEALTH_CONCURRENCY)")
+	serveCmd.Flags().IntVar(&metricsPort, "metrics_port", getEnvIntOrDefault("NSM_METRICS_PORT", defaultMetricsPort), "Port for the Prometheus /metrics endpoint, 0 disables it (env: NSM_METRICS_PORT)")
+
+	// RLA flags
+	serveCmd.Flags().StringVar(&rlaHost, "rla_host", getEnvOrDefault("RLA_HOST", defaultRLAHost), "RLA host whose maintenance windows gate firmware updates, empty disables the check (env: RLA_HOST)")
+	serveCmd.Flags().IntVar(&rlaPort, "rla_port", getEnvIntOrDefault("RLA_PORT", defaultRLAPort), "RLA gRPC port (env: RLA_PORT)")
 }
 
 func doServe() {
@@ -155,13 +212,28 @@ func doServe() {
 				FirmwareDir:       firmwareFirmwareDir,
 				NumWorkers:        firmwareNumWorkers,
 				SchedulerInterval: time.Duration(firmwarePollSeconds) * time.Second,
+				TrustedKeyPaths:   firmwareTrustedKeys,
+				RequireSignatures: firmwareRequireSig,
+				InstanceID:        firmwareInstanceID,
+				LeaseDuration:     time.Duration(firmwareLeaseSecs) * time.Second,
+			},
+			HealthConf: svc.HealthConfig{
+				Interval:    time.Duration(healthIntervalSecs) * time.Second,
+				Retention:   time.Duration(healthRetentionHrs) * time.Hour,
+				Concurrency: healthConcurrency,
+				InstanceID:  firmwareInstanceID,
+			},
+			MetricsPort: metricsPort,
+			RLAConf: svc.RLAConfig{
+				Host: rlaHost,
+				Port: rlaPort,
 			},
 		},
 	)
 
 	if firmwarePackagesDir != "" {
-		log.Printf("Firmware config: packages_dir=%s, firmware_dir=%s, workers=%d, poll_seconds=%d",
-			firmwarePackagesDir, firmwareFirmwareDir, firmwareNumWorkers, firmwarePollSeconds)
+		log.Printf("Firmware config: packages_dir=%s, firmware_dir=%s, workers=%d, poll_seconds=%d, trusted_keys=%d, require_signature=%t",
+			firmwarePackagesDir, firmwareFirmwareDir, firmwareNumWorkers, firmwarePollSeconds, len(firmwareTrustedKeys), firmwareRequireSig)
 	}
 
 	if err != nil {
@@ -374,6 +374,112 @@ func (UpdateState) EnumDescriptor() ([]byte, []int) {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{5}
 }
 
+// HealthStatus is the rolled-up health of a switch or sensor.
+type HealthStatus int32
+
+const (
+	HealthStatus_HEALTH_STATUS_UNKNOWN  HealthStatus = 0 // Not collected yet, or BMC and NVOS both unreachable
+	HealthStatus_HEALTH_STATUS_OK       HealthStatus = 1
+	HealthStatus_HEALTH_STATUS_WARNING  HealthStatus = 2 // Degraded: NVLink port down/flapping/erroring, sensor warning, or partial collection
+	HealthStatus_HEALTH_STATUS_CRITICAL HealthStatus = 3 // A sensor is critical (e.g. failed fan, over-temperature)
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "HEALTH_STATUS_UNKNOWN",
+		1: "HEALTH_STATUS_OK",
+		2: "HEALTH_STATUS_WARNING",
+		3: "HEALTH_STATUS_CRITICAL",
+	}
+	HealthStatus_value = map[string]int32{
+		"HEALTH_STATUS_UNKNOWN":  0,
+		"HEALTH_STATUS_OK":       1,
+		"HEALTH_STATUS_WARNING":  2,
+		"HEALTH_STATUS_CRITICAL": 3,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[6].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[6]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{6}
+}
+
+// SensorKind identifies the type of a BMC sensor.
+type SensorKind int32
+
+const (
+	SensorKind_SENSOR_KIND_UNKNOWN     SensorKind = 0
+	SensorKind_SENSOR_KIND_FAN         SensorKind = 1
+	SensorKind_SENSOR_KIND_PSU         SensorKind = 2
+	SensorKind_SENSOR_KIND_TEMPERATURE SensorKind = 3
+)
+
+// Enum value maps for SensorKind.
+var (
+	SensorKind_name = map[int32]string{
+		0: "SENSOR_KIND_UNKNOWN",
+		1: "SENSOR_KIND_FAN",
+		2: "SENSOR_KIND_PSU",
+		3: "SENSOR_KIND_TEMPERATURE",
+	}
+	SensorKind_value = map[string]int32{
+		"SENSOR_KIND_UNKNOWN":     0,
+		"SENSOR_KIND_FAN":         1,
+		"SENSOR_KIND_PSU":         2,
+		"SENSOR_KIND_TEMPERATURE": 3,
+	}
+)
+
+func (x SensorKind) Enum() *SensorKind {
+	p := new(SensorKind)
+	*p = x
+	return p
+}
+
+func (x SensorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SensorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_v1_nvswitch_manager_proto_enumTypes[7].Descriptor()
+}
+
+func (SensorKind) Type() protoreflect.EnumType {
+	return &file_internal_proto_v1_nvswitch_manager_proto_enumTypes[7]
+}
+
+func (x SensorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SensorKind.Descriptor instead.
+func (SensorKind) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{7}
+}
+
 // Credentials wraps around a username and password.
 type Credentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1424,17 +1530,79 @@ func (x *ComponentInfo) GetStrategy() string {
 	return ""
 }
 
+// RejectedBundle describes a bundle definition that failed validation or integrity checks.
+type RejectedBundle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          string                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`       // Path to the bundle YAML definition
+	Version       string                 `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"` // Bundle version, empty if the definition could not be parsed
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`   // Why the bundle was rejected
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedBundle) Reset() {
+	*x = RejectedBundle{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedBundle) ProtoMessage() {}
+
+func (x *RejectedBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedBundle.ProtoReflect.Descriptor instead.
+func (*RejectedBundle) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RejectedBundle) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *RejectedBundle) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RejectedBundle) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 // ListBundlesResponse returns available firmware bundles.
 type ListBundlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bundles       []*FirmwareBundle      `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
+	Rejected      []*RejectedBundle      `protobuf:"bytes,2,rep,name=rejected,proto3" json:"rejected,omitempty"` // Bundles that cannot be used for updates
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListBundlesResponse) Reset() {
 	*x = ListBundlesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1446,7 +1614,7 @@ func (x *ListBundlesResponse) String() string {
 func (*ListBundlesResponse) ProtoMessage() {}
 
 func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[17]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1459,7 +1627,7 @@ func (x *ListBundlesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBundlesResponse.ProtoReflect.Descriptor instead.
 func (*ListBundlesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{17}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListBundlesResponse) GetBundles() []*FirmwareBundle {
@@ -1469,20 +1637,28 @@ func (x *ListBundlesResponse) GetBundles() []*FirmwareBundle {
 	return nil
 }
 
+func (x *ListBundlesResponse) GetRejected() []*RejectedBundle {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
 // QueueUpdateRequest queues firmware updates for one or more components.
 // If components is empty, all components in the bundle are updated in sequence.
 type QueueUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`                 // UUID of the NV-Switch to update
-	BundleVersion string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`        // Version of the firmware bundle
-	Components    []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"` // Components to update (empty = all)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid                string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`                                                // UUID of the NV-Switch to update
+	BundleVersion             string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`                                       // Version of the firmware bundle
+	Components                []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"`                                // Components to update (empty = all)
+	MaintenanceOverrideReason string                 `protobuf:"bytes,4,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *QueueUpdateRequest) Reset() {
 	*x = QueueUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1494,7 +1670,7 @@ func (x *QueueUpdateRequest) String() string {
 func (*QueueUpdateRequest) ProtoMessage() {}
 
 func (x *QueueUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[18]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1507,7 +1683,7 @@ func (x *QueueUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateRequest.ProtoReflect.Descriptor instead.
 func (*QueueUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{18}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *QueueUpdateRequest) GetSwitchUuid() string {
@@ -1531,6 +1707,13 @@ func (x *QueueUpdateRequest) GetComponents() []NVSwitchComponent {
 	return nil
 }
 
+func (x *QueueUpdateRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 // QueueUpdateResponse returns the queued updates.
 type QueueUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1541,7 +1724,7 @@ type QueueUpdateResponse struct {
 
 func (x *QueueUpdateResponse) Reset() {
 	*x = QueueUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1553,7 +1736,7 @@ func (x *QueueUpdateResponse) String() string {
 func (*QueueUpdateResponse) ProtoMessage() {}
 
 func (x *QueueUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[19]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1566,7 +1749,7 @@ func (x *QueueUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateResponse.ProtoReflect.Descriptor instead.
 func (*QueueUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{19}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *QueueUpdateResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -1578,17 +1761,18 @@ func (x *QueueUpdateResponse) GetUpdates() []*FirmwareUpdateInfo {
 
 // QueueUpdatesRequest queues firmware updates for multiple switches.
 type QueueUpdatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SwitchUuids   []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`              // UUIDs of NV-Switches to update
-	BundleVersion string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`        // Version of the firmware bundle
-	Components    []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"` // Components to update (empty = all)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuids               []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`                                             // UUIDs of NV-Switches to update
+	BundleVersion             string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`                                       // Version of the firmware bundle
+	Components                []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=v1.NVSwitchComponent" json:"components,omitempty"`                                // Components to update (empty = all)
+	MaintenanceOverrideReason string                 `protobuf:"bytes,4,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *QueueUpdatesRequest) Reset() {
 	*x = QueueUpdatesRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1600,7 +1784,7 @@ func (x *QueueUpdatesRequest) String() string {
 func (*QueueUpdatesRequest) ProtoMessage() {}
 
 func (x *QueueUpdatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[20]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1613,7 +1797,7 @@ func (x *QueueUpdatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdatesRequest.ProtoReflect.Descriptor instead.
 func (*QueueUpdatesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{20}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *QueueUpdatesRequest) GetSwitchUuids() []string {
@@ -1637,6 +1821,13 @@ func (x *QueueUpdatesRequest) GetComponents() []NVSwitchComponent {
 	return nil
 }
 
+func (x *QueueUpdatesRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 // QueueUpdatesResponse returns the results for each switch.
 type QueueUpdatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1647,7 +1838,7 @@ type QueueUpdatesResponse struct {
 
 func (x *QueueUpdatesResponse) Reset() {
 	*x = QueueUpdatesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1659,7 +1850,7 @@ func (x *QueueUpdatesResponse) String() string {
 func (*QueueUpdatesResponse) ProtoMessage() {}
 
 func (x *QueueUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[21]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1672,7 +1863,7 @@ func (x *QueueUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*QueueUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{21}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *QueueUpdatesResponse) GetResults() []*QueueUpdateResult {
@@ -1695,7 +1886,7 @@ type QueueUpdateResult struct {
 
 func (x *QueueUpdateResult) Reset() {
 	*x = QueueUpdateResult{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1707,7 +1898,7 @@ func (x *QueueUpdateResult) String() string {
 func (*QueueUpdateResult) ProtoMessage() {}
 
 func (x *QueueUpdateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[22]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1720,7 +1911,7 @@ func (x *QueueUpdateResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueueUpdateResult.ProtoReflect.Descriptor instead.
 func (*QueueUpdateResult) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{22}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *QueueUpdateResult) GetSwitchUuid() string {
@@ -1761,7 +1952,7 @@ type GetUpdateRequest struct {
 
 func (x *GetUpdateRequest) Reset() {
 	*x = GetUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1773,7 +1964,7 @@ func (x *GetUpdateRequest) String() string {
 func (*GetUpdateRequest) ProtoMessage() {}
 
 func (x *GetUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[23]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1786,7 +1977,7 @@ func (x *GetUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdateRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{23}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *GetUpdateRequest) GetUpdateId() string {
@@ -1806,7 +1997,7 @@ type GetUpdateResponse struct {
 
 func (x *GetUpdateResponse) Reset() {
 	*x = GetUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1818,7 +2009,7 @@ func (x *GetUpdateResponse) String() string {
 func (*GetUpdateResponse) ProtoMessage() {}
 
 func (x *GetUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1831,7 +2022,7 @@ func (x *GetUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdateResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{24}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetUpdateResponse) GetUpdate() *FirmwareUpdateInfo {
@@ -1851,7 +2042,7 @@ type GetUpdatesForSwitchRequest struct {
 
 func (x *GetUpdatesForSwitchRequest) Reset() {
 	*x = GetUpdatesForSwitchRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1863,7 +2054,7 @@ func (x *GetUpdatesForSwitchRequest) String() string {
 func (*GetUpdatesForSwitchRequest) ProtoMessage() {}
 
 func (x *GetUpdatesForSwitchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1876,7 +2067,7 @@ func (x *GetUpdatesForSwitchRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdatesForSwitchRequest.ProtoReflect.Descriptor instead.
 func (*GetUpdatesForSwitchRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{25}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *GetUpdatesForSwitchRequest) GetSwitchUuid() string {
@@ -1896,7 +2087,7 @@ type GetUpdatesForSwitchResponse struct {
 
 func (x *GetUpdatesForSwitchResponse) Reset() {
 	*x = GetUpdatesForSwitchResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1908,7 +2099,7 @@ func (x *GetUpdatesForSwitchResponse) String() string {
 func (*GetUpdatesForSwitchResponse) ProtoMessage() {}
 
 func (x *GetUpdatesForSwitchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1921,7 +2112,7 @@ func (x *GetUpdatesForSwitchResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUpdatesForSwitchResponse.ProtoReflect.Descriptor instead.
 func (*GetUpdatesForSwitchResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{26}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetUpdatesForSwitchResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -1941,7 +2132,7 @@ type GetAllUpdatesResponse struct {
 
 func (x *GetAllUpdatesResponse) Reset() {
 	*x = GetAllUpdatesResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1953,7 +2144,7 @@ func (x *GetAllUpdatesResponse) String() string {
 func (*GetAllUpdatesResponse) ProtoMessage() {}
 
 func (x *GetAllUpdatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1966,7 +2157,7 @@ func (x *GetAllUpdatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllUpdatesResponse.ProtoReflect.Descriptor instead.
 func (*GetAllUpdatesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{27}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *GetAllUpdatesResponse) GetUpdates() []*FirmwareUpdateInfo {
@@ -1986,7 +2177,7 @@ type CancelUpdateRequest struct {
 
 func (x *CancelUpdateRequest) Reset() {
 	*x = CancelUpdateRequest{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1998,7 +2189,7 @@ func (x *CancelUpdateRequest) String() string {
 func (*CancelUpdateRequest) ProtoMessage() {}
 
 func (x *CancelUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,7 +2202,7 @@ func (x *CancelUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelUpdateRequest.ProtoReflect.Descriptor instead.
 func (*CancelUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{28}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *CancelUpdateRequest) GetUpdateId() string {
@@ -2032,7 +2223,7 @@ type CancelUpdateResponse struct {
 
 func (x *CancelUpdateResponse) Reset() {
 	*x = CancelUpdateResponse{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2044,7 +2235,7 @@ func (x *CancelUpdateResponse) String() string {
 func (*CancelUpdateResponse) ProtoMessage() {}
 
 func (x *CancelUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2248,7 @@ func (x *CancelUpdateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelUpdateResponse.ProtoReflect.Descriptor instead.
 func (*CancelUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{29}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CancelUpdateResponse) GetSuccess() bool {
@@ -2099,7 +2290,7 @@ type FirmwareUpdateInfo struct {
 
 func (x *FirmwareUpdateInfo) Reset() {
 	*x = FirmwareUpdateInfo{}
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2111,7 +2302,7 @@ func (x *FirmwareUpdateInfo) String() string {
 func (*FirmwareUpdateInfo) ProtoMessage() {}
 
 func (x *FirmwareUpdateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2124,7 +2315,7 @@ func (x *FirmwareUpdateInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateInfo.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateInfo) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{30}
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FirmwareUpdateInfo) GetId() string {
@@ -2232,66 +2423,652 @@ func (x *FirmwareUpdateInfo) GetPredecessorId() string {
 	return ""
 }
 
-var File_internal_proto_v1_nvswitch_manager_proto protoreflect.FileDescriptor
+// SensorReading is a fan, PSU or thermal sensor reported by the BMC over Redfish.
+type SensorReading struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Kind                   SensorKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=v1.SensorKind" json:"kind,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // <chassis ID>/<sensor name>
+	Reading                float64                `protobuf:"fixed64,3,opt,name=reading,proto3" json:"reading,omitempty"`
+	Units                  string                 `protobuf:"bytes,4,opt,name=units,proto3" json:"units,omitempty"`                                                                     // RPM, W, Cel
+	UpperThresholdCritical float64                `protobuf:"fixed64,5,opt,name=upper_threshold_critical,json=upperThresholdCritical,proto3" json:"upper_threshold_critical,omitempty"` // 0 if not reported
+	Health                 HealthStatus           `protobuf:"varint,6,opt,name=health,proto3,enum=v1.HealthStatus" json:"health,omitempty"`
+	State                  string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"` // Redfish state, e.g. Enabled, Absent
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
 
-const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
-	"\n" +
-	"(internal/proto/v1/nvswitch-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
-	"\vCredentials\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x92\x01\n" +
-	"\tSubsystem\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x121\n" +
-	"\vcredentials\x18\x03 \x01(\v2\x0f.v1.CredentialsR\vcredentials\x12\x12\n" +
-	"\x04port\x18\x04 \x01(\x05R\x04port\"\x88\x02\n" +
-	"\aBMCInfo\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x12#\n" +
-	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x14\n" +
-	"\x05model\x18\x04 \x01(\tR\x05model\x12\"\n" +
-	"\fmanufacturer\x18\x05 \x01(\tR\fmanufacturer\x12\x1f\n" +
-	"\vpart_number\x18\x06 \x01(\tR\n" +
-	"partNumber\x12)\n" +
-	"\x10firmware_version\x18\a \x01(\tR\x0ffirmwareVersion\x12\x12\n" +
-	"\x04port\x18\b \x01(\x05R\x04port\"x\n" +
-	"\bNVOSInfo\x12\x1f\n" +
-	"\vmac_address\x18\x01 \x01(\tR\n" +
-	"macAddress\x12\x1d\n" +
-	"\n" +
-	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x18\n" +
-	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
-	"\x04port\x18\x04 \x01(\x05R\x04port\"h\n" +
-	"\aChassis\x12#\n" +
-	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x14\n" +
-	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
-	"\fmanufacturer\x18\x03 \x01(\tR\fmanufacturer\"\xea\x01\n" +
-	"\fNVSwitchTray\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\"\n" +
-	"\x06vendor\x18\x02 \x01(\x0e2\n" +
-	".v1.VendorR\x06vendor\x12\x1d\n" +
-	"\x03bmc\x18\x03 \x01(\v2\v.v1.BMCInfoR\x03bmc\x12 \n" +
-	"\x04nvos\x18\x04 \x01(\v2\f.v1.NVOSInfoR\x04nvos\x12%\n" +
-	"\achassis\x18\x05 \x01(\v2\v.v1.ChassisR\achassis\x12!\n" +
-	"\fcpld_version\x18\x06 \x01(\tR\vcpldVersion\x12\x17\n" +
-	"\arack_id\x18\a \x01(\tR\x06rackId\"\x9a\x01\n" +
-	"\x17RegisterNVSwitchRequest\x12\"\n" +
-	"\x06vendor\x18\x01 \x01(\x0e2\n" +
-	".v1.VendorR\x06vendor\x12\x1f\n" +
-	"\x03bmc\x18\x02 \x01(\v2\r.v1.SubsystemR\x03bmc\x12!\n" +
-	"\x04nvos\x18\x03 \x01(\v2\r.v1.SubsystemR\x04nvos\x12\x17\n" +
-	"\arack_id\x18\x04 \x01(\tR\x06rackId\"m\n" +
-	"\x19RegisterNVSwitchesRequest\x12P\n" +
-	"\x15registration_requests\x18\x01 \x03(\v2\x1b.v1.RegisterNVSwitchRequestR\x14registrationRequests\"\xb9\x01\n" +
-	"\x18RegisterNVSwitchResponse\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x15\n" +
-	"\x06is_new\x18\x02 \x01(\bR\x05isNew\x124\n" +
-	"\acreated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12&\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+func (x *SensorReading) Reset() {
+	*x = SensorReading{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorReading) ProtoMessage() {}
+
+func (x *SensorReading) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorReading.ProtoReflect.Descriptor instead.
+func (*SensorReading) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SensorReading) GetKind() SensorKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SensorKind_SENSOR_KIND_UNKNOWN
+}
+
+func (x *SensorReading) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorReading) GetReading() float64 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *SensorReading) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *SensorReading) GetUpperThresholdCritical() float64 {
+	if x != nil {
+		return x.UpperThresholdCritical
+	}
+	return 0
+}
+
+func (x *SensorReading) GetHealth() HealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return HealthStatus_HEALTH_STATUS_UNKNOWN
+}
+
+func (x *SensorReading) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// SwitchHealth is the latest health sample for a switch.
+type SwitchHealth struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid       string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	Status           HealthStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=v1.HealthStatus" json:"status,omitempty"`
+	CollectedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Sensors          []*SensorReading       `protobuf:"bytes,4,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	NvlinkPortsTotal int32                  `protobuf:"varint,5,opt,name=nvlink_ports_total,json=nvlinkPortsTotal,proto3" json:"nvlink_ports_total,omitempty"`
+	NvlinkPortsDown  int32                  `protobuf:"varint,6,opt,name=nvlink_ports_down,json=nvlinkPortsDown,proto3" json:"nvlink_ports_down,omitempty"`
+	Errors           []string               `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"` // Collection failures (unreachable BMC/NVOS, unreadable ports)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SwitchHealth) Reset() {
+	*x = SwitchHealth{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchHealth) ProtoMessage() {}
+
+func (x *SwitchHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchHealth.ProtoReflect.Descriptor instead.
+func (*SwitchHealth) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SwitchHealth) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *SwitchHealth) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_STATUS_UNKNOWN
+}
+
+func (x *SwitchHealth) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *SwitchHealth) GetSensors() []*SensorReading {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *SwitchHealth) GetNvlinkPortsTotal() int32 {
+	if x != nil {
+		return x.NvlinkPortsTotal
+	}
+	return 0
+}
+
+func (x *SwitchHealth) GetNvlinkPortsDown() int32 {
+	if x != nil {
+		return x.NvlinkPortsDown
+	}
+	return 0
+}
+
+func (x *SwitchHealth) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetSwitchHealthRequest selects switches by UUID; empty means all registered switches.
+type GetSwitchHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuids   []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwitchHealthRequest) Reset() {
+	*x = GetSwitchHealthRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwitchHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwitchHealthRequest) ProtoMessage() {}
+
+func (x *GetSwitchHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwitchHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetSwitchHealthRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSwitchHealthRequest) GetSwitchUuids() []string {
+	if x != nil {
+		return x.SwitchUuids
+	}
+	return nil
+}
+
+// GetSwitchHealthResponse returns one entry per requested switch.
+type GetSwitchHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SwitchHealthResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwitchHealthResponse) Reset() {
+	*x = GetSwitchHealthResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwitchHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwitchHealthResponse) ProtoMessage() {}
+
+func (x *GetSwitchHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwitchHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetSwitchHealthResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetSwitchHealthResponse) GetResults() []*SwitchHealthResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SwitchHealthResult contains the health of a single switch, or why it is unavailable.
+type SwitchHealthResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	Status        StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Health        *SwitchHealth          `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"` // Unset if no sample has been collected yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchHealthResult) Reset() {
+	*x = SwitchHealthResult{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchHealthResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchHealthResult) ProtoMessage() {}
+
+func (x *SwitchHealthResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchHealthResult.ProtoReflect.Descriptor instead.
+func (*SwitchHealthResult) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SwitchHealthResult) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *SwitchHealthResult) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *SwitchHealthResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SwitchHealthResult) GetHealth() *SwitchHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// PortCounters is a sample of an NVLink port's state and error counters.
+// Counter fields are the raw values read from NVOS; link_flaps and new_errors are the
+// increases since the previous sample of the same port.
+type PortCounters struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Port                string                 `protobuf:"bytes,1,opt,name=port,proto3" json:"port,omitempty"`
+	CollectedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	LinkState           string                 `protobuf:"bytes,3,opt,name=link_state,json=linkState,proto3" json:"link_state,omitempty"` // up, down
+	Speed               string                 `protobuf:"bytes,4,opt,name=speed,proto3" json:"speed,omitempty"`
+	SymbolErrors        uint64                 `protobuf:"varint,5,opt,name=symbol_errors,json=symbolErrors,proto3" json:"symbol_errors,omitempty"`
+	RxErrors            uint64                 `protobuf:"varint,6,opt,name=rx_errors,json=rxErrors,proto3" json:"rx_errors,omitempty"`
+	TxDiscards          uint64                 `protobuf:"varint,7,opt,name=tx_discards,json=txDiscards,proto3" json:"tx_discards,omitempty"`
+	LinkErrorRecoveries uint64                 `protobuf:"varint,8,opt,name=link_error_recoveries,json=linkErrorRecoveries,proto3" json:"link_error_recoveries,omitempty"`
+	LinkDowned          uint64                 `protobuf:"varint,9,opt,name=link_downed,json=linkDowned,proto3" json:"link_downed,omitempty"`
+	LinkFlaps           uint64                 `protobuf:"varint,10,opt,name=link_flaps,json=linkFlaps,proto3" json:"link_flaps,omitempty"`
+	NewErrors           uint64                 `protobuf:"varint,11,opt,name=new_errors,json=newErrors,proto3" json:"new_errors,omitempty"`
+	Counters            map[string]uint64      `protobuf:"bytes,12,rep,name=counters,proto3" json:"counters,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Every numeric counter reported by NVOS
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PortCounters) Reset() {
+	*x = PortCounters{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortCounters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortCounters) ProtoMessage() {}
+
+func (x *PortCounters) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortCounters.ProtoReflect.Descriptor instead.
+func (*PortCounters) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PortCounters) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *PortCounters) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *PortCounters) GetLinkState() string {
+	if x != nil {
+		return x.LinkState
+	}
+	return ""
+}
+
+func (x *PortCounters) GetSpeed() string {
+	if x != nil {
+		return x.Speed
+	}
+	return ""
+}
+
+func (x *PortCounters) GetSymbolErrors() uint64 {
+	if x != nil {
+		return x.SymbolErrors
+	}
+	return 0
+}
+
+func (x *PortCounters) GetRxErrors() uint64 {
+	if x != nil {
+		return x.RxErrors
+	}
+	return 0
+}
+
+func (x *PortCounters) GetTxDiscards() uint64 {
+	if x != nil {
+		return x.TxDiscards
+	}
+	return 0
+}
+
+func (x *PortCounters) GetLinkErrorRecoveries() uint64 {
+	if x != nil {
+		return x.LinkErrorRecoveries
+	}
+	return 0
+}
+
+func (x *PortCounters) GetLinkDowned() uint64 {
+	if x != nil {
+		return x.LinkDowned
+	}
+	return 0
+}
+
+func (x *PortCounters) GetLinkFlaps() uint64 {
+	if x != nil {
+		return x.LinkFlaps
+	}
+	return 0
+}
+
+func (x *PortCounters) GetNewErrors() uint64 {
+	if x != nil {
+		return x.NewErrors
+	}
+	return 0
+}
+
+func (x *PortCounters) GetCounters() map[string]uint64 {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+// GetPortCountersRequest selects port samples for a switch.
+type GetPortCountersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	Port          string                 `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`   // Optional, empty = all NVLink ports
+	Since         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"` // Optional, unset = latest sample per port only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortCountersRequest) Reset() {
+	*x = GetPortCountersRequest{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortCountersRequest) ProtoMessage() {}
+
+func (x *GetPortCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetPortCountersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPortCountersRequest) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *GetPortCountersRequest) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *GetPortCountersRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+// GetPortCountersResponse returns port samples, oldest first when since is set.
+type GetPortCountersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []*PortCounters        `protobuf:"bytes,1,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPortCountersResponse) Reset() {
+	*x = GetPortCountersResponse{}
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPortCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPortCountersResponse) ProtoMessage() {}
+
+func (x *GetPortCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_nvswitch_manager_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPortCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetPortCountersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPortCountersResponse) GetPorts() []*PortCounters {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+var File_internal_proto_v1_nvswitch_manager_proto protoreflect.FileDescriptor
+
+const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
+	"\n" +
+	"(internal/proto/v1/nvswitch-manager.proto\x12\x02v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"E\n" +
+	"\vCredentials\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x92\x01\n" +
+	"\tSubsystem\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x121\n" +
+	"\vcredentials\x18\x03 \x01(\v2\x0f.v1.CredentialsR\vcredentials\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\"\x88\x02\n" +
+	"\aBMCInfo\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12#\n" +
+	"\rserial_number\x18\x03 \x01(\tR\fserialNumber\x12\x14\n" +
+	"\x05model\x18\x04 \x01(\tR\x05model\x12\"\n" +
+	"\fmanufacturer\x18\x05 \x01(\tR\fmanufacturer\x12\x1f\n" +
+	"\vpart_number\x18\x06 \x01(\tR\n" +
+	"partNumber\x12)\n" +
+	"\x10firmware_version\x18\a \x01(\tR\x0ffirmwareVersion\x12\x12\n" +
+	"\x04port\x18\b \x01(\x05R\x04port\"x\n" +
+	"\bNVOSInfo\x12\x1f\n" +
+	"\vmac_address\x18\x01 \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\"h\n" +
+	"\aChassis\x12#\n" +
+	"\rserial_number\x18\x01 \x01(\tR\fserialNumber\x12\x14\n" +
+	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
+	"\fmanufacturer\x18\x03 \x01(\tR\fmanufacturer\"\xea\x01\n" +
+	"\fNVSwitchTray\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\"\n" +
+	"\x06vendor\x18\x02 \x01(\x0e2\n" +
+	".v1.VendorR\x06vendor\x12\x1d\n" +
+	"\x03bmc\x18\x03 \x01(\v2\v.v1.BMCInfoR\x03bmc\x12 \n" +
+	"\x04nvos\x18\x04 \x01(\v2\f.v1.NVOSInfoR\x04nvos\x12%\n" +
+	"\achassis\x18\x05 \x01(\v2\v.v1.ChassisR\achassis\x12!\n" +
+	"\fcpld_version\x18\x06 \x01(\tR\vcpldVersion\x12\x17\n" +
+	"\arack_id\x18\a \x01(\tR\x06rackId\"\x9a\x01\n" +
+	"\x17RegisterNVSwitchRequest\x12\"\n" +
+	"\x06vendor\x18\x01 \x01(\x0e2\n" +
+	".v1.VendorR\x06vendor\x12\x1f\n" +
+	"\x03bmc\x18\x02 \x01(\v2\r.v1.SubsystemR\x03bmc\x12!\n" +
+	"\x04nvos\x18\x03 \x01(\v2\r.v1.SubsystemR\x04nvos\x12\x17\n" +
+	"\arack_id\x18\x04 \x01(\tR\x06rackId\"m\n" +
+	"\x19RegisterNVSwitchesRequest\x12P\n" +
+	"\x15registration_requests\x18\x01 \x03(\v2\x1b.v1.RegisterNVSwitchRequestR\x14registrationRequests\"\xb9\x01\n" +
+	"\x18RegisterNVSwitchResponse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x15\n" +
+	"\x06is_new\x18\x02 \x01(\bR\x05isNew\x124\n" +
+	"\acreated\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\acreated\x12&\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
 	"\x05error\x18\x05 \x01(\tR\x05error\"X\n" +
 	"\x1aRegisterNVSwitchesResponse\x12:\n" +
 	"\tresponses\x18\x01 \x03(\v2\x1c.v1.RegisterNVSwitchResponseR\tresponses\"'\n" +
@@ -2319,24 +3096,31 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\rComponentInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
-	"\bstrategy\x18\x03 \x01(\tR\bstrategy\"C\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\"V\n" +
+	"\x0eRejectedBundle\x12\x12\n" +
+	"\x04file\x18\x01 \x01(\tR\x04file\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"s\n" +
 	"\x13ListBundlesResponse\x12,\n" +
-	"\abundles\x18\x01 \x03(\v2\x12.v1.FirmwareBundleR\abundles\"\x93\x01\n" +
+	"\abundles\x18\x01 \x03(\v2\x12.v1.FirmwareBundleR\abundles\x12.\n" +
+	"\brejected\x18\x02 \x03(\v2\x12.v1.RejectedBundleR\brejected\"\xd3\x01\n" +
 	"\x12QueueUpdateRequest\x12\x1f\n" +
 	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
 	"switchUuid\x12%\n" +
 	"\x0ebundle_version\x18\x02 \x01(\tR\rbundleVersion\x125\n" +
 	"\n" +
 	"components\x18\x03 \x03(\x0e2\x15.v1.NVSwitchComponentR\n" +
-	"components\"G\n" +
+	"components\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x04 \x01(\tR\x19maintenanceOverrideReason\"G\n" +
 	"\x13QueueUpdateResponse\x120\n" +
-	"\aupdates\x18\x01 \x03(\v2\x16.v1.FirmwareUpdateInfoR\aupdates\"\x96\x01\n" +
+	"\aupdates\x18\x01 \x03(\v2\x16.v1.FirmwareUpdateInfoR\aupdates\"\xd6\x01\n" +
 	"\x13QueueUpdatesRequest\x12!\n" +
 	"\fswitch_uuids\x18\x01 \x03(\tR\vswitchUuids\x12%\n" +
 	"\x0ebundle_version\x18\x02 \x01(\tR\rbundleVersion\x125\n" +
 	"\n" +
 	"components\x18\x03 \x03(\x0e2\x15.v1.NVSwitchComponentR\n" +
-	"components\"G\n" +
+	"components\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x04 \x01(\tR\x19maintenanceOverrideReason\"G\n" +
 	"\x14QueueUpdatesResponse\x12/\n" +
 	"\aresults\x18\x01 \x03(\v2\x15.v1.QueueUpdateResultR\aresults\"\xa4\x01\n" +
 	"\x11QueueUpdateResult\x12\x1f\n" +
@@ -2381,7 +3165,63 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
 	"\x10bundle_update_id\x18\r \x01(\tR\x0ebundleUpdateId\x12%\n" +
 	"\x0esequence_order\x18\x0e \x01(\x05R\rsequenceOrder\x12%\n" +
-	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId*/\n" +
+	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId\"\xf1\x01\n" +
+	"\rSensorReading\x12\"\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x0e.v1.SensorKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\areading\x18\x03 \x01(\x01R\areading\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\tR\x05units\x128\n" +
+	"\x18upper_threshold_critical\x18\x05 \x01(\x01R\x16upperThresholdCritical\x12(\n" +
+	"\x06health\x18\x06 \x01(\x0e2\x10.v1.HealthStatusR\x06health\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\"\xb7\x02\n" +
+	"\fSwitchHealth\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12(\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x10.v1.HealthStatusR\x06status\x12=\n" +
+	"\fcollected_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12+\n" +
+	"\asensors\x18\x04 \x03(\v2\x11.v1.SensorReadingR\asensors\x12,\n" +
+	"\x12nvlink_ports_total\x18\x05 \x01(\x05R\x10nvlinkPortsTotal\x12*\n" +
+	"\x11nvlink_ports_down\x18\x06 \x01(\x05R\x0fnvlinkPortsDown\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\";\n" +
+	"\x16GetSwitchHealthRequest\x12!\n" +
+	"\fswitch_uuids\x18\x01 \x03(\tR\vswitchUuids\"K\n" +
+	"\x17GetSwitchHealthResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.v1.SwitchHealthResultR\aresults\"\x9d\x01\n" +
+	"\x12SwitchHealthResult\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12&\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12(\n" +
+	"\x06health\x18\x04 \x01(\v2\x10.v1.SwitchHealthR\x06health\"\x85\x04\n" +
+	"\fPortCounters\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\tR\x04port\x12=\n" +
+	"\fcollected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12\x1d\n" +
+	"\n" +
+	"link_state\x18\x03 \x01(\tR\tlinkState\x12\x14\n" +
+	"\x05speed\x18\x04 \x01(\tR\x05speed\x12#\n" +
+	"\rsymbol_errors\x18\x05 \x01(\x04R\fsymbolErrors\x12\x1b\n" +
+	"\trx_errors\x18\x06 \x01(\x04R\brxErrors\x12\x1f\n" +
+	"\vtx_discards\x18\a \x01(\x04R\n" +
+	"txDiscards\x122\n" +
+	"\x15link_error_recoveries\x18\b \x01(\x04R\x13linkErrorRecoveries\x12\x1f\n" +
+	"\vlink_downed\x18\t \x01(\x04R\n" +
+	"linkDowned\x12\x1d\n" +
+	"\n" +
+	"link_flaps\x18\n" +
+	" \x01(\x04R\tlinkFlaps\x12\x1d\n" +
+	"\n" +
+	"new_errors\x18\v \x01(\x04R\tnewErrors\x12:\n" +
+	"\bcounters\x18\f \x03(\v2\x1e.v1.PortCounters.CountersEntryR\bcounters\x1a;\n" +
+	"\rCountersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x04R\x05value:\x028\x01\"\x7f\n" +
+	"\x16GetPortCountersRequest\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\tR\x04port\x120\n" +
+	"\x05since\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\"A\n" +
+	"\x17GetPortCountersResponse\x12&\n" +
+	"\x05ports\x18\x01 \x03(\v2\x10.v1.PortCountersR\x05ports*/\n" +
 	"\x06Vendor\x12\x12\n" +
 	"\x0eVENDOR_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rVENDOR_NVIDIA\x10\x01*C\n" +
@@ -2424,7 +3264,18 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\x16UPDATE_STATE_COMPLETED\x10\n" +
 	"\x12\x17\n" +
 	"\x13UPDATE_STATE_FAILED\x10\v\x12\x1a\n" +
-	"\x16UPDATE_STATE_CANCELLED\x10\f2\xc6\x05\n" +
+	"\x16UPDATE_STATE_CANCELLED\x10\f*v\n" +
+	"\fHealthStatus\x12\x19\n" +
+	"\x15HEALTH_STATUS_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10HEALTH_STATUS_OK\x10\x01\x12\x19\n" +
+	"\x15HEALTH_STATUS_WARNING\x10\x02\x12\x1a\n" +
+	"\x16HEALTH_STATUS_CRITICAL\x10\x03*l\n" +
+	"\n" +
+	"SensorKind\x12\x17\n" +
+	"\x13SENSOR_KIND_UNKNOWN\x10\x00\x12\x13\n" +
+	"\x0fSENSOR_KIND_FAN\x10\x01\x12\x13\n" +
+	"\x0fSENSOR_KIND_PSU\x10\x02\x12\x1b\n" +
+	"\x17SENSOR_KIND_TEMPERATURE\x10\x032\xde\x06\n" +
 	"\x0fNVSwitchManager\x12S\n" +
 	"\x12RegisterNVSwitches\x12\x1d.v1.RegisterNVSwitchesRequest\x1a\x1e.v1.RegisterNVSwitchesResponse\x12?\n" +
 	"\rGetNVSwitches\x12\x13.v1.NVSwitchRequest\x1a\x19.v1.GetNVSwitchesResponse\x12>\n" +
@@ -2435,7 +3286,9 @@ const file_internal_proto_v1_nvswitch_manager_proto_rawDesc = "" +
 	"\x13GetUpdatesForSwitch\x12\x1e.v1.GetUpdatesForSwitchRequest\x1a\x1f.v1.GetUpdatesForSwitchResponse\x12B\n" +
 	"\rGetAllUpdates\x12\x16.google.protobuf.Empty\x1a\x19.v1.GetAllUpdatesResponse\x12A\n" +
 	"\fCancelUpdate\x12\x17.v1.CancelUpdateRequest\x1a\x18.v1.CancelUpdateResponse\x12A\n" +
-	"\fPowerControl\x12\x17.v1.PowerControlRequest\x1a\x18.v1.PowerControlResponseB\n" +
+	"\fPowerControl\x12\x17.v1.PowerControlRequest\x1a\x18.v1.PowerControlResponse\x12J\n" +
+	"\x0fGetSwitchHealth\x12\x1a.v1.GetSwitchHealthRequest\x1a\x1b.v1.GetSwitchHealthResponse\x12J\n" +
+	"\x0fGetPortCounters\x12\x1a.v1.GetPortCountersRequest\x1a\x1b.v1.GetPortCountersResponseB\n" +
 	"Z\bproto/v1b\x06proto3"
 
 var (
@@ -2450,8 +3303,8 @@ func file_internal_proto_v1_nvswitch_manager_proto_rawDescGZIP() []byte {
 	return file_internal_proto_v1_nvswitch_manager_proto_rawDescData
 }
 
-var file_internal_proto_v1_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_internal_proto_v1_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_internal_proto_v1_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_internal_proto_v1_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_internal_proto_v1_nvswitch_manager_proto_goTypes = []any{
 	(Vendor)(0),                         // 0: v1.Vendor
 	(StatusCode)(0),                     // 1: v1.StatusCode
@@ -2459,98 +3312,127 @@ var file_internal_proto_v1_nvswitch_manager_proto_goTypes = []any{
 	(NVSwitchComponent)(0),              // 3: v1.NVSwitchComponent
 	(UpdateStrategy)(0),                 // 4: v1.UpdateStrategy
 	(UpdateState)(0),                    // 5: v1.UpdateState
-	(*Credentials)(nil),                 // 6: v1.Credentials
-	(*Subsystem)(nil),                   // 7: v1.Subsystem
-	(*BMCInfo)(nil),                     // 8: v1.BMCInfo
-	(*NVOSInfo)(nil),                    // 9: v1.NVOSInfo
-	(*Chassis)(nil),                     // 10: v1.Chassis
-	(*NVSwitchTray)(nil),                // 11: v1.NVSwitchTray
-	(*RegisterNVSwitchRequest)(nil),     // 12: v1.RegisterNVSwitchRequest
-	(*RegisterNVSwitchesRequest)(nil),   // 13: v1.RegisterNVSwitchesRequest
-	(*RegisterNVSwitchResponse)(nil),    // 14: v1.RegisterNVSwitchResponse
-	(*RegisterNVSwitchesResponse)(nil),  // 15: v1.RegisterNVSwitchesResponse
-	(*NVSwitchRequest)(nil),             // 16: v1.NVSwitchRequest
-	(*NVSwitchResponse)(nil),            // 17: v1.NVSwitchResponse
-	(*PowerControlRequest)(nil),         // 18: v1.PowerControlRequest
-	(*PowerControlResponse)(nil),        // 19: v1.PowerControlResponse
-	(*GetNVSwitchesResponse)(nil),       // 20: v1.GetNVSwitchesResponse
-	(*FirmwareBundle)(nil),              // 21: v1.FirmwareBundle
-	(*ComponentInfo)(nil),               // 22: v1.ComponentInfo
-	(*ListBundlesResponse)(nil),         // 23: v1.ListBundlesResponse
-	(*QueueUpdateRequest)(nil),          // 24: v1.QueueUpdateRequest
-	(*QueueUpdateResponse)(nil),         // 25: v1.QueueUpdateResponse
-	(*QueueUpdatesRequest)(nil),         // 26: v1.QueueUpdatesRequest
-	(*QueueUpdatesResponse)(nil),        // 27: v1.QueueUpdatesResponse
-	(*QueueUpdateResult)(nil),           // 28: v1.QueueUpdateResult
-	(*GetUpdateRequest)(nil),            // 29: v1.GetUpdateRequest
-	(*GetUpdateResponse)(nil),           // 30: v1.GetUpdateResponse
-	(*GetUpdatesForSwitchRequest)(nil),  // 31: v1.GetUpdatesForSwitchRequest
-	(*GetUpdatesForSwitchResponse)(nil), // 32: v1.GetUpdatesForSwitchResponse
-	(*GetAllUpdatesResponse)(nil),       // 33: v1.GetAllUpdatesResponse
-	(*CancelUpdateRequest)(nil),         // 34: v1.CancelUpdateRequest
-	(*CancelUpdateResponse)(nil),        // 35: v1.CancelUpdateResponse
-	(*FirmwareUpdateInfo)(nil),          // 36: v1.FirmwareUpdateInfo
-	(*timestamppb.Timestamp)(nil),       // 37: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 38: google.protobuf.Empty
+	(HealthStatus)(0),                   // 6: v1.HealthStatus
+	(SensorKind)(0),                     // 7: v1.SensorKind
+	(*Credentials)(nil),                 // 8: v1.Credentials
+	(*Subsystem)(nil),                   // 9: v1.Subsystem
+	(*BMCInfo)(nil),                     // 10: v1.BMCInfo
+	(*NVOSInfo)(nil),                    // 11: v1.NVOSInfo
+	(*Chassis)(nil),                     // 12: v1.Chassis
+	(*NVSwitchTray)(nil),                // 13: v1.NVSwitchTray
+	(*RegisterNVSwitchRequest)(nil),     // 14: v1.RegisterNVSwitchRequest
+	(*RegisterNVSwitchesRequest)(nil),   // 15: v1.RegisterNVSwitchesRequest
+	(*RegisterNVSwitchResponse)(nil),    // 16: v1.RegisterNVSwitchResponse
+	(*RegisterNVSwitchesResponse)(nil),  // 17: v1.RegisterNVSwitchesResponse
+	(*NVSwitchRequest)(nil),             // 18: v1.NVSwitchRequest
+	(*NVSwitchResponse)(nil),            // 19: v1.NVSwitchResponse
+	(*PowerControlRequest)(nil),         // 20: v1.PowerControlRequest
+	(*PowerControlResponse)(nil),        // 21: v1.PowerControlResponse
+	(*GetNVSwitchesResponse)(nil),       // 22: v1.GetNVSwitchesResponse
+	(*FirmwareBundle)(nil),              // 23: v1.FirmwareBundle
+	(*ComponentInfo)(nil),               // 24: v1.ComponentInfo
+	(*RejectedBundle)(nil),              // 25: v1.RejectedBundle
+	(*ListBundlesResponse)(nil),         // 26: v1.ListBundlesResponse
+	(*QueueUpdateRequest)(nil),          // 27: v1.QueueUpdateRequest
+	(*QueueUpdateResponse)(nil),         // 28: v1.QueueUpdateResponse
+	(*QueueUpdatesRequest)(nil),         // 29: v1.QueueUpdatesRequest
+	(*QueueUpdatesResponse)(nil),        // 30: v1.QueueUpdatesResponse
+	(*QueueUpdateResult)(nil),           // 31: v1.QueueUpdateResult
+	(*GetUpdateRequest)(nil),            // 32: v1.GetUpdateRequest
+	(*GetUpdateResponse)(nil),           // 33: v1.GetUpdateResponse
+	(*GetUpdatesForSwitchRequest)(nil),  // 34: v1.GetUpdatesForSwitchRequest
+	(*GetUpdatesForSwitchResponse)(nil), // 35: v1.GetUpdatesForSwitchResponse
+	(*GetAllUpdatesResponse)(nil),       // 36: v1.GetAllUpdatesResponse
+	(*CancelUpdateRequest)(nil),         // 37: v1.CancelUpdateRequest
+	(*CancelUpdateResponse)(nil),        // 38: v1.CancelUpdateResponse
+	(*FirmwareUpdateInfo)(nil),          // 39: v1.FirmwareUpdateInfo
+	(*SensorReading)(nil),               // 40: v1.SensorReading
+	(*SwitchHealth)(nil),                // 41: v1.SwitchHealth
+	(*GetSwitchHealthRequest)(nil),      // 42: v1.GetSwitchHealthRequest
+	(*GetSwitchHealthResponse)(nil),     // 43: v1.GetSwitchHealthResponse
+	(*SwitchHealthResult)(nil),          // 44: v1.SwitchHealthResult
+	(*PortCounters)(nil),                // 45: v1.PortCounters
+	(*GetPortCountersRequest)(nil),      // 46: v1.GetPortCountersRequest
+	(*GetPortCountersResponse)(nil),     // 47: v1.GetPortCountersResponse
+	nil,                                 // 48: v1.PortCounters.CountersEntry
+	(*timestamppb.Timestamp)(nil),       // 49: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 50: google.protobuf.Empty
 }
 var file_internal_proto_v1_nvswitch_manager_proto_depIdxs = []int32{
-	6,  // 0: v1.Subsystem.credentials:type_name -> v1.Credentials
+	8,  // 0: v1.Subsystem.credentials:type_name -> v1.Credentials
 	0,  // 1: v1.NVSwitchTray.vendor:type_name -> v1.Vendor
-	8,  // 2: v1.NVSwitchTray.bmc:type_name -> v1.BMCInfo
-	9,  // 3: v1.NVSwitchTray.nvos:type_name -> v1.NVOSInfo
-	10, // 4: v1.NVSwitchTray.chassis:type_name -> v1.Chassis
+	10, // 2: v1.NVSwitchTray.bmc:type_name -> v1.BMCInfo
+	11, // 3: v1.NVSwitchTray.nvos:type_name -> v1.NVOSInfo
+	12, // 4: v1.NVSwitchTray.chassis:type_name -> v1.Chassis
 	0,  // 5: v1.RegisterNVSwitchRequest.vendor:type_name -> v1.Vendor
-	7,  // 6: v1.RegisterNVSwitchRequest.bmc:type_name -> v1.Subsystem
-	7,  // 7: v1.RegisterNVSwitchRequest.nvos:type_name -> v1.Subsystem
-	12, // 8: v1.RegisterNVSwitchesRequest.registration_requests:type_name -> v1.RegisterNVSwitchRequest
-	37, // 9: v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
+	9,  // 6: v1.RegisterNVSwitchRequest.bmc:type_name -> v1.Subsystem
+	9,  // 7: v1.RegisterNVSwitchRequest.nvos:type_name -> v1.Subsystem
+	14, // 8: v1.RegisterNVSwitchesRequest.registration_requests:type_name -> v1.RegisterNVSwitchRequest
+	49, // 9: v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 10: v1.RegisterNVSwitchResponse.status:type_name -> v1.StatusCode
-	14, // 11: v1.RegisterNVSwitchesResponse.responses:type_name -> v1.RegisterNVSwitchResponse
+	16, // 11: v1.RegisterNVSwitchesResponse.responses:type_name -> v1.RegisterNVSwitchResponse
 	1,  // 12: v1.NVSwitchResponse.status:type_name -> v1.StatusCode
 	2,  // 13: v1.PowerControlRequest.action:type_name -> v1.PowerAction
-	17, // 14: v1.PowerControlResponse.responses:type_name -> v1.NVSwitchResponse
-	11, // 15: v1.GetNVSwitchesResponse.nvswitches:type_name -> v1.NVSwitchTray
-	22, // 16: v1.FirmwareBundle.components:type_name -> v1.ComponentInfo
-	21, // 17: v1.ListBundlesResponse.bundles:type_name -> v1.FirmwareBundle
-	3,  // 18: v1.QueueUpdateRequest.components:type_name -> v1.NVSwitchComponent
-	36, // 19: v1.QueueUpdateResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	3,  // 20: v1.QueueUpdatesRequest.components:type_name -> v1.NVSwitchComponent
-	28, // 21: v1.QueueUpdatesResponse.results:type_name -> v1.QueueUpdateResult
-	1,  // 22: v1.QueueUpdateResult.status:type_name -> v1.StatusCode
-	36, // 23: v1.QueueUpdateResult.updates:type_name -> v1.FirmwareUpdateInfo
-	36, // 24: v1.GetUpdateResponse.update:type_name -> v1.FirmwareUpdateInfo
-	36, // 25: v1.GetUpdatesForSwitchResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	36, // 26: v1.GetAllUpdatesResponse.updates:type_name -> v1.FirmwareUpdateInfo
-	3,  // 27: v1.FirmwareUpdateInfo.component:type_name -> v1.NVSwitchComponent
-	4,  // 28: v1.FirmwareUpdateInfo.strategy:type_name -> v1.UpdateStrategy
-	5,  // 29: v1.FirmwareUpdateInfo.state:type_name -> v1.UpdateState
-	37, // 30: v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
-	37, // 31: v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 32: v1.NVSwitchManager.RegisterNVSwitches:input_type -> v1.RegisterNVSwitchesRequest
-	16, // 33: v1.NVSwitchManager.GetNVSwitches:input_type -> v1.NVSwitchRequest
-	38, // 34: v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
-	24, // 35: v1.NVSwitchManager.QueueUpdate:input_type -> v1.QueueUpdateRequest
-	26, // 36: v1.NVSwitchManager.QueueUpdates:input_type -> v1.QueueUpdatesRequest
-	29, // 37: v1.NVSwitchManager.GetUpdate:input_type -> v1.GetUpdateRequest
-	31, // 38: v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> v1.GetUpdatesForSwitchRequest
-	38, // 39: v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
-	34, // 40: v1.NVSwitchManager.CancelUpdate:input_type -> v1.CancelUpdateRequest
-	18, // 41: v1.NVSwitchManager.PowerControl:input_type -> v1.PowerControlRequest
-	15, // 42: v1.NVSwitchManager.RegisterNVSwitches:output_type -> v1.RegisterNVSwitchesResponse
-	20, // 43: v1.NVSwitchManager.GetNVSwitches:output_type -> v1.GetNVSwitchesResponse
-	23, // 44: v1.NVSwitchManager.ListBundles:output_type -> v1.ListBundlesResponse
-	25, // 45: v1.NVSwitchManager.QueueUpdate:output_type -> v1.QueueUpdateResponse
-	27, // 46: v1.NVSwitchManager.QueueUpdates:output_type -> v1.QueueUpdatesResponse
-	30, // 47: v1.NVSwitchManager.GetUpdate:output_type -> v1.GetUpdateResponse
-	32, // 48: v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> v1.GetUpdatesForSwitchResponse
-	33, // 49: v1.NVSwitchManager.GetAllUpdates:output_type -> v1.GetAllUpdatesResponse
-	35, // 50: v1.NVSwitchManager.CancelUpdate:output_type -> v1.CancelUpdateResponse
-	19, // 51: v1.NVSwitchManager.PowerControl:output_type -> v1.PowerControlResponse
-	42, // [42:52] is the sub-list for method output_type
-	32, // [32:42] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	19, // 14: v1.PowerControlResponse.responses:type_name -> v1.NVSwitchResponse
+	13, // 15: v1.GetNVSwitchesResponse.nvswitches:type_name -> v1.NVSwitchTray
+	24, // 16: v1.FirmwareBundle.components:type_name -> v1.ComponentInfo
+	23, // 17: v1.ListBundlesResponse.bundles:type_name -> v1.FirmwareBundle
+	25, // 18: v1.ListBundlesResponse.rejected:type_name -> v1.RejectedBundle
+	3,  // 19: v1.QueueUpdateRequest.components:type_name -> v1.NVSwitchComponent
+	39, // 20: v1.QueueUpdateResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	3,  // 21: v1.QueueUpdatesRequest.components:type_name -> v1.NVSwitchComponent
+	31, // 22: v1.QueueUpdatesResponse.results:type_name -> v1.QueueUpdateResult
+	1,  // 23: v1.QueueUpdateResult.status:type_name -> v1.StatusCode
+	39, // 24: v1.QueueUpdateResult.updates:type_name -> v1.FirmwareUpdateInfo
+	39, // 25: v1.GetUpdateResponse.update:type_name -> v1.FirmwareUpdateInfo
+	39, // 26: v1.GetUpdatesForSwitchResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	39, // 27: v1.GetAllUpdatesResponse.updates:type_name -> v1.FirmwareUpdateInfo
+	3,  // 28: v1.FirmwareUpdateInfo.component:type_name -> v1.NVSwitchComponent
+	4,  // 29: v1.FirmwareUpdateInfo.strategy:type_name -> v1.UpdateStrategy
+	5,  // 30: v1.FirmwareUpdateInfo.state:type_name -> v1.UpdateState
+	49, // 31: v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
+	49, // 32: v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 33: v1.SensorReading.kind:type_name -> v1.SensorKind
+	6,  // 34: v1.SensorReading.health:type_name -> v1.HealthStatus
+	6,  // 35: v1.SwitchHealth.status:type_name -> v1.HealthStatus
+	49, // 36: v1.SwitchHealth.collected_at:type_name -> google.protobuf.Timestamp
+	40, // 37: v1.SwitchHealth.sensors:type_name -> v1.SensorReading
+	44, // 38: v1.GetSwitchHealthResponse.results:type_name -> v1.SwitchHealthResult
+	1,  // 39: v1.SwitchHealthResult.status:type_name -> v1.StatusCode
+	41, // 40: v1.SwitchHealthResult.health:type_name -> v1.SwitchHealth
+	49, // 41: v1.PortCounters.collected_at:type_name -> google.protobuf.Timestamp
+	48, // 42: v1.PortCounters.counters:type_name -> v1.PortCounters.CountersEntry
+	49, // 43: v1.GetPortCountersRequest.since:type_name -> google.protobuf.Timestamp
+	45, // 44: v1.GetPortCountersResponse.ports:type_name -> v1.PortCounters
+	15, // 45: v1.NVSwitchManager.RegisterNVSwitches:input_type -> v1.RegisterNVSwitchesRequest
+	18, // 46: v1.NVSwitchManager.GetNVSwitches:input_type -> v1.NVSwitchRequest
+	50, // 47: v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
+	27, // 48: v1.NVSwitchManager.QueueUpdate:input_type -> v1.QueueUpdateRequest
+	29, // 49: v1.NVSwitchManager.QueueUpdates:input_type -> v1.QueueUpdatesRequest
+	32, // 50: v1.NVSwitchManager.GetUpdate:input_type -> v1.GetUpdateRequest
+	34, // 51: v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> v1.GetUpdatesForSwitchRequest
+	50, // 52: v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
+	37, // 53: v1.NVSwitchManager.CancelUpdate:input_type -> v1.CancelUpdateRequest
+	20, // 54: v1.NVSwitchManager.PowerControl:input_type -> v1.PowerControlRequest
+	42, // 55: v1.NVSwitchManager.GetSwitchHealth:input_type -> v1.GetSwitchHealthRequest
+	46, // 56: v1.NVSwitchManager.GetPortCounters:input_type -> v1.GetPortCountersRequest
+	17, // 57: v1.NVSwitchManager.RegisterNVSwitches:output_type -> v1.RegisterNVSwitchesResponse
+	22, // 58: v1.NVSwitchManager.GetNVSwitches:output_type -> v1.GetNVSwitchesResponse
+	26, // 59: v1.NVSwitchManager.ListBundles:output_type -> v1.ListBundlesResponse
+	28, // 60: v1.NVSwitchManager.QueueUpdate:output_type -> v1.QueueUpdateResponse
+	30, // 61: v1.NVSwitchManager.QueueUpdates:output_type -> v1.QueueUpdatesResponse
+	33, // 62: v1.NVSwitchManager.GetUpdate:output_type -> v1.GetUpdateResponse
+	35, // 63: v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> v1.GetUpdatesForSwitchResponse
+	36, // 64: v1.NVSwitchManager.GetAllUpdates:output_type -> v1.GetAllUpdatesResponse
+	38, // 65: v1.NVSwitchManager.CancelUpdate:output_type -> v1.CancelUpdateResponse
+	21, // 66: v1.NVSwitchManager.PowerControl:output_type -> v1.PowerControlResponse
+	43, // 67: v1.NVSwitchManager.GetSwitchHealth:output_type -> v1.GetSwitchHealthResponse
+	47, // 68: v1.NVSwitchManager.GetPortCounters:output_type -> v1.GetPortCountersResponse
+	57, // [57:69] is the sub-list for method output_type
+	45, // [45:57] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_v1_nvswitch_manager_proto_init() }
@@ -2563,8 +3445,8 @@ func file_internal_proto_v1_nvswitch_manager_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_v1_nvswitch_manager_proto_rawDesc), len(file_internal_proto_v1_nvswitch_manager_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   31,
+			NumEnums:      8,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
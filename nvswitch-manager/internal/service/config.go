@@ -27,6 +27,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/credentials"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/db"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/healthmanager"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
 )
 
@@ -37,6 +38,16 @@ type Config struct {
 	VaultConf     credentials.VaultConfig
 	DBConf        db.Config
 	FirmwareConf  FirmwareConfig
+	HealthConf    HealthConfig
+	MetricsPort   int // Port for the Prometheus /metrics endpoint (0 = disabled)
+	RLAConf       RLAConfig
+}
+
+// RLAConfig locates the RLA service whose rack maintenance windows gate
+// firmware updates. An empty Host disables the check.
+type RLAConfig struct {
+	Host string
+	Port int
 }
 
 // FirmwareConfig contains firmware manager configuration.
@@ -45,6 +56,10 @@ type FirmwareConfig struct {
 	FirmwareDir       string        // Directory containing firmware files
 	NumWorkers        int           // Number of concurrent update workers
 	SchedulerInterval time.Duration // How often the scheduler queries for pending updates
+	TrustedKeyPaths   []string      // Public keys used to verify detached firmware signatures
+	RequireSignatures bool          // Reject firmware files without a valid signature
+	InstanceID        string        // Lease owner ID for this replica (defaults to hostname plus random suffix)
+	LeaseDuration     time.Duration // How long a claimed update stays leased without a heartbeat
 }
 
 // ToFirmwareManagerConfig converts FirmwareConfig to firmwaremanager.Config.
@@ -54,6 +69,28 @@ func (c *FirmwareConfig) ToFirmwareManagerConfig() firmwaremanager.Config {
 		FirmwareDir:       c.FirmwareDir,
 		NumWorkers:        c.NumWorkers,
 		SchedulerInterval: c.SchedulerInterval,
+		TrustedKeyPaths:   c.TrustedKeyPaths,
+		RequireSignatures: c.RequireSignatures,
+		InstanceID:        c.InstanceID,
+		LeaseDuration:     c.LeaseDuration,
+	}
+}
+
+// HealthConfig contains health collector configuration.
+type HealthConfig struct {
+	Interval    time.Duration // How often switches are polled (0 = collector disabled)
+	Retention   time.Duration // How long health and port counter samples are kept
+	Concurrency int           // Number of switches polled in parallel
+	InstanceID  string        // Collector lease owner ID for this replica
+}
+
+// ToHealthManagerConfig converts HealthConfig to healthmanager.Config.
+func (c *HealthConfig) ToHealthManagerConfig() healthmanager.Config {
+	return healthmanager.Config{
+		Interval:    c.Interval,
+		Retention:   c.Retention,
+		Concurrency: c.Concurrency,
+		InstanceID:  c.InstanceID,
 	}
 }
 
@@ -19,6 +19,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/common/vendor"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/converter/protobuf"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/healthmanager"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/bmc"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvos"
@@ -46,13 +48,15 @@ import (
 type NVSwitchManagerServerImpl struct {
 	nsm *nvswitchmanager.NVSwitchManager
 	fwm *firmwaremanager.FirmwareManager
+	hm  *healthmanager.HealthManager
 	pb.UnimplementedNVSwitchManagerServer
 }
 
-func newServerImplementation(nsm *nvswitchmanager.NVSwitchManager, fwm *firmwaremanager.FirmwareManager) (*NVSwitchManagerServerImpl, error) {
+func newServerImplementation(nsm *nvswitchmanager.NVSwitchManager, fwm *firmwaremanager.FirmwareManager, hm *healthmanager.HealthManager) (*NVSwitchManagerServerImpl, error) {
 	return &NVSwitchManagerServerImpl{
 		nsm: nsm,
 		fwm: fwm,
+		hm:  hm,
 	}, nil
 }
 
@@ -299,7 +303,16 @@ func (s *NVSwitchManagerServerImpl) ListBundles(ctx context.Context, _ *emptypb.
 		})
 	}
 
-	return &pb.ListBundlesResponse{Bundles: bundles}, nil
+	rejected := make([]*pb.RejectedBundle, 0)
+	for _, r := range s.fwm.ListRejectedBundles() {
+		rejected = append(rejected, &pb.RejectedBundle{
+			File:    r.File,
+			Version: r.Version,
+			Reason:  r.Reason,
+		})
+	}
+
+	return &pb.ListBundlesResponse{Bundles: bundles, Rejected: rejected}, nil
 }
 
 // QueueUpdate queues a firmware update for a specific switch and component.
@@ -323,7 +336,7 @@ func (s *NVSwitchManagerServerImpl) QueueUpdate(ctx context.Context, req *pb.Que
 		components = append(components, component)
 	}
 
-	updates, err := s.fwm.QueueUpdate(ctx, switchUUID, req.BundleVersion, components)
+	updates, err := s.fwm.QueueUpdate(ctx, switchUUID, req.BundleVersion, components, req.MaintenanceOverrideReason)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to queue update: %v", err)
 	}
@@ -369,7 +382,7 @@ func (s *NVSwitchManagerServerImpl) QueueUpdates(ctx context.Context, req *pb.Qu
 			continue
 		}
 
-		updates, err := s.fwm.QueueUpdate(ctx, switchUUID, req.BundleVersion, components)
+		updates, err := s.fwm.QueueUpdate(ctx, switchUUID, req.BundleVersion, components, req.MaintenanceOverrideReason)
 		if err != nil {
 			result.Status = pb.StatusCode_INTERNAL_ERROR
 			result.Error = fmt.Sprintf("failed to queue update: %v", err)
@@ -486,6 +499,87 @@ func (s *NVSwitchManagerServerImpl) CancelUpdate(ctx context.Context, req *pb.Ca
 	}, nil
 }
 
+// ============================================================================
+// Health Monitoring API
+// ============================================================================
+
+// GetSwitchHealth returns the latest collected health sample for the specified switches.
+func (s *NVSwitchManagerServerImpl) GetSwitchHealth(ctx context.Context, req *pb.GetSwitchHealthRequest) (*pb.GetSwitchHealthResponse, error) {
+	if s.hm == nil {
+		return nil, status.Error(codes.Unavailable, "health manager not initialized")
+	}
+
+	uuidStrs := req.SwitchUuids
+	if len(uuidStrs) == 0 {
+		trays, err := s.nsm.List(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list switches: %v", err)
+		}
+		for _, tray := range trays {
+			uuidStrs = append(uuidStrs, tray.UUID.String())
+		}
+	}
+
+	results := make([]*pb.SwitchHealthResult, 0, len(uuidStrs))
+	for _, uuidStr := range uuidStrs {
+		result := &pb.SwitchHealthResult{SwitchUuid: uuidStr}
+
+		switchUUID, err := uuid.Parse(uuidStr)
+		if err != nil {
+			result.Status = pb.StatusCode_INVALID_ARGUMENT
+			result.Error = fmt.Sprintf("invalid switch UUID: %v", err)
+			results = append(results, result)
+			continue
+		}
+
+		health, err := s.hm.GetSwitchHealth(ctx, switchUUID)
+		switch {
+		case errors.Is(err, healthmanager.ErrNoSample):
+			// Registered but not collected yet: report UNKNOWN rather than an error
+			result.Status = pb.StatusCode_SUCCESS
+			result.Health = &pb.SwitchHealth{SwitchUuid: uuidStr, Status: pb.HealthStatus_HEALTH_STATUS_UNKNOWN}
+		case err != nil:
+			result.Status = pb.StatusCode_INTERNAL_ERROR
+			result.Error = err.Error()
+		default:
+			result.Status = pb.StatusCode_SUCCESS
+			result.Health = switchHealthToProto(health)
+		}
+		results = append(results, result)
+	}
+
+	return &pb.GetSwitchHealthResponse{Results: results}, nil
+}
+
+// GetPortCounters returns NVLink port state and error counters for a switch.
+func (s *NVSwitchManagerServerImpl) GetPortCounters(ctx context.Context, req *pb.GetPortCountersRequest) (*pb.GetPortCountersResponse, error) {
+	if s.hm == nil {
+		return nil, status.Error(codes.Unavailable, "health manager not initialized")
+	}
+
+	switchUUID, err := uuid.Parse(req.SwitchUuid)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid switch UUID: %v", err)
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	ports, err := s.hm.GetPortCounters(ctx, switchUUID, req.Port, since)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get port counters: %v", err)
+	}
+
+	protoPorts := make([]*pb.PortCounters, len(ports))
+	for i, p := range ports {
+		protoPorts[i] = portCountersToProto(p)
+	}
+
+	return &pb.GetPortCountersResponse{Ports: protoPorts}, nil
+}
+
 // Helper functions for proto conversion
 
 func protoComponentToDomain(c pb.NVSwitchComponent) nvswitch.Component {
@@ -589,3 +683,71 @@ func firmwareUpdateToProto(update *firmwaremanager.FirmwareUpdate) *pb.FirmwareU
 
 	return info
 }
+
+func healthStatusToProto(h healthmanager.HealthStatus) pb.HealthStatus {
+	switch h {
+	case healthmanager.HealthOK:
+		return pb.HealthStatus_HEALTH_STATUS_OK
+	case healthmanager.HealthWarning:
+		return pb.HealthStatus_HEALTH_STATUS_WARNING
+	case healthmanager.HealthCritical:
+		return pb.HealthStatus_HEALTH_STATUS_CRITICAL
+	default:
+		return pb.HealthStatus_HEALTH_STATUS_UNKNOWN
+	}
+}
+
+func sensorKindToProto(k healthmanager.SensorKind) pb.SensorKind {
+	switch k {
+	case healthmanager.SensorFan:
+		return pb.SensorKind_SENSOR_KIND_FAN
+	case healthmanager.SensorPSU:
+		return pb.SensorKind_SENSOR_KIND_PSU
+	case healthmanager.SensorTemperature:
+		return pb.SensorKind_SENSOR_KIND_TEMPERATURE
+	default:
+		return pb.SensorKind_SENSOR_KIND_UNKNOWN
+	}
+}
+
+func switchHealthToProto(h *healthmanager.SwitchHealth) *pb.SwitchHealth {
+	sensors := make([]*pb.SensorReading, len(h.Sensors))
+	for i, sr := range h.Sensors {
+		sensors[i] = &pb.SensorReading{
+			Kind:                   sensorKindToProto(sr.Kind),
+			Name:                   sr.Name,
+			Reading:                sr.Reading,
+			Units:                  sr.Units,
+			UpperThresholdCritical: sr.UpperThresholdCritical,
+			Health:                 healthStatusToProto(sr.Health),
+			State:                  sr.State,
+		}
+	}
+
+	return &pb.SwitchHealth{
+		SwitchUuid:       h.SwitchUUID.String(),
+		Status:           healthStatusToProto(h.Status),
+		CollectedAt:      timestamppb.New(h.CollectedAt),
+		Sensors:          sensors,
+		NvlinkPortsTotal: int32(h.PortsTotal),
+		NvlinkPortsDown:  int32(h.PortsDown),
+		Errors:           h.Errors,
+	}
+}
+
+func portCountersToProto(p *healthmanager.PortCounters) *pb.PortCounters {
+	return &pb.PortCounters{
+		Port:                p.Port,
+		CollectedAt:         timestamppb.New(p.CollectedAt),
+		LinkState:           p.LinkState,
+		Speed:               p.Speed,
+		SymbolErrors:        p.SymbolErrors,
+		RxErrors:            p.RxErrors,
+		TxDiscards:          p.TxDiscards,
+		LinkErrorRecoveries: p.LinkErrorRecoveries,
+		LinkDowned:          p.LinkDowned,
+		LinkFlaps:           p.LinkFlaps,
+		NewErrors:           p.NewErrors,
+		Counters:            p.Counters,
+	}
+}
@@ -14,10 +14,13 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
 	"github.com/uptrace/bun"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -27,7 +30,11 @@ import (
 	pb "github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/internal/proto/v1"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/db/postgres"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/firmwaremanager"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/healthmanager"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
+	rlaclient "github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/maintenancegate"
+	rlatypes "github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -42,6 +49,9 @@ type Service struct {
 	db         *bun.DB
 	nsm        *nvswitchmanager.NVSwitchManager
 	fwm        *firmwaremanager.FirmwareManager
+	hm         *healthmanager.HealthManager
+	rla        *rlaclient.Client
+	metricsSrv *http.Server
 }
 
 // New initializes an NVSwitchManager and constructs a Service from the Config.
@@ -89,6 +99,23 @@ func (s *Service) Start(ctx context.Context) error {
 		return err
 	}
 
+	// Firmware updates wait for the rack maintenance window reported by RLA, if configured
+	var window firmwaremanager.MaintenanceWindow
+	if s.conf.RLAConf.Host != "" {
+		s.rla, err = rlaclient.New(rlaclient.Config{
+			Host: s.conf.RLAConf.Host,
+			Port: s.conf.RLAConf.Port,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create RLA client: %w", err)
+		}
+
+		window = maintenancegate.New(s.rla, rlatypes.ComponentTypeNVSwitch)
+		log.Infof("Firmware updates gated by RLA maintenance windows at %s:%d", s.conf.RLAConf.Host, s.conf.RLAConf.Port)
+	} else {
+		log.Warn("No RLA configured, firmware updates are not gated by maintenance windows")
+	}
+
 	// Initialize FirmwareManager if firmware config is present
 	if s.conf.FirmwareConf.PackagesDir != "" {
 		fwmConfig := s.conf.FirmwareConf.ToFirmwareManagerConfig()
@@ -103,7 +130,7 @@ func (s *Service) Start(ctx context.Context) error {
 			log.Info("FirmwareManager using in-memory store (updates will not persist across restarts)")
 		}
 
-		fwm, err := firmwaremanager.New(fwmConfig, store, s.nsm)
+		fwm, err := firmwaremanager.New(fwmConfig, store, s.nsm, window)
 		if err != nil {
 			log.Warnf("Failed to initialize FirmwareManager: %v", err)
 		} else {
@@ -117,12 +144,36 @@ func (s *Service) Start(ctx context.Context) error {
 		}
 	}
 
+	// Initialize HealthManager unless the collector is disabled
+	if s.conf.HealthConf.Interval > 0 {
+		var store healthmanager.HealthStore
+		if s.conf.DataStoreType == nvswitchmanager.DatastoreTypePersistent && s.db != nil {
+			store = healthmanager.NewPostgresHealthStore(s.db)
+			log.Info("HealthManager using PostgreSQL store")
+		} else {
+			store = healthmanager.NewInMemoryHealthStore()
+			log.Info("HealthManager using in-memory store (samples will not persist across restarts)")
+		}
+
+		s.hm = healthmanager.New(s.conf.HealthConf.ToHealthManagerConfig(), store, s.nsm)
+		if err := s.hm.Start(ctx); err != nil {
+			log.Warnf("Failed to start HealthManager: %v", err)
+			s.hm = nil
+		} else {
+			log.Info("HealthManager initialized and started")
+		}
+	}
+
+	if s.conf.MetricsPort > 0 {
+		s.startMetricsServer()
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", s.conf.Port))
 	if err != nil {
 		return err
 	}
 
-	serverImpl, err := newServerImplementation(s.nsm, s.fwm)
+	serverImpl, err := newServerImplementation(s.nsm, s.fwm, s.hm)
 	if err != nil {
 		return err
 	}
@@ -158,9 +209,44 @@ func (s *Service) Stop(ctx context.Context) {
 		s.fwm.Stop()
 	}
 
+	if s.hm != nil {
+		s.hm.Stop()
+	}
+
+	if s.rla != nil {
+		if err := s.rla.Close(); err != nil {
+			log.Warnf("Failed to close RLA client: %v", err)
+		}
+	}
+
+	if s.metricsSrv != nil {
+		if err := s.metricsSrv.Shutdown(ctx); err != nil {
+			log.Warnf("Failed to shut down metrics server: %v", err)
+		}
+	}
+
 	s.nsm.Stop(ctx)
 }
 
+// startMetricsServer serves Prometheus metrics on the configured metrics port in the background.
+func (s *Service) startMetricsServer() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	s.metricsSrv = &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.conf.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		log.Infof("Metrics server listening on :%d/metrics", s.conf.MetricsPort)
+		if err := s.metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Metrics server failed: %v", err)
+		}
+	}()
+}
+
 // certOption returns the gRPC server option for TLS/mTLS if certificates are present.
 // Falls back to plaintext if certificates are not found.
 func (s *Service) certOption() grpc.ServerOption {
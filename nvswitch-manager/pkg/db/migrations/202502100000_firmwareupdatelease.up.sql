@@ -0,0 +1,33 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+--
+-- Migration: Add lease-based claiming of firmware updates
+-- Allows multiple nvswitch-manager replicas to share the firmware_update table
+-- without two workers driving the same update
+--
+
+-- Worker pool instance currently holding the update
+ALTER TABLE public.firmware_update
+    ADD COLUMN lease_owner TEXT;
+
+-- When the lease expires and another instance may take the update over
+ALTER TABLE public.firmware_update
+    ADD COLUMN lease_expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Index for heartbeat renewal and release by owner
+CREATE INDEX firmware_update_lease_owner_idx
+    ON public.firmware_update (lease_owner)
+    WHERE state NOT IN ('COMPLETED', 'FAILED', 'CANCELLED');
@@ -0,0 +1,70 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+--
+-- Migration: Add switch health and NVLink port counter samples
+-- Written by the health collector on every round and pruned after the retention period
+--
+
+CREATE TABLE public.switch_health (
+    id BIGSERIAL PRIMARY KEY,
+    switch_uuid UUID NOT NULL,
+    collected_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    status TEXT NOT NULL,
+    sensors JSONB,
+    ports_total INTEGER NOT NULL DEFAULT 0,
+    ports_down INTEGER NOT NULL DEFAULT 0,
+    errors JSONB
+);
+
+-- Latest sample per switch
+CREATE INDEX switch_health_switch_collected_idx
+    ON public.switch_health (switch_uuid, collected_at DESC);
+
+-- Retention pruning
+CREATE INDEX switch_health_collected_idx
+    ON public.switch_health (collected_at);
+
+CREATE TABLE public.nvlink_port_sample (
+    id BIGSERIAL PRIMARY KEY,
+    switch_uuid UUID NOT NULL,
+    port TEXT NOT NULL,
+    collected_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    link_state TEXT NOT NULL,
+    speed TEXT,
+    symbol_errors BIGINT NOT NULL DEFAULT 0,
+    rx_errors BIGINT NOT NULL DEFAULT 0,
+    tx_discards BIGINT NOT NULL DEFAULT 0,
+    link_error_recoveries BIGINT NOT NULL DEFAULT 0,
+    link_downed BIGINT NOT NULL DEFAULT 0,
+    counters JSONB,
+    link_flaps BIGINT NOT NULL DEFAULT 0,
+    new_errors BIGINT NOT NULL DEFAULT 0
+);
+
+-- Latest sample per port and per-port history
+CREATE INDEX nvlink_port_sample_switch_port_collected_idx
+    ON public.nvlink_port_sample (switch_uuid, port, collected_at DESC);
+
+-- Retention pruning
+CREATE INDEX nvlink_port_sample_collected_idx
+    ON public.nvlink_port_sample (collected_at);
+
+-- Single-row lease naming the replica that currently polls the switches
+CREATE TABLE public.health_collector_lease (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    owner TEXT NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,25 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--
+-- Migration rollback: Remove maintenance window deferral of firmware updates
+--
+
+ALTER TABLE public.firmware_update
+    DROP COLUMN IF EXISTS maintenance_override_reason;
+
+ALTER TABLE public.firmware_update
+    DROP COLUMN IF EXISTS not_before;
@@ -0,0 +1,29 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+
+--
+-- Migration: Defer firmware updates to the rack maintenance window
+-- Updates submitted or paused while the window is closed wait for its next opening,
+-- and a maintenance window override is recorded with the update for audit
+--
+
+-- Updates are not picked up by workers before this time
+ALTER TABLE public.firmware_update
+    ADD COLUMN not_before TIMESTAMP WITH TIME ZONE;
+
+-- Why the maintenance window was bypassed; written once when the update is queued
+ALTER TABLE public.firmware_update
+    ADD COLUMN maintenance_override_reason TEXT;
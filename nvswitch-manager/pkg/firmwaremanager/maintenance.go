@@ -0,0 +1,67 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firmwaremanager
+
+import (
+	"context"
+	"time"
+)
+
+// maintenanceWindowRecheckInterval is how long an update waits before the maintenance window is
+// checked again when no opening is scheduled or the window could not be evaluated.
+const maintenanceWindowRecheckInterval = 5 * time.Minute
+
+// MaintenanceWindow reports when disruptive work may run on a switch, typically
+// backed by the rack maintenance windows defined in RLA.
+type MaintenanceWindow interface {
+	// Window reports whether disruptive work may run on the component now. When it may not,
+	// the returned time is the next opening of the window, or nil if none is scheduled.
+	Window(ctx context.Context, componentID string) (bool, *time.Time, error)
+}
+
+// IsDisruptive returns true if starting the step may interrupt the switch's traffic.
+// Updates are only paused for a closed maintenance window before such steps.
+func (s UpdateState) IsDisruptive() bool {
+	return s.TransfersFirmware() || s == StatePowerCycle
+}
+
+// nextWindowOpening returns when the update may run its next disruptive step, or nil if it may
+// run now. Updates with a maintenance override are never deferred. When the window cannot be
+// evaluated, the update is deferred by maintenanceWindowRecheckInterval and the error returned.
+func nextWindowOpening(ctx context.Context, window MaintenanceWindow, update *FirmwareUpdate, now time.Time) (*time.Time, error) {
+	if window == nil || update.MaintenanceOverrideReason != "" {
+		return nil, nil
+	}
+
+	recheck := now.Add(maintenanceWindowRecheckInterval)
+
+	open, next, err := window.Window(ctx, update.SwitchUUID.String())
+	if err != nil {
+		return &recheck, err
+	}
+
+	if open {
+		return nil, nil
+	}
+
+	if next == nil || !next.After(now) {
+		return &recheck, nil
+	}
+
+	return next, nil
+}
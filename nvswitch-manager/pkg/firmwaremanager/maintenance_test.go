@@ -0,0 +1,119 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firmwaremanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWindow struct {
+	open bool
+	next *time.Time
+	err  error
+}
+
+func (w *fakeWindow) Window(ctx context.Context, componentID string) (bool, *time.Time, error) {
+	return w.open, w.next, w.err
+}
+
+func TestNextWindowOpening(t *testing.T) {
+	now := time.Now()
+	opening := now.Add(3 * time.Hour)
+	recheck := now.Add(maintenanceWindowRecheckInterval)
+
+	testCases := map[string]struct {
+		window    MaintenanceWindow
+		override  string
+		expected  *time.Time
+		expectErr bool
+	}{
+		"not gated": {
+			window: nil,
+		},
+		"window open": {
+			window: &fakeWindow{open: true},
+		},
+		"window closed defers to the next opening": {
+			window:   &fakeWindow{next: &opening},
+			expected: &opening,
+		},
+		"window closed without a scheduled opening is rechecked": {
+			window:   &fakeWindow{},
+			expected: &recheck,
+		},
+		"override runs in a closed window": {
+			window:   &fakeWindow{next: &opening},
+			override: "emergency security fix",
+		},
+		"status error is rechecked": {
+			window:    &fakeWindow{err: errors.New("rla unavailable")},
+			expected:  &recheck,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			update := NewFirmwareUpdate(uuid.New(), nvswitch.BMC, "1.0.0", StrategyRedfish, "2.0")
+			update.MaintenanceOverrideReason = tc.override
+
+			notBefore, err := nextWindowOpening(context.Background(), tc.window, update, now)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, notBefore)
+		})
+	}
+}
+
+func TestWorkerPool_PausesForMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newTestStore()
+	update := queueTestUpdate(t, store, StateInstall)
+
+	opening := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	pool := NewWorkerPool(1, time.Second, store, nil, nil, "a", time.Minute, &fakeWindow{next: &opening})
+	defer pool.cancel()
+
+	claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+
+	pool.processUpdate(0, claimed[0])
+
+	stored, err := store.Get(ctx, update.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateInstall, stored.State, "the update pauses before the disruptive step")
+	require.NotNil(t, stored.NotBefore)
+	assert.True(t, opening.Equal(*stored.NotBefore))
+
+	claimed, err = store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimed, "a paused update is not picked up before the window opens")
+}
@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/firmwaremanager/packages"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
@@ -45,6 +46,19 @@ type Config struct {
 
 	// SchedulerInterval is how often the scheduler queries for pending updates
 	SchedulerInterval time.Duration
+
+	// InstanceID identifies this replica as a lease owner; defaults to the hostname plus a random suffix
+	InstanceID string
+
+	// LeaseDuration is how long a claimed update stays leased without a heartbeat before
+	// another replica may take it over
+	LeaseDuration time.Duration
+
+	// TrustedKeyPaths are public keys (PEM or minisign) used to verify detached firmware signatures
+	TrustedKeyPaths []string
+
+	// RequireSignatures rejects firmware files without a valid signature from a trusted key
+	RequireSignatures bool
 }
 
 // FirmwareManager orchestrates firmware updates for NV-Switches.
@@ -53,6 +67,7 @@ type FirmwareManager struct {
 	packages   *packages.Registry
 	store      UpdateStore
 	nsmgr      *nvswitchmanager.NVSwitchManager
+	window     MaintenanceWindow
 	workerPool *WorkerPool
 
 	// switchLocks provides per-switch mutex to prevent concurrent QueueUpdate calls
@@ -72,19 +87,31 @@ func (m *FirmwareManager) getSwitchLock(switchUUID uuid.UUID) *sync.Mutex {
 	return lock.(*sync.Mutex)
 }
 
-// New creates a new FirmwareManager.
+// New creates a new FirmwareManager. Updates are deferred to the rack maintenance window
+// reported by window, if any.
 func New(
 	config Config,
 	store UpdateStore,
 	nsmgr *nvswitchmanager.NVSwitchManager,
+	window MaintenanceWindow,
 ) (*FirmwareManager, error) {
+	trustedKeys, err := integrity.LoadPublicKeys(config.TrustedKeyPaths...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trusted firmware keys: %w", err)
+	}
+
+	verifier, err := integrity.NewVerifier(trustedKeys, config.RequireSignatures)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create firmware verifier: %w", err)
+	}
+
 	// Create and load package registry
-	pkgRegistry := packages.NewRegistry(config.FirmwareDir)
+	pkgRegistry := packages.NewRegistry(config.FirmwareDir, verifier)
 	if err := pkgRegistry.LoadFromDirectory(config.PackagesDir); err != nil {
 		return nil, fmt.Errorf("failed to load firmware packages: %w", err)
 	}
 
-	log.Infof("Loaded %d firmware packages", pkgRegistry.Count())
+	log.Infof("Loaded %d firmware packages (%d rejected, %d trusted keys)", pkgRegistry.Count(), len(pkgRegistry.ListRejected()), len(trustedKeys))
 
 	// Create worker pool with scheduler
 	workerPool := NewWorkerPool(
@@ -93,6 +120,9 @@ func New(
 		store,
 		nsmgr,
 		pkgRegistry,
+		config.InstanceID,
+		config.LeaseDuration,
+		window,
 	)
 
 	return &FirmwareManager{
@@ -100,6 +130,7 @@ func New(
 		packages:   pkgRegistry,
 		store:      store,
 		nsmgr:      nsmgr,
+		window:     window,
 		workerPool: workerPool,
 	}, nil
 }
@@ -122,12 +153,15 @@ func (m *FirmwareManager) Stop() {
 
 // QueueUpdate queues firmware updates for one or more components.
 // If components is empty, all components in the bundle are updated in sequence.
+// Updates queued while the rack maintenance window is closed wait for its next opening, unless
+// overrideReason is set; the reason is persisted with every queued update for audit.
 // Returns the list of queued updates in execution order.
 func (m *FirmwareManager) QueueUpdate(
 	ctx context.Context,
 	switchUUID uuid.UUID,
 	bundleVersion string,
 	components []nvswitch.Component,
+	overrideReason string,
 ) ([]*FirmwareUpdate, error) {
 	// Acquire per-switch lock to prevent concurrent QueueUpdate calls for the same switch.
 	// This ensures the "check for active update + save new updates" sequence is atomic.
@@ -176,6 +210,19 @@ func (m *FirmwareManager) QueueUpdate(
 			switchUUID, existing.ID, existing.Component, existing.State)
 	}
 
+	// Defer the updates to the next maintenance window opening. The worker checks the window again
+	// before every disruptive step, so this only decides when the first step is picked up.
+	var notBefore *time.Time
+	if overrideReason != "" {
+		log.Warnf("Maintenance window overridden for firmware update of switch %s to bundle %s: %s",
+			switchUUID, bundleVersion, overrideReason)
+	} else {
+		notBefore, err = nextWindowOpening(ctx, m.window, &FirmwareUpdate{SwitchUUID: switchUUID}, time.Now())
+		if err != nil {
+			log.Warnf("Failed to check maintenance window of switch %s, deferring update: %v", switchUUID, err)
+		}
+	}
+
 	// Generate bundle update ID if we're updating multiple components
 	var bundleUpdateID *uuid.UUID
 	if len(componentNames) > 1 {
@@ -206,6 +253,8 @@ func (m *FirmwareManager) QueueUpdate(
 
 		// Set sequencing
 		update.WithSequencing(bundleUpdateID, i+1, prevID)
+		update.NotBefore = notBefore
+		update.MaintenanceOverrideReason = overrideReason
 
 		// Try to get current version
 		if currentVersion, err := m.getCurrentVersion(ctx, tray, component, strategy, pkg); err == nil {
@@ -217,8 +266,8 @@ func (m *FirmwareManager) QueueUpdate(
 			return nil, fmt.Errorf("failed to queue update for %s: %w", component, err)
 		}
 
-		log.Infof("Queued firmware update: id=%s, switch=%s, component=%s, strategy=%s, version=%s->%s, seq=%d",
-			update.ID, switchUUID, component, strategy, update.VersionFrom, update.VersionTo, update.SequenceOrder)
+		log.Infof("Queued firmware update: id=%s, switch=%s, component=%s, strategy=%s, version=%s->%s, seq=%d, not_before=%v",
+			update.ID, switchUUID, component, strategy, update.VersionFrom, update.VersionTo, update.SequenceOrder, update.NotBefore)
 
 		updates = append(updates, update)
 		prevID = &update.ID
@@ -281,7 +330,9 @@ func (m *FirmwareManager) CancelUpdate(ctx context.Context, updateID uuid.UUID)
 		log.Infof("Cancelled active job: %s", updateID)
 	}
 
-	// Update state in database
+	// Update state in database. Clearing the lease owner makes this an unconditional write;
+	// the instance holding the lease loses it because the update is now terminal.
+	update.LeaseOwner = ""
 	update.ErrorMessage = "cancelled by user"
 	update.SetState(StateCancelled)
 	if err := m.store.Save(ctx, update); err != nil {
@@ -306,6 +357,11 @@ func (m *FirmwareManager) ListBundles() []string {
 	return m.packages.List()
 }
 
+// ListRejectedBundles returns the bundle definitions that failed validation or integrity checks.
+func (m *FirmwareManager) ListRejectedBundles() []packages.RejectedPackage {
+	return m.packages.ListRejected()
+}
+
 // GetBundle returns a firmware package by version.
 func (m *FirmwareManager) GetBundle(version string) (*packages.FirmwarePackage, error) {
 	return m.packages.Get(version)
@@ -21,27 +21,53 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
+
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
 
+// RejectedPackage describes a package definition that could not be loaded.
+type RejectedPackage struct {
+	// File is the path to the package YAML definition
+	File string
+
+	// Version is the bundle version, empty if the definition could not be parsed
+	Version string
+
+	// Reason explains why the package was rejected
+	Reason string
+}
+
 // Registry loads and provides access to firmware packages.
 type Registry struct {
 	// firmwareDir is the base directory containing firmware files
 	firmwareDir string
 
+	// verifier checks firmware files against their checksums and signatures
+	verifier *integrity.Verifier
+
 	// packages maps bundle version to package definition
 	packages map[string]*FirmwarePackage
+
+	// rejected lists package definitions that failed validation or verification
+	rejected []RejectedPackage
 	mu       sync.RWMutex
 }
 
-// NewRegistry creates a new package registry.
-func NewRegistry(firmwareDir string) *Registry {
+// NewRegistry creates a new package registry. If verifier is nil, firmware files are only
+// checked against their checksums.
+func NewRegistry(firmwareDir string, verifier *integrity.Verifier) *Registry {
+	if verifier == nil {
+		verifier = integrity.NewChecksumVerifier()
+	}
 	return &Registry{
 		firmwareDir: firmwareDir,
+		verifier:    verifier,
 		packages:    make(map[string]*FirmwarePackage),
 	}
 }
@@ -53,6 +79,7 @@ func (r *Registry) LoadFromDirectory(packagesDir string) error {
 
 	// Clear existing packages
 	r.packages = make(map[string]*FirmwarePackage)
+	r.rejected = nil
 
 	// Find all YAML files
 	entries, err := os.ReadDir(packagesDir)
@@ -72,52 +99,124 @@ func (r *Registry) LoadFromDirectory(packagesDir string) error {
 		}
 
 		path := filepath.Join(packagesDir, name)
-		if err := r.loadPackageFile(path); err != nil {
-			log.Warnf("Failed to load package file %s: %v", path, err)
+		if version, err := r.loadPackageFile(path); err != nil {
+			log.Warnf("Rejected package file %s: %v", path, err)
+			r.rejected = append(r.rejected, RejectedPackage{File: path, Version: version, Reason: err.Error()})
 			continue
 		}
 		loaded++
 	}
 
-	log.Infof("Loaded %d firmware packages from %s", loaded, packagesDir)
+	log.Infof("Loaded %d firmware packages from %s (%d rejected)", loaded, packagesDir, len(r.rejected))
 	return nil
 }
 
-// loadPackageFile loads a single YAML package file.
-func (r *Registry) loadPackageFile(path string) error {
+// loadPackageFile loads a single YAML package file, returning the bundle version if it could be parsed.
+func (r *Registry) loadPackageFile(path string) (string, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
-		return fmt.Errorf("failed to read file: %w", err)
+		return "", fmt.Errorf("failed to read file: %w", err)
 	}
 
 	var pkg FirmwarePackage
 	if err := yaml.Unmarshal(data, &pkg); err != nil {
-		return fmt.Errorf("failed to parse YAML: %w", err)
+		return "", fmt.Errorf("failed to parse YAML: %w", err)
 	}
 
 	// Validate the package
 	if err := pkg.Validate(); err != nil {
-		return fmt.Errorf("validation failed: %w", err)
+		return pkg.Version, fmt.Errorf("validation failed: %w", err)
 	}
 
 	// Check for duplicate version
 	if existing, ok := r.packages[pkg.Version]; ok {
-		return fmt.Errorf("duplicate version %s (already loaded from another file)", existing.Version)
+		return pkg.Version, fmt.Errorf("duplicate version %s (already loaded from another file)", existing.Version)
+	}
+
+	if err := r.resolveChecksums(&pkg); err != nil {
+		return pkg.Version, err
 	}
 
-	// Validate firmware files exist
-	for name, comp := range pkg.Components {
+	// Validate firmware files exist and match their checksums and signatures
+	for _, name := range pkg.sortedComponents() {
+		comp := pkg.Components[name]
 		firmwarePath := filepath.Join(r.firmwareDir, comp.File)
 		if _, err := os.Stat(firmwarePath); os.IsNotExist(err) {
-			return fmt.Errorf("component %s: firmware file not found: %s", name, firmwarePath)
+			return pkg.Version, fmt.Errorf("component %s: firmware file not found: %s", name, firmwarePath)
+		}
+		if err := r.verifyComponent(&pkg, name); err != nil {
+			return pkg.Version, err
 		}
 	}
 
 	r.packages[pkg.Version] = &pkg
 	log.Debugf("Loaded firmware package: version=%s, components=%d", pkg.Version, len(pkg.Components))
+	return pkg.Version, nil
+}
+
+// resolveChecksums fills in the checksum of every component that does not declare one from the
+// integrity.ChecksumManifestFile next to its firmware file. Components that are not listed there are rejected.
+func (r *Registry) resolveChecksums(pkg *FirmwarePackage) error {
+	manifests := make(map[string]map[string]string)
+
+	for _, name := range pkg.sortedComponents() {
+		comp := pkg.Components[name]
+		if comp.Checksum != "" {
+			continue
+		}
+
+		dir := filepath.Dir(filepath.Join(r.firmwareDir, comp.File))
+		checksums, ok := manifests[dir]
+		if !ok {
+			manifestPath := filepath.Join(dir, integrity.ChecksumManifestFile)
+			data, err := os.ReadFile(manifestPath)
+			if err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("component %s: failed to read %s: %w", name, manifestPath, err)
+			}
+			if checksums, err = integrity.ParseChecksumManifest(data); err != nil {
+				return fmt.Errorf("component %s: failed to parse %s: %w", name, manifestPath, err)
+			}
+			manifests[dir] = checksums
+		}
+
+		checksum, ok := checksums[filepath.Base(comp.File)]
+		if !ok {
+			return fmt.Errorf("component %s: %w: not declared in the bundle and %s is not listed in %s",
+				name, integrity.ErrMissingChecksum, filepath.Base(comp.File), filepath.Join(dir, integrity.ChecksumManifestFile))
+		}
+		comp.Checksum = checksum
+		pkg.Components[name] = comp
+	}
+
+	return nil
+}
+
+// verifyComponent checks a component's firmware file against its checksum and signature.
+func (r *Registry) verifyComponent(pkg *FirmwarePackage, componentName string) error {
+	comp := pkg.GetComponent(componentName)
+	if comp == nil {
+		return fmt.Errorf("component %q not found in package %s", componentName, pkg.Version)
+	}
+
+	var signaturePath string
+	if comp.Signature != "" {
+		signaturePath = filepath.Join(r.firmwareDir, comp.Signature)
+	}
+
+	firmwarePath := filepath.Join(r.firmwareDir, comp.File)
+	if err := r.verifier.VerifyFile(firmwarePath, comp.Checksum, signaturePath); err != nil {
+		return fmt.Errorf("component %s: integrity check failed for %s: %w", componentName, firmwarePath, err)
+	}
 	return nil
 }
 
+// VerifyComponent re-checks a component's firmware file against its checksum and signature.
+// Callers should do this right before sending the file to a device, since it may have changed on disk
+// since the package was loaded.
+func (r *Registry) VerifyComponent(pkg *FirmwarePackage, componentName string) error {
+	return r.verifyComponent(pkg, componentName)
+}
+
 // Get retrieves a firmware package by version.
 func (r *Registry) Get(version string) (*FirmwarePackage, error) {
 	r.mu.RLock()
@@ -125,11 +224,27 @@ func (r *Registry) Get(version string) (*FirmwarePackage, error) {
 
 	pkg, ok := r.packages[version]
 	if !ok {
+		for _, rejected := range r.rejected {
+			if rejected.Version == version {
+				return nil, fmt.Errorf("firmware bundle version %q was rejected: %s", version, rejected.Reason)
+			}
+		}
 		return nil, fmt.Errorf("firmware bundle version %q not found", version)
 	}
 	return pkg, nil
 }
 
+// ListRejected returns the package definitions that failed validation or verification, sorted by file.
+func (r *Registry) ListRejected() []RejectedPackage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	rejected := make([]RejectedPackage, len(r.rejected))
+	copy(rejected, r.rejected)
+	sort.Slice(rejected, func(i, j int) bool { return rejected[i].File < rejected[j].File })
+	return rejected
+}
+
 // List returns all available package versions.
 func (r *Registry) List() []string {
 	r.mu.RLock()
@@ -0,0 +1,189 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package packages
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
+)
+
+// shippedBundlesDir is the directory of firmware bundles shipped with nvswitch-manager
+const shippedBundlesDir = "../../../firmware/bundles"
+
+const testFirmware = "bmc firmware image"
+
+func writeBundle(t *testing.T, dir, name, version, checksum string) {
+	t.Helper()
+	bundle := fmt.Sprintf(`version: %q
+components:
+  bmc:
+    version: "1.0"
+    file: "bmc.fwpkg"
+    checksum: %q
+    strategy: script
+    script: "update.sh"
+`, version, checksum)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(bundle), 0o600))
+}
+
+func TestRegistryLoadFromDirectory(t *testing.T) {
+	sum := sha256.Sum256([]byte(testFirmware))
+	goodChecksum := "sha256:" + hex.EncodeToString(sum[:])
+	badChecksum := "sha256:" + hex.EncodeToString(make([]byte, sha256.Size))
+
+	testCases := map[string]struct {
+		checksum       string
+		manifest       string
+		expectLoaded   bool
+		expectRejected string
+	}{
+		"matching checksum is loaded": {
+			checksum:     goodChecksum,
+			expectLoaded: true,
+		},
+		"missing checksum is rejected": {
+			checksum:       "",
+			expectRejected: "checksum",
+		},
+		"missing checksum is resolved from manifest": {
+			checksum:     "",
+			manifest:     hex.EncodeToString(sum[:]) + "  bmc.fwpkg\n",
+			expectLoaded: true,
+		},
+		"missing checksum not listed in manifest is rejected": {
+			checksum:       "",
+			manifest:       hex.EncodeToString(sum[:]) + "  other.fwpkg\n",
+			expectRejected: "not listed in",
+		},
+		"mismatched manifest checksum is rejected": {
+			checksum:       "",
+			manifest:       hex.EncodeToString(make([]byte, sha256.Size)) + " *bmc.fwpkg\n",
+			expectRejected: "checksum mismatch",
+		},
+		"mismatched checksum is rejected": {
+			checksum:       badChecksum,
+			expectRejected: "checksum mismatch",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			bundlesDir := t.TempDir()
+			firmwareDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(firmwareDir, "bmc.fwpkg"), []byte(testFirmware), 0o600))
+			if tc.manifest != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(firmwareDir, integrity.ChecksumManifestFile), []byte(tc.manifest), 0o600))
+			}
+			writeBundle(t, bundlesDir, "1.0.0.yaml", "1.0.0", tc.checksum)
+
+			registry := NewRegistry(firmwareDir, nil)
+			require.NoError(t, registry.LoadFromDirectory(bundlesDir))
+
+			pkg, err := registry.Get("1.0.0")
+			if tc.expectLoaded {
+				require.NoError(t, err)
+				assert.Empty(t, registry.ListRejected())
+				assert.NoError(t, registry.VerifyComponent(pkg, "bmc"))
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "was rejected")
+			rejected := registry.ListRejected()
+			require.Len(t, rejected, 1)
+			assert.Equal(t, "1.0.0", rejected[0].Version)
+			assert.Contains(t, rejected[0].Reason, tc.expectRejected)
+		})
+	}
+}
+
+func TestRegistryVerifyComponentDetectsModifiedFile(t *testing.T) {
+	sum := sha256.Sum256([]byte(testFirmware))
+	bundlesDir := t.TempDir()
+	firmwareDir := t.TempDir()
+	firmwarePath := filepath.Join(firmwareDir, "bmc.fwpkg")
+	require.NoError(t, os.WriteFile(firmwarePath, []byte(testFirmware), 0o600))
+	writeBundle(t, bundlesDir, "1.0.0.yaml", "1.0.0", "sha256:"+hex.EncodeToString(sum[:]))
+
+	registry := NewRegistry(firmwareDir, nil)
+	require.NoError(t, registry.LoadFromDirectory(bundlesDir))
+	pkg, err := registry.Get("1.0.0")
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(firmwarePath, []byte("corrupted"), 0o600))
+	assert.Error(t, registry.VerifyComponent(pkg, "bmc"))
+}
+
+func TestRegistryLoadShippedBundles(t *testing.T) {
+	entries, err := os.ReadDir(shippedBundlesDir)
+	require.NoError(t, err)
+
+	// The firmware files are not part of the repository, so stage a stand-in for every file referenced by the
+	// shipped bundles along with a SHA256SUMS manifest per directory. Checksums declared in a bundle are moved
+	// to the manifest, since the stand-ins cannot match them.
+	bundlesDir := t.TempDir()
+	firmwareDir := t.TempDir()
+	manifests := make(map[string]string)
+	versions := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		data, err := os.ReadFile(filepath.Join(shippedBundlesDir, entry.Name()))
+		require.NoError(t, err)
+
+		var pkg FirmwarePackage
+		require.NoError(t, yaml.Unmarshal(data, &pkg), entry.Name())
+		require.NoError(t, pkg.Validate(), entry.Name())
+		versions = append(versions, pkg.Version)
+
+		for _, name := range pkg.sortedComponents() {
+			comp := pkg.Components[name]
+			content := []byte(pkg.Version + "/" + name)
+			firmwarePath := filepath.Join(firmwareDir, comp.File)
+			require.NoError(t, os.MkdirAll(filepath.Dir(firmwarePath), 0o700))
+			require.NoError(t, os.WriteFile(firmwarePath, content, 0o600))
+
+			sum := sha256.Sum256(content)
+			manifests[filepath.Dir(firmwarePath)] += hex.EncodeToString(sum[:]) + "  " + filepath.Base(comp.File) + "\n"
+			comp.Checksum = ""
+			pkg.Components[name] = comp
+		}
+
+		data, err = yaml.Marshal(&pkg)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(bundlesDir, entry.Name()), data, 0o600))
+	}
+	require.NotEmpty(t, versions)
+
+	for dir, manifest := range manifests {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, integrity.ChecksumManifestFile), []byte(manifest), 0o600))
+	}
+
+	registry := NewRegistry(firmwareDir, nil)
+	require.NoError(t, registry.LoadFromDirectory(bundlesDir))
+	assert.Empty(t, registry.ListRejected())
+	assert.ElementsMatch(t, versions, registry.List())
+}
@@ -18,6 +18,12 @@
 // Package packages provides firmware package definition and loading.
 package packages
 
+import (
+	"sort"
+
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
+)
+
 // FirmwarePackage represents a firmware bundle defined in YAML.
 // The Version field serves as the unique identifier for the bundle.
 type FirmwarePackage struct {
@@ -48,9 +54,15 @@ type ComponentDef struct {
 	// File is the relative path to the firmware file within the firmware directory
 	File string `yaml:"file"`
 
-	// Checksum for integrity verification (optional, format: "sha256:abc123...")
+	// Checksum for integrity verification (format: "sha256:abc123..." or "sha512:...")
+	// Required unless a SHA256SUMS manifest in the firmware file's directory lists the file.
 	Checksum string `yaml:"checksum,omitempty"`
 
+	// Signature is the relative path to a detached signature of the firmware file within the
+	// firmware directory (optional unless signatures are required). Accepts cosign (base64 ECDSA/RSA)
+	// or minisign signature files.
+	Signature string `yaml:"signature,omitempty"`
+
 	// Strategy specifies how this component is updated: "redfish", "ssh", or "script"
 	Strategy string `yaml:"strategy"`
 
@@ -117,6 +129,16 @@ func (p *FirmwarePackage) HasComponent(name string) bool {
 	return ok
 }
 
+// sortedComponents returns the names of all components in the package in lexical order.
+func (p *FirmwarePackage) sortedComponents() []string {
+	names := make([]string, 0, len(p.Components))
+	for name := range p.Components {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // DefaultComponentOrder defines the fallback update order when not specified in YAML.
 // Update sequence: BMC → CPLD → BIOS → NVOS
 var DefaultComponentOrder = []string{"bmc", "cpld", "bios", "nvos"}
@@ -167,6 +189,12 @@ func (p *FirmwarePackage) Validate() error {
 		if comp.File == "" {
 			return &ValidationError{Field: "components." + name + ".file", Message: "file is required"}
 		}
+		// A missing checksum is resolved from the firmware directory's SHA256SUMS manifest when loaded
+		if comp.Checksum != "" {
+			if _, err := integrity.ParseChecksum(comp.Checksum); err != nil {
+				return &ValidationError{Field: "components." + name + ".checksum", Message: err.Error()}
+			}
+		}
 		if comp.Strategy == "" {
 			return &ValidationError{Field: "components." + name + ".strategy", Message: "strategy is required"}
 		}
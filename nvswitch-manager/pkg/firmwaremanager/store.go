@@ -20,6 +20,7 @@ package firmwaremanager
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
 
@@ -32,9 +33,15 @@ var ErrNoWorkAvailable = errors.New("no work available")
 // ErrUpdateNotFound is returned when an update is not found.
 var ErrUpdateNotFound = errors.New("firmware update not found")
 
+// ErrLeaseLost is returned when a worker saves or renews an update whose lease it no longer holds,
+// either because another instance took it over or because it was moved to a terminal state.
+var ErrLeaseLost = errors.New("firmware update lease lost")
+
 // UpdateStore provides persistence for firmware update records.
 type UpdateStore interface {
-	// Save persists a firmware update (insert or update).
+	// Save persists a firmware update (insert or update). Lease fields are never written by Save.
+	// If update.LeaseOwner is set, the write only succeeds while that owner still holds the lease
+	// and the update is not terminal; otherwise ErrLeaseLost is returned.
 	Save(ctx context.Context, update *FirmwareUpdate) error
 
 	// Get retrieves a firmware update by ID.
@@ -52,6 +59,18 @@ type UpdateStore interface {
 	// This method is used by the scheduler to dispatch work to workers.
 	GetPendingUpdates(ctx context.Context, limit int) ([]*FirmwareUpdate, error)
 
+	// ClaimPendingUpdates selects pending updates like GetPendingUpdates, restricted to updates
+	// that are unleased, already leased by owner, or whose lease has expired, and leases them to
+	// owner for leaseDuration. Concurrent callers never receive the same update.
+	ClaimPendingUpdates(ctx context.Context, owner string, limit int, leaseDuration time.Duration) ([]*FirmwareUpdate, error)
+
+	// RenewLeases extends the lease of every non-terminal update held by owner.
+	// Returns the number of leases renewed.
+	RenewLeases(ctx context.Context, owner string, leaseDuration time.Duration) (int, error)
+
+	// ReleaseLeases gives up every lease held by owner so other instances can take over immediately.
+	ReleaseLeases(ctx context.Context, owner string) error
+
 	// GetBySwitch returns all firmware updates for a given switch.
 	GetBySwitch(ctx context.Context, switchUUID uuid.UUID) ([]*FirmwareUpdate, error)
 
@@ -37,12 +37,16 @@ var _ UpdateStore = (*InMemoryUpdateStore)(nil)
 type InMemoryUpdateStore struct {
 	updates map[uuid.UUID]*FirmwareUpdate
 	mu      sync.RWMutex
+
+	// now returns the current time; overridden in tests to expire leases
+	now func() time.Time
 }
 
 // NewInMemoryUpdateStore creates a new in-memory update store.
 func NewInMemoryUpdateStore() *InMemoryUpdateStore {
 	return &InMemoryUpdateStore{
 		updates: make(map[uuid.UUID]*FirmwareUpdate),
+		now:     time.Now,
 	}
 }
 
@@ -53,7 +57,19 @@ func (s *InMemoryUpdateStore) Save(ctx context.Context, update *FirmwareUpdate)
 
 	// Deep copy to avoid external mutations
 	stored := *update
-	stored.UpdatedAt = time.Now()
+	stored.LeaseOwner = ""
+	stored.LeaseExpiresAt = nil
+
+	// Lease fields are owned by the lease methods; keep the stored values
+	if existing, ok := s.updates[update.ID]; ok {
+		if update.LeaseOwner != "" && (existing.LeaseOwner != update.LeaseOwner || existing.State.IsTerminal()) {
+			return ErrLeaseLost
+		}
+		stored.LeaseOwner = existing.LeaseOwner
+		stored.LeaseExpiresAt = existing.LeaseExpiresAt
+	}
+
+	stored.UpdatedAt = s.now()
 	s.updates[update.ID] = &stored
 
 	return nil
@@ -98,11 +114,82 @@ func (s *InMemoryUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	return s.pendingLocked(limit, func(*FirmwareUpdate) bool { return true }), nil
+}
+
+// ClaimPendingUpdates leases up to `limit` pending updates to owner.
+func (s *InMemoryUpdateStore) ClaimPendingUpdates(ctx context.Context, owner string, limit int, leaseDuration time.Duration) ([]*FirmwareUpdate, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	claimable := func(update *FirmwareUpdate) bool {
+		return update.LeaseOwner == "" || update.LeaseOwner == owner ||
+			update.LeaseExpiresAt == nil || update.LeaseExpiresAt.Before(now)
+	}
+
+	results := s.pendingLocked(limit, claimable)
+	expiresAt := now.Add(leaseDuration)
+	for _, result := range results {
+		stored := s.updates[result.ID]
+		stored.LeaseOwner = owner
+		stored.LeaseExpiresAt = &expiresAt
+
+		result.LeaseOwner = owner
+		result.LeaseExpiresAt = &expiresAt
+	}
+
+	return results, nil
+}
+
+// RenewLeases extends the lease of every non-terminal update held by owner.
+func (s *InMemoryUpdateStore) RenewLeases(ctx context.Context, owner string, leaseDuration time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := s.now().Add(leaseDuration)
+	renewed := 0
+	for _, update := range s.updates {
+		if update.LeaseOwner == owner && !update.State.IsTerminal() {
+			update.LeaseExpiresAt = &expiresAt
+			renewed++
+		}
+	}
+
+	return renewed, nil
+}
+
+// ReleaseLeases gives up every lease held by owner.
+func (s *InMemoryUpdateStore) ReleaseLeases(ctx context.Context, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, update := range s.updates {
+		if update.LeaseOwner == owner {
+			update.LeaseOwner = ""
+			update.LeaseExpiresAt = nil
+		}
+	}
+
+	return nil
+}
+
+// pendingLocked returns copies of up to `limit` pending updates accepted by include.
+// Updates waiting for their maintenance window to open are skipped. The caller must hold s.mu.
+func (s *InMemoryUpdateStore) pendingLocked(limit int, include func(*FirmwareUpdate) bool) []*FirmwareUpdate {
 	var results []*FirmwareUpdate
 
+	now := s.now()
+	ready := func(update *FirmwareUpdate) bool {
+		return include(update) && (update.NotBefore == nil || !update.NotBefore.After(now))
+	}
+
 	// First, collect QUEUED updates whose predecessor has completed
 	var queuedUpdates []*FirmwareUpdate
 	for _, update := range s.updates {
+		if !ready(update) {
+			continue
+		}
 		if update.State == StateQueued {
 			// Check if predecessor is complete (or no predecessor)
 			if update.PredecessorID == nil {
@@ -134,7 +221,7 @@ func (s *InMemoryUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 	if len(results) < limit {
 		var activeUpdates []*FirmwareUpdate
 		for _, update := range s.updates {
-			if !update.State.IsTerminal() && update.State != StateQueued {
+			if ready(update) && !update.State.IsTerminal() && update.State != StateQueued {
 				activeUpdates = append(activeUpdates, update)
 			}
 		}
@@ -153,7 +240,7 @@ func (s *InMemoryUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 		}
 	}
 
-	return results, nil
+	return results
 }
 
 // GetBySwitch returns all firmware updates for a given switch.
@@ -222,7 +309,7 @@ func (s *InMemoryUpdateStore) CancelRemainingInBundle(ctx context.Context, bundl
 
 			update.State = StateCancelled
 			update.ErrorMessage = fmt.Sprintf("cancelled due to %s failure", failedComponent)
-			update.UpdatedAt = time.Now()
+			update.UpdatedAt = s.now()
 			cancelled++
 		}
 	}
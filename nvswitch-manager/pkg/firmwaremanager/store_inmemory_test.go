@@ -0,0 +1,290 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firmwaremanager
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestStore returns an in-memory store whose clock is controlled by the returned function.
+func newTestStore() (*InMemoryUpdateStore, func(time.Duration)) {
+	store := NewInMemoryUpdateStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+	return store, func(d time.Duration) { now = now.Add(d) }
+}
+
+func queueTestUpdate(t *testing.T, store *InMemoryUpdateStore, state UpdateState) *FirmwareUpdate {
+	t.Helper()
+	update := NewFirmwareUpdate(uuid.New(), nvswitch.BMC, "1.0.0", StrategyRedfish, "2.0")
+	update.State = state
+	require.NoError(t, store.Save(context.Background(), update))
+	return update
+}
+
+func TestInMemoryClaimPendingUpdates(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		run func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration))
+	}{
+		"claimed updates are leased to the owner": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				update := queueTestUpdate(t, store, StateQueued)
+
+				claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+				assert.Equal(t, "a", claimed[0].LeaseOwner)
+
+				stored, err := store.Get(ctx, update.ID)
+				require.NoError(t, err)
+				assert.Equal(t, "a", stored.LeaseOwner)
+				require.NotNil(t, stored.LeaseExpiresAt)
+			},
+		},
+		"other owners cannot claim a live lease": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				queueTestUpdate(t, store, StateUpload)
+
+				claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+
+				claimed, err = store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+				require.NoError(t, err)
+				assert.Empty(t, claimed)
+
+				claimed, err = store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				assert.Len(t, claimed, 1, "the owner keeps its lease across cycles")
+			},
+		},
+		"expired leases are taken over with exec context intact": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				update := queueTestUpdate(t, store, StatePollCompletion)
+
+				claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+
+				claimed[0].ExecContext = &ExecContext{TaskURI: "/redfish/v1/TaskService/Tasks/1", Owner: "a"}
+				require.NoError(t, store.Save(ctx, claimed[0]))
+
+				advance(2 * time.Minute)
+
+				claimed, err = store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+				assert.Equal(t, update.ID, claimed[0].ID)
+				assert.Equal(t, "b", claimed[0].LeaseOwner)
+				require.NotNil(t, claimed[0].ExecContext)
+				assert.Equal(t, "/redfish/v1/TaskService/Tasks/1", claimed[0].ExecContext.TaskURI)
+				assert.True(t, claimed[0].ExecContext.IsResumableBy("b"))
+			},
+		},
+		"renewed leases do not expire": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				queueTestUpdate(t, store, StateInstall)
+
+				_, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+
+				advance(45 * time.Second)
+				renewed, err := store.RenewLeases(ctx, "a", time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, 1, renewed)
+
+				advance(45 * time.Second)
+				claimed, err := store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+				require.NoError(t, err)
+				assert.Empty(t, claimed)
+			},
+		},
+		"deferred updates are claimed once not_before has passed": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				update := NewFirmwareUpdate(uuid.New(), nvswitch.BMC, "1.0.0", StrategyRedfish, "2.0")
+				notBefore := store.now().Add(time.Hour)
+				update.NotBefore = &notBefore
+				require.NoError(t, store.Save(ctx, update))
+
+				claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				assert.Empty(t, claimed)
+
+				advance(time.Hour)
+				claimed, err = store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+				assert.Equal(t, update.ID, claimed[0].ID)
+			},
+		},
+		"released leases can be claimed immediately": {
+			run: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration)) {
+				queueTestUpdate(t, store, StateInstall)
+
+				_, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+				require.NoError(t, err)
+				require.NoError(t, store.ReleaseLeases(ctx, "a"))
+
+				claimed, err := store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+				require.NoError(t, err)
+				assert.Len(t, claimed, 1)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store, advance := newTestStore()
+			tc.run(t, store, advance)
+		})
+	}
+}
+
+func TestInMemoryClaimPendingUpdates_Concurrent(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryUpdateStore()
+	for i := 0; i < 50; i++ {
+		queueTestUpdate(t, store, StateQueued)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[uuid.UUID]string)
+
+	var wg sync.WaitGroup
+	for _, owner := range []string{"a", "b", "c", "d"} {
+		wg.Add(1)
+		go func(owner string) {
+			defer wg.Done()
+			for i := 0; i < 10; i++ {
+				claimed, err := store.ClaimPendingUpdates(ctx, owner, 5, time.Minute)
+				assert.NoError(t, err)
+
+				mu.Lock()
+				for _, update := range claimed {
+					if previous, ok := seen[update.ID]; ok {
+						assert.Equal(t, previous, owner, "update %s claimed by two owners", update.ID)
+					}
+					seen[update.ID] = owner
+				}
+				mu.Unlock()
+			}
+		}(owner)
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, 50)
+}
+
+func TestInMemorySave_LeaseLost(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		setup     func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration), update *FirmwareUpdate)
+		expectErr error
+	}{
+		"owner can save": {
+			setup:     func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration), update *FirmwareUpdate) {},
+			expectErr: nil,
+		},
+		"stale owner cannot save after takeover": {
+			setup: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration), update *FirmwareUpdate) {
+				advance(2 * time.Minute)
+				claimed, err := store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+				require.NoError(t, err)
+				require.Len(t, claimed, 1)
+			},
+			expectErr: ErrLeaseLost,
+		},
+		"owner cannot save after cancellation": {
+			setup: func(t *testing.T, store *InMemoryUpdateStore, advance func(time.Duration), update *FirmwareUpdate) {
+				cancelled := *update
+				cancelled.LeaseOwner = ""
+				cancelled.SetState(StateCancelled)
+				require.NoError(t, store.Save(ctx, &cancelled))
+			},
+			expectErr: ErrLeaseLost,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			store, advance := newTestStore()
+			queueTestUpdate(t, store, StateInstall)
+
+			claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+			require.NoError(t, err)
+			require.Len(t, claimed, 1)
+			update := claimed[0]
+
+			tc.setup(t, store, advance, update)
+
+			update.SetState(StateVerify)
+			err = store.Save(ctx, update)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := store.Get(ctx, update.ID)
+			require.NoError(t, err)
+			assert.Equal(t, StateVerify, stored.State)
+			assert.Equal(t, "a", stored.LeaseOwner, "save keeps the lease")
+		})
+	}
+}
+
+func TestWorkerPool_TakeoverOfLocalProcess(t *testing.T) {
+	ctx := context.Background()
+	store, advance := newTestStore()
+	update := queueTestUpdate(t, store, StateInstall)
+
+	claimed, err := store.ClaimPendingUpdates(ctx, "a", 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	claimed[0].ExecContext = &ExecContext{PID: 4242, Owner: "a"}
+	require.NoError(t, store.Save(ctx, claimed[0]))
+
+	advance(2 * time.Minute)
+
+	pool := NewWorkerPool(1, time.Second, store, nil, nil, "b", time.Minute, nil)
+	defer pool.cancel()
+
+	claimed, err = store.ClaimPendingUpdates(ctx, "b", 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.False(t, claimed[0].ExecContext.IsResumableBy("b"))
+
+	pool.processUpdate(0, claimed[0])
+
+	stored, err := store.Get(ctx, update.ID)
+	require.NoError(t, err)
+	assert.Equal(t, StateFailed, stored.State, "an install started by another instance must not be re-run")
+	assert.Contains(t, stored.ErrorMessage, "cannot be resumed")
+}
@@ -29,6 +29,9 @@ import (
 	"github.com/uptrace/bun"
 )
 
+// notDeferred excludes updates waiting for their maintenance window to open.
+const notDeferred = "(not_before IS NULL OR not_before <= now())"
+
 // Ensure PostgresUpdateStore implements UpdateStore.
 var _ UpdateStore = (*PostgresUpdateStore)(nil)
 
@@ -65,6 +68,12 @@ type FirmwareUpdateModel struct {
 	// Async worker pool fields
 	ExecContext   *ExecContext `bun:"exec_context,type:jsonb"`
 	LastCheckedAt *time.Time   `bun:"last_checked_at"`
+	// Lease fields for multi-replica workers
+	LeaseOwner     string     `bun:"lease_owner,nullzero"`
+	LeaseExpiresAt *time.Time `bun:"lease_expires_at"`
+	// Maintenance window fields
+	NotBefore                 *time.Time `bun:"not_before"`
+	MaintenanceOverrideReason string     `bun:"maintenance_override_reason,nullzero"`
 	// Timestamps
 	CreatedAt time.Time `bun:"created_at,notnull,default:now()"`
 	UpdatedAt time.Time `bun:"updated_at,notnull,default:now()"`
@@ -73,57 +82,69 @@ type FirmwareUpdateModel struct {
 // toModel converts a FirmwareUpdate to its database model.
 func toModel(fu *FirmwareUpdate) *FirmwareUpdateModel {
 	return &FirmwareUpdateModel{
-		ID:             fu.ID,
-		SwitchUUID:     fu.SwitchUUID,
-		Component:      fu.Component,
-		BundleVersion:  fu.BundleVersion,
-		Strategy:       fu.Strategy,
-		State:          fu.State,
-		VersionFrom:    fu.VersionFrom,
-		VersionTo:      fu.VersionTo,
-		VersionActual:  fu.VersionActual,
-		TaskURI:        fu.TaskURI,
-		ErrorMessage:   fu.ErrorMessage,
-		BundleUpdateID: fu.BundleUpdateID,
-		SequenceOrder:  fu.SequenceOrder,
-		PredecessorID:  fu.PredecessorID,
-		ExecContext:    fu.ExecContext,
-		LastCheckedAt:  fu.LastCheckedAt,
-		CreatedAt:      fu.CreatedAt,
-		UpdatedAt:      fu.UpdatedAt,
+		ID:                        fu.ID,
+		SwitchUUID:                fu.SwitchUUID,
+		Component:                 fu.Component,
+		BundleVersion:             fu.BundleVersion,
+		Strategy:                  fu.Strategy,
+		State:                     fu.State,
+		VersionFrom:               fu.VersionFrom,
+		VersionTo:                 fu.VersionTo,
+		VersionActual:             fu.VersionActual,
+		TaskURI:                   fu.TaskURI,
+		ErrorMessage:              fu.ErrorMessage,
+		BundleUpdateID:            fu.BundleUpdateID,
+		SequenceOrder:             fu.SequenceOrder,
+		PredecessorID:             fu.PredecessorID,
+		ExecContext:               fu.ExecContext,
+		LastCheckedAt:             fu.LastCheckedAt,
+		LeaseOwner:                fu.LeaseOwner,
+		LeaseExpiresAt:            fu.LeaseExpiresAt,
+		NotBefore:                 fu.NotBefore,
+		MaintenanceOverrideReason: fu.MaintenanceOverrideReason,
+		CreatedAt:                 fu.CreatedAt,
+		UpdatedAt:                 fu.UpdatedAt,
 	}
 }
 
 // fromModel converts a database model to FirmwareUpdate.
 func fromModel(m *FirmwareUpdateModel) *FirmwareUpdate {
 	return &FirmwareUpdate{
-		ID:             m.ID,
-		SwitchUUID:     m.SwitchUUID,
-		Component:      m.Component,
-		BundleVersion:  m.BundleVersion,
-		Strategy:       m.Strategy,
-		State:          m.State,
-		VersionFrom:    m.VersionFrom,
-		VersionTo:      m.VersionTo,
-		VersionActual:  m.VersionActual,
-		TaskURI:        m.TaskURI,
-		ErrorMessage:   m.ErrorMessage,
-		BundleUpdateID: m.BundleUpdateID,
-		SequenceOrder:  m.SequenceOrder,
-		PredecessorID:  m.PredecessorID,
-		ExecContext:    m.ExecContext,
-		LastCheckedAt:  m.LastCheckedAt,
-		CreatedAt:      m.CreatedAt,
-		UpdatedAt:      m.UpdatedAt,
+		ID:                        m.ID,
+		SwitchUUID:                m.SwitchUUID,
+		Component:                 m.Component,
+		BundleVersion:             m.BundleVersion,
+		Strategy:                  m.Strategy,
+		State:                     m.State,
+		VersionFrom:               m.VersionFrom,
+		VersionTo:                 m.VersionTo,
+		VersionActual:             m.VersionActual,
+		TaskURI:                   m.TaskURI,
+		ErrorMessage:              m.ErrorMessage,
+		BundleUpdateID:            m.BundleUpdateID,
+		SequenceOrder:             m.SequenceOrder,
+		PredecessorID:             m.PredecessorID,
+		ExecContext:               m.ExecContext,
+		LastCheckedAt:             m.LastCheckedAt,
+		LeaseOwner:                m.LeaseOwner,
+		LeaseExpiresAt:            m.LeaseExpiresAt,
+		NotBefore:                 m.NotBefore,
+		MaintenanceOverrideReason: m.MaintenanceOverrideReason,
+		CreatedAt:                 m.CreatedAt,
+		UpdatedAt:                 m.UpdatedAt,
 	}
 }
 
 // Save persists a firmware update (insert or update).
+// Lease columns are only written on insert; the lease methods own them afterwards.
+// The maintenance override reason is only written on insert so the audit record cannot be altered.
 func (s *PostgresUpdateStore) Save(ctx context.Context, update *FirmwareUpdate) error {
 	update.UpdatedAt = time.Now()
 	model := toModel(update)
+	model.LeaseOwner = ""
+	model.LeaseExpiresAt = nil
 
-	_, err := s.db.NewInsert().
+	query := s.db.NewInsert().
 		Model(model).
 		On("CONFLICT (id) DO UPDATE").
 		Set("state = EXCLUDED.state").
@@ -133,10 +154,27 @@ func (s *PostgresUpdateStore) Save(ctx context.Context, update *FirmwareUpdate)
 		Set("error_message = EXCLUDED.error_message").
 		Set("exec_context = EXCLUDED.exec_context").
 		Set("last_checked_at = EXCLUDED.last_checked_at").
-		Set("updated_at = EXCLUDED.updated_at").
-		Exec(ctx)
+		Set("not_before = EXCLUDED.not_before").
+		Set("updated_at = EXCLUDED.updated_at")
 
-	return err
+	if update.LeaseOwner != "" {
+		query = query.
+			Where("fu.lease_owner = ?", update.LeaseOwner).
+			Where("fu.state NOT IN (?, ?, ?)", StateCompleted, StateFailed, StateCancelled)
+	}
+
+	result, err := query.Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if update.LeaseOwner != "" {
+		if rowsAffected, err := result.RowsAffected(); err == nil && rowsAffected == 0 {
+			return ErrLeaseLost
+		}
+	}
+
+	return nil
 }
 
 // Get retrieves a firmware update by ID.
@@ -195,6 +233,7 @@ func (s *PostgresUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 			predecessor_id IS NULL 
 			OR predecessor_id IN (SELECT id FROM firmware_update WHERE state = ?)
 		)`, StateCompleted).
+		Where(notDeferred).
 		OrderExpr("sequence_order ASC, created_at ASC").
 		Limit(limit).
 		Scan(ctx)
@@ -218,6 +257,7 @@ func (s *PostgresUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 	err = s.db.NewSelect().
 		Model(&activeModels).
 		Where("state NOT IN (?, ?, ?, ?)", StateQueued, StateCompleted, StateFailed, StateCancelled).
+		Where(notDeferred).
 		OrderExpr("updated_at ASC NULLS FIRST").
 		Limit(remaining).
 		Scan(ctx)
@@ -233,6 +273,123 @@ func (s *PostgresUpdateStore) GetPendingUpdates(ctx context.Context, limit int)
 	return results, nil
 }
 
+// ClaimPendingUpdates leases up to `limit` pending updates to owner.
+// Candidate rows are locked with FOR UPDATE SKIP LOCKED so concurrent replicas claim disjoint sets.
+// Lease expiry is computed from the database clock to avoid skew between replicas.
+func (s *PostgresUpdateStore) ClaimPendingUpdates(ctx context.Context, owner string, limit int, leaseDuration time.Duration) ([]*FirmwareUpdate, error) {
+	var results []*FirmwareUpdate
+
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		const claimable = "(lease_owner IS NULL OR lease_owner = ? OR lease_expires_at IS NULL OR lease_expires_at < now())"
+
+		// QUEUED updates ready to start (predecessor completed or no predecessor)
+		var queuedModels []FirmwareUpdateModel
+		err := tx.NewSelect().
+			Model(&queuedModels).
+			Where("state = ?", StateQueued).
+			Where(`(
+				predecessor_id IS NULL
+				OR predecessor_id IN (SELECT id FROM firmware_update WHERE state = ?)
+			)`, StateCompleted).
+			Where(claimable, owner).
+			Where(notDeferred).
+			OrderExpr("sequence_order ASC, created_at ASC").
+			Limit(limit).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		models := queuedModels
+
+		// Active updates (non-terminal, non-queued), ordered by oldest updated_at
+		if remaining := limit - len(models); remaining > 0 {
+			var activeModels []FirmwareUpdateModel
+			err = tx.NewSelect().
+				Model(&activeModels).
+				Where("state NOT IN (?, ?, ?, ?)", StateQueued, StateCompleted, StateFailed, StateCancelled).
+				Where(claimable, owner).
+				Where(notDeferred).
+				OrderExpr("updated_at ASC NULLS FIRST").
+				Limit(remaining).
+				For("UPDATE SKIP LOCKED").
+				Scan(ctx)
+			if err != nil && err != sql.ErrNoRows {
+				return err
+			}
+			models = append(models, activeModels...)
+		}
+
+		if len(models) == 0 {
+			return nil
+		}
+
+		ids := make([]uuid.UUID, len(models))
+		for i := range models {
+			ids[i] = models[i].ID
+		}
+
+		var leased []FirmwareUpdateModel
+		err = tx.NewUpdate().
+			Model(&leased).
+			Set("lease_owner = ?", owner).
+			Set("lease_expires_at = now() + ? * interval '1 millisecond'", leaseDuration.Milliseconds()).
+			Where("id IN (?)", bun.In(ids)).
+			Returning("id, lease_expires_at").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		expiry := make(map[uuid.UUID]*time.Time, len(leased))
+		for i := range leased {
+			expiry[leased[i].ID] = leased[i].LeaseExpiresAt
+		}
+
+		for i := range models {
+			update := fromModel(&models[i])
+			update.LeaseOwner = owner
+			update.LeaseExpiresAt = expiry[update.ID]
+			results = append(results, update)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// RenewLeases extends the lease of every non-terminal update held by owner.
+func (s *PostgresUpdateStore) RenewLeases(ctx context.Context, owner string, leaseDuration time.Duration) (int, error) {
+	result, err := s.db.NewUpdate().
+		Model((*FirmwareUpdateModel)(nil)).
+		Set("lease_expires_at = now() + ? * interval '1 millisecond'", leaseDuration.Milliseconds()).
+		Where("lease_owner = ?", owner).
+		Where("state NOT IN (?, ?, ?)", StateCompleted, StateFailed, StateCancelled).
+		Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	return int(rowsAffected), nil
+}
+
+// ReleaseLeases gives up every lease held by owner.
+func (s *PostgresUpdateStore) ReleaseLeases(ctx context.Context, owner string) error {
+	_, err := s.db.NewUpdate().
+		Model((*FirmwareUpdateModel)(nil)).
+		Set("lease_owner = NULL").
+		Set("lease_expires_at = NULL").
+		Where("lease_owner = ?", owner).
+		Exec(ctx)
+	return err
+}
+
 // GetBySwitch returns all firmware updates for a given switch.
 func (s *PostgresUpdateStore) GetBySwitch(ctx context.Context, switchUUID uuid.UUID) ([]*FirmwareUpdate, error) {
 	var models []FirmwareUpdateModel
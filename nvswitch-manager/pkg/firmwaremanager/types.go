@@ -85,6 +85,12 @@ func (s UpdateState) IsActive() bool {
 	return !s.IsTerminal() && s != StateQueued
 }
 
+// TransfersFirmware returns true if the step sends the firmware file to the switch.
+// The file's integrity is re-verified before any of these steps starts.
+func (s UpdateState) TransfersFirmware() bool {
+	return s == StateCopy || s == StateUpload || s == StateInstall
+}
+
 // OutcomeType represents the type of outcome from executing a step.
 type OutcomeType int
 
@@ -137,6 +143,17 @@ type ExecContext struct {
 
 	// WaitingForReboot indicates we're waiting for the device to come back after reboot.
 	WaitingForReboot bool `json:"waiting_for_reboot,omitempty"`
+
+	// Owner is the worker pool instance that started the operation. A PID is only meaningful
+	// on the instance that spawned the process.
+	Owner string `json:"owner,omitempty"`
+}
+
+// IsResumableBy returns true if the operation can be monitored by the given worker pool instance.
+// Redfish tasks and reachability checks can be resumed anywhere; local processes only by their owner.
+// Contexts persisted before ownership was tracked are treated as resumable.
+func (ec *ExecContext) IsResumableBy(owner string) bool {
+	return ec.PID == 0 || ec.Owner == "" || ec.Owner == owner
 }
 
 // StepOutcome represents the result of executing a single step in the update state machine.
@@ -220,6 +237,15 @@ type FirmwareUpdate struct {
 	ExecContext   *ExecContext `json:"exec_context,omitempty"`    // Persisted async execution state
 	LastCheckedAt *time.Time   `json:"last_checked_at,omitempty"` // Last time worker polled this update
 
+	// Lease fields for multi-replica deployments. Only the lease owner may drive the update.
+	LeaseOwner     string     `json:"lease_owner,omitempty"`      // Worker pool instance holding the lease
+	LeaseExpiresAt *time.Time `json:"lease_expires_at,omitempty"` // When other instances may take over
+
+	// Maintenance window fields. The update is not picked up before NotBefore, which is set while
+	// the rack maintenance window is closed. The override reason is kept with the update for audit.
+	NotBefore                 *time.Time `json:"not_before,omitempty"`                  // Next maintenance window opening
+	MaintenanceOverrideReason string     `json:"maintenance_override_reason,omitempty"` // Why the window was bypassed
+
 	// Timestamps
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
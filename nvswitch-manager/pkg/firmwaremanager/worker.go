@@ -19,7 +19,9 @@ package firmwaremanager
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -36,8 +38,21 @@ const (
 	DefaultSchedulerInterval = 5 * time.Second
 	// DefaultNumWorkers is the default number of concurrent workers.
 	DefaultNumWorkers = 10
+	// DefaultLeaseDuration is how long a claimed update stays leased without a heartbeat.
+	DefaultLeaseDuration = 2 * time.Minute
+	// leaseReleaseTimeout bounds how long Stop waits to release leases.
+	leaseReleaseTimeout = 10 * time.Second
 )
 
+// newInstanceID returns a worker pool instance ID that is unique per process.
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "nsm"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8])
+}
+
 // WorkItem represents a unit of work dispatched to a worker.
 type WorkItem struct {
 	Update *FirmwareUpdate
@@ -50,8 +65,13 @@ type WorkItem struct {
 //   - Scheduler: Single goroutine that queries DB for pending updates and dispatches to workChan
 //   - Workers: N goroutines that read from workChan and process updates
 //   - Batch-and-Wait: Scheduler waits for all dispatched work to complete before next cycle
+//   - Heartbeat: Single goroutine that renews the leases of all updates held by this instance
 //
-// This ensures no two workers process the same update simultaneously (channel = natural mutex).
+// Within an instance, no two workers process the same update simultaneously (channel = natural mutex).
+// Across replicas, the scheduler only dispatches updates it has leased from the store. A lease stays
+// with its owner until the update is terminal, so long-running operations are polled by the instance
+// that started them; if the owner stops heartbeating, another instance takes the update over once the
+// lease expires and resumes from the persisted ExecContext.
 type WorkerPool struct {
 	numWorkers        int
 	schedulerInterval time.Duration
@@ -59,6 +79,13 @@ type WorkerPool struct {
 	nsmgr             *nvswitchmanager.NVSwitchManager
 	packages          *packages.Registry
 
+	// instanceID identifies this pool as a lease owner
+	instanceID string
+	// leaseDuration is how long claimed updates stay leased without renewal
+	leaseDuration time.Duration
+	// window defers disruptive steps to the rack maintenance window (nil = not gated)
+	window MaintenanceWindow
+
 	// Work dispatch channel - scheduler sends, workers receive
 	workChan chan WorkItem
 
@@ -80,6 +107,9 @@ func NewWorkerPool(
 	store UpdateStore,
 	nsmgr *nvswitchmanager.NVSwitchManager,
 	packages *packages.Registry,
+	instanceID string,
+	leaseDuration time.Duration,
+	window MaintenanceWindow,
 ) *WorkerPool {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -89,6 +119,12 @@ func NewWorkerPool(
 	if schedulerInterval <= 0 {
 		schedulerInterval = DefaultSchedulerInterval
 	}
+	if instanceID == "" {
+		instanceID = newInstanceID()
+	}
+	if leaseDuration <= 0 {
+		leaseDuration = DefaultLeaseDuration
+	}
 
 	return &WorkerPool{
 		numWorkers:        numWorkers,
@@ -96,6 +132,9 @@ func NewWorkerPool(
 		store:             store,
 		nsmgr:             nsmgr,
 		packages:          packages,
+		instanceID:        instanceID,
+		leaseDuration:     leaseDuration,
+		window:            window,
 		workChan:          make(chan WorkItem, numWorkers),
 		activeJobs:        make(map[uuid.UUID]context.CancelFunc),
 		ctx:               ctx,
@@ -105,7 +144,8 @@ func NewWorkerPool(
 
 // Start launches the scheduler and worker goroutines.
 func (p *WorkerPool) Start() {
-	log.Infof("Starting worker pool with %d workers (scheduler interval: %v)", p.numWorkers, p.schedulerInterval)
+	log.Infof("Starting worker pool %s with %d workers (scheduler interval: %v, lease duration: %v)",
+		p.instanceID, p.numWorkers, p.schedulerInterval, p.leaseDuration)
 
 	// Start workers
 	for i := 0; i < p.numWorkers; i++ {
@@ -116,6 +156,10 @@ func (p *WorkerPool) Start() {
 	// Start scheduler
 	p.wg.Add(1)
 	go p.scheduler()
+
+	// Start lease heartbeat
+	p.wg.Add(1)
+	go p.heartbeat()
 }
 
 // Stop gracefully shuts down the worker pool.
@@ -137,9 +181,64 @@ func (p *WorkerPool) Stop() {
 	// NOTE: workChan is closed by the scheduler goroutine (the sole writer)
 	// when it observes context cancellation, which unblocks the workers.
 	p.wg.Wait()
+
+	// Hand our leases back so another instance can take over without waiting for expiry
+	ctx, cancel := context.WithTimeout(context.Background(), leaseReleaseTimeout)
+	defer cancel()
+	if err := p.store.ReleaseLeases(ctx, p.instanceID); err != nil {
+		log.Warnf("Failed to release firmware update leases held by %s: %v", p.instanceID, err)
+	}
+
 	log.Info("Worker pool stopped")
 }
 
+// heartbeat periodically renews the leases held by this instance and cancels
+// any running job whose lease was lost (taken over or cancelled elsewhere).
+func (p *WorkerPool) heartbeat() {
+	defer p.wg.Done()
+
+	ticker := time.NewTicker(p.leaseDuration / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.ctx.Done():
+			return
+
+		case <-ticker.C:
+			p.renewLeases()
+		}
+	}
+}
+
+// renewLeases extends this instance's leases and cancels jobs it no longer owns.
+func (p *WorkerPool) renewLeases() {
+	renewed, err := p.store.RenewLeases(p.ctx, p.instanceID, p.leaseDuration)
+	if err != nil {
+		log.Errorf("Heartbeat: failed to renew firmware update leases: %v", err)
+		return
+	}
+	log.Debugf("Heartbeat: renewed %d firmware update leases", renewed)
+
+	p.mu.RLock()
+	active := make([]uuid.UUID, 0, len(p.activeJobs))
+	for id := range p.activeJobs {
+		active = append(active, id)
+	}
+	p.mu.RUnlock()
+
+	for _, id := range active {
+		update, err := p.store.Get(p.ctx, id)
+		if err != nil {
+			continue
+		}
+		if update.LeaseOwner != p.instanceID || update.State.IsTerminal() {
+			log.Warnf("Heartbeat: lease on update %s lost (owner: %q, state: %s), cancelling job", id, update.LeaseOwner, update.State)
+			p.CancelJob(id)
+		}
+	}
+}
+
 // scheduler is the main scheduling loop that queries DB and dispatches work.
 // It implements a batch-and-wait model: dispatch N updates, wait for all to complete, repeat.
 func (p *WorkerPool) scheduler() {
@@ -165,8 +264,8 @@ func (p *WorkerPool) scheduler() {
 // runSchedulerCycle queries for pending updates and dispatches them to workers.
 // It waits for all dispatched work to complete before returning.
 func (p *WorkerPool) runSchedulerCycle() {
-	// Query DB for pending updates (up to numWorkers)
-	updates, err := p.store.GetPendingUpdates(p.ctx, p.numWorkers)
+	// Claim pending updates (up to numWorkers) so no other replica drives them
+	updates, err := p.store.ClaimPendingUpdates(p.ctx, p.instanceID, p.numWorkers, p.leaseDuration)
 	if err != nil {
 		log.Errorf("Scheduler: failed to get pending updates: %v", err)
 		return
@@ -252,6 +351,29 @@ func (p *WorkerPool) processUpdate(workerID int, update *FirmwareUpdate) {
 		return
 	}
 
+	// Process-based operations can only be monitored by the instance that started them.
+	// After a takeover, a file copy is restarted; anything else may have been flashing when
+	// the previous owner died and must be checked by an operator rather than run twice.
+	if update.ExecContext != nil && !update.ExecContext.IsResumableBy(p.instanceID) {
+		if update.State != StateCopy {
+			p.failUpdate(ctx, update, fmt.Sprintf(
+				"step %s was interrupted when instance %s lost its lease; process %d cannot be resumed from %s, verify the switch before retrying",
+				update.State, update.ExecContext.Owner, update.ExecContext.PID, p.instanceID))
+			return
+		}
+
+		log.Warnf("Worker %d: [%s] Taking over %s from instance %s, restarting copy", workerID, update.ID, update.State, update.ExecContext.Owner)
+		update.ExecContext = nil
+	}
+
+	// Disruptive steps only start while the rack maintenance window is open. A step that is
+	// already running is polled to completion; the update then pauses at the next boundary.
+	if update.ExecContext == nil && (update.State == StateQueued || update.State.IsDisruptive()) {
+		if p.deferToMaintenanceWindow(ctx, workerID, update) {
+			return
+		}
+	}
+
 	// Transition QUEUED updates to their first active state
 	if update.State == StateQueued {
 		firstState := GetFirstState(update)
@@ -295,6 +417,15 @@ func (p *WorkerPool) processUpdate(workerID int, update *FirmwareUpdate) {
 		return
 	}
 
+	// Re-verify the firmware file before it is sent to the switch. Steps that are being polled
+	// (ExecContext set) have already transferred the file.
+	if update.State.TransfersFirmware() && update.ExecContext == nil {
+		if err := p.packages.VerifyComponent(pkg, componentName); err != nil {
+			p.failUpdate(ctx, update, fmt.Sprintf("firmware integrity check failed: %v", err))
+			return
+		}
+	}
+
 	// Create strategy
 	strategy := p.createStrategy(update.Strategy, pkg, firmwarePath, compDef.Script, compDef.ScriptArgs)
 	if strategy == nil {
@@ -345,6 +476,38 @@ func (p *WorkerPool) processUpdate(workerID int, update *FirmwareUpdate) {
 	p.handleStepOutcome(ctx, workerID, update, outcome, duration)
 }
 
+// deferToMaintenanceWindow pauses the update until the next opening of the rack maintenance
+// window if the window is closed. Returns true if the update was deferred.
+func (p *WorkerPool) deferToMaintenanceWindow(ctx context.Context, workerID int, update *FirmwareUpdate) bool {
+	notBefore, err := nextWindowOpening(ctx, p.window, update, time.Now())
+	if err != nil {
+		log.Warnf("Worker %d: [%s] Failed to check maintenance window, retrying at %s: %v",
+			workerID, update.ID, notBefore.Format(time.RFC3339), err)
+	}
+
+	if notBefore == nil {
+		if update.NotBefore != nil {
+			// Cleared with the next save of the update
+			log.Infof("Worker %d: [%s] Maintenance window open, resuming at %s", workerID, update.ID, update.State)
+			update.NotBefore = nil
+		}
+		return false
+	}
+
+	if err == nil {
+		log.Infof("Worker %d: [%s] Maintenance window closed, pausing before %s until %s",
+			workerID, update.ID, update.State, notBefore.Format(time.RFC3339))
+	}
+
+	update.NotBefore = notBefore
+	update.UpdatedAt = time.Now()
+	if err := p.store.Save(ctx, update); err != nil {
+		log.Errorf("Worker %d: [%s] Failed to persist maintenance window deferral: %v", workerID, update.ID, err)
+	}
+
+	return true
+}
+
 // handleStepOutcome processes the result of executing a step.
 func (p *WorkerPool) handleStepOutcome(ctx context.Context, workerID int, update *FirmwareUpdate, outcome StepOutcome, duration time.Duration) {
 	switch outcome.Type {
@@ -354,6 +517,9 @@ func (p *WorkerPool) handleStepOutcome(ctx context.Context, workerID int, update
 			workerID, update.ID, update.State, duration)
 
 		update.ExecContext = outcome.ExecContext
+		if update.ExecContext != nil {
+			update.ExecContext.Owner = p.instanceID
+		}
 		update.UpdatedAt = time.Now()
 
 		if err := p.store.Save(ctx, update); err != nil {
@@ -431,7 +597,13 @@ func (p *WorkerPool) failUpdate(ctx context.Context, update *FirmwareUpdate, rea
 	log.Errorf("Update %s failed: %s", update.ID, reason)
 	update.ErrorMessage = reason
 	update.SetState(StateFailed)
-	_ = p.store.Save(ctx, update)
+	if err := p.store.Save(ctx, update); err != nil {
+		// Another instance owns the update now; leave the bundle to it
+		log.Errorf("Failed to persist failure of update %s: %v", update.ID, err)
+		if errors.Is(err, ErrLeaseLost) {
+			return
+		}
+	}
 
 	// Cancel remaining updates in the bundle if this is part of a multi-component update
 	if update.BundleUpdateID != nil {
@@ -0,0 +1,332 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package healthmanager periodically collects switch health: fan, PSU and thermal sensors
+// from the BMC over Redfish, and NVLink port state, error counters and link flaps from NVOS
+// over SSH. Samples are stored with a retention period and exported as Prometheus metrics.
+package healthmanager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultInterval is how often switches are polled when no interval is configured.
+	DefaultInterval = time.Minute
+
+	// DefaultRetention is how long samples are kept when no retention is configured.
+	DefaultRetention = 7 * 24 * time.Hour
+
+	// DefaultConcurrency is how many switches are polled in parallel when not configured.
+	DefaultConcurrency = 8
+)
+
+// Config holds configuration for the HealthManager.
+type Config struct {
+	// Interval is how often every registered switch is polled
+	Interval time.Duration
+
+	// Retention is how long health and port samples are kept
+	Retention time.Duration
+
+	// Concurrency is the number of switches polled in parallel
+	Concurrency int
+
+	// InstanceID identifies this replica as the collector lease owner; defaults to the hostname plus a random suffix
+	InstanceID string
+
+	// Registerer receives the health metrics; defaults to prometheus.DefaultRegisterer
+	Registerer prometheus.Registerer
+}
+
+// HealthManager runs the periodic health collector and serves the collected samples.
+type HealthManager struct {
+	config  Config
+	store   HealthStore
+	nsmgr   *nvswitchmanager.NVSwitchManager
+	source  source
+	metrics *metrics
+
+	// known tracks the switches that have metric series, so deleted switches can be forgotten.
+	// Only accessed from the collector goroutine.
+	known map[uuid.UUID]struct{}
+
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+}
+
+// New creates a new HealthManager.
+func New(config Config, store HealthStore, nsmgr *nvswitchmanager.NVSwitchManager) *HealthManager {
+	if config.Interval <= 0 {
+		config.Interval = DefaultInterval
+	}
+	if config.Retention <= 0 {
+		config.Retention = DefaultRetention
+	}
+	if config.Concurrency <= 0 {
+		config.Concurrency = DefaultConcurrency
+	}
+	if config.InstanceID == "" {
+		config.InstanceID = newInstanceID()
+	}
+	if config.Registerer == nil {
+		config.Registerer = prometheus.DefaultRegisterer
+	}
+
+	return &HealthManager{
+		config:  config,
+		store:   store,
+		nsmgr:   nsmgr,
+		source:  deviceSource{},
+		metrics: newMetrics(config.Registerer),
+		known:   make(map[uuid.UUID]struct{}),
+	}
+}
+
+// newInstanceID returns a collector instance ID that is unique per process.
+func newInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "nsm"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8])
+}
+
+// Start launches the collector. The first round runs immediately.
+func (m *HealthManager) Start(ctx context.Context) error {
+	log.Infof("Starting health manager (interval=%s, retention=%s, concurrency=%d, instance=%s)",
+		m.config.Interval, m.config.Retention, m.config.Concurrency, m.config.InstanceID)
+
+	m.stopCh = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+
+	return nil
+}
+
+// Stop shuts down the collector and waits for the current round to finish.
+func (m *HealthManager) Stop() {
+	log.Info("Stopping health manager")
+	if m.stopCh != nil {
+		close(m.stopCh)
+	}
+	m.wg.Wait()
+}
+
+func (m *HealthManager) run() {
+	defer m.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-m.stopCh
+		cancel()
+	}()
+
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		m.collectRound(ctx)
+
+		select {
+		case <-m.stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// collectRound polls every registered switch once and prunes expired samples.
+// Rounds are skipped while another replica holds the collector lease.
+func (m *HealthManager) collectRound(ctx context.Context) {
+	// The lease outlives a few rounds so a slow round does not hand collection to another replica.
+	claimed, err := m.store.ClaimCollector(ctx, m.config.InstanceID, 3*m.config.Interval)
+	if err != nil {
+		log.Warnf("Health collector: failed to claim lease: %v", err)
+		return
+	}
+	if !claimed {
+		log.Debug("Health collector: another instance holds the lease, skipping round")
+		return
+	}
+
+	trays, err := m.nsmgr.List(ctx)
+	if err != nil {
+		log.Warnf("Health collector: failed to list switches: %v", err)
+		return
+	}
+
+	sem := make(chan struct{}, m.config.Concurrency)
+	var wg sync.WaitGroup
+	current := make(map[uuid.UUID]struct{}, len(trays))
+	for _, tray := range trays {
+		current[tray.UUID] = struct{}{}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(id uuid.UUID) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := m.collectSwitch(ctx, id); err != nil {
+				log.Warnf("Health collector: switch %s: %v", id, err)
+			}
+		}(tray.UUID)
+	}
+	wg.Wait()
+
+	for id := range m.known {
+		if _, ok := current[id]; !ok {
+			m.metrics.forget(id.String())
+		}
+	}
+	m.known = current
+
+	deleted, err := m.store.Prune(ctx, time.Now().Add(-m.config.Retention))
+	if err != nil {
+		log.Warnf("Health collector: failed to prune samples: %v", err)
+	} else if deleted > 0 {
+		log.Debugf("Health collector: pruned %d samples older than %s", deleted, m.config.Retention)
+	}
+}
+
+// collectSwitch collects, stores and exports one health sample for a switch.
+func (m *HealthManager) collectSwitch(ctx context.Context, id uuid.UUID) error {
+	ctx, cancel := context.WithTimeout(ctx, m.config.Interval)
+	defer cancel()
+
+	tray, err := m.nsmgr.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to get switch: %w", err)
+	}
+
+	start := time.Now()
+	health := &SwitchHealth{
+		SwitchUUID:  id,
+		CollectedAt: start,
+		Status:      HealthOK,
+	}
+
+	sensors, sensorErr := m.source.Sensors(ctx, tray)
+	if sensorErr != nil {
+		m.metrics.recordFailure(sourceRedfish)
+		health.Errors = append(health.Errors, fmt.Sprintf("redfish: %v", sensorErr))
+	}
+	health.Sensors = sensors
+
+	ports, portErrs, portErr := m.source.Ports(ctx, tray)
+	if portErr != nil {
+		m.metrics.recordFailure(sourceNVOS)
+		health.Errors = append(health.Errors, fmt.Sprintf("nvos: %v", portErr))
+	}
+	for _, err := range portErrs {
+		health.Errors = append(health.Errors, fmt.Sprintf("nvos: %v", err))
+	}
+
+	previous, err := m.store.LatestPortCounters(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to load previous port counters: %w", err)
+	}
+	prevByPort := make(map[string]*PortCounters, len(previous))
+	for _, p := range previous {
+		prevByPort[p.Port] = p
+	}
+
+	for _, p := range ports {
+		p.SwitchUUID = id
+		p.CollectedAt = start
+		p.ApplyPrevious(prevByPort[p.Port])
+	}
+
+	health.Status = rollup(health, ports, sensorErr != nil, portErr != nil)
+
+	if err := m.store.Save(ctx, health, ports); err != nil {
+		return fmt.Errorf("failed to save health sample: %w", err)
+	}
+
+	m.metrics.record(health, ports, time.Since(start))
+	return nil
+}
+
+// rollup computes the switch status from its sensors and ports and fills in the port summary.
+// A switch whose BMC and NVOS were both unreachable is UNKNOWN; if only one of them was
+// unreachable the switch is at least a WARNING.
+func rollup(health *SwitchHealth, ports []*PortCounters, sensorsFailed, portsFailed bool) HealthStatus {
+	if sensorsFailed && portsFailed {
+		return HealthUnknown
+	}
+
+	status := HealthOK
+	if sensorsFailed || portsFailed {
+		status = HealthWarning
+	}
+
+	for _, s := range health.Sensors {
+		status = status.Worse(s.Health)
+	}
+
+	health.PortsTotal = len(ports)
+	health.PortsDown = 0
+	for _, p := range ports {
+		if !p.IsUp() {
+			health.PortsDown++
+		}
+		status = status.Worse(p.Status())
+	}
+
+	return status
+}
+
+// GetSwitchHealth returns the latest health sample for a switch, or ErrNoSample.
+func (m *HealthManager) GetSwitchHealth(ctx context.Context, switchUUID uuid.UUID) (*SwitchHealth, error) {
+	return m.store.LatestHealth(ctx, switchUUID)
+}
+
+// GetPortCounters returns NVLink port samples for a switch. With a zero since only the
+// latest sample of each port is returned; otherwise every sample collected at or after since.
+// An empty port selects every port.
+func (m *HealthManager) GetPortCounters(ctx context.Context, switchUUID uuid.UUID, port string, since time.Time) ([]*PortCounters, error) {
+	if !since.IsZero() {
+		return m.store.PortCounterHistory(ctx, switchUUID, port, since)
+	}
+
+	latest, err := m.store.LatestPortCounters(ctx, switchUUID)
+	if err != nil {
+		return nil, err
+	}
+	if port == "" {
+		return latest, nil
+	}
+
+	for _, p := range latest {
+		if p.Port == port {
+			return []*PortCounters{p}, nil
+		}
+	}
+	return []*PortCounters{}, nil
+}
@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/common/credential"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/credentials"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/nvswitchmanager"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/bmc"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvos"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSource returns canned sensors and ports, which tests change between rounds.
+type fakeSource struct {
+	mu        sync.Mutex
+	sensors   []SensorReading
+	sensorErr error
+	ports     []*PortCounters
+	portErr   error
+}
+
+func (f *fakeSource) Sensors(ctx context.Context, tray *nvswitch.NVSwitchTray) ([]SensorReading, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.sensors, f.sensorErr
+}
+
+func (f *fakeSource) Ports(ctx context.Context, tray *nvswitch.NVSwitchTray) ([]*PortCounters, []error, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.portErr != nil {
+		return nil, nil, f.portErr
+	}
+	ports := make([]*PortCounters, 0, len(f.ports))
+	for _, p := range f.ports {
+		pc := *p
+		ports = append(ports, &pc)
+	}
+	return ports, nil, nil
+}
+
+func newTestManager(t *testing.T) (*HealthManager, *InMemoryHealthStore, *fakeSource, uuid.UUID) {
+	t.Helper()
+	ctx := context.Background()
+
+	nsm, err := nvswitchmanager.New(ctx, nvswitchmanager.Config{
+		DSType:         nvswitchmanager.DatastoreTypeInMemory,
+		CredentialConf: credentials.Config{DataStoreType: credentials.DatastoreTypeInMemory},
+	})
+	require.NoError(t, err)
+
+	b, err := bmc.New("00:00:00:00:00:01", "192.0.2.10", credential.New("root", "pw"))
+	require.NoError(t, err)
+	n, err := nvos.New("00:00:00:00:00:02", "192.0.2.11", credential.New("admin", "pw"))
+	require.NoError(t, err)
+	id, _, err := nsm.Register(ctx, &nvswitch.NVSwitchTray{BMC: b, NVOS: n})
+	require.NoError(t, err)
+
+	store := NewInMemoryHealthStore()
+	m := New(Config{InstanceID: "test", Registerer: prometheus.NewRegistry()}, store, nsm)
+	src := &fakeSource{}
+	m.source = src
+
+	return m, store, src, id
+}
+
+func TestCollectRound_DetectsFlapsAndErrors(t *testing.T) {
+	ctx := context.Background()
+	m, _, src, id := newTestManager(t)
+
+	src.sensors = []SensorReading{{Kind: SensorFan, Name: "c/FAN_0", Reading: 12000, Units: "RPM", Health: HealthOK}}
+	src.ports = []*PortCounters{
+		{Port: "nvl1", LinkState: LinkStateUp, LinkDowned: 2, SymbolErrors: 10},
+		{Port: "nvl2", LinkState: LinkStateUp},
+	}
+	m.collectRound(ctx)
+
+	health, err := m.GetSwitchHealth(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, HealthOK, health.Status, "raw counters on the first sample are not treated as new")
+	assert.Equal(t, 2, health.PortsTotal)
+	assert.Equal(t, 0, health.PortsDown)
+
+	src.ports = []*PortCounters{
+		{Port: "nvl1", LinkState: LinkStateUp, LinkDowned: 3, SymbolErrors: 15},
+		{Port: "nvl2", LinkState: LinkStateDown},
+	}
+	m.collectRound(ctx)
+
+	health, err = m.GetSwitchHealth(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, HealthWarning, health.Status)
+	assert.Equal(t, 1, health.PortsDown)
+
+	latest, err := m.GetPortCounters(ctx, id, "", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, latest, 2)
+	assert.Equal(t, uint64(1), latest[0].LinkFlaps)
+	assert.Equal(t, uint64(5), latest[0].NewErrors)
+	assert.Equal(t, uint64(1), latest[1].LinkFlaps)
+
+	history, err := m.GetPortCounters(ctx, id, "nvl1", time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Len(t, history, 2)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.metrics.portFlaps.WithLabelValues(id.String(), "nvl1")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(m.metrics.portUp.WithLabelValues(id.String(), "nvl2")))
+	assert.Equal(t, 15.0, testutil.ToFloat64(m.metrics.portErrors.WithLabelValues(id.String(), "nvl1", "symbol_errors")))
+	assert.Equal(t, float64(HealthWarning.severity()), testutil.ToFloat64(m.metrics.switchHealth.WithLabelValues(id.String())))
+}
+
+func TestCollectSwitch_SourceFailures(t *testing.T) {
+	testCases := map[string]struct {
+		sensorErr  error
+		portErr    error
+		wantStatus HealthStatus
+		wantErrors int
+	}{
+		"both reachable": {
+			wantStatus: HealthOK,
+		},
+		"BMC unreachable": {
+			sensorErr:  errors.New("connection refused"),
+			wantStatus: HealthWarning,
+			wantErrors: 1,
+		},
+		"both unreachable": {
+			sensorErr:  errors.New("connection refused"),
+			portErr:    errors.New("ssh: handshake failed"),
+			wantStatus: HealthUnknown,
+			wantErrors: 2,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			m, _, src, id := newTestManager(t)
+			src.ports = []*PortCounters{{Port: "nvl1", LinkState: LinkStateUp}}
+			src.sensorErr = tc.sensorErr
+			src.portErr = tc.portErr
+
+			require.NoError(t, m.collectSwitch(ctx, id))
+
+			health, err := m.GetSwitchHealth(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantStatus, health.Status)
+			assert.Len(t, health.Errors, tc.wantErrors)
+		})
+	}
+}
+
+func TestCollectRound_SkipsWhenLeaseHeldElsewhere(t *testing.T) {
+	ctx := context.Background()
+	m, store, src, id := newTestManager(t)
+	src.ports = []*PortCounters{{Port: "nvl1", LinkState: LinkStateUp}}
+
+	claimed, err := store.ClaimCollector(ctx, "other", time.Hour)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	m.collectRound(ctx)
+
+	_, err = m.GetSwitchHealth(ctx, id)
+	assert.ErrorIs(t, err, ErrNoSample)
+}
+
+func TestInMemoryClaimCollector_Expiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryHealthStore()
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	ok, err := store.ClaimCollector(ctx, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = store.ClaimCollector(ctx, "b", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, ok, "lease is held by a")
+
+	ok, err = store.ClaimCollector(ctx, "a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "owner renews its own lease")
+
+	now = now.Add(2 * time.Minute)
+	ok, err = store.ClaimCollector(ctx, "b", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, ok, "expired lease is taken over")
+}
+
+func TestInMemoryPrune(t *testing.T) {
+	ctx := context.Background()
+	store := NewInMemoryHealthStore()
+	id := uuid.New()
+	old := time.Now().Add(-48 * time.Hour)
+	recent := time.Now()
+
+	for _, at := range []time.Time{old, recent} {
+		require.NoError(t, store.Save(ctx,
+			&SwitchHealth{SwitchUUID: id, CollectedAt: at, Status: HealthOK},
+			[]*PortCounters{{SwitchUUID: id, Port: "nvl1", CollectedAt: at, LinkState: LinkStateUp}},
+		))
+	}
+
+	deleted, err := store.Prune(ctx, time.Now().Add(-24*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	history, err := store.PortCounterHistory(ctx, id, "", time.Time{})
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	assert.Equal(t, recent, history[0].CollectedAt)
+
+	health, err := store.LatestHealth(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, recent, health.CollectedAt)
+}
@@ -0,0 +1,170 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "nvswitch_manager"
+
+// Collection sources reported in the collection failure metric.
+const (
+	sourceRedfish = "redfish"
+	sourceNVOS    = "nvos"
+)
+
+// metrics exposes the collected health as Prometheus series. Health gauges encode
+// HealthStatus as 0=unknown, 1=ok, 2=warning, 3=critical.
+type metrics struct {
+	switchHealth       *prometheus.GaugeVec
+	sensorReading      *prometheus.GaugeVec
+	sensorHealth       *prometheus.GaugeVec
+	portUp             *prometheus.GaugeVec
+	portErrors         *prometheus.GaugeVec
+	portFlaps          *prometheus.CounterVec
+	collectionFailures *prometheus.CounterVec
+	collectionLatency  prometheus.Histogram
+}
+
+func newMetrics(reg prometheus.Registerer) *metrics {
+	m := &metrics{
+		switchHealth: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "switch_health_status",
+				Help:      "Rolled-up switch health (0=unknown, 1=ok, 2=warning, 3=critical)",
+			},
+			[]string{"switch_uuid"}),
+		sensorReading: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "switch_sensor_reading",
+				Help:      "Latest fan, PSU or temperature sensor reading from the BMC",
+			},
+			[]string{"switch_uuid", "kind", "sensor", "units"}),
+		sensorHealth: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "switch_sensor_health_status",
+				Help:      "Sensor health (0=unknown, 1=ok, 2=warning, 3=critical)",
+			},
+			[]string{"switch_uuid", "kind", "sensor"}),
+		portUp: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "nvlink_port_up",
+				Help:      "Whether the NVLink port link is up (1) or down (0)",
+			},
+			[]string{"switch_uuid", "port"}),
+		portErrors: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "nvlink_port_error_counter",
+				Help:      "Raw NVLink port error counter as reported by NVOS",
+			},
+			[]string{"switch_uuid", "port", "counter"}),
+		portFlaps: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "nvlink_port_link_flaps_total",
+				Help:      "Number of NVLink link flaps observed by the health collector",
+			},
+			[]string{"switch_uuid", "port"}),
+		collectionFailures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "health_collection_failures_total",
+				Help:      "Number of failed health collections per source",
+			},
+			[]string{"source"}),
+		collectionLatency: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Name:      "health_collection_duration_seconds",
+				Help:      "Duration of a health collection for one switch",
+				Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 30, 60, 120},
+			}),
+	}
+
+	reg.MustRegister(
+		m.switchHealth,
+		m.sensorReading,
+		m.sensorHealth,
+		m.portUp,
+		m.portErrors,
+		m.portFlaps,
+		m.collectionFailures,
+		m.collectionLatency,
+	)
+
+	return m
+}
+
+// record replaces the series of a switch with the values of its latest sample.
+func (m *metrics) record(health *SwitchHealth, ports []*PortCounters, duration time.Duration) {
+	id := health.SwitchUUID.String()
+
+	m.collectionLatency.Observe(duration.Seconds())
+	m.switchHealth.WithLabelValues(id).Set(float64(health.Status.severity()))
+
+	// Sensors can disappear between rounds (e.g. a PSU pulled), so drop the old series.
+	m.sensorReading.DeletePartialMatch(prometheus.Labels{"switch_uuid": id})
+	m.sensorHealth.DeletePartialMatch(prometheus.Labels{"switch_uuid": id})
+	for _, s := range health.Sensors {
+		m.sensorReading.WithLabelValues(id, string(s.Kind), s.Name, s.Units).Set(s.Reading)
+		m.sensorHealth.WithLabelValues(id, string(s.Kind), s.Name).Set(float64(s.Health.severity()))
+	}
+
+	// Only replace port series when NVOS answered, so an unreachable switch keeps its last values.
+	if len(ports) > 0 {
+		m.portUp.DeletePartialMatch(prometheus.Labels{"switch_uuid": id})
+		m.portErrors.DeletePartialMatch(prometheus.Labels{"switch_uuid": id})
+	}
+	for _, p := range ports {
+		up := 0.0
+		if p.IsUp() {
+			up = 1
+		}
+		m.portUp.WithLabelValues(id, p.Port).Set(up)
+		m.portErrors.WithLabelValues(id, p.Port, "symbol_errors").Set(float64(p.SymbolErrors))
+		m.portErrors.WithLabelValues(id, p.Port, "rx_errors").Set(float64(p.RxErrors))
+		m.portErrors.WithLabelValues(id, p.Port, "tx_discards").Set(float64(p.TxDiscards))
+		m.portErrors.WithLabelValues(id, p.Port, "link_error_recoveries").Set(float64(p.LinkErrorRecoveries))
+		m.portErrors.WithLabelValues(id, p.Port, "link_downed").Set(float64(p.LinkDowned))
+		m.portFlaps.WithLabelValues(id, p.Port).Add(float64(p.LinkFlaps))
+	}
+}
+
+// recordFailure counts a failed collection from the given source.
+func (m *metrics) recordFailure(source string) {
+	m.collectionFailures.WithLabelValues(source).Inc()
+}
+
+// forget removes every series of a switch that is no longer registered.
+func (m *metrics) forget(switchUUID string) {
+	labels := prometheus.Labels{"switch_uuid": switchUUID}
+	m.switchHealth.DeletePartialMatch(labels)
+	m.sensorReading.DeletePartialMatch(labels)
+	m.sensorHealth.DeletePartialMatch(labels)
+	m.portUp.DeletePartialMatch(labels)
+	m.portErrors.DeletePartialMatch(labels)
+	m.portFlaps.DeletePartialMatch(labels)
+}
@@ -0,0 +1,280 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// nvShowInterfacesCmd lists every interface with its link state.
+	nvShowInterfacesCmd = "nv show interface -o json"
+
+	// nvShowCountersCmd reads the counters of a single interface.
+	nvShowCountersCmd = "nv show interface %s counters -o json"
+
+	// nvlinkInterfaceType is the NVUE interface type of NVLink ports.
+	nvlinkInterfaceType = "nvl"
+)
+
+// portNameRe matches interface names that are safe to interpolate into an NVUE command.
+var portNameRe = regexp.MustCompile(`^[a-zA-Z0-9/_.:\-]+$`)
+
+// counterAliases maps the typed PortCounters fields to the counter names NVOS releases
+// have used for them, normalized to lower-case with dashes.
+var counterAliases = map[string][]string{
+	"symbol-errors":         {"symbol-errors", "symbol-error", "symbol-error-counter"},
+	"rx-errors":             {"rx-errors", "in-errors", "port-rcv-errors"},
+	"tx-discards":           {"tx-discards", "out-discards", "port-xmit-discards"},
+	"link-error-recoveries": {"link-error-recovery", "link-error-recovery-counter", "link-error-recoveries"},
+	"link-downed":           {"link-downed", "link-down", "link-downed-counter", "carrier-down-count"},
+}
+
+// NVLinkPort is an NVLink interface as listed by NVOS.
+type NVLinkPort struct {
+	Name      string
+	LinkState string
+	Speed     string
+}
+
+// ValidPortName reports whether name can safely be used as an NVOS interface argument.
+func ValidPortName(name string) bool {
+	return portNameRe.MatchString(name)
+}
+
+// jsonBody strips anything NVOS prints before the JSON document (banners, warnings).
+func jsonBody(output string) ([]byte, error) {
+	i := strings.IndexByte(output, '{')
+	if i < 0 {
+		return nil, fmt.Errorf("no JSON object in NVOS output: %q", truncate(output, 80))
+	}
+	return []byte(output[i:]), nil
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+// ParseInterfaces parses `nv show interface -o json` output and returns the NVLink ports sorted by name.
+func ParseInterfaces(output string) ([]NVLinkPort, error) {
+	body, err := jsonBody(output)
+	if err != nil {
+		return nil, err
+	}
+
+	var ifaces map[string]struct {
+		Type string `json:"type"`
+		Link struct {
+			State      json.RawMessage `json:"state"`
+			OperStatus json.RawMessage `json:"oper-status"`
+			Speed      string          `json:"speed"`
+		} `json:"link"`
+	}
+	if err := json.Unmarshal(body, &ifaces); err != nil {
+		return nil, fmt.Errorf("failed to parse interface list: %w", err)
+	}
+
+	ports := make([]NVLinkPort, 0, len(ifaces))
+	for name, iface := range ifaces {
+		if iface.Type != nvlinkInterfaceType && (iface.Type != "" || !strings.HasPrefix(name, nvlinkInterfaceType)) {
+			continue
+		}
+
+		state := parseLinkState(iface.Link.OperStatus)
+		if state == "" {
+			state = parseLinkState(iface.Link.State)
+		}
+		if state == "" {
+			state = LinkStateDown
+		}
+
+		ports = append(ports, NVLinkPort{Name: name, LinkState: state, Speed: iface.Link.Speed})
+	}
+
+	sort.Slice(ports, func(i, j int) bool { return portLess(ports[i].Name, ports[j].Name) })
+	return ports, nil
+}
+
+// parseLinkState accepts both NVUE encodings of a state: a plain string ("up")
+// and a single-key object ({"up": {}}).
+func parseLinkState(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return normalizeLinkState(s)
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &obj); err == nil {
+		for k := range obj {
+			return normalizeLinkState(k)
+		}
+	}
+
+	return ""
+}
+
+func normalizeLinkState(s string) string {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "up", "active", "linkup":
+		return LinkStateUp
+	case "":
+		return ""
+	default:
+		return LinkStateDown
+	}
+}
+
+// portLess orders port names naturally so nvl2 sorts before nvl10.
+func portLess(a, b string) bool {
+	pa, na := splitPortName(a)
+	pb, nb := splitPortName(b)
+	if pa != pb || na < 0 || nb < 0 {
+		return a < b
+	}
+	return na < nb
+}
+
+func splitPortName(name string) (string, int) {
+	i := len(name)
+	for i > 0 && name[i-1] >= '0' && name[i-1] <= '9' {
+		i--
+	}
+	n, err := strconv.Atoi(name[i:])
+	if err != nil {
+		return name, -1
+	}
+	return name[:i], n
+}
+
+// ParseCounters parses `nv show interface <port> counters -o json` output into the
+// port's counter fields. Nested objects are flattened with dashes (rx.errors becomes
+// rx-errors) and non-numeric values are ignored.
+func ParseCounters(output string, pc *PortCounters) error {
+	body, err := jsonBody(output)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+
+	var raw map[string]any
+	if err := dec.Decode(&raw); err != nil {
+		return fmt.Errorf("failed to parse counters: %w", err)
+	}
+
+	counters := make(map[string]uint64)
+	flattenCounters("", raw, counters)
+
+	pc.Counters = counters
+	pc.SymbolErrors = lookupCounter(counters, "symbol-errors")
+	pc.RxErrors = lookupCounter(counters, "rx-errors")
+	pc.TxDiscards = lookupCounter(counters, "tx-discards")
+	pc.LinkErrorRecoveries = lookupCounter(counters, "link-error-recoveries")
+	pc.LinkDowned = lookupCounter(counters, "link-downed")
+
+	return nil
+}
+
+func flattenCounters(prefix string, raw map[string]any, out map[string]uint64) {
+	for k, v := range raw {
+		key := strings.ReplaceAll(strings.ToLower(k), "_", "-")
+		if prefix != "" {
+			key = prefix + "-" + key
+		}
+
+		switch val := v.(type) {
+		case map[string]any:
+			flattenCounters(key, val, out)
+		case json.Number:
+			if n, err := strconv.ParseUint(val.String(), 10, 64); err == nil {
+				out[key] = n
+			}
+		case string:
+			if n, err := strconv.ParseUint(strings.TrimSpace(val), 10, 64); err == nil {
+				out[key] = n
+			}
+		}
+	}
+}
+
+// lookupCounter returns the value of a typed counter field. An exact alias match wins;
+// otherwise a flattened key ending in an alias is used (link.link_downed_counter matches
+// link-downed-counter), picking the first such key in sorted order for determinism.
+func lookupCounter(counters map[string]uint64, field string) uint64 {
+	aliases := counterAliases[field]
+	for _, alias := range aliases {
+		if v, ok := counters[alias]; ok {
+			return v
+		}
+	}
+
+	keys := make([]string, 0, len(counters))
+	for k := range counters {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, alias := range aliases {
+		for _, k := range keys {
+			if strings.HasSuffix(k, "-"+alias) {
+				return counters[k]
+			}
+		}
+	}
+	return 0
+}
+
+// counterDelta returns how much a monotonically increasing counter grew, treating a
+// smaller current value as a counter reset (e.g. after a switch reboot).
+func counterDelta(prev, cur uint64) uint64 {
+	if cur < prev {
+		return cur
+	}
+	return cur - prev
+}
+
+// ApplyPrevious derives LinkFlaps and NewErrors from the previous sample of the same port.
+// Without a previous sample nothing is derived, since the raw counters may date back to the last reboot.
+func (p *PortCounters) ApplyPrevious(prev *PortCounters) {
+	if prev == nil {
+		return
+	}
+
+	p.LinkFlaps = counterDelta(prev.LinkDowned, p.LinkDowned)
+	// A link that went down without the counter moving (or on firmware that does not
+	// report it) still counts as a flap.
+	if p.LinkFlaps == 0 && prev.IsUp() && !p.IsUp() {
+		p.LinkFlaps = 1
+	}
+
+	p.NewErrors = counterDelta(prev.errorTotal(), p.errorTotal())
+}
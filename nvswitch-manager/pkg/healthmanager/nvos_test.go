@@ -0,0 +1,192 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestdata(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestParseInterfaces(t *testing.T) {
+	ports, err := ParseInterfaces(readTestdata(t, "nv_show_interface.json"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []NVLinkPort{
+		{Name: "nvl1", LinkState: LinkStateUp, Speed: "400G"},
+		{Name: "nvl2", LinkState: LinkStateUp, Speed: "400G"},
+		{Name: "nvl3", LinkState: LinkStateDown},
+		{Name: "nvl10", LinkState: LinkStateDown, Speed: "400G"},
+	}, ports, "only NVLink ports, naturally sorted, oper-status preferred over admin state")
+}
+
+func TestParseInterfaces_Errors(t *testing.T) {
+	testCases := map[string]struct {
+		output  string
+		wantErr bool
+		want    int
+	}{
+		"banner before JSON": {
+			output: "Warning: config has pending changes\n{\"nvl1\": {\"type\": \"nvl\", \"link\": {\"state\": \"up\"}}}",
+			want:   1,
+		},
+		"untyped nvl interface": {
+			output: `{"nvl7": {"link": {"state": {"down": {}}}}, "swp1": {"link": {"state": "up"}}}`,
+			want:   1,
+		},
+		"no JSON": {
+			output:  "Error: command not found",
+			wantErr: true,
+		},
+		"truncated JSON": {
+			output:  `{"nvl1": {"type": "nvl"`,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ports, err := ParseInterfaces(tc.output)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, ports, tc.want)
+		})
+	}
+}
+
+func TestParseCounters(t *testing.T) {
+	testCases := map[string]struct {
+		file string
+		want PortCounters
+	}{
+		"flat NVUE counters": {
+			file: "nv_show_interface_nvl1_counters.json",
+			want: PortCounters{
+				SymbolErrors:        40,
+				RxErrors:            12,
+				TxDiscards:          0,
+				LinkErrorRecoveries: 1,
+				LinkDowned:          3,
+			},
+		},
+		"nested IB-style counters": {
+			file: "nv_show_interface_nvl2_counters.json",
+			want: PortCounters{
+				SymbolErrors:        7,
+				RxErrors:            2,
+				TxDiscards:          5,
+				LinkErrorRecoveries: 0,
+				LinkDowned:          0,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var pc PortCounters
+			require.NoError(t, ParseCounters(readTestdata(t, tc.file), &pc))
+
+			assert.Equal(t, tc.want.SymbolErrors, pc.SymbolErrors)
+			assert.Equal(t, tc.want.RxErrors, pc.RxErrors)
+			assert.Equal(t, tc.want.TxDiscards, pc.TxDiscards)
+			assert.Equal(t, tc.want.LinkErrorRecoveries, pc.LinkErrorRecoveries)
+			assert.Equal(t, tc.want.LinkDowned, pc.LinkDowned)
+			assert.NotEmpty(t, pc.Counters)
+		})
+	}
+}
+
+func TestParseCounters_KeepsAllNumericCounters(t *testing.T) {
+	var pc PortCounters
+	require.NoError(t, ParseCounters(readTestdata(t, "nv_show_interface_nvl2_counters.json"), &pc))
+
+	assert.Equal(t, uint64(112233445566), pc.Counters["port-rcv-data"])
+	assert.Equal(t, uint64(7), pc.Counters["link-symbol-error-counter"])
+	assert.NotContains(t, pc.Counters, "state", "non-numeric values are ignored")
+}
+
+func TestApplyPrevious(t *testing.T) {
+	testCases := map[string]struct {
+		prev      *PortCounters
+		cur       PortCounters
+		wantFlaps uint64
+		wantErrs  uint64
+	}{
+		"first sample": {
+			prev:      nil,
+			cur:       PortCounters{LinkState: LinkStateUp, LinkDowned: 5, SymbolErrors: 100},
+			wantFlaps: 0,
+			wantErrs:  0,
+		},
+		"steady": {
+			prev: &PortCounters{LinkState: LinkStateUp, LinkDowned: 5, SymbolErrors: 100},
+			cur:  PortCounters{LinkState: LinkStateUp, LinkDowned: 5, SymbolErrors: 100},
+		},
+		"link downed counter moved": {
+			prev:      &PortCounters{LinkState: LinkStateUp, LinkDowned: 5},
+			cur:       PortCounters{LinkState: LinkStateUp, LinkDowned: 7},
+			wantFlaps: 2,
+		},
+		"went down without counter": {
+			prev:      &PortCounters{LinkState: LinkStateUp},
+			cur:       PortCounters{LinkState: LinkStateDown},
+			wantFlaps: 1,
+		},
+		"errors increased": {
+			prev:     &PortCounters{LinkState: LinkStateUp, SymbolErrors: 10, RxErrors: 1},
+			cur:      PortCounters{LinkState: LinkStateUp, SymbolErrors: 25, RxErrors: 3},
+			wantErrs: 17,
+		},
+		"counters reset after reboot": {
+			prev:      &PortCounters{LinkState: LinkStateUp, LinkDowned: 9, SymbolErrors: 500},
+			cur:       PortCounters{LinkState: LinkStateUp, LinkDowned: 1, SymbolErrors: 4},
+			wantFlaps: 1,
+			wantErrs:  4,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cur := tc.cur
+			cur.ApplyPrevious(tc.prev)
+			assert.Equal(t, tc.wantFlaps, cur.LinkFlaps)
+			assert.Equal(t, tc.wantErrs, cur.NewErrors)
+		})
+	}
+}
+
+func TestValidPortName(t *testing.T) {
+	assert.True(t, ValidPortName("nvl1"))
+	assert.True(t, ValidPortName("nvl1/1"))
+	assert.False(t, ValidPortName("nvl1; reboot"))
+	assert.False(t, ValidPortName("$(id)"))
+	assert.False(t, ValidPortName(""))
+}
@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/redfish"
+
+	"github.com/stmcginnis/gofish/common"
+	gofish "github.com/stmcginnis/gofish/redfish"
+)
+
+// collectSensors reads fan, PSU and thermal sensors from every chassis exposed by the BMC.
+// Chassis without Thermal or Power resources are skipped; an error is only returned when
+// no chassis yields any sensor.
+func collectSensors(rf *redfish.RedfishClient) ([]SensorReading, error) {
+	chassis, err := rf.Service.Chassis()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chassis: %w", err)
+	}
+
+	var sensors []SensorReading
+	var errs []error
+	for _, c := range chassis {
+		thermal, err := c.Thermal()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("chassis %s thermal: %w", c.ID, err))
+		} else if thermal != nil {
+			sensors = append(sensors, sensorsFromThermal(c.ID, thermal)...)
+		}
+
+		power, err := c.Power()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("chassis %s power: %w", c.ID, err))
+		} else if power != nil {
+			sensors = append(sensors, sensorsFromPower(c.ID, power)...)
+		}
+	}
+
+	if len(sensors) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return sensors, nil
+}
+
+// sensorsFromThermal converts the fans and temperatures of a Redfish Thermal resource.
+func sensorsFromThermal(chassisID string, thermal *gofish.Thermal) []SensorReading {
+	sensors := make([]SensorReading, 0, len(thermal.Fans)+len(thermal.Temperatures))
+
+	for _, fan := range thermal.Fans {
+		sensors = append(sensors, SensorReading{
+			Kind:    SensorFan,
+			Name:    sensorName(chassisID, fan.Name, fan.MemberID),
+			Reading: float64(fan.Reading),
+			Units:   string(fan.ReadingUnits),
+			Health:  statusHealth(fan.Status, true),
+			State:   string(fan.Status.State),
+		})
+	}
+
+	for _, temp := range thermal.Temperatures {
+		if temp.Status.State == common.AbsentState {
+			continue
+		}
+
+		reading := SensorReading{
+			Kind:                   SensorTemperature,
+			Name:                   sensorName(chassisID, temp.Name, temp.MemberID),
+			Reading:                float64(temp.ReadingCelsius),
+			Units:                  "Cel",
+			UpperThresholdCritical: float64(temp.UpperThresholdCritical),
+			Health:                 statusHealth(temp.Status, false),
+			State:                  string(temp.Status.State),
+		}
+
+		// Do not rely on the BMC alone to flag an over-temperature condition.
+		if temp.UpperThresholdCritical > 0 && temp.ReadingCelsius >= temp.UpperThresholdCritical {
+			reading.Health = reading.Health.Worse(HealthCritical)
+		} else if temp.UpperThresholdNonCritical > 0 && temp.ReadingCelsius >= temp.UpperThresholdNonCritical {
+			reading.Health = reading.Health.Worse(HealthWarning)
+		}
+
+		sensors = append(sensors, reading)
+	}
+
+	return sensors
+}
+
+// sensorsFromPower converts the power supplies of a Redfish Power resource.
+func sensorsFromPower(chassisID string, power *gofish.Power) []SensorReading {
+	sensors := make([]SensorReading, 0, len(power.PowerSupplies))
+
+	for _, psu := range power.PowerSupplies {
+		watts := psu.PowerOutputWatts
+		if watts == 0 {
+			watts = psu.LastPowerOutputWatts
+		}
+
+		sensors = append(sensors, SensorReading{
+			Kind:    SensorPSU,
+			Name:    sensorName(chassisID, psu.Name, psu.MemberID),
+			Reading: float64(watts),
+			Units:   "W",
+			Health:  statusHealth(psu.Status, true),
+			State:   string(psu.Status.State),
+		})
+	}
+
+	return sensors
+}
+
+func sensorName(chassisID, name, memberID string) string {
+	if name == "" {
+		name = memberID
+	}
+	return chassisID + "/" + name
+}
+
+// statusHealth maps a Redfish status to a HealthStatus. A missing fan or PSU is a warning
+// because the tray has lost redundancy.
+func statusHealth(status common.Status, absentIsWarning bool) HealthStatus {
+	if status.State == common.AbsentState {
+		if absentIsWarning {
+			return HealthWarning
+		}
+		return HealthUnknown
+	}
+
+	switch status.Health {
+	case common.OKHealth:
+		return HealthOK
+	case common.WarningHealth:
+		return HealthWarning
+	case common.CriticalHealth:
+		return HealthCritical
+	default:
+		return HealthUnknown
+	}
+}
@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"encoding/json"
+	"testing"
+
+	gofish "github.com/stmcginnis/gofish/redfish"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensorsFromThermal(t *testing.T) {
+	var thermal gofish.Thermal
+	require.NoError(t, json.Unmarshal([]byte(readTestdata(t, "redfish_thermal.json")), &thermal))
+
+	sensors := sensorsFromThermal("MGX_NVSwitch_0", &thermal)
+	byName := make(map[string]SensorReading, len(sensors))
+	for _, s := range sensors {
+		byName[s.Name] = s
+	}
+
+	require.Len(t, sensors, 5, "absent temperature sensors are skipped, absent fans are kept")
+
+	assert.Equal(t, SensorFan, byName["MGX_NVSwitch_0/FAN_0"].Kind)
+	assert.Equal(t, 14520.0, byName["MGX_NVSwitch_0/FAN_0"].Reading)
+	assert.Equal(t, "RPM", byName["MGX_NVSwitch_0/FAN_0"].Units)
+	assert.Equal(t, HealthOK, byName["MGX_NVSwitch_0/FAN_0"].Health)
+	assert.Equal(t, HealthCritical, byName["MGX_NVSwitch_0/FAN_1"].Health)
+	assert.Equal(t, HealthWarning, byName["MGX_NVSwitch_0/FAN_2"].Health, "a missing fan loses redundancy")
+
+	assert.Equal(t, HealthOK, byName["MGX_NVSwitch_0/NVSwitch_0_Temp"].Health)
+	assert.Equal(t, HealthWarning, byName["MGX_NVSwitch_0/NVSwitch_1_Temp"].Health,
+		"a reading above the non-critical threshold is a warning even if the BMC reports OK")
+	assert.Equal(t, 95.0, byName["MGX_NVSwitch_0/NVSwitch_1_Temp"].UpperThresholdCritical)
+}
+
+func TestSensorsFromPower(t *testing.T) {
+	var power gofish.Power
+	require.NoError(t, json.Unmarshal([]byte(readTestdata(t, "redfish_power.json")), &power))
+
+	sensors := sensorsFromPower("MGX_NVSwitch_0", &power)
+	require.Len(t, sensors, 2)
+
+	assert.Equal(t, SensorReading{
+		Kind:    SensorPSU,
+		Name:    "MGX_NVSwitch_0/PSU_0",
+		Reading: 512.5,
+		Units:   "W",
+		Health:  HealthOK,
+		State:   "Enabled",
+	}, sensors[0])
+
+	assert.Equal(t, "MGX_NVSwitch_0/1", sensors[1].Name, "unnamed PSUs fall back to their member ID")
+	assert.Equal(t, 498.0, sensors[1].Reading)
+	assert.Equal(t, HealthWarning, sensors[1].Health)
+}
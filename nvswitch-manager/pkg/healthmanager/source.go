@@ -0,0 +1,106 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/objects/nvswitch"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/redfish"
+	"github.com/nvidia/bare-metal-manager-rest/nvswitch-manager/pkg/sshclient"
+)
+
+// source reads raw health data from a switch. deviceSource talks to the BMC over Redfish
+// and to NVOS over SSH; tests substitute a fake.
+type source interface {
+	// Sensors returns the fan, PSU and thermal sensors of the tray.
+	Sensors(ctx context.Context, tray *nvswitch.NVSwitchTray) ([]SensorReading, error)
+
+	// Ports returns the NVLink ports of the tray with their counters. Ports whose counters
+	// could not be read are left out and reported in portErrs; err is set when NVOS could
+	// not be queried at all.
+	Ports(ctx context.Context, tray *nvswitch.NVSwitchTray) (ports []*PortCounters, portErrs []error, err error)
+}
+
+// deviceSource collects health data from the switch itself.
+type deviceSource struct{}
+
+// Sensors reads sensors from the BMC via Redfish.
+func (deviceSource) Sensors(ctx context.Context, tray *nvswitch.NVSwitchTray) ([]SensorReading, error) {
+	client, err := redfish.New(ctx, tray.BMC, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Redfish client: %w", err)
+	}
+	defer client.Logout()
+
+	return collectSensors(client)
+}
+
+// Ports reads NVLink port state and counters from NVOS over SSH.
+func (deviceSource) Ports(ctx context.Context, tray *nvswitch.NVSwitchTray) ([]*PortCounters, []error, error) {
+	client, err := sshclient.New(ctx, tray.NVOS)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create SSH client: %w", err)
+	}
+	defer client.Close()
+
+	output, err := client.RunCommand(nvShowInterfacesCmd)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list interfaces: %w", err)
+	}
+
+	nvlPorts, err := ParseInterfaces(output)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ports []*PortCounters
+	var portErrs []error
+	for _, p := range nvlPorts {
+		if ctx.Err() != nil {
+			return ports, portErrs, ctx.Err()
+		}
+
+		if !ValidPortName(p.Name) {
+			portErrs = append(portErrs, fmt.Errorf("port %q: invalid interface name", p.Name))
+			continue
+		}
+
+		pc := &PortCounters{
+			SwitchUUID: tray.UUID,
+			Port:       p.Name,
+			LinkState:  p.LinkState,
+			Speed:      p.Speed,
+		}
+
+		output, err := client.RunCommand(fmt.Sprintf(nvShowCountersCmd, p.Name))
+		if err != nil {
+			portErrs = append(portErrs, fmt.Errorf("port %s: failed to read counters: %w", p.Name, err))
+			continue
+		}
+		if err := ParseCounters(output, pc); err != nil {
+			portErrs = append(portErrs, fmt.Errorf("port %s: %w", p.Name, err))
+			continue
+		}
+
+		ports = append(ports, pc)
+	}
+
+	return ports, portErrs, nil
+}
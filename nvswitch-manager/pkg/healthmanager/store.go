@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrNoSample is returned when no health sample has been collected for a switch yet.
+var ErrNoSample = errors.New("no health sample collected")
+
+// HealthStore persists switch health and NVLink port counter samples.
+type HealthStore interface {
+	// Save persists one collection round for a switch: the health summary and its port samples.
+	Save(ctx context.Context, health *SwitchHealth, ports []*PortCounters) error
+
+	// LatestHealth returns the most recent health sample for a switch, or ErrNoSample.
+	LatestHealth(ctx context.Context, switchUUID uuid.UUID) (*SwitchHealth, error)
+
+	// LatestPortCounters returns the most recent sample of every port of a switch, ordered by port.
+	LatestPortCounters(ctx context.Context, switchUUID uuid.UUID) ([]*PortCounters, error)
+
+	// PortCounterHistory returns the samples of a switch collected at or after since, oldest first.
+	// An empty port returns the samples of every port.
+	PortCounterHistory(ctx context.Context, switchUUID uuid.UUID, port string, since time.Time) ([]*PortCounters, error)
+
+	// ClaimCollector makes owner the collecting instance for leaseDuration, so that only one
+	// replica polls the switches at a time. It succeeds if the lease is free, expired or
+	// already held by owner, and returns false if another instance holds it.
+	ClaimCollector(ctx context.Context, owner string, leaseDuration time.Duration) (bool, error)
+
+	// Prune deletes health and port samples collected before the cutoff and returns how many were deleted.
+	Prune(ctx context.Context, before time.Time) (int, error)
+}
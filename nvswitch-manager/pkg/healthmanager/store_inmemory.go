@@ -0,0 +1,185 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ensure InMemoryHealthStore implements HealthStore.
+var _ HealthStore = (*InMemoryHealthStore)(nil)
+
+// InMemoryHealthStore implements HealthStore in memory. Samples are lost on restart.
+type InMemoryHealthStore struct {
+	mu     sync.RWMutex
+	health map[uuid.UUID][]*SwitchHealth
+	ports  map[uuid.UUID][]*PortCounters
+
+	leaseOwner     string
+	leaseExpiresAt time.Time
+	now            func() time.Time
+}
+
+// NewInMemoryHealthStore creates a new in-memory health store.
+func NewInMemoryHealthStore() *InMemoryHealthStore {
+	return &InMemoryHealthStore{
+		health: make(map[uuid.UUID][]*SwitchHealth),
+		ports:  make(map[uuid.UUID][]*PortCounters),
+		now:    time.Now,
+	}
+}
+
+// Save appends a collection round for a switch.
+func (s *InMemoryHealthStore) Save(ctx context.Context, health *SwitchHealth, ports []*PortCounters) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h := *health
+	s.health[health.SwitchUUID] = append(s.health[health.SwitchUUID], &h)
+
+	for _, p := range ports {
+		pc := *p
+		s.ports[p.SwitchUUID] = append(s.ports[p.SwitchUUID], &pc)
+	}
+
+	return nil
+}
+
+// LatestHealth returns the most recent health sample for a switch.
+func (s *InMemoryHealthStore) LatestHealth(ctx context.Context, switchUUID uuid.UUID) (*SwitchHealth, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var latest *SwitchHealth
+	for _, h := range s.health[switchUUID] {
+		if latest == nil || !h.CollectedAt.Before(latest.CollectedAt) {
+			latest = h
+		}
+	}
+	if latest == nil {
+		return nil, ErrNoSample
+	}
+
+	h := *latest
+	return &h, nil
+}
+
+// LatestPortCounters returns the most recent sample of every port of a switch.
+func (s *InMemoryHealthStore) LatestPortCounters(ctx context.Context, switchUUID uuid.UUID) ([]*PortCounters, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	latest := make(map[string]*PortCounters)
+	for _, p := range s.ports[switchUUID] {
+		if cur, ok := latest[p.Port]; !ok || !p.CollectedAt.Before(cur.CollectedAt) {
+			latest[p.Port] = p
+		}
+	}
+
+	result := make([]*PortCounters, 0, len(latest))
+	for _, p := range latest {
+		pc := *p
+		result = append(result, &pc)
+	}
+	sortPorts(result)
+
+	return result, nil
+}
+
+// PortCounterHistory returns the samples of a switch collected at or after since, oldest first.
+func (s *InMemoryHealthStore) PortCounterHistory(ctx context.Context, switchUUID uuid.UUID, port string, since time.Time) ([]*PortCounters, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var result []*PortCounters
+	for _, p := range s.ports[switchUUID] {
+		if (port == "" || p.Port == port) && !p.CollectedAt.Before(since) {
+			pc := *p
+			result = append(result, &pc)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].CollectedAt.Equal(result[j].CollectedAt) {
+			return result[i].CollectedAt.Before(result[j].CollectedAt)
+		}
+		return portLess(result[i].Port, result[j].Port)
+	})
+
+	return result, nil
+}
+
+// ClaimCollector makes owner the collecting instance if the lease is free, expired or already held by owner.
+func (s *InMemoryHealthStore) ClaimCollector(ctx context.Context, owner string, leaseDuration time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.leaseOwner != "" && s.leaseOwner != owner && now.Before(s.leaseExpiresAt) {
+		return false, nil
+	}
+
+	s.leaseOwner = owner
+	s.leaseExpiresAt = now.Add(leaseDuration)
+	return true, nil
+}
+
+// Prune deletes samples collected before the cutoff.
+func (s *InMemoryHealthStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deleted := 0
+	for id, samples := range s.health {
+		kept := samples[:0]
+		for _, h := range samples {
+			if h.CollectedAt.Before(before) {
+				deleted++
+				continue
+			}
+			kept = append(kept, h)
+		}
+		if len(kept) == 0 {
+			delete(s.health, id)
+		} else {
+			s.health[id] = kept
+		}
+	}
+
+	for id, samples := range s.ports {
+		kept := samples[:0]
+		for _, p := range samples {
+			if p.CollectedAt.Before(before) {
+				deleted++
+				continue
+			}
+			kept = append(kept, p)
+		}
+		if len(kept) == 0 {
+			delete(s.ports, id)
+		} else {
+			s.ports[id] = kept
+		}
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,274 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// Ensure PostgresHealthStore implements HealthStore.
+var _ HealthStore = (*PostgresHealthStore)(nil)
+
+// PostgresHealthStore implements HealthStore using PostgreSQL.
+type PostgresHealthStore struct {
+	db *bun.DB
+}
+
+// NewPostgresHealthStore creates a new PostgreSQL-backed health store.
+func NewPostgresHealthStore(db *bun.DB) *PostgresHealthStore {
+	return &PostgresHealthStore{db: db}
+}
+
+// SwitchHealthModel is the database model for switch health samples.
+// This maps to the switch_health table.
+type SwitchHealthModel struct {
+	bun.BaseModel `bun:"table:switch_health,alias:sh"`
+
+	ID          int64           `bun:"id,pk,autoincrement"`
+	SwitchUUID  uuid.UUID       `bun:"switch_uuid,notnull,type:uuid"`
+	CollectedAt time.Time       `bun:"collected_at,notnull"`
+	Status      HealthStatus    `bun:"status,notnull"`
+	Sensors     []SensorReading `bun:"sensors,type:jsonb"`
+	PortsTotal  int             `bun:"ports_total,notnull"`
+	PortsDown   int             `bun:"ports_down,notnull"`
+	Errors      []string        `bun:"errors,type:jsonb"`
+}
+
+// NVLinkPortSampleModel is the database model for NVLink port counter samples.
+// This maps to the nvlink_port_sample table.
+type NVLinkPortSampleModel struct {
+	bun.BaseModel `bun:"table:nvlink_port_sample,alias:nps"`
+
+	ID                  int64             `bun:"id,pk,autoincrement"`
+	SwitchUUID          uuid.UUID         `bun:"switch_uuid,notnull,type:uuid"`
+	Port                string            `bun:"port,notnull"`
+	CollectedAt         time.Time         `bun:"collected_at,notnull"`
+	LinkState           string            `bun:"link_state,notnull"`
+	Speed               string            `bun:"speed"`
+	SymbolErrors        int64             `bun:"symbol_errors,notnull"`
+	RxErrors            int64             `bun:"rx_errors,notnull"`
+	TxDiscards          int64             `bun:"tx_discards,notnull"`
+	LinkErrorRecoveries int64             `bun:"link_error_recoveries,notnull"`
+	LinkDowned          int64             `bun:"link_downed,notnull"`
+	Counters            map[string]uint64 `bun:"counters,type:jsonb"`
+	LinkFlaps           int64             `bun:"link_flaps,notnull"`
+	NewErrors           int64             `bun:"new_errors,notnull"`
+}
+
+// toPortModel converts PortCounters to its database model.
+// Counters are stored as BIGINT; device counters never approach the signed range.
+func toPortModel(p *PortCounters) *NVLinkPortSampleModel {
+	return &NVLinkPortSampleModel{
+		SwitchUUID:          p.SwitchUUID,
+		Port:                p.Port,
+		CollectedAt:         p.CollectedAt,
+		LinkState:           p.LinkState,
+		Speed:               p.Speed,
+		SymbolErrors:        int64(p.SymbolErrors),
+		RxErrors:            int64(p.RxErrors),
+		TxDiscards:          int64(p.TxDiscards),
+		LinkErrorRecoveries: int64(p.LinkErrorRecoveries),
+		LinkDowned:          int64(p.LinkDowned),
+		Counters:            p.Counters,
+		LinkFlaps:           int64(p.LinkFlaps),
+		NewErrors:           int64(p.NewErrors),
+	}
+}
+
+// fromPortModel converts a database model to PortCounters.
+func fromPortModel(m *NVLinkPortSampleModel) *PortCounters {
+	return &PortCounters{
+		SwitchUUID:          m.SwitchUUID,
+		Port:                m.Port,
+		CollectedAt:         m.CollectedAt,
+		LinkState:           m.LinkState,
+		Speed:               m.Speed,
+		SymbolErrors:        uint64(m.SymbolErrors),
+		RxErrors:            uint64(m.RxErrors),
+		TxDiscards:          uint64(m.TxDiscards),
+		LinkErrorRecoveries: uint64(m.LinkErrorRecoveries),
+		LinkDowned:          uint64(m.LinkDowned),
+		Counters:            m.Counters,
+		LinkFlaps:           uint64(m.LinkFlaps),
+		NewErrors:           uint64(m.NewErrors),
+	}
+}
+
+// Save persists one collection round for a switch in a single transaction.
+func (s *PostgresHealthStore) Save(ctx context.Context, health *SwitchHealth, ports []*PortCounters) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		model := &SwitchHealthModel{
+			SwitchUUID:  health.SwitchUUID,
+			CollectedAt: health.CollectedAt,
+			Status:      health.Status,
+			Sensors:     health.Sensors,
+			PortsTotal:  health.PortsTotal,
+			PortsDown:   health.PortsDown,
+			Errors:      health.Errors,
+		}
+		if _, err := tx.NewInsert().Model(model).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save switch health: %w", err)
+		}
+
+		if len(ports) == 0 {
+			return nil
+		}
+
+		models := make([]*NVLinkPortSampleModel, 0, len(ports))
+		for _, p := range ports {
+			models = append(models, toPortModel(p))
+		}
+		if _, err := tx.NewInsert().Model(&models).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to save port counters: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// LatestHealth returns the most recent health sample for a switch.
+func (s *PostgresHealthStore) LatestHealth(ctx context.Context, switchUUID uuid.UUID) (*SwitchHealth, error) {
+	var model SwitchHealthModel
+	err := s.db.NewSelect().
+		Model(&model).
+		Where("switch_uuid = ?", switchUUID).
+		Order("collected_at DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNoSample
+		}
+		return nil, fmt.Errorf("failed to get switch health: %w", err)
+	}
+
+	return &SwitchHealth{
+		SwitchUUID:  model.SwitchUUID,
+		CollectedAt: model.CollectedAt,
+		Status:      model.Status,
+		Sensors:     model.Sensors,
+		PortsTotal:  model.PortsTotal,
+		PortsDown:   model.PortsDown,
+		Errors:      model.Errors,
+	}, nil
+}
+
+// LatestPortCounters returns the most recent sample of every port of a switch.
+func (s *PostgresHealthStore) LatestPortCounters(ctx context.Context, switchUUID uuid.UUID) ([]*PortCounters, error) {
+	var models []*NVLinkPortSampleModel
+	err := s.db.NewSelect().
+		Model(&models).
+		DistinctOn("port").
+		Where("switch_uuid = ?", switchUUID).
+		Order("port ASC", "collected_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest port counters: %w", err)
+	}
+
+	result := make([]*PortCounters, 0, len(models))
+	for _, m := range models {
+		result = append(result, fromPortModel(m))
+	}
+	sortPorts(result)
+
+	return result, nil
+}
+
+// PortCounterHistory returns the samples of a switch collected at or after since, oldest first.
+func (s *PostgresHealthStore) PortCounterHistory(ctx context.Context, switchUUID uuid.UUID, port string, since time.Time) ([]*PortCounters, error) {
+	var models []*NVLinkPortSampleModel
+	query := s.db.NewSelect().
+		Model(&models).
+		Where("switch_uuid = ?", switchUUID).
+		Where("collected_at >= ?", since)
+	if port != "" {
+		query = query.Where("port = ?", port)
+	}
+
+	if err := query.Order("collected_at ASC", "port ASC").Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get port counter history: %w", err)
+	}
+
+	result := make([]*PortCounters, 0, len(models))
+	for _, m := range models {
+		result = append(result, fromPortModel(m))
+	}
+
+	return result, nil
+}
+
+// ClaimCollector makes owner the collecting instance if the lease is free, expired or already held by owner.
+// The lease is a single row in health_collector_lease that is upserted only when the condition holds.
+func (s *PostgresHealthStore) ClaimCollector(ctx context.Context, owner string, leaseDuration time.Duration) (bool, error) {
+	res, err := s.db.NewRaw(`
+		INSERT INTO health_collector_lease (id, owner, expires_at)
+		VALUES (1, ?, now() + ? * interval '1 millisecond')
+		ON CONFLICT (id) DO UPDATE
+		SET owner = EXCLUDED.owner, expires_at = EXCLUDED.expires_at
+		WHERE health_collector_lease.owner = EXCLUDED.owner
+		   OR health_collector_lease.expires_at < now()`,
+		owner, leaseDuration.Milliseconds(),
+	).Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to claim health collector lease: %w", err)
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to claim health collector lease: %w", err)
+	}
+
+	return n > 0, nil
+}
+
+// Prune deletes samples collected before the cutoff.
+func (s *PostgresHealthStore) Prune(ctx context.Context, before time.Time) (int, error) {
+	deleted := 0
+
+	res, err := s.db.NewDelete().
+		Model((*SwitchHealthModel)(nil)).
+		Where("collected_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune switch health: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil {
+		deleted += int(n)
+	}
+
+	res, err = s.db.NewDelete().
+		Model((*NVLinkPortSampleModel)(nil)).
+		Where("collected_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return deleted, fmt.Errorf("failed to prune port counters: %w", err)
+	}
+	if n, err := res.RowsAffected(); err == nil {
+		deleted += int(n)
+	}
+
+	return deleted, nil
+}
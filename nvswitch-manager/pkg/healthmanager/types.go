@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package healthmanager
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HealthStatus is the rolled-up health of a switch or one of its sensors.
+type HealthStatus string
+
+const (
+	HealthUnknown  HealthStatus = "UNKNOWN"
+	HealthOK       HealthStatus = "OK"
+	HealthWarning  HealthStatus = "WARNING"
+	HealthCritical HealthStatus = "CRITICAL"
+)
+
+// severity orders health statuses so the worst one can be picked.
+func (h HealthStatus) severity() int {
+	switch h {
+	case HealthOK:
+		return 1
+	case HealthWarning:
+		return 2
+	case HealthCritical:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Worse returns whichever of h and other is more severe.
+func (h HealthStatus) Worse(other HealthStatus) HealthStatus {
+	if other.severity() > h.severity() {
+		return other
+	}
+	return h
+}
+
+// SensorKind identifies the type of a Redfish sensor.
+type SensorKind string
+
+const (
+	SensorFan         SensorKind = "FAN"
+	SensorPSU         SensorKind = "PSU"
+	SensorTemperature SensorKind = "TEMPERATURE"
+)
+
+// SensorReading is a single fan, PSU or thermal sensor reported by the BMC.
+type SensorReading struct {
+	Kind                   SensorKind   `json:"kind"`
+	Name                   string       `json:"name"`
+	Reading                float64      `json:"reading"`
+	Units                  string       `json:"units"`
+	UpperThresholdCritical float64      `json:"upper_threshold_critical,omitempty"`
+	Health                 HealthStatus `json:"health"`
+	State                  string       `json:"state"`
+}
+
+// Link states reported for NVLink ports.
+const (
+	LinkStateUp   = "up"
+	LinkStateDown = "down"
+)
+
+// PortCounters is a sample of one NVLink port's state and error counters.
+// Counter fields hold the raw, monotonically increasing values read from NVOS;
+// LinkFlaps and NewErrors are derived by comparing against the previous sample.
+type PortCounters struct {
+	SwitchUUID  uuid.UUID `json:"switch_uuid"`
+	Port        string    `json:"port"`
+	CollectedAt time.Time `json:"collected_at"`
+
+	LinkState string `json:"link_state"`
+	Speed     string `json:"speed,omitempty"`
+
+	SymbolErrors        uint64 `json:"symbol_errors"`
+	RxErrors            uint64 `json:"rx_errors"`
+	TxDiscards          uint64 `json:"tx_discards"`
+	LinkErrorRecoveries uint64 `json:"link_error_recoveries"`
+	LinkDowned          uint64 `json:"link_downed"`
+
+	// Counters holds every numeric counter NVOS reported, including the ones above.
+	Counters map[string]uint64 `json:"counters,omitempty"`
+
+	// LinkFlaps is the number of times the link went down since the previous sample.
+	LinkFlaps uint64 `json:"link_flaps"`
+
+	// NewErrors is the increase of the error counters since the previous sample.
+	NewErrors uint64 `json:"new_errors"`
+}
+
+// IsUp reports whether the port link is up.
+func (p *PortCounters) IsUp() bool {
+	return p.LinkState == LinkStateUp
+}
+
+// errorTotal sums the error counters used to detect a degrading link.
+func (p *PortCounters) errorTotal() uint64 {
+	return p.SymbolErrors + p.RxErrors + p.TxDiscards + p.LinkErrorRecoveries
+}
+
+// Status returns the health of the port: a down link or a flapping or erroring link is a warning.
+func (p *PortCounters) Status() HealthStatus {
+	if !p.IsUp() || p.LinkFlaps > 0 || p.NewErrors > 0 {
+		return HealthWarning
+	}
+	return HealthOK
+}
+
+// SwitchHealth is one health sample for a switch.
+type SwitchHealth struct {
+	SwitchUUID  uuid.UUID       `json:"switch_uuid"`
+	CollectedAt time.Time       `json:"collected_at"`
+	Status      HealthStatus    `json:"status"`
+	Sensors     []SensorReading `json:"sensors"`
+
+	// PortsTotal and PortsDown summarize the NVLink ports collected with this sample.
+	PortsTotal int `json:"ports_total"`
+	PortsDown  int `json:"ports_down"`
+
+	// Errors lists collection failures, e.g. an unreachable BMC or NVOS.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// sortPorts orders port samples naturally by port name (nvl2 before nvl10).
+func sortPorts(ports []*PortCounters) {
+	sort.Slice(ports, func(i, j int) bool { return portLess(ports[i].Port, ports[j].Port) })
+}
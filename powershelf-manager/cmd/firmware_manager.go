@@ -26,6 +26,7 @@ import (
 	svc "github.com/nvidia/bare-metal-manager-rest/powershelf-manager/internal/service"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/credentials"
+	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/firmwaremanager"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/objects/powershelf"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/powershelfmanager"
@@ -83,6 +84,7 @@ func init() {
 	fwCmd.Flags().StringVarP(&pmcUsername, "user", "u", "root", "Username")
 	fwCmd.Flags().StringVarP(&pmcPassword, "pass", "p", "0penBmc", "Password")
 	fwCmd.Flags().StringVar(&versionTo, "version", "0penBmc", "Target Version to upgrade to")
+	addFirmwareVerificationFlags(fwCmd)
 }
 
 func doFw() {
@@ -120,6 +122,10 @@ func doFw() {
 			Credential:        credential.New(dbUser, dbPassword),
 			CACertificatePath: "",
 		},
+		FirmwareConf: firmwaremanager.Config{
+			TrustedKeyPaths:   firmwareTrustedKeys,
+			RequireSignatures: firmwareRequireSig,
+		},
 	}
 
 	psmConfig, err := svcConfig.ToPsmConf()
@@ -156,7 +162,7 @@ func doFw() {
 		fmt.Printf("%v\n", supported)
 	case Upgrade:
 		fmt.Printf("Upgrading fw for %v (ip %s user: %s password: %s)\n", vendor, pmcIP, pmcUsername, pmcPassword)
-		err := fw_manager.Upgrade(context.Background(), pmc, powershelf.PMC, versionTo)
+		err := fw_manager.Upgrade(context.Background(), pmc, powershelf.PMC, versionTo, "")
 		if err != nil {
 			log.Fatalf("failed to upgrade fw for %v: %v\n", vendor, err)
 		}
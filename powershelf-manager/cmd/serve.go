@@ -22,6 +22,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 
 	log "github.com/sirupsen/logrus"
@@ -32,6 +33,7 @@ import (
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	svc "github.com/nvidia/bare-metal-manager-rest/powershelf-manager/internal/service"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/credentials"
+	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/firmwaremanager"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/powershelfmanager"
 )
 
@@ -53,6 +55,24 @@ func getEnvIntOrDefault(envVar string, defaultVal int) int {
 	return defaultVal
 }
 
+// getEnvBoolOrDefault returns the bool value of an environment variable or a default value.
+func getEnvBoolOrDefault(envVar string, defaultVal bool) bool {
+	if val := os.Getenv(envVar); val != "" {
+		if boolVal, err := strconv.ParseBool(val); err == nil {
+			return boolVal
+		}
+	}
+	return defaultVal
+}
+
+// getEnvListOrDefault returns the comma-separated values of an environment variable or a default value.
+func getEnvListOrDefault(envVar string, defaultVal []string) []string {
+	if val := os.Getenv(envVar); val != "" {
+		return strings.Split(val, ",")
+	}
+	return defaultVal
+}
+
 const (
 	// default service config
 	defaultServicePort   = 50051
@@ -69,6 +89,10 @@ const (
 	// default vault config
 	defaultVaultToken   = "psmvaultroot"
 	defaultVaultAddress = "http://127.0.0.1:8201"
+
+	// default RLA config (empty host disables maintenance window checks)
+	defaultRLAHost = ""
+	defaultRLAPort = 50051
 )
 
 var (
@@ -87,6 +111,14 @@ var (
 	// Vault config
 	vaultToken   string
 	vaultAddress string
+
+	// Firmware config
+	firmwareTrustedKeys []string
+	firmwareRequireSig  bool
+
+	// RLA config
+	rlaHost string
+	rlaPort int
 )
 
 // serveCmd represents the serve command
@@ -117,6 +149,17 @@ func init() {
 
 	serveCmd.Flags().StringVarP(&vaultToken, "vault_token", "t", getEnvOrDefault("VAULT_TOKEN", defaultVaultToken), "Vault Token (env: VAULT_TOKEN)")
 	serveCmd.Flags().StringVarP(&vaultAddress, "vault_address", "a", getEnvOrDefault("VAULT_ADDR", defaultVaultAddress), "Vault Address (env: VAULT_ADDR)")
+
+	serveCmd.Flags().StringVar(&rlaHost, "rla_host", getEnvOrDefault("RLA_HOST", defaultRLAHost), "RLA host whose maintenance windows gate firmware updates, empty disables the check (env: RLA_HOST)")
+	serveCmd.Flags().IntVar(&rlaPort, "rla_port", getEnvIntOrDefault("RLA_PORT", defaultRLAPort), "RLA gRPC port (env: RLA_PORT)")
+
+	addFirmwareVerificationFlags(serveCmd)
+}
+
+// addFirmwareVerificationFlags registers the flags controlling firmware signature verification.
+func addFirmwareVerificationFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSliceVar(&firmwareTrustedKeys, "fw_trusted_keys", getEnvListOrDefault("FW_TRUSTED_KEYS", nil), "Public keys (PEM or minisign) trusted to sign firmware, comma-separated (env: FW_TRUSTED_KEYS)")
+	cmd.Flags().BoolVar(&firmwareRequireSig, "fw_require_signature", getEnvBoolOrDefault("FW_REQUIRE_SIGNATURE", false), "Reject firmware without a valid signature from a trusted key (env: FW_REQUIRE_SIGNATURE)")
 }
 
 func doServe() {
@@ -137,6 +180,14 @@ func doServe() {
 				Credential:        credential.New(dbUser, dbPassword),
 				CACertificatePath: dbCertPath,
 			},
+			FirmwareConf: firmwaremanager.Config{
+				TrustedKeyPaths:   firmwareTrustedKeys,
+				RequireSignatures: firmwareRequireSig,
+			},
+			RLAConf: svc.RLAConfig{
+				Host: rlaHost,
+				Port: rlaPort,
+			},
 		},
 	)
 
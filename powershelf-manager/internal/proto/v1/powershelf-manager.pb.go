@@ -1364,10 +1364,11 @@ func (x *UpdatePowershelfFirmwareRequest) GetComponents() []*UpdateComponentFirm
 }
 
 type UpdateFirmwareRequest struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Upgrades      []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState             `protogen:"open.v1"`
+	Upgrades                  []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	MaintenanceOverrideReason string                             `protobuf:"bytes,2,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *UpdateFirmwareRequest) Reset() {
@@ -1407,6 +1408,13 @@ func (x *UpdateFirmwareRequest) GetUpgrades() []*UpdatePowershelfFirmwareRequest
 	return nil
 }
 
+func (x *UpdateFirmwareRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 type UpdateComponentFirmwareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Component     PowershelfComponent    `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
@@ -1651,17 +1659,71 @@ func (x *FirmwareVersion) GetVersion() string {
 	return ""
 }
 
+// RejectedFirmware is a firmware upgrade whose artifact failed checksum or signature verification.
+type RejectedFirmware struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *FirmwareVersion       `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedFirmware) Reset() {
+	*x = RejectedFirmware{}
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedFirmware) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedFirmware) ProtoMessage() {}
+
+func (x *RejectedFirmware) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedFirmware.ProtoReflect.Descriptor instead.
+func (*RejectedFirmware) Descriptor() ([]byte, []int) {
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RejectedFirmware) GetVersion() *FirmwareVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+func (x *RejectedFirmware) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 type ComponentFirmwareUpgrades struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Component     PowershelfComponent    `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
 	Upgrades      []*FirmwareVersion     `protobuf:"bytes,2,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	Rejected      []*RejectedFirmware    `protobuf:"bytes,3,rep,name=rejected,proto3" json:"rejected,omitempty"` // Upgrades that cannot be used because verification failed
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ComponentFirmwareUpgrades) Reset() {
 	*x = ComponentFirmwareUpgrades{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1673,7 +1735,7 @@ func (x *ComponentFirmwareUpgrades) String() string {
 func (*ComponentFirmwareUpgrades) ProtoMessage() {}
 
 func (x *ComponentFirmwareUpgrades) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[24]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1686,7 +1748,7 @@ func (x *ComponentFirmwareUpgrades) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ComponentFirmwareUpgrades.ProtoReflect.Descriptor instead.
 func (*ComponentFirmwareUpgrades) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{24}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ComponentFirmwareUpgrades) GetComponent() PowershelfComponent {
@@ -1703,6 +1765,13 @@ func (x *ComponentFirmwareUpgrades) GetUpgrades() []*FirmwareVersion {
 	return nil
 }
 
+func (x *ComponentFirmwareUpgrades) GetRejected() []*RejectedFirmware {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
 type AvailableFirmware struct {
 	state         protoimpl.MessageState       `protogen:"open.v1"`
 	PmcMacAddress string                       `protobuf:"bytes,1,opt,name=pmc_mac_address,json=pmcMacAddress,proto3" json:"pmc_mac_address,omitempty"`
@@ -1713,7 +1782,7 @@ type AvailableFirmware struct {
 
 func (x *AvailableFirmware) Reset() {
 	*x = AvailableFirmware{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1725,7 +1794,7 @@ func (x *AvailableFirmware) String() string {
 func (*AvailableFirmware) ProtoMessage() {}
 
 func (x *AvailableFirmware) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[25]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1738,7 +1807,7 @@ func (x *AvailableFirmware) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvailableFirmware.ProtoReflect.Descriptor instead.
 func (*AvailableFirmware) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{25}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *AvailableFirmware) GetPmcMacAddress() string {
@@ -1764,7 +1833,7 @@ type ListAvailableFirmwareResponse struct {
 
 func (x *ListAvailableFirmwareResponse) Reset() {
 	*x = ListAvailableFirmwareResponse{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1776,7 +1845,7 @@ func (x *ListAvailableFirmwareResponse) String() string {
 func (*ListAvailableFirmwareResponse) ProtoMessage() {}
 
 func (x *ListAvailableFirmwareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[26]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,7 +1858,7 @@ func (x *ListAvailableFirmwareResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAvailableFirmwareResponse.ProtoReflect.Descriptor instead.
 func (*ListAvailableFirmwareResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{26}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListAvailableFirmwareResponse) GetUpgrades() []*AvailableFirmware {
@@ -1808,7 +1877,7 @@ type SetDryRunRequest struct {
 
 func (x *SetDryRunRequest) Reset() {
 	*x = SetDryRunRequest{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1820,7 +1889,7 @@ func (x *SetDryRunRequest) String() string {
 func (*SetDryRunRequest) ProtoMessage() {}
 
 func (x *SetDryRunRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[27]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1833,7 +1902,7 @@ func (x *SetDryRunRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDryRunRequest.ProtoReflect.Descriptor instead.
 func (*SetDryRunRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{27}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *SetDryRunRequest) GetDryRun() bool {
@@ -1853,7 +1922,7 @@ type GetFirmwareUpdateStatusRequest struct {
 
 func (x *GetFirmwareUpdateStatusRequest) Reset() {
 	*x = GetFirmwareUpdateStatusRequest{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1865,7 +1934,7 @@ func (x *GetFirmwareUpdateStatusRequest) String() string {
 func (*GetFirmwareUpdateStatusRequest) ProtoMessage() {}
 
 func (x *GetFirmwareUpdateStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[28]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,7 +1947,7 @@ func (x *GetFirmwareUpdateStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirmwareUpdateStatusRequest.ProtoReflect.Descriptor instead.
 func (*GetFirmwareUpdateStatusRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{28}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *GetFirmwareUpdateStatusRequest) GetQueries() []*FirmwareUpdateQuery {
@@ -1899,7 +1968,7 @@ type FirmwareUpdateQuery struct {
 
 func (x *FirmwareUpdateQuery) Reset() {
 	*x = FirmwareUpdateQuery{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1911,7 +1980,7 @@ func (x *FirmwareUpdateQuery) String() string {
 func (*FirmwareUpdateQuery) ProtoMessage() {}
 
 func (x *FirmwareUpdateQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[29]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1924,7 +1993,7 @@ func (x *FirmwareUpdateQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateQuery.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateQuery) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{29}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *FirmwareUpdateQuery) GetPmcMacAddress() string {
@@ -1951,7 +2020,7 @@ type GetFirmwareUpdateStatusResponse struct {
 
 func (x *GetFirmwareUpdateStatusResponse) Reset() {
 	*x = GetFirmwareUpdateStatusResponse{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1963,7 +2032,7 @@ func (x *GetFirmwareUpdateStatusResponse) String() string {
 func (*GetFirmwareUpdateStatusResponse) ProtoMessage() {}
 
 func (x *GetFirmwareUpdateStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[30]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1976,7 +2045,7 @@ func (x *GetFirmwareUpdateStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetFirmwareUpdateStatusResponse.ProtoReflect.Descriptor instead.
 func (*GetFirmwareUpdateStatusResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{30}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetFirmwareUpdateStatusResponse) GetStatuses() []*FirmwareUpdateStatus {
@@ -2000,7 +2069,7 @@ type FirmwareUpdateStatus struct {
 
 func (x *FirmwareUpdateStatus) Reset() {
 	*x = FirmwareUpdateStatus{}
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[31]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2012,7 +2081,7 @@ func (x *FirmwareUpdateStatus) String() string {
 func (*FirmwareUpdateStatus) ProtoMessage() {}
 
 func (x *FirmwareUpdateStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[31]
+	mi := &file_internal_proto_v1_powershelf_manager_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2025,7 +2094,7 @@ func (x *FirmwareUpdateStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FirmwareUpdateStatus.ProtoReflect.Descriptor instead.
 func (*FirmwareUpdateStatus) Descriptor() ([]byte, []int) {
-	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{31}
+	return file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *FirmwareUpdateStatus) GetPmcMacAddress() string {
@@ -2158,9 +2227,10 @@ const file_internal_proto_v1_powershelf_manager_proto_rawDesc = "" +
 	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12B\n" +
 	"\n" +
 	"components\x18\x02 \x03(\v2\".v1.UpdateComponentFirmwareRequestR\n" +
-	"components\"X\n" +
+	"components\"\x98\x01\n" +
 	"\x15UpdateFirmwareRequest\x12?\n" +
-	"\bupgrades\x18\x01 \x03(\v2#.v1.UpdatePowershelfFirmwareRequestR\bupgrades\"\x96\x01\n" +
+	"\bupgrades\x18\x01 \x03(\v2#.v1.UpdatePowershelfFirmwareRequestR\bupgrades\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x02 \x01(\tR\x19maintenanceOverrideReason\"\x96\x01\n" +
 	"\x1fUpdateComponentFirmwareResponse\x125\n" +
 	"\tcomponent\x18\x01 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12&\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
@@ -2176,10 +2246,14 @@ const file_internal_proto_v1_powershelf_manager_proto_rawDesc = "" +
 	"\n" +
 	"can_update\x18\x01 \x01(\bR\tcanUpdate\"+\n" +
 	"\x0fFirmwareVersion\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion\"\x83\x01\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"Y\n" +
+	"\x10RejectedFirmware\x12-\n" +
+	"\aversion\x18\x01 \x01(\v2\x13.v1.FirmwareVersionR\aversion\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xb5\x01\n" +
 	"\x19ComponentFirmwareUpgrades\x125\n" +
 	"\tcomponent\x18\x01 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12/\n" +
-	"\bupgrades\x18\x02 \x03(\v2\x13.v1.FirmwareVersionR\bupgrades\"v\n" +
+	"\bupgrades\x18\x02 \x03(\v2\x13.v1.FirmwareVersionR\bupgrades\x120\n" +
+	"\brejected\x18\x03 \x03(\v2\x14.v1.RejectedFirmwareR\brejected\"v\n" +
 	"\x11AvailableFirmware\x12&\n" +
 	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x129\n" +
 	"\bupgrades\x18\x02 \x03(\v2\x1d.v1.ComponentFirmwareUpgradesR\bupgrades\"R\n" +
@@ -2241,7 +2315,7 @@ func file_internal_proto_v1_powershelf_manager_proto_rawDescGZIP() []byte {
 }
 
 var file_internal_proto_v1_powershelf_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_internal_proto_v1_powershelf_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_internal_proto_v1_powershelf_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_internal_proto_v1_powershelf_manager_proto_goTypes = []any{
 	(PMCVendor)(0),                           // 0: v1.PMCVendor
 	(StatusCode)(0),                          // 1: v1.StatusCode
@@ -2271,16 +2345,17 @@ var file_internal_proto_v1_powershelf_manager_proto_goTypes = []any{
 	(*UpdateFirmwareResponse)(nil),           // 25: v1.UpdateFirmwareResponse
 	(*CanUpdateFirmwareResponse)(nil),        // 26: v1.CanUpdateFirmwareResponse
 	(*FirmwareVersion)(nil),                  // 27: v1.FirmwareVersion
-	(*ComponentFirmwareUpgrades)(nil),        // 28: v1.ComponentFirmwareUpgrades
-	(*AvailableFirmware)(nil),                // 29: v1.AvailableFirmware
-	(*ListAvailableFirmwareResponse)(nil),    // 30: v1.ListAvailableFirmwareResponse
-	(*SetDryRunRequest)(nil),                 // 31: v1.SetDryRunRequest
-	(*GetFirmwareUpdateStatusRequest)(nil),   // 32: v1.GetFirmwareUpdateStatusRequest
-	(*FirmwareUpdateQuery)(nil),              // 33: v1.FirmwareUpdateQuery
-	(*GetFirmwareUpdateStatusResponse)(nil),  // 34: v1.GetFirmwareUpdateStatusResponse
-	(*FirmwareUpdateStatus)(nil),             // 35: v1.FirmwareUpdateStatus
-	(*timestamppb.Timestamp)(nil),            // 36: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 37: google.protobuf.Empty
+	(*RejectedFirmware)(nil),                 // 28: v1.RejectedFirmware
+	(*ComponentFirmwareUpgrades)(nil),        // 29: v1.ComponentFirmwareUpgrades
+	(*AvailableFirmware)(nil),                // 30: v1.AvailableFirmware
+	(*ListAvailableFirmwareResponse)(nil),    // 31: v1.ListAvailableFirmwareResponse
+	(*SetDryRunRequest)(nil),                 // 32: v1.SetDryRunRequest
+	(*GetFirmwareUpdateStatusRequest)(nil),   // 33: v1.GetFirmwareUpdateStatusRequest
+	(*FirmwareUpdateQuery)(nil),              // 34: v1.FirmwareUpdateQuery
+	(*GetFirmwareUpdateStatusResponse)(nil),  // 35: v1.GetFirmwareUpdateStatusResponse
+	(*FirmwareUpdateStatus)(nil),             // 36: v1.FirmwareUpdateStatus
+	(*timestamppb.Timestamp)(nil),            // 37: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 38: google.protobuf.Empty
 }
 var file_internal_proto_v1_powershelf_manager_proto_depIdxs = []int32{
 	0,  // 0: v1.PowerManagementController.vendor:type_name -> v1.PMCVendor
@@ -2296,7 +2371,7 @@ var file_internal_proto_v1_powershelf_manager_proto_depIdxs = []int32{
 	0,  // 10: v1.RegisterPowershelfRequest.pmc_vendor:type_name -> v1.PMCVendor
 	4,  // 11: v1.RegisterPowershelfRequest.pmc_credentials:type_name -> v1.Credentials
 	12, // 12: v1.RegisterPowershelvesRequest.registration_requests:type_name -> v1.RegisterPowershelfRequest
-	36, // 13: v1.RegisterPowershelfResponse.created:type_name -> google.protobuf.Timestamp
+	37, // 13: v1.RegisterPowershelfResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 14: v1.RegisterPowershelfResponse.status:type_name -> v1.StatusCode
 	14, // 15: v1.RegisterPowershelvesResponse.responses:type_name -> v1.RegisterPowershelfResponse
 	1,  // 16: v1.PowershelfResponse.status:type_name -> v1.StatusCode
@@ -2310,37 +2385,39 @@ var file_internal_proto_v1_powershelf_manager_proto_depIdxs = []int32{
 	1,  // 24: v1.UpdateComponentFirmwareResponse.status:type_name -> v1.StatusCode
 	23, // 25: v1.UpdatePowershelfFirmwareResponse.components:type_name -> v1.UpdateComponentFirmwareResponse
 	24, // 26: v1.UpdateFirmwareResponse.responses:type_name -> v1.UpdatePowershelfFirmwareResponse
-	2,  // 27: v1.ComponentFirmwareUpgrades.component:type_name -> v1.PowershelfComponent
-	27, // 28: v1.ComponentFirmwareUpgrades.upgrades:type_name -> v1.FirmwareVersion
-	28, // 29: v1.AvailableFirmware.upgrades:type_name -> v1.ComponentFirmwareUpgrades
-	29, // 30: v1.ListAvailableFirmwareResponse.upgrades:type_name -> v1.AvailableFirmware
-	33, // 31: v1.GetFirmwareUpdateStatusRequest.queries:type_name -> v1.FirmwareUpdateQuery
-	2,  // 32: v1.FirmwareUpdateQuery.component:type_name -> v1.PowershelfComponent
-	35, // 33: v1.GetFirmwareUpdateStatusResponse.statuses:type_name -> v1.FirmwareUpdateStatus
-	2,  // 34: v1.FirmwareUpdateStatus.component:type_name -> v1.PowershelfComponent
-	3,  // 35: v1.FirmwareUpdateStatus.state:type_name -> v1.FirmwareUpdateState
-	1,  // 36: v1.FirmwareUpdateStatus.status:type_name -> v1.StatusCode
-	13, // 37: v1.PowershelfManager.RegisterPowershelves:input_type -> v1.RegisterPowershelvesRequest
-	16, // 38: v1.PowershelfManager.GetPowershelves:input_type -> v1.PowershelfRequest
-	22, // 39: v1.PowershelfManager.UpdateFirmware:input_type -> v1.UpdateFirmwareRequest
-	32, // 40: v1.PowershelfManager.GetFirmwareUpdateStatus:input_type -> v1.GetFirmwareUpdateStatusRequest
-	16, // 41: v1.PowershelfManager.ListAvailableFirmware:input_type -> v1.PowershelfRequest
-	31, // 42: v1.PowershelfManager.SetDryRun:input_type -> v1.SetDryRunRequest
-	16, // 43: v1.PowershelfManager.PowerOff:input_type -> v1.PowershelfRequest
-	16, // 44: v1.PowershelfManager.PowerOn:input_type -> v1.PowershelfRequest
-	15, // 45: v1.PowershelfManager.RegisterPowershelves:output_type -> v1.RegisterPowershelvesResponse
-	19, // 46: v1.PowershelfManager.GetPowershelves:output_type -> v1.GetPowershelvesResponse
-	25, // 47: v1.PowershelfManager.UpdateFirmware:output_type -> v1.UpdateFirmwareResponse
-	34, // 48: v1.PowershelfManager.GetFirmwareUpdateStatus:output_type -> v1.GetFirmwareUpdateStatusResponse
-	30, // 49: v1.PowershelfManager.ListAvailableFirmware:output_type -> v1.ListAvailableFirmwareResponse
-	37, // 50: v1.PowershelfManager.SetDryRun:output_type -> google.protobuf.Empty
-	18, // 51: v1.PowershelfManager.PowerOff:output_type -> v1.PowerControlResponse
-	18, // 52: v1.PowershelfManager.PowerOn:output_type -> v1.PowerControlResponse
-	45, // [45:53] is the sub-list for method output_type
-	37, // [37:45] is the sub-list for method input_type
-	37, // [37:37] is the sub-list for extension type_name
-	37, // [37:37] is the sub-list for extension extendee
-	0,  // [0:37] is the sub-list for field type_name
+	27, // 27: v1.RejectedFirmware.version:type_name -> v1.FirmwareVersion
+	2,  // 28: v1.ComponentFirmwareUpgrades.component:type_name -> v1.PowershelfComponent
+	27, // 29: v1.ComponentFirmwareUpgrades.upgrades:type_name -> v1.FirmwareVersion
+	28, // 30: v1.ComponentFirmwareUpgrades.rejected:type_name -> v1.RejectedFirmware
+	29, // 31: v1.AvailableFirmware.upgrades:type_name -> v1.ComponentFirmwareUpgrades
+	30, // 32: v1.ListAvailableFirmwareResponse.upgrades:type_name -> v1.AvailableFirmware
+	34, // 33: v1.GetFirmwareUpdateStatusRequest.queries:type_name -> v1.FirmwareUpdateQuery
+	2,  // 34: v1.FirmwareUpdateQuery.component:type_name -> v1.PowershelfComponent
+	36, // 35: v1.GetFirmwareUpdateStatusResponse.statuses:type_name -> v1.FirmwareUpdateStatus
+	2,  // 36: v1.FirmwareUpdateStatus.component:type_name -> v1.PowershelfComponent
+	3,  // 37: v1.FirmwareUpdateStatus.state:type_name -> v1.FirmwareUpdateState
+	1,  // 38: v1.FirmwareUpdateStatus.status:type_name -> v1.StatusCode
+	13, // 39: v1.PowershelfManager.RegisterPowershelves:input_type -> v1.RegisterPowershelvesRequest
+	16, // 40: v1.PowershelfManager.GetPowershelves:input_type -> v1.PowershelfRequest
+	22, // 41: v1.PowershelfManager.UpdateFirmware:input_type -> v1.UpdateFirmwareRequest
+	33, // 42: v1.PowershelfManager.GetFirmwareUpdateStatus:input_type -> v1.GetFirmwareUpdateStatusRequest
+	16, // 43: v1.PowershelfManager.ListAvailableFirmware:input_type -> v1.PowershelfRequest
+	32, // 44: v1.PowershelfManager.SetDryRun:input_type -> v1.SetDryRunRequest
+	16, // 45: v1.PowershelfManager.PowerOff:input_type -> v1.PowershelfRequest
+	16, // 46: v1.PowershelfManager.PowerOn:input_type -> v1.PowershelfRequest
+	15, // 47: v1.PowershelfManager.RegisterPowershelves:output_type -> v1.RegisterPowershelvesResponse
+	19, // 48: v1.PowershelfManager.GetPowershelves:output_type -> v1.GetPowershelvesResponse
+	25, // 49: v1.PowershelfManager.UpdateFirmware:output_type -> v1.UpdateFirmwareResponse
+	35, // 50: v1.PowershelfManager.GetFirmwareUpdateStatus:output_type -> v1.GetFirmwareUpdateStatusResponse
+	31, // 51: v1.PowershelfManager.ListAvailableFirmware:output_type -> v1.ListAvailableFirmwareResponse
+	38, // 52: v1.PowershelfManager.SetDryRun:output_type -> google.protobuf.Empty
+	18, // 53: v1.PowershelfManager.PowerOff:output_type -> v1.PowerControlResponse
+	18, // 54: v1.PowershelfManager.PowerOn:output_type -> v1.PowerControlResponse
+	47, // [47:55] is the sub-list for method output_type
+	39, // [39:47] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_v1_powershelf_manager_proto_init() }
@@ -2354,7 +2431,7 @@ func file_internal_proto_v1_powershelf_manager_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_proto_v1_powershelf_manager_proto_rawDesc), len(file_internal_proto_v1_powershelf_manager_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   32,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
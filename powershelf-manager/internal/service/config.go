@@ -21,6 +21,7 @@ import (
 
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/credentials"
+	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/firmwaremanager"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/pmcregistry"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/powershelfmanager"
 )
@@ -32,6 +33,15 @@ type Config struct {
 	DataStoreType powershelfmanager.DataStoreType
 	VaultConf     credentials.VaultConfig
 	DBConf        cdb.Config
+	FirmwareConf  firmwaremanager.Config
+	RLAConf       RLAConfig
+}
+
+// RLAConfig locates the RLA service whose rack maintenance windows gate
+// firmware updates. An empty Host disables the check.
+type RLAConfig struct {
+	Host string
+	Port int
 }
 
 // toCredentialManagerConf converts the public service Config into a pmcregistry.Config,
@@ -85,6 +95,7 @@ func (c *Config) ToPsmConf() (*powershelfmanager.Config, error) {
 		DSType:          c.DataStoreType,
 		CredentialConf:  *credentialManagerConf,
 		PmcRegistryConf: *dataStoreConf,
+		FirmwareConf:    c.FirmwareConf,
 	}
 
 	return &psmConf, nil
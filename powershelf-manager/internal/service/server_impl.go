@@ -179,9 +179,23 @@ func (s *PowershelfManagerServerImpl) listAvailableFirmware(ctx context.Context,
 		})
 	}
 
+	rejected, err := s.psm.ListRejectedFirmware(ctx, mac)
+	if err != nil {
+		return nil, err
+	}
+
+	protoRejected := make([]*pb.RejectedFirmware, 0, len(rejected))
+	for _, r := range rejected {
+		protoRejected = append(protoRejected, &pb.RejectedFirmware{
+			Version: &pb.FirmwareVersion{Version: r.UpgradeTo().String()},
+			Reason:  r.Reason,
+		})
+	}
+
 	pmcComponent := &pb.ComponentFirmwareUpgrades{
 		Component: pb.PowershelfComponent_PMC,
 		Upgrades:  protoUpgrades,
+		Rejected:  protoRejected,
 	}
 
 	componentUpgrades := []*pb.ComponentFirmwareUpgrades{pmcComponent}
@@ -197,7 +211,7 @@ func (s *PowershelfManagerServerImpl) UpdateFirmware(ctx context.Context, req *p
 		pmc_mac := powershelf.PmcMacAddress
 		componentUpgradeResponses := make([]*pb.UpdateComponentFirmwareResponse, 0, len(powershelf.Components))
 		for _, component := range powershelf.Components {
-			componentUpgradeResponse := s.updateFirmware(ctx, pmc_mac, component.Component, component.UpgradeTo.Version)
+			componentUpgradeResponse := s.updateFirmware(ctx, pmc_mac, component.Component, component.UpgradeTo.Version, req.MaintenanceOverrideReason)
 			componentUpgradeResponses = append(componentUpgradeResponses, componentUpgradeResponse)
 		}
 		responses = append(responses, &pb.UpdatePowershelfFirmwareResponse{
@@ -212,7 +226,7 @@ func (s *PowershelfManagerServerImpl) UpdateFirmware(ctx context.Context, req *p
 }
 
 // UpdateFirmware triggers a firmware upgrade for the PMC. If dry_run is true, it resolves artifacts and simulates the update without uploading.
-func (s *PowershelfManagerServerImpl) updateFirmware(ctx context.Context, pmc_mac string, pbComponent pb.PowershelfComponent, targetFwVersion string) *pb.UpdateComponentFirmwareResponse {
+func (s *PowershelfManagerServerImpl) updateFirmware(ctx context.Context, pmc_mac string, pbComponent pb.PowershelfComponent, targetFwVersion string, overrideReason string) *pb.UpdateComponentFirmwareResponse {
 	// TODO: support upgrading components other than the PMC
 	if pbComponent != pb.PowershelfComponent_PMC {
 		return &pb.UpdateComponentFirmwareResponse{
@@ -237,7 +251,7 @@ func (s *PowershelfManagerServerImpl) updateFirmware(ctx context.Context, pmc_ma
 		}
 	}
 
-	if err := s.psm.UpgradeFirmware(ctx, mac, component, targetFwVersion); err != nil {
+	if err := s.psm.UpgradeFirmware(ctx, mac, component, targetFwVersion, overrideReason); err != nil {
 		return &pb.UpdateComponentFirmwareResponse{
 			Status: pb.StatusCode_INTERNAL_ERROR,
 			Error:  err.Error(),
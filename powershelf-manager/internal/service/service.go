@@ -32,6 +32,9 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/internal/certs"
 	pb "github.com/nvidia/bare-metal-manager-rest/powershelf-manager/internal/proto/v1"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/powershelfmanager"
+	rlaclient "github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/maintenancegate"
+	rlatypes "github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -44,6 +47,7 @@ type Service struct {
 	conf       Config
 	grpcServer *grpc.Server
 	psm        *powershelfmanager.PowershelfManager
+	rla        *rlaclient.Client
 }
 
 // New initializes a PowershelfManager and constructs a Service from the Config.
@@ -53,14 +57,35 @@ func New(ctx context.Context, c Config) (*Service, error) {
 		return nil, err
 	}
 
+	// Firmware updates wait for the rack maintenance window reported by RLA, if configured
+	var rla *rlaclient.Client
+	if c.RLAConf.Host != "" {
+		rla, err = rlaclient.New(rlaclient.Config{
+			Host: c.RLAConf.Host,
+			Port: c.RLAConf.Port,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create RLA client: %w", err)
+		}
+
+		psmConfig.FirmwareConf.Window = maintenancegate.New(rla, rlatypes.ComponentTypePowerShelf)
+		log.Infof("Firmware updates gated by RLA maintenance windows at %s:%d", c.RLAConf.Host, c.RLAConf.Port)
+	} else {
+		log.Warn("No RLA configured, firmware updates are not gated by maintenance windows")
+	}
+
 	psm, err := powershelfmanager.New(ctx, *psmConfig)
 	if err != nil {
+		if rla != nil {
+			rla.Close()
+		}
 		return nil, err
 	}
 
 	return &Service{
 		conf: c,
 		psm:  psm,
+		rla:  rla,
 	}, nil
 }
 
@@ -106,6 +131,13 @@ func (s *Service) Stop(ctx context.Context) {
 	log.Printf("Starting graceful shutdown now...")
 
 	s.grpcServer.GracefulStop()
+
+	if s.rla != nil {
+		if err := s.rla.Close(); err != nil {
+			log.Warnf("Failed to close RLA client: %v", err)
+		}
+	}
+
 	s.psm.Stop(ctx)
 }
 
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS public.maintenance_override_pmc_created_idx;
+DROP TABLE IF EXISTS public.maintenance_override;
+
+ALTER TABLE public.firmware_update
+    DROP COLUMN IF EXISTS maintenance_override_reason,
+    DROP COLUMN IF EXISTS not_before;
@@ -0,0 +1,33 @@
+--
+-- Name: firmware_update.not_before, firmware_update.maintenance_override_reason; Type: COLUMN; Schema: public
+-- Matches Go model: pkg/db/model/firmware_update.go
+-- Updates queued while the rack maintenance window is closed are not initiated before not_before,
+-- unless they were queued with a maintenance override
+--
+
+ALTER TABLE public.firmware_update
+    ADD COLUMN not_before timestamp with time zone,
+    ADD COLUMN maintenance_override_reason character varying;
+
+-- SECTION
+
+--
+-- Name: maintenance_override; Type: TABLE; Schema: public
+-- Matches Go model: pkg/db/model/maintenance_override.go
+-- Append-only audit of firmware updates queued outside the rack maintenance window
+--
+
+CREATE TABLE public.maintenance_override (
+    id bigserial NOT NULL,
+    pmc_mac_address macaddr NOT NULL,
+    component character varying NOT NULL,
+    version_to character varying NOT NULL,
+    reason character varying NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE ONLY public.maintenance_override
+    ADD CONSTRAINT maintenance_override_pkey PRIMARY KEY (id);
+
+-- Index for ListMaintenanceOverridesForPMC() which filters by PMC and orders by created_at
+CREATE INDEX maintenance_override_pmc_created_idx ON public.maintenance_override (pmc_mac_address, created_at DESC);
@@ -41,13 +41,16 @@ type FirmwareUpdate struct {
 	LastTransitionTime time.Time                `bun:"last_transition_time,notnull"`            // When the state last changed
 	JobID              string                   `bun:"job_id"`                                  // Device job/task ID, if provided by hardware
 	ErrorMessage       string                   `bun:"error_message"`                           // Error message if the upgrade failed
+	NotBefore          *time.Time               `bun:"not_before"`                              // Not initiated before this time (maintenance window), nil = any time
+	OverrideReason     string                   `bun:"maintenance_override_reason,nullzero"`    // Reason the update may run outside the maintenance window
 	CreatedAt          time.Time                `bun:"created_at,notnull,default:now()"`        // When this record was created
 	UpdatedAt          time.Time                `bun:"updated_at,notnull,default:now()"`        // When this record was last updated
 }
 
 // NewFirmwareUpdate creates and inserts (or upserts) a FirmwareUpdate record using the composite PK.
-// If a record already exists for (pmcMac, comp), it will be replaced.
-func NewFirmwareUpdate(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr, comp powershelf.Component, vStart, vTarget string) (*FirmwareUpdate, error) {
+// If a record already exists for (pmcMac, comp), it will be replaced. A non-nil notBefore defers
+// the update until that time; a non-empty overrideReason lets it run outside the maintenance window.
+func NewFirmwareUpdate(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr, comp powershelf.Component, vStart, vTarget string, notBefore *time.Time, overrideReason string) (*FirmwareUpdate, error) {
 	now := time.Now()
 	fu := &FirmwareUpdate{
 		PmcMacAddress:      MacAddr(pmcMac),
@@ -57,6 +60,8 @@ func NewFirmwareUpdate(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr,
 		State:              powershelf.FirmwareStateQueued,
 		LastTransitionTime: now,
 		JobID:              "",
+		NotBefore:          notBefore,
+		OverrideReason:     overrideReason,
 		CreatedAt:          now,
 		UpdatedAt:          now,
 	}
@@ -64,7 +69,7 @@ func NewFirmwareUpdate(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr,
 	_, err := db.NewInsert().
 		Model(fu).
 		On("CONFLICT (pmc_mac_address, component) DO UPDATE").
-		Set("version_from = EXCLUDED.version_from, version_to = EXCLUDED.version_to, state = EXCLUDED.state, last_transition_time = EXCLUDED.last_transition_time, job_id = EXCLUDED.job_id, error_message = EXCLUDED.error_message, updated_at = EXCLUDED.updated_at").
+		Set("version_from = EXCLUDED.version_from, version_to = EXCLUDED.version_to, state = EXCLUDED.state, last_transition_time = EXCLUDED.last_transition_time, job_id = EXCLUDED.job_id, error_message = EXCLUDED.error_message, not_before = EXCLUDED.not_before, maintenance_override_reason = EXCLUDED.maintenance_override_reason, updated_at = EXCLUDED.updated_at").
 		Exec(ctx)
 
 	return fu, err
@@ -158,6 +163,23 @@ func (fu *FirmwareUpdate) SetJobID(ctx context.Context, db bun.IDB, jobID string
 	return err
 }
 
+// SetNotBefore defers the FirmwareUpdate until notBefore and persists it.
+func (fu *FirmwareUpdate) SetNotBefore(ctx context.Context, db bun.IDB, notBefore *time.Time) error {
+	fu.NotBefore = notBefore
+	fu.UpdatedAt = time.Now()
+	_, err := db.NewUpdate().
+		Model(fu).
+		Column("not_before", "updated_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// IsDeferred returns true if the firmware update may not be initiated yet.
+func (fu *FirmwareUpdate) IsDeferred(now time.Time) bool {
+	return fu.NotBefore != nil && fu.NotBefore.After(now)
+}
+
 // SetVersionTarget sets the target version for a FirmwareUpdate and persists it.
 func (fu *FirmwareUpdate) SetVersionTarget(ctx context.Context, db bun.IDB, vTarget string) error {
 	if fu.VersionTo == vTarget {
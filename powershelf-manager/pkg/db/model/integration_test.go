@@ -186,7 +186,7 @@ func TestIntegration_FirmwareUpdate_CreateAndGet(t *testing.T) {
 
 	// Create FirmwareUpdate - use net.HardwareAddr for the function signature
 	netMac := mac.HardwareAddr()
-	fu, err := NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PMC, "1.0.0", "2.0.0")
+	fu, err := NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	assert.NoError(t, err, "NewFirmwareUpdate should succeed")
 	assert.NotNil(t, fu)
 	assert.Equal(t, powershelf.FirmwareStateQueued, fu.State)
@@ -224,7 +224,7 @@ func TestIntegration_FirmwareUpdate_UpdateState(t *testing.T) {
 
 	// Create FirmwareUpdate
 	netMac := mac.HardwareAddr()
-	fu, err := NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PSU, "1.0.0", "2.0.0")
+	fu, err := NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PSU, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Update state
@@ -260,7 +260,7 @@ func TestIntegration_FirmwareUpdate_GetAllPending(t *testing.T) {
 		require.NoError(t, pmc.Create(ctx, tx))
 		require.NoError(t, tx.Commit())
 
-		_, err = NewFirmwareUpdate(ctx, session.DB, mac.HardwareAddr(), powershelf.PMC, "1.0.0", "2.0.0")
+		_, err = NewFirmwareUpdate(ctx, session.DB, mac.HardwareAddr(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 		require.NoError(t, err)
 	}
 
@@ -297,13 +297,13 @@ func TestIntegration_FirmwareUpdate_ListForPMC(t *testing.T) {
 	netMac := mac.HardwareAddr()
 
 	// Create updates for different components
-	_, err = NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PMC, "1.0.0", "2.0.0")
+	_, err = NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Wait a moment to ensure different created_at times
 	time.Sleep(10 * time.Millisecond)
 
-	_, err = NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PSU, "3.0.0", "4.0.0")
+	_, err = NewFirmwareUpdate(ctx, session.DB, netMac, powershelf.PSU, "3.0.0", "4.0.0", nil, "")
 	require.NoError(t, err)
 
 	// List all for PMC
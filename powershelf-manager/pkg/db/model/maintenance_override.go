@@ -0,0 +1,65 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package model
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/objects/powershelf"
+
+	"github.com/uptrace/bun"
+)
+
+// MaintenanceOverride is an append-only audit record of a firmware update that was queued to
+// run outside the rack maintenance window.
+type MaintenanceOverride struct {
+	bun.BaseModel `bun:"table:maintenance_override,alias:mo"`
+
+	ID            int64                `bun:"id,pk,autoincrement"`                  // Row identifier
+	PmcMacAddress MacAddr              `bun:"pmc_mac_address,notnull,type:macaddr"` // MAC address of the target PMC
+	Component     powershelf.Component `bun:"component,notnull"`                    // Component being updated (e.g., "PMC", "PSU1")
+	VersionTo     string               `bun:"version_to,notnull"`                   // Target firmware version of the update
+	Reason        string               `bun:"reason,notnull"`                       // Operator-supplied justification for the override
+	CreatedAt     time.Time            `bun:"created_at,notnull,default:now()"`     // When the override was recorded
+}
+
+// NewMaintenanceOverride inserts an audit record for a firmware update queued outside the maintenance window.
+func NewMaintenanceOverride(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr, comp powershelf.Component, vTarget, reason string) (*MaintenanceOverride, error) {
+	mo := &MaintenanceOverride{
+		PmcMacAddress: MacAddr(pmcMac),
+		Component:     comp,
+		VersionTo:     vTarget,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+
+	_, err := db.NewInsert().Model(mo).Exec(ctx)
+	return mo, err
+}
+
+// ListMaintenanceOverridesForPMC lists the maintenance overrides recorded for a PMC, newest first.
+func ListMaintenanceOverridesForPMC(ctx context.Context, db bun.IDB, pmcMac net.HardwareAddr) ([]MaintenanceOverride, error) {
+	var overrides []MaintenanceOverride
+	err := db.NewSelect().
+		Model(&overrides).
+		Where("pmc_mac_address = ?", MacAddr(pmcMac)).
+		Order("created_at DESC").
+		Scan(ctx)
+	return overrides, err
+}
@@ -18,7 +18,9 @@ package firmwaremanager
 
 import (
 	"embed"
+	"errors"
 	"fmt"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
 	"io/fs"
 	"strings"
@@ -32,15 +34,22 @@ var firmware embed.FS
 const firmware_path = "firmware"
 const pmc_path = "pmc"
 
+// checksums_file lists the sha256 of every firmware artifact in a vendor's pmc directory, in sha256sum format.
+const checksums_file = integrity.ChecksumManifestFile
+
+// signatureExtensions are the suffixes of detached signatures that may accompany a firmware artifact.
+var signatureExtensions = []string{".sig", ".minisig"}
+
 // FirmwareFetcher provides read-only access to embedded firmware assets organized as firmware/<vendor>/pmc.
 type FirmwareFetcher struct {
 	fs embed.FS
 }
 
-// FirmwareEntry identifies a firmware artifact by name and embedded FS path.
+// FirmwareEntry identifies a firmware artifact by name and embedded FS path, along with its declared checksum.
 type FirmwareEntry struct {
-	name string
-	path string
+	name     string
+	path     string
+	checksum string
 }
 
 func newFirmwareFetcher() *FirmwareFetcher {
@@ -73,6 +82,11 @@ func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEnt
 					return nil, err
 				}
 
+				checksums, err := ff.readChecksums(fmt.Sprintf("%s/%s", path, checksums_file))
+				if err != nil {
+					return nil, err
+				}
+
 				fwEntries := make([]FirmwareEntry, 0, len(entries))
 				for _, entry := range entries {
 					if entry.IsDir() {
@@ -80,6 +94,10 @@ func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEnt
 						continue
 					}
 
+					if !strings.HasSuffix(entry.Name(), ".tar") {
+						continue
+					}
+
 					name := entry.Name()
 					info, err := entry.Info()
 					if err != nil {
@@ -96,8 +114,9 @@ func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEnt
 					fw_path := fmt.Sprintf("%s/%s", path, name)
 					//log.Printf("Vendor %s: adding fw {%s} at %s (size: %d bytes)\n", vendorName, name, fw_path, size)
 					fwEntries = append(fwEntries, FirmwareEntry{
-						name: name,
-						path: fw_path,
+						name:     name,
+						path:     fw_path,
+						checksum: checksums[name],
 					})
 
 				}
@@ -110,6 +129,41 @@ func (ff *FirmwareFetcher) getPmcFirmwareEntries(v vendor.Vendor) ([]FirmwareEnt
 	return nil, fmt.Errorf("no firmware found for vendor %s out of vendors %v", v.Name, vendors)
 }
 
+// readChecksums parses a sha256sum-format manifest into a map of file name to "sha256:<hex>".
+// A missing manifest yields an empty map, which causes every artifact to fail verification.
+func (ff *FirmwareFetcher) readChecksums(manifestPath string) (map[string]string, error) {
+	data, err := fs.ReadFile(ff.fs, manifestPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			log.Printf("no firmware checksum manifest found at {%s}\n", manifestPath)
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	checksums, err := integrity.ParseChecksumManifest(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", manifestPath, err)
+	}
+
+	return checksums, nil
+}
+
+// readSignature returns the detached signature for an artifact, or nil if there is none.
+func (ff *FirmwareFetcher) readSignature(artifactPath string) ([]byte, error) {
+	for _, ext := range signatureExtensions {
+		data, err := fs.ReadFile(ff.fs, artifactPath+ext)
+		if err == nil {
+			return data, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
 // open opens a file by embedded path.
 func (ff *FirmwareFetcher) open(path string) (fs.File, error) {
 	return ff.fs.Open(path)
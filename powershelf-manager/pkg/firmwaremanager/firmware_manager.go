@@ -26,7 +26,9 @@ import (
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/uptrace/bun"
 
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/runner"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
@@ -41,17 +43,43 @@ const redfishTimeout = time.Minute * 1
 const dbTimeout = time.Second * 30
 const waiterSleep = time.Second * 30
 
+// Config controls how embedded firmware artifacts are verified before they are offered or uploaded.
+type Config struct {
+	// TrustedKeyPaths are public keys (PEM or minisign) used to verify detached firmware signatures
+	TrustedKeyPaths []string
+	// RequireSignatures rejects artifacts without a valid signature from a trusted key
+	RequireSignatures bool
+	// Window defers firmware updates to the rack maintenance window (nil = not gated)
+	Window MaintenanceWindow
+}
+
+// newVerifier loads the trusted keys and builds the artifact verifier.
+func (c Config) newVerifier() (*integrity.Verifier, error) {
+	keys, err := integrity.LoadPublicKeys(c.TrustedKeyPaths...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trusted firmware keys: %w", err)
+	}
+
+	return integrity.NewVerifier(keys, c.RequireSignatures)
+}
+
 // Manager aggregates per-vendor FirmwareUpdater instances and exposes a vendor-agnostic API for firmware operations.
 type Manager struct {
 	firmwareUpdater  map[vendor.Vendor]*FirmwareUpdater
 	fwUpdateRegistry *Registry
 	pmcManager       *pmcmanager.PmcManager
 	runner           *runner.Runner
+	window           MaintenanceWindow
 	dryRun           bool
 }
 
 // New constructs a Manager by creating updaters for all supported vendors.
-func New(ctx context.Context, c cdb.Config, pmcManager *pmcmanager.PmcManager, dryRun bool) (*Manager, error) {
+func New(ctx context.Context, c cdb.Config, fwConf Config, pmcManager *pmcmanager.PmcManager, dryRun bool) (*Manager, error) {
+	verifier, err := fwConf.newVerifier()
+	if err != nil {
+		return nil, err
+	}
+
 	registry, err := newRegistry(ctx, c)
 	if err != nil {
 		return nil, err
@@ -61,6 +89,7 @@ func New(ctx context.Context, c cdb.Config, pmcManager *pmcmanager.PmcManager, d
 		firmwareUpdater:  make(map[vendor.Vendor]*FirmwareUpdater),
 		fwUpdateRegistry: registry,
 		pmcManager:       pmcManager,
+		window:           fwConf.Window,
 		dryRun:           dryRun,
 	}
 
@@ -70,7 +99,7 @@ func New(ctx context.Context, c cdb.Config, pmcManager *pmcmanager.PmcManager, d
 			continue
 		}
 
-		updater, err := newFirmwareUpdater(vendor)
+		updater, err := newFirmwareUpdater(vendor, verifier)
 		if err != nil {
 			return nil, err
 		}
@@ -117,8 +146,10 @@ func (manager *Manager) getUpdater(pmc *pmc.PMC) (*FirmwareUpdater, error) {
 	return updater, nil
 }
 
-// Upgrade upgrades firmware for a PMC, honoring vendor rules and dry-run.
-func (manager *Manager) Upgrade(ctx context.Context, pmc *pmc.PMC, component powershelf.Component, targetVersion string) error {
+// Upgrade queues a firmware upgrade for a PMC, honoring vendor rules and dry-run. While the rack
+// maintenance window is closed the upgrade waits for its next opening, unless overrideReason is set;
+// the override is recorded in the maintenance_override audit table.
+func (manager *Manager) Upgrade(ctx context.Context, pmc *pmc.PMC, component powershelf.Component, targetVersion string, overrideReason string) error {
 	currentFwVersion, err := getFwVersion(ctx, pmc, component)
 	if err != nil {
 		return err
@@ -133,7 +164,26 @@ func (manager *Manager) Upgrade(ctx context.Context, pmc *pmc.PMC, component pow
 		return fmt.Errorf("cannot update %v for %v from %v to %v", component, pmc, currentFwVersion.String(), targetVersion)
 	}
 
-	_, err = model.NewFirmwareUpdate(ctx, manager.fwUpdateRegistry.session.DB, pmc.MAC, component, currentFwVersion.String(), targetVersion)
+	if overrideReason != "" {
+		log.Printf("Maintenance window overridden for firmware update of %v on powershelf with PMC MAC %v to %v: %s", component, pmc.MAC, targetVersion, overrideReason)
+
+		return manager.fwUpdateRegistry.session.DB.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			if _, err := model.NewMaintenanceOverride(ctx, tx, pmc.MAC, component, targetVersion, overrideReason); err != nil {
+				return fmt.Errorf("failed to record maintenance override: %w", err)
+			}
+
+			_, err := model.NewFirmwareUpdate(ctx, tx, pmc.MAC, component, currentFwVersion.String(), targetVersion, nil, overrideReason)
+			return err
+		})
+	}
+
+	// The runner checks the window again before initiating, so this only decides when it first looks
+	notBefore, err := nextWindowOpening(ctx, manager.window, pmc.MAC, time.Now())
+	if err != nil {
+		log.Printf("failed to check maintenance window of powershelf with PMC MAC %v, deferring update: %v", pmc.MAC, err)
+	}
+
+	_, err = model.NewFirmwareUpdate(ctx, manager.fwUpdateRegistry.session.DB, pmc.MAC, component, currentFwVersion.String(), targetVersion, notBefore, "")
 	return err
 }
 
@@ -164,7 +214,7 @@ func (manager *Manager) CanUpdate(ctx context.Context, pmc *pmc.PMC, component p
 	return updater.canUpdatePmc(ctx, pmc, targetFwVersion)
 }
 
-// CanUpdate returns whether a PMC’s current firmware is within the supported range.
+// ListAvailableFirmware returns the verified upgrade edges for the PMC's vendor.
 func (manager *Manager) ListAvailableFirmware(ctx context.Context, pmc *pmc.PMC) ([]FirmwareUpgrade, error) {
 	updater, err := manager.getUpdater(pmc)
 	if err != nil {
@@ -174,6 +224,16 @@ func (manager *Manager) ListAvailableFirmware(ctx context.Context, pmc *pmc.PMC)
 	return updater.repo.upgrades, nil
 }
 
+// ListRejectedFirmware returns the upgrade edges for the PMC's vendor whose artifacts failed verification.
+func (manager *Manager) ListRejectedFirmware(ctx context.Context, pmc *pmc.PMC) ([]RejectedUpgrade, error) {
+	updater, err := manager.getUpdater(pmc)
+	if err != nil {
+		return nil, err
+	}
+
+	return updater.repo.rejected, nil
+}
+
 func getFwVersion(ctx context.Context, pmc *pmc.PMC, component powershelf.Component) (firmwareVersion, error) {
 	client, err := redfish.New(ctx, pmc, true)
 	if err != nil {
@@ -220,6 +280,40 @@ func (manager *Manager) SetUpdateState(ctx context.Context, update model.Firmwar
 	return update.UpdateFirmwareUpdateState(dbCtx, manager.fwUpdateRegistry.session.DB, newState, errMsg)
 }
 
+// deferToMaintenanceWindow defers a queued update until the next opening of the rack maintenance
+// window if the window is closed. Returns true if the update may not be initiated now.
+func (manager *Manager) deferToMaintenanceWindow(ctx context.Context, update model.FirmwareUpdate) bool {
+	if update.OverrideReason != "" {
+		return false
+	}
+
+	now := time.Now()
+	if update.IsDeferred(now) {
+		return true
+	}
+
+	mac := update.PmcMacAddress.HardwareAddr()
+	notBefore, err := nextWindowOpening(ctx, manager.window, mac, now)
+	if err != nil {
+		log.Printf("failed to check maintenance window of powershelf with PMC MAC %v, retrying at %v: %v", mac, notBefore, err)
+	}
+
+	if notBefore == nil {
+		return false
+	}
+
+	log.Printf("Maintenance window closed, deferring update of %v in powershelf with PMC MAC %v to %v until %v", update.Component, mac, update.VersionTo, notBefore)
+
+	dbCtx, cancel := context.WithTimeout(ctx, dbTimeout)
+	defer cancel()
+
+	if err := update.SetNotBefore(dbCtx, manager.fwUpdateRegistry.session.DB, notBefore); err != nil {
+		log.Printf("failed to persist maintenance window deferral of %v in powershelf with PMC MAC %v: %v", update.Component, mac, err)
+	}
+
+	return true
+}
+
 func (manager *Manager) handleOnePmcUpdate(ctx context.Context, pmc *pmc.PMC, update model.FirmwareUpdate) (powershelf.FirmwareState, error) {
 	ctx, cancel := context.WithTimeout(ctx, redfishTimeout)
 	defer cancel()
@@ -270,6 +364,12 @@ func (manager *Manager) handleOneUpdate(ctx context.Context, update model.Firmwa
 	timeSincelastStateTransition := time.Since(update.LastTransitionTime)
 	timeSincelastUpdate := time.Since(update.UpdatedAt)
 	mac := update.PmcMacAddress.HardwareAddr()
+
+	// Initiating the update disrupts the powershelf, so it only starts while the maintenance window is open
+	if update.State == powershelf.FirmwareStateQueued && manager.deferToMaintenanceWindow(ctx, update) {
+		return
+	}
+
 	log.Printf("Handling update of %v in powershelf with PMC MAC %v at state %v from version %v to version %v (Time Since Last State Transition: %v; Last Update: %v)", update.Component, mac, update.State, update.VersionFrom, update.VersionTo, timeSincelastStateTransition, timeSincelastUpdate)
 
 	pmc, err := manager.pmcManager.GetPmc(context.Background(), mac)
@@ -31,6 +31,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/nvidia/bare-metal-manager-rest/common/pkg/credential"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	dbtestutil "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/testutil"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
@@ -132,7 +133,7 @@ func TestIntegration_FirmwareManager_CanUpdate(t *testing.T) {
 	}
 
 	// Add Liteon updater
-	updater, err := newFirmwareUpdater(vendor.CodeToVendor(vendor.VendorCodeLiteon))
+	updater, err := newFirmwareUpdater(vendor.CodeToVendor(vendor.VendorCodeLiteon), integrity.NewChecksumVerifier())
 	require.NoError(t, err)
 	manager.firmwareUpdater[vendor.CodeToVendor(vendor.VendorCodeLiteon)] = updater
 
@@ -141,7 +142,7 @@ func TestIntegration_FirmwareManager_CanUpdate(t *testing.T) {
 	// so we test the database-level blocking logic
 
 	// Create a pending (non-terminal) firmware update
-	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Verify update was created
@@ -183,7 +184,7 @@ func TestIntegration_FirmwareManager_SetUpdateState(t *testing.T) {
 	}
 
 	// Create a firmware update in Queued state
-	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 	assert.Equal(t, powershelf.FirmwareStateQueued, fu.State)
 
@@ -238,7 +239,7 @@ func TestIntegration_FirmwareManager_SetUpdateState_WithError(t *testing.T) {
 	}
 
 	// Create a firmware update
-	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "1.0.0", "2.0.0")
+	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Transition to Failed with error message
@@ -292,7 +293,7 @@ func TestIntegration_FirmwareManager_GetPendingUpdates(t *testing.T) {
 
 	// Create firmware updates for all PMCs
 	for _, p := range pmcs {
-		_, err := model.NewFirmwareUpdate(ctx, session.DB, p.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+		_, err := model.NewFirmwareUpdate(ctx, session.DB, p.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 		require.NoError(t, err)
 	}
 
@@ -346,7 +347,7 @@ func TestIntegration_FirmwareManager_BlockDuplicateUpdate(t *testing.T) {
 	require.NoError(t, tx.Commit())
 
 	// Create initial firmware update
-	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Get the update
@@ -356,7 +357,7 @@ func TestIntegration_FirmwareManager_BlockDuplicateUpdate(t *testing.T) {
 	assert.Equal(t, "2.0.0", fu.VersionTo)
 
 	// Create another update for the same component - should upsert (replace)
-	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "2.0.0", "3.0.0")
+	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "2.0.0", "3.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Verify the update was replaced
@@ -395,12 +396,12 @@ func TestIntegration_FirmwareManager_MultipleComponents(t *testing.T) {
 	require.NoError(t, tx.Commit())
 
 	// Create updates for different components
-	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	time.Sleep(10 * time.Millisecond) // Ensure different timestamps
 
-	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "3.0.0", "4.0.0")
+	_, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "3.0.0", "4.0.0", nil, "")
 	require.NoError(t, err)
 
 	// Create firmware manager
@@ -459,7 +460,7 @@ func TestIntegration_FirmwareManager_StateTransitionTimestamps(t *testing.T) {
 	}
 
 	// Create firmware update
-	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 	require.NoError(t, err)
 
 	initialTransitionTime := fu.LastTransitionTime
@@ -533,7 +534,7 @@ func TestIntegration_FirmwareManager_TerminalStateCheck(t *testing.T) {
 			}
 
 			// Create and transition firmware update
-			fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0")
+			fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
 			require.NoError(t, err)
 
 			if tc.finalState != powershelf.FirmwareStateQueued {
@@ -565,3 +566,60 @@ func TestIntegration_FirmwareManager_GetUpdateNoRows(t *testing.T) {
 	// Should get sql.ErrNoRows
 	assert.True(t, errors.Is(err, sql.ErrNoRows), "Should return sql.ErrNoRows for missing update")
 }
+
+// TestIntegration_FirmwareManager_DefersToMaintenanceWindow tests that queued updates wait for the maintenance window
+func TestIntegration_FirmwareManager_DefersToMaintenanceWindow(t *testing.T) {
+	skipIfNoDatabase(t)
+
+	session, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	// Create test PMC
+	testPmc := createTestPMC(t, 1, vendor.VendorCodeLiteon)
+
+	// Register PMC
+	pmcModel := &model.PMC{
+		MacAddress: model.MacAddr(testPmc.GetMac()),
+		Vendor:     testPmc.GetVendor().Code,
+		IPAddress:  model.IPAddr(testPmc.GetIp()),
+	}
+	tx, err := session.BeginTx(ctx)
+	require.NoError(t, err)
+	require.NoError(t, pmcModel.Create(ctx, tx))
+	require.NoError(t, tx.Commit())
+
+	// Create firmware manager behind a closed maintenance window
+	opening := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	registry := &Registry{session: session}
+	manager := &Manager{
+		fwUpdateRegistry: registry,
+		window:           &fakeWindow{next: &opening},
+		dryRun:           true,
+	}
+
+	// A queued update is deferred to the next opening
+	fu, err := model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC, "1.0.0", "2.0.0", nil, "")
+	require.NoError(t, err)
+	assert.True(t, manager.deferToMaintenanceWindow(ctx, *fu))
+
+	retrieved, err := model.GetFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PMC)
+	require.NoError(t, err)
+	assert.Equal(t, powershelf.FirmwareStateQueued, retrieved.State)
+	require.NotNil(t, retrieved.NotBefore)
+	assert.True(t, opening.Equal(*retrieved.NotBefore))
+	assert.True(t, manager.deferToMaintenanceWindow(ctx, *retrieved), "deferred updates stay deferred without asking RLA again")
+
+	// An overridden update runs in the closed window and its override is audited
+	_, err = model.NewMaintenanceOverride(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "4.0.0", "emergency security fix")
+	require.NoError(t, err)
+	fu, err = model.NewFirmwareUpdate(ctx, session.DB, testPmc.GetMac(), powershelf.PSU, "3.0.0", "4.0.0", nil, "emergency security fix")
+	require.NoError(t, err)
+	assert.False(t, manager.deferToMaintenanceWindow(ctx, *fu))
+
+	overrides, err := model.ListMaintenanceOverridesForPMC(ctx, session.DB, testPmc.GetMac())
+	require.NoError(t, err)
+	require.Len(t, overrides, 1)
+	assert.Equal(t, "emergency security fix", overrides[0].Reason)
+}
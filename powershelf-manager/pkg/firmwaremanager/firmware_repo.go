@@ -18,18 +18,24 @@ package firmwaremanager
 
 import (
 	"fmt"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/util"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
 	"io/fs"
 	"strings"
+
+	log "github.com/sirupsen/logrus"
 )
 
 // FirmwareRepo holds parsed firmware upgrade edges and the supported starting-version range for a vendor.
+// Edges whose artifacts fail verification are kept in rejected and never offered for upgrades.
 type FirmwareRepo struct {
 	ff                   *FirmwareFetcher
+	verifier             *integrity.Verifier
 	minStartingFwVersion firmwareVersion
 	maxStartingFwVersion firmwareVersion
 	upgrades             []FirmwareUpgrade
+	rejected             []RejectedUpgrade
 }
 
 // summary returns a human-readable report of supported versions and artifacts.
@@ -52,9 +58,33 @@ func (repo *FirmwareRepo) summary() (string, error) {
 		sb.WriteString(fmt.Sprintf("FW Upgrade %d: %v from %s to %s (size: %v bytes)\n", i, upgrade.path, upgrade.from, upgrade.to, util.HumanReadableSize(info.Size())))
 	}
 
+	for _, rejected := range repo.rejected {
+		sb.WriteString(fmt.Sprintf("Rejected FW Upgrade: %v from %s to %s: %s\n", rejected.path, rejected.from, rejected.to, rejected.Reason))
+	}
+
 	return sb.String(), nil
 }
 
+// verify checks the artifact for an edge against its declared checksum and detached signature.
+func (repo *FirmwareRepo) verify(upgrade *FirmwareUpgrade) error {
+	sig, err := repo.ff.readSignature(upgrade.path)
+	if err != nil {
+		return err
+	}
+
+	file, err := repo.ff.open(upgrade.path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := repo.verifier.Verify(file, upgrade.checksum, sig); err != nil {
+		return fmt.Errorf("integrity check failed for %s: %w", upgrade.path, err)
+	}
+
+	return nil
+}
+
 // supportUpgrade returns true if the current version is within the repo’s supported starting range.
 func (repo *FirmwareRepo) supportUpgrade(currentFwVersion firmwareVersion) bool {
 	// Check if currentFwVersion is within the FirmwareReport's supported range
@@ -66,9 +96,10 @@ func (repo *FirmwareRepo) open(upgrade *FirmwareUpgrade) (fs.File, error) {
 	return repo.ff.open(upgrade.path)
 }
 
-// newFirmwareRepo discovers embedded artifacts for a vendor, parses filename-encoded edges, and computes supported range.
-func newFirmwareRepo(v vendor.Vendor) (*FirmwareRepo, error) {
+// newFirmwareRepo discovers embedded artifacts for a vendor, parses filename-encoded edges, verifies each artifact, and computes supported range.
+func newFirmwareRepo(v vendor.Vendor, verifier *integrity.Verifier) (*FirmwareRepo, error) {
 	ff := newFirmwareFetcher()
+	repo := &FirmwareRepo{ff: ff, verifier: verifier}
 
 	fw_entries, err := ff.getPmcFirmwareEntries(v)
 	if err != nil {
@@ -87,11 +118,20 @@ func newFirmwareRepo(v vendor.Vendor) (*FirmwareRepo, error) {
 			&from.major, &from.minor, &from.patch,
 			&to.major, &to.minor, &to.patch)
 		if err == nil {
-			upgrades = append(upgrades, FirmwareUpgrade{
-				from: from,
-				to:   to,
-				path: fw.path,
-			})
+			upgrade := FirmwareUpgrade{
+				from:     from,
+				to:       to,
+				path:     fw.path,
+				checksum: fw.checksum,
+			}
+
+			if err := repo.verify(&upgrade); err != nil {
+				log.Printf("Vendor %s: rejecting fw upgrade from %s to %s: %v\n", v.Name, from, to, err)
+				repo.rejected = append(repo.rejected, RejectedUpgrade{FirmwareUpgrade: upgrade, Reason: err.Error()})
+				continue
+			}
+
+			upgrades = append(upgrades, upgrade)
 
 			if len(upgrades) == 1 {
 				minStartingFwVersion = from
@@ -104,5 +144,9 @@ func newFirmwareRepo(v vendor.Vendor) (*FirmwareRepo, error) {
 		}
 	}
 
-	return &FirmwareRepo{ff: ff, minStartingFwVersion: minStartingFwVersion, maxStartingFwVersion: maxStartingFwVersion, upgrades: upgrades}, nil
+	repo.minStartingFwVersion = minStartingFwVersion
+	repo.maxStartingFwVersion = maxStartingFwVersion
+	repo.upgrades = upgrades
+
+	return repo, nil
 }
@@ -18,6 +18,7 @@ package firmwaremanager
 
 import (
 	"context"
+	"github.com/nvidia/bare-metal-manager-rest/common/pkg/integrity"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/common/vendor"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/objects/pmc"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/redfish"
@@ -50,12 +51,12 @@ func (updater *FirmwareUpdater) Summary() (string, error) {
 	return sb.String(), nil
 }
 
-func newFirmwareUpdater(v vendor.Vendor) (*FirmwareUpdater, error) {
+func newFirmwareUpdater(v vendor.Vendor, verifier *integrity.Verifier) (*FirmwareUpdater, error) {
 	if err := v.IsSupported(); err != nil {
 		return nil, err
 	}
 
-	repo, err := newFirmwareRepo(v)
+	repo, err := newFirmwareRepo(v, verifier)
 	if err != nil {
 		return nil, err
 	}
@@ -118,6 +119,11 @@ func (fp *FirmwareUpdater) update(client *redfish.RedfishClient, targetVersion f
 	if fp.canUpdate(currentVersion, targetVersion) {
 		upgrade := fp.getFwUpgrade(currentVersion, targetVersion)
 		if upgrade != nil {
+			// Re-verify the artifact right before it is uploaded; a corrupted image bricks the PMC.
+			if err := fp.repo.verify(upgrade); err != nil {
+				return nil, err
+			}
+
 			fw, err := fp.repo.open(upgrade)
 			if err != nil {
 				return nil, err
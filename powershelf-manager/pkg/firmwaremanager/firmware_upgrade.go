@@ -22,9 +22,16 @@ import (
 
 // FirmwareUpgrade represents a single directed edge from a source firmware version to a target version, with the artifact path.
 type FirmwareUpgrade struct {
-	from firmwareVersion
-	to   firmwareVersion
-	path string
+	from     firmwareVersion
+	to       firmwareVersion
+	path     string
+	checksum string
+}
+
+// RejectedUpgrade is an upgrade edge whose artifact failed its checksum or signature check and cannot be used.
+type RejectedUpgrade struct {
+	FirmwareUpgrade
+	Reason string
 }
 
 /*
@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package firmwaremanager
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeWindow struct {
+	open bool
+	next *time.Time
+	err  error
+}
+
+func (w *fakeWindow) Window(ctx context.Context, componentID string) (bool, *time.Time, error) {
+	return w.open, w.next, w.err
+}
+
+func TestNextWindowOpening(t *testing.T) {
+	now := time.Now()
+	opening := now.Add(3 * time.Hour)
+	recheck := now.Add(maintenanceWindowRecheckInterval)
+	mac, _ := net.ParseMAC("00:11:22:33:44:55")
+
+	testCases := map[string]struct {
+		window    MaintenanceWindow
+		expected  *time.Time
+		expectErr bool
+	}{
+		"not gated": {
+			window: nil,
+		},
+		"window open": {
+			window: &fakeWindow{open: true},
+		},
+		"window closed defers to the next opening": {
+			window:   &fakeWindow{next: &opening},
+			expected: &opening,
+		},
+		"window closed without a scheduled opening is rechecked": {
+			window:   &fakeWindow{},
+			expected: &recheck,
+		},
+		"status error is rechecked": {
+			window:    &fakeWindow{err: errors.New("rla unavailable")},
+			expected:  &recheck,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			notBefore, err := nextWindowOpening(context.Background(), tc.window, mac, now)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expected, notBefore)
+		})
+	}
+}
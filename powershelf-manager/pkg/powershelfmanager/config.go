@@ -18,6 +18,7 @@ package powershelfmanager
 
 import (
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/credentials"
+	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/firmwaremanager"
 	"github.com/nvidia/bare-metal-manager-rest/powershelf-manager/pkg/pmcregistry"
 )
 
@@ -29,11 +30,13 @@ const (
 	DatastoreTypeInMemory   DataStoreType = "InMemory"
 )
 
-// Config contains the orchestrator’s datastore mode and concrete backends for the PMC registry and the credential manager.
+// Config contains the orchestrator’s datastore mode, concrete backends for the PMC registry and the credential manager,
+// and how firmware artifacts are verified.
 type Config struct {
 	DSType          DataStoreType
 	PmcRegistryConf pmcregistry.Config
 	CredentialConf  credentials.Config
+	FirmwareConf    firmwaremanager.Config
 }
 
 // StringToDSType converts a string to a DataStoreType, returning false if unsupported.
@@ -70,7 +70,7 @@ func New(ctx context.Context, c Config) (*PowershelfManager, error) {
 	}
 
 	pmcManager := pmcmanager.New(registry, credentialManager)
-	firmwareManager, err := firmwaremanager.New(ctx, c.PmcRegistryConf.DSConf, pmcManager, false)
+	firmwareManager, err := firmwaremanager.New(ctx, c.PmcRegistryConf.DSConf, c.FirmwareConf, pmcManager, false)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize firmware manager (conf: %v): %w", c, err)
 	}
@@ -130,14 +130,24 @@ func (pm *PowershelfManager) ListAvailableFirmware(ctx context.Context, mac net.
 
 }
 
-// UpgradeFirmware performs (or simulates) a firmware upgrade. Returns the underlying HTTP response from the device on success.
-func (pm *PowershelfManager) UpgradeFirmware(ctx context.Context, mac net.HardwareAddr, component powershelf.Component, targetFwVersion string) error {
+// ListRejectedFirmware returns the firmware upgrades for a PMC that failed checksum or signature verification.
+func (pm *PowershelfManager) ListRejectedFirmware(ctx context.Context, mac net.HardwareAddr) ([]firmwaremanager.RejectedUpgrade, error) {
+	pmc, err := pm.GetPmc(ctx, mac)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get query PMC (%s): %w", mac.String(), err)
+	}
+
+	return pm.FirmwareManager.ListRejectedFirmware(ctx, pmc)
+}
+
+// UpgradeFirmware queues (or simulates) a firmware upgrade, deferred to the rack maintenance window unless overrideReason is set.
+func (pm *PowershelfManager) UpgradeFirmware(ctx context.Context, mac net.HardwareAddr, component powershelf.Component, targetFwVersion string, overrideReason string) error {
 	pmc, err := pm.GetPmc(ctx, mac)
 	if err != nil {
 		return fmt.Errorf("failed to get query PMC (%s): %w", mac.String(), err)
 	}
 
-	return pm.FirmwareManager.Upgrade(ctx, pmc, component, targetFwVersion)
+	return pm.FirmwareManager.Upgrade(ctx, pmc, component, targetFwVersion, overrideReason)
 }
 
 // GetFirmwareUpdateStatus returns the status of a firmware update for the specified PMC and component.
@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -217,9 +218,11 @@ func outputDiffTable(result *client.ValidateComponentsResult) {
 
 	// Differences table
 	fmt.Println("Differences:")
-	fmt.Println(strings.Repeat("-", 100))
-	fmt.Printf("%-20s %-30s %s\n", "TYPE", "COMPONENT_ID", "DETAILS")
-	fmt.Println(strings.Repeat("-", 100))
+	fmt.Println(strings.Repeat("-", 110))
+	fmt.Printf("%-20s %-38s %-10s %s\n", "TYPE", "COMPONENT_ID", "AGE", "DETAILS")
+	fmt.Println(strings.Repeat("-", 110))
+
+	now := time.Now()
 
 	for _, diff := range result.Diffs {
 		diffType := ""
@@ -242,7 +245,40 @@ func outputDiffTable(result *client.ValidateComponentsResult) {
 			details = strings.Join(fieldStrs, ", ")
 		}
 
-		fmt.Printf("%-20s %-30s %s\n", diffType, diff.ComponentID, details)
+		fmt.Printf("%-20s %-38s %-10s %s\n", diffType, diff.ComponentID, formatDriftAge(diff.FirstSeenAt, now), details)
+	}
+	fmt.Println(strings.Repeat("-", 110))
+}
+
+// formatDriftAge returns how long a drift has been detected, e.g. "3d4h",
+// "2h15m" or "45s", or "-" if its first detection time is unknown.
+func formatDriftAge(firstSeen *time.Time, now time.Time) string {
+	if firstSeen == nil {
+		return "-"
+	}
+
+	return formatDuration(now.Sub(*firstSeen))
+}
+
+// formatDuration formats a duration with its two most significant units.
+func formatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+
+	days := int(d / (24 * time.Hour))
+	hours := int(d/time.Hour) % 24
+	minutes := int(d/time.Minute) % 60
+	seconds := int(d/time.Second) % 60
+
+	switch {
+	case days > 0:
+		return fmt.Sprintf("%dd%dh", days, hours)
+	case hours > 0:
+		return fmt.Sprintf("%dh%dm", hours, minutes)
+	case minutes > 0:
+		return fmt.Sprintf("%dm%ds", minutes, seconds)
+	default:
+		return fmt.Sprintf("%ds", seconds)
 	}
-	fmt.Println(strings.Repeat("-", 100))
 }
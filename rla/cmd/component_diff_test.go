@@ -0,0 +1,51 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatDriftAge(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+
+	testCases := map[string]struct {
+		firstSeen *time.Time
+		expected  string
+	}{
+		"unknown":    {firstSeen: nil, expected: "-"},
+		"seconds":    {firstSeen: ago(45 * time.Second), expected: "45s"},
+		"minutes":    {firstSeen: ago(12*time.Minute + 3*time.Second), expected: "12m3s"},
+		"hours":      {firstSeen: ago(2*time.Hour + 15*time.Minute), expected: "2h15m"},
+		"days":       {firstSeen: ago(76 * time.Hour), expected: "3d4h"},
+		"clock skew": {firstSeen: ago(-time.Minute), expected: "0s"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, formatDriftAge(tc.firstSeen, now))
+		})
+	}
+}
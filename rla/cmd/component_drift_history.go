@@ -0,0 +1,194 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
+)
+
+var (
+	driftHistoryCmd = &cobra.Command{
+		Use:   "drift-history",
+		Short: "List the history of drifts detected by the inventory loop",
+		Long: `List the history of drifts detected by the inventory loop, most recently seen first.
+
+Each entry records when a drift was first seen, when it was last seen and when
+it was resolved. Mismatches are tracked per field. A drift that reappears after
+being resolved gets a new entry, so repeated entries for the same component
+and field indicate flapping. The REMEDIATION column shows whether a drift
+policy accepted the actual value or raised an alert.
+
+Examples:
+  # List all drifts that are still open
+  rla component drift-history --open-only
+
+  # List the firmware drift history of a component over the last week
+  rla component drift-history --component-ids "uuid-1" --field firmware_version --since 168h
+
+  # Output as table
+  rla component drift-history --output table
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doListDriftHistory()
+		},
+	}
+
+	driftHistoryComponentIDs string
+	driftHistoryField        string
+	driftHistoryOpenOnly     bool
+	driftHistorySince        time.Duration
+	driftHistoryOffset       int
+	driftHistoryLimit        int
+	driftHistoryOutput       string
+	driftHistoryHost         string
+	driftHistoryPort         int
+)
+
+func init() {
+	componentCmd.AddCommand(driftHistoryCmd)
+
+	driftHistoryCmd.Flags().StringVar(&driftHistoryComponentIDs, "component-ids", "", "Comma-separated list of component UUIDs")
+	driftHistoryCmd.Flags().StringVar(&driftHistoryField, "field", "", "Only drifts of this field (e.g. firmware_version)")
+	driftHistoryCmd.Flags().BoolVar(&driftHistoryOpenOnly, "open-only", false, "Only drifts that are still detected")
+	driftHistoryCmd.Flags().DurationVar(&driftHistorySince, "since", 0, "Only drifts seen within this duration (e.g. 24h)")
+	driftHistoryCmd.Flags().IntVar(&driftHistoryOffset, "offset", 0, "Pagination offset")
+	driftHistoryCmd.Flags().IntVar(&driftHistoryLimit, "limit", 100, "Pagination limit")
+	driftHistoryCmd.Flags().StringVarP(&driftHistoryOutput, "output", "o", "json", "Output format: json, table")
+	driftHistoryCmd.Flags().StringVar(&driftHistoryHost, "host", "localhost", "RLA server host")
+	driftHistoryCmd.Flags().IntVar(&driftHistoryPort, "port", 50051, "RLA server port")
+}
+
+func doListDriftHistory() {
+	query := client.DriftHistoryQuery{
+		FieldName: driftHistoryField,
+		OpenOnly:  driftHistoryOpenOnly,
+		Pagination: &types.Pagination{
+			Offset: driftHistoryOffset,
+			Limit:  driftHistoryLimit,
+		},
+	}
+
+	if driftHistoryComponentIDs != "" {
+		for _, idStr := range strings.Split(driftHistoryComponentIDs, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(idStr))
+			if err != nil {
+				log.Fatal().Err(err).Str("id", idStr).Msg("Invalid component UUID")
+			}
+			query.ComponentIDs = append(query.ComponentIDs, id)
+		}
+	}
+
+	if driftHistorySince > 0 {
+		since := time.Now().Add(-driftHistorySince)
+		query.Since = &since
+	}
+
+	c, err := client.New(client.Config{
+		Host: driftHistoryHost,
+		Port: driftHistoryPort,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create client")
+	}
+	defer c.Close()
+
+	result, err := c.ListDriftHistory(context.Background(), query)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to list drift history")
+	}
+
+	switch driftHistoryOutput {
+	case "json":
+		outputDriftHistoryJSON(result)
+	case "table":
+		outputDriftHistoryTable(result)
+	default:
+		log.Fatal().Str("format", driftHistoryOutput).Msg("Unknown output format")
+	}
+}
+
+func outputDriftHistoryJSON(result *client.ListDriftHistoryResult) {
+	output := struct {
+		Total   int                        `json:"total"`
+		Entries []*types.DriftHistoryEntry `json:"entries"`
+	}{
+		Total:   result.Total,
+		Entries: result.Entries,
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to marshal JSON")
+	}
+	fmt.Println(string(data))
+}
+
+func outputDriftHistoryTable(result *client.ListDriftHistoryResult) {
+	fmt.Printf("Total: %d drift(s)\n", result.Total)
+	fmt.Println(strings.Repeat("-", 150))
+	fmt.Printf("%-38s %-18s %-18s %-30s %-20s %-10s %-10s %s\n",
+		"COMPONENT_ID", "TYPE", "FIELD", "EXPECTED → ACTUAL", "FIRST_SEEN", "DURATION", "STATUS", "REMEDIATION")
+	fmt.Println(strings.Repeat("-", 150))
+
+	now := time.Now()
+	for _, e := range result.Entries {
+		componentID := e.ComponentID
+		if componentID == "" {
+			componentID = e.ExternalID
+		}
+
+		values := ""
+		if e.Type == types.DiffTypeDrift {
+			values = fmt.Sprintf("%s → %s", e.ExpectedValue, e.ActualValue)
+		}
+
+		status := "open"
+		end := now
+		if e.ResolvedAt != nil {
+			status = "resolved"
+			end = *e.ResolvedAt
+		}
+
+		remediation := e.Remediation
+		if remediation == "" {
+			remediation = "-"
+		}
+
+		fmt.Printf("%-38s %-18s %-18s %-30s %-20s %-10s %-10s %s\n",
+			componentID,
+			string(e.Type),
+			e.FieldName,
+			values,
+			e.FirstSeenAt.Local().Format("2006-01-02 15:04:05"),
+			formatDuration(end.Sub(e.FirstSeenAt)),
+			status,
+			remediation,
+		)
+	}
+}
@@ -77,6 +77,7 @@ Examples:
 	firmwareUpgradeComponentType string
 	firmwareUpgradeStartTime     string
 	firmwareUpgradeEndTime       string
+	firmwareUpgradeOverride      string
 	firmwareUpgradeHost          string
 	firmwareUpgradePort          int
 )
@@ -90,6 +91,7 @@ func init() {
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeComponentType, "type", "t", "", "Component type: compute, nvlswitch, powershelf (required for rack-ids/rack-names)")
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeStartTime, "start", "s", "", "Start time (default: now)")
 	firmwareUpgradeCmd.Flags().StringVarP(&firmwareUpgradeEndTime, "end", "e", "", "End time (default: start + 24h)")
+	firmwareUpgradeCmd.Flags().StringVar(&firmwareUpgradeOverride, "override-window", "", "Run even if the maintenance window is closed; the value is the reason recorded with the task")
 	firmwareUpgradeCmd.Flags().StringVar(&firmwareUpgradeHost, "host", "localhost", "RLA service host")
 	firmwareUpgradeCmd.Flags().IntVarP(&firmwareUpgradePort, "port", "p", defaultServicePort, "RLA service port")
 }
@@ -186,6 +188,7 @@ func doFirmwareUpgrade() {
 
 	// Execute based on the specified option
 	var result *client.UpgradeFirmwareResult
+	opts := maintenanceOverrideOptions(firmwareUpgradeOverride)
 
 	switch {
 	case hasComponentIDs:
@@ -198,7 +201,7 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by component IDs")
-		result, err = rlaClient.UpgradeFirmwareByMachineIDs(ctx, componentIDs, &startTime, &endTime)
+		result, err = rlaClient.UpgradeFirmwareByMachineIDs(ctx, componentIDs, &startTime, &endTime, opts...)
 
 	case hasRackIDs:
 		rackIDs := parseUUIDList(firmwareUpgradeRackIDs)
@@ -211,7 +214,7 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by rack IDs")
-		result, err = rlaClient.UpgradeFirmwareByRackIDs(ctx, rackIDs, componentType, &startTime, &endTime)
+		result, err = rlaClient.UpgradeFirmwareByRackIDs(ctx, rackIDs, componentType, &startTime, &endTime, opts...)
 
 	case hasRackNames:
 		rackNames := parseCommaSeparatedList(firmwareUpgradeRackNames)
@@ -224,7 +227,7 @@ func doFirmwareUpgrade() {
 			Time("start_time", startTime).
 			Time("end_time", endTime).
 			Msg("Upgrading firmware by rack names")
-		result, err = rlaClient.UpgradeFirmwareByRackNames(ctx, rackNames, componentType, &startTime, &endTime)
+		result, err = rlaClient.UpgradeFirmwareByRackNames(ctx, rackNames, componentType, &startTime, &endTime, opts...)
 	}
 
 	if err != nil {
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+)
+
+var (
+	powerBudgetCmd = &cobra.Command{
+		Use:   "budget",
+		Short: "Set the power budget of a rack",
+		Long: `Set the power budget of a rack in watts.
+
+RLA raises an alert when the rack draws more than its budget for longer
+than the configured sustain period, and refuses power-on requests whose
+projected draw would exceed the budget. A budget of 0 removes it.
+
+Examples:
+  # Limit a rack to 120 kW
+  rla power budget --rack-id "uuid-1" --watts 120000
+
+  # Remove the budget of a rack
+  rla power budget --rack-id "uuid-1" --watts 0
+`,
+		Run: func(cmd *cobra.Command, args []string) {
+			doSetPowerBudget()
+		},
+	}
+
+	powerBudgetRackID string
+	powerBudgetWatts  float64
+	powerBudgetHost   string
+	powerBudgetPort   int
+)
+
+func init() {
+	powerCmd.AddCommand(powerBudgetCmd)
+
+	powerBudgetCmd.Flags().StringVar(&powerBudgetRackID, "rack-id", "", "Rack UUID (required)")
+	powerBudgetCmd.Flags().Float64Var(&powerBudgetWatts, "watts", 0, "Power budget in watts; 0 removes the budget")
+	powerBudgetCmd.Flags().StringVar(&powerBudgetHost, "host", "localhost", "RLA server host")
+	powerBudgetCmd.Flags().IntVar(&powerBudgetPort, "port", 50051, "RLA server port")
+
+	_ = powerBudgetCmd.MarkFlagRequired("rack-id")
+	_ = powerBudgetCmd.MarkFlagRequired("watts")
+}
+
+func doSetPowerBudget() {
+	rackID, err := uuid.Parse(powerBudgetRackID)
+	if err != nil {
+		log.Fatal().Err(err).Str("id", powerBudgetRackID).Msg("Invalid rack UUID")
+	}
+
+	if powerBudgetWatts < 0 {
+		log.Fatal().Float64("watts", powerBudgetWatts).Msg("--watts must not be negative")
+	}
+
+	c, err := client.New(client.Config{
+		Host: powerBudgetHost,
+		Port: powerBudgetPort,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create client")
+	}
+	defer c.Close()
+
+	report, err := c.SetRackPowerBudget(context.Background(), rackID, powerBudgetWatts)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to set rack power budget")
+	}
+
+	fmt.Println(report)
+}
@@ -73,6 +73,9 @@ Examples:
 
   # Power control by component IDs (no --type needed)
   rla power control --component-ids "machine1,machine2" --op restart
+
+  # Run now even though the maintenance window is closed
+  rla power control --rack-names "rack-name-1" --type compute --op cold-reset --override-window "INC-1234 hung nodes"
 `,
 		Run: func(cmd *cobra.Command, args []string) {
 			doPowerControl()
@@ -84,6 +87,7 @@ Examples:
 	powerControlComponentIDs  string
 	powerControlComponentType string
 	powerControlOp            string
+	powerControlOverride      string
 	powerControlHost          string
 	powerControlPort          int
 )
@@ -96,6 +100,7 @@ func init() {
 	powerControlCmd.Flags().StringVar(&powerControlComponentIDs, "component-ids", "", "Comma-separated list of component IDs")
 	powerControlCmd.Flags().StringVarP(&powerControlComponentType, "type", "t", "", "Component type: compute, nvlswitch, powershelf (required for rack-ids/rack-names)")
 	powerControlCmd.Flags().StringVar(&powerControlOp, "op", "", "Power operation: on, off, force-off, reset, force-reset, ac-powercycle")
+	powerControlCmd.Flags().StringVar(&powerControlOverride, "override-window", "", "Run even if the maintenance window is closed; the value is the reason recorded with the task")
 	powerControlCmd.Flags().StringVar(&powerControlHost, "host", "localhost", "RLA service host")
 	powerControlCmd.Flags().IntVarP(&powerControlPort, "port", "p", defaultServicePort, "RLA service port")
 
@@ -180,6 +185,7 @@ func doPowerControl() {
 
 	// Execute based on the specified option
 	var result *client.PowerControlResult
+	opts := maintenanceOverrideOptions(powerControlOverride)
 
 	switch {
 	case hasRackIDs:
@@ -192,7 +198,7 @@ func doPowerControl() {
 			Str("component_type", powerControlComponentType).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by rack IDs")
-		result, err = rlaClient.PowerControlByRackIDs(ctx, rackIDs, componentType, op)
+		result, err = rlaClient.PowerControlByRackIDs(ctx, rackIDs, componentType, op, opts...)
 
 	case hasRackNames:
 		rackNames := parseCommaSeparatedList(powerControlRackNames)
@@ -204,7 +210,7 @@ func doPowerControl() {
 			Str("component_type", powerControlComponentType).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by rack names")
-		result, err = rlaClient.PowerControlByRackNames(ctx, rackNames, componentType, op)
+		result, err = rlaClient.PowerControlByRackNames(ctx, rackNames, componentType, op, opts...)
 
 	case hasComponentIDs:
 		componentIDs := parseCommaSeparatedList(powerControlComponentIDs)
@@ -215,7 +221,7 @@ func doPowerControl() {
 			Strs("component_ids", componentIDs).
 			Str("operation", powerControlOp).
 			Msg("Executing power control by component IDs")
-		result, err = rlaClient.PowerControlByMachineIDs(ctx, componentIDs, op)
+		result, err = rlaClient.PowerControlByMachineIDs(ctx, componentIDs, op, opts...)
 	}
 
 	if err != nil {
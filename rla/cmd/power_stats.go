@@ -18,9 +18,16 @@
 package cmd
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
 )
 
 var (
@@ -29,30 +36,176 @@ var (
 		Short: "Get power statistics (consumption, etc.) of components",
 		Long: `Get power statistics such as power consumption of components.
 
-This command is not yet implemented.
+Compute tray power is read through Carbide, NVLink switch power through
+NV-Switch Manager and power shelf output through PSM. Readings are
+aggregated per rack and per NVL domain.
+
+Specify at most ONE of the following options (all racks if none is given):
+  --rack-ids      : Comma-separated list of rack UUIDs
+  --rack-names    : Comma-separated list of rack names
+  --component-ids : Comma-separated list of component IDs (e.g. machine_id from Carbide)
+
+Output formats:
+  --output json      : JSON format (default)
+  --output table     : Table format
 
 Examples:
+  # Get power stats of all racks
+  rla power stats --output table
+
   # Get power stats by rack names
   rla power stats --rack-names "rack-1,rack-2" --type compute
 
   # Get power stats by component IDs
-  rla power stats --component-ids "machine-1,machine-2"
+  rla power stats --component-ids "machine-1,machine-2" --type compute
 `,
 		Run: func(cmd *cobra.Command, args []string) {
-			fmt.Println("Error: 'power stats' command is not yet implemented")
+			doGetPowerStats()
 		},
 	}
+
+	powerStatsRackIDs       string
+	powerStatsRackNames     string
+	powerStatsComponentIDs  string
+	powerStatsComponentType string
+	powerStatsOutput        string
+	powerStatsHost          string
+	powerStatsPort          int
 )
 
 func init() {
 	powerCmd.AddCommand(powerStatsCmd)
 
-	// Add placeholder flags for future implementation
-	powerStatsCmd.Flags().String("rack-ids", "", "Comma-separated list of rack UUIDs")
-	powerStatsCmd.Flags().String("rack-names", "", "Comma-separated list of rack names")
-	powerStatsCmd.Flags().String("component-ids", "", "Comma-separated list of component IDs")
-	powerStatsCmd.Flags().StringP("type", "t", "", "Component type: compute, nvlswitch, powershelf")
-	powerStatsCmd.Flags().StringP("output", "o", "json", "Output format: json, table")
-	powerStatsCmd.Flags().String("host", "localhost", "RLA server host")
-	powerStatsCmd.Flags().Int("port", 50051, "RLA server port")
+	powerStatsCmd.Flags().StringVar(&powerStatsRackIDs, "rack-ids", "", "Comma-separated list of rack UUIDs")
+	powerStatsCmd.Flags().StringVar(&powerStatsRackNames, "rack-names", "", "Comma-separated list of rack names")
+	powerStatsCmd.Flags().StringVar(&powerStatsComponentIDs, "component-ids", "", "Comma-separated list of component IDs")
+	powerStatsCmd.Flags().StringVarP(&powerStatsComponentType, "type", "t", "", "Component type: compute, nvlswitch, powershelf")
+	powerStatsCmd.Flags().StringVarP(&powerStatsOutput, "output", "o", "json", "Output format: json, table")
+	powerStatsCmd.Flags().StringVar(&powerStatsHost, "host", "localhost", "RLA server host")
+	powerStatsCmd.Flags().IntVar(&powerStatsPort, "port", 50051, "RLA server port")
+}
+
+func doGetPowerStats() {
+	optionCount := 0
+	if powerStatsRackIDs != "" {
+		optionCount++
+	}
+	if powerStatsRackNames != "" {
+		optionCount++
+	}
+	if powerStatsComponentIDs != "" {
+		optionCount++
+	}
+
+	if optionCount > 1 {
+		log.Fatal().Msg("Only one of --rack-ids, --rack-names, or --component-ids can be specified")
+	}
+
+	if powerStatsComponentIDs != "" && powerStatsComponentType == "" {
+		log.Fatal().Msg("--type is required when using --component-ids")
+	}
+
+	componentType := parseComponentTypeToTypes(powerStatsComponentType)
+
+	c, err := client.New(client.Config{
+		Host: powerStatsHost,
+		Port: powerStatsPort,
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to create client")
+	}
+	defer c.Close()
+
+	ctx := context.Background()
+	var result *client.GetPowerStatsResult
+
+	if powerStatsRackIDs != "" {
+		rackIDStrs := strings.Split(powerStatsRackIDs, ",")
+		rackIDs := make([]uuid.UUID, 0, len(rackIDStrs))
+		for _, idStr := range rackIDStrs {
+			id, err := uuid.Parse(strings.TrimSpace(idStr))
+			if err != nil {
+				log.Fatal().Err(err).Str("id", idStr).Msg("Invalid rack UUID")
+			}
+			rackIDs = append(rackIDs, id)
+		}
+		result, err = c.GetPowerStatsByRackIDs(ctx, rackIDs, componentType)
+	} else if powerStatsRackNames != "" {
+		rackNames := strings.Split(powerStatsRackNames, ",")
+		for i := range rackNames {
+			rackNames[i] = strings.TrimSpace(rackNames[i])
+		}
+		result, err = c.GetPowerStatsByRackNames(ctx, rackNames, componentType)
+	} else if powerStatsComponentIDs != "" {
+		componentIDs := strings.Split(powerStatsComponentIDs, ",")
+		for i := range componentIDs {
+			componentIDs[i] = strings.TrimSpace(componentIDs[i])
+		}
+		result, err = c.GetPowerStatsByComponentIDs(ctx, componentIDs, componentType)
+	} else {
+		result, err = c.GetPowerStats(ctx)
+	}
+
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to get power stats")
+	}
+
+	switch powerStatsOutput {
+	case "json":
+		outputPowerStatsJSON(result)
+	case "table":
+		outputPowerStatsTable(result)
+	default:
+		log.Fatal().Str("format", powerStatsOutput).Msg("Unknown output format")
+	}
+}
+
+func outputPowerStatsJSON(result *client.GetPowerStatsResult) {
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to marshal JSON")
+	}
+	fmt.Println(string(data))
+}
+
+func outputPowerStatsTable(result *client.GetPowerStatsResult) {
+	fmt.Printf("Collected at: %s\n", result.CollectedAt.Format("2006-01-02 15:04:05 MST"))
+	fmt.Println()
+
+	fmt.Printf("Racks: %d\n", len(result.Racks))
+	fmt.Println(strings.Repeat("-", 110))
+	fmt.Printf("%-30s %-12s %-12s %-12s %-12s %-12s %-12s\n",
+		"RACK", "COMPUTE_W", "NVLSWITCH_W", "SHELF_OUT_W", "DRAW_W", "BUDGET_W", "UNAVAILABLE")
+	fmt.Println(strings.Repeat("-", 110))
+	for _, r := range result.Racks {
+		budget := "-"
+		if r.BudgetWatts != nil && *r.BudgetWatts > 0 {
+			budget = fmt.Sprintf("%.0f", *r.BudgetWatts)
+		}
+		fmt.Printf("%-30s %-12.0f %-12.0f %-12.0f %-12.0f %-12s %-12d\n",
+			r.RackName,
+			r.ComputeWatts,
+			r.NVLSwitchWatts,
+			r.PowerShelfOutputWatts,
+			r.DrawWatts,
+			budget,
+			r.UnavailableComponents,
+		)
+	}
+
+	if len(result.NVLDomains) > 0 {
+		fmt.Println()
+		fmt.Printf("NVL domains: %d\n", len(result.NVLDomains))
+		fmt.Println(strings.Repeat("-", 80))
+		fmt.Printf("%-30s %-8s %-12s %-12s\n", "NVL_DOMAIN", "RACKS", "DRAW_W", "BUDGET_W")
+		fmt.Println(strings.Repeat("-", 80))
+		for _, d := range result.NVLDomains {
+			fmt.Printf("%-30s %-8d %-12.0f %-12.0f\n",
+				d.NVLDomain.Name,
+				d.RackCount,
+				d.DrawWatts,
+				d.BudgetWatts,
+			)
+		}
+	}
 }
@@ -45,7 +45,8 @@ type rackInput struct {
 		Room       string `json:"room"`
 		Position   string `json:"position"`
 	} `json:"location"`
-	Components []rackComponentInput `json:"components"`
+	Components       []rackComponentInput `json:"components"`
+	PowerBudgetWatts *float64             `json:"power_budget_watts"`
 }
 
 type rackComponentInput struct {
@@ -108,6 +109,16 @@ func parseRackJSON(data []byte) (*types.Rack, error) {
 		},
 	}
 
+	if input.PowerBudgetWatts != nil {
+		if *input.PowerBudgetWatts < 0 {
+			return nil, fmt.Errorf(
+				"invalid power budget %v: must not be negative",
+				*input.PowerBudgetWatts,
+			)
+		}
+		rack.PowerBudgetWatts = input.PowerBudgetWatts
+	}
+
 	if input.Info.ID != "" {
 		id, err := uuid.Parse(input.Info.ID)
 		if err != nil {
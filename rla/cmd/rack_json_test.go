@@ -134,6 +134,18 @@ func TestParseRackJSON(t *testing.T) {
 				assert.Equal(t, "Row-1", rack.Location.Position)
 			},
 		},
+		"rack with power budget": {
+			json: `{"info": {"name": "R1"}, "power_budget_watts": 120000}`,
+			validate: func(t *testing.T, rack *types.Rack) {
+				require.NotNil(t, rack.PowerBudgetWatts)
+				assert.Equal(t, 120000.0, *rack.PowerBudgetWatts)
+			},
+		},
+		"rack with negative power budget": {
+			json:        `{"info": {}, "power_budget_watts": -1}`,
+			expectError: true,
+			errContains: "invalid power budget",
+		},
 		"rack with compute component": {
 			json: `{
 				"info": {"name": "R1"},
@@ -284,6 +284,7 @@ func doServe() {
 			ExecutorConf:  &temporalManagerConf,
 			CarbideClient: clients.carbide,
 			PSMClient:     clients.psm,
+			NSMClient:     clients.nsm,
 		},
 	)
 
@@ -0,0 +1,80 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
+)
+
+var windowCmd = &cobra.Command{
+	Use:   "window",
+	Short: "Maintenance window management",
+	Long: `Commands for managing maintenance windows.
+
+Power control, firmware upgrade and bring-up tasks only run while the
+maintenance window of their rack is open. Tasks submitted while it is closed
+wait until it opens, and running tasks pause between stages when it closes.
+Racks with no enabled window are not restricted.`,
+}
+
+func init() {
+	rootCmd.AddCommand(windowCmd)
+}
+
+// parseBlackout parses a blackout given as "START,END[,REASON]" with RFC 3339
+// timestamps.
+func parseBlackout(s string) (types.BlackoutPeriod, error) {
+	parts := strings.SplitN(s, ",", 3)
+	if len(parts) < 2 {
+		return types.BlackoutPeriod{}, fmt.Errorf("invalid blackout %q: expected START,END[,REASON]", s)
+	}
+
+	start, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[0]))
+	if err != nil {
+		return types.BlackoutPeriod{}, fmt.Errorf("invalid blackout start: %w", err)
+	}
+
+	end, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[1]))
+	if err != nil {
+		return types.BlackoutPeriod{}, fmt.Errorf("invalid blackout end: %w", err)
+	}
+
+	blackout := types.BlackoutPeriod{Start: start, End: end}
+	if len(parts) == 3 {
+		blackout.Reason = strings.TrimSpace(parts[2])
+	}
+
+	return blackout, nil
+}
+
+// maintenanceOverrideOptions returns the submit options for an optional
+// maintenance window override reason given on the command line.
+func maintenanceOverrideOptions(reason string) []client.SubmitOption {
+	if reason == "" {
+		return nil
+	}
+
+	return []client.SubmitOption{client.WithMaintenanceOverride(reason)}
+}
@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
+)
+
+var windowCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a maintenance window",
+	Long: `Create a maintenance window. Without --rack-id the window is site-wide;
+windows attached to a rack replace the site-wide windows for that rack.
+
+Examples:
+  # Every Saturday 02:00-06:00 US Pacific, site-wide
+  rla window create --name weekly --schedule "0 2 * * 6" --duration 4h --timezone America/Los_Angeles
+
+  # Nightly for a single rack, with a change freeze
+  rla window create --name nightly --rack-id <uuid> --schedule "0 1 * * *" --duration 2h \
+    --blackout "2026-12-20T00:00:00Z,2027-01-04T00:00:00Z,holiday freeze"`,
+	RunE: runWindowCreate,
+}
+
+var (
+	windowCreateHost        string
+	windowCreatePort        int
+	windowCreateName        string
+	windowCreateDescription string
+	windowCreateRackID      string
+	windowCreateSchedule    string
+	windowCreateDuration    string
+	windowCreateTimezone    string
+	windowCreateBlackouts   []string
+	windowCreateDisabled    bool
+)
+
+func init() {
+	windowCmd.AddCommand(windowCreateCmd)
+
+	windowCreateCmd.Flags().StringVar(&windowCreateHost, "host", "localhost", "RLA service host")
+	windowCreateCmd.Flags().IntVar(&windowCreatePort, "port", 50051, "RLA service port")
+	windowCreateCmd.Flags().StringVar(&windowCreateName, "name", "", "Window name (required)")
+	windowCreateCmd.Flags().StringVar(&windowCreateDescription, "description", "", "Window description")
+	windowCreateCmd.Flags().StringVar(&windowCreateRackID, "rack-id", "", "Rack UUID (default: site-wide)")
+	windowCreateCmd.Flags().StringVar(&windowCreateSchedule, "schedule", "", "Cron expression for each opening, e.g. \"0 2 * * 6\" (required)")
+	windowCreateCmd.Flags().StringVar(&windowCreateDuration, "duration", "", "How long the window stays open, e.g. 4h (required)")
+	windowCreateCmd.Flags().StringVar(&windowCreateTimezone, "timezone", "UTC", "IANA timezone the schedule is evaluated in")
+	windowCreateCmd.Flags().StringArrayVar(&windowCreateBlackouts, "blackout", nil, "Blackout period START,END[,REASON] in RFC 3339 (repeatable)")
+	windowCreateCmd.Flags().BoolVar(&windowCreateDisabled, "disabled", false, "Create the window disabled")
+
+	windowCreateCmd.MarkFlagRequired("name")     //nolint
+	windowCreateCmd.MarkFlagRequired("schedule") //nolint
+	windowCreateCmd.MarkFlagRequired("duration") //nolint
+}
+
+func runWindowCreate(cmd *cobra.Command, args []string) error {
+	duration, err := time.ParseDuration(windowCreateDuration)
+	if err != nil {
+		return fmt.Errorf("invalid duration: %w", err)
+	}
+
+	window := &types.MaintenanceWindow{
+		Name:        windowCreateName,
+		Description: windowCreateDescription,
+		Schedule:    windowCreateSchedule,
+		Duration:    duration,
+		Timezone:    windowCreateTimezone,
+		Enabled:     !windowCreateDisabled,
+	}
+
+	if windowCreateRackID != "" {
+		rackID, err := uuid.Parse(windowCreateRackID)
+		if err != nil {
+			return fmt.Errorf("invalid rack ID: %w", err)
+		}
+		window.RackID = &rackID
+	}
+
+	for _, b := range windowCreateBlackouts {
+		blackout, err := parseBlackout(b)
+		if err != nil {
+			return err
+		}
+		window.Blackouts = append(window.Blackouts, blackout)
+	}
+
+	rlaClient, err := client.New(client.Config{
+		Host: windowCreateHost,
+		Port: windowCreatePort,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	windowID, err := rlaClient.CreateMaintenanceWindow(context.Background(), window)
+	if err != nil {
+		return fmt.Errorf("failed to create maintenance window: %w", err)
+	}
+
+	fmt.Printf("Successfully created maintenance window\n")
+	fmt.Printf("ID:   %s\n", windowID.String())
+	fmt.Printf("Name: %s\n", windowCreateName)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+)
+
+var windowDeleteCmd = &cobra.Command{
+	Use:   "delete <window-id>",
+	Short: "Delete a maintenance window",
+	Long:  `Delete a maintenance window by ID. Tasks waiting on it are re-evaluated against the remaining windows.`,
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWindowDelete,
+}
+
+var (
+	windowDeleteHost string
+	windowDeletePort int
+)
+
+func init() {
+	windowCmd.AddCommand(windowDeleteCmd)
+
+	windowDeleteCmd.Flags().StringVar(&windowDeleteHost, "host", "localhost", "RLA service host")
+	windowDeleteCmd.Flags().IntVar(&windowDeletePort, "port", 50051, "RLA service port")
+}
+
+func runWindowDelete(cmd *cobra.Command, args []string) error {
+	windowIDStr := args[0]
+
+	windowID, err := uuid.Parse(windowIDStr)
+	if err != nil {
+		return fmt.Errorf("invalid window ID: %w", err)
+	}
+
+	rlaClient, err := client.New(client.Config{
+		Host: windowDeleteHost,
+		Port: windowDeletePort,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	if err := rlaClient.DeleteMaintenanceWindow(context.Background(), windowID); err != nil {
+		return fmt.Errorf("failed to delete maintenance window: %w", err)
+	}
+
+	fmt.Printf("Successfully deleted maintenance window %s\n", windowIDStr)
+	return nil
+}
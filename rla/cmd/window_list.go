@@ -0,0 +1,113 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+)
+
+var windowListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List maintenance windows",
+	Long:  `List maintenance windows. With --rack-id, only the windows that can govern that rack (its own and site-wide) are shown.`,
+	RunE:  runWindowList,
+}
+
+var (
+	windowListHost   string
+	windowListPort   int
+	windowListRackID string
+)
+
+func init() {
+	windowCmd.AddCommand(windowListCmd)
+
+	windowListCmd.Flags().StringVar(&windowListHost, "host", "localhost", "RLA service host")
+	windowListCmd.Flags().IntVar(&windowListPort, "port", 50051, "RLA service port")
+	windowListCmd.Flags().StringVar(&windowListRackID, "rack-id", "", "Filter by rack UUID")
+}
+
+func runWindowList(cmd *cobra.Command, args []string) error {
+	var rackID *uuid.UUID
+	if windowListRackID != "" {
+		id, err := uuid.Parse(windowListRackID)
+		if err != nil {
+			return fmt.Errorf("invalid rack ID: %w", err)
+		}
+		rackID = &id
+	}
+
+	rlaClient, err := client.New(client.Config{
+		Host: windowListHost,
+		Port: windowListPort,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	windows, err := rlaClient.ListMaintenanceWindows(context.Background(), rackID)
+	if err != nil {
+		return fmt.Errorf("failed to list maintenance windows: %w", err)
+	}
+
+	if len(windows) == 0 {
+		fmt.Println("No maintenance windows found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSCOPE\tSCHEDULE\tDURATION\tTIMEZONE\tBLACKOUTS\tENABLED")
+	fmt.Fprintln(w, "--\t----\t-----\t--------\t--------\t--------\t---------\t-------")
+
+	for _, window := range windows {
+		scope := "site"
+		if window.RackID != nil {
+			scope = "rack " + window.RackID.String()
+		}
+
+		enabled := "no"
+		if window.Enabled {
+			enabled = "yes"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\n",
+			window.ID.String(),
+			window.Name,
+			scope,
+			window.Schedule,
+			window.Duration.String(),
+			window.Timezone,
+			len(window.Blackouts),
+			enabled,
+		)
+	}
+
+	w.Flush()
+	fmt.Printf("\nTotal: %d maintenance windows\n", len(windows))
+
+	return nil
+}
@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/spf13/cobra"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+)
+
+var windowStatusCmd = &cobra.Command{
+	Use:   "status <rack-id>",
+	Short: "Show whether a rack's maintenance window is open",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runWindowStatus,
+}
+
+var (
+	windowStatusHost string
+	windowStatusPort int
+)
+
+func init() {
+	windowCmd.AddCommand(windowStatusCmd)
+
+	windowStatusCmd.Flags().StringVar(&windowStatusHost, "host", "localhost", "RLA service host")
+	windowStatusCmd.Flags().IntVar(&windowStatusPort, "port", 50051, "RLA service port")
+}
+
+func runWindowStatus(cmd *cobra.Command, args []string) error {
+	rackID, err := uuid.Parse(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid rack ID: %w", err)
+	}
+
+	rlaClient, err := client.New(client.Config{
+		Host: windowStatusHost,
+		Port: windowStatusPort,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rlaClient.Close()
+
+	status, err := rlaClient.GetMaintenanceWindowStatus(context.Background(), rackID)
+	if err != nil {
+		return fmt.Errorf("failed to get maintenance window status: %w", err)
+	}
+
+	switch {
+	case !status.Governed:
+		fmt.Println("No maintenance window applies to this rack; disruptive tasks run immediately")
+	case status.Open:
+		fmt.Printf("OPEN (window %q)\n", status.WindowName)
+		if status.ClosesAt != nil {
+			fmt.Printf("Closes at: %s\n", status.ClosesAt.Local().Format(time.RFC3339))
+		}
+	default:
+		fmt.Println("CLOSED")
+		if status.NextOpening != nil {
+			fmt.Printf("Next opening: %s (window %q)\n", status.NextOpening.Local().Format(time.RFC3339), status.WindowName)
+		} else {
+			fmt.Println("No opening scheduled; submit with --override-window to run anyway")
+		}
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ package carbideapi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -376,6 +377,72 @@ func (c *grpcClient) AddExpectedSwitch(ctx context.Context, req AddExpectedSwitc
 	return nil
 }
 
+// GetMachinePowerDraw reads the power consumption of the given machines from their BMCs through carbide-api's
+// Redfish browse proxy.  Every chassis exposed by a BMC is checked and the largest PowerConsumedWatts is used, since
+// the enclosure chassis reports the draw of the whole tray while sub-chassis only report their own share.
+func (c *grpcClient) GetMachinePowerDraw(ctx context.Context, machineIds []string) ([]MachinePowerDraw, error) {
+	machines, err := c.FindMachinesByIds(ctx, machineIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []MachinePowerDraw
+	for _, machine := range machines {
+		if machine.BmcIP == "" {
+			continue
+		}
+		watts, ok, err := c.bmcPowerDraw(ctx, machine.BmcIP)
+		if err != nil {
+			log.Debug().Err(err).Str("machine_id", machine.MachineID).Msg("Unable to read power draw from BMC")
+			continue
+		}
+		if ok {
+			ret = append(ret, MachinePowerDraw{MachineID: machine.MachineID, Watts: watts})
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *grpcClient) bmcPowerDraw(ctx context.Context, bmcIP string) (watts float64, ok bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	base := fmt.Sprintf("https://%s", bmcIP)
+
+	var chassis redfishCollection
+	if err := c.redfishGet(ctx, base+"/redfish/v1/Chassis", &chassis); err != nil {
+		return 0, false, err
+	}
+
+	for _, member := range chassis.Members {
+		var power redfishPower
+		if err := c.redfishGet(ctx, base+member.ODataID+"/Power", &power); err != nil {
+			// Not every chassis exposes a Power resource
+			continue
+		}
+		for _, pc := range power.PowerControl {
+			if pc.PowerConsumedWatts != nil && *pc.PowerConsumedWatts > watts {
+				watts = *pc.PowerConsumedWatts
+				ok = true
+			}
+		}
+	}
+
+	return watts, ok, nil
+}
+
+func (c *grpcClient) redfishGet(ctx context.Context, uri string, out any) error {
+	res, err := c.gclient.RedfishBrowse(ctx, &pb.RedfishBrowseRequest{Uri: uri})
+	if err != nil {
+		return fmt.Errorf("failed to browse %s: %w", uri, err)
+	}
+	if err := json.Unmarshal([]byte(res.GetText()), out); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", uri, err)
+	}
+	return nil
+}
+
 func (c *grpcClient) AddMachine(machine Machine) {
 	panic("Not a unit test")
 }
@@ -395,3 +462,7 @@ func (c *grpcClient) SetAdminPowerControlError(err error) {
 func (c *grpcClient) AddMachineInterface(iface MachineInterface) {
 	panic("Not a unit test")
 }
+
+func (c *grpcClient) AddPowerDraw(machineID string, watts float64) {
+	panic("Not a unit test")
+}
@@ -28,6 +28,7 @@ type mockClient struct {
 	machineInterfaces           map[string]MachineInterface
 	firmwareUpdateTimeWindowErr error // If set, SetFirmwareUpdateTimeWindow will return this error
 	adminPowerControlErr        error // If set, AdminPowerControl will return this error
+	powerDraws                  map[string]float64
 }
 
 // NewMockClient returns a "GRPC" client that returns mock values so it can be used in unit tests.
@@ -36,6 +37,7 @@ func NewMockClient() Client {
 		machines:          map[string]Machine{},
 		powerStates:       map[string]PowerState{},
 		machineInterfaces: map[string]MachineInterface{},
+		powerDraws:        map[string]float64{},
 	}
 }
 
@@ -135,3 +137,17 @@ func (c *mockClient) AddExpectedMachine(ctx context.Context, req AddExpectedMach
 func (c *mockClient) AddExpectedSwitch(ctx context.Context, req AddExpectedSwitchRequest) error {
 	return nil
 }
+
+func (c *mockClient) GetMachinePowerDraw(ctx context.Context, machineIds []string) (ret []MachinePowerDraw, err error) {
+	for _, cur := range machineIds {
+		if watts, ok := c.powerDraws[cur]; ok {
+			ret = append(ret, MachinePowerDraw{MachineID: cur, Watts: watts})
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *mockClient) AddPowerDraw(machineID string, watts float64) {
+	c.powerDraws[machineID] = watts
+}
@@ -62,10 +62,15 @@ type Client interface {
 	// AddExpectedSwitch registers an expected switch with Carbide for ingestion.
 	AddExpectedSwitch(ctx context.Context, req AddExpectedSwitchRequest) error
 
+	// GetMachinePowerDraw returns the power currently consumed by the given machines, read from their BMCs.
+	// Machines whose BMC does not report a reading are omitted from the result.
+	GetMachinePowerDraw(ctx context.Context, machineIds []string) ([]MachinePowerDraw, error)
+
 	// The following are only valid in the mock environment and should only be called by unit tests
 	AddMachine(Machine)
 	AddPowerState(machineID string, state PowerState)
 	SetFirmwareUpdateTimeWindowError(err error)
 	SetAdminPowerControlError(err error)
 	AddMachineInterface(iface MachineInterface)
+	AddPowerDraw(machineID string, watts float64)
 }
@@ -141,6 +141,26 @@ type MachinePowerState struct {
 	PowerState PowerState
 }
 
+// MachinePowerDraw is the instantaneous power consumption of a machine as reported by its BMC
+type MachinePowerDraw struct {
+	MachineID string
+	Watts     float64
+}
+
+// redfishCollection is the subset of a Redfish resource collection we read through RedfishBrowse
+type redfishCollection struct {
+	Members []struct {
+		ODataID string `json:"@odata.id"`
+	} `json:"Members"`
+}
+
+// redfishPower is the subset of a Redfish chassis Power resource we read through RedfishBrowse
+type redfishPower struct {
+	PowerControl []struct {
+		PowerConsumedWatts *float64 `json:"PowerConsumedWatts"`
+	} `json:"PowerControl"`
+}
+
 func machinePowerStateFromPb(state *pb.PowerOptions) MachinePowerState {
 	return MachinePowerState{MachineID: state.HostId.Id, PowerState: powerStateFromPb(state.ActualState)}
 }
@@ -36,11 +36,49 @@ type Config struct {
 	UpdateMachineIDsFrequency time.Duration `yaml:"update_machine_ids_frequency"`
 	GRPCTimeout               time.Duration `yaml:"grpc_timeout"`
 	DisableInventory          bool          `yaml:"disable_inventory"`
+
+	// Power budget enforcement: how often rack draw is sampled, how long it must stay over budget before alerting,
+	// and the draw assumed for a tray being powered on when projecting against the budget.
+	PowerMonitorFrequency    time.Duration `yaml:"power_monitor_frequency"`
+	PowerBudgetSustainPeriod time.Duration `yaml:"power_budget_sustain_period"`
+	NominalComputeWatts      float64       `yaml:"nominal_compute_watts"`
+	NominalNVLSwitchWatts    float64       `yaml:"nominal_nvlswitch_watts"`
+
+	// Drift remediation policies keyed by drift field name (slot_id, tray_index, host_id, firmware_version,
+	// serial_number). Fields without a policy are only recorded in the drift history.
+	DriftPolicies map[string]DriftPolicy `yaml:"drift_policies"`
+}
+
+// DriftAction is what the inventory loop does about a field drift.
+type DriftAction string
+
+const (
+	// DriftActionRecord only records the drift in the drift history.
+	DriftActionRecord DriftAction = "record"
+	// DriftActionAccept writes the actual value into the expected component, resolving the drift.
+	DriftActionAccept DriftAction = "accept"
+	// DriftActionAlert raises an alert once for the drift.
+	DriftActionAlert DriftAction = "alert"
+)
+
+// DriftPolicy is the remediation policy for one drift field.
+type DriftPolicy struct {
+	Action   DriftAction   `yaml:"action"`
+	After    time.Duration `yaml:"after"`    // How long the drift must persist before the action is taken
+	Severity string        `yaml:"severity"` // Alert severity (info, warning, critical); warning if empty
 }
 
 // defaultConfig sets up the default values used when something is not specified
 func defaultConfig() Config {
-	return Config{InventoryRunFrequency: time.Minute, GRPCTimeout: time.Minute, UpdateMachineIDsFrequency: time.Hour}
+	return Config{
+		InventoryRunFrequency:     time.Minute,
+		GRPCTimeout:               time.Minute,
+		UpdateMachineIDsFrequency: time.Hour,
+		PowerMonitorFrequency:     time.Minute,
+		PowerBudgetSustainPeriod:  5 * time.Minute,
+		NominalComputeWatts:       6000,
+		NominalNVLSwitchWatts:     1600,
+	}
 }
 
 // ReadConfig reads a configuration file if present and returns a Config with the details.  A config file with
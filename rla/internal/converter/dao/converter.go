@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/db/model"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/operation"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
 	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
@@ -151,7 +153,8 @@ func RackFrom(dao *model.Rack) *rack.Rack {
 		Loc: location.New(
 			[]byte(utils.MapToJSONString(dao.Location)),
 		),
-		Components: components,
+		Components:       components,
+		PowerBudgetWatts: dao.PowerBudget,
 	}
 }
 
@@ -191,6 +194,8 @@ func TaskFrom(dao *model.Task) *taskdef.Task {
 		Status:         dao.Status,
 		Message:        dao.Message,
 		AppliedRuleID:  dao.AppliedRuleID,
+		WindowOverride: dao.WindowOverride,
+		OverrideReason: dao.OverrideReason,
 	}
 }
 
@@ -288,6 +293,7 @@ func RackTo(r *rack.Rack) *model.Rack {
 		Description:  utils.JSONStringToMap("description", r.Info.Description),
 		Location:     r.Loc.ToMap(),
 		Components:   components,
+		PowerBudget:  r.PowerBudgetWatts,
 	}
 }
 
@@ -320,6 +326,8 @@ func TaskTo(task *taskdef.Task) *model.Task {
 		Status:         task.Status,
 		Message:        task.Message,
 		AppliedRuleID:  task.AppliedRuleID,
+		WindowOverride: task.WindowOverride,
+		OverrideReason: task.OverrideReason,
 	}
 }
 
@@ -410,3 +418,77 @@ func RackRuleAssociationTo(assoc *operationrules.RackRuleAssociation) *model.Rac
 		UpdatedAt:     assoc.UpdatedAt,
 	}
 }
+
+// MaintenanceWindowTo converts domain object to database model
+func MaintenanceWindowTo(w *maintenance.Window) (*model.MaintenanceWindow, error) {
+	if w == nil {
+		return nil, nil
+	}
+
+	blackouts := w.Blackouts
+	if blackouts == nil {
+		blackouts = []maintenance.Blackout{}
+	}
+
+	blackoutsJSON, err := json.Marshal(blackouts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal blackouts: %w", err)
+	}
+
+	timezone := w.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	dbModel := &model.MaintenanceWindow{
+		ID:              w.ID,
+		Name:            w.Name,
+		RackID:          w.RackID,
+		Schedule:        w.Schedule,
+		DurationSeconds: int64(w.Duration / time.Second),
+		Timezone:        timezone,
+		Blackouts:       blackoutsJSON,
+		Enabled:         w.Enabled,
+		CreatedAt:       w.CreatedAt,
+		UpdatedAt:       w.UpdatedAt,
+	}
+
+	if w.Description != "" {
+		dbModel.Description = sql.NullString{String: w.Description, Valid: true}
+	}
+
+	return dbModel, nil
+}
+
+// MaintenanceWindowFrom converts database model to domain object
+func MaintenanceWindowFrom(dbModel *model.MaintenanceWindow) (*maintenance.Window, error) {
+	if dbModel == nil {
+		return nil, nil
+	}
+
+	var blackouts []maintenance.Blackout
+	if len(dbModel.Blackouts) > 0 {
+		if err := json.Unmarshal(dbModel.Blackouts, &blackouts); err != nil {
+			return nil, errors.GRPCErrorInternal(fmt.Sprintf("failed to unmarshal blackouts: %v", err))
+		}
+	}
+
+	w := &maintenance.Window{
+		ID:        dbModel.ID,
+		Name:      dbModel.Name,
+		RackID:    dbModel.RackID,
+		Schedule:  dbModel.Schedule,
+		Duration:  time.Duration(dbModel.DurationSeconds) * time.Second,
+		Timezone:  dbModel.Timezone,
+		Blackouts: blackouts,
+		Enabled:   dbModel.Enabled,
+		CreatedAt: dbModel.CreatedAt,
+		UpdatedAt: dbModel.UpdatedAt,
+	}
+
+	if dbModel.Description.Valid {
+		w.Description = dbModel.Description.String
+	}
+
+	return w, nil
+}
@@ -21,13 +21,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/nvidia/bare-metal-manager-rest/common/pkg/credential"
 	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	inventorystore "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/store"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/power"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
 	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
@@ -235,11 +239,18 @@ func RackFrom(r *pb.Rack) *rack.Rack {
 		components = append(components, *ComponentFrom(c))
 	}
 
-	return &rack.Rack{
+	rk := &rack.Rack{
 		Info:       DeviceInfoFrom(r.GetInfo()),
 		Loc:        LocationFrom(r.GetLocation()),
 		Components: components,
 	}
+
+	if r.PowerBudgetWatts != nil {
+		budget := r.GetPowerBudgetWatts()
+		rk.PowerBudgetWatts = &budget
+	}
+
+	return rk
 }
 
 func PaginationFrom(pg *pb.Pagination) *dbquery.Pagination {
@@ -400,6 +411,10 @@ func TaskStatusFrom(status pb.TaskStatus) taskcommon.TaskStatus {
 		return taskcommon.TaskStatusCompleted
 	case pb.TaskStatus_TASK_STATUS_FAILED:
 		return taskcommon.TaskStatusFailed
+	case pb.TaskStatus_TASK_STATUS_WAITING:
+		return taskcommon.TaskStatusWaiting
+	case pb.TaskStatus_TASK_STATUS_PAUSED:
+		return taskcommon.TaskStatusPaused
 	default:
 		return taskcommon.TaskStatusUnknown
 	}
@@ -415,6 +430,10 @@ func TaskStatusTo(status taskcommon.TaskStatus) pb.TaskStatus {
 		return pb.TaskStatus_TASK_STATUS_COMPLETED
 	case taskcommon.TaskStatusFailed:
 		return pb.TaskStatus_TASK_STATUS_FAILED
+	case taskcommon.TaskStatusWaiting:
+		return pb.TaskStatus_TASK_STATUS_WAITING
+	case taskcommon.TaskStatusPaused:
+		return pb.TaskStatus_TASK_STATUS_PAUSED
 	default:
 		return pb.TaskStatus_TASK_STATUS_UNKNOWN
 	}
@@ -446,6 +465,8 @@ func TaskTo(task *taskdef.Task) *pb.Task {
 		ExecutionId:    task.ExecutionID,
 		Status:         TaskStatusTo(task.Status),
 		Message:        task.Message,
+		WindowOverride: task.WindowOverride,
+		OverrideReason: task.OverrideReason,
 	}
 }
 
@@ -600,9 +621,10 @@ func RackTo(r *rack.Rack) *pb.Rack {
 	}
 
 	return &pb.Rack{
-		Info:       DeviceInfoTo(&r.Info),
-		Location:   LocationTo(&r.Loc),
-		Components: components,
+		Info:             DeviceInfoTo(&r.Info),
+		Location:         LocationTo(&r.Loc),
+		Components:       components,
+		PowerBudgetWatts: r.PowerBudgetWatts,
 	}
 }
 
@@ -898,3 +920,200 @@ func RackRuleAssociationFromProto(pbAssoc *pb.RackRuleAssociation) *operationrul
 
 	return assoc
 }
+
+// MaintenanceWindowTo converts a maintenance window to protobuf
+func MaintenanceWindowTo(w *maintenance.Window) *pb.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+
+	blackouts := make([]*pb.BlackoutPeriod, 0, len(w.Blackouts))
+	for _, b := range w.Blackouts {
+		blackouts = append(blackouts, &pb.BlackoutPeriod{
+			Start:  timestamppb.New(b.Start),
+			End:    timestamppb.New(b.End),
+			Reason: b.Reason,
+		})
+	}
+
+	pbWindow := &pb.MaintenanceWindow{
+		Id:              UUIDTo(w.ID),
+		Name:            w.Name,
+		Description:     w.Description,
+		Schedule:        w.Schedule,
+		DurationSeconds: int64(w.Duration / time.Second),
+		Timezone:        w.Timezone,
+		Blackouts:       blackouts,
+		Enabled:         w.Enabled,
+		CreatedAt:       timestamppb.New(w.CreatedAt),
+		UpdatedAt:       timestamppb.New(w.UpdatedAt),
+	}
+
+	if w.RackID != nil {
+		pbWindow.RackId = UUIDTo(*w.RackID)
+	}
+
+	return pbWindow
+}
+
+// MaintenanceWindowFrom converts protobuf to a maintenance window
+func MaintenanceWindowFrom(pbWindow *pb.MaintenanceWindow) *maintenance.Window {
+	if pbWindow == nil {
+		return nil
+	}
+
+	w := &maintenance.Window{
+		ID:          UUIDFrom(pbWindow.GetId()),
+		Name:        pbWindow.GetName(),
+		Description: pbWindow.GetDescription(),
+		Schedule:    pbWindow.GetSchedule(),
+		Duration:    time.Duration(pbWindow.GetDurationSeconds()) * time.Second,
+		Timezone:    pbWindow.GetTimezone(),
+		Enabled:     pbWindow.GetEnabled(),
+	}
+
+	if rackID := UUIDFrom(pbWindow.GetRackId()); rackID != uuid.Nil {
+		w.RackID = &rackID
+	}
+
+	for _, b := range pbWindow.GetBlackouts() {
+		w.Blackouts = append(w.Blackouts, maintenance.Blackout{
+			Start:  b.GetStart().AsTime(),
+			End:    b.GetEnd().AsTime(),
+			Reason: b.GetReason(),
+		})
+	}
+
+	if pbWindow.GetCreatedAt() != nil {
+		w.CreatedAt = pbWindow.GetCreatedAt().AsTime()
+	}
+	if pbWindow.GetUpdatedAt() != nil {
+		w.UpdatedAt = pbWindow.GetUpdatedAt().AsTime()
+	}
+
+	return w
+}
+
+// MaintenanceWindowStatusTo converts a maintenance window evaluation to protobuf
+func MaintenanceWindowStatusTo(status *maintenance.Status) *pb.MaintenanceWindowStatus {
+	if status == nil {
+		return nil
+	}
+
+	pbStatus := &pb.MaintenanceWindowStatus{
+		Governed:   status.Governed,
+		Open:       status.Open,
+		WindowId:   UUIDTo(status.WindowID),
+		WindowName: status.WindowName,
+	}
+
+	if status.ClosesAt != nil {
+		pbStatus.ClosesAt = timestamppb.New(*status.ClosesAt)
+	}
+	if status.NextOpening != nil {
+		pbStatus.NextOpening = timestamppb.New(*status.NextOpening)
+	}
+
+	return pbStatus
+}
+
+// PowerReadingTo converts a component power reading to protobuf
+func PowerReadingTo(r *power.Reading) *pb.ComponentPowerStats {
+	if r == nil {
+		return nil
+	}
+
+	return &pb.ComponentPowerStats{
+		Id:          UUIDTo(r.ID),
+		ComponentId: r.ComponentID,
+		Type:        ComponentTypeTo(r.Type),
+		RackId:      UUIDTo(r.RackID),
+		Watts:       r.Watts,
+		Available:   r.Available,
+	}
+}
+
+// RackPowerStatsTo converts aggregated rack power to protobuf
+func RackPowerStatsTo(s *power.RackStats) *pb.RackPowerStats {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.RackPowerStats{
+		RackId:                UUIDTo(s.RackID),
+		RackName:              s.RackName,
+		NvlDomainId:           UUIDTo(s.NVLDomainID),
+		ComputeWatts:          s.ComputeWatts,
+		NvlswitchWatts:        s.NVLSwitchWatts,
+		PowershelfOutputWatts: s.PowerShelfOutputWatts,
+		DrawWatts:             s.DrawWatts,
+		BudgetWatts:           s.BudgetWatts,
+		UnavailableComponents: int32(s.Unavailable),
+	}
+}
+
+// NVLDomainPowerStatsTo converts aggregated NVL domain power to protobuf
+func NVLDomainPowerStatsTo(s *power.DomainStats) *pb.NVLDomainPowerStats {
+	if s == nil {
+		return nil
+	}
+
+	return &pb.NVLDomainPowerStats{
+		NvlDomain:   IdentifierTo(&s.NVLDomain),
+		DrawWatts:   s.DrawWatts,
+		BudgetWatts: s.BudgetWatts,
+		RackCount:   int32(s.RackCount),
+	}
+}
+
+// OptionalTimestampTo converts an optional time to protobuf; nil stays unset
+func OptionalTimestampTo(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// DriftTypeTo converts an inventory drift type to the protobuf diff type
+func DriftTypeTo(driftType string) pb.DiffType {
+	switch driftType {
+	case "missing_in_expected":
+		return pb.DiffType_DIFF_TYPE_ONLY_IN_ACTUAL
+	case "missing_in_actual":
+		return pb.DiffType_DIFF_TYPE_ONLY_IN_EXPECTED
+	case "mismatch":
+		return pb.DiffType_DIFF_TYPE_DRIFT
+	default:
+		return pb.DiffType_DIFF_TYPE_UNKNOWN
+	}
+}
+
+// DriftHistoryTo converts a drift history record to protobuf
+func DriftHistoryTo(h *inventorystore.DriftHistory) *pb.DriftHistoryEntry {
+	if h == nil {
+		return nil
+	}
+
+	entry := &pb.DriftHistoryEntry{
+		Id:            UUIDTo(h.ID),
+		Type:          DriftTypeTo(h.DriftType),
+		FieldName:     h.FieldName,
+		ExpectedValue: h.ExpectedValue,
+		ActualValue:   h.ActualValue,
+		FirstSeenAt:   timestamppb.New(h.FirstSeenAt),
+		LastSeenAt:    timestamppb.New(h.LastSeenAt),
+		ResolvedAt:    OptionalTimestampTo(h.ResolvedAt),
+		Remediation:   h.Remediation,
+	}
+
+	if h.ComponentID != nil {
+		entry.ComponentId = h.ComponentID.String()
+	}
+
+	if h.ExternalID != nil {
+		entry.ExternalId = *h.ExternalID
+	}
+
+	return entry
+}
@@ -20,12 +20,15 @@ package protobuf
 import (
 	"net"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/nvidia/bare-metal-manager-rest/common/pkg/credential"
 	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/deviceinfo"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/location"
@@ -72,6 +75,53 @@ func TestComponentTypeConverter(t *testing.T) {
 	assert.Equal(t, pb.ComponentType_COMPONENT_TYPE_UNKNOWN, ComponentTypeTo(devicetypes.ComponentType(-1))) //nolint
 }
 
+func TestTaskStatusConverter(t *testing.T) {
+	for _, status := range []taskcommon.TaskStatus{
+		taskcommon.TaskStatusPending,
+		taskcommon.TaskStatusRunning,
+		taskcommon.TaskStatusCompleted,
+		taskcommon.TaskStatusFailed,
+		taskcommon.TaskStatusWaiting,
+		taskcommon.TaskStatusPaused,
+	} {
+		assert.Equal(t, status, TaskStatusFrom(TaskStatusTo(status)))
+	}
+}
+
+func TestMaintenanceWindowConverter(t *testing.T) {
+	rackID := uuid.New()
+	start := time.Date(2026, 12, 20, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]*maintenance.Window{
+		"site window": {
+			ID:       uuid.New(),
+			Name:     "weekly",
+			Schedule: "0 2 * * 6",
+			Duration: 4 * time.Hour,
+			Timezone: "America/Los_Angeles",
+			Enabled:  true,
+		},
+		"rack window with blackout": {
+			ID:          uuid.New(),
+			Name:        "nightly",
+			Description: "rack maintenance",
+			RackID:      &rackID,
+			Schedule:    "0 1 * * *",
+			Duration:    2 * time.Hour,
+			Timezone:    "UTC",
+			Blackouts: []maintenance.Blackout{
+				{Start: start, End: start.Add(14 * 24 * time.Hour), Reason: "holiday freeze"},
+			},
+		},
+	}
+
+	for name, window := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, window, MaintenanceWindowFrom(MaintenanceWindowTo(window)))
+		})
+	}
+}
+
 func TestBMCTypeConverter(t *testing.T) {
 	for typ, ptype := range bmcTypeToMap {
 		assert.Equal(t, typ, BMCTypeFrom(ptype))
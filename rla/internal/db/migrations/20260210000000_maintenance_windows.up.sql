@@ -0,0 +1,49 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Create maintenance_window table
+-- Windows gate disruptive tasks (power, firmware, bring-up). A NULL rack_id
+-- makes the window site-wide; rack windows take precedence over site windows.
+
+CREATE TABLE public.maintenance_window (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    name character varying(128) NOT NULL,
+    description text,
+    rack_id uuid,
+    schedule character varying(128) NOT NULL,
+    duration_seconds bigint NOT NULL,
+    timezone character varying(64) DEFAULT 'UTC' NOT NULL,
+    blackouts jsonb DEFAULT '[]'::jsonb NOT NULL,
+    enabled boolean DEFAULT true NOT NULL,
+    created_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    CONSTRAINT maintenance_window_pkey PRIMARY KEY (id),
+    CONSTRAINT maintenance_window_duration_check CHECK (duration_seconds > 0),
+    CONSTRAINT maintenance_window_rack_fkey FOREIGN KEY (rack_id)
+        REFERENCES rack(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_maintenance_window_rack_id
+    ON public.maintenance_window(rack_id);
+
+-- Record maintenance window overrides on the task for auditing
+
+ALTER TABLE public.task ADD COLUMN window_override boolean DEFAULT false NOT NULL;
+ALTER TABLE public.task ADD COLUMN override_reason text;
+
+-- Waiting tasks are polled by the window scheduler
+
+CREATE INDEX idx_task_status ON public.task(status);
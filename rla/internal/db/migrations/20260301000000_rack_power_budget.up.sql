@@ -0,0 +1,22 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+-- Per-rack power budget in watts. NULL or 0 means the rack has no budget.
+-- Sustained draw above the budget raises an alert, and power-on requests
+-- whose projected draw exceeds it are refused.
+
+ALTER TABLE public.rack ADD COLUMN power_budget_watts double precision;
+ALTER TABLE public.rack ADD CONSTRAINT rack_power_budget_watts_check
+    CHECK (power_budget_watts IS NULL OR power_budget_watts >= 0);
@@ -0,0 +1,51 @@
+-- SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+-- SPDX-License-Identifier: Apache-2.0
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+-- http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+
+--
+-- Name: component_drift_history; Type: TABLE; Schema: public; Owner: postgres
+-- Records when each drift appeared, when it was last seen and when it was
+-- resolved. Mismatch drifts are tracked per field; missing_in_* drifts have
+-- an empty field_name. A drift that reappears after being resolved opens a
+-- new row, so repeated rows for the same key indicate flapping.
+--
+-- remediation: '' (recorded only), 'accepted' (actual value copied into the
+-- expected component), 'alerted' (an alert was raised)
+--
+
+CREATE TABLE public.component_drift_history (
+    id uuid DEFAULT gen_random_uuid() NOT NULL,
+    component_id uuid,
+    external_id character varying,
+    drift_type character varying(32) NOT NULL,
+    field_name character varying(64) DEFAULT '' NOT NULL,
+    expected_value text DEFAULT '' NOT NULL,
+    actual_value text DEFAULT '' NOT NULL,
+    first_seen_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    last_seen_at timestamp with time zone DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    resolved_at timestamp with time zone,
+    remediation character varying(32) DEFAULT '' NOT NULL,
+
+    CONSTRAINT component_drift_history_pkey PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_component_drift_history_component_id
+    ON public.component_drift_history(component_id);
+
+CREATE INDEX idx_component_drift_history_open
+    ON public.component_drift_history(resolved_at) WHERE resolved_at IS NULL;
+
+-- Age of the current drifts, copied from the oldest open history row
+
+ALTER TABLE public.component_drift ADD COLUMN first_seen_at timestamp with time zone;
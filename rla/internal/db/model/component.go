@@ -186,6 +186,12 @@ func (cd *Component) Patch(ctx context.Context, idb bun.IDB) error {
 	return err
 }
 
+// PatchColumns updates only the given columns of the component.
+func (cd *Component) PatchColumns(ctx context.Context, idb bun.IDB, columns ...string) error {
+	_, err := idb.NewUpdate().Model(cd).Column(columns...).Where("id = ?", cd.ID).Exec(ctx)
+	return err
+}
+
 // Delete soft-deletes the component by setting deleted_at.
 func (cd *Component) Delete(ctx context.Context, idb bun.IDB) error {
 	_, err := idb.NewDelete().Model(cd).Where("id = ?", cd.ID).Exec(ctx)
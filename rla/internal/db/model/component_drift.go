@@ -61,6 +61,7 @@ type ComponentDrift struct {
 	DriftType   DriftType   `bun:"drift_type,type:varchar(32),notnull"`
 	Diffs       []FieldDiff `bun:"diffs,type:jsonb,notnull,default:'[]'"`
 	CheckedAt   time.Time   `bun:"checked_at,notnull,default:current_timestamp"`
+	FirstSeenAt *time.Time  `bun:"first_seen_at"` // Oldest open history record of the drift
 }
 
 // ReplaceAllDrifts replaces all component_drift rows with the given set.
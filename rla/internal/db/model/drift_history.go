@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+
+	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+)
+
+// DriftRemediation records the action taken by a drift remediation policy.
+type DriftRemediation string
+
+const (
+	// DriftRemediationNone means the drift was only recorded.
+	DriftRemediationNone DriftRemediation = ""
+
+	// DriftRemediationAccepted means the actual value was written into the
+	// expected component, which resolved the drift.
+	DriftRemediationAccepted DriftRemediation = "accepted"
+
+	// DriftRemediationAlerted means an alert was raised for the drift.
+	DriftRemediationAlerted DriftRemediation = "alerted"
+)
+
+// DriftHistory is the lifetime of a single drift as observed by the inventory
+// loop. Mismatch drifts are tracked per field; missing_in_* drifts have an
+// empty FieldName. A row stays open (ResolvedAt is NULL) for as long as the
+// drift keeps being detected.
+type DriftHistory struct {
+	bun.BaseModel `bun:"table:component_drift_history,alias:cdh"`
+
+	ID            uuid.UUID        `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	ComponentID   *uuid.UUID       `bun:"component_id,type:uuid"` // NULL for missing_in_expected
+	ExternalID    *string          `bun:"external_id"`            // NULL for missing_in_actual without external_id
+	DriftType     DriftType        `bun:"drift_type,type:varchar(32),notnull"`
+	FieldName     string           `bun:"field_name,notnull,default:''"`
+	ExpectedValue string           `bun:"expected_value,notnull,default:''"`
+	ActualValue   string           `bun:"actual_value,notnull,default:''"`
+	FirstSeenAt   time.Time        `bun:"first_seen_at,notnull,default:current_timestamp"`
+	LastSeenAt    time.Time        `bun:"last_seen_at,notnull,default:current_timestamp"`
+	ResolvedAt    *time.Time       `bun:"resolved_at"`
+	Remediation   DriftRemediation `bun:"remediation,type:varchar(32),notnull,default:''"`
+}
+
+// DriftHistoryFilter narrows down ListDriftHistory results. Zero values
+// match everything.
+type DriftHistoryFilter struct {
+	ComponentIDs []uuid.UUID
+	FieldName    string
+	OpenOnly     bool
+	Since        *time.Time // Only records last seen at or after this time
+}
+
+// Key identifies the drift a history record tracks. Records of the same
+// drift share a key across resolve/reopen cycles.
+func (dh *DriftHistory) Key() string {
+	key := string(dh.DriftType) + "|" + dh.FieldName + "|"
+	if dh.ComponentID != nil {
+		return key + dh.ComponentID.String()
+	}
+	if dh.ExternalID != nil {
+		return key + "ext:" + *dh.ExternalID
+	}
+	return key
+}
+
+// SaveDriftHistory inserts new records and updates existing ones.
+func SaveDriftHistory(ctx context.Context, idb bun.IDB, created []DriftHistory, updated []DriftHistory) error {
+	if len(created) > 0 {
+		if _, err := idb.NewInsert().Model(&created).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	for i := range updated {
+		if _, err := idb.NewUpdate().
+			Model(&updated[i]).
+			Column("expected_value", "actual_value", "last_seen_at", "resolved_at", "remediation").
+			WherePK().
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetOpenDriftHistory returns all drift history records that have not been
+// resolved yet.
+func GetOpenDriftHistory(ctx context.Context, idb bun.IDB) ([]DriftHistory, error) {
+	var records []DriftHistory
+	err := idb.NewSelect().
+		Model(&records).
+		Where("resolved_at IS NULL").
+		Scan(ctx)
+	return records, err
+}
+
+var defaultDriftHistoryPagination = dbquery.Pagination{
+	Offset: 0,
+	Limit:  100,
+}
+
+// ListDriftHistory returns drift history records matching the filter, most
+// recently seen first, together with the total number of matches.
+func ListDriftHistory(
+	ctx context.Context,
+	idb bun.IDB,
+	filter DriftHistoryFilter,
+	pagination *dbquery.Pagination,
+) ([]DriftHistory, int32, error) {
+	if pagination == nil {
+		pagination = &defaultDriftHistoryPagination
+	}
+
+	var records []DriftHistory
+	q := idb.NewSelect().Model(&records)
+
+	if len(filter.ComponentIDs) > 0 {
+		q = q.Where("component_id IN (?)", bun.In(filter.ComponentIDs))
+	}
+
+	if filter.FieldName != "" {
+		q = q.Where("field_name = ?", filter.FieldName)
+	}
+
+	if filter.OpenOnly {
+		q = q.Where("resolved_at IS NULL")
+	}
+
+	if filter.Since != nil {
+		q = q.Where("last_seen_at >= ?", *filter.Since)
+	}
+
+	total, err := q.
+		Order("last_seen_at DESC", "first_seen_at DESC").
+		Offset(pagination.Offset).
+		Limit(pagination.Limit).
+		ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return records, int32(total), nil
+}
@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// MaintenanceWindow models a persisted maintenance window definition.
+// A NULL rack_id makes the window site-wide.
+type MaintenanceWindow struct {
+	bun.BaseModel `bun:"table:maintenance_window,alias:mw"`
+
+	ID              uuid.UUID       `bun:"id,pk,type:uuid,default:gen_random_uuid()"`
+	Name            string          `bun:"name,notnull"`
+	Description     sql.NullString  `bun:"description"`
+	RackID          *uuid.UUID      `bun:"rack_id,type:uuid"`
+	Schedule        string          `bun:"schedule,notnull"`
+	DurationSeconds int64           `bun:"duration_seconds,notnull"`
+	Timezone        string          `bun:"timezone,notnull,default:'UTC'"`
+	Blackouts       json.RawMessage `bun:"blackouts,type:jsonb,notnull,default:'[]'"`
+	Enabled         bool            `bun:"enabled,notnull,default:true"`
+	CreatedAt       time.Time       `bun:"created_at,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time       `bun:"updated_at,notnull,default:current_timestamp"`
+}
+
+// Create inserts the maintenance window record into the backing store.
+func (w *MaintenanceWindow) Create(ctx context.Context, idb bun.IDB) error {
+	w.CreatedAt = time.Now().UTC()
+	w.UpdatedAt = w.CreatedAt
+	_, err := idb.NewInsert().Model(w).Exec(ctx)
+	return err
+}
+
+// Update replaces the definition of the maintenance window.
+func (w *MaintenanceWindow) Update(ctx context.Context, idb bun.IDB) error {
+	w.UpdatedAt = time.Now().UTC()
+
+	res, err := idb.NewUpdate().
+		Model(w).
+		Column(
+			"name", "description", "rack_id", "schedule", "duration_seconds",
+			"timezone", "blackouts", "enabled", "updated_at",
+		).
+		Where("id = ?", w.ID).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := res.RowsAffected(); err == nil && affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Delete deletes the maintenance window from the backing store.
+func (w *MaintenanceWindow) Delete(ctx context.Context, idb bun.IDB) error {
+	_, err := idb.NewDelete().
+		Model(w).
+		Where("id = ?", w.ID).
+		Exec(ctx)
+	return err
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by its UUID.
+// Returns nil if no window exists with the given UUID.
+func GetMaintenanceWindow(ctx context.Context, idb bun.IDB, id uuid.UUID) (*MaintenanceWindow, error) {
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("maintenance window UUID is required")
+	}
+
+	var window MaintenanceWindow
+	err := idb.NewSelect().
+		Model(&window).
+		Where("id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &window, nil
+}
+
+// ListMaintenanceWindows returns all maintenance windows. If rackID is set,
+// only the windows attached to that rack and the site-wide windows are
+// returned.
+func ListMaintenanceWindows(ctx context.Context, idb bun.IDB, rackID *uuid.UUID) ([]MaintenanceWindow, error) {
+	var windows []MaintenanceWindow
+
+	q := idb.NewSelect().Model(&windows)
+	if rackID != nil {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("rack_id IS NULL").WhereOr("rack_id = ?", *rackID)
+		})
+	}
+
+	if err := q.Order("name ASC").Scan(ctx); err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
@@ -49,6 +49,7 @@ type Rack struct {
 	CreatedAt    time.Time      `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt    time.Time      `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 	IngestedAt   *time.Time     `bun:"ingested_at"`
+	PowerBudget  *float64       `bun:"power_budget_watts"`
 	DeletedAt    *time.Time     `bun:"deleted_at,soft_delete"`
 	Components   []Component    `bun:"rel:has-many,join:id=rack_id"`
 	NVLDomain    *NVLDomain     `bun:"rel:belongs-to,join:nvldomain_id=id"`
@@ -129,6 +130,7 @@ func (rd *Rack) BuildPatch(cur *Rack) *Rack {
 	// Name
 	// Description
 	// Location
+	// PowerBudget
 
 	// Make a copy fo the current rack which serves as the base for the
 	// patched rack.
@@ -150,6 +152,13 @@ func (rd *Rack) BuildPatch(cur *Rack) *Rack {
 		patched = true
 	}
 
+	if rd.PowerBudget != nil &&
+		(cur.PowerBudget == nil || *cur.PowerBudget != *rd.PowerBudget) {
+		budget := *rd.PowerBudget
+		patchedRack.PowerBudget = &budget
+		patched = true
+	}
+
 	if !patched {
 		return nil
 	}
@@ -52,6 +52,11 @@ func (tr *testRack) modifyLocation(location map[string]any) *testRack {
 	return tr
 }
 
+func (tr *testRack) modifyPowerBudget(watts float64) *testRack {
+	tr.r.PowerBudget = &watts
+	return tr
+}
+
 func TestRackBuildPatch(t *testing.T) {
 	rackID := uuid.New()
 	now := time.Now()
@@ -192,6 +197,26 @@ func TestRackBuildPatch(t *testing.T) {
 			input:    newTestRack(shareRack).modifyName("X").Rack(),
 			expected: newTestRack(shareRack).modifyName("X").Rack(),
 		},
+		"power budget set": {
+			cur:      newTestRack(shareRack).Rack(),
+			input:    newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+			expected: newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+		},
+		"power budget change": {
+			cur:      newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+			input:    newTestRack(shareRack).modifyPowerBudget(0).Rack(),
+			expected: newTestRack(shareRack).modifyPowerBudget(0).Rack(),
+		},
+		"same power budget returns nil": {
+			cur:      newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+			input:    newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+			expected: nil,
+		},
+		"unset power budget keeps current": {
+			cur:      newTestRack(shareRack).modifyPowerBudget(120000).Rack(),
+			input:    newTestRack(shareRack).modifyName("Rack-05").Rack(),
+			expected: newTestRack(shareRack).modifyPowerBudget(120000).modifyName("Rack-05").Rack(),
+		},
 	}
 
 	for name, tc := range testCases {
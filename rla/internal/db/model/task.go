@@ -51,6 +51,8 @@ type Task struct {
 	Status         taskcommon.TaskStatus   `bun:"status,type:varchar(32),notnull"`
 	Message        string                  `bun:"message,nullzero"`
 	AppliedRuleID  *uuid.UUID              `bun:"applied_rule_id,type:uuid"` // Which opeation rule was applied
+	WindowOverride bool                    `bun:"window_override,notnull,default:false"`
+	OverrideReason string                  `bun:"override_reason,nullzero"`
 	CreatedAt      time.Time               `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt      time.Time               `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
 	FinishedAt     *time.Time              `bun:"finished_at"`
@@ -111,6 +113,43 @@ func (t *Task) UpdateTaskStatus(
 	return err
 }
 
+// TransitionTaskStatus moves the task to status only if it is currently in
+// from. It reports whether the transition happened, which lets concurrent
+// RLA instances race for the same task without both acting on it.
+func (t *Task) TransitionTaskStatus(
+	ctx context.Context,
+	idb bun.IDB,
+	from taskcommon.TaskStatus,
+	status taskcommon.TaskStatus,
+	message string,
+) (bool, error) {
+	t.Status = status
+	t.Message = message
+	t.UpdatedAt = time.Now().UTC()
+	if status.IsFinished() {
+		t.FinishedAt = &t.UpdatedAt
+	} else {
+		t.FinishedAt = nil
+	}
+
+	res, err := idb.NewUpdate().
+		Model(t).
+		Column("status", "message", "updated_at", "finished_at").
+		Where("id = ?", t.ID).
+		Where("status = ?", from).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func taskListOptionsToFilterable(
 	options *taskcommon.TaskListOptions,
 ) dbquery.Filterable {
@@ -118,7 +157,7 @@ func taskListOptionsToFilterable(
 		return nil
 	}
 
-	filters := make([]dbquery.Filter, 0, 3)
+	filters := make([]dbquery.Filter, 0, 4)
 
 	// Filter by rack_id directly
 	if options.RackID != uuid.Nil {
@@ -147,10 +186,20 @@ func taskListOptionsToFilterable(
 			Value: []taskcommon.TaskStatus{
 				taskcommon.TaskStatusPending,
 				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusWaiting,
+				taskcommon.TaskStatusPaused,
 			},
 		})
 	}
 
+	if len(options.Statuses) > 0 {
+		filters = append(filters, dbquery.Filter{
+			Column:   "status",
+			Operator: dbquery.OperatorIn,
+			Value:    options.Statuses,
+		})
+	}
+
 	return &dbquery.FilterGroup{
 		Filters:   filters,
 		Connector: dbquery.ConnectorAND,
@@ -38,6 +38,8 @@ import (
 // Re-export for convenience
 type ComponentDrift = inventorystore.ComponentDrift
 type FieldDiff = inventorystore.FieldDiff
+type DriftHistory = inventorystore.DriftHistory
+type DriftHistoryFilter = inventorystore.DriftHistoryFilter
 
 // Manager defines the interface for inventory management business logic.
 // It wraps InventoryStore and provides a consistent API for the service layer.
@@ -68,6 +70,7 @@ type Manager interface {
 	// Component drift operations
 	GetDriftsByComponentIDs(ctx context.Context, componentIDs []uuid.UUID) ([]inventorystore.ComponentDrift, error)
 	GetAllDrifts(ctx context.Context) ([]inventorystore.ComponentDrift, error)
+	ListDriftHistory(ctx context.Context, filter inventorystore.DriftHistoryFilter, pagination *dbquery.Pagination) ([]inventorystore.DriftHistory, int32, error)
 
 	// NVL Domain operations
 	CreateNVLDomain(ctx context.Context, nvlDomain *nvldomain.NVLDomain) (uuid.UUID, error)
@@ -221,3 +224,8 @@ func (m *ManagerImpl) GetDriftsByComponentIDs(ctx context.Context, componentIDs
 func (m *ManagerImpl) GetAllDrifts(ctx context.Context) ([]inventorystore.ComponentDrift, error) {
 	return m.store.GetAllDrifts(ctx)
 }
+
+// ListDriftHistory retrieves drift history records matching the filter.
+func (m *ManagerImpl) ListDriftHistory(ctx context.Context, filter inventorystore.DriftHistoryFilter, pagination *dbquery.Pagination) ([]inventorystore.DriftHistory, int32, error) {
+	return m.store.ListDriftHistory(ctx, filter, pagination)
+}
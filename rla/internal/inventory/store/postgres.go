@@ -1015,7 +1015,49 @@ func convertDriftsFromModel(drifts []model.ComponentDrift) []ComponentDrift {
 			DriftType:   string(d.DriftType),
 			Diffs:       fieldDiffs,
 			CheckedAt:   d.CheckedAt,
+			FirstSeenAt: d.FirstSeenAt,
 		})
 	}
 	return result
 }
+
+// ListDriftHistory retrieves drift history records matching the filter.
+func (s *PostgresStore) ListDriftHistory(
+	ctx context.Context,
+	filter DriftHistoryFilter,
+	pagination *dbquery.Pagination,
+) ([]DriftHistory, int32, error) {
+	records, total, err := model.ListDriftHistory(
+		ctx,
+		s.pg.DB,
+		model.DriftHistoryFilter{
+			ComponentIDs: filter.ComponentIDs,
+			FieldName:    filter.FieldName,
+			OpenOnly:     filter.OpenOnly,
+			Since:        filter.Since,
+		},
+		pagination,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]DriftHistory, 0, len(records))
+	for _, r := range records {
+		result = append(result, DriftHistory{
+			ID:            r.ID,
+			ComponentID:   r.ComponentID,
+			ExternalID:    r.ExternalID,
+			DriftType:     string(r.DriftType),
+			FieldName:     r.FieldName,
+			ExpectedValue: r.ExpectedValue,
+			ActualValue:   r.ActualValue,
+			FirstSeenAt:   r.FirstSeenAt,
+			LastSeenAt:    r.LastSeenAt,
+			ResolvedAt:    r.ResolvedAt,
+			Remediation:   string(r.Remediation),
+		})
+	}
+
+	return result, total, nil
+}
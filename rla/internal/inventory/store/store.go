@@ -42,6 +42,31 @@ type ComponentDrift struct {
 	DriftType   string      // "missing_in_expected", "missing_in_actual", "mismatch"
 	Diffs       []FieldDiff // Field-level differences (for mismatch type)
 	CheckedAt   time.Time
+	FirstSeenAt *time.Time // When the oldest open part of the drift was first detected
+}
+
+// DriftHistory is the lifetime of a single drift as observed by the inventory loop.
+// Mismatch drifts are tracked per field; missing_in_* drifts have an empty FieldName.
+type DriftHistory struct {
+	ID            uuid.UUID
+	ComponentID   *uuid.UUID // NULL for missing_in_expected
+	ExternalID    *string    // Component ID from the component manager service
+	DriftType     string     // "missing_in_expected", "missing_in_actual", "mismatch"
+	FieldName     string
+	ExpectedValue string
+	ActualValue   string
+	FirstSeenAt   time.Time
+	LastSeenAt    time.Time
+	ResolvedAt    *time.Time // NULL while the drift is still detected
+	Remediation   string     // "", "accepted" or "alerted"
+}
+
+// DriftHistoryFilter narrows down drift history queries. Zero values match everything.
+type DriftHistoryFilter struct {
+	ComponentIDs []uuid.UUID
+	FieldName    string
+	OpenOnly     bool
+	Since        *time.Time
 }
 
 // FieldDiff represents a single field difference between expected and actual values.
@@ -80,6 +105,7 @@ type Store interface {
 	// Component drift operations
 	GetDriftsByComponentIDs(ctx context.Context, componentIDs []uuid.UUID) ([]ComponentDrift, error)
 	GetAllDrifts(ctx context.Context) ([]ComponentDrift, error)
+	ListDriftHistory(ctx context.Context, filter DriftHistoryFilter, pagination *dbquery.Pagination) ([]DriftHistory, int32, error)
 
 	// NVL Domain operations
 	CreateNVLDomain(ctx context.Context, nvlDomain *nvldomain.NVLDomain) (uuid.UUID, error)
@@ -0,0 +1,361 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventorysync
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"github.com/uptrace/bun"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/db/model"
+)
+
+// persistDrifts records the drifts detected in one inventory cycle. It
+// updates the drift history, applies the configured remediation policies and
+// replaces the current drift table with the drifts that remain.
+func persistDrifts(ctx context.Context, policies map[string]config.DriftPolicy, pool *cdb.Session, drifts []model.ComponentDrift) error {
+	now := time.Now()
+	var alerts []alert.Alert
+	var remaining []model.ComponentDrift
+
+	err := pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
+		open, err := model.GetOpenDriftHistory(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve open drift history: %w", err)
+		}
+
+		current, created, resolved := reconcileDriftHistory(open, observeDrifts(drifts, now), now)
+
+		for _, action := range dueDriftActions(current, policies, now) {
+			switch action.policy.Action {
+			case config.DriftActionAccept:
+				// Run in a savepoint so a failed accept does not abort the cycle
+				if err := tx.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+					return acceptDrift(ctx, tx, action.record)
+				}); err != nil {
+					log.Error().Msgf("Unable to accept %s drift of component %v: %v", action.record.FieldName, action.record.ComponentID, err)
+					continue
+				}
+				resolvedAt := now
+				action.record.ResolvedAt = &resolvedAt
+				action.record.Remediation = model.DriftRemediationAccepted
+				log.Info().Msgf("Accepted %s drift of component %v: %q -> %q", action.record.FieldName, action.record.ComponentID, action.record.ExpectedValue, action.record.ActualValue)
+			case config.DriftActionAlert:
+				alerts = append(alerts, driftAlert(action.record, action.policy))
+				action.record.Remediation = model.DriftRemediationAlerted
+			}
+		}
+
+		remaining = applyDriftHistory(drifts, current)
+
+		isNew := make(map[*model.DriftHistory]bool, len(created))
+		var newRecords, changedRecords []model.DriftHistory
+		for _, r := range created {
+			isNew[r] = true
+			newRecords = append(newRecords, *r)
+		}
+		for _, r := range current {
+			if !isNew[r] {
+				changedRecords = append(changedRecords, *r)
+			}
+		}
+		for _, r := range resolved {
+			changedRecords = append(changedRecords, *r)
+		}
+
+		if err := model.SaveDriftHistory(ctx, tx, newRecords, changedRecords); err != nil {
+			return fmt.Errorf("unable to save drift history: %w", err)
+		}
+
+		// Replace the current drifts with the ones that were not remediated
+		return model.ReplaceAllDrifts(ctx, tx, remaining)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, a := range alerts {
+		if err := alert.Send(ctx, a); err != nil {
+			log.Error().Msgf("Unable to send drift alert: %v", err)
+		}
+	}
+
+	log.Info().Msgf("Drift detection complete: %d drift(s) detected, %d remaining after remediation", len(drifts), len(remaining))
+	return nil
+}
+
+// observeDrifts turns the drifts of one cycle into history records: one per
+// field for mismatches and one per drift otherwise.
+func observeDrifts(drifts []model.ComponentDrift, now time.Time) []model.DriftHistory {
+	var observed []model.DriftHistory
+
+	for _, d := range drifts {
+		base := model.DriftHistory{
+			ComponentID: d.ComponentID,
+			ExternalID:  d.ExternalID,
+			DriftType:   d.DriftType,
+			FirstSeenAt: now,
+			LastSeenAt:  now,
+		}
+
+		if d.DriftType != model.DriftTypeMismatch {
+			observed = append(observed, base)
+			continue
+		}
+
+		for _, fd := range d.Diffs {
+			r := base
+			r.FieldName = fd.FieldName
+			r.ExpectedValue = fd.ExpectedValue
+			r.ActualValue = fd.ActualValue
+			observed = append(observed, r)
+		}
+	}
+
+	return observed
+}
+
+// reconcileDriftHistory matches the observed drifts against the open history
+// records. It returns the records of all observed drifts (existing records
+// are marked as seen again), the subset that is new, and the open records
+// that were not observed anymore, which are marked as resolved.
+func reconcileDriftHistory(
+	open []model.DriftHistory,
+	observed []model.DriftHistory,
+	now time.Time,
+) (current []*model.DriftHistory, created []*model.DriftHistory, resolved []*model.DriftHistory) {
+	openByKey := make(map[string]*model.DriftHistory, len(open))
+	for i := range open {
+		openByKey[open[i].Key()] = &open[i]
+	}
+
+	seen := make(map[string]bool, len(observed))
+	for i := range observed {
+		o := &observed[i]
+		key := o.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if r, ok := openByKey[key]; ok {
+			r.ExpectedValue = o.ExpectedValue
+			r.ActualValue = o.ActualValue
+			r.ExternalID = o.ExternalID
+			r.LastSeenAt = now
+			current = append(current, r)
+			continue
+		}
+
+		o.ID = uuid.New()
+		current = append(current, o)
+		created = append(created, o)
+	}
+
+	for i := range open {
+		if !seen[open[i].Key()] {
+			resolvedAt := now
+			open[i].ResolvedAt = &resolvedAt
+			resolved = append(resolved, &open[i])
+		}
+	}
+
+	return current, created, resolved
+}
+
+// driftAction is a remediation policy that is due for a drift.
+type driftAction struct {
+	record *model.DriftHistory
+	policy config.DriftPolicy
+}
+
+// dueDriftActions returns the policy actions to take for the current drifts.
+// Only mismatches are remediated, each one at most once, and only after the
+// drift has persisted for the policy's After duration.
+func dueDriftActions(
+	current []*model.DriftHistory,
+	policies map[string]config.DriftPolicy,
+	now time.Time,
+) []driftAction {
+	var actions []driftAction
+
+	for _, r := range current {
+		if r.DriftType != model.DriftTypeMismatch || r.Remediation != model.DriftRemediationNone {
+			continue
+		}
+
+		policy, ok := policies[r.FieldName]
+		if !ok {
+			continue
+		}
+
+		switch policy.Action {
+		case config.DriftActionAccept:
+			if r.ComponentID == nil {
+				continue
+			}
+		case config.DriftActionAlert:
+		case config.DriftActionRecord, "":
+			continue
+		default:
+			log.Warn().Msgf("Unknown drift policy action %q for field %s; recording only", policy.Action, r.FieldName)
+			continue
+		}
+
+		if now.Sub(r.FirstSeenAt) < policy.After {
+			continue
+		}
+
+		actions = append(actions, driftAction{record: r, policy: policy})
+	}
+
+	return actions
+}
+
+// applyDriftHistory returns the drifts that remain after remediation, with
+// their first-seen time taken from the oldest open history record. Field
+// diffs that were accepted are dropped, as are mismatches left without diffs.
+func applyDriftHistory(drifts []model.ComponentDrift, current []*model.DriftHistory) []model.ComponentDrift {
+	byKey := make(map[string]*model.DriftHistory, len(current))
+	for _, r := range current {
+		byKey[r.Key()] = r
+	}
+
+	remaining := make([]model.ComponentDrift, 0, len(drifts))
+	for _, d := range drifts {
+		probe := model.DriftHistory{ComponentID: d.ComponentID, ExternalID: d.ExternalID, DriftType: d.DriftType}
+
+		if d.DriftType != model.DriftTypeMismatch {
+			if r, ok := byKey[probe.Key()]; ok {
+				firstSeen := r.FirstSeenAt
+				d.FirstSeenAt = &firstSeen
+			}
+			remaining = append(remaining, d)
+			continue
+		}
+
+		diffs := make([]model.FieldDiff, 0, len(d.Diffs))
+		for _, fd := range d.Diffs {
+			probe.FieldName = fd.FieldName
+			r, ok := byKey[probe.Key()]
+			if ok && r.ResolvedAt != nil {
+				continue
+			}
+			diffs = append(diffs, fd)
+			if ok && (d.FirstSeenAt == nil || r.FirstSeenAt.Before(*d.FirstSeenAt)) {
+				firstSeen := r.FirstSeenAt
+				d.FirstSeenAt = &firstSeen
+			}
+		}
+
+		if len(diffs) == 0 {
+			continue
+		}
+
+		d.Diffs = diffs
+		remaining = append(remaining, d)
+	}
+
+	return remaining
+}
+
+// acceptDrift writes the actual value of a drifted field into the expected
+// component.
+func acceptDrift(ctx context.Context, idb bun.IDB, r *model.DriftHistory) error {
+	comp, err := (&model.Component{ID: *r.ComponentID}).Get(ctx, idb)
+	if err != nil {
+		return err
+	}
+
+	if err := setComponentField(comp, r.FieldName, r.ActualValue); err != nil {
+		return err
+	}
+
+	return comp.PatchColumns(ctx, idb, r.FieldName)
+}
+
+// setComponentField sets a drift validation field of the component. Field
+// names match the columns of the component table.
+func setComponentField(comp *model.Component, field string, value string) error {
+	switch field {
+	case "firmware_version":
+		comp.FirmwareVersion = value
+	case "serial_number":
+		comp.SerialNumber = value
+	case "slot_id", "tray_index", "host_id":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid %s value %q: %w", field, value, err)
+		}
+		switch field {
+		case "slot_id":
+			comp.SlotID = n
+		case "tray_index":
+			comp.TrayIndex = n
+		default:
+			comp.HostID = n
+		}
+	default:
+		return fmt.Errorf("field %s cannot be accepted", field)
+	}
+
+	return nil
+}
+
+// driftAlert builds the alert raised by an alert policy.
+func driftAlert(r *model.DriftHistory, policy config.DriftPolicy) alert.Alert {
+	severity := alert.Severity(policy.Severity)
+	if severity == "" {
+		severity = alert.SeverityWarning
+	}
+
+	componentID := ""
+	if r.ComponentID != nil {
+		componentID = r.ComponentID.String()
+	}
+	externalID := ""
+	if r.ExternalID != nil {
+		externalID = *r.ExternalID
+	}
+
+	return alert.Alert{
+		Severity: severity,
+		Message: fmt.Sprintf(
+			"Component %s drifted on %s: expected %q, actual %q",
+			componentID, r.FieldName, r.ExpectedValue, r.ActualValue,
+		),
+		Component: "component",
+		Operation: "drift",
+		Details: map[string]string{
+			"component_id":   componentID,
+			"external_id":    externalID,
+			"field":          r.FieldName,
+			"expected_value": r.ExpectedValue,
+			"actual_value":   r.ActualValue,
+			"first_seen_at":  r.FirstSeenAt.Format(time.RFC3339),
+		},
+	}
+}
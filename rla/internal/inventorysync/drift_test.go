@@ -19,10 +19,15 @@ package inventorysync
 
 import (
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/db/model"
 )
 
@@ -189,3 +194,307 @@ func TestCompareMachineFieldsForDrift_ExpectedEmptyFirmwareActualHasValue(t *tes
 	assert.Equal(t, "", diffs[0].ExpectedValue)
 	assert.Equal(t, "2.0.0", diffs[0].ActualValue)
 }
+
+func TestObserveDrifts(t *testing.T) {
+	now := time.Now()
+	compID := uuid.New()
+
+	drifts := []model.ComponentDrift{
+		{
+			ComponentID: &compID,
+			ExternalID:  ptr("machine-1"),
+			DriftType:   model.DriftTypeMismatch,
+			Diffs: []model.FieldDiff{
+				{FieldName: "firmware_version", ExpectedValue: "1.0.0", ActualValue: "1.1.0"},
+				{FieldName: "slot_id", ExpectedValue: "2", ActualValue: "3"},
+			},
+		},
+		{
+			ExternalID: ptr("machine-2"),
+			DriftType:  model.DriftTypeMissingInExpected,
+		},
+	}
+
+	observed := observeDrifts(drifts, now)
+	require.Len(t, observed, 3)
+
+	assert.Equal(t, "firmware_version", observed[0].FieldName)
+	assert.Equal(t, "1.0.0", observed[0].ExpectedValue)
+	assert.Equal(t, "1.1.0", observed[0].ActualValue)
+	assert.Equal(t, &compID, observed[0].ComponentID)
+	assert.Equal(t, "slot_id", observed[1].FieldName)
+	assert.Equal(t, model.DriftTypeMissingInExpected, observed[2].DriftType)
+	assert.Empty(t, observed[2].FieldName)
+	for _, o := range observed {
+		assert.Equal(t, now, o.FirstSeenAt)
+		assert.Equal(t, now, o.LastSeenAt)
+	}
+}
+
+func TestReconcileDriftHistory(t *testing.T) {
+	firstSeen := time.Now().Add(-time.Hour)
+	now := time.Now()
+	compID := uuid.New()
+
+	open := []model.DriftHistory{
+		{
+			ID:            uuid.New(),
+			ComponentID:   &compID,
+			DriftType:     model.DriftTypeMismatch,
+			FieldName:     "firmware_version",
+			ExpectedValue: "1.0.0",
+			ActualValue:   "1.1.0",
+			FirstSeenAt:   firstSeen,
+			LastSeenAt:    firstSeen,
+		},
+		{
+			ID:          uuid.New(),
+			ExternalID:  ptr("machine-2"),
+			DriftType:   model.DriftTypeMissingInExpected,
+			FirstSeenAt: firstSeen,
+			LastSeenAt:  firstSeen,
+		},
+	}
+	observed := observeDrifts([]model.ComponentDrift{
+		{
+			ComponentID: &compID,
+			DriftType:   model.DriftTypeMismatch,
+			Diffs: []model.FieldDiff{
+				{FieldName: "firmware_version", ExpectedValue: "1.0.0", ActualValue: "1.2.0"},
+				{FieldName: "serial_number", ExpectedValue: "SN001", ActualValue: "SN002"},
+			},
+		},
+	}, now)
+
+	current, created, resolved := reconcileDriftHistory(open, observed, now)
+
+	require.Len(t, current, 2)
+	assert.Equal(t, open[0].ID, current[0].ID, "seen again keeps the open record")
+	assert.Equal(t, firstSeen, current[0].FirstSeenAt)
+	assert.Equal(t, now, current[0].LastSeenAt)
+	assert.Equal(t, "1.2.0", current[0].ActualValue)
+
+	require.Len(t, created, 1)
+	assert.Equal(t, "serial_number", created[0].FieldName)
+	assert.NotEqual(t, uuid.Nil, created[0].ID)
+	assert.Same(t, current[1], created[0])
+
+	require.Len(t, resolved, 1)
+	assert.Equal(t, model.DriftTypeMissingInExpected, resolved[0].DriftType)
+	require.NotNil(t, resolved[0].ResolvedAt)
+	assert.Equal(t, now, *resolved[0].ResolvedAt)
+}
+
+func TestDueDriftActions(t *testing.T) {
+	now := time.Now()
+	compID := uuid.New()
+
+	record := func(field string, age time.Duration) *model.DriftHistory {
+		return &model.DriftHistory{
+			ComponentID: &compID,
+			DriftType:   model.DriftTypeMismatch,
+			FieldName:   field,
+			FirstSeenAt: now.Add(-age),
+		}
+	}
+
+	policies := map[string]config.DriftPolicy{
+		"firmware_version": {Action: config.DriftActionAccept, After: time.Hour},
+		"serial_number":    {Action: config.DriftActionAlert, Severity: "critical"},
+		"slot_id":          {Action: config.DriftActionRecord},
+	}
+
+	testCases := map[string]struct {
+		record   *model.DriftHistory
+		expected bool
+	}{
+		"accept after duration elapsed": {
+			record:   record("firmware_version", 2*time.Hour),
+			expected: true,
+		},
+		"accept before duration elapsed": {
+			record:   record("firmware_version", time.Minute),
+			expected: false,
+		},
+		"alert without duration": {
+			record:   record("serial_number", 0),
+			expected: true,
+		},
+		"record only": {
+			record:   record("slot_id", 24*time.Hour),
+			expected: false,
+		},
+		"no policy": {
+			record:   record("host_id", 24*time.Hour),
+			expected: false,
+		},
+		"already remediated": {
+			record: func() *model.DriftHistory {
+				r := record("serial_number", time.Hour)
+				r.Remediation = model.DriftRemediationAlerted
+				return r
+			}(),
+			expected: false,
+		},
+		"accept without component": {
+			record: func() *model.DriftHistory {
+				r := record("firmware_version", 2*time.Hour)
+				r.ComponentID = nil
+				r.ExternalID = ptr("machine-1")
+				return r
+			}(),
+			expected: false,
+		},
+		"not a mismatch": {
+			record: func() *model.DriftHistory {
+				r := record("", 2*time.Hour)
+				r.DriftType = model.DriftTypeMissingInActual
+				return r
+			}(),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			actions := dueDriftActions([]*model.DriftHistory{tc.record}, policies, now)
+			if !tc.expected {
+				assert.Empty(t, actions)
+				return
+			}
+			require.Len(t, actions, 1)
+			assert.Same(t, tc.record, actions[0].record)
+			assert.Equal(t, policies[tc.record.FieldName], actions[0].policy)
+		})
+	}
+}
+
+func TestApplyDriftHistory(t *testing.T) {
+	now := time.Now()
+	older := now.Add(-2 * time.Hour)
+	newer := now.Add(-time.Hour)
+	compID := uuid.New()
+
+	drift := model.ComponentDrift{
+		ComponentID: &compID,
+		DriftType:   model.DriftTypeMismatch,
+		Diffs: []model.FieldDiff{
+			{FieldName: "firmware_version", ExpectedValue: "1.0.0", ActualValue: "1.1.0"},
+			{FieldName: "serial_number", ExpectedValue: "SN001", ActualValue: "SN002"},
+			{FieldName: "slot_id", ExpectedValue: "2", ActualValue: "3"},
+		},
+	}
+	current := []*model.DriftHistory{
+		{ComponentID: &compID, DriftType: model.DriftTypeMismatch, FieldName: "firmware_version", FirstSeenAt: older, ResolvedAt: &now, Remediation: model.DriftRemediationAccepted},
+		{ComponentID: &compID, DriftType: model.DriftTypeMismatch, FieldName: "serial_number", FirstSeenAt: newer},
+		{ComponentID: &compID, DriftType: model.DriftTypeMismatch, FieldName: "slot_id", FirstSeenAt: now},
+	}
+
+	remaining := applyDriftHistory([]model.ComponentDrift{drift}, current)
+	require.Len(t, remaining, 1)
+	require.Len(t, remaining[0].Diffs, 2)
+	assert.Equal(t, "serial_number", remaining[0].Diffs[0].FieldName)
+	assert.Equal(t, "slot_id", remaining[0].Diffs[1].FieldName)
+	require.NotNil(t, remaining[0].FirstSeenAt)
+	assert.Equal(t, newer, *remaining[0].FirstSeenAt, "first seen of the oldest open diff")
+
+	// A mismatch whose diffs were all accepted is dropped.
+	current[1].ResolvedAt = &now
+	current[2].ResolvedAt = &now
+	assert.Empty(t, applyDriftHistory([]model.ComponentDrift{drift}, current))
+
+	// Drifts without field diffs take the first seen of their record.
+	missing := model.ComponentDrift{ExternalID: ptr("machine-2"), DriftType: model.DriftTypeMissingInExpected}
+	remaining = applyDriftHistory([]model.ComponentDrift{missing}, []*model.DriftHistory{
+		{ExternalID: ptr("machine-2"), DriftType: model.DriftTypeMissingInExpected, FirstSeenAt: older},
+	})
+	require.Len(t, remaining, 1)
+	require.NotNil(t, remaining[0].FirstSeenAt)
+	assert.Equal(t, older, *remaining[0].FirstSeenAt)
+}
+
+func TestSetComponentField(t *testing.T) {
+	testCases := map[string]struct {
+		field       string
+		value       string
+		expectError bool
+		validate    func(t *testing.T, comp *model.Component)
+	}{
+		"firmware version": {
+			field: "firmware_version",
+			value: "2.0.0",
+			validate: func(t *testing.T, comp *model.Component) {
+				assert.Equal(t, "2.0.0", comp.FirmwareVersion)
+			},
+		},
+		"serial number": {
+			field: "serial_number",
+			value: "SN002",
+			validate: func(t *testing.T, comp *model.Component) {
+				assert.Equal(t, "SN002", comp.SerialNumber)
+			},
+		},
+		"slot id": {
+			field: "slot_id",
+			value: "7",
+			validate: func(t *testing.T, comp *model.Component) {
+				assert.Equal(t, 7, comp.SlotID)
+			},
+		},
+		"tray index": {
+			field: "tray_index",
+			value: "3",
+			validate: func(t *testing.T, comp *model.Component) {
+				assert.Equal(t, 3, comp.TrayIndex)
+			},
+		},
+		"host id": {
+			field: "host_id",
+			value: "9",
+			validate: func(t *testing.T, comp *model.Component) {
+				assert.Equal(t, 9, comp.HostID)
+			},
+		},
+		"non-numeric position": {
+			field:       "slot_id",
+			value:       "abc",
+			expectError: true,
+		},
+		"unsupported field": {
+			field:       "name",
+			value:       "x",
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			comp := &model.Component{}
+			err := setComponentField(comp, tc.field, tc.value)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.validate(t, comp)
+		})
+	}
+}
+
+func TestDriftAlert(t *testing.T) {
+	compID := uuid.New()
+	r := &model.DriftHistory{
+		ComponentID:   &compID,
+		DriftType:     model.DriftTypeMismatch,
+		FieldName:     "firmware_version",
+		ExpectedValue: "1.0.0",
+		ActualValue:   "1.1.0",
+	}
+
+	a := driftAlert(r, config.DriftPolicy{Action: config.DriftActionAlert})
+	assert.Equal(t, alert.SeverityWarning, a.Severity)
+	assert.Equal(t, "drift", a.Operation)
+
+	a = driftAlert(r, config.DriftPolicy{Action: config.DriftActionAlert, Severity: "critical"})
+	assert.Equal(t, alert.SeverityCritical, a.Severity)
+}
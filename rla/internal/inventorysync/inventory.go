@@ -94,13 +94,10 @@ func runInventoryOne(ctx context.Context, config *config.Config, pool *cdb.Sessi
 	powershelfDrifts := syncPowershelves(ctx, pool, carbideClient, psmClient)
 	allDrifts = append(allDrifts, powershelfDrifts...)
 
-	// Persist all drifts atomically (replace entire table)
-	if err := pool.RunInTx(ctx, func(ctx context.Context, tx bun.Tx) error {
-		return model.ReplaceAllDrifts(ctx, tx, allDrifts)
-	}); err != nil {
+	// Persist all drifts atomically: update the drift history, apply the
+	// remediation policies and replace the current drift table
+	if err := persistDrifts(ctx, config.DriftPolicies, pool, allDrifts); err != nil {
 		log.Error().Msgf("Unable to persist drift records: %v", err)
-	} else {
-		log.Info().Msgf("Drift detection complete: %d drift(s) detected", len(allDrifts))
 	}
 
 	time.Sleep(config.InventoryRunFrequency)
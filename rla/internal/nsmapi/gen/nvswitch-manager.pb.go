@@ -374,6 +374,112 @@ func (UpdateState) EnumDescriptor() ([]byte, []int) {
 	return file_nvswitch_manager_proto_rawDescGZIP(), []int{5}
 }
 
+// HealthStatus is the rolled-up health of a switch or sensor.
+type HealthStatus int32
+
+const (
+	HealthStatus_HEALTH_STATUS_UNKNOWN  HealthStatus = 0 // Not collected yet, or BMC and NVOS both unreachable
+	HealthStatus_HEALTH_STATUS_OK       HealthStatus = 1
+	HealthStatus_HEALTH_STATUS_WARNING  HealthStatus = 2 // Degraded: NVLink port down/flapping/erroring, sensor warning, or partial collection
+	HealthStatus_HEALTH_STATUS_CRITICAL HealthStatus = 3 // A sensor is critical (e.g. failed fan, over-temperature)
+)
+
+// Enum value maps for HealthStatus.
+var (
+	HealthStatus_name = map[int32]string{
+		0: "HEALTH_STATUS_UNKNOWN",
+		1: "HEALTH_STATUS_OK",
+		2: "HEALTH_STATUS_WARNING",
+		3: "HEALTH_STATUS_CRITICAL",
+	}
+	HealthStatus_value = map[string]int32{
+		"HEALTH_STATUS_UNKNOWN":  0,
+		"HEALTH_STATUS_OK":       1,
+		"HEALTH_STATUS_WARNING":  2,
+		"HEALTH_STATUS_CRITICAL": 3,
+	}
+)
+
+func (x HealthStatus) Enum() *HealthStatus {
+	p := new(HealthStatus)
+	*p = x
+	return p
+}
+
+func (x HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_nvswitch_manager_proto_enumTypes[6].Descriptor()
+}
+
+func (HealthStatus) Type() protoreflect.EnumType {
+	return &file_nvswitch_manager_proto_enumTypes[6]
+}
+
+func (x HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HealthStatus.Descriptor instead.
+func (HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{6}
+}
+
+// SensorKind identifies the type of a BMC sensor.
+type SensorKind int32
+
+const (
+	SensorKind_SENSOR_KIND_UNKNOWN     SensorKind = 0
+	SensorKind_SENSOR_KIND_FAN         SensorKind = 1
+	SensorKind_SENSOR_KIND_PSU         SensorKind = 2
+	SensorKind_SENSOR_KIND_TEMPERATURE SensorKind = 3
+)
+
+// Enum value maps for SensorKind.
+var (
+	SensorKind_name = map[int32]string{
+		0: "SENSOR_KIND_UNKNOWN",
+		1: "SENSOR_KIND_FAN",
+		2: "SENSOR_KIND_PSU",
+		3: "SENSOR_KIND_TEMPERATURE",
+	}
+	SensorKind_value = map[string]int32{
+		"SENSOR_KIND_UNKNOWN":     0,
+		"SENSOR_KIND_FAN":         1,
+		"SENSOR_KIND_PSU":         2,
+		"SENSOR_KIND_TEMPERATURE": 3,
+	}
+)
+
+func (x SensorKind) Enum() *SensorKind {
+	p := new(SensorKind)
+	*p = x
+	return p
+}
+
+func (x SensorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SensorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_nvswitch_manager_proto_enumTypes[7].Descriptor()
+}
+
+func (SensorKind) Type() protoreflect.EnumType {
+	return &file_nvswitch_manager_proto_enumTypes[7]
+}
+
+func (x SensorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SensorKind.Descriptor instead.
+func (SensorKind) EnumDescriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{7}
+}
+
 // Credentials wraps around a username and password.
 type Credentials struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1472,12 +1578,13 @@ func (x *ListBundlesResponse) GetBundles() []*FirmwareBundle {
 // QueueUpdateRequest queues firmware updates for one or more components.
 // If components is empty, all components in the bundle are updated in sequence.
 type QueueUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`                     // UUID of the NV-Switch to update
-	BundleVersion string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`            // Version of the firmware bundle
-	Components    []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=nsm.v1.NVSwitchComponent" json:"components,omitempty"` // Components to update (empty = all)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid                string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`                                                // UUID of the NV-Switch to update
+	BundleVersion             string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`                                       // Version of the firmware bundle
+	Components                []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=nsm.v1.NVSwitchComponent" json:"components,omitempty"`                            // Components to update (empty = all)
+	MaintenanceOverrideReason string                 `protobuf:"bytes,4,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *QueueUpdateRequest) Reset() {
@@ -1531,6 +1638,13 @@ func (x *QueueUpdateRequest) GetComponents() []NVSwitchComponent {
 	return nil
 }
 
+func (x *QueueUpdateRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 // QueueUpdateResponse returns the queued updates.
 type QueueUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1578,12 +1692,13 @@ func (x *QueueUpdateResponse) GetUpdates() []*FirmwareUpdateInfo {
 
 // QueueUpdatesRequest queues firmware updates for multiple switches.
 type QueueUpdatesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SwitchUuids   []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`                  // UUIDs of NV-Switches to update
-	BundleVersion string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`            // Version of the firmware bundle
-	Components    []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=nsm.v1.NVSwitchComponent" json:"components,omitempty"` // Components to update (empty = all)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuids               []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`                                             // UUIDs of NV-Switches to update
+	BundleVersion             string                 `protobuf:"bytes,2,opt,name=bundle_version,json=bundleVersion,proto3" json:"bundle_version,omitempty"`                                       // Version of the firmware bundle
+	Components                []NVSwitchComponent    `protobuf:"varint,3,rep,packed,name=components,proto3,enum=nsm.v1.NVSwitchComponent" json:"components,omitempty"`                            // Components to update (empty = all)
+	MaintenanceOverrideReason string                 `protobuf:"bytes,4,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *QueueUpdatesRequest) Reset() {
@@ -1637,6 +1752,13 @@ func (x *QueueUpdatesRequest) GetComponents() []NVSwitchComponent {
 	return nil
 }
 
+func (x *QueueUpdatesRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 // QueueUpdatesResponse returns the results for each switch.
 type QueueUpdatesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2232,6 +2354,351 @@ func (x *FirmwareUpdateInfo) GetPredecessorId() string {
 	return ""
 }
 
+// SensorReading is a fan, PSU or thermal sensor reported by the BMC over Redfish.
+type SensorReading struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Kind                   SensorKind             `protobuf:"varint,1,opt,name=kind,proto3,enum=nsm.v1.SensorKind" json:"kind,omitempty"`
+	Name                   string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // <chassis ID>/<sensor name>
+	Reading                float64                `protobuf:"fixed64,3,opt,name=reading,proto3" json:"reading,omitempty"`
+	Units                  string                 `protobuf:"bytes,4,opt,name=units,proto3" json:"units,omitempty"`                                                                     // RPM, W, Cel
+	UpperThresholdCritical float64                `protobuf:"fixed64,5,opt,name=upper_threshold_critical,json=upperThresholdCritical,proto3" json:"upper_threshold_critical,omitempty"` // 0 if not reported
+	Health                 HealthStatus           `protobuf:"varint,6,opt,name=health,proto3,enum=nsm.v1.HealthStatus" json:"health,omitempty"`
+	State                  string                 `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"` // Redfish state, e.g. Enabled, Absent
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *SensorReading) Reset() {
+	*x = SensorReading{}
+	mi := &file_nvswitch_manager_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorReading) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorReading) ProtoMessage() {}
+
+func (x *SensorReading) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorReading.ProtoReflect.Descriptor instead.
+func (*SensorReading) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SensorReading) GetKind() SensorKind {
+	if x != nil {
+		return x.Kind
+	}
+	return SensorKind_SENSOR_KIND_UNKNOWN
+}
+
+func (x *SensorReading) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorReading) GetReading() float64 {
+	if x != nil {
+		return x.Reading
+	}
+	return 0
+}
+
+func (x *SensorReading) GetUnits() string {
+	if x != nil {
+		return x.Units
+	}
+	return ""
+}
+
+func (x *SensorReading) GetUpperThresholdCritical() float64 {
+	if x != nil {
+		return x.UpperThresholdCritical
+	}
+	return 0
+}
+
+func (x *SensorReading) GetHealth() HealthStatus {
+	if x != nil {
+		return x.Health
+	}
+	return HealthStatus_HEALTH_STATUS_UNKNOWN
+}
+
+func (x *SensorReading) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// SwitchHealth is the latest health sample for a switch.
+type SwitchHealth struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid       string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	Status           HealthStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=nsm.v1.HealthStatus" json:"status,omitempty"`
+	CollectedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	Sensors          []*SensorReading       `protobuf:"bytes,4,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	NvlinkPortsTotal int32                  `protobuf:"varint,5,opt,name=nvlink_ports_total,json=nvlinkPortsTotal,proto3" json:"nvlink_ports_total,omitempty"`
+	NvlinkPortsDown  int32                  `protobuf:"varint,6,opt,name=nvlink_ports_down,json=nvlinkPortsDown,proto3" json:"nvlink_ports_down,omitempty"`
+	Errors           []string               `protobuf:"bytes,7,rep,name=errors,proto3" json:"errors,omitempty"` // Collection failures (unreachable BMC/NVOS, unreadable ports)
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SwitchHealth) Reset() {
+	*x = SwitchHealth{}
+	mi := &file_nvswitch_manager_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchHealth) ProtoMessage() {}
+
+func (x *SwitchHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchHealth.ProtoReflect.Descriptor instead.
+func (*SwitchHealth) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SwitchHealth) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *SwitchHealth) GetStatus() HealthStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthStatus_HEALTH_STATUS_UNKNOWN
+}
+
+func (x *SwitchHealth) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *SwitchHealth) GetSensors() []*SensorReading {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+func (x *SwitchHealth) GetNvlinkPortsTotal() int32 {
+	if x != nil {
+		return x.NvlinkPortsTotal
+	}
+	return 0
+}
+
+func (x *SwitchHealth) GetNvlinkPortsDown() int32 {
+	if x != nil {
+		return x.NvlinkPortsDown
+	}
+	return 0
+}
+
+func (x *SwitchHealth) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// GetSwitchHealthRequest selects switches by UUID; empty means all registered switches.
+type GetSwitchHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuids   []string               `protobuf:"bytes,1,rep,name=switch_uuids,json=switchUuids,proto3" json:"switch_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwitchHealthRequest) Reset() {
+	*x = GetSwitchHealthRequest{}
+	mi := &file_nvswitch_manager_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwitchHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwitchHealthRequest) ProtoMessage() {}
+
+func (x *GetSwitchHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwitchHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetSwitchHealthRequest) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetSwitchHealthRequest) GetSwitchUuids() []string {
+	if x != nil {
+		return x.SwitchUuids
+	}
+	return nil
+}
+
+// GetSwitchHealthResponse returns one entry per requested switch.
+type GetSwitchHealthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SwitchHealthResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSwitchHealthResponse) Reset() {
+	*x = GetSwitchHealthResponse{}
+	mi := &file_nvswitch_manager_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSwitchHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSwitchHealthResponse) ProtoMessage() {}
+
+func (x *GetSwitchHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSwitchHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetSwitchHealthResponse) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSwitchHealthResponse) GetResults() []*SwitchHealthResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SwitchHealthResult contains the health of a single switch, or why it is unavailable.
+type SwitchHealthResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SwitchUuid    string                 `protobuf:"bytes,1,opt,name=switch_uuid,json=switchUuid,proto3" json:"switch_uuid,omitempty"`
+	Status        StatusCode             `protobuf:"varint,2,opt,name=status,proto3,enum=nsm.v1.StatusCode" json:"status,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Health        *SwitchHealth          `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"` // Unset if no sample has been collected yet
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchHealthResult) Reset() {
+	*x = SwitchHealthResult{}
+	mi := &file_nvswitch_manager_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchHealthResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchHealthResult) ProtoMessage() {}
+
+func (x *SwitchHealthResult) ProtoReflect() protoreflect.Message {
+	mi := &file_nvswitch_manager_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchHealthResult.ProtoReflect.Descriptor instead.
+func (*SwitchHealthResult) Descriptor() ([]byte, []int) {
+	return file_nvswitch_manager_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SwitchHealthResult) GetSwitchUuid() string {
+	if x != nil {
+		return x.SwitchUuid
+	}
+	return ""
+}
+
+func (x *SwitchHealthResult) GetStatus() StatusCode {
+	if x != nil {
+		return x.Status
+	}
+	return StatusCode_SUCCESS
+}
+
+func (x *SwitchHealthResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SwitchHealthResult) GetHealth() *SwitchHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
 var File_nvswitch_manager_proto protoreflect.FileDescriptor
 
 const file_nvswitch_manager_proto_rawDesc = "" +
@@ -2319,22 +2786,24 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"\aversion\x18\x02 \x01(\tR\aversion\x12\x1a\n" +
 	"\bstrategy\x18\x03 \x01(\tR\bstrategy\"G\n" +
 	"\x13ListBundlesResponse\x120\n" +
-	"\abundles\x18\x01 \x03(\v2\x16.nsm.v1.FirmwareBundleR\abundles\"\x97\x01\n" +
+	"\abundles\x18\x01 \x03(\v2\x16.nsm.v1.FirmwareBundleR\abundles\"\xd7\x01\n" +
 	"\x12QueueUpdateRequest\x12\x1f\n" +
 	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
 	"switchUuid\x12%\n" +
 	"\x0ebundle_version\x18\x02 \x01(\tR\rbundleVersion\x129\n" +
 	"\n" +
 	"components\x18\x03 \x03(\x0e2\x19.nsm.v1.NVSwitchComponentR\n" +
-	"components\"K\n" +
+	"components\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x04 \x01(\tR\x19maintenanceOverrideReason\"K\n" +
 	"\x13QueueUpdateResponse\x124\n" +
-	"\aupdates\x18\x01 \x03(\v2\x1a.nsm.v1.FirmwareUpdateInfoR\aupdates\"\x9a\x01\n" +
+	"\aupdates\x18\x01 \x03(\v2\x1a.nsm.v1.FirmwareUpdateInfoR\aupdates\"\xda\x01\n" +
 	"\x13QueueUpdatesRequest\x12!\n" +
 	"\fswitch_uuids\x18\x01 \x03(\tR\vswitchUuids\x12%\n" +
 	"\x0ebundle_version\x18\x02 \x01(\tR\rbundleVersion\x129\n" +
 	"\n" +
 	"components\x18\x03 \x03(\x0e2\x19.nsm.v1.NVSwitchComponentR\n" +
-	"components\"K\n" +
+	"components\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x04 \x01(\tR\x19maintenanceOverrideReason\"K\n" +
 	"\x14QueueUpdatesResponse\x123\n" +
 	"\aresults\x18\x01 \x03(\v2\x19.nsm.v1.QueueUpdateResultR\aresults\"\xac\x01\n" +
 	"\x11QueueUpdateResult\x12\x1f\n" +
@@ -2379,7 +2848,34 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
 	"\x10bundle_update_id\x18\r \x01(\tR\x0ebundleUpdateId\x12%\n" +
 	"\x0esequence_order\x18\x0e \x01(\x05R\rsequenceOrder\x12%\n" +
-	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId*/\n" +
+	"\x0epredecessor_id\x18\x0f \x01(\tR\rpredecessorId\"\xf9\x01\n" +
+	"\rSensorReading\x12&\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x12.nsm.v1.SensorKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\areading\x18\x03 \x01(\x01R\areading\x12\x14\n" +
+	"\x05units\x18\x04 \x01(\tR\x05units\x128\n" +
+	"\x18upper_threshold_critical\x18\x05 \x01(\x01R\x16upperThresholdCritical\x12,\n" +
+	"\x06health\x18\x06 \x01(\x0e2\x14.nsm.v1.HealthStatusR\x06health\x12\x14\n" +
+	"\x05state\x18\a \x01(\tR\x05state\"\xbf\x02\n" +
+	"\fSwitchHealth\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12,\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x14.nsm.v1.HealthStatusR\x06status\x12=\n" +
+	"\fcollected_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12/\n" +
+	"\asensors\x18\x04 \x03(\v2\x15.nsm.v1.SensorReadingR\asensors\x12,\n" +
+	"\x12nvlink_ports_total\x18\x05 \x01(\x05R\x10nvlinkPortsTotal\x12*\n" +
+	"\x11nvlink_ports_down\x18\x06 \x01(\x05R\x0fnvlinkPortsDown\x12\x16\n" +
+	"\x06errors\x18\a \x03(\tR\x06errors\";\n" +
+	"\x16GetSwitchHealthRequest\x12!\n" +
+	"\fswitch_uuids\x18\x01 \x03(\tR\vswitchUuids\"O\n" +
+	"\x17GetSwitchHealthResponse\x124\n" +
+	"\aresults\x18\x01 \x03(\v2\x1a.nsm.v1.SwitchHealthResultR\aresults\"\xa5\x01\n" +
+	"\x12SwitchHealthResult\x12\x1f\n" +
+	"\vswitch_uuid\x18\x01 \x01(\tR\n" +
+	"switchUuid\x12*\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x12.nsm.v1.StatusCodeR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12,\n" +
+	"\x06health\x18\x04 \x01(\v2\x14.nsm.v1.SwitchHealthR\x06health*/\n" +
 	"\x06Vendor\x12\x12\n" +
 	"\x0eVENDOR_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rVENDOR_NVIDIA\x10\x01*C\n" +
@@ -2422,7 +2918,18 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"\x16UPDATE_STATE_COMPLETED\x10\n" +
 	"\x12\x17\n" +
 	"\x13UPDATE_STATE_FAILED\x10\v\x12\x1a\n" +
-	"\x16UPDATE_STATE_CANCELLED\x10\f2\x8e\x06\n" +
+	"\x16UPDATE_STATE_CANCELLED\x10\f*v\n" +
+	"\fHealthStatus\x12\x19\n" +
+	"\x15HEALTH_STATUS_UNKNOWN\x10\x00\x12\x14\n" +
+	"\x10HEALTH_STATUS_OK\x10\x01\x12\x19\n" +
+	"\x15HEALTH_STATUS_WARNING\x10\x02\x12\x1a\n" +
+	"\x16HEALTH_STATUS_CRITICAL\x10\x03*l\n" +
+	"\n" +
+	"SensorKind\x12\x17\n" +
+	"\x13SENSOR_KIND_UNKNOWN\x10\x00\x12\x13\n" +
+	"\x0fSENSOR_KIND_FAN\x10\x01\x12\x13\n" +
+	"\x0fSENSOR_KIND_PSU\x10\x02\x12\x1b\n" +
+	"\x17SENSOR_KIND_TEMPERATURE\x10\x032\xe2\x06\n" +
 	"\x0fNVSwitchManager\x12[\n" +
 	"\x12RegisterNVSwitches\x12!.nsm.v1.RegisterNVSwitchesRequest\x1a\".nsm.v1.RegisterNVSwitchesResponse\x12G\n" +
 	"\rGetNVSwitches\x12\x17.nsm.v1.NVSwitchRequest\x1a\x1d.nsm.v1.GetNVSwitchesResponse\x12B\n" +
@@ -2433,7 +2940,8 @@ const file_nvswitch_manager_proto_rawDesc = "" +
 	"\x13GetUpdatesForSwitch\x12\".nsm.v1.GetUpdatesForSwitchRequest\x1a#.nsm.v1.GetUpdatesForSwitchResponse\x12F\n" +
 	"\rGetAllUpdates\x12\x16.google.protobuf.Empty\x1a\x1d.nsm.v1.GetAllUpdatesResponse\x12I\n" +
 	"\fCancelUpdate\x12\x1b.nsm.v1.CancelUpdateRequest\x1a\x1c.nsm.v1.CancelUpdateResponse\x12I\n" +
-	"\fPowerControl\x12\x1b.nsm.v1.PowerControlRequest\x1a\x1c.nsm.v1.PowerControlResponseBCZAgithub.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi/genb\x06proto3"
+	"\fPowerControl\x12\x1b.nsm.v1.PowerControlRequest\x1a\x1c.nsm.v1.PowerControlResponse\x12R\n" +
+	"\x0fGetSwitchHealth\x12\x1e.nsm.v1.GetSwitchHealthRequest\x1a\x1f.nsm.v1.GetSwitchHealthResponseBCZAgithub.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi/genb\x06proto3"
 
 var (
 	file_nvswitch_manager_proto_rawDescOnce sync.Once
@@ -2447,8 +2955,8 @@ func file_nvswitch_manager_proto_rawDescGZIP() []byte {
 	return file_nvswitch_manager_proto_rawDescData
 }
 
-var file_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
+var file_nvswitch_manager_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_nvswitch_manager_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_nvswitch_manager_proto_goTypes = []any{
 	(Vendor)(0),                         // 0: nsm.v1.Vendor
 	(StatusCode)(0),                     // 1: nsm.v1.StatusCode
@@ -2456,98 +2964,115 @@ var file_nvswitch_manager_proto_goTypes = []any{
 	(NVSwitchComponent)(0),              // 3: nsm.v1.NVSwitchComponent
 	(UpdateStrategy)(0),                 // 4: nsm.v1.UpdateStrategy
 	(UpdateState)(0),                    // 5: nsm.v1.UpdateState
-	(*Credentials)(nil),                 // 6: nsm.v1.Credentials
-	(*Subsystem)(nil),                   // 7: nsm.v1.Subsystem
-	(*BMCInfo)(nil),                     // 8: nsm.v1.BMCInfo
-	(*NVOSInfo)(nil),                    // 9: nsm.v1.NVOSInfo
-	(*Chassis)(nil),                     // 10: nsm.v1.Chassis
-	(*NVSwitchTray)(nil),                // 11: nsm.v1.NVSwitchTray
-	(*RegisterNVSwitchRequest)(nil),     // 12: nsm.v1.RegisterNVSwitchRequest
-	(*RegisterNVSwitchesRequest)(nil),   // 13: nsm.v1.RegisterNVSwitchesRequest
-	(*RegisterNVSwitchResponse)(nil),    // 14: nsm.v1.RegisterNVSwitchResponse
-	(*RegisterNVSwitchesResponse)(nil),  // 15: nsm.v1.RegisterNVSwitchesResponse
-	(*NVSwitchRequest)(nil),             // 16: nsm.v1.NVSwitchRequest
-	(*NVSwitchResponse)(nil),            // 17: nsm.v1.NVSwitchResponse
-	(*PowerControlRequest)(nil),         // 18: nsm.v1.PowerControlRequest
-	(*PowerControlResponse)(nil),        // 19: nsm.v1.PowerControlResponse
-	(*GetNVSwitchesResponse)(nil),       // 20: nsm.v1.GetNVSwitchesResponse
-	(*FirmwareBundle)(nil),              // 21: nsm.v1.FirmwareBundle
-	(*ComponentInfo)(nil),               // 22: nsm.v1.ComponentInfo
-	(*ListBundlesResponse)(nil),         // 23: nsm.v1.ListBundlesResponse
-	(*QueueUpdateRequest)(nil),          // 24: nsm.v1.QueueUpdateRequest
-	(*QueueUpdateResponse)(nil),         // 25: nsm.v1.QueueUpdateResponse
-	(*QueueUpdatesRequest)(nil),         // 26: nsm.v1.QueueUpdatesRequest
-	(*QueueUpdatesResponse)(nil),        // 27: nsm.v1.QueueUpdatesResponse
-	(*QueueUpdateResult)(nil),           // 28: nsm.v1.QueueUpdateResult
-	(*GetUpdateRequest)(nil),            // 29: nsm.v1.GetUpdateRequest
-	(*GetUpdateResponse)(nil),           // 30: nsm.v1.GetUpdateResponse
-	(*GetUpdatesForSwitchRequest)(nil),  // 31: nsm.v1.GetUpdatesForSwitchRequest
-	(*GetUpdatesForSwitchResponse)(nil), // 32: nsm.v1.GetUpdatesForSwitchResponse
-	(*GetAllUpdatesResponse)(nil),       // 33: nsm.v1.GetAllUpdatesResponse
-	(*CancelUpdateRequest)(nil),         // 34: nsm.v1.CancelUpdateRequest
-	(*CancelUpdateResponse)(nil),        // 35: nsm.v1.CancelUpdateResponse
-	(*FirmwareUpdateInfo)(nil),          // 36: nsm.v1.FirmwareUpdateInfo
-	(*timestamppb.Timestamp)(nil),       // 37: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),               // 38: google.protobuf.Empty
+	(HealthStatus)(0),                   // 6: nsm.v1.HealthStatus
+	(SensorKind)(0),                     // 7: nsm.v1.SensorKind
+	(*Credentials)(nil),                 // 8: nsm.v1.Credentials
+	(*Subsystem)(nil),                   // 9: nsm.v1.Subsystem
+	(*BMCInfo)(nil),                     // 10: nsm.v1.BMCInfo
+	(*NVOSInfo)(nil),                    // 11: nsm.v1.NVOSInfo
+	(*Chassis)(nil),                     // 12: nsm.v1.Chassis
+	(*NVSwitchTray)(nil),                // 13: nsm.v1.NVSwitchTray
+	(*RegisterNVSwitchRequest)(nil),     // 14: nsm.v1.RegisterNVSwitchRequest
+	(*RegisterNVSwitchesRequest)(nil),   // 15: nsm.v1.RegisterNVSwitchesRequest
+	(*RegisterNVSwitchResponse)(nil),    // 16: nsm.v1.RegisterNVSwitchResponse
+	(*RegisterNVSwitchesResponse)(nil),  // 17: nsm.v1.RegisterNVSwitchesResponse
+	(*NVSwitchRequest)(nil),             // 18: nsm.v1.NVSwitchRequest
+	(*NVSwitchResponse)(nil),            // 19: nsm.v1.NVSwitchResponse
+	(*PowerControlRequest)(nil),         // 20: nsm.v1.PowerControlRequest
+	(*PowerControlResponse)(nil),        // 21: nsm.v1.PowerControlResponse
+	(*GetNVSwitchesResponse)(nil),       // 22: nsm.v1.GetNVSwitchesResponse
+	(*FirmwareBundle)(nil),              // 23: nsm.v1.FirmwareBundle
+	(*ComponentInfo)(nil),               // 24: nsm.v1.ComponentInfo
+	(*ListBundlesResponse)(nil),         // 25: nsm.v1.ListBundlesResponse
+	(*QueueUpdateRequest)(nil),          // 26: nsm.v1.QueueUpdateRequest
+	(*QueueUpdateResponse)(nil),         // 27: nsm.v1.QueueUpdateResponse
+	(*QueueUpdatesRequest)(nil),         // 28: nsm.v1.QueueUpdatesRequest
+	(*QueueUpdatesResponse)(nil),        // 29: nsm.v1.QueueUpdatesResponse
+	(*QueueUpdateResult)(nil),           // 30: nsm.v1.QueueUpdateResult
+	(*GetUpdateRequest)(nil),            // 31: nsm.v1.GetUpdateRequest
+	(*GetUpdateResponse)(nil),           // 32: nsm.v1.GetUpdateResponse
+	(*GetUpdatesForSwitchRequest)(nil),  // 33: nsm.v1.GetUpdatesForSwitchRequest
+	(*GetUpdatesForSwitchResponse)(nil), // 34: nsm.v1.GetUpdatesForSwitchResponse
+	(*GetAllUpdatesResponse)(nil),       // 35: nsm.v1.GetAllUpdatesResponse
+	(*CancelUpdateRequest)(nil),         // 36: nsm.v1.CancelUpdateRequest
+	(*CancelUpdateResponse)(nil),        // 37: nsm.v1.CancelUpdateResponse
+	(*FirmwareUpdateInfo)(nil),          // 38: nsm.v1.FirmwareUpdateInfo
+	(*SensorReading)(nil),               // 39: nsm.v1.SensorReading
+	(*SwitchHealth)(nil),                // 40: nsm.v1.SwitchHealth
+	(*GetSwitchHealthRequest)(nil),      // 41: nsm.v1.GetSwitchHealthRequest
+	(*GetSwitchHealthResponse)(nil),     // 42: nsm.v1.GetSwitchHealthResponse
+	(*SwitchHealthResult)(nil),          // 43: nsm.v1.SwitchHealthResult
+	(*timestamppb.Timestamp)(nil),       // 44: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),               // 45: google.protobuf.Empty
 }
 var file_nvswitch_manager_proto_depIdxs = []int32{
-	6,  // 0: nsm.v1.Subsystem.credentials:type_name -> nsm.v1.Credentials
+	8,  // 0: nsm.v1.Subsystem.credentials:type_name -> nsm.v1.Credentials
 	0,  // 1: nsm.v1.NVSwitchTray.vendor:type_name -> nsm.v1.Vendor
-	8,  // 2: nsm.v1.NVSwitchTray.bmc:type_name -> nsm.v1.BMCInfo
-	9,  // 3: nsm.v1.NVSwitchTray.nvos:type_name -> nsm.v1.NVOSInfo
-	10, // 4: nsm.v1.NVSwitchTray.chassis:type_name -> nsm.v1.Chassis
+	10, // 2: nsm.v1.NVSwitchTray.bmc:type_name -> nsm.v1.BMCInfo
+	11, // 3: nsm.v1.NVSwitchTray.nvos:type_name -> nsm.v1.NVOSInfo
+	12, // 4: nsm.v1.NVSwitchTray.chassis:type_name -> nsm.v1.Chassis
 	0,  // 5: nsm.v1.RegisterNVSwitchRequest.vendor:type_name -> nsm.v1.Vendor
-	7,  // 6: nsm.v1.RegisterNVSwitchRequest.bmc:type_name -> nsm.v1.Subsystem
-	7,  // 7: nsm.v1.RegisterNVSwitchRequest.nvos:type_name -> nsm.v1.Subsystem
-	12, // 8: nsm.v1.RegisterNVSwitchesRequest.registration_requests:type_name -> nsm.v1.RegisterNVSwitchRequest
-	37, // 9: nsm.v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
+	9,  // 6: nsm.v1.RegisterNVSwitchRequest.bmc:type_name -> nsm.v1.Subsystem
+	9,  // 7: nsm.v1.RegisterNVSwitchRequest.nvos:type_name -> nsm.v1.Subsystem
+	14, // 8: nsm.v1.RegisterNVSwitchesRequest.registration_requests:type_name -> nsm.v1.RegisterNVSwitchRequest
+	44, // 9: nsm.v1.RegisterNVSwitchResponse.created:type_name -> google.protobuf.Timestamp
 	1,  // 10: nsm.v1.RegisterNVSwitchResponse.status:type_name -> nsm.v1.StatusCode
-	14, // 11: nsm.v1.RegisterNVSwitchesResponse.responses:type_name -> nsm.v1.RegisterNVSwitchResponse
+	16, // 11: nsm.v1.RegisterNVSwitchesResponse.responses:type_name -> nsm.v1.RegisterNVSwitchResponse
 	1,  // 12: nsm.v1.NVSwitchResponse.status:type_name -> nsm.v1.StatusCode
 	2,  // 13: nsm.v1.PowerControlRequest.action:type_name -> nsm.v1.PowerAction
-	17, // 14: nsm.v1.PowerControlResponse.responses:type_name -> nsm.v1.NVSwitchResponse
-	11, // 15: nsm.v1.GetNVSwitchesResponse.nvswitches:type_name -> nsm.v1.NVSwitchTray
-	22, // 16: nsm.v1.FirmwareBundle.components:type_name -> nsm.v1.ComponentInfo
-	21, // 17: nsm.v1.ListBundlesResponse.bundles:type_name -> nsm.v1.FirmwareBundle
+	19, // 14: nsm.v1.PowerControlResponse.responses:type_name -> nsm.v1.NVSwitchResponse
+	13, // 15: nsm.v1.GetNVSwitchesResponse.nvswitches:type_name -> nsm.v1.NVSwitchTray
+	24, // 16: nsm.v1.FirmwareBundle.components:type_name -> nsm.v1.ComponentInfo
+	23, // 17: nsm.v1.ListBundlesResponse.bundles:type_name -> nsm.v1.FirmwareBundle
 	3,  // 18: nsm.v1.QueueUpdateRequest.components:type_name -> nsm.v1.NVSwitchComponent
-	36, // 19: nsm.v1.QueueUpdateResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 19: nsm.v1.QueueUpdateResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
 	3,  // 20: nsm.v1.QueueUpdatesRequest.components:type_name -> nsm.v1.NVSwitchComponent
-	28, // 21: nsm.v1.QueueUpdatesResponse.results:type_name -> nsm.v1.QueueUpdateResult
+	30, // 21: nsm.v1.QueueUpdatesResponse.results:type_name -> nsm.v1.QueueUpdateResult
 	1,  // 22: nsm.v1.QueueUpdateResult.status:type_name -> nsm.v1.StatusCode
-	36, // 23: nsm.v1.QueueUpdateResult.updates:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 24: nsm.v1.GetUpdateResponse.update:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 25: nsm.v1.GetUpdatesForSwitchResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
-	36, // 26: nsm.v1.GetAllUpdatesResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 23: nsm.v1.QueueUpdateResult.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 24: nsm.v1.GetUpdateResponse.update:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 25: nsm.v1.GetUpdatesForSwitchResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
+	38, // 26: nsm.v1.GetAllUpdatesResponse.updates:type_name -> nsm.v1.FirmwareUpdateInfo
 	3,  // 27: nsm.v1.FirmwareUpdateInfo.component:type_name -> nsm.v1.NVSwitchComponent
 	4,  // 28: nsm.v1.FirmwareUpdateInfo.strategy:type_name -> nsm.v1.UpdateStrategy
 	5,  // 29: nsm.v1.FirmwareUpdateInfo.state:type_name -> nsm.v1.UpdateState
-	37, // 30: nsm.v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
-	37, // 31: nsm.v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
-	13, // 32: nsm.v1.NVSwitchManager.RegisterNVSwitches:input_type -> nsm.v1.RegisterNVSwitchesRequest
-	16, // 33: nsm.v1.NVSwitchManager.GetNVSwitches:input_type -> nsm.v1.NVSwitchRequest
-	38, // 34: nsm.v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
-	24, // 35: nsm.v1.NVSwitchManager.QueueUpdate:input_type -> nsm.v1.QueueUpdateRequest
-	26, // 36: nsm.v1.NVSwitchManager.QueueUpdates:input_type -> nsm.v1.QueueUpdatesRequest
-	29, // 37: nsm.v1.NVSwitchManager.GetUpdate:input_type -> nsm.v1.GetUpdateRequest
-	31, // 38: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> nsm.v1.GetUpdatesForSwitchRequest
-	38, // 39: nsm.v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
-	34, // 40: nsm.v1.NVSwitchManager.CancelUpdate:input_type -> nsm.v1.CancelUpdateRequest
-	18, // 41: nsm.v1.NVSwitchManager.PowerControl:input_type -> nsm.v1.PowerControlRequest
-	15, // 42: nsm.v1.NVSwitchManager.RegisterNVSwitches:output_type -> nsm.v1.RegisterNVSwitchesResponse
-	20, // 43: nsm.v1.NVSwitchManager.GetNVSwitches:output_type -> nsm.v1.GetNVSwitchesResponse
-	23, // 44: nsm.v1.NVSwitchManager.ListBundles:output_type -> nsm.v1.ListBundlesResponse
-	25, // 45: nsm.v1.NVSwitchManager.QueueUpdate:output_type -> nsm.v1.QueueUpdateResponse
-	27, // 46: nsm.v1.NVSwitchManager.QueueUpdates:output_type -> nsm.v1.QueueUpdatesResponse
-	30, // 47: nsm.v1.NVSwitchManager.GetUpdate:output_type -> nsm.v1.GetUpdateResponse
-	32, // 48: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> nsm.v1.GetUpdatesForSwitchResponse
-	33, // 49: nsm.v1.NVSwitchManager.GetAllUpdates:output_type -> nsm.v1.GetAllUpdatesResponse
-	35, // 50: nsm.v1.NVSwitchManager.CancelUpdate:output_type -> nsm.v1.CancelUpdateResponse
-	19, // 51: nsm.v1.NVSwitchManager.PowerControl:output_type -> nsm.v1.PowerControlResponse
-	42, // [42:52] is the sub-list for method output_type
-	32, // [32:42] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	44, // 30: nsm.v1.FirmwareUpdateInfo.created_at:type_name -> google.protobuf.Timestamp
+	44, // 31: nsm.v1.FirmwareUpdateInfo.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 32: nsm.v1.SensorReading.kind:type_name -> nsm.v1.SensorKind
+	6,  // 33: nsm.v1.SensorReading.health:type_name -> nsm.v1.HealthStatus
+	6,  // 34: nsm.v1.SwitchHealth.status:type_name -> nsm.v1.HealthStatus
+	44, // 35: nsm.v1.SwitchHealth.collected_at:type_name -> google.protobuf.Timestamp
+	39, // 36: nsm.v1.SwitchHealth.sensors:type_name -> nsm.v1.SensorReading
+	43, // 37: nsm.v1.GetSwitchHealthResponse.results:type_name -> nsm.v1.SwitchHealthResult
+	1,  // 38: nsm.v1.SwitchHealthResult.status:type_name -> nsm.v1.StatusCode
+	40, // 39: nsm.v1.SwitchHealthResult.health:type_name -> nsm.v1.SwitchHealth
+	15, // 40: nsm.v1.NVSwitchManager.RegisterNVSwitches:input_type -> nsm.v1.RegisterNVSwitchesRequest
+	18, // 41: nsm.v1.NVSwitchManager.GetNVSwitches:input_type -> nsm.v1.NVSwitchRequest
+	45, // 42: nsm.v1.NVSwitchManager.ListBundles:input_type -> google.protobuf.Empty
+	26, // 43: nsm.v1.NVSwitchManager.QueueUpdate:input_type -> nsm.v1.QueueUpdateRequest
+	28, // 44: nsm.v1.NVSwitchManager.QueueUpdates:input_type -> nsm.v1.QueueUpdatesRequest
+	31, // 45: nsm.v1.NVSwitchManager.GetUpdate:input_type -> nsm.v1.GetUpdateRequest
+	33, // 46: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:input_type -> nsm.v1.GetUpdatesForSwitchRequest
+	45, // 47: nsm.v1.NVSwitchManager.GetAllUpdates:input_type -> google.protobuf.Empty
+	36, // 48: nsm.v1.NVSwitchManager.CancelUpdate:input_type -> nsm.v1.CancelUpdateRequest
+	20, // 49: nsm.v1.NVSwitchManager.PowerControl:input_type -> nsm.v1.PowerControlRequest
+	41, // 50: nsm.v1.NVSwitchManager.GetSwitchHealth:input_type -> nsm.v1.GetSwitchHealthRequest
+	17, // 51: nsm.v1.NVSwitchManager.RegisterNVSwitches:output_type -> nsm.v1.RegisterNVSwitchesResponse
+	22, // 52: nsm.v1.NVSwitchManager.GetNVSwitches:output_type -> nsm.v1.GetNVSwitchesResponse
+	25, // 53: nsm.v1.NVSwitchManager.ListBundles:output_type -> nsm.v1.ListBundlesResponse
+	27, // 54: nsm.v1.NVSwitchManager.QueueUpdate:output_type -> nsm.v1.QueueUpdateResponse
+	29, // 55: nsm.v1.NVSwitchManager.QueueUpdates:output_type -> nsm.v1.QueueUpdatesResponse
+	32, // 56: nsm.v1.NVSwitchManager.GetUpdate:output_type -> nsm.v1.GetUpdateResponse
+	34, // 57: nsm.v1.NVSwitchManager.GetUpdatesForSwitch:output_type -> nsm.v1.GetUpdatesForSwitchResponse
+	35, // 58: nsm.v1.NVSwitchManager.GetAllUpdates:output_type -> nsm.v1.GetAllUpdatesResponse
+	37, // 59: nsm.v1.NVSwitchManager.CancelUpdate:output_type -> nsm.v1.CancelUpdateResponse
+	21, // 60: nsm.v1.NVSwitchManager.PowerControl:output_type -> nsm.v1.PowerControlResponse
+	42, // 61: nsm.v1.NVSwitchManager.GetSwitchHealth:output_type -> nsm.v1.GetSwitchHealthResponse
+	51, // [51:62] is the sub-list for method output_type
+	40, // [40:51] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_nvswitch_manager_proto_init() }
@@ -2560,8 +3085,8 @@ func file_nvswitch_manager_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nvswitch_manager_proto_rawDesc), len(file_nvswitch_manager_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   31,
+			NumEnums:      8,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -30,6 +30,7 @@ const (
 	NVSwitchManager_GetAllUpdates_FullMethodName       = "/nsm.v1.NVSwitchManager/GetAllUpdates"
 	NVSwitchManager_CancelUpdate_FullMethodName        = "/nsm.v1.NVSwitchManager/CancelUpdate"
 	NVSwitchManager_PowerControl_FullMethodName        = "/nsm.v1.NVSwitchManager/PowerControl"
+	NVSwitchManager_GetSwitchHealth_FullMethodName     = "/nsm.v1.NVSwitchManager/GetSwitchHealth"
 )
 
 // NVSwitchManagerClient is the client API for NVSwitchManager service.
@@ -62,6 +63,8 @@ type NVSwitchManagerClient interface {
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(ctx context.Context, in *PowerControlRequest, opts ...grpc.CallOption) (*PowerControlResponse, error)
+	// GetSwitchHealth returns the latest collected health sample for the specified switches (all if empty).
+	GetSwitchHealth(ctx context.Context, in *GetSwitchHealthRequest, opts ...grpc.CallOption) (*GetSwitchHealthResponse, error)
 }
 
 type nVSwitchManagerClient struct {
@@ -172,6 +175,16 @@ func (c *nVSwitchManagerClient) PowerControl(ctx context.Context, in *PowerContr
 	return out, nil
 }
 
+func (c *nVSwitchManagerClient) GetSwitchHealth(ctx context.Context, in *GetSwitchHealthRequest, opts ...grpc.CallOption) (*GetSwitchHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSwitchHealthResponse)
+	err := c.cc.Invoke(ctx, NVSwitchManager_GetSwitchHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NVSwitchManagerServer is the server API for NVSwitchManager service.
 // All implementations should embed UnimplementedNVSwitchManagerServer
 // for forward compatibility.
@@ -202,6 +215,8 @@ type NVSwitchManagerServer interface {
 	// Power Control
 	// PowerControl performs a power action (e.g. PowerCycle, GracefulShutdown) on NV-Switch trays.
 	PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error)
+	// GetSwitchHealth returns the latest collected health sample for the specified switches (all if empty).
+	GetSwitchHealth(context.Context, *GetSwitchHealthRequest) (*GetSwitchHealthResponse, error)
 }
 
 // UnimplementedNVSwitchManagerServer should be embedded to have
@@ -241,6 +256,9 @@ func (UnimplementedNVSwitchManagerServer) CancelUpdate(context.Context, *CancelU
 func (UnimplementedNVSwitchManagerServer) PowerControl(context.Context, *PowerControlRequest) (*PowerControlResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerControl not implemented")
 }
+func (UnimplementedNVSwitchManagerServer) GetSwitchHealth(context.Context, *GetSwitchHealthRequest) (*GetSwitchHealthResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSwitchHealth not implemented")
+}
 func (UnimplementedNVSwitchManagerServer) testEmbeddedByValue() {}
 
 // UnsafeNVSwitchManagerServer may be embedded to opt out of forward compatibility for this service.
@@ -441,6 +459,24 @@ func _NVSwitchManager_PowerControl_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NVSwitchManager_GetSwitchHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSwitchHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NVSwitchManagerServer).GetSwitchHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NVSwitchManager_GetSwitchHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NVSwitchManagerServer).GetSwitchHealth(ctx, req.(*GetSwitchHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NVSwitchManager_ServiceDesc is the grpc.ServiceDesc for NVSwitchManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -488,6 +524,10 @@ var NVSwitchManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PowerControl",
 			Handler:    _NVSwitchManager_PowerControl_Handler,
 		},
+		{
+			MethodName: "GetSwitchHealth",
+			Handler:    _NVSwitchManager_GetSwitchHealth_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "nvswitch-manager.proto",
@@ -31,6 +31,10 @@ import (
 	pb "github.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi/gen"
 )
 
+// maintenanceOverrideReason is sent with firmware updates because RLA tasks are
+// already held until the rack's maintenance window opens.
+const maintenanceOverrideReason = "RLA task, maintenance window checked by RLA"
+
 type grpcClient struct {
 	conn        *grpc.ClientConn
 	client      pb.NVSwitchManagerClient
@@ -104,9 +108,10 @@ func (c *grpcClient) QueueUpdates(ctx context.Context, switchUUIDs []string, bun
 	}
 
 	resp, err := c.client.QueueUpdates(ctx, &pb.QueueUpdatesRequest{
-		SwitchUuids:   switchUUIDs,
-		BundleVersion: bundleVersion,
-		Components:    pbComponents,
+		SwitchUuids:               switchUUIDs,
+		BundleVersion:             bundleVersion,
+		Components:                pbComponents,
+		MaintenanceOverrideReason: maintenanceOverrideReason,
 	})
 
 	if err != nil {
@@ -166,3 +171,20 @@ func (c *grpcClient) ListBundles(ctx context.Context) ([]FirmwareBundle, error)
 	}
 	return results, nil
 }
+
+// GetSwitchHealth returns the latest health sample for the specified switches (all if empty).
+func (c *grpcClient) GetSwitchHealth(ctx context.Context, uuids []string) ([]SwitchHealthResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, c.grpcTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetSwitchHealth(ctx, &pb.GetSwitchHealthRequest{SwitchUuids: uuids})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []SwitchHealthResult
+	for _, r := range resp.GetResults() {
+		results = append(results, switchHealthResultFromPb(r))
+	}
+	return results, nil
+}
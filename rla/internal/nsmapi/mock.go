@@ -99,3 +99,23 @@ func (c *mockClient) ListBundles(_ context.Context) ([]FirmwareBundle, error) {
 		},
 	}, nil
 }
+
+func (c *mockClient) GetSwitchHealth(_ context.Context, uuids []string) ([]SwitchHealthResult, error) {
+	var results []SwitchHealthResult
+	for _, id := range uuids {
+		results = append(results, SwitchHealthResult{
+			UUID:   id,
+			Status: StatusSuccess,
+			Health: &SwitchHealth{
+				SwitchUUID:  id,
+				CollectedAt: time.Now(),
+				Sensors: []SensorReading{
+					{Kind: SensorKindPSU, Name: "MGX_NVSwitch_0/PSU0", Reading: 450, Units: "W", State: "Enabled"},
+					{Kind: SensorKindPSU, Name: "MGX_NVSwitch_0/PSU1", Reading: 450, Units: "W", State: "Enabled"},
+					{Kind: SensorKindFan, Name: "MGX_NVSwitch_0/Fan0", Reading: 9000, Units: "RPM", State: "Enabled"},
+				},
+			},
+		})
+	}
+	return results, nil
+}
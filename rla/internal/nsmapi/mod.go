@@ -37,6 +37,10 @@ type Client interface {
 	// ListBundles returns all available firmware bundles.
 	ListBundles(ctx context.Context) ([]FirmwareBundle, error)
 
+	// GetSwitchHealth returns the latest health sample, including PSU power readings, for the specified switches.
+	// If uuids is empty, all registered switches are returned.
+	GetSwitchHealth(ctx context.Context, uuids []string) ([]SwitchHealthResult, error)
+
 	// Close closes the underlying gRPC connection.
 	Close() error
 }
@@ -296,3 +296,83 @@ func firmwareBundleFromPb(bundle *pb.FirmwareBundle) FirmwareBundle {
 	}
 	return result
 }
+
+// SensorKind identifies the type of a switch BMC sensor.
+type SensorKind int
+
+const (
+	SensorKindUnknown     SensorKind = 0
+	SensorKindFan         SensorKind = 1
+	SensorKindPSU         SensorKind = 2
+	SensorKindTemperature SensorKind = 3
+)
+
+func sensorKindFromPb(k pb.SensorKind) SensorKind {
+	switch k {
+	case pb.SensorKind_SENSOR_KIND_FAN:
+		return SensorKindFan
+	case pb.SensorKind_SENSOR_KIND_PSU:
+		return SensorKindPSU
+	case pb.SensorKind_SENSOR_KIND_TEMPERATURE:
+		return SensorKindTemperature
+	default:
+		return SensorKindUnknown
+	}
+}
+
+// SensorReading is a fan, PSU or thermal sensor reported by the switch BMC.
+type SensorReading struct {
+	Kind    SensorKind
+	Name    string
+	Reading float64
+	Units   string
+	State   string
+}
+
+// SwitchHealth is the latest health sample collected by NV-Switch Manager for a switch.
+type SwitchHealth struct {
+	SwitchUUID  string
+	CollectedAt time.Time
+	Sensors     []SensorReading
+	Errors      []string
+}
+
+func switchHealthFromPb(h *pb.SwitchHealth) *SwitchHealth {
+	if h == nil {
+		return nil
+	}
+	result := &SwitchHealth{
+		SwitchUUID: h.GetSwitchUuid(),
+		Errors:     h.GetErrors(),
+	}
+	if h.GetCollectedAt() != nil {
+		result.CollectedAt = h.GetCollectedAt().AsTime()
+	}
+	for _, sr := range h.GetSensors() {
+		result.Sensors = append(result.Sensors, SensorReading{
+			Kind:    sensorKindFromPb(sr.GetKind()),
+			Name:    sr.GetName(),
+			Reading: sr.GetReading(),
+			Units:   sr.GetUnits(),
+			State:   sr.GetState(),
+		})
+	}
+	return result
+}
+
+// SwitchHealthResult contains the health of a single switch. Health is nil if no sample has been collected yet.
+type SwitchHealthResult struct {
+	UUID   string
+	Status StatusCode
+	Error  string
+	Health *SwitchHealth
+}
+
+func switchHealthResultFromPb(r *pb.SwitchHealthResult) SwitchHealthResult {
+	return SwitchHealthResult{
+		UUID:   r.GetSwitchUuid(),
+		Status: statusCodeFromPb(r.GetStatus()),
+		Error:  r.GetError(),
+		Health: switchHealthFromPb(r.GetHealth()),
+	}
+}
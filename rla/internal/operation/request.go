@@ -20,6 +20,7 @@ package operation
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
 
@@ -161,10 +162,14 @@ func (er *ExternalRef) Validate() error {
 // -- Operation: The operation to be performed.
 // -- TargetSpec: Either rack targets or component targets (single-type targeting enforced).
 // -- Description: Optional task description.
+// -- WindowOverride: Run immediately even if the maintenance window is closed.
+// -- OverrideReason: Justification recorded with the task; required with WindowOverride.
 type Request struct {
-	Operation   Wrapper
-	TargetSpec  TargetSpec // Either racks or components, not both
-	Description string
+	Operation      Wrapper
+	TargetSpec     TargetSpec // Either racks or components, not both
+	Description    string
+	WindowOverride bool
+	OverrideReason string
 }
 
 func (r *Request) Validate() error {
@@ -180,5 +185,9 @@ func (r *Request) Validate() error {
 		return fmt.Errorf("invalid target spec: %w", err)
 	}
 
+	if r.WindowOverride && strings.TrimSpace(r.OverrideReason) == "" {
+		return fmt.Errorf("a reason is required to override the maintenance window")
+	}
+
 	return nil
 }
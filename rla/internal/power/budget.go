@@ -0,0 +1,107 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// ErrBudgetExceeded is returned when powering on components would push a rack
+// over its power budget.
+var ErrBudgetExceeded = errors.New("power budget exceeded")
+
+// NominalWatts returns the draw assumed for each component type once it is
+// powered on. Types that are not listed (e.g. power shelves) add nothing.
+func NominalWatts(conf config.Config) map[devicetypes.ComponentType]float64 {
+	return map[devicetypes.ComponentType]float64{
+		devicetypes.ComponentTypeCompute:   conf.NominalComputeWatts,
+		devicetypes.ComponentTypeNVLSwitch: conf.NominalNVLSwitchWatts,
+	}
+}
+
+// ProjectedDraw returns the rack draw expected once the targets are powered
+// on. Each target is assumed to reach its nominal draw; what it already draws
+// is part of stats.DrawWatts, so only the difference is added. Targets without
+// a reading are treated as drawing nothing.
+func ProjectedDraw(
+	stats RackStats,
+	readings []Reading,
+	targets []*component.Component,
+	nominal map[devicetypes.ComponentType]float64,
+) float64 {
+	measured := make(map[uuid.UUID]float64, len(readings))
+	for _, rd := range readings {
+		if rd.Available {
+			measured[rd.ID] = rd.Watts
+		}
+	}
+
+	projected := stats.DrawWatts
+	for _, t := range targets {
+		if t.RackID != stats.RackID {
+			continue
+		}
+		if n := nominal[t.Type]; n > measured[t.Info.ID] {
+			projected += n - measured[t.Info.ID]
+		}
+	}
+
+	return projected
+}
+
+// CheckPowerOn reads the current draw of the rack and returns an error
+// wrapping ErrBudgetExceeded if powering on the targets would take it over
+// its budget. The rack must be loaded with its components. Racks without a
+// budget always pass.
+func (c *Collector) CheckPowerOn(
+	ctx context.Context,
+	r *rack.Rack,
+	targets []*component.Component,
+	nominal map[devicetypes.ComponentType]float64,
+) error {
+	if r.PowerBudgetWatts == nil || *r.PowerBudgetWatts <= 0 {
+		return nil
+	}
+
+	components := make([]*component.Component, 0, len(r.Components))
+	for i := range r.Components {
+		components = append(components, &r.Components[i])
+	}
+
+	readings := c.Collect(ctx, components)
+	stats := AggregateRack(r, readings)
+	projected := ProjectedDraw(stats, readings, targets, nominal)
+
+	if projected > *r.PowerBudgetWatts {
+		return fmt.Errorf(
+			"%w: rack %s would draw %.0f W (currently %.0f W) against a budget of %.0f W",
+			ErrBudgetExceeded, r.Info.Name, projected, stats.DrawWatts, *r.PowerBudgetWatts,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package power reads the power draw of rack components from the services that
+// manage them, aggregates it per rack and NVL domain, and enforces per-rack
+// power budgets. Compute trays are read through Carbide, NVLink switches
+// through NV-Switch Manager and power shelves through PSM.
+package power
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/psmapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+)
+
+// Reading is the latest power reading of a single component. For power
+// shelves Watts is the output delivered to the rack; for everything else it
+// is the power consumed.
+type Reading struct {
+	ID          uuid.UUID
+	ComponentID string
+	Type        devicetypes.ComponentType
+	RackID      uuid.UUID
+	Watts       float64
+	Available   bool
+}
+
+// Collector reads component power from Carbide, NV-Switch Manager and PSM.
+// Any of the clients may be nil, in which case readings for that component
+// type are reported as unavailable.
+type Collector struct {
+	carbideClient carbideapi.Client
+	nsmClient     nsmapi.Client
+	psmClient     psmapi.Client
+}
+
+// NewCollector creates a Collector using the given clients.
+func NewCollector(
+	carbideClient carbideapi.Client,
+	nsmClient nsmapi.Client,
+	psmClient psmapi.Client,
+) *Collector {
+	return &Collector{
+		carbideClient: carbideClient,
+		nsmClient:     nsmClient,
+		psmClient:     psmClient,
+	}
+}
+
+// Collect returns one reading per given component, in the same order. A
+// failure of one source service is logged and only marks the components it
+// manages as unavailable.
+func (c *Collector) Collect(
+	ctx context.Context,
+	components []*component.Component,
+) []Reading {
+	readings := make([]Reading, 0, len(components))
+	idsByType := make(map[devicetypes.ComponentType][]string)
+
+	for _, comp := range components {
+		readings = append(readings, Reading{
+			ID:          comp.Info.ID,
+			ComponentID: comp.ComponentID,
+			Type:        comp.Type,
+			RackID:      comp.RackID,
+		})
+		if comp.ComponentID != "" {
+			idsByType[comp.Type] = append(idsByType[comp.Type], comp.ComponentID)
+		}
+	}
+
+	watts := make(map[devicetypes.ComponentType]map[string]float64)
+	if ids := idsByType[devicetypes.ComponentTypeCompute]; len(ids) > 0 {
+		watts[devicetypes.ComponentTypeCompute] = c.computeWatts(ctx, ids)
+	}
+	if ids := idsByType[devicetypes.ComponentTypeNVLSwitch]; len(ids) > 0 {
+		watts[devicetypes.ComponentTypeNVLSwitch] = c.nvlSwitchWatts(ctx, ids)
+	}
+	if ids := idsByType[devicetypes.ComponentTypePowerShelf]; len(ids) > 0 {
+		watts[devicetypes.ComponentTypePowerShelf] = c.powerShelfWatts(ctx, ids)
+	}
+
+	for i := range readings {
+		if w, ok := watts[readings[i].Type][readings[i].ComponentID]; ok {
+			readings[i].Watts = w
+			readings[i].Available = true
+		}
+	}
+
+	return readings
+}
+
+func (c *Collector) computeWatts(
+	ctx context.Context,
+	machineIDs []string,
+) map[string]float64 {
+	result := make(map[string]float64)
+	if c.carbideClient == nil {
+		return result
+	}
+
+	draws, err := c.carbideClient.GetMachinePowerDraw(ctx, machineIDs)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to read compute power from Carbide")
+		return result
+	}
+
+	for _, d := range draws {
+		result[d.MachineID] = d.Watts
+	}
+
+	return result
+}
+
+func (c *Collector) nvlSwitchWatts(
+	ctx context.Context,
+	switchUUIDs []string,
+) map[string]float64 {
+	result := make(map[string]float64)
+	if c.nsmClient == nil {
+		return result
+	}
+
+	results, err := c.nsmClient.GetSwitchHealth(ctx, switchUUIDs)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to read NVLink switch power from NV-Switch Manager")
+		return result
+	}
+
+	for _, r := range results {
+		if r.Status != nsmapi.StatusSuccess || r.Health == nil {
+			continue
+		}
+		if w, ok := switchWatts(r.Health); ok {
+			result[r.UUID] = w
+		}
+	}
+
+	return result
+}
+
+func (c *Collector) powerShelfWatts(
+	ctx context.Context,
+	pmcMACs []string,
+) map[string]float64 {
+	result := make(map[string]float64)
+	if c.psmClient == nil {
+		return result
+	}
+
+	shelves, err := c.psmClient.GetPowershelves(ctx, pmcMACs)
+	if err != nil {
+		log.Warn().Err(err).Msg("Unable to read power shelf output from PSM")
+		return result
+	}
+
+	for _, ps := range shelves {
+		if w, ok := shelfOutputWatts(ps); ok {
+			result[ps.PMC.MACAddress] = w
+		}
+	}
+
+	return result
+}
+
+// switchWatts sums the PSU power sensors reported by the switch BMC.
+func switchWatts(h *nsmapi.SwitchHealth) (float64, bool) {
+	total, found := 0.0, false
+	for _, s := range h.Sensors {
+		if s.Kind == nsmapi.SensorKindPSU && strings.EqualFold(s.Units, "W") {
+			total += s.Reading
+			found = true
+		}
+	}
+
+	return total, found
+}
+
+// shelfOutputWatts sums the output power of every PSU in the shelf.
+func shelfOutputWatts(ps psmapi.PowerShelf) (float64, bool) {
+	total, found := 0.0, false
+	for _, psu := range ps.PSUs {
+		if w, ok := psuOutputWatts(psu); ok {
+			total += w
+			found = true
+		}
+	}
+
+	return total, found
+}
+
+// psuOutputWatts returns the PSU output power sensor reading. PSUs report
+// input and output power as separate sensors, so a sensor named as output is
+// preferred; otherwise the first power sensor is used.
+func psuOutputWatts(psu psmapi.PowerSupplyUnit) (float64, bool) {
+	var fallback *float64
+	for _, s := range psu.Sensors {
+		if !strings.EqualFold(s.ReadingType, "Power") && !strings.EqualFold(s.ReadingUnits, "W") {
+			continue
+		}
+
+		reading := float64(s.Reading)
+		if strings.Contains(strings.ToLower(s.ID+" "+s.Name), "output") {
+			return reading, true
+		}
+
+		if fallback == nil {
+			fallback = &reading
+		}
+	}
+
+	if fallback == nil {
+		return 0, false
+	}
+
+	return *fallback, true
+}
@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// RackLister returns the racks to monitor, loaded with their components.
+type RackLister func(ctx context.Context) ([]*rack.Rack, error)
+
+// Monitor periodically samples the draw of racks that have a power budget
+// and raises an alert once a rack has stayed over its budget for the sustain
+// period. A follow-up alert is sent when the rack drops back under budget.
+type Monitor struct {
+	collector *Collector
+	racks     RackLister
+	frequency time.Duration
+	sustain   time.Duration
+	now       func() time.Time
+	send      func(context.Context, alert.Alert) error
+
+	overSince map[uuid.UUID]time.Time
+	alerted   map[uuid.UUID]bool
+}
+
+// NewMonitor creates a Monitor that samples racks returned by racks using the
+// frequency and sustain period from conf.
+func NewMonitor(collector *Collector, racks RackLister, conf config.Config) *Monitor {
+	return &Monitor{
+		collector: collector,
+		racks:     racks,
+		frequency: conf.PowerMonitorFrequency,
+		sustain:   conf.PowerBudgetSustainPeriod,
+		now:       time.Now,
+		send:      alert.Send,
+		overSince: make(map[uuid.UUID]time.Time),
+		alerted:   make(map[uuid.UUID]bool),
+	}
+}
+
+// Run samples rack draw until ctx is cancelled.
+func (m *Monitor) Run(ctx context.Context) {
+	if m.frequency <= 0 {
+		log.Info().Msg("Power budget monitor disabled by configuration")
+		return
+	}
+
+	ticker := time.NewTicker(m.frequency)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check(ctx)
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context) {
+	racks, err := m.racks(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("Power budget monitor unable to list racks")
+		return
+	}
+
+	var budgeted []*rack.Rack
+	var components []*component.Component
+	for _, r := range racks {
+		if r.PowerBudgetWatts == nil || *r.PowerBudgetWatts <= 0 {
+			continue
+		}
+
+		budgeted = append(budgeted, r)
+		for i := range r.Components {
+			components = append(components, &r.Components[i])
+		}
+	}
+
+	readings := m.collector.Collect(ctx, components)
+	now := m.now()
+	seen := make(map[uuid.UUID]bool, len(budgeted))
+
+	for _, r := range budgeted {
+		stats := AggregateRack(r, readings)
+		seen[stats.RackID] = true
+
+		if !stats.OverBudget() {
+			if m.alerted[stats.RackID] {
+				m.sendAlert(ctx, alert.SeverityInfo, &stats, m.overSince[stats.RackID],
+					"rack %s is back under its power budget", stats.RackName)
+			}
+			delete(m.overSince, stats.RackID)
+			delete(m.alerted, stats.RackID)
+			continue
+		}
+
+		since, ok := m.overSince[stats.RackID]
+		if !ok {
+			since = now
+			m.overSince[stats.RackID] = now
+		}
+
+		if !m.alerted[stats.RackID] && now.Sub(since) >= m.sustain {
+			m.sendAlert(ctx, alert.SeverityCritical, &stats, since,
+				"rack %s has drawn %.0f W against a %.0f W budget for %s",
+				stats.RackName, stats.DrawWatts, *stats.BudgetWatts, now.Sub(since).Round(time.Second))
+			m.alerted[stats.RackID] = true
+		}
+	}
+
+	// Forget racks that were deleted or lost their budget.
+	for id := range m.overSince {
+		if !seen[id] {
+			delete(m.overSince, id)
+			delete(m.alerted, id)
+		}
+	}
+}
+
+func (m *Monitor) sendAlert(
+	ctx context.Context,
+	severity alert.Severity,
+	stats *RackStats,
+	since time.Time,
+	format string,
+	args ...any,
+) {
+	err := m.send(ctx, alert.Alert{
+		Severity:  severity,
+		Message:   fmt.Sprintf(format, args...),
+		Component: "rack",
+		Operation: "power_budget",
+		Details: map[string]string{
+			"rack_id":      stats.RackID.String(),
+			"rack_name":    stats.RackName,
+			"draw_watts":   fmt.Sprintf("%.0f", stats.DrawWatts),
+			"budget_watts": fmt.Sprintf("%.0f", *stats.BudgetWatts),
+			"over_since":   since.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		log.Warn().Err(err).Str("rack_id", stats.RackID.String()).Msg("Failed to send power budget alert")
+	}
+}
@@ -0,0 +1,304 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package power
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/psmapi"
+	identifier "github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/Identifier"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/deviceinfo"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+)
+
+func budget(w float64) *float64 {
+	return &w
+}
+
+func newComponent(
+	rackID uuid.UUID,
+	typ devicetypes.ComponentType,
+	componentID string,
+) component.Component {
+	return component.Component{
+		Type:        typ,
+		Info:        deviceinfo.DeviceInfo{ID: uuid.New()},
+		ComponentID: componentID,
+		RackID:      rackID,
+	}
+}
+
+// testRack returns a rack with two compute trays, one NVLink switch and one
+// power shelf.
+func testRack(budgetWatts *float64) *rack.Rack {
+	id := uuid.New()
+	return &rack.Rack{
+		Info: deviceinfo.DeviceInfo{ID: id, Name: "rack-1"},
+		Components: []component.Component{
+			newComponent(id, devicetypes.ComponentTypeCompute, "machine-1"),
+			newComponent(id, devicetypes.ComponentTypeCompute, "machine-2"),
+			newComponent(id, devicetypes.ComponentTypeNVLSwitch, "switch-1"),
+			newComponent(id, devicetypes.ComponentTypePowerShelf, "00:11:22:33:44:55"),
+		},
+		PowerBudgetWatts: budgetWatts,
+	}
+}
+
+func powerShelf(mac string, outputWatts ...float32) psmapi.PowerShelf {
+	ps := psmapi.PowerShelf{PMC: psmapi.PowerManagementController{MACAddress: mac}}
+	for _, w := range outputWatts {
+		ps.PSUs = append(ps.PSUs, psmapi.PowerSupplyUnit{
+			Sensors: []psmapi.Sensor{
+				{ID: "input_power", ReadingType: "Power", ReadingUnits: "W", Reading: w + 50},
+				{ID: "output_power", ReadingType: "Power", ReadingUnits: "W", Reading: w},
+				{ID: "output_voltage", ReadingType: "Voltage", ReadingUnits: "V", Reading: 54},
+			},
+		})
+	}
+	return ps
+}
+
+func components(r *rack.Rack) []*component.Component {
+	result := make([]*component.Component, 0, len(r.Components))
+	for i := range r.Components {
+		result = append(result, &r.Components[i])
+	}
+	return result
+}
+
+func TestCollect(t *testing.T) {
+	carbide := carbideapi.NewMockClient()
+	carbide.AddPowerDraw("machine-1", 5000)
+	psm := psmapi.NewMockClient()
+	psm.AddPowershelf(powerShelf("00:11:22:33:44:55", 3000, 3200))
+
+	r := testRack(nil)
+	readings := NewCollector(carbide, nsmapi.NewMockClient(), psm).Collect(context.Background(), components(r))
+	require.Len(t, readings, 4)
+
+	assert.True(t, readings[0].Available)
+	assert.Equal(t, 5000.0, readings[0].Watts)
+	assert.False(t, readings[1].Available, "machine-2 has no reading")
+	assert.True(t, readings[2].Available)
+	assert.Equal(t, 900.0, readings[2].Watts, "sum of the mock switch PSU sensors")
+	assert.True(t, readings[3].Available)
+	assert.Equal(t, 6200.0, readings[3].Watts, "output sensors only")
+}
+
+func TestCollectWithoutClients(t *testing.T) {
+	r := testRack(nil)
+	readings := NewCollector(nil, nil, nil).Collect(context.Background(), components(r))
+	require.Len(t, readings, 4)
+	for _, rd := range readings {
+		assert.False(t, rd.Available)
+	}
+}
+
+func TestAggregateRack(t *testing.T) {
+	r := testRack(budget(10000))
+	c := r.Components
+
+	testCases := map[string]struct {
+		readings []Reading
+		draw     float64
+		over     bool
+		missing  int
+	}{
+		"power shelf output is the rack draw": {
+			readings: []Reading{
+				{ID: c[0].Info.ID, RackID: r.Info.ID, Type: c[0].Type, Watts: 5000, Available: true},
+				{ID: c[2].Info.ID, RackID: r.Info.ID, Type: c[2].Type, Watts: 900, Available: true},
+				{ID: c[3].Info.ID, RackID: r.Info.ID, Type: c[3].Type, Watts: 11000, Available: true},
+			},
+			draw: 11000,
+			over: true,
+		},
+		"compute and switch without a shelf reading": {
+			readings: []Reading{
+				{ID: c[0].Info.ID, RackID: r.Info.ID, Type: c[0].Type, Watts: 5000, Available: true},
+				{ID: c[1].Info.ID, RackID: r.Info.ID, Type: c[1].Type, Available: false},
+				{ID: c[2].Info.ID, RackID: r.Info.ID, Type: c[2].Type, Watts: 900, Available: true},
+				{ID: c[3].Info.ID, RackID: r.Info.ID, Type: c[3].Type, Available: false},
+			},
+			draw:    5900,
+			missing: 2,
+		},
+		"readings of other racks are ignored": {
+			readings: []Reading{
+				{ID: uuid.New(), RackID: uuid.New(), Type: c[0].Type, Watts: 50000, Available: true},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			stats := AggregateRack(r, tc.readings)
+			assert.Equal(t, tc.draw, stats.DrawWatts)
+			assert.Equal(t, tc.over, stats.OverBudget())
+			assert.Equal(t, tc.missing, stats.Unavailable)
+		})
+	}
+}
+
+func TestAggregateDomains(t *testing.T) {
+	domainA := identifier.Identifier{ID: uuid.New(), Name: "a"}
+	domainB := identifier.Identifier{ID: uuid.New(), Name: "b"}
+
+	racks := []RackStats{
+		{RackID: uuid.New(), NVLDomainID: domainB.ID, DrawWatts: 100, BudgetWatts: budget(200)},
+		{RackID: uuid.New(), NVLDomainID: domainA.ID, DrawWatts: 300},
+		{RackID: uuid.New(), NVLDomainID: domainB.ID, DrawWatts: 50, BudgetWatts: budget(150)},
+		{RackID: uuid.New(), DrawWatts: 1000},
+	}
+
+	domains := AggregateDomains(racks, map[uuid.UUID]identifier.Identifier{
+		domainA.ID: domainA,
+		domainB.ID: domainB,
+	})
+	require.Len(t, domains, 2)
+
+	assert.Equal(t, DomainStats{NVLDomain: domainA, DrawWatts: 300, RackCount: 1}, domains[0])
+	assert.Equal(t, DomainStats{NVLDomain: domainB, DrawWatts: 150, BudgetWatts: 350, RackCount: 2}, domains[1])
+}
+
+func TestCheckPowerOn(t *testing.T) {
+	nominal := NominalWatts(config.Config{NominalComputeWatts: 6000, NominalNVLSwitchWatts: 1600})
+
+	testCases := map[string]struct {
+		budget  *float64
+		targets func(r *rack.Rack) []*component.Component
+		wantErr bool
+	}{
+		"no budget": {
+			budget:  nil,
+			targets: components,
+		},
+		"off tray fits": {
+			// 6100 W shelf output + (6000 - 0) for machine-2
+			budget: budget(12500),
+			targets: func(r *rack.Rack) []*component.Component {
+				return []*component.Component{&r.Components[1]}
+			},
+		},
+		"off tray exceeds budget": {
+			budget: budget(12000),
+			targets: func(r *rack.Rack) []*component.Component {
+				return []*component.Component{&r.Components[1]}
+			},
+			wantErr: true,
+		},
+		"tray already drawing only adds the difference": {
+			// 6100 W shelf output + (6000 - 5000) for machine-1
+			budget: budget(7200),
+			targets: func(r *rack.Rack) []*component.Component {
+				return []*component.Component{&r.Components[0]}
+			},
+		},
+		"power shelves add nothing": {
+			budget: budget(6200),
+			targets: func(r *rack.Rack) []*component.Component {
+				return []*component.Component{&r.Components[3]}
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			carbide := carbideapi.NewMockClient()
+			carbide.AddPowerDraw("machine-1", 5000)
+			psm := psmapi.NewMockClient()
+			psm.AddPowershelf(powerShelf("00:11:22:33:44:55", 3000, 3100))
+
+			r := testRack(tc.budget)
+			err := NewCollector(carbide, nsmapi.NewMockClient(), psm).
+				CheckPowerOn(context.Background(), r, tc.targets(r), nominal)
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrBudgetExceeded)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMonitorSustainedDraw(t *testing.T) {
+	carbide := carbideapi.NewMockClient()
+	carbide.AddPowerDraw("machine-1", 6000)
+	carbide.AddPowerDraw("machine-2", 6000)
+
+	r := testRack(budget(10000))
+	// Drop the power shelf so the draw comes from the trays alone.
+	r.Components = r.Components[:3]
+
+	var alerts []alert.Alert
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	m := NewMonitor(
+		NewCollector(carbide, nil, nil),
+		func(context.Context) ([]*rack.Rack, error) { return []*rack.Rack{r}, nil },
+		config.Config{PowerMonitorFrequency: time.Minute, PowerBudgetSustainPeriod: 5 * time.Minute},
+	)
+	m.now = func() time.Time { return now }
+	m.send = func(_ context.Context, a alert.Alert) error {
+		alerts = append(alerts, a)
+		return nil
+	}
+
+	ctx := context.Background()
+
+	m.check(ctx)
+	assert.Empty(t, alerts, "over budget but not sustained yet")
+
+	now = now.Add(4 * time.Minute)
+	m.check(ctx)
+	assert.Empty(t, alerts)
+
+	now = now.Add(time.Minute)
+	m.check(ctx)
+	require.Len(t, alerts, 1)
+	assert.Equal(t, alert.SeverityCritical, alerts[0].Severity)
+	assert.Equal(t, r.Info.ID.String(), alerts[0].Details["rack_id"])
+	assert.Equal(t, "12000", alerts[0].Details["draw_watts"])
+
+	now = now.Add(time.Minute)
+	m.check(ctx)
+	assert.Len(t, alerts, 1, "alert is only raised once while over budget")
+
+	carbide.AddPowerDraw("machine-2", 1000)
+	now = now.Add(time.Minute)
+	m.check(ctx)
+	require.Len(t, alerts, 2)
+	assert.Equal(t, alert.SeverityInfo, alerts[1].Severity)
+
+	carbide.AddPowerDraw("machine-2", 6000)
+	now = now.Add(time.Minute)
+	m.check(ctx)
+	assert.Len(t, alerts, 2, "sustain period restarts after recovery")
+}
@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package power
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+
+	identifier "github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/Identifier"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// RackStats aggregates the readings of the components of a rack.
+type RackStats struct {
+	RackID                uuid.UUID
+	RackName              string
+	NVLDomainID           uuid.UUID
+	ComputeWatts          float64
+	NVLSwitchWatts        float64
+	PowerShelfOutputWatts float64
+	// DrawWatts is the power shelf output when any shelf reported, since that
+	// covers everything in the rack; otherwise compute plus NVLink switch.
+	DrawWatts   float64
+	BudgetWatts *float64
+	Unavailable int
+}
+
+// HasBudget reports whether the rack has a non-zero power budget.
+func (s *RackStats) HasBudget() bool {
+	return s.BudgetWatts != nil && *s.BudgetWatts > 0
+}
+
+// OverBudget reports whether the rack draw exceeds its budget.
+func (s *RackStats) OverBudget() bool {
+	return s.HasBudget() && s.DrawWatts > *s.BudgetWatts
+}
+
+// DomainStats aggregates the racks of an NVL domain.
+type DomainStats struct {
+	NVLDomain   identifier.Identifier
+	DrawWatts   float64
+	BudgetWatts float64
+	RackCount   int
+}
+
+// AggregateRack sums the readings that belong to the given rack.
+func AggregateRack(r *rack.Rack, readings []Reading) RackStats {
+	stats := RackStats{
+		RackID:      r.Info.ID,
+		RackName:    r.Info.Name,
+		BudgetWatts: r.PowerBudgetWatts,
+	}
+
+	shelfReported := false
+	for _, rd := range readings {
+		if rd.RackID != r.Info.ID {
+			continue
+		}
+
+		if !rd.Available {
+			stats.Unavailable++
+			continue
+		}
+
+		switch rd.Type {
+		case devicetypes.ComponentTypeCompute:
+			stats.ComputeWatts += rd.Watts
+		case devicetypes.ComponentTypeNVLSwitch:
+			stats.NVLSwitchWatts += rd.Watts
+		case devicetypes.ComponentTypePowerShelf:
+			stats.PowerShelfOutputWatts += rd.Watts
+			shelfReported = true
+		}
+	}
+
+	if shelfReported {
+		stats.DrawWatts = stats.PowerShelfOutputWatts
+	} else {
+		stats.DrawWatts = stats.ComputeWatts + stats.NVLSwitchWatts
+	}
+
+	return stats
+}
+
+// AggregateDomains groups rack stats by NVL domain. Racks that are not in a
+// domain are skipped. The result is ordered by domain name.
+func AggregateDomains(
+	racks []RackStats,
+	domains map[uuid.UUID]identifier.Identifier,
+) []DomainStats {
+	byID := make(map[uuid.UUID]*DomainStats)
+	for _, rs := range racks {
+		if rs.NVLDomainID == uuid.Nil {
+			continue
+		}
+
+		ds, ok := byID[rs.NVLDomainID]
+		if !ok {
+			id := identifier.Identifier{ID: rs.NVLDomainID}
+			if known, found := domains[rs.NVLDomainID]; found {
+				id = known
+			}
+			ds = &DomainStats{NVLDomain: id}
+			byID[rs.NVLDomainID] = ds
+		}
+
+		ds.DrawWatts += rs.DrawWatts
+		if rs.HasBudget() {
+			ds.BudgetWatts += *rs.BudgetWatts
+		}
+		ds.RackCount++
+	}
+
+	result := make([]DomainStats, 0, len(byID))
+	for _, ds := range byID {
+		result = append(result, *ds)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].NVLDomain.Name != result[j].NVLDomain.Name {
+			return result[i].NVLDomain.Name < result[j].NVLDomain.Name
+		}
+		return result[i].NVLDomain.ID.String() < result[j].NVLDomain.ID.String()
+	})
+
+	return result
+}
@@ -1380,10 +1380,11 @@ func (x *UpdatePowershelfFirmwareRequest) GetComponents() []*UpdateComponentFirm
 }
 
 type UpdateFirmwareRequest struct {
-	state         protoimpl.MessageState             `protogen:"open.v1"`
-	Upgrades      []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                     protoimpl.MessageState             `protogen:"open.v1"`
+	Upgrades                  []*UpdatePowershelfFirmwareRequest `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades,omitempty"`
+	MaintenanceOverrideReason string                             `protobuf:"bytes,2,opt,name=maintenance_override_reason,json=maintenanceOverrideReason,proto3" json:"maintenance_override_reason,omitempty"` // Bypass a closed rack maintenance window (audited)
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *UpdateFirmwareRequest) Reset() {
@@ -1423,6 +1424,13 @@ func (x *UpdateFirmwareRequest) GetUpgrades() []*UpdatePowershelfFirmwareRequest
 	return nil
 }
 
+func (x *UpdateFirmwareRequest) GetMaintenanceOverrideReason() string {
+	if x != nil {
+		return x.MaintenanceOverrideReason
+	}
+	return ""
+}
+
 type UpdateComponentFirmwareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Component     PowershelfComponent    `protobuf:"varint,1,opt,name=component,proto3,enum=v1.PowershelfComponent" json:"component,omitempty"`
@@ -2174,9 +2182,10 @@ const file_powershelf_manager_proto_rawDesc = "" +
 	"\x0fpmc_mac_address\x18\x01 \x01(\tR\rpmcMacAddress\x12B\n" +
 	"\n" +
 	"components\x18\x02 \x03(\v2\".v1.UpdateComponentFirmwareRequestR\n" +
-	"components\"X\n" +
+	"components\"\x98\x01\n" +
 	"\x15UpdateFirmwareRequest\x12?\n" +
-	"\bupgrades\x18\x01 \x03(\v2#.v1.UpdatePowershelfFirmwareRequestR\bupgrades\"\x96\x01\n" +
+	"\bupgrades\x18\x01 \x03(\v2#.v1.UpdatePowershelfFirmwareRequestR\bupgrades\x12>\n" +
+	"\x1bmaintenance_override_reason\x18\x02 \x01(\tR\x19maintenanceOverrideReason\"\x96\x01\n" +
 	"\x1fUpdateComponentFirmwareResponse\x125\n" +
 	"\tcomponent\x18\x01 \x01(\x0e2\x17.v1.PowershelfComponentR\tcomponent\x12&\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x0e.v1.StatusCodeR\x06status\x12\x14\n" +
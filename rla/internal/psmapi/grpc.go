@@ -33,6 +33,10 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
+// maintenanceOverrideReason is sent with firmware updates because RLA tasks are
+// already held until the rack's maintenance window opens.
+const maintenanceOverrideReason = "RLA task, maintenance window checked by RLA"
+
 type grpcClient struct {
 	conn        *grpc.ClientConn
 	client      pb.PowershelfManagerClient
@@ -167,7 +171,10 @@ func (c *grpcClient) UpdateFirmware(ctx context.Context, requests []UpdatePowers
 		pbRequests = append(pbRequests, updatePowershelfFirmwareRequestToPb(req))
 	}
 
-	resp, err := c.client.UpdateFirmware(ctx, &pb.UpdateFirmwareRequest{Upgrades: pbRequests})
+	resp, err := c.client.UpdateFirmware(ctx, &pb.UpdateFirmwareRequest{
+		Upgrades:                  pbRequests,
+		MaintenanceOverrideReason: maintenanceOverrideReason,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,7 @@ import (
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/clients/temporal"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/nsmapi"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/psmapi"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor"
 )
@@ -39,12 +40,14 @@ const (
 //   - ExecutorConfig: abstracts the task executor (e.g., Temporal)
 //   - CarbideClient: abstracts the hardware management API client
 //   - PSMClient: abstracts the powershelf manager API client
+//   - NSMClient: abstracts the NV-Switch manager API client
 type Config struct {
 	Port          int
 	DBConf        cdb.Config
 	ExecutorConf  executor.ExecutorConfig
 	CarbideClient carbideapi.Client
 	PSMClient     psmapi.Client
+	NSMClient     nsmapi.Client
 }
 
 func BuildTemporalConfigFromEnv() (*temporal.Config, error) {
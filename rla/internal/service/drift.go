@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/converter/protobuf"
+	inventorymanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/manager"
+	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
+)
+
+// ListDriftHistory returns the history of drifts detected by the inventory
+// loop, most recently seen first. If target_spec is provided, only the
+// history of the specified components is returned.
+func (rs *RLAServerImpl) ListDriftHistory(
+	ctx context.Context,
+	req *pb.ListDriftHistoryRequest,
+) (*pb.ListDriftHistoryResponse, error) {
+	pg := protobuf.PaginationFrom(req.GetPagination())
+	if err := pg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid pagination information: %w", err)
+	}
+
+	filter := inventorymanager.DriftHistoryFilter{
+		FieldName: req.GetFieldName(),
+		OpenOnly:  req.GetOpenOnly(),
+	}
+
+	if req.GetSince() != nil {
+		since := req.GetSince().AsTime()
+		filter.Since = &since
+	}
+
+	if req.GetTargetSpec() != nil {
+		components, err := rs.extractComponentsFromTargetSpec(ctx, req.GetTargetSpec())
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract components from target_spec: %w", err)
+		}
+
+		if len(components) == 0 {
+			return &pb.ListDriftHistoryResponse{}, nil
+		}
+
+		filter.ComponentIDs = make([]uuid.UUID, 0, len(components))
+		for _, comp := range components {
+			filter.ComponentIDs = append(filter.ComponentIDs, comp.Info.ID)
+		}
+	}
+
+	records, total, err := rs.inventoryManager.ListDriftHistory(ctx, filter, pg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list drift history: %w", err)
+	}
+
+	entries := make([]*pb.DriftHistoryEntry, 0, len(records))
+	for i := range records {
+		entries = append(entries, protobuf.DriftHistoryTo(&records[i]))
+	}
+
+	return &pb.ListDriftHistoryResponse{
+		Entries: entries,
+		Total:   total,
+	}, nil
+}
@@ -0,0 +1,120 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	inventorystore "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/store"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/deviceinfo"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
+)
+
+// driftHistoryManager records the filter of the last ListDriftHistory call.
+type driftHistoryManager struct {
+	*mockManager
+
+	history []inventorystore.DriftHistory
+	filter  *inventorystore.DriftHistoryFilter
+}
+
+func (m *driftHistoryManager) ListDriftHistory(
+	_ context.Context,
+	filter inventorystore.DriftHistoryFilter,
+	_ *dbquery.Pagination,
+) ([]inventorystore.DriftHistory, int32, error) {
+	m.filter = &filter
+	return m.history, int32(len(m.history)), nil
+}
+
+func TestListDriftHistory(t *testing.T) {
+	rackID := uuid.New()
+	compID := uuid.New()
+	firstSeen := time.Now().Add(-time.Hour).UTC()
+	resolved := time.Now().UTC()
+	machineID := "machine-1"
+
+	mgr := &driftHistoryManager{
+		mockManager: newMockManager(),
+		history: []inventorystore.DriftHistory{
+			{
+				ID:            uuid.New(),
+				ComponentID:   &compID,
+				ExternalID:    &machineID,
+				DriftType:     "mismatch",
+				FieldName:     "firmware_version",
+				ExpectedValue: "1.0.0",
+				ActualValue:   "1.1.0",
+				FirstSeenAt:   firstSeen,
+				LastSeenAt:    resolved,
+				ResolvedAt:    &resolved,
+				Remediation:   "accepted",
+			},
+		},
+	}
+	mgr.racks[rackID] = &rack.Rack{
+		Info: deviceinfo.DeviceInfo{ID: rackID, Name: "rack-1"},
+		Components: []component.Component{
+			{
+				Type:        devicetypes.ComponentTypeCompute,
+				Info:        deviceinfo.DeviceInfo{ID: compID, Name: "machine-1"},
+				ComponentID: "machine-1",
+				RackID:      rackID,
+			},
+		},
+	}
+
+	srv := &RLAServerImpl{inventoryManager: mgr}
+	since := time.Now().Add(-24 * time.Hour)
+
+	rsp, err := srv.ListDriftHistory(context.Background(), &pb.ListDriftHistoryRequest{
+		TargetSpec: rackTargetSpec(rackID),
+		FieldName:  "firmware_version",
+		Since:      timestamppb.New(since),
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, mgr.filter)
+	assert.Equal(t, []uuid.UUID{compID}, mgr.filter.ComponentIDs)
+	assert.Equal(t, "firmware_version", mgr.filter.FieldName)
+	assert.False(t, mgr.filter.OpenOnly)
+	require.NotNil(t, mgr.filter.Since)
+	assert.True(t, since.Equal(*mgr.filter.Since))
+
+	assert.Equal(t, int32(1), rsp.GetTotal())
+	require.Len(t, rsp.GetEntries(), 1)
+	e := rsp.GetEntries()[0]
+	assert.Equal(t, compID.String(), e.GetComponentId())
+	assert.Equal(t, "machine-1", e.GetExternalId())
+	assert.Equal(t, pb.DiffType_DIFF_TYPE_DRIFT, e.GetType())
+	assert.Equal(t, "1.1.0", e.GetActualValue())
+	assert.Equal(t, firstSeen, e.GetFirstSeenAt().AsTime())
+	assert.NotNil(t, e.GetResolvedAt())
+	assert.Equal(t, "accepted", e.GetRemediation())
+}
@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/converter/protobuf"
+	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	inventorymanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/manager"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/power"
+	identifier "github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/Identifier"
+	rlaerrors "github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/errors"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
+)
+
+// rackListPageSize is the page size used when walking every rack.
+const rackListPageSize = 100
+
+// GetPowerStats returns the current power draw of the targeted components
+// (every rack if no target is given), aggregated per rack and NVL domain.
+func (rs *RLAServerImpl) GetPowerStats(
+	ctx context.Context,
+	req *pb.GetPowerStatsRequest,
+) (*pb.GetPowerStatsResponse, error) {
+	if rs.powerCollector == nil {
+		return nil, errors.New("power collector is not available")
+	}
+
+	var components []*component.Component
+	if req.GetTargetSpec() != nil {
+		var err error
+		components, err = rs.extractComponentsFromTargetSpec(ctx, req.GetTargetSpec())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		racks, err := listAllRacks(ctx, rs.inventoryManager, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range racks {
+			for i := range r.Components {
+				components = append(components, &r.Components[i])
+			}
+		}
+	}
+
+	readings := rs.powerCollector.Collect(ctx, components)
+
+	domains, rackDomain, err := rs.nvlDomainsByRack(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Aggregate per rack in the order the racks first appear.
+	rackStats := make([]power.RackStats, 0)
+	seen := make(map[uuid.UUID]bool)
+	for _, rd := range readings {
+		if rd.RackID == uuid.Nil || seen[rd.RackID] {
+			continue
+		}
+		seen[rd.RackID] = true
+
+		r, err := rs.inventoryManager.GetRackByID(ctx, rd.RackID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		stats := power.AggregateRack(r, readings)
+		stats.NVLDomainID = rackDomain[rd.RackID]
+		rackStats = append(rackStats, stats)
+	}
+
+	rsp := &pb.GetPowerStatsResponse{
+		Components:  make([]*pb.ComponentPowerStats, 0, len(readings)),
+		Racks:       make([]*pb.RackPowerStats, 0, len(rackStats)),
+		CollectedAt: timestamppb.New(time.Now()),
+	}
+
+	for i := range readings {
+		rsp.Components = append(rsp.Components, protobuf.PowerReadingTo(&readings[i]))
+	}
+
+	for i := range rackStats {
+		rsp.Racks = append(rsp.Racks, protobuf.RackPowerStatsTo(&rackStats[i]))
+	}
+
+	for _, ds := range power.AggregateDomains(rackStats, domains) {
+		rsp.NvlDomains = append(rsp.NvlDomains, protobuf.NVLDomainPowerStatsTo(&ds))
+	}
+
+	return rsp, nil
+}
+
+// checkPowerBudget refuses a power-on if the projected draw of any targeted
+// rack would exceed the rack's power budget.
+func (rs *RLAServerImpl) checkPowerBudget(
+	ctx context.Context,
+	targetSpec *pb.OperationTargetSpec,
+) error {
+	if rs.powerCollector == nil || targetSpec == nil {
+		return nil
+	}
+
+	targets, err := rs.extractComponentsFromTargetSpec(ctx, targetSpec)
+	if err != nil {
+		return err
+	}
+
+	byRack := make(map[uuid.UUID][]*component.Component)
+	rackOrder := make([]uuid.UUID, 0)
+	for _, t := range targets {
+		if _, ok := byRack[t.RackID]; !ok {
+			rackOrder = append(rackOrder, t.RackID)
+		}
+		byRack[t.RackID] = append(byRack[t.RackID], t)
+	}
+
+	for _, rackID := range rackOrder {
+		if rackID == uuid.Nil {
+			continue
+		}
+
+		r, err := rs.inventoryManager.GetRackByID(ctx, rackID, true)
+		if err != nil {
+			return err
+		}
+
+		err = rs.powerCollector.CheckPowerOn(ctx, r, byRack[rackID], rs.nominalWatts)
+		if errors.Is(err, power.ErrBudgetExceeded) {
+			return rlaerrors.GRPCErrorFailedPrecondition(err.Error())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// nvlDomainsByRack returns every NVL domain and the domain each rack belongs to.
+func (rs *RLAServerImpl) nvlDomainsByRack(
+	ctx context.Context,
+) (map[uuid.UUID]identifier.Identifier, map[uuid.UUID]uuid.UUID, error) {
+	domains := make(map[uuid.UUID]identifier.Identifier)
+	rackDomain := make(map[uuid.UUID]uuid.UUID)
+
+	for offset := 0; ; offset += rackListPageSize {
+		page, total, err := rs.inventoryManager.GetListOfNVLDomains(
+			ctx,
+			dbquery.StringQueryInfo{},
+			&dbquery.Pagination{Offset: offset, Limit: rackListPageSize},
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, d := range page {
+			domains[d.Identifier.ID] = d.Identifier
+
+			racks, err := rs.inventoryManager.GetRacksForNVLDomain(ctx, d.Identifier)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, r := range racks {
+				rackDomain[r.Info.ID] = d.Identifier.ID
+			}
+		}
+
+		if len(page) == 0 || offset+len(page) >= int(total) {
+			break
+		}
+	}
+
+	return domains, rackDomain, nil
+}
+
+// listAllRacks walks every page of racks known to the inventory manager.
+func listAllRacks(
+	ctx context.Context,
+	mgr inventorymanager.Manager,
+	withComponents bool,
+) ([]*rack.Rack, error) {
+	var result []*rack.Rack
+
+	for offset := 0; ; offset += rackListPageSize {
+		page, total, err := mgr.GetListOfRacks(
+			ctx,
+			dbquery.StringQueryInfo{},
+			nil,
+			nil,
+			&dbquery.Pagination{Offset: offset, Limit: rackListPageSize},
+			nil,
+			withComponents,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, page...)
+		if len(page) == 0 || offset+len(page) >= int(total) {
+			break
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,151 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
+	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/power"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/deviceinfo"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/nvldomain"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
+)
+
+func (m *mockManager) GetListOfNVLDomains(_ context.Context, _ dbquery.StringQueryInfo, _ *dbquery.Pagination) ([]*nvldomain.NVLDomain, int32, error) {
+	return nil, 0, nil
+}
+
+func newPowerTestServer(t *testing.T, budget *float64) (*RLAServerImpl, uuid.UUID) {
+	t.Helper()
+
+	rackID := uuid.New()
+	r := &rack.Rack{
+		Info:             deviceinfo.DeviceInfo{ID: rackID, Name: "rack-1"},
+		PowerBudgetWatts: budget,
+	}
+	for _, machineID := range []string{"machine-1", "machine-2"} {
+		r.Components = append(r.Components, component.Component{
+			Type:        devicetypes.ComponentTypeCompute,
+			Info:        deviceinfo.DeviceInfo{ID: uuid.New(), Name: machineID},
+			ComponentID: machineID,
+			RackID:      rackID,
+		})
+	}
+
+	mgr := newMockManager()
+	mgr.racks[rackID] = r
+
+	carbide := carbideapi.NewMockClient()
+	carbide.AddPowerDraw("machine-1", 5000)
+
+	return &RLAServerImpl{
+		inventoryManager: mgr,
+		powerCollector:   power.NewCollector(carbide, nil, nil),
+		nominalWatts:     power.NominalWatts(config.Config{NominalComputeWatts: 6000}),
+	}, rackID
+}
+
+func rackTargetSpec(rackID uuid.UUID) *pb.OperationTargetSpec {
+	return &pb.OperationTargetSpec{
+		Targets: &pb.OperationTargetSpec_Racks{
+			Racks: &pb.RackTargets{
+				Targets: []*pb.RackTarget{
+					{Identifier: &pb.RackTarget_Id{Id: &pb.UUID{Id: rackID.String()}}},
+				},
+			},
+		},
+	}
+}
+
+func TestGetPowerStats(t *testing.T) {
+	budget := 12000.0
+	srv, rackID := newPowerTestServer(t, &budget)
+
+	rsp, err := srv.GetPowerStats(context.Background(), &pb.GetPowerStatsRequest{
+		TargetSpec: rackTargetSpec(rackID),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, rsp.GetComponents(), 2)
+	assert.Equal(t, "machine-1", rsp.GetComponents()[0].GetComponentId())
+	assert.True(t, rsp.GetComponents()[0].GetAvailable())
+	assert.Equal(t, 5000.0, rsp.GetComponents()[0].GetWatts())
+	assert.False(t, rsp.GetComponents()[1].GetAvailable())
+
+	require.Len(t, rsp.GetRacks(), 1)
+	rs := rsp.GetRacks()[0]
+	assert.Equal(t, rackID.String(), rs.GetRackId().GetId())
+	assert.Equal(t, "rack-1", rs.GetRackName())
+	assert.Equal(t, 5000.0, rs.GetComputeWatts())
+	assert.Equal(t, 5000.0, rs.GetDrawWatts())
+	assert.Equal(t, 12000.0, rs.GetBudgetWatts())
+	assert.Equal(t, int32(1), rs.GetUnavailableComponents())
+
+	assert.Empty(t, rsp.GetNvlDomains())
+	assert.NotNil(t, rsp.GetCollectedAt())
+}
+
+func TestPowerOnRack_BudgetExceeded(t *testing.T) {
+	// 5000 W measured, plus (6000 - 5000) W for the first tray and
+	// 6000 W for the second tray that reports no reading.
+	budget := 11000.0
+	srv, rackID := newPowerTestServer(t, &budget)
+
+	_, err := srv.PowerOnRack(context.Background(), &pb.PowerOnRackRequest{
+		TargetSpec: rackTargetSpec(rackID),
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestCheckPowerBudget(t *testing.T) {
+	testCases := map[string]struct {
+		budget  *float64
+		wantErr bool
+	}{
+		"no budget":      {budget: nil},
+		"within budget":  {budget: func() *float64 { b := 12500.0; return &b }()},
+		"exceeds budget": {budget: func() *float64 { b := 11000.0; return &b }(), wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			srv, rackID := newPowerTestServer(t, tc.budget)
+
+			err := srv.checkPowerBudget(context.Background(), rackTargetSpec(rackID))
+			if tc.wantErr {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
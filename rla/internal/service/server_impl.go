@@ -40,8 +40,10 @@ import (
 	inventorymanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/manager"
 
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/operation"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/power"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/psmapi"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	taskmanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/manager"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	operations "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
@@ -58,12 +60,14 @@ import (
 // It acts as an adapter between gRPC protobuf messages and the internal managers,
 // handling protobuf conversion and delegating business logic to the InventoryManager.
 type RLAServerImpl struct {
-	inventoryManager          inventorymanager.Manager // Business logic manager for inventory operations
-	taskManager               *taskmanager.Manager     // Task manager for orchestrating task lifecycle
-	taskStore                 taskstore.Store          // Task store for task queries
-	carbideClient             carbideapi.Client        // Carbide API client for actual component data
-	psmClient                 psmapi.Client            // PSM API client for powershelf operations
-	pb.UnimplementedRLAServer                          // Embedded protobuf server interface for forward compatibility
+	inventoryManager          inventorymanager.Manager              // Business logic manager for inventory operations
+	taskManager               *taskmanager.Manager                  // Task manager for orchestrating task lifecycle
+	taskStore                 taskstore.Store                       // Task store for task queries
+	carbideClient             carbideapi.Client                     // Carbide API client for actual component data
+	psmClient                 psmapi.Client                         // PSM API client for powershelf operations
+	powerCollector            *power.Collector                      // Collects per-component power readings
+	nominalWatts              map[devicetypes.ComponentType]float64 // Nominal per-component draw for power-on projection
+	pb.UnimplementedRLAServer                                       // Embedded protobuf server interface for forward compatibility
 }
 
 // newServerImplementation creates a new RLA gRPC server implementation.
@@ -75,6 +79,8 @@ type RLAServerImpl struct {
 //   - taskStore: The task store for task queries
 //   - carbideClient: The Carbide API client for actual component data
 //   - psmClient: The PSM API client for powershelf operations
+//   - powerCollector: The collector for per-component power readings
+//   - nominalWatts: The nominal per-component draw used to project power-on draw
 //
 // Returns:
 //   - *RLAServerImpl: A new server implementation instance
@@ -85,6 +91,8 @@ func newServerImplementation(
 	taskStore taskstore.Store,
 	carbideClient carbideapi.Client,
 	psmClient psmapi.Client,
+	powerCollector *power.Collector,
+	nominalWatts map[devicetypes.ComponentType]float64,
 ) (*RLAServerImpl, error) {
 	return &RLAServerImpl{
 		inventoryManager: inventoryManager,
@@ -92,6 +100,8 @@ func newServerImplementation(
 		taskStore:        taskStore,
 		carbideClient:    carbideClient,
 		psmClient:        psmClient,
+		powerCollector:   powerCollector,
+		nominalWatts:     nominalWatts,
 	}, nil
 }
 
@@ -595,10 +605,15 @@ func (rs *RLAServerImpl) PowerOnRack(
 	ctx context.Context,
 	req *pb.PowerOnRackRequest,
 ) (*pb.SubmitTaskResponse, error) {
+	if err := rs.checkPowerBudget(ctx, req.GetTargetSpec()); err != nil {
+		return nil, err
+	}
+
 	return rs.handlePowerControlTask(
 		ctx,
 		req.GetTargetSpec(),
 		req.GetDescription(),
+		req.GetMaintenanceOverride(),
 		&operations.PowerControlTaskInfo{
 			Operation: operations.PowerOperationPowerOn,
 		},
@@ -617,6 +632,7 @@ func (rs *RLAServerImpl) PowerOffRack(
 		ctx,
 		req.GetTargetSpec(),
 		req.GetDescription(),
+		req.GetMaintenanceOverride(),
 		&operations.PowerControlTaskInfo{
 			Operation: op,
 			Forced:    req.GetForced(),
@@ -636,6 +652,7 @@ func (rs *RLAServerImpl) PowerResetRack(
 		ctx,
 		req.GetTargetSpec(),
 		req.GetDescription(),
+		req.GetMaintenanceOverride(),
 		&operations.PowerControlTaskInfo{
 			Operation: op,
 			Forced:    req.GetForced(),
@@ -668,6 +685,8 @@ func (rs *RLAServerImpl) BringUpRack(
 		return nil, err
 	}
 
+	applyMaintenanceOverride(opReq, req.GetMaintenanceOverride())
+
 	taskIDs, err := rs.taskManager.SubmitTask(ctx, opReq)
 	if err != nil {
 		return nil, err
@@ -732,6 +751,7 @@ func (rs *RLAServerImpl) handlePowerControlTask(
 	ctx context.Context,
 	targetSpec *pb.OperationTargetSpec,
 	description string,
+	override *pb.MaintenanceOverride,
 	info *operations.PowerControlTaskInfo,
 ) (*pb.SubmitTaskResponse, error) {
 	if rs.taskManager == nil {
@@ -748,6 +768,8 @@ func (rs *RLAServerImpl) handlePowerControlTask(
 		return nil, err
 	}
 
+	applyMaintenanceOverride(req, override)
+
 	// Task Manager handles resolve + split by rack + create tasks
 	taskIDs, err := rs.taskManager.SubmitTask(ctx, req)
 	if err != nil {
@@ -809,6 +831,17 @@ func (rs *RLAServerImpl) convertTargetSpecToOperationRequest(
 	return req, nil
 }
 
+// applyMaintenanceOverride marks the request to bypass maintenance windows
+// when the caller asked for it. The reason is validated by the task manager.
+func applyMaintenanceOverride(req *operation.Request, override *pb.MaintenanceOverride) {
+	if override == nil {
+		return
+	}
+
+	req.WindowOverride = true
+	req.OverrideReason = override.GetReason()
+}
+
 // convertPbRackTargetToRackTarget converts a protobuf RackTarget to an internal RackTarget
 func (rs *RLAServerImpl) convertPbRackTargetToRackTarget(rt *pb.RackTarget) (*operation.RackTarget, error) {
 	if rt == nil {
@@ -1202,6 +1235,160 @@ func (rs *RLAServerImpl) ListRackRuleAssociations(
 	}, nil
 }
 
+// ========================================
+// Maintenance Windows API
+// ========================================
+
+func (rs *RLAServerImpl) CreateMaintenanceWindow(
+	ctx context.Context,
+	req *pb.CreateMaintenanceWindowRequest,
+) (*pb.CreateMaintenanceWindowResponse, error) {
+	window := protobuf.MaintenanceWindowFrom(req.GetWindow())
+	if window == nil {
+		return nil, errors.New("window is required")
+	}
+
+	window.ID = uuid.New()
+	if err := rs.validateMaintenanceWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	if err := rs.taskStore.CreateMaintenanceWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateMaintenanceWindowResponse{
+		Id: protobuf.UUIDTo(window.ID),
+	}, nil
+}
+
+func (rs *RLAServerImpl) UpdateMaintenanceWindow(
+	ctx context.Context,
+	req *pb.UpdateMaintenanceWindowRequest,
+) (*emptypb.Empty, error) {
+	window := protobuf.MaintenanceWindowFrom(req.GetWindow())
+	if window == nil {
+		return nil, errors.New("window is required")
+	}
+
+	if window.ID == uuid.Nil {
+		return nil, errors.New("window ID is required")
+	}
+
+	if err := rs.validateMaintenanceWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	if err := rs.taskStore.UpdateMaintenanceWindow(ctx, window); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (rs *RLAServerImpl) DeleteMaintenanceWindow(
+	ctx context.Context,
+	req *pb.DeleteMaintenanceWindowRequest,
+) (*emptypb.Empty, error) {
+	windowID := protobuf.UUIDFrom(req.GetWindowId())
+
+	if windowID == uuid.Nil {
+		return nil, errors.New("window ID is required")
+	}
+
+	if err := rs.taskStore.DeleteMaintenanceWindow(ctx, windowID); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (rs *RLAServerImpl) GetMaintenanceWindow(
+	ctx context.Context,
+	req *pb.GetMaintenanceWindowRequest,
+) (*pb.MaintenanceWindow, error) {
+	windowID := protobuf.UUIDFrom(req.GetWindowId())
+
+	if windowID == uuid.Nil {
+		return nil, errors.New("window ID is required")
+	}
+
+	window, err := rs.taskStore.GetMaintenanceWindow(ctx, windowID)
+	if err != nil {
+		return nil, err
+	}
+
+	return protobuf.MaintenanceWindowTo(window), nil
+}
+
+func (rs *RLAServerImpl) ListMaintenanceWindows(
+	ctx context.Context,
+	req *pb.ListMaintenanceWindowsRequest,
+) (*pb.ListMaintenanceWindowsResponse, error) {
+	var rackID *uuid.UUID
+	if req.RackId != nil {
+		id := protobuf.UUIDFrom(req.GetRackId())
+		if id == uuid.Nil {
+			return nil, errors.New("rack ID is invalid")
+		}
+		rackID = &id
+	}
+
+	windows, err := rs.taskStore.ListMaintenanceWindows(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	pbWindows := make([]*pb.MaintenanceWindow, 0, len(windows))
+	for _, window := range windows {
+		pbWindows = append(pbWindows, protobuf.MaintenanceWindowTo(window))
+	}
+
+	return &pb.ListMaintenanceWindowsResponse{
+		Windows: pbWindows,
+	}, nil
+}
+
+func (rs *RLAServerImpl) GetMaintenanceWindowStatus(
+	ctx context.Context,
+	req *pb.GetMaintenanceWindowStatusRequest,
+) (*pb.MaintenanceWindowStatus, error) {
+	if rs.taskManager == nil {
+		return nil, errors.New("task manager is not available")
+	}
+
+	rackID := protobuf.UUIDFrom(req.GetRackId())
+	if rackID == uuid.Nil {
+		return nil, errors.New("rack ID is required")
+	}
+
+	status, err := rs.taskManager.CheckMaintenanceWindow(ctx, rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	return protobuf.MaintenanceWindowStatusTo(status), nil
+}
+
+// validateMaintenanceWindow validates the window definition and, for
+// rack-scoped windows, that the rack exists.
+func (rs *RLAServerImpl) validateMaintenanceWindow(
+	ctx context.Context,
+	window *maintenance.Window,
+) error {
+	if err := window.Validate(); err != nil {
+		return fmt.Errorf("maintenance window validation failed: %w", err)
+	}
+
+	if window.Scope() == maintenance.ScopeRack {
+		if _, err := rs.inventoryManager.GetRackByID(ctx, *window.RackID, false); err != nil {
+			return fmt.Errorf("rack %s: %w", window.RackID, err)
+		}
+	}
+
+	return nil
+}
+
 // UpgradeFirmware upgrades firmware for components.
 // It uses OperationTargetSpec to specify targets and creates a task via the Task framework.
 func (rs *RLAServerImpl) UpgradeFirmware(
@@ -1237,6 +1424,8 @@ func (rs *RLAServerImpl) UpgradeFirmware(
 		return nil, err
 	}
 
+	applyMaintenanceOverride(opReq, req.GetMaintenanceOverride())
+
 	// Task Manager handles resolve + split by rack + create tasks
 	taskIDs, err := rs.taskManager.SubmitTask(ctx, opReq)
 	if err != nil {
@@ -1532,12 +1721,14 @@ func (rs *RLAServerImpl) ValidateComponents(
 			diffs = append(diffs, &pb.ComponentDiff{
 				Type:        pb.DiffType_DIFF_TYPE_ONLY_IN_ACTUAL,
 				ComponentId: externalIDStr, // only external_id is known
+				FirstSeenAt: protobuf.OptionalTimestampTo(sd.FirstSeenAt),
 			})
 			onlyInExpectedCount++
 		case "missing_in_actual":
 			diffs = append(diffs, &pb.ComponentDiff{
 				Type:        pb.DiffType_DIFF_TYPE_ONLY_IN_EXPECTED,
 				ComponentId: componentIDStr,
+				FirstSeenAt: protobuf.OptionalTimestampTo(sd.FirstSeenAt),
 			})
 			onlyInActualCount++
 		case "mismatch":
@@ -1553,6 +1744,7 @@ func (rs *RLAServerImpl) ValidateComponents(
 				Type:        pb.DiffType_DIFF_TYPE_DRIFT,
 				ComponentId: componentIDStr,
 				FieldDiffs:  fieldDiffs,
+				FirstSeenAt: protobuf.OptionalTimestampTo(sd.FirstSeenAt),
 			})
 			driftCount++
 		}
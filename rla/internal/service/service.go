@@ -29,12 +29,15 @@ import (
 
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/certs"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/config"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/db/migrations"
 	inventorymanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/manager"
 	inventorystore "github.com/nvidia/bare-metal-manager-rest/rla/internal/inventory/store"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/inventorysync"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/power"
 	taskmanager "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/manager"
 	taskstore "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/store"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
 	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
 )
 
@@ -116,6 +119,15 @@ func (s *Service) Start(ctx context.Context) error {
 
 	go inventorysync.RunInventory(ctx, &s.conf.DBConf)
 
+	rlaConf := config.ReadConfig()
+	powerCollector := power.NewCollector(
+		s.conf.CarbideClient,
+		s.conf.NSMClient,
+		s.conf.PSMClient,
+	)
+
+	go power.NewMonitor(powerCollector, s.listRacks, rlaConf).Run(ctx)
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", s.conf.Port))
 	if err != nil {
 		return err
@@ -127,6 +139,8 @@ func (s *Service) Start(ctx context.Context) error {
 		s.taskStore,
 		s.conf.CarbideClient,
 		s.conf.PSMClient,
+		powerCollector,
+		power.NominalWatts(rlaConf),
 	)
 	if err != nil {
 		return err
@@ -147,6 +161,11 @@ func (s *Service) Start(ctx context.Context) error {
 	return nil
 }
 
+// listRacks returns every rack with its components for the power monitor.
+func (s *Service) listRacks(ctx context.Context) ([]*rack.Rack, error) {
+	return listAllRacks(ctx, s.inventoryManager, true)
+}
+
 func (s *Service) Stop(ctx context.Context) {
 	log.Info().Msg("Starting graceful shutdown now...")
 
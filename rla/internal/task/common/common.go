@@ -78,6 +78,11 @@ const (
 	TaskStatusCompleted  TaskStatus = "completed"
 	TaskStatusFailed     TaskStatus = "failed"
 	TaskStatusTerminated TaskStatus = "terminated"
+	// TaskStatusWaiting marks a task queued until its maintenance window opens.
+	TaskStatusWaiting TaskStatus = "waiting"
+	// TaskStatusPaused marks a running task held at a stage boundary because
+	// its maintenance window closed.
+	TaskStatusPaused TaskStatus = "paused"
 )
 
 func (s TaskStatus) IsFinished() bool {
@@ -90,6 +95,7 @@ type TaskListOptions struct {
 	TaskType   TaskType
 	RackID     uuid.UUID
 	ActiveOnly bool
+	Statuses   []TaskStatus
 }
 
 type OperationRuleListOptions struct {
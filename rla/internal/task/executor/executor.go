@@ -21,6 +21,7 @@ import (
 	"context"
 
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
 )
@@ -36,18 +37,27 @@ type Executor interface {
 	CheckStatus(ctx context.Context, executionID string) (common.TaskStatus, error)
 }
 
+// Dependencies are the runtime collaborators an executor needs that are
+// created by the task manager rather than read from configuration.
+type Dependencies struct {
+	// MaintenanceWindowChecker evaluates rack maintenance windows for
+	// workflows that pause disruptive stages outside a window.
+	MaintenanceWindowChecker maintenance.Checker
+}
+
 type ExecutorConfig interface {
 	Validate() error
-	Build(ctx context.Context) (Executor, error)
+	Build(ctx context.Context, deps Dependencies) (Executor, error)
 }
 
 func New(
 	ctx context.Context,
 	executorConfig ExecutorConfig,
+	deps Dependencies,
 ) (Executor, error) {
 	if err := executorConfig.Validate(); err != nil {
 		return nil, err
 	}
 
-	return executorConfig.Build(ctx)
+	return executorConfig.Build(ctx, deps)
 }
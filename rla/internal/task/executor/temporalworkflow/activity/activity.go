@@ -28,6 +28,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/carbideapi"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/componentmanager"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
 )
@@ -106,6 +107,37 @@ func UpdateTaskStatus(
 	return taskStatusUpdater.UpdateTaskStatus(ctx, arg)
 }
 
+// MaintenanceWindowActivities holds the activities that consult rack
+// maintenance windows. The checker is supplied when the worker is built.
+type MaintenanceWindowActivities struct {
+	checker maintenance.Checker
+}
+
+// NewMaintenanceWindowActivities creates the maintenance window activities
+// backed by the given checker.
+func NewMaintenanceWindowActivities(
+	checker maintenance.Checker,
+) *MaintenanceWindowActivities {
+	return &MaintenanceWindowActivities{checker: checker}
+}
+
+// CheckMaintenanceWindow is a Temporal activity that reports whether the
+// maintenance window of a rack is open.
+func (a *MaintenanceWindowActivities) CheckMaintenanceWindow(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*maintenance.Status, error) {
+	if a == nil || a.checker == nil {
+		return nil, fmt.Errorf("maintenance window checker is not configured")
+	}
+
+	if rackID == uuid.Nil {
+		return nil, fmt.Errorf("invalid rack identifier")
+	}
+
+	return a.checker.CheckMaintenanceWindow(ctx, rackID)
+}
+
 func GetAllActivities() []any {
 	return []any{
 		InjectExpectation,
@@ -0,0 +1,66 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package activity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
+)
+
+type fakeWindowChecker struct {
+	status *maintenance.Status
+	rackID uuid.UUID
+}
+
+func (f *fakeWindowChecker) CheckMaintenanceWindow(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*maintenance.Status, error) {
+	f.rackID = rackID
+	return f.status, nil
+}
+
+func TestMaintenanceWindowActivitiesCheckMaintenanceWindow(t *testing.T) {
+	ctx := context.Background()
+	rackID := uuid.New()
+
+	t.Run("missing checker returns error", func(t *testing.T) {
+		_, err := NewMaintenanceWindowActivities(nil).CheckMaintenanceWindow(ctx, rackID)
+		assert.Error(t, err)
+	})
+
+	t.Run("nil rack returns error", func(t *testing.T) {
+		checker := &fakeWindowChecker{status: &maintenance.Status{Open: true}}
+		_, err := NewMaintenanceWindowActivities(checker).CheckMaintenanceWindow(ctx, uuid.Nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("delegates to the injected checker", func(t *testing.T) {
+		want := &maintenance.Status{Governed: true, Open: false}
+		checker := &fakeWindowChecker{status: want}
+
+		got, err := NewMaintenanceWindowActivities(checker).CheckMaintenanceWindow(ctx, rackID)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+		assert.Equal(t, rackID, checker.rackID)
+	})
+}
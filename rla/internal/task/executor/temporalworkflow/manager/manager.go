@@ -75,7 +75,10 @@ type Manager struct {
 	workers          map[string]worker.Worker
 }
 
-func (c *Config) Build(ctx context.Context) (executor.Executor, error) {
+func (c *Config) Build(
+	ctx context.Context,
+	deps executor.Dependencies,
+) (executor.Executor, error) {
 	// Set the component manager registry for activities
 	if c.ComponentManagerRegistry != nil {
 		activity.SetComponentManagerRegistry(c.ComponentManagerRegistry)
@@ -83,6 +86,14 @@ func (c *Config) Build(ctx context.Context) (executor.Executor, error) {
 		log.Warn().Msg("No component manager registry configured, activities may fail")
 	}
 
+	if deps.MaintenanceWindowChecker == nil {
+		log.Warn().Msg("No maintenance window checker configured, window checks will fail")
+	}
+
+	windowActivities := activity.NewMaintenanceWindowActivities(
+		deps.MaintenanceWindowChecker,
+	)
+
 	publisherClient, err := temporal.New(c.ClientConf)
 	if err != nil {
 		return nil, err
@@ -99,6 +110,7 @@ func (c *Config) Build(ctx context.Context) (executor.Executor, error) {
 		for _, a := range activity.GetAllActivities() {
 			worker.RegisterActivity(a)
 		}
+		worker.RegisterActivity(windowActivities)
 
 		for _, wf := range workflow.GetAllWorkflows() {
 			worker.RegisterWorkflow(wf)
@@ -63,7 +63,7 @@ func BringUp(
 		typeToTargets,
 		"BringUp",
 		info,
-		reqInfo.RuleDefinition,
+		&reqInfo,
 	)
 
 	return updateFinishedTaskStatus(ctx, reqInfo.TaskID, err)
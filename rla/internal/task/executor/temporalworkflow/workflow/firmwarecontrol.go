@@ -72,7 +72,7 @@ func FirmwareControl(
 		typeToTargets,
 		"FirmwareControl",
 		info,
-		reqInfo.RuleDefinition,
+		&reqInfo,
 	)
 
 	return updateFinishedTaskStatus(ctx, reqInfo.TaskID, err)
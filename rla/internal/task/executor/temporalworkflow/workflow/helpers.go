@@ -31,6 +31,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
@@ -90,6 +91,97 @@ func updateFinishedTaskStatus(
 	return err
 }
 
+// maintenanceWindowRecheckInterval bounds how long a paused task sleeps before
+// re-evaluating its maintenance windows, so that edits to the window
+// definitions take effect without waiting for the previously computed opening.
+const maintenanceWindowRecheckInterval = 15 * time.Minute
+
+var maintenanceWindowActivityOptions = workflow.ActivityOptions{
+	StartToCloseTimeout: 1 * time.Minute,
+	RetryPolicy: &temporal.RetryPolicy{
+		MaximumAttempts:    5,
+		InitialInterval:    1 * time.Second,
+		MaximumInterval:    30 * time.Second,
+		BackoffCoefficient: 2,
+	},
+}
+
+// waitForMaintenanceWindow blocks at a stage boundary until the maintenance
+// window of the rack is open. While it waits the task is reported as paused.
+// Tasks that are not gated (non-disruptive or explicitly overridden) return
+// immediately.
+func waitForMaintenanceWindow(
+	ctx workflow.Context,
+	reqInfo *task.ExecutionInfo,
+	stage int,
+) error {
+	if !reqInfo.MaintenanceWindowGated || reqInfo.Rack == nil {
+		return nil
+	}
+
+	actx := workflow.WithActivityOptions(ctx, maintenanceWindowActivityOptions)
+	paused := false
+
+	for {
+		var status maintenance.Status
+		if err := workflow.ExecuteActivity(
+			actx, "CheckMaintenanceWindow", reqInfo.Rack.Info.ID,
+		).Get(ctx, &status); err != nil {
+			return fmt.Errorf("failed to check maintenance window: %w", err)
+		}
+
+		if status.Open {
+			if paused {
+				log.Info().
+					Int("stage", stage).
+					Str("window", status.WindowName).
+					Msg("Maintenance window open, resuming task")
+				return updateTaskStatus(ctx, reqInfo.TaskID, taskcommon.TaskStatusRunning,
+					fmt.Sprintf("Resumed at stage %d: %s", stage, status.Describe()))
+			}
+			return nil
+		}
+
+		if !paused {
+			log.Info().
+				Int("stage", stage).
+				Str("window", status.WindowName).
+				Msg("Maintenance window closed, pausing task at stage boundary")
+			if err := updateTaskStatus(ctx, reqInfo.TaskID, taskcommon.TaskStatusPaused,
+				fmt.Sprintf("Paused before stage %d: %s", stage, status.Describe())); err != nil {
+				return err
+			}
+			paused = true
+		}
+
+		wait := maintenanceWindowRecheckInterval
+		if status.NextOpening != nil {
+			if d := status.NextOpening.Sub(workflow.Now(ctx)); d > 0 && d < wait {
+				wait = d
+			}
+		}
+
+		if err := workflow.Sleep(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+func updateTaskStatus(
+	ctx workflow.Context,
+	taskID uuid.UUID,
+	status taskcommon.TaskStatus,
+	message string,
+) error {
+	arg := &task.TaskStatusUpdate{
+		ID:      taskID,
+		Status:  status,
+		Message: message,
+	}
+
+	return workflow.ExecuteActivity(ctx, "UpdateTaskStatus", arg).Get(ctx, nil)
+}
+
 func buildTargets(info *task.ExecutionInfo) map[devicetypes.ComponentType]common.Target {
 	if info.Rack == nil {
 		return nil
@@ -340,14 +432,16 @@ func parseDurationParam(val any) time.Duration {
 // executeRuleBasedOperation drives any operation through its RuleDefinition.
 // Stages execute sequentially; steps within a stage execute in parallel via
 // child workflows. The activityName is a legacy fallback used only when a
-// step has no MainOperation configured.
+// step has no MainOperation configured. Stage boundaries are the safe points
+// at which a task gated by a maintenance window is paused.
 func executeRuleBasedOperation(
 	ctx workflow.Context,
 	typeToTargets map[devicetypes.ComponentType]common.Target,
 	activityName string,
 	operationInfo any,
-	ruleDef *operationrules.RuleDefinition,
+	reqInfo *task.ExecutionInfo,
 ) error {
+	ruleDef := reqInfo.RuleDefinition
 	if ruleDef == nil {
 		return fmt.Errorf(
 			"rule definition is nil (resolver should never return nil)",
@@ -364,6 +458,10 @@ func executeRuleBasedOperation(
 
 	iter := operationrules.NewStageIterator(ruleDef)
 	for stage := iter.Next(); stage != nil; stage = iter.Next() {
+		if err := waitForMaintenanceWindow(ctx, reqInfo, stage.Number); err != nil {
+			return fmt.Errorf("stage %d: %w", stage.Number, err)
+		}
+
 		log.Info().
 			Int("stage", stage.Number).
 			Int("step_count", len(stage.Steps)).
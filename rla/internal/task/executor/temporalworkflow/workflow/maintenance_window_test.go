@@ -0,0 +1,160 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package workflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+
+	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor/temporalworkflow/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
+	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/devicetypes"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/component"
+)
+
+// mockCheckMaintenanceWindow is a mock activity function for testing
+// maintenance window gating. Return values are defined via env.OnActivity().
+func mockCheckMaintenanceWindow(ctx context.Context, rackID uuid.UUID) (*maintenance.Status, error) {
+	return nil, nil
+}
+
+func TestPowerControlWorkflow_MaintenanceWindow(t *testing.T) {
+	components := []*component.Component{
+		newTestComponent(uuid.New(), "powershelf-1", "ext-powershelf-1", devicetypes.ComponentTypePowerShelf),
+		newTestComponent(uuid.New(), "nvlswitch-1", "ext-nvlswitch-1", devicetypes.ComponentTypeNVLSwitch),
+		newTestComponent(uuid.New(), "compute-1", "ext-compute-1", devicetypes.ComponentTypeCompute),
+	}
+
+	openStatus := &maintenance.Status{Governed: true, Open: true, WindowName: "nightly"}
+	closedStatus := func() *maintenance.Status {
+		next := time.Now().Add(time.Hour)
+		return &maintenance.Status{Governed: true, Open: false, NextOpening: &next, WindowName: "nightly"}
+	}
+
+	testCases := map[string]struct {
+		gated          bool
+		checks         []*maintenance.Status
+		expectChecks   int
+		expectStatuses []taskcommon.TaskStatus
+	}{
+		"ungated task never checks the window": {
+			gated:        false,
+			expectChecks: 0,
+			expectStatuses: []taskcommon.TaskStatus{
+				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusCompleted,
+			},
+		},
+		"open window runs every stage": {
+			gated:        true,
+			checks:       []*maintenance.Status{openStatus, openStatus, openStatus},
+			expectChecks: 3,
+			expectStatuses: []taskcommon.TaskStatus{
+				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusCompleted,
+			},
+		},
+		"closed window pauses at stage boundary and resumes": {
+			gated:        true,
+			checks:       []*maintenance.Status{openStatus, closedStatus(), closedStatus(), openStatus, openStatus},
+			expectChecks: 5,
+			expectStatuses: []taskcommon.TaskStatus{
+				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusPaused,
+				taskcommon.TaskStatusRunning,
+				taskcommon.TaskStatusCompleted,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			env.RegisterActivityWithOptions(mockPowerControl, activity.RegisterOptions{
+				Name: "PowerControl",
+			})
+			env.RegisterActivityWithOptions(mockUpdateTaskStatus, activity.RegisterOptions{
+				Name: "UpdateTaskStatus",
+			})
+			env.RegisterActivityWithOptions(mockGetPowerStatus, activity.RegisterOptions{
+				Name: "GetPowerStatus",
+			})
+			env.RegisterActivityWithOptions(mockCheckMaintenanceWindow, activity.RegisterOptions{
+				Name: "CheckMaintenanceWindow",
+			})
+			env.RegisterWorkflow(GenericComponentStepWorkflow)
+
+			env.OnActivity(mockPowerControl, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			env.OnActivity(mockGetPowerStatus, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, target common.Target) (map[string]operations.PowerStatus, error) {
+					result := make(map[string]operations.PowerStatus)
+					for _, componentID := range target.ComponentIDs {
+						result[componentID] = operations.PowerStatusOn
+					}
+					return result, nil
+				},
+			)
+
+			var statuses []taskcommon.TaskStatus
+			env.OnActivity(mockUpdateTaskStatus, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, arg *taskdef.TaskStatusUpdate) error {
+					statuses = append(statuses, arg.Status)
+					return nil
+				},
+			)
+
+			checks := 0
+			env.OnActivity(mockCheckMaintenanceWindow, mock.Anything, mock.Anything).Return(
+				func(ctx context.Context, rackID uuid.UUID) (*maintenance.Status, error) {
+					status := tc.checks[checks]
+					checks++
+					return status, nil
+				},
+			)
+
+			reqInfo := taskdef.ExecutionInfo{
+				TaskID:                 uuid.New(),
+				Rack:                   buildTestRack(components),
+				RuleDefinition:         createDefaultPowerRuleDef(operations.PowerOperationPowerOn),
+				MaintenanceWindowGated: tc.gated,
+			}
+
+			env.ExecuteWorkflow(PowerControl, reqInfo, operations.PowerControlTaskInfo{
+				Operation: operations.PowerOperationPowerOn,
+			})
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, tc.expectChecks, checks)
+			assert.Equal(t, tc.expectStatuses, statuses)
+		})
+	}
+}
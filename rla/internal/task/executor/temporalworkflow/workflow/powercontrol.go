@@ -61,7 +61,7 @@ func PowerControl(
 		typeToTargets,
 		"PowerControl",
 		info,
-		reqInfo.RuleDefinition,
+		&reqInfo,
 	)
 
 	return updateFinishedTaskStatus(ctx, reqInfo.TaskID, err)
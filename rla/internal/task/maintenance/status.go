@@ -0,0 +1,149 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/operation"
+	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+)
+
+// Status is the result of evaluating the maintenance windows of a rack.
+// -- Governed: Whether any enabled window applies to the rack. Racks without
+// windows are not restricted.
+// -- Open: Whether disruptive work may run now.
+// -- ClosesAt: When the current opening ends (only set when Open).
+// -- NextOpening: When the next opening starts (only set when closed and an
+// opening exists within the search horizon).
+// -- WindowID: The window that is open, or that opens next.
+type Status struct {
+	Governed    bool       `json:"governed"`
+	Open        bool       `json:"open"`
+	ClosesAt    *time.Time `json:"closes_at,omitempty"`
+	NextOpening *time.Time `json:"next_opening,omitempty"`
+	WindowID    uuid.UUID  `json:"window_id"`
+	WindowName  string     `json:"window_name,omitempty"`
+}
+
+// Checker evaluates the maintenance windows governing a rack.
+type Checker interface {
+	CheckMaintenanceWindow(ctx context.Context, rackID uuid.UUID) (*Status, error)
+}
+
+// ApplicableWindows returns the enabled windows that govern the rack. Windows
+// attached to the rack take precedence over site-wide windows, mirroring how
+// rack rule associations take precedence over default operation rules.
+func ApplicableWindows(windows []*Window, rackID uuid.UUID) []*Window {
+	var rackWindows, siteWindows []*Window
+	for _, w := range windows {
+		if w == nil || !w.Enabled {
+			continue
+		}
+
+		switch w.Scope() {
+		case ScopeRack:
+			if *w.RackID == rackID {
+				rackWindows = append(rackWindows, w)
+			}
+		case ScopeSite:
+			siteWindows = append(siteWindows, w)
+		}
+	}
+
+	if len(rackWindows) > 0 {
+		return rackWindows
+	}
+
+	return siteWindows
+}
+
+// Evaluate evaluates the maintenance windows governing the rack at t.
+func Evaluate(windows []*Window, rackID uuid.UUID, t time.Time) Status {
+	applicable := ApplicableWindows(windows, rackID)
+	if len(applicable) == 0 {
+		return Status{Governed: false, Open: true}
+	}
+
+	status := Status{Governed: true}
+	for _, w := range applicable {
+		if open, closesAt := w.OpenAt(t); open {
+			// With overlapping windows, report the one that stays open longest.
+			if !status.Open || closesAt.After(*status.ClosesAt) {
+				closesAt = closesAt.UTC()
+				status.Open = true
+				status.ClosesAt = &closesAt
+				status.WindowID = w.ID
+				status.WindowName = w.Name
+			}
+		}
+	}
+
+	if status.Open {
+		return status
+	}
+
+	for _, w := range applicable {
+		next, ok := w.NextOpening(t)
+		if !ok {
+			continue
+		}
+
+		if status.NextOpening == nil || next.Before(*status.NextOpening) {
+			status.NextOpening = &next
+			status.WindowID = w.ID
+			status.WindowName = w.Name
+		}
+	}
+
+	return status
+}
+
+// Describe returns a short human readable summary of the status, suitable for
+// task status messages.
+func (s Status) Describe() string {
+	switch {
+	case !s.Governed:
+		return "no maintenance window configured"
+	case s.Open && s.ClosesAt != nil:
+		return "maintenance window " + s.WindowName + " open until " + s.ClosesAt.Format(time.RFC3339)
+	case s.Open:
+		return "maintenance window " + s.WindowName + " open"
+	case s.NextOpening != nil:
+		return "next maintenance window " + s.WindowName + " opens at " + s.NextOpening.Format(time.RFC3339)
+	default:
+		return "no upcoming maintenance window"
+	}
+}
+
+// RequiresWindow reports whether an operation is disruptive and may only run
+// inside a maintenance window. Power and firmware operations interrupt
+// workloads, as does a full bring-up; ingestion only registers expectations.
+func RequiresWindow(op operation.Wrapper) bool {
+	switch op.Type {
+	case taskcommon.TaskTypePowerControl, taskcommon.TaskTypeFirmwareControl:
+		return true
+	case taskcommon.TaskTypeBringUp:
+		return op.Code != taskcommon.OpCodeIngest
+	default:
+		return false
+	}
+}
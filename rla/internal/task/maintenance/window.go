@@ -0,0 +1,287 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package maintenance defines maintenance windows and evaluates whether
+// disruptive work may run on a rack at a given time.
+//
+// A window opens on every occurrence of a cron schedule and stays open for a
+// fixed duration. Blackout periods close a window regardless of its schedule.
+// Windows are either site-wide (no rack) or attached to a single rack; when a
+// rack has windows of its own they replace the site-wide ones.
+package maintenance
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron"
+)
+
+const (
+	// maxOccurrences bounds how many schedule occurrences are walked when
+	// looking for an opening so that a misconfigured window cannot spin.
+	maxOccurrences = 10000
+
+	// searchHorizon bounds how far ahead the next opening is searched for.
+	searchHorizon = 366 * 24 * time.Hour
+)
+
+// Scope describes what a maintenance window applies to.
+type Scope string
+
+const (
+	ScopeSite Scope = "site"
+	ScopeRack Scope = "rack"
+)
+
+// Blackout is a period during which a window is closed even if its schedule
+// says otherwise, e.g. a product launch or a change freeze.
+type Blackout struct {
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// Contains reports whether t falls within the blackout.
+func (b *Blackout) Contains(t time.Time) bool {
+	return !t.Before(b.Start) && t.Before(b.End)
+}
+
+// Validate validates the blackout period.
+func (b *Blackout) Validate() error {
+	if b.Start.IsZero() || b.End.IsZero() {
+		return fmt.Errorf("blackout start and end are required")
+	}
+
+	if !b.End.After(b.Start) {
+		return fmt.Errorf("blackout end must be after start")
+	}
+
+	return nil
+}
+
+// Window defines a recurring maintenance window. It includes:
+// -- ID: The unique identifier of the window.
+// -- Name: A human readable name.
+// -- RackID: The rack the window applies to, or nil for a site-wide window.
+// -- Schedule: A standard 5-field cron expression giving each opening.
+// -- Duration: How long the window stays open after each opening.
+// -- Timezone: IANA timezone the schedule is evaluated in (default UTC).
+// -- Blackouts: Periods during which the window is closed.
+// -- Enabled: Disabled windows are ignored by evaluation.
+type Window struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	RackID      *uuid.UUID
+	Schedule    string
+	Duration    time.Duration
+	Timezone    string
+	Blackouts   []Blackout
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	schedule cron.Schedule
+	location *time.Location
+}
+
+// Scope returns whether the window is site-wide or attached to a rack.
+func (w *Window) Scope() Scope {
+	if w.RackID != nil && *w.RackID != uuid.Nil {
+		return ScopeRack
+	}
+	return ScopeSite
+}
+
+// Validate validates the window definition and prepares it for evaluation.
+func (w *Window) Validate() error {
+	if w == nil {
+		return fmt.Errorf("maintenance window is nil")
+	}
+
+	if strings.TrimSpace(w.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	if w.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+
+	if _, _, err := w.prepare(); err != nil {
+		return err
+	}
+
+	for i := range w.Blackouts {
+		if err := w.Blackouts[i].Validate(); err != nil {
+			return fmt.Errorf("blackout %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func (w *Window) prepare() (cron.Schedule, *time.Location, error) {
+	if w.schedule != nil && w.location != nil {
+		return w.schedule, w.location, nil
+	}
+
+	spec := strings.TrimSpace(w.Schedule)
+	if spec == "" {
+		return nil, nil, fmt.Errorf("schedule is required")
+	}
+
+	// @every schedules are relative to when they are evaluated rather than
+	// anchored to the wall clock, so they cannot describe a window.
+	if strings.HasPrefix(spec, "@every") {
+		return nil, nil, fmt.Errorf("schedule %q is not anchored to the calendar", spec)
+	}
+
+	sched, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", spec, err)
+	}
+
+	loc := time.UTC
+	if w.Timezone != "" {
+		if loc, err = time.LoadLocation(w.Timezone); err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone %q: %w", w.Timezone, err)
+		}
+	}
+
+	w.schedule = sched
+	w.location = loc
+
+	return sched, loc, nil
+}
+
+// inBlackout returns the blackout containing t, if any.
+func (w *Window) inBlackout(t time.Time) *Blackout {
+	for i := range w.Blackouts {
+		if w.Blackouts[i].Contains(t) {
+			return &w.Blackouts[i]
+		}
+	}
+	return nil
+}
+
+// scheduledEnd returns when the scheduled occurrence covering t ends.
+// Back-to-back or overlapping occurrences are merged into one opening.
+// ok is false if no occurrence covers t.
+func (w *Window) scheduledEnd(t time.Time) (end time.Time, ok bool) {
+	sched, loc, err := w.prepare()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// cron's Next is exclusive, so step back one second to include an
+	// occurrence starting exactly at t - Duration.
+	next := sched.Next(t.In(loc).Add(-w.Duration).Add(-time.Second))
+	for i := 0; i < maxOccurrences && !next.IsZero(); i++ {
+		if next.After(t) && (!ok || next.After(end)) {
+			break
+		}
+
+		if occEnd := next.Add(w.Duration); occEnd.After(t) && occEnd.After(end) {
+			end = occEnd
+			ok = true
+		}
+
+		next = sched.Next(next)
+	}
+
+	return end, ok
+}
+
+// OpenAt reports whether the window is open at t and, if so, when it closes.
+// A window closes at the end of its scheduled occurrence or at the start of
+// the next blackout, whichever comes first.
+func (w *Window) OpenAt(t time.Time) (bool, time.Time) {
+	if w == nil || !w.Enabled {
+		return false, time.Time{}
+	}
+
+	if w.inBlackout(t) != nil {
+		return false, time.Time{}
+	}
+
+	end, ok := w.scheduledEnd(t)
+	if !ok {
+		return false, time.Time{}
+	}
+
+	for _, b := range w.Blackouts {
+		if b.Start.After(t) && b.Start.Before(end) {
+			end = b.Start
+		}
+	}
+
+	return true, end
+}
+
+// NextOpening returns the earliest time at or after t at which the window is
+// open. ok is false if the window does not open within the search horizon.
+func (w *Window) NextOpening(t time.Time) (time.Time, bool) {
+	if w == nil || !w.Enabled {
+		return time.Time{}, false
+	}
+
+	if open, _ := w.OpenAt(t); open {
+		return t, true
+	}
+
+	sched, loc, err := w.prepare()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// The window can open either when an occurrence starts or when a
+	// blackout covering an occurrence ends.
+	var best time.Time
+	consider := func(c time.Time) {
+		if !best.IsZero() && !c.Before(best) {
+			return
+		}
+		if open, _ := w.OpenAt(c); open {
+			best = c
+		}
+	}
+
+	for _, b := range w.Blackouts {
+		if b.End.After(t) {
+			consider(b.End)
+		}
+	}
+
+	horizon := t.Add(searchHorizon)
+	next := sched.Next(t.In(loc))
+	for i := 0; i < maxOccurrences && !next.IsZero() && next.Before(horizon); i++ {
+		if !best.IsZero() && !next.Before(best) {
+			break
+		}
+		consider(next)
+		next = sched.Next(next)
+	}
+
+	if best.IsZero() {
+		return time.Time{}, false
+	}
+
+	return best.UTC(), true
+}
@@ -0,0 +1,383 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package maintenance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/operation"
+	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+)
+
+func mustTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	ts, err := time.Parse(time.RFC3339, s)
+	require.NoError(t, err)
+	return ts
+}
+
+// nightly returns a window that opens at 02:00 UTC every day for two hours.
+func nightly() *Window {
+	return &Window{
+		ID:       uuid.New(),
+		Name:     "nightly",
+		Schedule: "0 2 * * *",
+		Duration: 2 * time.Hour,
+		Enabled:  true,
+	}
+}
+
+func TestWindowValidate(t *testing.T) {
+	testCases := map[string]struct {
+		mutate      func(w *Window)
+		errContains string
+	}{
+		"valid": {
+			mutate: func(w *Window) {},
+		},
+		"missing name": {
+			mutate:      func(w *Window) { w.Name = " " },
+			errContains: "name is required",
+		},
+		"non-positive duration": {
+			mutate:      func(w *Window) { w.Duration = 0 },
+			errContains: "duration must be positive",
+		},
+		"invalid schedule": {
+			mutate:      func(w *Window) { w.Schedule = "not a cron" },
+			errContains: "invalid schedule",
+		},
+		"relative schedule": {
+			mutate:      func(w *Window) { w.Schedule = "@every 1h" },
+			errContains: "not anchored",
+		},
+		"invalid timezone": {
+			mutate:      func(w *Window) { w.Timezone = "Mars/Olympus" },
+			errContains: "invalid timezone",
+		},
+		"inverted blackout": {
+			mutate: func(w *Window) {
+				now := time.Now()
+				w.Blackouts = []Blackout{{Start: now, End: now.Add(-time.Hour)}}
+			},
+			errContains: "blackout 0",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := nightly()
+			tc.mutate(w)
+			err := w.Validate()
+			if tc.errContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errContains)
+		})
+	}
+}
+
+func TestWindowOpenAt(t *testing.T) {
+	testCases := map[string]struct {
+		window       func() *Window
+		at           string
+		expectOpen   bool
+		expectCloses string
+	}{
+		"inside occurrence": {
+			window:       nightly,
+			at:           "2026-03-10T03:00:00Z",
+			expectOpen:   true,
+			expectCloses: "2026-03-10T04:00:00Z",
+		},
+		"exactly at opening": {
+			window:       nightly,
+			at:           "2026-03-10T02:00:00Z",
+			expectOpen:   true,
+			expectCloses: "2026-03-10T04:00:00Z",
+		},
+		"exactly at closing": {
+			window:     nightly,
+			at:         "2026-03-10T04:00:00Z",
+			expectOpen: false,
+		},
+		"outside occurrence": {
+			window:     nightly,
+			at:         "2026-03-10T12:00:00Z",
+			expectOpen: false,
+		},
+		"disabled": {
+			window: func() *Window {
+				w := nightly()
+				w.Enabled = false
+				return w
+			},
+			at:         "2026-03-10T03:00:00Z",
+			expectOpen: false,
+		},
+		"timezone": {
+			window: func() *Window {
+				w := nightly()
+				w.Timezone = "America/Los_Angeles"
+				return w
+			},
+			at:           "2026-03-10T10:00:00Z", // 03:00 PDT
+			expectOpen:   true,
+			expectCloses: "2026-03-10T11:00:00Z",
+		},
+		"back-to-back occurrences merge": {
+			window: func() *Window {
+				w := nightly()
+				w.Schedule = "0 2,3 * * *"
+				w.Duration = time.Hour
+				return w
+			},
+			at:           "2026-03-10T02:30:00Z",
+			expectOpen:   true,
+			expectCloses: "2026-03-10T04:00:00Z",
+		},
+		"blackout closes window": {
+			window: func() *Window {
+				w := nightly()
+				w.Blackouts = []Blackout{{
+					Start: mustTime(t, "2026-03-10T00:00:00Z"),
+					End:   mustTime(t, "2026-03-11T00:00:00Z"),
+				}}
+				return w
+			},
+			at:         "2026-03-10T03:00:00Z",
+			expectOpen: false,
+		},
+		"upcoming blackout shortens opening": {
+			window: func() *Window {
+				w := nightly()
+				w.Blackouts = []Blackout{{
+					Start: mustTime(t, "2026-03-10T03:30:00Z"),
+					End:   mustTime(t, "2026-03-10T05:00:00Z"),
+				}}
+				return w
+			},
+			at:           "2026-03-10T03:00:00Z",
+			expectOpen:   true,
+			expectCloses: "2026-03-10T03:30:00Z",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			w := tc.window()
+			open, closes := w.OpenAt(mustTime(t, tc.at))
+			assert.Equal(t, tc.expectOpen, open)
+			if !tc.expectOpen {
+				return
+			}
+			assert.True(t, mustTime(t, tc.expectCloses).Equal(closes), "closes at %s", closes)
+		})
+	}
+}
+
+func TestWindowNextOpening(t *testing.T) {
+	testCases := map[string]struct {
+		window     func() *Window
+		at         string
+		expectOK   bool
+		expectNext string
+	}{
+		"already open": {
+			window:     nightly,
+			at:         "2026-03-10T03:00:00Z",
+			expectOK:   true,
+			expectNext: "2026-03-10T03:00:00Z",
+		},
+		"later today": {
+			window:     nightly,
+			at:         "2026-03-10T01:00:00Z",
+			expectOK:   true,
+			expectNext: "2026-03-10T02:00:00Z",
+		},
+		"tomorrow": {
+			window:     nightly,
+			at:         "2026-03-10T12:00:00Z",
+			expectOK:   true,
+			expectNext: "2026-03-11T02:00:00Z",
+		},
+		"skips blacked out occurrences": {
+			window: func() *Window {
+				w := nightly()
+				w.Blackouts = []Blackout{{
+					Start: mustTime(t, "2026-03-10T00:00:00Z"),
+					End:   mustTime(t, "2026-03-12T00:00:00Z"),
+				}}
+				return w
+			},
+			at:         "2026-03-10T12:00:00Z",
+			expectOK:   true,
+			expectNext: "2026-03-12T02:00:00Z",
+		},
+		"reopens when blackout ends mid occurrence": {
+			window: func() *Window {
+				w := nightly()
+				w.Blackouts = []Blackout{{
+					Start: mustTime(t, "2026-03-10T01:00:00Z"),
+					End:   mustTime(t, "2026-03-10T03:00:00Z"),
+				}}
+				return w
+			},
+			at:         "2026-03-10T01:30:00Z",
+			expectOK:   true,
+			expectNext: "2026-03-10T03:00:00Z",
+		},
+		"disabled": {
+			window: func() *Window {
+				w := nightly()
+				w.Enabled = false
+				return w
+			},
+			at:       "2026-03-10T12:00:00Z",
+			expectOK: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			next, ok := tc.window().NextOpening(mustTime(t, tc.at))
+			assert.Equal(t, tc.expectOK, ok)
+			if tc.expectOK {
+				assert.True(t, mustTime(t, tc.expectNext).Equal(next), "next opening %s", next)
+			}
+		})
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	rackID := uuid.New()
+	otherRack := uuid.New()
+
+	site := nightly()
+	site.Name = "site"
+
+	rackWindow := nightly()
+	rackWindow.Name = "rack"
+	rackWindow.Schedule = "0 14 * * *"
+	rackWindow.RackID = &rackID
+
+	otherWindow := nightly()
+	otherWindow.Name = "other"
+	otherWindow.RackID = &otherRack
+
+	testCases := map[string]struct {
+		windows        []*Window
+		at             string
+		expectGoverned bool
+		expectOpen     bool
+		expectWindow   string
+		expectNext     string
+	}{
+		"no windows": {
+			windows:        nil,
+			at:             "2026-03-10T12:00:00Z",
+			expectGoverned: false,
+			expectOpen:     true,
+		},
+		"site window open": {
+			windows:        []*Window{site, otherWindow},
+			at:             "2026-03-10T03:00:00Z",
+			expectGoverned: true,
+			expectOpen:     true,
+			expectWindow:   "site",
+		},
+		"site window closed": {
+			windows:        []*Window{site},
+			at:             "2026-03-10T12:00:00Z",
+			expectGoverned: true,
+			expectOpen:     false,
+			expectWindow:   "site",
+			expectNext:     "2026-03-11T02:00:00Z",
+		},
+		"rack window replaces site window": {
+			windows:        []*Window{site, rackWindow},
+			at:             "2026-03-10T03:00:00Z",
+			expectGoverned: true,
+			expectOpen:     false,
+			expectWindow:   "rack",
+			expectNext:     "2026-03-10T14:00:00Z",
+		},
+		"other rack window is ignored": {
+			windows:        []*Window{otherWindow},
+			at:             "2026-03-10T12:00:00Z",
+			expectGoverned: false,
+			expectOpen:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			status := Evaluate(tc.windows, rackID, mustTime(t, tc.at))
+			assert.Equal(t, tc.expectGoverned, status.Governed)
+			assert.Equal(t, tc.expectOpen, status.Open)
+			assert.Equal(t, tc.expectWindow, status.WindowName)
+			if tc.expectNext != "" {
+				require.NotNil(t, status.NextOpening)
+				assert.True(t, mustTime(t, tc.expectNext).Equal(*status.NextOpening))
+			} else {
+				assert.Nil(t, status.NextOpening)
+			}
+		})
+	}
+}
+
+func TestRequiresWindow(t *testing.T) {
+	testCases := map[string]struct {
+		op     operation.Wrapper
+		expect bool
+	}{
+		"power control": {
+			op:     operation.Wrapper{Type: taskcommon.TaskTypePowerControl, Code: taskcommon.OpCodePowerControlPowerOn},
+			expect: true,
+		},
+		"firmware control": {
+			op:     operation.Wrapper{Type: taskcommon.TaskTypeFirmwareControl, Code: taskcommon.OpCodeFirmwareControlUpgrade},
+			expect: true,
+		},
+		"bring up": {
+			op:     operation.Wrapper{Type: taskcommon.TaskTypeBringUp},
+			expect: true,
+		},
+		"ingest": {
+			op:     operation.Wrapper{Type: taskcommon.TaskTypeBringUp, Code: taskcommon.OpCodeIngest},
+			expect: false,
+		},
+		"inject expectation": {
+			op:     operation.Wrapper{Type: taskcommon.TaskTypeInjectExpectation},
+			expect: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, RequiresWindow(tc.op))
+		})
+	}
+}
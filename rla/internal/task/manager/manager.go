@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
@@ -31,6 +32,7 @@ import (
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/executor/temporalworkflow/activity"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operations"
 	taskstore "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/store"
@@ -38,11 +40,18 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
 )
 
+// defaultWindowCheckInterval is how often tasks waiting for a maintenance
+// window are re-evaluated when Config.WindowCheckInterval is not set.
+const defaultWindowCheckInterval = time.Minute
+
 // Config holds the configuration for the task manager.
 type Config struct {
 	InventoryStore inventorystore.Store // For rack/component lookups (read-only)
-	TaskStore      taskstore.Store      // For task persistence
+	TaskStore      taskstore.Store      // For task and maintenance window persistence
 	ExecutorConfig executor.ExecutorConfig
+	// WindowCheckInterval is how often tasks waiting for a maintenance window
+	// are re-evaluated. Defaults to one minute.
+	WindowCheckInterval time.Duration
 	// Note: RuleResolver is created internally from TaskStore
 }
 
@@ -74,8 +83,12 @@ type Manager struct {
 	executor       executor.Executor
 	ruleResolver   *operationrules.Resolver // Resolves operation rules (created internally)
 
+	windowCheckInterval time.Duration
+	now                 func() time.Time
+
 	ctx       context.Context
 	cancel    context.CancelFunc
+	wg        sync.WaitGroup
 	startOnce sync.Once
 	stopOnce  sync.Once
 }
@@ -89,20 +102,36 @@ func New(ctx context.Context, conf *Config) (*Manager, error) {
 	// Set task store as the status updater for workflow activities
 	activity.SetTaskStatusUpdater(conf.TaskStore)
 
-	executor, err := executor.New(ctx, conf.ExecutorConfig)
+	// Create rule resolver internally (queries DB for operation rules)
+	ruleResolver := operationrules.NewResolver(conf.TaskStore)
+
+	windowCheckInterval := conf.WindowCheckInterval
+	if windowCheckInterval <= 0 {
+		windowCheckInterval = defaultWindowCheckInterval
+	}
+
+	m := &Manager{
+		inventoryStore:      conf.InventoryStore,
+		taskStore:           conf.TaskStore,
+		ruleResolver:        ruleResolver,
+		windowCheckInterval: windowCheckInterval,
+		now:                 time.Now,
+	}
+
+	// Workflows consult the manager at stage boundaries to pause disruptive
+	// tasks outside their maintenance window.
+	exec, err := executor.New(
+		ctx,
+		conf.ExecutorConfig,
+		executor.Dependencies{MaintenanceWindowChecker: m},
+	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create rule resolver internally (queries DB for operation rules)
-	ruleResolver := operationrules.NewResolver(conf.TaskStore)
+	m.executor = exec
 
-	return &Manager{
-		inventoryStore: conf.InventoryStore,
-		taskStore:      conf.TaskStore,
-		executor:       executor,
-		ruleResolver:   ruleResolver,
-	}, nil
+	return m, nil
 }
 
 // Start starts the task manager to make it ready to accept tasks.
@@ -123,6 +152,9 @@ func (m *Manager) Start(ctx context.Context) error {
 		startCtx, cancel := context.WithCancel(ctx)
 		m.ctx = startCtx
 		m.cancel = cancel
+
+		m.wg.Add(1)
+		go m.runWindowScheduler(startCtx)
 	})
 
 	return startErr
@@ -135,6 +167,8 @@ func (m *Manager) Stop(ctx context.Context) {
 			m.cancel()
 		}
 
+		m.wg.Wait()
+
 		if m.executor != nil {
 			if err := m.executor.Stop(ctx); err != nil {
 				log.Warn().Err(err).Msg("failed to stop executor")
@@ -246,12 +280,63 @@ func (m *Manager) createAndExecuteTask(
 		AppliedRuleID:  appliedRuleID,
 	}
 
+	// Disruptive work only runs inside a maintenance window unless the caller
+	// explicitly overrides it.
+	gated := maintenance.RequiresWindow(req.Operation)
+	if gated && req.WindowOverride {
+		gated = false
+		task.WindowOverride = true
+		task.OverrideReason = req.OverrideReason
+		task.Message = "Created with maintenance window override"
+	}
+
+	if gated {
+		status, err := m.CheckMaintenanceWindow(ctx, targetRack.Info.ID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to check maintenance window: %w", err)
+		}
+
+		if !status.Open {
+			task.Status = taskcommon.TaskStatusWaiting
+			task.Message = "Waiting for maintenance window: " + status.Describe()
+		}
+	}
+
 	if err := m.taskStore.CreateTask(ctx, &task); err != nil {
 		return uuid.Nil, err
 	}
 
+	if task.WindowOverride {
+		auditWindowOverride(ctx, &task)
+	}
+
+	if task.Status == taskcommon.TaskStatusWaiting {
+		log.Info().
+			Str("task_id", task.ID.String()).
+			Str("rack_id", task.RackID.String()).
+			Str("operation_type", string(req.Operation.Type)).
+			Msg(task.Message)
+		return task.ID, nil
+	}
+
+	if err := m.startTask(ctx, &task, targetRack, ruleDef, gated); err != nil {
+		return uuid.Nil, err
+	}
+
+	return task.ID, nil
+}
+
+// startTask hands a persisted task to the executor and records the
+// execution. The task is marked failed if it cannot be started.
+func (m *Manager) startTask(
+	ctx context.Context,
+	task *taskdef.Task,
+	targetRack *rack.Rack,
+	ruleDef *operationrules.RuleDefinition,
+	gated bool,
+) error {
 	// Execute the task (pass rule definition to execution)
-	resp, err := m.executeTask(ctx, &task, targetRack, ruleDef)
+	resp, err := m.executeTask(ctx, task, targetRack, ruleDef, gated)
 	if err != nil {
 		lerr := m.taskStore.UpdateTaskStatus(
 			ctx,
@@ -265,18 +350,18 @@ func (m *Manager) createAndExecuteTask(
 			log.Error().Err(err).Msgf("failed to update task %s status to failed", task.ID)
 			err = errors.Join(err, lerr)
 		}
-		return uuid.Nil, err
+		return err
 	}
 
 	// Update task with execution info
 	task.ExecutionID = resp.ExecutionID
 	task.ExecutorType = m.executor.Type()
 
-	if err := m.taskStore.UpdateScheduledTask(ctx, &task); err != nil {
+	if err := m.taskStore.UpdateScheduledTask(ctx, task); err != nil {
 		log.Error().Err(err).Msgf("failed to update task %s scheduled", task.ID)
 	}
 
-	return task.ID, nil
+	return nil
 }
 
 func (m *Manager) executeTask(
@@ -284,6 +369,7 @@ func (m *Manager) executeTask(
 	task *taskdef.Task,
 	targetRack *rack.Rack,
 	ruleDef *operationrules.RuleDefinition,
+	gated bool,
 ) (*taskdef.ExecutionResponse, error) {
 	if task == nil {
 		return nil, fmt.Errorf("task is nil")
@@ -291,9 +377,10 @@ func (m *Manager) executeTask(
 
 	req := taskdef.ExecutionRequest{
 		Info: taskdef.ExecutionInfo{
-			TaskID:         task.ID,
-			Rack:           targetRack,
-			RuleDefinition: ruleDef, // Pass rule definition to workflow
+			TaskID:                 task.ID,
+			Rack:                   targetRack,
+			RuleDefinition:         ruleDef, // Pass rule definition to workflow
+			MaintenanceWindowGated: gated,
 		},
 		Async: true,
 	}
@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/alert"
+	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/operation"
+	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
+	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/inventoryobjects/rack"
+)
+
+// waitingTaskBatchSize bounds how many waiting tasks are considered per
+// scheduler round.
+const waitingTaskBatchSize = 500
+
+// CheckMaintenanceWindow evaluates the maintenance windows governing a rack
+// at the current time.
+func (m *Manager) CheckMaintenanceWindow(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*maintenance.Status, error) {
+	windows, err := m.taskStore.ListMaintenanceWindows(ctx, &rackID)
+	if err != nil {
+		return nil, err
+	}
+
+	status := maintenance.Evaluate(windows, rackID, m.now())
+	return &status, nil
+}
+
+// runWindowScheduler periodically starts tasks that were queued because they
+// were submitted outside their maintenance window.
+func (m *Manager) runWindowScheduler(ctx context.Context) {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.windowCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		m.startWaitingTasks(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// startWaitingTasks starts every waiting task whose maintenance window is
+// open. Several RLA instances may run this concurrently; the conditional
+// status transition ensures only one of them starts a given task.
+func (m *Manager) startWaitingTasks(ctx context.Context) {
+	tasks, _, err := m.taskStore.ListTasks(
+		ctx,
+		&taskcommon.TaskListOptions{
+			Statuses: []taskcommon.TaskStatus{taskcommon.TaskStatusWaiting},
+		},
+		&dbquery.Pagination{Offset: 0, Limit: waitingTaskBatchSize},
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list tasks waiting for a maintenance window")
+		return
+	}
+
+	if len(tasks) == 0 {
+		return
+	}
+
+	windows, err := m.taskStore.ListMaintenanceWindows(ctx, nil)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to list maintenance windows")
+		return
+	}
+
+	now := m.now()
+	for _, task := range tasks {
+		if ctx.Err() != nil {
+			return
+		}
+
+		status := maintenance.Evaluate(windows, task.RackID, now)
+		if !status.Open {
+			continue
+		}
+
+		claimed, err := m.taskStore.TransitionTaskStatus(
+			ctx,
+			taskcommon.TaskStatusWaiting,
+			&taskdef.TaskStatusUpdate{
+				ID:      task.ID,
+				Status:  taskcommon.TaskStatusPending,
+				Message: "Starting: " + status.Describe(),
+			},
+		)
+		if err != nil {
+			log.Error().Err(err).Str("task_id", task.ID.String()).Msg("failed to claim waiting task")
+			continue
+		}
+
+		if !claimed {
+			// Another instance started it, or it was cancelled.
+			continue
+		}
+
+		log.Info().
+			Str("task_id", task.ID.String()).
+			Str("rack_id", task.RackID.String()).
+			Str("window", status.WindowName).
+			Msg("Maintenance window open, starting waiting task")
+
+		if err := m.startWaitingTask(ctx, task); err != nil {
+			log.Error().Err(err).Str("task_id", task.ID.String()).Msg("failed to start waiting task")
+		}
+	}
+}
+
+// startWaitingTask rebuilds the execution context of a queued task from the
+// inventory and its recorded rule, then starts it.
+func (m *Manager) startWaitingTask(ctx context.Context, task *taskdef.Task) error {
+	targetRack, err := m.rackForTask(ctx, task)
+	if err == nil {
+		var ruleDef *operationrules.RuleDefinition
+		if ruleDef, err = m.ruleForTask(ctx, task); err == nil {
+			return m.startTask(ctx, task, targetRack, ruleDef, true)
+		}
+	}
+
+	if lerr := m.taskStore.UpdateTaskStatus(
+		ctx,
+		&taskdef.TaskStatusUpdate{
+			ID:      task.ID,
+			Status:  taskcommon.TaskStatusFailed,
+			Message: err.Error(),
+		},
+	); lerr != nil {
+		log.Error().Err(lerr).Msgf("failed to update task %s status to failed", task.ID)
+	}
+
+	return err
+}
+
+// rackForTask resolves the components recorded on the task back to a rack.
+func (m *Manager) rackForTask(ctx context.Context, task *taskdef.Task) (*rack.Rack, error) {
+	targets := make([]operation.ComponentTarget, 0, len(task.ComponentUUIDs))
+	for _, id := range task.ComponentUUIDs {
+		targets = append(targets, operation.ComponentTarget{UUID: id})
+	}
+
+	rackMap, err := resolveComponentTargetSpec(ctx, m.inventoryStore, targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve task components: %w", err)
+	}
+
+	targetRack, ok := rackMap[task.RackID]
+	if !ok || len(rackMap) != 1 {
+		return nil, fmt.Errorf("task components no longer belong to rack %s", task.RackID)
+	}
+
+	return targetRack, nil
+}
+
+// ruleForTask returns the rule that was applied when the task was created,
+// or resolves the current one if the task used the built-in default.
+func (m *Manager) ruleForTask(ctx context.Context, task *taskdef.Task) (*operationrules.RuleDefinition, error) {
+	if task.AppliedRuleID != nil {
+		rule, err := m.taskStore.GetRule(ctx, *task.AppliedRuleID)
+		if err == nil && rule != nil {
+			return &rule.RuleDefinition, nil
+		}
+		log.Warn().
+			Err(err).
+			Str("task_id", task.ID.String()).
+			Str("rule_id", task.AppliedRuleID.String()).
+			Msg("Applied rule is no longer available, resolving the current rule")
+	}
+
+	rule, err := m.ruleResolver.ResolveRule(ctx, task.Operation.Type, task.Operation.Code, task.RackID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve operation rule: %w", err)
+	}
+
+	if rule == nil {
+		return nil, fmt.Errorf("resolver returned nil rule (should never happen)")
+	}
+
+	return &rule.RuleDefinition, nil
+}
+
+// auditWindowOverride records that a disruptive task bypassed its maintenance
+// window. The override and its reason are also persisted on the task.
+func auditWindowOverride(ctx context.Context, task *taskdef.Task) {
+	alert.Send(ctx, alert.Alert{
+		Severity:  alert.SeverityWarning,
+		Message:   "maintenance window overridden: " + task.OverrideReason,
+		Operation: string(task.Operation.Type),
+		TaskID:    task.ID.String(),
+		Details: map[string]string{
+			"rack_id":        task.RackID.String(),
+			"operation_code": task.Operation.Code,
+			"reason":         task.OverrideReason,
+		},
+	})
+}
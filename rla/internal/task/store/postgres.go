@@ -31,6 +31,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/db/model"
 	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/common/errors"
@@ -130,6 +131,25 @@ func (s *PostgresStore) UpdateTaskStatus(
 	return nil
 }
 
+// TransitionTaskStatus updates the status and message of a task if it is
+// currently in the from status.
+func (s *PostgresStore) TransitionTaskStatus(
+	ctx context.Context,
+	from taskcommon.TaskStatus,
+	arg *taskdef.TaskStatusUpdate,
+) (bool, error) {
+	taskDao := &model.Task{
+		ID: arg.ID,
+	}
+
+	updated, err := taskDao.TransitionTaskStatus(ctx, s.pg.DB, from, arg.Status, arg.Message)
+	if err != nil {
+		return false, errors.GRPCErrorInternal(err.Error())
+	}
+
+	return updated, nil
+}
+
 // ========================================
 // Operation Rule Methods
 // ========================================
@@ -535,3 +555,112 @@ func (s *PostgresStore) ListRackRuleAssociations(
 
 	return result, nil
 }
+
+// ========================================
+// Maintenance Window Methods
+// ========================================
+
+// CreateMaintenanceWindow creates a new maintenance window
+func (s *PostgresStore) CreateMaintenanceWindow(
+	ctx context.Context,
+	window *maintenance.Window,
+) error {
+	dbModel, err := dao.MaintenanceWindowTo(window)
+	if err != nil {
+		return errors.GRPCErrorInvalidArgument(err.Error())
+	}
+
+	if err := dbModel.Create(ctx, s.pg.DB); err != nil {
+		return errors.GRPCErrorInternal(
+			fmt.Sprintf("failed to create maintenance window: %v", err),
+		)
+	}
+
+	window.ID = dbModel.ID
+	window.CreatedAt = dbModel.CreatedAt
+	window.UpdatedAt = dbModel.UpdatedAt
+
+	return nil
+}
+
+// UpdateMaintenanceWindow replaces the definition of a maintenance window
+func (s *PostgresStore) UpdateMaintenanceWindow(
+	ctx context.Context,
+	window *maintenance.Window,
+) error {
+	dbModel, err := dao.MaintenanceWindowTo(window)
+	if err != nil {
+		return errors.GRPCErrorInvalidArgument(err.Error())
+	}
+
+	if err := dbModel.Update(ctx, s.pg.DB); err != nil {
+		if s.pg.GetErrorChecker().IsErrNoRows(err) {
+			return errors.GRPCErrorNotFound("maintenance window not found")
+		}
+		return errors.GRPCErrorInternal(
+			fmt.Sprintf("failed to update maintenance window: %v", err),
+		)
+	}
+
+	window.UpdatedAt = dbModel.UpdatedAt
+
+	return nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window by ID
+func (s *PostgresStore) DeleteMaintenanceWindow(
+	ctx context.Context,
+	id uuid.UUID,
+) error {
+	dbModel := &model.MaintenanceWindow{ID: id}
+	if err := dbModel.Delete(ctx, s.pg.DB); err != nil {
+		return errors.GRPCErrorInternal(
+			fmt.Sprintf("failed to delete maintenance window: %v", err),
+		)
+	}
+	return nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by ID
+func (s *PostgresStore) GetMaintenanceWindow(
+	ctx context.Context,
+	id uuid.UUID,
+) (*maintenance.Window, error) {
+	dbModel, err := model.GetMaintenanceWindow(ctx, s.pg.DB, id)
+	if err != nil {
+		return nil, errors.GRPCErrorInternal(
+			fmt.Sprintf("failed to get maintenance window: %v", err),
+		)
+	}
+
+	if dbModel == nil {
+		return nil, errors.GRPCErrorNotFound("maintenance window not found")
+	}
+
+	return dao.MaintenanceWindowFrom(dbModel)
+}
+
+// ListMaintenanceWindows lists maintenance windows, optionally limited to
+// those that can govern a rack
+func (s *PostgresStore) ListMaintenanceWindows(
+	ctx context.Context,
+	rackID *uuid.UUID,
+) ([]*maintenance.Window, error) {
+	dbModels, err := model.ListMaintenanceWindows(ctx, s.pg.DB, rackID)
+	if err != nil {
+		return nil, errors.GRPCErrorInternal(
+			fmt.Sprintf("failed to list maintenance windows: %v", err),
+		)
+	}
+
+	windows := make([]*maintenance.Window, 0, len(dbModels))
+	for i := range dbModels {
+		w, err := dao.MaintenanceWindowFrom(&dbModels[i])
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, w)
+	}
+
+	return windows, nil
+}
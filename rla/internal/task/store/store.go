@@ -15,8 +15,9 @@
  * limitations under the License.
  */
 
-// Package store provides the storage layer for task and operation rule management.
-// It defines the Store interface for persisting and retrieving task and operation rule data.
+// Package store provides the storage layer for task, operation rule and
+// maintenance window management. It defines the Store interface for persisting
+// and retrieving that data.
 package store
 
 import (
@@ -26,6 +27,7 @@ import (
 
 	dbquery "github.com/nvidia/bare-metal-manager-rest/rla/internal/db/query"
 	taskcommon "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/common"
+	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/maintenance"
 	"github.com/nvidia/bare-metal-manager-rest/rla/internal/task/operationrules"
 	taskdef "github.com/nvidia/bare-metal-manager-rest/rla/internal/task/task"
 )
@@ -50,6 +52,10 @@ type Store interface {
 	// UpdateTaskStatus updates the status and message of a task.
 	UpdateTaskStatus(ctx context.Context, arg *taskdef.TaskStatusUpdate) error
 
+	// TransitionTaskStatus updates the status and message of a task only if it
+	// is currently in the from status. Returns whether the task was updated.
+	TransitionTaskStatus(ctx context.Context, from taskcommon.TaskStatus, arg *taskdef.TaskStatusUpdate) (bool, error)
+
 	// Operation rule operations
 
 	// CreateRule creates a new operation rule.
@@ -100,4 +106,22 @@ type Store interface {
 	// ListRackRuleAssociations retrieves all rule associations for a rack.
 	// Returns a list of associations with full details (operation type, operation code, rule ID).
 	ListRackRuleAssociations(ctx context.Context, rackID uuid.UUID) ([]*operationrules.RackRuleAssociation, error)
+
+	// Maintenance window operations
+
+	// CreateMaintenanceWindow creates a new maintenance window.
+	CreateMaintenanceWindow(ctx context.Context, window *maintenance.Window) error
+
+	// UpdateMaintenanceWindow replaces the definition of a maintenance window.
+	UpdateMaintenanceWindow(ctx context.Context, window *maintenance.Window) error
+
+	// DeleteMaintenanceWindow deletes a maintenance window by ID.
+	DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) error
+
+	// GetMaintenanceWindow retrieves a maintenance window by ID.
+	GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*maintenance.Window, error)
+
+	// ListMaintenanceWindows lists maintenance windows. If rackID is set, only
+	// the windows attached to that rack and the site-wide windows are returned.
+	ListMaintenanceWindows(ctx context.Context, rackID *uuid.UUID) ([]*maintenance.Window, error)
 }
@@ -40,6 +40,8 @@ import (
 // -- Status: The status of the task.
 // -- Message: Status message or error details.
 // -- AppliedRuleID: The ID of the operation rule that was applied (if any).
+// -- WindowOverride: Whether the task was allowed to bypass maintenance windows.
+// -- OverrideReason: Why the maintenance window override was requested.
 type Task struct {
 	ID             uuid.UUID
 	Operation      operation.Wrapper
@@ -51,15 +53,20 @@ type Task struct {
 	Status         taskcommon.TaskStatus
 	Message        string
 	AppliedRuleID  *uuid.UUID // The ID of the operation rule that was applied
+	WindowOverride bool
+	OverrideReason string
 }
 
 // ExecutionInfo contains the information needed to execute a task.
 // Rack contains rack info and the components to be operated on (see rack.Rack NOTE).
 // RuleDefinition contains the resolved operation rule (resolved at task creation time).
+// MaintenanceWindowGated makes the workflow check the rack's maintenance
+// windows before every stage and pause while they are closed.
 type ExecutionInfo struct {
-	TaskID         uuid.UUID
-	Rack           *rack.Rack
-	RuleDefinition *operationrules.RuleDefinition
+	TaskID                 uuid.UUID
+	Rack                   *rack.Rack
+	RuleDefinition         *operationrules.RuleDefinition
+	MaintenanceWindowGated bool
 }
 
 type ExecutionRequest struct {
@@ -67,6 +67,29 @@ func (c *Client) Close() error {
 	return c.conn.Close()
 }
 
+// SubmitOption customizes a task submission.
+type SubmitOption func(*submitOptions)
+
+type submitOptions struct {
+	maintenanceOverride *pb.MaintenanceOverride
+}
+
+// WithMaintenanceOverride runs the task immediately even if the maintenance
+// window of the rack is closed. The reason is recorded with the task.
+func WithMaintenanceOverride(reason string) SubmitOption {
+	return func(o *submitOptions) {
+		o.maintenanceOverride = &pb.MaintenanceOverride{Reason: reason}
+	}
+}
+
+func newSubmitOptions(opts []SubmitOption) *submitOptions {
+	o := &submitOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
 // CreateExpectedRack creates a new expected rack and returns its UUID.
 func (c *Client) CreateExpectedRack(
 	ctx context.Context,
@@ -331,6 +354,7 @@ func (c *Client) UpgradeFirmwareByRackIDs(
 	rackIDs []uuid.UUID,
 	componentType types.ComponentType,
 	startTime, endTime *time.Time,
+	opts ...SubmitOption,
 ) (*UpgradeFirmwareResult, error) {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackIDs))
 	for _, id := range rackIDs {
@@ -356,6 +380,7 @@ func (c *Client) UpgradeFirmwareByRackIDs(
 	if endTime != nil {
 		req.EndTime = timestamppb.New(*endTime)
 	}
+	req.MaintenanceOverride = newSubmitOptions(opts).maintenanceOverride
 
 	rsp, err := c.client.UpgradeFirmware(ctx, req)
 	if err != nil {
@@ -373,6 +398,7 @@ func (c *Client) UpgradeFirmwareByRackNames(
 	rackNames []string,
 	componentType types.ComponentType,
 	startTime, endTime *time.Time,
+	opts ...SubmitOption,
 ) (*UpgradeFirmwareResult, error) {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackNames))
 	for _, name := range rackNames {
@@ -398,6 +424,7 @@ func (c *Client) UpgradeFirmwareByRackNames(
 	if endTime != nil {
 		req.EndTime = timestamppb.New(*endTime)
 	}
+	req.MaintenanceOverride = newSubmitOptions(opts).maintenanceOverride
 
 	rsp, err := c.client.UpgradeFirmware(ctx, req)
 	if err != nil {
@@ -414,6 +441,7 @@ func (c *Client) UpgradeFirmwareByMachineIDs(
 	ctx context.Context,
 	machineIDs []string,
 	startTime, endTime *time.Time,
+	opts ...SubmitOption,
 ) (*UpgradeFirmwareResult, error) {
 	compTargets := make([]*pb.ComponentTarget, 0, len(machineIDs))
 	for _, machineID := range machineIDs {
@@ -440,6 +468,7 @@ func (c *Client) UpgradeFirmwareByMachineIDs(
 	if endTime != nil {
 		req.EndTime = timestamppb.New(*endTime)
 	}
+	req.MaintenanceOverride = newSubmitOptions(opts).maintenanceOverride
 
 	rsp, err := c.client.UpgradeFirmware(ctx, req)
 	if err != nil {
@@ -457,6 +486,7 @@ func (c *Client) PowerControlByRackIDs(
 	rackIDs []uuid.UUID,
 	componentType types.ComponentType,
 	op types.PowerControlOp,
+	opts ...SubmitOption,
 ) (*PowerControlResult, error) {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackIDs))
 	for _, id := range rackIDs {
@@ -475,7 +505,7 @@ func (c *Client) PowerControlByRackIDs(
 		},
 	}
 
-	return c.executePowerControl(ctx, targetSpec, op)
+	return c.executePowerControl(ctx, targetSpec, op, opts...)
 }
 
 // PowerControlByRackNames performs power control on components in the given rack names.
@@ -484,6 +514,7 @@ func (c *Client) PowerControlByRackNames(
 	rackNames []string,
 	componentType types.ComponentType,
 	op types.PowerControlOp,
+	opts ...SubmitOption,
 ) (*PowerControlResult, error) {
 	rackTargets := make([]*pb.RackTarget, 0, len(rackNames))
 	for _, name := range rackNames {
@@ -502,7 +533,7 @@ func (c *Client) PowerControlByRackNames(
 		},
 	}
 
-	return c.executePowerControl(ctx, targetSpec, op)
+	return c.executePowerControl(ctx, targetSpec, op, opts...)
 }
 
 // PowerControlByMachineIDs performs power control on the given machine IDs.
@@ -510,6 +541,7 @@ func (c *Client) PowerControlByMachineIDs(
 	ctx context.Context,
 	machineIDs []string,
 	op types.PowerControlOp,
+	opts ...SubmitOption,
 ) (*PowerControlResult, error) {
 	compTargets := make([]*pb.ComponentTarget, 0, len(machineIDs))
 	for _, machineID := range machineIDs {
@@ -529,7 +561,7 @@ func (c *Client) PowerControlByMachineIDs(
 		},
 	}
 
-	return c.executePowerControl(ctx, targetSpec, op)
+	return c.executePowerControl(ctx, targetSpec, op, opts...)
 }
 
 // executePowerControl executes a power control operation with the given target spec.
@@ -537,40 +569,47 @@ func (c *Client) executePowerControl(
 	ctx context.Context,
 	targetSpec *pb.OperationTargetSpec,
 	op types.PowerControlOp,
+	opts ...SubmitOption,
 ) (*PowerControlResult, error) {
 	var rsp *pb.SubmitTaskResponse
 	var err error
 
 	pbOp := powerControlOpToProto(op)
+	override := newSubmitOptions(opts).maintenanceOverride
 
 	switch pbOp {
 	case pb.PowerControlOp_POWER_CONTROL_OP_ON, pb.PowerControlOp_POWER_CONTROL_OP_FORCE_ON:
 		rsp, err = c.client.PowerOnRack(ctx, &pb.PowerOnRackRequest{
-			TargetSpec: targetSpec,
+			TargetSpec:          targetSpec,
+			MaintenanceOverride: override,
 		})
 
 	case pb.PowerControlOp_POWER_CONTROL_OP_OFF:
 		rsp, err = c.client.PowerOffRack(ctx, &pb.PowerOffRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     false,
+			TargetSpec:          targetSpec,
+			Forced:              false,
+			MaintenanceOverride: override,
 		})
 
 	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_OFF:
 		rsp, err = c.client.PowerOffRack(ctx, &pb.PowerOffRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     true,
+			TargetSpec:          targetSpec,
+			Forced:              true,
+			MaintenanceOverride: override,
 		})
 
 	case pb.PowerControlOp_POWER_CONTROL_OP_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_WARM_RESET:
 		rsp, err = c.client.PowerResetRack(ctx, &pb.PowerResetRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     false,
+			TargetSpec:          targetSpec,
+			Forced:              false,
+			MaintenanceOverride: override,
 		})
 
 	case pb.PowerControlOp_POWER_CONTROL_OP_FORCE_RESTART, pb.PowerControlOp_POWER_CONTROL_OP_COLD_RESET:
 		rsp, err = c.client.PowerResetRack(ctx, &pb.PowerResetRackRequest{
-			TargetSpec: targetSpec,
-			Forced:     true,
+			TargetSpec:          targetSpec,
+			Forced:              true,
+			MaintenanceOverride: override,
 		})
 
 	default:
@@ -874,6 +913,200 @@ func convertValidateComponentsResponse(rsp *pb.ValidateComponentsResponse) *Vali
 	}
 }
 
+// GetPowerStats retrieves the current power draw of every rack.
+func (c *Client) GetPowerStats(ctx context.Context) (*GetPowerStatsResult, error) {
+	return c.getPowerStats(ctx, nil)
+}
+
+// GetPowerStatsByRackIDs retrieves the current power draw of components by rack IDs.
+func (c *Client) GetPowerStatsByRackIDs(
+	ctx context.Context,
+	rackIDs []uuid.UUID,
+	componentType types.ComponentType,
+) (*GetPowerStatsResult, error) {
+	rackTargets := make([]*pb.RackTarget, 0, len(rackIDs))
+	for _, id := range rackIDs {
+		rt := &pb.RackTarget{
+			Identifier: &pb.RackTarget_Id{Id: uuidToProto(id)},
+		}
+		if componentType != types.ComponentTypeUnknown {
+			rt.ComponentTypes = []pb.ComponentType{componentTypeToProto(componentType)}
+		}
+		rackTargets = append(rackTargets, rt)
+	}
+
+	return c.getPowerStats(ctx, &pb.OperationTargetSpec{
+		Targets: &pb.OperationTargetSpec_Racks{
+			Racks: &pb.RackTargets{Targets: rackTargets},
+		},
+	})
+}
+
+// GetPowerStatsByRackNames retrieves the current power draw of components by rack names.
+func (c *Client) GetPowerStatsByRackNames(
+	ctx context.Context,
+	rackNames []string,
+	componentType types.ComponentType,
+) (*GetPowerStatsResult, error) {
+	rackTargets := make([]*pb.RackTarget, 0, len(rackNames))
+	for _, name := range rackNames {
+		rt := &pb.RackTarget{
+			Identifier: &pb.RackTarget_Name{Name: name},
+		}
+		if componentType != types.ComponentTypeUnknown {
+			rt.ComponentTypes = []pb.ComponentType{componentTypeToProto(componentType)}
+		}
+		rackTargets = append(rackTargets, rt)
+	}
+
+	return c.getPowerStats(ctx, &pb.OperationTargetSpec{
+		Targets: &pb.OperationTargetSpec_Racks{
+			Racks: &pb.RackTargets{Targets: rackTargets},
+		},
+	})
+}
+
+// GetPowerStatsByComponentIDs retrieves the current power draw of components by external component IDs.
+func (c *Client) GetPowerStatsByComponentIDs(
+	ctx context.Context,
+	componentIDs []string,
+	componentType types.ComponentType,
+) (*GetPowerStatsResult, error) {
+	compTargets := make([]*pb.ComponentTarget, 0, len(componentIDs))
+	for _, compID := range componentIDs {
+		compTargets = append(compTargets, &pb.ComponentTarget{
+			Identifier: &pb.ComponentTarget_External{
+				External: &pb.ExternalRef{
+					Type: componentTypeToProto(componentType),
+					Id:   compID,
+				},
+			},
+		})
+	}
+
+	return c.getPowerStats(ctx, &pb.OperationTargetSpec{
+		Targets: &pb.OperationTargetSpec_Components{
+			Components: &pb.ComponentTargets{Targets: compTargets},
+		},
+	})
+}
+
+func (c *Client) getPowerStats(
+	ctx context.Context,
+	targetSpec *pb.OperationTargetSpec,
+) (*GetPowerStatsResult, error) {
+	rsp, err := c.client.GetPowerStats(
+		ctx,
+		&pb.GetPowerStatsRequest{TargetSpec: targetSpec},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GetPowerStatsResult{
+		Components: make([]*types.ComponentPowerStats, 0, len(rsp.GetComponents())),
+		Racks:      make([]*types.RackPowerStats, 0, len(rsp.GetRacks())),
+		NVLDomains: make([]*types.NVLDomainPowerStats, 0, len(rsp.GetNvlDomains())),
+	}
+
+	for _, cs := range rsp.GetComponents() {
+		result.Components = append(result.Components, componentPowerStatsFromProto(cs))
+	}
+
+	for _, rs := range rsp.GetRacks() {
+		result.Racks = append(result.Racks, rackPowerStatsFromProto(rs))
+	}
+
+	for _, ds := range rsp.GetNvlDomains() {
+		result.NVLDomains = append(result.NVLDomains, nvlDomainPowerStatsFromProto(ds))
+	}
+
+	if rsp.GetCollectedAt() != nil {
+		result.CollectedAt = rsp.GetCollectedAt().AsTime()
+	}
+
+	return result, nil
+}
+
+// SetRackPowerBudget sets the power budget of a rack in watts. A budget of
+// 0 removes the budget.
+func (c *Client) SetRackPowerBudget(
+	ctx context.Context,
+	rackID uuid.UUID,
+	watts float64,
+) (string, error) {
+	rsp, err := c.client.PatchRack(
+		ctx,
+		&pb.PatchRackRequest{
+			Rack: &pb.Rack{
+				Info:             &pb.DeviceInfo{Id: uuidToProto(rackID)},
+				PowerBudgetWatts: &watts,
+			},
+		},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	return rsp.GetReport(), nil
+}
+
+// DriftHistoryQuery narrows down ListDriftHistory results. Zero values match
+// everything.
+type DriftHistoryQuery struct {
+	ComponentIDs []uuid.UUID // RLA component UUIDs
+	FieldName    string
+	OpenOnly     bool
+	Since        *time.Time // Only drifts last seen at or after this time
+	Pagination   *types.Pagination
+}
+
+// ListDriftHistory lists the drifts detected by the inventory loop, most
+// recently seen first.
+func (c *Client) ListDriftHistory(
+	ctx context.Context,
+	query DriftHistoryQuery,
+) (*ListDriftHistoryResult, error) {
+	req := &pb.ListDriftHistoryRequest{
+		FieldName:  query.FieldName,
+		OpenOnly:   query.OpenOnly,
+		Pagination: paginationToProto(query.Pagination),
+	}
+
+	if query.Since != nil {
+		req.Since = timestamppb.New(*query.Since)
+	}
+
+	if len(query.ComponentIDs) > 0 {
+		compTargets := make([]*pb.ComponentTarget, 0, len(query.ComponentIDs))
+		for _, id := range query.ComponentIDs {
+			compTargets = append(compTargets, &pb.ComponentTarget{
+				Identifier: &pb.ComponentTarget_Id{Id: uuidToProto(id)},
+			})
+		}
+		req.TargetSpec = &pb.OperationTargetSpec{
+			Targets: &pb.OperationTargetSpec_Components{
+				Components: &pb.ComponentTargets{Targets: compTargets},
+			},
+		}
+	}
+
+	rsp, err := c.client.ListDriftHistory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*types.DriftHistoryEntry, 0, len(rsp.GetEntries()))
+	for _, e := range rsp.GetEntries() {
+		entries = append(entries, driftHistoryEntryFromProto(e))
+	}
+
+	return &ListDriftHistoryResult{
+		Entries: entries,
+		Total:   int(rsp.GetTotal()),
+	}, nil
+}
+
 // ListTasks lists tasks matching the query.
 func (c *Client) ListTasks(
 	ctx context.Context,
@@ -1231,6 +1464,116 @@ func (c *Client) ListRackRuleAssociations(
 	return associations, nil
 }
 
+// ========================================
+// Maintenance Windows Methods
+// ========================================
+
+// CreateMaintenanceWindow creates a new maintenance window and returns its UUID.
+func (c *Client) CreateMaintenanceWindow(
+	ctx context.Context,
+	window *types.MaintenanceWindow,
+) (uuid.UUID, error) {
+	rsp, err := c.client.CreateMaintenanceWindow(
+		ctx,
+		&pb.CreateMaintenanceWindowRequest{
+			Window: maintenanceWindowToProto(window),
+		},
+	)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return uuidFromProto(rsp.GetId()), nil
+}
+
+// UpdateMaintenanceWindow replaces the definition of an existing maintenance window.
+func (c *Client) UpdateMaintenanceWindow(
+	ctx context.Context,
+	window *types.MaintenanceWindow,
+) error {
+	_, err := c.client.UpdateMaintenanceWindow(
+		ctx,
+		&pb.UpdateMaintenanceWindowRequest{
+			Window: maintenanceWindowToProto(window),
+		},
+	)
+	return err
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window by its ID.
+func (c *Client) DeleteMaintenanceWindow(
+	ctx context.Context,
+	windowID uuid.UUID,
+) error {
+	_, err := c.client.DeleteMaintenanceWindow(
+		ctx,
+		&pb.DeleteMaintenanceWindowRequest{
+			WindowId: uuidToProto(windowID),
+		},
+	)
+	return err
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by its ID.
+func (c *Client) GetMaintenanceWindow(
+	ctx context.Context,
+	windowID uuid.UUID,
+) (*types.MaintenanceWindow, error) {
+	rsp, err := c.client.GetMaintenanceWindow(
+		ctx,
+		&pb.GetMaintenanceWindowRequest{
+			WindowId: uuidToProto(windowID),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return maintenanceWindowFromProto(rsp), nil
+}
+
+// ListMaintenanceWindows lists maintenance windows. If rackID is set, only
+// the windows that can govern that rack (its own and site-wide) are returned.
+func (c *Client) ListMaintenanceWindows(
+	ctx context.Context,
+	rackID *uuid.UUID,
+) ([]*types.MaintenanceWindow, error) {
+	req := &pb.ListMaintenanceWindowsRequest{}
+	if rackID != nil {
+		req.RackId = uuidToProto(*rackID)
+	}
+
+	rsp, err := c.client.ListMaintenanceWindows(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]*types.MaintenanceWindow, 0, len(rsp.Windows))
+	for _, w := range rsp.Windows {
+		windows = append(windows, maintenanceWindowFromProto(w))
+	}
+
+	return windows, nil
+}
+
+// GetMaintenanceWindowStatus reports whether disruptive work may run on a rack now.
+func (c *Client) GetMaintenanceWindowStatus(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*types.MaintenanceWindowStatus, error) {
+	rsp, err := c.client.GetMaintenanceWindowStatus(
+		ctx,
+		&pb.GetMaintenanceWindowStatusRequest{
+			RackId: uuidToProto(rackID),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return maintenanceWindowStatusFromProto(rsp), nil
+}
+
 // IngestRackByRackIDs submits an ingestion task for the given rack IDs.
 func (c *Client) IngestRackByRackIDs(
 	ctx context.Context,
@@ -19,8 +19,10 @@ package client
 
 import (
 	"net"
+	"time"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb "github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1"
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
@@ -38,6 +40,11 @@ func rackFromProto(r *pb.Rack) *types.Rack {
 		Location: locationFromProto(r.GetLocation()),
 	}
 
+	if r.PowerBudgetWatts != nil {
+		budget := r.GetPowerBudgetWatts()
+		rack.PowerBudgetWatts = &budget
+	}
+
 	if len(r.GetComponents()) > 0 {
 		rack.Components = make([]types.Component, 0, len(r.GetComponents()))
 		for _, c := range r.GetComponents() {
@@ -170,15 +177,17 @@ func taskFromProto(t *pb.Task) *types.Task {
 	}
 
 	return &types.Task{
-		ID:           uuidFromProto(t.GetId()),
-		Operation:    t.GetOperation(),
-		RackID:       uuidFromProto(t.GetRackId()),
-		ComponentIDs: uuidsFromProto(t.GetComponentUuids()),
-		Description:  t.GetDescription(),
-		ExecutorType: taskExecutorTypeFromProto(t.GetExecutorType()),
-		ExecutionID:  t.GetExecutionId(),
-		Status:       taskStatusFromProto(t.GetStatus()),
-		Message:      t.GetMessage(),
+		ID:             uuidFromProto(t.GetId()),
+		Operation:      t.GetOperation(),
+		RackID:         uuidFromProto(t.GetRackId()),
+		ComponentIDs:   uuidsFromProto(t.GetComponentUuids()),
+		Description:    t.GetDescription(),
+		ExecutorType:   taskExecutorTypeFromProto(t.GetExecutorType()),
+		ExecutionID:    t.GetExecutionId(),
+		Status:         taskStatusFromProto(t.GetStatus()),
+		Message:        t.GetMessage(),
+		WindowOverride: t.GetWindowOverride(),
+		OverrideReason: t.GetOverrideReason(),
 	}
 }
 
@@ -205,9 +214,40 @@ func componentDiffFromProto(d *pb.ComponentDiff) *types.ComponentDiff {
 		}
 	}
 
+	if d.GetFirstSeenAt() != nil {
+		t := d.GetFirstSeenAt().AsTime()
+		diff.FirstSeenAt = &t
+	}
+
 	return diff
 }
 
+func driftHistoryEntryFromProto(e *pb.DriftHistoryEntry) *types.DriftHistoryEntry {
+	if e == nil {
+		return nil
+	}
+
+	entry := &types.DriftHistoryEntry{
+		ID:            uuidFromProto(e.GetId()),
+		ComponentID:   e.GetComponentId(),
+		ExternalID:    e.GetExternalId(),
+		Type:          diffTypeFromProto(e.GetType()),
+		FieldName:     e.GetFieldName(),
+		ExpectedValue: e.GetExpectedValue(),
+		ActualValue:   e.GetActualValue(),
+		FirstSeenAt:   e.GetFirstSeenAt().AsTime(),
+		LastSeenAt:    e.GetLastSeenAt().AsTime(),
+		Remediation:   e.GetRemediation(),
+	}
+
+	if e.GetResolvedAt() != nil {
+		t := e.GetResolvedAt().AsTime()
+		entry.ResolvedAt = &t
+	}
+
+	return entry
+}
+
 // Enum conversions from proto
 
 func componentTypeFromProto(ct pb.ComponentType) types.ComponentType {
@@ -250,6 +290,10 @@ func taskStatusFromProto(ts pb.TaskStatus) types.TaskStatus {
 		return types.TaskStatusCompleted
 	case pb.TaskStatus_TASK_STATUS_FAILED:
 		return types.TaskStatusFailed
+	case pb.TaskStatus_TASK_STATUS_WAITING:
+		return types.TaskStatusWaiting
+	case pb.TaskStatus_TASK_STATUS_PAUSED:
+		return types.TaskStatusPaused
 	default:
 		return types.TaskStatusUnknown
 	}
@@ -285,8 +329,9 @@ func rackToProto(r *types.Rack) *pb.Rack {
 	}
 
 	rack := &pb.Rack{
-		Info:     deviceInfoToProto(&r.Info),
-		Location: locationToProto(&r.Location),
+		Info:             deviceInfoToProto(&r.Info),
+		Location:         locationToProto(&r.Location),
+		PowerBudgetWatts: r.PowerBudgetWatts,
 	}
 
 	if len(r.Components) > 0 {
@@ -588,3 +633,154 @@ func rackRuleAssociationFromProto(a *pb.RackRuleAssociation) *types.RackRuleAsso
 
 	return assoc
 }
+
+func maintenanceWindowFromProto(w *pb.MaintenanceWindow) *types.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+
+	window := &types.MaintenanceWindow{
+		ID:          uuidFromProto(w.GetId()),
+		Name:        w.GetName(),
+		Description: w.GetDescription(),
+		Schedule:    w.GetSchedule(),
+		Duration:    time.Duration(w.GetDurationSeconds()) * time.Second,
+		Timezone:    w.GetTimezone(),
+		Enabled:     w.GetEnabled(),
+	}
+
+	if rackID := uuidFromProto(w.GetRackId()); rackID != uuid.Nil {
+		window.RackID = &rackID
+	}
+
+	for _, b := range w.GetBlackouts() {
+		window.Blackouts = append(window.Blackouts, types.BlackoutPeriod{
+			Start:  b.GetStart().AsTime(),
+			End:    b.GetEnd().AsTime(),
+			Reason: b.GetReason(),
+		})
+	}
+
+	if w.GetCreatedAt() != nil {
+		window.CreatedAt = w.GetCreatedAt().AsTime()
+	}
+	if w.GetUpdatedAt() != nil {
+		window.UpdatedAt = w.GetUpdatedAt().AsTime()
+	}
+
+	return window
+}
+
+func maintenanceWindowToProto(w *types.MaintenanceWindow) *pb.MaintenanceWindow {
+	if w == nil {
+		return nil
+	}
+
+	window := &pb.MaintenanceWindow{
+		Id:              uuidToProto(w.ID),
+		Name:            w.Name,
+		Description:     w.Description,
+		Schedule:        w.Schedule,
+		DurationSeconds: int64(w.Duration / time.Second),
+		Timezone:        w.Timezone,
+		Enabled:         w.Enabled,
+	}
+
+	if w.RackID != nil {
+		window.RackId = uuidToProto(*w.RackID)
+	}
+
+	for _, b := range w.Blackouts {
+		window.Blackouts = append(window.Blackouts, &pb.BlackoutPeriod{
+			Start:  timestamppb.New(b.Start),
+			End:    timestamppb.New(b.End),
+			Reason: b.Reason,
+		})
+	}
+
+	return window
+}
+
+func maintenanceWindowStatusFromProto(s *pb.MaintenanceWindowStatus) *types.MaintenanceWindowStatus {
+	if s == nil {
+		return nil
+	}
+
+	status := &types.MaintenanceWindowStatus{
+		Governed:   s.GetGoverned(),
+		Open:       s.GetOpen(),
+		WindowID:   uuidFromProto(s.GetWindowId()),
+		WindowName: s.GetWindowName(),
+	}
+
+	if s.GetClosesAt() != nil {
+		closesAt := s.GetClosesAt().AsTime()
+		status.ClosesAt = &closesAt
+	}
+	if s.GetNextOpening() != nil {
+		nextOpening := s.GetNextOpening().AsTime()
+		status.NextOpening = &nextOpening
+	}
+
+	return status
+}
+
+func componentPowerStatsFromProto(c *pb.ComponentPowerStats) *types.ComponentPowerStats {
+	if c == nil {
+		return nil
+	}
+
+	return &types.ComponentPowerStats{
+		ID:          uuidFromProto(c.GetId()),
+		ComponentID: c.GetComponentId(),
+		Type:        componentTypeFromProto(c.GetType()),
+		RackID:      uuidFromProto(c.GetRackId()),
+		Watts:       c.GetWatts(),
+		Available:   c.GetAvailable(),
+	}
+}
+
+func rackPowerStatsFromProto(r *pb.RackPowerStats) *types.RackPowerStats {
+	if r == nil {
+		return nil
+	}
+
+	stats := &types.RackPowerStats{
+		RackID:                uuidFromProto(r.GetRackId()),
+		RackName:              r.GetRackName(),
+		NVLDomainID:           uuidFromProto(r.GetNvlDomainId()),
+		ComputeWatts:          r.GetComputeWatts(),
+		NVLSwitchWatts:        r.GetNvlswitchWatts(),
+		PowerShelfOutputWatts: r.GetPowershelfOutputWatts(),
+		DrawWatts:             r.GetDrawWatts(),
+		UnavailableComponents: int(r.GetUnavailableComponents()),
+	}
+
+	if r.BudgetWatts != nil {
+		budget := r.GetBudgetWatts()
+		stats.BudgetWatts = &budget
+	}
+
+	return stats
+}
+
+func nvlDomainPowerStatsFromProto(d *pb.NVLDomainPowerStats) *types.NVLDomainPowerStats {
+	if d == nil {
+		return nil
+	}
+
+	stats := &types.NVLDomainPowerStats{
+		DrawWatts:   d.GetDrawWatts(),
+		BudgetWatts: d.GetBudgetWatts(),
+		RackCount:   int(d.GetRackCount()),
+	}
+
+	if id := d.GetNvlDomain(); id != nil {
+		stats.NVLDomain = types.Identifier{
+			ID:   uuidFromProto(id.GetId()),
+			Name: id.GetName(),
+		}
+	}
+
+	return stats
+}
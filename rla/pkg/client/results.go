@@ -18,6 +18,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/google/uuid"
 
 	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
@@ -49,11 +51,25 @@ type ValidateComponentsResult struct {
 	MatchCount          int
 }
 
+// ListDriftHistoryResult represents the result of ListDriftHistory call.
+type ListDriftHistoryResult struct {
+	Entries []*types.DriftHistoryEntry
+	Total   int
+}
+
 // IngestRackResult represents the result of an IngestRack operation.
 type IngestRackResult struct {
 	TaskIDs []uuid.UUID
 }
 
+// GetPowerStatsResult represents the result of GetPowerStats call.
+type GetPowerStatsResult struct {
+	Components  []*types.ComponentPowerStats
+	Racks       []*types.RackPowerStats
+	NVLDomains  []*types.NVLDomainPowerStats
+	CollectedAt time.Time
+}
+
 // ListTasksResult represents the result of ListTasks call.
 type ListTasksResult struct {
 	Tasks []*types.Task
@@ -34,6 +34,10 @@ func GRPCErrorInvalidArgument(msg string) error {
 	return status.Error(codes.InvalidArgument, msg)
 }
 
+func GRPCErrorFailedPrecondition(msg string) error {
+	return status.Error(codes.FailedPrecondition, msg)
+}
+
 func GRPCErrorInternal(msg string) error {
 	return status.Error(codes.Internal, msg)
 }
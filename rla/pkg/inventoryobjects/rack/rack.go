@@ -45,6 +45,10 @@ type Rack struct {
 	Loc        location.Location     `json:"loc"`
 	Components []component.Component `json:"components"`
 
+	// PowerBudgetWatts is the maximum sustained draw allowed for the rack.
+	// Nil or zero means the rack has no budget.
+	PowerBudgetWatts *float64 `json:"power_budget_watts,omitempty"`
+
 	serialToCompIndex map[deviceinfo.SerialInfo]int
 	sealed            bool
 }
@@ -0,0 +1,139 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+// Package maintenancegate lets component manager services refuse or defer
+// disruptive operations on components whose rack maintenance window, as
+// defined in RLA, is closed.
+package maintenancegate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
+)
+
+// ErrWindowClosed is returned when the maintenance window of the component's
+// rack is closed.
+var ErrWindowClosed = errors.New("maintenance window is closed")
+
+// Client is the subset of the RLA client used by the gate.
+type Client interface {
+	GetExpectedComponentsByComponentIDs(
+		ctx context.Context,
+		componentIDs []string,
+		componentType types.ComponentType,
+	) (*client.GetExpectedComponentsResult, error)
+	GetMaintenanceWindowStatus(
+		ctx context.Context,
+		rackID uuid.UUID,
+	) (*types.MaintenanceWindowStatus, error)
+}
+
+// Gate checks the maintenance window of the rack holding a component before a
+// disruptive operation. A nil Gate allows every operation.
+type Gate struct {
+	client        Client
+	componentType types.ComponentType
+}
+
+// New creates a gate for components of the given type. Component IDs passed to
+// Check are the IDs the component manager service uses for the component.
+func New(c Client, componentType types.ComponentType) *Gate {
+	return &Gate{client: c, componentType: componentType}
+}
+
+// Check returns nil when disruptive work may run on the component now.
+// Components RLA does not know about, and racks without an enabled window,
+// are not restricted. An error wrapping ErrWindowClosed is returned when the
+// window is closed; any other error means the window could not be evaluated
+// and callers should refuse the operation.
+func (g *Gate) Check(ctx context.Context, componentID string) error {
+	rackID, status, err := g.status(ctx, componentID)
+	if err != nil {
+		return err
+	}
+
+	if status == nil || !status.Governed || status.Open {
+		return nil
+	}
+
+	if status.NextOpening != nil {
+		return fmt.Errorf(
+			"%w for rack %s, next opening at %s",
+			ErrWindowClosed, rackID, status.NextOpening.UTC().Format(time.RFC3339),
+		)
+	}
+
+	return fmt.Errorf("%w for rack %s", ErrWindowClosed, rackID)
+}
+
+// Window reports whether disruptive work may run on the component now, for
+// callers that defer work instead of refusing it. When the window is closed,
+// next is its next opening, or nil if no opening is scheduled.
+func (g *Gate) Window(ctx context.Context, componentID string) (bool, *time.Time, error) {
+	_, status, err := g.status(ctx, componentID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	if status == nil || !status.Governed || status.Open {
+		return true, nil, nil
+	}
+
+	return false, status.NextOpening, nil
+}
+
+// status returns the maintenance window status of the rack holding the
+// component, or a nil status when the component is not restricted.
+func (g *Gate) status(ctx context.Context, componentID string) (uuid.UUID, *types.MaintenanceWindowStatus, error) {
+	if g == nil || g.client == nil {
+		return uuid.Nil, nil, nil
+	}
+
+	result, err := g.client.GetExpectedComponentsByComponentIDs(
+		ctx,
+		[]string{componentID},
+		g.componentType,
+	)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to look up component %s in RLA: %w", componentID, err)
+	}
+
+	var rackID uuid.UUID
+	for _, c := range result.Components {
+		if c != nil && c.RackID != uuid.Nil {
+			rackID = c.RackID
+			break
+		}
+	}
+
+	if rackID == uuid.Nil {
+		return uuid.Nil, nil, nil
+	}
+
+	status, err := g.client.GetMaintenanceWindowStatus(ctx, rackID)
+	if err != nil {
+		return rackID, nil, fmt.Errorf("failed to get maintenance window status of rack %s: %w", rackID, err)
+	}
+
+	return rackID, status, nil
+}
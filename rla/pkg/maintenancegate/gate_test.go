@@ -0,0 +1,214 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package maintenancegate
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/client"
+	"github.com/nvidia/bare-metal-manager-rest/rla/pkg/types"
+)
+
+type fakeClient struct {
+	components []*types.Component
+	lookupErr  error
+	status     *types.MaintenanceWindowStatus
+	statusErr  error
+
+	gotType types.ComponentType
+	gotRack uuid.UUID
+}
+
+func (f *fakeClient) GetExpectedComponentsByComponentIDs(
+	ctx context.Context,
+	componentIDs []string,
+	componentType types.ComponentType,
+) (*client.GetExpectedComponentsResult, error) {
+	f.gotType = componentType
+	if f.lookupErr != nil {
+		return nil, f.lookupErr
+	}
+
+	return &client.GetExpectedComponentsResult{
+		Components: f.components,
+		Total:      len(f.components),
+	}, nil
+}
+
+func (f *fakeClient) GetMaintenanceWindowStatus(
+	ctx context.Context,
+	rackID uuid.UUID,
+) (*types.MaintenanceWindowStatus, error) {
+	f.gotRack = rackID
+	if f.statusErr != nil {
+		return nil, f.statusErr
+	}
+
+	return f.status, nil
+}
+
+func TestGateCheck(t *testing.T) {
+	rackID := uuid.New()
+	nextOpening := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	inRack := []*types.Component{{ComponentID: "sw-1", RackID: rackID}}
+
+	testCases := map[string]struct {
+		client     *fakeClient
+		wantErr    bool
+		wantClosed bool
+	}{
+		"unknown component is allowed": {
+			client: &fakeClient{},
+		},
+		"component without rack is allowed": {
+			client: &fakeClient{components: []*types.Component{{ComponentID: "sw-1"}}},
+		},
+		"ungoverned rack is allowed": {
+			client: &fakeClient{
+				components: inRack,
+				status:     &types.MaintenanceWindowStatus{Governed: false, Open: true},
+			},
+		},
+		"open window is allowed": {
+			client: &fakeClient{
+				components: inRack,
+				status:     &types.MaintenanceWindowStatus{Governed: true, Open: true},
+			},
+		},
+		"closed window is refused": {
+			client: &fakeClient{
+				components: inRack,
+				status: &types.MaintenanceWindowStatus{
+					Governed:    true,
+					Open:        false,
+					NextOpening: &nextOpening,
+				},
+			},
+			wantErr:    true,
+			wantClosed: true,
+		},
+		"lookup failure is returned": {
+			client:  &fakeClient{lookupErr: errors.New("unavailable")},
+			wantErr: true,
+		},
+		"status failure is returned": {
+			client:  &fakeClient{components: inRack, statusErr: errors.New("unavailable")},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			gate := New(tc.client, types.ComponentTypeNVSwitch)
+
+			err := gate.Check(context.Background(), "sw-1")
+			if !tc.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.Error(t, err)
+			assert.Equal(t, tc.wantClosed, errors.Is(err, ErrWindowClosed))
+			assert.Equal(t, types.ComponentTypeNVSwitch, tc.client.gotType)
+		})
+	}
+
+	t.Run("closed window reports rack and next opening", func(t *testing.T) {
+		fc := &fakeClient{
+			components: inRack,
+			status: &types.MaintenanceWindowStatus{
+				Governed:    true,
+				NextOpening: &nextOpening,
+			},
+		}
+
+		err := New(fc, types.ComponentTypeNVSwitch).Check(context.Background(), "sw-1")
+		assert.ErrorContains(t, err, rackID.String())
+		assert.ErrorContains(t, err, "2026-01-02T03:00:00Z")
+		assert.Equal(t, rackID, fc.gotRack)
+	})
+
+	t.Run("nil gate allows everything", func(t *testing.T) {
+		var gate *Gate
+		assert.NoError(t, gate.Check(context.Background(), "sw-1"))
+	})
+}
+
+func TestGateWindow(t *testing.T) {
+	rackID := uuid.New()
+	nextOpening := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	inRack := []*types.Component{{ComponentID: "sw-1", RackID: rackID}}
+
+	testCases := map[string]struct {
+		client   *fakeClient
+		wantOpen bool
+		wantNext *time.Time
+		wantErr  bool
+	}{
+		"unknown component is open": {
+			client:   &fakeClient{},
+			wantOpen: true,
+		},
+		"open window": {
+			client: &fakeClient{
+				components: inRack,
+				status:     &types.MaintenanceWindowStatus{Governed: true, Open: true},
+			},
+			wantOpen: true,
+		},
+		"closed window returns next opening": {
+			client: &fakeClient{
+				components: inRack,
+				status:     &types.MaintenanceWindowStatus{Governed: true, NextOpening: &nextOpening},
+			},
+			wantNext: &nextOpening,
+		},
+		"closed window without scheduled opening": {
+			client: &fakeClient{
+				components: inRack,
+				status:     &types.MaintenanceWindowStatus{Governed: true},
+			},
+		},
+		"status error": {
+			client: &fakeClient{
+				components: inRack,
+				statusErr:  errors.New("unavailable"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			open, next, err := New(tc.client, types.ComponentTypeNVSwitch).Window(context.Background(), "sw-1")
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantOpen, open)
+			assert.Equal(t, tc.wantNext, next)
+		})
+	}
+}
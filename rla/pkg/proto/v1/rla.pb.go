@@ -446,6 +446,8 @@ const (
 	TaskStatus_TASK_STATUS_RUNNING   TaskStatus = 2
 	TaskStatus_TASK_STATUS_COMPLETED TaskStatus = 3
 	TaskStatus_TASK_STATUS_FAILED    TaskStatus = 4
+	TaskStatus_TASK_STATUS_WAITING   TaskStatus = 5 // queued until the rack's maintenance window opens
+	TaskStatus_TASK_STATUS_PAUSED    TaskStatus = 6 // held at a stage boundary while the maintenance window is closed
 )
 
 // Enum value maps for TaskStatus.
@@ -456,6 +458,8 @@ var (
 		2: "TASK_STATUS_RUNNING",
 		3: "TASK_STATUS_COMPLETED",
 		4: "TASK_STATUS_FAILED",
+		5: "TASK_STATUS_WAITING",
+		6: "TASK_STATUS_PAUSED",
 	}
 	TaskStatus_value = map[string]int32{
 		"TASK_STATUS_UNKNOWN":   0,
@@ -463,6 +467,8 @@ var (
 		"TASK_STATUS_RUNNING":   2,
 		"TASK_STATUS_COMPLETED": 3,
 		"TASK_STATUS_FAILED":    4,
+		"TASK_STATUS_WAITING":   5,
+		"TASK_STATUS_PAUSED":    6,
 	}
 )
 
@@ -1125,12 +1131,13 @@ func (x *Component) GetPowerState() string {
 }
 
 type Rack struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          *DeviceInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
-	Location      *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Components    []*Component           `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Info             *DeviceInfo            `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	Location         *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Components       []*Component           `protobuf:"bytes,3,rep,name=components,proto3" json:"components,omitempty"`
+	PowerBudgetWatts *float64               `protobuf:"fixed64,4,opt,name=power_budget_watts,json=powerBudgetWatts,proto3,oneof" json:"power_budget_watts,omitempty"` // Maximum sustained draw; unset or 0 = no budget
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *Rack) Reset() {
@@ -1184,6 +1191,13 @@ func (x *Rack) GetComponents() []*Component {
 	return nil
 }
 
+func (x *Rack) GetPowerBudgetWatts() float64 {
+	if x != nil && x.PowerBudgetWatts != nil {
+		return *x.PowerBudgetWatts
+	}
+	return 0
+}
+
 type Identifier struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1990,6 +2004,8 @@ type Task struct {
 	ExecutionId    string                 `protobuf:"bytes,7,opt,name=execution_id,json=executionId,proto3" json:"execution_id,omitempty"`
 	Status         TaskStatus             `protobuf:"varint,8,opt,name=status,proto3,enum=v1.TaskStatus" json:"status,omitempty"`
 	Message        string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	WindowOverride bool                   `protobuf:"varint,10,opt,name=window_override,json=windowOverride,proto3" json:"window_override,omitempty"` // task bypassed maintenance windows
+	OverrideReason string                 `protobuf:"bytes,11,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`  // justification given for the override
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -2087,6 +2103,20 @@ func (x *Task) GetMessage() string {
 	return ""
 }
 
+func (x *Task) GetWindowOverride() bool {
+	if x != nil {
+		return x.WindowOverride
+	}
+	return false
+}
+
+func (x *Task) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
 type CreateExpectedRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rack          *Rack                  `protobuf:"bytes,1,opt,name=rack,proto3" json:"rack,omitempty"`
@@ -3064,14 +3094,15 @@ func (x *GetRacksForNVLDomainResponse) GetRacks() []*Rack {
 }
 
 type UpgradeFirmwareRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`                // required: identifies components to upgrade
-	TargetVersion *string                `protobuf:"bytes,2,opt,name=target_version,json=targetVersion,proto3,oneof" json:"target_version,omitempty"` // optional: target firmware version
-	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`             // optional: scheduled start time
-	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`                   // optional: scheduled end time
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                // optional: task description
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec          *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`                            // required: identifies components to upgrade
+	TargetVersion       *string                `protobuf:"bytes,2,opt,name=target_version,json=targetVersion,proto3,oneof" json:"target_version,omitempty"`             // optional: target firmware version
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`                         // optional: scheduled start time
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`                               // optional: scheduled end time
+	Description         string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                                            // optional: task description
+	MaintenanceOverride *MaintenanceOverride   `protobuf:"bytes,6,opt,name=maintenance_override,json=maintenanceOverride,proto3" json:"maintenance_override,omitempty"` // optional: run outside the maintenance window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *UpgradeFirmwareRequest) Reset() {
@@ -3139,6 +3170,13 @@ func (x *UpgradeFirmwareRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpgradeFirmwareRequest) GetMaintenanceOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.MaintenanceOverride
+	}
+	return nil
+}
+
 // GetComponents - retrieves components from local database
 type GetComponentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3421,7 +3459,8 @@ type ComponentDiff struct {
 	Expected *Component `protobuf:"bytes,3,opt,name=expected,proto3" json:"expected,omitempty"`
 	Actual   *Component `protobuf:"bytes,4,opt,name=actual,proto3" json:"actual,omitempty"`
 	// Populated when type is DRIFT - lists the fields that differ
-	FieldDiffs    []*FieldDiff `protobuf:"bytes,5,rep,name=field_diffs,json=fieldDiffs,proto3" json:"field_diffs,omitempty"`
+	FieldDiffs    []*FieldDiff           `protobuf:"bytes,5,rep,name=field_diffs,json=fieldDiffs,proto3" json:"field_diffs,omitempty"`
+	FirstSeenAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"` // When the drift was first detected by the inventory loop
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -3491,6 +3530,13 @@ func (x *ComponentDiff) GetFieldDiffs() []*FieldDiff {
 	return nil
 }
 
+func (x *ComponentDiff) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
 type FieldDiff struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FieldName     string                 `protobuf:"bytes,1,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"` // e.g., "position.slot_id", "firmware_version"
@@ -3551,6 +3597,261 @@ func (x *FieldDiff) GetActualValue() string {
 	return ""
 }
 
+type ListDriftHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3,oneof" json:"target_spec,omitempty"` // Optional: components to list the history of; all drifts if not provided
+	FieldName     string                 `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`          // Optional: only drifts of this field
+	OpenOnly      bool                   `protobuf:"varint,3,opt,name=open_only,json=openOnly,proto3" json:"open_only,omitempty"`            // Only drifts that are still detected
+	Since         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`                                   // Optional: only drifts last seen at or after this time
+	Pagination    *Pagination            `protobuf:"bytes,5,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriftHistoryRequest) Reset() {
+	*x = ListDriftHistoryRequest{}
+	mi := &file_rla_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriftHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftHistoryRequest) ProtoMessage() {}
+
+func (x *ListDriftHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListDriftHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListDriftHistoryRequest) GetTargetSpec() *OperationTargetSpec {
+	if x != nil {
+		return x.TargetSpec
+	}
+	return nil
+}
+
+func (x *ListDriftHistoryRequest) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *ListDriftHistoryRequest) GetOpenOnly() bool {
+	if x != nil {
+		return x.OpenOnly
+	}
+	return false
+}
+
+func (x *ListDriftHistoryRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListDriftHistoryRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// DriftHistoryEntry is the lifetime of a single drift. Mismatches are tracked
+// per field. A drift that reappears after being resolved gets a new entry, so
+// several entries for the same component and field indicate flapping.
+type DriftHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ComponentId   string                 `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"` // Empty for ONLY_IN_ACTUAL
+	ExternalId    string                 `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Type          DiffType               `protobuf:"varint,4,opt,name=type,proto3,enum=v1.DiffType" json:"type,omitempty"`
+	FieldName     string                 `protobuf:"bytes,5,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"` // Empty unless type is DRIFT
+	ExpectedValue string                 `protobuf:"bytes,6,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`
+	ActualValue   string                 `protobuf:"bytes,7,opt,name=actual_value,json=actualValue,proto3" json:"actual_value,omitempty"`
+	FirstSeenAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ResolvedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"` // Unset while the drift is still detected
+	Remediation   string                 `protobuf:"bytes,11,opt,name=remediation,proto3" json:"remediation,omitempty"`                 // "accepted" or "alerted" if a policy acted on the drift
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriftHistoryEntry) Reset() {
+	*x = DriftHistoryEntry{}
+	mi := &file_rla_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriftHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriftHistoryEntry) ProtoMessage() {}
+
+func (x *DriftHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriftHistoryEntry.ProtoReflect.Descriptor instead.
+func (*DriftHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DriftHistoryEntry) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *DriftHistoryEntry) GetComponentId() string {
+	if x != nil {
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *DriftHistoryEntry) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *DriftHistoryEntry) GetType() DiffType {
+	if x != nil {
+		return x.Type
+	}
+	return DiffType_DIFF_TYPE_UNKNOWN
+}
+
+func (x *DriftHistoryEntry) GetFieldName() string {
+	if x != nil {
+		return x.FieldName
+	}
+	return ""
+}
+
+func (x *DriftHistoryEntry) GetExpectedValue() string {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return ""
+}
+
+func (x *DriftHistoryEntry) GetActualValue() string {
+	if x != nil {
+		return x.ActualValue
+	}
+	return ""
+}
+
+func (x *DriftHistoryEntry) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+func (x *DriftHistoryEntry) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *DriftHistoryEntry) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
+func (x *DriftHistoryEntry) GetRemediation() string {
+	if x != nil {
+		return x.Remediation
+	}
+	return ""
+}
+
+type ListDriftHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*DriftHistoryEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriftHistoryResponse) Reset() {
+	*x = ListDriftHistoryResponse{}
+	mi := &file_rla_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriftHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriftHistoryResponse) ProtoMessage() {}
+
+func (x *ListDriftHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriftHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListDriftHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListDriftHistoryResponse) GetEntries() []*DriftHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListDriftHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
 // AddComponent - add a single component to an existing rack
 type AddComponentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -3561,7 +3862,7 @@ type AddComponentRequest struct {
 
 func (x *AddComponentRequest) Reset() {
 	*x = AddComponentRequest{}
-	mi := &file_rla_proto_msgTypes[48]
+	mi := &file_rla_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3573,7 +3874,7 @@ func (x *AddComponentRequest) String() string {
 func (*AddComponentRequest) ProtoMessage() {}
 
 func (x *AddComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[48]
+	mi := &file_rla_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3586,7 +3887,7 @@ func (x *AddComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddComponentRequest.ProtoReflect.Descriptor instead.
 func (*AddComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{48}
+	return file_rla_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *AddComponentRequest) GetComponent() *Component {
@@ -3605,7 +3906,7 @@ type AddComponentResponse struct {
 
 func (x *AddComponentResponse) Reset() {
 	*x = AddComponentResponse{}
-	mi := &file_rla_proto_msgTypes[49]
+	mi := &file_rla_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3617,7 +3918,7 @@ func (x *AddComponentResponse) String() string {
 func (*AddComponentResponse) ProtoMessage() {}
 
 func (x *AddComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[49]
+	mi := &file_rla_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3630,7 +3931,7 @@ func (x *AddComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddComponentResponse.ProtoReflect.Descriptor instead.
 func (*AddComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{49}
+	return file_rla_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AddComponentResponse) GetComponent() *Component {
@@ -3650,7 +3951,7 @@ type DeleteComponentRequest struct {
 
 func (x *DeleteComponentRequest) Reset() {
 	*x = DeleteComponentRequest{}
-	mi := &file_rla_proto_msgTypes[50]
+	mi := &file_rla_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3662,7 +3963,7 @@ func (x *DeleteComponentRequest) String() string {
 func (*DeleteComponentRequest) ProtoMessage() {}
 
 func (x *DeleteComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[50]
+	mi := &file_rla_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3675,7 +3976,7 @@ func (x *DeleteComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteComponentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{50}
+	return file_rla_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *DeleteComponentRequest) GetId() *UUID {
@@ -3693,7 +3994,7 @@ type DeleteComponentResponse struct {
 
 func (x *DeleteComponentResponse) Reset() {
 	*x = DeleteComponentResponse{}
-	mi := &file_rla_proto_msgTypes[51]
+	mi := &file_rla_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3705,7 +4006,7 @@ func (x *DeleteComponentResponse) String() string {
 func (*DeleteComponentResponse) ProtoMessage() {}
 
 func (x *DeleteComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[51]
+	mi := &file_rla_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3718,7 +4019,7 @@ func (x *DeleteComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteComponentResponse.ProtoReflect.Descriptor instead.
 func (*DeleteComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{51}
+	return file_rla_proto_rawDescGZIP(), []int{54}
 }
 
 // PatchComponent - update a single component's fields
@@ -3735,7 +4036,7 @@ type PatchComponentRequest struct {
 
 func (x *PatchComponentRequest) Reset() {
 	*x = PatchComponentRequest{}
-	mi := &file_rla_proto_msgTypes[52]
+	mi := &file_rla_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3747,7 +4048,7 @@ func (x *PatchComponentRequest) String() string {
 func (*PatchComponentRequest) ProtoMessage() {}
 
 func (x *PatchComponentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[52]
+	mi := &file_rla_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,7 +4061,7 @@ func (x *PatchComponentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchComponentRequest.ProtoReflect.Descriptor instead.
 func (*PatchComponentRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{52}
+	return file_rla_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *PatchComponentRequest) GetId() *UUID {
@@ -3807,7 +4108,7 @@ type PatchComponentResponse struct {
 
 func (x *PatchComponentResponse) Reset() {
 	*x = PatchComponentResponse{}
-	mi := &file_rla_proto_msgTypes[53]
+	mi := &file_rla_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3819,7 +4120,7 @@ func (x *PatchComponentResponse) String() string {
 func (*PatchComponentResponse) ProtoMessage() {}
 
 func (x *PatchComponentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[53]
+	mi := &file_rla_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3832,7 +4133,7 @@ func (x *PatchComponentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PatchComponentResponse.ProtoReflect.Descriptor instead.
 func (*PatchComponentResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{53}
+	return file_rla_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *PatchComponentResponse) GetComponent() *Component {
@@ -3851,7 +4152,7 @@ type SubmitTaskResponse struct {
 
 func (x *SubmitTaskResponse) Reset() {
 	*x = SubmitTaskResponse{}
-	mi := &file_rla_proto_msgTypes[54]
+	mi := &file_rla_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3863,7 +4164,7 @@ func (x *SubmitTaskResponse) String() string {
 func (*SubmitTaskResponse) ProtoMessage() {}
 
 func (x *SubmitTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[54]
+	mi := &file_rla_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3876,7 +4177,7 @@ func (x *SubmitTaskResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubmitTaskResponse.ProtoReflect.Descriptor instead.
 func (*SubmitTaskResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{54}
+	return file_rla_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *SubmitTaskResponse) GetTaskIds() []*UUID {
@@ -3886,17 +4187,65 @@ func (x *SubmitTaskResponse) GetTaskIds() []*UUID {
 	return nil
 }
 
-type PowerOnRackRequest struct {
+// MaintenanceOverride runs a disruptive task immediately even if the rack's
+// maintenance window is closed. The override and reason are recorded on the
+// task and raised as an alert.
+type MaintenanceOverride struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                 // optional task description
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"` // required
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *MaintenanceOverride) Reset() {
+	*x = MaintenanceOverride{}
+	mi := &file_rla_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MaintenanceOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MaintenanceOverride) ProtoMessage() {}
+
+func (x *MaintenanceOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MaintenanceOverride.ProtoReflect.Descriptor instead.
+func (*MaintenanceOverride) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MaintenanceOverride) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PowerOnRackRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec          *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`                            // Flexible targeting: rack(s) with optional type filter, or specific components
+	Description         string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                            // optional task description
+	MaintenanceOverride *MaintenanceOverride   `protobuf:"bytes,3,opt,name=maintenance_override,json=maintenanceOverride,proto3" json:"maintenance_override,omitempty"` // optional: run outside the maintenance window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
 func (x *PowerOnRackRequest) Reset() {
 	*x = PowerOnRackRequest{}
-	mi := &file_rla_proto_msgTypes[55]
+	mi := &file_rla_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3908,7 +4257,7 @@ func (x *PowerOnRackRequest) String() string {
 func (*PowerOnRackRequest) ProtoMessage() {}
 
 func (x *PowerOnRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[55]
+	mi := &file_rla_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3921,7 +4270,7 @@ func (x *PowerOnRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerOnRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerOnRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{55}
+	return file_rla_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *PowerOnRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -3938,18 +4287,26 @@ func (x *PowerOnRackRequest) GetDescription() string {
 	return ""
 }
 
+func (x *PowerOnRackRequest) GetMaintenanceOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.MaintenanceOverride
+	}
+	return nil
+}
+
 type PowerOffRackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
-	Forced        bool                   `protobuf:"varint,2,opt,name=forced,proto3" json:"forced,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // optional task description
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec          *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
+	Forced              bool                   `protobuf:"varint,2,opt,name=forced,proto3" json:"forced,omitempty"`
+	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                            // optional task description
+	MaintenanceOverride *MaintenanceOverride   `protobuf:"bytes,4,opt,name=maintenance_override,json=maintenanceOverride,proto3" json:"maintenance_override,omitempty"` // optional: run outside the maintenance window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *PowerOffRackRequest) Reset() {
 	*x = PowerOffRackRequest{}
-	mi := &file_rla_proto_msgTypes[56]
+	mi := &file_rla_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3961,7 +4318,7 @@ func (x *PowerOffRackRequest) String() string {
 func (*PowerOffRackRequest) ProtoMessage() {}
 
 func (x *PowerOffRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[56]
+	mi := &file_rla_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3974,7 +4331,7 @@ func (x *PowerOffRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerOffRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerOffRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{56}
+	return file_rla_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *PowerOffRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -3998,18 +4355,26 @@ func (x *PowerOffRackRequest) GetDescription() string {
 	return ""
 }
 
+func (x *PowerOffRackRequest) GetMaintenanceOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.MaintenanceOverride
+	}
+	return nil
+}
+
 type PowerResetRackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
-	Forced        bool                   `protobuf:"varint,2,opt,name=forced,proto3" json:"forced,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // optional task description
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec          *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Flexible targeting: rack(s) with optional type filter, or specific components
+	Forced              bool                   `protobuf:"varint,2,opt,name=forced,proto3" json:"forced,omitempty"`
+	Description         string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                                            // optional task description
+	MaintenanceOverride *MaintenanceOverride   `protobuf:"bytes,4,opt,name=maintenance_override,json=maintenanceOverride,proto3" json:"maintenance_override,omitempty"` // optional: run outside the maintenance window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *PowerResetRackRequest) Reset() {
 	*x = PowerResetRackRequest{}
-	mi := &file_rla_proto_msgTypes[57]
+	mi := &file_rla_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4021,7 +4386,7 @@ func (x *PowerResetRackRequest) String() string {
 func (*PowerResetRackRequest) ProtoMessage() {}
 
 func (x *PowerResetRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[57]
+	mi := &file_rla_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4034,7 +4399,7 @@ func (x *PowerResetRackRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PowerResetRackRequest.ProtoReflect.Descriptor instead.
 func (*PowerResetRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{57}
+	return file_rla_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *PowerResetRackRequest) GetTargetSpec() *OperationTargetSpec {
@@ -4058,29 +4423,35 @@ func (x *PowerResetRackRequest) GetDescription() string {
 	return ""
 }
 
-type BringUpRackRequest struct {
+func (x *PowerResetRackRequest) GetMaintenanceOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.MaintenanceOverride
+	}
+	return nil
+}
+
+type GetPowerStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Target racks for bring-up
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                 // optional task description
+	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Rack(s) with optional type filter, or specific components
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BringUpRackRequest) Reset() {
-	*x = BringUpRackRequest{}
-	mi := &file_rla_proto_msgTypes[58]
+func (x *GetPowerStatsRequest) Reset() {
+	*x = GetPowerStatsRequest{}
+	mi := &file_rla_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BringUpRackRequest) String() string {
+func (x *GetPowerStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BringUpRackRequest) ProtoMessage() {}
+func (*GetPowerStatsRequest) ProtoMessage() {}
 
-func (x *BringUpRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[58]
+func (x *GetPowerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4091,49 +4462,48 @@ func (x *BringUpRackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BringUpRackRequest.ProtoReflect.Descriptor instead.
-func (*BringUpRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use GetPowerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPowerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *BringUpRackRequest) GetTargetSpec() *OperationTargetSpec {
+func (x *GetPowerStatsRequest) GetTargetSpec() *OperationTargetSpec {
 	if x != nil {
 		return x.TargetSpec
 	}
 	return nil
 }
 
-func (x *BringUpRackRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type IngestRackRequest struct {
+// ComponentPowerStats is the latest power reading of a single component.
+// Compute trays are read through Carbide, NVLink switches through NV-Switch
+// Manager and power shelves through PSM.
+type ComponentPowerStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Target racks for ingestion
-	Filters       []*Filter              `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`                         // Filter conditions for component queries (e.g. by type, name)
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // optional task description
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ComponentId   string                 `protobuf:"bytes,2,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"` // External ID: Carbide machine ID, NSM switch UUID or PMC MAC
+	Type          ComponentType          `protobuf:"varint,3,opt,name=type,proto3,enum=v1.ComponentType" json:"type,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,4,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	Watts         float64                `protobuf:"fixed64,5,opt,name=watts,proto3" json:"watts,omitempty"`        // Consumed power; output power for power shelves
+	Available     bool                   `protobuf:"varint,6,opt,name=available,proto3" json:"available,omitempty"` // False if the source service returned no reading
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IngestRackRequest) Reset() {
-	*x = IngestRackRequest{}
-	mi := &file_rla_proto_msgTypes[59]
+func (x *ComponentPowerStats) Reset() {
+	*x = ComponentPowerStats{}
+	mi := &file_rla_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IngestRackRequest) String() string {
+func (x *ComponentPowerStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IngestRackRequest) ProtoMessage() {}
+func (*ComponentPowerStats) ProtoMessage() {}
 
-func (x *IngestRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[59]
+func (x *ComponentPowerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4144,56 +4514,84 @@ func (x *IngestRackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IngestRackRequest.ProtoReflect.Descriptor instead.
-func (*IngestRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ComponentPowerStats.ProtoReflect.Descriptor instead.
+func (*ComponentPowerStats) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *IngestRackRequest) GetTargetSpec() *OperationTargetSpec {
+func (x *ComponentPowerStats) GetId() *UUID {
 	if x != nil {
-		return x.TargetSpec
+		return x.Id
 	}
 	return nil
 }
 
-func (x *IngestRackRequest) GetFilters() []*Filter {
+func (x *ComponentPowerStats) GetComponentId() string {
 	if x != nil {
-		return x.Filters
+		return x.ComponentId
+	}
+	return ""
+}
+
+func (x *ComponentPowerStats) GetType() ComponentType {
+	if x != nil {
+		return x.Type
+	}
+	return ComponentType_COMPONENT_TYPE_UNKNOWN
+}
+
+func (x *ComponentPowerStats) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *IngestRackRequest) GetDescription() string {
+func (x *ComponentPowerStats) GetWatts() float64 {
 	if x != nil {
-		return x.Description
+		return x.Watts
 	}
-	return ""
+	return 0
 }
 
-type ListTasksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
-	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ComponentPowerStats) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
 }
 
-func (x *ListTasksRequest) Reset() {
-	*x = ListTasksRequest{}
-	mi := &file_rla_proto_msgTypes[60]
+// RackPowerStats aggregates the readings of the requested components of a rack.
+type RackPowerStats struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RackId                *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RackName              string                 `protobuf:"bytes,2,opt,name=rack_name,json=rackName,proto3" json:"rack_name,omitempty"`
+	NvlDomainId           *UUID                  `protobuf:"bytes,3,opt,name=nvl_domain_id,json=nvlDomainId,proto3" json:"nvl_domain_id,omitempty"` // Unset if the rack is not in an NVL domain
+	ComputeWatts          float64                `protobuf:"fixed64,4,opt,name=compute_watts,json=computeWatts,proto3" json:"compute_watts,omitempty"`
+	NvlswitchWatts        float64                `protobuf:"fixed64,5,opt,name=nvlswitch_watts,json=nvlswitchWatts,proto3" json:"nvlswitch_watts,omitempty"`
+	PowershelfOutputWatts float64                `protobuf:"fixed64,6,opt,name=powershelf_output_watts,json=powershelfOutputWatts,proto3" json:"powershelf_output_watts,omitempty"`
+	DrawWatts             float64                `protobuf:"fixed64,7,opt,name=draw_watts,json=drawWatts,proto3" json:"draw_watts,omitempty"` // Power shelf output if reported, else compute + NVLink switch
+	BudgetWatts           *float64               `protobuf:"fixed64,8,opt,name=budget_watts,json=budgetWatts,proto3,oneof" json:"budget_watts,omitempty"`
+	UnavailableComponents int32                  `protobuf:"varint,9,opt,name=unavailable_components,json=unavailableComponents,proto3" json:"unavailable_components,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RackPowerStats) Reset() {
+	*x = RackPowerStats{}
+	mi := &file_rla_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksRequest) String() string {
+func (x *RackPowerStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksRequest) ProtoMessage() {}
+func (*RackPowerStats) ProtoMessage() {}
 
-func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[60]
+func (x *RackPowerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4204,55 +4602,100 @@ func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListTasksRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use RackPowerStats.ProtoReflect.Descriptor instead.
+func (*RackPowerStats) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListTasksRequest) GetRackId() *UUID {
+func (x *RackPowerStats) GetRackId() *UUID {
 	if x != nil {
 		return x.RackId
 	}
 	return nil
 }
 
-func (x *ListTasksRequest) GetActiveOnly() bool {
+func (x *RackPowerStats) GetRackName() string {
 	if x != nil {
-		return x.ActiveOnly
+		return x.RackName
 	}
-	return false
+	return ""
 }
 
-func (x *ListTasksRequest) GetPagination() *Pagination {
+func (x *RackPowerStats) GetNvlDomainId() *UUID {
 	if x != nil {
-		return x.Pagination
+		return x.NvlDomainId
 	}
 	return nil
 }
 
-type ListTasksResponse struct {
+func (x *RackPowerStats) GetComputeWatts() float64 {
+	if x != nil {
+		return x.ComputeWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStats) GetNvlswitchWatts() float64 {
+	if x != nil {
+		return x.NvlswitchWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStats) GetPowershelfOutputWatts() float64 {
+	if x != nil {
+		return x.PowershelfOutputWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStats) GetDrawWatts() float64 {
+	if x != nil {
+		return x.DrawWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStats) GetBudgetWatts() float64 {
+	if x != nil && x.BudgetWatts != nil {
+		return *x.BudgetWatts
+	}
+	return 0
+}
+
+func (x *RackPowerStats) GetUnavailableComponents() int32 {
+	if x != nil {
+		return x.UnavailableComponents
+	}
+	return 0
+}
+
+// NVLDomainPowerStats aggregates the racks of an NVL domain.
+type NVLDomainPowerStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NvlDomain     *Identifier            `protobuf:"bytes,1,opt,name=nvl_domain,json=nvlDomain,proto3" json:"nvl_domain,omitempty"`
+	DrawWatts     float64                `protobuf:"fixed64,2,opt,name=draw_watts,json=drawWatts,proto3" json:"draw_watts,omitempty"`
+	BudgetWatts   float64                `protobuf:"fixed64,3,opt,name=budget_watts,json=budgetWatts,proto3" json:"budget_watts,omitempty"` // Sum of the budgets of racks that have one
+	RackCount     int32                  `protobuf:"varint,4,opt,name=rack_count,json=rackCount,proto3" json:"rack_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTasksResponse) Reset() {
-	*x = ListTasksResponse{}
-	mi := &file_rla_proto_msgTypes[61]
+func (x *NVLDomainPowerStats) Reset() {
+	*x = NVLDomainPowerStats{}
+	mi := &file_rla_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTasksResponse) String() string {
+func (x *NVLDomainPowerStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTasksResponse) ProtoMessage() {}
+func (*NVLDomainPowerStats) ProtoMessage() {}
 
-func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[61]
+func (x *NVLDomainPowerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4263,47 +4706,64 @@ func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListTasksResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use NVLDomainPowerStats.ProtoReflect.Descriptor instead.
+func (*NVLDomainPowerStats) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ListTasksResponse) GetTasks() []*Task {
+func (x *NVLDomainPowerStats) GetNvlDomain() *Identifier {
 	if x != nil {
-		return x.Tasks
+		return x.NvlDomain
 	}
 	return nil
 }
 
-func (x *ListTasksResponse) GetTotal() int32 {
+func (x *NVLDomainPowerStats) GetDrawWatts() float64 {
 	if x != nil {
-		return x.Total
+		return x.DrawWatts
 	}
 	return 0
 }
 
-type GetTasksByIDsRequest struct {
+func (x *NVLDomainPowerStats) GetBudgetWatts() float64 {
+	if x != nil {
+		return x.BudgetWatts
+	}
+	return 0
+}
+
+func (x *NVLDomainPowerStats) GetRackCount() int32 {
+	if x != nil {
+		return x.RackCount
+	}
+	return 0
+}
+
+type GetPowerStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskIds       []*UUID                `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	Components    []*ComponentPowerStats `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	Racks         []*RackPowerStats      `protobuf:"bytes,2,rep,name=racks,proto3" json:"racks,omitempty"`
+	NvlDomains    []*NVLDomainPowerStats `protobuf:"bytes,3,rep,name=nvl_domains,json=nvlDomains,proto3" json:"nvl_domains,omitempty"`
+	CollectedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksByIDsRequest) Reset() {
-	*x = GetTasksByIDsRequest{}
-	mi := &file_rla_proto_msgTypes[62]
+func (x *GetPowerStatsResponse) Reset() {
+	*x = GetPowerStatsResponse{}
+	mi := &file_rla_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksByIDsRequest) String() string {
+func (x *GetPowerStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksByIDsRequest) ProtoMessage() {}
+func (*GetPowerStatsResponse) ProtoMessage() {}
 
-func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[62]
+func (x *GetPowerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4314,40 +4774,983 @@ func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksByIDsRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksByIDsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetPowerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPowerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *GetTasksByIDsRequest) GetTaskIds() []*UUID {
+func (x *GetPowerStatsResponse) GetComponents() []*ComponentPowerStats {
 	if x != nil {
-		return x.TaskIds
+		return x.Components
 	}
 	return nil
 }
 
-type GetTasksByIDsResponse struct {
+func (x *GetPowerStatsResponse) GetRacks() []*RackPowerStats {
+	if x != nil {
+		return x.Racks
+	}
+	return nil
+}
+
+func (x *GetPowerStatsResponse) GetNvlDomains() []*NVLDomainPowerStats {
+	if x != nil {
+		return x.NvlDomains
+	}
+	return nil
+}
+
+func (x *GetPowerStatsResponse) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+type BringUpRackRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec          *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"`                            // Target racks for bring-up
+	Description         string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`                                            // optional task description
+	MaintenanceOverride *MaintenanceOverride   `protobuf:"bytes,3,opt,name=maintenance_override,json=maintenanceOverride,proto3" json:"maintenance_override,omitempty"` // optional: run outside the maintenance window
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BringUpRackRequest) Reset() {
+	*x = BringUpRackRequest{}
+	mi := &file_rla_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BringUpRackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BringUpRackRequest) ProtoMessage() {}
+
+func (x *BringUpRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BringUpRackRequest.ProtoReflect.Descriptor instead.
+func (*BringUpRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BringUpRackRequest) GetTargetSpec() *OperationTargetSpec {
+	if x != nil {
+		return x.TargetSpec
+	}
+	return nil
+}
+
+func (x *BringUpRackRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BringUpRackRequest) GetMaintenanceOverride() *MaintenanceOverride {
+	if x != nil {
+		return x.MaintenanceOverride
+	}
+	return nil
+}
+
+type IngestRackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetSpec    *OperationTargetSpec   `protobuf:"bytes,1,opt,name=target_spec,json=targetSpec,proto3" json:"target_spec,omitempty"` // Target racks for ingestion
+	Filters       []*Filter              `protobuf:"bytes,2,rep,name=filters,proto3" json:"filters,omitempty"`                         // Filter conditions for component queries (e.g. by type, name)
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`                 // optional task description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestRackRequest) Reset() {
+	*x = IngestRackRequest{}
+	mi := &file_rla_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestRackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestRackRequest) ProtoMessage() {}
+
+func (x *IngestRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestRackRequest.ProtoReflect.Descriptor instead.
+func (*IngestRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *IngestRackRequest) GetTargetSpec() *OperationTargetSpec {
+	if x != nil {
+		return x.TargetSpec
+	}
+	return nil
+}
+
+func (x *IngestRackRequest) GetFilters() []*Filter {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *IngestRackRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	Pagination    *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3,oneof" json:"pagination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksRequest) Reset() {
+	*x = ListTasksRequest{}
+	mi := &file_rla_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksRequest) ProtoMessage() {}
+
+func (x *ListTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListTasksRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListTasksRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *ListTasksRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *ListTasksRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListTasksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTasksResponse) Reset() {
+	*x = ListTasksResponse{}
+	mi := &file_rla_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTasksResponse) ProtoMessage() {}
+
+func (x *ListTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListTasksResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ListTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTasksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTasksByIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskIds       []*UUID                `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksByIDsRequest) Reset() {
+	*x = GetTasksByIDsRequest{}
+	mi := &file_rla_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksByIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksByIDsRequest) ProtoMessage() {}
+
+func (x *GetTasksByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetTasksByIDsRequest) GetTaskIds() []*UUID {
+	if x != nil {
+		return x.TaskIds
+	}
+	return nil
+}
+
+type GetTasksByIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksByIDsResponse) Reset() {
+	*x = GetTasksByIDsResponse{}
+	mi := &file_rla_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksByIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksByIDsResponse) ProtoMessage() {}
+
+func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetTasksByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetTasksByIDsResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+// Version API messages
+type VersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	mi := &file_rla_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{73}
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // e.g., v2025.11.19
+	BuildTime     string                 `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // e.g., 2025-01-27T10:30:00Z
+	GitCommit     string                 `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"` // e.g., abc1234
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	mi := &file_rla_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *BuildInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetBuildTime() string {
+	if x != nil {
+		return x.BuildTime
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetGitCommit() string {
+	if x != nil {
+		return x.GitCommit
+	}
+	return ""
+}
+
+type OperationRule struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	OperationType      OperationType          `protobuf:"varint,4,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode      string                 `protobuf:"bytes,5,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
+	RuleDefinitionJson string                 `protobuf:"bytes,6,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	IsDefault          bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *OperationRule) Reset() {
+	*x = OperationRule{}
+	mi := &file_rla_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OperationRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRule) ProtoMessage() {}
+
+func (x *OperationRule) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRule.ProtoReflect.Descriptor instead.
+func (*OperationRule) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *OperationRule) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *OperationRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OperationRule) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *OperationRule) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+func (x *OperationRule) GetRuleDefinitionJson() string {
+	if x != nil {
+		return x.RuleDefinitionJson
+	}
+	return ""
+}
+
+func (x *OperationRule) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *OperationRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OperationRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateOperationRuleRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	OperationType      OperationType          `protobuf:"varint,3,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode      string                 `protobuf:"bytes,4,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
+	RuleDefinitionJson string                 `protobuf:"bytes,5,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	IsDefault          bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateOperationRuleRequest) Reset() {
+	*x = CreateOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOperationRuleRequest) ProtoMessage() {}
+
+func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateOperationRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *CreateOperationRuleRequest) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetRuleDefinitionJson() string {
+	if x != nil {
+		return x.RuleDefinitionJson
+	}
+	return ""
+}
+
+func (x *CreateOperationRuleRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type CreateOperationRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOperationRuleResponse) Reset() {
+	*x = CreateOperationRuleResponse{}
+	mi := &file_rla_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOperationRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOperationRuleResponse) ProtoMessage() {}
+
+func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOperationRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateOperationRuleResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CreateOperationRuleResponse) GetId() *UUID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type UpdateOperationRuleRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RuleId             *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Name               *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description        *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	RuleDefinitionJson *string                `protobuf:"bytes,4,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3,oneof" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *UpdateOperationRuleRequest) Reset() {
+	*x = UpdateOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateOperationRuleRequest) ProtoMessage() {}
+
+func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+func (x *UpdateOperationRuleRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateOperationRuleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateOperationRuleRequest) GetRuleDefinitionJson() string {
+	if x != nil && x.RuleDefinitionJson != nil {
+		return *x.RuleDefinitionJson
+	}
+	return ""
+}
+
+type DeleteOperationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteOperationRuleRequest) Reset() {
+	*x = DeleteOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteOperationRuleRequest) ProtoMessage() {}
+
+func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *DeleteOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type SetRuleAsDefaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRuleAsDefaultRequest) Reset() {
+	*x = SetRuleAsDefaultRequest{}
+	mi := &file_rla_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRuleAsDefaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRuleAsDefaultRequest) ProtoMessage() {}
+
+func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRuleAsDefaultRequest.ProtoReflect.Descriptor instead.
+func (*SetRuleAsDefaultRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetRuleAsDefaultRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type GetOperationRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOperationRuleRequest) Reset() {
+	*x = GetOperationRuleRequest{}
+	mi := &file_rla_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOperationRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOperationRuleRequest) ProtoMessage() {}
+
+func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOperationRuleRequest.ProtoReflect.Descriptor instead.
+func (*GetOperationRuleRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetOperationRuleRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type ListOperationRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperationType *OperationType         `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType,oneof" json:"operation_type,omitempty"`
+	IsDefault     *bool                  `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
+	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOperationRulesRequest) Reset() {
+	*x = ListOperationRulesRequest{}
+	mi := &file_rla_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOperationRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOperationRulesRequest) ProtoMessage() {}
+
+func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOperationRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListOperationRulesRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListOperationRulesRequest) GetOperationType() OperationType {
+	if x != nil && x.OperationType != nil {
+		return *x.OperationType
+	}
+	return OperationType_OPERATION_TYPE_UNKNOWN
+}
+
+func (x *ListOperationRulesRequest) GetIsDefault() bool {
+	if x != nil && x.IsDefault != nil {
+		return *x.IsDefault
+	}
+	return false
+}
+
+func (x *ListOperationRulesRequest) GetOffset() int32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *ListOperationRulesRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type ListOperationRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*OperationRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTasksByIDsResponse) Reset() {
-	*x = GetTasksByIDsResponse{}
-	mi := &file_rla_proto_msgTypes[63]
+func (x *ListOperationRulesResponse) Reset() {
+	*x = ListOperationRulesResponse{}
+	mi := &file_rla_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksByIDsResponse) String() string {
+func (x *ListOperationRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksByIDsResponse) ProtoMessage() {}
+func (*ListOperationRulesResponse) ProtoMessage() {}
 
-func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[63]
+func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4358,40 +5761,48 @@ func (x *GetTasksByIDsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksByIDsResponse.ProtoReflect.Descriptor instead.
-func (*GetTasksByIDsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use ListOperationRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListOperationRulesResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetTasksByIDsResponse) GetTasks() []*Task {
+func (x *ListOperationRulesResponse) GetRules() []*OperationRule {
 	if x != nil {
-		return x.Tasks
+		return x.Rules
 	}
 	return nil
 }
 
-// Version API messages
-type VersionRequest struct {
+func (x *ListOperationRulesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type AssociateRuleWithRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RuleId        *UUID                  `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VersionRequest) Reset() {
-	*x = VersionRequest{}
-	mi := &file_rla_proto_msgTypes[64]
+func (x *AssociateRuleWithRackRequest) Reset() {
+	*x = AssociateRuleWithRackRequest{}
+	mi := &file_rla_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VersionRequest) String() string {
+func (x *AssociateRuleWithRackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionRequest) ProtoMessage() {}
+func (*AssociateRuleWithRackRequest) ProtoMessage() {}
 
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[64]
+func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4402,35 +5813,49 @@ func (x *VersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use AssociateRuleWithRackRequest.ProtoReflect.Descriptor instead.
+func (*AssociateRuleWithRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{84}
 }
 
-type BuildInfo struct {
+func (x *AssociateRuleWithRackRequest) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
+}
+
+func (x *AssociateRuleWithRackRequest) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
+	}
+	return nil
+}
+
+type DisassociateRuleFromRackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`                      // e.g., v2025.11.19
-	BuildTime     string                 `protobuf:"bytes,2,opt,name=build_time,json=buildTime,proto3" json:"build_time,omitempty"` // e.g., 2025-01-27T10:30:00Z
-	GitCommit     string                 `protobuf:"bytes,3,opt,name=git_commit,json=gitCommit,proto3" json:"git_commit,omitempty"` // e.g., abc1234
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BuildInfo) Reset() {
-	*x = BuildInfo{}
-	mi := &file_rla_proto_msgTypes[65]
+func (x *DisassociateRuleFromRackRequest) Reset() {
+	*x = DisassociateRuleFromRackRequest{}
+	mi := &file_rla_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BuildInfo) String() string {
+func (x *DisassociateRuleFromRackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BuildInfo) ProtoMessage() {}
+func (*DisassociateRuleFromRackRequest) ProtoMessage() {}
 
-func (x *BuildInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[65]
+func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4441,62 +5866,56 @@ func (x *BuildInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
-func (*BuildInfo) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use DisassociateRuleFromRackRequest.ProtoReflect.Descriptor instead.
+func (*DisassociateRuleFromRackRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *BuildInfo) GetVersion() string {
+func (x *DisassociateRuleFromRackRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Version
+		return x.RackId
 	}
-	return ""
+	return nil
 }
 
-func (x *BuildInfo) GetBuildTime() string {
+func (x *DisassociateRuleFromRackRequest) GetOperationType() OperationType {
 	if x != nil {
-		return x.BuildTime
+		return x.OperationType
 	}
-	return ""
+	return OperationType_OPERATION_TYPE_UNKNOWN
 }
 
-func (x *BuildInfo) GetGitCommit() string {
+func (x *DisassociateRuleFromRackRequest) GetOperationCode() string {
 	if x != nil {
-		return x.GitCommit
+		return x.OperationCode
 	}
 	return ""
 }
 
-type OperationRule struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	OperationType      OperationType          `protobuf:"varint,4,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode      string                 `protobuf:"bytes,5,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
-	RuleDefinitionJson string                 `protobuf:"bytes,6,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	IsDefault          bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetRackRuleAssociationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OperationRule) Reset() {
-	*x = OperationRule{}
-	mi := &file_rla_proto_msgTypes[66]
+func (x *GetRackRuleAssociationRequest) Reset() {
+	*x = GetRackRuleAssociationRequest{}
+	mi := &file_rla_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OperationRule) String() string {
+func (x *GetRackRuleAssociationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRule) ProtoMessage() {}
+func (*GetRackRuleAssociationRequest) ProtoMessage() {}
 
-func (x *OperationRule) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[66]
+func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4507,180 +5926,98 @@ func (x *OperationRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRule.ProtoReflect.Descriptor instead.
-func (*OperationRule) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use GetRackRuleAssociationRequest.ProtoReflect.Descriptor instead.
+func (*GetRackRuleAssociationRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *OperationRule) GetId() *UUID {
+func (x *GetRackRuleAssociationRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Id
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *OperationRule) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *OperationRule) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *OperationRule) GetOperationType() OperationType {
+func (x *GetRackRuleAssociationRequest) GetOperationType() OperationType {
 	if x != nil {
 		return x.OperationType
 	}
 	return OperationType_OPERATION_TYPE_UNKNOWN
 }
 
-func (x *OperationRule) GetOperationCode() string {
+func (x *GetRackRuleAssociationRequest) GetOperationCode() string {
 	if x != nil {
 		return x.OperationCode
 	}
 	return ""
 }
 
-func (x *OperationRule) GetRuleDefinitionJson() string {
-	if x != nil {
-		return x.RuleDefinitionJson
-	}
-	return ""
-}
-
-func (x *OperationRule) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-func (x *OperationRule) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *OperationRule) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type CreateOperationRuleRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description        string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	OperationType      OperationType          `protobuf:"varint,3,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode      string                 `protobuf:"bytes,4,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"`                  // Specific operation code (e.g., "power_on", "upgrade")
-	RuleDefinitionJson string                 `protobuf:"bytes,5,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	IsDefault          bool                   `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetRackRuleAssociationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Empty if no association exists
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOperationRuleRequest) Reset() {
-	*x = CreateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[67]
+func (x *GetRackRuleAssociationResponse) Reset() {
+	*x = GetRackRuleAssociationResponse{}
+	mi := &file_rla_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOperationRuleRequest) String() string {
+func (x *GetRackRuleAssociationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOperationRuleRequest) ProtoMessage() {}
-
-func (x *CreateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[67]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*CreateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{67}
-}
-
-func (x *CreateOperationRuleRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateOperationRuleRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *CreateOperationRuleRequest) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
-}
-
-func (x *CreateOperationRuleRequest) GetOperationCode() string {
+func (*GetRackRuleAssociationResponse) ProtoMessage() {}
+
+func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[87]
 	if x != nil {
-		return x.OperationCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateOperationRuleRequest) GetRuleDefinitionJson() string {
-	if x != nil {
-		return x.RuleDefinitionJson
-	}
-	return ""
+// Deprecated: Use GetRackRuleAssociationResponse.ProtoReflect.Descriptor instead.
+func (*GetRackRuleAssociationResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CreateOperationRuleRequest) GetIsDefault() bool {
+func (x *GetRackRuleAssociationResponse) GetRuleId() *UUID {
 	if x != nil {
-		return x.IsDefault
+		return x.RuleId
 	}
-	return false
+	return nil
 }
 
-type CreateOperationRuleResponse struct {
+type ListRackRuleAssociationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateOperationRuleResponse) Reset() {
-	*x = CreateOperationRuleResponse{}
-	mi := &file_rla_proto_msgTypes[68]
+func (x *ListRackRuleAssociationsRequest) Reset() {
+	*x = ListRackRuleAssociationsRequest{}
+	mi := &file_rla_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateOperationRuleResponse) String() string {
+func (x *ListRackRuleAssociationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateOperationRuleResponse) ProtoMessage() {}
+func (*ListRackRuleAssociationsRequest) ProtoMessage() {}
 
-func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[68]
+func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4691,43 +6028,45 @@ func (x *CreateOperationRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateOperationRuleResponse.ProtoReflect.Descriptor instead.
-func (*CreateOperationRuleResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use ListRackRuleAssociationsRequest.ProtoReflect.Descriptor instead.
+func (*ListRackRuleAssociationsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *CreateOperationRuleResponse) GetId() *UUID {
+func (x *ListRackRuleAssociationsRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.Id
+		return x.RackId
 	}
 	return nil
 }
 
-type UpdateOperationRuleRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	RuleId             *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	Name               *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	Description        *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	RuleDefinitionJson *string                `protobuf:"bytes,4,opt,name=rule_definition_json,json=ruleDefinitionJson,proto3,oneof" json:"rule_definition_json,omitempty"` // JSON-encoded RuleDefinition
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type RackRuleAssociation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
+	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code (e.g., "power_on", "upgrade")
+	RuleId        *UUID                  `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateOperationRuleRequest) Reset() {
-	*x = UpdateOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[69]
+func (x *RackRuleAssociation) Reset() {
+	*x = RackRuleAssociation{}
+	mi := &file_rla_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateOperationRuleRequest) String() string {
+func (x *RackRuleAssociation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateOperationRuleRequest) ProtoMessage() {}
+func (*RackRuleAssociation) ProtoMessage() {}
 
-func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[69]
+func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4738,61 +6077,75 @@ func (x *UpdateOperationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{69}
+// Deprecated: Use RackRuleAssociation.ProtoReflect.Descriptor instead.
+func (*RackRuleAssociation) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpdateOperationRuleRequest) GetRuleId() *UUID {
+func (x *RackRuleAssociation) GetRackId() *UUID {
 	if x != nil {
-		return x.RuleId
+		return x.RackId
 	}
 	return nil
 }
 
-func (x *UpdateOperationRuleRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
+func (x *RackRuleAssociation) GetOperationType() OperationType {
+	if x != nil {
+		return x.OperationType
 	}
-	return ""
+	return OperationType_OPERATION_TYPE_UNKNOWN
 }
 
-func (x *UpdateOperationRuleRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *RackRuleAssociation) GetOperationCode() string {
+	if x != nil {
+		return x.OperationCode
 	}
 	return ""
 }
 
-func (x *UpdateOperationRuleRequest) GetRuleDefinitionJson() string {
-	if x != nil && x.RuleDefinitionJson != nil {
-		return *x.RuleDefinitionJson
+func (x *RackRuleAssociation) GetRuleId() *UUID {
+	if x != nil {
+		return x.RuleId
 	}
-	return ""
+	return nil
 }
 
-type DeleteOperationRuleRequest struct {
+func (x *RackRuleAssociation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RackRuleAssociation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListRackRuleAssociationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Associations  []*RackRuleAssociation `protobuf:"bytes,1,rep,name=associations,proto3" json:"associations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteOperationRuleRequest) Reset() {
-	*x = DeleteOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[70]
+func (x *ListRackRuleAssociationsResponse) Reset() {
+	*x = ListRackRuleAssociationsResponse{}
+	mi := &file_rla_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteOperationRuleRequest) String() string {
+func (x *ListRackRuleAssociationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteOperationRuleRequest) ProtoMessage() {}
+func (*ListRackRuleAssociationsResponse) ProtoMessage() {}
 
-func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[70]
+func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4803,40 +6156,42 @@ func (x *DeleteOperationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use ListRackRuleAssociationsResponse.ProtoReflect.Descriptor instead.
+func (*ListRackRuleAssociationsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *DeleteOperationRuleRequest) GetRuleId() *UUID {
+func (x *ListRackRuleAssociationsResponse) GetAssociations() []*RackRuleAssociation {
 	if x != nil {
-		return x.RuleId
+		return x.Associations
 	}
 	return nil
 }
 
-type SetRuleAsDefaultRequest struct {
+type BlackoutPeriod struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Start         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetRuleAsDefaultRequest) Reset() {
-	*x = SetRuleAsDefaultRequest{}
-	mi := &file_rla_proto_msgTypes[71]
+func (x *BlackoutPeriod) Reset() {
+	*x = BlackoutPeriod{}
+	mi := &file_rla_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetRuleAsDefaultRequest) String() string {
+func (x *BlackoutPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetRuleAsDefaultRequest) ProtoMessage() {}
+func (*BlackoutPeriod) ProtoMessage() {}
 
-func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[71]
+func (x *BlackoutPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4847,40 +6202,64 @@ func (x *SetRuleAsDefaultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetRuleAsDefaultRequest.ProtoReflect.Descriptor instead.
-func (*SetRuleAsDefaultRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use BlackoutPeriod.ProtoReflect.Descriptor instead.
+func (*BlackoutPeriod) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *SetRuleAsDefaultRequest) GetRuleId() *UUID {
+func (x *BlackoutPeriod) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RuleId
+		return x.Start
 	}
 	return nil
 }
 
-type GetOperationRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BlackoutPeriod) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-func (x *GetOperationRuleRequest) Reset() {
-	*x = GetOperationRuleRequest{}
-	mi := &file_rla_proto_msgTypes[72]
+func (x *BlackoutPeriod) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type MaintenanceWindow struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	RackId          *UUID                  `protobuf:"bytes,4,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`                             // Empty for a site-wide window
+	Schedule        string                 `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`                                       // Standard 5-field cron expression for each opening
+	DurationSeconds int64                  `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // How long the window stays open after each opening
+	Timezone        string                 `protobuf:"bytes,7,opt,name=timezone,proto3" json:"timezone,omitempty"`                                       // IANA timezone for the schedule (default UTC)
+	Blackouts       []*BlackoutPeriod      `protobuf:"bytes,8,rep,name=blackouts,proto3" json:"blackouts,omitempty"`
+	Enabled         bool                   `protobuf:"varint,9,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MaintenanceWindow) Reset() {
+	*x = MaintenanceWindow{}
+	mi := &file_rla_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOperationRuleRequest) String() string {
+func (x *MaintenanceWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOperationRuleRequest) ProtoMessage() {}
+func (*MaintenanceWindow) ProtoMessage() {}
 
-func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[72]
+func (x *MaintenanceWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4891,109 +6270,110 @@ func (x *GetOperationRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOperationRuleRequest.ProtoReflect.Descriptor instead.
-func (*GetOperationRuleRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use MaintenanceWindow.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindow) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetOperationRuleRequest) GetRuleId() *UUID {
+func (x *MaintenanceWindow) GetId() *UUID {
 	if x != nil {
-		return x.RuleId
+		return x.Id
 	}
 	return nil
 }
 
-type ListOperationRulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperationType *OperationType         `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType,oneof" json:"operation_type,omitempty"`
-	IsDefault     *bool                  `protobuf:"varint,2,opt,name=is_default,json=isDefault,proto3,oneof" json:"is_default,omitempty"`
-	Offset        *int32                 `protobuf:"varint,3,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
-	Limit         *int32                 `protobuf:"varint,4,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MaintenanceWindow) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *ListOperationRulesRequest) Reset() {
-	*x = ListOperationRulesRequest{}
-	mi := &file_rla_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MaintenanceWindow) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *ListOperationRulesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MaintenanceWindow) GetRackId() *UUID {
+	if x != nil {
+		return x.RackId
+	}
+	return nil
 }
 
-func (*ListOperationRulesRequest) ProtoMessage() {}
+func (x *MaintenanceWindow) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
 
-func (x *ListOperationRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[73]
+func (x *MaintenanceWindow) GetDurationSeconds() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DurationSeconds
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ListOperationRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListOperationRulesRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{73}
+func (x *MaintenanceWindow) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
 }
 
-func (x *ListOperationRulesRequest) GetOperationType() OperationType {
-	if x != nil && x.OperationType != nil {
-		return *x.OperationType
+func (x *MaintenanceWindow) GetBlackouts() []*BlackoutPeriod {
+	if x != nil {
+		return x.Blackouts
 	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+	return nil
 }
 
-func (x *ListOperationRulesRequest) GetIsDefault() bool {
-	if x != nil && x.IsDefault != nil {
-		return *x.IsDefault
+func (x *MaintenanceWindow) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
 	}
 	return false
 }
-
-func (x *ListOperationRulesRequest) GetOffset() int32 {
-	if x != nil && x.Offset != nil {
-		return *x.Offset
+
+func (x *MaintenanceWindow) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *ListOperationRulesRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *MaintenanceWindow) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
-	return 0
+	return nil
 }
 
-type ListOperationRulesResponse struct {
+type CreateMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*OperationRule       `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // id, created_at and updated_at are ignored
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOperationRulesResponse) Reset() {
-	*x = ListOperationRulesResponse{}
-	mi := &file_rla_proto_msgTypes[74]
+func (x *CreateMaintenanceWindowRequest) Reset() {
+	*x = CreateMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOperationRulesResponse) String() string {
+func (x *CreateMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOperationRulesResponse) ProtoMessage() {}
+func (*CreateMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[74]
+func (x *CreateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5004,48 +6384,40 @@ func (x *ListOperationRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOperationRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListOperationRulesResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use CreateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *ListOperationRulesResponse) GetRules() []*OperationRule {
+func (x *CreateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
 	if x != nil {
-		return x.Rules
+		return x.Window
 	}
 	return nil
 }
 
-func (x *ListOperationRulesResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-type AssociateRuleWithRackRequest struct {
+type CreateMaintenanceWindowResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	RuleId        *UUID                  `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Id            *UUID                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AssociateRuleWithRackRequest) Reset() {
-	*x = AssociateRuleWithRackRequest{}
-	mi := &file_rla_proto_msgTypes[75]
+func (x *CreateMaintenanceWindowResponse) Reset() {
+	*x = CreateMaintenanceWindowResponse{}
+	mi := &file_rla_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AssociateRuleWithRackRequest) String() string {
+func (x *CreateMaintenanceWindowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AssociateRuleWithRackRequest) ProtoMessage() {}
+func (*CreateMaintenanceWindowResponse) ProtoMessage() {}
 
-func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[75]
+func (x *CreateMaintenanceWindowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5056,49 +6428,40 @@ func (x *AssociateRuleWithRackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AssociateRuleWithRackRequest.ProtoReflect.Descriptor instead.
-func (*AssociateRuleWithRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{75}
-}
-
-func (x *AssociateRuleWithRackRequest) GetRackId() *UUID {
-	if x != nil {
-		return x.RackId
-	}
-	return nil
+// Deprecated: Use CreateMaintenanceWindowResponse.ProtoReflect.Descriptor instead.
+func (*CreateMaintenanceWindowResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *AssociateRuleWithRackRequest) GetRuleId() *UUID {
+func (x *CreateMaintenanceWindowResponse) GetId() *UUID {
 	if x != nil {
-		return x.RuleId
+		return x.Id
 	}
 	return nil
 }
 
-type DisassociateRuleFromRackRequest struct {
+type UpdateMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	Window        *MaintenanceWindow     `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"` // Replaces the definition of the window with this id
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisassociateRuleFromRackRequest) Reset() {
-	*x = DisassociateRuleFromRackRequest{}
-	mi := &file_rla_proto_msgTypes[76]
+func (x *UpdateMaintenanceWindowRequest) Reset() {
+	*x = UpdateMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisassociateRuleFromRackRequest) String() string {
+func (x *UpdateMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisassociateRuleFromRackRequest) ProtoMessage() {}
+func (*UpdateMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[76]
+func (x *UpdateMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5109,56 +6472,40 @@ func (x *DisassociateRuleFromRackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisassociateRuleFromRackRequest.ProtoReflect.Descriptor instead.
-func (*DisassociateRuleFromRackRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{76}
+// Deprecated: Use UpdateMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *DisassociateRuleFromRackRequest) GetRackId() *UUID {
+func (x *UpdateMaintenanceWindowRequest) GetWindow() *MaintenanceWindow {
 	if x != nil {
-		return x.RackId
+		return x.Window
 	}
 	return nil
 }
 
-func (x *DisassociateRuleFromRackRequest) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
-}
-
-func (x *DisassociateRuleFromRackRequest) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
-}
-
-type GetRackRuleAssociationRequest struct {
+type DeleteMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code
+	WindowId      *UUID                  `protobuf:"bytes,1,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRackRuleAssociationRequest) Reset() {
-	*x = GetRackRuleAssociationRequest{}
-	mi := &file_rla_proto_msgTypes[77]
+func (x *DeleteMaintenanceWindowRequest) Reset() {
+	*x = DeleteMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRackRuleAssociationRequest) String() string {
+func (x *DeleteMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRackRuleAssociationRequest) ProtoMessage() {}
+func (*DeleteMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[77]
+func (x *DeleteMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5169,54 +6516,40 @@ func (x *GetRackRuleAssociationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRackRuleAssociationRequest.ProtoReflect.Descriptor instead.
-func (*GetRackRuleAssociationRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{77}
+// Deprecated: Use DeleteMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *GetRackRuleAssociationRequest) GetRackId() *UUID {
+func (x *DeleteMaintenanceWindowRequest) GetWindowId() *UUID {
 	if x != nil {
-		return x.RackId
+		return x.WindowId
 	}
 	return nil
 }
 
-func (x *GetRackRuleAssociationRequest) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
-}
-
-func (x *GetRackRuleAssociationRequest) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
-}
-
-type GetRackRuleAssociationResponse struct {
+type GetMaintenanceWindowRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        *UUID                  `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // Empty if no association exists
+	WindowId      *UUID                  `protobuf:"bytes,1,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRackRuleAssociationResponse) Reset() {
-	*x = GetRackRuleAssociationResponse{}
-	mi := &file_rla_proto_msgTypes[78]
+func (x *GetMaintenanceWindowRequest) Reset() {
+	*x = GetMaintenanceWindowRequest{}
+	mi := &file_rla_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRackRuleAssociationResponse) String() string {
+func (x *GetMaintenanceWindowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRackRuleAssociationResponse) ProtoMessage() {}
+func (*GetMaintenanceWindowRequest) ProtoMessage() {}
 
-func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[78]
+func (x *GetMaintenanceWindowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5227,40 +6560,40 @@ func (x *GetRackRuleAssociationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRackRuleAssociationResponse.ProtoReflect.Descriptor instead.
-func (*GetRackRuleAssociationResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{78}
+// Deprecated: Use GetMaintenanceWindowRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *GetRackRuleAssociationResponse) GetRuleId() *UUID {
+func (x *GetMaintenanceWindowRequest) GetWindowId() *UUID {
 	if x != nil {
-		return x.RuleId
+		return x.WindowId
 	}
 	return nil
 }
 
-type ListRackRuleAssociationsRequest struct {
+type ListMaintenanceWindowsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3,oneof" json:"rack_id,omitempty"` // Only windows that can govern this rack (its own and site-wide)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRackRuleAssociationsRequest) Reset() {
-	*x = ListRackRuleAssociationsRequest{}
-	mi := &file_rla_proto_msgTypes[79]
+func (x *ListMaintenanceWindowsRequest) Reset() {
+	*x = ListMaintenanceWindowsRequest{}
+	mi := &file_rla_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRackRuleAssociationsRequest) String() string {
+func (x *ListMaintenanceWindowsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRackRuleAssociationsRequest) ProtoMessage() {}
+func (*ListMaintenanceWindowsRequest) ProtoMessage() {}
 
-func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[79]
+func (x *ListMaintenanceWindowsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5271,45 +6604,40 @@ func (x *ListRackRuleAssociationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRackRuleAssociationsRequest.ProtoReflect.Descriptor instead.
-func (*ListRackRuleAssociationsRequest) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{79}
+// Deprecated: Use ListMaintenanceWindowsRequest.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *ListRackRuleAssociationsRequest) GetRackId() *UUID {
+func (x *ListMaintenanceWindowsRequest) GetRackId() *UUID {
 	if x != nil {
 		return x.RackId
 	}
 	return nil
 }
 
-type RackRuleAssociation struct {
+type ListMaintenanceWindowsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
-	OperationType OperationType          `protobuf:"varint,2,opt,name=operation_type,json=operationType,proto3,enum=v1.OperationType" json:"operation_type,omitempty"`
-	OperationCode string                 `protobuf:"bytes,3,opt,name=operation_code,json=operationCode,proto3" json:"operation_code,omitempty"` // Specific operation code (e.g., "power_on", "upgrade")
-	RuleId        *UUID                  `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Windows       []*MaintenanceWindow   `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RackRuleAssociation) Reset() {
-	*x = RackRuleAssociation{}
-	mi := &file_rla_proto_msgTypes[80]
+func (x *ListMaintenanceWindowsResponse) Reset() {
+	*x = ListMaintenanceWindowsResponse{}
+	mi := &file_rla_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RackRuleAssociation) String() string {
+func (x *ListMaintenanceWindowsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RackRuleAssociation) ProtoMessage() {}
+func (*ListMaintenanceWindowsResponse) ProtoMessage() {}
 
-func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[80]
+func (x *ListMaintenanceWindowsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5320,75 +6648,89 @@ func (x *RackRuleAssociation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RackRuleAssociation.ProtoReflect.Descriptor instead.
-func (*RackRuleAssociation) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{80}
+// Deprecated: Use ListMaintenanceWindowsResponse.ProtoReflect.Descriptor instead.
+func (*ListMaintenanceWindowsResponse) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *RackRuleAssociation) GetRackId() *UUID {
+func (x *ListMaintenanceWindowsResponse) GetWindows() []*MaintenanceWindow {
 	if x != nil {
-		return x.RackId
+		return x.Windows
 	}
 	return nil
 }
 
-func (x *RackRuleAssociation) GetOperationType() OperationType {
-	if x != nil {
-		return x.OperationType
-	}
-	return OperationType_OPERATION_TYPE_UNKNOWN
+type GetMaintenanceWindowStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RackId        *UUID                  `protobuf:"bytes,1,opt,name=rack_id,json=rackId,proto3" json:"rack_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RackRuleAssociation) GetOperationCode() string {
-	if x != nil {
-		return x.OperationCode
-	}
-	return ""
+func (x *GetMaintenanceWindowStatusRequest) Reset() {
+	*x = GetMaintenanceWindowStatusRequest{}
+	mi := &file_rla_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RackRuleAssociation) GetRuleId() *UUID {
-	if x != nil {
-		return x.RuleId
-	}
-	return nil
+func (x *GetMaintenanceWindowStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RackRuleAssociation) GetCreatedAt() *timestamppb.Timestamp {
+func (*GetMaintenanceWindowStatusRequest) ProtoMessage() {}
+
+func (x *GetMaintenanceWindowStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[100]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RackRuleAssociation) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use GetMaintenanceWindowStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMaintenanceWindowStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetMaintenanceWindowStatusRequest) GetRackId() *UUID {
 	if x != nil {
-		return x.UpdatedAt
+		return x.RackId
 	}
 	return nil
 }
 
-type ListRackRuleAssociationsResponse struct {
+type MaintenanceWindowStatus struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Associations  []*RackRuleAssociation `protobuf:"bytes,1,rep,name=associations,proto3" json:"associations,omitempty"`
+	Governed      bool                   `protobuf:"varint,1,opt,name=governed,proto3" json:"governed,omitempty"`                         // false if no enabled window applies to the rack
+	Open          bool                   `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`                                 // disruptive work may run now
+	ClosesAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=closes_at,json=closesAt,proto3" json:"closes_at,omitempty"`          // set when open
+	NextOpening   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=next_opening,json=nextOpening,proto3" json:"next_opening,omitempty"` // set when closed and an opening is scheduled
+	WindowId      *UUID                  `protobuf:"bytes,5,opt,name=window_id,json=windowId,proto3" json:"window_id,omitempty"`          // window that is open or opens next
+	WindowName    string                 `protobuf:"bytes,6,opt,name=window_name,json=windowName,proto3" json:"window_name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListRackRuleAssociationsResponse) Reset() {
-	*x = ListRackRuleAssociationsResponse{}
-	mi := &file_rla_proto_msgTypes[81]
+func (x *MaintenanceWindowStatus) Reset() {
+	*x = MaintenanceWindowStatus{}
+	mi := &file_rla_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRackRuleAssociationsResponse) String() string {
+func (x *MaintenanceWindowStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRackRuleAssociationsResponse) ProtoMessage() {}
+func (*MaintenanceWindowStatus) ProtoMessage() {}
 
-func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rla_proto_msgTypes[81]
+func (x *MaintenanceWindowStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rla_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5399,18 +6741,53 @@ func (x *ListRackRuleAssociationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRackRuleAssociationsResponse.ProtoReflect.Descriptor instead.
-func (*ListRackRuleAssociationsResponse) Descriptor() ([]byte, []int) {
-	return file_rla_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use MaintenanceWindowStatus.ProtoReflect.Descriptor instead.
+func (*MaintenanceWindowStatus) Descriptor() ([]byte, []int) {
+	return file_rla_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ListRackRuleAssociationsResponse) GetAssociations() []*RackRuleAssociation {
+func (x *MaintenanceWindowStatus) GetGoverned() bool {
 	if x != nil {
-		return x.Associations
+		return x.Governed
+	}
+	return false
+}
+
+func (x *MaintenanceWindowStatus) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *MaintenanceWindowStatus) GetClosesAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosesAt
+	}
+	return nil
+}
+
+func (x *MaintenanceWindowStatus) GetNextOpening() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOpening
+	}
+	return nil
+}
+
+func (x *MaintenanceWindowStatus) GetWindowId() *UUID {
+	if x != nil {
+		return x.WindowId
 	}
 	return nil
 }
 
+func (x *MaintenanceWindowStatus) GetWindowName() string {
+	if x != nil {
+		return x.WindowName
+	}
+	return ""
+}
+
 var File_rla_proto protoreflect.FileDescriptor
 
 const file_rla_proto_rawDesc = "" +
@@ -5462,13 +6839,15 @@ const file_rla_proto_rawDesc = "" +
 	"\fcomponent_id\x18\x06 \x01(\tR\vcomponentId\x12!\n" +
 	"\arack_id\x18\a \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1f\n" +
 	"\vpower_state\x18\b \x01(\tR\n" +
-	"powerState\"\x83\x01\n" +
+	"powerState\"\xcd\x01\n" +
 	"\x04Rack\x12\"\n" +
 	"\x04info\x18\x01 \x01(\v2\x0e.v1.DeviceInfoR\x04info\x12(\n" +
 	"\blocation\x18\x02 \x01(\v2\f.v1.LocationR\blocation\x12-\n" +
 	"\n" +
 	"components\x18\x03 \x03(\v2\r.v1.ComponentR\n" +
-	"components\":\n" +
+	"components\x121\n" +
+	"\x12power_budget_watts\x18\x04 \x01(\x01H\x00R\x10powerBudgetWatts\x88\x01\x01B\x15\n" +
+	"\x13_power_budget_watts\":\n" +
 	"\n" +
 	"Identifier\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
@@ -5523,7 +6902,7 @@ const file_rla_proto_rawDesc = "" +
 	"rack_field\x18\x01 \x01(\x0e2\x14.v1.RackOrderByFieldH\x00R\trackField\x12D\n" +
 	"\x0fcomponent_field\x18\x02 \x01(\x0e2\x19.v1.ComponentOrderByFieldH\x00R\x0ecomponentField\x12\x1c\n" +
 	"\tdirection\x18\x03 \x01(\tR\tdirectionB\a\n" +
-	"\x05field\"\xd6\x02\n" +
+	"\x05field\"\xa8\x03\n" +
 	"\x04Task\x12\x18\n" +
 	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x1c\n" +
 	"\toperation\x18\x02 \x01(\tR\toperation\x12!\n" +
@@ -5533,7 +6912,10 @@ const file_rla_proto_rawDesc = "" +
 	"\rexecutor_type\x18\x06 \x01(\x0e2\x14.v1.TaskExecutorTypeR\fexecutorType\x12!\n" +
 	"\fexecution_id\x18\a \x01(\tR\vexecutionId\x12&\n" +
 	"\x06status\x18\b \x01(\x0e2\x0e.v1.TaskStatusR\x06status\x12\x18\n" +
-	"\amessage\x18\t \x01(\tR\amessage\"9\n" +
+	"\amessage\x18\t \x01(\tR\amessage\x12'\n" +
+	"\x0fwindow_override\x18\n" +
+	" \x01(\bR\x0ewindowOverride\x12'\n" +
+	"\x0foverride_reason\x18\v \x01(\tR\x0eoverrideReason\"9\n" +
 	"\x19CreateExpectedRackRequest\x12\x1c\n" +
 	"\x04rack\x18\x01 \x01(\v2\b.v1.RackR\x04rack\"6\n" +
 	"\x1aCreateExpectedRackResponse\x12\x18\n" +
@@ -5597,7 +6979,7 @@ const file_rla_proto_rawDesc = "" +
 	"\x1bGetRacksForNVLDomainRequest\x12B\n" +
 	"\x15nvl_domain_identifier\x18\x01 \x01(\v2\x0e.v1.IdentifierR\x13nvlDomainIdentifier\">\n" +
 	"\x1cGetRacksForNVLDomainResponse\x12\x1e\n" +
-	"\x05racks\x18\x01 \x03(\v2\b.v1.RackR\x05racks\"\xcb\x02\n" +
+	"\x05racks\x18\x01 \x03(\v2\b.v1.RackR\x05racks\"\x97\x03\n" +
 	"\x16UpgradeFirmwareRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12*\n" +
@@ -5605,7 +6987,8 @@ const file_rla_proto_rawDesc = "" +
 	"\n" +
 	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\tstartTime\x88\x01\x01\x12:\n" +
 	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\aendTime\x88\x01\x01\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescriptionB\x11\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12J\n" +
+	"\x14maintenance_override\x18\x06 \x01(\v2\x17.v1.MaintenanceOverrideR\x13maintenanceOverrideB\x11\n" +
 	"\x0f_target_versionB\r\n" +
 	"\v_start_timeB\v\n" +
 	"\t_end_time\"\x89\x02\n" +
@@ -5647,19 +7030,52 @@ const file_rla_proto_rawDesc = "" +
 	"\vdrift_count\x18\x05 \x01(\x05R\n" +
 	"driftCount\x12\x1f\n" +
 	"\vmatch_count\x18\x06 \x01(\x05R\n" +
-	"matchCount\"\xd6\x01\n" +
+	"matchCount\"\x96\x02\n" +
 	"\rComponentDiff\x12 \n" +
 	"\x04type\x18\x01 \x01(\x0e2\f.v1.DiffTypeR\x04type\x12!\n" +
 	"\fcomponent_id\x18\x02 \x01(\tR\vcomponentId\x12)\n" +
 	"\bexpected\x18\x03 \x01(\v2\r.v1.ComponentR\bexpected\x12%\n" +
 	"\x06actual\x18\x04 \x01(\v2\r.v1.ComponentR\x06actual\x12.\n" +
 	"\vfield_diffs\x18\x05 \x03(\v2\r.v1.FieldDiffR\n" +
-	"fieldDiffs\"t\n" +
+	"fieldDiffs\x12>\n" +
+	"\rfirst_seen_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAt\"t\n" +
 	"\tFieldDiff\x12\x1d\n" +
 	"\n" +
 	"field_name\x18\x01 \x01(\tR\tfieldName\x12%\n" +
 	"\x0eexpected_value\x18\x02 \x01(\tR\rexpectedValue\x12!\n" +
-	"\factual_value\x18\x03 \x01(\tR\vactualValue\"B\n" +
+	"\factual_value\x18\x03 \x01(\tR\vactualValue\"\x9a\x02\n" +
+	"\x17ListDriftHistoryRequest\x12=\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecH\x00R\n" +
+	"targetSpec\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x02 \x01(\tR\tfieldName\x12\x1b\n" +
+	"\topen_only\x18\x03 \x01(\bR\bopenOnly\x120\n" +
+	"\x05since\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x123\n" +
+	"\n" +
+	"pagination\x18\x05 \x01(\v2\x0e.v1.PaginationH\x01R\n" +
+	"pagination\x88\x01\x01B\x0e\n" +
+	"\f_target_specB\r\n" +
+	"\v_pagination\"\xd9\x03\n" +
+	"\x11DriftHistoryEntry\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\tR\vcomponentId\x12\x1f\n" +
+	"\vexternal_id\x18\x03 \x01(\tR\n" +
+	"externalId\x12 \n" +
+	"\x04type\x18\x04 \x01(\x0e2\f.v1.DiffTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"field_name\x18\x05 \x01(\tR\tfieldName\x12%\n" +
+	"\x0eexpected_value\x18\x06 \x01(\tR\rexpectedValue\x12!\n" +
+	"\factual_value\x18\a \x01(\tR\vactualValue\x12>\n" +
+	"\rfirst_seen_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAt\x12<\n" +
+	"\flast_seen_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x12;\n" +
+	"\vresolved_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\x12 \n" +
+	"\vremediation\x18\v \x01(\tR\vremediation\"a\n" +
+	"\x18ListDriftHistoryResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.v1.DriftHistoryEntryR\aentries\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"B\n" +
 	"\x13AddComponentRequest\x12+\n" +
 	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"C\n" +
 	"\x14AddComponentResponse\x12+\n" +
@@ -5681,25 +7097,69 @@ const file_rla_proto_rawDesc = "" +
 	"\x16PatchComponentResponse\x12+\n" +
 	"\tcomponent\x18\x01 \x01(\v2\r.v1.ComponentR\tcomponent\"9\n" +
 	"\x12SubmitTaskResponse\x12#\n" +
-	"\btask_ids\x18\x01 \x03(\v2\b.v1.UUIDR\ataskIds\"p\n" +
+	"\btask_ids\x18\x01 \x03(\v2\b.v1.UUIDR\ataskIds\"-\n" +
+	"\x13MaintenanceOverride\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\"\xbc\x01\n" +
 	"\x12PowerOnRackRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x89\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12J\n" +
+	"\x14maintenance_override\x18\x03 \x01(\v2\x17.v1.MaintenanceOverrideR\x13maintenanceOverride\"\xd5\x01\n" +
 	"\x13PowerOffRackRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12\x16\n" +
 	"\x06forced\x18\x02 \x01(\bR\x06forced\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"\x8b\x01\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12J\n" +
+	"\x14maintenance_override\x18\x04 \x01(\v2\x17.v1.MaintenanceOverrideR\x13maintenanceOverride\"\xd7\x01\n" +
 	"\x15PowerResetRackRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12\x16\n" +
 	"\x06forced\x18\x02 \x01(\bR\x06forced\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"p\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12J\n" +
+	"\x14maintenance_override\x18\x04 \x01(\v2\x17.v1.MaintenanceOverrideR\x13maintenanceOverride\"P\n" +
+	"\x14GetPowerStatsRequest\x128\n" +
+	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
+	"targetSpec\"\xd0\x01\n" +
+	"\x13ComponentPowerStats\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12!\n" +
+	"\fcomponent_id\x18\x02 \x01(\tR\vcomponentId\x12%\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x11.v1.ComponentTypeR\x04type\x12!\n" +
+	"\arack_id\x18\x04 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x14\n" +
+	"\x05watts\x18\x05 \x01(\x01R\x05watts\x12\x1c\n" +
+	"\tavailable\x18\x06 \x01(\bR\tavailable\"\x93\x03\n" +
+	"\x0eRackPowerStats\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1b\n" +
+	"\track_name\x18\x02 \x01(\tR\brackName\x12,\n" +
+	"\rnvl_domain_id\x18\x03 \x01(\v2\b.v1.UUIDR\vnvlDomainId\x12#\n" +
+	"\rcompute_watts\x18\x04 \x01(\x01R\fcomputeWatts\x12'\n" +
+	"\x0fnvlswitch_watts\x18\x05 \x01(\x01R\x0envlswitchWatts\x126\n" +
+	"\x17powershelf_output_watts\x18\x06 \x01(\x01R\x15powershelfOutputWatts\x12\x1d\n" +
+	"\n" +
+	"draw_watts\x18\a \x01(\x01R\tdrawWatts\x12&\n" +
+	"\fbudget_watts\x18\b \x01(\x01H\x00R\vbudgetWatts\x88\x01\x01\x125\n" +
+	"\x16unavailable_components\x18\t \x01(\x05R\x15unavailableComponentsB\x0f\n" +
+	"\r_budget_watts\"\xa5\x01\n" +
+	"\x13NVLDomainPowerStats\x12-\n" +
+	"\n" +
+	"nvl_domain\x18\x01 \x01(\v2\x0e.v1.IdentifierR\tnvlDomain\x12\x1d\n" +
+	"\n" +
+	"draw_watts\x18\x02 \x01(\x01R\tdrawWatts\x12!\n" +
+	"\fbudget_watts\x18\x03 \x01(\x01R\vbudgetWatts\x12\x1d\n" +
+	"\n" +
+	"rack_count\x18\x04 \x01(\x05R\trackCount\"\xf3\x01\n" +
+	"\x15GetPowerStatsResponse\x127\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x17.v1.ComponentPowerStatsR\n" +
+	"components\x12(\n" +
+	"\x05racks\x18\x02 \x03(\v2\x12.v1.RackPowerStatsR\x05racks\x128\n" +
+	"\vnvl_domains\x18\x03 \x03(\v2\x17.v1.NVLDomainPowerStatsR\n" +
+	"nvlDomains\x12=\n" +
+	"\fcollected_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\"\xbc\x01\n" +
 	"\x12BringUpRackRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x95\x01\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12J\n" +
+	"\x14maintenance_override\x18\x03 \x01(\v2\x17.v1.MaintenanceOverrideR\x13maintenanceOverride\"\x95\x01\n" +
 	"\x11IngestRackRequest\x128\n" +
 	"\vtarget_spec\x18\x01 \x01(\v2\x17.v1.OperationTargetSpecR\n" +
 	"targetSpec\x12$\n" +
@@ -5806,7 +7266,52 @@ const file_rla_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"_\n" +
 	" ListRackRuleAssociationsResponse\x12;\n" +
-	"\fassociations\x18\x01 \x03(\v2\x17.v1.RackRuleAssociationR\fassociations*D\n" +
+	"\fassociations\x18\x01 \x03(\v2\x17.v1.RackRuleAssociationR\fassociations\"\x88\x01\n" +
+	"\x0eBlackoutPeriod\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xab\x03\n" +
+	"\x11MaintenanceWindow\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12!\n" +
+	"\arack_id\x18\x04 \x01(\v2\b.v1.UUIDR\x06rackId\x12\x1a\n" +
+	"\bschedule\x18\x05 \x01(\tR\bschedule\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x12\x1a\n" +
+	"\btimezone\x18\a \x01(\tR\btimezone\x120\n" +
+	"\tblackouts\x18\b \x03(\v2\x12.v1.BlackoutPeriodR\tblackouts\x12\x18\n" +
+	"\aenabled\x18\t \x01(\bR\aenabled\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"O\n" +
+	"\x1eCreateMaintenanceWindowRequest\x12-\n" +
+	"\x06window\x18\x01 \x01(\v2\x15.v1.MaintenanceWindowR\x06window\";\n" +
+	"\x1fCreateMaintenanceWindowResponse\x12\x18\n" +
+	"\x02id\x18\x01 \x01(\v2\b.v1.UUIDR\x02id\"O\n" +
+	"\x1eUpdateMaintenanceWindowRequest\x12-\n" +
+	"\x06window\x18\x01 \x01(\v2\x15.v1.MaintenanceWindowR\x06window\"G\n" +
+	"\x1eDeleteMaintenanceWindowRequest\x12%\n" +
+	"\twindow_id\x18\x01 \x01(\v2\b.v1.UUIDR\bwindowId\"D\n" +
+	"\x1bGetMaintenanceWindowRequest\x12%\n" +
+	"\twindow_id\x18\x01 \x01(\v2\b.v1.UUIDR\bwindowId\"S\n" +
+	"\x1dListMaintenanceWindowsRequest\x12&\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDH\x00R\x06rackId\x88\x01\x01B\n" +
+	"\n" +
+	"\b_rack_id\"Q\n" +
+	"\x1eListMaintenanceWindowsResponse\x12/\n" +
+	"\awindows\x18\x01 \x03(\v2\x15.v1.MaintenanceWindowR\awindows\"F\n" +
+	"!GetMaintenanceWindowStatusRequest\x12!\n" +
+	"\arack_id\x18\x01 \x01(\v2\b.v1.UUIDR\x06rackId\"\x89\x02\n" +
+	"\x17MaintenanceWindowStatus\x12\x1a\n" +
+	"\bgoverned\x18\x01 \x01(\bR\bgoverned\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\bR\x04open\x127\n" +
+	"\tcloses_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bclosesAt\x12=\n" +
+	"\fnext_opening\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vnextOpening\x12%\n" +
+	"\twindow_id\x18\x05 \x01(\v2\b.v1.UUIDR\bwindowId\x12\x1f\n" +
+	"\vwindow_name\x18\x06 \x01(\tR\n" +
+	"windowName*D\n" +
 	"\aBMCType\x12\x14\n" +
 	"\x10BMC_TYPE_UNKNOWN\x10\x00\x12\x11\n" +
 	"\rBMC_TYPE_HOST\x10\x01\x12\x10\n" +
@@ -5850,14 +7355,16 @@ const file_rla_proto_rawDesc = "" +
 	"\x18POWER_CONTROL_OP_RESTART\x10\x05\x12\"\n" +
 	"\x1ePOWER_CONTROL_OP_FORCE_RESTART\x10\x06\x12\x1f\n" +
 	"\x1bPOWER_CONTROL_OP_WARM_RESET\x10\a\x12\x1f\n" +
-	"\x1bPOWER_CONTROL_OP_COLD_RESET\x10\b*\x8a\x01\n" +
+	"\x1bPOWER_CONTROL_OP_COLD_RESET\x10\b*\xbb\x01\n" +
 	"\n" +
 	"TaskStatus\x12\x17\n" +
 	"\x13TASK_STATUS_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13TASK_STATUS_PENDING\x10\x01\x12\x17\n" +
 	"\x13TASK_STATUS_RUNNING\x10\x02\x12\x19\n" +
 	"\x15TASK_STATUS_COMPLETED\x10\x03\x12\x16\n" +
-	"\x12TASK_STATUS_FAILED\x10\x04*S\n" +
+	"\x12TASK_STATUS_FAILED\x10\x04\x12\x17\n" +
+	"\x13TASK_STATUS_WAITING\x10\x05\x12\x16\n" +
+	"\x12TASK_STATUS_PAUSED\x10\x06*S\n" +
 	"\x10TaskExecutorType\x12\x1e\n" +
 	"\x1aTASK_EXECUTOR_TYPE_UNKNOWN\x10\x00\x12\x1f\n" +
 	"\x1bTASK_EXECUTOR_TYPE_TEMPORAL\x10\x01*t\n" +
@@ -5869,7 +7376,7 @@ const file_rla_proto_rawDesc = "" +
 	"\rOperationType\x12\x1a\n" +
 	"\x16OPERATION_TYPE_UNKNOWN\x10\x00\x12 \n" +
 	"\x1cOPERATION_TYPE_POWER_CONTROL\x10\x01\x12#\n" +
-	"\x1fOPERATION_TYPE_FIRMWARE_CONTROL\x10\x022\xcd\x15\n" +
+	"\x1fOPERATION_TYPE_FIRMWARE_CONTROL\x10\x022\x87\x1b\n" +
 	"\x03RLA\x12,\n" +
 	"\aVersion\x12\x12.v1.VersionRequest\x1a\r.v1.BuildInfo\x12S\n" +
 	"\x12CreateExpectedRack\x12\x1d.v1.CreateExpectedRackRequest\x1a\x1e.v1.CreateExpectedRackResponse\x128\n" +
@@ -5889,13 +7396,15 @@ const file_rla_proto_rawDesc = "" +
 	"\n" +
 	"IngestRack\x12\x15.v1.IngestRackRequest\x1a\x16.v1.SubmitTaskResponse\x12D\n" +
 	"\rGetComponents\x12\x18.v1.GetComponentsRequest\x1a\x19.v1.GetComponentsResponse\x12S\n" +
-	"\x12ValidateComponents\x12\x1d.v1.ValidateComponentsRequest\x1a\x1e.v1.ValidateComponentsResponse\x12A\n" +
+	"\x12ValidateComponents\x12\x1d.v1.ValidateComponentsRequest\x1a\x1e.v1.ValidateComponentsResponse\x12M\n" +
+	"\x10ListDriftHistory\x12\x1b.v1.ListDriftHistoryRequest\x1a\x1c.v1.ListDriftHistoryResponse\x12A\n" +
 	"\fAddComponent\x12\x17.v1.AddComponentRequest\x1a\x18.v1.AddComponentResponse\x12G\n" +
 	"\x0ePatchComponent\x12\x19.v1.PatchComponentRequest\x1a\x1a.v1.PatchComponentResponse\x12J\n" +
 	"\x0fDeleteComponent\x12\x1a.v1.DeleteComponentRequest\x1a\x1b.v1.DeleteComponentResponse\x12=\n" +
 	"\vPowerOnRack\x12\x16.v1.PowerOnRackRequest\x1a\x16.v1.SubmitTaskResponse\x12?\n" +
 	"\fPowerOffRack\x12\x17.v1.PowerOffRackRequest\x1a\x16.v1.SubmitTaskResponse\x12C\n" +
-	"\x0ePowerResetRack\x12\x19.v1.PowerResetRackRequest\x1a\x16.v1.SubmitTaskResponse\x128\n" +
+	"\x0ePowerResetRack\x12\x19.v1.PowerResetRackRequest\x1a\x16.v1.SubmitTaskResponse\x12D\n" +
+	"\rGetPowerStats\x12\x18.v1.GetPowerStatsRequest\x1a\x19.v1.GetPowerStatsResponse\x128\n" +
 	"\tListTasks\x12\x14.v1.ListTasksRequest\x1a\x15.v1.ListTasksResponse\x12D\n" +
 	"\rGetTasksByIDs\x12\x18.v1.GetTasksByIDsRequest\x1a\x19.v1.GetTasksByIDsResponse\x12V\n" +
 	"\x13CreateOperationRule\x12\x1e.v1.CreateOperationRuleRequest\x1a\x1f.v1.CreateOperationRuleResponse\x12M\n" +
@@ -5907,7 +7416,13 @@ const file_rla_proto_rawDesc = "" +
 	"\x15AssociateRuleWithRack\x12 .v1.AssociateRuleWithRackRequest\x1a\x16.google.protobuf.Empty\x12W\n" +
 	"\x18DisassociateRuleFromRack\x12#.v1.DisassociateRuleFromRackRequest\x1a\x16.google.protobuf.Empty\x12_\n" +
 	"\x16GetRackRuleAssociation\x12!.v1.GetRackRuleAssociationRequest\x1a\".v1.GetRackRuleAssociationResponse\x12e\n" +
-	"\x18ListRackRuleAssociations\x12#.v1.ListRackRuleAssociationsRequest\x1a$.v1.ListRackRuleAssociationsResponseB<Z:github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1b\x06proto3"
+	"\x18ListRackRuleAssociations\x12#.v1.ListRackRuleAssociationsRequest\x1a$.v1.ListRackRuleAssociationsResponse\x12b\n" +
+	"\x17CreateMaintenanceWindow\x12\".v1.CreateMaintenanceWindowRequest\x1a#.v1.CreateMaintenanceWindowResponse\x12U\n" +
+	"\x17UpdateMaintenanceWindow\x12\".v1.UpdateMaintenanceWindowRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x17DeleteMaintenanceWindow\x12\".v1.DeleteMaintenanceWindowRequest\x1a\x16.google.protobuf.Empty\x12N\n" +
+	"\x14GetMaintenanceWindow\x12\x1f.v1.GetMaintenanceWindowRequest\x1a\x15.v1.MaintenanceWindow\x12_\n" +
+	"\x16ListMaintenanceWindows\x12!.v1.ListMaintenanceWindowsRequest\x1a\".v1.ListMaintenanceWindowsResponse\x12`\n" +
+	"\x1aGetMaintenanceWindowStatus\x12%.v1.GetMaintenanceWindowStatusRequest\x1a\x1b.v1.MaintenanceWindowStatusB<Z:github.com/nvidia/bare-metal-manager-rest/rla/pkg/proto/v1b\x06proto3"
 
 var (
 	file_rla_proto_rawDescOnce sync.Once
@@ -5922,103 +7437,123 @@ func file_rla_proto_rawDescGZIP() []byte {
 }
 
 var file_rla_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
+var file_rla_proto_msgTypes = make([]protoimpl.MessageInfo, 102)
 var file_rla_proto_goTypes = []any{
-	(BMCType)(0),                             // 0: v1.BMCType
-	(ComponentType)(0),                       // 1: v1.ComponentType
-	(RackFilterField)(0),                     // 2: v1.RackFilterField
-	(ComponentFilterField)(0),                // 3: v1.ComponentFilterField
-	(ComponentOrderByField)(0),               // 4: v1.ComponentOrderByField
-	(RackOrderByField)(0),                    // 5: v1.RackOrderByField
-	(PowerControlOp)(0),                      // 6: v1.PowerControlOp
-	(TaskStatus)(0),                          // 7: v1.TaskStatus
-	(TaskExecutorType)(0),                    // 8: v1.TaskExecutorType
-	(DiffType)(0),                            // 9: v1.DiffType
-	(OperationType)(0),                       // 10: v1.OperationType
-	(*UUID)(nil),                             // 11: v1.UUID
-	(*DeviceInfo)(nil),                       // 12: v1.DeviceInfo
-	(*Location)(nil),                         // 13: v1.Location
-	(*DeviceSerialInfo)(nil),                 // 14: v1.DeviceSerialInfo
-	(*BMCInfo)(nil),                          // 15: v1.BMCInfo
-	(*RackPosition)(nil),                     // 16: v1.RackPosition
-	(*Component)(nil),                        // 17: v1.Component
-	(*Rack)(nil),                             // 18: v1.Rack
-	(*Identifier)(nil),                       // 19: v1.Identifier
-	(*OperationTargetSpec)(nil),              // 20: v1.OperationTargetSpec
-	(*RackTargets)(nil),                      // 21: v1.RackTargets
-	(*ComponentTargets)(nil),                 // 22: v1.ComponentTargets
-	(*RackTarget)(nil),                       // 23: v1.RackTarget
-	(*ComponentTarget)(nil),                  // 24: v1.ComponentTarget
-	(*ExternalRef)(nil),                      // 25: v1.ExternalRef
-	(*NVLDomain)(nil),                        // 26: v1.NVLDomain
-	(*Pagination)(nil),                       // 27: v1.Pagination
-	(*StringQueryInfo)(nil),                  // 28: v1.StringQueryInfo
-	(*Filter)(nil),                           // 29: v1.Filter
-	(*OrderBy)(nil),                          // 30: v1.OrderBy
-	(*Task)(nil),                             // 31: v1.Task
-	(*CreateExpectedRackRequest)(nil),        // 32: v1.CreateExpectedRackRequest
-	(*CreateExpectedRackResponse)(nil),       // 33: v1.CreateExpectedRackResponse
-	(*GetRackInfoByIDRequest)(nil),           // 34: v1.GetRackInfoByIDRequest
-	(*GetRackInfoBySerialRequest)(nil),       // 35: v1.GetRackInfoBySerialRequest
-	(*GetRackInfoResponse)(nil),              // 36: v1.GetRackInfoResponse
-	(*PatchRackRequest)(nil),                 // 37: v1.PatchRackRequest
-	(*PatchRackResponse)(nil),                // 38: v1.PatchRackResponse
-	(*GetComponentInfoByIDRequest)(nil),      // 39: v1.GetComponentInfoByIDRequest
-	(*GetComponentInfoBySerialRequest)(nil),  // 40: v1.GetComponentInfoBySerialRequest
-	(*GetComponentInfoResponse)(nil),         // 41: v1.GetComponentInfoResponse
-	(*GetListOfRacksRequest)(nil),            // 42: v1.GetListOfRacksRequest
-	(*GetListOfRacksResponse)(nil),           // 43: v1.GetListOfRacksResponse
-	(*CreateNVLDomainRequest)(nil),           // 44: v1.CreateNVLDomainRequest
-	(*CreateNVLDomainResponse)(nil),          // 45: v1.CreateNVLDomainResponse
-	(*AttachRacksToNVLDomainRequest)(nil),    // 46: v1.AttachRacksToNVLDomainRequest
-	(*DetachRacksFromNVLDomainRequest)(nil),  // 47: v1.DetachRacksFromNVLDomainRequest
-	(*GetListOfNVLDomainsRequest)(nil),       // 48: v1.GetListOfNVLDomainsRequest
-	(*GetListOfNVLDomainsResponse)(nil),      // 49: v1.GetListOfNVLDomainsResponse
-	(*GetRacksForNVLDomainRequest)(nil),      // 50: v1.GetRacksForNVLDomainRequest
-	(*GetRacksForNVLDomainResponse)(nil),     // 51: v1.GetRacksForNVLDomainResponse
-	(*UpgradeFirmwareRequest)(nil),           // 52: v1.UpgradeFirmwareRequest
-	(*GetComponentsRequest)(nil),             // 53: v1.GetComponentsRequest
-	(*GetComponentsResponse)(nil),            // 54: v1.GetComponentsResponse
-	(*ValidateComponentsRequest)(nil),        // 55: v1.ValidateComponentsRequest
-	(*ValidateComponentsResponse)(nil),       // 56: v1.ValidateComponentsResponse
-	(*ComponentDiff)(nil),                    // 57: v1.ComponentDiff
-	(*FieldDiff)(nil),                        // 58: v1.FieldDiff
-	(*AddComponentRequest)(nil),              // 59: v1.AddComponentRequest
-	(*AddComponentResponse)(nil),             // 60: v1.AddComponentResponse
-	(*DeleteComponentRequest)(nil),           // 61: v1.DeleteComponentRequest
-	(*DeleteComponentResponse)(nil),          // 62: v1.DeleteComponentResponse
-	(*PatchComponentRequest)(nil),            // 63: v1.PatchComponentRequest
-	(*PatchComponentResponse)(nil),           // 64: v1.PatchComponentResponse
-	(*SubmitTaskResponse)(nil),               // 65: v1.SubmitTaskResponse
-	(*PowerOnRackRequest)(nil),               // 66: v1.PowerOnRackRequest
-	(*PowerOffRackRequest)(nil),              // 67: v1.PowerOffRackRequest
-	(*PowerResetRackRequest)(nil),            // 68: v1.PowerResetRackRequest
-	(*BringUpRackRequest)(nil),               // 69: v1.BringUpRackRequest
-	(*IngestRackRequest)(nil),                // 70: v1.IngestRackRequest
-	(*ListTasksRequest)(nil),                 // 71: v1.ListTasksRequest
-	(*ListTasksResponse)(nil),                // 72: v1.ListTasksResponse
-	(*GetTasksByIDsRequest)(nil),             // 73: v1.GetTasksByIDsRequest
-	(*GetTasksByIDsResponse)(nil),            // 74: v1.GetTasksByIDsResponse
-	(*VersionRequest)(nil),                   // 75: v1.VersionRequest
-	(*BuildInfo)(nil),                        // 76: v1.BuildInfo
-	(*OperationRule)(nil),                    // 77: v1.OperationRule
-	(*CreateOperationRuleRequest)(nil),       // 78: v1.CreateOperationRuleRequest
-	(*CreateOperationRuleResponse)(nil),      // 79: v1.CreateOperationRuleResponse
-	(*UpdateOperationRuleRequest)(nil),       // 80: v1.UpdateOperationRuleRequest
-	(*DeleteOperationRuleRequest)(nil),       // 81: v1.DeleteOperationRuleRequest
-	(*SetRuleAsDefaultRequest)(nil),          // 82: v1.SetRuleAsDefaultRequest
-	(*GetOperationRuleRequest)(nil),          // 83: v1.GetOperationRuleRequest
-	(*ListOperationRulesRequest)(nil),        // 84: v1.ListOperationRulesRequest
-	(*ListOperationRulesResponse)(nil),       // 85: v1.ListOperationRulesResponse
-	(*AssociateRuleWithRackRequest)(nil),     // 86: v1.AssociateRuleWithRackRequest
-	(*DisassociateRuleFromRackRequest)(nil),  // 87: v1.DisassociateRuleFromRackRequest
-	(*GetRackRuleAssociationRequest)(nil),    // 88: v1.GetRackRuleAssociationRequest
-	(*GetRackRuleAssociationResponse)(nil),   // 89: v1.GetRackRuleAssociationResponse
-	(*ListRackRuleAssociationsRequest)(nil),  // 90: v1.ListRackRuleAssociationsRequest
-	(*RackRuleAssociation)(nil),              // 91: v1.RackRuleAssociation
-	(*ListRackRuleAssociationsResponse)(nil), // 92: v1.ListRackRuleAssociationsResponse
-	(*timestamppb.Timestamp)(nil),            // 93: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                    // 94: google.protobuf.Empty
+	(BMCType)(0),                              // 0: v1.BMCType
+	(ComponentType)(0),                        // 1: v1.ComponentType
+	(RackFilterField)(0),                      // 2: v1.RackFilterField
+	(ComponentFilterField)(0),                 // 3: v1.ComponentFilterField
+	(ComponentOrderByField)(0),                // 4: v1.ComponentOrderByField
+	(RackOrderByField)(0),                     // 5: v1.RackOrderByField
+	(PowerControlOp)(0),                       // 6: v1.PowerControlOp
+	(TaskStatus)(0),                           // 7: v1.TaskStatus
+	(TaskExecutorType)(0),                     // 8: v1.TaskExecutorType
+	(DiffType)(0),                             // 9: v1.DiffType
+	(OperationType)(0),                        // 10: v1.OperationType
+	(*UUID)(nil),                              // 11: v1.UUID
+	(*DeviceInfo)(nil),                        // 12: v1.DeviceInfo
+	(*Location)(nil),                          // 13: v1.Location
+	(*DeviceSerialInfo)(nil),                  // 14: v1.DeviceSerialInfo
+	(*BMCInfo)(nil),                           // 15: v1.BMCInfo
+	(*RackPosition)(nil),                      // 16: v1.RackPosition
+	(*Component)(nil),                         // 17: v1.Component
+	(*Rack)(nil),                              // 18: v1.Rack
+	(*Identifier)(nil),                        // 19: v1.Identifier
+	(*OperationTargetSpec)(nil),               // 20: v1.OperationTargetSpec
+	(*RackTargets)(nil),                       // 21: v1.RackTargets
+	(*ComponentTargets)(nil),                  // 22: v1.ComponentTargets
+	(*RackTarget)(nil),                        // 23: v1.RackTarget
+	(*ComponentTarget)(nil),                   // 24: v1.ComponentTarget
+	(*ExternalRef)(nil),                       // 25: v1.ExternalRef
+	(*NVLDomain)(nil),                         // 26: v1.NVLDomain
+	(*Pagination)(nil),                        // 27: v1.Pagination
+	(*StringQueryInfo)(nil),                   // 28: v1.StringQueryInfo
+	(*Filter)(nil),                            // 29: v1.Filter
+	(*OrderBy)(nil),                           // 30: v1.OrderBy
+	(*Task)(nil),                              // 31: v1.Task
+	(*CreateExpectedRackRequest)(nil),         // 32: v1.CreateExpectedRackRequest
+	(*CreateExpectedRackResponse)(nil),        // 33: v1.CreateExpectedRackResponse
+	(*GetRackInfoByIDRequest)(nil),            // 34: v1.GetRackInfoByIDRequest
+	(*GetRackInfoBySerialRequest)(nil),        // 35: v1.GetRackInfoBySerialRequest
+	(*GetRackInfoResponse)(nil),               // 36: v1.GetRackInfoResponse
+	(*PatchRackRequest)(nil),                  // 37: v1.PatchRackRequest
+	(*PatchRackResponse)(nil),                 // 38: v1.PatchRackResponse
+	(*GetComponentInfoByIDRequest)(nil),       // 39: v1.GetComponentInfoByIDRequest
+	(*GetComponentInfoBySerialRequest)(nil),   // 40: v1.GetComponentInfoBySerialRequest
+	(*GetComponentInfoResponse)(nil),          // 41: v1.GetComponentInfoResponse
+	(*GetListOfRacksRequest)(nil),             // 42: v1.GetListOfRacksRequest
+	(*GetListOfRacksResponse)(nil),            // 43: v1.GetListOfRacksResponse
+	(*CreateNVLDomainRequest)(nil),            // 44: v1.CreateNVLDomainRequest
+	(*CreateNVLDomainResponse)(nil),           // 45: v1.CreateNVLDomainResponse
+	(*AttachRacksToNVLDomainRequest)(nil),     // 46: v1.AttachRacksToNVLDomainRequest
+	(*DetachRacksFromNVLDomainRequest)(nil),   // 47: v1.DetachRacksFromNVLDomainRequest
+	(*GetListOfNVLDomainsRequest)(nil),        // 48: v1.GetListOfNVLDomainsRequest
+	(*GetListOfNVLDomainsResponse)(nil),       // 49: v1.GetListOfNVLDomainsResponse
+	(*GetRacksForNVLDomainRequest)(nil),       // 50: v1.GetRacksForNVLDomainRequest
+	(*GetRacksForNVLDomainResponse)(nil),      // 51: v1.GetRacksForNVLDomainResponse
+	(*UpgradeFirmwareRequest)(nil),            // 52: v1.UpgradeFirmwareRequest
+	(*GetComponentsRequest)(nil),              // 53: v1.GetComponentsRequest
+	(*GetComponentsResponse)(nil),             // 54: v1.GetComponentsResponse
+	(*ValidateComponentsRequest)(nil),         // 55: v1.ValidateComponentsRequest
+	(*ValidateComponentsResponse)(nil),        // 56: v1.ValidateComponentsResponse
+	(*ComponentDiff)(nil),                     // 57: v1.ComponentDiff
+	(*FieldDiff)(nil),                         // 58: v1.FieldDiff
+	(*ListDriftHistoryRequest)(nil),           // 59: v1.ListDriftHistoryRequest
+	(*DriftHistoryEntry)(nil),                 // 60: v1.DriftHistoryEntry
+	(*ListDriftHistoryResponse)(nil),          // 61: v1.ListDriftHistoryResponse
+	(*AddComponentRequest)(nil),               // 62: v1.AddComponentRequest
+	(*AddComponentResponse)(nil),              // 63: v1.AddComponentResponse
+	(*DeleteComponentRequest)(nil),            // 64: v1.DeleteComponentRequest
+	(*DeleteComponentResponse)(nil),           // 65: v1.DeleteComponentResponse
+	(*PatchComponentRequest)(nil),             // 66: v1.PatchComponentRequest
+	(*PatchComponentResponse)(nil),            // 67: v1.PatchComponentResponse
+	(*SubmitTaskResponse)(nil),                // 68: v1.SubmitTaskResponse
+	(*MaintenanceOverride)(nil),               // 69: v1.MaintenanceOverride
+	(*PowerOnRackRequest)(nil),                // 70: v1.PowerOnRackRequest
+	(*PowerOffRackRequest)(nil),               // 71: v1.PowerOffRackRequest
+	(*PowerResetRackRequest)(nil),             // 72: v1.PowerResetRackRequest
+	(*GetPowerStatsRequest)(nil),              // 73: v1.GetPowerStatsRequest
+	(*ComponentPowerStats)(nil),               // 74: v1.ComponentPowerStats
+	(*RackPowerStats)(nil),                    // 75: v1.RackPowerStats
+	(*NVLDomainPowerStats)(nil),               // 76: v1.NVLDomainPowerStats
+	(*GetPowerStatsResponse)(nil),             // 77: v1.GetPowerStatsResponse
+	(*BringUpRackRequest)(nil),                // 78: v1.BringUpRackRequest
+	(*IngestRackRequest)(nil),                 // 79: v1.IngestRackRequest
+	(*ListTasksRequest)(nil),                  // 80: v1.ListTasksRequest
+	(*ListTasksResponse)(nil),                 // 81: v1.ListTasksResponse
+	(*GetTasksByIDsRequest)(nil),              // 82: v1.GetTasksByIDsRequest
+	(*GetTasksByIDsResponse)(nil),             // 83: v1.GetTasksByIDsResponse
+	(*VersionRequest)(nil),                    // 84: v1.VersionRequest
+	(*BuildInfo)(nil),                         // 85: v1.BuildInfo
+	(*OperationRule)(nil),                     // 86: v1.OperationRule
+	(*CreateOperationRuleRequest)(nil),        // 87: v1.CreateOperationRuleRequest
+	(*CreateOperationRuleResponse)(nil),       // 88: v1.CreateOperationRuleResponse
+	(*UpdateOperationRuleRequest)(nil),        // 89: v1.UpdateOperationRuleRequest
+	(*DeleteOperationRuleRequest)(nil),        // 90: v1.DeleteOperationRuleRequest
+	(*SetRuleAsDefaultRequest)(nil),           // 91: v1.SetRuleAsDefaultRequest
+	(*GetOperationRuleRequest)(nil),           // 92: v1.GetOperationRuleRequest
+	(*ListOperationRulesRequest)(nil),         // 93: v1.ListOperationRulesRequest
+	(*ListOperationRulesResponse)(nil),        // 94: v1.ListOperationRulesResponse
+	(*AssociateRuleWithRackRequest)(nil),      // 95: v1.AssociateRuleWithRackRequest
+	(*DisassociateRuleFromRackRequest)(nil),   // 96: v1.DisassociateRuleFromRackRequest
+	(*GetRackRuleAssociationRequest)(nil),     // 97: v1.GetRackRuleAssociationRequest
+	(*GetRackRuleAssociationResponse)(nil),    // 98: v1.GetRackRuleAssociationResponse
+	(*ListRackRuleAssociationsRequest)(nil),   // 99: v1.ListRackRuleAssociationsRequest
+	(*RackRuleAssociation)(nil),               // 100: v1.RackRuleAssociation
+	(*ListRackRuleAssociationsResponse)(nil),  // 101: v1.ListRackRuleAssociationsResponse
+	(*BlackoutPeriod)(nil),                    // 102: v1.BlackoutPeriod
+	(*MaintenanceWindow)(nil),                 // 103: v1.MaintenanceWindow
+	(*CreateMaintenanceWindowRequest)(nil),    // 104: v1.CreateMaintenanceWindowRequest
+	(*CreateMaintenanceWindowResponse)(nil),   // 105: v1.CreateMaintenanceWindowResponse
+	(*UpdateMaintenanceWindowRequest)(nil),    // 106: v1.UpdateMaintenanceWindowRequest
+	(*DeleteMaintenanceWindowRequest)(nil),    // 107: v1.DeleteMaintenanceWindowRequest
+	(*GetMaintenanceWindowRequest)(nil),       // 108: v1.GetMaintenanceWindowRequest
+	(*ListMaintenanceWindowsRequest)(nil),     // 109: v1.ListMaintenanceWindowsRequest
+	(*ListMaintenanceWindowsResponse)(nil),    // 110: v1.ListMaintenanceWindowsResponse
+	(*GetMaintenanceWindowStatusRequest)(nil), // 111: v1.GetMaintenanceWindowStatusRequest
+	(*MaintenanceWindowStatus)(nil),           // 112: v1.MaintenanceWindowStatus
+	(*timestamppb.Timestamp)(nil),             // 113: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                     // 114: google.protobuf.Empty
 }
 var file_rla_proto_depIdxs = []int32{
 	11,  // 0: v1.DeviceInfo.id:type_name -> v1.UUID
@@ -6077,144 +7612,204 @@ var file_rla_proto_depIdxs = []int32{
 	19,  // 53: v1.GetRacksForNVLDomainRequest.nvl_domain_identifier:type_name -> v1.Identifier
 	18,  // 54: v1.GetRacksForNVLDomainResponse.racks:type_name -> v1.Rack
 	20,  // 55: v1.UpgradeFirmwareRequest.target_spec:type_name -> v1.OperationTargetSpec
-	93,  // 56: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
-	93,  // 57: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
-	20,  // 58: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	29,  // 59: v1.GetComponentsRequest.filters:type_name -> v1.Filter
-	27,  // 60: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
-	30,  // 61: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
-	17,  // 62: v1.GetComponentsResponse.components:type_name -> v1.Component
-	20,  // 63: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
-	29,  // 64: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
-	27,  // 65: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
-	30,  // 66: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
-	57,  // 67: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
-	9,   // 68: v1.ComponentDiff.type:type_name -> v1.DiffType
-	17,  // 69: v1.ComponentDiff.expected:type_name -> v1.Component
-	17,  // 70: v1.ComponentDiff.actual:type_name -> v1.Component
-	58,  // 71: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
-	17,  // 72: v1.AddComponentRequest.component:type_name -> v1.Component
-	17,  // 73: v1.AddComponentResponse.component:type_name -> v1.Component
-	11,  // 74: v1.DeleteComponentRequest.id:type_name -> v1.UUID
-	11,  // 75: v1.PatchComponentRequest.id:type_name -> v1.UUID
-	16,  // 76: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
-	11,  // 77: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
-	17,  // 78: v1.PatchComponentResponse.component:type_name -> v1.Component
-	11,  // 79: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
-	20,  // 80: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	20,  // 81: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	20,  // 82: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	20,  // 83: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	20,  // 84: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
-	29,  // 85: v1.IngestRackRequest.filters:type_name -> v1.Filter
-	11,  // 86: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
-	27,  // 87: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
-	31,  // 88: v1.ListTasksResponse.tasks:type_name -> v1.Task
-	11,  // 89: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
-	31,  // 90: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
-	11,  // 91: v1.OperationRule.id:type_name -> v1.UUID
-	10,  // 92: v1.OperationRule.operation_type:type_name -> v1.OperationType
-	93,  // 93: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
-	93,  // 94: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
-	10,  // 95: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
-	11,  // 96: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
-	11,  // 97: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
-	11,  // 98: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
-	11,  // 99: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
-	11,  // 100: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
-	10,  // 101: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
-	77,  // 102: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
-	11,  // 103: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
-	11,  // 104: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
-	11,  // 105: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
-	10,  // 106: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
-	11,  // 107: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
-	10,  // 108: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
-	11,  // 109: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
-	11,  // 110: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
-	11,  // 111: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
-	10,  // 112: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
-	11,  // 113: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
-	93,  // 114: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
-	93,  // 115: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
-	91,  // 116: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
-	75,  // 117: v1.RLA.Version:input_type -> v1.VersionRequest
-	32,  // 118: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
-	37,  // 119: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
-	34,  // 120: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
-	35,  // 121: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
-	39,  // 122: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
-	40,  // 123: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
-	42,  // 124: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
-	44,  // 125: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
-	46,  // 126: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
-	47,  // 127: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
-	48,  // 128: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
-	50,  // 129: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
-	52,  // 130: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
-	69,  // 131: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
-	70,  // 132: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
-	53,  // 133: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
-	55,  // 134: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
-	59,  // 135: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
-	63,  // 136: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
-	61,  // 137: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
-	66,  // 138: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
-	67,  // 139: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
-	68,  // 140: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
-	71,  // 141: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
-	73,  // 142: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
-	78,  // 143: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
-	80,  // 144: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
-	81,  // 145: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
-	83,  // 146: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
-	84,  // 147: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
-	82,  // 148: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
-	86,  // 149: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
-	87,  // 150: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
-	88,  // 151: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
-	90,  // 152: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
-	76,  // 153: v1.RLA.Version:output_type -> v1.BuildInfo
-	33,  // 154: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
-	38,  // 155: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
-	36,  // 156: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
-	36,  // 157: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
-	41,  // 158: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
-	41,  // 159: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
-	43,  // 160: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
-	45,  // 161: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
-	94,  // 162: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
-	94,  // 163: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
-	49,  // 164: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
-	51,  // 165: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
-	65,  // 166: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
-	65,  // 167: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
-	65,  // 168: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
-	54,  // 169: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
-	56,  // 170: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
-	60,  // 171: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
-	64,  // 172: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
-	62,  // 173: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
-	65,  // 174: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
-	65,  // 175: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
-	65,  // 176: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
-	72,  // 177: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
-	74,  // 178: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
-	79,  // 179: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
-	94,  // 180: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
-	94,  // 181: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
-	77,  // 182: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
-	85,  // 183: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
-	94,  // 184: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
-	94,  // 185: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
-	94,  // 186: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
-	89,  // 187: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
-	92,  // 188: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
-	153, // [153:189] is the sub-list for method output_type
-	117, // [117:153] is the sub-list for method input_type
-	117, // [117:117] is the sub-list for extension type_name
-	117, // [117:117] is the sub-list for extension extendee
-	0,   // [0:117] is the sub-list for field type_name
+	113, // 56: v1.UpgradeFirmwareRequest.start_time:type_name -> google.protobuf.Timestamp
+	113, // 57: v1.UpgradeFirmwareRequest.end_time:type_name -> google.protobuf.Timestamp
+	69,  // 58: v1.UpgradeFirmwareRequest.maintenance_override:type_name -> v1.MaintenanceOverride
+	20,  // 59: v1.GetComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	29,  // 60: v1.GetComponentsRequest.filters:type_name -> v1.Filter
+	27,  // 61: v1.GetComponentsRequest.pagination:type_name -> v1.Pagination
+	30,  // 62: v1.GetComponentsRequest.order_by:type_name -> v1.OrderBy
+	17,  // 63: v1.GetComponentsResponse.components:type_name -> v1.Component
+	20,  // 64: v1.ValidateComponentsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	29,  // 65: v1.ValidateComponentsRequest.filters:type_name -> v1.Filter
+	27,  // 66: v1.ValidateComponentsRequest.pagination:type_name -> v1.Pagination
+	30,  // 67: v1.ValidateComponentsRequest.order_by:type_name -> v1.OrderBy
+	57,  // 68: v1.ValidateComponentsResponse.diffs:type_name -> v1.ComponentDiff
+	9,   // 69: v1.ComponentDiff.type:type_name -> v1.DiffType
+	17,  // 70: v1.ComponentDiff.expected:type_name -> v1.Component
+	17,  // 71: v1.ComponentDiff.actual:type_name -> v1.Component
+	58,  // 72: v1.ComponentDiff.field_diffs:type_name -> v1.FieldDiff
+	113, // 73: v1.ComponentDiff.first_seen_at:type_name -> google.protobuf.Timestamp
+	20,  // 74: v1.ListDriftHistoryRequest.target_spec:type_name -> v1.OperationTargetSpec
+	113, // 75: v1.ListDriftHistoryRequest.since:type_name -> google.protobuf.Timestamp
+	27,  // 76: v1.ListDriftHistoryRequest.pagination:type_name -> v1.Pagination
+	11,  // 77: v1.DriftHistoryEntry.id:type_name -> v1.UUID
+	9,   // 78: v1.DriftHistoryEntry.type:type_name -> v1.DiffType
+	113, // 79: v1.DriftHistoryEntry.first_seen_at:type_name -> google.protobuf.Timestamp
+	113, // 80: v1.DriftHistoryEntry.last_seen_at:type_name -> google.protobuf.Timestamp
+	113, // 81: v1.DriftHistoryEntry.resolved_at:type_name -> google.protobuf.Timestamp
+	60,  // 82: v1.ListDriftHistoryResponse.entries:type_name -> v1.DriftHistoryEntry
+	17,  // 83: v1.AddComponentRequest.component:type_name -> v1.Component
+	17,  // 84: v1.AddComponentResponse.component:type_name -> v1.Component
+	11,  // 85: v1.DeleteComponentRequest.id:type_name -> v1.UUID
+	11,  // 86: v1.PatchComponentRequest.id:type_name -> v1.UUID
+	16,  // 87: v1.PatchComponentRequest.position:type_name -> v1.RackPosition
+	11,  // 88: v1.PatchComponentRequest.rack_id:type_name -> v1.UUID
+	17,  // 89: v1.PatchComponentResponse.component:type_name -> v1.Component
+	11,  // 90: v1.SubmitTaskResponse.task_ids:type_name -> v1.UUID
+	20,  // 91: v1.PowerOnRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	69,  // 92: v1.PowerOnRackRequest.maintenance_override:type_name -> v1.MaintenanceOverride
+	20,  // 93: v1.PowerOffRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	69,  // 94: v1.PowerOffRackRequest.maintenance_override:type_name -> v1.MaintenanceOverride
+	20,  // 95: v1.PowerResetRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	69,  // 96: v1.PowerResetRackRequest.maintenance_override:type_name -> v1.MaintenanceOverride
+	20,  // 97: v1.GetPowerStatsRequest.target_spec:type_name -> v1.OperationTargetSpec
+	11,  // 98: v1.ComponentPowerStats.id:type_name -> v1.UUID
+	1,   // 99: v1.ComponentPowerStats.type:type_name -> v1.ComponentType
+	11,  // 100: v1.ComponentPowerStats.rack_id:type_name -> v1.UUID
+	11,  // 101: v1.RackPowerStats.rack_id:type_name -> v1.UUID
+	11,  // 102: v1.RackPowerStats.nvl_domain_id:type_name -> v1.UUID
+	19,  // 103: v1.NVLDomainPowerStats.nvl_domain:type_name -> v1.Identifier
+	74,  // 104: v1.GetPowerStatsResponse.components:type_name -> v1.ComponentPowerStats
+	75,  // 105: v1.GetPowerStatsResponse.racks:type_name -> v1.RackPowerStats
+	76,  // 106: v1.GetPowerStatsResponse.nvl_domains:type_name -> v1.NVLDomainPowerStats
+	113, // 107: v1.GetPowerStatsResponse.collected_at:type_name -> google.protobuf.Timestamp
+	20,  // 108: v1.BringUpRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	69,  // 109: v1.BringUpRackRequest.maintenance_override:type_name -> v1.MaintenanceOverride
+	20,  // 110: v1.IngestRackRequest.target_spec:type_name -> v1.OperationTargetSpec
+	29,  // 111: v1.IngestRackRequest.filters:type_name -> v1.Filter
+	11,  // 112: v1.ListTasksRequest.rack_id:type_name -> v1.UUID
+	27,  // 113: v1.ListTasksRequest.pagination:type_name -> v1.Pagination
+	31,  // 114: v1.ListTasksResponse.tasks:type_name -> v1.Task
+	11,  // 115: v1.GetTasksByIDsRequest.task_ids:type_name -> v1.UUID
+	31,  // 116: v1.GetTasksByIDsResponse.tasks:type_name -> v1.Task
+	11,  // 117: v1.OperationRule.id:type_name -> v1.UUID
+	10,  // 118: v1.OperationRule.operation_type:type_name -> v1.OperationType
+	113, // 119: v1.OperationRule.created_at:type_name -> google.protobuf.Timestamp
+	113, // 120: v1.OperationRule.updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 121: v1.CreateOperationRuleRequest.operation_type:type_name -> v1.OperationType
+	11,  // 122: v1.CreateOperationRuleResponse.id:type_name -> v1.UUID
+	11,  // 123: v1.UpdateOperationRuleRequest.rule_id:type_name -> v1.UUID
+	11,  // 124: v1.DeleteOperationRuleRequest.rule_id:type_name -> v1.UUID
+	11,  // 125: v1.SetRuleAsDefaultRequest.rule_id:type_name -> v1.UUID
+	11,  // 126: v1.GetOperationRuleRequest.rule_id:type_name -> v1.UUID
+	10,  // 127: v1.ListOperationRulesRequest.operation_type:type_name -> v1.OperationType
+	86,  // 128: v1.ListOperationRulesResponse.rules:type_name -> v1.OperationRule
+	11,  // 129: v1.AssociateRuleWithRackRequest.rack_id:type_name -> v1.UUID
+	11,  // 130: v1.AssociateRuleWithRackRequest.rule_id:type_name -> v1.UUID
+	11,  // 131: v1.DisassociateRuleFromRackRequest.rack_id:type_name -> v1.UUID
+	10,  // 132: v1.DisassociateRuleFromRackRequest.operation_type:type_name -> v1.OperationType
+	11,  // 133: v1.GetRackRuleAssociationRequest.rack_id:type_name -> v1.UUID
+	10,  // 134: v1.GetRackRuleAssociationRequest.operation_type:type_name -> v1.OperationType
+	11,  // 135: v1.GetRackRuleAssociationResponse.rule_id:type_name -> v1.UUID
+	11,  // 136: v1.ListRackRuleAssociationsRequest.rack_id:type_name -> v1.UUID
+	11,  // 137: v1.RackRuleAssociation.rack_id:type_name -> v1.UUID
+	10,  // 138: v1.RackRuleAssociation.operation_type:type_name -> v1.OperationType
+	11,  // 139: v1.RackRuleAssociation.rule_id:type_name -> v1.UUID
+	113, // 140: v1.RackRuleAssociation.created_at:type_name -> google.protobuf.Timestamp
+	113, // 141: v1.RackRuleAssociation.updated_at:type_name -> google.protobuf.Timestamp
+	100, // 142: v1.ListRackRuleAssociationsResponse.associations:type_name -> v1.RackRuleAssociation
+	113, // 143: v1.BlackoutPeriod.start:type_name -> google.protobuf.Timestamp
+	113, // 144: v1.BlackoutPeriod.end:type_name -> google.protobuf.Timestamp
+	11,  // 145: v1.MaintenanceWindow.id:type_name -> v1.UUID
+	11,  // 146: v1.MaintenanceWindow.rack_id:type_name -> v1.UUID
+	102, // 147: v1.MaintenanceWindow.blackouts:type_name -> v1.BlackoutPeriod
+	113, // 148: v1.MaintenanceWindow.created_at:type_name -> google.protobuf.Timestamp
+	113, // 149: v1.MaintenanceWindow.updated_at:type_name -> google.protobuf.Timestamp
+	103, // 150: v1.CreateMaintenanceWindowRequest.window:type_name -> v1.MaintenanceWindow
+	11,  // 151: v1.CreateMaintenanceWindowResponse.id:type_name -> v1.UUID
+	103, // 152: v1.UpdateMaintenanceWindowRequest.window:type_name -> v1.MaintenanceWindow
+	11,  // 153: v1.DeleteMaintenanceWindowRequest.window_id:type_name -> v1.UUID
+	11,  // 154: v1.GetMaintenanceWindowRequest.window_id:type_name -> v1.UUID
+	11,  // 155: v1.ListMaintenanceWindowsRequest.rack_id:type_name -> v1.UUID
+	103, // 156: v1.ListMaintenanceWindowsResponse.windows:type_name -> v1.MaintenanceWindow
+	11,  // 157: v1.GetMaintenanceWindowStatusRequest.rack_id:type_name -> v1.UUID
+	113, // 158: v1.MaintenanceWindowStatus.closes_at:type_name -> google.protobuf.Timestamp
+	113, // 159: v1.MaintenanceWindowStatus.next_opening:type_name -> google.protobuf.Timestamp
+	11,  // 160: v1.MaintenanceWindowStatus.window_id:type_name -> v1.UUID
+	84,  // 161: v1.RLA.Version:input_type -> v1.VersionRequest
+	32,  // 162: v1.RLA.CreateExpectedRack:input_type -> v1.CreateExpectedRackRequest
+	37,  // 163: v1.RLA.PatchRack:input_type -> v1.PatchRackRequest
+	34,  // 164: v1.RLA.GetRackInfoByID:input_type -> v1.GetRackInfoByIDRequest
+	35,  // 165: v1.RLA.GetRackInfoBySerial:input_type -> v1.GetRackInfoBySerialRequest
+	39,  // 166: v1.RLA.GetComponentInfoByID:input_type -> v1.GetComponentInfoByIDRequest
+	40,  // 167: v1.RLA.GetComponentInfoBySerial:input_type -> v1.GetComponentInfoBySerialRequest
+	42,  // 168: v1.RLA.GetListOfRacks:input_type -> v1.GetListOfRacksRequest
+	44,  // 169: v1.RLA.CreateNVLDomain:input_type -> v1.CreateNVLDomainRequest
+	46,  // 170: v1.RLA.AttachRacksToNVLDomain:input_type -> v1.AttachRacksToNVLDomainRequest
+	47,  // 171: v1.RLA.DetachRacksFromNVLDomain:input_type -> v1.DetachRacksFromNVLDomainRequest
+	48,  // 172: v1.RLA.GetListOfNVLDomains:input_type -> v1.GetListOfNVLDomainsRequest
+	50,  // 173: v1.RLA.GetRacksForNVLDomain:input_type -> v1.GetRacksForNVLDomainRequest
+	52,  // 174: v1.RLA.UpgradeFirmware:input_type -> v1.UpgradeFirmwareRequest
+	78,  // 175: v1.RLA.BringUpRack:input_type -> v1.BringUpRackRequest
+	79,  // 176: v1.RLA.IngestRack:input_type -> v1.IngestRackRequest
+	53,  // 177: v1.RLA.GetComponents:input_type -> v1.GetComponentsRequest
+	55,  // 178: v1.RLA.ValidateComponents:input_type -> v1.ValidateComponentsRequest
+	59,  // 179: v1.RLA.ListDriftHistory:input_type -> v1.ListDriftHistoryRequest
+	62,  // 180: v1.RLA.AddComponent:input_type -> v1.AddComponentRequest
+	66,  // 181: v1.RLA.PatchComponent:input_type -> v1.PatchComponentRequest
+	64,  // 182: v1.RLA.DeleteComponent:input_type -> v1.DeleteComponentRequest
+	70,  // 183: v1.RLA.PowerOnRack:input_type -> v1.PowerOnRackRequest
+	71,  // 184: v1.RLA.PowerOffRack:input_type -> v1.PowerOffRackRequest
+	72,  // 185: v1.RLA.PowerResetRack:input_type -> v1.PowerResetRackRequest
+	73,  // 186: v1.RLA.GetPowerStats:input_type -> v1.GetPowerStatsRequest
+	80,  // 187: v1.RLA.ListTasks:input_type -> v1.ListTasksRequest
+	82,  // 188: v1.RLA.GetTasksByIDs:input_type -> v1.GetTasksByIDsRequest
+	87,  // 189: v1.RLA.CreateOperationRule:input_type -> v1.CreateOperationRuleRequest
+	89,  // 190: v1.RLA.UpdateOperationRule:input_type -> v1.UpdateOperationRuleRequest
+	90,  // 191: v1.RLA.DeleteOperationRule:input_type -> v1.DeleteOperationRuleRequest
+	92,  // 192: v1.RLA.GetOperationRule:input_type -> v1.GetOperationRuleRequest
+	93,  // 193: v1.RLA.ListOperationRules:input_type -> v1.ListOperationRulesRequest
+	91,  // 194: v1.RLA.SetRuleAsDefault:input_type -> v1.SetRuleAsDefaultRequest
+	95,  // 195: v1.RLA.AssociateRuleWithRack:input_type -> v1.AssociateRuleWithRackRequest
+	96,  // 196: v1.RLA.DisassociateRuleFromRack:input_type -> v1.DisassociateRuleFromRackRequest
+	97,  // 197: v1.RLA.GetRackRuleAssociation:input_type -> v1.GetRackRuleAssociationRequest
+	99,  // 198: v1.RLA.ListRackRuleAssociations:input_type -> v1.ListRackRuleAssociationsRequest
+	104, // 199: v1.RLA.CreateMaintenanceWindow:input_type -> v1.CreateMaintenanceWindowRequest
+	106, // 200: v1.RLA.UpdateMaintenanceWindow:input_type -> v1.UpdateMaintenanceWindowRequest
+	107, // 201: v1.RLA.DeleteMaintenanceWindow:input_type -> v1.DeleteMaintenanceWindowRequest
+	108, // 202: v1.RLA.GetMaintenanceWindow:input_type -> v1.GetMaintenanceWindowRequest
+	109, // 203: v1.RLA.ListMaintenanceWindows:input_type -> v1.ListMaintenanceWindowsRequest
+	111, // 204: v1.RLA.GetMaintenanceWindowStatus:input_type -> v1.GetMaintenanceWindowStatusRequest
+	85,  // 205: v1.RLA.Version:output_type -> v1.BuildInfo
+	33,  // 206: v1.RLA.CreateExpectedRack:output_type -> v1.CreateExpectedRackResponse
+	38,  // 207: v1.RLA.PatchRack:output_type -> v1.PatchRackResponse
+	36,  // 208: v1.RLA.GetRackInfoByID:output_type -> v1.GetRackInfoResponse
+	36,  // 209: v1.RLA.GetRackInfoBySerial:output_type -> v1.GetRackInfoResponse
+	41,  // 210: v1.RLA.GetComponentInfoByID:output_type -> v1.GetComponentInfoResponse
+	41,  // 211: v1.RLA.GetComponentInfoBySerial:output_type -> v1.GetComponentInfoResponse
+	43,  // 212: v1.RLA.GetListOfRacks:output_type -> v1.GetListOfRacksResponse
+	45,  // 213: v1.RLA.CreateNVLDomain:output_type -> v1.CreateNVLDomainResponse
+	114, // 214: v1.RLA.AttachRacksToNVLDomain:output_type -> google.protobuf.Empty
+	114, // 215: v1.RLA.DetachRacksFromNVLDomain:output_type -> google.protobuf.Empty
+	49,  // 216: v1.RLA.GetListOfNVLDomains:output_type -> v1.GetListOfNVLDomainsResponse
+	51,  // 217: v1.RLA.GetRacksForNVLDomain:output_type -> v1.GetRacksForNVLDomainResponse
+	68,  // 218: v1.RLA.UpgradeFirmware:output_type -> v1.SubmitTaskResponse
+	68,  // 219: v1.RLA.BringUpRack:output_type -> v1.SubmitTaskResponse
+	68,  // 220: v1.RLA.IngestRack:output_type -> v1.SubmitTaskResponse
+	54,  // 221: v1.RLA.GetComponents:output_type -> v1.GetComponentsResponse
+	56,  // 222: v1.RLA.ValidateComponents:output_type -> v1.ValidateComponentsResponse
+	61,  // 223: v1.RLA.ListDriftHistory:output_type -> v1.ListDriftHistoryResponse
+	63,  // 224: v1.RLA.AddComponent:output_type -> v1.AddComponentResponse
+	67,  // 225: v1.RLA.PatchComponent:output_type -> v1.PatchComponentResponse
+	65,  // 226: v1.RLA.DeleteComponent:output_type -> v1.DeleteComponentResponse
+	68,  // 227: v1.RLA.PowerOnRack:output_type -> v1.SubmitTaskResponse
+	68,  // 228: v1.RLA.PowerOffRack:output_type -> v1.SubmitTaskResponse
+	68,  // 229: v1.RLA.PowerResetRack:output_type -> v1.SubmitTaskResponse
+	77,  // 230: v1.RLA.GetPowerStats:output_type -> v1.GetPowerStatsResponse
+	81,  // 231: v1.RLA.ListTasks:output_type -> v1.ListTasksResponse
+	83,  // 232: v1.RLA.GetTasksByIDs:output_type -> v1.GetTasksByIDsResponse
+	88,  // 233: v1.RLA.CreateOperationRule:output_type -> v1.CreateOperationRuleResponse
+	114, // 234: v1.RLA.UpdateOperationRule:output_type -> google.protobuf.Empty
+	114, // 235: v1.RLA.DeleteOperationRule:output_type -> google.protobuf.Empty
+	86,  // 236: v1.RLA.GetOperationRule:output_type -> v1.OperationRule
+	94,  // 237: v1.RLA.ListOperationRules:output_type -> v1.ListOperationRulesResponse
+	114, // 238: v1.RLA.SetRuleAsDefault:output_type -> google.protobuf.Empty
+	114, // 239: v1.RLA.AssociateRuleWithRack:output_type -> google.protobuf.Empty
+	114, // 240: v1.RLA.DisassociateRuleFromRack:output_type -> google.protobuf.Empty
+	98,  // 241: v1.RLA.GetRackRuleAssociation:output_type -> v1.GetRackRuleAssociationResponse
+	101, // 242: v1.RLA.ListRackRuleAssociations:output_type -> v1.ListRackRuleAssociationsResponse
+	105, // 243: v1.RLA.CreateMaintenanceWindow:output_type -> v1.CreateMaintenanceWindowResponse
+	114, // 244: v1.RLA.UpdateMaintenanceWindow:output_type -> google.protobuf.Empty
+	114, // 245: v1.RLA.DeleteMaintenanceWindow:output_type -> google.protobuf.Empty
+	103, // 246: v1.RLA.GetMaintenanceWindow:output_type -> v1.MaintenanceWindow
+	110, // 247: v1.RLA.ListMaintenanceWindows:output_type -> v1.ListMaintenanceWindowsResponse
+	112, // 248: v1.RLA.GetMaintenanceWindowStatus:output_type -> v1.MaintenanceWindowStatus
+	205, // [205:249] is the sub-list for method output_type
+	161, // [161:205] is the sub-list for method input_type
+	161, // [161:161] is the sub-list for extension type_name
+	161, // [161:161] is the sub-list for extension extendee
+	0,   // [0:161] is the sub-list for field type_name
 }
 
 func init() { file_rla_proto_init() }
@@ -6224,6 +7819,7 @@ func file_rla_proto_init() {
 	}
 	file_rla_proto_msgTypes[1].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[4].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[7].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[9].OneofWrappers = []any{
 		(*OperationTargetSpec_Racks)(nil),
 		(*OperationTargetSpec_Components)(nil),
@@ -6249,17 +7845,20 @@ func file_rla_proto_init() {
 	file_rla_proto_msgTypes[41].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[42].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[44].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[52].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[60].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[48].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[55].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[64].OneofWrappers = []any{}
 	file_rla_proto_msgTypes[69].OneofWrappers = []any{}
-	file_rla_proto_msgTypes[73].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[78].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[82].OneofWrappers = []any{}
+	file_rla_proto_msgTypes[98].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rla_proto_rawDesc), len(file_rla_proto_rawDesc)),
 			NumEnums:      11,
-			NumMessages:   82,
+			NumMessages:   102,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -36,42 +36,50 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RLA_Version_FullMethodName                  = "/v1.RLA/Version"
-	RLA_CreateExpectedRack_FullMethodName       = "/v1.RLA/CreateExpectedRack"
-	RLA_PatchRack_FullMethodName                = "/v1.RLA/PatchRack"
-	RLA_GetRackInfoByID_FullMethodName          = "/v1.RLA/GetRackInfoByID"
-	RLA_GetRackInfoBySerial_FullMethodName      = "/v1.RLA/GetRackInfoBySerial"
-	RLA_GetComponentInfoByID_FullMethodName     = "/v1.RLA/GetComponentInfoByID"
-	RLA_GetComponentInfoBySerial_FullMethodName = "/v1.RLA/GetComponentInfoBySerial"
-	RLA_GetListOfRacks_FullMethodName           = "/v1.RLA/GetListOfRacks"
-	RLA_CreateNVLDomain_FullMethodName          = "/v1.RLA/CreateNVLDomain"
-	RLA_AttachRacksToNVLDomain_FullMethodName   = "/v1.RLA/AttachRacksToNVLDomain"
-	RLA_DetachRacksFromNVLDomain_FullMethodName = "/v1.RLA/DetachRacksFromNVLDomain"
-	RLA_GetListOfNVLDomains_FullMethodName      = "/v1.RLA/GetListOfNVLDomains"
-	RLA_GetRacksForNVLDomain_FullMethodName     = "/v1.RLA/GetRacksForNVLDomain"
-	RLA_UpgradeFirmware_FullMethodName          = "/v1.RLA/UpgradeFirmware"
-	RLA_BringUpRack_FullMethodName              = "/v1.RLA/BringUpRack"
-	RLA_IngestRack_FullMethodName               = "/v1.RLA/IngestRack"
-	RLA_GetComponents_FullMethodName            = "/v1.RLA/GetComponents"
-	RLA_ValidateComponents_FullMethodName       = "/v1.RLA/ValidateComponents"
-	RLA_AddComponent_FullMethodName             = "/v1.RLA/AddComponent"
-	RLA_PatchComponent_FullMethodName           = "/v1.RLA/PatchComponent"
-	RLA_DeleteComponent_FullMethodName          = "/v1.RLA/DeleteComponent"
-	RLA_PowerOnRack_FullMethodName              = "/v1.RLA/PowerOnRack"
-	RLA_PowerOffRack_FullMethodName             = "/v1.RLA/PowerOffRack"
-	RLA_PowerResetRack_FullMethodName           = "/v1.RLA/PowerResetRack"
-	RLA_ListTasks_FullMethodName                = "/v1.RLA/ListTasks"
-	RLA_GetTasksByIDs_FullMethodName            = "/v1.RLA/GetTasksByIDs"
-	RLA_CreateOperationRule_FullMethodName      = "/v1.RLA/CreateOperationRule"
-	RLA_UpdateOperationRule_FullMethodName      = "/v1.RLA/UpdateOperationRule"
-	RLA_DeleteOperationRule_FullMethodName      = "/v1.RLA/DeleteOperationRule"
-	RLA_GetOperationRule_FullMethodName         = "/v1.RLA/GetOperationRule"
-	RLA_ListOperationRules_FullMethodName       = "/v1.RLA/ListOperationRules"
-	RLA_SetRuleAsDefault_FullMethodName         = "/v1.RLA/SetRuleAsDefault"
-	RLA_AssociateRuleWithRack_FullMethodName    = "/v1.RLA/AssociateRuleWithRack"
-	RLA_DisassociateRuleFromRack_FullMethodName = "/v1.RLA/DisassociateRuleFromRack"
-	RLA_GetRackRuleAssociation_FullMethodName   = "/v1.RLA/GetRackRuleAssociation"
-	RLA_ListRackRuleAssociations_FullMethodName = "/v1.RLA/ListRackRuleAssociations"
+	RLA_Version_FullMethodName                    = "/v1.RLA/Version"
+	RLA_CreateExpectedRack_FullMethodName         = "/v1.RLA/CreateExpectedRack"
+	RLA_PatchRack_FullMethodName                  = "/v1.RLA/PatchRack"
+	RLA_GetRackInfoByID_FullMethodName            = "/v1.RLA/GetRackInfoByID"
+	RLA_GetRackInfoBySerial_FullMethodName        = "/v1.RLA/GetRackInfoBySerial"
+	RLA_GetComponentInfoByID_FullMethodName       = "/v1.RLA/GetComponentInfoByID"
+	RLA_GetComponentInfoBySerial_FullMethodName   = "/v1.RLA/GetComponentInfoBySerial"
+	RLA_GetListOfRacks_FullMethodName             = "/v1.RLA/GetListOfRacks"
+	RLA_CreateNVLDomain_FullMethodName            = "/v1.RLA/CreateNVLDomain"
+	RLA_AttachRacksToNVLDomain_FullMethodName     = "/v1.RLA/AttachRacksToNVLDomain"
+	RLA_DetachRacksFromNVLDomain_FullMethodName   = "/v1.RLA/DetachRacksFromNVLDomain"
+	RLA_GetListOfNVLDomains_FullMethodName        = "/v1.RLA/GetListOfNVLDomains"
+	RLA_GetRacksForNVLDomain_FullMethodName       = "/v1.RLA/GetRacksForNVLDomain"
+	RLA_UpgradeFirmware_FullMethodName            = "/v1.RLA/UpgradeFirmware"
+	RLA_BringUpRack_FullMethodName                = "/v1.RLA/BringUpRack"
+	RLA_IngestRack_FullMethodName                 = "/v1.RLA/IngestRack"
+	RLA_GetComponents_FullMethodName              = "/v1.RLA/GetComponents"
+	RLA_ValidateComponents_FullMethodName         = "/v1.RLA/ValidateComponents"
+	RLA_ListDriftHistory_FullMethodName           = "/v1.RLA/ListDriftHistory"
+	RLA_AddComponent_FullMethodName               = "/v1.RLA/AddComponent"
+	RLA_PatchComponent_FullMethodName             = "/v1.RLA/PatchComponent"
+	RLA_DeleteComponent_FullMethodName            = "/v1.RLA/DeleteComponent"
+	RLA_PowerOnRack_FullMethodName                = "/v1.RLA/PowerOnRack"
+	RLA_PowerOffRack_FullMethodName               = "/v1.RLA/PowerOffRack"
+	RLA_PowerResetRack_FullMethodName             = "/v1.RLA/PowerResetRack"
+	RLA_GetPowerStats_FullMethodName              = "/v1.RLA/GetPowerStats"
+	RLA_ListTasks_FullMethodName                  = "/v1.RLA/ListTasks"
+	RLA_GetTasksByIDs_FullMethodName              = "/v1.RLA/GetTasksByIDs"
+	RLA_CreateOperationRule_FullMethodName        = "/v1.RLA/CreateOperationRule"
+	RLA_UpdateOperationRule_FullMethodName        = "/v1.RLA/UpdateOperationRule"
+	RLA_DeleteOperationRule_FullMethodName        = "/v1.RLA/DeleteOperationRule"
+	RLA_GetOperationRule_FullMethodName           = "/v1.RLA/GetOperationRule"
+	RLA_ListOperationRules_FullMethodName         = "/v1.RLA/ListOperationRules"
+	RLA_SetRuleAsDefault_FullMethodName           = "/v1.RLA/SetRuleAsDefault"
+	RLA_AssociateRuleWithRack_FullMethodName      = "/v1.RLA/AssociateRuleWithRack"
+	RLA_DisassociateRuleFromRack_FullMethodName   = "/v1.RLA/DisassociateRuleFromRack"
+	RLA_GetRackRuleAssociation_FullMethodName     = "/v1.RLA/GetRackRuleAssociation"
+	RLA_ListRackRuleAssociations_FullMethodName   = "/v1.RLA/ListRackRuleAssociations"
+	RLA_CreateMaintenanceWindow_FullMethodName    = "/v1.RLA/CreateMaintenanceWindow"
+	RLA_UpdateMaintenanceWindow_FullMethodName    = "/v1.RLA/UpdateMaintenanceWindow"
+	RLA_DeleteMaintenanceWindow_FullMethodName    = "/v1.RLA/DeleteMaintenanceWindow"
+	RLA_GetMaintenanceWindow_FullMethodName       = "/v1.RLA/GetMaintenanceWindow"
+	RLA_ListMaintenanceWindows_FullMethodName     = "/v1.RLA/ListMaintenanceWindows"
+	RLA_GetMaintenanceWindowStatus_FullMethodName = "/v1.RLA/GetMaintenanceWindowStatus"
 )
 
 // RLAClient is the client API for RLA service.
@@ -100,6 +108,7 @@ type RLAClient interface {
 	// Components APIs
 	GetComponents(ctx context.Context, in *GetComponentsRequest, opts ...grpc.CallOption) (*GetComponentsResponse, error)
 	ValidateComponents(ctx context.Context, in *ValidateComponentsRequest, opts ...grpc.CallOption) (*ValidateComponentsResponse, error)
+	ListDriftHistory(ctx context.Context, in *ListDriftHistoryRequest, opts ...grpc.CallOption) (*ListDriftHistoryResponse, error)
 	AddComponent(ctx context.Context, in *AddComponentRequest, opts ...grpc.CallOption) (*AddComponentResponse, error)
 	PatchComponent(ctx context.Context, in *PatchComponentRequest, opts ...grpc.CallOption) (*PatchComponentResponse, error)
 	DeleteComponent(ctx context.Context, in *DeleteComponentRequest, opts ...grpc.CallOption) (*DeleteComponentResponse, error)
@@ -107,6 +116,8 @@ type RLAClient interface {
 	PowerOnRack(ctx context.Context, in *PowerOnRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
 	PowerOffRack(ctx context.Context, in *PowerOffRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
 	PowerResetRack(ctx context.Context, in *PowerResetRackRequest, opts ...grpc.CallOption) (*SubmitTaskResponse, error)
+	// Current power draw of components, aggregated per rack and NVL domain
+	GetPowerStats(ctx context.Context, in *GetPowerStatsRequest, opts ...grpc.CallOption) (*GetPowerStatsResponse, error)
 	// Query for tasks
 	ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error)
 	GetTasksByIDs(ctx context.Context, in *GetTasksByIDsRequest, opts ...grpc.CallOption) (*GetTasksByIDsResponse, error)
@@ -122,6 +133,13 @@ type RLAClient interface {
 	DisassociateRuleFromRack(ctx context.Context, in *DisassociateRuleFromRackRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRackRuleAssociation(ctx context.Context, in *GetRackRuleAssociationRequest, opts ...grpc.CallOption) (*GetRackRuleAssociationResponse, error)
 	ListRackRuleAssociations(ctx context.Context, in *ListRackRuleAssociationsRequest, opts ...grpc.CallOption) (*ListRackRuleAssociationsResponse, error)
+	// Maintenance windows: disruptive tasks (power, firmware, bring-up) only run while open
+	CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error)
+	UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error)
+	GetMaintenanceWindowStatus(ctx context.Context, in *GetMaintenanceWindowStatusRequest, opts ...grpc.CallOption) (*MaintenanceWindowStatus, error)
 }
 
 type rLAClient struct {
@@ -312,6 +330,16 @@ func (c *rLAClient) ValidateComponents(ctx context.Context, in *ValidateComponen
 	return out, nil
 }
 
+func (c *rLAClient) ListDriftHistory(ctx context.Context, in *ListDriftHistoryRequest, opts ...grpc.CallOption) (*ListDriftHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriftHistoryResponse)
+	err := c.cc.Invoke(ctx, RLA_ListDriftHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rLAClient) AddComponent(ctx context.Context, in *AddComponentRequest, opts ...grpc.CallOption) (*AddComponentResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddComponentResponse)
@@ -372,6 +400,16 @@ func (c *rLAClient) PowerResetRack(ctx context.Context, in *PowerResetRackReques
 	return out, nil
 }
 
+func (c *rLAClient) GetPowerStats(ctx context.Context, in *GetPowerStatsRequest, opts ...grpc.CallOption) (*GetPowerStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPowerStatsResponse)
+	err := c.cc.Invoke(ctx, RLA_GetPowerStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rLAClient) ListTasks(ctx context.Context, in *ListTasksRequest, opts ...grpc.CallOption) (*ListTasksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListTasksResponse)
@@ -492,6 +530,66 @@ func (c *rLAClient) ListRackRuleAssociations(ctx context.Context, in *ListRackRu
 	return out, nil
 }
 
+func (c *rLAClient) CreateMaintenanceWindow(ctx context.Context, in *CreateMaintenanceWindowRequest, opts ...grpc.CallOption) (*CreateMaintenanceWindowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMaintenanceWindowResponse)
+	err := c.cc.Invoke(ctx, RLA_CreateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) UpdateMaintenanceWindow(ctx context.Context, in *UpdateMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RLA_UpdateMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) DeleteMaintenanceWindow(ctx context.Context, in *DeleteMaintenanceWindowRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, RLA_DeleteMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetMaintenanceWindow(ctx context.Context, in *GetMaintenanceWindowRequest, opts ...grpc.CallOption) (*MaintenanceWindow, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindow)
+	err := c.cc.Invoke(ctx, RLA_GetMaintenanceWindow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) ListMaintenanceWindows(ctx context.Context, in *ListMaintenanceWindowsRequest, opts ...grpc.CallOption) (*ListMaintenanceWindowsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMaintenanceWindowsResponse)
+	err := c.cc.Invoke(ctx, RLA_ListMaintenanceWindows_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rLAClient) GetMaintenanceWindowStatus(ctx context.Context, in *GetMaintenanceWindowStatusRequest, opts ...grpc.CallOption) (*MaintenanceWindowStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MaintenanceWindowStatus)
+	err := c.cc.Invoke(ctx, RLA_GetMaintenanceWindowStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RLAServer is the server API for RLA service.
 // All implementations must embed UnimplementedRLAServer
 // for forward compatibility.
@@ -518,6 +616,7 @@ type RLAServer interface {
 	// Components APIs
 	GetComponents(context.Context, *GetComponentsRequest) (*GetComponentsResponse, error)
 	ValidateComponents(context.Context, *ValidateComponentsRequest) (*ValidateComponentsResponse, error)
+	ListDriftHistory(context.Context, *ListDriftHistoryRequest) (*ListDriftHistoryResponse, error)
 	AddComponent(context.Context, *AddComponentRequest) (*AddComponentResponse, error)
 	PatchComponent(context.Context, *PatchComponentRequest) (*PatchComponentResponse, error)
 	DeleteComponent(context.Context, *DeleteComponentRequest) (*DeleteComponentResponse, error)
@@ -525,6 +624,8 @@ type RLAServer interface {
 	PowerOnRack(context.Context, *PowerOnRackRequest) (*SubmitTaskResponse, error)
 	PowerOffRack(context.Context, *PowerOffRackRequest) (*SubmitTaskResponse, error)
 	PowerResetRack(context.Context, *PowerResetRackRequest) (*SubmitTaskResponse, error)
+	// Current power draw of components, aggregated per rack and NVL domain
+	GetPowerStats(context.Context, *GetPowerStatsRequest) (*GetPowerStatsResponse, error)
 	// Query for tasks
 	ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error)
 	GetTasksByIDs(context.Context, *GetTasksByIDsRequest) (*GetTasksByIDsResponse, error)
@@ -540,6 +641,13 @@ type RLAServer interface {
 	DisassociateRuleFromRack(context.Context, *DisassociateRuleFromRackRequest) (*emptypb.Empty, error)
 	GetRackRuleAssociation(context.Context, *GetRackRuleAssociationRequest) (*GetRackRuleAssociationResponse, error)
 	ListRackRuleAssociations(context.Context, *ListRackRuleAssociationsRequest) (*ListRackRuleAssociationsResponse, error)
+	// Maintenance windows: disruptive tasks (power, firmware, bring-up) only run while open
+	CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error)
+	UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*emptypb.Empty, error)
+	DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error)
+	GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*MaintenanceWindow, error)
+	ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error)
+	GetMaintenanceWindowStatus(context.Context, *GetMaintenanceWindowStatusRequest) (*MaintenanceWindowStatus, error)
 	mustEmbedUnimplementedRLAServer()
 }
 
@@ -604,6 +712,9 @@ func (UnimplementedRLAServer) GetComponents(context.Context, *GetComponentsReque
 func (UnimplementedRLAServer) ValidateComponents(context.Context, *ValidateComponentsRequest) (*ValidateComponentsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ValidateComponents not implemented")
 }
+func (UnimplementedRLAServer) ListDriftHistory(context.Context, *ListDriftHistoryRequest) (*ListDriftHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDriftHistory not implemented")
+}
 func (UnimplementedRLAServer) AddComponent(context.Context, *AddComponentRequest) (*AddComponentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AddComponent not implemented")
 }
@@ -622,6 +733,9 @@ func (UnimplementedRLAServer) PowerOffRack(context.Context, *PowerOffRackRequest
 func (UnimplementedRLAServer) PowerResetRack(context.Context, *PowerResetRackRequest) (*SubmitTaskResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method PowerResetRack not implemented")
 }
+func (UnimplementedRLAServer) GetPowerStats(context.Context, *GetPowerStatsRequest) (*GetPowerStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPowerStats not implemented")
+}
 func (UnimplementedRLAServer) ListTasks(context.Context, *ListTasksRequest) (*ListTasksResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListTasks not implemented")
 }
@@ -658,6 +772,24 @@ func (UnimplementedRLAServer) GetRackRuleAssociation(context.Context, *GetRackRu
 func (UnimplementedRLAServer) ListRackRuleAssociations(context.Context, *ListRackRuleAssociationsRequest) (*ListRackRuleAssociationsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListRackRuleAssociations not implemented")
 }
+func (UnimplementedRLAServer) CreateMaintenanceWindow(context.Context, *CreateMaintenanceWindowRequest) (*CreateMaintenanceWindowResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) UpdateMaintenanceWindow(context.Context, *UpdateMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) DeleteMaintenanceWindow(context.Context, *DeleteMaintenanceWindowRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) GetMaintenanceWindow(context.Context, *GetMaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMaintenanceWindow not implemented")
+}
+func (UnimplementedRLAServer) ListMaintenanceWindows(context.Context, *ListMaintenanceWindowsRequest) (*ListMaintenanceWindowsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMaintenanceWindows not implemented")
+}
+func (UnimplementedRLAServer) GetMaintenanceWindowStatus(context.Context, *GetMaintenanceWindowStatusRequest) (*MaintenanceWindowStatus, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMaintenanceWindowStatus not implemented")
+}
 func (UnimplementedRLAServer) mustEmbedUnimplementedRLAServer() {}
 func (UnimplementedRLAServer) testEmbeddedByValue()             {}
 
@@ -1003,6 +1135,24 @@ func _RLA_ValidateComponents_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_ListDriftHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriftHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListDriftHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListDriftHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListDriftHistory(ctx, req.(*ListDriftHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RLA_AddComponent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AddComponentRequest)
 	if err := dec(in); err != nil {
@@ -1111,6 +1261,24 @@ func _RLA_PowerResetRack_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_GetPowerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPowerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetPowerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetPowerStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetPowerStats(ctx, req.(*GetPowerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RLA_ListTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTasksRequest)
 	if err := dec(in); err != nil {
@@ -1327,6 +1495,114 @@ func _RLA_ListRackRuleAssociations_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RLA_CreateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).CreateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_CreateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).CreateMaintenanceWindow(ctx, req.(*CreateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_UpdateMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).UpdateMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_UpdateMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).UpdateMaintenanceWindow(ctx, req.(*UpdateMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_DeleteMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).DeleteMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_DeleteMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).DeleteMaintenanceWindow(ctx, req.(*DeleteMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetMaintenanceWindow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetMaintenanceWindow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetMaintenanceWindow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetMaintenanceWindow(ctx, req.(*GetMaintenanceWindowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_ListMaintenanceWindows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMaintenanceWindowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).ListMaintenanceWindows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_ListMaintenanceWindows_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).ListMaintenanceWindows(ctx, req.(*ListMaintenanceWindowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RLA_GetMaintenanceWindowStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMaintenanceWindowStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RLAServer).GetMaintenanceWindowStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RLA_GetMaintenanceWindowStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RLAServer).GetMaintenanceWindowStatus(ctx, req.(*GetMaintenanceWindowStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RLA_ServiceDesc is the grpc.ServiceDesc for RLA service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1406,6 +1682,10 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ValidateComponents",
 			Handler:    _RLA_ValidateComponents_Handler,
 		},
+		{
+			MethodName: "ListDriftHistory",
+			Handler:    _RLA_ListDriftHistory_Handler,
+		},
 		{
 			MethodName: "AddComponent",
 			Handler:    _RLA_AddComponent_Handler,
@@ -1430,6 +1710,10 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PowerResetRack",
 			Handler:    _RLA_PowerResetRack_Handler,
 		},
+		{
+			MethodName: "GetPowerStats",
+			Handler:    _RLA_GetPowerStats_Handler,
+		},
 		{
 			MethodName: "ListTasks",
 			Handler:    _RLA_ListTasks_Handler,
@@ -1478,6 +1762,30 @@ var RLA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListRackRuleAssociations",
 			Handler:    _RLA_ListRackRuleAssociations_Handler,
 		},
+		{
+			MethodName: "CreateMaintenanceWindow",
+			Handler:    _RLA_CreateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "UpdateMaintenanceWindow",
+			Handler:    _RLA_UpdateMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "DeleteMaintenanceWindow",
+			Handler:    _RLA_DeleteMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindow",
+			Handler:    _RLA_GetMaintenanceWindow_Handler,
+		},
+		{
+			MethodName: "ListMaintenanceWindows",
+			Handler:    _RLA_ListMaintenanceWindows_Handler,
+		},
+		{
+			MethodName: "GetMaintenanceWindowStatus",
+			Handler:    _RLA_GetMaintenanceWindowStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rla.proto",
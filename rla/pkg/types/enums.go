@@ -66,6 +66,8 @@ const (
 	TaskStatusRunning   TaskStatus = "RUNNING"
 	TaskStatusCompleted TaskStatus = "COMPLETED"
 	TaskStatusFailed    TaskStatus = "FAILED"
+	TaskStatusWaiting   TaskStatus = "WAITING"
+	TaskStatusPaused    TaskStatus = "PAUSED"
 )
 
 // TaskExecutorType represents the type of task executor.
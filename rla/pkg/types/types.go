@@ -72,9 +72,10 @@ type Component struct {
 
 // Rack represents a physical rack containing components.
 type Rack struct {
-	Info       DeviceInfo
-	Location   Location
-	Components []Component
+	Info             DeviceInfo
+	Location         Location
+	Components       []Component
+	PowerBudgetWatts *float64 // nil or 0 means the rack has no power budget
 }
 
 // NVLDomain represents an NVL domain (a group of related racks).
@@ -113,6 +114,10 @@ type Task struct {
 	ExecutionID  string
 	Status       TaskStatus
 	Message      string
+	// WindowOverride is set when the task was submitted to run outside the
+	// maintenance window; OverrideReason records why.
+	WindowOverride bool
+	OverrideReason string
 }
 
 // ComponentDiff represents a difference found during validation.
@@ -122,6 +127,7 @@ type ComponentDiff struct {
 	Expected    *Component
 	Actual      *Component
 	FieldDiffs  []FieldDiff
+	FirstSeenAt *time.Time // When the inventory loop first detected the drift
 }
 
 // FieldDiff represents a single field difference.
@@ -131,6 +137,23 @@ type FieldDiff struct {
 	ActualValue   string
 }
 
+// DriftHistoryEntry is the lifetime of a single drift detected by the
+// inventory loop. Mismatches are tracked per field; several entries for the
+// same component and field indicate a flapping drift.
+type DriftHistoryEntry struct {
+	ID            uuid.UUID
+	ComponentID   string // Empty for DiffTypeOnlyInActual
+	ExternalID    string
+	Type          DiffType
+	FieldName     string // Empty unless Type is DiffTypeDrift
+	ExpectedValue string
+	ActualValue   string
+	FirstSeenAt   time.Time
+	LastSeenAt    time.Time
+	ResolvedAt    *time.Time // nil while the drift is still detected
+	Remediation   string     // "accepted" or "alerted" if a policy acted on the drift
+}
+
 // OperationRule represents a configurable rule for executing operations.
 type OperationRule struct {
 	ID                 uuid.UUID
@@ -153,3 +176,68 @@ type RackRuleAssociation struct {
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
+
+// MaintenanceWindow represents a recurring window during which disruptive
+// tasks (power control, firmware upgrade, bring-up) may run. A nil RackID
+// makes the window site-wide; rack windows take precedence over site windows.
+type MaintenanceWindow struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	RackID      *uuid.UUID
+	Schedule    string        // Standard 5-field cron expression, e.g. "0 2 * * 6"
+	Duration    time.Duration // How long the window stays open after each opening
+	Timezone    string        // IANA timezone for the schedule (default UTC)
+	Blackouts   []BlackoutPeriod
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// BlackoutPeriod is a period during which a maintenance window is closed.
+type BlackoutPeriod struct {
+	Start  time.Time
+	End    time.Time
+	Reason string
+}
+
+// MaintenanceWindowStatus reports whether disruptive work may run on a rack.
+type MaintenanceWindowStatus struct {
+	Governed    bool // false if no enabled window applies to the rack
+	Open        bool
+	ClosesAt    *time.Time
+	NextOpening *time.Time
+	WindowID    uuid.UUID
+	WindowName  string
+}
+
+// ComponentPowerStats is the latest power reading of a single component.
+type ComponentPowerStats struct {
+	ID          uuid.UUID
+	ComponentID string // Component ID from the component manager service
+	Type        ComponentType
+	RackID      uuid.UUID
+	Watts       float64 // Consumed power; output power for power shelves
+	Available   bool    // false if no reading could be collected
+}
+
+// RackPowerStats aggregates the power readings of a rack.
+type RackPowerStats struct {
+	RackID                uuid.UUID
+	RackName              string
+	NVLDomainID           uuid.UUID // uuid.Nil if the rack is not in an NVL domain
+	ComputeWatts          float64
+	NVLSwitchWatts        float64
+	PowerShelfOutputWatts float64
+	DrawWatts             float64 // Power shelf output if reported, else compute + NVLink switch
+	BudgetWatts           *float64
+	UnavailableComponents int
+}
+
+// NVLDomainPowerStats aggregates the power readings of the racks of an NVL domain.
+type NVLDomainPowerStats struct {
+	NVLDomain   Identifier
+	DrawWatts   float64
+	BudgetWatts float64 // Sum of the budgets of racks that have one
+	RackCount   int
+}
@@ -0,0 +1,236 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simple
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+// AllocationConstraint represents a simplified Allocation Constraint
+type AllocationConstraint struct {
+	ID                string  `json:"id"`
+	ResourceType      string  `json:"resourceType"`
+	ResourceTypeID    string  `json:"resourceTypeId"`
+	ConstraintType    string  `json:"constraintType"`
+	ConstraintValue   int     `json:"constraintValue"`
+	DerivedResourceID *string `json:"derivedResourceId"`
+}
+
+// Allocation represents a simplified Allocation
+type Allocation struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description *string                `json:"description"`
+	TenantID    string                 `json:"tenantId"`
+	SiteID      string                 `json:"siteId"`
+	Constraints []AllocationConstraint `json:"constraints"`
+	Status      string                 `json:"status"`
+	Created     time.Time              `json:"created"`
+	Updated     time.Time              `json:"updated"`
+}
+
+// AllocationConstraintCreateRequest is a request to create an Allocation Constraint
+type AllocationConstraintCreateRequest struct {
+	ResourceType    string `json:"resourceType"`
+	ResourceTypeID  string `json:"resourceTypeId"`
+	ConstraintType  string `json:"constraintType"`
+	ConstraintValue int    `json:"constraintValue"`
+}
+
+// AllocationCreateRequest is a request to create an Allocation of the current Site's resources for a Tenant
+type AllocationCreateRequest struct {
+	Name        string                              `json:"name"`
+	Description *string                             `json:"description"`
+	TenantID    string                              `json:"tenantId"`
+	Constraints []AllocationConstraintCreateRequest `json:"constraints"`
+}
+
+// AllocationUpdateRequest is a request to update an Allocation
+type AllocationUpdateRequest struct {
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+// AllocationManager manages Allocation operations
+type AllocationManager struct {
+	client *Client
+}
+
+// NewAllocationManager creates a new AllocationManager
+func NewAllocationManager(client *Client) AllocationManager {
+	return AllocationManager{client: client}
+}
+
+func allocationFromStandard(api standard.Allocation) Allocation {
+	a := Allocation{}
+	if api.Id != nil {
+		a.ID = *api.Id
+	}
+	if api.Name != nil {
+		a.Name = *api.Name
+	}
+	a.Description = api.Description
+	if api.TenantId != nil {
+		a.TenantID = *api.TenantId
+	}
+	if api.SiteId != nil {
+		a.SiteID = *api.SiteId
+	}
+	for _, apiAc := range api.AllocationConstraints {
+		ac := AllocationConstraint{DerivedResourceID: apiAc.DerivedResourceId.Get()}
+		if apiAc.Id != nil {
+			ac.ID = *apiAc.Id
+		}
+		if apiAc.ResourceType != nil {
+			ac.ResourceType = *apiAc.ResourceType
+		}
+		if apiAc.ResourceTypeId != nil {
+			ac.ResourceTypeID = *apiAc.ResourceTypeId
+		}
+		if apiAc.ConstraintType != nil {
+			ac.ConstraintType = *apiAc.ConstraintType
+		}
+		if apiAc.ConstraintValue != nil {
+			ac.ConstraintValue = int(*apiAc.ConstraintValue)
+		}
+		a.Constraints = append(a.Constraints, ac)
+	}
+	if api.Status != nil {
+		a.Status = string(*api.Status)
+	}
+	if api.Created != nil {
+		a.Created = *api.Created
+	}
+	if api.Updated != nil {
+		a.Updated = *api.Updated
+	}
+	return a
+}
+
+// Create creates a new Allocation on the current Site
+func (am AllocationManager) Create(ctx context.Context, request AllocationCreateRequest) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, am.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, am.client.Config.Token)
+
+	apiReq := standard.AllocationCreateRequest{
+		Name:        request.Name,
+		Description: request.Description,
+		TenantId:    request.TenantID,
+		SiteId:      am.client.apiMetadata.SiteID,
+	}
+	for _, ac := range request.Constraints {
+		apiReq.AllocationConstraints = append(apiReq.AllocationConstraints, standard.AllocationConstraintCreateRequest{
+			ResourceType:    standard.PtrString(ac.ResourceType),
+			ResourceTypeId:  ac.ResourceTypeID,
+			ConstraintType:  ac.ConstraintType,
+			ConstraintValue: int32(ac.ConstraintValue),
+		})
+	}
+	apiAlloc, resp, err := am.client.apiClient.AllocationAPI.CreateAllocation(ctx, am.client.apiMetadata.Organization).
+		AllocationCreateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	a := allocationFromStandard(*apiAlloc)
+	return &a, nil
+}
+
+// Get returns an Allocation by ID
+func (am AllocationManager) Get(ctx context.Context, id string) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, am.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, am.client.Config.Token)
+
+	apiAlloc, resp, err := am.client.apiClient.AllocationAPI.GetAllocation(ctx, am.client.apiMetadata.Organization, id).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	a := allocationFromStandard(*apiAlloc)
+	return &a, nil
+}
+
+// GetAllocations returns all Allocations for the current Site
+func (am AllocationManager) GetAllocations(ctx context.Context, paginationFilter *PaginationFilter) ([]Allocation, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, am.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, am.client.Config.Token)
+
+	gar := am.client.apiClient.AllocationAPI.GetAllAllocation(ctx, am.client.apiMetadata.Organization).
+		SiteId(am.client.apiMetadata.SiteID)
+	if paginationFilter != nil {
+		if paginationFilter.PageNumber != nil {
+			gar = gar.PageNumber(int32(*paginationFilter.PageNumber))
+		}
+		if paginationFilter.PageSize != nil {
+			gar = gar.PageSize(int32(*paginationFilter.PageSize))
+		}
+		if paginationFilter.OrderBy != nil {
+			gar = gar.OrderBy(*paginationFilter.OrderBy)
+		}
+	}
+
+	apiAllocs, resp, err := gar.Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	allocs := make([]Allocation, 0, len(apiAllocs))
+	for _, apiAlloc := range apiAllocs {
+		allocs = append(allocs, allocationFromStandard(apiAlloc))
+	}
+
+	paginationResponse, perr := standard.GetPaginationResponse(ctx, resp)
+	if perr != nil {
+		return nil, nil, &ApiError{
+			Code:    http.StatusInternalServerError,
+			Message: "failed to extract pagination: " + perr.Error(),
+			Data:    map[string]interface{}{"parseError": perr.Error()},
+		}
+	}
+	return allocs, paginationResponse, nil
+}
+
+// Update updates an Allocation
+func (am AllocationManager) Update(ctx context.Context, id string, request AllocationUpdateRequest) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, am.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, am.client.Config.Token)
+
+	apiReq := standard.AllocationUpdateRequest{Name: request.Name, Description: request.Description}
+	apiAlloc, resp, err := am.client.apiClient.AllocationAPI.UpdateAllocation(ctx, am.client.apiMetadata.Organization, id).
+		AllocationUpdateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	a := allocationFromStandard(*apiAlloc)
+	return &a, nil
+}
+
+// Delete deletes an Allocation
+func (am AllocationManager) Delete(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, am.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, am.client.Config.Token)
+
+	resp, err := am.client.apiClient.AllocationAPI.DeleteAllocation(ctx, am.client.apiMetadata.Organization, id).Execute()
+	return HandleResponseError(resp, err)
+}
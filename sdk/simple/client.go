@@ -92,6 +92,34 @@ type ClientInterface interface {
 	GetVpc(ctx context.Context, id string) (*Vpc, *ApiError)
 	UpdateVpc(ctx context.Context, id string, request VpcUpdateRequest) (*Vpc, *ApiError)
 	DeleteVpc(ctx context.Context, id string) *ApiError
+
+	// VPC Prefix management interfaces
+	CreateVpcPrefix(ctx context.Context, request VpcPrefixCreateRequest) (*VpcPrefix, *ApiError)
+	GetVpcPrefixes(ctx context.Context, vpcPrefixFilter *VpcPrefixFilter, paginationFilter *PaginationFilter) ([]VpcPrefix, *standard.PaginationResponse, *ApiError)
+	GetVpcPrefix(ctx context.Context, id string) (*VpcPrefix, *ApiError)
+	UpdateVpcPrefix(ctx context.Context, id string, request VpcPrefixUpdateRequest) (*VpcPrefix, *ApiError)
+	DeleteVpcPrefix(ctx context.Context, id string) *ApiError
+
+	// Subnet management interfaces
+	CreateSubnet(ctx context.Context, request SubnetCreateRequest) (*Subnet, *ApiError)
+	GetSubnets(ctx context.Context, subnetFilter *SubnetFilter, paginationFilter *PaginationFilter) ([]Subnet, *standard.PaginationResponse, *ApiError)
+	GetSubnet(ctx context.Context, id string) (*Subnet, *ApiError)
+	UpdateSubnet(ctx context.Context, id string, request SubnetUpdateRequest) (*Subnet, *ApiError)
+	DeleteSubnet(ctx context.Context, id string) *ApiError
+
+	// Network Security Group management interfaces
+	CreateNetworkSecurityGroup(ctx context.Context, request NetworkSecurityGroupCreateRequest) (*NetworkSecurityGroup, *ApiError)
+	GetNetworkSecurityGroups(ctx context.Context, paginationFilter *PaginationFilter) ([]NetworkSecurityGroup, *standard.PaginationResponse, *ApiError)
+	GetNetworkSecurityGroup(ctx context.Context, id string) (*NetworkSecurityGroup, *ApiError)
+	UpdateNetworkSecurityGroup(ctx context.Context, id string, request NetworkSecurityGroupUpdateRequest) (*NetworkSecurityGroup, *ApiError)
+	DeleteNetworkSecurityGroup(ctx context.Context, id string) *ApiError
+
+	// Allocation management interfaces
+	CreateAllocation(ctx context.Context, request AllocationCreateRequest) (*Allocation, *ApiError)
+	GetAllocations(ctx context.Context, paginationFilter *PaginationFilter) ([]Allocation, *standard.PaginationResponse, *ApiError)
+	GetAllocation(ctx context.Context, id string) (*Allocation, *ApiError)
+	UpdateAllocation(ctx context.Context, id string, request AllocationUpdateRequest) (*Allocation, *ApiError)
+	DeleteAllocation(ctx context.Context, id string) *ApiError
 }
 
 // Ensure *Client implements ClientInterface at compile time
@@ -470,6 +498,24 @@ func (c *Client) CreateSshKeyGroupForInstance(ctx context.Context, instanceName
 
 	return NewSshKeyGroupManager(c).CreateSshKeyGroupForInstance(ctx, instanceName, sshPublicKeys)
 }
+func (c *Client) CreateSshKeyGroup(ctx context.Context, request SshKeyGroupCreateRequest) (*standard.SshKeyGroup, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Creating SSH Key Group for org: %s", c.Config.Org)
+
+	return NewSshKeyGroupManager(c).CreateSshKeyGroup(ctx, request)
+}
+func (c *Client) UpdateSshKeyGroup(ctx context.Context, sshKeyGroupID string, request SshKeyGroupUpdateRequest) (*standard.SshKeyGroup, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Updating SSH Key Group for org: %s", c.Config.Org)
+
+	return NewSshKeyGroupManager(c).UpdateSshKeyGroup(ctx, sshKeyGroupID, request)
+}
 func (c *Client) GetSshKeyGroup(ctx context.Context, sshKeyGroupID string) (*standard.SshKeyGroup, *ApiError) {
 	ctx = WithLogger(ctx, c.Logger)
 	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
@@ -648,6 +694,194 @@ func (c *Client) DeleteVpc(ctx context.Context, id string) *ApiError {
 	return NewVpcManager(c).DeleteVpc(ctx, id)
 }
 
+// VpcPrefix
+func (c *Client) CreateVpcPrefix(ctx context.Context, request VpcPrefixCreateRequest) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Creating VPC Prefix for org: %s", c.Config.Org)
+
+	return NewVpcPrefixManager(c).Create(ctx, request)
+}
+func (c *Client) GetVpcPrefixes(ctx context.Context, vpcPrefixFilter *VpcPrefixFilter, paginationFilter *PaginationFilter) ([]VpcPrefix, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting all VPC Prefixes for org: %s", c.Config.Org)
+
+	return NewVpcPrefixManager(c).GetVpcPrefixes(ctx, vpcPrefixFilter, paginationFilter)
+}
+func (c *Client) GetVpcPrefix(ctx context.Context, id string) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting VPC Prefix for org: %s", c.Config.Org)
+
+	return NewVpcPrefixManager(c).Get(ctx, id)
+}
+func (c *Client) UpdateVpcPrefix(ctx context.Context, id string, request VpcPrefixUpdateRequest) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Updating VPC Prefix for org: %s", c.Config.Org)
+
+	return NewVpcPrefixManager(c).Update(ctx, id, request)
+}
+func (c *Client) DeleteVpcPrefix(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Deleting VPC Prefix for org: %s", c.Config.Org)
+
+	return NewVpcPrefixManager(c).Delete(ctx, id)
+}
+
+// Subnet
+func (c *Client) CreateSubnet(ctx context.Context, request SubnetCreateRequest) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Creating Subnet for org: %s", c.Config.Org)
+
+	return NewSubnetManager(c).Create(ctx, request)
+}
+func (c *Client) GetSubnets(ctx context.Context, subnetFilter *SubnetFilter, paginationFilter *PaginationFilter) ([]Subnet, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting all Subnets for org: %s", c.Config.Org)
+
+	return NewSubnetManager(c).GetSubnets(ctx, subnetFilter, paginationFilter)
+}
+func (c *Client) GetSubnet(ctx context.Context, id string) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting Subnet for org: %s", c.Config.Org)
+
+	return NewSubnetManager(c).Get(ctx, id)
+}
+func (c *Client) UpdateSubnet(ctx context.Context, id string, request SubnetUpdateRequest) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Updating Subnet for org: %s", c.Config.Org)
+
+	return NewSubnetManager(c).Update(ctx, id, request)
+}
+func (c *Client) DeleteSubnet(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Deleting Subnet for org: %s", c.Config.Org)
+
+	return NewSubnetManager(c).Delete(ctx, id)
+}
+
+// NetworkSecurityGroup
+func (c *Client) CreateNetworkSecurityGroup(ctx context.Context, request NetworkSecurityGroupCreateRequest) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Creating Network Security Group for org: %s", c.Config.Org)
+
+	return NewNetworkSecurityGroupManager(c).Create(ctx, request)
+}
+func (c *Client) GetNetworkSecurityGroups(ctx context.Context, paginationFilter *PaginationFilter) ([]NetworkSecurityGroup, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting all Network Security Groups for org: %s", c.Config.Org)
+
+	return NewNetworkSecurityGroupManager(c).GetNetworkSecurityGroups(ctx, paginationFilter)
+}
+func (c *Client) GetNetworkSecurityGroup(ctx context.Context, id string) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting Network Security Group for org: %s", c.Config.Org)
+
+	return NewNetworkSecurityGroupManager(c).Get(ctx, id)
+}
+func (c *Client) UpdateNetworkSecurityGroup(ctx context.Context, id string, request NetworkSecurityGroupUpdateRequest) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Updating Network Security Group for org: %s", c.Config.Org)
+
+	return NewNetworkSecurityGroupManager(c).Update(ctx, id, request)
+}
+func (c *Client) DeleteNetworkSecurityGroup(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Deleting Network Security Group for org: %s", c.Config.Org)
+
+	return NewNetworkSecurityGroupManager(c).Delete(ctx, id)
+}
+
+// Allocation
+func (c *Client) CreateAllocation(ctx context.Context, request AllocationCreateRequest) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Creating Allocation for org: %s", c.Config.Org)
+
+	return NewAllocationManager(c).Create(ctx, request)
+}
+func (c *Client) GetAllocations(ctx context.Context, paginationFilter *PaginationFilter) ([]Allocation, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting all Allocations for org: %s", c.Config.Org)
+
+	return NewAllocationManager(c).GetAllocations(ctx, paginationFilter)
+}
+func (c *Client) GetAllocation(ctx context.Context, id string) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Getting Allocation for org: %s", c.Config.Org)
+
+	return NewAllocationManager(c).Get(ctx, id)
+}
+func (c *Client) UpdateAllocation(ctx context.Context, id string, request AllocationUpdateRequest) (*Allocation, *ApiError) {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Updating Allocation for org: %s", c.Config.Org)
+
+	return NewAllocationManager(c).Update(ctx, id, request)
+}
+func (c *Client) DeleteAllocation(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, c.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, c.Config.Token)
+
+	logger := LoggerFromContext(ctx)
+	logger.Info().Msgf("Deleting Allocation for org: %s", c.Config.Org)
+
+	return NewAllocationManager(c).Delete(ctx, id)
+}
+
 // NewClient creates a new simple SDK client
 func NewClient(config ClientConfig) (*Client, error) {
 	if config.BaseURL == "" {
@@ -29,16 +29,30 @@ type InstanceCreateRequest struct {
 	Name                           string                                     `json:"name"`
 	Description                    *string                                    `json:"description"`
 	MachineID                      string                                     `json:"machineId"`
+	InstanceTypeID                 *string                                    `json:"instanceTypeId"`
 	VpcID                          *string                                    `json:"vpcId"`
+	OperatingSystemID              *string                                    `json:"operatingSystemId"`
+	NetworkSecurityGroupID         *string                                    `json:"networkSecurityGroupId"`
 	IpxeScript                     string                                     `json:"ipxeScript"`
 	UserData                       *string                                    `json:"userData"`
 	SSHKeys                        []string                                   `json:"sshKeys"`
+	SshKeyGroupIDs                 []string                                   `json:"sshKeyGroupIds"`
 	Labels                         map[string]string                          `json:"labels"`
+	Interfaces                     []InterfaceCreateRequest                   `json:"interfaces"`
 	InfinibandInterfaces           []InfiniBandInterfaceCreateOrUpdateRequest `json:"infinibandInterfaces"`
 	NVLinkInterfaces               []NVLinkInterfaceCreateOrUpdateRequest     `json:"nvLinkInterfaces"`
 	DpuExtensionServiceDeployments []DpuExtensionServiceDeploymentRequest     `json:"dpuExtensionServiceDeployments"`
 }
 
+// InterfaceCreateRequest represents an Ethernet interface attached to a Subnet or VPC Prefix.
+// When no interfaces are given, the Instance gets a single physical interface on the default
+// VPC Prefix or Subnet.
+type InterfaceCreateRequest struct {
+	SubnetID    *string `json:"subnetId"`
+	VpcPrefixID *string `json:"vpcPrefixId"`
+	IsPhysical  bool    `json:"isPhysical"`
+}
+
 // InfiniBandInterfaceCreateOrUpdateRequest represents an InfiniBand interface attachment
 type InfiniBandInterfaceCreateOrUpdateRequest struct {
 	PartitionID       string  `json:"partitionId"`
@@ -67,12 +81,16 @@ type InstanceFilter struct {
 	VpcID *string
 }
 
-// InstanceUpdateRequest represents a simplified request to update an Instance
+// InstanceUpdateRequest represents a simplified request to update an Instance. An empty
+// NetworkSecurityGroupID detaches the Network Security Group.
 type InstanceUpdateRequest struct {
 	Name                           *string                                    `json:"name"`
 	Description                    *string                                    `json:"description"`
+	OperatingSystemID              *string                                    `json:"operatingSystemId"`
+	NetworkSecurityGroupID         *string                                    `json:"networkSecurityGroupId"`
 	IpxeScript                     *string                                    `json:"ipxeScript"`
 	UserData                       *string                                    `json:"userData"`
+	SshKeyGroupIDs                 []string                                   `json:"sshKeyGroupIds"`
 	Labels                         map[string]string                          `json:"labels"`
 	InfinibandInterfaces           []InfiniBandInterfaceCreateOrUpdateRequest `json:"infinibandInterfaces"`
 	NVLinkInterfaces               []NVLinkInterfaceCreateOrUpdateRequest     `json:"nvLinkInterfaces"`
@@ -146,15 +164,34 @@ func toStandardInstanceCreateRequest(request InstanceCreateRequest, sshKeyGroupI
 	apiReq := standard.InstanceCreateRequest{
 		Name:           request.Name,
 		TenantId:       am.TenantID,
-		MachineId:      &request.MachineID,
+		InstanceTypeId: request.InstanceTypeID,
 		VpcId:          vpcID,
 		Labels:         request.Labels,
-		SshKeyGroupIds: sshKeyGroupIDs,
+		SshKeyGroupIds: append(sshKeyGroupIDs, request.SshKeyGroupIDs...),
 		Interfaces:     []standard.InterfaceCreateRequest{defaultIface},
 	}
+	if request.MachineID != "" {
+		apiReq.MachineId = &request.MachineID
+	}
+	if len(request.Interfaces) > 0 {
+		apiReq.Interfaces = make([]standard.InterfaceCreateRequest, 0, len(request.Interfaces))
+		for _, iface := range request.Interfaces {
+			apiReq.Interfaces = append(apiReq.Interfaces, standard.InterfaceCreateRequest{
+				SubnetId:    iface.SubnetID,
+				VpcPrefixId: iface.VpcPrefixID,
+				IsPhysical:  standard.PtrBool(iface.IsPhysical),
+			})
+		}
+	}
 	if request.Description != nil {
 		apiReq.Description.Set(request.Description)
 	}
+	if request.OperatingSystemID != nil {
+		apiReq.OperatingSystemId.Set(request.OperatingSystemID)
+	}
+	if request.NetworkSecurityGroupID != nil {
+		apiReq.NetworkSecurityGroupId.Set(request.NetworkSecurityGroupID)
+	}
 	if request.IpxeScript != "" {
 		apiReq.IpxeScript.Set(&request.IpxeScript)
 	}
@@ -174,13 +211,23 @@ func toStandardInstanceCreateRequest(request InstanceCreateRequest, sshKeyGroupI
 }
 
 func toStandardInstanceUpdateRequest(request InstanceUpdateRequest) standard.InstanceUpdateRequest {
-	apiReq := standard.InstanceUpdateRequest{Labels: request.Labels}
+	apiReq := standard.InstanceUpdateRequest{Labels: request.Labels, SshKeyGroupIds: request.SshKeyGroupIDs}
 	if request.Name != nil {
 		apiReq.Name.Set(request.Name)
 	}
 	if request.Description != nil {
 		apiReq.Description.Set(request.Description)
 	}
+	if request.OperatingSystemID != nil {
+		apiReq.OperatingSystemId.Set(request.OperatingSystemID)
+	}
+	if request.NetworkSecurityGroupID != nil {
+		if *request.NetworkSecurityGroupID == "" {
+			apiReq.NetworkSecurityGroupId.Set(nil)
+		} else {
+			apiReq.NetworkSecurityGroupId.Set(request.NetworkSecurityGroupID)
+		}
+	}
 	if request.IpxeScript != nil {
 		apiReq.IpxeScript.Set(request.IpxeScript)
 	}
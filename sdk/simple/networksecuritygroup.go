@@ -0,0 +1,264 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simple
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+// NetworkSecurityGroupRule represents a single rule of a Network Security Group
+type NetworkSecurityGroupRule struct {
+	Name                 *string `json:"name"`
+	Direction            string  `json:"direction"`
+	SourcePortRange      *string `json:"sourcePortRange"`
+	DestinationPortRange *string `json:"destinationPortRange"`
+	Protocol             string  `json:"protocol"`
+	Action               string  `json:"action"`
+	Priority             *int    `json:"priority"`
+	SourcePrefix         string  `json:"sourcePrefix"`
+	DestinationPrefix    string  `json:"destinationPrefix"`
+}
+
+// NetworkSecurityGroup represents a simplified Network Security Group
+type NetworkSecurityGroup struct {
+	ID             string                     `json:"id"`
+	Name           string                     `json:"name"`
+	Description    *string                    `json:"description"`
+	SiteID         string                     `json:"siteId"`
+	StatefulEgress bool                       `json:"statefulEgress"`
+	Rules          []NetworkSecurityGroupRule `json:"rules"`
+	Labels         map[string]string          `json:"labels"`
+	Status         string                     `json:"status"`
+	Created        time.Time                  `json:"created"`
+	Updated        time.Time                  `json:"updated"`
+}
+
+// NetworkSecurityGroupCreateRequest is a request to create a Network Security Group
+type NetworkSecurityGroupCreateRequest struct {
+	Name           string                     `json:"name"`
+	Description    *string                    `json:"description"`
+	StatefulEgress *bool                      `json:"statefulEgress"`
+	Rules          []NetworkSecurityGroupRule `json:"rules"`
+	Labels         map[string]string          `json:"labels"`
+}
+
+// NetworkSecurityGroupUpdateRequest is a request to update a Network Security Group.
+// Rules, when set, replace the existing rules.
+type NetworkSecurityGroupUpdateRequest struct {
+	Name           *string                    `json:"name"`
+	Description    *string                    `json:"description"`
+	StatefulEgress *bool                      `json:"statefulEgress"`
+	Rules          []NetworkSecurityGroupRule `json:"rules"`
+	Labels         map[string]string          `json:"labels"`
+}
+
+// NetworkSecurityGroupManager manages Network Security Group operations
+type NetworkSecurityGroupManager struct {
+	client *Client
+}
+
+// NewNetworkSecurityGroupManager creates a new NetworkSecurityGroupManager
+func NewNetworkSecurityGroupManager(client *Client) NetworkSecurityGroupManager {
+	return NetworkSecurityGroupManager{client: client}
+}
+
+func toStandardNetworkSecurityGroupRules(rules []NetworkSecurityGroupRule) []standard.NetworkSecurityGroupRule {
+	if rules == nil {
+		return nil
+	}
+	apiRules := make([]standard.NetworkSecurityGroupRule, 0, len(rules))
+	for _, rule := range rules {
+		apiRule := standard.NetworkSecurityGroupRule{
+			Direction:         rule.Direction,
+			Protocol:          rule.Protocol,
+			Action:            rule.Action,
+			Priority:          IntPtrToInt32Ptr(rule.Priority),
+			SourcePrefix:      rule.SourcePrefix,
+			DestinationPrefix: rule.DestinationPrefix,
+		}
+		if rule.Name != nil {
+			apiRule.Name.Set(rule.Name)
+		}
+		if rule.SourcePortRange != nil {
+			apiRule.SourcePortRange.Set(rule.SourcePortRange)
+		}
+		if rule.DestinationPortRange != nil {
+			apiRule.DestinationPortRange.Set(rule.DestinationPortRange)
+		}
+		apiRules = append(apiRules, apiRule)
+	}
+	return apiRules
+}
+
+func networkSecurityGroupFromStandard(api standard.NetworkSecurityGroup) NetworkSecurityGroup {
+	nsg := NetworkSecurityGroup{}
+	if api.Id != nil {
+		nsg.ID = *api.Id
+	}
+	if api.Name != nil {
+		nsg.Name = *api.Name
+	}
+	nsg.Description = api.Description
+	if api.SiteId != nil {
+		nsg.SiteID = *api.SiteId
+	}
+	if api.StatefulEgress != nil {
+		nsg.StatefulEgress = *api.StatefulEgress
+	}
+	for _, apiRule := range api.Rules {
+		nsg.Rules = append(nsg.Rules, NetworkSecurityGroupRule{
+			Name:                 apiRule.Name.Get(),
+			Direction:            apiRule.Direction,
+			SourcePortRange:      apiRule.SourcePortRange.Get(),
+			DestinationPortRange: apiRule.DestinationPortRange.Get(),
+			Protocol:             apiRule.Protocol,
+			Action:               apiRule.Action,
+			Priority:             Int32PtrToIntPtr(apiRule.Priority),
+			SourcePrefix:         apiRule.SourcePrefix,
+			DestinationPrefix:    apiRule.DestinationPrefix,
+		})
+	}
+	nsg.Labels = api.Labels
+	if api.Status != nil {
+		nsg.Status = string(*api.Status)
+	}
+	if api.Created != nil {
+		nsg.Created = *api.Created
+	}
+	if api.Updated != nil {
+		nsg.Updated = *api.Updated
+	}
+	return nsg
+}
+
+// Create creates a new Network Security Group on the current Site
+func (nsgm NetworkSecurityGroupManager) Create(ctx context.Context, request NetworkSecurityGroupCreateRequest) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, nsgm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, nsgm.client.Config.Token)
+
+	apiReq := standard.NetworkSecurityGroupCreateRequest{
+		Name:           request.Name,
+		Description:    request.Description,
+		SiteId:         nsgm.client.apiMetadata.SiteID,
+		StatefulEgress: request.StatefulEgress,
+		Rules:          toStandardNetworkSecurityGroupRules(request.Rules),
+		Labels:         request.Labels,
+	}
+	apiNsg, resp, err := nsgm.client.apiClient.NetworkSecurityGroupAPI.CreateNetworkSecurityGroup(ctx, nsgm.client.apiMetadata.Organization).
+		NetworkSecurityGroupCreateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	nsg := networkSecurityGroupFromStandard(*apiNsg)
+	return &nsg, nil
+}
+
+// Get returns a Network Security Group by ID
+func (nsgm NetworkSecurityGroupManager) Get(ctx context.Context, id string) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, nsgm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, nsgm.client.Config.Token)
+
+	apiNsg, resp, err := nsgm.client.apiClient.NetworkSecurityGroupAPI.GetNetworkSecurityGroup(ctx, nsgm.client.apiMetadata.Organization, id).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	nsg := networkSecurityGroupFromStandard(*apiNsg)
+	return &nsg, nil
+}
+
+// GetNetworkSecurityGroups returns all Network Security Groups for the current Site
+func (nsgm NetworkSecurityGroupManager) GetNetworkSecurityGroups(ctx context.Context, paginationFilter *PaginationFilter) ([]NetworkSecurityGroup, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, nsgm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, nsgm.client.Config.Token)
+
+	gnr := nsgm.client.apiClient.NetworkSecurityGroupAPI.GetAllNetworkSecurityGroup(ctx, nsgm.client.apiMetadata.Organization).
+		SiteId(nsgm.client.apiMetadata.SiteID)
+	if paginationFilter != nil {
+		if paginationFilter.PageNumber != nil {
+			gnr = gnr.PageNumber(int32(*paginationFilter.PageNumber))
+		}
+		if paginationFilter.PageSize != nil {
+			gnr = gnr.PageSize(int32(*paginationFilter.PageSize))
+		}
+		if paginationFilter.OrderBy != nil {
+			gnr = gnr.OrderBy(*paginationFilter.OrderBy)
+		}
+	}
+
+	apiNsgs, resp, err := gnr.Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	nsgs := make([]NetworkSecurityGroup, 0, len(apiNsgs))
+	for _, apiNsg := range apiNsgs {
+		nsgs = append(nsgs, networkSecurityGroupFromStandard(apiNsg))
+	}
+
+	paginationResponse, perr := standard.GetPaginationResponse(ctx, resp)
+	if perr != nil {
+		return nil, nil, &ApiError{
+			Code:    http.StatusInternalServerError,
+			Message: "failed to extract pagination: " + perr.Error(),
+			Data:    map[string]interface{}{"parseError": perr.Error()},
+		}
+	}
+	return nsgs, paginationResponse, nil
+}
+
+// Update updates a Network Security Group
+func (nsgm NetworkSecurityGroupManager) Update(ctx context.Context, id string, request NetworkSecurityGroupUpdateRequest) (*NetworkSecurityGroup, *ApiError) {
+	ctx = WithLogger(ctx, nsgm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, nsgm.client.Config.Token)
+
+	apiReq := standard.NetworkSecurityGroupUpdateRequest{
+		StatefulEgress: request.StatefulEgress,
+		Rules:          toStandardNetworkSecurityGroupRules(request.Rules),
+		Labels:         request.Labels,
+	}
+	if request.Name != nil {
+		apiReq.Name.Set(request.Name)
+	}
+	if request.Description != nil {
+		apiReq.Description.Set(request.Description)
+	}
+	apiNsg, resp, err := nsgm.client.apiClient.NetworkSecurityGroupAPI.UpdateNetworkSecurityGroup(ctx, nsgm.client.apiMetadata.Organization, id).
+		NetworkSecurityGroupUpdateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	nsg := networkSecurityGroupFromStandard(*apiNsg)
+	return &nsg, nil
+}
+
+// Delete deletes a Network Security Group
+func (nsgm NetworkSecurityGroupManager) Delete(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, nsgm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, nsgm.client.Config.Token)
+
+	resp, err := nsgm.client.apiClient.NetworkSecurityGroupAPI.DeleteNetworkSecurityGroup(ctx, nsgm.client.apiMetadata.Organization, id).Execute()
+	return HandleResponseError(resp, err)
+}
@@ -50,6 +50,22 @@ func GetInstanceSshKeyGroupName(instanceName string) string {
 	return fmt.Sprintf("%s-ssh-key-group", instanceName)
 }
 
+// SshKeyGroupCreateRequest is a request to create an SSH Key Group on the current Site
+type SshKeyGroupCreateRequest struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	SshKeyIDs   []string `json:"sshKeyIds"`
+}
+
+// SshKeyGroupUpdateRequest is a request to update an SSH Key Group. Version must match
+// the current version of the group.
+type SshKeyGroupUpdateRequest struct {
+	Name        *string  `json:"name"`
+	Description *string  `json:"description"`
+	SshKeyIDs   []string `json:"sshKeyIds"`
+	Version     string   `json:"version"`
+}
+
 // SshKeyGroupManager manages SSH Key Group operations
 type SshKeyGroupManager struct {
 	client *Client
@@ -178,6 +194,46 @@ func (skm SshKeyGroupManager) CreateSshKeyGroupForInstance(ctx context.Context,
 	}
 }
 
+// CreateSshKeyGroup creates a new SSH Key Group associated with the current Site
+func (skm SshKeyGroupManager) CreateSshKeyGroup(ctx context.Context, request SshKeyGroupCreateRequest) (*standard.SshKeyGroup, *ApiError) {
+	ctx = WithLogger(ctx, skm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, skm.client.Config.Token)
+
+	apiSkg, resp, err := skm.client.apiClient.SSHKeyGroupAPI.CreateSshKeyGroup(ctx, skm.client.apiMetadata.Organization).
+		SshKeyGroupCreateRequest(standard.SshKeyGroupCreateRequest{
+			Name:        request.Name,
+			Description: request.Description,
+			SshKeyIds:   request.SshKeyIDs,
+			SiteIds:     []string{skm.client.apiMetadata.SiteID},
+		}).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return apiSkg, nil
+}
+
+// UpdateSshKeyGroup updates an SSH Key Group
+func (skm SshKeyGroupManager) UpdateSshKeyGroup(ctx context.Context, sshKeyGroupID string, request SshKeyGroupUpdateRequest) (*standard.SshKeyGroup, *ApiError) {
+	ctx = WithLogger(ctx, skm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, skm.client.Config.Token)
+
+	apiReq := standard.SshKeyGroupUpdateRequest{SshKeyIds: request.SshKeyIDs, Version: request.Version}
+	if request.Name != nil {
+		apiReq.Name.Set(request.Name)
+	}
+	if request.Description != nil {
+		apiReq.Description.Set(request.Description)
+	}
+	apiSkg, resp, err := skm.client.apiClient.SSHKeyGroupAPI.UpdateSshKeyGroup(ctx, skm.client.apiMetadata.Organization, sshKeyGroupID).
+		SshKeyGroupUpdateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	return apiSkg, nil
+}
+
 // GetSshKeyGroup returns an SSH Key Group by ID
 func (skm SshKeyGroupManager) GetSshKeyGroup(ctx context.Context, sshKeyGroupID string) (*standard.SshKeyGroup, *ApiError) {
 	ctx = WithLogger(ctx, skm.client.Logger)
@@ -0,0 +1,216 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simple
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+// Subnet represents a simplified Subnet
+type Subnet struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	Description  *string   `json:"description"`
+	SiteID       string    `json:"siteId"`
+	VpcID        string    `json:"vpcId"`
+	Ipv4BlockID  *string   `json:"ipv4BlockId"`
+	Ipv4Prefix   *string   `json:"ipv4Prefix"`
+	Ipv4Gateway  *string   `json:"ipv4Gateway"`
+	PrefixLength int       `json:"prefixLength"`
+	Status       string    `json:"status"`
+	Created      time.Time `json:"created"`
+	Updated      time.Time `json:"updated"`
+}
+
+// SubnetCreateRequest is a request to create a Subnet
+type SubnetCreateRequest struct {
+	Name         string  `json:"name"`
+	Description  *string `json:"description"`
+	VpcID        string  `json:"vpcId"`
+	Ipv4BlockID  *string `json:"ipv4BlockId"`
+	PrefixLength int     `json:"prefixLength"`
+}
+
+// SubnetUpdateRequest is a request to update a Subnet
+type SubnetUpdateRequest struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description"`
+}
+
+// SubnetFilter encapsulates Subnet filter parameters
+type SubnetFilter struct {
+	VpcID *string
+}
+
+// SubnetManager manages Subnet operations
+type SubnetManager struct {
+	client *Client
+}
+
+// NewSubnetManager creates a new SubnetManager
+func NewSubnetManager(client *Client) SubnetManager {
+	return SubnetManager{client: client}
+}
+
+func subnetFromStandard(api standard.Subnet) Subnet {
+	s := Subnet{}
+	if api.Id != nil {
+		s.ID = *api.Id
+	}
+	if api.Name != nil {
+		s.Name = *api.Name
+	}
+	s.Description = api.Description
+	if api.SiteId != nil {
+		s.SiteID = *api.SiteId
+	}
+	if api.VpcId != nil {
+		s.VpcID = *api.VpcId
+	}
+	if api.Ipv4BlockId.IsSet() {
+		s.Ipv4BlockID = api.Ipv4BlockId.Get()
+	}
+	if api.Ipv4Prefix.IsSet() {
+		s.Ipv4Prefix = api.Ipv4Prefix.Get()
+	}
+	if api.Ipv4Gateway.IsSet() {
+		s.Ipv4Gateway = api.Ipv4Gateway.Get()
+	}
+	if api.PrefixLength != nil {
+		s.PrefixLength = int(*api.PrefixLength)
+	}
+	if api.Status != nil {
+		s.Status = string(*api.Status)
+	}
+	if api.Created != nil {
+		s.Created = *api.Created
+	}
+	if api.Updated != nil {
+		s.Updated = *api.Updated
+	}
+	return s
+}
+
+// Create creates a new Subnet
+func (sm SubnetManager) Create(ctx context.Context, request SubnetCreateRequest) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, sm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, sm.client.Config.Token)
+
+	apiReq := standard.SubnetCreateRequest{
+		Name:         request.Name,
+		Description:  request.Description,
+		VpcId:        request.VpcID,
+		Ipv4BlockId:  request.Ipv4BlockID,
+		PrefixLength: int32(request.PrefixLength),
+	}
+	apiSubnet, resp, err := sm.client.apiClient.SubnetAPI.CreateSubnet(ctx, sm.client.apiMetadata.Organization).
+		SubnetCreateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	s := subnetFromStandard(*apiSubnet)
+	return &s, nil
+}
+
+// Get returns a Subnet by ID
+func (sm SubnetManager) Get(ctx context.Context, id string) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, sm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, sm.client.Config.Token)
+
+	apiSubnet, resp, err := sm.client.apiClient.SubnetAPI.GetSubnet(ctx, sm.client.apiMetadata.Organization, id).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	s := subnetFromStandard(*apiSubnet)
+	return &s, nil
+}
+
+// GetSubnets returns all Subnets for the current Site
+func (sm SubnetManager) GetSubnets(ctx context.Context, subnetFilter *SubnetFilter, paginationFilter *PaginationFilter) ([]Subnet, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, sm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, sm.client.Config.Token)
+
+	gsr := sm.client.apiClient.SubnetAPI.GetAllSubnet(ctx, sm.client.apiMetadata.Organization).
+		SiteId(sm.client.apiMetadata.SiteID)
+	if subnetFilter != nil && subnetFilter.VpcID != nil {
+		gsr = gsr.VpcId(*subnetFilter.VpcID)
+	}
+	if paginationFilter != nil {
+		if paginationFilter.PageNumber != nil {
+			gsr = gsr.PageNumber(int32(*paginationFilter.PageNumber))
+		}
+		if paginationFilter.PageSize != nil {
+			gsr = gsr.PageSize(int32(*paginationFilter.PageSize))
+		}
+		if paginationFilter.OrderBy != nil {
+			gsr = gsr.OrderBy(*paginationFilter.OrderBy)
+		}
+	}
+
+	apiSubnets, resp, err := gsr.Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	subnets := make([]Subnet, 0, len(apiSubnets))
+	for _, apiSubnet := range apiSubnets {
+		subnets = append(subnets, subnetFromStandard(apiSubnet))
+	}
+
+	paginationResponse, perr := standard.GetPaginationResponse(ctx, resp)
+	if perr != nil {
+		return nil, nil, &ApiError{
+			Code:    http.StatusInternalServerError,
+			Message: "failed to extract pagination: " + perr.Error(),
+			Data:    map[string]interface{}{"parseError": perr.Error()},
+		}
+	}
+	return subnets, paginationResponse, nil
+}
+
+// Update updates a Subnet
+func (sm SubnetManager) Update(ctx context.Context, id string, request SubnetUpdateRequest) (*Subnet, *ApiError) {
+	ctx = WithLogger(ctx, sm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, sm.client.Config.Token)
+
+	apiReq := standard.SubnetUpdateRequest{Name: request.Name, Description: request.Description}
+	apiSubnet, resp, err := sm.client.apiClient.SubnetAPI.UpdateSubnet(ctx, sm.client.apiMetadata.Organization, id).
+		SubnetUpdateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	s := subnetFromStandard(*apiSubnet)
+	return &s, nil
+}
+
+// Delete deletes a Subnet
+func (sm SubnetManager) Delete(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, sm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, sm.client.Config.Token)
+
+	resp, err := sm.client.apiClient.SubnetAPI.DeleteSubnet(ctx, sm.client.apiMetadata.Organization, id).Execute()
+	return HandleResponseError(resp, err)
+}
@@ -27,25 +27,34 @@ import (
 
 // Vpc represents a simplified VPC
 type Vpc struct {
-	ID                        string    `json:"id"`
-	Name                      string    `json:"name"`
-	Description               *string   `json:"description"`
-	NetworkVirtualizationType string    `json:"networkVirtualizationType"`
-	Created                   time.Time `json:"created"`
-	Updated                   time.Time `json:"updated"`
+	ID                        string            `json:"id"`
+	Name                      string            `json:"name"`
+	Description               *string           `json:"description"`
+	NetworkVirtualizationType string            `json:"networkVirtualizationType"`
+	SiteID                    string            `json:"siteId"`
+	NetworkSecurityGroupID    *string           `json:"networkSecurityGroupId"`
+	Labels                    map[string]string `json:"labels"`
+	Status                    string            `json:"status"`
+	Created                   time.Time         `json:"created"`
+	Updated                   time.Time         `json:"updated"`
 }
 
 // VpcCreateRequest is a request to create a VPC
 type VpcCreateRequest struct {
-	Name                      string  `json:"name"`
-	Description               *string `json:"description"`
-	NetworkVirtualizationType string  `json:"networkVirtualizationType"`
+	Name                      string            `json:"name"`
+	Description               *string           `json:"description"`
+	NetworkVirtualizationType string            `json:"networkVirtualizationType"`
+	NetworkSecurityGroupID    *string           `json:"networkSecurityGroupId"`
+	Labels                    map[string]string `json:"labels"`
 }
 
-// VpcUpdateRequest is a request to update a VPC
+// VpcUpdateRequest is a request to update a VPC. An empty NetworkSecurityGroupID detaches the
+// Network Security Group.
 type VpcUpdateRequest struct {
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
+	Name                   *string           `json:"name"`
+	Description            *string           `json:"description"`
+	NetworkSecurityGroupID *string           `json:"networkSecurityGroupId"`
+	Labels                 map[string]string `json:"labels"`
 }
 
 // VpcFilter encapsulates VPC filter parameters
@@ -71,17 +80,28 @@ func toStandardVpcCreateRequest(request VpcCreateRequest, siteID string) standar
 	if request.NetworkVirtualizationType != "" {
 		apiReq.SetNetworkVirtualizationType(request.NetworkVirtualizationType)
 	}
+	if request.NetworkSecurityGroupID != nil {
+		apiReq.SetNetworkSecurityGroupId(*request.NetworkSecurityGroupID)
+	}
+	apiReq.Labels = request.Labels
 	return apiReq
 }
 
 func toStandardVpcUpdateRequest(request VpcUpdateRequest) standard.VpcUpdateRequest {
-	apiReq := standard.VpcUpdateRequest{}
+	apiReq := standard.VpcUpdateRequest{Labels: request.Labels}
 	if request.Name != nil {
 		apiReq.SetName(*request.Name)
 	}
 	if request.Description != nil {
 		apiReq.SetDescription(*request.Description)
 	}
+	if request.NetworkSecurityGroupID != nil {
+		if *request.NetworkSecurityGroupID == "" {
+			apiReq.SetNetworkSecurityGroupIdNil()
+		} else {
+			apiReq.SetNetworkSecurityGroupId(*request.NetworkSecurityGroupID)
+		}
+	}
 	return apiReq
 }
 
@@ -97,6 +117,16 @@ func vpcFromStandard(api standard.VPC) Vpc {
 	if api.NetworkVirtualizationType != nil {
 		v.NetworkVirtualizationType = *api.NetworkVirtualizationType
 	}
+	if api.SiteId != nil {
+		v.SiteID = *api.SiteId
+	}
+	if api.NetworkSecurityGroupId.IsSet() {
+		v.NetworkSecurityGroupID = api.NetworkSecurityGroupId.Get()
+	}
+	v.Labels = api.Labels
+	if api.Status != nil {
+		v.Status = string(*api.Status)
+	}
 	if api.Created != nil {
 		v.Created = *api.Created
 	}
@@ -0,0 +1,206 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package simple
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+// VpcPrefix represents a simplified VPC Prefix
+type VpcPrefix struct {
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	SiteID       string    `json:"siteId"`
+	VpcID        string    `json:"vpcId"`
+	IpBlockID    *string   `json:"ipBlockId"`
+	Prefix       *string   `json:"prefix"`
+	PrefixLength int       `json:"prefixLength"`
+	Status       string    `json:"status"`
+	Created      time.Time `json:"created"`
+	Updated      time.Time `json:"updated"`
+}
+
+// VpcPrefixCreateRequest is a request to create a VPC Prefix
+type VpcPrefixCreateRequest struct {
+	Name         string  `json:"name"`
+	VpcID        string  `json:"vpcId"`
+	IpBlockID    *string `json:"ipBlockId"`
+	PrefixLength int     `json:"prefixLength"`
+}
+
+// VpcPrefixUpdateRequest is a request to update a VPC Prefix
+type VpcPrefixUpdateRequest struct {
+	Name string `json:"name"`
+}
+
+// VpcPrefixFilter encapsulates VPC Prefix filter parameters
+type VpcPrefixFilter struct {
+	VpcID *string
+}
+
+// VpcPrefixManager manages VPC Prefix operations
+type VpcPrefixManager struct {
+	client *Client
+}
+
+// NewVpcPrefixManager creates a new VpcPrefixManager
+func NewVpcPrefixManager(client *Client) VpcPrefixManager {
+	return VpcPrefixManager{client: client}
+}
+
+func vpcPrefixFromStandard(api standard.VpcPrefix) VpcPrefix {
+	vp := VpcPrefix{}
+	if api.Id != nil {
+		vp.ID = *api.Id
+	}
+	if api.Name != nil {
+		vp.Name = *api.Name
+	}
+	if api.SiteId != nil {
+		vp.SiteID = *api.SiteId
+	}
+	if api.VpcId != nil {
+		vp.VpcID = *api.VpcId
+	}
+	if api.IpBlockId.IsSet() {
+		vp.IpBlockID = api.IpBlockId.Get()
+	}
+	if api.Prefix.IsSet() {
+		vp.Prefix = api.Prefix.Get()
+	}
+	if api.PrefixLength != nil {
+		vp.PrefixLength = int(*api.PrefixLength)
+	}
+	if api.Status != nil {
+		vp.Status = string(*api.Status)
+	}
+	if api.Created != nil {
+		vp.Created = *api.Created
+	}
+	if api.Updated != nil {
+		vp.Updated = *api.Updated
+	}
+	return vp
+}
+
+// Create creates a new VPC Prefix
+func (vpm VpcPrefixManager) Create(ctx context.Context, request VpcPrefixCreateRequest) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, vpm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, vpm.client.Config.Token)
+
+	apiReq := standard.VpcPrefixCreateRequest{
+		Name:         request.Name,
+		VpcId:        request.VpcID,
+		IpBlockId:    request.IpBlockID,
+		PrefixLength: int32(request.PrefixLength),
+	}
+	apiVp, resp, err := vpm.client.apiClient.VPCPrefixAPI.CreateVpcPrefix(ctx, vpm.client.apiMetadata.Organization).
+		VpcPrefixCreateRequest(apiReq).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	vp := vpcPrefixFromStandard(*apiVp)
+	return &vp, nil
+}
+
+// Get returns a VPC Prefix by ID
+func (vpm VpcPrefixManager) Get(ctx context.Context, id string) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, vpm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, vpm.client.Config.Token)
+
+	apiVp, resp, err := vpm.client.apiClient.VPCPrefixAPI.GetVpcPrefix(ctx, vpm.client.apiMetadata.Organization, id).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	vp := vpcPrefixFromStandard(*apiVp)
+	return &vp, nil
+}
+
+// GetVpcPrefixes returns all VPC Prefixes for the current Site
+func (vpm VpcPrefixManager) GetVpcPrefixes(ctx context.Context, vpcPrefixFilter *VpcPrefixFilter, paginationFilter *PaginationFilter) ([]VpcPrefix, *standard.PaginationResponse, *ApiError) {
+	ctx = WithLogger(ctx, vpm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, vpm.client.Config.Token)
+
+	gvpr := vpm.client.apiClient.VPCPrefixAPI.GetAllVpcPrefix(ctx, vpm.client.apiMetadata.Organization).
+		SiteId(vpm.client.apiMetadata.SiteID)
+	if vpcPrefixFilter != nil && vpcPrefixFilter.VpcID != nil {
+		gvpr = gvpr.VpcId(*vpcPrefixFilter.VpcID)
+	}
+	if paginationFilter != nil {
+		if paginationFilter.PageNumber != nil {
+			gvpr = gvpr.PageNumber(int32(*paginationFilter.PageNumber))
+		}
+		if paginationFilter.PageSize != nil {
+			gvpr = gvpr.PageSize(int32(*paginationFilter.PageSize))
+		}
+		if paginationFilter.OrderBy != nil {
+			gvpr = gvpr.OrderBy(*paginationFilter.OrderBy)
+		}
+	}
+
+	apiVps, resp, err := gvpr.Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, nil, apiErr
+	}
+
+	vps := make([]VpcPrefix, 0, len(apiVps))
+	for _, apiVp := range apiVps {
+		vps = append(vps, vpcPrefixFromStandard(apiVp))
+	}
+
+	paginationResponse, perr := standard.GetPaginationResponse(ctx, resp)
+	if perr != nil {
+		return nil, nil, &ApiError{
+			Code:    http.StatusInternalServerError,
+			Message: "failed to extract pagination: " + perr.Error(),
+			Data:    map[string]interface{}{"parseError": perr.Error()},
+		}
+	}
+	return vps, paginationResponse, nil
+}
+
+// Update updates a VPC Prefix
+func (vpm VpcPrefixManager) Update(ctx context.Context, id string, request VpcPrefixUpdateRequest) (*VpcPrefix, *ApiError) {
+	ctx = WithLogger(ctx, vpm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, vpm.client.Config.Token)
+
+	apiVp, resp, err := vpm.client.apiClient.VPCPrefixAPI.UpdateVpcPrefix(ctx, vpm.client.apiMetadata.Organization, id).
+		VpcPrefixUpdateRequest(standard.VpcPrefixUpdateRequest{Name: request.Name}).Execute()
+	apiErr := HandleResponseError(resp, err)
+	if apiErr != nil {
+		return nil, apiErr
+	}
+	vp := vpcPrefixFromStandard(*apiVp)
+	return &vp, nil
+}
+
+// Delete deletes a VPC Prefix
+func (vpm VpcPrefixManager) Delete(ctx context.Context, id string) *ApiError {
+	ctx = WithLogger(ctx, vpm.client.Logger)
+	ctx = context.WithValue(ctx, standard.ContextAccessToken, vpm.client.Config.Token)
+
+	resp, err := vpm.client.apiClient.VPCPrefixAPI.DeleteVpcPrefix(ctx, vpm.client.apiMetadata.Organization, id).Execute()
+	return HandleResponseError(resp, err)
+}
@@ -43,6 +43,14 @@ const (
 	DefaultRLAClientCAPath   = "/etc/carbide/ca.crt"
 	DefaultRLAClientCertPath = "/etc/carbide/tls.crt"
 	DefaultRLAClientKeyPath  = "/etc/carbide/tls.key"
+
+	// DefaultCertRenewalThreshold renews the Temporal client cert after two
+	// thirds of its lifetime
+	DefaultCertRenewalThreshold = 2.0 / 3.0
+
+	// DefaultInventoryFullSnapshotInterval publishes a full inventory snapshot
+	// after every 20 delta publications
+	DefaultInventoryFullSnapshotInterval = 20
 )
 
 // NewElektraConfig reads configurations from env variables and returns
@@ -55,6 +63,9 @@ func NewElektraConfig(utMode bool) *conftypes.Config {
 	var enableTLS string
 	var disableBootstrap string
 	var watcherInterval string
+	var certRenewalThreshold string
+	var inventoryDeltaPublishing string
+	var inventoryFullSnapshotInterval string
 	var podName string
 	var skipServerAuth string
 
@@ -142,6 +153,9 @@ func NewElektraConfig(utMode bool) *conftypes.Config {
 	flag.StringVar(&disableBootstrap, "DisableBootstrap", os.Getenv("DISABLE_BOOTSTRAP"), "Disable secret based bootstrap")
 	flag.StringVar(&conf.BootstrapSecret, "bootstrapSecret", os.Getenv("BOOTSTRAP_SECRET"), "Bootstrap secret")
 	flag.StringVar(&watcherInterval, "watcherInterval", os.Getenv("WATCHER_INTERVAL"), "Watcher Interval")
+	flag.StringVar(&certRenewalThreshold, "certRenewalThreshold", os.Getenv("CERT_RENEWAL_THRESHOLD"), "Fraction of the client cert lifetime after which it is renewed")
+	flag.StringVar(&inventoryDeltaPublishing, "inventoryDeltaPublishing", os.Getenv("INVENTORY_DELTA_PUBLISHING"), "Publish only changed inventory items to Cloud")
+	flag.StringVar(&inventoryFullSnapshotInterval, "inventoryFullSnapshotInterval", os.Getenv("INVENTORY_FULL_SNAPSHOT_INTERVAL"), "Number of delta inventory publications between full snapshots")
 	flag.StringVar(&podName, "podName", os.Getenv("POD_NAME"), "POD Name")
 	flag.StringVar(&conf.PodNamespace, "podNamespace", os.Getenv("POD_NAMESPACE"), "POD Namespace")
 	flag.StringVar(&conf.TemporalSecret, "temporalSecret", os.Getenv("TEMPORAL_CERT"), "Temporal cert secret")
@@ -208,6 +222,25 @@ func NewElektraConfig(utMode bool) *conftypes.Config {
 		conf.BootstrapSecret = "/etc/sitereg/"
 	}
 
+	conf.CertRenewalThreshold = DefaultCertRenewalThreshold
+	if certRenewalThreshold != "" {
+		crt, err := strconv.ParseFloat(certRenewalThreshold, 64)
+		if err != nil || crt <= 0 || crt >= 1 {
+			log.Fatal().Msgf("error loading config, cert renewal threshold %v must be between 0 and 1", certRenewalThreshold)
+		}
+		conf.CertRenewalThreshold = crt
+	}
+
+	conf.InventoryDeltaPublishing = strings.ToLower(inventoryDeltaPublishing) == "true"
+	conf.InventoryFullSnapshotInterval = DefaultInventoryFullSnapshotInterval
+	if inventoryFullSnapshotInterval != "" {
+		fsi, err := strconv.Atoi(inventoryFullSnapshotInterval)
+		if err != nil || fsi <= 0 {
+			log.Fatal().Msgf("error loading config, inventory full snapshot interval %v must be a positive integer", inventoryFullSnapshotInterval)
+		}
+		conf.InventoryFullSnapshotInterval = fsi
+	}
+
 	// Site ID
 	// TODO: Rename CLUSTER_ID to SITE_ID
 	clusterID := ""
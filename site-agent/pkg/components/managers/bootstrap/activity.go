@@ -23,6 +23,7 @@ import (
 	"encoding/base64"
 	"encoding/pem"
 	"errors"
+	"time"
 
 	cloudutils "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
 	"github.com/rs/zerolog/log"
@@ -134,6 +135,17 @@ func (o *OTPHandler) ReceiveAndSaveOTP(ctx context.Context, base64EncodedEncrypt
 
 	logger.Info().Msgf("Certificate expiration date: %v", certExpiry)
 
+	if err := reportCertExpiry(ctx, certExpiry); err != nil {
+		logger.Error().Err(err).Msg("Failed to start UpdateAgentCertExpiry")
+		return err
+	}
+
+	logger.Info().Msg("Successfully completed the activity")
+	return nil
+}
+
+// reportCertExpiry reports the expiry of the site agent cert to the cloud
+func reportCertExpiry(ctx context.Context, certExpiry time.Time) error {
 	siteID := ManagerAccess.Data.EB.Managers.Bootstrap.Config.UUID
 	workflowOptions := client.StartWorkflowOptions{
 		ID:        "update-agent-cert-expiry-" + siteID,
@@ -141,15 +153,16 @@ func (o *OTPHandler) ReceiveAndSaveOTP(ctx context.Context, base64EncodedEncrypt
 	}
 
 	tcPublish := ManagerAccess.Data.EB.Managers.Workflow.Temporal.Publisher
-	we, werr := tcPublish.ExecuteWorkflow(ctx, workflowOptions, "UpdateAgentCertExpiry", siteID, certExpiry)
-	if werr != nil {
-		logger.Error().Err(werr).Msg("Failed to start UpdateAgentCertExpiry")
-		return werr
-	} else {
-		logger.Info().Msgf("Started UpdateAgentCertExpiry with WorkflowID: %s", we.GetID())
+	if tcPublish == nil {
+		return errors.New("temporal publisher is not set")
 	}
 
-	logger.Info().Msg("Successfully completed the activity")
+	we, err := tcPublish.ExecuteWorkflow(ctx, workflowOptions, "UpdateAgentCertExpiry", siteID, certExpiry)
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Started UpdateAgentCertExpiry with WorkflowID: %s", we.GetID())
+
 	return nil
 }
 
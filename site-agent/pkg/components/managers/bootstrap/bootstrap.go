@@ -42,6 +42,7 @@ import (
 	computils "github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/components/utils"
 	"github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/conftypes"
 	bootstraptypes "github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/datatypes/managertypes/bootstrap"
+	"github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/metadata"
 	"gopkg.in/fsnotify.v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -70,6 +71,10 @@ const (
 	MetricCredDnloadAttempt = "credentials_download_attempted"
 	// MetricCredDnloadSucc - Metric Cred Dnload Succ
 	MetricCredDnloadSucc = "credentials_download_succeeded"
+	// MetricCredRenewAttempt - Metric Cred Renew Attempt
+	MetricCredRenewAttempt = "credentials_renewal_attempted"
+	// MetricCredRenewSucc - Metric Cred Renew Succ
+	MetricCredRenewSucc = "credentials_renewal_succeeded"
 )
 
 // newBootstrapConfig creates the Configuration required for fetching Credentials
@@ -187,6 +192,26 @@ func (bs *BoostrapAPI) Init() {
 				return float64(ManagerAccess.Data.EB.Managers.Bootstrap.State.DownloadSucceeded.Load())
 			}))
 
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "elektra_site_agent",
+			Name:      MetricCredRenewAttempt,
+			Help:      "Credentials renewal attempted for Site Agent",
+		},
+			func() float64 {
+				return float64(ManagerAccess.Data.EB.Managers.Bootstrap.State.RenewalAttempted.Load())
+			}))
+
+	prometheus.MustRegister(
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Namespace: "elektra_site_agent",
+			Name:      MetricCredRenewSucc,
+			Help:      "Credentials renewal succeeded for Site Agent",
+		},
+			func() float64 {
+				return float64(ManagerAccess.Data.EB.Managers.Bootstrap.State.RenewalSucceeded.Load())
+			}))
+
 	err := newBootstrapConfig(ManagerAccess.Conf.EB.BootstrapSecret)
 	if err != nil {
 		ManagerAccess.Data.EB.Log.Fatal().Msgf("Boostrap: error %v", err.Error())
@@ -262,6 +287,7 @@ func (bs *BoostrapAPI) Start() {
 	log.Info().Msgf("Bootstrap: trigger workflow")
 	bs.DownloadAndStoreCreds(nil)
 	go bs.watchBootstrapFile()
+	go bs.watchCertRenewal()
 }
 
 // GetState - handle http request
@@ -270,6 +296,8 @@ func (bs *BoostrapAPI) GetState() []string {
 	var strs []string
 	strs = append(strs, fmt.Sprintln("Creds Download Attempted: ", bt.State.DownloadAttempted.Load()))
 	strs = append(strs, fmt.Sprintln("Creds Download Succeeded: ", bt.State.DownloadSucceeded.Load()))
+	strs = append(strs, fmt.Sprintln("Creds Renewal Attempted: ", bt.State.RenewalAttempted.Load()))
+	strs = append(strs, fmt.Sprintln("Creds Renewal Succeeded: ", bt.State.RenewalSucceeded.Load()))
 	strs = append(strs, fmt.Sprintln("URL: ", bt.Config.CredsURL))
 	strs = append(strs, fmt.Sprintln("OTP: ", bt.Config.OTP))
 	strs = append(strs, fmt.Sprintln("UUID: ", bt.Config.UUID))
@@ -431,8 +459,9 @@ func (bs *BoostrapAPI) storeCredentials(ctx context.Context, credsResponse *boot
 func (bs *BoostrapAPI) downloadCredentials(ctx context.Context) (*bootstraptypes.SiteCredsResponse, error) {
 	bCfg := ManagerAccess.Data.EB.Managers.Bootstrap.Config
 	bReq := &bootstraptypes.SecretReq{
-		UUID: bCfg.UUID,
-		OTP:  bCfg.OTP,
+		UUID:         bCfg.UUID,
+		OTP:          bCfg.OTP,
+		AgentVersion: metadata.Version,
 	}
 	m, err := json.Marshal(bReq)
 	if err != nil {
@@ -440,16 +469,24 @@ func (bs *BoostrapAPI) downloadCredentials(ctx context.Context) (*bootstraptypes
 		return nil, err
 	}
 	log.Info().Msgf("Bootstrap: body %v", string(m))
+
+	return postCredsRequest(ctx, bCfg.CredsURL, m)
+}
+
+// postCredsRequest posts a credentials request to the site manager and
+// returns the validated response
+func postCredsRequest(ctx context.Context, credsURL string, m []byte) (*bootstraptypes.SiteCredsResponse, error) {
+	bCfg := ManagerAccess.Data.EB.Managers.Bootstrap.Config
 	ctx, span := otel.Tracer("elektra-site-agent").Start(ctx, "Bootstrap-client")
-	span.SetAttributes(attribute.String("url", bCfg.CredsURL))
+	span.SetAttributes(attribute.String("url", credsURL))
 	defer span.End()
 
-	u, err := url.Parse(bCfg.CredsURL)
+	u, err := url.Parse(credsURL)
 	if err != nil {
 		log.Error().Msgf("Bootstrap: url parse %v", err.Error())
 		return nil, err
 	}
-	log.Info().Msgf("Bootstrap: hostname %v, %v", string(u.Hostname()), bCfg.CredsURL)
+	log.Info().Msgf("Bootstrap: hostname %v, %v", string(u.Hostname()), credsURL)
 
 	caCertPool := x509.NewCertPool()
 	caCertPool.AppendCertsFromPEM([]byte(bCfg.CACert))
@@ -470,7 +507,7 @@ func (bs *BoostrapAPI) downloadCredentials(ctx context.Context) (*bootstraptypes
 		},
 	}
 	ctx = httptrace.WithClientTrace(ctx, otelhttptrace.NewClientTrace(ctx))
-	req, err := http.NewRequestWithContext(ctx, "POST", bCfg.CredsURL, bytes.NewReader(m))
+	req, err := http.NewRequestWithContext(ctx, "POST", credsURL, bytes.NewReader(m))
 	if err != nil {
 		log.Error().Msgf("Bootstrap: new req failed %v", err.Error())
 		return nil, err
@@ -504,12 +541,31 @@ func (bs *BoostrapAPI) downloadCredentials(ctx context.Context) (*bootstraptypes
 		return nil, fmt.Errorf("failed to decode certificate PEM CACertificate %v", credsResponse.CACertificate)
 	}
 
-	cert, err := x509.ParseCertificate(block.Bytes)
-	if err != nil {
+	if _, err := x509.ParseCertificate(block.Bytes); err != nil {
 		log.Error().Err(err).Msgf("Bootstrap: failed to parse certificate")
 		return nil, fmt.Errorf("failed to parse certificate %w", err)
 	}
 
+	cert, err := parseCertificatePEM([]byte(credsResponse.Certificate))
+	if err != nil {
+		log.Error().Err(err).Msgf("Bootstrap: failed to parse client certificate")
+		return nil, err
+	}
+
 	CertExpirationMetric.Set(float64(cert.NotAfter.UTC().Unix()))
 	return credsResponse, nil
 }
+
+// parseCertificatePEM parses the first certificate of a PEM bundle
+func parseCertificatePEM(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode certificate PEM")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate %w", err)
+	}
+	return cert, nil
+}
@@ -0,0 +1,209 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bootstrap
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/conftypes"
+	bootstraptypes "github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/datatypes/managertypes/bootstrap"
+	"github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/metadata"
+)
+
+// renewalCheckInterval is how often the client cert is checked for renewal
+var renewalCheckInterval = time.Hour
+
+// renewalDue reports whether the cert has used up the threshold fraction of
+// its lifetime
+func renewalDue(cert *x509.Certificate, threshold float64, now time.Time) bool {
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	renewAt := cert.NotBefore.Add(time.Duration(float64(lifetime) * threshold))
+	return !now.Before(renewAt)
+}
+
+// watchCertRenewal periodically renews the Temporal client cert before it
+// expires. The new cert is picked up by the Temporal cert file watcher, which
+// reconnects the workflow orchestrator.
+func (bs *BoostrapAPI) watchCertRenewal() {
+	log.Info().Msgf("Bootstrap: Checking client cert for renewal every %v", renewalCheckInterval)
+
+	ticker := time.NewTicker(renewalCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := bs.renewCredsIfDue(context.Background()); err != nil {
+			log.Error().Err(err).Msg("Bootstrap: Failed to renew credentials")
+		}
+		<-ticker.C
+	}
+}
+
+// renewCredsIfDue renews the Temporal client cert once it passes the renewal
+// threshold of its lifetime
+func (bs *BoostrapAPI) renewCredsIfDue(ctx context.Context) error {
+	certPEM, keyPEM, err := loadCurrentCreds(ctx)
+	if err != nil {
+		return err
+	}
+
+	cert, err := parseCertificatePEM(certPEM)
+	if err != nil {
+		return err
+	}
+	CertExpirationMetric.Set(float64(cert.NotAfter.UTC().Unix()))
+
+	now := time.Now()
+	if !renewalDue(cert, ManagerAccess.Conf.EB.CertRenewalThreshold, now) {
+		log.Debug().Msgf("Bootstrap: Client cert valid until %v, renewal not due", cert.NotAfter)
+		return nil
+	}
+	if !now.Before(cert.NotAfter) {
+		return fmt.Errorf("client cert expired at %v, a new OTP is required", cert.NotAfter)
+	}
+
+	log.Info().Msgf("Bootstrap: Client cert expires at %v, renewing", cert.NotAfter)
+	return bs.renewCreds(ctx, certPEM, keyPEM)
+}
+
+// renewCreds requests new credentials from the site manager, proving
+// possession of the current cert by signing the request with its key
+func (bs *BoostrapAPI) renewCreds(ctx context.Context, certPEM, keyPEM []byte) error {
+	bw := ManagerAccess.Data.EB.Managers.Bootstrap.State
+	bw.RenewalAttempted.Inc()
+
+	ctx, span := otel.Tracer("elektra-site-agent").Start(ctx, "Bootstrap-renew")
+	defer span.End()
+
+	bCfg := ManagerAccess.Data.EB.Managers.Bootstrap.Config
+	bReq := &bootstraptypes.RenewReq{
+		UUID:        bCfg.UUID,
+		Certificate: string(certPEM),
+		Timestamp:   time.Now().Unix(),
+
+		AgentVersion: metadata.Version,
+	}
+
+	sig, err := signRenewReq(bReq, certPEM, keyPEM)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	bReq.Signature = sig
+
+	m, err := json.Marshal(bReq)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	credsResponse, err := postCredsRequest(ctx, strings.TrimSuffix(bCfg.CredsURL, "/")+"/renew", m)
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := bs.storeCredentials(ctx, credsResponse); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	span.SetStatus(codes.Ok, "Bootstrap: RenewalSucceeded")
+	bw.RenewalSucceeded.Inc()
+
+	cert, err := parseCertificatePEM([]byte(credsResponse.Certificate))
+	if err != nil {
+		return err
+	}
+	log.Info().Msgf("Bootstrap: Renewed client cert, valid until %v", cert.NotAfter)
+
+	// The cloud alerts on the expiry it knows of, keep it up to date
+	if err := reportCertExpiry(ctx, cert.NotAfter); err != nil {
+		log.Warn().Err(err).Msg("Bootstrap: Failed to report renewed cert expiry")
+	}
+
+	return nil
+}
+
+// signRenewReq signs the renewal payload with the key of the current cert
+func signRenewReq(req *bootstraptypes.RenewReq, certPEM, keyPEM []byte) ([]byte, error) {
+	kp, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client key pair: %w", err)
+	}
+
+	signer, ok := kp.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("client key does not support signing")
+	}
+
+	// Ed25519 signs the message itself rather than a digest
+	if _, ok := signer.(ed25519.PrivateKey); ok {
+		return signer.Sign(rand.Reader, req.SigningPayload(), crypto.Hash(0))
+	}
+
+	digest := sha256.Sum256(req.SigningPayload())
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+// loadCurrentCreds returns the current Temporal client cert and key. In
+// Kubernetes they are read from the secret, since updates to the secret are
+// propagated to the pod file system over time.
+func loadCurrentCreds(ctx context.Context) ([]byte, []byte, error) {
+	if ManagerAccess.Conf.EB.RunningIn != conftypes.RunningInK8s {
+		certPEM, err := os.ReadFile(ManagerAccess.Conf.EB.Temporal.GetTemporalClientCertFullPath())
+		if err != nil {
+			return nil, nil, err
+		}
+		keyPEM, err := os.ReadFile(ManagerAccess.Conf.EB.Temporal.GetTemporalClientKeyFullPath())
+		if err != nil {
+			return nil, nil, err
+		}
+		return certPEM, keyPEM, nil
+	}
+
+	secretIf := ManagerAccess.Data.EB.Managers.Bootstrap.Secret
+	if secretIf == nil {
+		return nil, nil, fmt.Errorf("Bootstrap: secretIf is nil")
+	}
+	secret, err := secretIf.Get(ctx, ManagerAccess.Conf.EB.TemporalSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	certPEM, keyPEM := secret.Data["certificate"], secret.Data["key"]
+	if len(certPEM) == 0 || len(keyPEM) == 0 {
+		return nil, nil, errors.New("no client cert in Temporal cert secret")
+	}
+	return certPEM, keyPEM, nil
+}
@@ -0,0 +1,108 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package bootstrap
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	bootstraptypes "github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/datatypes/managertypes/bootstrap"
+)
+
+func TestRenewalDue(t *testing.T) {
+	notBefore := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert := &x509.Certificate{
+		NotBefore: notBefore,
+		NotAfter:  notBefore.Add(90 * 24 * time.Hour),
+	}
+
+	assert.False(t, renewalDue(cert, 2.0/3.0, notBefore))
+	assert.False(t, renewalDue(cert, 2.0/3.0, notBefore.Add(59*24*time.Hour)))
+	assert.True(t, renewalDue(cert, 2.0/3.0, notBefore.Add(60*24*time.Hour)))
+	assert.True(t, renewalDue(cert, 2.0/3.0, notBefore.Add(100*24*time.Hour)))
+	assert.True(t, renewalDue(cert, 0.1, notBefore.Add(10*24*time.Hour)))
+}
+
+func selfSignedPEM(t *testing.T, pub, priv any) ([]byte, []byte) {
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "site.client.test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, priv)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestSignRenewReq(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		pub  any
+		priv any
+		algo x509.SignatureAlgorithm
+	}{
+		{"ecdsa", &ecKey.PublicKey, ecKey, x509.ECDSAWithSHA256},
+		{"ed25519", edPub, edKey, x509.PureEd25519},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certPEM, keyPEM := selfSignedPEM(t, tt.pub, tt.priv)
+			req := &bootstraptypes.RenewReq{
+				UUID:        "site-uuid",
+				Certificate: string(certPEM),
+				Timestamp:   time.Now().Unix(),
+			}
+
+			sig, err := signRenewReq(req, certPEM, keyPEM)
+			require.NoError(t, err)
+
+			cert, err := parseCertificatePEM(certPEM)
+			require.NoError(t, err)
+			assert.NoError(t, cert.CheckSignature(tt.algo, req.SigningPayload(), sig))
+
+			// A key that does not match the cert is rejected
+			otherCert, _ := selfSignedPEM(t, &ecKey.PublicKey, ecKey)
+			if tt.name != "ecdsa" {
+				_, err = signRenewReq(req, otherCert, keyPEM)
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -32,6 +32,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.CreateExpectedPowerShelf)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the CreateExpectedPowerShelf workflow")
 
+	// Register CreateExpectedPowerShelves workflow (plural)
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.CreateExpectedPowerShelves)
+	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the CreateExpectedPowerShelves workflow")
+
 	// Register UpdateExpectedPowerShelf workflow
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.UpdateExpectedPowerShelf)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the UpdateExpectedPowerShelf workflow")
@@ -47,6 +51,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedPowerShelfManager.CreateExpectedPowerShelfOnSite)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the CreateExpectedPowerShelfOnSite activity")
 
+	// Register CreateExpectedPowerShelvesOnSite activity (plural)
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the CreateExpectedPowerShelvesOnSite activity")
+
 	// Register UpdateExpectedPowerShelfOnSite activity
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedPowerShelfManager.UpdateExpectedPowerShelfOnSite)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedPowerShelf: successfully registered the UpdateExpectedPowerShelfOnSite activity")
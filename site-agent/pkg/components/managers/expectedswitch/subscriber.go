@@ -32,6 +32,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.CreateExpectedSwitch)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the CreateExpectedSwitch workflow")
 
+	// Register CreateExpectedSwitches workflow (plural)
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.CreateExpectedSwitches)
+	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the CreateExpectedSwitches workflow")
+
 	// Register UpdateExpectedSwitch workflow
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.UpdateExpectedSwitch)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the UpdateExpectedSwitch workflow")
@@ -47,6 +51,10 @@ func (api *API) RegisterSubscriber() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedSwitchManager.CreateExpectedSwitchOnSite)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the CreateExpectedSwitchOnSite activity")
 
+	// Register CreateExpectedSwitchesOnSite activity (plural)
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedSwitchManager.CreateExpectedSwitchesOnSite)
+	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the CreateExpectedSwitchesOnSite activity")
+
 	// Register UpdateExpectedSwitchOnSite activity
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(expectedSwitchManager.UpdateExpectedSwitchOnSite)
 	ManagerAccess.Data.EB.Log.Info().Msg("ExpectedSwitch: successfully registered the UpdateExpectedSwitchOnSite activity")
@@ -58,6 +58,11 @@ func (api *API) RegisterPublisher() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.DiscoverInstanceInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Instance: successfully registered the Discover Instance Inventory workflow")
 
+	var deltaTracker *swa.InventoryDeltaTracker
+	if ManagerAccess.Conf.EB.InventoryDeltaPublishing {
+		deltaTracker = swa.NewInventoryDeltaTracker(ManagerAccess.Conf.EB.InventoryFullSnapshotInterval)
+	}
+
 	instanceInventoryManager := swa.NewManageInstanceInventory(swa.ManageInventoryConfig{
 		SiteID:                uuid.MustParse(ManagerAccess.Conf.EB.Temporal.ClusterID),
 		CarbideAtomicClient:   ManagerAccess.Data.EB.Managers.Carbide.Client,
@@ -65,10 +70,18 @@ func (api *API) RegisterPublisher() error {
 		TemporalPublishQueue:  ManagerAccess.Conf.EB.Temporal.TemporalPublishQueue,
 		SitePageSize:          InventoryCarbidePageSize,
 		CloudPageSize:         InventoryCloudPageSize,
+		DeltaTracker:          deltaTracker,
 	})
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(instanceInventoryManager.DiscoverInstanceInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Instance: successfully registered the Discover Instance Inventory activity")
 
+	// Full Instance Inventory request workflow, triggered by Cloud when delta inventory is out of sync
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.RequestFullInstanceInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Instance: successfully registered the Request Full Instance Inventory workflow")
+
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(instanceInventoryManager.RequestFullInstanceInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Instance: successfully registered the Request Full Instance Inventory activity")
+
 	api.RegisterCron()
 	return nil
 }
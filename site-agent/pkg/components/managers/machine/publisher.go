@@ -32,6 +32,11 @@ func (api *API) RegisterPublisher() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.CollectAndPublishMachineInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Machine: successfully registered the Collect and Publish Machine Inventory workflow")
 
+	var deltaTracker *swa.InventoryDeltaTracker
+	if ManagerAccess.Conf.EB.InventoryDeltaPublishing {
+		deltaTracker = swa.NewInventoryDeltaTracker(ManagerAccess.Conf.EB.InventoryFullSnapshotInterval)
+	}
+
 	// Register Machine activity for Collect and Publish Machine Inventory
 	machineInventoryManager := swa.NewManageMachineInventory(
 		uuid.MustParse(ManagerAccess.Conf.EB.Temporal.ClusterID),
@@ -40,10 +45,18 @@ func (api *API) RegisterPublisher() error {
 		ManagerAccess.Conf.EB.Temporal.TemporalPublishQueue,
 		InventoryCarbidePageSize,
 		InventoryCloudPageSize,
+		deltaTracker,
 	)
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(machineInventoryManager.CollectAndPublishMachineInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Machine: successfully registered the Collect and Publish Machine Inventory activity")
 
+	// Full Machine Inventory request workflow, triggered by Cloud when delta inventory is out of sync
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.RequestFullMachineInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Machine: successfully registered the Request Full Machine Inventory workflow")
+
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(machineInventoryManager.RequestFullMachineInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Machine: successfully registered the Request Full Machine Inventory activity")
+
 	api.RegisterCron()
 	return nil
 }
@@ -48,6 +48,11 @@ func (api *API) RegisterPublisher() error {
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.DiscoverSubnetInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Subnet: successfully registered the Discover Subnet Inventory workflow")
 
+	var deltaTracker *swa.InventoryDeltaTracker
+	if ManagerAccess.Conf.EB.InventoryDeltaPublishing {
+		deltaTracker = swa.NewInventoryDeltaTracker(ManagerAccess.Conf.EB.InventoryFullSnapshotInterval)
+	}
+
 	inventoryManager := swa.NewManageSubnetInventory(swa.ManageInventoryConfig{
 		SiteID:                uuid.MustParse(ManagerAccess.Conf.EB.Temporal.ClusterID),
 		CarbideAtomicClient:   ManagerAccess.Data.EB.Managers.Carbide.Client,
@@ -55,10 +60,18 @@ func (api *API) RegisterPublisher() error {
 		TemporalPublishQueue:  ManagerAccess.Conf.EB.Temporal.TemporalPublishQueue,
 		SitePageSize:          InventoryCarbidePageSize,
 		CloudPageSize:         InventoryCloudPageSize,
+		DeltaTracker:          deltaTracker,
 	})
 	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(inventoryManager.DiscoverSubnetInventory)
 	ManagerAccess.Data.EB.Log.Info().Msg("Subnet: successfully registered the Discover Subnet Inventory activity")
 
+	// Full Subnet Inventory request workflow, triggered by Cloud when delta inventory is out of sync
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterWorkflow(sww.RequestFullSubnetInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Subnet: successfully registered the Request Full Subnet Inventory workflow")
+
+	ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.RegisterActivity(inventoryManager.RequestFullSubnetInventory)
+	ManagerAccess.Data.EB.Log.Info().Msg("Subnet: successfully registered the Request Full Subnet Inventory activity")
+
 	api.RegisterCron()
 
 	return nil
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"sync/atomic"
@@ -40,25 +41,26 @@ import (
 	computils "github.com/nvidia/bare-metal-manager-rest/site-agent/pkg/components/utils"
 )
 
+// workerStopTimeout is how long a retired worker waits for its in-flight
+// activities to complete before it is stopped
+const workerStopTimeout = 2 * time.Minute
+
+// orchestratorMu serializes reconnects, e.g. when several cert files change
+var orchestratorMu sync.Mutex
+
 // Orchestrator - Workflow Orchestrator
 func Orchestrator() {
+	orchestratorMu.Lock()
+	defer orchestratorMu.Unlock()
+
 	defer computils.UpdateState(ManagerAccess.Data.EB)
 	log := ManagerAccess.Data.EB.Log
 	state := ManagerAccess.Data.EB.Managers.Workflow.State
 
-	// Cleanup resources
-	if ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker != nil {
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker.Stop()
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Worker = nil
-	}
-	if ManagerAccess.Data.EB.Managers.Workflow.Temporal.Publisher != nil {
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Publisher.Close()
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Publisher = nil
-	}
-	if ManagerAccess.Data.EB.Managers.Workflow.Temporal.Subscriber != nil {
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Subscriber.Close()
-		ManagerAccess.Data.EB.Managers.Workflow.Temporal.Subscriber = nil
-	}
+	// Keep the previous worker and clients until the new ones are up, so that
+	// a credential rotation does not drop in-flight activities
+	temporal := ManagerAccess.Data.EB.Managers.Workflow.Temporal
+	prevWorker, prevPublisher, prevSubscriber := temporal.Worker, temporal.Publisher, temporal.Subscriber
 
 	// keep track how many events we've seen.
 	state.ConnectionAttempted.Inc()
@@ -70,10 +72,26 @@ func Orchestrator() {
 		tStr := err.Error()
 		atomic.StorePointer((*unsafe.Pointer)(unsafe.Pointer(&state.Err)), unsafe.Pointer(&tStr))
 		log.Error().Msg(*state.Err)
+		retire(prevWorker, prevPublisher, prevSubscriber)
 	} else {
 		// keep track how many succeeded.
 		state.ConnectionSucc.Inc()
 		state.HealthStatus.Store(uint64(computils.CompHealthy))
+		go retire(prevWorker, prevPublisher, prevSubscriber)
+	}
+}
+
+// retire stops a previous worker, letting its in-flight activities drain,
+// then closes the clients it used
+func retire(w worker.Worker, publisher client.Client, subscriber client.Client) {
+	if w != nil {
+		w.Stop()
+	}
+	if publisher != nil {
+		publisher.Close()
+	}
+	if subscriber != nil {
+		subscriber.Close()
 	}
 }
 
@@ -218,6 +236,7 @@ func workflowOrchestrator() error {
 		worker.Options{
 			Interceptors:        workerInterceptors,
 			WorkflowPanicPolicy: worker.FailWorkflow,
+			WorkerStopTimeout:   workerStopTimeout,
 		})
 	log.Info().Msg("Workflow: Registering orchestrator workflows and activities for elektra cluster ")
 
@@ -119,13 +119,21 @@ type Config struct {
 	DisableBootstrap bool          `json:"disableBootstrap"`
 	BootstrapSecret  string        `json:"bootstrapSecret"` // Path to the bootstrap secret file
 	WatcherInterval  time.Duration `json:"watcherInterval"`
-	PodNamespace     string        `json:"podNamespace"`
-	TemporalSecret   string        `json:"temporalSecret"`
-	MetricsPort      string        `json:"metricsPort"`
-	SiteVersion      string        `json:"siteVersion"`
-	CloudVersion     string        `json:"cloudVersion"`
-	RunningIn        RunInEnvironment
-	UtMode           bool
+	// CertRenewalThreshold is the fraction of the client certificate lifetime
+	// after which the certificate is renewed
+	CertRenewalThreshold float64 `json:"certRenewalThreshold"`
+	PodNamespace         string  `json:"podNamespace"`
+	TemporalSecret       string  `json:"temporalSecret"`
+	MetricsPort          string  `json:"metricsPort"`
+	SiteVersion          string  `json:"siteVersion"`
+	CloudVersion         string  `json:"cloudVersion"`
+	RunningIn            RunInEnvironment
+	UtMode               bool
+
+	// InventoryDeltaPublishing publishes only changed Machine, Instance and
+	// Subnet inventory, with a full snapshot every InventoryFullSnapshotInterval publications
+	InventoryDeltaPublishing      bool `json:"inventoryDeltaPublishing"`
+	InventoryFullSnapshotInterval int  `json:"inventoryFullSnapshotInterval"`
 }
 
 // String - json string
@@ -18,6 +18,8 @@
 package bootstraptypes
 
 import (
+	"fmt"
+
 	"go.uber.org/atomic"
 	coreV1Types "k8s.io/client-go/kubernetes/typed/core/v1"
 )
@@ -40,8 +42,25 @@ type SecretConfig struct {
 
 // SecretReq Secret request data body
 type SecretReq struct {
-	UUID string `json:"siteuuid"`
-	OTP  string `json:"otp"`
+	UUID         string `json:"siteuuid"`
+	OTP          string `json:"otp"`
+	AgentVersion string `json:"agentversion,omitempty"`
+}
+
+// RenewReq Renewal request data body, signed with the current client key
+type RenewReq struct {
+	UUID        string `json:"siteuuid"`
+	Certificate string `json:"certificate"`
+	Timestamp   int64  `json:"timestamp"`
+	Signature   []byte `json:"signature"`
+
+	AgentVersion string `json:"agentversion,omitempty"`
+}
+
+// SigningPayload returns the bytes signed with the current client key, must
+// match the payload verified by the site manager
+func (r *RenewReq) SigningPayload() []byte {
+	return []byte(fmt.Sprintf("site-creds-renew:%s:%d", r.UUID, r.Timestamp))
 }
 
 // SiteCredsResponse defines a site credentials response
@@ -60,6 +79,10 @@ type State struct {
 	DownloadSucceeded atomic.Uint64
 	// DownloadAttempted the number of times the secret file has been updated
 	DownloadAttempted atomic.Uint64
+	// RenewalSucceeded the number of times the credentials have been renewed
+	RenewalSucceeded atomic.Uint64
+	// RenewalAttempted the number of times a renewal has been attempted
+	RenewalAttempted atomic.Uint64
 }
 
 // Bootstrap - data type for Bootstrap
@@ -27,6 +27,14 @@ const (
 	// SiteRegistrationComplete site was regsistered, creds no longer
 	// available
 	SiteRegistrationComplete = "RegistrationComplete"
+
+	// CredsFetchOTP creds were fetched with the bootstrap OTP
+	CredsFetchOTP = "otp"
+	// CredsFetchRenew creds were renewed with the current certificate
+	CredsFetchRenew = "renew"
+
+	// MaxRollHistory is the number of rolls kept in the site status
+	MaxRollHistory = 10
 )
 
 // Site represents one Forge Site
@@ -57,6 +65,36 @@ type SiteStatus struct {
 	OTP                OTPInfo `json:"otp,omitempty"`
 	BootstrapState     string  `json:"bootstrapstate,omitempty"`
 	ControlPlaneStatus string  `json:"controlplanestatus,omitempty"`
+	// +optional
+	LastCredsFetch *CredsFetchInfo `json:"lastcredsfetch,omitempty"`
+	// +optional
+	Certificate *CertInfo `json:"certificate,omitempty"`
+	// +optional
+	AgentVersion string `json:"agentversion,omitempty"`
+	// +optional
+	Rolls []RollInfo `json:"rolls,omitempty"`
+}
+
+// CredsFetchInfo records the last time a site fetched credentials
+// +k8s:openapi-gen=true
+type CredsFetchInfo struct {
+	Time     metav1.Time `json:"time"`
+	SourceIP string      `json:"sourceip,omitempty"`
+	Method   string      `json:"method,omitempty"`
+}
+
+// CertInfo identifies the client certificate last issued to a site
+// +k8s:openapi-gen=true
+type CertInfo struct {
+	Serial string      `json:"serial,omitempty"`
+	Expiry metav1.Time `json:"expiry"`
+}
+
+// RollInfo records an OTP roll, newest last
+// +k8s:openapi-gen=true
+type RollInfo struct {
+	Time          metav1.Time `json:"time"`
+	PreviousState string      `json:"previousstate,omitempty"`
 }
 
 // OTPInfo has a passcode and expiry timestamp
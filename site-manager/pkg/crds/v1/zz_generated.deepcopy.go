@@ -28,6 +28,40 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertInfo) DeepCopyInto(out *CertInfo) {
+	*out = *in
+	in.Expiry.DeepCopyInto(&out.Expiry)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertInfo.
+func (in *CertInfo) DeepCopy() *CertInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CertInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredsFetchInfo) DeepCopyInto(out *CredsFetchInfo) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredsFetchInfo.
+func (in *CredsFetchInfo) DeepCopy() *CredsFetchInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(CredsFetchInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OTPInfo) DeepCopyInto(out *OTPInfo) {
 	*out = *in
@@ -44,13 +78,30 @@ func (in *OTPInfo) DeepCopy() *OTPInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollInfo) DeepCopyInto(out *RollInfo) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollInfo.
+func (in *RollInfo) DeepCopy() *RollInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(RollInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Site) DeepCopyInto(out *Site) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -125,6 +176,23 @@ func (in *SiteSpec) DeepCopy() *SiteSpec {
 func (in *SiteStatus) DeepCopyInto(out *SiteStatus) {
 	*out = *in
 	out.OTP = in.OTP
+	if in.LastCredsFetch != nil {
+		in, out := &in.LastCredsFetch, &out.LastCredsFetch
+		*out = new(CredsFetchInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(CertInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rolls != nil {
+		in, out := &in.Rolls, &out.Rolls
+		*out = make([]RollInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -118,6 +118,15 @@ func (h *deleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		http.Error(w, "UUID is required", http.StatusBadRequest)
 		return
 	}
+	// Revoke before deleting so that a failed revocation can be retried
+	if h.manager.credsMgrToken == "" {
+		log.Warnf("No creds manager token configured, not revoking certificates of site %s", uuid)
+	} else if err := h.manager.revokeSiteCertificates(r.Context(), uuid, "site deleted"); err != nil {
+		log.Errorf("Revoke certificates of site %s %v", uuid, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	objName := nameFromUUID(uuid)
 	if err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).Delete(r.Context(), objName, metav1.DeleteOptions{}); err != nil {
 		log.Errorf("Delete site %s %v", objName, err)
@@ -244,6 +253,7 @@ func (h *rollHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	recordRoll(&siteObj.Status, time.Now())
 	siteObj.Status.OTP = *otp
 	siteObj.Status.BootstrapState = crdsv1.SiteAwaitHandshake
 	if _, err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).UpdateStatus(r.Context(), siteObj, metav1.UpdateOptions{}); err != nil {
@@ -61,6 +61,12 @@ func NewCommand() *cli.Command {
 				Value: "https://localhost:8000",
 				Usage: "creds manager service endpoint used by backend",
 			},
+			&cli.StringFlag{
+				Name:    "creds-manager-token",
+				Value:   "",
+				EnvVars: []string{"CREDS_MANAGER_TOKEN"},
+				Usage:   "Admin token for the creds manager, used to revoke the certificates of deleted sites",
+			},
 			&cli.StringFlag{
 				Name:  "tls-key-path",
 				Value: "",
@@ -82,6 +88,12 @@ func NewCommand() *cli.Command {
 				Value: defOTPDuration,
 				Usage: "OTP duration in hours",
 			},
+			&cli.DurationFlag{
+				Name:    "otp-alert-window",
+				Value:   defOTPAlertWindow,
+				EnvVars: []string{"OTP_ALERT_WINDOW"},
+				Usage:   "Alert on sites awaiting the bootstrap handshake whose OTP expires within this window",
+			},
 			&cli.StringFlag{
 				Name:    "sentry-dsn",
 				Value:   "",
@@ -100,13 +112,16 @@ func NewCommand() *cli.Command {
 			log := core.GetLogger(ctx)
 
 			o := Options{
-				credsMgrURL: c.String("creds-manager-url"),
-				ingressHost: c.String("ingress-host"),
-				listenPort:  c.String("listen-port"),
-				tlsKeyPath:  c.String("tls-key-path"),
-				tlsCertPath: c.String("tls-cert-path"),
-				namespace:   c.String("namespace"),
-				sentryDSN:   c.String("sentry-dsn"),
+				credsMgrURL:   c.String("creds-manager-url"),
+				credsMgrToken: c.String("creds-manager-token"),
+				ingressHost:   c.String("ingress-host"),
+				listenPort:    c.String("listen-port"),
+				tlsKeyPath:    c.String("tls-key-path"),
+				tlsCertPath:   c.String("tls-cert-path"),
+				namespace:     c.String("namespace"),
+				sentryDSN:     c.String("sentry-dsn"),
+
+				otpAlertWindow: c.Duration("otp-alert-window"),
 			}
 
 			otpHrs := c.Int("otp-duration")
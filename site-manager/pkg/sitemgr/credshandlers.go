@@ -18,9 +18,15 @@
 package sitemgr
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	crdsv1 "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/crds/v1"
@@ -31,6 +37,10 @@ import (
 
 const (
 	clientTTL = 90 * 24
+
+	// renewMaxSkew bounds the age of a signed renewal request so that a
+	// captured request cannot be replayed later
+	renewMaxSkew = 5 * time.Minute
 )
 
 type credsHandler struct {
@@ -108,6 +118,7 @@ func (h *credsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 
 	siteObj.Status.BootstrapState = crdsv1.SiteHandshakeComplete
+	recordCredsFetch(&siteObj.Status, r, crdsv1.CredsFetchOTP, req.AgentVersion, cr.Certificate)
 	if _, err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).UpdateStatus(r.Context(), siteObj, metav1.UpdateOptions{}); err != nil {
 		log.Errorf("Get credentials site %s %v", objName, err)
 		http.Error(w, "check logs", http.StatusInternalServerError)
@@ -116,3 +127,160 @@ func (h *credsHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	h.manager.writeJSONResp(w, resp)
 }
+
+type credsRenewHandler struct {
+	manager *SiteMgr
+}
+
+// ServeHTTP implements the site creds renewal method. Unlike the OTP
+// handshake it needs no operator involvement: the site proves possession of
+// its current, unexpired and unrevoked certificate instead.
+func (h *credsRenewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := h.manager.log
+	req := &types.SiteCredsRenewRequest{}
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	objName := nameFromUUID(req.SiteUUID)
+	siteObj, err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).Get(r.Context(), objName, metav1.GetOptions{})
+	if err != nil {
+		log.Errorf("Site Creds Renew Req: %s  %v", req.SiteUUID, err)
+		if k8serr.IsNotFound(err) {
+			http.Error(w, fmt.Sprintf("site %s not found", req.SiteUUID), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Unable to retrieve site - check logs", http.StatusInternalServerError)
+		return
+	}
+
+	ca, err := h.manager.getCA(r.Context())
+	if err != nil {
+		log.Errorf("getCA: %v", err)
+		http.Error(w, "Error getting CA, check logs", http.StatusInternalServerError)
+		return
+	}
+
+	crl, err := h.manager.getCRL(r.Context())
+	if err != nil {
+		log.Errorf("getCRL: %v", err)
+		http.Error(w, "Error getting CRL, check logs", http.StatusInternalServerError)
+		return
+	}
+
+	if err := verifyRenewRequest(req, ca, crl, time.Now()); err != nil {
+		log.Infof("Rejected creds renewal for site %s: %v", req.SiteUUID, err)
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	log.Infof("Renewing creds for site: %s", req.SiteUUID)
+	cr, err := h.manager.getCertificate(r.Context(), "client", req.SiteUUID, clientTTL)
+	if err != nil {
+		log.Errorf("getCertificate: %v", err)
+		http.Error(w, "Error getting creds, check logs", http.StatusInternalServerError)
+		return
+	}
+
+	// The certificate is already issued, so a failure to record it must not
+	// make the site retry and obtain yet another one
+	recordCredsFetch(&siteObj.Status, r, crdsv1.CredsFetchRenew, req.AgentVersion, cr.Certificate)
+	if _, err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).UpdateStatus(r.Context(), siteObj, metav1.UpdateOptions{}); err != nil {
+		log.Errorf("Renew credentials site %s %v", objName, err)
+	}
+
+	resp := &types.SiteCredsResponse{
+		Key:           cr.Key,
+		Certificate:   cr.Certificate,
+		CACertificate: ca,
+	}
+
+	h.manager.writeJSONResp(w, resp)
+}
+
+// verifyRenewRequest checks that the request is recent, carries a valid
+// client certificate issued to the site and is signed with its key
+func verifyRenewRequest(req *types.SiteCredsRenewRequest, caPEM, crlPEM string, now time.Time) error {
+	if req.SiteUUID == "" {
+		return fmt.Errorf("site uuid is required")
+	}
+
+	signedAt := time.Unix(req.Timestamp, 0)
+	if now.Sub(signedAt) > renewMaxSkew || signedAt.Sub(now) > renewMaxSkew {
+		return fmt.Errorf("request timestamp %v out of range", signedAt.UTC())
+	}
+
+	block, _ := pem.Decode([]byte(req.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("failed to decode certificate PEM")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM([]byte(caPEM)) {
+		return fmt.Errorf("no CA certificates to verify against")
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return fmt.Errorf("certificate not valid: %w", err)
+	}
+
+	// Certificates for sites are issued as <uuid>.client.<base dns>
+	if !strings.HasPrefix(cert.Subject.CommonName, req.SiteUUID+".client.") {
+		return fmt.Errorf("certificate %q not issued to site %s", cert.Subject.CommonName, req.SiteUUID)
+	}
+
+	revoked, err := isRevoked(cert, crlPEM)
+	if err != nil {
+		return err
+	}
+	if revoked {
+		return fmt.Errorf("certificate has been revoked")
+	}
+
+	var algo x509.SignatureAlgorithm
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		algo = x509.SHA256WithRSA
+	case *ecdsa.PublicKey:
+		algo = x509.ECDSAWithSHA256
+	case ed25519.PublicKey:
+		algo = x509.PureEd25519
+	default:
+		return fmt.Errorf("unsupported public key type %T", cert.PublicKey)
+	}
+	if err := cert.CheckSignature(algo, req.SigningPayload(), req.Signature); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	return nil
+}
+
+// isRevoked reports whether the certificate is listed in any of the CRLs
+func isRevoked(cert *x509.Certificate, crlPEM string) (bool, error) {
+	rest := []byte(crlPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return false, nil
+		}
+
+		crl, err := x509.ParseRevocationList(block.Bytes)
+		if err != nil {
+			return false, fmt.Errorf("failed to parse CRL: %w", err)
+		}
+		for _, entry := range crl.RevokedCertificateEntries {
+			if entry.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return true, nil
+			}
+		}
+	}
+}
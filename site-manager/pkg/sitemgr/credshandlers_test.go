@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sitemgr
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testPKI struct {
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	caPEM  string
+}
+
+func newTestPKI(t *testing.T) *testPKI {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testPKI{
+		caCert: cert,
+		caKey:  key,
+		caPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+func (p *testPKI) issue(t *testing.T, cn string, serial int64, notAfter time.Time) (string, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.caCert, &key.PublicKey, p.caKey)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), key
+}
+
+func (p *testPKI) crl(t *testing.T, serials ...int64) string {
+	tmpl := &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+	for _, s := range serials {
+		tmpl.RevokedCertificateEntries = append(tmpl.RevokedCertificateEntries, x509.RevocationListEntry{
+			SerialNumber:   big.NewInt(s),
+			RevocationTime: time.Now(),
+		})
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, tmpl, p.caCert, p.caKey)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}))
+}
+
+func signedRenewRequest(t *testing.T, uuid, certPEM string, key crypto.Signer, at time.Time) *types.SiteCredsRenewRequest {
+	req := &types.SiteCredsRenewRequest{
+		SiteUUID:    uuid,
+		Certificate: certPEM,
+		Timestamp:   at.Unix(),
+	}
+	digest := sha256.Sum256(req.SigningPayload())
+	sig, err := key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	require.NoError(t, err)
+	req.Signature = sig
+	return req
+}
+
+func TestVerifyRenewRequest(t *testing.T) {
+	pki := newTestPKI(t)
+	otherPKI := newTestPKI(t)
+	now := time.Now()
+	uuid := "0c5f3b1e-site"
+
+	certPEM, key := pki.issue(t, uuid+".client.carbide.local", 10, now.Add(time.Hour))
+	otherPEM, otherKey := otherPKI.issue(t, uuid+".client.carbide.local", 11, now.Add(time.Hour))
+	_, wrongKey := pki.issue(t, uuid+".client.carbide.local", 12, now.Add(time.Hour))
+	otherSitePEM, otherSiteKey := pki.issue(t, "other-site.client.carbide.local", 13, now.Add(time.Hour))
+
+	tests := []struct {
+		name    string
+		req     *types.SiteCredsRenewRequest
+		crl     string
+		wantErr bool
+	}{
+		{"valid", signedRenewRequest(t, uuid, certPEM, key, now), "", false},
+		{"valid with empty CRL", signedRenewRequest(t, uuid, certPEM, key, now), pki.crl(t, 99), false},
+		{"revoked", signedRenewRequest(t, uuid, certPEM, key, now), pki.crl(t, 10), true},
+		{"stale", signedRenewRequest(t, uuid, certPEM, key, now.Add(-time.Hour)), "", true},
+		{"untrusted CA", signedRenewRequest(t, uuid, otherPEM, otherKey, now), "", true},
+		{"wrong key", signedRenewRequest(t, uuid, certPEM, wrongKey, now), "", true},
+		{"other site", signedRenewRequest(t, uuid, otherSitePEM, otherSiteKey, now), "", true},
+		{"no certificate", signedRenewRequest(t, uuid, "", key, now), "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyRenewRequest(tt.req, pki.caPEM, tt.crl, now)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	// an expired certificate needs the OTP handshake
+	expiredPEM, expiredKey := pki.issue(t, uuid+".client.carbide.local", 14, now.Add(-time.Minute))
+	assert.Error(t, verifyRenewRequest(signedRenewRequest(t, uuid, expiredPEM, expiredKey, now), pki.caPEM, "", now))
+}
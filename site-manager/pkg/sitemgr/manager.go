@@ -41,8 +41,10 @@ import (
 )
 
 const (
-	vaultTimeout      = 20 * time.Second
-	oneYrHours        = 365 * 24
+	vaultTimeout = 20 * time.Second
+	// ingressTTL requests the default TTL of the cert-manager
+	ingressTTL        = 0
+	tlsRenewInterval  = 10 * time.Minute
 	mgrCertFile       = "/tmp/mgr.cert"
 	mgrKeyFile        = "/tmp/mgr.key"
 	crdClientName     = "site-crd-client"
@@ -51,13 +53,16 @@ const (
 
 // Options are args passed to the site manager at boot
 type Options struct {
-	credsMgrURL string
-	ingressHost string
-	listenPort  string
-	tlsKeyPath  string
-	tlsCertPath string
-	namespace   string
-	sentryDSN   string
+	credsMgrURL   string
+	credsMgrToken string
+	ingressHost   string
+	listenPort    string
+	tlsKeyPath    string
+	tlsCertPath   string
+	namespace     string
+	sentryDSN     string
+	// otpAlertWindow is how long before expiry an unused OTP is alerted on
+	otpAlertWindow time.Duration
 }
 
 // SiteMgr defines an instance of site manager
@@ -68,6 +73,12 @@ type SiteMgr struct {
 	certClient *http.Client
 	log        *logrus.Entry
 	l          net.Listener
+	// renewTLS is set when the ingress certificate is issued by the
+	// cert-manager rather than passed on the command line
+	renewTLS bool
+	// otpAlertStates is the OTP state last warned about per site UUID, it
+	// is only accessed by the OTP monitor
+	otpAlertStates map[string]string
 }
 
 // NewSiteManager creates an instance of SiteMgr
@@ -117,6 +128,9 @@ func newSiteManager(ctx context.Context, o Options, c crdclient.Interface) (*Sit
 	}
 
 	s.crdClient = c
+	if s.otpAlertWindow == 0 {
+		s.otpAlertWindow = defOTPAlertWindow
+	}
 
 	err := s.tlsSetup(ctx)
 	if err != nil {
@@ -130,10 +144,12 @@ func newSiteManager(ctx context.Context, o Options, c crdclient.Interface) (*Sit
 	appService.Use(core.NewHTTPMiddleware(ctx, core.WithRequestMetrics("cloud_site_manager"))...)
 	appService.Path("/v1/site").Handler(s.siteCreateHandler()).Methods("POST")
 	appService.Path("/v1/site/{uuid}").Handler(s.siteGetHandler()).Methods("GET")
+	appService.Path("/v1/site/{uuid}/status").Handler(s.siteStatusHandler()).Methods("GET")
 	appService.Path("/v1/site/roll/{uuid}").Handler(s.siteRollHandler()).Methods("POST")
 	appService.Path("/v1/site/register/{uuid}").Handler(s.siteRegisterHandler()).Methods("POST")
 	appService.Path("/v1/site/{uuid}").Handler(s.siteDeleteHandler()).Methods("DELETE")
 	appService.Path("/v1/sitecreds").Handler(s.siteCredsHandler()).Methods("POST")
+	appService.Path("/v1/sitecreds/renew").Handler(s.siteCredsRenewHandler()).Methods("POST")
 	s.appService = appService
 	return s, nil
 }
@@ -145,6 +161,11 @@ func (s *SiteMgr) Start(ctx context.Context) {
 		log.Fatalf("failed to start appService: %v", err)
 	}
 	s.l = l
+
+	go s.monitorOTPExpiry(ctx)
+	if s.renewTLS {
+		go s.renewIngressCert(ctx)
+	}
 }
 
 func (s *SiteMgr) tlsSetup(ctx context.Context) error {
@@ -156,32 +177,77 @@ func (s *SiteMgr) tlsSetup(ctx context.Context) error {
 
 	log.Infof("Setting up TLS Config using vault service")
 
-	var (
-		resp *certs.CertificateResponse
-		err  error
-	)
-
 	for {
-		resp, err = s.getCertificate(ctx, s.ingressHost, "", oneYrHours)
+		err := s.issueIngressCert(ctx)
 		if err == nil {
 			break
 		}
-		log.Infof("getCertificate: %v, retry in 10s", err)
+		log.Infof("issueIngressCert: %v, retry in 10s", err)
 		time.Sleep(10 * time.Second)
 	}
-	err = os.WriteFile(mgrCertFile, []byte(resp.Certificate), 0644)
+
+	s.tlsCertPath = mgrCertFile
+	s.tlsKeyPath = mgrKeyFile
+	s.renewTLS = true
+	return nil
+}
+
+// issueIngressCert gets a certificate for the ingress host from the
+// cert-manager and writes it to mgrCertFile/mgrKeyFile. The cert-manager
+// caps its TTL, renewIngressCert reissues it before it expires.
+func (s *SiteMgr) issueIngressCert(ctx context.Context) error {
+	resp, err := s.getCertificate(ctx, s.ingressHost, "", ingressTTL)
 	if err != nil {
 		return err
 	}
 
+	// the key goes first, the TLS service reloads the pair when the
+	// certificate changes
 	err = os.WriteFile(mgrKeyFile, []byte(resp.Key), 0644)
 	if err != nil {
 		return err
 	}
 
-	s.tlsCertPath = mgrCertFile
-	s.tlsKeyPath = mgrKeyFile
-	return nil
+	return os.WriteFile(mgrCertFile, []byte(resp.Certificate), 0644)
+}
+
+// renewIngressCert reissues the ingress certificate once the cert-manager
+// signs with another CA, e.g. after a CA rotation, or when it nears expiry
+func (s *SiteMgr) renewIngressCert(ctx context.Context) {
+	ticker := time.NewTicker(tlsRenewInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := s.checkIngressCert(ctx, time.Now()); err != nil {
+			s.log.Errorf("checkIngressCert: %v", err)
+		}
+	}
+}
+
+// checkIngressCert reissues the ingress certificate if it needs to be
+func (s *SiteMgr) checkIngressCert(ctx context.Context, now time.Time) error {
+	cert, err := os.ReadFile(s.tlsCertPath)
+	if err != nil {
+		return err
+	}
+	ca, err := s.getCA(ctx)
+	if err != nil {
+		return err
+	}
+
+	reissue, err := core.NeedsReissue(cert, []byte(ca), now)
+	if err != nil || !reissue {
+		return err
+	}
+
+	s.log.Infof("Reissuing ingress certificate for %s", s.ingressHost)
+	return s.issueIngressCert(ctx)
 }
 
 func (s *SiteMgr) getCertificate(ctx context.Context, name, app string, ttl int) (*certs.CertificateResponse, error) {
@@ -229,6 +295,57 @@ func (s *SiteMgr) getCA(ctx context.Context) (string, error) {
 	return string(content), nil
 }
 
+// revokeSiteCertificates revokes the client certificates issued to a site
+func (s *SiteMgr) revokeSiteCertificates(ctx context.Context, uuid, reason string) error {
+	body := &certs.RevokeRequest{
+		Name:   "client",
+		App:    uuid,
+		Reason: reason,
+	}
+
+	payloadBuf := new(bytes.Buffer)
+	err := json.NewEncoder(payloadBuf).Encode(body)
+	if err != nil {
+		return errors.Wrap(err, "json encode payload")
+	}
+	url := s.credsMgrURL + "/v1/pki/revoke"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, payloadBuf)
+	if err != nil {
+		return errors.Wrap(err, "http.NewRequestWithContext")
+	}
+	req.Header.Set("Authorization", "Bearer "+s.credsMgrToken)
+
+	content, err := s.roundTrip(req)
+	if err != nil {
+		return errors.Wrap(err, "s.roundTrip(req)")
+	}
+
+	resp := &certs.RevokeResponse{}
+	if content != nil {
+		if err := json.Unmarshal(content, resp); err != nil {
+			return errors.Wrap(err, "s.json.Unmarshal")
+		}
+	}
+	for _, c := range resp.Revoked {
+		s.log.Infof("Revoked certificate %s for site %s", c.SerialNumber, uuid)
+	}
+	return nil
+}
+
+func (s *SiteMgr) getCRL(ctx context.Context) (string, error) {
+	url := s.credsMgrURL + "/v1/pki/crl"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "http.NewRequestWithContext")
+	}
+	content, err := s.roundTrip(req)
+	if err != nil {
+		return "", errors.Wrap(err, "s.roundTrip(req)")
+	}
+
+	return string(content), nil
+}
+
 func (s *SiteMgr) roundTrip(req *http.Request) ([]byte, error) {
 	resp, err := s.certClient.Do(req)
 	if err != nil {
@@ -263,6 +380,11 @@ func (s *SiteMgr) siteGetHandler() http.Handler {
 	return s.withWraps(h, "csm-site-get")
 }
 
+func (s *SiteMgr) siteStatusHandler() http.Handler {
+	h := &statusHandler{manager: s}
+	return s.withWraps(h, "csm-site-status")
+}
+
 func (s *SiteMgr) siteRegisterHandler() http.Handler {
 	h := &registerHandler{manager: s}
 	return s.withWraps(h, "csm-site-register")
@@ -277,6 +399,11 @@ func (s *SiteMgr) siteCredsHandler() http.Handler {
 	return s.withWraps(h, "csm-site-bootstrap")
 }
 
+func (s *SiteMgr) siteCredsRenewHandler() http.Handler {
+	h := &credsRenewHandler{manager: s}
+	return s.withWraps(h, "csm-site-renew")
+}
+
 // withWraps applies the required wrappers to the handlers
 func (s *SiteMgr) withWraps(h http.Handler, oper string) http.Handler {
 	oh := otelhttp.NewHandler(h, oper)
@@ -43,6 +43,8 @@ const (
 	Testuuid1 = "test-uuid1-1234567890"
 	testuuid2 = "test-uuid2-1234567890"
 	testuuid3 = "test-uuid3-1234567890"
+
+	testCredsMgrToken = "test-creds-manager-token"
 )
 
 // Suite test instance
@@ -56,6 +58,7 @@ type Suite struct {
 	MgrURL   string
 	cancel   context.CancelFunc
 	UUID1OTP string
+	revoked  []string
 }
 
 var (
@@ -169,6 +172,27 @@ func (s *Suite) setup() error {
 		}
 	})
 
+	rtr.HandleFunc("/v1/pki/crl", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/x-pem-file")
+	})
+
+	rtr.HandleFunc("/v1/pki/revoke", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testCredsMgrToken {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		req := &certs.RevokeRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil || req.Name != "client" || req.App == "" {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		s.Lock()
+		s.revoked = append(s.revoked, req.App)
+		s.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"revoked":[]}`))
+	})
+
 	s.srv = httptest.NewUnstartedServer(rtr)
 	s.srv.Listener = l
 	s.srv.StartTLS()
@@ -288,10 +312,11 @@ func TestManagerCreateSite() (*Suite, error) {
 
 	fcrd := fakecrdclient.NewSimpleClientset()
 	o := Options{
-		credsMgrURL: fmt.Sprintf("https://%s", ts.l.Addr().String()),
-		ingressHost: "test-host",
-		listenPort:  "0",
-		namespace:   "csm",
+		credsMgrURL:   fmt.Sprintf("https://%s", ts.l.Addr().String()),
+		credsMgrToken: testCredsMgrToken,
+		ingressHost:   "test-host",
+		listenPort:    "0",
+		namespace:     "csm",
 	}
 
 	ctx := core.NewDefaultContext(context.Background())
@@ -456,6 +481,12 @@ func (s *Suite) TestManagerSiteTest() error {
 	if http.StatusOK != httpResp.StatusCode {
 		return fmt.Errorf("status code is !OK %+v", httpResp.StatusCode)
 	}
+	ts.Lock()
+	revoked := ts.revoked
+	ts.Unlock()
+	if len(revoked) != 1 || revoked[0] != testuuid2 {
+		return fmt.Errorf("site certificates not revoked on delete: %v", revoked)
+	}
 
 	testCase("Delete non-existent site")
 	dreq, err = http.NewRequest(http.MethodDelete, testURL+"/v1/site/"+testuuid2, nil)
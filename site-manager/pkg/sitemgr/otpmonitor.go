@@ -0,0 +1,147 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sitemgr
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	crdsv1 "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/crds/v1"
+)
+
+const (
+	defOTPAlertWindow = 4 * time.Hour
+
+	otpStateExpiring = "expiring"
+	otpStateExpired  = "expired"
+)
+
+var (
+	otpMonitorInterval = time.Minute
+
+	siteOTPExpiryMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cloud_site_manager",
+		Name:      "site_otp_expiry_timestamp_seconds",
+		Help:      "Expiry of the OTP of sites awaiting the bootstrap handshake",
+	}, []string{"site"})
+	siteCertExpiryMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cloud_site_manager",
+		Name:      "site_cert_expiry_timestamp_seconds",
+		Help:      "Expiry of the client certificate last issued to a site",
+	}, []string{"site"})
+	sitesOTPExpiringMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "cloud_site_manager",
+		Name:      "sites_otp_expiring",
+		Help:      "Number of sites awaiting the bootstrap handshake whose OTP expires within the alert window",
+	})
+	sitesOTPExpiredMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "cloud_site_manager",
+		Name:      "sites_otp_expired",
+		Help:      "Number of sites awaiting the bootstrap handshake whose OTP has expired",
+	})
+)
+
+// otpAlerts are the sites awaiting the bootstrap handshake with an OTP that
+// is about to expire or has expired
+type otpAlerts struct {
+	expiring []string
+	expired  []string
+	// warned are the sites logged by this check, a site is only logged
+	// when its OTP state changes, the metrics track the ongoing state
+	warned []string
+}
+
+// monitorOTPExpiry periodically exports OTP and certificate expiry metrics
+// and warns about sites whose bootstrap is stuck on an expiring OTP
+func (s *SiteMgr) monitorOTPExpiry(ctx context.Context) {
+	ticker := time.NewTicker(otpMonitorInterval)
+	defer ticker.Stop()
+
+	for {
+		s.checkOTPExpiry(ctx, time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkOTPExpiry updates the expiry metrics for all sites
+func (s *SiteMgr) checkOTPExpiry(ctx context.Context, now time.Time) (*otpAlerts, error) {
+	log := s.log
+	sites, err := s.crdClient.ForgeV1().Sites(s.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Errorf("List sites for OTP expiry check %v", err)
+		return nil, err
+	}
+
+	if s.otpAlertStates == nil {
+		s.otpAlertStates = make(map[string]string)
+	}
+	states := make(map[string]string)
+	alerts := &otpAlerts{}
+	siteOTPExpiryMetric.Reset()
+	siteCertExpiryMetric.Reset()
+	for i := range sites.Items {
+		site := &sites.Items[i]
+		uuid := site.Spec.UUID
+
+		if c := site.Status.Certificate; c != nil {
+			siteCertExpiryMetric.WithLabelValues(uuid).Set(float64(c.Expiry.Unix()))
+		}
+
+		if site.Status.BootstrapState != crdsv1.SiteAwaitHandshake {
+			continue
+		}
+		exp, err := parseExpiry(&site.Status.OTP)
+		if err != nil {
+			continue
+		}
+		siteOTPExpiryMetric.WithLabelValues(uuid).Set(float64(exp.Unix()))
+
+		switch {
+		case !now.Before(*exp):
+			alerts.expired = append(alerts.expired, uuid)
+			states[uuid] = otpStateExpired
+			if s.otpAlertStates[uuid] != otpStateExpired {
+				alerts.warned = append(alerts.warned, uuid)
+				log.Warnf("OTP of site %s expired at %s before the bootstrap handshake, roll the site", uuid, exp.String())
+			}
+		case exp.Sub(now) <= s.otpAlertWindow:
+			alerts.expiring = append(alerts.expiring, uuid)
+			states[uuid] = otpStateExpiring
+			if s.otpAlertStates[uuid] != otpStateExpiring {
+				alerts.warned = append(alerts.warned, uuid)
+				log.Warnf("OTP of site %s expires at %s, bootstrap handshake pending", uuid, exp.String())
+			}
+		}
+	}
+	// sites that were rolled or completed the handshake are dropped, so
+	// they are warned about again if their new OTP expires
+	s.otpAlertStates = states
+
+	sitesOTPExpiringMetric.Set(float64(len(alerts.expiring)))
+	sitesOTPExpiredMetric.Set(float64(len(alerts.expired)))
+	return alerts, nil
+}
@@ -0,0 +1,143 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sitemgr
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	crdsv1 "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/crds/v1"
+	"github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/types"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type statusHandler struct {
+	manager *SiteMgr
+}
+
+// ServeHTTP implements the site status method
+func (h *statusHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := h.manager.log
+	vars := mux.Vars(r)
+	uuid := vars["uuid"]
+	if uuid == "" {
+		http.Error(w, "UUID is required", http.StatusBadRequest)
+		return
+	}
+	objName := nameFromUUID(uuid)
+	siteObj, err := h.manager.crdClient.ForgeV1().Sites(h.manager.namespace).Get(r.Context(), objName, metav1.GetOptions{})
+	if err != nil {
+		log.Errorf("Get site status %s %v", objName, err)
+		if k8serr.IsNotFound(err) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	h.manager.writeJSONResp(w, siteStatusResponse(uuid, &siteObj.Status))
+}
+
+// siteStatusResponse converts the CRD status of a site to its API form
+func siteStatusResponse(uuid string, st *crdsv1.SiteStatus) *types.SiteStatusResponse {
+	resp := &types.SiteStatusResponse{
+		SiteUUID:           uuid,
+		BootstrapState:     st.BootstrapState,
+		ControlPlaneStatus: st.ControlPlaneStatus,
+		AgentVersion:       st.AgentVersion,
+	}
+	if exp, err := parseExpiry(&st.OTP); err == nil {
+		resp.OTPExpiry = exp
+	}
+	if f := st.LastCredsFetch; f != nil {
+		t := f.Time.UTC()
+		resp.LastCredsFetch = &t
+		resp.LastCredsFetchSourceIP = f.SourceIP
+		resp.LastCredsFetchMethod = f.Method
+	}
+	if c := st.Certificate; c != nil {
+		t := c.Expiry.UTC()
+		resp.CertSerial = c.Serial
+		resp.CertExpiry = &t
+	}
+	for _, roll := range st.Rolls {
+		resp.Rolls = append(resp.Rolls, types.SiteRoll{
+			Time:          roll.Time.UTC(),
+			PreviousState: roll.PreviousState,
+		})
+	}
+	return resp
+}
+
+// recordCredsFetch records a credentials fetch and the certificate it issued
+// in the site status
+func recordCredsFetch(st *crdsv1.SiteStatus, r *http.Request, method, agentVersion, certPEM string) {
+	st.LastCredsFetch = &crdsv1.CredsFetchInfo{
+		Time:     metav1.Now(),
+		SourceIP: sourceIP(r),
+		Method:   method,
+	}
+	if agentVersion != "" {
+		st.AgentVersion = agentVersion
+	}
+
+	block, _ := pem.Decode([]byte(certPEM))
+	if block == nil {
+		return
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return
+	}
+	st.Certificate = &crdsv1.CertInfo{
+		Serial: cert.SerialNumber.Text(16),
+		Expiry: metav1.NewTime(cert.NotAfter),
+	}
+}
+
+// recordRoll appends an OTP roll to the site status, keeping the most recent
+// MaxRollHistory rolls
+func recordRoll(st *crdsv1.SiteStatus, now time.Time) {
+	st.Rolls = append(st.Rolls, crdsv1.RollInfo{
+		Time:          metav1.NewTime(now),
+		PreviousState: st.BootstrapState,
+	})
+	if n := len(st.Rolls); n > crdsv1.MaxRollHistory {
+		st.Rolls = st.Rolls[n-crdsv1.MaxRollHistory:]
+	}
+}
+
+// sourceIP returns the client address of a request, honoring the header set
+// by the ingress
+func sourceIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		return strings.TrimSpace(strings.Split(fwd, ",")[0])
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,137 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sitemgr
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/nvidia/bare-metal-manager-rest/cert-manager/pkg/core"
+	fakecrdclient "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/client/clientset/versioned/fake"
+	crdsv1 "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/crds/v1"
+)
+
+func TestRecordCredsFetch(t *testing.T) {
+	pki := newTestPKI(t)
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	certPEM, _ := pki.issue(t, "site.client.carbide.local", 0x1f2e, expiry)
+
+	r := httptest.NewRequest("POST", "/v1/sitecreds", nil)
+	r.RemoteAddr = "10.1.2.3:41234"
+
+	st := &crdsv1.SiteStatus{}
+	recordCredsFetch(st, r, crdsv1.CredsFetchOTP, "1.2.3", certPEM)
+	require.NotNil(t, st.LastCredsFetch)
+	assert.Equal(t, "10.1.2.3", st.LastCredsFetch.SourceIP)
+	assert.Equal(t, crdsv1.CredsFetchOTP, st.LastCredsFetch.Method)
+	assert.Equal(t, "1.2.3", st.AgentVersion)
+	require.NotNil(t, st.Certificate)
+	assert.Equal(t, "1f2e", st.Certificate.Serial)
+	assert.True(t, expiry.Equal(st.Certificate.Expiry.Time))
+
+	// the ingress address is used when present, an older agent keeps the
+	// last reported version
+	r.Header.Set("X-Forwarded-For", "192.0.2.10, 10.0.0.1")
+	recordCredsFetch(st, r, crdsv1.CredsFetchRenew, "", certPEM)
+	assert.Equal(t, "192.0.2.10", st.LastCredsFetch.SourceIP)
+	assert.Equal(t, crdsv1.CredsFetchRenew, st.LastCredsFetch.Method)
+	assert.Equal(t, "1.2.3", st.AgentVersion)
+
+	resp := siteStatusResponse("site", st)
+	assert.Equal(t, "1f2e", resp.CertSerial)
+	assert.Equal(t, "192.0.2.10", resp.LastCredsFetchSourceIP)
+	require.NotNil(t, resp.CertExpiry)
+	assert.True(t, expiry.Equal(*resp.CertExpiry))
+}
+
+func TestRecordRoll(t *testing.T) {
+	st := &crdsv1.SiteStatus{BootstrapState: crdsv1.SiteRegistrationComplete}
+	start := time.Now()
+	for i := 0; i < crdsv1.MaxRollHistory+3; i++ {
+		recordRoll(st, start.Add(time.Duration(i)*time.Minute))
+		st.BootstrapState = crdsv1.SiteAwaitHandshake
+	}
+
+	require.Len(t, st.Rolls, crdsv1.MaxRollHistory)
+	// the oldest rolls are dropped
+	assert.True(t, st.Rolls[0].Time.Time.Equal(start.Add(3*time.Minute)))
+	assert.Equal(t, crdsv1.SiteAwaitHandshake, st.Rolls[0].PreviousState)
+
+	resp := siteStatusResponse("site", st)
+	assert.Len(t, resp.Rolls, crdsv1.MaxRollHistory)
+}
+
+func TestCheckOTPExpiry(t *testing.T) {
+	now := time.Now()
+	fcrd := fakecrdclient.NewSimpleClientset()
+	m := &SiteMgr{
+		Options:   Options{namespace: "csm", otpAlertWindow: time.Hour},
+		crdClient: fcrd,
+		log:       core.GetLogger(context.Background()),
+	}
+
+	sites := []struct {
+		uuid   string
+		state  string
+		expiry time.Time
+	}{
+		{"fresh", crdsv1.SiteAwaitHandshake, now.Add(10 * time.Hour)},
+		{"expiring", crdsv1.SiteAwaitHandshake, now.Add(30 * time.Minute)},
+		{"expired", crdsv1.SiteAwaitHandshake, now.Add(-time.Minute)},
+		{"registered", crdsv1.SiteRegistrationComplete, now.Add(-time.Hour)},
+	}
+	for _, s := range sites {
+		ts, err := s.expiry.MarshalText()
+		require.NoError(t, err)
+		_, err = fcrd.ForgeV1().Sites("csm").Create(context.Background(), &crdsv1.Site{
+			ObjectMeta: metav1.ObjectMeta{Name: nameFromUUID(s.uuid), Namespace: "csm"},
+			Spec:       crdsv1.SiteSpec{UUID: s.uuid},
+			Status: crdsv1.SiteStatus{
+				OTP:            crdsv1.OTPInfo{Passcode: fmt.Sprintf("otp-%s", s.uuid), Timestamp: string(ts)},
+				BootstrapState: s.state,
+			},
+		}, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+
+	alerts, err := m.checkOTPExpiry(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"expiring"}, alerts.expiring)
+	assert.Equal(t, []string{"expired"}, alerts.expired)
+	assert.ElementsMatch(t, []string{"expiring", "expired"}, alerts.warned)
+
+	// unchanged states are not warned about again
+	alerts, err = m.checkOTPExpiry(context.Background(), now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"expiring"}, alerts.expiring)
+	assert.Empty(t, alerts.warned)
+
+	// the expiring OTP expires
+	alerts, err = m.checkOTPExpiry(context.Background(), now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, alerts.expiring)
+	assert.ElementsMatch(t, []string{"expiring", "expired"}, alerts.expired)
+	assert.Equal(t, []string{"expiring"}, alerts.warned)
+}
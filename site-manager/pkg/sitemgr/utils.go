@@ -99,6 +99,36 @@ func GetSiteOTP(ctx context.Context, logger zerolog.Logger, uuid, url string) (*
 
 }
 
+// GetSiteStatus retrieves the bootstrap lifecycle status of a site from cloud-site-manager.
+func GetSiteStatus(ctx context.Context, logger zerolog.Logger, uuid, url string) (*csmtypes.SiteStatusResponse, error) {
+	getURL := fmt.Sprintf("%s/%s/status", url, uuid)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getURL, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to create request object for Site Manager")
+		return nil, err
+	}
+	resp, err := csmClient.Do(req)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Site status from Site Manager")
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrSiteNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting Site status from Site Manager, status: %v", resp.StatusCode)
+	}
+
+	status := &csmtypes.SiteStatusResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(status); err != nil {
+		logger.Error().Err(err).Msg("failed to unmarshal Site Manager status response")
+		return nil, err
+	}
+	return status, nil
+}
+
 // CreateSite creates a site in cloud-site-manager.
 // It accepts the minimal fields needed to create a site.
 func CreateSite(ctx context.Context, logger zerolog.Logger, siteUUID, name, provider, fcOrg, url string) error {
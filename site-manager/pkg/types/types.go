@@ -18,6 +18,11 @@
 // Package types defines api structure types
 package types
 
+import (
+	"fmt"
+	"time"
+)
+
 // SiteCreateRequest defines a site create request
 type SiteCreateRequest struct {
 	// SiteUUID is the uuid for the site
@@ -68,6 +73,8 @@ type SiteCredsRequest struct {
 	SiteUUID string `json:"siteuuid,omitempty"`
 	// OTP is the one time passcode
 	OTP string `json:"otp,omitempty"`
+	// AgentVersion is the version of the requesting site agent
+	AgentVersion string `json:"agentversion,omitempty"`
 }
 
 // SiteCredsResponse defines a site credentials response
@@ -79,3 +86,69 @@ type SiteCredsResponse struct {
 	// CACertificate is the CA cert for validating the server
 	CACertificate string `json:"cacertificate,omitempty"`
 }
+
+// SiteCredsRenewRequest defines a request to renew site credentials before
+// they expire. The site proves possession of its current certificate by
+// signing SigningPayload with the certificate key.
+type SiteCredsRenewRequest struct {
+	// SiteUUID is the uuid for the site
+	SiteUUID string `json:"siteuuid,omitempty"`
+	// Certificate is the current client certificate in PEM
+	Certificate string `json:"certificate,omitempty"`
+	// Timestamp is the unix time the request was signed at
+	Timestamp int64 `json:"timestamp,omitempty"`
+	// Signature is the signature over SigningPayload
+	Signature []byte `json:"signature,omitempty"`
+	// AgentVersion is the version of the requesting site agent
+	AgentVersion string `json:"agentversion,omitempty"`
+}
+
+// SigningPayload returns the bytes the site signs with its current key
+func (r *SiteCredsRenewRequest) SigningPayload() []byte {
+	return []byte(fmt.Sprintf("site-creds-renew:%s:%d", r.SiteUUID, r.Timestamp))
+}
+
+// SiteStatusResponse defines the bootstrap lifecycle status of a site
+type SiteStatusResponse struct {
+	// SiteUUID is the uuid for the site
+	SiteUUID string `json:"siteuuid,omitempty"`
+
+	// BootstrapState is the current bootstrap state of the site
+	BootstrapState string `json:"bootstrapstate,omitempty"`
+
+	// ControlPlaneStatus is the current status of the site control plane
+	ControlPlaneStatus string `json:"controlplanestatus,omitempty"`
+
+	// OTPExpiry is when the current one time passcode expires
+	OTPExpiry *time.Time `json:"otpexpiry,omitempty"`
+
+	// LastCredsFetch is when the site last fetched credentials
+	LastCredsFetch *time.Time `json:"lastcredsfetch,omitempty"`
+
+	// LastCredsFetchSourceIP is the source IP of the last credentials request
+	LastCredsFetchSourceIP string `json:"lastcredsfetchsourceip,omitempty"`
+
+	// LastCredsFetchMethod is how the credentials were last fetched, otp or renew
+	LastCredsFetchMethod string `json:"lastcredsfetchmethod,omitempty"`
+
+	// CertSerial is the serial number of the client certificate last issued to the site
+	CertSerial string `json:"certserial,omitempty"`
+
+	// CertExpiry is the expiry of the client certificate last issued to the site
+	CertExpiry *time.Time `json:"certexpiry,omitempty"`
+
+	// AgentVersion is the site agent version reported on the last credentials request
+	AgentVersion string `json:"agentversion,omitempty"`
+
+	// Rolls are the most recent OTP rolls, oldest first
+	Rolls []SiteRoll `json:"rolls,omitempty"`
+}
+
+// SiteRoll defines an OTP roll of a site
+type SiteRoll struct {
+	// Time is when the OTP was rolled
+	Time time.Time `json:"time"`
+
+	// PreviousState is the bootstrap state before the roll
+	PreviousState string `json:"previousstate,omitempty"`
+}
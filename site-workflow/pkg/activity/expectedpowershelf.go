@@ -28,6 +28,8 @@ import (
 	"go.temporal.io/sdk/client"
 	tClient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -299,6 +301,80 @@ func (meps *ManageExpectedPowerShelf) CreateExpectedPowerShelfOnSite(ctx context
 	return nil
 }
 
+// CreateExpectedPowerShelvesOnSite creates multiple Expected Power Shelves with Carbide in a single activity
+// Requests are validated upfront, and entries added by the activity are deleted again if a later one fails,
+// so that the Site is not left with a partial set. Entries that already exist with the same ID were added by
+// a previous attempt and are treated as created.
+func (meps *ManageExpectedPowerShelf) CreateExpectedPowerShelvesOnSite(ctx context.Context, request *cwssaws.ExpectedPowerShelfList) error {
+	logger := log.With().Str("Activity", "CreateExpectedPowerShelvesOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	var err error
+
+	// Validate request
+	if request == nil || len(request.GetExpectedPowerShelves()) == 0 {
+		err = errors.New("received empty batch create Expected Power Shelf request")
+	} else {
+		for i, item := range request.GetExpectedPowerShelves() {
+			if id := item.GetId(); id == nil || id.GetValue() == "" {
+				err = fmt.Errorf("received batch create Expected Power Shelf request without required id field at index %d", i)
+				break
+			}
+			if item.GetBmcMacAddress() == "" || item.GetShelfSerialNumber() == "" {
+				err = fmt.Errorf("received batch create Expected Power Shelf request with missing MAC or serial at index %d", i)
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller gRPC endpoint
+	carbideClient := meps.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return cclient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	// Site Controller does not provide a batch endpoint, so entries are added one by one
+	added := make([]*cwssaws.ExpectedPowerShelf, 0, len(request.GetExpectedPowerShelves()))
+	for _, item := range request.GetExpectedPowerShelves() {
+		_, err = forgeClient.AddExpectedPowerShelf(ctx, item)
+		if status.Code(err) == codes.AlreadyExists {
+			// IDs are generated by Cloud for each entry, so an existing entry with the same ID was added by this batch
+			existing, gerr := forgeClient.GetExpectedPowerShelf(ctx, &cwssaws.ExpectedPowerShelfRequest{Id: item.GetId()})
+			if gerr == nil && existing.GetId().GetValue() == item.GetId().GetValue() {
+				logger.Info().Str("ID", item.GetId().GetValue()).Msg("Expected Power Shelf was already created by a previous attempt")
+				err = nil
+			}
+		}
+		if err != nil {
+			logger.Warn().Err(err).Str("ID", item.GetId().GetValue()).Msg("Failed to create Expected Power Shelf using Site Controller API")
+			meps.deleteExpectedPowerShelvesOnSite(ctx, forgeClient, added)
+			return swe.WrapErr(err)
+		}
+		added = append(added, item)
+	}
+
+	logger.Info().Int("Count", len(request.GetExpectedPowerShelves())).Msg("Completed activity")
+
+	return nil
+}
+
+// deleteExpectedPowerShelvesOnSite removes Expected Power Shelves added by a batch create that failed part way through.
+// Failures are only logged, since the error that caused the batch to fail is returned to the caller.
+func (meps *ManageExpectedPowerShelf) deleteExpectedPowerShelvesOnSite(ctx context.Context, forgeClient cwssaws.ForgeClient, items []*cwssaws.ExpectedPowerShelf) {
+	for _, item := range items {
+		_, err := forgeClient.DeleteExpectedPowerShelf(ctx, &cwssaws.ExpectedPowerShelfRequest{Id: item.GetId(), BmcMacAddress: item.GetBmcMacAddress()})
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Error().Err(err).Str("ID", item.GetId().GetValue()).Msg("Failed to delete Expected Power Shelf added by failed batch create using Site Controller API")
+		}
+	}
+}
+
 // UpdateExpectedPowerShelfOnSite updates Expected Power Shelf on Carbide
 func (meps *ManageExpectedPowerShelf) UpdateExpectedPowerShelfOnSite(ctx context.Context, request *cwssaws.ExpectedPowerShelf) error {
 	logger := log.With().Str("Activity", "UpdateExpectedPowerShelfOnSite").Logger()
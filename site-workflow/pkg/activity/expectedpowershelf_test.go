@@ -252,6 +252,116 @@ func TestManageExpectedPowerShelf_CreateExpectedPowerShelfOnSite(t *testing.T) {
 	}
 }
 
+func TestManageExpectedPowerShelf_CreateExpectedPowerShelvesOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	newItem := func(id string, mac string, serial string) *cwssaws.ExpectedPowerShelf {
+		return &cwssaws.ExpectedPowerShelf{
+			Id:                &cwssaws.UUID{Value: id},
+			BmcMacAddress:     mac,
+			ShelfSerialNumber: serial,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		request        *cwssaws.ExpectedPowerShelfList
+		existingIDs    []string
+		wantErrorID    string
+		wantErr        bool
+		wantDeletedIDs []string
+	}{
+		{
+			name: "test create expected power shelves success",
+			request: &cwssaws.ExpectedPowerShelfList{
+				ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+					newItem("test-001", "00:11:22:33:44:55", "SHELF-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SHELF-002"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test create expected power shelves fail on missing serial number in any entry",
+			request: &cwssaws.ExpectedPowerShelfList{
+				ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+					newItem("test-001", "00:11:22:33:44:55", "SHELF-001"),
+					newItem("test-002", "00:11:22:33:44:56", ""),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test create expected power shelves fail on missing id in any entry",
+			request: &cwssaws.ExpectedPowerShelfList{
+				ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+					{BmcMacAddress: "00:11:22:33:44:55", ShelfSerialNumber: "SHELF-001"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test create expected power shelves succeeds when retried after a partial attempt",
+			request: &cwssaws.ExpectedPowerShelfList{
+				ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+					newItem("test-001", "00:11:22:33:44:55", "SHELF-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SHELF-002"),
+				},
+			},
+			existingIDs: []string{"test-001"},
+			wantErr:     false,
+		},
+		{
+			name: "test create expected power shelves fail deletes entries already added",
+			request: &cwssaws.ExpectedPowerShelfList{
+				ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+					newItem("test-001", "00:11:22:33:44:55", "SHELF-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SHELF-002"),
+					newItem("test-003", "00:11:22:33:44:57", "SHELF-003"),
+				},
+			},
+			existingIDs:    []string{"test-001"},
+			wantErrorID:    "test-003",
+			wantErr:        true,
+			wantDeletedIDs: []string{"test-001", "test-002"},
+		},
+		{
+			name:    "test create expected power shelves fail on empty list",
+			request: &cwssaws.ExpectedPowerShelfList{},
+			wantErr: true,
+		},
+		{
+			name:    "test create expected power shelves fail on missing request",
+			request: nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deletedIDs := []string{}
+			ctx := context.WithValue(context.Background(), "deletedIDs", &deletedIDs)
+			if tt.existingIDs != nil {
+				ctx = context.WithValue(ctx, "existingIDs", tt.existingIDs)
+			}
+			if tt.wantErrorID != "" {
+				ctx = context.WithValue(ctx, "wantErrorID", tt.wantErrorID)
+			}
+
+			mm := NewManageExpectedPowerShelf(carbideAtomicClient)
+			err := mm.CreateExpectedPowerShelvesOnSite(ctx, tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.wantDeletedIDs, deletedIDs)
+		})
+	}
+}
+
 func TestManageExpectedPowerShelf_UpdateExpectedPowerShelfOnSite(t *testing.T) {
 	mockCarbide := cClient.NewMockCarbideClient()
 
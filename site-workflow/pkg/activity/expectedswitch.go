@@ -28,6 +28,8 @@ import (
 	"go.temporal.io/sdk/client"
 	tClient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -299,6 +301,80 @@ func (mes *ManageExpectedSwitch) CreateExpectedSwitchOnSite(ctx context.Context,
 	return nil
 }
 
+// CreateExpectedSwitchesOnSite creates multiple Expected Switches with Carbide in a single activity
+// Requests are validated upfront, and entries added by the activity are deleted again if a later one fails,
+// so that the Site is not left with a partial set. Entries that already exist with the same ID were added by
+// a previous attempt and are treated as created.
+func (mes *ManageExpectedSwitch) CreateExpectedSwitchesOnSite(ctx context.Context, request *cwssaws.ExpectedSwitchList) error {
+	logger := log.With().Str("Activity", "CreateExpectedSwitchesOnSite").Logger()
+
+	logger.Info().Msg("Starting activity")
+
+	var err error
+
+	// Validate request
+	if request == nil || len(request.GetExpectedSwitches()) == 0 {
+		err = errors.New("received empty batch create Expected Switch request")
+	} else {
+		for i, item := range request.GetExpectedSwitches() {
+			if id := item.GetId(); id == nil || id.GetValue() == "" {
+				err = fmt.Errorf("received batch create Expected Switch request without required id field at index %d", i)
+				break
+			}
+			if item.GetBmcMacAddress() == "" || item.GetSwitchSerialNumber() == "" {
+				err = fmt.Errorf("received batch create Expected Switch request with missing MAC or serial at index %d", i)
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(err.Error(), swe.ErrTypeInvalidRequest, err)
+	}
+
+	// Call Site Controller gRPC endpoint
+	carbideClient := mes.CarbideAtomicClient.GetClient()
+	if carbideClient == nil {
+		return cclient.ErrClientNotConnected
+	}
+	forgeClient := carbideClient.Carbide()
+
+	// Site Controller does not provide a batch endpoint, so entries are added one by one
+	added := make([]*cwssaws.ExpectedSwitch, 0, len(request.GetExpectedSwitches()))
+	for _, item := range request.GetExpectedSwitches() {
+		_, err = forgeClient.AddExpectedSwitch(ctx, item)
+		if status.Code(err) == codes.AlreadyExists {
+			// IDs are generated by Cloud for each entry, so an existing entry with the same ID was added by this batch
+			existing, gerr := forgeClient.GetExpectedSwitch(ctx, &cwssaws.ExpectedSwitchRequest{Id: item.GetId()})
+			if gerr == nil && existing.GetId().GetValue() == item.GetId().GetValue() {
+				logger.Info().Str("ID", item.GetId().GetValue()).Msg("Expected Switch was already created by a previous attempt")
+				err = nil
+			}
+		}
+		if err != nil {
+			logger.Warn().Err(err).Str("ID", item.GetId().GetValue()).Msg("Failed to create Expected Switch using Site Controller API")
+			mes.deleteExpectedSwitchesOnSite(ctx, forgeClient, added)
+			return swe.WrapErr(err)
+		}
+		added = append(added, item)
+	}
+
+	logger.Info().Int("Count", len(request.GetExpectedSwitches())).Msg("Completed activity")
+
+	return nil
+}
+
+// deleteExpectedSwitchesOnSite removes Expected Switches added by a batch create that failed part way through.
+// Failures are only logged, since the error that caused the batch to fail is returned to the caller.
+func (mes *ManageExpectedSwitch) deleteExpectedSwitchesOnSite(ctx context.Context, forgeClient cwssaws.ForgeClient, items []*cwssaws.ExpectedSwitch) {
+	for _, item := range items {
+		_, err := forgeClient.DeleteExpectedSwitch(ctx, &cwssaws.ExpectedSwitchRequest{Id: item.GetId(), BmcMacAddress: item.GetBmcMacAddress()})
+		if err != nil && status.Code(err) != codes.NotFound {
+			log.Error().Err(err).Str("ID", item.GetId().GetValue()).Msg("Failed to delete Expected Switch added by failed batch create using Site Controller API")
+		}
+	}
+}
+
 // UpdateExpectedSwitchOnSite updates Expected Switch on Carbide
 func (mes *ManageExpectedSwitch) UpdateExpectedSwitchOnSite(ctx context.Context, request *cwssaws.ExpectedSwitch) error {
 	logger := log.With().Str("Activity", "UpdateExpectedSwitchOnSite").Logger()
@@ -252,6 +252,116 @@ func TestManageExpectedSwitch_CreateExpectedSwitchOnSite(t *testing.T) {
 	}
 }
 
+func TestManageExpectedSwitch_CreateExpectedSwitchesOnSite(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	newItem := func(id string, mac string, serial string) *cwssaws.ExpectedSwitch {
+		return &cwssaws.ExpectedSwitch{
+			Id:                 &cwssaws.UUID{Value: id},
+			BmcMacAddress:      mac,
+			SwitchSerialNumber: serial,
+		}
+	}
+
+	tests := []struct {
+		name           string
+		request        *cwssaws.ExpectedSwitchList
+		existingIDs    []string
+		wantErrorID    string
+		wantErr        bool
+		wantDeletedIDs []string
+	}{
+		{
+			name: "test create expected switches success",
+			request: &cwssaws.ExpectedSwitchList{
+				ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+					newItem("test-001", "00:11:22:33:44:55", "SWITCH-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SWITCH-002"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "test create expected switches fail on missing serial number in any entry",
+			request: &cwssaws.ExpectedSwitchList{
+				ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+					newItem("test-001", "00:11:22:33:44:55", "SWITCH-001"),
+					newItem("test-002", "00:11:22:33:44:56", ""),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test create expected switches fail on missing id in any entry",
+			request: &cwssaws.ExpectedSwitchList{
+				ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+					{BmcMacAddress: "00:11:22:33:44:55", SwitchSerialNumber: "SWITCH-001"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "test create expected switches succeeds when retried after a partial attempt",
+			request: &cwssaws.ExpectedSwitchList{
+				ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+					newItem("test-001", "00:11:22:33:44:55", "SWITCH-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SWITCH-002"),
+				},
+			},
+			existingIDs: []string{"test-001"},
+			wantErr:     false,
+		},
+		{
+			name: "test create expected switches fail deletes entries already added",
+			request: &cwssaws.ExpectedSwitchList{
+				ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+					newItem("test-001", "00:11:22:33:44:55", "SWITCH-001"),
+					newItem("test-002", "00:11:22:33:44:56", "SWITCH-002"),
+					newItem("test-003", "00:11:22:33:44:57", "SWITCH-003"),
+				},
+			},
+			existingIDs:    []string{"test-001"},
+			wantErrorID:    "test-003",
+			wantErr:        true,
+			wantDeletedIDs: []string{"test-001", "test-002"},
+		},
+		{
+			name:    "test create expected switches fail on empty list",
+			request: &cwssaws.ExpectedSwitchList{},
+			wantErr: true,
+		},
+		{
+			name:    "test create expected switches fail on missing request",
+			request: nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deletedIDs := []string{}
+			ctx := context.WithValue(context.Background(), "deletedIDs", &deletedIDs)
+			if tt.existingIDs != nil {
+				ctx = context.WithValue(ctx, "existingIDs", tt.existingIDs)
+			}
+			if tt.wantErrorID != "" {
+				ctx = context.WithValue(ctx, "wantErrorID", tt.wantErrorID)
+			}
+
+			mm := NewManageExpectedSwitch(carbideAtomicClient)
+			err := mm.CreateExpectedSwitchesOnSite(ctx, tt.request)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.ElementsMatch(t, tt.wantDeletedIDs, deletedIDs)
+		})
+	}
+}
+
 func TestManageExpectedSwitch_UpdateExpectedSwitchOnSite(t *testing.T) {
 	mockCarbide := cClient.NewMockCarbideClient()
 
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/rs/zerolog/log"
@@ -251,10 +252,28 @@ func (mmi *ManageInstanceInventory) DiscoverInstanceInventory(ctx context.Contex
 		internalFindByIDs:                 instanceFindByIDs,
 		internalPagedInventory:            instancePagedInventory,
 		internalPagedInventoryPostProcess: instancePagedInventoryPostProcess,
+		internalItemID:                    instanceItemID,
+		internalItemHashes:                instanceItemHashes,
 	}
 	return inventoryImpl.CollectAndPublishInventory(ctx, &logger)
 }
 
+// RequestFullInstanceInventory is an activity that makes the next Instance inventory publication a full snapshot
+func (mmi *ManageInstanceInventory) RequestFullInstanceInventory(ctx context.Context) error {
+	logger := log.With().Str("Activity", "RequestFullInstanceInventory").Logger()
+
+	if mmi.config.DeltaTracker == nil {
+		logger.Info().Msg("Delta publishing is disabled, every publication is a full snapshot")
+		return nil
+	}
+
+	mmi.config.DeltaTracker.RequestFullSnapshot()
+
+	logger.Info().Msg("Requested full snapshot for next inventory publication")
+
+	return nil
+}
+
 // NewManageInstanceInventory returns a ManageInventory implementation for Instance activity
 func NewManageInstanceInventory(config ManageInventoryConfig) ManageInstanceInventory {
 	return ManageInstanceInventory{
@@ -305,6 +324,50 @@ func instancePagedInventoryPostProcess(ctx context.Context, carbideClient *cClie
 	return inventory, nil
 }
 
+func instanceItemID(instance *cwssaws.Instance) string {
+	return instance.GetId().GetValue()
+}
+
+// instanceItemHashes hashes Instances together with their NSG propagation
+// status, so that a change in propagation is published as a delta as well
+func instanceItemHashes(ctx context.Context, carbideClient *cClient.CarbideClient, instances []*cwssaws.Instance) (map[string]string, error) {
+	hashes := make(map[string]string, len(instances))
+	if len(instances) == 0 {
+		return hashes, nil
+	}
+
+	instanceIds := make([]string, len(instances))
+	for i, instance := range instances {
+		instanceIds[i] = instance.GetId().GetValue()
+	}
+
+	propList, err := carbideClient.Carbide().GetNetworkSecurityGroupPropagationStatus(ctx, &cwssaws.GetNetworkSecurityGroupPropagationStatusRequest{
+		InstanceIds: instanceIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	propagations := map[string]*cwssaws.NetworkSecurityGroupPropagationObjectStatus{}
+	for _, prop := range propList.GetInstances() {
+		propagations[prop.GetId()] = prop
+	}
+
+	for _, instance := range instances {
+		id := instance.GetId().GetValue()
+		msgs := []proto.Message{instance}
+		if prop, ok := propagations[id]; ok {
+			msgs = append(msgs, prop)
+		}
+		hash, err := inventoryItemHash(msgs...)
+		if err != nil {
+			return nil, err
+		}
+		hashes[id] = hash
+	}
+	return hashes, nil
+}
+
 func instancePagedInventory(allItemIDs []*cwssaws.InstanceId, pagedItems []*cwssaws.Instance, input *pagedInventoryInput) *cwssaws.InstanceInventory {
 	itemIDs := []string{}
 	for _, id := range allItemIDs {
@@ -31,6 +31,7 @@ import (
 	tClient "go.temporal.io/sdk/client"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 type ManageInventoryConfig struct {
@@ -40,6 +41,8 @@ type ManageInventoryConfig struct {
 	TemporalPublishQueue  string
 	SitePageSize          int
 	CloudPageSize         int
+	// DeltaTracker enables delta publishing for inventory types that support it, nil publishes full inventory every time
+	DeltaTracker *InventoryDeltaTracker
 }
 
 type manageInventoryImpl[K any, R any, P any] struct {
@@ -53,6 +56,10 @@ type manageInventoryImpl[K any, R any, P any] struct {
 	internalPagedInventoryPostProcess func(context.Context, *cClient.CarbideClient, P) (P, error)
 	// fallback function to get all the items when pagination is not supported
 	internalFindFallback func(ctx context.Context, client *cClient.CarbideClient) ([]K, []R, error)
+	// function to get the ID of an item, required for delta publishing
+	internalItemID func(R) string
+	// optional function to hash items for delta publishing, items are hashed individually by default
+	internalItemHashes func(context.Context, *cClient.CarbideClient, []R) (map[string]string, error)
 }
 
 type pagedInventoryInput struct {
@@ -67,6 +74,11 @@ type pagedInventoryInput struct {
 	// status
 	status        cwssaws.InventoryStatus
 	statusMessage string
+	// delta publishing details
+	delta          bool
+	removedItemIDs []string
+	checksum       string
+	baseChecksum   string
 }
 
 func (pii *pagedInventoryInput) buildPage() *cwssaws.InventoryPage {
@@ -74,12 +86,20 @@ func (pii *pagedInventoryInput) buildPage() *cwssaws.InventoryPage {
 	if pii.status != cwssaws.InventoryStatus_INVENTORY_STATUS_SUCCESS {
 		return nil
 	}
-	return &cwssaws.InventoryPage{
-		TotalPages:  int32(pii.totalPages),
-		CurrentPage: int32(pii.pageNumber),
-		PageSize:    int32(pii.pageSize),
-		TotalItems:  int32(pii.totalItems),
+	page := &cwssaws.InventoryPage{
+		TotalPages:   int32(pii.totalPages),
+		CurrentPage:  int32(pii.pageNumber),
+		PageSize:     int32(pii.pageSize),
+		TotalItems:   int32(pii.totalItems),
+		Delta:        pii.delta,
+		Checksum:     pii.checksum,
+		BaseChecksum: pii.baseChecksum,
 	}
+	// removed items are processed once by Cloud, on the last page
+	if pii.delta && pii.pageNumber >= pii.totalPages {
+		page.RemovedItemIds = pii.removedItemIDs
+	}
+	return page
 }
 
 func buildPagedInventoryInput(totalCount int, pageSize int) *pagedInventoryInput {
@@ -133,6 +153,10 @@ func (impl *manageInventoryImpl[K, R, P]) CollectAndPublishInventory(ctx context
 		return err
 	}
 
+	if impl.config.DeltaTracker != nil && impl.internalItemID != nil {
+		return impl.collectAndPublishDelta(ctx, logger, carbideClient, allIDs, workflowName, workflowOptions)
+	}
+
 	// build paged inventory input with common values
 	pagedInput := buildPagedInventoryInput(len(allIDs), impl.config.CloudPageSize)
 	if pagedInput.totalItems == 0 {
@@ -202,6 +226,109 @@ func (impl *manageInventoryImpl[K, R, P]) CollectAndPublishInventory(ctx context
 	return nil
 }
 
+// collectAndPublishDelta collects the complete inventory and publishes only the items that changed since the
+// last successful publication, or all items when a full snapshot is due
+func (impl *manageInventoryImpl[K, R, P]) collectAndPublishDelta(ctx context.Context, logger *zerolog.Logger,
+	carbideClient *cClient.CarbideClient, allIDs []K, workflowName string, workflowOptions tClient.StartWorkflowOptions) error {
+	// A delta can only be computed against the complete inventory
+	allItems := []R{}
+	for sitePage, siteItemIDs := range cClient.SliceToChunks(allIDs, impl.config.SitePageSize) {
+		siteItems, err := impl.internalFindByIDs(ctx, carbideClient, siteItemIDs)
+		if err != nil {
+			logger.Warn().Err(err).Int("Site Page", sitePage+1).Msg("Failed to retrieve using Site Controller API")
+			return err
+		}
+		allItems = append(allItems, siteItems...)
+	}
+
+	hashes, err := impl.itemHashes(ctx, carbideClient, allItems)
+	if err != nil {
+		logger.Warn().Err(err).Msg("Failed to compute inventory item hashes")
+		return err
+	}
+
+	delta := impl.config.DeltaTracker.diff(hashes)
+
+	publishedIDs := allIDs
+	publishedItems := allItems
+	if !delta.full {
+		// Cloud only uses the complete list of item IDs to detect removed items in full snapshots
+		publishedIDs = []K{}
+		publishedItems = []R{}
+		for _, item := range allItems {
+			if delta.changed[impl.internalItemID(item)] {
+				publishedItems = append(publishedItems, item)
+			}
+		}
+	}
+
+	pagedInput := buildPagedInventoryInput(len(publishedItems), impl.config.CloudPageSize)
+	pagedInput.status = cwssaws.InventoryStatus_INVENTORY_STATUS_SUCCESS
+	pagedInput.statusMessage = "Successfully retrieved from Site Controller"
+	pagedInput.delta = !delta.full
+	pagedInput.removedItemIDs = delta.removed
+	pagedInput.checksum = delta.checksum
+	pagedInput.baseChecksum = delta.baseChecksum
+
+	// Always publish at least one page so Cloud can verify the checksum
+	cloudItems := cClient.SliceToChunks(publishedItems, impl.config.CloudPageSize)
+	if len(cloudItems) == 0 {
+		cloudItems = [][]R{{}}
+	}
+
+	logger.Info().Bool("Full", delta.full).Int("Changed", len(publishedItems)).Int("Removed", len(delta.removed)).
+		Msg("Publishing inventory delta to Cloud")
+
+	for page, items := range cloudItems {
+		workflowOptions := tClient.StartWorkflowOptions{
+			ID:        fmt.Sprintf("%v-%v", workflowOptions.ID, page+1),
+			TaskQueue: workflowOptions.TaskQueue,
+		}
+		pagedInput.pageNumber = page + 1
+		inventoryPage := impl.internalPagedInventory(publishedIDs, items, pagedInput)
+
+		// Handle any requested post processing
+		if impl.internalPagedInventoryPostProcess != nil && len(items) > 0 {
+			inventoryPage, err = impl.internalPagedInventoryPostProcess(ctx, carbideClient, inventoryPage)
+			if err != nil {
+				return err
+			}
+		}
+
+		logger.Info().Msgf("Publishing inventory page %d to Cloud", page+1)
+		if _, err = impl.config.TemporalPublishClient.ExecuteWorkflow(context.Background(), workflowOptions, workflowName, impl.config.SiteID, inventoryPage); err != nil {
+			logger.Error().Err(err).Int("Cloud Page", page+1).Msg("Failed to publish inventory to Cloud")
+			return err
+		}
+	}
+
+	// Only remember what was published once Cloud has received every page
+	impl.config.DeltaTracker.commit(delta)
+
+	return nil
+}
+
+// itemHashes returns the hash of each item keyed by item ID
+func (impl *manageInventoryImpl[K, R, P]) itemHashes(ctx context.Context, carbideClient *cClient.CarbideClient, items []R) (map[string]string, error) {
+	if impl.internalItemHashes != nil {
+		return impl.internalItemHashes(ctx, carbideClient, items)
+	}
+
+	hashes := make(map[string]string, len(items))
+	for _, item := range items {
+		msg, ok := any(item).(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("%s inventory item cannot be hashed", impl.itemType)
+		}
+		hash, err := inventoryItemHash(msg)
+		if err != nil {
+			return nil, err
+		}
+		hashes[impl.internalItemID(item)] = hash
+	}
+	return hashes, nil
+}
+
 func (impl *manageInventoryImpl[K, R, P]) collectAndPublishFallback(ctx context.Context, logger *zerolog.Logger,
 	carbideClient *cClient.CarbideClient, workflowName string, workflowOptions tClient.StartWorkflowOptions) error {
 	if impl.internalFindFallback == nil {
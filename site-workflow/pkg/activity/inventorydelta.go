@@ -0,0 +1,156 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package activity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// InventoryDeltaTracker remembers what was last published to Cloud for a single inventory type,
+// so that subsequent publications only need to carry the items that were added, changed or removed.
+// A full snapshot is published on the first run, every fullSnapshotInterval publications, and
+// whenever Cloud requests one because its state no longer matches the Site.
+type InventoryDeltaTracker struct {
+	mu sync.Mutex
+	// number of delta publications after which a full snapshot is published, <= 0 disables periodic snapshots
+	fullSnapshotInterval int
+	// hash of every item as of the last successful publication, keyed by item ID
+	hashes map[string]string
+	// checksum of the inventory as of the last successful publication
+	checksum string
+	// number of delta publications since the last full snapshot
+	sinceFull int
+	// set when the next publication must be a full snapshot
+	fullRequested bool
+}
+
+// NewInventoryDeltaTracker returns a tracker whose first publication will be a full snapshot
+func NewInventoryDeltaTracker(fullSnapshotInterval int) *InventoryDeltaTracker {
+	return &InventoryDeltaTracker{
+		fullSnapshotInterval: fullSnapshotInterval,
+		fullRequested:        true,
+	}
+}
+
+// RequestFullSnapshot forces the next publication to be a full snapshot
+func (t *InventoryDeltaTracker) RequestFullSnapshot() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.fullRequested = true
+}
+
+// inventoryDelta describes a single publication computed by the tracker
+type inventoryDelta struct {
+	// whether all items must be published
+	full bool
+	// IDs of items that must be published
+	changed map[string]bool
+	// IDs of items that were published previously but no longer exist, sorted
+	removed []string
+	// checksum of the inventory after this publication
+	checksum string
+	// checksum of the inventory the delta was computed against, empty for full snapshots
+	baseChecksum string
+	// item hashes to remember once the publication succeeds
+	hashes map[string]string
+}
+
+// diff compares the current item hashes against the last successful publication
+func (t *InventoryDeltaTracker) diff(hashes map[string]string) *inventoryDelta {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delta := &inventoryDelta{
+		changed:  map[string]bool{},
+		removed:  []string{},
+		checksum: inventoryChecksum(hashes),
+		hashes:   hashes,
+	}
+
+	delta.full = t.fullRequested || t.hashes == nil || (t.fullSnapshotInterval > 0 && t.sinceFull >= t.fullSnapshotInterval)
+	if delta.full {
+		for id := range hashes {
+			delta.changed[id] = true
+		}
+		return delta
+	}
+
+	delta.baseChecksum = t.checksum
+	for id, hash := range hashes {
+		if prevHash, ok := t.hashes[id]; !ok || prevHash != hash {
+			delta.changed[id] = true
+		}
+	}
+	for id := range t.hashes {
+		if _, ok := hashes[id]; !ok {
+			delta.removed = append(delta.removed, id)
+		}
+	}
+	sort.Strings(delta.removed)
+
+	return delta
+}
+
+// commit records a publication once all of its pages were published successfully
+func (t *InventoryDeltaTracker) commit(delta *inventoryDelta) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.hashes = delta.hashes
+	t.checksum = delta.checksum
+	if delta.full {
+		t.sinceFull = 0
+		t.fullRequested = false
+	} else {
+		t.sinceFull++
+	}
+}
+
+// inventoryItemHash returns a stable hash of an inventory item
+func inventoryItemHash(msgs ...proto.Message) (string, error) {
+	h := sha256.New()
+	for _, msg := range msgs {
+		b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return "", err
+		}
+		h.Write(b)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// inventoryChecksum returns a checksum of the complete inventory, independent of item order
+func inventoryChecksum(hashes map[string]string) string {
+	ids := make([]string, 0, len(hashes))
+	for id := range hashes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	h := sha256.New()
+	for _, id := range ids {
+		h.Write([]byte(id + ":" + hashes[id] + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,159 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package activity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	cClient "github.com/nvidia/bare-metal-manager-rest/site-workflow/pkg/grpc/client"
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+func TestInventoryDeltaTracker_diff(t *testing.T) {
+	tracker := NewInventoryDeltaTracker(2)
+
+	// First publication is always a full snapshot
+	delta := tracker.diff(map[string]string{"a": "1", "b": "2"})
+	assert.True(t, delta.full)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, delta.changed)
+	assert.Empty(t, delta.baseChecksum)
+	tracker.commit(delta)
+	first := delta.checksum
+
+	// Unchanged inventory produces an empty delta with the same checksum
+	delta = tracker.diff(map[string]string{"b": "2", "a": "1"})
+	assert.False(t, delta.full)
+	assert.Empty(t, delta.changed)
+	assert.Empty(t, delta.removed)
+	assert.Equal(t, first, delta.baseChecksum)
+	assert.Equal(t, first, delta.checksum)
+
+	// A publication that is not committed is computed again
+	delta = tracker.diff(map[string]string{"a": "3", "c": "4"})
+	assert.False(t, delta.full)
+	assert.Equal(t, map[string]bool{"a": true, "c": true}, delta.changed)
+	assert.Equal(t, []string{"b"}, delta.removed)
+	assert.Equal(t, first, delta.baseChecksum)
+	assert.NotEqual(t, first, delta.checksum)
+	tracker.commit(delta)
+	second := delta.checksum
+
+	delta = tracker.diff(map[string]string{"a": "3"})
+	assert.False(t, delta.full)
+	assert.Equal(t, []string{"c"}, delta.removed)
+	assert.Equal(t, second, delta.baseChecksum)
+	tracker.commit(delta)
+
+	// A full snapshot is published after fullSnapshotInterval deltas
+	delta = tracker.diff(map[string]string{"a": "3"})
+	assert.True(t, delta.full)
+	assert.Empty(t, delta.removed)
+	tracker.commit(delta)
+
+	// Cloud can request a full snapshot at any time
+	tracker.RequestFullSnapshot()
+	delta = tracker.diff(map[string]string{"a": "3"})
+	assert.True(t, delta.full)
+	tracker.commit(delta)
+
+	delta = tracker.diff(map[string]string{"a": "3"})
+	assert.False(t, delta.full)
+}
+
+func TestInventoryChecksum(t *testing.T) {
+	assert.Equal(t, inventoryChecksum(map[string]string{"a": "1", "b": "2"}), inventoryChecksum(map[string]string{"b": "2", "a": "1"}))
+	assert.NotEqual(t, inventoryChecksum(map[string]string{"a": "1", "b": "2"}), inventoryChecksum(map[string]string{"a": "1", "b": "3"}))
+	assert.NotEqual(t, inventoryChecksum(map[string]string{"a": "1"}), inventoryChecksum(map[string]string{}))
+}
+
+func TestManageSubnetInventory_DiscoverSubnetInventory_Delta(t *testing.T) {
+	mockCarbide := cClient.NewMockCarbideClient()
+
+	carbideAtomicClient := cClient.NewCarbideAtomicClient(&cClient.CarbideClientConfig{})
+	carbideAtomicClient.SwapClient(mockCarbide)
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+
+	tc := &tmocks.Client{}
+	tc.Mock.On("ExecuteWorkflow", mock.Anything, mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.AnythingOfType("string"), mock.AnythingOfType("uuid.UUID"), mock.Anything).Return(wrun, nil)
+
+	tracker := NewInventoryDeltaTracker(10)
+
+	manageSubnetInventory := NewManageSubnetInventory(ManageInventoryConfig{
+		SiteID:                uuid.New(),
+		CarbideAtomicClient:   carbideAtomicClient,
+		TemporalPublishClient: tc,
+		TemporalPublishQueue:  "test-queue",
+		SitePageSize:          100,
+		CloudPageSize:         25,
+		DeltaTracker:          tracker,
+	})
+
+	ctx := context.WithValue(context.Background(), "wantCount", 30)
+
+	// First publication is a full snapshot with all item IDs
+	err := manageSubnetInventory.DiscoverSubnetInventory(ctx)
+	assert.NoError(t, err)
+	tc.AssertNumberOfCalls(t, "ExecuteWorkflow", 2)
+
+	first := tc.Calls[0].Arguments[4].(*cwssaws.SubnetInventory)
+	last := tc.Calls[1].Arguments[4].(*cwssaws.SubnetInventory)
+	assert.False(t, first.InventoryPage.Delta)
+	assert.Equal(t, 30, len(first.InventoryPage.ItemIds))
+	assert.Equal(t, 25, len(first.Segments))
+	assert.Equal(t, 5, len(last.Segments))
+	assert.NotEmpty(t, last.InventoryPage.Checksum)
+	assert.Empty(t, last.InventoryPage.RemovedItemIds)
+	fullChecksum := last.InventoryPage.Checksum
+
+	// Mock Site Controller reports new Subnets on every call, so the delta replaces all of them
+	err = manageSubnetInventory.DiscoverSubnetInventory(ctx)
+	assert.NoError(t, err)
+	tc.AssertNumberOfCalls(t, "ExecuteWorkflow", 4)
+
+	first = tc.Calls[2].Arguments[4].(*cwssaws.SubnetInventory)
+	last = tc.Calls[3].Arguments[4].(*cwssaws.SubnetInventory)
+	assert.True(t, first.InventoryPage.Delta)
+	assert.Empty(t, first.InventoryPage.ItemIds)
+	assert.Empty(t, first.InventoryPage.RemovedItemIds)
+	assert.Equal(t, fullChecksum, first.InventoryPage.BaseChecksum)
+	assert.Equal(t, 30, len(last.InventoryPage.RemovedItemIds))
+	assert.Equal(t, fullChecksum, last.InventoryPage.BaseChecksum)
+	assert.NotEqual(t, fullChecksum, last.InventoryPage.Checksum)
+
+	// Full snapshot requested by Cloud
+	err = manageSubnetInventory.RequestFullSubnetInventory(ctx)
+	assert.NoError(t, err)
+
+	err = manageSubnetInventory.DiscoverSubnetInventory(ctx)
+	assert.NoError(t, err)
+	tc.AssertNumberOfCalls(t, "ExecuteWorkflow", 6)
+
+	first = tc.Calls[4].Arguments[4].(*cwssaws.SubnetInventory)
+	assert.False(t, first.InventoryPage.Delta)
+	assert.Equal(t, 30, len(first.InventoryPage.ItemIds))
+	assert.Empty(t, first.InventoryPage.BaseChecksum)
+}
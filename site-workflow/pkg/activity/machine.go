@@ -194,6 +194,7 @@ type ManageMachineInventory struct {
 	temporalPublishQueue  string
 	sitePageSize          int
 	cloudPageSize         int
+	deltaTracker          *InventoryDeltaTracker
 }
 
 // CollectAndPublishMachineInventory is an activity to collect Machine inventory and publish to Temporal queue
@@ -202,6 +203,26 @@ func (mmi *ManageMachineInventory) CollectAndPublishMachineInventory(ctx context
 
 	logger.Info().Msg("Starting activity")
 
+	if mmi.deltaTracker != nil {
+		inventoryImpl := manageInventoryImpl[*cwssaws.MachineId, *cwssaws.Machine, *cwssaws.MachineInventory]{
+			itemType: "Machine",
+			config: ManageInventoryConfig{
+				SiteID:                mmi.siteID,
+				CarbideAtomicClient:   mmi.carbideAtomicClient,
+				TemporalPublishClient: mmi.temporalPublishClient,
+				TemporalPublishQueue:  mmi.temporalPublishQueue,
+				SitePageSize:          mmi.sitePageSize,
+				CloudPageSize:         mmi.cloudPageSize,
+				DeltaTracker:          mmi.deltaTracker,
+			},
+			internalFindIDs:        machineFindIDs,
+			internalFindByIDs:      machineFindByIDs,
+			internalPagedInventory: machinePagedInventory,
+			internalItemID:         machineItemID,
+		}
+		return inventoryImpl.CollectAndPublishInventory(ctx, &logger)
+	}
+
 	// Define workflow options
 	workflowOptions := tClient.StartWorkflowOptions{
 		ID:        "update-machine-inventory-" + mmi.siteID.String(),
@@ -307,6 +328,22 @@ func (mmi *ManageMachineInventory) CollectAndPublishMachineInventory(ctx context
 	return nil
 }
 
+// RequestFullMachineInventory is an activity that makes the next Machine inventory publication a full snapshot
+func (mmi *ManageMachineInventory) RequestFullMachineInventory(ctx context.Context) error {
+	logger := log.With().Str("Activity", "RequestFullMachineInventory").Logger()
+
+	if mmi.deltaTracker == nil {
+		logger.Info().Msg("Delta publishing is disabled, every publication is a full snapshot")
+		return nil
+	}
+
+	mmi.deltaTracker.RequestFullSnapshot()
+
+	logger.Info().Msg("Requested full snapshot for next inventory publication")
+
+	return nil
+}
+
 // getPagedMachineIDs returns a slice of Machine IDs for a given page
 func getPagedMachineIDs(machineIDs []*cwssaws.MachineId, page int, pageSize int) []*cwssaws.MachineId {
 	totalCount := len(machineIDs)
@@ -358,8 +395,59 @@ func getPagedMachineInventory(pagedMachines []*cwssaws.Machine, machineIDs []*cw
 	return inventoryPage
 }
 
-// NewManageMachineInventory returns a new ManageMachineInventory activity
-func NewManageMachineInventory(siteID uuid.UUID, carbideAtomicClient *cClient.CarbideAtomicClient, temporalPublishClient tClient.Client, temporalPublishQueue string, sitePageSize int, cloudPageSize int) ManageMachineInventory {
+func machineFindIDs(ctx context.Context, carbideClient *cClient.CarbideClient) ([]*cwssaws.MachineId, error) {
+	machineIDList, err := carbideClient.Carbide().FindMachineIds(ctx, &cwssaws.MachineSearchConfig{})
+	if err != nil {
+		return nil, err
+	}
+	return machineIDList.GetMachineIds(), nil
+}
+
+func machineFindByIDs(ctx context.Context, carbideClient *cClient.CarbideClient, ids []*cwssaws.MachineId) ([]*cwssaws.Machine, error) {
+	machineList, err := carbideClient.Carbide().FindMachinesByIds(ctx, &cwssaws.MachinesByIdsRequest{
+		MachineIds: ids,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return machineList.GetMachines(), nil
+}
+
+func machineItemID(machine *cwssaws.Machine) string {
+	return machine.GetId().GetId()
+}
+
+func machinePagedInventory(allItemIDs []*cwssaws.MachineId, pagedItems []*cwssaws.Machine, input *pagedInventoryInput) *cwssaws.MachineInventory {
+	itemIDs := []string{}
+	for _, id := range allItemIDs {
+		itemIDs = append(itemIDs, id.GetId())
+	}
+
+	pagedMachineInfo := []*cwssaws.MachineInfo{}
+	for _, machine := range pagedItems {
+		pagedMachineInfo = append(pagedMachineInfo, &cwssaws.MachineInfo{
+			Machine: machine,
+		})
+	}
+
+	// Create an inventory page with the subset of Machines
+	inventory := &cwssaws.MachineInventory{
+		Machines: pagedMachineInfo,
+		Timestamp: &timestamppb.Timestamp{
+			Seconds: time.Now().Unix(),
+		},
+		InventoryStatus: input.status,
+		StatusMsg:       input.statusMessage,
+		InventoryPage:   input.buildPage(),
+	}
+	if inventory.InventoryPage != nil {
+		inventory.InventoryPage.ItemIds = itemIDs
+	}
+	return inventory
+}
+
+// NewManageMachineInventory returns a new ManageMachineInventory activity, deltaTracker is optional and enables delta publishing
+func NewManageMachineInventory(siteID uuid.UUID, carbideAtomicClient *cClient.CarbideAtomicClient, temporalPublishClient tClient.Client, temporalPublishQueue string, sitePageSize int, cloudPageSize int, deltaTracker *InventoryDeltaTracker) ManageMachineInventory {
 	return ManageMachineInventory{
 		siteID:                siteID,
 		carbideAtomicClient:   carbideAtomicClient,
@@ -367,5 +455,6 @@ func NewManageMachineInventory(siteID uuid.UUID, carbideAtomicClient *cClient.Ca
 		temporalPublishQueue:  temporalPublishQueue,
 		sitePageSize:          sitePageSize,
 		cloudPageSize:         cloudPageSize,
+		deltaTracker:          deltaTracker,
 	}
 }
@@ -154,10 +154,27 @@ func (mmi *ManageSubnetInventory) DiscoverSubnetInventory(ctx context.Context) e
 		internalFindByIDs:      subnetFindByIDs,
 		internalPagedInventory: subnetPagedInventory,
 		internalFindFallback:   subnetFindFallback,
+		internalItemID:         subnetItemID,
 	}
 	return inventoryImpl.CollectAndPublishInventory(ctx, &logger)
 }
 
+// RequestFullSubnetInventory is an activity that makes the next Subnet inventory publication a full snapshot
+func (mmi *ManageSubnetInventory) RequestFullSubnetInventory(ctx context.Context) error {
+	logger := log.With().Str("Activity", "RequestFullSubnetInventory").Logger()
+
+	if mmi.config.DeltaTracker == nil {
+		logger.Info().Msg("Delta publishing is disabled, every publication is a full snapshot")
+		return nil
+	}
+
+	mmi.config.DeltaTracker.RequestFullSnapshot()
+
+	logger.Info().Msg("Requested full snapshot for next inventory publication")
+
+	return nil
+}
+
 // NewManageSubnetInventory returns a ManageInventory implementation for Subnet activity
 func NewManageSubnetInventory(config ManageInventoryConfig) ManageSubnetInventory {
 	return ManageSubnetInventory{
@@ -205,6 +222,10 @@ func subnetPagedInventory(allItemIDs []*cwssaws.NetworkSegmentId, pagedItems []*
 	return inventory
 }
 
+func subnetItemID(segment *cwssaws.NetworkSegment) string {
+	return segment.GetId().GetValue()
+}
+
 func subnetFindFallback(ctx context.Context, carbideClient *cClient.CarbideClient) ([]*cwssaws.NetworkSegmentId, []*cwssaws.NetworkSegment, error) {
 	items, err := carbideClient.Networks().GetNetworkSegmentDeprecated(ctx, nil)
 	if err != nil {
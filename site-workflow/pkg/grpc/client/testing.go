@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"slices"
 	"time"
 
 	"github.com/gogo/status"
@@ -1063,14 +1064,30 @@ func (c *MockForgeClient) AddExpectedPowerShelf(ctx context.Context, in *wflows.
 	if in.ShelfSerialNumber == "" {
 		return nil, status.Error(codes.Internal, "Shelf Serial Number not provided for AddExpectedPowerShelf")
 	}
+	if existingIDs, ok := ctx.Value("existingIDs").([]string); ok && slices.Contains(existingIDs, in.Id.Value) {
+		return nil, status.Error(codes.AlreadyExists, "Expected PowerShelf already exists")
+	}
+	if wantErrorID, ok := ctx.Value("wantErrorID").(string); ok && wantErrorID == in.Id.Value {
+		return nil, status.Error(codes.Internal, "failed to add Expected PowerShelf")
+	}
 	out := new(emptypb.Empty)
 	return out, nil
 }
 
+func (c *MockForgeClient) GetExpectedPowerShelf(ctx context.Context, in *wflows.ExpectedPowerShelfRequest, opts ...grpc.CallOption) (*wflows.ExpectedPowerShelf, error) {
+	if existingIDs, ok := ctx.Value("existingIDs").([]string); ok && slices.Contains(existingIDs, in.GetId().GetValue()) {
+		return &wflows.ExpectedPowerShelf{Id: in.GetId()}, nil
+	}
+	return nil, status.Error(codes.NotFound, "Expected PowerShelf not found")
+}
+
 func (c *MockForgeClient) DeleteExpectedPowerShelf(ctx context.Context, in *wflows.ExpectedPowerShelfRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if in.Id == nil || in.Id.Value == "" {
 		return nil, status.Error(codes.Internal, "ID not provided for DeleteExpectedPowerShelf")
 	}
+	if deletedIDs, ok := ctx.Value("deletedIDs").(*[]string); ok {
+		*deletedIDs = append(*deletedIDs, in.Id.Value)
+	}
 	out := new(emptypb.Empty)
 	return out, nil
 }
@@ -1158,14 +1175,30 @@ func (c *MockForgeClient) AddExpectedSwitch(ctx context.Context, in *wflows.Expe
 	if in.SwitchSerialNumber == "" {
 		return nil, status.Error(codes.Internal, "Switch Serial Number not provided for AddExpectedSwitch")
 	}
+	if existingIDs, ok := ctx.Value("existingIDs").([]string); ok && slices.Contains(existingIDs, in.Id.Value) {
+		return nil, status.Error(codes.AlreadyExists, "Expected Switch already exists")
+	}
+	if wantErrorID, ok := ctx.Value("wantErrorID").(string); ok && wantErrorID == in.Id.Value {
+		return nil, status.Error(codes.Internal, "failed to add Expected Switch")
+	}
 	out := new(emptypb.Empty)
 	return out, nil
 }
 
+func (c *MockForgeClient) GetExpectedSwitch(ctx context.Context, in *wflows.ExpectedSwitchRequest, opts ...grpc.CallOption) (*wflows.ExpectedSwitch, error) {
+	if existingIDs, ok := ctx.Value("existingIDs").([]string); ok && slices.Contains(existingIDs, in.GetId().GetValue()) {
+		return &wflows.ExpectedSwitch{Id: in.GetId()}, nil
+	}
+	return nil, status.Error(codes.NotFound, "Expected Switch not found")
+}
+
 func (c *MockForgeClient) DeleteExpectedSwitch(ctx context.Context, in *wflows.ExpectedSwitchRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	if in.Id == nil || in.Id.Value == "" {
 		return nil, status.Error(codes.Internal, "ID not provided for DeleteExpectedSwitch")
 	}
+	if deletedIDs, ok := ctx.Value("deletedIDs").(*[]string); ok {
+		*deletedIDs = append(*deletedIDs, in.Id.Value)
+	}
 	out := new(emptypb.Empty)
 	return out, nil
 }
@@ -99,6 +99,42 @@ func CreateExpectedPowerShelf(ctx workflow.Context, request *cwssaws.ExpectedPow
 	return nil
 }
 
+// CreateExpectedPowerShelves is a workflow to create multiple Expected Power Shelves using the CreateExpectedPowerShelvesOnSite activity
+func CreateExpectedPowerShelves(ctx workflow.Context, request *cwssaws.ExpectedPowerShelfList) error {
+	logger := log.With().Str("Workflow", "ExpectedPowerShelves").Str("Action", "Create").Int("Count", len(request.GetExpectedPowerShelves())).Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		// Longer timeout for batch operations since they process multiple entries
+		StartToCloseTimeout: 5 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var expectedPowerShelfManager activity.ManageExpectedPowerShelf
+
+	err := workflow.ExecuteActivity(ctx, expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite, request).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "CreateExpectedPowerShelvesOnSite").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
 // UpdateExpectedPowerShelf is a workflow to update an Expected Power Shelf using the UpdateExpectedPowerShelfOnSite activity
 func UpdateExpectedPowerShelf(ctx workflow.Context, request *cwssaws.ExpectedPowerShelf) error {
 	logger := log.With().Str("Workflow", "ExpectedPowerShelf").Str("Action", "Update").Str("ID", request.GetId().GetValue()).Str("Expected MAC address", request.BmcMacAddress).Str("Serial", request.ShelfSerialNumber).Logger()
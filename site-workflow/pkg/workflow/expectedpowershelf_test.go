@@ -138,6 +138,70 @@ func TestCreateExpectedPowerShelfTestSuite(t *testing.T) {
 	suite.Run(t, new(CreateExpectedPowerShelfTestSuite))
 }
 
+type CreateExpectedPowerShelvesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (cepssts *CreateExpectedPowerShelvesTestSuite) SetupTest() {
+	cepssts.env = cepssts.NewTestWorkflowEnvironment()
+}
+
+func (cepssts *CreateExpectedPowerShelvesTestSuite) AfterTest(suiteName, testName string) {
+	cepssts.env.AssertExpectations(cepssts.T())
+}
+
+func (cepssts *CreateExpectedPowerShelvesTestSuite) newRequest() *cwssaws.ExpectedPowerShelfList {
+	return &cwssaws.ExpectedPowerShelfList{
+		ExpectedPowerShelves: []*cwssaws.ExpectedPowerShelf{
+			{
+				Id:                &cwssaws.UUID{Value: "test-create-workflow-001"},
+				BmcMacAddress:     "00:11:22:33:44:55",
+				ShelfSerialNumber: "SHELF-001",
+			},
+			{
+				Id:                &cwssaws.UUID{Value: "test-create-workflow-002"},
+				BmcMacAddress:     "00:11:22:33:44:56",
+				ShelfSerialNumber: "SHELF-002",
+			},
+		},
+	}
+}
+
+func (cepssts *CreateExpectedPowerShelvesTestSuite) Test_CreateExpectedPowerShelves_Success() {
+	var expectedPowerShelfManager iActivity.ManageExpectedPowerShelf
+
+	// Mock CreateExpectedPowerShelvesOnSite activity
+	cepssts.env.RegisterActivity(expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite)
+	cepssts.env.OnActivity(expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite, mock.Anything, mock.Anything).Return(nil)
+
+	// Execute CreateExpectedPowerShelves workflow
+	cepssts.env.ExecuteWorkflow(CreateExpectedPowerShelves, cepssts.newRequest())
+	cepssts.True(cepssts.env.IsWorkflowCompleted())
+	cepssts.NoError(cepssts.env.GetWorkflowError())
+}
+
+func (cepssts *CreateExpectedPowerShelvesTestSuite) Test_CreateExpectedPowerShelves_Failure() {
+	var expectedPowerShelfManager iActivity.ManageExpectedPowerShelf
+
+	errMsg := "Site Controller communication error"
+
+	// Mock CreateExpectedPowerShelvesOnSite activity
+	cepssts.env.RegisterActivity(expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite)
+	cepssts.env.OnActivity(expectedPowerShelfManager.CreateExpectedPowerShelvesOnSite, mock.Anything, mock.Anything).Return(errors.New(errMsg))
+
+	// Execute CreateExpectedPowerShelves workflow
+	cepssts.env.ExecuteWorkflow(CreateExpectedPowerShelves, cepssts.newRequest())
+	cepssts.True(cepssts.env.IsWorkflowCompleted())
+	cepssts.Error(cepssts.env.GetWorkflowError())
+}
+
+func TestCreateExpectedPowerShelvesTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateExpectedPowerShelvesTestSuite))
+}
+
 type UpdateExpectedPowerShelfTestSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
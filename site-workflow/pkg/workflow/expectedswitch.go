@@ -99,6 +99,42 @@ func CreateExpectedSwitch(ctx workflow.Context, request *cwssaws.ExpectedSwitch)
 	return nil
 }
 
+// CreateExpectedSwitches is a workflow to create multiple Expected Switches using the CreateExpectedSwitchesOnSite activity
+func CreateExpectedSwitches(ctx workflow.Context, request *cwssaws.ExpectedSwitchList) error {
+	logger := log.With().Str("Workflow", "ExpectedSwitches").Str("Action", "Create").Int("Count", len(request.GetExpectedSwitches())).Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    1 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    10 * time.Second,
+		MaximumAttempts:    2,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		// Longer timeout for batch operations since they process multiple entries
+		StartToCloseTimeout: 5 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var expectedSwitchManager activity.ManageExpectedSwitch
+
+	err := workflow.ExecuteActivity(ctx, expectedSwitchManager.CreateExpectedSwitchesOnSite, request).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "CreateExpectedSwitchesOnSite").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
 // UpdateExpectedSwitch is a workflow to update an Expected Switch using the UpdateExpectedSwitchOnSite activity
 func UpdateExpectedSwitch(ctx workflow.Context, request *cwssaws.ExpectedSwitch) error {
 	logger := log.With().Str("Workflow", "ExpectedSwitch").Str("Action", "Update").Str("ID", request.GetId().GetValue()).Str("Expected MAC address", request.BmcMacAddress).Str("Serial", request.SwitchSerialNumber).Logger()
@@ -138,6 +138,70 @@ func TestCreateExpectedSwitchTestSuite(t *testing.T) {
 	suite.Run(t, new(CreateExpectedSwitchTestSuite))
 }
 
+type CreateExpectedSwitchesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (cessts *CreateExpectedSwitchesTestSuite) SetupTest() {
+	cessts.env = cessts.NewTestWorkflowEnvironment()
+}
+
+func (cessts *CreateExpectedSwitchesTestSuite) AfterTest(suiteName, testName string) {
+	cessts.env.AssertExpectations(cessts.T())
+}
+
+func (cessts *CreateExpectedSwitchesTestSuite) newRequest() *cwssaws.ExpectedSwitchList {
+	return &cwssaws.ExpectedSwitchList{
+		ExpectedSwitches: []*cwssaws.ExpectedSwitch{
+			{
+				Id:                 &cwssaws.UUID{Value: "test-create-workflow-001"},
+				BmcMacAddress:      "00:11:22:33:44:55",
+				SwitchSerialNumber: "SWITCH-001",
+			},
+			{
+				Id:                 &cwssaws.UUID{Value: "test-create-workflow-002"},
+				BmcMacAddress:      "00:11:22:33:44:56",
+				SwitchSerialNumber: "SWITCH-002",
+			},
+		},
+	}
+}
+
+func (cessts *CreateExpectedSwitchesTestSuite) Test_CreateExpectedSwitches_Success() {
+	var expectedSwitchManager iActivity.ManageExpectedSwitch
+
+	// Mock CreateExpectedSwitchesOnSite activity
+	cessts.env.RegisterActivity(expectedSwitchManager.CreateExpectedSwitchesOnSite)
+	cessts.env.OnActivity(expectedSwitchManager.CreateExpectedSwitchesOnSite, mock.Anything, mock.Anything).Return(nil)
+
+	// Execute CreateExpectedSwitches workflow
+	cessts.env.ExecuteWorkflow(CreateExpectedSwitches, cessts.newRequest())
+	cessts.True(cessts.env.IsWorkflowCompleted())
+	cessts.NoError(cessts.env.GetWorkflowError())
+}
+
+func (cessts *CreateExpectedSwitchesTestSuite) Test_CreateExpectedSwitches_Failure() {
+	var expectedSwitchManager iActivity.ManageExpectedSwitch
+
+	errMsg := "Site Controller communication error"
+
+	// Mock CreateExpectedSwitchesOnSite activity
+	cessts.env.RegisterActivity(expectedSwitchManager.CreateExpectedSwitchesOnSite)
+	cessts.env.OnActivity(expectedSwitchManager.CreateExpectedSwitchesOnSite, mock.Anything, mock.Anything).Return(errors.New(errMsg))
+
+	// Execute CreateExpectedSwitches workflow
+	cessts.env.ExecuteWorkflow(CreateExpectedSwitches, cessts.newRequest())
+	cessts.True(cessts.env.IsWorkflowCompleted())
+	cessts.Error(cessts.env.GetWorkflowError())
+}
+
+func TestCreateExpectedSwitchesTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateExpectedSwitchesTestSuite))
+}
+
 type UpdateExpectedSwitchTestSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
@@ -251,3 +251,32 @@ func DiscoverInstanceInventory(ctx workflow.Context) error {
 
 	return nil
 }
+
+// RequestFullInstanceInventory is a workflow triggered by Cloud to have the next Instance inventory publication be a full snapshot
+func RequestFullInstanceInventory(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "RequestFullInstanceInventory").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke activity
+	var inventoryManager activity.ManageInstanceInventory
+
+	err := workflow.ExecuteActivity(ctx, inventoryManager.RequestFullInstanceInventory).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "RequestFullInstanceInventory").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
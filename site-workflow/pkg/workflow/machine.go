@@ -173,3 +173,32 @@ func GetDpuMachines(ctx workflow.Context, dpuMachineIDs []string) ([]*cwssaws.Dp
 
 	return result, nil
 }
+
+// RequestFullMachineInventory is a workflow triggered by Cloud to have the next Machine inventory publication be a full snapshot
+func RequestFullMachineInventory(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "RequestFullMachineInventory").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke activity
+	var inventoryManager activity.ManageMachineInventory
+
+	err := workflow.ExecuteActivity(ctx, inventoryManager.RequestFullMachineInventory).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "RequestFullMachineInventory").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
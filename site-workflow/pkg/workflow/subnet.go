@@ -139,3 +139,32 @@ func DiscoverSubnetInventory(ctx workflow.Context) error {
 
 	return nil
 }
+
+// RequestFullSubnetInventory is a workflow triggered by Cloud to have the next Subnet inventory publication be a full snapshot
+func RequestFullSubnetInventory(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "RequestFullSubnetInventory").Logger()
+
+	logger.Info().Msg("Starting workflow")
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 2,
+		},
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Invoke activity
+	var inventoryManager activity.ManageSubnetInventory
+
+	err := workflow.ExecuteActivity(ctx, inventoryManager.RequestFullSubnetInventory).Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Activity", "RequestFullSubnetInventory").Msg("Failed to execute activity from workflow")
+		return err
+	}
+
+	logger.Info().Msg("Completing workflow")
+
+	return nil
+}
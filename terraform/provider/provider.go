@@ -0,0 +1,180 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package provider implements the Terraform provider for the Bare Metal Manager REST API on top of
+// the simple SDK.
+package provider
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+const (
+	defaultPollInterval = 10 * time.Second
+	defaultReadyTimeout = 30 * time.Minute
+)
+
+// providerData is handed to every resource by Configure.
+type providerData struct {
+	client       *simple.Client
+	pollInterval time.Duration
+	readyTimeout time.Duration
+}
+
+type carbideProvider struct {
+	version string
+}
+
+type carbideProviderModel struct {
+	BaseURL      types.String `tfsdk:"base_url"`
+	Org          types.String `tfsdk:"org"`
+	Token        types.String `tfsdk:"token"`
+	SiteID       types.String `tfsdk:"site_id"`
+	PollInterval types.String `tfsdk:"poll_interval"`
+	ReadyTimeout types.String `tfsdk:"ready_timeout"`
+}
+
+var _ provider.Provider = (*carbideProvider)(nil)
+
+// New returns a function that creates the provider, as expected by providerserver.
+func New(version string) func() provider.Provider {
+	return func() provider.Provider {
+		return &carbideProvider{version: version}
+	}
+}
+
+func (p *carbideProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "carbide"
+	resp.Version = p.version
+}
+
+func (p *carbideProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages resources of the NVIDIA Bare Metal Manager REST API. All resources are created on a single Site.",
+		Attributes: map[string]schema.Attribute{
+			"base_url": schema.StringAttribute{
+				Description: "Base URL of the REST API. Defaults to the CARBIDE_BASE_URL environment variable.",
+				Optional:    true,
+			},
+			"org": schema.StringAttribute{
+				Description: "Organization name. Defaults to the CARBIDE_ORG environment variable.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Bearer token. Defaults to the CARBIDE_TOKEN or CARBIDE_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"site_id": schema.StringAttribute{
+				Description: "ID of the Site resources are created on. Defaults to the CARBIDE_SITE_ID environment variable, then to the first Site of the organization.",
+				Optional:    true,
+			},
+			"poll_interval": schema.StringAttribute{
+				Description: "How often to poll a resource while waiting for it to become ready or deleted, e.g. \"10s\".",
+				Optional:    true,
+			},
+			"ready_timeout": schema.StringAttribute{
+				Description: "How long to wait for a resource to become ready or deleted, e.g. \"30m\".",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (p *carbideProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var config carbideProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	attrs := map[string]types.String{
+		"base_url": config.BaseURL,
+		"org":      config.Org,
+		"token":    config.Token,
+		"site_id":  config.SiteID,
+	}
+	for name, value := range attrs {
+		if value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unknown provider configuration",
+				"The provider cannot be configured with a value that is only known after apply.")
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := stringOrEnv(config.Token, "CARBIDE_TOKEN")
+	if token == "" {
+		token = os.Getenv("CARBIDE_API_KEY")
+	}
+	data := &providerData{pollInterval: defaultPollInterval, readyTimeout: defaultReadyTimeout}
+	data.pollInterval = parseDuration(config.PollInterval, path.Root("poll_interval"), defaultPollInterval, &resp.Diagnostics)
+	data.readyTimeout = parseDuration(config.ReadyTimeout, path.Root("ready_timeout"), defaultReadyTimeout, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := simple.NewClient(simple.ClientConfig{
+		BaseURL: stringOrEnv(config.BaseURL, "CARBIDE_BASE_URL"),
+		Org:     stringOrEnv(config.Org, "CARBIDE_ORG"),
+		Token:   token,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid provider configuration", err.Error())
+		return
+	}
+	if siteID := stringOrEnv(config.SiteID, "CARBIDE_SITE_ID"); siteID != "" {
+		client.SetSiteID(siteID)
+	}
+	if err := client.Authenticate(ctx); err != nil {
+		resp.Diagnostics.AddError("Unable to authenticate with the REST API", err.Error())
+		return
+	}
+	data.client = client
+
+	resp.ResourceData = data
+	resp.DataSourceData = data
+}
+
+func (p *carbideProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		newVpcResource,
+		newVpcPrefixResource,
+		newSubnetResource,
+		newNetworkSecurityGroupResource,
+		newSshKeyGroupResource,
+		newOperatingSystemResource,
+		newInstanceResource,
+		newAllocationResource,
+	}
+}
+
+func (p *carbideProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return nil
+}
@@ -0,0 +1,328 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/nvidia/bare-metal-manager-rest/cli/mockserver"
+	carbidecli "github.com/nvidia/bare-metal-manager-rest/cli/pkg"
+	"github.com/nvidia/bare-metal-manager-rest/openapi"
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+const (
+	testOrg  = "test-org"
+	siteUUID = "72771e6a-6f5e-4de4-a5b9-1266c4197811"
+)
+
+// newTestServer starts the carbidecli mock server with a single Site. The mock's metadata
+// example predates the SDK's minimum API version, so the metadata endpoint is overridden.
+func newTestServer(t *testing.T, readyAfter time.Duration) string {
+	t.Helper()
+	spec, err := carbidecli.ParseSpec(openapi.Spec)
+	if err != nil {
+		t.Fatalf("ParseSpec: %v", err)
+	}
+	srv := mockserver.New(spec)
+	srv.ReadyAfter = readyAfter
+	srv.Seed("/v2/org/{org}/carbide/site", map[string]interface{}{"id": siteUUID, "name": "test-site", "status": "Registered"})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v2/org/"+testOrg+"/carbide/metadata", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version":"1.0.0"}`)
+	})
+	mux.Handle("/", srv)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts.URL
+}
+
+func newTestClient(t *testing.T, baseURL string) *simple.Client {
+	t.Helper()
+	client, err := simple.NewClient(simple.ClientConfig{BaseURL: baseURL, Org: testOrg, Token: "test-token"})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if err := client.Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate: %v", err)
+	}
+	return client
+}
+
+func testProviderFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"carbide": providerserver.NewProtocol6WithError(New("test")()),
+	}
+}
+
+func testProviderConfig(baseURL string) string {
+	return fmt.Sprintf(`
+provider "carbide" {
+  base_url      = %q
+  org           = %q
+  token         = "test-token"
+  poll_interval = "20ms"
+  ready_timeout = "10s"
+}
+`, baseURL, testOrg)
+}
+
+func TestProviderSchema(t *testing.T) {
+	server := providerserver.NewProtocol6(New("test")())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+	for _, name := range []string{
+		"carbide_vpc", "carbide_vpc_prefix", "carbide_subnet", "carbide_network_security_group",
+		"carbide_ssh_key_group", "carbide_operating_system", "carbide_instance", "carbide_allocation",
+	} {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("missing resource %s", name)
+		}
+	}
+}
+
+func TestWaitForStatus(t *testing.T) {
+	client := newTestClient(t, newTestServer(t, 100*time.Millisecond))
+	ctx := context.Background()
+	vpc, apiErr := client.CreateVpc(ctx, simple.VpcCreateRequest{Name: "wait"})
+	if apiErr != nil {
+		t.Fatalf("CreateVpc: %v", apiErr)
+	}
+	if vpc.Status != "Pending" {
+		t.Fatalf("expected a Pending VPC, got %s", vpc.Status)
+	}
+	get := func(ctx context.Context, id string) (string, *simple.ApiError) {
+		vpc, apiErr := client.GetVpc(ctx, id)
+		if apiErr != nil {
+			return "", apiErr
+		}
+		return vpc.Status, nil
+	}
+
+	impatient := &providerData{client: client, pollInterval: 10 * time.Millisecond, readyTimeout: 30 * time.Millisecond}
+	if err := waitForStatus(ctx, impatient, "VPC", vpc.ID, "Ready", get); err == nil {
+		t.Fatal("expected a timeout before the VPC became Ready")
+	}
+
+	data := &providerData{client: client, pollInterval: 10 * time.Millisecond, readyTimeout: 5 * time.Second}
+	if err := waitForStatus(ctx, data, "VPC", vpc.ID, "Ready", get); err != nil {
+		t.Fatalf("waitForStatus: %v", err)
+	}
+	if apiErr := client.DeleteVpc(ctx, vpc.ID); apiErr != nil {
+		t.Fatalf("DeleteVpc: %v", apiErr)
+	}
+	if err := waitForDeletion(ctx, data, "VPC", vpc.ID, get); err != nil {
+		t.Fatalf("waitForDeletion: %v", err)
+	}
+}
+
+func TestAccVpcResource(t *testing.T) {
+	baseURL := newTestServer(t, 50*time.Millisecond)
+	config := func(name string) string {
+		return testProviderConfig(baseURL) + fmt.Sprintf(`
+resource "carbide_vpc" "test" {
+  name   = %q
+  labels = { env = "test" }
+}
+`, name)
+	}
+
+	var vpcID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("tf-vpc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("carbide_vpc.test", "name", "tf-vpc"),
+					resource.TestCheckResourceAttr("carbide_vpc.test", "status", "Ready"),
+					resource.TestCheckResourceAttr("carbide_vpc.test", "site_id", siteUUID),
+					resource.TestCheckResourceAttr("carbide_vpc.test", "labels.env", "test"),
+					func(s *terraform.State) error {
+						vpcID = s.RootModule().Resources["carbide_vpc.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("tf-vpc-renamed"),
+				Check:  resource.TestCheckResourceAttr("carbide_vpc.test", "name", "tf-vpc-renamed"),
+			},
+			{
+				ResourceName:      "carbide_vpc.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Deleting the VPC outside of Terraform is detected on refresh.
+				PreConfig: func() {
+					client := newTestClient(t, baseURL)
+					if apiErr := client.DeleteVpc(context.Background(), vpcID); apiErr != nil {
+						t.Fatalf("DeleteVpc: %v", apiErr)
+					}
+				},
+				Config:             config("tf-vpc-renamed"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTenantResources(t *testing.T) {
+	baseURL := newTestServer(t, 50*time.Millisecond)
+	config := testProviderConfig(baseURL) + `
+resource "carbide_vpc" "test" {
+  name = "tf-vpc"
+}
+
+resource "carbide_vpc_prefix" "test" {
+  name          = "tf-prefix"
+  vpc_id        = carbide_vpc.test.id
+  ip_block_id   = "0a1b2c3d-0000-4000-8000-000000000001"
+  prefix_length = 24
+}
+
+resource "carbide_subnet" "test" {
+  name          = "tf-subnet"
+  vpc_id        = carbide_vpc.test.id
+  ipv4_block_id = "0a1b2c3d-0000-4000-8000-000000000001"
+  prefix_length = 26
+}
+
+resource "carbide_network_security_group" "test" {
+  name = "tf-nsg"
+  rules = [{
+    name                   = "ssh"
+    direction              = "INGRESS"
+    protocol               = "TCP"
+    action                 = "PERMIT"
+    priority               = 100
+    source_prefix          = "0.0.0.0/0"
+    destination_prefix     = "0.0.0.0/0"
+    destination_port_range = "22"
+  }]
+}
+
+resource "carbide_ssh_key_group" "test" {
+  name = "tf-keys"
+}
+
+resource "carbide_operating_system" "test" {
+  name        = "tf-os"
+  ipxe_script = "#!ipxe\nchain http://boot.example.com/ubuntu.ipxe"
+}
+
+resource "carbide_instance" "test" {
+  name                      = "tf-instance"
+  vpc_id                    = carbide_vpc.test.id
+  instance_type_id          = "0a1b2c3d-0000-4000-8000-000000000002"
+  operating_system_id       = carbide_operating_system.test.id
+  network_security_group_id = carbide_network_security_group.test.id
+  ssh_key_group_ids         = [carbide_ssh_key_group.test.id]
+  interfaces = [{
+    subnet_id = carbide_subnet.test.id
+  }]
+}
+`
+	resources := []string{
+		"carbide_vpc.test", "carbide_vpc_prefix.test", "carbide_subnet.test", "carbide_network_security_group.test",
+		"carbide_ssh_key_group.test", "carbide_operating_system.test", "carbide_instance.test",
+	}
+	steps := []resource.TestStep{{
+		Config: config,
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("carbide_vpc.test", "status", "Ready"),
+			resource.TestCheckResourceAttr("carbide_subnet.test", "status", "Ready"),
+			resource.TestCheckResourceAttr("carbide_network_security_group.test", "status", "Ready"),
+			resource.TestCheckResourceAttr("carbide_network_security_group.test", "rules.0.priority", "100"),
+			resource.TestCheckResourceAttr("carbide_ssh_key_group.test", "status", "Synced"),
+			resource.TestCheckResourceAttr("carbide_operating_system.test", "status", "Ready"),
+			resource.TestCheckResourceAttr("carbide_instance.test", "status", "Ready"),
+			resource.TestCheckResourceAttrPair("carbide_instance.test", "interfaces.0.subnet_id", "carbide_subnet.test", "id"),
+		),
+	}}
+	for _, name := range resources {
+		steps = append(steps, resource.TestStep{
+			ResourceName:      name,
+			ImportState:       true,
+			ImportStateVerify: true,
+		})
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps:                    steps,
+	})
+}
+
+func TestAccAllocationResource(t *testing.T) {
+	baseURL := newTestServer(t, 50*time.Millisecond)
+	config := func(description string) string {
+		return testProviderConfig(baseURL) + fmt.Sprintf(`
+resource "carbide_allocation" "test" {
+  name        = "tf-allocation"
+  description = %q
+  tenant_id   = "0a1b2c3d-0000-4000-8000-000000000003"
+  constraints = [{
+    resource_type    = "InstanceType"
+    resource_type_id = "0a1b2c3d-0000-4000-8000-000000000002"
+    constraint_type  = "Reserved"
+    constraint_value = 4
+  }]
+}
+`, description)
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: config("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("carbide_allocation.test", "status", "Ready"),
+					resource.TestCheckResourceAttr("carbide_allocation.test", "constraints.0.constraint_value", "4"),
+				),
+			},
+			{
+				Config: config("second"),
+				Check:  resource.TestCheckResourceAttr("carbide_allocation.test", "description", "second"),
+			},
+			{
+				ResourceName:      "carbide_allocation.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,249 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*allocationResource)(nil)
+	_ resource.ResourceWithImportState = (*allocationResource)(nil)
+)
+
+type allocationResource struct {
+	data *providerData
+}
+
+type allocationConstraintModel struct {
+	ResourceType    types.String `tfsdk:"resource_type"`
+	ResourceTypeID  types.String `tfsdk:"resource_type_id"`
+	ConstraintType  types.String `tfsdk:"constraint_type"`
+	ConstraintValue types.Int64  `tfsdk:"constraint_value"`
+}
+
+type allocationResourceModel struct {
+	ID          types.String                `tfsdk:"id"`
+	Name        types.String                `tfsdk:"name"`
+	Description types.String                `tfsdk:"description"`
+	TenantID    types.String                `tfsdk:"tenant_id"`
+	Constraints []allocationConstraintModel `tfsdk:"constraints"`
+	SiteID      types.String                `tfsdk:"site_id"`
+	Status      types.String                `tfsdk:"status"`
+}
+
+func newAllocationResource() resource.Resource {
+	return &allocationResource{}
+}
+
+func (r *allocationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allocation"
+}
+
+func (r *allocationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An Allocation of the provider's Site resources to a Tenant. Only usable with provider credentials.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"constraints": schema.ListNestedAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							Description: "Type of the allocated resource, e.g. InstanceType or IPBlock.",
+							Required:    true,
+						},
+						"resource_type_id": schema.StringAttribute{
+							Required: true,
+						},
+						"constraint_type": schema.StringAttribute{
+							Description: "Reserved, OnDemand or Preemptible.",
+							Required:    true,
+						},
+						"constraint_value": schema.Int64Attribute{
+							Description: "Machine count for Instance Types, prefix length for IP Blocks.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *allocationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *allocationResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	allocation, apiErr := r.data.client.GetAllocation(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return allocation.Status, nil
+}
+
+func (m *allocationResourceModel) fromAllocation(allocation *simple.Allocation) {
+	m.ID = types.StringValue(allocation.ID)
+	m.Name = types.StringValue(allocation.Name)
+	m.Description = optionalString(allocation.Description)
+	m.TenantID = types.StringValue(allocation.TenantID)
+	m.Constraints = make([]allocationConstraintModel, 0, len(allocation.Constraints))
+	for _, c := range allocation.Constraints {
+		m.Constraints = append(m.Constraints, allocationConstraintModel{
+			ResourceType:    types.StringValue(c.ResourceType),
+			ResourceTypeID:  types.StringValue(c.ResourceTypeID),
+			ConstraintType:  types.StringValue(c.ConstraintType),
+			ConstraintValue: types.Int64Value(int64(c.ConstraintValue)),
+		})
+	}
+	m.SiteID = types.StringValue(allocation.SiteID)
+	m.Status = types.StringValue(allocation.Status)
+}
+
+func (r *allocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan allocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := simple.AllocationCreateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: stringPointer(plan.Description),
+		TenantID:    plan.TenantID.ValueString(),
+	}
+	for _, c := range plan.Constraints {
+		request.Constraints = append(request.Constraints, simple.AllocationConstraintCreateRequest{
+			ResourceType:    c.ResourceType.ValueString(),
+			ResourceTypeID:  c.ResourceTypeID.ValueString(),
+			ConstraintType:  c.ConstraintType.ValueString(),
+			ConstraintValue: int(c.ConstraintValue.ValueInt64()),
+		})
+	}
+
+	allocation, apiErr := r.data.client.CreateAllocation(ctx, request)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create Allocation", apiErr)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), allocation.ID)...)
+	if err := waitForStatus(ctx, r.data, "Allocation", allocation.ID, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Allocation did not become ready", err.Error())
+		return
+	}
+	allocation, apiErr = r.data.client.GetAllocation(ctx, allocation.ID)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Allocation", apiErr)
+		return
+	}
+	plan.fromAllocation(allocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *allocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state allocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocation, apiErr := r.data.client.GetAllocation(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Allocation", apiErr)
+		return
+	}
+	state.fromAllocation(allocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *allocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state allocationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allocation, apiErr := r.data.client.UpdateAllocation(ctx, state.ID.ValueString(), simple.AllocationUpdateRequest{
+		Name:        stringPointer(plan.Name),
+		Description: clearableString(plan.Description),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update Allocation", apiErr)
+		return
+	}
+	plan.fromAllocation(allocation)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *allocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state allocationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteAllocation(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete Allocation", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "Allocation", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Allocation was not deleted", err.Error())
+	}
+}
+
+func (r *allocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,317 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*instanceResource)(nil)
+	_ resource.ResourceWithImportState = (*instanceResource)(nil)
+)
+
+type instanceResource struct {
+	data *providerData
+}
+
+type instanceInterfaceModel struct {
+	SubnetID    types.String `tfsdk:"subnet_id"`
+	VpcPrefixID types.String `tfsdk:"vpc_prefix_id"`
+	IsPhysical  types.Bool   `tfsdk:"is_physical"`
+}
+
+type instanceResourceModel struct {
+	ID                     types.String             `tfsdk:"id"`
+	Name                   types.String             `tfsdk:"name"`
+	Description            types.String             `tfsdk:"description"`
+	VpcID                  types.String             `tfsdk:"vpc_id"`
+	InstanceTypeID         types.String             `tfsdk:"instance_type_id"`
+	MachineID              types.String             `tfsdk:"machine_id"`
+	OperatingSystemID      types.String             `tfsdk:"operating_system_id"`
+	IpxeScript             types.String             `tfsdk:"ipxe_script"`
+	UserData               types.String             `tfsdk:"user_data"`
+	NetworkSecurityGroupID types.String             `tfsdk:"network_security_group_id"`
+	SshKeyGroupIDs         types.Set                `tfsdk:"ssh_key_group_ids"`
+	Labels                 types.Map                `tfsdk:"labels"`
+	Interfaces             []instanceInterfaceModel `tfsdk:"interfaces"`
+	SiteID                 types.String             `tfsdk:"site_id"`
+	Status                 types.String             `tfsdk:"status"`
+}
+
+func newInstanceResource() resource.Resource {
+	return &instanceResource{}
+}
+
+func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance"
+}
+
+func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Attributes the API fills in when they are not configured keep their state value, so they
+	// don't show up as changes on every plan.
+	computedString := func(description string, replace bool) schema.StringAttribute {
+		modifiers := []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		if replace {
+			modifiers = append(modifiers, stringplanmodifier.RequiresReplace())
+		}
+		return schema.StringAttribute{
+			Description:   description,
+			Optional:      true,
+			Computed:      true,
+			PlanModifiers: modifiers,
+		}
+	}
+	resp.Schema = schema.Schema{
+		Description: "A bare metal Instance. Set either instance_type_id to allocate any Machine of that type, or machine_id for a specific Machine.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"instance_type_id": computedString("Instance Type to allocate a Machine from.", true),
+			"machine_id":       computedString("Machine to provision. Filled in with the allocated Machine when instance_type_id is used.", true),
+			"operating_system_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"ipxe_script": computedString("iPXE script, when not using an Operating System.", false),
+			"user_data":   computedString("cloud-init user data. Defaults to the Operating System's user data.", false),
+			"network_security_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"ssh_key_group_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"interfaces": schema.ListNestedAttribute{
+				Description:   "Ethernet interfaces. Each one is attached to either a Subnet or a VPC Prefix.",
+				Required:      true,
+				PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"subnet_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"vpc_prefix_id": schema.StringAttribute{
+							Optional: true,
+						},
+						"is_physical": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *instanceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *instanceResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	inst, apiErr := r.data.client.GetInstance(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return string(inst.GetStatus()), nil
+}
+
+func (m *instanceResourceModel) fromInstance(inst *standard.Instance) {
+	m.ID = types.StringValue(inst.GetId())
+	m.Name = types.StringValue(inst.GetName())
+	m.Description = optionalString(inst.Description)
+	m.VpcID = types.StringValue(inst.GetVpcId())
+	m.InstanceTypeID = optionalString(inst.InstanceTypeId)
+	m.MachineID = optionalString(inst.MachineId.Get())
+	m.OperatingSystemID = optionalString(inst.OperatingSystemId)
+	m.IpxeScript = optionalString(inst.IpxeScript.Get())
+	m.UserData = optionalString(inst.UserData.Get())
+	m.NetworkSecurityGroupID = optionalString(inst.NetworkSecurityGroupId.Get())
+	m.SshKeyGroupIDs = setValue(inst.SshKeyGroupIds)
+	m.Labels = mapValue(inst.Labels)
+	m.Interfaces = make([]instanceInterfaceModel, 0, len(inst.Interfaces))
+	for _, iface := range inst.Interfaces {
+		m.Interfaces = append(m.Interfaces, instanceInterfaceModel{
+			SubnetID:    optionalString(iface.SubnetId.Get()),
+			VpcPrefixID: optionalString(iface.VpcPrefixId.Get()),
+			IsPhysical:  types.BoolValue(iface.GetIsPhysical()),
+		})
+	}
+	m.SiteID = types.StringValue(inst.GetSiteId())
+	m.Status = types.StringValue(string(inst.GetStatus()))
+}
+
+func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan instanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	request := simple.InstanceCreateRequest{
+		Name:                   plan.Name.ValueString(),
+		Description:            stringPointer(plan.Description),
+		VpcID:                  stringPointer(plan.VpcID),
+		InstanceTypeID:         stringPointer(plan.InstanceTypeID),
+		MachineID:              plan.MachineID.ValueString(),
+		OperatingSystemID:      stringPointer(plan.OperatingSystemID),
+		IpxeScript:             plan.IpxeScript.ValueString(),
+		UserData:               stringPointer(plan.UserData),
+		NetworkSecurityGroupID: stringPointer(plan.NetworkSecurityGroupID),
+		SshKeyGroupIDs:         stringSlice(ctx, plan.SshKeyGroupIDs, &resp.Diagnostics),
+		Labels:                 stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	for _, iface := range plan.Interfaces {
+		request.Interfaces = append(request.Interfaces, simple.InterfaceCreateRequest{
+			SubnetID:    stringPointer(iface.SubnetID),
+			VpcPrefixID: stringPointer(iface.VpcPrefixID),
+			IsPhysical:  iface.IsPhysical.ValueBool(),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inst, apiErr := r.data.client.CreateInstance(ctx, request)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create Instance", apiErr)
+		return
+	}
+	id := inst.GetId()
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if err := waitForStatus(ctx, r.data, "Instance", id, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Instance did not become ready", err.Error())
+		return
+	}
+	inst, apiErr = r.data.client.GetInstance(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Instance", apiErr)
+		return
+	}
+	plan.fromInstance(inst)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state instanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inst, apiErr := r.data.client.GetInstance(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Instance", apiErr)
+		return
+	}
+	state.fromInstance(inst)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *instanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state instanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	request := simple.InstanceUpdateRequest{
+		Name:                   stringPointer(plan.Name),
+		Description:            clearableString(plan.Description),
+		OperatingSystemID:      stringPointer(plan.OperatingSystemID),
+		IpxeScript:             stringPointer(plan.IpxeScript),
+		UserData:               stringPointer(plan.UserData),
+		NetworkSecurityGroupID: clearableString(plan.NetworkSecurityGroupID),
+		SshKeyGroupIDs:         stringSlice(ctx, plan.SshKeyGroupIDs, &resp.Diagnostics),
+		Labels:                 stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if _, apiErr := r.data.client.UpdateInstance(ctx, id, request); apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update Instance", apiErr)
+		return
+	}
+	if err := waitForStatus(ctx, r.data, "Instance", id, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Instance did not become ready", err.Error())
+		return
+	}
+	inst, apiErr := r.data.client.GetInstance(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Instance", apiErr)
+		return
+	}
+	plan.fromInstance(inst)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state instanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteInstance(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete Instance", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "Instance", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Instance was not deleted", err.Error())
+	}
+}
+
+func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,312 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*networkSecurityGroupResource)(nil)
+	_ resource.ResourceWithImportState = (*networkSecurityGroupResource)(nil)
+)
+
+type networkSecurityGroupResource struct {
+	data *providerData
+}
+
+type networkSecurityGroupRuleModel struct {
+	Name                 types.String `tfsdk:"name"`
+	Direction            types.String `tfsdk:"direction"`
+	Protocol             types.String `tfsdk:"protocol"`
+	Action               types.String `tfsdk:"action"`
+	Priority             types.Int64  `tfsdk:"priority"`
+	SourcePrefix         types.String `tfsdk:"source_prefix"`
+	DestinationPrefix    types.String `tfsdk:"destination_prefix"`
+	SourcePortRange      types.String `tfsdk:"source_port_range"`
+	DestinationPortRange types.String `tfsdk:"destination_port_range"`
+}
+
+type networkSecurityGroupResourceModel struct {
+	ID             types.String                    `tfsdk:"id"`
+	Name           types.String                    `tfsdk:"name"`
+	Description    types.String                    `tfsdk:"description"`
+	StatefulEgress types.Bool                      `tfsdk:"stateful_egress"`
+	Rules          []networkSecurityGroupRuleModel `tfsdk:"rules"`
+	Labels         types.Map                       `tfsdk:"labels"`
+	SiteID         types.String                    `tfsdk:"site_id"`
+	Status         types.String                    `tfsdk:"status"`
+}
+
+func newNetworkSecurityGroupResource() resource.Resource {
+	return &networkSecurityGroupResource{}
+}
+
+func (r *networkSecurityGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_security_group"
+}
+
+func (r *networkSecurityGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A Network Security Group on the provider's Site. VPCs and Instances reference it by ID.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"stateful_egress": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "Rules in evaluation order.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"direction": schema.StringAttribute{
+							Description: "INGRESS or EGRESS.",
+							Required:    true,
+						},
+						"protocol": schema.StringAttribute{
+							Description: "TCP, UDP, ICMP or ANY.",
+							Required:    true,
+						},
+						"action": schema.StringAttribute{
+							Description: "PERMIT or DENY.",
+							Required:    true,
+						},
+						"priority": schema.Int64Attribute{
+							Optional: true,
+						},
+						"source_prefix": schema.StringAttribute{
+							Required: true,
+						},
+						"destination_prefix": schema.StringAttribute{
+							Required: true,
+						},
+						"source_port_range": schema.StringAttribute{
+							Optional: true,
+						},
+						"destination_port_range": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *networkSecurityGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *networkSecurityGroupResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	nsg, apiErr := r.data.client.GetNetworkSecurityGroup(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return nsg.Status, nil
+}
+
+func (m *networkSecurityGroupResourceModel) rules() []simple.NetworkSecurityGroupRule {
+	if m.Rules == nil {
+		return nil
+	}
+	rules := make([]simple.NetworkSecurityGroupRule, 0, len(m.Rules))
+	for _, rule := range m.Rules {
+		rules = append(rules, simple.NetworkSecurityGroupRule{
+			Name:                 stringPointer(rule.Name),
+			Direction:            rule.Direction.ValueString(),
+			Protocol:             rule.Protocol.ValueString(),
+			Action:               rule.Action.ValueString(),
+			Priority:             int64Pointer(rule.Priority),
+			SourcePrefix:         rule.SourcePrefix.ValueString(),
+			DestinationPrefix:    rule.DestinationPrefix.ValueString(),
+			SourcePortRange:      stringPointer(rule.SourcePortRange),
+			DestinationPortRange: stringPointer(rule.DestinationPortRange),
+		})
+	}
+	return rules
+}
+
+func (m *networkSecurityGroupResourceModel) fromNetworkSecurityGroup(nsg *simple.NetworkSecurityGroup) {
+	m.ID = types.StringValue(nsg.ID)
+	m.Name = types.StringValue(nsg.Name)
+	m.Description = optionalString(nsg.Description)
+	m.StatefulEgress = types.BoolValue(nsg.StatefulEgress)
+	m.Rules = nil
+	for _, rule := range nsg.Rules {
+		m.Rules = append(m.Rules, networkSecurityGroupRuleModel{
+			Name:                 optionalString(rule.Name),
+			Direction:            types.StringValue(rule.Direction),
+			Protocol:             types.StringValue(rule.Protocol),
+			Action:               types.StringValue(rule.Action),
+			Priority:             optionalInt64(rule.Priority),
+			SourcePrefix:         types.StringValue(rule.SourcePrefix),
+			DestinationPrefix:    types.StringValue(rule.DestinationPrefix),
+			SourcePortRange:      optionalString(rule.SourcePortRange),
+			DestinationPortRange: optionalString(rule.DestinationPortRange),
+		})
+	}
+	m.Labels = mapValue(nsg.Labels)
+	m.SiteID = types.StringValue(nsg.SiteID)
+	m.Status = types.StringValue(nsg.Status)
+}
+
+func (r *networkSecurityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan networkSecurityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	request := simple.NetworkSecurityGroupCreateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: stringPointer(plan.Description),
+		Rules:       plan.rules(),
+		Labels:      stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	if !plan.StatefulEgress.IsUnknown() && !plan.StatefulEgress.IsNull() {
+		request.StatefulEgress = plan.StatefulEgress.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsg, apiErr := r.data.client.CreateNetworkSecurityGroup(ctx, request)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create Network Security Group", apiErr)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), nsg.ID)...)
+	if err := waitForStatus(ctx, r.data, "Network Security Group", nsg.ID, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Network Security Group did not become ready", err.Error())
+		return
+	}
+	nsg, apiErr = r.data.client.GetNetworkSecurityGroup(ctx, nsg.ID)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Network Security Group", apiErr)
+		return
+	}
+	plan.fromNetworkSecurityGroup(nsg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *networkSecurityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state networkSecurityGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nsg, apiErr := r.data.client.GetNetworkSecurityGroup(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Network Security Group", apiErr)
+		return
+	}
+	state.fromNetworkSecurityGroup(nsg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *networkSecurityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state networkSecurityGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	request := simple.NetworkSecurityGroupUpdateRequest{
+		Name:        stringPointer(plan.Name),
+		Description: clearableString(plan.Description),
+		Rules:       plan.rules(),
+		Labels:      stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	if !plan.StatefulEgress.IsUnknown() && !plan.StatefulEgress.IsNull() {
+		request.StatefulEgress = plan.StatefulEgress.ValueBoolPointer()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if _, apiErr := r.data.client.UpdateNetworkSecurityGroup(ctx, id, request); apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update Network Security Group", apiErr)
+		return
+	}
+	if err := waitForStatus(ctx, r.data, "Network Security Group", id, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Network Security Group did not become ready", err.Error())
+		return
+	}
+	nsg, apiErr := r.data.client.GetNetworkSecurityGroup(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Network Security Group", apiErr)
+		return
+	}
+	plan.fromNetworkSecurityGroup(nsg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *networkSecurityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state networkSecurityGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteNetworkSecurityGroup(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete Network Security Group", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "Network Security Group", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Network Security Group was not deleted", err.Error())
+	}
+}
+
+func (r *networkSecurityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
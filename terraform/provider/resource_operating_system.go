@@ -0,0 +1,209 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*operatingSystemResource)(nil)
+	_ resource.ResourceWithImportState = (*operatingSystemResource)(nil)
+)
+
+type operatingSystemResource struct {
+	data *providerData
+}
+
+type operatingSystemResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	IpxeScript  types.String `tfsdk:"ipxe_script"`
+	UserData    types.String `tfsdk:"user_data"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func newOperatingSystemResource() resource.Resource {
+	return &operatingSystemResource{}
+}
+
+func (r *operatingSystemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operating_system"
+}
+
+func (r *operatingSystemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An iPXE based Operating System owned by the tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"ipxe_script": schema.StringAttribute{
+				Optional: true,
+			},
+			"user_data": schema.StringAttribute{
+				Optional: true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *operatingSystemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *operatingSystemResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	os, apiErr := r.data.client.GetOperatingSystem(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return os.Status, nil
+}
+
+func (m *operatingSystemResourceModel) fromOperatingSystem(os *simple.OperatingSystem) {
+	m.ID = types.StringValue(os.ID)
+	m.Name = types.StringValue(os.Name)
+	m.Description = optionalString(os.Description)
+	m.IpxeScript = optionalString(os.IpxeScript)
+	m.UserData = optionalString(os.UserData)
+	m.Status = types.StringValue(os.Status)
+}
+
+func (r *operatingSystemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan operatingSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	os, apiErr := r.data.client.CreateOperatingSystem(ctx, simple.OperatingSystemCreateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: stringPointer(plan.Description),
+		IpxeScript:  stringPointer(plan.IpxeScript),
+		UserData:    stringPointer(plan.UserData),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create Operating System", apiErr)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), os.ID)...)
+	if err := waitForStatus(ctx, r.data, "Operating System", os.ID, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Operating System did not become ready", err.Error())
+		return
+	}
+	os, apiErr = r.data.client.GetOperatingSystem(ctx, os.ID)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Operating System", apiErr)
+		return
+	}
+	plan.fromOperatingSystem(os)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *operatingSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state operatingSystemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	os, apiErr := r.data.client.GetOperatingSystem(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Operating System", apiErr)
+		return
+	}
+	state.fromOperatingSystem(os)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *operatingSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state operatingSystemResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	_, apiErr := r.data.client.UpdateOperatingSystem(ctx, id, simple.OperatingSystemUpdateRequest{
+		Name:        stringPointer(plan.Name),
+		Description: clearableString(plan.Description),
+		IpxeScript:  stringPointer(plan.IpxeScript),
+		UserData:    clearableString(plan.UserData),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update Operating System", apiErr)
+		return
+	}
+	if err := waitForStatus(ctx, r.data, "Operating System", id, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Operating System did not become ready", err.Error())
+		return
+	}
+	os, apiErr := r.data.client.GetOperatingSystem(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Operating System", apiErr)
+		return
+	}
+	plan.fromOperatingSystem(os)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *operatingSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state operatingSystemResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteOperatingSystem(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete Operating System", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "Operating System", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Operating System was not deleted", err.Error())
+	}
+}
+
+func (r *operatingSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,222 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+	"github.com/nvidia/bare-metal-manager-rest/sdk/standard"
+)
+
+// sshKeyGroupSynced is the status of an SSH Key Group whose keys have been pushed to its Sites.
+const sshKeyGroupSynced = "Synced"
+
+var (
+	_ resource.ResourceWithConfigure   = (*sshKeyGroupResource)(nil)
+	_ resource.ResourceWithImportState = (*sshKeyGroupResource)(nil)
+)
+
+type sshKeyGroupResource struct {
+	data *providerData
+}
+
+type sshKeyGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	SshKeyIDs   types.Set    `tfsdk:"ssh_key_ids"`
+	Version     types.String `tfsdk:"version"`
+	Status      types.String `tfsdk:"status"`
+}
+
+func newSshKeyGroupResource() resource.Resource {
+	return &sshKeyGroupResource{}
+}
+
+func (r *sshKeyGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ssh_key_group"
+}
+
+func (r *sshKeyGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "An SSH Key Group synced to the provider's Site.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"ssh_key_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the group, changed by every update.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *sshKeyGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *sshKeyGroupResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	skg, apiErr := r.data.client.GetSshKeyGroup(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return string(skg.GetStatus()), nil
+}
+
+func (m *sshKeyGroupResourceModel) fromSshKeyGroup(skg *standard.SshKeyGroup) {
+	m.ID = types.StringValue(skg.GetId())
+	m.Name = types.StringValue(skg.GetName())
+	m.Description = optionalString(skg.Description.Get())
+	ids := make([]string, 0, len(skg.SshKeys))
+	for _, sk := range skg.SshKeys {
+		if sk.Id != nil {
+			ids = append(ids, *sk.Id)
+		}
+	}
+	m.SshKeyIDs = setValue(ids)
+	m.Version = types.StringValue(skg.GetVersion())
+	m.Status = types.StringValue(string(skg.GetStatus()))
+}
+
+func (r *sshKeyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	request := simple.SshKeyGroupCreateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: stringPointer(plan.Description),
+		SshKeyIDs:   stringSlice(ctx, plan.SshKeyIDs, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skg, apiErr := r.data.client.CreateSshKeyGroup(ctx, request)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create SSH Key Group", apiErr)
+		return
+	}
+	id := skg.GetId()
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if err := waitForStatus(ctx, r.data, "SSH Key Group", id, sshKeyGroupSynced, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("SSH Key Group did not sync", err.Error())
+		return
+	}
+	skg, apiErr = r.data.client.GetSshKeyGroup(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read SSH Key Group", apiErr)
+		return
+	}
+	plan.fromSshKeyGroup(skg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	skg, apiErr := r.data.client.GetSshKeyGroup(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read SSH Key Group", apiErr)
+		return
+	}
+	state.fromSshKeyGroup(skg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *sshKeyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	request := simple.SshKeyGroupUpdateRequest{
+		Name:        stringPointer(plan.Name),
+		Description: clearableString(plan.Description),
+		SshKeyIDs:   stringSlice(ctx, plan.SshKeyIDs, &resp.Diagnostics),
+		Version:     state.Version.ValueString(),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if _, apiErr := r.data.client.UpdateSshKeyGroup(ctx, id, request); apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update SSH Key Group", apiErr)
+		return
+	}
+	if err := waitForStatus(ctx, r.data, "SSH Key Group", id, sshKeyGroupSynced, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("SSH Key Group did not sync", err.Error())
+		return
+	}
+	skg, apiErr := r.data.client.GetSshKeyGroup(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read SSH Key Group", apiErr)
+		return
+	}
+	plan.fromSshKeyGroup(skg)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *sshKeyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state sshKeyGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteSshKeyGroup(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete SSH Key Group", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "SSH Key Group", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("SSH Key Group was not deleted", err.Error())
+	}
+}
+
+func (r *sshKeyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,225 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*subnetResource)(nil)
+	_ resource.ResourceWithImportState = (*subnetResource)(nil)
+)
+
+type subnetResource struct {
+	data *providerData
+}
+
+type subnetResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	VpcID        types.String `tfsdk:"vpc_id"`
+	Ipv4BlockID  types.String `tfsdk:"ipv4_block_id"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	Ipv4Prefix   types.String `tfsdk:"ipv4_prefix"`
+	Ipv4Gateway  types.String `tfsdk:"ipv4_gateway"`
+	SiteID       types.String `tfsdk:"site_id"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func newSubnetResource() resource.Resource {
+	return &subnetResource{}
+}
+
+func (r *subnetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_subnet"
+}
+
+func (r *subnetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A Subnet of an Ethernet virtualized VPC.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"ipv4_block_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"ipv4_prefix": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"ipv4_gateway": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *subnetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *subnetResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	subnet, apiErr := r.data.client.GetSubnet(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return subnet.Status, nil
+}
+
+func (m *subnetResourceModel) fromSubnet(subnet *simple.Subnet) {
+	m.ID = types.StringValue(subnet.ID)
+	m.Name = types.StringValue(subnet.Name)
+	m.Description = optionalString(subnet.Description)
+	m.VpcID = types.StringValue(subnet.VpcID)
+	m.Ipv4BlockID = optionalString(subnet.Ipv4BlockID)
+	m.PrefixLength = types.Int64Value(int64(subnet.PrefixLength))
+	m.Ipv4Prefix = optionalString(subnet.Ipv4Prefix)
+	m.Ipv4Gateway = optionalString(subnet.Ipv4Gateway)
+	m.SiteID = types.StringValue(subnet.SiteID)
+	m.Status = types.StringValue(subnet.Status)
+}
+
+func (r *subnetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, apiErr := r.data.client.CreateSubnet(ctx, simple.SubnetCreateRequest{
+		Name:         plan.Name.ValueString(),
+		Description:  stringPointer(plan.Description),
+		VpcID:        plan.VpcID.ValueString(),
+		Ipv4BlockID:  stringPointer(plan.Ipv4BlockID),
+		PrefixLength: int(plan.PrefixLength.ValueInt64()),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create Subnet", apiErr)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), subnet.ID)...)
+	if err := waitForStatus(ctx, r.data, "Subnet", subnet.ID, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Subnet did not become ready", err.Error())
+		return
+	}
+	subnet, apiErr = r.data.client.GetSubnet(ctx, subnet.ID)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Subnet", apiErr)
+		return
+	}
+	plan.fromSubnet(subnet)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *subnetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state subnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, apiErr := r.data.client.GetSubnet(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read Subnet", apiErr)
+		return
+	}
+	state.fromSubnet(subnet)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *subnetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state subnetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subnet, apiErr := r.data.client.UpdateSubnet(ctx, state.ID.ValueString(), simple.SubnetUpdateRequest{
+		Name:        plan.Name.ValueString(),
+		Description: clearableString(plan.Description),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update Subnet", apiErr)
+		return
+	}
+	plan.fromSubnet(subnet)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *subnetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state subnetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteSubnet(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete Subnet", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "Subnet", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("Subnet was not deleted", err.Error())
+	}
+}
+
+func (r *subnetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
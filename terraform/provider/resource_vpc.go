@@ -0,0 +1,230 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*vpcResource)(nil)
+	_ resource.ResourceWithImportState = (*vpcResource)(nil)
+)
+
+type vpcResource struct {
+	data *providerData
+}
+
+type vpcResourceModel struct {
+	ID                        types.String `tfsdk:"id"`
+	Name                      types.String `tfsdk:"name"`
+	Description               types.String `tfsdk:"description"`
+	NetworkVirtualizationType types.String `tfsdk:"network_virtualization_type"`
+	NetworkSecurityGroupID    types.String `tfsdk:"network_security_group_id"`
+	Labels                    types.Map    `tfsdk:"labels"`
+	SiteID                    types.String `tfsdk:"site_id"`
+	Status                    types.String `tfsdk:"status"`
+}
+
+func newVpcResource() resource.Resource {
+	return &vpcResource{}
+}
+
+func (r *vpcResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc"
+}
+
+func (r *vpcResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A VPC on the provider's Site.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+			},
+			"network_virtualization_type": schema.StringAttribute{
+				Description: "ETHERNET_VIRTUALIZER or FNN. Chosen by the Site when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_security_group_id": schema.StringAttribute{
+				Optional: true,
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *vpcResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *vpcResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	vpc, apiErr := r.data.client.GetVpc(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return vpc.Status, nil
+}
+
+func (m *vpcResourceModel) fromVpc(vpc *simple.Vpc) {
+	m.ID = types.StringValue(vpc.ID)
+	m.Name = types.StringValue(vpc.Name)
+	m.Description = optionalString(vpc.Description)
+	m.NetworkVirtualizationType = optionalString(&vpc.NetworkVirtualizationType)
+	m.NetworkSecurityGroupID = optionalString(vpc.NetworkSecurityGroupID)
+	m.Labels = mapValue(vpc.Labels)
+	m.SiteID = types.StringValue(vpc.SiteID)
+	m.Status = types.StringValue(vpc.Status)
+}
+
+func (r *vpcResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	request := simple.VpcCreateRequest{
+		Name:                      plan.Name.ValueString(),
+		Description:               stringPointer(plan.Description),
+		NetworkVirtualizationType: plan.NetworkVirtualizationType.ValueString(),
+		NetworkSecurityGroupID:    stringPointer(plan.NetworkSecurityGroupID),
+		Labels:                    stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpc, apiErr := r.data.client.CreateVpc(ctx, request)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create VPC", apiErr)
+		return
+	}
+	// Save the ID first so a VPC that fails to become ready is tracked and can be destroyed.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vpc.ID)...)
+	if err := waitForStatus(ctx, r.data, "VPC", vpc.ID, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("VPC did not become ready", err.Error())
+		return
+	}
+	vpc, apiErr = r.data.client.GetVpc(ctx, vpc.ID)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read VPC", apiErr)
+		return
+	}
+	plan.fromVpc(vpc)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vpc, apiErr := r.data.client.GetVpc(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read VPC", apiErr)
+		return
+	}
+	state.fromVpc(vpc)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vpcResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	request := simple.VpcUpdateRequest{
+		Name:                   stringPointer(plan.Name),
+		Description:            clearableString(plan.Description),
+		NetworkSecurityGroupID: clearableString(plan.NetworkSecurityGroupID),
+		Labels:                 stringMap(ctx, plan.Labels, &resp.Diagnostics),
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if _, apiErr := r.data.client.UpdateVpc(ctx, id, request); apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update VPC", apiErr)
+		return
+	}
+	if err := waitForStatus(ctx, r.data, "VPC", id, "Ready", r.getStatus); err != nil {
+		resp.Diagnostics.AddError("VPC did not become ready", err.Error())
+		return
+	}
+	vpc, apiErr := r.data.client.GetVpc(ctx, id)
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read VPC", apiErr)
+		return
+	}
+	plan.fromVpc(vpc)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteVpc(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete VPC", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "VPC", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("VPC was not deleted", err.Error())
+	}
+}
+
+func (r *vpcResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,203 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+var (
+	_ resource.ResourceWithConfigure   = (*vpcPrefixResource)(nil)
+	_ resource.ResourceWithImportState = (*vpcPrefixResource)(nil)
+)
+
+type vpcPrefixResource struct {
+	data *providerData
+}
+
+type vpcPrefixResourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	VpcID        types.String `tfsdk:"vpc_id"`
+	IpBlockID    types.String `tfsdk:"ip_block_id"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+	Prefix       types.String `tfsdk:"prefix"`
+	SiteID       types.String `tfsdk:"site_id"`
+	Status       types.String `tfsdk:"status"`
+}
+
+func newVpcPrefixResource() resource.Resource {
+	return &vpcPrefixResource{}
+}
+
+func (r *vpcPrefixResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_prefix"
+}
+
+func (r *vpcPrefixResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "A VPC Prefix carved out of an IP Block for an FNN VPC.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"vpc_id": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"ip_block_id": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"prefix_length": schema.Int64Attribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+			},
+			"prefix": schema.StringAttribute{
+				Description:   "The allocated prefix in CIDR notation.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"site_id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *vpcPrefixResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.data = configureResource(req, resp)
+}
+
+func (r *vpcPrefixResource) getStatus(ctx context.Context, id string) (string, *simple.ApiError) {
+	vp, apiErr := r.data.client.GetVpcPrefix(ctx, id)
+	if apiErr != nil {
+		return "", apiErr
+	}
+	return vp.Status, nil
+}
+
+func (m *vpcPrefixResourceModel) fromVpcPrefix(vp *simple.VpcPrefix) {
+	m.ID = types.StringValue(vp.ID)
+	m.Name = types.StringValue(vp.Name)
+	m.VpcID = types.StringValue(vp.VpcID)
+	m.IpBlockID = optionalString(vp.IpBlockID)
+	m.PrefixLength = types.Int64Value(int64(vp.PrefixLength))
+	m.Prefix = optionalString(vp.Prefix)
+	m.SiteID = types.StringValue(vp.SiteID)
+	m.Status = types.StringValue(vp.Status)
+}
+
+// Create does not wait for a status: VPC Prefixes are allocated synchronously and are Ready
+// as soon as they are created.
+func (r *vpcPrefixResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vpcPrefixResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vp, apiErr := r.data.client.CreateVpcPrefix(ctx, simple.VpcPrefixCreateRequest{
+		Name:         plan.Name.ValueString(),
+		VpcID:        plan.VpcID.ValueString(),
+		IpBlockID:    stringPointer(plan.IpBlockID),
+		PrefixLength: int(plan.PrefixLength.ValueInt64()),
+	})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "create VPC Prefix", apiErr)
+		return
+	}
+	plan.fromVpcPrefix(vp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcPrefixResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vpcPrefixResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vp, apiErr := r.data.client.GetVpcPrefix(ctx, state.ID.ValueString())
+	if isNotFound(apiErr) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "read VPC Prefix", apiErr)
+		return
+	}
+	state.fromVpcPrefix(vp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *vpcPrefixResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vpcPrefixResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vp, apiErr := r.data.client.UpdateVpcPrefix(ctx, state.ID.ValueString(), simple.VpcPrefixUpdateRequest{Name: plan.Name.ValueString()})
+	if apiErr != nil {
+		addAPIError(&resp.Diagnostics, "update VPC Prefix", apiErr)
+		return
+	}
+	plan.fromVpcPrefix(vp)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *vpcPrefixResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vpcPrefixResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.ID.ValueString()
+	if apiErr := r.data.client.DeleteVpcPrefix(ctx, id); apiErr != nil && !isNotFound(apiErr) {
+		addAPIError(&resp.Diagnostics, "delete VPC Prefix", apiErr)
+		return
+	}
+	if err := waitForDeletion(ctx, r.data, "VPC Prefix", id, r.getStatus); err != nil {
+		resp.Diagnostics.AddError("VPC Prefix was not deleted", err.Error())
+	}
+}
+
+func (r *vpcPrefixResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,212 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/nvidia/bare-metal-manager-rest/sdk/simple"
+)
+
+const statusError = "Error"
+
+// configureResource extracts the provider data passed to a resource. It returns nil when the
+// provider has not been configured yet, which happens during validation.
+func configureResource(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *providerData {
+	if req.ProviderData == nil {
+		return nil
+	}
+	data, ok := req.ProviderData.(*providerData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data",
+			fmt.Sprintf("Expected *providerData, got %T. Please report this issue to the provider developers.", req.ProviderData))
+		return nil
+	}
+	return data
+}
+
+// addAPIError records a failed API call, including the validation details the API returns in data.
+func addAPIError(diags *diag.Diagnostics, action string, apiErr *simple.ApiError) {
+	detail := apiErr.Message
+	if len(apiErr.Data) > 0 {
+		detail = fmt.Sprintf("%s\n\n%v", detail, apiErr.Data)
+	}
+	diags.AddError(fmt.Sprintf("Unable to %s (HTTP %d)", action, apiErr.Code), detail)
+}
+
+func isNotFound(apiErr *simple.ApiError) bool {
+	return apiErr != nil && apiErr.Code == http.StatusNotFound
+}
+
+// waitForStatus polls get until the resource reports the target status. It fails when the
+// resource reports Error or the ready timeout passes.
+func waitForStatus(ctx context.Context, data *providerData, kind, id, target string, get func(context.Context, string) (string, *simple.ApiError)) error {
+	ctx, cancel := context.WithTimeout(ctx, data.readyTimeout)
+	defer cancel()
+	for {
+		status, apiErr := get(ctx, id)
+		if apiErr != nil {
+			return apiErr
+		}
+		switch status {
+		case target:
+			return nil
+		case statusError:
+			return fmt.Errorf("%s %s is in status %s", kind, id, status)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s %s to become %s, last status %q", kind, id, target, status)
+		case <-time.After(data.pollInterval):
+		}
+	}
+}
+
+// waitForDeletion polls get until the resource is gone.
+func waitForDeletion(ctx context.Context, data *providerData, kind, id string, get func(context.Context, string) (string, *simple.ApiError)) error {
+	ctx, cancel := context.WithTimeout(ctx, data.readyTimeout)
+	defer cancel()
+	for {
+		status, apiErr := get(ctx, id)
+		if isNotFound(apiErr) {
+			return nil
+		}
+		if apiErr != nil {
+			return apiErr
+		}
+		if status == statusError {
+			return fmt.Errorf("%s %s went into status %s while being deleted", kind, id, status)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s %s to be deleted, last status %q", kind, id, status)
+		case <-time.After(data.pollInterval):
+		}
+	}
+}
+
+func stringOrEnv(v types.String, env string) string {
+	if !v.IsNull() && v.ValueString() != "" {
+		return v.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+func parseDuration(v types.String, p path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if v.IsNull() || v.IsUnknown() {
+		return def
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(p, "Invalid duration", fmt.Sprintf("%q is not a positive duration such as \"30s\" or \"10m\".", v.ValueString()))
+		return def
+	}
+	return d
+}
+
+// stringPointer returns nil for a null or unknown value.
+func stringPointer(v types.String) *string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := v.ValueString()
+	return &s
+}
+
+// clearableString returns an empty string for a null value, so an update clears the field.
+func clearableString(v types.String) *string {
+	s := v.ValueString()
+	return &s
+}
+
+// optionalString maps a missing or empty API value to null, the value of an unset attribute.
+func optionalString(s *string) types.String {
+	if s == nil || *s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+func stringMap(ctx context.Context, v types.Map, diags *diag.Diagnostics) map[string]string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	m := make(map[string]string, len(v.Elements()))
+	diags.Append(v.ElementsAs(ctx, &m, false)...)
+	return m
+}
+
+// mapValue maps an empty API map to null.
+func mapValue(m map[string]string) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	elems := make(map[string]attr.Value, len(m))
+	for k, v := range m {
+		elems[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elems)
+}
+
+func stringSlice(ctx context.Context, v types.Set, diags *diag.Diagnostics) []string {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	s := make([]string, 0, len(v.Elements()))
+	diags.Append(v.ElementsAs(ctx, &s, false)...)
+	sort.Strings(s)
+	return s
+}
+
+// setValue maps an empty API list to null.
+func setValue(s []string) types.Set {
+	if len(s) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	elems := make([]attr.Value, 0, len(s))
+	for _, v := range s {
+		elems = append(elems, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func int64Pointer(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	i := int(v.ValueInt64())
+	return &i
+}
+
+func optionalInt64(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
@@ -383,8 +383,16 @@ type InventoryPage struct {
 	PageSize int32 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	// Total number of items
 	TotalItems int32 `protobuf:"varint,4,opt,name=total_items,json=totalItems,proto3" json:"total_items,omitempty"`
-	// IDs of all items
-	ItemIds       []string `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	// IDs of all items, empty for delta publications
+	ItemIds []string `protobuf:"bytes,5,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	// Whether the publication only carries items that changed since the previous one
+	Delta bool `protobuf:"varint,6,opt,name=delta,proto3" json:"delta,omitempty"`
+	// IDs of items removed since the previous publication, set on the last page of a delta
+	RemovedItemIds []string `protobuf:"bytes,7,rep,name=removed_item_ids,json=removedItemIds,proto3" json:"removed_item_ids,omitempty"`
+	// Checksum of the full inventory state after this publication
+	Checksum string `protobuf:"bytes,8,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Checksum of the full inventory state the delta was computed against
+	BaseChecksum  string `protobuf:"bytes,9,opt,name=base_checksum,json=baseChecksum,proto3" json:"base_checksum,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -454,6 +462,34 @@ func (x *InventoryPage) GetItemIds() []string {
 	return nil
 }
 
+func (x *InventoryPage) GetDelta() bool {
+	if x != nil {
+		return x.Delta
+	}
+	return false
+}
+
+func (x *InventoryPage) GetRemovedItemIds() []string {
+	if x != nil {
+		return x.RemovedItemIds
+	}
+	return nil
+}
+
+func (x *InventoryPage) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *InventoryPage) GetBaseChecksum() string {
+	if x != nil {
+		return x.BaseChecksum
+	}
+	return ""
+}
+
 var File_workflow_proto protoreflect.FileDescriptor
 
 const file_workflow_proto_rawDesc = "" +
@@ -470,7 +506,7 @@ const file_workflow_proto_rawDesc = "" +
 	"\rTransactionID\x12\x1f\n" +
 	"\vresource_id\x18\x01 \x01(\tR\n" +
 	"resourceId\x128\n" +
-	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xac\x01\n" +
+	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xad\x02\n" +
 	"\rInventoryPage\x12\x1f\n" +
 	"\vtotal_pages\x18\x01 \x01(\x05R\n" +
 	"totalPages\x12!\n" +
@@ -478,7 +514,11 @@ const file_workflow_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1f\n" +
 	"\vtotal_items\x18\x04 \x01(\x05R\n" +
 	"totalItems\x12\x19\n" +
-	"\bitem_ids\x18\x05 \x03(\tR\aitemIds*\xc7\x01\n" +
+	"\bitem_ids\x18\x05 \x03(\tR\aitemIds\x12\x14\n" +
+	"\x05delta\x18\x06 \x01(\bR\x05delta\x12(\n" +
+	"\x10removed_item_ids\x18\a \x03(\tR\x0eremovedItemIds\x12\x1a\n" +
+	"\bchecksum\x18\b \x01(\tR\bchecksum\x12#\n" +
+	"\rbase_checksum\x18\t \x01(\tR\fbaseChecksum*\xc7\x01\n" +
 	"\x0eWorkflowStatus\x12\x1f\n" +
 	"\x1bWORKFLOW_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
 	"\x17WORKFLOW_STATUS_CREATED\x10\x01\x12\x1f\n" +
@@ -71,6 +71,14 @@ import (
 	siteActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/site"
 	siteWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/site"
 
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
+
+	auditActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/audit"
+	auditWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/audit"
+
+	usageActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/usage"
+	usageWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/usage"
+
 	sshKeyGroupActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/sshkeygroup"
 	sshKeyGroupWorkflow "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/workflow/sshkeygroup"
 
@@ -267,12 +275,26 @@ func main() {
 		w.RegisterWorkflow(siteWorkflow.MonitorHealthForAllSites)
 		w.RegisterWorkflow(siteWorkflow.CheckHealthForAllSites)
 		w.RegisterWorkflow(siteWorkflow.MonitorTemporalCertExpirationForAllSites)
+		w.RegisterWorkflow(siteWorkflow.SyncBootstrapStatusForAllSites)
 		w.RegisterWorkflow(siteWorkflow.MonitorSiteTemporalNamespaces)
 
+		// Audit Entry workflows
+		w.RegisterWorkflow(auditWorkflow.DeleteExpiredAuditEntries)
+		w.RegisterWorkflow(auditWorkflow.ForwardAuditEntries)
+
+		// Tenant Usage workflows
+		w.RegisterWorkflow(usageWorkflow.RollupTenantUsage)
+
 		// SSHKeyGroup workflows
 		w.RegisterWorkflow(sshKeyGroupWorkflow.SyncSSHKeyGroup)
 		w.RegisterWorkflow(sshKeyGroupWorkflow.DeleteSSHKeyGroup)
 
+		// NetworkSecurityGroup workflows
+		w.RegisterWorkflow(networkSecurityGroupWorkflow.SyncNetworkSecurityGroup)
+
+		// OperatingSystem workflows
+		w.RegisterWorkflow(osImageWorkflow.VerifyOperatingSystemVersion)
+
 		// InfiniBandPartition workflows
 		w.RegisterWorkflow(ibpWorkflow.CreateInfiniBandPartition)
 		w.RegisterWorkflow(ibpWorkflow.DeleteInfiniBandPartition)
@@ -359,6 +381,9 @@ func main() {
 	siteManager := siteActivity.NewManageSite(dbSession, siteClientPool, tc, cfg)
 	w.RegisterActivity(&siteManager)
 
+	inventorySyncManager := inventoryActivity.NewManageInventorySync(dbSession, siteClientPool)
+	w.RegisterActivity(&inventorySyncManager)
+
 	sshKeyGroupManager := sshKeyGroupActivity.NewManageSSHKeyGroup(dbSession, siteClientPool)
 	w.RegisterActivity(&sshKeyGroupManager)
 
@@ -411,6 +436,14 @@ func main() {
 		// User activities
 		userManager := userActivity.NewManageUser(dbSession, cfg)
 		w.RegisterActivity(&userManager)
+
+		// Audit Entry activities
+		auditManager := auditActivity.NewManageAuditEntry(dbSession, cfg)
+		w.RegisterActivity(&auditManager)
+
+		// Tenant Usage activities
+		usageManager := usageActivity.NewManageTenantUsage(dbSession)
+		w.RegisterActivity(&usageManager)
 	}
 
 	// Serve health endpoint
@@ -487,6 +520,13 @@ func main() {
 		if err != nil {
 			log.Error().Err(err).Msg("failed to trigger Temporal Cert Expiration Monitor workflow")
 		}
+
+		// Trigger SyncBootstrapStatusForAllSites
+		_, err = siteWorkflow.ExecuteSyncBootstrapStatusForAllSites(ctx, tc)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to trigger Site Bootstrap Status Sync workflow")
+		}
+
 		// NOTE: This will stay disabled until Site Agent is ready
 		// _, err = siteWorkflow.ExecuteCheckHealthForAllSitesWorkflow(ctx, tc)
 		// if err != nil {
@@ -498,6 +538,24 @@ func main() {
 		if err != nil {
 			log.Error().Err(err).Msg("failed to trigger Monitor Site Temporal Namespaces workflow")
 		}
+
+		// Trigger DeleteExpiredAuditEntries
+		_, err = auditWorkflow.ExecuteDeleteExpiredAuditEntriesWorkflow(ctx, tc)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to trigger Delete Expired Audit Entries workflow")
+		}
+
+		// Trigger ForwardAuditEntries
+		_, err = auditWorkflow.ExecuteForwardAuditEntriesWorkflow(ctx, tc)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to trigger Forward Audit Entries workflow")
+		}
+
+		// Trigger RollupTenantUsage
+		_, err = usageWorkflow.ExecuteRollupTenantUsageWorkflow(ctx, tc)
+		if err != nil {
+			log.Error().Err(err).Msg("failed to trigger Rollup Tenant Usage workflow")
+		}
 	}
 	// NOTE: Log messages past this point do not show up in the log output
 }
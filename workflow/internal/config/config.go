@@ -117,6 +117,13 @@ const (
 	ConfigTracingEnabled = "tracing.enabled"
 	// ConfigTracingServiceName specifies the service name for tracing
 	ConfigTracingServiceName = "tracing.serviceName"
+
+	// ConfigAuditRetentionDays specifies how many days Audit Entries are kept, 0 (the default) keeps them indefinitely
+	ConfigAuditRetentionDays = "audit.retentionDays"
+	// ConfigAuditSyslogNetwork specifies the network used to reach the syslog collector, udp or tcp
+	ConfigAuditSyslogNetwork = "audit.syslog.network"
+	// ConfigAuditSyslogAddress specifies the host:port of the syslog collector Audit Entries are forwarded to
+	ConfigAuditSyslogAddress = "audit.syslog.address"
 )
 
 // Maintain a global config object
@@ -167,6 +174,9 @@ func NewConfig() *Config {
 
 	c.v.SetDefault(ConfigTracingEnabled, false)
 
+	c.v.SetDefault(ConfigAuditRetentionDays, 0)
+	c.v.SetDefault(ConfigAuditSyslogNetwork, "udp")
+
 	c.v.AutomaticEnv()
 	c.v.SetConfigFile(c.GetPathToConfig())
 
@@ -261,6 +271,14 @@ func (c *Config) Validate() {
 		log.Error().Msg("temporal encryption key config was not specified, arguments won't be encrypted")
 	}
 
+	if c.GetAuditRetentionDays() < 0 {
+		log.Panic().Msg("audit retention days config must not be negative")
+	}
+
+	if network := c.GetAuditSyslogNetwork(); network != "udp" && network != "tcp" {
+		log.Panic().Msg("audit syslog network config must be udp or tcp")
+	}
+
 	if c.GetNgcAPIBaseURL() == "" {
 		log.Warn().Msg("ngc api base url config not specified, NGC user lookups will be unavailable")
 	}
@@ -589,6 +607,36 @@ func (c *Config) GetTracingServiceName() string {
 	return c.v.GetString(ConfigTracingServiceName)
 }
 
+// GetAuditRetentionDays gets the number of days Audit Entries are kept
+func (c *Config) GetAuditRetentionDays() int {
+	return c.v.GetInt(ConfigAuditRetentionDays)
+}
+
+// SetAuditRetentionDays sets the number of days Audit Entries are kept
+func (c *Config) SetAuditRetentionDays(value int) {
+	c.v.Set(ConfigAuditRetentionDays, value)
+}
+
+// GetAuditSyslogNetwork gets the network used to reach the syslog collector
+func (c *Config) GetAuditSyslogNetwork() string {
+	return c.v.GetString(ConfigAuditSyslogNetwork)
+}
+
+// GetAuditSyslogAddress gets the address of the syslog collector
+func (c *Config) GetAuditSyslogAddress() string {
+	return c.v.GetString(ConfigAuditSyslogAddress)
+}
+
+// SetAuditSyslogAddress sets the address of the syslog collector
+func (c *Config) SetAuditSyslogAddress(value string) {
+	c.v.Set(ConfigAuditSyslogAddress, value)
+}
+
+// GetAuditSyslogEnabled returns if Audit Entries should be forwarded to a syslog collector
+func (c *Config) GetAuditSyslogEnabled() bool {
+	return c.GetAuditSyslogAddress() != ""
+}
+
 // WatchSecretFilePaths starts watching secret files for changes.
 func (c *Config) WatchSecretFilePaths() {
 	log.Info().Str("WatchSecretFilePaths", "").Msg("started watching secret file paths")
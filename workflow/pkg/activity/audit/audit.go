@@ -0,0 +1,195 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"log/syslog"
+	"time"
+
+	goset "github.com/deckarep/golang-set/v2"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/workflow/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
+)
+
+const (
+	// AuditEntryDeleteBatchSize is the number of expired Audit Entries removed per delete statement
+	AuditEntryDeleteBatchSize = 1000
+	// AuditEntryForwardPageSize is the number of Audit Entries read from DB at a time when forwarding
+	AuditEntryForwardPageSize = 100
+	// AuditSyslogTag is the syslog tag Audit Entries are forwarded with
+	AuditSyslogTag = "carbide-audit"
+)
+
+// SyslogWriter is the subset of syslog.Writer used to forward Audit Entries
+type SyslogWriter interface {
+	Info(m string) error
+	Close() error
+}
+
+// SyslogDialer connects to a syslog collector
+type SyslogDialer func(network, address string) (SyslogWriter, error)
+
+func dialSyslog(network, address string) (SyslogWriter, error) {
+	return syslog.Dial(network, address, syslog.LOG_INFO|syslog.LOG_AUTH, AuditSyslogTag)
+}
+
+// ManageAuditEntry is an activity wrapper for Audit Entry retention and export
+type ManageAuditEntry struct {
+	dbSession *cdb.Session
+	cfg       *config.Config
+	dial      SyslogDialer
+}
+
+// DeleteExpiredAuditEntries is a Temporal activity that deletes Audit Entries older than the configured retention period
+func (mae ManageAuditEntry) DeleteExpiredAuditEntries(ctx context.Context) (int, error) {
+	logger := log.With().Str("Activity", "DeleteExpiredAuditEntries").Logger()
+
+	logger.Info().Msg("starting activity")
+
+	retentionDays := mae.cfg.GetAuditRetentionDays()
+	if retentionDays <= 0 {
+		logger.Info().Msg("audit retention is not configured, skipping")
+		return 0, nil
+	}
+	cutoff := time.Now().UTC().AddDate(0, 0, -retentionDays)
+
+	aeDAO := cdbm.NewAuditEntryDAO(mae.dbSession)
+	total := 0
+	for {
+		deleted, err := aeDAO.DeleteOlderThan(ctx, nil, cutoff, AuditEntryDeleteBatchSize)
+		if err != nil {
+			logger.Error().Err(err).Int("Deleted", total).Msg("failed to delete expired Audit Entries from DB")
+			return total, err
+		}
+		total += deleted
+		if deleted < AuditEntryDeleteBatchSize {
+			break
+		}
+	}
+
+	logger.Info().Int("Deleted", total).Time("Cutoff", cutoff).Msg("successfully completed activity")
+
+	return total, nil
+}
+
+// ForwardAuditEntries is a Temporal activity that sends Audit Entries recorded in [start, end) to the configured
+// syslog collector in CEF
+func (mae ManageAuditEntry) ForwardAuditEntries(ctx context.Context, start time.Time, end time.Time) (int, error) {
+	logger := log.With().Str("Activity", "ForwardAuditEntries").Time("Start", start).Time("End", end).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	if !mae.cfg.GetAuditSyslogEnabled() {
+		logger.Info().Msg("audit syslog collector is not configured, skipping")
+		return 0, nil
+	}
+
+	writer, err := mae.dial(mae.cfg.GetAuditSyslogNetwork(), mae.cfg.GetAuditSyslogAddress())
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to connect to audit syslog collector")
+		return 0, err
+	}
+	defer writer.Close()
+
+	aeDAO := cdbm.NewAuditEntryDAO(mae.dbSession)
+	filter := cdbm.AuditEntryFilterInput{StartTime: &start, EndTime: &end}
+	orderBy := paginator.NewDefaultOrderBy(cdbm.AuditEntryOrderByDefault)
+
+	forwarded := 0
+	for {
+		offset := forwarded
+		entries, total, err := aeDAO.GetAll(ctx, nil, filter, paginator.PageInput{
+			Offset:  &offset,
+			Limit:   cdb.GetIntPtr(AuditEntryForwardPageSize),
+			OrderBy: orderBy,
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to retrieve Audit Entries from DB")
+			return forwarded, err
+		}
+
+		users, err := mae.getUsers(ctx, entries)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to retrieve Users from DB")
+			return forwarded, err
+		}
+
+		for _, entry := range entries {
+			var dbUser *cdbm.User
+			if entry.UserID != nil {
+				dbUser = users[*entry.UserID]
+			}
+			if err := writer.Info(util.FormatAuditEntryCEF(entry, dbUser)); err != nil {
+				logger.Error().Err(err).Int("Forwarded", forwarded).Msg("failed to write Audit Entry to syslog collector")
+				return forwarded, err
+			}
+			forwarded++
+		}
+
+		if len(entries) == 0 || forwarded >= total {
+			break
+		}
+	}
+
+	logger.Info().Int("Forwarded", forwarded).Msg("successfully completed activity")
+
+	return forwarded, nil
+}
+
+// getUsers returns the Users that made the given requests, keyed by ID
+func (mae ManageAuditEntry) getUsers(ctx context.Context, entries []cdbm.AuditEntry) (map[uuid.UUID]*cdbm.User, error) {
+	userIDs := goset.NewSet[uuid.UUID]()
+	for _, entry := range entries {
+		if entry.UserID != nil {
+			userIDs.Add(*entry.UserID)
+		}
+	}
+
+	users := make(map[uuid.UUID]*cdbm.User)
+	if userIDs.Cardinality() == 0 {
+		return users, nil
+	}
+
+	userDAO := cdbm.NewUserDAO(mae.dbSession)
+	dbUsers, _, err := userDAO.GetAll(ctx, nil, cdbm.UserFilterInput{UserIDs: userIDs.ToSlice()},
+		paginator.PageInput{Limit: cdb.GetIntPtr(paginator.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range dbUsers {
+		users[dbUsers[i].ID] = &dbUsers[i]
+	}
+	return users, nil
+}
+
+// NewManageAuditEntry returns a new ManageAuditEntry activity
+func NewManageAuditEntry(dbSession *cdb.Session, cfg *config.Config) ManageAuditEntry {
+	return ManageAuditEntry{
+		dbSession: dbSession,
+		cfg:       cfg,
+		dial:      dialSyslog,
+	}
+}
@@ -0,0 +1,186 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/internal/config"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
+)
+
+type testSyslogWriter struct {
+	messages []string
+	failOn   int
+	closed   bool
+}
+
+func (w *testSyslogWriter) Info(m string) error {
+	if w.failOn > 0 && len(w.messages)+1 == w.failOn {
+		return errors.New("connection refused")
+	}
+	w.messages = append(w.messages, m)
+	return nil
+}
+
+func (w *testSyslogWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func testAuditSetupSchema(t *testing.T, dbSession *cdb.Session) {
+	err := dbSession.DB.ResetModel(context.Background(), (*cdbm.User)(nil))
+	assert.Nil(t, err)
+	err = dbSession.DB.ResetModel(context.Background(), (*cdbm.AuditEntry)(nil))
+	assert.Nil(t, err)
+}
+
+func testAuditCreateEntries(t *testing.T, dbSession *cdb.Session, user *cdbm.User, timestamps ...time.Time) {
+	aeDAO := cdbm.NewAuditEntryDAO(dbSession)
+	for i, ts := range timestamps {
+		_, err := aeDAO.Create(context.Background(), nil, cdbm.AuditEntryCreateInput{
+			Endpoint:   fmt.Sprintf("/v2/org/test-org/carbide/vpc/%d", i),
+			Method:     http.MethodPatch,
+			StatusCode: http.StatusOK,
+			ClientIP:   "10.0.0.1",
+			UserID:     &user.ID,
+			OrgName:    "test-org",
+			Timestamp:  ts,
+			Duration:   time.Second,
+			APIVersion: "1.0.0",
+		})
+		assert.Nil(t, err)
+	}
+}
+
+func TestManageAuditEntry_DeleteExpiredAuditEntries(t *testing.T) {
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+	testAuditSetupSchema(t, dbSession)
+
+	user := util.TestBuildUser(t, dbSession, "test123", []string{"test-org"}, []string{"FORGE_PROVIDER_ADMIN"})
+
+	now := time.Now().UTC()
+	testAuditCreateEntries(t, dbSession, user, now.AddDate(0, 0, -1), now.AddDate(0, 0, -29), now.AddDate(0, 0, -31), now.AddDate(0, 0, -400))
+
+	cfg := config.GetTestConfig()
+	defer cfg.SetAuditRetentionDays(cfg.GetAuditRetentionDays())
+
+	tests := []struct {
+		name          string
+		retentionDays int
+		wantDeleted   int
+		wantRemaining int
+	}{
+		{name: "retention disabled keeps all entries", retentionDays: 0, wantDeleted: 0, wantRemaining: 4},
+		{name: "entries past retention are deleted", retentionDays: 30, wantDeleted: 2, wantRemaining: 2},
+		{name: "second run has nothing to delete", retentionDays: 30, wantDeleted: 0, wantRemaining: 2},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg.SetAuditRetentionDays(tc.retentionDays)
+			mae := NewManageAuditEntry(dbSession, cfg)
+
+			deleted, err := mae.DeleteExpiredAuditEntries(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantDeleted, deleted)
+
+			_, total, err := cdbm.NewAuditEntryDAO(dbSession).GetAll(context.Background(), nil, cdbm.AuditEntryFilterInput{}, paginator.PageInput{})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantRemaining, total)
+		})
+	}
+}
+
+func TestManageAuditEntry_ForwardAuditEntries(t *testing.T) {
+	dbSession := util.TestInitDB(t)
+	defer dbSession.Close()
+	testAuditSetupSchema(t, dbSession)
+
+	user := util.TestBuildUser(t, dbSession, "test123", []string{"test-org"}, []string{"FORGE_PROVIDER_ADMIN"})
+
+	start := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	var timestamps []time.Time
+	for i := 0; i < AuditEntryForwardPageSize+5; i++ {
+		timestamps = append(timestamps, start.Add(time.Duration(i)*time.Second))
+	}
+	// outside of the window
+	timestamps = append(timestamps, start.Add(-time.Second), start.Add(time.Hour))
+	testAuditCreateEntries(t, dbSession, user, timestamps...)
+
+	cfg := config.GetTestConfig()
+	defer cfg.SetAuditSyslogAddress(cfg.GetAuditSyslogAddress())
+
+	t.Run("collector not configured", func(t *testing.T) {
+		cfg.SetAuditSyslogAddress("")
+		mae := NewManageAuditEntry(dbSession, cfg)
+		mae.dial = func(network, address string) (SyslogWriter, error) {
+			t.Fatal("unexpected dial")
+			return nil, nil
+		}
+
+		forwarded, err := mae.ForwardAuditEntries(context.Background(), start, start.Add(30*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, 0, forwarded)
+	})
+
+	t.Run("entries in window are forwarded in order", func(t *testing.T) {
+		cfg.SetAuditSyslogAddress("siem.example.com:514")
+		writer := &testSyslogWriter{}
+		mae := NewManageAuditEntry(dbSession, cfg)
+		mae.dial = func(network, address string) (SyslogWriter, error) {
+			assert.Equal(t, "siem.example.com:514", address)
+			return writer, nil
+		}
+
+		forwarded, err := mae.ForwardAuditEntries(context.Background(), start, start.Add(30*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, AuditEntryForwardPageSize+5, forwarded)
+		assert.Len(t, writer.messages, AuditEntryForwardPageSize+5)
+		assert.True(t, writer.closed)
+		for i, m := range writer.messages {
+			assert.True(t, strings.HasPrefix(m, "CEF:0|"))
+			assert.Contains(t, m, fmt.Sprintf("request=/v2/org/test-org/carbide/vpc/%d ", i))
+			assert.Contains(t, m, "suser=jdoe@test.com")
+		}
+	})
+
+	t.Run("write failure is returned", func(t *testing.T) {
+		cfg.SetAuditSyslogAddress("siem.example.com:514")
+		writer := &testSyslogWriter{failOn: 3}
+		mae := NewManageAuditEntry(dbSession, cfg)
+		mae.dial = func(network, address string) (SyslogWriter, error) {
+			return writer, nil
+		}
+
+		forwarded, err := mae.ForwardAuditEntries(context.Background(), start, start.Add(30*time.Minute))
+		assert.Error(t, err)
+		assert.Equal(t, 2, forwarded)
+	})
+}
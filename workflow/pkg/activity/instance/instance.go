@@ -36,6 +36,7 @@ import (
 	"github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
 	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
 	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
@@ -717,6 +718,9 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 	}
 
 	reportedInstanceIDMap := map[uuid.UUID]bool{}
+	removedInstanceIDMap := map[uuid.UUID]bool{}
+	// Whether any reported Instance was skipped because it changed in Cloud after the inventory was collected
+	skippedStale := false
 
 	if instanceInventory.InventoryPage != nil {
 		logger.Info().Msgf("Received Instance inventory page: %d of %d, page size: %d, total count: %d",
@@ -731,6 +735,15 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 			}
 			reportedInstanceIDMap[id] = true
 		}
+
+		for _, strId := range instanceInventory.InventoryPage.RemovedItemIds {
+			id, serr := uuid.Parse(strId)
+			if serr != nil {
+				logger.Error().Err(serr).Str("ID", strId).Msg("failed to parse removed Instance ID from inventory page")
+				continue
+			}
+			removedInstanceIDMap[id] = true
+		}
 	}
 
 	// Get temporal client for specified Site
@@ -789,6 +802,7 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 		// but only if we never allow multiple inventory processes to run concurrently.
 		if time.Since(instance.Updated) < cwutil.InventoryReceiptInterval+(time.Second*5) {
 			slogger.Warn().Msg("instance updated more recently than inventory received time, skipping processing")
+			skippedStale = true
 			continue
 		}
 
@@ -1403,10 +1417,19 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 		for _, instance := range existingInstanceIDMap {
 			found := false
 
-			_, found = reportedInstanceIDMap[instance.ID]
-			if !found && instance.ControllerInstanceID != nil {
-				// Additional check if controller Instance ID != Instance ID
-				_, found = reportedInstanceIDMap[*instance.ControllerInstanceID]
+			if instanceInventory.GetInventoryPage().GetDelta() {
+				// Delta inventory only reports the Instances removed since the previous publication
+				removed := removedInstanceIDMap[instance.ID]
+				if instance.ControllerInstanceID != nil {
+					removed = removed || removedInstanceIDMap[*instance.ControllerInstanceID]
+				}
+				found = !removed
+			} else {
+				_, found = reportedInstanceIDMap[instance.ID]
+				if !found && instance.ControllerInstanceID != nil {
+					// Additional check if controller Instance ID != Instance ID
+					_, found = reportedInstanceIDMap[*instance.ControllerInstanceID]
+				}
 			}
 
 			if !found {
@@ -1520,6 +1543,16 @@ func (mi ManageInstance) UpdateInstancesInDB(ctx context.Context, siteID uuid.UU
 		}
 	}
 
+	// Skipped Instances are not resent in delta inventory until the next full snapshot, so request one now
+	if skippedStale && instanceInventory.GetInventoryPage().GetChecksum() != "" {
+		logger.Info().Msg("skipped stale Instances, requesting full inventory snapshot from Site")
+		err = inventoryActivity.RequestFullInventory(ctx, mi.siteClientPool, siteID, inventoryActivity.ItemTypeInstance)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to request full inventory snapshot from Site")
+			return nil, err
+		}
+	}
+
 	return instanceLifecycleEvents, nil
 }
 
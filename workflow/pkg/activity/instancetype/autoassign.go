@@ -0,0 +1,286 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instancetype
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
+
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+
+	cwutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
+)
+
+// autoAssignmentPlan is the result of matching the unassigned Machines of a Site against
+// the Instance Types that have auto assignment enabled
+type autoAssignmentPlan struct {
+	// machineIDs are the Machines to assign, keyed by Instance Type ID
+	machineIDs map[uuid.UUID][]string
+	// nearMisses are the mismatches of Machines that almost match, keyed by Instance Type ID and Machine ID
+	nearMisses map[uuid.UUID]map[string][]string
+	// ambiguous are the Machines that match more than one Instance Type and are left for manual assignment
+	ambiguous map[string][]uuid.UUID
+}
+
+// planAutoAssignment matches the Capabilities of each Machine against the Capabilities of each Instance Type.
+// A Machine is assigned only when it matches exactly one Instance Type.
+func planAutoAssignment(itCaps map[uuid.UUID][]cdbm.MachineCapability, machineCaps map[string][]cdbm.MachineCapability) autoAssignmentPlan {
+	plan := autoAssignmentPlan{
+		machineIDs: map[uuid.UUID][]string{},
+		nearMisses: map[uuid.UUID]map[string][]string{},
+		ambiguous:  map[string][]uuid.UUID{},
+	}
+
+	// Iterate in a stable order so assignments are deterministic
+	itIDs := make([]uuid.UUID, 0, len(itCaps))
+	for itID := range itCaps {
+		itIDs = append(itIDs, itID)
+	}
+	sort.Slice(itIDs, func(i, j int) bool { return itIDs[i].String() < itIDs[j].String() })
+
+	machineIDs := make([]string, 0, len(machineCaps))
+	for machineID := range machineCaps {
+		machineIDs = append(machineIDs, machineID)
+	}
+	sort.Strings(machineIDs)
+
+	for _, machineID := range machineIDs {
+		matched := []uuid.UUID{}
+
+		for _, itID := range itIDs {
+			mismatches := cdbm.MatchMachineCapabilities(itCaps[itID], machineCaps[machineID], cdbm.MachineCapabilityMatchExact)
+			if len(mismatches) == 0 {
+				matched = append(matched, itID)
+			} else if len(mismatches) <= cdbm.MachineCapabilityNearMissMismatchMax {
+				if plan.nearMisses[itID] == nil {
+					plan.nearMisses[itID] = map[string][]string{}
+				}
+				plan.nearMisses[itID][machineID] = mismatches
+			}
+		}
+
+		if len(matched) == 1 {
+			plan.machineIDs[matched[0]] = append(plan.machineIDs[matched[0]], machineID)
+		} else if len(matched) > 1 {
+			plan.ambiguous[machineID] = matched
+		}
+	}
+
+	return plan
+}
+
+// AutoAssignMachinesToInstanceTypes is a Temporal activity that assigns the unassigned Machines of a Site to the
+// Instance Types that have auto assignment enabled, when their Capabilities match exactly
+func (mv ManageInstanceType) AutoAssignMachinesToInstanceTypes(ctx context.Context, siteID uuid.UUID) error {
+	logger := log.With().Str("Activity", "AutoAssignMachinesToInstanceTypes").Str("Site ID", siteID.String()).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	itDAO := cdbm.NewInstanceTypeDAO(mv.dbSession)
+
+	its, _, err := itDAO.GetAll(ctx, nil, cdbm.InstanceTypeFilterInput{
+		SiteIDs:    []uuid.UUID{siteID},
+		Status:     cdb.GetStrPtr(cdbm.InstanceTypeStatusReady),
+		AutoAssign: cdb.GetBoolPtr(true),
+	}, nil, nil, cdb.GetIntPtr(cdbp.TotalLimit), nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Instance Types with auto assignment enabled from DB")
+		return err
+	}
+
+	if len(its) == 0 {
+		logger.Info().Msg("no Instance Types with auto assignment enabled for Site, skipping")
+		return nil
+	}
+
+	// Machines can only be assigned to Instance Types of the Provider that owns them
+	itsByProviderID := map[uuid.UUID][]cdbm.InstanceType{}
+	for _, it := range its {
+		itsByProviderID[it.InfrastructureProviderID] = append(itsByProviderID[it.InfrastructureProviderID], it)
+	}
+
+	mcDAO := cdbm.NewMachineCapabilityDAO(mv.dbSession)
+
+	var errs []error
+
+	for providerID, pits := range itsByProviderID {
+		itCaps := map[uuid.UUID][]cdbm.MachineCapability{}
+		itByID := map[uuid.UUID]*cdbm.InstanceType{}
+
+		for i := range pits {
+			it := &pits[i]
+
+			mcs, _, serr := mcDAO.GetAll(ctx, nil, nil, []uuid.UUID{it.ID}, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cdb.GetIntPtr(cdbp.TotalLimit), nil)
+			if serr != nil {
+				logger.Error().Err(serr).Str("Instance Type ID", it.ID.String()).Msg("failed to retrieve Machine Capabilities for Instance Type from DB")
+				return serr
+			}
+
+			// Every Machine would match an Instance Type without Capabilities
+			if len(mcs) == 0 {
+				logger.Warn().Str("Instance Type ID", it.ID.String()).Msg("Instance Type has auto assignment enabled but no Machine Capabilities, skipping")
+				continue
+			}
+
+			itCaps[it.ID] = mcs
+			itByID[it.ID] = it
+		}
+
+		if len(itCaps) == 0 {
+			continue
+		}
+
+		machineCaps, serr := util.GetUnassignedMachineCapabilities(ctx, nil, mv.dbSession, providerID, siteID)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("failed to retrieve unassigned Machines for Site from DB")
+			return serr
+		}
+
+		plan := planAutoAssignment(itCaps, machineCaps)
+
+		for machineID, itIDs := range plan.ambiguous {
+			logger.Warn().Str("Machine ID", machineID).Interface("Instance Type IDs", itIDs).Msg("Machine matches more than one Instance Type with auto assignment enabled, skipping")
+		}
+
+		for itID, nearMisses := range plan.nearMisses {
+			for machineID, mismatches := range nearMisses {
+				logger.Info().Str("Instance Type ID", itID.String()).Str("Machine ID", machineID).Strs("Mismatches", mismatches).Msg("Machine is a near-miss for Instance Type")
+			}
+		}
+
+		for itID, machineIDs := range plan.machineIDs {
+			serr := mv.assignMachinesToInstanceType(ctx, logger, itByID[itID], machineIDs)
+			if serr != nil {
+				errs = append(errs, serr)
+			}
+		}
+	}
+
+	logger.Info().Msg("completed activity")
+
+	return errors.Join(errs...)
+}
+
+// assignMachinesToInstanceType associates Machines with an Instance Type in the DB and on Site.
+// The DB changes are only committed once the Site has accepted the association.
+func (mv ManageInstanceType) assignMachinesToInstanceType(ctx context.Context, logger zerolog.Logger, it *cdbm.InstanceType, machineIDs []string) error {
+	slogger := logger.With().Str("Instance Type ID", it.ID.String()).Logger()
+
+	tx, err := cdb.BeginTx(ctx, mv.dbSession, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction to assign Machines to Instance Type: %w", err)
+	}
+
+	txCommitted := false
+	defer func(dbTx *cdb.Tx, committed *bool) {
+		if committed != nil && !*committed {
+			dbTx.Rollback()
+		}
+	}(tx, &txCommitted)
+
+	mDAO := cdbm.NewMachineDAO(mv.dbSession)
+	mitDAO := cdbm.NewMachineInstanceTypeDAO(mv.dbSession)
+
+	assignedMachineIDs := make([]string, 0, len(machineIDs))
+
+	for _, machineID := range machineIDs {
+		// The Machine may have been assigned manually since the candidates were retrieved, it is skipped so the
+		// other Machines can still be assigned
+		emits, _, serr := mitDAO.GetAll(ctx, tx, &machineID, nil, nil, nil, nil, nil)
+		if serr != nil {
+			return fmt.Errorf("failed to check for existing Instance Type association for Machine %s: %w", machineID, serr)
+		}
+		if len(emits) > 0 {
+			slogger.Info().Str("Machine ID", machineID).Str("Existing Instance Type ID", emits[0].InstanceTypeID.String()).Msg("Machine is already associated with an Instance Type, skipping")
+			continue
+		}
+
+		_, serr = mitDAO.CreateFromParams(ctx, tx, machineID, it.ID)
+		if serr != nil {
+			return fmt.Errorf("failed to create Instance Type association for Machine %s: %w", machineID, serr)
+		}
+
+		_, serr = mDAO.Update(ctx, tx, cdbm.MachineUpdateInput{MachineID: machineID, InstanceTypeID: &it.ID})
+		if serr != nil {
+			return fmt.Errorf("failed to update Instance Type for Machine %s: %w", machineID, serr)
+		}
+
+		assignedMachineIDs = append(assignedMachineIDs, machineID)
+	}
+
+	if len(assignedMachineIDs) == 0 {
+		slogger.Info().Msg("all candidate Machines are already associated with an Instance Type, nothing to assign")
+		return nil
+	}
+
+	// Send the association to Site
+	stc, err := mv.siteClientPool.GetClientByID(*it.SiteID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve Temporal client for Site: %w", err)
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                       "associate-machines-with-instance-type-" + it.ID.String(),
+		TaskQueue:                queue.SiteTaskQueue,
+		WorkflowExecutionTimeout: cwutil.WorkflowExecutionTimeout,
+	}
+
+	request := &cwssaws.AssociateMachinesWithInstanceTypeRequest{
+		InstanceTypeId: it.ID.String(),
+		MachineIds:     assignedMachineIDs,
+	}
+
+	wctx, cancel := context.WithTimeout(ctx, cwutil.WorkflowContextTimeout)
+	defer cancel()
+
+	we, err := stc.ExecuteWorkflow(wctx, workflowOptions, "AssociateMachinesWithInstanceType", request)
+	if err != nil {
+		return fmt.Errorf("failed to start workflow to associate Machines with Instance Type on Site: %w", err)
+	}
+
+	// Block until the workflow has completed and returned success/error.
+	err = we.Get(wctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to associate Machines with Instance Type on Site: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("failed to commit Machine assignments to DB: %w", err)
+	}
+	txCommitted = true
+
+	slogger.Info().Strs("Machine IDs", assignedMachineIDs).Msg("automatically assigned Machines to Instance Type")
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package instancetype
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestPlanAutoAssignment(t *testing.T) {
+	gpu := func(name string, count int) cdbm.MachineCapability {
+		return cdbm.MachineCapability{Type: cdbm.MachineCapabilityTypeGPU, Name: name, Count: cdb.GetIntPtr(count)}
+	}
+	cpu := func(name string, count int) cdbm.MachineCapability {
+		return cdbm.MachineCapability{Type: cdbm.MachineCapabilityTypeCPU, Name: name, Count: cdb.GetIntPtr(count)}
+	}
+
+	mem := cdbm.MachineCapability{Type: cdbm.MachineCapabilityTypeMemory, Name: "DDR5", Capacity: cdb.GetStrPtr("2TB")}
+
+	h100 := uuid.New()
+	a100 := uuid.New()
+	anyGPU := uuid.New()
+
+	itCaps := map[uuid.UUID][]cdbm.MachineCapability{
+		h100: {gpu("NVIDIA H100", 8), cpu("Intel Xeon", 2), mem},
+		a100: {gpu("NVIDIA A100", 8), cpu("Intel Xeon", 2), mem},
+	}
+
+	machineCaps := map[string][]cdbm.MachineCapability{
+		"machine-h100-1": {gpu("NVIDIA H100", 8), cpu("Intel Xeon", 2), mem},
+		"machine-h100-2": {gpu("NVIDIA H100", 8), cpu("Intel Xeon", 2), mem},
+		"machine-a100":   {gpu("NVIDIA A100", 8), cpu("Intel Xeon", 2), mem},
+		"machine-h100-4": {gpu("NVIDIA H100", 4), cpu("Intel Xeon", 2), mem},
+		"machine-cpu":    {cpu("AMD EPYC", 1)},
+		"machine-h100-ib": {gpu("NVIDIA H100", 8), cpu("Intel Xeon", 2), mem,
+			{Type: cdbm.MachineCapabilityTypeInfiniBand, Name: "MT2910 Family [ConnectX-7]", Count: cdb.GetIntPtr(8)}},
+	}
+
+	plan := planAutoAssignment(itCaps, machineCaps)
+
+	assert.Equal(t, []string{"machine-h100-1", "machine-h100-2"}, plan.machineIDs[h100])
+	assert.Equal(t, []string{"machine-a100"}, plan.machineIDs[a100])
+	assert.Empty(t, plan.ambiguous)
+
+	// Machine with fewer GPUs is a near miss for the H100 Instance Type only
+	assert.Equal(t, []string{"GPU Capability NVIDIA H100: count is 4, expected 8"}, plan.nearMisses[h100]["machine-h100-4"])
+	assert.NotContains(t, plan.nearMisses[a100], "machine-cpu")
+	assert.NotContains(t, plan.nearMisses[h100], "machine-cpu")
+
+	// Machine with a Capability the Instance Type does not list is not an exact match
+	assert.Equal(t, []string{"InfiniBand Capability MT2910 Family [ConnectX-7] is not part of Instance Type"}, plan.nearMisses[h100]["machine-h100-ib"])
+
+	// A Machine matching more than one Instance Type is not assigned
+	itCaps[anyGPU] = []cdbm.MachineCapability{
+		{Type: cdbm.MachineCapabilityTypeGPU, Name: "NVIDIA H100"},
+		{Type: cdbm.MachineCapabilityTypeCPU, Name: "Intel Xeon"},
+		{Type: cdbm.MachineCapabilityTypeMemory, Name: "DDR5"},
+	}
+
+	plan = planAutoAssignment(itCaps, machineCaps)
+
+	assert.Empty(t, plan.machineIDs[h100])
+	assert.Equal(t, []string{"machine-h100-4"}, plan.machineIDs[anyGPU])
+	assert.Equal(t, []string{"machine-a100"}, plan.machineIDs[a100])
+	assert.ElementsMatch(t, []uuid.UUID{h100, anyGPU}, plan.ambiguous["machine-h100-1"])
+	assert.ElementsMatch(t, []uuid.UUID{h100, anyGPU}, plan.ambiguous["machine-h100-2"])
+}
@@ -0,0 +1,144 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+
+	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+const (
+	// ItemTypeMachine is the inventory type of Machine inventory
+	ItemTypeMachine = "Machine"
+	// ItemTypeInstance is the inventory type of Instance inventory
+	ItemTypeInstance = "Instance"
+	// ItemTypeSubnet is the inventory type of Subnet inventory
+	ItemTypeSubnet = "Subnet"
+)
+
+// ManageInventorySync is an activity wrapper for verifying that delta inventory published by
+// Site Agent applies on top of the inventory state known to Cloud
+type ManageInventorySync struct {
+	dbSession      *cdb.Session
+	siteClientPool *sc.ClientPool
+}
+
+// Activity functions
+
+// CheckDeltaInventoryPage is a Temporal activity that returns whether a delta inventory page can be applied.
+// Pages of the same publication may be processed in any order and more than once, so the page is applied
+// when Cloud holds either the state the delta was computed against or the state it results in.
+// Otherwise a full snapshot is requested from Site Agent and the page is skipped.
+func (mis ManageInventorySync) CheckDeltaInventoryPage(ctx context.Context, siteID uuid.UUID, itemType string, page *cwssaws.InventoryPage) (bool, error) {
+	logger := log.With().Str("Activity", "CheckDeltaInventoryPage").Str("Site ID", siteID.String()).Str("Item Type", itemType).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	if !page.GetDelta() {
+		return true, nil
+	}
+
+	issDAO := cdbm.NewInventorySyncStateDAO(mis.dbSession)
+
+	state, err := issDAO.Get(ctx, nil, siteID, itemType)
+	if err != nil && !errors.Is(err, cdb.ErrDoesNotExist) {
+		logger.Error().Err(err).Msg("failed to retrieve inventory sync state from DB")
+		return false, err
+	}
+
+	if state != nil && (state.Checksum == page.GetBaseChecksum() || state.Checksum == page.GetChecksum()) {
+		logger.Info().Msg("completing activity")
+		return true, nil
+	}
+
+	logger.Warn().Msg("delta inventory does not match inventory state in Cloud, requesting full snapshot from Site")
+
+	err = RequestFullInventory(ctx, mis.siteClientPool, siteID, itemType)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to request full inventory snapshot from Site")
+		return false, err
+	}
+
+	logger.Info().Msg("completing activity")
+
+	return false, nil
+}
+
+// RecordInventoryChecksum is a Temporal activity that records the inventory checksum reported by Site Agent
+// once the last page of a publication has been applied
+func (mis ManageInventorySync) RecordInventoryChecksum(ctx context.Context, siteID uuid.UUID, itemType string, page *cwssaws.InventoryPage) error {
+	logger := log.With().Str("Activity", "RecordInventoryChecksum").Str("Site ID", siteID.String()).Str("Item Type", itemType).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	if page.GetChecksum() == "" || (page.GetTotalPages() != 0 && page.GetCurrentPage() != page.GetTotalPages()) {
+		return nil
+	}
+
+	issDAO := cdbm.NewInventorySyncStateDAO(mis.dbSession)
+
+	_, err := issDAO.Upsert(ctx, nil, siteID, itemType, page.GetChecksum())
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to update inventory sync state in DB")
+		return err
+	}
+
+	logger.Info().Msg("completing activity")
+
+	return nil
+}
+
+// RequestFullInventory triggers the Site workflow that makes the next inventory publication a full snapshot.
+// Inventory activities must call it when they skip items of a page that carries a checksum, since Site Agent
+// does not resend unchanged items in delta publications and the recorded checksum would hide the divergence.
+func RequestFullInventory(ctx context.Context, siteClientPool *sc.ClientPool, siteID uuid.UUID, itemType string) error {
+	tc, err := siteClientPool.GetClientByID(siteID)
+	if err != nil {
+		return err
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("site-%s-inventory-full-request-%s", strings.ToLower(itemType), siteID.String()),
+		TaskQueue: queue.SiteTaskQueue,
+	}
+
+	_, err = tc.ExecuteWorkflow(ctx, workflowOptions, fmt.Sprintf("RequestFull%sInventory", itemType))
+	return err
+}
+
+// NewManageInventorySync returns a new ManageInventorySync activity
+func NewManageInventorySync(dbSession *cdb.Session, siteClientPool *sc.ClientPool) ManageInventorySync {
+	return ManageInventorySync{
+		dbSession:      dbSession,
+		siteClientPool: siteClientPool,
+	}
+}
@@ -0,0 +1,161 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package inventory
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	tmocks "go.temporal.io/sdk/mocks"
+
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbu "github.com/nvidia/bare-metal-manager-rest/db/pkg/util"
+
+	"github.com/nvidia/bare-metal-manager-rest/workflow/internal/config"
+	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
+
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+)
+
+// testTemporalSiteClientPool Building site client pool
+func testTemporalSiteClientPool(t *testing.T) *sc.ClientPool {
+	keyPath, certPath := config.SetupTestCerts(t)
+	defer os.Remove(keyPath)
+	defer os.Remove(certPath)
+
+	cfg := config.NewConfig()
+	cfg.SetTemporalCertPath(certPath)
+	cfg.SetTemporalKeyPath(keyPath)
+	cfg.SetTemporalCaPath(certPath)
+
+	tcfg, err := cfg.GetTemporalConfig()
+	assert.NoError(t, err)
+
+	return sc.NewClientPool(tcfg)
+}
+
+func TestManageInventorySync_CheckDeltaInventoryPage(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := cdbu.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.InventorySyncState)(nil))
+	assert.NoError(t, err)
+
+	siteID := uuid.New()
+
+	_, err = cdbm.NewInventorySyncStateDAO(dbSession).Upsert(ctx, nil, siteID, ItemTypeSubnet, "checksum-1")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		siteID      uuid.UUID
+		page        *cwssaws.InventoryPage
+		wantApply   bool
+		wantRequest bool
+	}{
+		{
+			name:      "full inventory page is always applied",
+			siteID:    siteID,
+			page:      &cwssaws.InventoryPage{TotalPages: 1, CurrentPage: 1, Checksum: "checksum-9"},
+			wantApply: true,
+		},
+		{
+			name:      "delta computed against Cloud state is applied",
+			siteID:    siteID,
+			page:      &cwssaws.InventoryPage{Delta: true, BaseChecksum: "checksum-1", Checksum: "checksum-2"},
+			wantApply: true,
+		},
+		{
+			name:      "delta resulting in Cloud state is applied again",
+			siteID:    siteID,
+			page:      &cwssaws.InventoryPage{Delta: true, BaseChecksum: "checksum-0", Checksum: "checksum-1"},
+			wantApply: true,
+		},
+		{
+			name:        "delta not matching Cloud state requests full inventory",
+			siteID:      siteID,
+			page:        &cwssaws.InventoryPage{Delta: true, BaseChecksum: "checksum-5", Checksum: "checksum-6"},
+			wantRequest: true,
+		},
+		{
+			name:        "delta without Cloud state requests full inventory",
+			siteID:      uuid.New(),
+			page:        &cwssaws.InventoryPage{Delta: true, BaseChecksum: "checksum-1", Checksum: "checksum-2"},
+			wantRequest: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mis := NewManageInventorySync(dbSession, testTemporalSiteClientPool(t))
+
+			mtc := &tmocks.Client{}
+			mtc.Mock.On("ExecuteWorkflow", mock.Anything, mock.Anything, "RequestFullSubnetInventory").Return(&tmocks.WorkflowRun{}, nil)
+			mis.siteClientPool.IDClientMap[tt.siteID.String()] = mtc
+
+			apply, err := mis.CheckDeltaInventoryPage(ctx, tt.siteID, ItemTypeSubnet, tt.page)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantApply, apply)
+
+			if tt.wantRequest {
+				mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+			} else {
+				mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 0)
+			}
+		})
+	}
+}
+
+func TestManageInventorySync_RecordInventoryChecksum(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := cdbu.GetTestDBSession(t, false)
+	defer dbSession.Close()
+
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.InventorySyncState)(nil))
+	assert.NoError(t, err)
+
+	siteID := uuid.New()
+	issDAO := cdbm.NewInventorySyncStateDAO(dbSession)
+	mis := NewManageInventorySync(dbSession, nil)
+
+	// Checksum is only recorded once the last page has been applied
+	err = mis.RecordInventoryChecksum(ctx, siteID, ItemTypeMachine, &cwssaws.InventoryPage{TotalPages: 2, CurrentPage: 1, Checksum: "checksum-1"})
+	assert.NoError(t, err)
+	_, err = issDAO.Get(ctx, nil, siteID, ItemTypeMachine)
+	assert.Error(t, err)
+
+	err = mis.RecordInventoryChecksum(ctx, siteID, ItemTypeMachine, &cwssaws.InventoryPage{TotalPages: 2, CurrentPage: 2, Checksum: "checksum-1"})
+	assert.NoError(t, err)
+	state, err := issDAO.Get(ctx, nil, siteID, ItemTypeMachine)
+	assert.NoError(t, err)
+	assert.Equal(t, "checksum-1", state.Checksum)
+
+	// Empty delta publications are a single page with no items
+	err = mis.RecordInventoryChecksum(ctx, siteID, ItemTypeMachine, &cwssaws.InventoryPage{TotalPages: 0, CurrentPage: 1, Delta: true, Checksum: "checksum-2"})
+	assert.NoError(t, err)
+	state, err = issDAO.Get(ctx, nil, siteID, ItemTypeMachine)
+	assert.NoError(t, err)
+	assert.Equal(t, "checksum-2", state.Checksum)
+}
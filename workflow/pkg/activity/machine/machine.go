@@ -34,6 +34,7 @@ import (
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
 	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
 
@@ -201,6 +202,9 @@ func (mm *ManageMachine) UpdateMachinesInDB(ctx context.Context, siteIDStr strin
 	existingCloudMachineIDMap := map[string]*cdbm.Machine{}
 
 	reportedMachineIDMap := map[string]bool{}
+	removedMachineIDMap := map[string]bool{}
+	// Whether any reported Machine was skipped because it changed in Cloud after the inventory was collected
+	skippedStale := false
 
 	if machineInventory.InventoryPage != nil {
 		logger.Info().Msgf("Received Machine inventory page: %d of %d, page size: %d, total count: %d",
@@ -210,6 +214,10 @@ func (mm *ManageMachine) UpdateMachinesInDB(ctx context.Context, siteIDStr strin
 		for _, id := range machineInventory.InventoryPage.ItemIds {
 			reportedMachineIDMap[id] = true
 		}
+
+		for _, id := range machineInventory.InventoryPage.RemovedItemIds {
+			removedMachineIDMap[id] = true
+		}
 	}
 
 	for _, machine := range existingMachines {
@@ -427,6 +435,7 @@ func (mm *ManageMachine) UpdateMachinesInDB(ctx context.Context, siteIDStr strin
 			if time.Since(existingCloudMachine.Updated) < cwutil.InventoryReceiptInterval+(time.Second*5) {
 				slogger.Warn().Msg("machine updated more recently than inventory received time, skipping processing")
 				txn.Rollback()
+				skippedStale = true
 				continue
 			}
 
@@ -659,7 +668,11 @@ func (mm *ManageMachine) UpdateMachinesInDB(ctx context.Context, siteIDStr strin
 	// If inventory paging is enabled, we only need to do this once and we do it on the last page
 	if machineInventory.InventoryPage == nil || machineInventory.InventoryPage.TotalPages == 0 || (machineInventory.InventoryPage.CurrentPage == machineInventory.InventoryPage.TotalPages) {
 		for _, existingMachine := range existingMachines {
-			_, found := reportedMachineIDMap[existingMachine.ID]
+			found := reportedMachineIDMap[existingMachine.ID]
+			if machineInventory.GetInventoryPage().GetDelta() {
+				// Delta inventory only reports the Machines removed since the previous publication
+				found = !removedMachineIDMap[existingMachine.ID]
+			}
 			if found {
 				continue
 			}
@@ -699,6 +712,16 @@ func (mm *ManageMachine) UpdateMachinesInDB(ctx context.Context, siteIDStr strin
 		}
 	}
 
+	// Skipped Machines are not resent in delta inventory until the next full snapshot, so request one now
+	if skippedStale && machineInventory.GetInventoryPage().GetChecksum() != "" {
+		logger.Info().Msg("skipped stale Machines, requesting full inventory snapshot from Site")
+		err = inventoryActivity.RequestFullInventory(ctx, mm.siteClientPool, siteID, inventoryActivity.ItemTypeMachine)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to request full inventory snapshot from Site")
+			return err
+		}
+	}
+
 	logger.Info().Msg("completed activity")
 
 	return nil
@@ -33,7 +33,9 @@ import (
 	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
 	"github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/uptrace/bun/extra/bundebug"
+	tmocks "go.temporal.io/sdk/mocks"
 
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
@@ -1242,6 +1244,82 @@ func TestManageMachine_UpdateMachinesInDB(t *testing.T) {
 	}
 }
 
+func TestManageMachine_UpdateMachinesInDB_StaleMachineRequestsFullInventory(t *testing.T) {
+	dbSession := testMachineInitDB(t)
+	defer dbSession.Close()
+	testMachineSetupSchema(t, dbSession)
+
+	ip := testMachineBuildInfrastructureProvider(t, dbSession, "test-ip-org-1", "infraProvider1")
+	site := testMachineBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered)
+
+	// Machine was just updated in Cloud, so inventory collected before then is stale for it
+	m := testMachineBuildMachine(t, dbSession, ip.ID, site.ID, nil, nil, false, nil, false, nil, cdb.GetStrPtr(cdbm.MachineStatusReady))
+
+	buildInventory := func(checksum string) *cwssaws.MachineInventory {
+		return &cwssaws.MachineInventory{
+			Machines: []*cwssaws.MachineInfo{
+				{Machine: &cwssaws.Machine{Id: &cwssaws.MachineId{Id: m.ControllerMachineID}, State: controllerMachineStatePrefixReady}},
+			},
+			Timestamp:       timestamppb.Now(),
+			InventoryStatus: cwssaws.InventoryStatus_INVENTORY_STATUS_SUCCESS,
+			InventoryPage: &cwssaws.InventoryPage{
+				CurrentPage: 1,
+				TotalPages:  1,
+				PageSize:    25,
+				TotalItems:  1,
+				ItemIds:     []string{m.ControllerMachineID},
+				Checksum:    checksum,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		checksum    string
+		requestErr  error
+		wantRequest bool
+		wantErr     bool
+	}{
+		{
+			name: "inventory without checksum is resent in full by Site",
+		},
+		{
+			name:        "stale Machine in checksummed inventory requests full inventory",
+			checksum:    "checksum-1",
+			wantRequest: true,
+		},
+		{
+			name:        "failure to request full inventory fails the activity",
+			checksum:    "checksum-1",
+			requestErr:  fmt.Errorf("site unreachable"),
+			wantRequest: true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mm := ManageMachine{
+				dbSession:      dbSession,
+				siteClientPool: testTemporalSiteClientPool(t),
+			}
+
+			mtc := &tmocks.Client{}
+			mtc.Mock.On("ExecuteWorkflow", mock.Anything, mock.Anything, "RequestFullMachineInventory").Return(&tmocks.WorkflowRun{}, tt.requestErr)
+			mm.siteClientPool.IDClientMap[site.ID.String()] = mtc
+
+			err := mm.UpdateMachinesInDB(context.Background(), site.ID.String(), buildInventory(tt.checksum))
+			assert.Equal(t, tt.wantErr, err != nil)
+
+			if tt.wantRequest {
+				mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+			} else {
+				mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 0)
+			}
+		})
+	}
+}
+
 func TestNewManageMachine(t *testing.T) {
 	type args struct {
 		dbSession     *cdb.Session
@@ -20,16 +20,21 @@ package networksecuritygroup
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/proto"
 
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 
 	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/util"
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 
@@ -86,8 +91,23 @@ func (mv ManageNetworkSecurityGroup) UpdateNetworkSecurityGroupsInDB(ctx context
 
 	// Map of NetworkSecurityGroups known to cloud
 	existingNetworkSecurityGroupIDMap := make(map[string]*cdbm.NetworkSecurityGroup)
+	referencingNetworkSecurityGroups := []*cdbm.NetworkSecurityGroup{}
 	for _, networkSecurityGroup := range existingNetworkSecurityGroups {
 		existingNetworkSecurityGroupIDMap[networkSecurityGroup.ID] = &networkSecurityGroup
+		if networkSecurityGroup.HasReferences() {
+			referencingNetworkSecurityGroups = append(referencingNetworkSecurityGroups, &networkSecurityGroup)
+		}
+	}
+
+	// Rules that reference Address Sets or other NetworkSecurityGroups are only known to cloud,
+	// Site only knows the prefixes they resolve to.
+	prefixesByReferenceID := map[string][]string{}
+	if len(referencingNetworkSecurityGroups) > 0 {
+		prefixesByReferenceID, err = util.GetNetworkSecurityGroupReferencePrefixes(ctx, nil, mv.dbSession, referencingNetworkSecurityGroups...)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to resolve references in NetworkSecurityGroup rules")
+			return err
+		}
 	}
 
 	// Map of NetworkSecurityGroups known to site
@@ -169,13 +189,35 @@ func (mv ManageNetworkSecurityGroup) UpdateNetworkSecurityGroupsInDB(ctx context
 			//			but this isn't expensive.
 			reportedNetworkSecurityGroupIDMap[networkSecurityGroup.ID] = true
 
+			hasReferences := networkSecurityGroup.HasReferences()
+
+			if hasReferences && networkSecurityGroup.Status != cdbm.NetworkSecurityGroupStatusDeleting {
+				// Membership of referenced NetworkSecurityGroups changes as Instances come and go,
+				// so push the rules again if Site no longer has what the references resolve to.
+				resolvedRules, rerr := networkSecurityGroup.GetRulesAsResolvedProtoRefs(prefixesByReferenceID)
+				if rerr != nil {
+					slogger.Error().Err(rerr).Msg("failed to resolve references in NetworkSecurityGroup rules")
+				} else if !networkSecurityGroupRulesEqual(resolvedRules, controllerNetworkSecurityGroup.GetAttributes().GetRules()) {
+					slogger.Info().Msg("NetworkSecurityGroup rules on Site differ from resolved references, triggering update")
+
+					_, serr := mv.executeUpdateNetworkSecurityGroupWorkflow(ctx, siteID, networkSecurityGroup, resolvedRules)
+					if serr != nil {
+						slogger.Error().Err(serr).Msg("failed to trigger update of NetworkSecurityGroup rules on Site")
+					}
+				}
+			}
+
 			if networkSecurityGroup.Version != controllerNetworkSecurityGroup.Version {
 				// If the record coming in from site is known to cloud but site
 				// reports a different version, time to update cloud.
 
-				rules := make([]*cdbm.NetworkSecurityGroupRule, len(controllerNetworkSecurityGroup.GetAttributes().GetRules()))
-				for i, rule := range controllerNetworkSecurityGroup.GetAttributes().GetRules() {
-					rules[i] = &cdbm.NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule}
+				// Rules with references must not be replaced by the prefixes they resolved to
+				var rules []*cdbm.NetworkSecurityGroupRule
+				if !hasReferences {
+					rules = make([]*cdbm.NetworkSecurityGroupRule, len(controllerNetworkSecurityGroup.GetAttributes().GetRules()))
+					for i, rule := range controllerNetworkSecurityGroup.GetAttributes().GetRules() {
+						rules[i] = &cdbm.NetworkSecurityGroupRule{NetworkSecurityGroupRuleAttributes: rule}
+					}
 				}
 
 				_, err = networkSecurityGroupDAO.Update(ctx, nil, cdbm.NetworkSecurityGroupUpdateInput{
@@ -239,6 +281,145 @@ func (mv ManageNetworkSecurityGroup) UpdateNetworkSecurityGroupsInDB(ctx context
 	return nil
 }
 
+// SyncNetworkSecurityGroupViaSiteAgent is a Temporal activity that pushes the rules of a NetworkSecurityGroup to the Site, with
+// Address Set and NetworkSecurityGroup references resolved to the prefixes they currently contain
+func (mv ManageNetworkSecurityGroup) SyncNetworkSecurityGroupViaSiteAgent(ctx context.Context, siteID uuid.UUID, networkSecurityGroupID string) error {
+	logger := log.With().Str("Activity", "SyncNetworkSecurityGroupViaSiteAgent").Str("Site ID", siteID.String()).
+		Str("NetworkSecurityGroup ID", networkSecurityGroupID).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	networkSecurityGroupDAO := cdbm.NewNetworkSecurityGroupDAO(mv.dbSession)
+
+	networkSecurityGroup, err := networkSecurityGroupDAO.GetByID(ctx, nil, networkSecurityGroupID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			logger.Warn().Msg("NetworkSecurityGroup no longer exists, nothing to sync")
+			return nil
+		}
+		logger.Error().Err(err).Msg("failed to retrieve NetworkSecurityGroup from DB")
+		return err
+	}
+
+	if networkSecurityGroup.Status == cdbm.NetworkSecurityGroupStatusDeleting {
+		logger.Warn().Msg("NetworkSecurityGroup is being deleted, cannot sync to Site")
+		return nil
+	}
+
+	rules, err := util.GetResolvedNetworkSecurityGroupRules(ctx, nil, mv.dbSession, networkSecurityGroup)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to resolve references in NetworkSecurityGroup rules")
+		return err
+	}
+
+	stDAO := cdbm.NewSiteDAO(mv.dbSession)
+
+	site, err := stDAO.GetByID(ctx, nil, siteID, nil, false)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Site from DB")
+		return err
+	}
+
+	// References can expand to more rules than the Site supports, retrying won't help with that
+	if site.Config != nil && site.Config.MaxNetworkSecurityGroupRuleCount != nil && len(rules) > *site.Config.MaxNetworkSecurityGroupRuleCount {
+		statusMessage := fmt.Sprintf("rules expand to %d rules with references resolved, Site supports up to %d", len(rules), *site.Config.MaxNetworkSecurityGroupRuleCount)
+		logger.Error().Msg(statusMessage)
+
+		_, err = networkSecurityGroupDAO.Update(ctx, nil, cdbm.NetworkSecurityGroupUpdateInput{
+			NetworkSecurityGroupID: networkSecurityGroup.ID,
+			Status:                 cdb.GetStrPtr(cdbm.NetworkSecurityGroupStatusError),
+			UpdatedByID:            siteID, /* This would normally be a user ID, but the update is not made on behalf of a user */
+		})
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to update NetworkSecurityGroup status in DB")
+			return err
+		}
+
+		sdDAO := cdbm.NewStatusDetailDAO(mv.dbSession)
+		_, err = sdDAO.CreateFromParams(ctx, nil, networkSecurityGroup.ID, cdbm.NetworkSecurityGroupStatusError, &statusMessage)
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to create Status Detail for NetworkSecurityGroup in DB")
+			return err
+		}
+
+		return nil
+	}
+
+	we, err := mv.executeUpdateNetworkSecurityGroupWorkflow(ctx, siteID, networkSecurityGroup, rules)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to start Site workflow to update NetworkSecurityGroup")
+		return err
+	}
+
+	// Block until the workflow has completed and returned success/error.
+	err = we.Get(ctx, nil)
+	if err != nil {
+		logger.Error().Err(err).Str("Workflow ID", we.GetID()).Msg("failed to execute Site workflow to update NetworkSecurityGroup")
+		return err
+	}
+
+	logger.Info().Str("Workflow ID", we.GetID()).Msg("completed activity")
+
+	return nil
+}
+
+// executeUpdateNetworkSecurityGroupWorkflow starts the Site workflow that updates a NetworkSecurityGroup with the given rules
+func (mv ManageNetworkSecurityGroup) executeUpdateNetworkSecurityGroupWorkflow(ctx context.Context, siteID uuid.UUID, networkSecurityGroup *cdbm.NetworkSecurityGroup, rules []*cwssaws.NetworkSecurityGroupRuleAttributes) (client.WorkflowRun, error) {
+	stc, err := mv.siteClientPool.GetClientByID(siteID)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []*cwssaws.Label{}
+	for k, v := range networkSecurityGroup.Labels {
+		labels = append(labels, &cwssaws.Label{
+			Key:   k,
+			Value: &v,
+		})
+	}
+
+	description := ""
+	if networkSecurityGroup.Description != nil {
+		description = *networkSecurityGroup.Description
+	}
+
+	updateNetworkSecurityGroupRequest := &cwssaws.UpdateNetworkSecurityGroupRequest{
+		Id:                   networkSecurityGroup.ID,
+		TenantOrganizationId: networkSecurityGroup.TenantOrg,
+		Metadata: &cwssaws.Metadata{
+			Name:        networkSecurityGroup.Name,
+			Description: description,
+			Labels:      labels,
+		},
+		NetworkSecurityGroupAttributes: &cwssaws.NetworkSecurityGroupAttributes{
+			StatefulEgress: networkSecurityGroup.StatefulEgress,
+			Rules:          rules,
+		},
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        "network-security-group-sync-" + networkSecurityGroup.ID,
+		TaskQueue: queue.SiteTaskQueue,
+	}
+
+	return stc.ExecuteWorkflow(ctx, workflowOptions, "UpdateNetworkSecurityGroup", updateNetworkSecurityGroupRequest)
+}
+
+// networkSecurityGroupRulesEqual returns whether two lists of rules are the same, in the same order
+func networkSecurityGroupRulesEqual(rules1, rules2 []*cwssaws.NetworkSecurityGroupRuleAttributes) bool {
+	if len(rules1) != len(rules2) {
+		return false
+	}
+
+	for i := range rules1 {
+		if !proto.Equal(rules1[i], rules2[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // NewManageNetworkSecurityGroup returns a new ManageNetworkSecurityGroup activity
 func NewManageNetworkSecurityGroup(dbSession *cdb.Session, siteClientPool *sc.ClientPool) ManageNetworkSecurityGroup {
 	return ManageNetworkSecurityGroup{
@@ -543,6 +543,127 @@ func TestManageNetworkSecurityGroup_UpdateNetworkSecurityGroupsInDB(t *testing.T
 
 }
 
+func TestManageNetworkSecurityGroup_References(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testNetworkSecurityGroupInitDB(t)
+	defer dbSession.Close()
+
+	testNetworkSecurityGroupSetupSchema(t, dbSession)
+	err := dbSession.DB.ResetModel(ctx, (*cdbm.AddressSet)(nil))
+	assert.Nil(t, err)
+
+	ipOrg := "test-provider-org"
+	ipu := testNetworkSecurityGroupBuildUser(t, dbSession, uuid.NewString(), ipOrg, []string{"FORGE_PROVIDER_ADMIN"})
+	ip := testNetworkSecurityGroupSiteBuildInfrastructureProvider(t, dbSession, "test-provider", ipOrg, ipu)
+	st := testNetworkSecurityGroupBuildSite(t, dbSession, ip, "test-site", ipu)
+
+	tnOrg := "test-tenant-org"
+	tnu := testNetworkSecurityGroupBuildUser(t, dbSession, uuid.NewString(), tnOrg, []string{"FORGE_TENANT_ADMIN"})
+	tn := testNetworkSecurityGroupBuildTenant(t, dbSession, "test tenant1", tnOrg, tnu)
+
+	asDAO := cdbm.NewAddressSetDAO(dbSession)
+	as, err := asDAO.Create(ctx, nil, cdbm.AddressSetCreateInput{Name: "corp", TenantOrg: tn.Org, TenantID: tn.ID, Prefixes: []string{"10.0.0.0/8"}, CreatedBy: tnu.ID})
+	assert.Nil(t, err)
+
+	networkSecurityGroupDAO := cdbm.NewNetworkSecurityGroupDAO(dbSession)
+	nsg, err := networkSecurityGroupDAO.Create(ctx, nil, cdbm.NetworkSecurityGroupCreateInput{
+		Name: "test-networkSecurityGroup", TenantOrg: tn.Org, TenantID: tn.ID, SiteID: st.ID, Status: cdbm.NetworkSecurityGroupStatusReady, CreatedByID: tnu.ID,
+		Rules: []*cdbm.NetworkSecurityGroupRule{
+			{
+				NetworkSecurityGroupRuleAttributes: &cwssaws.NetworkSecurityGroupRuleAttributes{
+					Id:             cdb.GetStrPtr("from-corp"),
+					DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+				},
+				SourceAddressSetID: cdb.GetStrPtr(as.ID.String()),
+			},
+		},
+	})
+	assert.Nil(t, err)
+
+	// Rules as Site knows them, with the reference resolved
+	siteRule := func(id string, prefix string) *cwssaws.NetworkSecurityGroupRuleAttributes {
+		return &cwssaws.NetworkSecurityGroupRuleAttributes{
+			Id:             cdb.GetStrPtr(id),
+			SourceNet:      &cwssaws.NetworkSecurityGroupRuleAttributes_SrcPrefix{SrcPrefix: prefix},
+			DestinationNet: &cwssaws.NetworkSecurityGroupRuleAttributes_DstPrefix{DstPrefix: "0.0.0.0/0"},
+		}
+	}
+
+	inventory := func(rules ...*cwssaws.NetworkSecurityGroupRuleAttributes) *cwssaws.NetworkSecurityGroupInventory {
+		return &cwssaws.NetworkSecurityGroupInventory{
+			NetworkSecurityGroups: []*cwssaws.NetworkSecurityGroup{
+				{
+					Id:                   nsg.ID,
+					Version:              uuid.NewString(),
+					TenantOrganizationId: tn.Org,
+					Metadata:             &cwssaws.Metadata{Name: nsg.Name},
+					Attributes:           &cwssaws.NetworkSecurityGroupAttributes{Rules: rules},
+				},
+			},
+		}
+	}
+
+	tSiteClientPool := testTemporalSiteClientPool(t)
+
+	mv := ManageNetworkSecurityGroup{
+		dbSession:      dbSession,
+		siteClientPool: tSiteClientPool,
+	}
+
+	// Site has the resolved rules, nothing is pushed and the reference is kept
+	mtc := &tmocks.Client{}
+	mv.siteClientPool.IDClientMap[st.ID.String()] = mtc
+
+	err = mv.UpdateNetworkSecurityGroupsInDB(ctx, st.ID, inventory(siteRule("from-corp", "10.0.0.0/8")))
+	assert.Nil(t, err)
+	mtc.AssertNotCalled(t, "ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	unsg, err := networkSecurityGroupDAO.GetByID(ctx, nil, nsg.ID, nil)
+	assert.Nil(t, err)
+	require.Equal(t, 1, len(unsg.Rules))
+	assert.Equal(t, as.ID.String(), *unsg.Rules[0].SourceAddressSetID)
+	assert.Nil(t, unsg.Rules[0].SourceNet)
+
+	// Address Set has changed, Site is out of date and the resolved rules are pushed
+	_, err = asDAO.Update(ctx, nil, cdbm.AddressSetUpdateInput{AddressSetID: as.ID, Prefixes: []string{"10.0.0.0/8", "172.16.0.0/12"}, UpdatedBy: tnu.ID})
+	assert.Nil(t, err)
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return("test-workflow-id")
+	wrun.On("Get", mock.Anything, mock.Anything).Return(nil)
+
+	expandedRules := mock.MatchedBy(func(req *cwssaws.UpdateNetworkSecurityGroupRequest) bool {
+		return networkSecurityGroupRulesEqual(req.NetworkSecurityGroupAttributes.Rules, []*cwssaws.NetworkSecurityGroupRuleAttributes{
+			siteRule("from-corp-1", "10.0.0.0/8"),
+			siteRule("from-corp-2", "172.16.0.0/12"),
+		})
+	})
+
+	mtc = &tmocks.Client{}
+	mtc.On("ExecuteWorkflow", mock.Anything, mock.Anything, "UpdateNetworkSecurityGroup", expandedRules).Return(wrun, nil)
+	mv.siteClientPool.IDClientMap[st.ID.String()] = mtc
+
+	err = mv.UpdateNetworkSecurityGroupsInDB(ctx, st.ID, inventory(siteRule("from-corp", "10.0.0.0/8")))
+	assert.Nil(t, err)
+	mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+
+	// Sync pushes the resolved rules and waits for the Site
+	mtc = &tmocks.Client{}
+	mtc.On("ExecuteWorkflow", mock.Anything, mock.Anything, "UpdateNetworkSecurityGroup", expandedRules).Return(wrun, nil)
+	mv.siteClientPool.IDClientMap[st.ID.String()] = mtc
+
+	err = mv.SyncNetworkSecurityGroupViaSiteAgent(ctx, st.ID, nsg.ID)
+	assert.Nil(t, err)
+	mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+	wrun.AssertCalled(t, "Get", mock.Anything, mock.Anything)
+
+	// Sync of a deleted NetworkSecurityGroup is a no-op
+	err = mv.SyncNetworkSecurityGroupViaSiteAgent(ctx, st.ID, uuid.NewString())
+	assert.Nil(t, err)
+	mtc.AssertNumberOfCalls(t, "ExecuteWorkflow", 1)
+}
+
 func TestNewManageNetworkSecurityGroup(t *testing.T) {
 	type args struct {
 		dbSession      *cdb.Session
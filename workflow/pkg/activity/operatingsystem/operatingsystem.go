@@ -99,11 +99,12 @@ func (mskg ManageOsImage) UpdateOsImagesInDB(ctx context.Context, siteID uuid.UU
 		return nil, err
 	}
 
-	// Construct a map ID of Operating System Site Association to Operating System
+	// Construct a map of Site image ID to Operating System Site Association
+	// Operating System Versions are synced to Site as images of their own, identified by the version ID
 	existingOsImageMap := make(map[string]*cdbm.OperatingSystemSiteAssociation)
 	for _, ossa := range existingOssas {
 		curossa := ossa
-		existingOsImageMap[ossa.OperatingSystemID.String()] = &curossa
+		existingOsImageMap[curossa.GetSiteImageID().String()] = &curossa
 	}
 
 	reportedOsImageIDMap := map[uuid.UUID]bool{}
@@ -138,7 +139,7 @@ func (mskg ManageOsImage) UpdateOsImagesInDB(ctx context.Context, siteID uuid.UU
 				continue
 			}
 
-			reportedOsImageIDMap[ossa.OperatingSystemID] = true
+			reportedOsImageIDMap[ossa.GetSiteImageID()] = true
 
 			// Reset missing flag if necessary
 			if ossa.IsMissingOnSite {
@@ -202,7 +203,7 @@ func (mskg ManageOsImage) UpdateOsImagesInDB(ctx context.Context, siteID uuid.UU
 	if osImageInventory.InventoryPage == nil || osImageInventory.InventoryPage.TotalPages == 0 || (osImageInventory.InventoryPage.CurrentPage == osImageInventory.InventoryPage.TotalPages) {
 		for _, ossa := range existingOsImageMap {
 			found := false
-			_, found = reportedOsImageIDMap[ossa.OperatingSystemID]
+			_, found = reportedOsImageIDMap[ossa.GetSiteImageID()]
 			if !found || ossa.Status == cdbm.OperatingSystemSiteAssociationStatusDeleting {
 				// The OS Image was not found in the Os Image Inventory, so add it to list of OS Image to potentially delete
 				ossasToDelete = append(ossasToDelete, ossa)
@@ -368,8 +369,13 @@ func (mskg ManageOsImage) UpdateOperatingSystemStatusInDB(ctx context.Context, o
 		osStatus = cdb.GetStrPtr(cdbm.OperatingSystemStatusReady)
 		osMessage = cdb.GetStrPtr("Operating System successfully synced to all Sites")
 	} else {
+		// Operating System status reflects the sync of the Operating System itself, version associations
+		// are tracked individually
 		statusCountMap := map[string]int{}
 		for _, dbossa := range ossas {
+			if dbossa.OperatingSystemVersionID != nil {
+				continue
+			}
 			statusCountMap[dbossa.Status]++
 		}
 
@@ -0,0 +1,466 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operatingsystem
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+
+	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+const (
+	// MsgOsVersionVerified is the status message recorded when an Operating System Version image matches its digest
+	MsgOsVersionVerified = "Operating System Version image verified successfully"
+)
+
+const (
+	// imageFetchTimeout bounds the total time spent fetching an image, it must be shorter than the StartToClose
+	// timeout of the verification activity
+	imageFetchTimeout = 110 * time.Minute
+	// imageFetchMaxRedirects is the maximum number of redirects followed when fetching an image
+	imageFetchMaxRedirects = 3
+	// imageFetchHeartbeatInterval is the minimum interval between heartbeats recorded while an image is read
+	imageFetchHeartbeatInterval = 10 * time.Second
+)
+
+var (
+	// errImageFetchAddressNotAllowed is returned when an image URL resolves to an address that must not be fetched from
+	errImageFetchAddressNotAllowed = errors.New("image URL resolves to a loopback, private, link-local or otherwise non-public address")
+
+	// imageFetchBlockedNetworks are non-public ranges not covered by net.IP.IsPrivate: carrier-grade NAT, which some
+	// clusters use for pod or service addresses, and benchmarking
+	imageFetchBlockedNetworks = []*net.IPNet{
+		mustParseCIDR("100.64.0.0/10"),
+		mustParseCIDR("198.18.0.0/15"),
+	}
+)
+
+// mustParseCIDR parses a CIDR that is known to be valid
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return ipNet
+}
+
+// checkImageFetchAddress rejects connections to addresses that are not publicly routable, so that image URLs
+// cannot be used to reach the cloud's own network or metadata services. It is invoked after name resolution
+// for every connection, including those made for redirects.
+func checkImageFetchAddress(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+		return fmt.Errorf("%w: %s", errImageFetchAddressNotAllowed, host)
+	}
+	for _, ipNet := range imageFetchBlockedNetworks {
+		if ipNet.Contains(ip) {
+			return fmt.Errorf("%w: %s", errImageFetchAddressNotAllowed, host)
+		}
+	}
+
+	return nil
+}
+
+// checkImageFetchRedirect limits the number of redirects followed when fetching an image and only allows HTTP(S) targets
+func checkImageFetchRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= imageFetchMaxRedirects {
+		return fmt.Errorf("stopped after %d redirects", imageFetchMaxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("redirect to unsupported URL scheme: %s", req.URL.Scheme)
+	}
+	return nil
+}
+
+// newImageFetchClient returns the HTTP client used to fetch Operating System Version images. Image URLs are user
+// supplied so the client only connects to public addresses, never uses a proxy and bounds redirects and total time.
+func newImageFetchClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   checkImageFetchAddress,
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 nil,
+			DialContext:           dialer.DialContext,
+			TLSHandshakeTimeout:   30 * time.Second,
+			ResponseHeaderTimeout: time.Minute,
+			MaxIdleConns:          1,
+			IdleConnTimeout:       30 * time.Second,
+		},
+		CheckRedirect: checkImageFetchRedirect,
+		Timeout:       imageFetchTimeout,
+	}
+}
+
+// heartbeatReader reports read progress through heartbeat at most once per imageFetchHeartbeatInterval
+type heartbeatReader struct {
+	reader    io.Reader
+	heartbeat func(read int64)
+	read      int64
+	last      time.Time
+}
+
+func (hr *heartbeatReader) Read(p []byte) (int, error) {
+	n, err := hr.reader.Read(p)
+	hr.read += int64(n)
+	if hr.heartbeat != nil && time.Since(hr.last) >= imageFetchHeartbeatInterval {
+		hr.last = time.Now()
+		hr.heartbeat(hr.read)
+	}
+	return n, err
+}
+
+// newImageDigestHash returns the hash matching the length of the hex encoded digest
+func newImageDigestHash(digest string) (hash.Hash, error) {
+	switch len(digest) {
+	case sha1.Size * 2:
+		return sha1.New(), nil
+	case sha256.Size * 2:
+		return sha256.New(), nil
+	case sha512.Size * 2:
+		return sha512.New(), nil
+	}
+	return nil, fmt.Errorf("unsupported image digest length: %d, expected a SHA1, SHA256 or SHA512 hex digest", len(digest))
+}
+
+// verifyImageDigest fetches the image at the given URL and checks that its content matches the expected hex encoded digest
+// If specified, heartbeat is invoked periodically with the number of bytes read so far
+// The returned error describes why the image could not be verified
+func verifyImageDigest(ctx context.Context, httpClient *http.Client, imageURL string, digest string, authType *string, authToken *string, heartbeat func(read int64)) error {
+	h, err := newImageDigestHash(digest)
+	if err != nil {
+		return err
+	}
+
+	parsedURL, err := url.Parse(imageURL)
+	if err != nil {
+		return fmt.Errorf("invalid image URL: %w", err)
+	}
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		return fmt.Errorf("invalid image URL, unsupported scheme: %s", parsedURL.Scheme)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid image URL: %w", err)
+	}
+
+	if authType != nil && authToken != nil {
+		req.Header.Set("Authorization", *authType+" "+*authToken)
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch image, server responded with status: %s", resp.Status)
+	}
+
+	_, err = io.Copy(h, &heartbeatReader{reader: resp.Body, heartbeat: heartbeat, last: time.Now()})
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+
+	computed := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(computed, digest) {
+		return fmt.Errorf("image digest mismatch, expected: %s, computed: %s", strings.ToLower(digest), computed)
+	}
+
+	return nil
+}
+
+// updateOperatingSystemVersionStatusInDB is helper function to write OperatingSystemVersion status updates to DB
+func (mskg ManageOsImage) updateOperatingSystemVersionStatusInDB(ctx context.Context, input cdbm.OperatingSystemVersionUpdateInput, statusMessage *string) error {
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(mskg.dbSession)
+
+	_, err := osvDAO.Update(ctx, nil, input)
+	if err != nil {
+		return err
+	}
+
+	statusDetailDAO := cdbm.NewStatusDetailDAO(mskg.dbSession)
+	_, err = statusDetailDAO.CreateFromParams(ctx, nil, input.OperatingSystemVersionID.String(), *input.Status, statusMessage)
+	return err
+}
+
+// VerifyOperatingSystemVersionImage is a Temporal activity that fetches the image of an Operating System Version,
+// checks it against the image digest and records the result. Returns true if the version is now Ready.
+func (mskg ManageOsImage) VerifyOperatingSystemVersionImage(ctx context.Context, osvID uuid.UUID) (bool, error) {
+	logger := log.With().Str("Activity", "VerifyOperatingSystemVersionImage").Str("Operating System Version ID", osvID.String()).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(mskg.dbSession)
+
+	osv, err := osvDAO.GetByID(ctx, nil, osvID, nil)
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			logger.Warn().Msg("Operating System Version no longer exists, nothing to verify")
+			return false, nil
+		}
+		logger.Error().Err(err).Msg("failed to retrieve Operating System Version from DB")
+		return false, err
+	}
+
+	if osv.Status == cdbm.OperatingSystemVersionStatusReady {
+		logger.Info().Msg("Operating System Version has already been verified")
+		return true, nil
+	}
+
+	err = mskg.updateOperatingSystemVersionStatusInDB(ctx, cdbm.OperatingSystemVersionUpdateInput{
+		OperatingSystemVersionID: osv.ID,
+		Status:                   cdb.GetStrPtr(cdbm.OperatingSystemVersionStatusVerifying),
+	}, cdb.GetStrPtr("Fetching image to verify digest"))
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to update Operating System Version status in DB")
+		return false, err
+	}
+
+	var verr error
+	if osv.ImageURL == nil || osv.ImageSHA == nil {
+		verr = fmt.Errorf("image URL and digest must be specified to verify Operating System Version")
+	} else {
+		verr = verifyImageDigest(ctx, newImageFetchClient(), *osv.ImageURL, *osv.ImageSHA, osv.ImageAuthType, osv.ImageAuthToken, func(read int64) {
+			activity.RecordHeartbeat(ctx, read)
+		})
+	}
+
+	if verr != nil {
+		logger.Warn().Err(verr).Msg("failed to verify Operating System Version image")
+
+		err = mskg.updateOperatingSystemVersionStatusInDB(ctx, cdbm.OperatingSystemVersionUpdateInput{
+			OperatingSystemVersionID: osv.ID,
+			Status:                   cdb.GetStrPtr(cdbm.OperatingSystemVersionStatusError),
+		}, cdb.GetStrPtr(verr.Error()))
+		if err != nil {
+			logger.Error().Err(err).Msg("failed to update Operating System Version status in DB")
+			return false, err
+		}
+		return false, nil
+	}
+
+	err = mskg.updateOperatingSystemVersionStatusInDB(ctx, cdbm.OperatingSystemVersionUpdateInput{
+		OperatingSystemVersionID: osv.ID,
+		Status:                   cdb.GetStrPtr(cdbm.OperatingSystemVersionStatusReady),
+		Verified:                 cdb.GetTimePtr(cdb.GetCurTime()),
+	}, cdb.GetStrPtr(MsgOsVersionVerified))
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to update Operating System Version status in DB")
+		return false, err
+	}
+
+	logger.Info().Msg("successfully completed activity")
+
+	return true, nil
+}
+
+// SyncOperatingSystemVersionToSites is a Temporal activity that creates the image of a verified Operating System Version
+// on every Site the Operating System is associated with
+func (mskg ManageOsImage) SyncOperatingSystemVersionToSites(ctx context.Context, osvID uuid.UUID) error {
+	logger := log.With().Str("Activity", "SyncOperatingSystemVersionToSites").Str("Operating System Version ID", osvID.String()).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	osvDAO := cdbm.NewOperatingSystemVersionDAO(mskg.dbSession)
+
+	osv, err := osvDAO.GetByID(ctx, nil, osvID, []string{cdbm.OperatingSystemRelationName})
+	if err != nil {
+		if err == cdb.ErrDoesNotExist {
+			logger.Warn().Msg("Operating System Version no longer exists, nothing to sync")
+			return nil
+		}
+		logger.Error().Err(err).Msg("failed to retrieve Operating System Version from DB")
+		return err
+	}
+
+	os := osv.OperatingSystem
+	if os == nil || os.Type != cdbm.OperatingSystemTypeImage || osv.Status != cdbm.OperatingSystemVersionStatusReady {
+		logger.Info().Msg("Operating System Version is not a verified image, nothing to sync")
+		return nil
+	}
+
+	if os.Status == cdbm.OperatingSystemStatusDeleting {
+		logger.Warn().Msg("Operating System is being deleted, cannot sync version to Sites")
+		return nil
+	}
+
+	tenantOrg := os.Org
+	if os.TenantID != nil {
+		tnDAO := cdbm.NewTenantDAO(mskg.dbSession)
+		tenant, serr := tnDAO.GetByID(ctx, nil, *os.TenantID, nil)
+		if serr != nil {
+			logger.Error().Err(serr).Msg("failed to retrieve Tenant for Operating System from DB")
+			return serr
+		}
+		tenantOrg = tenant.Org
+	}
+
+	ossaDAO := cdbm.NewOperatingSystemSiteAssociationDAO(mskg.dbSession)
+
+	// Versions are synced to the Sites the Operating System itself is associated with
+	baseOssas, _, err := ossaDAO.GetAll(ctx, nil, cdbm.OperatingSystemSiteAssociationFilterInput{
+		OperatingSystemIDs:        []uuid.UUID{os.ID},
+		HasOperatingSystemVersion: cdb.GetBoolPtr(false),
+	}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Operating System Site Associations from DB")
+		return err
+	}
+
+	versionOssas, _, err := ossaDAO.GetAll(ctx, nil, cdbm.OperatingSystemSiteAssociationFilterInput{
+		OperatingSystemVersionIDs: []uuid.UUID{osv.ID},
+	}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Operating System Version Site Associations from DB")
+		return err
+	}
+
+	versionOssaMap := map[uuid.UUID]*cdbm.OperatingSystemSiteAssociation{}
+	for _, ossa := range versionOssas {
+		curossa := ossa
+		versionOssaMap[ossa.SiteID] = &curossa
+	}
+
+	for _, baseOssa := range baseOssas {
+		if baseOssa.Status == cdbm.OperatingSystemSiteAssociationStatusDeleting {
+			continue
+		}
+
+		slogger := logger.With().Str("Site ID", baseOssa.SiteID.String()).Logger()
+
+		ossa, ok := versionOssaMap[baseOssa.SiteID]
+		if ok && ossa.Status != cdbm.OperatingSystemSiteAssociationStatusError {
+			continue
+		}
+
+		if !ok {
+			ossa, err = ossaDAO.Create(ctx, nil, cdbm.OperatingSystemSiteAssociationCreateInput{
+				OperatingSystemID:        os.ID,
+				OperatingSystemVersionID: &osv.ID,
+				SiteID:                   baseOssa.SiteID,
+				Status:                   cdbm.OperatingSystemSiteAssociationStatusSyncing,
+				CreatedBy:                osv.CreatedBy,
+			})
+			if err != nil {
+				slogger.Error().Err(err).Msg("failed to create Operating System Version Site Association in DB")
+				return err
+			}
+		}
+
+		ossa, err = ossaDAO.GenerateAndUpdateVersion(ctx, nil, ossa.ID)
+		if err != nil {
+			slogger.Error().Err(err).Msg("failed to generate Operating System Version Site Association version in DB")
+			return err
+		}
+
+		err = mskg.updateOperatingSystemSiteAssociationStatusInDB(ctx, nil, ossa.ID, cdb.GetStrPtr(cdbm.OperatingSystemSiteAssociationStatusSyncing), cdb.GetStrPtr("Operating System Version is syncing to Site"))
+		if err != nil {
+			slogger.Error().Err(err).Msg("failed to update Operating System Version Site Association status in DB")
+			return err
+		}
+
+		serr := mskg.createOsVersionImageOnSite(ctx, os, osv, tenantOrg, ossa)
+		if serr != nil {
+			// Record the failure and move on to the next Site, the sync can be retried by verifying the version again
+			slogger.Error().Err(serr).Msg("failed to create Operating System Version image on Site")
+
+			err = mskg.updateOperatingSystemSiteAssociationStatusInDB(ctx, nil, ossa.ID, cdb.GetStrPtr(cdbm.OperatingSystemSiteAssociationStatusError), cdb.GetStrPtr(fmt.Sprintf("Failed to create Operating System Version image on Site: %s", serr)))
+			if err != nil {
+				slogger.Error().Err(err).Msg("failed to update Operating System Version Site Association status in DB")
+				return err
+			}
+		}
+	}
+
+	logger.Info().Msg("successfully completed activity")
+
+	return nil
+}
+
+// createOsVersionImageOnSite executes the Site workflow that creates the image of an Operating System Version,
+// the image is identified on Site by the version ID
+func (mskg ManageOsImage) createOsVersionImageOnSite(ctx context.Context, os *cdbm.OperatingSystem, osv *cdbm.OperatingSystemVersion, tenantOrg string, ossa *cdbm.OperatingSystemSiteAssociation) error {
+	stc, err := mskg.siteClientPool.GetClientByID(ossa.SiteID)
+	if err != nil {
+		return err
+	}
+
+	name := os.Name + " " + osv.Version
+
+	createOsRequest := &cwssaws.OsImageAttributes{
+		Id:                   &cwssaws.UUID{Value: osv.ID.String()},
+		Name:                 &name,
+		TenantOrganizationId: tenantOrg,
+		Description:          os.Description,
+		SourceUrl:            *osv.ImageURL,
+		Digest:               *osv.ImageSHA,
+		CreateVolume:         os.EnableBlockStorage,
+		AuthType:             osv.ImageAuthType,
+		AuthToken:            osv.ImageAuthToken,
+		RootfsId:             osv.RootFsID,
+		RootfsLabel:          osv.RootFsLabel,
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        "image-os-version-create-" + ossa.SiteID.String() + "-" + osv.ID.String() + "-" + *ossa.Version,
+		TaskQueue: queue.SiteTaskQueue,
+	}
+
+	we, err := stc.ExecuteWorkflow(ctx, workflowOptions, "CreateOsImage", createOsRequest)
+	if err != nil {
+		return err
+	}
+
+	// Block until the workflow has completed and returned success/error.
+	return we.Get(ctx, nil)
+}
@@ -0,0 +1,172 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operatingsystem
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+)
+
+func Test_verifyImageDigest(t *testing.T) {
+	image := []byte("qcow2 image content")
+	sum := sha256.Sum256(image)
+	digest := hex.EncodeToString(sum[:])
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/private.qcow2" && r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/missing.qcow2" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(image)
+	}))
+	defer ts.Close()
+
+	tests := []struct {
+		name      string
+		path      string
+		digest    string
+		authType  *string
+		authToken *string
+		wantErr   string
+	}{
+		{
+			name:   "matching digest",
+			path:   "/image.qcow2",
+			digest: digest,
+		},
+		{
+			name:   "matching digest in upper case",
+			path:   "/image.qcow2",
+			digest: strings.ToUpper(digest),
+		},
+		{
+			name:      "matching digest with auth",
+			path:      "/private.qcow2",
+			digest:    digest,
+			authType:  cdb.GetStrPtr("Bearer"),
+			authToken: cdb.GetStrPtr("secret"),
+		},
+		{
+			name:    "missing auth",
+			path:    "/private.qcow2",
+			digest:  digest,
+			wantErr: "401",
+		},
+		{
+			name:    "image not found",
+			path:    "/missing.qcow2",
+			digest:  digest,
+			wantErr: "404",
+		},
+		{
+			name:    "digest mismatch",
+			path:    "/image.qcow2",
+			digest:  strings.Repeat("a", 64),
+			wantErr: "image digest mismatch",
+		},
+		{
+			name:    "unsupported URL scheme",
+			path:    "file:///etc/passwd",
+			digest:  digest,
+			wantErr: "unsupported scheme",
+		},
+		{
+			name:    "unsupported digest length",
+			path:    "/image.qcow2",
+			digest:  "abcd",
+			wantErr: "unsupported image digest length",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url := ts.URL + tt.path
+			if strings.Contains(tt.path, "://") {
+				url = tt.path
+			}
+			err := verifyImageDigest(context.Background(), ts.Client(), url, tt.digest, tt.authType, tt.authToken, nil)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_verifyImageDigest_heartbeat(t *testing.T) {
+	image := []byte("qcow2 image content")
+	sum := sha256.Sum256(image)
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(image)
+	}))
+	defer ts.Close()
+
+	var heartbeats []int64
+	hr := &heartbeatReader{reader: strings.NewReader(string(image)), heartbeat: func(read int64) { heartbeats = append(heartbeats, read) }}
+	_, err := io.ReadAll(hr)
+	require.NoError(t, err)
+	require.NotEmpty(t, heartbeats)
+	assert.Equal(t, int64(len(image)), heartbeats[len(heartbeats)-1])
+
+	heartbeats = nil
+	err = verifyImageDigest(context.Background(), ts.Client(), ts.URL+"/image.qcow2", hex.EncodeToString(sum[:]), nil, nil, func(read int64) { heartbeats = append(heartbeats, read) })
+	assert.NoError(t, err)
+}
+
+func Test_newImageFetchClient(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, r.URL.Path+"/next", http.StatusFound)
+	}))
+	defer ts.Close()
+
+	// Loopback, private, link-local, carrier-grade NAT and benchmarking addresses are never fetched from
+	for _, imageURL := range []string{ts.URL + "/image.qcow2", "http://10.0.0.1/image.qcow2", "http://169.254.169.254/latest/meta-data", "http://[::1]/image.qcow2",
+		"http://100.64.0.1/image.qcow2", "http://100.127.255.254/image.qcow2", "http://198.18.0.1/image.qcow2", "http://198.19.255.254/image.qcow2"} {
+		err := verifyImageDigest(context.Background(), newImageFetchClient(), imageURL, strings.Repeat("a", 64), nil, nil, nil)
+		assert.ErrorIs(t, err, errImageFetchAddressNotAllowed, imageURL)
+	}
+
+	assert.NoError(t, checkImageFetchAddress("tcp4", "8.8.8.8:443", nil))
+	assert.ErrorIs(t, checkImageFetchAddress("tcp4", "192.168.1.1:443", nil), errImageFetchAddressNotAllowed)
+	assert.ErrorIs(t, checkImageFetchAddress("tcp6", "[fe80::1]:443", nil), errImageFetchAddressNotAllowed)
+	// Addresses just outside of the carrier-grade NAT and benchmarking ranges are public
+	assert.NoError(t, checkImageFetchAddress("tcp4", "100.128.0.1:443", nil))
+	assert.NoError(t, checkImageFetchAddress("tcp4", "198.20.0.1:443", nil))
+
+	// Redirects are bounded
+	client := ts.Client()
+	client.CheckRedirect = checkImageFetchRedirect
+	err := verifyImageDigest(context.Background(), client, ts.URL+"/image.qcow2", strings.Repeat("a", 64), nil, nil, nil)
+	assert.ErrorContains(t, err, "stopped after 3 redirects")
+}
@@ -36,6 +36,7 @@ import (
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
 	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 	csm "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/sitemgr"
+	csmtypes "github.com/nvidia/bare-metal-manager-rest/site-manager/pkg/types"
 
 	"github.com/nvidia/bare-metal-manager-rest/workflow/internal/config"
 	sc "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/client/site"
@@ -663,6 +664,68 @@ func (mst ManageSite) UpdateAgentCertExpiry(ctx context.Context, siteID uuid.UUI
 	return nil
 }
 
+// SyncBootstrapStatusForAllSites mirrors the bootstrap lifecycle status tracked by Site Manager into the Site records
+func (mst ManageSite) SyncBootstrapStatusForAllSites(ctx context.Context) error {
+	logger := log.With().Str("Activity", "SyncBootstrapStatusForAllSites").Logger()
+
+	logger.Info().Msg("starting activity")
+
+	siteMgrURL := mst.cfg.GetSiteManagerEndpoint()
+	if siteMgrURL == "" {
+		logger.Warn().Msg("Site Manager endpoint not configured, skipping bootstrap status sync")
+		return nil
+	}
+
+	stDAO := cdbm.NewSiteDAO(mst.dbSession)
+	sites, _, err := stDAO.GetAll(ctx, nil, cdbm.SiteFilterInput{}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("Error retrieving Sites from DB")
+		return err
+	}
+
+	for _, site := range sites {
+		status, err := csm.GetSiteStatus(ctx, logger, site.ID.String(), siteMgrURL)
+		if err != nil {
+			if !errors.Is(err, csm.ErrSiteNotFound) {
+				logger.Error().Err(err).Str("siteUUID", site.ID.String()).Msg("Failed to retrieve bootstrap status from Site Manager")
+			}
+			continue
+		}
+
+		_, err = stDAO.Update(ctx, nil, cdbm.SiteUpdateInput{
+			SiteID:          site.ID,
+			BootstrapStatus: newSiteBootstrapStatus(status, time.Now()),
+		})
+		if err != nil {
+			logger.Error().Err(err).Str("siteUUID", site.ID.String()).Msg("Failed to update bootstrap status in DB")
+		}
+	}
+
+	logger.Info().Msg("successfully completed activity")
+
+	return nil
+}
+
+// newSiteBootstrapStatus converts a Site Manager status response into its DB representation
+func newSiteBootstrapStatus(status *csmtypes.SiteStatusResponse, synced time.Time) *cdbm.SiteBootstrapStatus {
+	bs := &cdbm.SiteBootstrapStatus{
+		State:                  status.BootstrapState,
+		OTPExpiry:              status.OTPExpiry,
+		LastCredsFetch:         status.LastCredsFetch,
+		LastCredsFetchSourceIP: status.LastCredsFetchSourceIP,
+		LastCredsFetchMethod:   status.LastCredsFetchMethod,
+		CertSerial:             status.CertSerial,
+		CertExpiry:             status.CertExpiry,
+		AgentVersion:           status.AgentVersion,
+		Synced:                 synced,
+	}
+	for _, roll := range status.Rolls {
+		bs.Rolls = append(bs.Rolls, cdbm.SiteBootstrapRoll{Time: roll.Time, PreviousState: roll.PreviousState})
+	}
+
+	return bs
+}
+
 // DeleteOrphanedSiteTemporalNamespaces finds and deletes orphaned Temporal namespaces for sites
 func (mst ManageSite) DeleteOrphanedSiteTemporalNamespaces(ctx context.Context) error {
 	logger := log.With().Str("activity", "DeleteOrphanedSiteTemporalNamespaces").Logger()
@@ -1202,6 +1202,107 @@ func TestManageSite_CheckOTPExpirationAndRenewForAllSites_MoreThanDefaultPageSiz
 	assert.Equal(t, siteCount, len(mockTemporalClient.Calls), "Expected Temporal client to be called for all sites")
 }
 
+func TestManageSite_SyncBootstrapStatusForAllSites(t *testing.T) {
+	ctx := context.Background()
+
+	dbSession := testSiteInitDB(t)
+	defer dbSession.Close()
+
+	// Initialize schema and mock data
+	util.TestSetupSchema(t, dbSession)
+
+	ipOrg := "test-provider-org-1"
+	ipRoles := []string{"FORGE_PROVIDER_ADMIN"}
+
+	ipu := util.TestBuildUser(t, dbSession, uuid.New().String(), []string{ipOrg}, ipRoles)
+	ip := util.TestBuildInfrastructureProvider(t, dbSession, "testIP", ipOrg, ipu)
+
+	site1 := util.TestBuildSite(t, dbSession, ip, "test-site-1", cdbm.SiteStatusRegistered, nil, ipu)
+	site2 := util.TestBuildSite(t, dbSession, ip, "test-site-2", cdbm.SiteStatusPending, nil, ipu)
+
+	// Mock Site Manager, which only knows about site1
+	certExpiry := time.Now().Add(90 * 24 * time.Hour).UTC().Truncate(time.Second)
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/"+site1.ID.String()+"/status" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"siteuuid": "` + site1.ID.String() + `",
+			"bootstrapstate": "RegistrationComplete",
+			"lastcredsfetchsourceip": "10.0.0.1",
+			"lastcredsfetchmethod": "renew",
+			"certserial": "1f",
+			"certexpiry": "` + certExpiry.Format(time.RFC3339) + `",
+			"agentversion": "v1.2.3",
+			"rolls": [{"time": "` + time.Now().UTC().Format(time.RFC3339) + `", "previousstate": "AwaitHandshake"}]
+		}`))
+	}))
+	defer testServer.Close()
+
+	tests := []struct {
+		name          string
+		endpoint      string
+		wantErr       bool
+		wantBootstrap map[uuid.UUID]bool
+	}{
+		{
+			name:     "Test bootstrap status sync skipped without Site Manager endpoint",
+			endpoint: "",
+			wantErr:  false,
+			wantBootstrap: map[uuid.UUID]bool{
+				site1.ID: false,
+				site2.ID: false,
+			},
+		},
+		{
+			name:     "Test bootstrap status sync for all sites",
+			endpoint: testServer.URL,
+			wantErr:  false,
+			wantBootstrap: map[uuid.UUID]bool{
+				site1.ID: true,
+				site2.ID: false,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewConfig()
+			cfg.SetSiteManagerEndpoint(tt.endpoint)
+
+			mst := ManageSite{
+				dbSession: dbSession,
+				cfg:       cfg,
+			}
+
+			err := mst.SyncBootstrapStatusForAllSites(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SyncBootstrapStatusForAllSites() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			siteDAO := cdbm.NewSiteDAO(dbSession)
+			for siteID, wantBootstrap := range tt.wantBootstrap {
+				site, err := siteDAO.GetByID(ctx, nil, siteID, nil, false)
+				assert.NoError(t, err)
+				if !wantBootstrap {
+					assert.Nil(t, site.BootstrapStatus)
+					continue
+				}
+				assert.NotNil(t, site.BootstrapStatus)
+				assert.Equal(t, "RegistrationComplete", site.BootstrapStatus.State)
+				assert.Equal(t, "10.0.0.1", site.BootstrapStatus.LastCredsFetchSourceIP)
+				assert.Equal(t, "1f", site.BootstrapStatus.CertSerial)
+				assert.Equal(t, "v1.2.3", site.BootstrapStatus.AgentVersion)
+				assert.True(t, certExpiry.Equal(*site.BootstrapStatus.CertExpiry))
+				assert.Len(t, site.BootstrapStatus.Rolls, 1)
+			}
+		})
+	}
+}
+
 func TestManageSite_UpdateAgentCertExpiry_Activity(t *testing.T) {
 	ctx := context.Background()
 
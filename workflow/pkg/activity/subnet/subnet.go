@@ -417,6 +417,7 @@ func (ms ManageSubnet) UpdateSubnetsInDB(ctx context.Context, siteID uuid.UUID,
 	}
 
 	reportedSubnetIDMap := map[uuid.UUID]bool{}
+	removedSubnetIDMap := map[uuid.UUID]bool{}
 
 	if subnetInventory.InventoryPage != nil {
 		logger.Info().Msgf("Received Subnet inventory page: %d of %d, page size: %d, total count: %d",
@@ -431,6 +432,15 @@ func (ms ManageSubnet) UpdateSubnetsInDB(ctx context.Context, siteID uuid.UUID,
 			}
 			reportedSubnetIDMap[id] = true
 		}
+
+		for _, strId := range subnetInventory.InventoryPage.RemovedItemIds {
+			id, serr := uuid.Parse(strId)
+			if serr != nil {
+				logger.Error().Err(serr).Str("ID", strId).Msg("failed to parse removed Subnet ID from inventory page")
+				continue
+			}
+			removedSubnetIDMap[id] = true
+		}
 	}
 
 	// Iterate through Subnet Inventory and update DB
@@ -532,10 +542,19 @@ func (ms ManageSubnet) UpdateSubnetsInDB(ctx context.Context, siteID uuid.UUID,
 		for _, subnet := range existingSubnetIDMap {
 			found := false
 
-			_, found = reportedSubnetIDMap[subnet.ID]
-			if !found && subnet.ControllerNetworkSegmentID != nil {
-				// Additional check if controller Segment ID != Subnet ID
-				_, found = reportedSubnetIDMap[*subnet.ControllerNetworkSegmentID]
+			if subnetInventory.GetInventoryPage().GetDelta() {
+				// Delta inventory only reports the Subnets removed since the previous publication
+				removed := removedSubnetIDMap[subnet.ID]
+				if subnet.ControllerNetworkSegmentID != nil {
+					removed = removed || removedSubnetIDMap[*subnet.ControllerNetworkSegmentID]
+				}
+				found = !removed
+			} else {
+				_, found = reportedSubnetIDMap[subnet.ID]
+				if !found && subnet.ControllerNetworkSegmentID != nil {
+					// Additional check if controller Segment ID != Subnet ID
+					_, found = reportedSubnetIDMap[*subnet.ControllerNetworkSegmentID]
+				}
 			}
 
 			if !found {
@@ -0,0 +1,303 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usage
+
+import (
+	"bytes"
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
+)
+
+const (
+	// StatusDetailBatchSize is the number of Instances whose status history is read from DB at a time
+	StatusDetailBatchSize = 500
+)
+
+// InstanceBillableStatuses are the Instance statuses during which a Tenant accrues instance-hours
+var InstanceBillableStatuses = map[string]bool{
+	cdbm.InstanceStatusReady:    true,
+	cdbm.InstanceStatusUpdating: true,
+}
+
+// ManageTenantUsage is an activity wrapper for Tenant usage metering
+type ManageTenantUsage struct {
+	dbSession *cdb.Session
+}
+
+// RollupTenantUsage is a Temporal activity that computes the usage of each Tenant for the UTC day containing
+// usageDate and replaces the stored rollup for that day. Returns the number of usage rows stored.
+func (mtu ManageTenantUsage) RollupTenantUsage(ctx context.Context, usageDate time.Time) (int, error) {
+	start := time.Date(usageDate.Year(), usageDate.Month(), usageDate.Day(), 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+
+	logger := log.With().Str("Activity", "RollupTenantUsage").Str("Usage Date", start.Format(time.DateOnly)).Logger()
+
+	logger.Info().Msg("starting activity")
+
+	// A day that has not ended yet is only metered up to now
+	if now := time.Now().UTC(); now.Before(end) {
+		end = now
+	}
+
+	inDAO := cdbm.NewInstanceDAO(mtu.dbSession)
+	instances, err := inDAO.GetAllExistingBetween(ctx, nil, start, end)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Instances from DB")
+		return 0, err
+	}
+
+	sdsByInstanceID, err := mtu.getStatusHistory(ctx, instances)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Instance status history from DB")
+		return 0, err
+	}
+
+	acDAO := cdbm.NewAllocationConstraintDAO(mtu.dbSession)
+	acs, err := acDAO.GetAllExistingBetween(ctx, nil, cdbm.AllocationResourceTypeInstanceType, start, end)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to retrieve Allocation Constraints from DB")
+		return 0, err
+	}
+
+	inputs := computeTenantUsage(start, end, instances, sdsByInstanceID, acs)
+
+	tx, err := cdb.BeginTx(ctx, mtu.dbSession, nil)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to start transaction")
+		return 0, err
+	}
+
+	txCommitted := false
+	defer func(dbTx *cdb.Tx, committed *bool) {
+		if committed != nil && !*committed {
+			dbTx.Rollback()
+		}
+	}(tx, &txCommitted)
+
+	tuDAO := cdbm.NewTenantUsageDAO(mtu.dbSession)
+	tus, err := tuDAO.ReplaceForDate(ctx, tx, start, inputs)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to store Tenant usage in DB")
+		return 0, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to commit Tenant usage to DB")
+		return 0, err
+	}
+	txCommitted = true
+
+	logger.Info().Int("Instances", len(instances)).Int("Allocation Constraints", len(acs)).Int("Rows", len(tus)).Msg("successfully completed activity")
+
+	return len(tus), nil
+}
+
+// getStatusHistory returns the status history of the given Instances, oldest first, keyed by Instance ID
+func (mtu ManageTenantUsage) getStatusHistory(ctx context.Context, instances []cdbm.Instance) (map[string][]cdbm.StatusDetail, error) {
+	sdsByInstanceID := map[string][]cdbm.StatusDetail{}
+
+	sdDAO := cdbm.NewStatusDetailDAO(mtu.dbSession)
+	orderBy := &cdbp.OrderBy{Field: cdbm.StatusDetailOrderByDefault, Order: cdbp.OrderAscending}
+
+	for i := 0; i < len(instances); i += StatusDetailBatchSize {
+		batch := instances[i:min(i+StatusDetailBatchSize, len(instances))]
+
+		entityIDs := make([]string, 0, len(batch))
+		for _, inst := range batch {
+			entityIDs = append(entityIDs, inst.ID.String())
+		}
+
+		sds, _, err := sdDAO.GetAllByEntityIDs(ctx, nil, entityIDs, nil, cdb.GetIntPtr(cdbp.TotalLimit), orderBy)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sd := range sds {
+			sdsByInstanceID[sd.EntityID] = append(sdsByInstanceID[sd.EntityID], sd)
+		}
+	}
+
+	return sdsByInstanceID, nil
+}
+
+// usageKey identifies a Tenant usage row
+type usageKey struct {
+	tenantID                 uuid.UUID
+	infrastructureProviderID uuid.UUID
+	siteID                   uuid.UUID
+	instanceTypeID           uuid.UUID // uuid.Nil for Instances without an Instance Type
+}
+
+// computeTenantUsage computes instance-hours from the status history of each Instance and allocated machine-hours
+// from the Allocation Constraints, both clipped to [start, end), and returns one row per Tenant, Site and Instance Type
+func computeTenantUsage(start time.Time, end time.Time, instances []cdbm.Instance, sdsByInstanceID map[string][]cdbm.StatusDetail, acs []cdbm.AllocationConstraint) []cdbm.TenantUsageCreateInput {
+	usage := map[usageKey]*cdbm.TenantUsageCreateInput{}
+
+	getUsage := func(key usageKey) *cdbm.TenantUsageCreateInput {
+		tu, ok := usage[key]
+		if !ok {
+			tu = &cdbm.TenantUsageCreateInput{
+				TenantID:                 key.tenantID,
+				InfrastructureProviderID: key.infrastructureProviderID,
+				SiteID:                   key.siteID,
+			}
+			if key.instanceTypeID != uuid.Nil {
+				tu.InstanceTypeID = cdb.GetUUIDPtr(key.instanceTypeID)
+			}
+			usage[key] = tu
+		}
+		return tu
+	}
+
+	for _, inst := range instances {
+		hours := instanceBillableHours(start, end, inst, sdsByInstanceID[inst.ID.String()])
+		if hours == 0 {
+			continue
+		}
+
+		key := usageKey{tenantID: inst.TenantID, infrastructureProviderID: inst.InfrastructureProviderID, siteID: inst.SiteID}
+		if inst.InstanceTypeID != nil {
+			key.instanceTypeID = *inst.InstanceTypeID
+		}
+
+		tu := getUsage(key)
+		tu.InstanceCount++
+		tu.InstanceHours += hours
+	}
+
+	for _, ac := range acs {
+		if ac.Allocation == nil {
+			continue
+		}
+
+		// A constraint ends when it or its Allocation is deleted
+		deleted := ac.Deleted
+		if ac.Allocation.Deleted != nil && (deleted == nil || ac.Allocation.Deleted.Before(*deleted)) {
+			deleted = ac.Allocation.Deleted
+		}
+
+		hours := overlapHours(start, end, ac.Created, deleted)
+		if hours == 0 {
+			continue
+		}
+
+		tu := getUsage(usageKey{
+			tenantID:                 ac.Allocation.TenantID,
+			infrastructureProviderID: ac.Allocation.InfrastructureProviderID,
+			siteID:                   ac.Allocation.SiteID,
+			instanceTypeID:           ac.ResourceTypeID,
+		})
+		tu.AllocatedHours += float64(ac.ConstraintValue) * hours
+		if deleted == nil || !deleted.Before(end) {
+			tu.AllocatedCount += ac.ConstraintValue
+		}
+	}
+
+	inputs := make([]cdbm.TenantUsageCreateInput, 0, len(usage))
+	for _, tu := range usage {
+		tu.InstanceHours = roundHours(tu.InstanceHours)
+		tu.AllocatedHours = roundHours(tu.AllocatedHours)
+		inputs = append(inputs, *tu)
+	}
+
+	// Sort for a stable order of rows
+	sort.Slice(inputs, func(i, j int) bool {
+		return compareUsageInputs(inputs[i], inputs[j]) < 0
+	})
+
+	return inputs
+}
+
+// instanceBillableHours returns the hours in [start, end) the Instance spent in a billable status.
+// Each status lasts until the next status transition or until the Instance was deleted.
+func instanceBillableHours(start time.Time, end time.Time, inst cdbm.Instance, sds []cdbm.StatusDetail) float64 {
+	// Instances without status history are assumed to have been in their current status since creation
+	if len(sds) == 0 {
+		sds = []cdbm.StatusDetail{{Status: inst.Status, Created: inst.Created}}
+	}
+
+	hours := 0.0
+	for i, sd := range sds {
+		if !InstanceBillableStatuses[sd.Status] {
+			continue
+		}
+
+		until := inst.Deleted
+		if i+1 < len(sds) && (until == nil || sds[i+1].Created.Before(*until)) {
+			until = &sds[i+1].Created
+		}
+
+		hours += overlapHours(start, end, sd.Created, until)
+	}
+
+	return hours
+}
+
+// overlapHours returns the hours that [from, until) overlaps [start, end), a nil until is open ended
+func overlapHours(start time.Time, end time.Time, from time.Time, until *time.Time) float64 {
+	if from.After(start) {
+		start = from
+	}
+	if until != nil && until.Before(end) {
+		end = *until
+	}
+	if !start.Before(end) {
+		return 0
+	}
+	return end.Sub(start).Hours()
+}
+
+// roundHours rounds hours to four decimal places, well below a second
+func roundHours(hours float64) float64 {
+	return math.Round(hours*1e4) / 1e4
+}
+
+// compareUsageInputs orders usage rows by Tenant, Site and Instance Type
+func compareUsageInputs(a, b cdbm.TenantUsageCreateInput) int {
+	if c := bytes.Compare(a.TenantID[:], b.TenantID[:]); c != 0 {
+		return c
+	}
+	if c := bytes.Compare(a.SiteID[:], b.SiteID[:]); c != 0 {
+		return c
+	}
+	var ait, bit uuid.UUID
+	if a.InstanceTypeID != nil {
+		ait = *a.InstanceTypeID
+	}
+	if b.InstanceTypeID != nil {
+		bit = *b.InstanceTypeID
+	}
+	return bytes.Compare(ait[:], bit[:])
+}
+
+// NewManageTenantUsage returns a new ManageTenantUsage activity
+func NewManageTenantUsage(dbSession *cdb.Session) ManageTenantUsage {
+	return ManageTenantUsage{
+		dbSession: dbSession,
+	}
+}
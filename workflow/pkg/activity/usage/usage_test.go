@@ -0,0 +1,169 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestInstanceBillableHours(t *testing.T) {
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	at := func(hours int) time.Time { return start.Add(time.Duration(hours) * time.Hour) }
+
+	tests := []struct {
+		desc     string
+		inst     cdbm.Instance
+		sds      []cdbm.StatusDetail
+		expected float64
+	}{
+		{
+			desc: "Ready for the whole day",
+			inst: cdbm.Instance{Created: at(-48)},
+			sds: []cdbm.StatusDetail{
+				{Status: cdbm.InstanceStatusProvisioning, Created: at(-48)},
+				{Status: cdbm.InstanceStatusReady, Created: at(-47)},
+			},
+			expected: 24,
+		},
+		{
+			desc: "provisioned during the day",
+			inst: cdbm.Instance{Created: at(2)},
+			sds: []cdbm.StatusDetail{
+				{Status: cdbm.InstanceStatusPending, Created: at(2)},
+				{Status: cdbm.InstanceStatusProvisioning, Created: at(3)},
+				{Status: cdbm.InstanceStatusReady, Created: at(6)},
+			},
+			expected: 18,
+		},
+		{
+			desc: "updating counts, error does not",
+			inst: cdbm.Instance{Created: at(-1)},
+			sds: []cdbm.StatusDetail{
+				{Status: cdbm.InstanceStatusReady, Created: at(-1)},
+				{Status: cdbm.InstanceStatusUpdating, Created: at(10)},
+				{Status: cdbm.InstanceStatusError, Created: at(12)},
+				{Status: cdbm.InstanceStatusReady, Created: at(20)},
+			},
+			expected: 16,
+		},
+		{
+			desc: "terminated and deleted during the day",
+			inst: cdbm.Instance{Created: at(-10), Deleted: cdb.GetTimePtr(at(9))},
+			sds: []cdbm.StatusDetail{
+				{Status: cdbm.InstanceStatusReady, Created: at(-10)},
+				{Status: cdbm.InstanceStatusTerminating, Created: at(8)},
+			},
+			expected: 8,
+		},
+		{
+			desc:     "deleted while Ready",
+			inst:     cdbm.Instance{Created: at(-10), Deleted: cdb.GetTimePtr(at(5))},
+			sds:      []cdbm.StatusDetail{{Status: cdbm.InstanceStatusReady, Created: at(-10)}},
+			expected: 5,
+		},
+		{
+			desc:     "no status history uses current status",
+			inst:     cdbm.Instance{Created: at(12), Status: cdbm.InstanceStatusReady},
+			expected: 12,
+		},
+		{
+			desc:     "no status history and not billable",
+			inst:     cdbm.Instance{Created: at(12), Status: cdbm.InstanceStatusPending},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.Equal(t, tc.expected, instanceBillableHours(start, end, tc.inst, tc.sds))
+		})
+	}
+}
+
+func TestComputeTenantUsage(t *testing.T) {
+	start := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	at := func(hours int) time.Time { return start.Add(time.Duration(hours) * time.Hour) }
+
+	tenant := uuid.New()
+	provider := uuid.New()
+	site := uuid.New()
+	instanceType := uuid.New()
+
+	newInstance := func(created time.Time, instanceTypeID *uuid.UUID) cdbm.Instance {
+		return cdbm.Instance{
+			ID:                       uuid.New(),
+			TenantID:                 tenant,
+			InfrastructureProviderID: provider,
+			SiteID:                   site,
+			InstanceTypeID:           instanceTypeID,
+			Status:                   cdbm.InstanceStatusReady,
+			Created:                  created,
+		}
+	}
+
+	i1 := newInstance(at(-24), &instanceType)
+	i2 := newInstance(at(12), &instanceType)
+	i3 := newInstance(at(18), nil)
+	i4 := newInstance(at(-24), &instanceType)
+
+	sdsByInstanceID := map[string][]cdbm.StatusDetail{
+		// Never became Ready, does not count as used
+		i4.ID.String(): {{Status: cdbm.InstanceStatusError, Created: at(-24)}},
+	}
+
+	alloc := &cdbm.Allocation{TenantID: tenant, InfrastructureProviderID: provider, SiteID: site}
+	deletedAlloc := &cdbm.Allocation{TenantID: tenant, InfrastructureProviderID: provider, SiteID: site, Deleted: cdb.GetTimePtr(at(6))}
+
+	acs := []cdbm.AllocationConstraint{
+		{Allocation: alloc, ResourceTypeID: instanceType, ConstraintValue: 4, Created: at(-48)},
+		{Allocation: alloc, ResourceTypeID: instanceType, ConstraintValue: 2, Created: at(12), Deleted: cdb.GetTimePtr(at(18))},
+		{Allocation: deletedAlloc, ResourceTypeID: instanceType, ConstraintValue: 10, Created: at(-48)},
+	}
+
+	got := computeTenantUsage(start, end, []cdbm.Instance{i1, i2, i3, i4}, sdsByInstanceID, acs)
+
+	expected := []cdbm.TenantUsageCreateInput{
+		{
+			TenantID:                 tenant,
+			InfrastructureProviderID: provider,
+			SiteID:                   site,
+			InstanceCount:            1,
+			InstanceHours:            6,
+		},
+		{
+			TenantID:                 tenant,
+			InfrastructureProviderID: provider,
+			SiteID:                   site,
+			InstanceTypeID:           &instanceType,
+			InstanceCount:            2,
+			InstanceHours:            36,
+			AllocatedCount:           4,
+			AllocatedHours:           4*24 + 2*6 + 10*6,
+		},
+	}
+	assert.Equal(t, expected, got)
+}
@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+const (
+	// AuditCEFVendor is the device vendor reported in CEF audit records
+	AuditCEFVendor = "NVIDIA"
+	// AuditCEFProduct is the device product reported in CEF audit records
+	AuditCEFProduct = "Bare Metal Manager REST"
+)
+
+var (
+	cefHeaderEscaper    = strings.NewReplacer(`\`, `\\`, "|", `\|`, "\r", " ", "\n", " ")
+	cefExtensionEscaper = strings.NewReplacer(`\`, `\\`, "=", `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// AuditEntrySeverity maps the response status of an audited request to a CEF severity (0-10)
+func AuditEntrySeverity(statusCode int) int {
+	switch {
+	case statusCode >= http.StatusInternalServerError:
+		return 8
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return 7
+	case statusCode >= http.StatusBadRequest:
+		return 5
+	default:
+		return 3
+	}
+}
+
+// FormatAuditEntryCEF renders an AuditEntry as an ArcSight Common Event Format record.
+// dbUser is optional and is used to report the user's email.
+func FormatAuditEntryCEF(entry cdbm.AuditEntry, dbUser *cdbm.User) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "CEF:0|%s|%s|%s|%s|%s|%d|",
+		cefHeaderEscaper.Replace(AuditCEFVendor),
+		cefHeaderEscaper.Replace(AuditCEFProduct),
+		cefHeaderEscaper.Replace(entry.APIVersion),
+		cefHeaderEscaper.Replace(entry.Method),
+		cefHeaderEscaper.Replace(entry.Method+" "+entry.Endpoint),
+		AuditEntrySeverity(entry.StatusCode))
+
+	extensions := [][2]string{
+		{"externalId", entry.ID.String()},
+		{"rt", fmt.Sprintf("%d", entry.Timestamp.UnixMilli())},
+		{"src", entry.ClientIP},
+		{"requestMethod", entry.Method},
+		{"request", entry.Endpoint},
+		{"outcome", fmt.Sprintf("%d", entry.StatusCode)},
+		{"cs1Label", "org"},
+		{"cs1", entry.OrgName},
+		{"cn1Label", "durationMs"},
+		{"cn1", fmt.Sprintf("%d", entry.Duration.Milliseconds())},
+	}
+	if entry.UserID != nil {
+		extensions = append(extensions, [2]string{"suid", entry.UserID.String()})
+	}
+	if dbUser != nil && dbUser.Email != nil {
+		extensions = append(extensions, [2]string{"suser", *dbUser.Email})
+	}
+	if entry.StatusMessage != "" {
+		extensions = append(extensions, [2]string{"msg", entry.StatusMessage})
+	}
+	for i, ext := range extensions {
+		if i > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(ext[0])
+		sb.WriteByte('=')
+		sb.WriteString(cefExtensionEscaper.Replace(ext[1]))
+	}
+	return sb.String()
+}
@@ -0,0 +1,83 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package util
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
+	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+)
+
+func TestFormatAuditEntryCEF(t *testing.T) {
+	userID := uuid.MustParse("5d9fe319-14d4-40e3-8e5a-7d79e680d55b")
+	entry := cdbm.AuditEntry{
+		ID:            uuid.MustParse("e313b3ca-c47a-4ec1-a79b-a147fad51a50"),
+		Endpoint:      "/v2/org/test-org/carbide/vpc",
+		Method:        http.MethodPost,
+		StatusCode:    http.StatusForbidden,
+		StatusMessage: "User does not have role=admin\nin org",
+		ClientIP:      "12.123.43.112",
+		UserID:        &userID,
+		OrgName:       "test-org",
+		Timestamp:     time.UnixMilli(1733375193849).UTC(),
+		Duration:      250 * time.Millisecond,
+		APIVersion:    "1.2|3",
+	}
+	dbUser := &cdbm.User{ID: userID, Email: cdb.GetStrPtr("jdoe@test.com")}
+
+	got := FormatAuditEntryCEF(entry, dbUser)
+	assert.True(t, strings.HasPrefix(got, `CEF:0|NVIDIA|Bare Metal Manager REST|1.2\|3|POST|POST /v2/org/test-org/carbide/vpc|7|`), got)
+	for _, want := range []string{
+		"externalId=e313b3ca-c47a-4ec1-a79b-a147fad51a50",
+		"rt=1733375193849",
+		"src=12.123.43.112",
+		"request=/v2/org/test-org/carbide/vpc",
+		"outcome=403",
+		"cs1Label=org cs1=test-org",
+		"cn1Label=durationMs cn1=250",
+		"suid=5d9fe319-14d4-40e3-8e5a-7d79e680d55b",
+		"suser=jdoe@test.com",
+		`msg=User does not have role\=admin\nin org`,
+	} {
+		assert.Contains(t, got, want)
+	}
+	assert.NotContains(t, got, "\n")
+
+	// Without a user
+	entry.UserID = nil
+	entry.StatusMessage = ""
+	got = FormatAuditEntryCEF(entry, nil)
+	assert.NotContains(t, got, "suid=")
+	assert.NotContains(t, got, "suser=")
+	assert.NotContains(t, got, "msg=")
+}
+
+func TestAuditEntrySeverity(t *testing.T) {
+	assert.Equal(t, 3, AuditEntrySeverity(http.StatusCreated))
+	assert.Equal(t, 5, AuditEntrySeverity(http.StatusConflict))
+	assert.Equal(t, 7, AuditEntrySeverity(http.StatusUnauthorized))
+	assert.Equal(t, 7, AuditEntrySeverity(http.StatusForbidden))
+	assert.Equal(t, 8, AuditEntrySeverity(http.StatusBadGateway))
+}
@@ -26,6 +26,7 @@ import (
 	cwutil "github.com/nvidia/bare-metal-manager-rest/common/pkg/util"
 	cdb "github.com/nvidia/bare-metal-manager-rest/db/pkg/db"
 	cdbm "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/model"
+	cdbp "github.com/nvidia/bare-metal-manager-rest/db/pkg/db/paginator"
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 )
@@ -95,6 +96,167 @@ func MachineCapabilitiesEqual(cap1 *cdbm.MachineCapability, cap2 *cdbm.MachineCa
 		cap1.Index == cap2.Index
 }
 
+// GetUnassignedMachineCapabilities returns the Capabilities of the Machines of a Provider at a Site that can be assigned to an Instance Type,
+// i.e. Machines in Ready or Reset status that are not assigned to any Instance Type, keyed by Machine ID.
+// Machines that have not reported any Capabilities are omitted.
+func GetUnassignedMachineCapabilities(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, infrastructureProviderID uuid.UUID, siteID uuid.UUID) (map[string][]cdbm.MachineCapability, error) {
+	mDAO := cdbm.NewMachineDAO(dbSession)
+
+	machines, _, err := mDAO.GetAll(ctx, tx, cdbm.MachineFilterInput{
+		InfrastructureProviderID: &infrastructureProviderID,
+		SiteID:                   &siteID,
+		HasInstanceType:          cdb.GetBoolPtr(false),
+		Statuses:                 []string{cdbm.MachineStatusReady, cdbm.MachineStatusReset},
+		ExcludeMetadata:          true,
+	}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	mcsByMachineID := map[string][]cdbm.MachineCapability{}
+	if len(machines) == 0 {
+		return mcsByMachineID, nil
+	}
+
+	machineIDs := make([]string, 0, len(machines))
+	for _, m := range machines {
+		machineIDs = append(machineIDs, m.ID)
+	}
+
+	mcDAO := cdbm.NewMachineCapabilityDAO(dbSession)
+
+	mcs, _, err := mcDAO.GetAll(ctx, tx, machineIDs, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, cdb.GetIntPtr(cdbp.TotalLimit), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mc := range mcs {
+		mcsByMachineID[*mc.MachineID] = append(mcsByMachineID[*mc.MachineID], mc)
+	}
+
+	return mcsByMachineID, nil
+}
+
+// GetNetworkSecurityGroupReferencePrefixes returns the prefixes that the Address Set and Network Security Group references in the rules
+// of the given Network Security Groups resolve to, keyed by the referenced ID.
+// A Network Security Group reference resolves to the addresses of the Instances that Network Security Group applies to, i.e. Instances
+// attached to it directly and Instances without their own Network Security Group in a VPC attached to it.
+func GetNetworkSecurityGroupReferencePrefixes(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, nsgs ...*cdbm.NetworkSecurityGroup) (map[string][]string, error) {
+	prefixesByReferenceID := map[string][]string{}
+
+	var addressSetIDs []uuid.UUID
+	var nsgIDs []string
+	for _, nsg := range nsgs {
+		asIDs, refNSGIDs := nsg.GetReferencedIDs()
+		addressSetIDs = append(addressSetIDs, asIDs...)
+		nsgIDs = append(nsgIDs, refNSGIDs...)
+	}
+
+	if len(addressSetIDs) > 0 {
+		asDAO := cdbm.NewAddressSetDAO(dbSession)
+
+		addressSets, _, err := asDAO.GetAll(ctx, tx, cdbm.AddressSetFilterInput{AddressSetIDs: addressSetIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, as := range addressSets {
+			prefixesByReferenceID[as.ID.String()] = as.Prefixes
+		}
+	}
+
+	if len(nsgIDs) == 0 {
+		return prefixesByReferenceID, nil
+	}
+
+	// Referenced Network Security Groups that apply to no Instances resolve to no prefixes
+	for _, nsgID := range nsgIDs {
+		prefixesByReferenceID[nsgID] = []string{}
+	}
+
+	instanceDAO := cdbm.NewInstanceDAO(dbSession)
+
+	instances, _, err := instanceDAO.GetAll(ctx, tx, cdbm.InstanceFilterInput{NetworkSecurityGroupIDs: nsgIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	nsgIDByInstanceID := map[uuid.UUID]string{}
+	for _, instance := range instances {
+		nsgIDByInstanceID[instance.ID] = *instance.NetworkSecurityGroupID
+	}
+
+	vpcDAO := cdbm.NewVpcDAO(dbSession)
+
+	vpcs, _, err := vpcDAO.GetAll(ctx, tx, cdbm.VpcFilterInput{NetworkSecurityGroupIDs: nsgIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(vpcs) > 0 {
+		nsgIDByVpcID := map[uuid.UUID]string{}
+		vpcIDs := make([]uuid.UUID, 0, len(vpcs))
+		for _, vpc := range vpcs {
+			nsgIDByVpcID[vpc.ID] = *vpc.NetworkSecurityGroupID
+			vpcIDs = append(vpcIDs, vpc.ID)
+		}
+
+		vpcInstances, _, err := instanceDAO.GetAll(ctx, tx, cdbm.InstanceFilterInput{VpcIDs: vpcIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range vpcInstances {
+			// An Instance's own Network Security Group takes precedence over its VPC's
+			if instance.NetworkSecurityGroupID == nil {
+				nsgIDByInstanceID[instance.ID] = nsgIDByVpcID[instance.VpcID]
+			}
+		}
+	}
+
+	if len(nsgIDByInstanceID) == 0 {
+		return prefixesByReferenceID, nil
+	}
+
+	instanceIDs := make([]uuid.UUID, 0, len(nsgIDByInstanceID))
+	for instanceID := range nsgIDByInstanceID {
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	ifcDAO := cdbm.NewInterfaceDAO(dbSession)
+
+	ifcs, _, err := ifcDAO.GetAll(ctx, tx, cdbm.InterfaceFilterInput{InstanceIDs: instanceIDs}, cdbp.PageInput{Limit: cdb.GetIntPtr(cdbp.TotalLimit)}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ifc := range ifcs {
+		nsgID := nsgIDByInstanceID[ifc.InstanceID]
+		for _, address := range ifc.IPAddresses {
+			prefix, err := cdbm.NetworkSecurityGroupAddressPrefix(address)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(prefixesByReferenceID[nsgID], prefix) {
+				prefixesByReferenceID[nsgID] = append(prefixesByReferenceID[nsgID], prefix)
+			}
+		}
+	}
+
+	return prefixesByReferenceID, nil
+}
+
+// GetResolvedNetworkSecurityGroupRules returns the rules of a Network Security Group with Address Set and Network Security Group references
+// expanded into literal prefixes, as they should be sent to the Site
+func GetResolvedNetworkSecurityGroupRules(ctx context.Context, tx *cdb.Tx, dbSession *cdb.Session, nsg *cdbm.NetworkSecurityGroup) ([]*cwssaws.NetworkSecurityGroupRuleAttributes, error) {
+	prefixesByReferenceID, err := GetNetworkSecurityGroupReferencePrefixes(ctx, tx, dbSession, nsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return nsg.GetRulesAsResolvedProtoRefs(prefixesByReferenceID)
+}
+
 // IsTimeWithinStaleInventoryThreshold checks if the action time is within the threshold where we could be processing an older inventory
 func IsTimeWithinStaleInventoryThreshold(actionTime time.Time) bool {
 	return time.Since(actionTime) < cwutil.InventoryReceiptInterval+(time.Second*10)
@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	auditActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/audit"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+const (
+	// AuditForwardInterval is how often Audit Entries are forwarded to the syslog collector
+	AuditForwardInterval = time.Minute
+	// AuditForwardDelay is how far behind the current time forwarding runs. Audit Entries are timestamped
+	// when a request starts but only written once it completes.
+	AuditForwardDelay = 5 * time.Minute
+)
+
+// DeleteExpiredAuditEntries is a Temporal cron workflow to periodically delete Audit Entries past the retention period
+func DeleteExpiredAuditEntries(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "AuditEntry").Str("Action", "DeleteExpired").Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    3 * time.Minute,
+		MaximumAttempts:    5,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 30 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var auditManager auditActivity.ManageAuditEntry
+
+	var deleted int
+	err := workflow.ExecuteActivity(ctx, auditManager.DeleteExpiredAuditEntries).Get(ctx, &deleted)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: DeleteExpiredAuditEntries")
+		return err
+	}
+
+	logger.Info().Int("Deleted", deleted).Msg("completing workflow")
+
+	return nil
+}
+
+// ExecuteDeleteExpiredAuditEntriesWorkflow is a helper function to trigger execution of DeleteExpiredAuditEntries workflow
+func ExecuteDeleteExpiredAuditEntriesWorkflow(ctx context.Context, tc client.Client) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:           "audit-entry-delete-expired",
+		CronSchedule: "@every 1h",
+		TaskQueue:    queue.CloudTaskQueue,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, DeleteExpiredAuditEntries)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute workflow: DeleteExpiredAuditEntries")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
+
+// ForwardAuditEntries is a Temporal cron workflow to periodically forward new Audit Entries to the syslog collector.
+// Each run picks up where the last successful run ended and returns the end of the window it forwarded.
+func ForwardAuditEntries(ctx workflow.Context) (time.Time, error) {
+	logger := log.With().Str("Workflow", "AuditEntry").Str("Action", "Forward").Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	end := workflow.Now(ctx).UTC().Add(-AuditForwardDelay)
+	start := end.Add(-AuditForwardInterval)
+	if workflow.HasLastCompletionResult(ctx) {
+		var lastEnd time.Time
+		if err := workflow.GetLastCompletionResult(ctx, &lastEnd); err != nil {
+			logger.Warn().Err(err).Msg("failed to decode last completion result, forwarding latest interval only")
+		} else if !lastEnd.IsZero() {
+			start = lastEnd
+		}
+	}
+	if !start.Before(end) {
+		return start, nil
+	}
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    time.Minute,
+		MaximumAttempts:    3,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 10 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var auditManager auditActivity.ManageAuditEntry
+
+	var forwarded int
+	err := workflow.ExecuteActivity(ctx, auditManager.ForwardAuditEntries, start, end).Get(ctx, &forwarded)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: ForwardAuditEntries")
+		return start, err
+	}
+
+	logger.Info().Int("Forwarded", forwarded).Msg("completing workflow")
+
+	return end, nil
+}
+
+// ExecuteForwardAuditEntriesWorkflow is a helper function to trigger execution of ForwardAuditEntries workflow
+func ExecuteForwardAuditEntriesWorkflow(ctx context.Context, tc client.Client) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:           "audit-entry-forward",
+		CronSchedule: "@every 1m",
+		TaskQueue:    queue.CloudTaskQueue,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, ForwardAuditEntries)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute workflow: ForwardAuditEntries")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
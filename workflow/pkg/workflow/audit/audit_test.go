@@ -0,0 +1,208 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	auditActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/audit"
+	tmocks "go.temporal.io/sdk/mocks"
+)
+
+type DeleteExpiredAuditEntriesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *DeleteExpiredAuditEntriesTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *DeleteExpiredAuditEntriesTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *DeleteExpiredAuditEntriesTestSuite) Test_DeleteExpiredAuditEntriesWorkflow_Success() {
+	var auditManager auditActivity.ManageAuditEntry
+
+	// Mock DeleteExpiredAuditEntries activity success
+	s.env.RegisterActivity(auditManager.DeleteExpiredAuditEntries)
+	s.env.OnActivity(auditManager.DeleteExpiredAuditEntries, mock.Anything).Return(10, nil)
+
+	// Execute DeleteExpiredAuditEntries workflow
+	s.env.ExecuteWorkflow(DeleteExpiredAuditEntries)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *DeleteExpiredAuditEntriesTestSuite) Test_DeleteExpiredAuditEntriesWorkflow_ActivityFails() {
+	var auditManager auditActivity.ManageAuditEntry
+
+	// Mock DeleteExpiredAuditEntries activity failure
+	s.env.RegisterActivity(auditManager.DeleteExpiredAuditEntries)
+	s.env.OnActivity(auditManager.DeleteExpiredAuditEntries, mock.Anything).Return(0, errors.New("DeleteExpiredAuditEntries Failure"))
+
+	// Execute DeleteExpiredAuditEntries workflow
+	s.env.ExecuteWorkflow(DeleteExpiredAuditEntries)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("DeleteExpiredAuditEntries Failure", applicationErr.Error())
+}
+
+func (s *DeleteExpiredAuditEntriesTestSuite) Test_ExecuteDeleteExpiredAuditEntriesWorkflow_Success() {
+	ctx := context.Background()
+
+	wrid := "test-workflow-run-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wrid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything).Return(wrun, nil)
+
+	rwrid, err := ExecuteDeleteExpiredAuditEntriesWorkflow(ctx, tc)
+	s.NoError(err)
+	s.Equal(wrid, *rwrid)
+}
+
+func TestDeleteExpiredAuditEntriesSuite(t *testing.T) {
+	suite.Run(t, new(DeleteExpiredAuditEntriesTestSuite))
+}
+
+type ForwardAuditEntriesTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *ForwardAuditEntriesTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *ForwardAuditEntriesTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *ForwardAuditEntriesTestSuite) Test_ForwardAuditEntriesWorkflow_FirstRun() {
+	var auditManager auditActivity.ManageAuditEntry
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.env.SetStartTime(now)
+
+	expectedEnd := now.Add(-AuditForwardDelay)
+
+	// Mock ForwardAuditEntries activity success, first run forwards the latest interval
+	s.env.RegisterActivity(auditManager.ForwardAuditEntries)
+	s.env.OnActivity(auditManager.ForwardAuditEntries, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, start, end time.Time) (int, error) {
+			s.True(start.Equal(expectedEnd.Add(-AuditForwardInterval)))
+			s.True(end.Equal(expectedEnd))
+			return 3, nil
+		})
+
+	// Execute ForwardAuditEntries workflow
+	s.env.ExecuteWorkflow(ForwardAuditEntries)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var end time.Time
+	s.NoError(s.env.GetWorkflowResult(&end))
+	s.True(end.Equal(expectedEnd))
+}
+
+func (s *ForwardAuditEntriesTestSuite) Test_ForwardAuditEntriesWorkflow_ResumesFromLastCompletion() {
+	var auditManager auditActivity.ManageAuditEntry
+
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	s.env.SetStartTime(now)
+
+	lastEnd := now.Add(-time.Hour)
+	s.env.SetLastCompletionResult(lastEnd)
+
+	// Mock ForwardAuditEntries activity success, window starts where the last run ended
+	s.env.RegisterActivity(auditManager.ForwardAuditEntries)
+	s.env.OnActivity(auditManager.ForwardAuditEntries, mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, start, end time.Time) (int, error) {
+			s.True(start.Equal(lastEnd))
+			s.True(end.Equal(now.Add(-AuditForwardDelay)))
+			return 0, nil
+		})
+
+	// Execute ForwardAuditEntries workflow
+	s.env.ExecuteWorkflow(ForwardAuditEntries)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *ForwardAuditEntriesTestSuite) Test_ForwardAuditEntriesWorkflow_ActivityFails() {
+	var auditManager auditActivity.ManageAuditEntry
+
+	// Mock ForwardAuditEntries activity failure
+	s.env.RegisterActivity(auditManager.ForwardAuditEntries)
+	s.env.OnActivity(auditManager.ForwardAuditEntries, mock.Anything, mock.Anything, mock.Anything).Return(0, errors.New("ForwardAuditEntries Failure"))
+
+	// Execute ForwardAuditEntries workflow
+	s.env.ExecuteWorkflow(ForwardAuditEntries)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("ForwardAuditEntries Failure", applicationErr.Error())
+}
+
+func (s *ForwardAuditEntriesTestSuite) Test_ExecuteForwardAuditEntriesWorkflow_Success() {
+	ctx := context.Background()
+
+	wrid := "test-workflow-run-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wrid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything).Return(wrun, nil)
+
+	rwrid, err := ExecuteForwardAuditEntriesWorkflow(ctx, tc)
+	s.NoError(err)
+	s.Equal(wrid, *rwrid)
+}
+
+func TestForwardAuditEntriesSuite(t *testing.T) {
+	suite.Run(t, new(ForwardAuditEntriesTestSuite))
+}
@@ -30,6 +30,7 @@ import (
 	"go.temporal.io/sdk/workflow"
 
 	instanceActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/instance"
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 )
@@ -134,6 +135,22 @@ func UpdateInstanceInventory(ctx workflow.Context, siteID string, instanceInvent
 
 	ctx = workflow.WithActivityOptions(ctx, options)
 
+	var inventorySyncManager inventoryActivity.ManageInventorySync
+
+	// Delta inventory is only applied on top of the inventory state known to Cloud
+	if instanceInventory.GetInventoryPage().GetDelta() {
+		apply := false
+		err = workflow.ExecuteActivity(ctx, inventorySyncManager.CheckDeltaInventoryPage, parsedSiteID, inventoryActivity.ItemTypeInstance, instanceInventory.InventoryPage).Get(ctx, &apply)
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to execute activity: CheckDeltaInventoryPage")
+			return err
+		}
+		if !apply {
+			logger.Info().Msg("skipping delta inventory page, full inventory has been requested from Site")
+			return nil
+		}
+	}
+
 	var instanceManager instanceActivity.ManageInstance
 
 	// Execute UpdateInstancesInDB activity and get lifecycle events
@@ -143,6 +160,14 @@ func UpdateInstanceInventory(ctx workflow.Context, siteID string, instanceInvent
 		logger.Warn().Err(err).Msg("failed to execute activity: UpdateInstancesInDB")
 	}
 
+	// Record the inventory state the Site reported once it has been applied
+	if err == nil && instanceInventory.GetInventoryPage().GetChecksum() != "" {
+		serr := workflow.ExecuteActivity(ctx, inventorySyncManager.RecordInventoryChecksum, parsedSiteID, inventoryActivity.ItemTypeInstance, instanceInventory.InventoryPage).Get(ctx, nil)
+		if serr != nil {
+			logger.Warn().Err(serr).Msg("failed to execute activity: RecordInventoryChecksum")
+		}
+	}
+
 	// Record instance lifecycle metrics
 	var lifecycleMetricsManager instanceActivity.ManageInstanceLifecycleMetrics
 	serr := workflow.ExecuteActivity(ctx, lifecycleMetricsManager.RecordInstanceStatusTransitionMetrics, parsedSiteID, objectLifecycleEvents).Get(ctx, nil)
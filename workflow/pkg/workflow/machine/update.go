@@ -30,6 +30,8 @@ import (
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 
+	instanceTypeActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/instancetype"
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 	machineActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/machine"
 
 	cwm "github.com/nvidia/bare-metal-manager-rest/workflow/internal/metrics"
@@ -65,6 +67,22 @@ func UpdateMachineInventory(ctx workflow.Context, siteID string, machineInventor
 
 	ctx = workflow.WithActivityOptions(ctx, options)
 
+	var inventorySyncManager inventoryActivity.ManageInventorySync
+
+	// Delta inventory is only applied on top of the inventory state known to Cloud
+	if machineInventory.GetInventoryPage().GetDelta() {
+		apply := false
+		err = workflow.ExecuteActivity(ctx, inventorySyncManager.CheckDeltaInventoryPage, parsedSiteID, inventoryActivity.ItemTypeMachine, machineInventory.InventoryPage).Get(ctx, &apply)
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to execute activity: CheckDeltaInventoryPage")
+			return err
+		}
+		if !apply {
+			logger.Info().Msg("skipping delta inventory page, full inventory has been requested from Site")
+			return nil
+		}
+	}
+
 	var machineManager machineActivity.ManageMachine
 
 	err = workflow.ExecuteActivity(ctx, machineManager.UpdateMachinesInDB, siteID, machineInventory).Get(ctx, nil)
@@ -72,6 +90,25 @@ func UpdateMachineInventory(ctx workflow.Context, siteID string, machineInventor
 		logger.Warn().Err(err).Msg("failed to execute activity: UpdateMachinesInDB")
 	}
 
+	// Record the inventory state the Site reported once it has been applied
+	if err == nil && machineInventory.GetInventoryPage().GetChecksum() != "" {
+		serr := workflow.ExecuteActivity(ctx, inventorySyncManager.RecordInventoryChecksum, parsedSiteID, inventoryActivity.ItemTypeMachine, machineInventory.InventoryPage).Get(ctx, nil)
+		if serr != nil {
+			logger.Warn().Err(serr).Msg("failed to execute activity: RecordInventoryChecksum")
+		}
+	}
+
+	// Assign new or changed Machines to Instance Types with auto assignment enabled once the inventory has been applied
+	page := machineInventory.GetInventoryPage()
+	if err == nil && (page == nil || page.TotalPages == 0 || page.CurrentPage == page.TotalPages || page.Delta) {
+		var instanceTypeManager instanceTypeActivity.ManageInstanceType
+
+		serr := workflow.ExecuteActivity(ctx, instanceTypeManager.AutoAssignMachinesToInstanceTypes, parsedSiteID).Get(ctx, nil)
+		if serr != nil {
+			logger.Warn().Err(serr).Msg("failed to execute activity: AutoAssignMachinesToInstanceTypes")
+		}
+	}
+
 	// Record latency for this inventory call
 	var inventoryMetricsManager cwm.ManageInventoryMetrics
 
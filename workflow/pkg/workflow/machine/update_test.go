@@ -31,6 +31,7 @@ import (
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
 
+	instanceTypeActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/instancetype"
 	machineActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/machine"
 )
 
@@ -51,6 +52,7 @@ func (s *UpdateMachineInventoryTestSuite) AfterTest(suiteName, testName string)
 
 func (s *UpdateMachineInventoryTestSuite) Test_UpdateMachineInventory_Success() {
 	var machineManager machineActivity.ManageMachine
+	var instanceTypeManager instanceTypeActivity.ManageInstanceType
 
 	siteID := uuid.New()
 
@@ -70,6 +72,10 @@ func (s *UpdateMachineInventoryTestSuite) Test_UpdateMachineInventory_Success()
 	s.env.RegisterActivity(machineManager.UpdateMachinesInDB)
 	s.env.OnActivity(machineManager.UpdateMachinesInDB, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
+	// Mock AutoAssignMachinesToInstanceTypes activity
+	s.env.RegisterActivity(instanceTypeManager.AutoAssignMachinesToInstanceTypes)
+	s.env.OnActivity(instanceTypeManager.AutoAssignMachinesToInstanceTypes, mock.Anything, siteID).Return(nil).Once()
+
 	// execute UpdateMachineInventory workflow
 	s.env.ExecuteWorkflow(UpdateMachineInventory, siteID.String(), machineInventory)
 	s.True(s.env.IsWorkflowCompleted())
@@ -108,6 +114,33 @@ func (s *UpdateMachineInventoryTestSuite) Test_UpdateMachineInventory_ActivityFa
 	s.Equal("UpdateMachineInventory Failure", applicationErr.Error())
 }
 
+func (s *UpdateMachineInventoryTestSuite) Test_UpdateMachineInventory_AutoAssignSkippedBeforeLastPage() {
+	var machineManager machineActivity.ManageMachine
+	var instanceTypeManager instanceTypeActivity.ManageInstanceType
+
+	siteID := uuid.New()
+
+	machineInventory := &cwssaws.MachineInventory{
+		Machines:  []*cwssaws.MachineInfo{},
+		Timestamp: timestamppb.Now(),
+		InventoryPage: &cwssaws.InventoryPage{
+			TotalPages:  3,
+			CurrentPage: 1,
+		},
+	}
+
+	s.env.RegisterActivity(machineManager.UpdateMachinesInDB)
+	s.env.OnActivity(machineManager.UpdateMachinesInDB, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// Machines are only evaluated once the last page has been applied
+	s.env.RegisterActivity(instanceTypeManager.AutoAssignMachinesToInstanceTypes)
+	s.env.OnActivity(instanceTypeManager.AutoAssignMachinesToInstanceTypes, mock.Anything, siteID).Return(nil).Never()
+
+	s.env.ExecuteWorkflow(UpdateMachineInventory, siteID.String(), machineInventory)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func TestUpdateMachineInventorySuite(t *testing.T) {
 	suite.Run(t, new(UpdateMachineInventoryTestSuite))
 }
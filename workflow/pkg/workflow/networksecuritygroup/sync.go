@@ -0,0 +1,91 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package networksecuritygroup
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	temporalEnums "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/sdk/client"
+
+	networkSecurityGroupActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/networksecuritygroup"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+// SyncNetworkSecurityGroup is a Temporal workflow to push the rules of a NetworkSecurityGroup, with references resolved, to a Site via Site Agent
+func SyncNetworkSecurityGroup(ctx workflow.Context, siteID uuid.UUID, networkSecurityGroupID string) error {
+	logger := log.With().Str("Workflow", "SyncNetworkSecurityGroup").Str("Site ID", siteID.String()).
+		Str("NetworkSecurityGroup ID", networkSecurityGroupID).Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    2 * time.Minute,
+		MaximumAttempts:    15,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 2 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var networkSecurityGroupManager networkSecurityGroupActivity.ManageNetworkSecurityGroup
+
+	err := workflow.ExecuteActivity(ctx, networkSecurityGroupManager.SyncNetworkSecurityGroupViaSiteAgent, siteID, networkSecurityGroupID).Get(ctx, nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: SyncNetworkSecurityGroupViaSiteAgent")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
+// ExecuteSyncNetworkSecurityGroupWorkflow is a helper function to trigger workflow to sync a NetworkSecurityGroup to a Site
+func ExecuteSyncNetworkSecurityGroupWorkflow(ctx context.Context, tc client.Client, siteID uuid.UUID, networkSecurityGroupID string) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                    "network-security-group-sync-" + siteID.String() + "-" + networkSecurityGroupID,
+		TaskQueue:             queue.CloudTaskQueue,
+		WorkflowIDReusePolicy: temporalEnums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, SyncNetworkSecurityGroup, siteID, networkSecurityGroupID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute SyncNetworkSecurityGroup workflow")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package networksecuritygroup
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tmocks "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	networkSecurityGroupActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/networksecuritygroup"
+)
+
+type SyncNetworkSecurityGroupTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *SyncNetworkSecurityGroupTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *SyncNetworkSecurityGroupTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *SyncNetworkSecurityGroupTestSuite) Test_SyncNetworkSecurityGroupWorkflow_Success() {
+	var networkSecurityGroupManager networkSecurityGroupActivity.ManageNetworkSecurityGroup
+
+	siteID := uuid.New()
+	networkSecurityGroupID := uuid.NewString()
+
+	// Mock SyncNetworkSecurityGroupViaSiteAgent activity
+	s.env.RegisterActivity(networkSecurityGroupManager.SyncNetworkSecurityGroupViaSiteAgent)
+	s.env.OnActivity(networkSecurityGroupManager.SyncNetworkSecurityGroupViaSiteAgent, mock.Anything, siteID, networkSecurityGroupID).Return(nil)
+
+	// execute SyncNetworkSecurityGroup workflow
+	s.env.ExecuteWorkflow(SyncNetworkSecurityGroup, siteID, networkSecurityGroupID)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *SyncNetworkSecurityGroupTestSuite) Test_SyncNetworkSecurityGroupWorkflow_ActivityFails() {
+	var networkSecurityGroupManager networkSecurityGroupActivity.ManageNetworkSecurityGroup
+
+	siteID := uuid.New()
+	networkSecurityGroupID := uuid.NewString()
+
+	// Mock SyncNetworkSecurityGroupViaSiteAgent activity failure
+	s.env.RegisterActivity(networkSecurityGroupManager.SyncNetworkSecurityGroupViaSiteAgent)
+	s.env.OnActivity(networkSecurityGroupManager.SyncNetworkSecurityGroupViaSiteAgent, mock.Anything, siteID, networkSecurityGroupID).Return(errors.New("SyncNetworkSecurityGroupViaSiteAgent Failure"))
+
+	// execute SyncNetworkSecurityGroup workflow
+	s.env.ExecuteWorkflow(SyncNetworkSecurityGroup, siteID, networkSecurityGroupID)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("SyncNetworkSecurityGroupViaSiteAgent Failure", applicationErr.Error())
+}
+
+func (s *SyncNetworkSecurityGroupTestSuite) Test_ExecuteSyncNetworkSecurityGroupWorkflow_Success() {
+	ctx := context.Background()
+	siteID := uuid.New()
+	networkSecurityGroupID := uuid.NewString()
+
+	wid := "test-workflow-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything, siteID, networkSecurityGroupID).Return(wrun, nil)
+
+	rwid, err := ExecuteSyncNetworkSecurityGroupWorkflow(ctx, tc, siteID, networkSecurityGroupID)
+	s.NoError(err)
+	s.Equal(wid, *rwid)
+}
+
+func TestSyncNetworkSecurityGroupSuite(t *testing.T) {
+	suite.Run(t, new(SyncNetworkSecurityGroupTestSuite))
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operatingsystem
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	temporalEnums "go.temporal.io/api/enums/v1"
+
+	"go.temporal.io/sdk/client"
+
+	osImageActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/operatingsystem"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+// VerifyOperatingSystemVersion is a Temporal workflow that verifies the image of an Operating System Version against its digest
+// and, once verified, syncs the version to the Sites the Operating System is associated with
+func VerifyOperatingSystemVersion(ctx workflow.Context, osvID uuid.UUID) error {
+	logger := log.With().Str("Workflow", "VerifyOperatingSystemVersion").Str("Operating System Version ID", osvID.String()).Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    5 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    2 * time.Minute,
+		MaximumAttempts:    3,
+	}
+	options := workflow.ActivityOptions{
+		// Images can be large, allow enough time to fetch them
+		StartToCloseTimeout: 2 * time.Hour,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Image verification heartbeats while the image is read, so a stalled fetch is retried without waiting for the StartToClose timeout
+	verifyOptions := options
+	verifyOptions.HeartbeatTimeout = 2 * time.Minute
+	verifyCtx := workflow.WithActivityOptions(ctx, verifyOptions)
+
+	var osImageManager osImageActivity.ManageOsImage
+
+	var verified bool
+
+	err := workflow.ExecuteActivity(verifyCtx, osImageManager.VerifyOperatingSystemVersionImage, osvID).Get(ctx, &verified)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: VerifyOperatingSystemVersionImage")
+		return err
+	}
+
+	if !verified {
+		logger.Info().Msg("Operating System Version could not be verified, skipping sync to Sites")
+		return nil
+	}
+
+	err = workflow.ExecuteActivity(ctx, osImageManager.SyncOperatingSystemVersionToSites, osvID).Get(ctx, nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: SyncOperatingSystemVersionToSites")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
+// ExecuteVerifyOperatingSystemVersionWorkflow is a helper function to trigger workflow to verify an Operating System Version
+func ExecuteVerifyOperatingSystemVersionWorkflow(ctx context.Context, tc client.Client, osvID uuid.UUID) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                    "operating-system-version-verify-" + osvID.String(),
+		TaskQueue:             queue.CloudTaskQueue,
+		WorkflowIDReusePolicy: temporalEnums.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, VerifyOperatingSystemVersion, osvID)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute VerifyOperatingSystemVersion workflow")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
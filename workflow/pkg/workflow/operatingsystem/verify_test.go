@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operatingsystem
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	tmocks "go.temporal.io/sdk/mocks"
+	"go.temporal.io/sdk/testsuite"
+
+	osImageActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/operatingsystem"
+)
+
+type VerifyOperatingSystemVersionTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) Test_VerifyOperatingSystemVersionWorkflow_Verified() {
+	var osImageManager osImageActivity.ManageOsImage
+
+	osvID := uuid.New()
+
+	s.env.RegisterActivity(osImageManager.VerifyOperatingSystemVersionImage)
+	s.env.OnActivity(osImageManager.VerifyOperatingSystemVersionImage, mock.Anything, osvID).Return(true, nil)
+
+	s.env.RegisterActivity(osImageManager.SyncOperatingSystemVersionToSites)
+	s.env.OnActivity(osImageManager.SyncOperatingSystemVersionToSites, mock.Anything, osvID).Return(nil)
+
+	s.env.ExecuteWorkflow(VerifyOperatingSystemVersion, osvID)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) Test_VerifyOperatingSystemVersionWorkflow_NotVerified() {
+	var osImageManager osImageActivity.ManageOsImage
+
+	osvID := uuid.New()
+
+	s.env.RegisterActivity(osImageManager.VerifyOperatingSystemVersionImage)
+	s.env.OnActivity(osImageManager.VerifyOperatingSystemVersionImage, mock.Anything, osvID).Return(false, nil)
+
+	// Sync must not be attempted for a version that failed verification
+	s.env.RegisterActivity(osImageManager.SyncOperatingSystemVersionToSites)
+
+	s.env.ExecuteWorkflow(VerifyOperatingSystemVersion, osvID)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) Test_VerifyOperatingSystemVersionWorkflow_ActivityFails() {
+	var osImageManager osImageActivity.ManageOsImage
+
+	osvID := uuid.New()
+
+	s.env.RegisterActivity(osImageManager.VerifyOperatingSystemVersionImage)
+	s.env.OnActivity(osImageManager.VerifyOperatingSystemVersionImage, mock.Anything, osvID).Return(false, errors.New("VerifyOperatingSystemVersionImage Failure"))
+
+	s.env.ExecuteWorkflow(VerifyOperatingSystemVersion, osvID)
+	s.True(s.env.IsWorkflowCompleted())
+	s.Error(s.env.GetWorkflowError())
+}
+
+func (s *VerifyOperatingSystemVersionTestSuite) Test_ExecuteVerifyOperatingSystemVersionWorkflow_Success() {
+	ctx := context.Background()
+	osvID := uuid.New()
+
+	wid := "test-workflow-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything, osvID).Return(wrun, nil)
+
+	rwid, err := ExecuteVerifyOperatingSystemVersionWorkflow(ctx, tc, osvID)
+	s.NoError(err)
+	s.Equal(wid, *rwid)
+}
+
+func TestVerifyOperatingSystemVersionSuite(t *testing.T) {
+	suite.Run(t, new(VerifyOperatingSystemVersionTestSuite))
+}
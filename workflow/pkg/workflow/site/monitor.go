@@ -276,6 +276,57 @@ func ExecuteMonitorTemporalCertExpirationForAllSites(ctx context.Context, tc cli
 	return &wid, nil
 }
 
+// SyncBootstrapStatusForAllSites is a Temporal cron workflow to periodically mirror Site bootstrap status from Site Manager
+func SyncBootstrapStatusForAllSites(ctx workflow.Context) error {
+	logger := log.With().Str("Workflow", "SyncBootstrapStatusForAllSites").Logger()
+	logger.Info().Msg("Starting workflow")
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    1 * time.Minute,
+		MaximumAttempts:    3,
+	}
+
+	options := workflow.ActivityOptions{
+		StartToCloseTimeout: 3 * time.Minute,
+		RetryPolicy:         retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	// Execute the activity
+	err := workflow.ExecuteActivity(ctx, siteActivity.ManageSite.SyncBootstrapStatusForAllSites).Get(ctx, nil)
+	if err != nil {
+		logger.Warn().Err(err).Msg("failed to execute activity: SyncBootstrapStatusForAllSites")
+		return err
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return nil
+}
+
+// ExecuteSyncBootstrapStatusForAllSites is a helper function to trigger execution of SyncBootstrapStatusForAllSites
+func ExecuteSyncBootstrapStatusForAllSites(ctx context.Context, tc client.Client) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:           "site-sync-bootstrap-status",
+		CronSchedule: "@every 5m",
+		TaskQueue:    queue.CloudTaskQueue,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, SyncBootstrapStatusForAllSites)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute workflow: SyncBootstrapStatusForAllSites")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
+
 // UpdateAgentCertExpiry updates the AgentCertExpiry field for a site
 func UpdateAgentCertExpiry(ctx workflow.Context, siteIDStr string, certExpiry time.Time) error {
 	logger := log.With().Str("Workflow", "UpdateAgentCertExpiry").Str("SiteID", siteIDStr).Logger()
@@ -230,6 +230,73 @@ func TestMonitorCertExpirationTestSuite(t *testing.T) {
 	suite.Run(t, new(MonitorCertExpirationTestSuite))
 }
 
+type SyncBootstrapStatusTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *SyncBootstrapStatusTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *SyncBootstrapStatusTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *SyncBootstrapStatusTestSuite) Test_SyncBootstrapStatusWorkflow_Success() {
+	var siteManager siteActivity.ManageSite
+
+	// Mock SyncBootstrapStatusForAllSites activity success
+	s.env.RegisterActivity(siteManager.SyncBootstrapStatusForAllSites)
+	s.env.OnActivity(siteManager.SyncBootstrapStatusForAllSites, mock.Anything).Return(nil)
+
+	// Execute SyncBootstrapStatusForAllSites workflow
+	s.env.ExecuteWorkflow(SyncBootstrapStatusForAllSites)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *SyncBootstrapStatusTestSuite) Test_SyncBootstrapStatusWorkflow_ActivityFails() {
+	var siteManager siteActivity.ManageSite
+
+	// Mock SyncBootstrapStatusForAllSites activity failure
+	s.env.RegisterActivity(siteManager.SyncBootstrapStatusForAllSites)
+	s.env.OnActivity(siteManager.SyncBootstrapStatusForAllSites, mock.Anything).Return(errors.New("SyncBootstrapStatusForAllSites Failure"))
+
+	// Execute SyncBootstrapStatusForAllSites workflow
+	s.env.ExecuteWorkflow(SyncBootstrapStatusForAllSites)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("SyncBootstrapStatusForAllSites Failure", applicationErr.Error())
+}
+
+func (s *SyncBootstrapStatusTestSuite) Test_ExecuteSyncBootstrapStatusWorkflow_Success() {
+	ctx := context.Background()
+
+	wrid := "test-workflow-run-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wrid)
+
+	tc := &tmocks.Client{}
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything).Return(wrun, nil)
+
+	rwrid, err := ExecuteSyncBootstrapStatusForAllSites(ctx, tc)
+	s.NoError(err)
+	s.Equal(wrid, *rwrid)
+}
+
+func TestSyncBootstrapStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(SyncBootstrapStatusTestSuite))
+}
+
 type UpdateAgentCertExpiryWorkflowTestSuite struct {
 	suite.Suite
 	testsuite.WorkflowTestSuite
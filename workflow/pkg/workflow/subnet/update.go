@@ -29,6 +29,7 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 	subnetActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/subnet"
 
 	cwssaws "github.com/nvidia/bare-metal-manager-rest/workflow-schema/schema/site-agent/workflows/v1"
@@ -99,6 +100,22 @@ func UpdateSubnetInventory(ctx workflow.Context, siteID string, subnetInventory
 
 	ctx = workflow.WithActivityOptions(ctx, options)
 
+	var inventorySyncManager inventoryActivity.ManageInventorySync
+
+	// Delta inventory is only applied on top of the inventory state known to Cloud
+	if subnetInventory.GetInventoryPage().GetDelta() {
+		apply := false
+		err = workflow.ExecuteActivity(ctx, inventorySyncManager.CheckDeltaInventoryPage, parsedSiteID, inventoryActivity.ItemTypeSubnet, subnetInventory.InventoryPage).Get(ctx, &apply)
+		if err != nil {
+			logger.Warn().Err(err).Msg("failed to execute activity: CheckDeltaInventoryPage")
+			return err
+		}
+		if !apply {
+			logger.Info().Msg("skipping delta inventory page, full inventory has been requested from Site")
+			return nil
+		}
+	}
+
 	var subnetManager subnetActivity.ManageSubnet
 
 	// Execute UpdateSubnetsInDB activity and get lifecycle events
@@ -108,6 +125,14 @@ func UpdateSubnetInventory(ctx workflow.Context, siteID string, subnetInventory
 		logger.Warn().Err(err).Msg("failed to execute activity: UpdateSubnetsInDB")
 	}
 
+	// Record the inventory state the Site reported once it has been applied
+	if err == nil && subnetInventory.GetInventoryPage().GetChecksum() != "" {
+		serr := workflow.ExecuteActivity(ctx, inventorySyncManager.RecordInventoryChecksum, parsedSiteID, inventoryActivity.ItemTypeSubnet, subnetInventory.InventoryPage).Get(ctx, nil)
+		if serr != nil {
+			logger.Warn().Err(serr).Msg("failed to execute activity: RecordInventoryChecksum")
+		}
+	}
+
 	// Record subnet lifecycle metrics
 	var lifecycleMetricsManager subnetActivity.ManageSubnetLifecycleMetrics
 	serr := workflow.ExecuteActivity(ctx, lifecycleMetricsManager.RecordSubnetStatusTransitionMetrics, parsedSiteID, subnetLifecycleEvents).Get(ctx, nil)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/uuid"
 	cwm "github.com/nvidia/bare-metal-manager-rest/workflow/internal/metrics"
+	inventoryActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/inventory"
 	subnetActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/subnet"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -166,6 +167,80 @@ func (s *UpdateSubnetTestSuite) Test_UpdateSubnetInventory_ActivityFails() {
 	s.Equal("UpdateSubnetInventory Failure", applicationErr.Error())
 }
 
+func (s *UpdateSubnetTestSuite) Test_UpdateSubnetInventory_DeltaApplied() {
+	var subnetManager subnetActivity.ManageSubnet
+	var lifecycleMetricsManager subnetActivity.ManageSubnetLifecycleMetrics
+	var inventoryMetricsManager cwm.ManageInventoryMetrics
+	var inventorySyncManager inventoryActivity.ManageInventorySync
+
+	siteID := uuid.New()
+
+	subnetInventory := &cwssaws.SubnetInventory{
+		Segments:  []*cwssaws.NetworkSegment{},
+		Timestamp: timestamppb.Now(),
+		InventoryPage: &cwssaws.InventoryPage{
+			CurrentPage:  1,
+			Delta:        true,
+			BaseChecksum: "checksum-1",
+			Checksum:     "checksum-2",
+		},
+	}
+
+	// Mock CheckDeltaInventoryPage activity
+	s.env.RegisterActivity(inventorySyncManager.CheckDeltaInventoryPage)
+	s.env.OnActivity(inventorySyncManager.CheckDeltaInventoryPage, mock.Anything, siteID, inventoryActivity.ItemTypeSubnet, mock.Anything).Return(true, nil)
+
+	// Mock UpdateSubnetsInDB activity
+	s.env.RegisterActivity(subnetManager.UpdateSubnetsInDB)
+	s.env.OnActivity(subnetManager.UpdateSubnetsInDB, mock.Anything, siteID, mock.Anything).Return([]cwm.InventoryObjectLifecycleEvent{}, nil)
+
+	// Mock RecordInventoryChecksum activity
+	s.env.RegisterActivity(inventorySyncManager.RecordInventoryChecksum)
+	s.env.OnActivity(inventorySyncManager.RecordInventoryChecksum, mock.Anything, siteID, inventoryActivity.ItemTypeSubnet, mock.Anything).Return(nil)
+
+	// Mock RecordSubnetStatusTransitionMetrics activity
+	s.env.RegisterActivity(lifecycleMetricsManager.RecordSubnetStatusTransitionMetrics)
+	s.env.OnActivity(lifecycleMetricsManager.RecordSubnetStatusTransitionMetrics, mock.Anything, siteID, mock.Anything).Return(nil)
+
+	// Mock RecordLatency activity
+	s.env.RegisterActivity(inventoryMetricsManager.RecordLatency)
+	s.env.OnActivity(inventoryMetricsManager.RecordLatency, mock.Anything, siteID, "UpdateSubnetInventory", false, mock.Anything).Return(nil)
+
+	// Execute UpdateSubnetInventory workflow
+	s.env.ExecuteWorkflow(UpdateSubnetInventory, siteID.String(), subnetInventory)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *UpdateSubnetTestSuite) Test_UpdateSubnetInventory_DeltaSkipped() {
+	var subnetManager subnetActivity.ManageSubnet
+	var inventorySyncManager inventoryActivity.ManageInventorySync
+
+	siteID := uuid.New()
+
+	subnetInventory := &cwssaws.SubnetInventory{
+		Segments:  []*cwssaws.NetworkSegment{},
+		Timestamp: timestamppb.Now(),
+		InventoryPage: &cwssaws.InventoryPage{
+			CurrentPage:  1,
+			Delta:        true,
+			BaseChecksum: "checksum-1",
+			Checksum:     "checksum-2",
+		},
+	}
+
+	// Mock CheckDeltaInventoryPage activity, delta does not match Cloud state
+	s.env.RegisterActivity(inventorySyncManager.CheckDeltaInventoryPage)
+	s.env.OnActivity(inventorySyncManager.CheckDeltaInventoryPage, mock.Anything, siteID, inventoryActivity.ItemTypeSubnet, mock.Anything).Return(false, nil)
+
+	s.env.RegisterActivity(subnetManager.UpdateSubnetsInDB)
+
+	// Execute UpdateSubnetInventory workflow
+	s.env.ExecuteWorkflow(UpdateSubnetInventory, siteID.String(), subnetInventory)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
 func TestUpdateSubnetInfoSuite(t *testing.T) {
 	suite.Run(t, new(UpdateSubnetTestSuite))
 }
@@ -0,0 +1,118 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	usageActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/usage"
+	"github.com/nvidia/bare-metal-manager-rest/workflow/pkg/queue"
+)
+
+const (
+	// TenantUsageRollupSchedule runs the rollup shortly after midnight UTC, once the previous day is complete
+	TenantUsageRollupSchedule = "15 0 * * *"
+	// TenantUsageMaxBackfillDays is the maximum number of missed days a single run rolls up
+	TenantUsageMaxBackfillDays = 31
+)
+
+// RollupTenantUsage is a Temporal cron workflow to roll up Tenant usage for each day since the last successful run,
+// up to and including the previous UTC day. Returns the last day rolled up.
+func RollupTenantUsage(ctx workflow.Context) (time.Time, error) {
+	logger := log.With().Str("Workflow", "TenantUsage").Str("Action", "Rollup").Logger()
+
+	logger.Info().Msg("starting workflow")
+
+	now := workflow.Now(ctx).UTC()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+
+	first := yesterday
+	if workflow.HasLastCompletionResult(ctx) {
+		var lastDay time.Time
+		if err := workflow.GetLastCompletionResult(ctx, &lastDay); err != nil {
+			logger.Warn().Err(err).Msg("failed to decode last completion result, rolling up previous day only")
+		} else if !lastDay.IsZero() {
+			first = lastDay.UTC().AddDate(0, 0, 1)
+		}
+	}
+	if earliest := yesterday.AddDate(0, 0, 1-TenantUsageMaxBackfillDays); first.Before(earliest) {
+		logger.Warn().Time("First", first).Time("Earliest", earliest).Msg("too many days missed, some days will not be rolled up")
+		first = earliest
+	}
+
+	// RetryPolicy specifies how to automatically handle retries if an Activity fails.
+	retrypolicy := &temporal.RetryPolicy{
+		InitialInterval:    2 * time.Second,
+		BackoffCoefficient: 2.0,
+		MaximumInterval:    3 * time.Minute,
+		MaximumAttempts:    5,
+	}
+	options := workflow.ActivityOptions{
+		// Timeout options specify when to automatically timeout Activity functions.
+		StartToCloseTimeout: 30 * time.Minute,
+		// Optionally provide a customized RetryPolicy.
+		RetryPolicy: retrypolicy,
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, options)
+
+	var usageManager usageActivity.ManageTenantUsage
+
+	lastDay := first.AddDate(0, 0, -1)
+	for day := first; !day.After(yesterday); day = day.AddDate(0, 0, 1) {
+		var rows int
+		err := workflow.ExecuteActivity(ctx, usageManager.RollupTenantUsage, day).Get(ctx, &rows)
+		if err != nil {
+			logger.Warn().Err(err).Str("Usage Date", day.Format(time.DateOnly)).Msg("failed to execute activity: RollupTenantUsage")
+			return lastDay, err
+		}
+
+		logger.Info().Str("Usage Date", day.Format(time.DateOnly)).Int("Rows", rows).Msg("rolled up Tenant usage")
+		lastDay = day
+	}
+
+	logger.Info().Msg("completing workflow")
+
+	return lastDay, nil
+}
+
+// ExecuteRollupTenantUsageWorkflow is a helper function to trigger execution of RollupTenantUsage workflow
+func ExecuteRollupTenantUsageWorkflow(ctx context.Context, tc client.Client) (*string, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:           "tenant-usage-rollup",
+		CronSchedule: TenantUsageRollupSchedule,
+		TaskQueue:    queue.CloudTaskQueue,
+	}
+
+	we, err := tc.ExecuteWorkflow(ctx, workflowOptions, RollupTenantUsage)
+	if err != nil {
+		log.Error().Err(err).Msg("failed to execute workflow: RollupTenantUsage")
+		return nil, err
+	}
+
+	wid := we.GetID()
+
+	return &wid, nil
+}
@@ -0,0 +1,154 @@
+/*
+ * SPDX-FileCopyrightText: Copyright (c) 2026 NVIDIA CORPORATION & AFFILIATES. All rights reserved.
+ * SPDX-License-Identifier: Apache-2.0
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+
+	usageActivity "github.com/nvidia/bare-metal-manager-rest/workflow/pkg/activity/usage"
+	tmocks "go.temporal.io/sdk/mocks"
+)
+
+type RollupTenantUsageTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	env *testsuite.TestWorkflowEnvironment
+}
+
+func (s *RollupTenantUsageTestSuite) SetupTest() {
+	s.env = s.NewTestWorkflowEnvironment()
+}
+
+func (s *RollupTenantUsageTestSuite) AfterTest(suiteName, testName string) {
+	s.env.AssertExpectations(s.T())
+}
+
+func (s *RollupTenantUsageTestSuite) Test_RollupTenantUsageWorkflow_FirstRun() {
+	var usageManager usageActivity.ManageTenantUsage
+
+	s.env.SetStartTime(time.Date(2026, 4, 2, 0, 15, 0, 0, time.UTC))
+
+	yesterday := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Mock RollupTenantUsage activity success, first run rolls up the previous day only
+	s.env.RegisterActivity(usageManager.RollupTenantUsage)
+	s.env.OnActivity(usageManager.RollupTenantUsage, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, day time.Time) (int, error) {
+			s.True(day.Equal(yesterday))
+			return 3, nil
+		}).Once()
+
+	// Execute RollupTenantUsage workflow
+	s.env.ExecuteWorkflow(RollupTenantUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	var lastDay time.Time
+	s.NoError(s.env.GetWorkflowResult(&lastDay))
+	s.True(lastDay.Equal(yesterday))
+}
+
+func (s *RollupTenantUsageTestSuite) Test_RollupTenantUsageWorkflow_BackfillsMissedDays() {
+	var usageManager usageActivity.ManageTenantUsage
+
+	s.env.SetStartTime(time.Date(2026, 4, 4, 0, 15, 0, 0, time.UTC))
+	s.env.SetLastCompletionResult(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	// Mock RollupTenantUsage activity success, days since the last completed run are rolled up in order
+	days := []time.Time{}
+	s.env.RegisterActivity(usageManager.RollupTenantUsage)
+	s.env.OnActivity(usageManager.RollupTenantUsage, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, day time.Time) (int, error) {
+			days = append(days, day)
+			return 0, nil
+		}).Times(2)
+
+	// Execute RollupTenantUsage workflow
+	s.env.ExecuteWorkflow(RollupTenantUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+
+	s.Len(days, 2)
+	s.True(days[0].Equal(time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)))
+	s.True(days[1].Equal(time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)))
+}
+
+func (s *RollupTenantUsageTestSuite) Test_RollupTenantUsageWorkflow_AlreadyRolledUp() {
+	var usageManager usageActivity.ManageTenantUsage
+
+	s.env.SetStartTime(time.Date(2026, 4, 2, 12, 0, 0, 0, time.UTC))
+	s.env.SetLastCompletionResult(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+
+	s.env.RegisterActivity(usageManager.RollupTenantUsage)
+	s.env.OnActivity(usageManager.RollupTenantUsage, mock.Anything, mock.Anything).Return(0, nil).Never()
+
+	// Execute RollupTenantUsage workflow
+	s.env.ExecuteWorkflow(RollupTenantUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	s.NoError(s.env.GetWorkflowError())
+}
+
+func (s *RollupTenantUsageTestSuite) Test_RollupTenantUsageWorkflow_ActivityFails() {
+	var usageManager usageActivity.ManageTenantUsage
+
+	// Mock RollupTenantUsage activity failure
+	s.env.RegisterActivity(usageManager.RollupTenantUsage)
+	s.env.OnActivity(usageManager.RollupTenantUsage, mock.Anything, mock.Anything).Return(0, errors.New("RollupTenantUsage Failure"))
+
+	// Execute RollupTenantUsage workflow
+	s.env.ExecuteWorkflow(RollupTenantUsage)
+	s.True(s.env.IsWorkflowCompleted())
+	err := s.env.GetWorkflowError()
+	s.Error(err)
+
+	var applicationErr *temporal.ApplicationError
+	s.True(errors.As(err, &applicationErr))
+	s.Equal("RollupTenantUsage Failure", applicationErr.Error())
+}
+
+func (s *RollupTenantUsageTestSuite) Test_ExecuteRollupTenantUsageWorkflow_Success() {
+	ctx := context.Background()
+
+	wrid := "test-workflow-run-id"
+
+	wrun := &tmocks.WorkflowRun{}
+	wrun.On("GetID").Return(wrid)
+
+	tc := &tmocks.Client{}
+
+	tc.Mock.On("ExecuteWorkflow", context.Background(), mock.AnythingOfType("internal.StartWorkflowOptions"),
+		mock.Anything).Return(wrun, nil)
+
+	rwrid, err := ExecuteRollupTenantUsageWorkflow(ctx, tc)
+	s.NoError(err)
+	s.Equal(wrid, *rwrid)
+}
+
+func TestRollupTenantUsageSuite(t *testing.T) {
+	suite.Run(t, new(RollupTenantUsageTestSuite))
+}